@@ -5,6 +5,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
@@ -173,6 +174,13 @@ type Config struct {
 	// Batching configuration
 	Batch     bool
 	BatchSize int
+
+	// Soak testing configuration
+	RateRamp         types.RateRamp
+	ChurnCardinality int
+	ChurnRate        float64
+	ChurnInterval    time.Duration
+	Seed             int64
 }
 
 type ClientAuth struct {
@@ -303,6 +311,29 @@ func (c *Config) CommonFlags(fs *pflag.FlagSet) {
 	// Batching configuration
 	fs.BoolVar(&c.Batch, "batch", c.Batch, "Whether to batch telemetry records before sending")
 	fs.IntVar(&c.BatchSize, "batch-size", c.BatchSize, "Number of telemetry records to batch before sending")
+
+	// Soak testing configuration
+	fs.Var(&c.RateRamp, "rate-ramp", "Profile of rates per worker, in the format <rate>:<duration>,... (e.g. 10:1m,100:5m). "+
+		"Each stage ramps the rate linearly from the rate of the previous stage, or from --rate for the first stage, during its duration. "+
+		"The rate stays at the rate of the last stage after the ramp.")
+	fs.IntVar(&c.ChurnCardinality, "churn-cardinality", c.ChurnCardinality, "Number of distinct values of the telemetrygen.series attribute generated by each worker. Zero means no series attribute.")
+	fs.Float64Var(&c.ChurnRate, "churn-rate", c.ChurnRate, "Fraction of the values of the telemetrygen.series attribute replaced by new values at each churn interval")
+	fs.DurationVar(&c.ChurnInterval, "churn-interval", c.ChurnInterval, "Interval at which the values of the telemetrygen.series attribute churn")
+	fs.Int64Var(&c.Seed, "seed", c.Seed, "Seed of the random generation, to reproduce the same telemetry across runs. Zero means a random seed.")
+}
+
+// ValidateSoak validates the soak testing parameters.
+func (c *Config) ValidateSoak() error {
+	if c.ChurnCardinality < 0 {
+		return fmt.Errorf("churn cardinality must be non-negative, found %d", c.ChurnCardinality)
+	}
+	if c.ChurnRate < 0 || c.ChurnRate > 1 {
+		return fmt.Errorf("churn rate must be between 0 and 1, found %v", c.ChurnRate)
+	}
+	if c.ChurnInterval < 0 {
+		return fmt.Errorf("churn interval must be non-negative, found %v", c.ChurnInterval)
+	}
+	return nil
 }
 
 // SetDefaults is here to mirror the defaults for flags above,
@@ -330,6 +361,11 @@ func (c *Config) SetDefaults() {
 	c.LoadSize = 0
 	c.Batch = true
 	c.BatchSize = 100
+	c.RateRamp = nil
+	c.ChurnCardinality = 0
+	c.ChurnRate = 0
+	c.ChurnInterval = 1 * time.Minute
+	c.Seed = 0
 }
 
 // CharactersPerMB is the number of characters needed to create a 1MB string attribute
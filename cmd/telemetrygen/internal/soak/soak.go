@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package soak provides the throughput ramps and the cardinality churn of the soak tests.
+package soak
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/time/rate"
+
+	types "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg"
+)
+
+// SeriesAttributeKey is the key of the attribute whose values churn
+const SeriesAttributeKey = "telemetrygen.series"
+
+// Seed returns the seed of the random number generator of a worker: derived from the configured seed so that
+// the runs are reproducible, or from the current time if no seed is configured.
+func Seed(seed int64, worker int) uint64 {
+	if seed == 0 {
+		return uint64(time.Now().UnixNano()) + uint64(worker)
+	}
+	return uint64(seed) + uint64(worker)
+}
+
+// Limiter is a rate limiter whose limit follows a rate ramp.
+type Limiter struct {
+	limiter *rate.Limiter
+	ramp    types.RateRamp
+	initial float64
+	start   time.Time
+}
+
+// NewLimiter creates a limiter starting at the given limit, and following the ramp if any.
+func NewLimiter(limit rate.Limit, ramp types.RateRamp) *Limiter {
+	initial := float64(limit)
+	if limit == rate.Inf {
+		initial = 0
+	}
+	return &Limiter{
+		limiter: rate.NewLimiter(limit, 1),
+		ramp:    ramp,
+		initial: initial,
+		start:   time.Now(),
+	}
+}
+
+// Wait blocks until the limiter permits an event to happen.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if len(l.ramp) > 0 {
+		l.limiter.SetLimit(rate.Limit(l.ramp.RateAt(time.Since(l.start), l.initial)))
+	}
+	return l.limiter.Wait(ctx)
+}
+
+// Churner generates the values of the series attribute: a bounded set of values, part of which is replaced by new
+// values at every churn interval.
+type Churner struct {
+	prefix    string
+	values    []string
+	next      int
+	nextID    int
+	churnRate float64
+	interval  time.Duration
+	lastChurn time.Time
+	rand      *rand.Rand
+}
+
+// NewChurner creates a churner of cardinality values, or returns nil if the cardinality is 0. The prefix makes
+// the values of the workers distinct.
+func NewChurner(prefix string, cardinality int, churnRate float64, interval time.Duration, seed uint64, now time.Time) *Churner {
+	if cardinality <= 0 {
+		return nil
+	}
+	c := &Churner{
+		prefix:    prefix,
+		values:    make([]string, cardinality),
+		churnRate: churnRate,
+		interval:  interval,
+		lastChurn: now,
+		rand:      rand.New(rand.NewPCG(seed, 0)),
+	}
+	for i := range c.values {
+		c.values[i] = c.newValue()
+	}
+	return c
+}
+
+// Next returns the series attribute of the next record, cycling through the current values.
+func (c *Churner) Next(now time.Time) attribute.KeyValue {
+	if c.interval > 0 && now.Sub(c.lastChurn) >= c.interval {
+		c.churn()
+		c.lastChurn = now
+	}
+	value := c.values[c.next]
+	c.next = (c.next + 1) % len(c.values)
+	return attribute.String(SeriesAttributeKey, value)
+}
+
+// churn replaces a random part of the values by new values.
+func (c *Churner) churn() {
+	n := int(math.Round(c.churnRate * float64(len(c.values))))
+	for _, i := range c.rand.Perm(len(c.values))[:n] {
+		c.values[i] = c.newValue()
+	}
+}
+
+func (c *Churner) newValue() string {
+	value := fmt.Sprintf("%s-%d", c.prefix, c.nextID)
+	c.nextID++
+	return value
+}
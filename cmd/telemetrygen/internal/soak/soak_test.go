@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package soak
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+
+	types "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg"
+)
+
+func TestChurner(t *testing.T) {
+	assert.Nil(t, NewChurner("w0", 0, 0.5, time.Minute, 1, time.Now()))
+
+	start := time.Now()
+	c := NewChurner("w0", 4, 0.5, time.Minute, 1, start)
+	var values []string
+	for range 4 {
+		kv := c.Next(start)
+		assert.Equal(t, SeriesAttributeKey, string(kv.Key))
+		values = append(values, kv.Value.AsString())
+	}
+	assert.Equal(t, []string{"w0-0", "w0-1", "w0-2", "w0-3"}, values)
+
+	// Half of the values are replaced at each churn interval
+	values = values[:0]
+	for range 4 {
+		values = append(values, c.Next(start.Add(time.Minute)).Value.AsString())
+	}
+	var replaced int
+	for i, v := range values {
+		if v != c.values[i] {
+			t.Fatalf("unexpected value %q", v)
+		}
+		if v >= "w0-4" {
+			replaced++
+		}
+	}
+	assert.Equal(t, 2, replaced)
+}
+
+func TestChurnerReproducible(t *testing.T) {
+	start := time.Now()
+	first := NewChurner("w0", 10, 0.3, time.Second, 42, start)
+	second := NewChurner("w0", 10, 0.3, time.Second, 42, start)
+	for i := range 100 {
+		now := start.Add(time.Duration(i) * 100 * time.Millisecond)
+		assert.Equal(t, first.Next(now), second.Next(now))
+	}
+}
+
+func TestSeed(t *testing.T) {
+	assert.Equal(t, uint64(45), Seed(42, 3))
+	assert.NotZero(t, Seed(0, 0))
+}
+
+func TestLimiterRamp(t *testing.T) {
+	l := NewLimiter(rate.Inf, types.RateRamp{{Rate: 1000, Duration: time.Hour}})
+	assert.NoError(t, l.Wait(t.Context()))
+	assert.InDelta(t, 1000, float64(l.limiter.Limit()), 1)
+
+	l = NewLimiter(rate.Limit(10), nil)
+	assert.NoError(t, l.Wait(t.Context()))
+	assert.Equal(t, rate.Limit(10), l.limiter.Limit())
+}
@@ -22,6 +22,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/log"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/soak"
 )
 
 // Start starts the log telemetry generator
@@ -90,6 +91,8 @@ func run(c *Config, expF exporterFunc, logger *zap.Logger) error {
 			batchSize:      c.BatchSize,
 			loadSize:       c.LoadSize,
 			allowFailures:  c.AllowExportFailures,
+			rateRamp:       c.RateRamp,
+			churner:        soak.NewChurner(fmt.Sprintf("w%d", i), c.ChurnCardinality, c.ChurnRate, c.ChurnInterval, soak.Seed(c.Seed, i), time.Now()),
 		}
 
 		exp, err := expF()
@@ -21,6 +21,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/soak"
 	types "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg"
 )
 
@@ -43,10 +44,12 @@ type worker struct {
 	batchSize      int                   // number of logs to batch before flushing
 	loadSize       int                   // desired minimum size in MB of string data for each generated log
 	allowFailures  bool                  // whether to continue on export failures
+	rateRamp       types.RateRamp        // profile of rates ramped during the test
+	churner        *soak.Churner         // generator of the series attribute, nil if disabled
 }
 
 func (w *worker) simulateLogs(res *resource.Resource, exporter sdklog.Exporter, telemetryAttributes []attribute.KeyValue) {
-	limiter := rate.NewLimiter(w.limitPerSecond, 1)
+	limiter := soak.NewLimiter(w.limitPerSecond, w.rateRamp)
 	var i int64
 
 	for w.running.Load() {
@@ -68,6 +71,9 @@ func (w *worker) simulateLogs(res *resource.Resource, exporter sdklog.Exporter,
 		for _, attr := range telemetryAttributes {
 			attrs = append(attrs, log.KeyValueFromAttribute(attr))
 		}
+		if w.churner != nil {
+			attrs = append(attrs, log.KeyValueFromAttribute(w.churner.Next(time.Now())))
+		}
 
 		// Add load size attributes if specified
 		if w.loadSize > 0 {
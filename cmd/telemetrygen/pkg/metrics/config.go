@@ -86,6 +86,10 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("load size must be non-negative, found %d", c.LoadSize)
 	}
 
+	if err := c.ValidateSoak(); err != nil {
+		return err
+	}
+
 	if c.TraceID != "" {
 		if err := validate.TraceID(c.TraceID); err != nil {
 			return err
@@ -22,6 +22,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/log"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/soak"
 )
 
 // Start starts the metric telemetry generator
@@ -88,8 +89,10 @@ func run(c *Config, expF exporterFunc, logger *zap.Logger) error {
 			metricBuffer:           make([]metricdata.ResourceMetrics, 0),
 			bufferMutex:            sync.Mutex{},
 			loadSize:               c.LoadSize,
-			rand:                   rand.New(rand.NewPCG(uint64(time.Now().UnixNano()+int64(i)), 0)),
+			rand:                   rand.New(rand.NewPCG(soak.Seed(c.Seed, i), 0)),
 			allowFailures:          c.AllowExportFailures,
+			rateRamp:               c.RateRamp,
+			churner:                soak.NewChurner(fmt.Sprintf("w%d", i), c.ChurnCardinality, c.ChurnRate, c.ChurnInterval, soak.Seed(c.Seed, i), time.Now()),
 		}
 		exp, err := expF()
 		if err != nil {
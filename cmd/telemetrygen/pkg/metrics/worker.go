@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand/v2"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/soak"
 	types "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg"
 )
 
@@ -44,6 +46,8 @@ type worker struct {
 	loadSize               int                          // desired minimum size in MB of string data for each generated metric
 	allowFailures          bool                         // whether to continue on export failures
 	rand                   *rand.Rand                   // random number generator for exponential histogram generation
+	rateRamp               types.RateRamp               // profile of rates ramped during the test
+	churner                *soak.Churner                // generator of the series attribute, nil if disabled
 }
 
 // We use a 15-element bounds slice for histograms below, so there must be 16 buckets here.
@@ -97,7 +101,7 @@ var histogramBucketSamples = []struct {
 }
 
 func (w *worker) simulateMetrics(res *resource.Resource, exporter sdkmetric.Exporter, signalAttrs []attribute.KeyValue, tb *timeBox) {
-	limiter := rate.NewLimiter(w.limitPerSecond, 1)
+	limiter := soak.NewLimiter(w.limitPerSecond, w.rateRamp)
 
 	startTime := w.clock.Now()
 
@@ -107,8 +111,13 @@ func (w *worker) simulateMetrics(res *resource.Resource, exporter sdkmetric.Expo
 			signalAttrs = append(signalAttrs, tb.getAttribute())
 		}
 
+		seriesAttrs := signalAttrs
+		if w.churner != nil {
+			seriesAttrs = append(slices.Clip(signalAttrs), w.churner.Next(w.clock.Now()))
+		}
+
 		// Add load size attributes if specified
-		loadAttrs := signalAttrs
+		loadAttrs := seriesAttrs
 		if w.loadSize > 0 {
 			for j := 0; j < w.loadSize; j++ {
 				loadAttrs = append(loadAttrs, config.CreateLoadAttribute(fmt.Sprintf("load-%v", j), 1))
@@ -195,7 +204,7 @@ func (w *worker) simulateMetrics(res *resource.Resource, exporter sdkmetric.Expo
 			dp := &metricdata.ExponentialHistogramDataPoint[int64]{
 				StartTime:  startTime,
 				Time:       now,
-				Attributes: attribute.NewSet(signalAttrs...),
+				Attributes: attribute.NewSet(seriesAttrs...),
 				Exemplars:  w.exemplars,
 			}
 			expoHistToSDKExponentialDataPoint(hist, dp)
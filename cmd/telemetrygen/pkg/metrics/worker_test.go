@@ -20,6 +20,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/soak"
 	types "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg"
 )
 
@@ -97,6 +98,32 @@ func TestFixedNumberOfMetrics(t *testing.T) {
 	require.Len(t, m.rms, 5)
 }
 
+func TestSeriesChurn(t *testing.T) {
+	// arrange
+	cfg := configWithOneAttribute(MetricTypeGauge, 6)
+	cfg.ChurnCardinality = 3
+	m := &mockExporter{}
+	expFunc := func() (sdkmetric.Exporter, error) {
+		return m, nil
+	}
+
+	// act
+	logger, _ := zap.NewDevelopment()
+	require.NoError(t, run(cfg, expFunc, logger))
+
+	// assert
+	require.Len(t, m.rms, 6)
+	var series []string
+	for _, rm := range m.rms {
+		attrs := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[int64]).DataPoints[0].Attributes
+		value, ok := attrs.Value(soak.SeriesAttributeKey)
+		require.True(t, ok)
+		series = append(series, value.AsString())
+		assert.True(t, attrs.HasValue(telemetryAttrKeyOne))
+	}
+	assert.Equal(t, []string{"w0-0", "w0-1", "w0-2", "w0-0", "w0-1", "w0-2"}, series)
+}
+
 func TestDurationInf(t *testing.T) {
 	cfg := &Config{
 		Config: config.Config{
@@ -66,5 +66,9 @@ func (c *Config) Validate() error {
 		return errors.New("either `traces` or `duration` must be greater than 0")
 	}
 
+	if err := c.ValidateSoak(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/rand/v2"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -26,6 +27,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/log"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/soak"
 )
 
 func Start(cfg *Config) error {
@@ -162,6 +164,9 @@ func run(c *Config, logger *zap.Logger) error {
 			allowFailures:    c.AllowExportFailures,
 			numSpanLinks:     c.NumSpanLinks,
 			spanContexts:     make([]trace.SpanContext, 0),
+			rateRamp:         c.RateRamp,
+			churner:          soak.NewChurner(fmt.Sprintf("w%d", i), c.ChurnCardinality, c.ChurnRate, c.ChurnInterval, soak.Seed(c.Seed, i)+1, time.Now()),
+			rand:             rand.New(rand.NewPCG(soak.Seed(c.Seed, i), 0)),
 		}
 
 		go w.simulateTraces(telemetryAttributes)
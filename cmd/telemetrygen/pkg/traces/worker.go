@@ -22,6 +22,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/soak"
 	types "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg"
 )
 
@@ -41,6 +42,9 @@ type worker struct {
 	allowFailures    bool                // whether to continue on export failures
 	spanContexts     []trace.SpanContext // collection of span contexts for linking
 	spanContextsMu   sync.RWMutex        // mutex for spanContexts slice
+	rateRamp         types.RateRamp      // profile of rates ramped during the test
+	churner          *soak.Churner       // generator of the series attribute, nil if disabled
+	rand             *rand.Rand          // random number generator for span links
 }
 
 const (
@@ -80,7 +84,12 @@ func (w *worker) generateSpanLinks() []trace.Link {
 
 	// Generate links to random existing span contexts
 	for i := 0; i < w.numSpanLinks; i++ {
-		randomIndex := rand.IntN(availableContexts)
+		var randomIndex int
+		if w.rand != nil {
+			randomIndex = w.rand.IntN(availableContexts)
+		} else {
+			randomIndex = rand.IntN(availableContexts)
+		}
 		spanCtx := w.spanContexts[randomIndex]
 
 		links = append(links, trace.Link{
@@ -97,7 +106,7 @@ func (w *worker) generateSpanLinks() []trace.Link {
 
 func (w *worker) simulateTraces(telemetryAttributes []attribute.KeyValue) {
 	tracer := otel.Tracer("telemetrygen")
-	limiter := rate.NewLimiter(w.limitPerSecond, 1)
+	limiter := soak.NewLimiter(w.limitPerSecond, w.rateRamp)
 	var i int
 
 	for w.running.Load() {
@@ -120,6 +129,11 @@ func (w *worker) simulateTraces(telemetryAttributes []attribute.KeyValue) {
 			trace.WithLinks(parentLinks...),
 		)
 		sp.SetAttributes(telemetryAttributes...)
+		var seriesAttrs []attribute.KeyValue
+		if w.churner != nil {
+			seriesAttrs = append(seriesAttrs, w.churner.Next(spanStart))
+			sp.SetAttributes(seriesAttrs...)
+		}
 		for j := 0; j < w.loadSize; j++ {
 			sp.SetAttributes(config.CreateLoadAttribute(fmt.Sprintf("load-%v", j), 1))
 		}
@@ -155,6 +169,7 @@ func (w *worker) simulateTraces(telemetryAttributes []attribute.KeyValue) {
 				trace.WithLinks(childLinks...),
 			)
 			child.SetAttributes(telemetryAttributes...)
+			child.SetAttributes(seriesAttrs...)
 
 			// Store the child span context for potential future linking
 			w.addSpanContext(child.SpanContext())
@@ -4,6 +4,8 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -63,3 +65,71 @@ func (d *DurationWithInf) Duration() time.Duration {
 	}
 	return time.Duration(*d)
 }
+
+// RateStage is a stage of a RateRamp: the rate ramps linearly from the rate of the previous stage to Rate during
+// Duration.
+type RateStage struct {
+	Rate     float64
+	Duration time.Duration
+}
+
+// RateRamp is a profile of rates, which can be set from a string in the format "<rate>:<duration>,..."
+// (e.g. "10:1m,100:5m").
+type RateRamp []RateStage
+
+func (r *RateRamp) String() string {
+	stages := make([]string, 0, len(*r))
+	for _, stage := range *r {
+		stages = append(stages, strconv.FormatFloat(stage.Rate, 'f', -1, 64)+":"+stage.Duration.String())
+	}
+	return strings.Join(stages, ",")
+}
+
+func (r *RateRamp) Set(s string) error {
+	var ramp RateRamp
+	for item := range strings.SplitSeq(s, ",") {
+		rateStr, durationStr, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok {
+			return fmt.Errorf("stage %q should be in the format <rate>:<duration>", item)
+		}
+		rate, err := strconv.ParseFloat(rateStr, 64)
+		if err != nil {
+			return fmt.Errorf("invalid rate of stage %q: %w", item, err)
+		}
+		if rate <= 0 {
+			return fmt.Errorf("rate of stage %q must be greater than 0", item)
+		}
+		duration, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return fmt.Errorf("invalid duration of stage %q: %w", item, err)
+		}
+		if duration < 0 {
+			return fmt.Errorf("duration of stage %q must be non-negative", item)
+		}
+		ramp = append(ramp, RateStage{Rate: rate, Duration: duration})
+	}
+	*r = ramp
+	return nil
+}
+
+func (*RateRamp) Type() string {
+	return "rate:duration,..."
+}
+
+// RateAt returns the rate of the ramp at the given time after its start. The first stage ramps from the initial
+// rate, or starts at its own rate if the initial rate is 0. The rate stays at the rate of the last stage after
+// the ramp.
+func (r RateRamp) RateAt(elapsed time.Duration, initial float64) float64 {
+	previous := initial
+	for _, stage := range r {
+		if previous <= 0 {
+			previous = stage.Rate
+		}
+		if elapsed < stage.Duration {
+			return previous + (stage.Rate-previous)*float64(elapsed)/float64(stage.Duration)
+		}
+		elapsed -= stage.Duration
+		previous = stage.Rate
+	}
+	return previous
+}
@@ -172,3 +172,31 @@ func TestDurationWithInf_Duration(t *testing.T) {
 		})
 	}
 }
+
+func TestRateRamp_Set(t *testing.T) {
+	var r RateRamp
+	require.NoError(t, r.Set("10:1m, 100:5m,100:0s"))
+	assert.Equal(t, RateRamp{
+		{Rate: 10, Duration: time.Minute},
+		{Rate: 100, Duration: 5 * time.Minute},
+		{Rate: 100, Duration: 0},
+	}, r)
+	assert.Equal(t, "10:1m0s,100:5m0s,100:0s", r.String())
+
+	for _, invalid := range []string{"10", "foo:1m", "0:1m", "10:foo", "10:-1m"} {
+		assert.Error(t, r.Set(invalid), invalid)
+	}
+}
+
+func TestRateRamp_RateAt(t *testing.T) {
+	r := RateRamp{
+		{Rate: 10, Duration: time.Minute},
+		{Rate: 100, Duration: 2 * time.Minute},
+	}
+	assert.InDelta(t, 1.0, r.RateAt(0, 1), 1e-9)
+	assert.InDelta(t, 5.5, r.RateAt(30*time.Second, 1), 1e-9)
+	assert.InDelta(t, 10.0, r.RateAt(0, 0), 1e-9)
+	assert.InDelta(t, 10.0, r.RateAt(30*time.Second, 0), 1e-9)
+	assert.InDelta(t, 55.0, r.RateAt(2*time.Minute, 1), 1e-9)
+	assert.InDelta(t, 100.0, r.RateAt(time.Hour, 1), 1e-9)
+}
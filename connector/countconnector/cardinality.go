@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/axiomhq/hyperloglog"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector/internal/metadata"
+	utilattri "github.com/open-telemetry/opentelemetry-collector-contrib/internal/pdatautil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+const (
+	cardinalityMetricName = "attribute.cardinality"
+	cardinalityMetricDesc = "The estimated number of unique values of the attribute over the interval."
+	cardinalityMetricUnit = "{value}"
+
+	cardinalityKeyAttribute    = "attribute.key"
+	cardinalitySignalAttribute = "signal"
+)
+
+// cardinalityEstimator estimates the number of unique values of the configured attributes with
+// HyperLogLog sketches. The estimations are emitted as gauges and the sketches are reset every
+// interval.
+type cardinalityEstimator struct {
+	cfg             CardinalityConfig
+	signal          string
+	metricsConsumer consumer.Metrics
+	logger          *zap.Logger
+
+	mu        sync.Mutex
+	sketches  map[string]*hyperloglog.Sketch
+	startTime pcommon.Timestamp
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newCardinalityEstimator(cfg CardinalityConfig, signal string, metricsConsumer consumer.Metrics, logger *zap.Logger) *cardinalityEstimator {
+	if len(cfg.Attributes) == 0 {
+		return nil
+	}
+	return &cardinalityEstimator{
+		cfg:             cfg,
+		signal:          signal,
+		metricsConsumer: metricsConsumer,
+		logger:          logger,
+		sketches:        make(map[string]*hyperloglog.Sketch, len(cfg.Attributes)),
+		startTime:       pcommon.NewTimestampFromTime(time.Now()),
+	}
+}
+
+// observe adds the values of the configured attributes to the sketches. The attributes are
+// looked up in the record, scope and resource attributes, in that order.
+func (e *cardinalityEstimator) observe(attrs, scopeAttrs, resourceAttrs pcommon.Map) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, key := range e.cfg.Attributes {
+		value, ok := utilattri.GetDimensionValue(utilattri.Dimension{Name: key}, attrs, scopeAttrs, resourceAttrs)
+		if !ok {
+			continue
+		}
+		sketch, ok := e.sketches[key]
+		if !ok {
+			// the precision is checked in Config.Validate()
+			sketch, _ = hyperloglog.NewSketch(e.cfg.Precision, true)
+			e.sketches[key] = sketch
+		}
+		hash := pdatautil.ValueHash(value)
+		sketch.Insert(hash[:])
+	}
+}
+
+func (e *cardinalityEstimator) start() {
+	if e == nil {
+		return
+	}
+	e.done = make(chan struct{})
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(e.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := e.emit(context.Background()); err != nil {
+					e.logger.Error("failed to emit the attribute cardinality metrics", zap.Error(err))
+				}
+			case <-e.done:
+				return
+			}
+		}
+	}()
+}
+
+func (e *cardinalityEstimator) shutdown() {
+	if e == nil || e.done == nil {
+		return
+	}
+	close(e.done)
+	e.wg.Wait()
+}
+
+// emit passes the estimations of the interval to the next consumer and resets the sketches.
+func (e *cardinalityEstimator) emit(ctx context.Context) error {
+	e.mu.Lock()
+	sketches := e.sketches
+	startTime, endTime := e.startTime, pcommon.NewTimestampFromTime(time.Now())
+	e.sketches = make(map[string]*hyperloglog.Sketch, len(e.cfg.Attributes))
+	e.startTime = endTime
+	e.mu.Unlock()
+
+	if len(sketches) == 0 {
+		return nil
+	}
+	md := pmetric.NewMetrics()
+	scope := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	scope.Scope().SetName(metadata.ScopeName)
+	metric := scope.Metrics().AppendEmpty()
+	metric.SetName(cardinalityMetricName)
+	metric.SetDescription(cardinalityMetricDesc)
+	metric.SetUnit(cardinalityMetricUnit)
+	dps := metric.SetEmptyGauge().DataPoints()
+	for _, key := range e.cfg.Attributes {
+		sketch, ok := sketches[key]
+		if !ok {
+			continue
+		}
+		dp := dps.AppendEmpty()
+		dp.Attributes().PutStr(cardinalityKeyAttribute, key)
+		dp.Attributes().PutStr(cardinalitySignalAttribute, e.signal)
+		dp.SetStartTimestamp(startTime)
+		dp.SetTimestamp(endTime)
+		dp.SetIntValue(int64(sketch.Estimate()))
+	}
+	return e.metricsConsumer.ConsumeMetrics(ctx, md)
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector/internal/metadata"
+)
+
+func cardinalityValues(t *testing.T, md pmetric.Metrics) map[string]int64 {
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	metric := metrics.At(0)
+	assert.Equal(t, cardinalityMetricName, metric.Name())
+	assert.Equal(t, cardinalityMetricUnit, metric.Unit())
+
+	values := map[string]int64{}
+	for _, dp := range metric.Gauge().DataPoints().All() {
+		key, _ := dp.Attributes().Get(cardinalityKeyAttribute)
+		signal, _ := dp.Attributes().Get(cardinalitySignalAttribute)
+		assert.Equal(t, "logs", signal.Str())
+		values[key.Str()] = dp.IntValue()
+	}
+	return values
+}
+
+func TestCardinalityEstimator(t *testing.T) {
+	assert.Nil(t, newCardinalityEstimator(defaultCardinalityConfig(), "logs", consumertest.NewNop(), zap.NewNop()))
+
+	sink := new(consumertest.MetricsSink)
+	cfg := defaultCardinalityConfig()
+	cfg.Attributes = []string{"user.id", "host.name", "missing"}
+	e := newCardinalityEstimator(cfg, "logs", sink, zap.NewNop())
+
+	scopeAttrs := pcommon.NewMap()
+	resourceAttrs := pcommon.NewMap()
+	resourceAttrs.PutStr("host.name", "host")
+	for i := range 10000 {
+		attrs := pcommon.NewMap()
+		attrs.PutStr("user.id", strconv.Itoa(i%5000))
+		e.observe(attrs, scopeAttrs, resourceAttrs)
+	}
+
+	require.NoError(t, e.emit(t.Context()))
+	require.Len(t, sink.AllMetrics(), 1)
+	values := cardinalityValues(t, sink.AllMetrics()[0])
+	assert.Len(t, values, 2, "the missing attribute is not emitted")
+	assert.InEpsilon(t, 5000, values["user.id"], 0.05)
+	assert.Equal(t, int64(1), values["host.name"])
+
+	// the sketches are reset after each interval
+	require.NoError(t, e.emit(t.Context()))
+	assert.Len(t, sink.AllMetrics(), 1)
+}
+
+func TestLogsCardinality(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Cardinality.Attributes = []string{"user.id"}
+	cfg.Cardinality.Interval = 10 * time.Millisecond
+	require.NoError(t, cfg.Validate())
+
+	sink := new(consumertest.MetricsSink)
+	conn, err := factory.CreateLogsToMetrics(t.Context(), connectortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, conn.Shutdown(t.Context()))
+	}()
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i := range 3 {
+		records.AppendEmpty().Attributes().PutStr("user.id", strconv.Itoa(i))
+	}
+	require.NoError(t, conn.ConsumeLogs(t.Context(), ld))
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		for _, md := range sink.AllMetrics() {
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			if metrics.At(0).Name() == cardinalityMetricName {
+				assert.Equal(c, int64(3), metrics.At(0).Gauge().DataPoints().At(0).IntValue())
+				return
+			}
+		}
+		assert.Fail(c, "the cardinality metric was not emitted")
+	}, 5*time.Second, 10*time.Millisecond)
+}
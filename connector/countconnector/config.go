@@ -6,6 +6,7 @@ package countconnector // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
@@ -43,6 +44,23 @@ type Config struct {
 	DataPoints map[string]MetricInfo `mapstructure:"datapoints"`
 	Logs       map[string]MetricInfo `mapstructure:"logs"`
 	Profiles   map[string]MetricInfo `mapstructure:"profiles"`
+	// Cardinality estimates the number of unique values of attributes.
+	Cardinality CardinalityConfig `mapstructure:"cardinality"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// CardinalityConfig configures the estimation of the number of unique values of attributes
+// with HyperLogLog sketches.
+type CardinalityConfig struct {
+	// Attributes are the keys of the attributes whose unique values are estimated.
+	// The estimation is disabled if empty.
+	Attributes []string `mapstructure:"attributes"`
+	// Interval is the interval at which the estimations are emitted before being reset.
+	Interval time.Duration `mapstructure:"interval"`
+	// Precision is the precision of the HyperLogLog sketches, between 4 and 18.
+	// Higher precisions use more memory and have a lower error.
+	Precision uint8 `mapstructure:"precision"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -131,6 +149,27 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("profiles attributes: metric %q: %w", name, err)
 		}
 	}
+	if err := c.Cardinality.validate(); err != nil {
+		return fmt.Errorf("cardinality: %w", err)
+	}
+	return nil
+}
+
+func (c *CardinalityConfig) validate() error {
+	if len(c.Attributes) == 0 {
+		return nil
+	}
+	for _, key := range c.Attributes {
+		if key == "" {
+			return errors.New("attribute key missing")
+		}
+	}
+	if c.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if c.Precision < 4 || c.Precision > 18 {
+		return errors.New("precision must be between 4 and 18")
+	}
 	return nil
 }
 
@@ -159,7 +198,7 @@ func (c *Config) Unmarshal(componentParser *confmap.Conf) error {
 	}
 	// Start from defaults provided by createDefaultConfig.
 	// Unmarshal into a temporary struct and override only sections that are provided and non-empty.
-	var userCfg Config
+	userCfg := Config{Cardinality: c.Cardinality}
 	if err := componentParser.Unmarshal(&userCfg, confmap.WithIgnoreUnused()); err != nil {
 		return err
 	}
@@ -187,9 +226,17 @@ func (c *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser.IsSet("profiles") && len(userCfg.Profiles) > 0 {
 		c.Profiles = userCfg.Profiles
 	}
+	c.Cardinality = userCfg.Cardinality
 	return nil
 }
 
+func defaultCardinalityConfig() CardinalityConfig {
+	return CardinalityConfig{
+		Interval:  time.Minute,
+		Precision: 14,
+	}
+}
+
 func defaultSpansConfig() map[string]MetricInfo {
 	return map[string]MetricInfo{
 		defaultMetricNameSpans: {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,6 +25,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "",
 			expect: &Config{
+				Cardinality: defaultCardinalityConfig(),
 				Spans: map[string]MetricInfo{
 					defaultMetricNameSpans: {
 						Description: defaultMetricDescSpans,
@@ -59,6 +61,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "custom_description",
 			expect: &Config{
+				Cardinality: defaultCardinalityConfig(),
 				Spans: map[string]MetricInfo{
 					defaultMetricNameSpans: {
 						Description: "My description for default span count metric.",
@@ -94,6 +97,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "custom_metric",
 			expect: &Config{
+				Cardinality: defaultCardinalityConfig(),
 				Spans: map[string]MetricInfo{
 					"my.span.count": {
 						Description: "My span count.",
@@ -129,6 +133,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "condition",
 			expect: &Config{
+				Cardinality: defaultCardinalityConfig(),
 				Spans: map[string]MetricInfo{
 					"my.span.count": {
 						Description: "My span count.",
@@ -170,6 +175,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "multiple_condition",
 			expect: &Config{
+				Cardinality: defaultCardinalityConfig(),
 				Spans: map[string]MetricInfo{
 					"my.span.count": {
 						Description: "My span count.",
@@ -229,6 +235,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "attribute",
 			expect: &Config{
+				Cardinality: defaultCardinalityConfig(),
 				Spans: map[string]MetricInfo{
 					"my.span.count": {
 						Description: "My span count by environment.",
@@ -279,6 +286,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "multiple_metrics",
 			expect: &Config{
+				Cardinality: defaultCardinalityConfig(),
 				Spans: map[string]MetricInfo{
 					"my.span.count": {
 						Description: "My span count.",
@@ -383,6 +391,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "default_values",
 			expect: &Config{
+				Cardinality: defaultCardinalityConfig(),
 				Spans: map[string]MetricInfo{
 					defaultMetricNameSpans: {
 						Description: defaultMetricDescSpans,
@@ -436,6 +445,22 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "cardinality",
+			expect: &Config{
+				Cardinality: CardinalityConfig{
+					Attributes: []string{"http.route", "user.id"},
+					Interval:   30 * time.Second,
+					Precision:  14,
+				},
+				Spans:      defaultSpansConfig(),
+				SpanEvents: defaultSpanEventsConfig(),
+				Metrics:    defaultMetricsConfig(),
+				DataPoints: defaultDataPointsConfig(),
+				Logs:       defaultLogsConfig(),
+				Profiles:   defaultProfilesConfig(),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -599,6 +624,38 @@ func TestConfigErrors(t *testing.T) {
 			},
 			expect: fmt.Sprintf("profiles condition: metric %q: unable to parse OTTL condition", defaultMetricNameProfiles),
 		},
+		{
+			name: "cardinality_missing_attribute_key",
+			input: &Config{
+				Cardinality: CardinalityConfig{
+					Attributes: []string{""},
+					Interval:   time.Minute,
+					Precision:  14,
+				},
+			},
+			expect: "cardinality: attribute key missing",
+		},
+		{
+			name: "cardinality_invalid_interval",
+			input: &Config{
+				Cardinality: CardinalityConfig{
+					Attributes: []string{"user.id"},
+					Precision:  14,
+				},
+			},
+			expect: "cardinality: interval must be positive",
+		},
+		{
+			name: "cardinality_invalid_precision",
+			input: &Config{
+				Cardinality: CardinalityConfig{
+					Attributes: []string{"user.id"},
+					Interval:   time.Minute,
+					Precision:  20,
+				},
+			},
+			expect: "cardinality: precision must be between 4 and 18",
+		},
 	}
 
 	for _, tc := range testCases {
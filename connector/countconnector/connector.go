@@ -29,8 +29,6 @@ import (
 // profiles and emit the counts onto a metrics pipeline.
 type count struct {
 	metricsConsumer consumer.Metrics
-	component.StartFunc
-	component.ShutdownFunc
 
 	spansMetricDefs      map[string]metricDef[*ottlspan.TransformContext]
 	spanEventsMetricDefs map[string]metricDef[*ottlspanevent.TransformContext]
@@ -38,6 +36,18 @@ type count struct {
 	dataPointsMetricDefs map[string]metricDef[*ottldatapoint.TransformContext]
 	logsMetricDefs       map[string]metricDef[*ottllog.TransformContext]
 	profilesMetricDefs   map[string]metricDef[ottlprofile.TransformContext]
+
+	cardinality *cardinalityEstimator
+}
+
+func (c *count) Start(context.Context, component.Host) error {
+	c.cardinality.start()
+	return nil
+}
+
+func (c *count) Shutdown(context.Context) error {
+	c.cardinality.shutdown()
+	return nil
 }
 
 func (*count) Capabilities() consumer.Capabilities {
@@ -65,6 +75,7 @@ func (c *count) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 				sCtx := ottlspan.NewTransformContextPtr(resourceSpan, scopeSpan, span)
 				multiError = errors.Join(multiError, spansCounter.update(ctx, span.Attributes(), scopeAttrs, resourceAttrs, sCtx))
 				sCtx.Close()
+				c.cardinality.observe(span.Attributes(), scopeAttrs, resourceAttrs)
 
 				for l := 0; l < span.Events().Len(); l++ {
 					event := span.Events().At(l)
@@ -126,6 +137,7 @@ func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 						dCtx := ottldatapoint.NewTransformContextPtr(resourceMetric, scopeMetrics, metric, dp)
 						multiError = errors.Join(multiError, dataPointsCounter.update(ctx, dp.Attributes(), scopeAttrs, resourceAttrs, dCtx))
 						dCtx.Close()
+						c.cardinality.observe(dp.Attributes(), scopeAttrs, resourceAttrs)
 					}
 				case pmetric.MetricTypeSum:
 					dps := metric.Sum().DataPoints()
@@ -135,6 +147,7 @@ func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 						dCtx := ottldatapoint.NewTransformContextPtr(resourceMetric, scopeMetrics, metric, dp)
 						multiError = errors.Join(multiError, dataPointsCounter.update(ctx, dp.Attributes(), scopeAttrs, resourceAttrs, dCtx))
 						dCtx.Close()
+						c.cardinality.observe(dp.Attributes(), scopeAttrs, resourceAttrs)
 					}
 				case pmetric.MetricTypeSummary:
 					dps := metric.Summary().DataPoints()
@@ -144,6 +157,7 @@ func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 						dCtx := ottldatapoint.NewTransformContextPtr(resourceMetric, scopeMetrics, metric, dp)
 						multiError = errors.Join(multiError, dataPointsCounter.update(ctx, dp.Attributes(), scopeAttrs, resourceAttrs, dCtx))
 						dCtx.Close()
+						c.cardinality.observe(dp.Attributes(), scopeAttrs, resourceAttrs)
 					}
 				case pmetric.MetricTypeHistogram:
 					dps := metric.Histogram().DataPoints()
@@ -153,6 +167,7 @@ func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 						dCtx := ottldatapoint.NewTransformContextPtr(resourceMetric, scopeMetrics, metric, dp)
 						multiError = errors.Join(multiError, dataPointsCounter.update(ctx, dp.Attributes(), scopeAttrs, resourceAttrs, dCtx))
 						dCtx.Close()
+						c.cardinality.observe(dp.Attributes(), scopeAttrs, resourceAttrs)
 					}
 				case pmetric.MetricTypeExponentialHistogram:
 					dps := metric.ExponentialHistogram().DataPoints()
@@ -162,6 +177,7 @@ func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 						dCtx := ottldatapoint.NewTransformContextPtr(resourceMetric, scopeMetrics, metric, dp)
 						multiError = errors.Join(multiError, dataPointsCounter.update(ctx, dp.Attributes(), scopeAttrs, resourceAttrs, dCtx))
 						dCtx.Close()
+						c.cardinality.observe(dp.Attributes(), scopeAttrs, resourceAttrs)
 					}
 				case pmetric.MetricTypeEmpty:
 					multiError = errors.Join(multiError, fmt.Errorf("metric %q: invalid metric type: %v", metric.Name(), metric.Type()))
@@ -208,6 +224,7 @@ func (c *count) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 				lCtx := ottllog.NewTransformContextPtr(resourceLog, scopeLogs, logRecord)
 				multiError = errors.Join(multiError, counter.update(ctx, logRecord.Attributes(), scopeAttrs, resourceAttrs, lCtx))
 				lCtx.Close()
+				c.cardinality.observe(logRecord.Attributes(), scopeAttrs, resourceAttrs)
 			}
 		}
 
@@ -249,6 +266,7 @@ func (c *count) ConsumeProfiles(ctx context.Context, ld pprofile.Profiles) error
 				pCtx := ottlprofile.NewTransformContext(profile, ld.Dictionary(), scopeProfile.Scope(), resourceProfile.Resource(), scopeProfile, resourceProfile)
 				attributes := pprofile.FromAttributeIndices(ld.Dictionary().AttributeTable(), profile, ld.Dictionary())
 				multiError = errors.Join(multiError, counter.update(ctx, attributes, scopeAttrs, resourceAttrs, pCtx))
+				c.cardinality.observe(attributes, scopeAttrs, resourceAttrs)
 			}
 		}
 
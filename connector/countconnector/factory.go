@@ -45,6 +45,8 @@ func createDefaultConfig() component.Config {
 		DataPoints: defaultDataPointsConfig(),
 		Logs:       defaultLogsConfig(),
 		Profiles:   defaultProfilesConfig(),
+
+		Cardinality: defaultCardinalityConfig(),
 	}
 }
 
@@ -89,6 +91,7 @@ func createTracesToMetrics(
 		metricsConsumer:      nextConsumer,
 		spansMetricDefs:      spanMetricDefs,
 		spanEventsMetricDefs: spanEventMetricDefs,
+		cardinality:          newCardinalityEstimator(c.Cardinality, "traces", nextConsumer, set.Logger),
 	}, nil
 }
 
@@ -132,6 +135,7 @@ func createMetricsToMetrics(
 		metricsConsumer:      nextConsumer,
 		metricsMetricDefs:    metricMetricDefs,
 		dataPointsMetricDefs: dataPointMetricDefs,
+		cardinality:          newCardinalityEstimator(c.Cardinality, "metrics", nextConsumer, set.Logger),
 	}, nil
 }
 
@@ -161,6 +165,7 @@ func createLogsToMetrics(
 	return &count{
 		metricsConsumer: nextConsumer,
 		logsMetricDefs:  metricDefs,
+		cardinality:     newCardinalityEstimator(c.Cardinality, "logs", nextConsumer, set.Logger),
 	}, nil
 }
 
@@ -190,6 +195,7 @@ func createProfilesToMetrics(
 	return &count{
 		metricsConsumer:    nextConsumer,
 		profilesMetricDefs: metricDefs,
+		cardinality:        newCardinalityEstimator(c.Cardinality, "profiles", nextConsumer, set.Logger),
 	}, nil
 }
 
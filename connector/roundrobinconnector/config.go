@@ -3,5 +3,37 @@
 
 package roundrobinconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/roundrobinconnector"
 
+import "fmt"
+
+const (
+	// modeRoundRobin sends each request to the next pipeline.
+	modeRoundRobin = "round_robin"
+	// modeHash splits each request, sending the spans of a trace and the logs and metrics
+	// of a resource always to the same pipeline.
+	modeHash = "hash"
+)
+
 // Config for the connector
-type Config struct{}
+type Config struct {
+	// Mode selects how the data is distributed to the pipelines: `round_robin` or `hash`.
+	Mode string `mapstructure:"mode"`
+	// HashAttributes are the resource attributes hashed to select the pipeline of the logs
+	// and metrics in the `hash` mode. All the resource attributes are hashed if empty.
+	HashAttributes []string `mapstructure:"hash_attributes"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+func (c *Config) Validate() error {
+	switch c.Mode {
+	case modeRoundRobin:
+		if len(c.HashAttributes) > 0 {
+			return fmt.Errorf("hash_attributes requires the %q mode", modeHash)
+		}
+	case modeHash:
+	default:
+		return fmt.Errorf("unsupported mode %q, must be %q or %q", c.Mode, modeRoundRobin, modeHash)
+	}
+	return nil
+}
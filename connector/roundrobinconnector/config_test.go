@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package roundrobinconnector
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/roundrobinconnector/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testcases := []struct {
+		id       component.ID
+		expected *Config
+		err      string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: &Config{Mode: modeRoundRobin},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "hash"),
+			expected: &Config{
+				Mode:           modeHash,
+				HashAttributes: []string{"service.name", "service.instance.id"},
+			},
+		},
+		{
+			id:  component.NewIDWithName(metadata.Type, "invalid_mode"),
+			err: `unsupported mode "random", must be "round_robin" or "hash"`,
+		},
+		{
+			id:  component.NewIDWithName(metadata.Type, "invalid_hash_attributes"),
+			err: `hash_attributes requires the "hash" mode`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(tc.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tc.err != "" {
+				assert.EqualError(t, xconfmap.Validate(cfg), tc.err)
+				return
+			}
+			assert.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tc.expected, cfg)
+		})
+	}
+}
@@ -5,6 +5,8 @@ package roundrobinconnector // import "github.com/open-telemetry/opentelemetry-c
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"sync/atomic"
 
 	"go.opentelemetry.io/collector/component"
@@ -17,7 +19,10 @@ import (
 )
 
 func allConsumers[T any](r router[T]) ([]T, error) {
-	pipeIDs := r.PipelineIDs()
+	// sort the pipelines so that the hash mode is stable across restarts
+	pipeIDs := slices.SortedFunc(slices.Values(r.PipelineIDs()), func(a, b pipeline.ID) int {
+		return strings.Compare(a.String(), b.String())
+	})
 	consumers := make([]T, len(pipeIDs))
 	for i, pipeID := range pipeIDs {
 		cons, err := r.Consumer(pipeID)
@@ -34,27 +39,36 @@ type router[T any] interface {
 	Consumer(pipelineIDs ...pipeline.ID) (T, error)
 }
 
-func newLogs(nextConsumer consumer.Logs) (connector.Logs, error) {
+func newLogs(cfg *Config, nextConsumer consumer.Logs) (connector.Logs, error) {
 	nextConsumers, err := allConsumers[consumer.Logs](nextConsumer.(connector.LogsRouterAndConsumer))
 	if err != nil {
 		return nil, err
 	}
+	if cfg.Mode == modeHash {
+		return &hash{hashAttributes: cfg.HashAttributes, nextLogs: nextConsumers}, nil
+	}
 	return &roundRobin{nextLogs: nextConsumers}, nil
 }
 
-func newMetrics(nextConsumer consumer.Metrics) (connector.Metrics, error) {
+func newMetrics(cfg *Config, nextConsumer consumer.Metrics) (connector.Metrics, error) {
 	nextConsumers, err := allConsumers[consumer.Metrics](nextConsumer.(connector.MetricsRouterAndConsumer))
 	if err != nil {
 		return nil, err
 	}
+	if cfg.Mode == modeHash {
+		return &hash{hashAttributes: cfg.HashAttributes, nextMetrics: nextConsumers}, nil
+	}
 	return &roundRobin{nextMetrics: nextConsumers}, nil
 }
 
-func newTraces(nextConsumer consumer.Traces) (connector.Traces, error) {
+func newTraces(cfg *Config, nextConsumer consumer.Traces) (connector.Traces, error) {
 	nextConsumers, err := allConsumers[consumer.Traces](nextConsumer.(connector.TracesRouterAndConsumer))
 	if err != nil {
 		return nil, err
 	}
+	if cfg.Mode == modeHash {
+		return &hash{hashAttributes: cfg.HashAttributes, nextTraces: nextConsumers}, nil
+	}
 	return &roundRobin{nextTraces: nextConsumers}, nil
 }
 
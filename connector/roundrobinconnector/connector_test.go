@@ -8,11 +8,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/connector/connectortest"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -32,7 +34,7 @@ func newPipelineMap[T any](signal pipeline.Signal, consumers ...T) map[pipeline.
 func TestLogsRoundRobin(t *testing.T) {
 	f := NewFactory()
 	cfg := f.CreateDefaultConfig()
-	assert.Equal(t, &Config{}, cfg)
+	assert.Equal(t, &Config{Mode: modeRoundRobin}, cfg)
 
 	ctx := t.Context()
 	set := connectortest.NewNopSettings(metadata.Type)
@@ -69,7 +71,7 @@ func TestLogsRoundRobin(t *testing.T) {
 func TestMetricsRoundRobin(t *testing.T) {
 	f := NewFactory()
 	cfg := f.CreateDefaultConfig()
-	assert.Equal(t, &Config{}, cfg)
+	assert.Equal(t, &Config{Mode: modeRoundRobin}, cfg)
 
 	ctx := t.Context()
 	set := connectortest.NewNopSettings(metadata.Type)
@@ -106,7 +108,7 @@ func TestMetricsRoundRobin(t *testing.T) {
 func TestTracesRoundRobin(t *testing.T) {
 	f := NewFactory()
 	cfg := f.CreateDefaultConfig()
-	assert.Equal(t, &Config{}, cfg)
+	assert.Equal(t, &Config{Mode: modeRoundRobin}, cfg)
 
 	ctx := t.Context()
 	set := connectortest.NewNopSettings(metadata.Type)
@@ -139,3 +141,107 @@ func TestTracesRoundRobin(t *testing.T) {
 
 	assert.NoError(t, traces.Shutdown(ctx))
 }
+
+func TestLogsHash(t *testing.T) {
+	ctx := t.Context()
+	cfg := &Config{Mode: modeHash, HashAttributes: []string{"service.name"}}
+	sinks := []*consumertest.LogsSink{new(consumertest.LogsSink), new(consumertest.LogsSink), new(consumertest.LogsSink)}
+	logs, err := NewFactory().CreateLogsToLogs(ctx, connectortest.NewNopSettings(metadata.Type), cfg,
+		connector.NewLogsRouter(newPipelineMap[consumer.Logs](pipeline.SignalLogs, sinks[0], sinks[1], sinks[2])))
+	require.NoError(t, err)
+	require.NoError(t, logs.Start(ctx, componenttest.NewNopHost()))
+
+	for range 2 {
+		ld := plog.NewLogs()
+		for i := range 10 {
+			rl := ld.ResourceLogs().AppendEmpty()
+			rl.Resource().Attributes().PutStr("service.name", "service-"+strconv.Itoa(i))
+			rl.Resource().Attributes().PutStr("host.name", "ignored")
+			rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(strconv.Itoa(i))
+		}
+		require.NoError(t, logs.ConsumeLogs(ctx, ld))
+	}
+
+	var total int
+	for _, sink := range sinks {
+		require.Len(t, sink.AllLogs(), 2)
+		total += sink.LogRecordCount()
+		// the logs of a service are always passed to the same pipeline
+		assert.Equal(t, sink.AllLogs()[0], sink.AllLogs()[1])
+	}
+	assert.Equal(t, 20, total)
+	require.NoError(t, logs.Shutdown(ctx))
+}
+
+func TestMetricsHash(t *testing.T) {
+	ctx := t.Context()
+	cfg := &Config{Mode: modeHash}
+	sinks := []*consumertest.MetricsSink{new(consumertest.MetricsSink), new(consumertest.MetricsSink)}
+	metrics, err := NewFactory().CreateMetricsToMetrics(ctx, connectortest.NewNopSettings(metadata.Type), cfg,
+		connector.NewMetricsRouter(newPipelineMap[consumer.Metrics](pipeline.SignalMetrics, sinks[0], sinks[1])))
+	require.NoError(t, err)
+	require.NoError(t, metrics.Start(ctx, componenttest.NewNopHost()))
+
+	for range 2 {
+		md := pmetric.NewMetrics()
+		for i := range 10 {
+			rm := md.ResourceMetrics().AppendEmpty()
+			rm.Resource().Attributes().PutStr("host.name", "host-"+strconv.Itoa(i))
+			rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(int64(i))
+		}
+		require.NoError(t, metrics.ConsumeMetrics(ctx, md))
+	}
+
+	var total int
+	for _, sink := range sinks {
+		require.Len(t, sink.AllMetrics(), 2)
+		total += sink.DataPointCount()
+		assert.Equal(t, sink.AllMetrics()[0], sink.AllMetrics()[1])
+	}
+	assert.Equal(t, 20, total)
+	require.NoError(t, metrics.Shutdown(ctx))
+}
+
+func TestTracesHash(t *testing.T) {
+	ctx := t.Context()
+	cfg := &Config{Mode: modeHash}
+	sinks := []*consumertest.TracesSink{new(consumertest.TracesSink), new(consumertest.TracesSink), new(consumertest.TracesSink)}
+	traces, err := NewFactory().CreateTracesToTraces(ctx, connectortest.NewNopSettings(metadata.Type), cfg,
+		connector.NewTracesRouter(newPipelineMap[consumer.Traces](pipeline.SignalTraces, sinks[0], sinks[1], sinks[2])))
+	require.NoError(t, err)
+	require.NoError(t, traces.Start(ctx, componenttest.NewNopHost()))
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "service")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("scope")
+	for i := range 30 {
+		span := ss.Spans().AppendEmpty()
+		span.SetTraceID(pcommon.TraceID{byte(i % 10)})
+		span.SetSpanID(pcommon.SpanID{byte(i)})
+	}
+	require.NoError(t, traces.ConsumeTraces(ctx, td))
+
+	traceIDs := map[pcommon.TraceID]int{}
+	var total int
+	for i, sink := range sinks {
+		for _, td := range sink.AllTraces() {
+			total += td.SpanCount()
+			require.Equal(t, 1, td.ResourceSpans().Len())
+			rs := td.ResourceSpans().At(0)
+			assert.Equal(t, map[string]any{"service.name": "service"}, rs.Resource().Attributes().AsRaw())
+			require.Equal(t, 1, rs.ScopeSpans().Len())
+			assert.Equal(t, "scope", rs.ScopeSpans().At(0).Scope().Name())
+			for _, span := range rs.ScopeSpans().At(0).Spans().All() {
+				if pipe, ok := traceIDs[span.TraceID()]; ok {
+					assert.Equal(t, pipe, i, "the spans of a trace are passed to the same pipeline")
+				}
+				traceIDs[span.TraceID()] = i
+			}
+		}
+	}
+	assert.Equal(t, 30, total)
+	assert.Len(t, traceIDs, 10)
+	require.NoError(t, traces.Shutdown(ctx))
+}
@@ -3,10 +3,12 @@ module github.com/open-telemetry/opentelemetry-collector-contrib/connector/round
 go 1.24.0
 
 require (
+	github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil v0.144.0
 	github.com/stretchr/testify v1.11.1
 	go.opentelemetry.io/collector/component v1.50.1-0.20260121161034-55399d4743af
 	go.opentelemetry.io/collector/component/componenttest v0.144.1-0.20260121161034-55399d4743af
 	go.opentelemetry.io/collector/confmap v1.50.1-0.20260121161034-55399d4743af
+	go.opentelemetry.io/collector/confmap/xconfmap v0.144.1-0.20260121161034-55399d4743af
 	go.opentelemetry.io/collector/connector v0.144.1-0.20260121161034-55399d4743af
 	go.opentelemetry.io/collector/connector/connectortest v0.144.1-0.20260121161034-55399d4743af
 	go.opentelemetry.io/collector/consumer v1.50.1-0.20260121161034-55399d4743af
@@ -53,3 +55,5 @@ require (
 	golang.org/x/sys v0.39.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil => ../../pkg/pdatautil
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package roundrobinconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/roundrobinconnector"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// hash splits the signals between the configured pipelines, so that the spans of a trace and the
+// logs and metrics of a resource are always passed to the same pipeline. This is useful when
+// the downstream components are stateful, such as the tail sampling processor.
+type hash struct {
+	component.StartFunc
+	component.ShutdownFunc
+	hashAttributes []string
+	nextMetrics    []consumer.Metrics
+	nextLogs       []consumer.Logs
+	nextTraces     []consumer.Traces
+}
+
+func (*hash) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// resourceIndex returns the index of the pipeline of the resource.
+func (h *hash) resourceIndex(resource pcommon.Resource, pipelines int) int {
+	attrs := resource.Attributes()
+	if len(h.hashAttributes) > 0 {
+		attrs = pcommon.NewMap()
+		for _, key := range h.hashAttributes {
+			if v, ok := resource.Attributes().Get(key); ok {
+				v.CopyTo(attrs.PutEmpty(key))
+			}
+		}
+	}
+	return int(pdatautil.Hash64(pdatautil.WithMap(attrs)) % uint64(pipelines))
+}
+
+func (h *hash) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	if len(h.nextLogs) == 1 {
+		return h.nextLogs[0].ConsumeLogs(ctx, ld)
+	}
+	split := make([]plog.Logs, len(h.nextLogs))
+	for i := range split {
+		split[i] = plog.NewLogs()
+	}
+	for _, rl := range ld.ResourceLogs().All() {
+		i := h.resourceIndex(rl.Resource(), len(split))
+		rl.CopyTo(split[i].ResourceLogs().AppendEmpty())
+	}
+
+	var errs []error
+	for i, logs := range split {
+		if logs.ResourceLogs().Len() > 0 {
+			errs = append(errs, h.nextLogs[i].ConsumeLogs(ctx, logs))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h *hash) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if len(h.nextMetrics) == 1 {
+		return h.nextMetrics[0].ConsumeMetrics(ctx, md)
+	}
+	split := make([]pmetric.Metrics, len(h.nextMetrics))
+	for i := range split {
+		split[i] = pmetric.NewMetrics()
+	}
+	for _, rm := range md.ResourceMetrics().All() {
+		i := h.resourceIndex(rm.Resource(), len(split))
+		rm.CopyTo(split[i].ResourceMetrics().AppendEmpty())
+	}
+
+	var errs []error
+	for i, metrics := range split {
+		if metrics.ResourceMetrics().Len() > 0 {
+			errs = append(errs, h.nextMetrics[i].ConsumeMetrics(ctx, metrics))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h *hash) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	if len(h.nextTraces) == 1 {
+		return h.nextTraces[0].ConsumeTraces(ctx, td)
+	}
+	split := make([]ptrace.Traces, len(h.nextTraces))
+	for i := range split {
+		split[i] = ptrace.NewTraces()
+	}
+	for _, rs := range td.ResourceSpans().All() {
+		// the resource and the scope are copied to a pipeline with its first span
+		resourceSpans := make([]*ptrace.ResourceSpans, len(split))
+		for _, ss := range rs.ScopeSpans().All() {
+			scopeSpans := make([]*ptrace.ScopeSpans, len(split))
+			for _, span := range ss.Spans().All() {
+				traceID := span.TraceID()
+				i := int(pdatautil.Hash64(pdatautil.WithString(string(traceID[:]))) % uint64(len(split)))
+				if scopeSpans[i] == nil {
+					if resourceSpans[i] == nil {
+						newRS := split[i].ResourceSpans().AppendEmpty()
+						rs.Resource().CopyTo(newRS.Resource())
+						newRS.SetSchemaUrl(rs.SchemaUrl())
+						resourceSpans[i] = &newRS
+					}
+					newSS := resourceSpans[i].ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(newSS.Scope())
+					newSS.SetSchemaUrl(ss.SchemaUrl())
+					scopeSpans[i] = &newSS
+				}
+				span.CopyTo(scopeSpans[i].Spans().AppendEmpty())
+			}
+		}
+	}
+
+	var errs []error
+	for i, traces := range split {
+		if traces.ResourceSpans().Len() > 0 {
+			errs = append(errs, h.nextTraces[i].ConsumeTraces(ctx, traces))
+		}
+	}
+	return errors.Join(errs...)
+}
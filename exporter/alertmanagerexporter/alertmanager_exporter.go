@@ -11,6 +11,8 @@ import (
 	"io"
 	"net/http"
 	"slices"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/prometheus/common/model"
@@ -19,8 +21,12 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
 )
 
 type alertmanagerExporter struct {
@@ -33,32 +39,49 @@ type alertmanagerExporter struct {
 	defaultSeverity   string
 	severityAttribute string
 	apiVersion        string
+	spanEventRules    *eventRules[*ottlspanevent.TransformContext]
+	logRules          *eventRules[*ottllog.TransformContext]
+
+	// sent holds the time at which the alerts were last sent, for deduplication
+	sentLock sync.Mutex
+	sent     map[model.Fingerprint]time.Time
 }
 
 type alertmanagerEvent struct {
-	spanEvent ptrace.SpanEvent
-	traceID   string
-	spanID    string
-	severity  string
+	name       string
+	attributes pcommon.Map
+	body       string
+	traceID    string
+	spanID     string
+	severity   string
+	labels     model.LabelSet
+}
+
+func (s *alertmanagerExporter) severity(attributes pcommon.Map) string {
+	if severityAttrValue, ok := attributes.Get(s.severityAttribute); ok {
+		return severityAttrValue.AsString()
+	}
+	return s.defaultSeverity
 }
 
-func (s *alertmanagerExporter) convertEventSliceToArray(eventSlice ptrace.SpanEventSlice, traceID pcommon.TraceID, spanID pcommon.SpanID) []*alertmanagerEvent {
+func (s *alertmanagerExporter) convertEventSliceToArray(ctx context.Context, rs ptrace.ResourceSpans, ss ptrace.ScopeSpans, span ptrace.Span) []*alertmanagerEvent {
+	eventSlice := span.Events()
 	if eventSlice.Len() > 0 {
 		events := make([]*alertmanagerEvent, eventSlice.Len())
 
 		for i := 0; i < eventSlice.Len(); i++ {
-			var severity string
-			severityAttrValue, ok := eventSlice.At(i).Attributes().Get(s.severityAttribute)
-			if ok {
-				severity = severityAttrValue.AsString()
-			} else {
-				severity = s.defaultSeverity
-			}
+			spanEvent := eventSlice.At(i)
 			event := alertmanagerEvent{
-				spanEvent: eventSlice.At(i),
-				traceID:   traceID.String(),
-				spanID:    spanID.String(),
-				severity:  severity,
+				name:       spanEvent.Name(),
+				attributes: spanEvent.Attributes(),
+				traceID:    span.TraceID().String(),
+				spanID:     span.SpanID().String(),
+				severity:   s.severity(spanEvent.Attributes()),
+			}
+			if s.spanEventRules != nil {
+				tCtx := ottlspanevent.NewTransformContextPtr(rs, ss, span, spanEvent)
+				s.spanEventRules.apply(ctx, tCtx, &event)
+				tCtx.Close()
 			}
 
 			events[i] = &event
@@ -68,7 +91,7 @@ func (s *alertmanagerExporter) convertEventSliceToArray(eventSlice ptrace.SpanEv
 	return nil
 }
 
-func (s *alertmanagerExporter) extractEvents(td ptrace.Traces) []*alertmanagerEvent {
+func (s *alertmanagerExporter) extractEvents(ctx context.Context, td ptrace.Traces) []*alertmanagerEvent {
 	// Stitch parent trace ID and span ID
 	rss := td.ResourceSpans()
 	var events []*alertmanagerEvent
@@ -87,9 +110,36 @@ func (s *alertmanagerExporter) extractEvents(td ptrace.Traces) []*alertmanagerEv
 		for j := 0; j < ilss.Len(); j++ {
 			spans := ilss.At(j).Spans()
 			for k := 0; k < spans.Len(); k++ {
-				traceID := spans.At(k).TraceID()
-				spanID := spans.At(k).SpanID()
-				events = append(events, s.convertEventSliceToArray(spans.At(k).Events(), traceID, spanID)...)
+				events = append(events, s.convertEventSliceToArray(ctx, rss.At(i), ilss.At(j), spans.At(k))...)
+			}
+		}
+	}
+	return events
+}
+
+func (s *alertmanagerExporter) extractLogEvents(ctx context.Context, ld plog.Logs) []*alertmanagerEvent {
+	var events []*alertmanagerEvent
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			logRecords := sls.At(j).LogRecords()
+			for k := 0; k < logRecords.Len(); k++ {
+				logRecord := logRecords.At(k)
+				event := alertmanagerEvent{
+					name:       logRecord.EventName(),
+					attributes: logRecord.Attributes(),
+					body:       logRecord.Body().AsString(),
+					traceID:    logRecord.TraceID().String(),
+					spanID:     logRecord.SpanID().String(),
+					severity:   s.severity(logRecord.Attributes()),
+				}
+				if s.logRules != nil {
+					tCtx := ottllog.NewTransformContextPtr(rls.At(i), sls.At(j), logRecord)
+					s.logRules.apply(ctx, tCtx, &event)
+					tCtx.Close()
+				}
+				events = append(events, &event)
 			}
 		}
 	}
@@ -97,34 +147,62 @@ func (s *alertmanagerExporter) extractEvents(td ptrace.Traces) []*alertmanagerEv
 }
 
 func createAnnotations(event *alertmanagerEvent) model.LabelSet {
-	labelMap := make(model.LabelSet, event.spanEvent.Attributes().Len()+2)
-	for key, attr := range event.spanEvent.Attributes().All() {
+	labelMap := make(model.LabelSet, event.attributes.Len()+3)
+	for key, attr := range event.attributes.All() {
 		labelMap[model.LabelName(key)] = model.LabelValue(attr.AsString())
 	}
 	labelMap["TraceID"] = model.LabelValue(event.traceID)
 	labelMap["SpanID"] = model.LabelValue(event.spanID)
+	if event.body != "" {
+		labelMap["Body"] = model.LabelValue(event.body)
+	}
 	return labelMap
 }
 
 func (s *alertmanagerExporter) createLabels(event *alertmanagerEvent) model.LabelSet {
 	labelMap := model.LabelSet{}
-	for key, attr := range event.spanEvent.Attributes().All() {
+	for key, attr := range event.attributes.All() {
 		if slices.Contains(s.config.EventLabels, key) {
 			labelMap[model.LabelName(key)] = model.LabelValue(attr.AsString())
 		}
 	}
+	for name, value := range event.labels {
+		labelMap[name] = value
+	}
 	labelMap["severity"] = model.LabelValue(event.severity)
-	labelMap["event_name"] = model.LabelValue(event.spanEvent.Name())
+	labelMap["event_name"] = model.LabelValue(event.name)
+	if len(s.config.GroupBy) > 0 {
+		groupLabels := make(model.LabelSet, len(s.config.GroupBy))
+		for _, name := range s.config.GroupBy {
+			if value, ok := labelMap[model.LabelName(name)]; ok {
+				groupLabels[model.LabelName(name)] = value
+			}
+		}
+		return groupLabels
+	}
 	return labelMap
 }
 
 func (s *alertmanagerExporter) convertEventsToAlertPayload(events []*alertmanagerEvent) []model.Alert {
-	payload := make([]model.Alert, len(events))
+	payload := make([]model.Alert, 0, len(events))
+	groups := make(map[model.Fingerprint]int)
 
-	for i, event := range events {
+	for _, event := range events {
 		annotations := createAnnotations(event)
 		labels := s.createLabels(event)
 
+		if len(s.config.GroupBy) > 0 {
+			// merge the events with the same group labels into the alert of the first one
+			fingerprint := labels.Fingerprint()
+			if i, ok := groups[fingerprint]; ok {
+				count, _ := strconv.Atoi(string(payload[i].Annotations["event_count"]))
+				payload[i].Annotations["event_count"] = model.LabelValue(strconv.Itoa(count + 1))
+				continue
+			}
+			groups[fingerprint] = len(payload)
+			annotations["event_count"] = "1"
+		}
+
 		alert := model.Alert{
 			StartsAt:     time.Now(),
 			Labels:       labels,
@@ -132,11 +210,56 @@ func (s *alertmanagerExporter) convertEventsToAlertPayload(events []*alertmanage
 			GeneratorURL: s.generatorURL,
 		}
 
-		payload[i] = alert
+		payload = append(payload, alert)
 	}
 	return payload
 }
 
+// deduplicate removes the alerts which were sent within the dedup window, or which are duplicated in the
+// payload, and reserves the other ones so that they aren't sent concurrently. The alerts are made to end with
+// the window so that they stay active in Alertmanager until they can be sent again.
+func (s *alertmanagerExporter) deduplicate(payload []model.Alert, now time.Time) []model.Alert {
+	if s.config.DedupWindow <= 0 {
+		return payload
+	}
+
+	s.sentLock.Lock()
+	defer s.sentLock.Unlock()
+	for fingerprint, sentAt := range s.sent {
+		if now.Sub(sentAt) >= s.config.DedupWindow {
+			delete(s.sent, fingerprint)
+		}
+	}
+
+	deduplicated := payload[:0]
+	for _, alert := range payload {
+		fingerprint := alert.Labels.Fingerprint()
+		if _, ok := s.sent[fingerprint]; ok {
+			continue
+		}
+		s.sent[fingerprint] = now
+		alert.EndsAt = now.Add(s.config.DedupWindow)
+		deduplicated = append(deduplicated, alert)
+	}
+	return deduplicated
+}
+
+// release removes the reservation of the alerts which couldn't be sent, so that they can be sent again.
+func (s *alertmanagerExporter) release(payload []model.Alert, now time.Time) {
+	if s.config.DedupWindow <= 0 {
+		return
+	}
+
+	s.sentLock.Lock()
+	defer s.sentLock.Unlock()
+	for _, alert := range payload {
+		fingerprint := alert.Labels.Fingerprint()
+		if sentAt, ok := s.sent[fingerprint]; ok && sentAt.Equal(now) {
+			delete(s.sent, fingerprint)
+		}
+	}
+}
+
 func (s *alertmanagerExporter) postAlert(ctx context.Context, payload []model.Alert) error {
 	msg, err := json.Marshal(payload)
 	if err != nil {
@@ -173,18 +296,29 @@ func (s *alertmanagerExporter) postAlert(ctx context.Context, payload []model.Al
 }
 
 func (s *alertmanagerExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
-	events := s.extractEvents(td)
+	return s.pushEvents(ctx, s.extractEvents(ctx, td))
+}
+
+func (s *alertmanagerExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	return s.pushEvents(ctx, s.extractLogEvents(ctx, ld))
+}
 
+func (s *alertmanagerExporter) pushEvents(ctx context.Context, events []*alertmanagerEvent) error {
 	if len(events) == 0 {
 		return nil
 	}
 
-	alert := s.convertEventsToAlertPayload(events)
+	now := time.Now()
+	alert := s.deduplicate(s.convertEventsToAlertPayload(events), now)
+	if len(alert) == 0 {
+		return nil
+	}
+
 	err := s.postAlert(ctx, alert)
 	if err != nil {
+		s.release(alert, now)
 		return err
 	}
-
 	return nil
 }
 
@@ -214,6 +348,7 @@ func newAlertManagerExporter(cfg *Config, set component.TelemetrySettings) *aler
 		defaultSeverity:   cfg.DefaultSeverity,
 		severityAttribute: cfg.SeverityAttribute,
 		apiVersion:        cfg.APIVersion,
+		sent:              make(map[model.Fingerprint]time.Time),
 	}
 }
 
@@ -221,6 +356,11 @@ func newTracesExporter(ctx context.Context, cfg component.Config, set exporter.S
 	config := cfg.(*Config)
 
 	s := newAlertManagerExporter(config, set.TelemetrySettings)
+	rules, err := newSpanEventRules(config, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	s.spanEventRules = rules
 
 	return exporterhelper.NewTraces(
 		ctx,
@@ -235,3 +375,27 @@ func newTracesExporter(ctx context.Context, cfg component.Config, set exporter.S
 		exporterhelper.WithShutdown(s.shutdown),
 	)
 }
+
+func newLogsExporter(ctx context.Context, cfg component.Config, set exporter.Settings) (exporter.Logs, error) {
+	config := cfg.(*Config)
+
+	s := newAlertManagerExporter(config, set.TelemetrySettings)
+	rules, err := newLogRules(config, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	s.logRules = rules
+
+	return exporterhelper.NewLogs(
+		ctx,
+		set,
+		cfg,
+		s.pushLogs,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(s.start),
+		exporterhelper.WithTimeout(config.TimeoutSettings),
+		exporterhelper.WithRetry(config.BackoffConfig),
+		exporterhelper.WithQueue(config.QueueSettings),
+		exporterhelper.WithShutdown(s.shutdown),
+	)
+}
@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/alertmanagerexporter/internal/metadata"
@@ -99,7 +100,7 @@ func TestAlertManagerExporterExtractEvents(t *testing.T) {
 			}
 
 			// test - events
-			got := am.extractEvents(traces)
+			got := am.extractEvents(t.Context(), traces)
 			assert.Len(t, got, tt.events)
 		})
 	}
@@ -129,18 +130,18 @@ func TestAlertManagerExporterEventNameAttributes(t *testing.T) {
 	attrs.PutDouble("attr3", 5.14)
 
 	// test - 1 event
-	got := am.extractEvents(traces)
+	got := am.extractEvents(t.Context(), traces)
 
 	// test - result length
 	assert.Len(t, got, 1)
 
 	// test - count of attributes
-	assert.Equal(t, 3, got[0].spanEvent.Attributes().Len())
-	attr, b := got[0].spanEvent.Attributes().Get("attr1")
+	assert.Equal(t, 3, got[0].attributes.Len())
+	attr, b := got[0].attributes.Get("attr1")
 	assert.True(t, b)
-	assert.Equal(t, "unittest-event", got[0].spanEvent.Name())
+	assert.Equal(t, "unittest-event", got[0].name)
 	assert.Equal(t, "unittest-baz", attr.AsString())
-	attr, b = got[0].spanEvent.Attributes().Get("attr3")
+	attr, b = got[0].attributes.Get("attr3")
 	assert.True(t, b)
 	assert.Equal(t, 5.14, attr.Double())
 }
@@ -182,7 +183,7 @@ func TestAlertManagerExporterSeverity(t *testing.T) {
 	attrs.PutStr("bar", "debug")
 
 	// test - 0 event
-	got := am.extractEvents(traces)
+	got := am.extractEvents(t.Context(), traces)
 	alerts := am.convertEventsToAlertPayload(got)
 
 	ls := model.LabelSet{"event_name": "unittest-event", "severity": "debug"}
@@ -215,7 +216,7 @@ func TestAlertManagerExporterNoDefaultSeverity(t *testing.T) {
 	attrs.PutStr("attr2", "debug")
 
 	// test - 0 event
-	got := am.extractEvents(traces)
+	got := am.extractEvents(t.Context(), traces)
 	alerts := am.convertEventsToAlertPayload(got)
 
 	ls := model.LabelSet{"event_name": "unittest-event", "severity": "info"}
@@ -249,10 +250,11 @@ func TestAlertManagerExporterAlertPayload(t *testing.T) {
 
 	var events []*alertmanagerEvent
 	events = append(events, &alertmanagerEvent{
-		spanEvent: event,
-		severity:  am.defaultSeverity,
-		traceID:   "0000000000000002",
-		spanID:    "00000002",
+		name:       event.Name(),
+		attributes: event.Attributes(),
+		severity:   am.defaultSeverity,
+		traceID:    "0000000000000002",
+		spanID:     "00000002",
 	})
 
 	got := am.convertEventsToAlertPayload(events)
@@ -302,10 +304,11 @@ func TestAlertManagerExporterEventLabels(t *testing.T) {
 
 	var events []*alertmanagerEvent
 	events = append(events, &alertmanagerEvent{
-		spanEvent: event,
-		severity:  am.defaultSeverity,
-		traceID:   "0000000000000002",
-		spanID:    "00000002",
+		name:       event.Name(),
+		attributes: event.Attributes(),
+		severity:   am.defaultSeverity,
+		traceID:    "0000000000000002",
+		spanID:     "00000002",
 	})
 
 	got := am.convertEventsToAlertPayload(events)
@@ -321,6 +324,215 @@ func TestAlertManagerExporterEventLabels(t *testing.T) {
 	assert.Equal(t, expect.GeneratorURL, got[0].GeneratorURL)
 }
 
+func TestAlertManagerExporterSeverityRulesAndLabels(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.EventLabels = nil
+	cfg.SeverityRules = []SeverityRule{
+		{Severity: "critical", Condition: `attributes["attr2"] > 50`},
+		{Severity: "warning", Condition: `IsMatch(name, "^timeout.*")`},
+	}
+	cfg.Labels = map[string]string{
+		"service": `resource.attributes["service.name"]`,
+		"span":    `span.name`,
+		"missing": `attributes["missing"]`,
+	}
+	set := exportertest.NewNopSettings(metadata.Type)
+	am := newAlertManagerExporter(cfg, set.TelemetrySettings)
+	rules, err := newSpanEventRules(cfg, set.TelemetrySettings)
+	require.NoError(t, err)
+	am.spanEventRules = rules
+
+	traces, span := createTracesAndSpan()
+	for _, e := range []struct {
+		name  string
+		attr2 int64
+	}{
+		{"error", 60},
+		{"timeout-db", 42},
+		{"other", 42},
+	} {
+		event := span.Events().AppendEmpty()
+		event.SetName(e.name)
+		event.Attributes().PutInt("attr2", e.attr2)
+	}
+	span.CopyTo(traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0))
+
+	got := am.extractEvents(t.Context(), traces)
+	require.Len(t, got, 3)
+	assert.Equal(t, "critical", got[0].severity)
+	assert.Equal(t, "warning", got[1].severity)
+	assert.Equal(t, "info", got[2].severity)
+	for _, event := range got {
+		assert.Equal(t, model.LabelSet{"service": "unittest-resource", "span": "unittest-span"}, event.labels)
+	}
+
+	payload := am.convertEventsToAlertPayload(got)
+	assert.Equal(t, model.LabelSet{"severity": "critical", "event_name": "error", "service": "unittest-resource", "span": "unittest-span"}, payload[0].Labels)
+}
+
+func TestAlertManagerExporterInvalidRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr string
+	}{
+		{
+			name: "SeverityRule",
+			modify: func(cfg *Config) {
+				cfg.SeverityRules = []SeverityRule{{Severity: "critical", Condition: "invalid("}}
+			},
+			wantErr: `invalid "severity_rules[0]::condition"`,
+		},
+		{
+			name: "Label",
+			modify: func(cfg *Config) {
+				cfg.Labels = map[string]string{"service": "invalid("}
+			},
+			wantErr: `invalid "labels::service"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			tt.modify(cfg)
+			_, err := newTracesExporter(t.Context(), cfg, exportertest.NewNopSettings(metadata.Type))
+			assert.ErrorContains(t, err, tt.wantErr)
+			_, err = newLogsExporter(t.Context(), cfg, exportertest.NewNopSettings(metadata.Type))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAlertManagerExporterExtractLogEvents(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.EventLabels = nil
+	cfg.SeverityAttribute = "foo"
+	cfg.SeverityRules = []SeverityRule{{Severity: "critical", Condition: `severity_number >= SEVERITY_NUMBER_ERROR`}}
+	cfg.Labels = map[string]string{"service": `resource.attributes["service.name"]`}
+	set := exportertest.NewNopSettings(metadata.Type)
+	am := newAlertManagerExporter(cfg, set.TelemetrySettings)
+	rules, err := newLogRules(cfg, set.TelemetrySettings)
+	require.NoError(t, err)
+	am.logRules = rules
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "unittest-resource")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	record := records.AppendEmpty()
+	record.SetEventName("unittest-event")
+	record.SetSeverityNumber(plog.SeverityNumberError)
+	record.Body().SetStr("something failed")
+	record.SetTraceID(pcommon.TraceID([16]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}))
+	record.SetSpanID(pcommon.SpanID([8]byte{0, 0, 0, 0, 0, 0, 0, 3}))
+	record.Attributes().PutStr("attr1", "unittest-baz")
+	record = records.AppendEmpty()
+	record.SetSeverityNumber(plog.SeverityNumberInfo)
+	record.Attributes().PutStr("foo", "debug")
+
+	got := am.extractLogEvents(t.Context(), logs)
+	require.Len(t, got, 2)
+	assert.Equal(t, "critical", got[0].severity)
+	assert.Equal(t, "debug", got[1].severity)
+
+	payload := am.convertEventsToAlertPayload(got)
+	assert.Equal(t, model.LabelSet{"severity": "critical", "event_name": "unittest-event", "service": "unittest-resource"}, payload[0].Labels)
+	assert.Equal(t, model.LabelSet{"attr1": "unittest-baz", "Body": "something failed", "TraceID": "00000000000000000000000000000002", "SpanID": "0000000000000003"}, payload[0].Annotations)
+}
+
+func TestAlertManagerLogsExporterNoErrors(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	lle, err := newLogsExporter(t.Context(), cfg, exportertest.NewNopSettings(metadata.Type))
+	require.NotNil(t, lle)
+	assert.NoError(t, err)
+}
+
+func TestAlertManagerExporterGroupBy(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.EventLabels = []string{"service"}
+	cfg.GroupBy = []string{"service", "severity"}
+	set := exportertest.NewNopSettings(metadata.Type)
+	am := newAlertManagerExporter(cfg, set.TelemetrySettings)
+
+	newEvent := func(name, service string) *alertmanagerEvent {
+		attributes := pcommon.NewMap()
+		attributes.PutStr("service", service)
+		return &alertmanagerEvent{name: name, attributes: attributes, severity: am.defaultSeverity}
+	}
+	events := []*alertmanagerEvent{
+		newEvent("event1", "foo"),
+		newEvent("event2", "bar"),
+		newEvent("event3", "foo"),
+	}
+
+	got := am.convertEventsToAlertPayload(events)
+	require.Len(t, got, 2)
+	assert.Equal(t, model.LabelSet{"service": "foo", "severity": "info"}, got[0].Labels)
+	assert.Equal(t, model.LabelValue("2"), got[0].Annotations["event_count"])
+	assert.Equal(t, model.LabelSet{"service": "bar", "severity": "info"}, got[1].Labels)
+	assert.Equal(t, model.LabelValue("1"), got[1].Annotations["event_count"])
+}
+
+func TestAlertManagerExporterDeduplicate(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.DedupWindow = time.Minute
+	set := exportertest.NewNopSettings(metadata.Type)
+	am := newAlertManagerExporter(cfg, set.TelemetrySettings)
+
+	newPayload := func() []model.Alert {
+		return []model.Alert{
+			{Labels: model.LabelSet{"event_name": "event1"}},
+			{Labels: model.LabelSet{"event_name": "event2"}},
+			{Labels: model.LabelSet{"event_name": "event1"}},
+		}
+	}
+	now := time.Now()
+
+	// the duplicated alerts of the payload are sent once
+	got := am.deduplicate(newPayload(), now)
+	require.Len(t, got, 2)
+	assert.Equal(t, now.Add(time.Minute), got[0].EndsAt)
+
+	// the alerts being sent aren't sent concurrently
+	assert.Empty(t, am.deduplicate(newPayload(), now.Add(time.Second)))
+
+	// the second alert couldn't be sent
+	am.release(got[1:], now)
+
+	got = am.deduplicate(newPayload(), now.Add(30*time.Second))
+	require.Len(t, got, 1)
+	assert.Equal(t, model.LabelSet{"event_name": "event2"}, got[0].Labels)
+
+	// the release of an older reservation doesn't release the new one
+	am.release(got, now)
+	assert.Empty(t, am.deduplicate(newPayload(), now.Add(45*time.Second)))
+
+	got = am.deduplicate(newPayload(), now.Add(time.Minute))
+	assert.Len(t, got, 1)
+	assert.Equal(t, model.LabelSet{"event_name": "event1"}, got[0].Labels)
+}
+
+func TestAlertManagerExporterDeduplicateFailedPost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.DedupWindow = time.Minute
+	cfg.Endpoint = server.URL
+	set := exportertest.NewNopSettings(metadata.Type)
+	am := newAlertManagerExporter(cfg, set.TelemetrySettings)
+	require.NoError(t, am.start(t.Context(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, am.shutdown(t.Context())) }()
+
+	events := []*alertmanagerEvent{{name: "event1", attributes: pcommon.NewMap(), severity: "info"}}
+	require.Error(t, am.pushEvents(t.Context(), events))
+	// the alerts which couldn't be sent can be sent again
+	assert.Len(t, am.deduplicate(am.convertEventsToAlertPayload(events), time.Now()), 1)
+}
+
 type mockServer struct {
 	mockserver            *httptest.Server // this means mockServer aggregates 'httptest.Server', but can it's more like inheritance in C++
 	fooCalledSuccessfully bool             // this is false by default
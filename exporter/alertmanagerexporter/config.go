@@ -5,7 +5,10 @@ package alertmanagerexporter // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/prometheus/common/model"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configoptional"
@@ -25,6 +28,28 @@ type Config struct {
 	SeverityAttribute       string                   `mapstructure:"severity_attribute"`
 	APIVersion              string                   `mapstructure:"api_version"`
 	EventLabels             []string                 `mapstructure:"event_labels"`
+
+	// SeverityRules are evaluated in order for each event, the severity of the first rule whose
+	// condition is true taking precedence over the severity attribute and the default severity.
+	SeverityRules []SeverityRule `mapstructure:"severity_rules"`
+	// Labels maps the names of alert labels to OTTL value expressions computing their values.
+	// Labels whose expression evaluates to nil or an empty string are not added to the alert.
+	Labels map[string]string `mapstructure:"labels"`
+	// GroupBy restricts the labels of the alerts to the given labels, merging the events of a
+	// batch that result in the same labels into a single alert.
+	GroupBy []string `mapstructure:"group_by"`
+	// DedupWindow is the duration during which an alert is not sent again after it was sent.
+	// Zero disables deduplication.
+	DedupWindow time.Duration `mapstructure:"dedup_window"`
+}
+
+// SeverityRule sets the severity of the events matching an OTTL condition.
+// The condition is evaluated in the span event context for traces, and in the log context for logs.
+type SeverityRule struct {
+	// Severity is the severity of the alerts created from the matching events.
+	Severity string `mapstructure:"severity"`
+	// Condition is the OTTL condition matching the events.
+	Condition string `mapstructure:"condition"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -37,5 +62,29 @@ func (cfg *Config) Validate() error {
 	if cfg.DefaultSeverity == "" {
 		return errors.New("severity must be non-empty")
 	}
+	for i, rule := range cfg.SeverityRules {
+		if rule.Severity == "" {
+			return fmt.Errorf("severity_rules[%d]: severity must be non-empty", i)
+		}
+		if rule.Condition == "" {
+			return fmt.Errorf("severity_rules[%d]: condition must be non-empty", i)
+		}
+	}
+	for name, expression := range cfg.Labels {
+		if !model.UTF8Validation.IsValidLabelName(name) {
+			return fmt.Errorf("labels: invalid label name %q", name)
+		}
+		if expression == "" {
+			return fmt.Errorf("labels::%s: expression must be non-empty", name)
+		}
+	}
+	for _, name := range cfg.GroupBy {
+		if !model.UTF8Validation.IsValidLabelName(name) {
+			return fmt.Errorf("group_by: invalid label name %q", name)
+		}
+	}
+	if cfg.DedupWindow < 0 {
+		return errors.New("dedup_window must not be negative")
+	}
 	return nil
 }
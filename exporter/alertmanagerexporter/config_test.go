@@ -86,6 +86,21 @@ func TestLoadConfig(t *testing.T) {
 				}(),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "3"),
+			expected: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = "a.new.alertmanager.target:9093"
+				cfg.SeverityRules = []SeverityRule{
+					{Severity: "critical", Condition: `attributes["http.response.status_code"] >= 500`},
+					{Severity: "warning", Condition: `IsMatch(name, "^timeout.*")`},
+				}
+				cfg.Labels = map[string]string{"service": `resource.attributes["service.name"]`}
+				cfg.GroupBy = []string{"service", "severity"}
+				cfg.DedupWindow = 5 * time.Minute
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -127,6 +142,60 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			wantErr: "severity must be non-empty",
 		},
+		{
+			name: "NoSeverityRuleSeverity",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.SeverityRules = []SeverityRule{{Condition: "true"}}
+				return cfg
+			}(),
+			wantErr: "severity_rules[0]: severity must be non-empty",
+		},
+		{
+			name: "NoSeverityRuleCondition",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.SeverityRules = []SeverityRule{{Severity: "critical"}}
+				return cfg
+			}(),
+			wantErr: "severity_rules[0]: condition must be non-empty",
+		},
+		{
+			name: "InvalidLabelName",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Labels = map[string]string{"": "name"}
+				return cfg
+			}(),
+			wantErr: `labels: invalid label name ""`,
+		},
+		{
+			name: "NoLabelExpression",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Labels = map[string]string{"service": ""}
+				return cfg
+			}(),
+			wantErr: "labels::service: expression must be non-empty",
+		},
+		{
+			name: "InvalidGroupBy",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.GroupBy = []string{""}
+				return cfg
+			}(),
+			wantErr: `group_by: invalid label name ""`,
+		},
+		{
+			name: "NegativeDedupWindow",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.DedupWindow = -time.Second
+				return cfg
+			}(),
+			wantErr: "dedup_window must not be negative",
+		},
 		{
 			name:    "Success",
 			cfg:     createDefaultConfig().(*Config),
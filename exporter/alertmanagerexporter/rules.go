@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alertmanagerexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/alertmanagerexporter"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/common/model"
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+type severityCondition[K any] struct {
+	severity  string
+	condition *ottl.Condition[K]
+}
+
+// eventRules holds the parsed severity rules and label expressions for the transform context K.
+type eventRules[K any] struct {
+	severities []severityCondition[K]
+	labels     map[model.LabelName]*ottl.ValueExpression[K]
+	logger     *zap.Logger
+}
+
+func newEventRules[K any](cfg *Config, logger *zap.Logger, parser ottl.Parser[K]) (*eventRules[K], error) {
+	if len(cfg.SeverityRules) == 0 && len(cfg.Labels) == 0 {
+		return nil, nil
+	}
+	r := &eventRules[K]{
+		severities: make([]severityCondition[K], 0, len(cfg.SeverityRules)),
+		labels:     make(map[model.LabelName]*ottl.ValueExpression[K], len(cfg.Labels)),
+		logger:     logger,
+	}
+	for i, rule := range cfg.SeverityRules {
+		condition, err := parser.ParseCondition(rule.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("invalid \"severity_rules[%d]::condition\": %w", i, err)
+		}
+		r.severities = append(r.severities, severityCondition[K]{severity: rule.Severity, condition: condition})
+	}
+	for name, expression := range cfg.Labels {
+		expr, err := parser.ParseValueExpression(expression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid \"labels::%s\": %w", name, err)
+		}
+		r.labels[model.LabelName(name)] = expr
+	}
+	return r, nil
+}
+
+func newSpanEventRules(cfg *Config, set component.TelemetrySettings) (*eventRules[*ottlspanevent.TransformContext], error) {
+	parser, err := ottlspanevent.NewParser(ottlfuncs.StandardConverters[*ottlspanevent.TransformContext](), set)
+	if err != nil {
+		return nil, err
+	}
+	return newEventRules(cfg, set.Logger, parser)
+}
+
+func newLogRules(cfg *Config, set component.TelemetrySettings) (*eventRules[*ottllog.TransformContext], error) {
+	parser, err := ottllog.NewParser(ottlfuncs.StandardConverters[*ottllog.TransformContext](), set)
+	if err != nil {
+		return nil, err
+	}
+	return newEventRules(cfg, set.Logger, parser)
+}
+
+// apply sets the severity of the first matching rule and the labels computed for the transform context.
+// Evaluation errors are logged, and the failing rule or label is skipped.
+func (r *eventRules[K]) apply(ctx context.Context, tCtx K, event *alertmanagerEvent) {
+	for _, rule := range r.severities {
+		matched, err := rule.condition.Eval(ctx, tCtx)
+		if err != nil {
+			r.logger.Debug("Failed to evaluate the severity rule", zap.Error(err))
+			continue
+		}
+		if matched {
+			event.severity = rule.severity
+			break
+		}
+	}
+	for name, expr := range r.labels {
+		value := r.eval(ctx, tCtx, expr)
+		if value == "" {
+			continue
+		}
+		if event.labels == nil {
+			event.labels = make(model.LabelSet, len(r.labels))
+		}
+		event.labels[name] = model.LabelValue(value)
+	}
+}
+
+func (r *eventRules[K]) eval(ctx context.Context, tCtx K, expr *ottl.ValueExpression[K]) string {
+	value, err := expr.Eval(ctx, tCtx)
+	if err != nil {
+		r.logger.Debug("Failed to evaluate the label expression", zap.Error(err))
+		return ""
+	}
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -5,6 +5,7 @@ package awscloudwatchlogsexporter // import "github.com/open-telemetry/opentelem
 
 import (
 	"errors"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configoptional"
@@ -43,6 +44,10 @@ type Config struct {
 	// Values must be between 1-256 characters and follow the regex pattern: ^([\p{L}\p{Z}\p{N}_.:/=+\-@]*)$
 	Tags map[string]string `mapstructure:"tags"`
 
+	// KMSKeyID is the ARN of the KMS key used to encrypt the CloudWatch Log Groups created by the exporter.
+	// Optional. The log groups which already exist are not changed.
+	KMSKeyID string `mapstructure:"kms_key_id"`
+
 	// Queue settings frm the exporterhelper
 	QueueSettings configoptional.Optional[exporterhelper.QueueBatchConfig] `mapstructure:"sending_queue"`
 
@@ -73,6 +78,10 @@ func (config *Config) Validate() error {
 		return errors.New("'log_stream_name'  has an invalid pattern between curly brackets: " + invalidPattern)
 	}
 
+	if config.KMSKeyID != "" && !strings.HasPrefix(config.KMSKeyID, "arn:") {
+		return errors.New("'kms_key_id' must be the ARN of a KMS key")
+	}
+
 	if err := config.QueueSettings.Validate(); err != nil {
 		return err
 	}
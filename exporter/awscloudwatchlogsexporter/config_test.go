@@ -74,6 +74,26 @@ func TestLoadConfig(t *testing.T) {
 				}()),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "e3-templated-kms"),
+			expected: &Config{
+				BackOffConfig:      defaultBackOffConfig,
+				AWSSessionSettings: awsutil.CreateDefaultSessionConfig(),
+				LogGroupName:       "/aws/{resource:k8s.namespace.name}",
+				LogStreamName:      "{PodName}",
+				LogRetention:       30,
+				KMSKeyID:           "arn:aws:kms:us-east-1:123456789012:key/abcd",
+				QueueSettings: configoptional.Some(func() exporterhelper.QueueBatchConfig {
+					queue := exporterhelper.NewDefaultQueueConfig()
+					queue.NumConsumers = 1
+					return queue
+				}()),
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_kms_key_id"),
+			errorMessage: "'kms_key_id' must be the ARN of a KMS key",
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "invalid_queue_size"),
 			errorMessage: "`queue_size` must be positive",
@@ -58,7 +58,7 @@ func newCwLogsPusher(ctx context.Context, expConfig *Config, params exp.Settings
 	}
 
 	// create CWLogs client with aws session config
-	svcStructuredLog := cwlogs.NewClient(params.Logger, awsConfig, params.BuildInfo, expConfig.LogGroupName, expConfig.LogRetention, expConfig.Tags, metadata.Type.String())
+	svcStructuredLog := cwlogs.NewClient(params.Logger, awsConfig, params.BuildInfo, expConfig.LogGroupName, expConfig.LogRetention, expConfig.Tags, metadata.Type.String(), cwlogs.WithKMSKeyID(expConfig.KMSKeyID))
 	collectorIdentifier, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -129,6 +129,13 @@ func pushLogsToCWLogs(ctx context.Context, logger *zap.Logger, ld plog.Logs, con
 	for i := 0; i < rls.Len(); i++ {
 		rl := rls.At(i)
 		resourceAttrs := attrsValue(rl.Resource().Attributes())
+		// The log group and stream only depend on the resource, so all the logs of the
+		// resource are sent to the same stream.
+		logGroupName, logStreamName, _ := getLogInfo(resourceAttrs, config)
+		streamKey := cwlogs.StreamKey{
+			LogGroupName:  logGroupName,
+			LogStreamName: logStreamName,
+		}
 
 		sls := rl.ScopeLogs()
 		for j := 0; j < sls.Len(); j++ {
@@ -137,7 +144,7 @@ func pushLogsToCWLogs(ctx context.Context, logger *zap.Logger, ld plog.Logs, con
 			logs := sl.LogRecords()
 			for k := 0; k < logs.Len(); k++ {
 				log := logs.At(k)
-				event, err := logToCWLog(resourceAttrs, scope, log, config)
+				event, err := logToCWLog(resourceAttrs, streamKey, scope, log, config)
 				if err != nil {
 					logger.Debug("Failed to convert to CloudWatch Log", zap.Error(err))
 				} else {
@@ -172,11 +179,10 @@ type cwLogBody struct {
 	Resource               map[string]any  `json:"resource,omitempty"`
 }
 
-func logToCWLog(resourceAttrs map[string]any, scope pcommon.InstrumentationScope, log plog.LogRecord, config *Config) (*cwlogs.Event, error) {
+func logToCWLog(resourceAttrs map[string]any, streamKey cwlogs.StreamKey, scope pcommon.InstrumentationScope, log plog.LogRecord, config *Config) (*cwlogs.Event, error) {
 	// TODO(jbd): Benchmark and improve the allocations.
 	// Evaluate go.elastic.co/fastjson as a replacement for encoding/json.
-	// Replace loggroup and logstream with resource attribute
-	logGroupName, logStreamName, _ := getLogInfo(resourceAttrs, config)
+	logGroupName, logStreamName := streamKey.LogGroupName, streamKey.LogStreamName
 
 	var bodyJSON []byte
 	var err error
@@ -244,7 +244,9 @@ func TestLogToCWLog(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			resourceAttrs := attrsValue(tt.resource.Attributes())
-			got, err := logToCWLog(resourceAttrs, tt.scope, tt.log, tt.config)
+			logGroupName, logStreamName, _ := getLogInfo(resourceAttrs, tt.config)
+			streamKey := cwlogs.StreamKey{LogGroupName: logGroupName, LogStreamName: logStreamName}
+			got, err := logToCWLog(resourceAttrs, streamKey, tt.scope, tt.log, tt.config)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("logToCWLog() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -264,7 +266,7 @@ func BenchmarkLogToCWLog(b *testing.B) {
 	log := testLogRecord()
 	scope := testScope()
 	for b.Loop() {
-		_, err := logToCWLog(attrsValue(resource.Attributes()), scope, log, &Config{})
+		_, err := logToCWLog(attrsValue(resource.Attributes()), cwlogs.StreamKey{}, scope, log, &Config{})
 		if err != nil {
 			b.Errorf("logToCWLog() failed %v", err)
 			return
@@ -25,17 +25,28 @@ var patternKeyToAttributeMap = map[string]string{
 	"FaasVersion":          "faas.version",
 }
 
+// resourcePatternPrefix is the prefix of the placeholders replaced by the value of any resource attribute,
+// e.g. {resource:k8s.namespace.name}
+const resourcePatternPrefix = "resource:"
+
+var (
+	patternRegex         = regexp.MustCompile(`\{([^{}]*)\}`)
+	resourcePatternRegex = regexp.MustCompile(`\{` + resourcePatternPrefix + `([^{}]+)\}`)
+)
+
 func isPatternValid(s string) (bool, string) {
 	if !strings.Contains(s, "{") && !strings.Contains(s, "}") {
 		return true, ""
 	}
 
-	re := regexp.MustCompile(`\{([^{}]*)\}`)
-	matches := re.FindAllStringSubmatch(s, -1)
+	matches := patternRegex.FindAllStringSubmatch(s, -1)
 
 	for _, match := range matches {
 		if len(match) > 1 {
 			key := match[1]
+			if name, found := strings.CutPrefix(key, resourcePatternPrefix); found && name != "" {
+				continue
+			}
 			if _, exists := patternKeyToAttributeMap[key]; !exists {
 				return false, key
 			}
@@ -51,6 +62,15 @@ func replacePatterns(s string, attrMap map[string]string, logger *zap.Logger) (s
 		s, foundAndReplaced = replacePatternWithAttrValue(s, key, attrMap, logger)
 		success = success && foundAndReplaced
 	}
+	s = resourcePatternRegex.ReplaceAllStringFunc(s, func(pattern string) string {
+		name := resourcePatternRegex.FindStringSubmatch(pattern)[1]
+		if value, ok := attrMap[name]; ok && value != "" {
+			return value
+		}
+		logger.Debug("No resource attribute found for pattern " + pattern)
+		success = false
+		return "undefined"
+	})
 	return s, success
 }
 
@@ -180,6 +180,22 @@ func TestReplacePatternValidTaskDefinitionFamily(t *testing.T) {
 	assert.True(t, success)
 }
 
+func TestReplaceResourceAttributePattern(t *testing.T) {
+	logger := zap.NewNop()
+	attrMap := map[string]any{
+		"k8s.namespace.name": "payments",
+		"k8s.pod.uid":        "",
+	}
+
+	s, success := replacePatterns("/aws/{resource:k8s.namespace.name}/logs", anyMapToStringMap(attrMap), logger)
+	assert.Equal(t, "/aws/payments/logs", s)
+	assert.True(t, success)
+
+	s, success = replacePatterns("{resource:k8s.namespace.name}-{resource:k8s.pod.uid}-{resource:unknown}", anyMapToStringMap(attrMap), logger)
+	assert.Equal(t, "payments-undefined-undefined", s)
+	assert.False(t, success)
+}
+
 func TestIsPatternValid(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -211,6 +227,16 @@ func TestIsPatternValid(t *testing.T) {
 			pattern:  "{ClusterName}-{RandomName}",
 			expected: false,
 		},
+		{
+			name:     "resource attribute pattern",
+			pattern:  "/aws/{resource:k8s.namespace.name}/{ServiceName}",
+			expected: true,
+		},
+		{
+			name:     "empty resource attribute pattern",
+			pattern:  "prefix-{resource:}-suffix",
+			expected: false,
+		},
 		{
 			name:     "empty curly brackets",
 			pattern:  "prefix-{}-suffix",
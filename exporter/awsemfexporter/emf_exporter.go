@@ -112,7 +112,7 @@ func (emf *emfExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics)
 	outputDestination := emf.config.OutputDestination
 
 	for i := 0; i < rms.Len(); i++ {
-		err := emf.metricTranslator.translateOTelToGroupedMetric(rms.At(i), groupedMetrics, emf.config)
+		err := emf.metricTranslator.translateOTelToGroupedMetric(ctx, rms.At(i), groupedMetrics, emf.config)
 		if err != nil {
 			return err
 		}
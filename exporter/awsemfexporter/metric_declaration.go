@@ -5,12 +5,20 @@ package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"fmt"
 	"regexp"
 	"sort"
 	"strings"
 
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
 // MetricDeclaration characterizes a rule to be used to set dimensions for certain
@@ -26,9 +34,17 @@ type MetricDeclaration struct {
 	// (Optional) List of label matchers that define matching rules to filter against
 	// the labels of incoming metrics.
 	LabelMatchers []*LabelMatcher `mapstructure:"label_matchers"`
+	// (Optional) List of OTTL conditions evaluated against the resource of incoming metrics.
+	// If set, only the metrics of the resources matching at least one condition are used.
+	Conditions []string `mapstructure:"conditions"`
+	// (Optional) Storage resolution in seconds of the matching metrics, either 1 for high-resolution
+	// metrics or 60. (Default: 60) The `aws.emf.storage_resolution` attribute takes precedence.
+	StorageResolution int `mapstructure:"storage_resolution"`
 
 	// metricRegexList is a list of compiled regexes for metric name selectors.
 	metricRegexList []*regexp.Regexp
+	// conditions is the compiled sequence of OTTL conditions.
+	conditions *ottl.ConditionSequence[*ottlresource.TransformContext]
 }
 
 // LabelMatcher defines a label filtering rule against the labels of incoming metrics. Only metrics that
@@ -114,6 +130,24 @@ func (m *MetricDeclaration) init(logger *zap.Logger) error {
 			return err
 		}
 	}
+
+	if m.StorageResolution != 0 && m.StorageResolution != 1 && m.StorageResolution != 60 {
+		return fmt.Errorf("invalid metric declaration: storage resolution must be 1 or 60, got %d", m.StorageResolution)
+	}
+
+	if len(m.Conditions) > 0 {
+		set := component.TelemetrySettings{Logger: logger}
+		parser, err := ottlresource.NewParser(ottlfuncs.StandardConverters[*ottlresource.TransformContext](), set)
+		if err != nil {
+			return err
+		}
+		conditions, err := parser.ParseConditions(m.Conditions)
+		if err != nil {
+			return fmt.Errorf("invalid metric declaration: %w", err)
+		}
+		sequence := ottlresource.NewConditionSequence(conditions, set, ottlresource.WithConditionSequenceErrorMode(ottl.IgnoreError))
+		m.conditions = &sequence
+	}
 	return nil
 }
 
@@ -128,6 +162,20 @@ func (m *MetricDeclaration) MatchesName(metricName string) bool {
 	return false
 }
 
+// MatchesResource returns true if the given resource matches any of the Metric Declaration's
+// OTTL conditions, or if no conditions are defined.
+func (m *MetricDeclaration) MatchesResource(ctx context.Context, rm pmetric.ResourceMetrics) bool {
+	if m.conditions == nil {
+		return true
+	}
+
+	tCtx := ottlresource.NewTransformContextPtr(rm.Resource(), rm)
+	defer tCtx.Close()
+	// Errors are ignored by the condition sequence, which then evaluates to false.
+	matches, _ := m.conditions.Eval(ctx, tCtx)
+	return matches
+}
+
 // MatchesLabels returns true if the given OTLP Metric's name matches any of the Metric
 // Declaration's label matchers.
 func (m *MetricDeclaration) MatchesLabels(labels map[string]string) bool {
@@ -366,6 +366,40 @@ func TestMetricDeclarationInit(t *testing.T) {
 	})
 }
 
+func TestMetricDeclarationConditions(t *testing.T) {
+	logger := zap.NewNop()
+	rm := pmetric.NewResourceMetrics()
+	rm.Resource().Attributes().PutStr("service.name", "payments")
+
+	m := &MetricDeclaration{
+		MetricNameSelectors: []string{"a"},
+	}
+	assert.NoError(t, m.init(logger))
+	assert.True(t, m.MatchesResource(t.Context(), rm))
+
+	m = &MetricDeclaration{
+		MetricNameSelectors: []string{"a"},
+		Conditions:          []string{`attributes["service.name"] == "checkout"`, `IsMatch(attributes["service.name"], "^pay")`},
+	}
+	assert.NoError(t, m.init(logger))
+	assert.True(t, m.MatchesResource(t.Context(), rm))
+
+	rm.Resource().Attributes().PutStr("service.name", "frontend")
+	assert.False(t, m.MatchesResource(t.Context(), rm))
+
+	m = &MetricDeclaration{
+		MetricNameSelectors: []string{"a"},
+		Conditions:          []string{`attributes["service.name"] ==`},
+	}
+	assert.ErrorContains(t, m.init(logger), "invalid metric declaration")
+
+	m = &MetricDeclaration{
+		MetricNameSelectors: []string{"a"},
+		StorageResolution:   10,
+	}
+	assert.EqualError(t, m.init(logger), "invalid metric declaration: storage resolution must be 1 or 60, got 10")
+}
+
 func TestMetricDeclarationMatchesName(t *testing.T) {
 	m := &MetricDeclaration{
 		MetricNameSelectors: []string{"^a+$", "^b.*$", "^ac+a$"},
@@ -4,6 +4,7 @@
 package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter"
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -87,6 +88,15 @@ type groupedMetricMetadata struct {
 	metricDataType             pmetric.MetricType
 	batchIndex                 int
 	retainInitialValueForDelta bool
+	// matchedConditions flags for each metric declaration whether the resource matches its
+	// OTTL conditions ('1') or not ('0'). It is empty if no metric declaration has conditions.
+	matchedConditions string
+}
+
+// matchesConditions returns true if the resource of the metrics matches the OTTL conditions of
+// the metric declaration at the given index.
+func (m groupedMetricMetadata) matchesConditions(metricDeclIdx int) bool {
+	return m.matchedConditions == "" || m.matchedConditions[metricDeclIdx] == '1'
 }
 
 // cWMetricMetadata represents the metadata associated with a given CloudWatch metric
@@ -123,12 +133,13 @@ func (mt metricTranslator) Shutdown() error {
 }
 
 // translateOTelToGroupedMetric converts OT metrics to Grouped Metric format.
-func (mt metricTranslator) translateOTelToGroupedMetric(rm pmetric.ResourceMetrics, groupedMetrics map[any]*groupedMetric, config *Config) error {
+func (mt metricTranslator) translateOTelToGroupedMetric(ctx context.Context, rm pmetric.ResourceMetrics, groupedMetrics map[any]*groupedMetric, config *Config) error {
 	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
 	var instrumentationScopeName string
 	cWNamespace := getNamespace(rm, config.Namespace)
 	logGroup, logStream, patternReplaceSucceeded := getLogInfo(rm, cWNamespace, config)
 	deltaInitialValue := config.RetainInitialValueOfDeltaMetric
+	matchedConditions := matchConditions(ctx, rm, config.MetricDeclarations)
 
 	ilms := rm.ScopeMetrics()
 	var metricReceiver string
@@ -153,6 +164,7 @@ func (mt metricTranslator) translateOTelToGroupedMetric(rm pmetric.ResourceMetri
 					metricDataType:             metric.Type(),
 					batchIndex:                 0,
 					retainInitialValueForDelta: deltaInitialValue,
+					matchedConditions:          matchedConditions,
 				},
 				instrumentationScopeName: instrumentationScopeName,
 				receiver:                 metricReceiver,
@@ -166,6 +178,30 @@ func (mt metricTranslator) translateOTelToGroupedMetric(rm pmetric.ResourceMetri
 	return nil
 }
 
+// matchConditions evaluates the OTTL conditions of the metric declarations against the resource
+// and returns the flags stored in groupedMetricMetadata.matchedConditions.
+func matchConditions(ctx context.Context, rm pmetric.ResourceMetrics, metricDeclarations []*MetricDeclaration) string {
+	hasConditions := false
+	for _, metricDeclaration := range metricDeclarations {
+		if metricDeclaration.conditions != nil {
+			hasConditions = true
+			break
+		}
+	}
+	if !hasConditions {
+		return ""
+	}
+
+	matched := make([]byte, len(metricDeclarations))
+	for i, metricDeclaration := range metricDeclarations {
+		matched[i] = '0'
+		if metricDeclaration.MatchesResource(ctx, rm) {
+			matched[i] = '1'
+		}
+	}
+	return string(matched)
+}
+
 // translateGroupedMetricToCWMetric converts Grouped Metric format to CloudWatch Metric format.
 func translateGroupedMetricToCWMetric(groupedMetric *groupedMetric, config *Config) *cWMetrics {
 	labels := filterAWSEMFAttributes(groupedMetric.labels)
@@ -273,8 +309,8 @@ func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, conf
 
 	// Filter metric declarations by labels
 	metricDeclarations := make([]*MetricDeclaration, 0, len(config.MetricDeclarations))
-	for _, metricDeclaration := range config.MetricDeclarations {
-		if metricDeclaration.MatchesLabels(labels) {
+	for i, metricDeclaration := range config.MetricDeclarations {
+		if groupedMetric.metadata.matchesConditions(i) && metricDeclaration.MatchesLabels(labels) {
 			metricDeclarations = append(metricDeclarations, metricDeclaration)
 		}
 	}
@@ -325,6 +361,12 @@ func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, conf
 		if metricInfo.unit != "" {
 			metric.Unit = metricInfo.unit
 		}
+		// Use high resolution if any of the matched metric declarations requests it
+		for _, idx := range metricDeclIdx {
+			if metricDeclarations[idx].StorageResolution == 1 {
+				metric.StorageResolution = 1
+			}
+		}
 		if storRes, ok := groupedMetric.labels[emfStorageResolutionAttribute]; ok {
 			if storResInt, err := strconv.Atoi(storRes); err == nil {
 				metric.StorageResolution = storResInt
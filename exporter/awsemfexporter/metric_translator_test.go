@@ -340,7 +340,7 @@ func TestTranslateOtToGroupedMetric(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.testName, func(t *testing.T) {
 			groupedMetrics := make(map[any]*groupedMetric)
-			err := translator.translateOTelToGroupedMetric(tc.metric, groupedMetrics, config)
+			err := translator.translateOTelToGroupedMetric(t.Context(), tc.metric, groupedMetrics, config)
 			assert.NoError(t, err)
 			assert.NotNil(t, groupedMetrics)
 			assert.Len(t, groupedMetrics, 3)
@@ -372,7 +372,7 @@ func TestTranslateOtToGroupedMetric(t *testing.T) {
 		rm.Resource().Attributes().PutStr("service.name", "myServiceName")
 		rm.Resource().Attributes().PutStr(occonventions.AttributeExporterVersion, "SomeVersion")
 		groupedMetrics := make(map[any]*groupedMetric)
-		err := translator.translateOTelToGroupedMetric(rm, groupedMetrics, config)
+		err := translator.translateOTelToGroupedMetric(t.Context(), rm, groupedMetrics, config)
 		assert.NoError(t, err)
 		assert.Empty(t, groupedMetrics)
 	})
@@ -2006,7 +2006,7 @@ func BenchmarkTranslateOtToGroupedMetricWithInstrLibrary(b *testing.B) {
 
 	for b.Loop() {
 		groupedMetric := make(map[any]*groupedMetric)
-		err := translator.translateOTelToGroupedMetric(rm, groupedMetric, config)
+		err := translator.translateOTelToGroupedMetric(b.Context(), rm, groupedMetric, config)
 		assert.NoError(b, err)
 	}
 }
@@ -2028,7 +2028,7 @@ func BenchmarkTranslateOtToGroupedMetricWithoutConfigReplacePattern(b *testing.B
 
 	for b.Loop() {
 		groupedMetrics := make(map[any]*groupedMetric)
-		err := translator.translateOTelToGroupedMetric(rm, groupedMetrics, config)
+		err := translator.translateOTelToGroupedMetric(b.Context(), rm, groupedMetrics, config)
 		assert.NoError(b, err)
 	}
 }
@@ -2050,7 +2050,7 @@ func BenchmarkTranslateOtToGroupedMetricWithConfigReplaceWithResource(b *testing
 
 	for b.Loop() {
 		groupedMetrics := make(map[any]*groupedMetric)
-		err := translator.translateOTelToGroupedMetric(rm, groupedMetrics, config)
+		err := translator.translateOTelToGroupedMetric(b.Context(), rm, groupedMetrics, config)
 		assert.NoError(b, err)
 	}
 }
@@ -2072,7 +2072,7 @@ func BenchmarkTranslateOtToGroupedMetricWithConfigReplaceWithLabel(b *testing.B)
 
 	for b.Loop() {
 		groupedMetrics := make(map[any]*groupedMetric)
-		err := translator.translateOTelToGroupedMetric(rm, groupedMetrics, config)
+		err := translator.translateOTelToGroupedMetric(b.Context(), rm, groupedMetrics, config)
 		assert.NoError(b, err)
 	}
 }
@@ -2089,7 +2089,7 @@ func BenchmarkTranslateOtToGroupedMetricWithoutInstrLibrary(b *testing.B) {
 
 	for b.Loop() {
 		groupedMetrics := make(map[any]*groupedMetric)
-		err := translator.translateOTelToGroupedMetric(rm, groupedMetrics, config)
+		err := translator.translateOTelToGroupedMetric(b.Context(), rm, groupedMetrics, config)
 		assert.NoError(b, err)
 	}
 }
@@ -2328,6 +2328,70 @@ var logGroupStreamTestCases = []logGroupStreamTest{
 	},
 }
 
+func TestGroupedMetricToCWMeasurementsWithConditions(t *testing.T) {
+	logger := zap.NewNop()
+	metricDeclarations := []*MetricDeclaration{
+		{
+			Dimensions:          [][]string{{"a"}},
+			MetricNameSelectors: []string{"metric1"},
+			Conditions:          []string{`attributes["service.name"] == "payments"`},
+			StorageResolution:   1,
+		},
+		{
+			Dimensions:          [][]string{{"b"}},
+			MetricNameSelectors: []string{"metric.*"},
+			Conditions:          []string{`attributes["service.name"] == "checkout"`},
+		},
+	}
+	for _, decl := range metricDeclarations {
+		require.NoError(t, decl.init(logger))
+	}
+	config := &Config{
+		MetricDeclarations: metricDeclarations,
+		logger:             logger,
+	}
+
+	rm := pmetric.NewResourceMetrics()
+	rm.Resource().Attributes().PutStr("service.name", "payments")
+	matchedConditions := matchConditions(t.Context(), rm, metricDeclarations)
+	assert.Equal(t, "10", matchedConditions)
+	assert.Empty(t, matchConditions(t.Context(), rm, nil))
+
+	groupedMetric := &groupedMetric{
+		labels: map[string]string{"a": "A", "b": "B"},
+		metrics: map[string]*metricInfo{
+			"metric1": {value: 1, unit: "Count"},
+			"metric2": {value: 2, unit: "Count"},
+		},
+		metadata: cWMetricMetadata{
+			groupedMetricMetadata: groupedMetricMetadata{
+				namespace:         "Namespace",
+				matchedConditions: matchedConditions,
+			},
+		},
+	}
+	cWMeasurements := groupedMetricToCWMeasurementsWithFilters(groupedMetric, config)
+	assertCWMeasurementSliceEqual(t, []cWMeasurement{
+		{
+			Namespace:  "Namespace",
+			Dimensions: [][]string{{"a"}},
+			Metrics: []cWMetricInfo{
+				{
+					Name:              "metric1",
+					Unit:              "Count",
+					StorageResolution: 1,
+				},
+			},
+		},
+	}, cWMeasurements)
+
+	// The storage resolution attribute takes precedence over the metric declaration
+	groupedMetric.labels[emfStorageResolutionAttribute] = "60"
+	cWMeasurements = groupedMetricToCWMeasurementsWithFilters(groupedMetric, config)
+	require.Len(t, cWMeasurements, 1)
+	assert.Equal(t, 60, cWMeasurements[0].Metrics[0].StorageResolution)
+}
+
 func TestTranslateOtToGroupedMetricForLogGroupAndStream(t *testing.T) {
 	for _, test := range logGroupStreamTestCases {
 		t.Run(test.name, func(t *testing.T) {
@@ -2345,7 +2409,7 @@ func TestTranslateOtToGroupedMetricForLogGroupAndStream(t *testing.T) {
 			groupedMetrics := make(map[any]*groupedMetric)
 
 			rm := test.inputMetrics.ResourceMetrics().At(0)
-			err := translator.translateOTelToGroupedMetric(rm, groupedMetrics, config)
+			err := translator.translateOTelToGroupedMetric(t.Context(), rm, groupedMetrics, config)
 			assert.NoError(t, err)
 
 			assert.NotNil(t, groupedMetrics)
@@ -2376,7 +2440,7 @@ func TestTranslateOtToGroupedMetricForInitialDeltaValue(t *testing.T) {
 			groupedMetrics := make(map[any]*groupedMetric)
 
 			rm := test.inputMetrics.ResourceMetrics().At(0)
-			err := translator.translateOTelToGroupedMetric(rm, groupedMetrics, config)
+			err := translator.translateOTelToGroupedMetric(t.Context(), rm, groupedMetrics, config)
 			assert.NoError(t, err)
 
 			assert.NotNil(t, groupedMetrics)
@@ -5,6 +5,7 @@ package awss3exporter // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -77,14 +78,27 @@ const (
 	OtlpJSON     MarshalerType = "otlp_json"
 	SumoIC       MarshalerType = "sumo_ic"
 	Body         MarshalerType = "body"
+	Parquet      MarshalerType = "parquet"
 )
 
+// ParquetConfig contains the options of the parquet marshaler.
+type ParquetConfig struct {
+	// Compression is the codec used to compress the column chunks of the Parquet files.
+	// Valid values are: `snappy` (default), `zstd`, `gzip` or `none`.
+	Compression string `mapstructure:"compression"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 // ResourceAttrsToS3 defines the mapping of S3 uploading configuration values to resource attribute values.
 type ResourceAttrsToS3 struct {
 	// S3Bucket indicates the mapping of the bucket name used for uploading to a specific resource attribute value.
 	S3Bucket string `mapstructure:"s3_bucket"`
 	// S3Prefix indicates the mapping of the key (directory) prefix used for writing into the bucket to a specific resource attribute value.
 	S3Prefix string `mapstructure:"s3_prefix"`
+	// S3PrefixTemplate builds the key (directory) prefix from several resource attribute values,
+	// referenced with `{attribute.name}`, e.g. `service={service.name}/namespace={service.namespace}`.
+	S3PrefixTemplate string `mapstructure:"s3_prefix_template"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -100,6 +114,8 @@ type Config struct {
 	Encoding              *component.ID     `mapstructure:"encoding"`
 	EncodingFileExtension string            `mapstructure:"encoding_file_extension"`
 	ResourceAttrsToS3     ResourceAttrsToS3 `mapstructure:"resource_attrs_to_s3"`
+	// Parquet contains the options of the parquet marshaler.
+	Parquet ParquetConfig `mapstructure:"parquet"`
 }
 
 func (c *Config) Validate() error {
@@ -156,5 +172,23 @@ func (c *Config) Validate() error {
 	if c.S3Uploader.UniqueKeyFuncName != "" && !validUniqueKeyFuncs[c.S3Uploader.UniqueKeyFuncName] {
 		errs = multierr.Append(errs, errors.New("invalid UniqueKeyFuncName"))
 	}
+
+	if c.MarshalerName == Parquet && c.Encoding == nil {
+		if _, ok := parquetCompressionCodecs[c.Parquet.Compression]; !ok {
+			errs = multierr.Append(errs, errors.New("invalid parquet compression, must be either 'snappy', 'zstd', 'gzip' or 'none'"))
+		}
+		if compression.IsCompressed() {
+			errs = multierr.Append(errs, errors.New("compression is not supported with the parquet marshaler, use parquet::compression instead"))
+		}
+	}
+
+	if c.ResourceAttrsToS3.S3PrefixTemplate != "" {
+		if c.ResourceAttrsToS3.S3Prefix != "" {
+			errs = multierr.Append(errs, errors.New("resource_attrs_to_s3: s3_prefix and s3_prefix_template are mutually exclusive"))
+		}
+		if _, err := parsePrefixTemplate(c.ResourceAttrsToS3.S3PrefixTemplate); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid s3_prefix_template: %w", err))
+		}
+	}
 	return errs
 }
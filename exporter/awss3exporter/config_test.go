@@ -5,6 +5,7 @@ package awss3exporter
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -320,6 +321,53 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			errExpected: errors.New("region is required"),
 		},
+		{
+			name: "s3_prefix and s3_prefix_template",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.Region = "foo"
+				c.S3Uploader.S3Bucket = "bar"
+				c.ResourceAttrsToS3.S3Prefix = "service.name"
+				c.ResourceAttrsToS3.S3PrefixTemplate = "service={service.name}"
+				return c
+			}(),
+			errExpected: errors.New("resource_attrs_to_s3: s3_prefix and s3_prefix_template are mutually exclusive"),
+		},
+		{
+			name: "invalid s3_prefix_template",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.Region = "foo"
+				c.S3Uploader.S3Bucket = "bar"
+				c.ResourceAttrsToS3.S3PrefixTemplate = "service={service.name"
+				return c
+			}(),
+			errExpected: fmt.Errorf("invalid s3_prefix_template: %w", errors.New(`unclosed placeholder in "service={service.name"`)),
+		},
+		{
+			name: "invalid parquet compression",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.Region = "foo"
+				c.S3Uploader.S3Bucket = "bar"
+				c.MarshalerName = Parquet
+				c.Parquet.Compression = "lz4"
+				return c
+			}(),
+			errExpected: errors.New("invalid parquet compression, must be either 'snappy', 'zstd', 'gzip' or 'none'"),
+		},
+		{
+			name: "parquet with compression",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.Region = "foo"
+				c.S3Uploader.S3Bucket = "bar"
+				c.S3Uploader.Compression = "gzip"
+				c.MarshalerName = Parquet
+				return c
+			}(),
+			errExpected: errors.New("compression is not supported with the parquet marshaler, use parquet::compression instead"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -495,6 +543,44 @@ func TestResourceAttrsToS3(t *testing.T) {
 	)
 }
 
+func TestResourceAttrsToS3PrefixTemplate(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Exporters[factory.Type()] = factory
+	cfg, err := otelcoltest.LoadConfigAndValidate(
+		filepath.Join("testdata", "config-s3_prefix_template.yaml"), factories)
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	queueCfg := configoptional.Default(exporterhelper.NewDefaultQueueConfig())
+	timeoutCfg := exporterhelper.NewDefaultTimeoutConfig()
+
+	e := cfg.Exporters[component.MustNewID("awss3")].(*Config)
+
+	assert.Equal(t, &Config{
+		QueueSettings:   queueCfg,
+		TimeoutSettings: timeoutCfg,
+		S3Uploader: S3UploaderConfig{
+			Region:            "us-east-1",
+			S3Bucket:          "foo",
+			S3PartitionFormat: "year=%Y/month=%m/day=%d/hour=%H",
+			Endpoint:          "http://endpoint.com",
+			StorageClass:      "STANDARD",
+			RetryMode:         DefaultRetryMode,
+			RetryMaxAttempts:  DefaultRetryMaxAttempts,
+			RetryMaxBackoff:   DefaultRetryMaxBackoff,
+		},
+		MarshalerName: "otlp_json",
+		ResourceAttrsToS3: ResourceAttrsToS3{
+			S3PrefixTemplate: "service={service.name}/namespace={service.namespace}",
+		},
+	}, e,
+	)
+}
+
 func TestRetry(t *testing.T) {
 	factories, err := otelcoltest.NopFactories()
 	assert.NoError(t, err)
@@ -20,11 +20,12 @@ import (
 )
 
 type s3Exporter struct {
-	config     *Config
-	signalType string
-	uploader   upload.Manager
-	logger     *zap.Logger
-	marshaler  marshaler
+	config         *Config
+	signalType     string
+	uploader       upload.Manager
+	logger         *zap.Logger
+	marshaler      marshaler
+	prefixTemplate *prefixTemplate
 }
 
 func newS3Exporter(
@@ -43,7 +44,9 @@ func newS3Exporter(
 func (e *s3Exporter) getUploadOpts(res pcommon.Resource) *upload.UploadOptions {
 	s3Prefix := ""
 	s3Bucket := ""
-	if s3PrefixKey := e.config.ResourceAttrsToS3.S3Prefix; s3PrefixKey != "" {
+	if e.prefixTemplate != nil {
+		s3Prefix = e.prefixTemplate.render(res.Attributes())
+	} else if s3PrefixKey := e.config.ResourceAttrsToS3.S3Prefix; s3PrefixKey != "" {
 		if value, ok := res.Attributes().Get(s3PrefixKey); ok {
 			s3Prefix = value.AsString()
 		}
@@ -67,6 +70,10 @@ func (e *s3Exporter) start(ctx context.Context, host component.Host) error {
 		if m, err = newMarshalerFromEncoding(e.config.Encoding, e.config.EncodingFileExtension, host, e.logger); err != nil {
 			return err
 		}
+	} else if e.config.MarshalerName == Parquet {
+		if m, err = newParquetS3Marshaler(e.config.Parquet, e.logger); err != nil {
+			return err
+		}
 	} else {
 		if m, err = newMarshaler(e.config.MarshalerName, e.logger); err != nil {
 			return fmt.Errorf("unknown marshaler %q", e.config.MarshalerName)
@@ -75,6 +82,12 @@ func (e *s3Exporter) start(ctx context.Context, host component.Host) error {
 
 	e.marshaler = m
 
+	if tmpl := e.config.ResourceAttrsToS3.S3PrefixTemplate; tmpl != "" {
+		if e.prefixTemplate, err = parsePrefixTemplate(tmpl); err != nil {
+			return fmt.Errorf("invalid s3_prefix_template: %w", err)
+		}
+	}
+
 	up, err := newUploadManager(ctx, e.config, e.signalType, m.format(), m.compressed())
 	if err != nil {
 		return err
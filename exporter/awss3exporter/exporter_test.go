@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/zap"
 
@@ -96,3 +97,37 @@ func TestLogWithBucketAndPrefixAttrs(t *testing.T) {
 	exporter := getLogExporterWithBucketAndPrefixAttrs(t)
 	assert.NoError(t, exporter.ConsumeLogs(t.Context(), logs))
 }
+
+func TestLogWithPrefixTemplate(t *testing.T) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("checkout")
+
+	marshaler, _ := newMarshaler("otlp_json", zap.NewNop())
+	config := createDefaultConfig().(*Config)
+	config.ResourceAttrsToS3.S3PrefixTemplate = "service={service.name}/namespace={service.namespace}"
+	tmpl, err := parsePrefixTemplate(config.ResourceAttrsToS3.S3PrefixTemplate)
+	require.NoError(t, err)
+	exporter := &s3Exporter{
+		config:         config,
+		uploader:       &recordingWriter{},
+		logger:         zap.NewNop(),
+		marshaler:      marshaler,
+		prefixTemplate: tmpl,
+	}
+
+	require.NoError(t, exporter.ConsumeLogs(t.Context(), logs))
+	assert.Equal(t, []upload.UploadOptions{
+		{OverridePrefix: "service=checkout/namespace=__HIVE_DEFAULT_PARTITION__"},
+	}, exporter.uploader.(*recordingWriter).opts)
+}
+
+type recordingWriter struct {
+	opts []upload.UploadOptions
+}
+
+func (w *recordingWriter) Upload(_ context.Context, _ []byte, uploadOpts *upload.UploadOptions) error {
+	w.opts = append(w.opts, *uploadOpts)
+	return nil
+}
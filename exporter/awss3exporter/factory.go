@@ -87,13 +87,14 @@ func createLogsExporter(ctx context.Context,
 		return nil, err
 	}
 
-	if cfg.ResourceAttrsToS3.S3Prefix == "" {
+	attrKeys := batchAttrKeys(cfg)
+	if len(attrKeys) == 0 {
 		return logsExporter, err
 	}
 
 	wrapped := &baseLogsExporter{
 		Component: logsExporter,
-		Logs:      batchperresourceattr.NewBatchPerResourceLogs(cfg.ResourceAttrsToS3.S3Prefix, logsExporter),
+		Logs:      batchperresourceattr.NewMultiBatchPerResourceLogs(attrKeys, logsExporter),
 	}
 	return wrapped, nil
 }
@@ -113,6 +114,10 @@ func createMetricsExporter(ctx context.Context,
 		return nil, errors.New("metrics are not supported by sumo_ic output format")
 	}
 
+	if cfg.MarshalerName == Parquet && cfg.Encoding == nil {
+		return nil, errors.New("metrics are not supported by parquet output format")
+	}
+
 	metricsExporter, err := exporterhelper.NewMetrics(ctx, params,
 		config,
 		s3Exporter.ConsumeMetrics,
@@ -124,13 +129,14 @@ func createMetricsExporter(ctx context.Context,
 		return nil, err
 	}
 
-	if cfg.ResourceAttrsToS3.S3Prefix == "" {
+	attrKeys := batchAttrKeys(cfg)
+	if len(attrKeys) == 0 {
 		return metricsExporter, err
 	}
 
 	wrapped := &baseMetricsExporter{
 		Component: metricsExporter,
-		Metrics:   batchperresourceattr.NewBatchPerResourceMetrics(cfg.ResourceAttrsToS3.S3Prefix, metricsExporter),
+		Metrics:   batchperresourceattr.NewMultiBatchPerResourceMetrics(attrKeys, metricsExporter),
 	}
 	return wrapped, nil
 }
@@ -162,17 +168,32 @@ func createTracesExporter(ctx context.Context,
 		return nil, err
 	}
 
-	if cfg.ResourceAttrsToS3.S3Prefix == "" {
+	attrKeys := batchAttrKeys(cfg)
+	if len(attrKeys) == 0 {
 		return tracesExporter, err
 	}
 
 	wrapped := &baseTracesExporter{
 		Component: tracesExporter,
-		Traces:    batchperresourceattr.NewBatchPerResourceTraces(cfg.ResourceAttrsToS3.S3Prefix, tracesExporter),
+		Traces:    batchperresourceattr.NewMultiBatchPerResourceTraces(attrKeys, tracesExporter),
 	}
 	return wrapped, nil
 }
 
+// batchAttrKeys returns the resource attributes used to split the data before it is
+// exported, so that each upload only holds data written under the same prefix.
+func batchAttrKeys(cfg *Config) []string {
+	if tmpl := cfg.ResourceAttrsToS3.S3PrefixTemplate; tmpl != "" {
+		if t, err := parsePrefixTemplate(tmpl); err == nil {
+			return t.attributes
+		}
+	}
+	if cfg.ResourceAttrsToS3.S3Prefix != "" {
+		return []string{cfg.ResourceAttrsToS3.S3Prefix}
+	}
+	return nil
+}
+
 // checkAndCastConfig checks the configuration type and casts it to the S3 exporter Config struct.
 func checkAndCastConfig(c component.Config) (*Config, error) {
 	cfg, ok := c.(*Config)
@@ -67,3 +67,33 @@ func TestUnsupportedMarshalerOptions(t *testing.T) {
 	assert.Error(t, err)
 	require.Nil(t, exp2)
 }
+
+func TestParquetMarshalerMetricsUnsupported(t *testing.T) {
+	cfg := createDefaultConfig()
+	cfg.(*Config).MarshalerName = Parquet
+	exp, err := createMetricsExporter(
+		t.Context(),
+		exportertest.NewNopSettings(metadata.Type),
+		cfg)
+	assert.EqualError(t, err, "metrics are not supported by parquet output format")
+	require.Nil(t, exp)
+
+	exp2, err := createTracesExporter(
+		t.Context(),
+		exportertest.NewNopSettings(metadata.Type),
+		cfg)
+	assert.NoError(t, err)
+	require.NotNil(t, exp2)
+}
+
+func TestBatchAttrKeys(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.Empty(t, batchAttrKeys(cfg))
+
+	cfg.ResourceAttrsToS3.S3Prefix = "service.name"
+	assert.Equal(t, []string{"service.name"}, batchAttrKeys(cfg))
+
+	cfg.ResourceAttrsToS3.S3Prefix = ""
+	cfg.ResourceAttrsToS3.S3PrefixTemplate = "service={service.name}/namespace={service.namespace}"
+	assert.Equal(t, []string{"service.name", "service.namespace"}, batchAttrKeys(cfg))
+}
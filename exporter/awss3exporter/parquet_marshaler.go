@@ -0,0 +1,347 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+var parquetCompressionCodecs = map[string]compress.Compression{
+	"":       compress.Codecs.Snappy,
+	"snappy": compress.Codecs.Snappy,
+	"zstd":   compress.Codecs.Zstd,
+	"gzip":   compress.Codecs.Gzip,
+	"none":   compress.Codecs.Uncompressed,
+}
+
+var (
+	parquetAttributesType = arrow.MapOf(arrow.BinaryTypes.String, arrow.BinaryTypes.String)
+	parquetTimestampType  = arrow.FixedWidthTypes.Timestamp_ns
+
+	// parquetResourceScopeFields are the trailing columns of every schema,
+	// holding the resource and instrumentation scope of the record.
+	parquetResourceScopeFields = []arrow.Field{
+		{Name: "resource_attributes", Type: parquetAttributesType},
+		{Name: "resource_dropped_attributes_count", Type: arrow.PrimitiveTypes.Uint32},
+		{Name: "resource_schema_url", Type: arrow.BinaryTypes.String},
+		{Name: "scope_name", Type: arrow.BinaryTypes.String},
+		{Name: "scope_version", Type: arrow.BinaryTypes.String},
+		{Name: "scope_attributes", Type: parquetAttributesType},
+		{Name: "scope_dropped_attributes_count", Type: arrow.PrimitiveTypes.Uint32},
+		{Name: "scope_schema_url", Type: arrow.BinaryTypes.String},
+	}
+
+	parquetLogsSchema = arrow.NewSchema(append([]arrow.Field{
+		{Name: "time", Type: parquetTimestampType, Nullable: true},
+		{Name: "observed_time", Type: parquetTimestampType, Nullable: true},
+		{Name: "trace_id", Type: arrow.BinaryTypes.String},
+		{Name: "span_id", Type: arrow.BinaryTypes.String},
+		{Name: "flags", Type: arrow.PrimitiveTypes.Uint32},
+		{Name: "severity_text", Type: arrow.BinaryTypes.String},
+		{Name: "severity_number", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "event_name", Type: arrow.BinaryTypes.String},
+		{Name: "body", Type: arrow.BinaryTypes.String},
+		{Name: "attributes", Type: parquetAttributesType},
+		{Name: "dropped_attributes_count", Type: arrow.PrimitiveTypes.Uint32},
+	}, parquetResourceScopeFields...), nil)
+
+	parquetSpanEventType = arrow.StructOf(
+		arrow.Field{Name: "time", Type: parquetTimestampType, Nullable: true},
+		arrow.Field{Name: "name", Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: "attributes", Type: parquetAttributesType},
+		arrow.Field{Name: "dropped_attributes_count", Type: arrow.PrimitiveTypes.Uint32},
+	)
+
+	parquetSpanLinkType = arrow.StructOf(
+		arrow.Field{Name: "trace_id", Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: "span_id", Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: "trace_state", Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: "flags", Type: arrow.PrimitiveTypes.Uint32},
+		arrow.Field{Name: "attributes", Type: parquetAttributesType},
+		arrow.Field{Name: "dropped_attributes_count", Type: arrow.PrimitiveTypes.Uint32},
+	)
+
+	parquetTracesSchema = arrow.NewSchema(append([]arrow.Field{
+		{Name: "trace_id", Type: arrow.BinaryTypes.String},
+		{Name: "span_id", Type: arrow.BinaryTypes.String},
+		{Name: "parent_span_id", Type: arrow.BinaryTypes.String},
+		{Name: "trace_state", Type: arrow.BinaryTypes.String},
+		{Name: "flags", Type: arrow.PrimitiveTypes.Uint32},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+		{Name: "kind", Type: arrow.BinaryTypes.String},
+		{Name: "start_time", Type: parquetTimestampType, Nullable: true},
+		{Name: "end_time", Type: parquetTimestampType, Nullable: true},
+		{Name: "attributes", Type: parquetAttributesType},
+		{Name: "dropped_attributes_count", Type: arrow.PrimitiveTypes.Uint32},
+		{Name: "events", Type: arrow.ListOf(parquetSpanEventType)},
+		{Name: "dropped_events_count", Type: arrow.PrimitiveTypes.Uint32},
+		{Name: "links", Type: arrow.ListOf(parquetSpanLinkType)},
+		{Name: "dropped_links_count", Type: arrow.PrimitiveTypes.Uint32},
+		{Name: "status_code", Type: arrow.BinaryTypes.String},
+		{Name: "status_message", Type: arrow.BinaryTypes.String},
+	}, parquetResourceScopeFields...), nil)
+)
+
+// parquetMarshaler writes logs and traces as Parquet files with one row per
+// log record or span. Attribute values are stored as strings, complex values
+// being encoded as JSON.
+type parquetMarshaler struct {
+	compression compress.Compression
+}
+
+func newParquetS3Marshaler(cfg ParquetConfig, logger *zap.Logger) (*s3Marshaler, error) {
+	codec, ok := parquetCompressionCodecs[cfg.Compression]
+	if !ok {
+		return nil, fmt.Errorf("unknown parquet compression %q", cfg.Compression)
+	}
+	pm := &parquetMarshaler{compression: codec}
+	return &s3Marshaler{
+		logsMarshaler:   pm,
+		tracesMarshaler: pm,
+		logger:          logger,
+		fileFormat:      "parquet",
+		IsCompressed:    false,
+	}, nil
+}
+
+func (m *parquetMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	if ld.LogRecordCount() == 0 {
+		return nil, nil
+	}
+
+	rb := array.NewRecordBuilder(memory.DefaultAllocator, parquetLogsSchema)
+	defer rb.Release()
+
+	timestamp := rb.Field(0).(*array.TimestampBuilder)
+	observedTimestamp := rb.Field(1).(*array.TimestampBuilder)
+	traceID := rb.Field(2).(*array.StringBuilder)
+	spanID := rb.Field(3).(*array.StringBuilder)
+	flags := rb.Field(4).(*array.Uint32Builder)
+	severityText := rb.Field(5).(*array.StringBuilder)
+	severityNumber := rb.Field(6).(*array.Int32Builder)
+	eventName := rb.Field(7).(*array.StringBuilder)
+	body := rb.Field(8).(*array.StringBuilder)
+	attributes := rb.Field(9).(*array.MapBuilder)
+	droppedAttributesCount := rb.Field(10).(*array.Uint32Builder)
+	resourceScope := newParquetResourceScopeBuilder(rb, 11)
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			lrs := sl.LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lr := lrs.At(k)
+				appendParquetTimestamp(timestamp, lr.Timestamp())
+				appendParquetTimestamp(observedTimestamp, lr.ObservedTimestamp())
+				traceID.Append(parquetTraceID(lr.TraceID()))
+				spanID.Append(parquetSpanID(lr.SpanID()))
+				flags.Append(uint32(lr.Flags()))
+				severityText.Append(lr.SeverityText())
+				severityNumber.Append(int32(lr.SeverityNumber()))
+				eventName.Append(lr.EventName())
+				body.Append(lr.Body().AsString())
+				appendParquetAttributes(attributes, lr.Attributes())
+				droppedAttributesCount.Append(lr.DroppedAttributesCount())
+				resourceScope.append(rl.Resource(), rl.SchemaUrl(), sl.Scope(), sl.SchemaUrl())
+			}
+		}
+	}
+
+	return m.write(rb)
+}
+
+func (m *parquetMarshaler) MarshalTraces(td ptrace.Traces) ([]byte, error) {
+	if td.SpanCount() == 0 {
+		return nil, nil
+	}
+
+	rb := array.NewRecordBuilder(memory.DefaultAllocator, parquetTracesSchema)
+	defer rb.Release()
+
+	traceID := rb.Field(0).(*array.StringBuilder)
+	spanID := rb.Field(1).(*array.StringBuilder)
+	parentSpanID := rb.Field(2).(*array.StringBuilder)
+	traceState := rb.Field(3).(*array.StringBuilder)
+	flags := rb.Field(4).(*array.Uint32Builder)
+	name := rb.Field(5).(*array.StringBuilder)
+	kind := rb.Field(6).(*array.StringBuilder)
+	startTimestamp := rb.Field(7).(*array.TimestampBuilder)
+	endTimestamp := rb.Field(8).(*array.TimestampBuilder)
+	attributes := rb.Field(9).(*array.MapBuilder)
+	droppedAttributesCount := rb.Field(10).(*array.Uint32Builder)
+	events := rb.Field(11).(*array.ListBuilder)
+	droppedEventsCount := rb.Field(12).(*array.Uint32Builder)
+	links := rb.Field(13).(*array.ListBuilder)
+	droppedLinksCount := rb.Field(14).(*array.Uint32Builder)
+	statusCode := rb.Field(15).(*array.StringBuilder)
+	statusMessage := rb.Field(16).(*array.StringBuilder)
+	resourceScope := newParquetResourceScopeBuilder(rb, 17)
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				traceID.Append(parquetTraceID(span.TraceID()))
+				spanID.Append(parquetSpanID(span.SpanID()))
+				parentSpanID.Append(parquetSpanID(span.ParentSpanID()))
+				traceState.Append(span.TraceState().AsRaw())
+				flags.Append(span.Flags())
+				name.Append(span.Name())
+				kind.Append(span.Kind().String())
+				appendParquetTimestamp(startTimestamp, span.StartTimestamp())
+				appendParquetTimestamp(endTimestamp, span.EndTimestamp())
+				appendParquetAttributes(attributes, span.Attributes())
+				droppedAttributesCount.Append(span.DroppedAttributesCount())
+				appendParquetSpanEvents(events, span.Events())
+				droppedEventsCount.Append(span.DroppedEventsCount())
+				appendParquetSpanLinks(links, span.Links())
+				droppedLinksCount.Append(span.DroppedLinksCount())
+				statusCode.Append(span.Status().Code().String())
+				statusMessage.Append(span.Status().Message())
+				resourceScope.append(rs.Resource(), rs.SchemaUrl(), ss.Scope(), ss.SchemaUrl())
+			}
+		}
+	}
+
+	return m.write(rb)
+}
+
+func (m *parquetMarshaler) write(rb *array.RecordBuilder) ([]byte, error) {
+	rec := rb.NewRecordBatch()
+	defer rec.Release()
+
+	var buf bytes.Buffer
+	props := parquet.NewWriterProperties(parquet.WithCompression(m.compression))
+	fw, err := pqarrow.NewFileWriter(rec.Schema(), &buf, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create parquet writer: %w", err)
+	}
+	if err := fw.Write(rec); err != nil {
+		_ = fw.Close()
+		return nil, fmt.Errorf("failed to write parquet record: %w", err)
+	}
+	if err := fw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close parquet writer: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+type parquetResourceScopeBuilder struct {
+	resourceAttributes             *array.MapBuilder
+	resourceDroppedAttributesCount *array.Uint32Builder
+	resourceSchemaURL              *array.StringBuilder
+	scopeName                      *array.StringBuilder
+	scopeVersion                   *array.StringBuilder
+	scopeAttributes                *array.MapBuilder
+	scopeDroppedAttributesCount    *array.Uint32Builder
+	scopeSchemaURL                 *array.StringBuilder
+}
+
+// newParquetResourceScopeBuilder returns the builders of the resource and
+// scope columns, starting at the given field index of the record.
+func newParquetResourceScopeBuilder(rb *array.RecordBuilder, offset int) parquetResourceScopeBuilder {
+	return parquetResourceScopeBuilder{
+		resourceAttributes:             rb.Field(offset).(*array.MapBuilder),
+		resourceDroppedAttributesCount: rb.Field(offset + 1).(*array.Uint32Builder),
+		resourceSchemaURL:              rb.Field(offset + 2).(*array.StringBuilder),
+		scopeName:                      rb.Field(offset + 3).(*array.StringBuilder),
+		scopeVersion:                   rb.Field(offset + 4).(*array.StringBuilder),
+		scopeAttributes:                rb.Field(offset + 5).(*array.MapBuilder),
+		scopeDroppedAttributesCount:    rb.Field(offset + 6).(*array.Uint32Builder),
+		scopeSchemaURL:                 rb.Field(offset + 7).(*array.StringBuilder),
+	}
+}
+
+func (b parquetResourceScopeBuilder) append(res pcommon.Resource, resSchemaURL string, scope pcommon.InstrumentationScope, scopeSchemaURL string) {
+	appendParquetAttributes(b.resourceAttributes, res.Attributes())
+	b.resourceDroppedAttributesCount.Append(res.DroppedAttributesCount())
+	b.resourceSchemaURL.Append(resSchemaURL)
+	b.scopeName.Append(scope.Name())
+	b.scopeVersion.Append(scope.Version())
+	appendParquetAttributes(b.scopeAttributes, scope.Attributes())
+	b.scopeDroppedAttributesCount.Append(scope.DroppedAttributesCount())
+	b.scopeSchemaURL.Append(scopeSchemaURL)
+}
+
+func appendParquetSpanEvents(lb *array.ListBuilder, events ptrace.SpanEventSlice) {
+	lb.Append(true)
+	sb := lb.ValueBuilder().(*array.StructBuilder)
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		sb.Append(true)
+		appendParquetTimestamp(sb.FieldBuilder(0).(*array.TimestampBuilder), event.Timestamp())
+		sb.FieldBuilder(1).(*array.StringBuilder).Append(event.Name())
+		appendParquetAttributes(sb.FieldBuilder(2).(*array.MapBuilder), event.Attributes())
+		sb.FieldBuilder(3).(*array.Uint32Builder).Append(event.DroppedAttributesCount())
+	}
+}
+
+func appendParquetSpanLinks(lb *array.ListBuilder, links ptrace.SpanLinkSlice) {
+	lb.Append(true)
+	sb := lb.ValueBuilder().(*array.StructBuilder)
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		sb.Append(true)
+		sb.FieldBuilder(0).(*array.StringBuilder).Append(parquetTraceID(link.TraceID()))
+		sb.FieldBuilder(1).(*array.StringBuilder).Append(parquetSpanID(link.SpanID()))
+		sb.FieldBuilder(2).(*array.StringBuilder).Append(link.TraceState().AsRaw())
+		sb.FieldBuilder(3).(*array.Uint32Builder).Append(link.Flags())
+		appendParquetAttributes(sb.FieldBuilder(4).(*array.MapBuilder), link.Attributes())
+		sb.FieldBuilder(5).(*array.Uint32Builder).Append(link.DroppedAttributesCount())
+	}
+}
+
+func appendParquetAttributes(mb *array.MapBuilder, attrs pcommon.Map) {
+	mb.Append(true)
+	kb := mb.KeyBuilder().(*array.StringBuilder)
+	ib := mb.ItemBuilder().(*array.StringBuilder)
+	for k, v := range attrs.All() {
+		kb.Append(k)
+		ib.Append(v.AsString())
+	}
+}
+
+// appendParquetTimestamp appends the timestamp, or null if it is not set.
+func appendParquetTimestamp(tb *array.TimestampBuilder, ts pcommon.Timestamp) {
+	if ts == 0 {
+		tb.AppendNull()
+		return
+	}
+	tb.Append(arrow.Timestamp(ts))
+}
+
+func parquetTraceID(id pcommon.TraceID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return hex.EncodeToString(id[:])
+}
+
+func parquetSpanID(id pcommon.SpanID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return hex.EncodeToString(id[:])
+}
@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func readParquetTable(t *testing.T, buf []byte) arrow.Table {
+	tbl, err := pqarrow.ReadTable(t.Context(), bytes.NewReader(buf), parquet.NewReaderProperties(memory.DefaultAllocator), pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	t.Cleanup(tbl.Release)
+	return tbl
+}
+
+// parquetColumn returns the first chunk of the named column.
+func parquetColumn(t *testing.T, tbl arrow.Table, name string) arrow.Array {
+	indices := tbl.Schema().FieldIndices(name)
+	require.Len(t, indices, 1, "column %q", name)
+	return tbl.Column(indices[0]).Data().Chunk(0)
+}
+
+func TestNewParquetS3Marshaler(t *testing.T) {
+	for _, compression := range []string{"", "snappy", "zstd", "gzip", "none"} {
+		m, err := newParquetS3Marshaler(ParquetConfig{Compression: compression}, zap.NewNop())
+		require.NoError(t, err)
+		assert.Equal(t, "parquet", m.format())
+		assert.False(t, m.compressed())
+	}
+
+	_, err := newParquetS3Marshaler(ParquetConfig{Compression: "lz4"}, zap.NewNop())
+	assert.EqualError(t, err, `unknown parquet compression "lz4"`)
+}
+
+func TestParquetMarshalLogs(t *testing.T) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.SetSchemaUrl("https://opentelemetry.io/schemas/1.26.0")
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("io.opentelemetry.checkout")
+	sl.Scope().SetVersion("1.2.3")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1700000000000000000))
+	lr.SetTraceID(pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	lr.SetSpanID(pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	lr.SetSeverityText("ERROR")
+	lr.SetSeverityNumber(plog.SeverityNumberError)
+	lr.Body().SetStr("payment failed")
+	lr.Attributes().PutInt("http.response.status_code", 502)
+	lr.Attributes().PutEmptySlice("tags").AppendEmpty().SetStr("a")
+	sl.LogRecords().AppendEmpty().Body().SetEmptyMap().PutStr("key", "value")
+
+	m, err := newParquetS3Marshaler(ParquetConfig{Compression: "zstd"}, zap.NewNop())
+	require.NoError(t, err)
+	buf, err := m.MarshalLogs(logs)
+	require.NoError(t, err)
+
+	tbl := readParquetTable(t, buf)
+	require.Equal(t, int64(2), tbl.NumRows())
+	assertParquetSchema(t, parquetLogsSchema, tbl.Schema())
+
+	timestamps := parquetColumn(t, tbl, "time").(*array.Timestamp)
+	assert.Equal(t, arrow.Timestamp(1700000000000000000), timestamps.Value(0))
+	assert.True(t, timestamps.IsNull(1))
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", parquetColumn(t, tbl, "trace_id").(*array.String).Value(0))
+	assert.Equal(t, "0102030405060708", parquetColumn(t, tbl, "span_id").(*array.String).Value(0))
+	assert.Empty(t, parquetColumn(t, tbl, "trace_id").(*array.String).Value(1))
+	assert.Equal(t, "ERROR", parquetColumn(t, tbl, "severity_text").(*array.String).Value(0))
+	assert.Equal(t, int32(plog.SeverityNumberError), parquetColumn(t, tbl, "severity_number").(*array.Int32).Value(0))
+	bodies := parquetColumn(t, tbl, "body").(*array.String)
+	assert.Equal(t, "payment failed", bodies.Value(0))
+	assert.JSONEq(t, `{"key":"value"}`, bodies.Value(1))
+	assert.Equal(t, map[string]string{
+		"http.response.status_code": "502",
+		"tags":                      `["a"]`,
+	}, parquetMapValue(parquetColumn(t, tbl, "attributes").(*array.Map), 0))
+	assert.Equal(t, map[string]string{"service.name": "checkout"}, parquetMapValue(parquetColumn(t, tbl, "resource_attributes").(*array.Map), 1))
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.26.0", parquetColumn(t, tbl, "resource_schema_url").(*array.String).Value(1))
+	assert.Equal(t, "io.opentelemetry.checkout", parquetColumn(t, tbl, "scope_name").(*array.String).Value(0))
+	assert.Equal(t, "1.2.3", parquetColumn(t, tbl, "scope_version").(*array.String).Value(0))
+}
+
+func TestParquetMarshalTraces(t *testing.T) {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("io.opentelemetry.checkout")
+	span := ss.Spans().AppendEmpty()
+	span.SetTraceID(pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	span.SetSpanID(pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	span.SetName("POST /checkout")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.Timestamp(1700000000000000000))
+	span.SetEndTimestamp(pcommon.Timestamp(1700000001000000000))
+	span.Attributes().PutStr("http.request.method", "POST")
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Status().SetMessage("payment failed")
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.SetTimestamp(pcommon.Timestamp(1700000000500000000))
+	event.Attributes().PutStr("exception.type", "PaymentError")
+	link := span.Links().AppendEmpty()
+	link.SetTraceID(pcommon.TraceID([16]byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}))
+	link.SetSpanID(pcommon.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1}))
+	child := ss.Spans().AppendEmpty()
+	child.SetParentSpanID(span.SpanID())
+	child.SetName("charge")
+
+	m, err := newParquetS3Marshaler(ParquetConfig{}, zap.NewNop())
+	require.NoError(t, err)
+	buf, err := m.MarshalTraces(traces)
+	require.NoError(t, err)
+
+	tbl := readParquetTable(t, buf)
+	require.Equal(t, int64(2), tbl.NumRows())
+	assertParquetSchema(t, parquetTracesSchema, tbl.Schema())
+
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", parquetColumn(t, tbl, "trace_id").(*array.String).Value(0))
+	assert.Equal(t, "0102030405060708", parquetColumn(t, tbl, "parent_span_id").(*array.String).Value(1))
+	assert.Equal(t, "POST /checkout", parquetColumn(t, tbl, "name").(*array.String).Value(0))
+	assert.Equal(t, "Server", parquetColumn(t, tbl, "kind").(*array.String).Value(0))
+	assert.Equal(t, arrow.Timestamp(1700000001000000000), parquetColumn(t, tbl, "end_time").(*array.Timestamp).Value(0))
+	assert.True(t, parquetColumn(t, tbl, "start_time").IsNull(1))
+	assert.Equal(t, "Error", parquetColumn(t, tbl, "status_code").(*array.String).Value(0))
+	assert.Equal(t, "payment failed", parquetColumn(t, tbl, "status_message").(*array.String).Value(0))
+	assert.Equal(t, map[string]string{"http.request.method": "POST"}, parquetMapValue(parquetColumn(t, tbl, "attributes").(*array.Map), 0))
+
+	events := parquetColumn(t, tbl, "events").(*array.List)
+	start, end := events.ValueOffsets(0)
+	require.Equal(t, int64(1), end-start)
+	start, end = events.ValueOffsets(1)
+	require.Equal(t, int64(0), end-start)
+	eventStruct := events.ListValues().(*array.Struct)
+	assert.Equal(t, arrow.Timestamp(1700000000500000000), eventStruct.Field(0).(*array.Timestamp).Value(0))
+	assert.Equal(t, "exception", eventStruct.Field(1).(*array.String).Value(0))
+	assert.Equal(t, map[string]string{"exception.type": "PaymentError"}, parquetMapValue(eventStruct.Field(2).(*array.Map), 0))
+
+	linkStruct := parquetColumn(t, tbl, "links").(*array.List).ListValues().(*array.Struct)
+	require.Equal(t, 1, linkStruct.Len())
+	assert.Equal(t, "100f0e0d0c0b0a090807060504030201", linkStruct.Field(0).(*array.String).Value(0))
+	assert.Equal(t, "0807060504030201", linkStruct.Field(1).(*array.String).Value(0))
+}
+
+func TestParquetMarshalEmpty(t *testing.T) {
+	m, err := newParquetS3Marshaler(ParquetConfig{}, zap.NewNop())
+	require.NoError(t, err)
+
+	buf, err := m.MarshalLogs(plog.NewLogs())
+	require.NoError(t, err)
+	assert.Nil(t, buf)
+
+	buf, err = m.MarshalTraces(ptrace.NewTraces())
+	require.NoError(t, err)
+	assert.Nil(t, buf)
+}
+
+// assertParquetSchema compares the fields of the schemas, ignoring the
+// metadata added by the parquet writer.
+func assertParquetSchema(t *testing.T, expected, actual *arrow.Schema) {
+	require.Equal(t, expected.NumFields(), actual.NumFields())
+	for i, f := range expected.Fields() {
+		assert.Equal(t, f.Name, actual.Field(i).Name)
+		assert.True(t, arrow.TypeEqual(f.Type, actual.Field(i).Type), "field %q: expected %s, got %s", f.Name, f.Type, actual.Field(i).Type)
+	}
+}
+
+func parquetMapValue(m *array.Map, i int) map[string]string {
+	keys := m.Keys().(*array.String)
+	items := m.Items().(*array.String)
+	start, end := m.ValueOffsets(i)
+	values := make(map[string]string, end-start)
+	for j := start; j < end; j++ {
+		values[keys.Value(int(j))] = items.Value(int(j))
+	}
+	return values
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// hiveDefaultPartition is the partition value Hive, Glue and Athena interpret as NULL.
+// It is used when a resource attribute referenced in the prefix template is missing.
+const hiveDefaultPartition = "__HIVE_DEFAULT_PARTITION__"
+
+// prefixTemplate renders an S3 key prefix from resource attributes.
+// Resource attributes are referenced with `{attribute.name}`.
+type prefixTemplate struct {
+	// literals has one more element than attributes, the rendered prefix is
+	// literals[0] + value(attributes[0]) + literals[1] + ... + literals[n].
+	literals   []string
+	attributes []string
+}
+
+func parsePrefixTemplate(tmpl string) (*prefixTemplate, error) {
+	t := &prefixTemplate{}
+	rest := tmpl
+	for {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			t.literals = append(t.literals, rest)
+			break
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed placeholder in %q", tmpl)
+		}
+		attr := strings.TrimSpace(rest[start+1 : start+end])
+		if attr == "" {
+			return nil, fmt.Errorf("empty placeholder in %q", tmpl)
+		}
+		t.literals = append(t.literals, rest[:start])
+		t.attributes = append(t.attributes, attr)
+		rest = rest[start+end+1:]
+	}
+	if len(t.attributes) == 0 {
+		return nil, errors.New("template must reference at least one resource attribute")
+	}
+	return t, nil
+}
+
+// render returns the prefix for the given resource attributes. Slashes in
+// attribute values are replaced so that each value stays a single path segment.
+func (t *prefixTemplate) render(attrs pcommon.Map) string {
+	var sb strings.Builder
+	for i, attr := range t.attributes {
+		sb.WriteString(t.literals[i])
+		value := hiveDefaultPartition
+		if v, ok := attrs.Get(attr); ok && v.AsString() != "" {
+			value = strings.ReplaceAll(v.AsString(), "/", "_")
+		}
+		sb.WriteString(value)
+	}
+	sb.WriteString(t.literals[len(t.literals)-1])
+	return sb.String()
+}
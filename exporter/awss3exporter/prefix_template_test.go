@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestPrefixTemplate(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("service.name", "checkout")
+	attrs.PutStr("service.namespace", "shop/eu")
+	attrs.PutInt("shard", 3)
+	attrs.PutStr("empty", "")
+
+	tests := []struct {
+		name        string
+		template    string
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "hive style",
+			template: "service={service.name}/namespace={service.namespace}",
+			expected: "service=checkout/namespace=shop_eu",
+		},
+		{
+			name:     "literal prefix and suffix",
+			template: "otel/{ service.name }-{shard}/data",
+			expected: "otel/checkout-3/data",
+		},
+		{
+			name:     "missing and empty attributes",
+			template: "env={deployment.environment}/x={empty}",
+			expected: "env=__HIVE_DEFAULT_PARTITION__/x=__HIVE_DEFAULT_PARTITION__",
+		},
+		{
+			name:        "unclosed placeholder",
+			template:    "service={service.name",
+			expectedErr: `unclosed placeholder in "service={service.name"`,
+		},
+		{
+			name:        "empty placeholder",
+			template:    "service={}",
+			expectedErr: `empty placeholder in "service={}"`,
+		},
+		{
+			name:        "no placeholder",
+			template:    "static",
+			expectedErr: "template must reference at least one resource attribute",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := parsePrefixTemplate(tt.template)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, tmpl.render(attrs))
+		})
+	}
+}
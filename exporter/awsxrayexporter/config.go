@@ -22,6 +22,10 @@ type Config struct {
 	IndexAllAttributes bool `mapstructure:"index_all_attributes"`
 
 	LogGroupNames []string `mapstructure:"aws_log_groups"`
+	// Set to true to restore the fields of the X-Ray segments recorded as span attributes by the
+	// X-Ray receiver with segment_fidelity enabled, e.g. the subsegment namespace and the throttle flag.
+	// Default value: false
+	SegmentFidelity bool `mapstructure:"segment_fidelity"`
 	// TelemetryConfig contains the options for telemetry collection.
 	TelemetryConfig telemetry.Config `mapstructure:"telemetry,omitempty"`
 
@@ -52,6 +52,14 @@ func TestLoadConfig(t *testing.T) {
 				skipTimestampValidation: false,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "segment_fidelity"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.SegmentFidelity = true
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
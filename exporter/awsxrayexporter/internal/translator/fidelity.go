@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translator // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter/internal/translator"
+
+import (
+	awsP "github.com/aws/aws-sdk-go-v2/aws"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	awsxray "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray"
+)
+
+// segmentFieldAttributes are the attributes recorded by the X-Ray receiver for
+// the segment fields which have no equivalent in the span.
+var segmentFieldAttributes = []string{
+	awsxray.AWSXRayNamespaceAttribute,
+	awsxray.AWSXRayFaultAttribute,
+	awsxray.AWSXRayErrorAttribute,
+	awsxray.AWSXRayThrottleAttribute,
+	awsxray.AWSXRayOriginAttribute,
+	awsxray.AWSXRayPrecursorIDsAttribute,
+	awsxray.AWSXRayTracedAttribute,
+	awsxray.AWSXRayResourceARNAttribute,
+}
+
+// restoreSegmentFields overrides the fields of the segment derived from the
+// span with the original values of the segment the span was received as, and
+// removes their attributes from the annotations and metadata.
+func restoreSegmentFields(span ptrace.Span, segment *awsxray.Segment) {
+	attributes := span.Attributes()
+	if v, ok := attributes.Get(awsxray.AWSXRayNamespaceAttribute); ok && v.Type() == pcommon.ValueTypeStr {
+		segment.Namespace = awsxray.String(v.Str())
+	}
+	if v, ok := attributes.Get(awsxray.AWSXRayFaultAttribute); ok && v.Type() == pcommon.ValueTypeBool {
+		segment.Fault = awsP.Bool(v.Bool())
+	}
+	if v, ok := attributes.Get(awsxray.AWSXRayErrorAttribute); ok && v.Type() == pcommon.ValueTypeBool {
+		segment.Error = awsP.Bool(v.Bool())
+	}
+	if v, ok := attributes.Get(awsxray.AWSXRayThrottleAttribute); ok && v.Type() == pcommon.ValueTypeBool {
+		segment.Throttle = awsP.Bool(v.Bool())
+	}
+	if v, ok := attributes.Get(awsxray.AWSXRayOriginAttribute); ok && v.Type() == pcommon.ValueTypeStr {
+		segment.Origin = awsxray.String(v.Str())
+	}
+	if v, ok := attributes.Get(awsxray.AWSXRayPrecursorIDsAttribute); ok && v.Type() == pcommon.ValueTypeSlice {
+		precursorIDs := make([]string, 0, v.Slice().Len())
+		for _, id := range v.Slice().All() {
+			precursorIDs = append(precursorIDs, id.AsString())
+		}
+		segment.PrecursorIDs = precursorIDs
+	}
+	if v, ok := attributes.Get(awsxray.AWSXRayTracedAttribute); ok && v.Type() == pcommon.ValueTypeBool {
+		segment.Traced = awsP.Bool(v.Bool())
+	}
+	if v, ok := attributes.Get(awsxray.AWSXRayResourceARNAttribute); ok && v.Type() == pcommon.ValueTypeStr {
+		segment.ResourceARN = awsxray.String(v.Str())
+	}
+
+	defaultMetadata := segment.Metadata[defaultMetadataNamespace]
+	for _, key := range segmentFieldAttributes {
+		delete(defaultMetadata, key)
+		delete(segment.Annotations, fixAnnotationKey(key))
+	}
+	if len(defaultMetadata) == 0 {
+		delete(segment.Metadata, defaultMetadataNamespace)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	awsxray "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray"
+)
+
+func constructFidelitySpan() ptrace.Span {
+	attributes := map[string]any{
+		awsxray.AWSXRayNamespaceAttribute:    "local",
+		awsxray.AWSXRayFaultAttribute:        false,
+		awsxray.AWSXRayErrorAttribute:        true,
+		awsxray.AWSXRayThrottleAttribute:     true,
+		awsxray.AWSXRayOriginAttribute:       "AWS::EC2::Instance",
+		awsxray.AWSXRayPrecursorIDsAttribute: []string{"e0c4d5a6f1f2e3b4"},
+		awsxray.AWSXRayTracedAttribute:       true,
+		"attr1":                              "val1",
+	}
+	// The status of the span only approximates the flags of the segment
+	return constructClientSpan(newSegmentID(), "compute", ptrace.StatusCodeError, "", attributes)
+}
+
+func makeSegmentFromDocuments(t *testing.T, documents []string) *awsxray.Segment {
+	require.Len(t, documents, 1)
+	segment := &awsxray.Segment{}
+	require.NoError(t, json.Unmarshal([]byte(documents[0]), segment))
+	return segment
+}
+
+func TestSegmentFidelity(t *testing.T) {
+	documents, err := MakeSegmentDocuments(constructFidelitySpan(), constructDefaultResource(), nil, false, nil, false, true)
+	require.NoError(t, err)
+	segment := makeSegmentFromDocuments(t, documents)
+
+	assert.Equal(t, "local", *segment.Namespace)
+	assert.False(t, *segment.Fault)
+	assert.True(t, *segment.Error)
+	assert.True(t, *segment.Throttle)
+	assert.Equal(t, "AWS::EC2::Instance", *segment.Origin)
+	assert.Equal(t, []string{"e0c4d5a6f1f2e3b4"}, segment.PrecursorIDs)
+	assert.True(t, *segment.Traced)
+	assert.Equal(t, map[string]map[string]any{"default": {"attr1": "val1"}}, segment.Metadata)
+}
+
+func TestSegmentFidelityIndexAllAttributes(t *testing.T) {
+	documents, err := MakeSegmentDocuments(constructFidelitySpan(), constructDefaultResource(), nil, true, nil, false, true)
+	require.NoError(t, err)
+	segment := makeSegmentFromDocuments(t, documents)
+
+	assert.Equal(t, "local", *segment.Namespace)
+	assert.Equal(t, map[string]any{"attr1": "val1"}, segment.Annotations)
+	assert.Empty(t, segment.Metadata)
+}
+
+func TestSegmentFidelityDisabled(t *testing.T) {
+	documents, err := MakeSegmentDocuments(constructFidelitySpan(), constructDefaultResource(), nil, false, nil, false, false)
+	require.NoError(t, err)
+	segment := makeSegmentFromDocuments(t, documents)
+
+	assert.Equal(t, "remote", *segment.Namespace)
+	assert.True(t, *segment.Fault)
+	assert.False(t, *segment.Throttle)
+	assert.Nil(t, segment.PrecursorIDs)
+	assert.Equal(t, "local", segment.Metadata["default"][awsxray.AWSXRayNamespaceAttribute])
+}
@@ -90,13 +90,19 @@ var removeAnnotationsFromServiceSegment = []string{
 
 var writers = newWriterPool(2048)
 
-// MakeSegmentDocuments converts spans to json documents
-func MakeSegmentDocuments(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation bool) ([]string, error) {
+// MakeSegmentDocuments converts spans to json documents. With segmentFidelity, the
+// fields of the segment recorded in the attributes by the X-Ray receiver are restored.
+func MakeSegmentDocuments(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation, segmentFidelity bool) ([]string, error) {
 	segments, err := MakeSegmentsFromSpan(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation)
 
 	if err == nil {
 		var documents []string
 
+		// A span split in several segments was not received as an X-Ray segment
+		if segmentFidelity && len(segments) == 1 {
+			restoreSegmentFields(span, segments[0])
+		}
+
 		for _, v := range segments {
 			document, documentErr := MakeDocumentFromSegment(v)
 			if documentErr != nil {
@@ -0,0 +1,239 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clickhouseexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// SchemaConfig defines a user supplied schema for a table.
+type SchemaConfig struct {
+	// CreateTableSQL is the DDL executed instead of the built-in one when
+	// `create_schema` is enabled. It is executed verbatim and must create
+	// the configured table in the configured database.
+	CreateTableSQL string `mapstructure:"create_table_sql"`
+	// Columns maps attributes to additional columns of the table. The values
+	// of mapped columns are inserted alongside the built-in columns.
+	Columns []ColumnMapping `mapstructure:"columns"`
+}
+
+// ColumnMapping maps an attribute to a column of the table.
+type ColumnMapping struct {
+	// Name is the name of the column.
+	Name string `mapstructure:"name"`
+	// Type is the ClickHouse type of the column. Supported types are String,
+	// Bool, Int8 to Int64, UInt8 to UInt64, Float32 and Float64, optionally
+	// wrapped in LowCardinality and/or Nullable.
+	Type string `mapstructure:"type"`
+	// Attribute is the key of the attribute inserted in the column.
+	Attribute string `mapstructure:"attribute"`
+	// Source is where the attribute is looked up: `resource`, `scope` or
+	// `record` for log record or span attributes. Default is `record`.
+	Source AttributeSource `mapstructure:"source"`
+}
+
+// AttributeSource defines where the attribute of a mapped column is looked up.
+type AttributeSource string
+
+const (
+	AttributeSourceResource AttributeSource = "resource"
+	AttributeSourceScope    AttributeSource = "scope"
+	AttributeSourceRecord   AttributeSource = "record"
+)
+
+var supportedColumnTypes = map[string]struct{}{
+	"String": {}, "Bool": {},
+	"Int8": {}, "Int16": {}, "Int32": {}, "Int64": {},
+	"UInt8": {}, "UInt16": {}, "UInt32": {}, "UInt64": {},
+	"Float32": {}, "Float64": {},
+}
+
+// Validate the schema configuration.
+func (s *SchemaConfig) Validate() error {
+	var err error
+	names := make(map[string]struct{}, len(s.Columns))
+	for i, c := range s.Columns {
+		if c.Name == "" {
+			err = errors.Join(err, fmt.Errorf("columns[%d]: name must be specified", i))
+		} else if _, ok := names[c.Name]; ok {
+			err = errors.Join(err, fmt.Errorf("columns[%d]: duplicate column %q", i, c.Name))
+		}
+		names[c.Name] = struct{}{}
+
+		if c.Attribute == "" {
+			err = errors.Join(err, fmt.Errorf("columns[%d]: attribute must be specified", i))
+		}
+		if _, ok := supportedColumnTypes[c.baseType()]; !ok {
+			err = errors.Join(err, fmt.Errorf("columns[%d]: unsupported type %q", i, c.Type))
+		}
+		switch c.Source {
+		case "", AttributeSourceResource, AttributeSourceScope, AttributeSourceRecord:
+		default:
+			err = errors.Join(err, fmt.Errorf("columns[%d]: invalid source %q, expected one of resource, scope, record", i, c.Source))
+		}
+	}
+	return err
+}
+
+// isSet returns true if a custom schema is configured.
+func (s *SchemaConfig) isSet() bool {
+	return s.CreateTableSQL != "" || len(s.Columns) > 0
+}
+
+// nullable returns true if the column type is wrapped in Nullable.
+func (c ColumnMapping) nullable() bool {
+	_, nullable := c.unwrapType()
+	return nullable
+}
+
+// baseType returns the column type without the LowCardinality and Nullable wrappers.
+func (c ColumnMapping) baseType() string {
+	typ, _ := c.unwrapType()
+	return typ
+}
+
+func (c ColumnMapping) unwrapType() (typ string, nullable bool) {
+	typ = strings.TrimSpace(c.Type)
+	for {
+		switch {
+		case strings.HasPrefix(typ, "LowCardinality(") && strings.HasSuffix(typ, ")"):
+			typ = typ[len("LowCardinality(") : len(typ)-1]
+		case strings.HasPrefix(typ, "Nullable(") && strings.HasSuffix(typ, ")"):
+			typ = typ[len("Nullable(") : len(typ)-1]
+			nullable = true
+		default:
+			return typ, nullable
+		}
+	}
+}
+
+// value returns the value inserted in the column for the given attributes,
+// converted to the Go type expected by the ClickHouse driver. Missing
+// attributes are inserted as NULL in nullable columns, and as the zero
+// value of the type otherwise.
+func (c ColumnMapping) value(resAttrs, scopeAttrs, recordAttrs pcommon.Map) any {
+	attrs := recordAttrs
+	switch c.Source {
+	case AttributeSourceResource:
+		attrs = resAttrs
+	case AttributeSourceScope:
+		attrs = scopeAttrs
+	}
+
+	v, ok := attrs.Get(c.Attribute)
+	if !ok && c.nullable() {
+		return nil
+	}
+	if !ok {
+		v = pcommon.NewValueEmpty()
+	}
+
+	switch c.baseType() {
+	case "Bool":
+		return attributeAsBool(v)
+	case "Int8":
+		return int8(attributeAsInt(v))
+	case "Int16":
+		return int16(attributeAsInt(v))
+	case "Int32":
+		return int32(attributeAsInt(v))
+	case "Int64":
+		return attributeAsInt(v)
+	case "UInt8":
+		return uint8(attributeAsInt(v))
+	case "UInt16":
+		return uint16(attributeAsInt(v))
+	case "UInt32":
+		return uint32(attributeAsInt(v))
+	case "UInt64":
+		return uint64(attributeAsInt(v))
+	case "Float32":
+		return float32(attributeAsFloat(v))
+	case "Float64":
+		return attributeAsFloat(v)
+	default:
+		if v.Type() == pcommon.ValueTypeEmpty {
+			return ""
+		}
+		return v.AsString()
+	}
+}
+
+func attributeAsBool(v pcommon.Value) bool {
+	switch v.Type() {
+	case pcommon.ValueTypeBool:
+		return v.Bool()
+	case pcommon.ValueTypeStr:
+		b, _ := strconv.ParseBool(v.Str())
+		return b
+	case pcommon.ValueTypeInt:
+		return v.Int() != 0
+	}
+	return false
+}
+
+func attributeAsInt(v pcommon.Value) int64 {
+	switch v.Type() {
+	case pcommon.ValueTypeInt:
+		return v.Int()
+	case pcommon.ValueTypeDouble:
+		return int64(math.Trunc(v.Double()))
+	case pcommon.ValueTypeBool:
+		if v.Bool() {
+			return 1
+		}
+	case pcommon.ValueTypeStr:
+		i, _ := strconv.ParseInt(v.Str(), 10, 64)
+		return i
+	}
+	return 0
+}
+
+func attributeAsFloat(v pcommon.Value) float64 {
+	switch v.Type() {
+	case pcommon.ValueTypeDouble:
+		return v.Double()
+	case pcommon.ValueTypeInt:
+		return float64(v.Int())
+	case pcommon.ValueTypeStr:
+		f, _ := strconv.ParseFloat(v.Str(), 64)
+		return f
+	}
+	return 0
+}
+
+// renderColumnMappingSQL renders the column names and value placeholders of
+// the mapped columns to append to an insert statement.
+func renderColumnMappingSQL(columns []ColumnMapping) (names, placeholders string) {
+	var namesBuilder, placeholdersBuilder strings.Builder
+	for _, c := range columns {
+		namesBuilder.WriteString(", ")
+		namesBuilder.WriteString(strconv.Quote(c.Name))
+		placeholdersBuilder.WriteString(", ?")
+	}
+	return namesBuilder.String(), placeholdersBuilder.String()
+}
+
+// validateColumnMappings checks that the mapped columns exist in the table
+// with the configured type. tableColumns maps column names to their type.
+func validateColumnMappings(table string, columns []ColumnMapping, tableColumns map[string]string) error {
+	var err error
+	for _, c := range columns {
+		typ, ok := tableColumns[c.Name]
+		if !ok {
+			err = errors.Join(err, fmt.Errorf("column %q does not exist in table %q", c.Name, table))
+			continue
+		}
+		if typ != strings.TrimSpace(c.Type) {
+			err = errors.Join(err, fmt.Errorf("column %q of table %q has type %q, configured type is %q", c.Name, table, typ, c.Type))
+		}
+	}
+	return err
+}
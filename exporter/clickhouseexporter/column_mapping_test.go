@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clickhouseexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+func TestSchemaConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		columns     []ColumnMapping
+		expectedErr []string
+	}{
+		{
+			name: "valid",
+			columns: []ColumnMapping{
+				{Name: "Namespace", Type: "LowCardinality(String)", Attribute: "k8s.namespace.name", Source: AttributeSourceResource},
+				{Name: "StatusCode", Type: "Nullable(UInt16)", Attribute: "http.response.status_code"},
+				{Name: "Library", Type: "LowCardinality(Nullable(String))", Attribute: "library", Source: AttributeSourceScope},
+			},
+		},
+		{
+			name: "invalid",
+			columns: []ColumnMapping{
+				{Type: "String", Attribute: "foo"},
+				{Name: "Foo", Type: "Array(String)", Attribute: "foo"},
+				{Name: "Foo", Type: "String", Source: "span"},
+			},
+			expectedErr: []string{
+				"columns[0]: name must be specified",
+				`columns[1]: unsupported type "Array(String)"`,
+				`columns[2]: duplicate column "Foo"`,
+				"columns[2]: attribute must be specified",
+				`columns[2]: invalid source "span", expected one of resource, scope, record`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&SchemaConfig{Columns: tt.columns}).Validate()
+			if len(tt.expectedErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, expected := range tt.expectedErr {
+				assert.ErrorContains(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestColumnMappingValue(t *testing.T) {
+	resAttrs := pcommon.NewMap()
+	resAttrs.PutStr("k8s.namespace.name", "default")
+	scopeAttrs := pcommon.NewMap()
+	scopeAttrs.PutBool("internal", true)
+	recordAttrs := pcommon.NewMap()
+	recordAttrs.PutInt("http.response.status_code", 404)
+	recordAttrs.PutStr("retries", "3")
+	recordAttrs.PutDouble("ratio", 0.5)
+	recordAttrs.PutEmptySlice("tags").AppendEmpty().SetStr("a")
+
+	tests := []struct {
+		name     string
+		column   ColumnMapping
+		expected any
+	}{
+		{
+			name:     "resource string",
+			column:   ColumnMapping{Type: "LowCardinality(String)", Attribute: "k8s.namespace.name", Source: AttributeSourceResource},
+			expected: "default",
+		},
+		{
+			name:     "scope bool",
+			column:   ColumnMapping{Type: "Bool", Attribute: "internal", Source: AttributeSourceScope},
+			expected: true,
+		},
+		{
+			name:     "record int",
+			column:   ColumnMapping{Type: "UInt16", Attribute: "http.response.status_code"},
+			expected: uint16(404),
+		},
+		{
+			name:     "record int from string",
+			column:   ColumnMapping{Type: "Int32", Attribute: "retries", Source: AttributeSourceRecord},
+			expected: int32(3),
+		},
+		{
+			name:     "record float",
+			column:   ColumnMapping{Type: "Float32", Attribute: "ratio"},
+			expected: float32(0.5),
+		},
+		{
+			name:     "record int as string",
+			column:   ColumnMapping{Type: "String", Attribute: "http.response.status_code"},
+			expected: "404",
+		},
+		{
+			name:     "record slice as string",
+			column:   ColumnMapping{Type: "String", Attribute: "tags"},
+			expected: `["a"]`,
+		},
+		{
+			name:     "missing attribute",
+			column:   ColumnMapping{Type: "Int64", Attribute: "missing"},
+			expected: int64(0),
+		},
+		{
+			name:     "missing string attribute",
+			column:   ColumnMapping{Type: "String", Attribute: "missing"},
+			expected: "",
+		},
+		{
+			name:     "missing nullable attribute",
+			column:   ColumnMapping{Type: "Nullable(Float64)", Attribute: "missing"},
+			expected: nil,
+		},
+		{
+			name:     "nullable attribute",
+			column:   ColumnMapping{Type: "Nullable(Float64)", Attribute: "ratio"},
+			expected: 0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.column.value(resAttrs, scopeAttrs, recordAttrs))
+		})
+	}
+}
+
+func TestValidateColumnMappings(t *testing.T) {
+	columns := []ColumnMapping{
+		{Name: "Namespace", Type: "LowCardinality(String)", Attribute: "k8s.namespace.name"},
+		{Name: "StatusCode", Type: "UInt16", Attribute: "http.response.status_code"},
+		{Name: "Route", Type: "String", Attribute: "http.route"},
+	}
+
+	require.NoError(t, validateColumnMappings("otel_logs", columns, map[string]string{
+		"Timestamp":  "DateTime64(9)",
+		"Namespace":  "LowCardinality(String)",
+		"StatusCode": "UInt16",
+		"Route":      "String",
+	}))
+
+	err := validateColumnMappings("otel_logs", columns, map[string]string{
+		"Namespace":  "LowCardinality(String)",
+		"StatusCode": "UInt32",
+	})
+	assert.ErrorContains(t, err, `column "StatusCode" of table "otel_logs" has type "UInt32", configured type is "UInt16"`)
+	assert.ErrorContains(t, err, `column "Route" does not exist in table "otel_logs"`)
+}
+
+func TestRenderInsertSQLWithColumnMapping(t *testing.T) {
+	columns := []ColumnMapping{
+		{Name: "Namespace", Type: "String", Attribute: "k8s.namespace.name"},
+		{Name: "StatusCode", Type: "UInt16", Attribute: "http.response.status_code"},
+	}
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoint = defaultEndpoint
+		cfg.LogsSchema.Columns = columns
+		cfg.TracesSchema.Columns = columns
+	})
+
+	logs := newLogsExporter(zap.NewNop(), cfg)
+	logs.schemaFeatures.EventName = true
+	logs.renderInsertLogsSQL()
+	assert.Contains(t, logs.insertSQL, `, EventName, "Namespace", "StatusCode"`)
+	assert.Contains(t, logs.insertSQL, "?, ?, ?\n)")
+
+	traces := newTracesExporter(zap.NewNop(), cfg)
+	assert.Contains(t, traces.insertSQL, `Links.Attributes
+    , "Namespace", "StatusCode"
+)`)
+	assert.Contains(t, traces.insertSQL, "    ?\n    , ?, ?\n)")
+}
+
+func TestRenderCreateTableSQLWithCustomSchema(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoint = defaultEndpoint
+		cfg.LogsSchema.CreateTableSQL = "CREATE TABLE custom_logs"
+		cfg.TracesSchema.CreateTableSQL = "CREATE TABLE custom_traces"
+	})
+
+	assert.Equal(t, "CREATE TABLE custom_logs", renderCreateLogsTableSQL(cfg))
+	assert.Equal(t, "CREATE TABLE custom_traces", renderCreateTracesTableSQL(cfg))
+	assert.Contains(t, renderCreateLogsTableSQL(withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoint = defaultEndpoint
+	})), `CREATE TABLE IF NOT EXISTS "default"."otel_logs"`)
+}
@@ -67,8 +67,18 @@ type Config struct {
 	// Ignored if async inserts are configured in the `endpoint` or `connection_params`.
 	// Async inserts may still be overridden server-side.
 	AsyncInsert bool `mapstructure:"async_insert"`
+	// AsyncInsertWait if true will wait for async inserts to be written to the table before acknowledging them. Default is `true`.
+	// Ignored if `wait_for_async_insert` is configured in the `endpoint` or `connection_params`.
+	AsyncInsertWait bool `mapstructure:"async_insert_wait"`
+	// InsertBlockSize is the number of rows after which a batch is streamed to the server as a block when using the native protocol.
+	// 0 sends the whole batch as a single block. Default is `10000`.
+	InsertBlockSize int `mapstructure:"insert_block_size"`
 	// MetricsTables defines the table names for metric types.
 	MetricsTables MetricTablesConfig `mapstructure:"metrics_tables"`
+	// LogsSchema defines a custom schema for the logs table.
+	LogsSchema SchemaConfig `mapstructure:"logs_schema"`
+	// TracesSchema defines a custom schema for the traces table.
+	TracesSchema SchemaConfig `mapstructure:"traces_schema"`
 }
 
 type MetricTablesConfig struct {
@@ -99,11 +109,14 @@ const (
 	defaultSummarySuffix      = "_summary"
 	defaultHistogramSuffix    = "_histogram"
 	defaultExpHistogramSuffix = "_exponential_histogram"
+	defaultInsertBlockSize    = 10000
 )
 
 var (
 	errConfigNoEndpoint      = errors.New("endpoint must be specified")
 	errConfigInvalidEndpoint = errors.New("endpoint must be url format")
+	errConfigInsertBlockSize = errors.New("insert_block_size must not be negative")
+	errConfigSchemaJSON      = errors.New("logs_schema and traces_schema are not supported by the JSON exporters of the clickhouse.json feature gate")
 )
 
 func createDefaultConfig() component.Config {
@@ -120,6 +133,8 @@ func createDefaultConfig() component.Config {
 		TTL:              0,
 		CreateSchema:     true,
 		AsyncInsert:      true,
+		AsyncInsertWait:  true,
+		InsertBlockSize:  defaultInsertBlockSize,
 		MetricsTables: MetricTablesConfig{
 			Gauge:                metrics.MetricTypeConfig{Name: defaultMetricTableName + defaultGaugeSuffix},
 			Sum:                  metrics.MetricTypeConfig{Name: defaultMetricTableName + defaultSumSuffix},
@@ -136,6 +151,14 @@ func (cfg *Config) Validate() (err error) {
 		err = errors.Join(err, errConfigNoEndpoint)
 	}
 
+	if cfg.InsertBlockSize < 0 {
+		err = errors.Join(err, errConfigInsertBlockSize)
+	}
+
+	if featureGateJSON.IsEnabled() && (cfg.LogsSchema.isSet() || cfg.TracesSchema.isSet()) {
+		err = errors.Join(err, errConfigSchemaJSON)
+	}
+
 	dsn, e := cfg.buildDSN()
 	if e != nil {
 		err = errors.Join(err, e)
@@ -175,6 +198,11 @@ func (cfg *Config) buildDSN() (string, error) {
 		queryParams.Set("async_insert", fmt.Sprintf("%t", cfg.AsyncInsert))
 	}
 
+	// Only override the server default of waiting for async inserts when disabled in config.
+	if !queryParams.Has("wait_for_async_insert") && !cfg.AsyncInsertWait {
+		queryParams.Set("wait_for_async_insert", "false")
+	}
+
 	if !queryParams.Has("compress") && (cfg.Compress == "" || cfg.Compress == "true") {
 		queryParams.Set("compress", "lz4")
 	} else if !queryParams.Has("compress") {
@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/featuregate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal/metrics"
@@ -86,7 +87,9 @@ func TestLoadConfig(t *testing.T) {
 					queue.StorageID = &storageID
 					return queue
 				}()),
-				AsyncInsert: true,
+				AsyncInsert:     true,
+				AsyncInsertWait: false,
+				InsertBlockSize: 50000,
 				TLS: configtls.ClientConfig{
 					Config: configtls.Config{
 						CertFile: "client.crt",
@@ -95,6 +98,24 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom-schema"),
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoint = defaultEndpoint
+				cfg.LogsSchema = SchemaConfig{
+					CreateTableSQL: `CREATE TABLE IF NOT EXISTS "default"."otel_logs" (...) ENGINE = MergeTree() ORDER BY Timestamp`,
+					Columns: []ColumnMapping{
+						{Name: "K8sNamespace", Type: "LowCardinality(String)", Attribute: "k8s.namespace.name", Source: AttributeSourceResource},
+						{Name: "HttpStatusCode", Type: "Nullable(UInt16)", Attribute: "http.response.status_code"},
+					},
+				}
+				cfg.TracesSchema = SchemaConfig{
+					Columns: []ColumnMapping{
+						{Name: "HttpRoute", Type: "String", Attribute: "http.route"},
+					},
+				}
+			}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -246,6 +267,7 @@ func TestConfig_buildDSN(t *testing.T) {
 		Compress         string
 		ConnectionParams map[string]string
 		AsyncInsert      *bool
+		AsyncInsertWait  *bool
 	}
 	mergeConfigWithFields := func(cfg *Config, fields fields) {
 		if fields.Endpoint != "" {
@@ -269,6 +291,9 @@ func TestConfig_buildDSN(t *testing.T) {
 		if fields.AsyncInsert != nil {
 			cfg.AsyncInsert = *fields.AsyncInsert
 		}
+		if fields.AsyncInsertWait != nil {
+			cfg.AsyncInsertWait = *fields.AsyncInsertWait
+		}
 	}
 
 	type ChOptions struct {
@@ -477,6 +502,24 @@ func TestConfig_buildDSN(t *testing.T) {
 
 			want: "tcp://127.0.0.1:9000?async_insert=true&client_info_product=otelcol%2Ftest&compress=lz4",
 		},
+		{
+			name: "disable waiting for async inserts when async_insert_wait is false",
+			fields: fields{
+				Endpoint:        "tcp://127.0.0.1:9000",
+				AsyncInsertWait: &configFalse,
+			},
+
+			want: "tcp://127.0.0.1:9000?async_insert=true&client_info_product=otelcol%2Ftest&compress=lz4&wait_for_async_insert=false",
+		},
+		{
+			name: "ignore async_insert_wait option when wait_for_async_insert is present in DSN",
+			fields: fields{
+				Endpoint:        "tcp://127.0.0.1:9000?wait_for_async_insert=1",
+				AsyncInsertWait: &configFalse,
+			},
+
+			want: "tcp://127.0.0.1:9000?async_insert=true&client_info_product=otelcol%2Ftest&compress=lz4&wait_for_async_insert=1",
+		},
 		{
 			name: "use compress br config option when it is not present in DSN",
 			fields: fields{
@@ -787,3 +830,34 @@ func TestBuildClickHouseOptions_WithCAFileOnly(t *testing.T) {
 	// No panic, but options may be nil since TLS setup failed early.
 	require.Nil(t, opt, "expected nil options when TLS setup fails cleanly")
 }
+
+func TestConfigValidateInsertBlockSize(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoint = defaultEndpoint
+		cfg.InsertBlockSize = 0
+	})
+	assert.NoError(t, xconfmap.Validate(cfg))
+
+	cfg.InsertBlockSize = -1
+	assert.ErrorIs(t, xconfmap.Validate(cfg), errConfigInsertBlockSize)
+}
+
+func TestConfigValidateSchemaJSON(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoint = defaultEndpoint
+		cfg.LogsSchema.Columns = []ColumnMapping{{Name: "service", Type: "String", Attribute: "service.name"}}
+		cfg.TracesSchema.CreateTableSQL = "CREATE TABLE otel_traces (...)"
+	})
+	assert.NoError(t, xconfmap.Validate(cfg))
+
+	gatePrev := featureGateJSON.IsEnabled()
+	require.NoError(t, featuregate.GlobalRegistry().Set(featureGateJSON.ID(), true))
+	defer func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(featureGateJSON.ID(), gatePrev))
+	}()
+	assert.ErrorIs(t, xconfmap.Validate(cfg), errConfigSchemaJSON)
+
+	cfg.LogsSchema = SchemaConfig{}
+	cfg.TracesSchema = SchemaConfig{}
+	assert.NoError(t, xconfmap.Validate(cfg))
+}
@@ -73,18 +73,16 @@ const (
 )
 
 func (e *logsExporter) detectSchemaFeatures(ctx context.Context) error {
-	columnNames, err := internal.GetTableColumns(ctx, e.db, e.cfg.database(), e.cfg.LogsTableName)
+	columnTypes, err := internal.GetTableColumnTypes(ctx, e.db, e.cfg.database(), e.cfg.LogsTableName)
 	if err != nil {
 		return err
 	}
 
-	for _, name := range columnNames {
-		if name == logsColumnEventName {
-			e.schemaFeatures.EventName = true
-		}
+	if _, ok := columnTypes[logsColumnEventName]; ok {
+		e.schemaFeatures.EventName = true
 	}
 
-	return nil
+	return validateColumnMappings(e.cfg.LogsTableName, e.cfg.LogsSchema.Columns, columnTypes)
 }
 
 func (e *logsExporter) shutdown(_ context.Context) error {
@@ -161,6 +159,9 @@ func (e *logsExporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
 				if e.schemaFeatures.EventName {
 					columnValues = append(columnValues, r.EventName())
 				}
+				for _, c := range e.cfg.LogsSchema.Columns {
+					columnValues = append(columnValues, c.value(resAttr, scopeLogScope.Attributes(), r.Attributes()))
+				}
 
 				appendErr := batch.Append(columnValues...)
 				if appendErr != nil {
@@ -168,6 +169,10 @@ func (e *logsExporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
 				}
 
 				logCount++
+
+				if flushErr := internal.FlushFullBlock(batch, logCount, e.cfg.InsertBlockSize); flushErr != nil {
+					return fmt.Errorf("failed to stream log rows: %w", flushErr)
+				}
 			}
 		}
 	}
@@ -200,10 +205,18 @@ func (e *logsExporter) renderInsertLogsSQL() {
 		featureColumnPositions.WriteString(", ?")
 	}
 
+	mappedColumnNames, mappedColumnPositions := renderColumnMappingSQL(e.cfg.LogsSchema.Columns)
+	featureColumnNames.WriteString(mappedColumnNames)
+	featureColumnPositions.WriteString(mappedColumnPositions)
+
 	e.insertSQL = fmt.Sprintf(sqltemplates.LogsInsert, e.cfg.database(), e.cfg.LogsTableName, featureColumnNames.String(), featureColumnPositions.String())
 }
 
 func renderCreateLogsTableSQL(cfg *Config) string {
+	if cfg.LogsSchema.CreateTableSQL != "" {
+		return cfg.LogsSchema.CreateTableSQL
+	}
+
 	ttlExpr := internal.GenerateTTLExpr(cfg.TTL, "TimestampTime")
 	return fmt.Sprintf(sqltemplates.LogsCreateTable,
 		cfg.database(), cfg.LogsTableName, cfg.clusterString(),
@@ -215,6 +215,10 @@ func (e *logsJSONExporter) pushLogsData(ctx context.Context, ld plog.Logs) error
 				}
 
 				logCount++
+
+				if flushErr := internal.FlushFullBlock(batch, logCount, e.cfg.InsertBlockSize); flushErr != nil {
+					return fmt.Errorf("failed to stream log rows: %w", flushErr)
+				}
 			}
 		}
 	}
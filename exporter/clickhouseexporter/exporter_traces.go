@@ -56,6 +56,16 @@ func (e *tracesExporter) start(ctx context.Context, _ component.Host) error {
 		}
 	}
 
+	if len(e.cfg.TracesSchema.Columns) > 0 {
+		columnTypes, err := internal.GetTableColumnTypes(ctx, e.db, e.cfg.database(), e.cfg.TracesTableName)
+		if err != nil {
+			return fmt.Errorf("schema detection: %w", err)
+		}
+		if err := validateColumnMappings(e.cfg.TracesTableName, e.cfg.TracesSchema.Columns, columnTypes); err != nil {
+			return fmt.Errorf("schema detection: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -108,7 +118,8 @@ func (e *tracesExporter) pushTraceData(ctx context.Context, td ptrace.Traces) er
 				eventTimes, eventNames, eventAttrs := convertEvents(span.Events())
 				linksTraceIDs, linksSpanIDs, linksTraceStates, linksAttrs := convertLinks(span.Links())
 
-				appendErr := batch.Append(
+				columnValues := make([]any, 0, 22+len(e.cfg.TracesSchema.Columns))
+				columnValues = append(columnValues,
 					span.StartTimestamp().AsTime(),
 					traceutil.TraceIDToHexOrEmptyString(span.TraceID()),
 					traceutil.SpanIDToHexOrEmptyString(span.SpanID()),
@@ -132,11 +143,20 @@ func (e *tracesExporter) pushTraceData(ctx context.Context, td ptrace.Traces) er
 					linksTraceStates,
 					linksAttrs,
 				)
+				for _, c := range e.cfg.TracesSchema.Columns {
+					columnValues = append(columnValues, c.value(resAttr, scopeSpanScope.Attributes(), span.Attributes()))
+				}
+
+				appendErr := batch.Append(columnValues...)
 				if appendErr != nil {
 					return fmt.Errorf("failed to append trace row: %w", appendErr)
 				}
 
 				spanCount++
+
+				if flushErr := internal.FlushFullBlock(batch, spanCount, e.cfg.InsertBlockSize); flushErr != nil {
+					return fmt.Errorf("failed to stream trace rows: %w", flushErr)
+				}
 			}
 		}
 	}
@@ -182,10 +202,15 @@ func convertLinks(links ptrace.SpanLinkSlice) (traceIDs, spanIDs, states []strin
 }
 
 func renderInsertTracesSQL(cfg *Config) string {
-	return fmt.Sprintf(sqltemplates.TracesInsert, cfg.database(), cfg.TracesTableName)
+	mappedColumnNames, mappedColumnPositions := renderColumnMappingSQL(cfg.TracesSchema.Columns)
+	return fmt.Sprintf(sqltemplates.TracesInsert, cfg.database(), cfg.TracesTableName, mappedColumnNames, mappedColumnPositions)
 }
 
 func renderCreateTracesTableSQL(cfg *Config) string {
+	if cfg.TracesSchema.CreateTableSQL != "" {
+		return cfg.TracesSchema.CreateTableSQL
+	}
+
 	ttlExpr := internal.GenerateTTLExpr(cfg.TTL, "toDateTime(Timestamp)")
 	return fmt.Sprintf(sqltemplates.TracesCreateTable,
 		cfg.database(), cfg.TracesTableName, cfg.clusterString(),
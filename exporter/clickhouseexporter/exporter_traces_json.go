@@ -204,6 +204,10 @@ func (e *tracesJSONExporter) pushTraceData(ctx context.Context, td ptrace.Traces
 				}
 
 				spanCount++
+
+				if flushErr := internal.FlushFullBlock(batch, spanCount, e.cfg.InsertBlockSize); flushErr != nil {
+					return fmt.Errorf("failed to stream trace rows: %w", flushErr)
+				}
 			}
 		}
 	}
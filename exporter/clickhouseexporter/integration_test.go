@@ -20,6 +20,7 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 	"go.uber.org/goleak"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal"
 )
@@ -266,3 +267,56 @@ func TestIntegration(t *testing.T) {
 	// Verify all integration tests, ignoring test container reaper
 	goleak.VerifyNone(t, goleak.IgnoreTopFunction("github.com/testcontainers/testcontainers-go.(*Reaper).connect.func1"))
 }
+
+// BenchmarkLogsExporterInsert compares the insert modes of the logs exporter against a ClickHouse server.
+// Run with: go test -tags integration -run '^$' -bench BenchmarkLogsExporterInsert -benchmem
+func BenchmarkLogsExporterInsert(b *testing.B) {
+	c, chEnv, err := createClickhouseContainer("clickhouse/clickhouse-server:25.8-alpine")
+	require.NoError(b, err)
+	b.Cleanup(func() { _ = c.Terminate(context.Background()) })
+
+	logs := simpleLogs(100000, false)
+
+	benchmarks := []struct {
+		name string
+		fn   func(*Config)
+	}{
+		{
+			name: "sync insert single block",
+			fn: func(cfg *Config) {
+				cfg.AsyncInsert = false
+				cfg.InsertBlockSize = 0
+			},
+		},
+		{
+			name: "sync insert streamed blocks",
+			fn: func(cfg *Config) {
+				cfg.AsyncInsert = false
+			},
+		},
+		{
+			name: "async insert streamed blocks",
+			fn:   func(*Config) {},
+		},
+		{
+			name: "async insert streamed blocks without wait",
+			fn: func(cfg *Config) {
+				cfg.AsyncInsertWait = false
+			},
+		},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			exporter := newLogsExporter(zap.NewNop(), withTestExporterConfig(bm.fn)(chEnv.NativeEndpoint))
+			require.NoError(b, exporter.start(b.Context(), nil))
+			b.Cleanup(func() { _ = exporter.shutdown(context.Background()) })
+
+			b.ReportAllocs()
+			for b.Loop() {
+				require.NoError(b, exporter.pushLogsData(b.Context(), logs))
+			}
+			b.ReportMetric(float64(logs.LogRecordCount()*b.N)/b.Elapsed().Seconds(), "records/s")
+		})
+	}
+}
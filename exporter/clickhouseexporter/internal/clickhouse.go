@@ -74,21 +74,56 @@ func CreateDatabase(ctx context.Context, db driver.Conn, database, clusterStr st
 
 // GetTableColumns returns the column names on a table for schema detection
 func GetTableColumns(ctx context.Context, db driver.Conn, database, table string) ([]string, error) {
+	columns, err := describeTable(ctx, db, database, table)
+	if err != nil {
+		return nil, err
+	}
+
+	columnNames := make([]string, 0, len(columns))
+	for _, c := range columns {
+		columnNames = append(columnNames, c.name)
+	}
+
+	return columnNames, nil
+}
+
+// GetTableColumnTypes returns the types of the columns on a table, keyed by column name
+func GetTableColumnTypes(ctx context.Context, db driver.Conn, database, table string) (map[string]string, error) {
+	columns, err := describeTable(ctx, db, database, table)
+	if err != nil {
+		return nil, err
+	}
+
+	columnTypes := make(map[string]string, len(columns))
+	for _, c := range columns {
+		columnTypes[c.name] = c.typ
+	}
+
+	return columnTypes, nil
+}
+
+type tableColumn struct {
+	name string
+	typ  string
+}
+
+func describeTable(ctx context.Context, db driver.Conn, database, table string) ([]tableColumn, error) {
 	descTable := fmt.Sprintf("DESC TABLE %q.%q", database, table)
 	rows, err := db.Query(ctx, descTable)
 	if err != nil {
 		return nil, fmt.Errorf("get table columns: %w", err)
 	}
 
-	var columnNames []string
+	var columns []tableColumn
 	for rows.Next() {
-		var columnName, skip string
-		scanErr := rows.Scan(&columnName, &skip, &skip, &skip, &skip, &skip, &skip)
+		var c tableColumn
+		var skip string
+		scanErr := rows.Scan(&c.name, &c.typ, &skip, &skip, &skip, &skip, &skip)
 		if scanErr != nil {
 			return nil, fmt.Errorf("scan table column: %w", scanErr)
 		}
 
-		columnNames = append(columnNames, columnName)
+		columns = append(columns, c)
 	}
 
 	err = rows.Close()
@@ -96,5 +131,20 @@ func GetTableColumns(ctx context.Context, db driver.Conn, database, table string
 		return nil, fmt.Errorf("get table columns rows close: %w", err)
 	}
 
-	return columnNames, nil
+	return columns, nil
+}
+
+// FlushFullBlock streams the rows appended to the batch to the server as a block
+// once the number of rows reaches a multiple of blockSize. A blockSize of 0 disables streaming.
+// Flushing is a no-op with the HTTP protocol, where the batch is always sent as a whole.
+func FlushFullBlock(batch driver.Batch, rows, blockSize int) error {
+	if blockSize <= 0 || rows%blockSize != 0 {
+		return nil
+	}
+
+	if err := batch.Flush(); err != nil {
+		return fmt.Errorf("flush block: %w", err)
+	}
+
+	return nil
 }
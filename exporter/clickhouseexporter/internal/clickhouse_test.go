@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 	"github.com/stretchr/testify/require"
 )
 
@@ -176,3 +177,37 @@ func TestGenerateTTLExpr(t *testing.T) {
 		})
 	}
 }
+
+type flushCountingBatch struct {
+	driver.Batch
+	flushes int
+}
+
+func (b *flushCountingBatch) Flush() error {
+	b.flushes++
+	return nil
+}
+
+func TestFlushFullBlock(t *testing.T) {
+	tests := []struct {
+		name            string
+		rows            int
+		blockSize       int
+		expectedFlushes int
+	}{
+		{name: "streaming disabled", rows: 100, blockSize: 0, expectedFlushes: 0},
+		{name: "block size larger than batch", rows: 100, blockSize: 1000, expectedFlushes: 0},
+		{name: "batch split in full blocks", rows: 100, blockSize: 25, expectedFlushes: 4},
+		{name: "batch with trailing partial block", rows: 100, blockSize: 30, expectedFlushes: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batch := &flushCountingBatch{}
+			for rows := 1; rows <= tt.rows; rows++ {
+				require.NoError(t, FlushFullBlock(batch, rows, tt.blockSize))
+			}
+			require.Equal(t, tt.expectedFlushes, batch.flushes)
+		})
+	}
+}
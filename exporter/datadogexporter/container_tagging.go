@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagger"
+	datadogconfig "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/datadog/config"
+)
+
+// newContainerTagger builds the local tagger of an exporter, started and stopped with it.
+// It returns nil if container tagging is disabled.
+func newContainerTagger(set component.TelemetrySettings, cfg datadogconfig.ContainerTaggingConfig) (*tagger.Tagger, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	return tagger.New(set, cfg)
+}
+
+func wrapTracesExporter(tg *tagger.Tagger, exp exporter.Traces) exporter.Traces {
+	if tg == nil {
+		return exp
+	}
+	return &taggingTracesExporter{Traces: exp, tagger: tg}
+}
+
+func wrapMetricsExporter(tg *tagger.Tagger, exp exporter.Metrics) exporter.Metrics {
+	if tg == nil {
+		return exp
+	}
+	return &taggingMetricsExporter{Metrics: exp, tagger: tg}
+}
+
+func wrapLogsExporter(tg *tagger.Tagger, exp exporter.Logs) exporter.Logs {
+	if tg == nil {
+		return exp
+	}
+	return &taggingLogsExporter{Logs: exp, tagger: tg}
+}
+
+// taggingTracesExporter adds the container and pod resource attributes resolved by the local tagger
+// to the traces before exporting them.
+type taggingTracesExporter struct {
+	exporter.Traces
+	tagger *tagger.Tagger
+}
+
+func (e *taggingTracesExporter) Start(ctx context.Context, host component.Host) error {
+	e.tagger.Start()
+	return e.Traces.Start(ctx, host)
+}
+
+func (e *taggingTracesExporter) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		e.tagger.Enrich(td.ResourceSpans().At(i).Resource())
+	}
+	return e.Traces.ConsumeTraces(ctx, td)
+}
+
+func (*taggingTracesExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+func (e *taggingTracesExporter) Shutdown(ctx context.Context) error {
+	defer e.tagger.Shutdown()
+	return e.Traces.Shutdown(ctx)
+}
+
+// taggingMetricsExporter adds the container and pod resource attributes resolved by the local tagger
+// to the metrics before exporting them.
+type taggingMetricsExporter struct {
+	exporter.Metrics
+	tagger *tagger.Tagger
+}
+
+func (e *taggingMetricsExporter) Start(ctx context.Context, host component.Host) error {
+	e.tagger.Start()
+	return e.Metrics.Start(ctx, host)
+}
+
+func (e *taggingMetricsExporter) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		e.tagger.Enrich(md.ResourceMetrics().At(i).Resource())
+	}
+	return e.Metrics.ConsumeMetrics(ctx, md)
+}
+
+func (*taggingMetricsExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+func (e *taggingMetricsExporter) Shutdown(ctx context.Context) error {
+	defer e.tagger.Shutdown()
+	return e.Metrics.Shutdown(ctx)
+}
+
+// taggingLogsExporter adds the container and pod resource attributes resolved by the local tagger
+// to the logs before exporting them.
+type taggingLogsExporter struct {
+	exporter.Logs
+	tagger *tagger.Tagger
+}
+
+func (e *taggingLogsExporter) Start(ctx context.Context, host component.Host) error {
+	e.tagger.Start()
+	return e.Logs.Start(ctx, host)
+}
+
+func (e *taggingLogsExporter) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		e.tagger.Enrich(ld.ResourceLogs().At(i).Resource())
+	}
+	return e.Logs.ConsumeLogs(ctx, ld)
+}
+
+func (*taggingLogsExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+func (e *taggingLogsExporter) Shutdown(ctx context.Context) error {
+	defer e.tagger.Shutdown()
+	return e.Logs.Shutdown(ctx)
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogexporter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagger"
+	datadogconfig "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/datadog/config"
+)
+
+const testContainerID = "3726184226f5d3147c25fdeab5b60097e378e8a720503a5e19ecfdf29f869860"
+
+type sinkExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumertest.TracesSink
+	consumertest.MetricsSink
+	consumertest.LogsSink
+}
+
+func (*sinkExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{}
+}
+
+func TestContainerTaggingExporters(t *testing.T) {
+	procRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procRoot, "42"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(procRoot, "42", "cgroup"), []byte("0::/docker/"+testContainerID+"\n"), 0o600))
+
+	cfg := datadogconfig.CreateDefaultConfig().(*datadogconfig.Config).ContainerTagging
+	tg, err := newContainerTagger(componenttest.NewNopTelemetrySettings(), cfg)
+	require.NoError(t, err)
+	assert.Nil(t, tg, "the tagger is disabled by default")
+
+	cfg.Enabled = true
+	cfg.ProcRoot = procRoot
+	newTagger := func() *tagger.Tagger {
+		tg, err := newContainerTagger(componenttest.NewNopTelemetrySettings(), cfg)
+		require.NoError(t, err)
+		require.NotNil(t, tg)
+		return tg
+	}
+	// each exporter owns its tagger, so that shutting down one doesn't stop the others
+	tracesTagger, metricsTagger, logsTagger := newTagger(), newTagger(), newTagger()
+	assert.NotSame(t, tracesTagger, metricsTagger)
+	assert.NotSame(t, metricsTagger, logsTagger)
+
+	sink := &sinkExporter{}
+	traces := wrapTracesExporter(tracesTagger, sink)
+	metrics := wrapMetricsExporter(metricsTagger, sink)
+	logs := wrapLogsExporter(logsTagger, sink)
+	assert.True(t, traces.Capabilities().MutatesData)
+	assert.True(t, metrics.Capabilities().MutatesData)
+	assert.True(t, logs.Capabilities().MutatesData)
+	require.NoError(t, traces.Start(t.Context(), componenttest.NewNopHost()))
+	require.NoError(t, metrics.Start(t.Context(), componenttest.NewNopHost()))
+	require.NoError(t, logs.Start(t.Context(), componenttest.NewNopHost()))
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().Resource().Attributes().PutInt("process.pid", 42)
+	require.NoError(t, traces.ConsumeTraces(t.Context(), td))
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().Resource().Attributes().PutInt("process.pid", 42)
+	require.NoError(t, metrics.ConsumeMetrics(t.Context(), md))
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutInt("process.pid", 42)
+	require.NoError(t, logs.ConsumeLogs(t.Context(), ld))
+
+	containerID := func(attrs map[string]any) any { return attrs["container.id"] }
+	assert.Equal(t, testContainerID, containerID(sink.AllTraces()[0].ResourceSpans().At(0).Resource().Attributes().AsRaw()))
+	assert.Equal(t, testContainerID, containerID(sink.AllMetrics()[0].ResourceMetrics().At(0).Resource().Attributes().AsRaw()))
+	assert.Equal(t, testContainerID, containerID(sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().AsRaw()))
+
+	require.NoError(t, traces.Shutdown(t.Context()))
+	require.NoError(t, metrics.Shutdown(t.Context()))
+	require.NoError(t, logs.Shutdown(t.Context()))
+}
@@ -245,6 +245,10 @@ func (f *factory) createMetricsExporter(
 	if err != nil {
 		return nil, fmt.Errorf("failed to build hostname provider: %w", err)
 	}
+	containerTagger, err := newContainerTagger(set.TelemetrySettings, cfg.ContainerTagging)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build container tagger: %w", err)
+	}
 
 	// cancel() runs on shutdown
 	ctx, cancel := context.WithCancel(ctx)
@@ -366,7 +370,11 @@ func (f *factory) createMetricsExporter(
 			},
 			HostMetadata: cfg.HostMetadata,
 		}
-		return sf.CreateMetrics(ctx, set, ex)
+		exp, err := sf.CreateMetrics(ctx, set, ex)
+		if err != nil {
+			return nil, err
+		}
+		return wrapMetricsExporter(containerTagger, exp), nil
 	default:
 		exp, metricsErr := newMetricsExporter(ctx, set, cfg, acfg, &f.onceMetadata, attrsTranslator, hostProvider, metadataReporter, statsIn, f.gatewayUsage)
 		if metricsErr != nil {
@@ -403,8 +411,8 @@ func (f *factory) createMetricsExporter(
 	if err != nil {
 		return nil, err
 	}
-	return resourcetotelemetry.WrapMetricsExporter(
-		resourcetotelemetry.Settings{Enabled: cfg.Metrics.ExporterConfig.ResourceAttributesAsTags}, exporter), nil
+	return wrapMetricsExporter(containerTagger, resourcetotelemetry.WrapMetricsExporter(
+		resourcetotelemetry.Settings{Enabled: cfg.Metrics.ExporterConfig.ResourceAttributesAsTags}, exporter)), nil
 }
 
 // createTracesExporter creates a trace exporter based on this config.
@@ -436,6 +444,10 @@ func (f *factory) createTracesExporter(
 	if err != nil {
 		return nil, fmt.Errorf("failed to build hostname provider: %w", err)
 	}
+	containerTagger, err := newContainerTagger(set.TelemetrySettings, cfg.ContainerTagging)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build container tagger: %w", err)
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	// cancel() runs on shutdown
 
@@ -499,7 +511,7 @@ func (f *factory) createTracesExporter(
 		}
 	}
 
-	return exporterhelper.NewTraces(
+	exp, err := exporterhelper.NewTraces(
 		ctx,
 		set,
 		cfg,
@@ -511,6 +523,10 @@ func (f *factory) createTracesExporter(
 		exporterhelper.WithQueue(cfg.QueueSettings),
 		exporterhelper.WithShutdown(stop),
 	)
+	if err != nil {
+		return nil, err
+	}
+	return wrapTracesExporter(containerTagger, exp), nil
 }
 
 // createLogsExporter creates a logs exporter based on the config.
@@ -531,6 +547,10 @@ func (f *factory) createLogsExporter(
 	if err != nil {
 		return nil, fmt.Errorf("failed to build hostname provider: %w", err)
 	}
+	containerTagger, err := newContainerTagger(set.TelemetrySettings, cfg.ContainerTagging)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build container tagger: %w", err)
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	// cancel() runs on shutdown
 
@@ -568,7 +588,7 @@ func (f *factory) createLogsExporter(
 		logsAgent = la
 		pusher = exp.ConsumeLogs
 	}
-	return exporterhelper.NewLogs(
+	exp, err := exporterhelper.NewLogs(
 		ctx,
 		set,
 		cfg,
@@ -586,4 +606,8 @@ func (f *factory) createLogsExporter(
 			return nil
 		}),
 	)
+	if err != nil {
+		return nil, err
+	}
+	return wrapLogsExporter(containerTagger, exp), nil
 }
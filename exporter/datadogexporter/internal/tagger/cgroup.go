@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tagger // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagger"
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// containerIDRegexp matches the container IDs of the container runtimes (64 hexadecimal characters),
+// and the ones of the ECS Fargate tasks (32 hexadecimal characters followed by a number).
+var containerIDRegexp = regexp.MustCompile(`^([0-9a-f]{64}|[0-9a-f]{32}-\d+)$`)
+
+// cgroupPrefixes are the prefixes added by the container runtimes and systemd to the container IDs
+// in the cgroup path, e.g. `cri-containerd-<id>.scope`.
+var cgroupPrefixes = []string{"docker-", "cri-containerd-", "crio-", "libpod-"}
+
+// containerIDFromPID returns the ID of the container the process runs in, or an empty string
+// if the process doesn't run in a container, or if its cgroup is not visible from the collector.
+func containerIDFromPID(procRoot string, pid int64) (string, error) {
+	f, err := os.Open(filepath.Join(procRoot, strconv.FormatInt(pid, 10), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return parseCgroup(f)
+}
+
+// parseCgroup returns the container ID found in the content of a /proc/<pid>/cgroup file, made of
+// lines like `hierarchy-ID:controller-list:cgroup-path`.
+func parseCgroup(r io.Reader) (string, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		if id := containerIDFromCgroupPath(parts[2]); id != "" {
+			return id, nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+// containerIDFromCgroupPath returns the container ID in the last segments of the cgroup path.
+func containerIDFromCgroupPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		segment := strings.TrimSuffix(segments[i], ".scope")
+		for _, prefix := range cgroupPrefixes {
+			segment = strings.TrimPrefix(segment, prefix)
+		}
+		if containerIDRegexp.MatchString(segment) {
+			return segment
+		}
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tagger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContainerID = "3726184226f5d3147c25fdeab5b60097e378e8a720503a5e19ecfdf29f869860"
+
+func TestParseCgroup(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "docker cgroup v1",
+			content: "12:memory:/docker/" + testContainerID + "\n11:cpu,cpuacct:/docker/" + testContainerID,
+			want:    testContainerID,
+		},
+		{
+			name:    "containerd cgroup v2 with systemd driver",
+			content: "0::/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod2c6ba1b8.slice/cri-containerd-" + testContainerID + ".scope",
+			want:    testContainerID,
+		},
+		{
+			name:    "cri-o",
+			content: "0::/kubepods/besteffort/pod2c6ba1b8/crio-" + testContainerID + ".scope",
+			want:    testContainerID,
+		},
+		{
+			name:    "ecs fargate",
+			content: "9:perf_event:/ecs/55091c13-b8cf-4801-b527-f4601742204d/432624d2150b349fe35ba397284dea788c2bf66b885d14dfc1569b01890ca7da\n",
+			want:    "432624d2150b349fe35ba397284dea788c2bf66b885d14dfc1569b01890ca7da",
+		},
+		{
+			name:    "ecs fargate platform 1.4",
+			content: "0::/ecs/34dc0b5e626f2c5c4c5170e34b10e765-1234567890",
+			want:    "34dc0b5e626f2c5c4c5170e34b10e765-1234567890",
+		},
+		{
+			name:    "host process",
+			content: "0::/user.slice/user-1000.slice/session-2.scope",
+		},
+		{
+			name:    "private cgroup namespace",
+			content: "0::/",
+		},
+		{
+			name:    "malformed",
+			content: "not a cgroup file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCgroup(strings.NewReader(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestContainerIDFromPID(t *testing.T) {
+	procRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procRoot, "42"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(procRoot, "42", "cgroup"), []byte("0::/docker/"+testContainerID+"\n"), 0o600))
+
+	got, err := containerIDFromPID(procRoot, 42)
+	require.NoError(t, err)
+	assert.Equal(t, testContainerID, got)
+
+	_, err = containerIDFromPID(procRoot, 43)
+	assert.Error(t, err)
+}
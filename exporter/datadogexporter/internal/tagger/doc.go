@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tagger provides a local equivalent of the Datadog Agent tagger: it resolves the container
+// of a resource from the cgroup of its process, and the pod of the container from the Kubernetes API,
+// and adds the corresponding resource attributes, which are then mapped to Datadog tags.
+package tagger // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagger"
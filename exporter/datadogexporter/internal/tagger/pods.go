@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tagger // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagger"
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	containerIDIndex = "containerID"
+	podUIDIndex      = "podUID"
+
+	podResyncPeriod = 10 * time.Minute
+)
+
+// podStore watches the pods from the Kubernetes API, and indexes them by container ID and pod UID.
+type podStore struct {
+	informer cache.SharedIndexInformer
+	stop     chan struct{}
+}
+
+func newPodStore(client kubernetes.Interface, nodeName string) (*podStore, error) {
+	selector := fields.Everything()
+	if nodeName != "" {
+		selector = fields.OneTermEqualSelector("spec.nodeName", nodeName)
+	}
+	pods := client.CoreV1().Pods(corev1.NamespaceAll)
+	informer := cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListWithContextFunc: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				opts.FieldSelector = selector.String()
+				return pods.List(ctx, opts)
+			},
+			WatchFuncWithContext: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+				opts.FieldSelector = selector.String()
+				return pods.Watch(ctx, opts)
+			},
+		},
+		&corev1.Pod{},
+		podResyncPeriod,
+		cache.Indexers{
+			containerIDIndex: indexByContainerID,
+			podUIDIndex:      indexByPodUID,
+		},
+	)
+	// the pods are only read to build tags: drop the fields that are not used to reduce the memory usage
+	if err := informer.SetTransform(transformPod); err != nil {
+		return nil, err
+	}
+	return &podStore{informer: informer, stop: make(chan struct{})}, nil
+}
+
+func (s *podStore) start() {
+	go s.informer.Run(s.stop)
+}
+
+func (s *podStore) shutdown() {
+	close(s.stop)
+}
+
+// byContainerID returns the pod running the container, and the status of the container.
+func (s *podStore) byContainerID(containerID string) (*corev1.Pod, *corev1.ContainerStatus) {
+	pod := s.get(containerIDIndex, containerID)
+	if pod == nil {
+		return nil, nil
+	}
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses, pod.Status.EphemeralContainerStatuses} {
+		for i := range statuses {
+			if trimRuntimePrefix(statuses[i].ContainerID) == containerID {
+				return pod, &statuses[i]
+			}
+		}
+	}
+	return pod, nil
+}
+
+func (s *podStore) byUID(uid string) *corev1.Pod {
+	return s.get(podUIDIndex, uid)
+}
+
+func (s *podStore) get(index, key string) *corev1.Pod {
+	objs, err := s.informer.GetIndexer().ByIndex(index, key)
+	if err != nil || len(objs) == 0 {
+		return nil
+	}
+	pod, _ := objs[0].(*corev1.Pod)
+	return pod
+}
+
+func indexByContainerID(obj any) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, nil
+	}
+	var ids []string
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses, pod.Status.EphemeralContainerStatuses} {
+		for _, status := range statuses {
+			if id := trimRuntimePrefix(status.ContainerID); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids, nil
+}
+
+func indexByPodUID(obj any) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, nil
+	}
+	return []string{string(pod.UID)}, nil
+}
+
+// trimRuntimePrefix removes the container runtime from a container ID, e.g. `containerd://<id>`.
+func trimRuntimePrefix(containerID string) string {
+	if i := strings.Index(containerID, "://"); i >= 0 {
+		return containerID[i+3:]
+	}
+	return containerID
+}
+
+func transformPod(obj any) (any, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return obj, nil
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            pod.Name,
+			Namespace:       pod.Namespace,
+			UID:             pod.UID,
+			Labels:          pod.Labels,
+			OwnerReferences: pod.OwnerReferences,
+		},
+		Spec: corev1.PodSpec{
+			NodeName: pod.Spec.NodeName,
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses:          pod.Status.ContainerStatuses,
+			InitContainerStatuses:      pod.Status.InitContainerStatuses,
+			EphemeralContainerStatuses: pod.Status.EphemeralContainerStatuses,
+		},
+	}, nil
+}
@@ -0,0 +1,245 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tagger // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagger"
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	conventions "go.opentelemetry.io/otel/semconv/v1.38.0"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	datadogconfig "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/datadog/config"
+)
+
+const (
+	// pidCacheTTL is how long the container ID of a process is cached for.
+	pidCacheTTL = time.Minute
+	// maxCachedPIDs bounds the number of processes whose container ID is cached.
+	maxCachedPIDs = 10000
+)
+
+// podLabels are the pod labels added as resource attributes, which are mapped to Datadog tags.
+var podLabels = []string{
+	"tags.datadoghq.com/env",
+	"tags.datadoghq.com/service",
+	"tags.datadoghq.com/version",
+	"app.kubernetes.io/name",
+	"app.kubernetes.io/instance",
+	"app.kubernetes.io/version",
+	"app.kubernetes.io/component",
+	"app.kubernetes.io/part-of",
+	"app.kubernetes.io/managed-by",
+}
+
+// Tagger adds the container and pod resource attributes to the resources, resolving the container
+// from the cgroup of the process and the pod from the Kubernetes API.
+type Tagger struct {
+	cfg    datadogconfig.ContainerTaggingConfig
+	logger *zap.Logger
+	pods   *podStore
+	now    func() time.Time
+
+	mu   sync.Mutex
+	pids map[int64]pidEntry
+}
+
+type pidEntry struct {
+	containerID string
+	expiresAt   time.Time
+}
+
+// New creates a Tagger from the configuration.
+func New(set component.TelemetrySettings, cfg datadogconfig.ContainerTaggingConfig) (*Tagger, error) {
+	var client kubernetes.Interface
+	if cfg.Kubernetes.Enabled {
+		var err error
+		client, err = k8sconfig.MakeClient(cfg.Kubernetes.APIConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newTagger(set.Logger, cfg, client)
+}
+
+func newTagger(logger *zap.Logger, cfg datadogconfig.ContainerTaggingConfig, client kubernetes.Interface) (*Tagger, error) {
+	t := &Tagger{
+		cfg:    cfg,
+		logger: logger,
+		now:    time.Now,
+		pids:   map[int64]pidEntry{},
+	}
+	if client != nil {
+		pods, err := newPodStore(client, cfg.Kubernetes.NodeName)
+		if err != nil {
+			return nil, err
+		}
+		t.pods = pods
+	}
+	return t, nil
+}
+
+// Start starts watching the pods.
+func (t *Tagger) Start() {
+	if t.pods != nil {
+		t.pods.start()
+	}
+}
+
+// Shutdown stops watching the pods.
+func (t *Tagger) Shutdown() {
+	if t.pods != nil {
+		t.pods.shutdown()
+	}
+}
+
+// Enrich adds the container and pod attributes to the resource. Existing attributes are never overwritten.
+func (t *Tagger) Enrich(res pcommon.Resource) {
+	attrs := res.Attributes()
+
+	var containerID string
+	if v, ok := attrs.Get(string(conventions.ContainerIDKey)); ok {
+		containerID = v.AsString()
+	} else if pid, ok := attrs.Get(string(conventions.ProcessPIDKey)); ok && t.cfg.OriginDetection && pid.Type() == pcommon.ValueTypeInt {
+		containerID = t.containerIDFromPID(pid.Int())
+		if containerID != "" {
+			attrs.PutStr(string(conventions.ContainerIDKey), containerID)
+		}
+	}
+
+	if t.pods == nil {
+		return
+	}
+	var (
+		pod    *corev1.Pod
+		status *corev1.ContainerStatus
+	)
+	if containerID != "" {
+		pod, status = t.pods.byContainerID(containerID)
+	}
+	if pod == nil {
+		if uid, ok := attrs.Get(string(conventions.K8SPodUIDKey)); ok {
+			pod = t.pods.byUID(uid.AsString())
+		}
+	}
+	if pod == nil {
+		return
+	}
+
+	putMissing(attrs, string(conventions.K8SPodNameKey), pod.Name)
+	putMissing(attrs, string(conventions.K8SPodUIDKey), string(pod.UID))
+	putMissing(attrs, string(conventions.K8SNamespaceNameKey), pod.Namespace)
+	putMissing(attrs, string(conventions.K8SNodeNameKey), pod.Spec.NodeName)
+	for _, owner := range pod.OwnerReferences {
+		switch owner.Kind {
+		case "ReplicaSet":
+			putMissing(attrs, string(conventions.K8SReplicaSetNameKey), owner.Name)
+			putMissing(attrs, string(conventions.K8SDeploymentNameKey), deploymentForReplicaSet(owner.Name))
+		case "DaemonSet":
+			putMissing(attrs, string(conventions.K8SDaemonSetNameKey), owner.Name)
+		case "StatefulSet":
+			putMissing(attrs, string(conventions.K8SStatefulSetNameKey), owner.Name)
+		case "Job":
+			putMissing(attrs, string(conventions.K8SJobNameKey), owner.Name)
+			putMissing(attrs, string(conventions.K8SCronJobNameKey), cronJobForJob(owner.Name))
+		}
+	}
+	if status != nil {
+		putMissing(attrs, string(conventions.K8SContainerNameKey), status.Name)
+		name, tag := splitImage(status.Image)
+		putMissing(attrs, string(conventions.ContainerImageNameKey), name)
+		putMissing(attrs, "container.image.tag", tag)
+	}
+	for _, label := range podLabels {
+		putMissing(attrs, label, pod.Labels[label])
+	}
+}
+
+// containerIDFromPID returns the container ID of the process, caching the result as the cgroup
+// of a process doesn't change.
+func (t *Tagger) containerIDFromPID(pid int64) string {
+	now := t.now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if entry, ok := t.pids[pid]; ok && now.Before(entry.expiresAt) {
+		return entry.containerID
+	}
+	containerID, err := containerIDFromPID(t.cfg.ProcRoot, pid)
+	if err != nil {
+		t.logger.Debug("Failed to resolve the container of the process", zap.Int64("pid", pid), zap.Error(err))
+	}
+	if len(t.pids) >= maxCachedPIDs {
+		for cachedPID, entry := range t.pids {
+			if !now.Before(entry.expiresAt) {
+				delete(t.pids, cachedPID)
+			}
+		}
+	}
+	if len(t.pids) < maxCachedPIDs {
+		t.pids[pid] = pidEntry{containerID: containerID, expiresAt: now.Add(pidCacheTTL)}
+	}
+	return containerID
+}
+
+func putMissing(attrs pcommon.Map, key, value string) {
+	if value == "" {
+		return
+	}
+	if _, ok := attrs.Get(key); !ok {
+		attrs.PutStr(key, value)
+	}
+}
+
+// splitImage splits a container image into its name and tag, e.g. `docker.io/library/nginx:1.25`
+// into `docker.io/library/nginx` and `1.25`.
+func splitImage(image string) (name, tag string) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:]
+	}
+	return image, ""
+}
+
+// podTemplateHashChars are the characters used by Kubernetes to generate the pod template hash
+// suffixed to the names of the replica sets created by deployments.
+const podTemplateHashChars = "bcdfghjklmnpqrstvwxz2456789"
+
+// deploymentForReplicaSet returns the name of the deployment that created the replica set, or an
+// empty string if the replica set was not created by a deployment.
+func deploymentForReplicaSet(name string) string {
+	i := strings.LastIndex(name, "-")
+	if i <= 0 || i == len(name)-1 {
+		return ""
+	}
+	for _, c := range name[i+1:] {
+		if !strings.ContainsRune(podTemplateHashChars, c) {
+			return ""
+		}
+	}
+	return name[:i]
+}
+
+// cronJobForJob returns the name of the cron job that created the job, or an empty string if the
+// job was not created by a cron job.
+func cronJobForJob(name string) string {
+	i := strings.LastIndex(name, "-")
+	if i <= 0 || i == len(name)-1 {
+		return ""
+	}
+	for _, c := range name[i+1:] {
+		if c < '0' || c > '9' {
+			return ""
+		}
+	}
+	return name[:i]
+}
@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tagger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	datadogconfig "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/datadog/config"
+)
+
+func newTestPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "checkout-6d4cf56db6-x7k2p",
+			Namespace: "shop",
+			UID:       "2c6ba1b8-0d4f-4a5e-9b36-0c7b3c0b2a11",
+			Labels: map[string]string{
+				"tags.datadoghq.com/env": "prod",
+				"app.kubernetes.io/name": "checkout",
+				"pod-template-hash":      "6d4cf56db6",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "ReplicaSet", Name: "checkout-6d4cf56db6"},
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "node-1",
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "istio-proxy", ContainerID: "containerd://0000000000000000000000000000000000000000000000000000000000000000", Image: "docker.io/istio/proxyv2:1.22.0"},
+				{Name: "checkout", ContainerID: "containerd://" + testContainerID, Image: "registry.example.com:5000/shop/checkout:1.4.2@sha256:0123"},
+			},
+		},
+	}
+}
+
+func newTestTagger(t *testing.T, cfg datadogconfig.ContainerTaggingConfig, pods ...*corev1.Pod) *Tagger {
+	var tg *Tagger
+	var err error
+	if cfg.Kubernetes.Enabled {
+		client := fake.NewClientset()
+		for _, pod := range pods {
+			_, err = client.CoreV1().Pods(pod.Namespace).Create(t.Context(), pod, metav1.CreateOptions{})
+			require.NoError(t, err)
+		}
+		tg, err = newTagger(zap.NewNop(), cfg, client)
+		require.NoError(t, err)
+		tg.Start()
+		t.Cleanup(tg.Shutdown)
+		require.Eventually(t, tg.pods.informer.HasSynced, 5*time.Second, 10*time.Millisecond)
+	} else {
+		tg, err = newTagger(zap.NewNop(), cfg, nil)
+		require.NoError(t, err)
+	}
+	return tg
+}
+
+func TestEnrichFromPod(t *testing.T) {
+	procRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procRoot, "42"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(procRoot, "42", "cgroup"), []byte("0::/kubepods.slice/cri-containerd-"+testContainerID+".scope\n"), 0o600))
+
+	tg := newTestTagger(t, datadogconfig.ContainerTaggingConfig{
+		Enabled:         true,
+		OriginDetection: true,
+		ProcRoot:        procRoot,
+		Kubernetes:      datadogconfig.KubernetesTaggingConfig{Enabled: true},
+	}, newTestPod())
+
+	res := pcommon.NewResource()
+	res.Attributes().PutInt("process.pid", 42)
+	res.Attributes().PutStr("k8s.namespace.name", "already-set")
+	tg.Enrich(res)
+
+	assert.Equal(t, map[string]any{
+		"process.pid":            int64(42),
+		"container.id":           testContainerID,
+		"container.image.name":   "registry.example.com:5000/shop/checkout",
+		"container.image.tag":    "1.4.2",
+		"k8s.container.name":     "checkout",
+		"k8s.pod.name":           "checkout-6d4cf56db6-x7k2p",
+		"k8s.pod.uid":            "2c6ba1b8-0d4f-4a5e-9b36-0c7b3c0b2a11",
+		"k8s.namespace.name":     "already-set",
+		"k8s.node.name":          "node-1",
+		"k8s.replicaset.name":    "checkout-6d4cf56db6",
+		"k8s.deployment.name":    "checkout",
+		"tags.datadoghq.com/env": "prod",
+		"app.kubernetes.io/name": "checkout",
+	}, res.Attributes().AsRaw())
+}
+
+func TestEnrichByPodUID(t *testing.T) {
+	pod := newTestPod()
+	pod.OwnerReferences = []metav1.OwnerReference{{Kind: "Job", Name: "report-28793520"}}
+	tg := newTestTagger(t, datadogconfig.ContainerTaggingConfig{
+		Enabled:    true,
+		Kubernetes: datadogconfig.KubernetesTaggingConfig{Enabled: true},
+	}, pod)
+
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("k8s.pod.uid", string(pod.UID))
+	tg.Enrich(res)
+
+	assert.Equal(t, "checkout-6d4cf56db6-x7k2p", res.Attributes().AsRaw()["k8s.pod.name"])
+	assert.Equal(t, "report-28793520", res.Attributes().AsRaw()["k8s.job.name"])
+	assert.Equal(t, "report", res.Attributes().AsRaw()["k8s.cronjob.name"])
+	// the container is unknown
+	assert.NotContains(t, res.Attributes().AsRaw(), "k8s.container.name")
+}
+
+func TestEnrichOriginDetectionOnly(t *testing.T) {
+	procRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procRoot, "42"), 0o755))
+	cgroupPath := filepath.Join(procRoot, "42", "cgroup")
+	require.NoError(t, os.WriteFile(cgroupPath, []byte("0::/docker/"+testContainerID+"\n"), 0o600))
+
+	tg := newTestTagger(t, datadogconfig.ContainerTaggingConfig{
+		Enabled:         true,
+		OriginDetection: true,
+		ProcRoot:        procRoot,
+	})
+	now := time.Unix(1700000000, 0)
+	tg.now = func() time.Time { return now }
+
+	res := pcommon.NewResource()
+	res.Attributes().PutInt("process.pid", 42)
+	tg.Enrich(res)
+	assert.Equal(t, map[string]any{"process.pid": int64(42), "container.id": testContainerID}, res.Attributes().AsRaw())
+
+	// the container ID of the process is cached
+	require.NoError(t, os.Remove(cgroupPath))
+	res = pcommon.NewResource()
+	res.Attributes().PutInt("process.pid", 42)
+	tg.Enrich(res)
+	assert.Equal(t, testContainerID, res.Attributes().AsRaw()["container.id"])
+
+	now = now.Add(pidCacheTTL)
+	res = pcommon.NewResource()
+	res.Attributes().PutInt("process.pid", 42)
+	tg.Enrich(res)
+	assert.NotContains(t, res.Attributes().AsRaw(), "container.id")
+
+	// origin detection is skipped when the container ID is known
+	res = pcommon.NewResource()
+	res.Attributes().PutStr("container.id", "abc")
+	res.Attributes().PutInt("process.pid", 42)
+	tg.Enrich(res)
+	assert.Equal(t, "abc", res.Attributes().AsRaw()["container.id"])
+}
+
+func TestSplitImage(t *testing.T) {
+	tests := []struct {
+		image, name, tag string
+	}{
+		{image: "nginx", name: "nginx"},
+		{image: "nginx:1.25", name: "nginx", tag: "1.25"},
+		{image: "docker.io/library/nginx:1.25", name: "docker.io/library/nginx", tag: "1.25"},
+		{image: "localhost:5000/nginx", name: "localhost:5000/nginx"},
+		{image: "localhost:5000/nginx:latest@sha256:0123", name: "localhost:5000/nginx", tag: "latest"},
+	}
+	for _, tt := range tests {
+		name, tag := splitImage(tt.image)
+		assert.Equal(t, tt.name, name, tt.image)
+		assert.Equal(t, tt.tag, tag, tt.image)
+	}
+}
+
+func TestOwnerNames(t *testing.T) {
+	assert.Equal(t, "checkout", deploymentForReplicaSet("checkout-6d4cf56db6"))
+	assert.Empty(t, deploymentForReplicaSet("standalone-replicaset"))
+	assert.Empty(t, deploymentForReplicaSet("checkout"))
+	assert.Equal(t, "report", cronJobForJob("report-28793520"))
+	assert.Empty(t, cronJobForJob("migration-v2"))
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/logging"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
 )
 
 type bulkIndexer interface {
@@ -76,9 +78,10 @@ func newBulkIndexer(
 	config *Config,
 	requireDataStream bool,
 	tb *metadata.TelemetryBuilder,
+	breaker *circuitbreaker.Breaker,
 	logger *zap.Logger,
 ) bulkIndexer {
-	return newSyncBulkIndexer(client, config, requireDataStream, tb, logger)
+	return newSyncBulkIndexer(client, config, requireDataStream, tb, breaker, logger)
 }
 
 func bulkIndexerConfig(client elastictransport.Interface, config *Config, requireDataStream bool, logger *zap.Logger) docappender.BulkIndexerConfig {
@@ -88,6 +91,11 @@ func bulkIndexerConfig(client elastictransport.Interface, config *Config, requir
 		if config.Retry.MaxRetries != 0 {
 			maxDocRetries = config.Retry.MaxRetries
 		}
+		if config.Retry.Backpressure {
+			// Documents are retried until they are indexed or the
+			// flush context is done, see syncBulkIndexerSession.Flush.
+			maxDocRetries = math.MaxInt
+		}
 	}
 	var compressionLevel int
 	if config.Compression == configcompression.TypeGzip {
@@ -142,6 +150,7 @@ func newSyncBulkIndexer(
 	config *Config,
 	requireDataStream bool,
 	tb *metadata.TelemetryBuilder,
+	breaker *circuitbreaker.Breaker,
 	logger *zap.Logger,
 ) *syncBulkIndexer {
 	var maxFlushBytes int64
@@ -158,6 +167,7 @@ func newSyncBulkIndexer(
 		retryConfig:           config.Retry,
 		metadataKeys:          config.MetadataKeys,
 		telemetryBuilder:      tb,
+		breaker:               breaker,
 		logger:                logger,
 		failedDocsInputLogger: newFailedDocsInputLogger(logger, config),
 	}
@@ -170,6 +180,7 @@ type syncBulkIndexer struct {
 	retryConfig           RetrySettings
 	metadataKeys          []string
 	telemetryBuilder      *metadata.TelemetryBuilder
+	breaker               *circuitbreaker.Breaker
 	logger                *zap.Logger
 	failedDocsInputLogger *zap.Logger
 }
@@ -236,7 +247,26 @@ func (*syncBulkIndexerSession) End() {
 func (s *syncBulkIndexerSession) Flush(ctx context.Context) error {
 	var retryBackoff func(int) time.Duration
 	for attempts := 0; ; attempts++ {
-		if err := flushBulkIndexer(
+		if s.bi.Items() == 0 {
+			return nil
+		}
+		if err := s.s.breaker.Allow(ctx); err != nil {
+			var openErr *circuitbreaker.OpenError
+			if !errors.As(err, &openErr) || !s.s.retryConfig.Enabled {
+				return err
+			}
+			// The exporter has no retry sender, so the documents are kept in the bulk
+			// indexer until the circuit breaker lets a probe request through.
+			timer := time.NewTimer(max(openErr.RetryAfter, s.s.retryConfig.InitialInterval))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+			continue
+		}
+		err := flushBulkIndexer(
 			ctx,
 			s.bi,
 			s.s.flushTimeout,
@@ -244,7 +274,11 @@ func (s *syncBulkIndexerSession) Flush(ctx context.Context) error {
 			s.s.telemetryBuilder,
 			s.s.logger,
 			s.s.failedDocsInputLogger,
-		); err != nil {
+		)
+		if ctx.Err() == nil {
+			s.s.breaker.Record(endpointFailure(err))
+		}
+		if err != nil {
 			return err
 		}
 		if s.bi.Items() == 0 {
@@ -271,6 +305,18 @@ func (s *syncBulkIndexerSession) Flush(ctx context.Context) error {
 	}
 }
 
+// endpointFailure returns the flush error if it shows that Elasticsearch is unhealthy,
+// that is if the bulk request timed out, could not be sent, or failed with a server error.
+func endpointFailure(err error) error {
+	var bulkFailedErr docappender.ErrorFlushFailed
+	if errors.As(err, &bulkFailedErr) {
+		if code := bulkFailedErr.StatusCode(); code != http.StatusTooManyRequests && code < 500 {
+			return nil
+		}
+	}
+	return err
+}
+
 func flushBulkIndexer(
 	ctx context.Context,
 	bi *docappender.BulkIndexer,
@@ -517,7 +563,11 @@ type bulkIndexers struct {
 	profilingStackFrames bulkIndexer // For profiling-stackframes
 	profilingExecutables bulkIndexer // For profiling-executables
 
+	// dataStreamCreator is non-nil when data_stream_auto_create is enabled.
+	dataStreamCreator *dataStreamCreator
+
 	telemetryBuilder *metadata.TelemetryBuilder
+	breaker          *circuitbreaker.Breaker
 }
 
 func (b *bulkIndexers) start(
@@ -541,20 +591,24 @@ func (b *bulkIndexers) start(
 	}
 
 	for _, mode := range allowedMappingModes {
-		bi := newBulkIndexer(esClient, cfg, mode == MappingOTel, b.telemetryBuilder, set.Logger)
+		bi := newBulkIndexer(esClient, cfg, mode == MappingOTel, b.telemetryBuilder, b.breaker, set.Logger)
 		b.modes[mode] = &wgTrackingBulkIndexer{bulkIndexer: bi, wg: &b.wg}
 	}
 
-	profilingEvents := newBulkIndexer(esClient, cfg, true, b.telemetryBuilder, set.Logger)
+	if cfg.DataStreamAutoCreate.Enabled {
+		b.dataStreamCreator = newDataStreamCreator(esClient, cfg.DataStreamAutoCreate, set.Logger)
+	}
+
+	profilingEvents := newBulkIndexer(esClient, cfg, true, b.telemetryBuilder, b.breaker, set.Logger)
 	b.profilingEvents = &wgTrackingBulkIndexer{bulkIndexer: profilingEvents, wg: &b.wg}
 
-	profilingStackTraces := newBulkIndexer(esClient, cfg, false, b.telemetryBuilder, set.Logger)
+	profilingStackTraces := newBulkIndexer(esClient, cfg, false, b.telemetryBuilder, b.breaker, set.Logger)
 	b.profilingStackTraces = &wgTrackingBulkIndexer{bulkIndexer: profilingStackTraces, wg: &b.wg}
 
-	profilingStackFrames := newBulkIndexer(esClient, cfg, false, b.telemetryBuilder, set.Logger)
+	profilingStackFrames := newBulkIndexer(esClient, cfg, false, b.telemetryBuilder, b.breaker, set.Logger)
 	b.profilingStackFrames = &wgTrackingBulkIndexer{bulkIndexer: profilingStackFrames, wg: &b.wg}
 
-	profilingExecutables := newBulkIndexer(esClient, cfg, false, b.telemetryBuilder, set.Logger)
+	profilingExecutables := newBulkIndexer(esClient, cfg, false, b.telemetryBuilder, b.breaker, set.Logger)
 	b.profilingExecutables = &wgTrackingBulkIndexer{bulkIndexer: profilingExecutables, wg: &b.wg}
 	return nil
 }
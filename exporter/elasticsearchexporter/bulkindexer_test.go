@@ -10,6 +10,7 @@ import (
 	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/elastic/elastic-transport-go/v8/elastictransport"
 	"github.com/elastic/go-docappender/v2"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/metadatatest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
 )
 
 var defaultRoundTripFunc = func(*http.Request) (*http.Response, error) {
@@ -113,7 +115,7 @@ func TestSyncBulkIndexer(t *testing.T) {
 			require.NoError(t, err)
 
 			core, observed := observer.New(zap.NewAtomicLevelAt(zapcore.DebugLevel))
-			bi := newSyncBulkIndexer(esClient, &cfg, false, tb, zap.New(core))
+			bi := newSyncBulkIndexer(esClient, &cfg, false, tb, nil, zap.New(core))
 
 			info := client.Info{Metadata: client.NewMetadata(map[string][]string{"x-test": {"test"}})}
 			ctx := client.NewContext(t.Context(), info)
@@ -191,6 +193,111 @@ func TestSyncBulkIndexer(t *testing.T) {
 	}
 }
 
+func TestSyncBulkIndexerCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantOpen   bool
+	}{
+		{name: "server_error", statusCode: http.StatusInternalServerError, wantOpen: true},
+		{name: "client_error", statusCode: http.StatusBadRequest, wantOpen: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reqCnt atomic.Int64
+			esClient, err := elastictransport.New(elastictransport.Config{
+				URLs: []*url.URL{{Scheme: "http", Host: "localhost:9200"}},
+				Transport: &mockTransport{
+					RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+						if r.URL.Path == "/_bulk" {
+							reqCnt.Add(1)
+						}
+						return &http.Response{
+							Header:     http.Header{"X-Elastic-Product": []string{"Elasticsearch"}},
+							Body:       io.NopCloser(strings.NewReader("{}")),
+							StatusCode: tt.statusCode,
+						}, nil
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			tb, err := metadata.NewTelemetryBuilder(componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+			breakerCfg := circuitbreaker.NewDefaultConfig()
+			breakerCfg.Enabled = true
+			breakerCfg.FailureThreshold = 1
+			breaker, err := circuitbreaker.New(breakerCfg, componenttest.NewNopTelemetrySettings().MeterProvider.Meter(""))
+			require.NoError(t, err)
+
+			cfg := Config{}
+			bi := newSyncBulkIndexer(esClient, &cfg, false, tb, breaker, zaptest.NewLogger(t))
+			for range 2 {
+				session := bi.StartSession(t.Context())
+				require.NoError(t, session.Add(t.Context(), "foo", "", "", strings.NewReader(`{"foo": "bar"}`), nil, docappender.ActionCreate))
+				assert.Error(t, session.Flush(t.Context()))
+				session.End()
+			}
+
+			if tt.wantOpen {
+				assert.Equal(t, circuitbreaker.StateOpen, breaker.State())
+				assert.Equal(t, int64(1), reqCnt.Load(), "the open circuit breaker rejects the second request")
+			} else {
+				assert.Equal(t, circuitbreaker.StateClosed, breaker.State())
+				assert.Equal(t, int64(2), reqCnt.Load())
+			}
+		})
+	}
+}
+
+func TestSyncBulkIndexerCircuitBreakerRetry(t *testing.T) {
+	var reqCnt atomic.Int64
+	esClient, err := elastictransport.New(elastictransport.Config{
+		URLs: []*url.URL{{Scheme: "http", Host: "localhost:9200"}},
+		Transport: &mockTransport{
+			RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+				statusCode := http.StatusOK
+				if r.URL.Path == "/_bulk" && reqCnt.Add(1) == 1 {
+					statusCode = http.StatusInternalServerError
+				}
+				return &http.Response{
+					Header:     http.Header{"X-Elastic-Product": []string{"Elasticsearch"}},
+					Body:       io.NopCloser(strings.NewReader(successResp)),
+					StatusCode: statusCode,
+				}, nil
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tb, err := metadata.NewTelemetryBuilder(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	breakerCfg := circuitbreaker.NewDefaultConfig()
+	breakerCfg.Enabled = true
+	breakerCfg.FailureThreshold = 1
+	breakerCfg.OpenTimeout = 10 * time.Millisecond
+	breaker, err := circuitbreaker.New(breakerCfg, componenttest.NewNopTelemetrySettings().MeterProvider.Meter(""))
+	require.NoError(t, err)
+
+	cfg := Config{Retry: RetrySettings{Enabled: true, InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}}
+	bi := newSyncBulkIndexer(esClient, &cfg, false, tb, breaker, zaptest.NewLogger(t))
+
+	session := bi.StartSession(t.Context())
+	require.NoError(t, session.Add(t.Context(), "foo", "", "", strings.NewReader(`{"foo": "bar"}`), nil, docappender.ActionCreate))
+	assert.Error(t, session.Flush(t.Context()))
+	session.End()
+	require.Equal(t, circuitbreaker.StateOpen, breaker.State())
+
+	// the documents are kept until the probe request is let through
+	session = bi.StartSession(t.Context())
+	require.NoError(t, session.Add(t.Context(), "foo", "", "", strings.NewReader(`{"foo": "bar"}`), nil, docappender.ActionCreate))
+	require.NoError(t, session.Flush(t.Context()))
+	session.End()
+	assert.Equal(t, int64(2), reqCnt.Load())
+	assert.Equal(t, circuitbreaker.StateClosed, breaker.State())
+}
+
 func TestQueryParamsParsedFromEndpoints(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	cfg.Endpoints = []string{"http://localhost:9200?pipeline=test-pipeline"}
@@ -211,7 +318,7 @@ func TestNewBulkIndexer(t *testing.T) {
 	client, err := newElasticsearchClient(t.Context(), cfg, componenttest.NewNopHost(), componenttest.NewTelemetry().NewTelemetrySettings(), "")
 	require.NoError(t, err)
 
-	bi := newBulkIndexer(client, cfg, true, nil, nil)
+	bi := newBulkIndexer(client, cfg, true, nil, nil, nil)
 	t.Cleanup(func() { bi.Close(t.Context()) })
 }
 
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -20,6 +21,9 @@ import (
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/flatten"
 )
 
 // Config defines configuration for Elastic exporter.
@@ -63,6 +67,20 @@ type Config struct {
 	TracesIndex        string              `mapstructure:"traces_index"`
 	TracesDynamicIndex DynamicIndexSetting `mapstructure:"traces_dynamic_index"`
 
+	// LogsIndexExpression, MetricsIndexExpression and TracesIndexExpression
+	// configure an OTTL value expression evaluated for every log record, data
+	// point and span respectively to compute the target index or data stream.
+	// The expression takes precedence over all other routing settings. An
+	// expression evaluating to nil or an empty string falls back to the
+	// default routing.
+	LogsIndexExpression    string `mapstructure:"logs_index_expression"`
+	MetricsIndexExpression string `mapstructure:"metrics_index_expression"`
+	TracesIndexExpression  string `mapstructure:"traces_index_expression"`
+
+	// DataStreamAutoCreate configures the automatic creation of the data
+	// streams computed by the index expressions.
+	DataStreamAutoCreate DataStreamAutoCreateSettings `mapstructure:"data_stream_auto_create"`
+
 	// LogsDynamicID configures whether log record attribute `elasticsearch.document_id` is set as the document ID in ES.
 	LogsDynamicID DynamicIDSettings `mapstructure:"logs_dynamic_id"`
 
@@ -80,6 +98,14 @@ type Config struct {
 	Discovery               DiscoverySettings      `mapstructure:"discover"`
 	Retry                   RetrySettings          `mapstructure:"retry"`
 
+	// CircuitBreaker stops sending bulk requests to Elasticsearch after consecutive failed
+	// requests, until a probe request succeeds.
+	CircuitBreaker circuitbreaker.Config `mapstructure:"circuit_breaker"`
+
+	// BodyFlattening limits the flattening of the map log bodies into dotted fields in the
+	// `none` and `raw` mapping modes.
+	BodyFlattening flatten.Config `mapstructure:"body_flattening"`
+
 	// Deprecated: [v0.136.0] This config is now deprecated. Use `sending_queue::batch` instead.
 	// If this config is defined then it will be used to configure sending queue's batch provided
 	// sending queue's config are not explicitly defined.
@@ -149,6 +175,29 @@ type DynamicIndexSetting struct {
 	_ struct{}
 }
 
+// DataStreamAutoCreateSettings defines how missing data streams targeted by
+// an index expression are created.
+type DataStreamAutoCreateSettings struct {
+	// Enabled enables the creation of missing data streams. An index template
+	// matching exactly the data stream name is installed before the data
+	// stream is created.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Priority is the priority of the installed index templates.
+	Priority int `mapstructure:"priority"`
+
+	// ComposedOf is the ordered list of component templates the installed
+	// index templates are composed of.
+	ComposedOf []string `mapstructure:"composed_of"`
+
+	// Template holds the settings, mappings and aliases of the installed
+	// index templates.
+	Template map[string]any `mapstructure:"template"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 type DynamicIDSettings struct {
 	Enabled bool `mapstructure:"enabled"`
 
@@ -251,6 +300,14 @@ type RetrySettings struct {
 
 	// RetryOnStatus configures the status codes that trigger request or document level retries.
 	RetryOnStatus []int `mapstructure:"retry_on_status"`
+
+	// Backpressure configures whether documents failing with one of the
+	// RetryOnStatus status codes keep being retried once MaxRetries is
+	// reached, instead of being dropped. Only the failed documents are
+	// resubmitted, with jittered exponential backoff. While documents are
+	// pending retry, the export request does not complete, which propagates
+	// backpressure to the sending queue.
+	Backpressure bool `mapstructure:"backpressure"`
 }
 
 type MappingsSettings struct {
@@ -367,6 +424,9 @@ func (cfg *Config) Validate() error {
 	if cfg.Retry.MaxRetries < 0 {
 		return errors.New("retry::max_retries should be non-negative")
 	}
+	if cfg.Retry.Backpressure && !cfg.Retry.Enabled {
+		return errors.New("retry::backpressure requires retry::enabled to be true")
+	}
 
 	if cfg.LogsIndex != "" && cfg.LogsDynamicIndex.Enabled {
 		return errors.New("must not specify both logs_index and logs_dynamic_index; logs_index should be empty unless all documents should be sent to the same index")
@@ -378,6 +438,18 @@ func (cfg *Config) Validate() error {
 		return errors.New("must not specify both traces_index and traces_dynamic_index; traces_index should be empty unless all documents should be sent to the same index")
 	}
 
+	if _, err := newIndexExpressions(cfg, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
+		return err
+	}
+	if cfg.DataStreamAutoCreate.Enabled {
+		if cfg.LogsIndexExpression == "" && cfg.MetricsIndexExpression == "" && cfg.TracesIndexExpression == "" {
+			return errors.New("data_stream_auto_create requires at least one of logs_index_expression, metrics_index_expression or traces_index_expression")
+		}
+		if cfg.DataStreamAutoCreate.Priority < 0 {
+			return errors.New("data_stream_auto_create::priority should be non-negative")
+		}
+	}
+
 	uniq := map[string]struct{}{}
 	for i, k := range cfg.MetadataKeys {
 		kl := strings.ToLower(k)
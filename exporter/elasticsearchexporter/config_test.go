@@ -23,6 +23,8 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/flatten"
 )
 
 func TestConfig(t *testing.T) {
@@ -118,6 +120,8 @@ func TestConfig(t *testing.T) {
 						http.StatusInternalServerError,
 					},
 				},
+				CircuitBreaker: circuitbreaker.NewDefaultConfig(),
+				BodyFlattening: flatten.NewDefaultConfig(),
 				Mapping: MappingsSettings{
 					Mode: "otel",
 					AllowedModes: []string{
@@ -133,6 +137,9 @@ func TestConfig(t *testing.T) {
 					PrefixSeparator: "-",
 					DateFormat:      "%Y.%m.%d",
 				},
+				DataStreamAutoCreate: DataStreamAutoCreateSettings{
+					Priority: defaultDataStreamTemplatePriority,
+				},
 				TelemetrySettings: TelemetrySettings{
 					LogFailedDocsInputRateLimit: time.Second,
 				},
@@ -197,6 +204,8 @@ func TestConfig(t *testing.T) {
 					MaxInterval:     1 * time.Minute,
 					RetryOnStatus:   []int{http.StatusTooManyRequests, http.StatusInternalServerError},
 				},
+				CircuitBreaker: circuitbreaker.NewDefaultConfig(),
+				BodyFlattening: flatten.NewDefaultConfig(),
 				Mapping: MappingsSettings{
 					Mode:         "otel",
 					AllowedModes: []string{"bodymap", "ecs", "none", "otel", "raw"},
@@ -206,6 +215,9 @@ func TestConfig(t *testing.T) {
 					PrefixSeparator: "-",
 					DateFormat:      "%Y.%m.%d",
 				},
+				DataStreamAutoCreate: DataStreamAutoCreateSettings{
+					Priority: defaultDataStreamTemplatePriority,
+				},
 				TelemetrySettings: TelemetrySettings{
 					LogFailedDocsInputRateLimit: time.Second,
 				},
@@ -270,6 +282,8 @@ func TestConfig(t *testing.T) {
 					MaxInterval:     1 * time.Minute,
 					RetryOnStatus:   []int{http.StatusTooManyRequests, http.StatusInternalServerError},
 				},
+				CircuitBreaker: circuitbreaker.NewDefaultConfig(),
+				BodyFlattening: flatten.NewDefaultConfig(),
 				Mapping: MappingsSettings{
 					Mode:         "otel",
 					AllowedModes: []string{"bodymap", "ecs", "none", "otel", "raw"},
@@ -279,6 +293,9 @@ func TestConfig(t *testing.T) {
 					PrefixSeparator: "-",
 					DateFormat:      "%Y.%m.%d",
 				},
+				DataStreamAutoCreate: DataStreamAutoCreateSettings{
+					Priority: defaultDataStreamTemplatePriority,
+				},
 				TelemetrySettings: TelemetrySettings{
 					LogFailedDocsInputRateLimit: time.Second,
 				},
@@ -344,6 +361,21 @@ func TestConfig(t *testing.T) {
 				cfg.MetadataKeys = []string{"x-test-1", "x-test-2"}
 			}),
 		},
+		{
+			id:         component.NewIDWithName(metadata.Type, "index_expression"),
+			configFile: "config.yaml",
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoint = "https://elastic.example.com:9200"
+
+				cfg.LogsIndexExpression = `Concat(["logs", resource.attributes["service.name"], "default"], "-")`
+				cfg.DataStreamAutoCreate.Enabled = true
+				cfg.DataStreamAutoCreate.Priority = 300
+				cfg.DataStreamAutoCreate.ComposedOf = []string{"logs@mappings"}
+				cfg.DataStreamAutoCreate.Template = map[string]any{
+					"settings": map[string]any{"index.mode": "logsdb"},
+				}
+			}),
+		},
 		{
 			id:         component.NewIDWithName(metadata.Type, "sendingqueue_disabled"),
 			configFile: "config.yaml",
@@ -522,6 +554,14 @@ func TestConfig_Validate(t *testing.T) {
 			}),
 			err: `must not specify both retry::max_requests and retry::max_retries`,
 		},
+		"backpressure with retry disabled": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.Retry.Enabled = false
+				cfg.Retry.Backpressure = true
+			}),
+			err: `retry::backpressure requires retry::enabled to be true`,
+		},
 		"duplicate metadata_keys specified": {
 			config: withDefaultConfig(func(cfg *Config) {
 				cfg.Endpoints = []string{"http://test:9200"}
@@ -529,6 +569,42 @@ func TestConfig_Validate(t *testing.T) {
 			}),
 			err: `metadata_keys must be case-insenstive and unique, found duplicate: x-test-1`,
 		},
+		"invalid logs_index_expression": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.LogsIndexExpression = `Concat(`
+			}),
+			err: `invalid logs_index_expression`,
+		},
+		"invalid metrics_index_expression": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.MetricsIndexExpression = `UnknownFunc(attributes["a"])`
+			}),
+			err: `invalid metrics_index_expression`,
+		},
+		"data_stream_auto_create without index expression": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.DataStreamAutoCreate.Enabled = true
+			}),
+			err: `data_stream_auto_create requires at least one of logs_index_expression, metrics_index_expression or traces_index_expression`,
+		},
+		"circuit_breaker without failure_threshold": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.CircuitBreaker.Enabled = true
+				cfg.CircuitBreaker.FailureThreshold = 0
+			}),
+			err: "circuit_breaker: `failure_threshold` must be positive",
+		},
+		"body_flattening with negative max_depth": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.BodyFlattening.MaxDepth = -1
+			}),
+			err: "body_flattening: `max_depth` must not be negative",
+		},
 	}
 
 	for name, tt := range tests {
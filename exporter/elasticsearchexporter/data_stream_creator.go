@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/elastic-transport-go/v8/elastictransport"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	defaultDataStreamTemplatePriority = 200
+	dataStreamTemplateNamePrefix      = "otel-"
+
+	// dataStreamInitialBackoff and dataStreamMaxBackoff bound the duration during
+	// which the failure to create a data stream is returned without retrying it.
+	dataStreamInitialBackoff = 5 * time.Second
+	dataStreamMaxBackoff     = 5 * time.Minute
+)
+
+// dataStreamCreator creates missing data streams together with an index
+// template matching exactly their name. Data streams which are known to
+// exist are cached for the lifetime of the exporter, and the failures to
+// create them are cached with an exponential backoff.
+type dataStreamCreator struct {
+	client   elastictransport.Interface
+	settings DataStreamAutoCreateSettings
+	logger   *zap.Logger
+	now      func() time.Time
+
+	// known holds the names of the data streams known to exist.
+	known sync.Map
+	// group deduplicates the concurrent creations of the same data stream,
+	// without blocking the creation of the others.
+	group singleflight.Group
+
+	mu sync.Mutex
+	// failures holds the last failure to create each data stream.
+	failures map[string]*dataStreamFailure
+}
+
+type dataStreamFailure struct {
+	err     error
+	backoff time.Duration
+	retryAt time.Time
+}
+
+func newDataStreamCreator(client elastictransport.Interface, settings DataStreamAutoCreateSettings, logger *zap.Logger) *dataStreamCreator {
+	return &dataStreamCreator{
+		client:   client,
+		settings: settings,
+		logger:   logger,
+		now:      time.Now,
+		failures: map[string]*dataStreamFailure{},
+	}
+}
+
+// ensure makes sure the data stream with the given name exists, creating it
+// and its index template if needed.
+func (c *dataStreamCreator) ensure(ctx context.Context, name string) error {
+	if _, ok := c.known.Load(name); ok {
+		return nil
+	}
+
+	c.mu.Lock()
+	failure, failed := c.failures[name]
+	c.mu.Unlock()
+	if failed && c.now().Before(failure.retryAt) {
+		return failure.err
+	}
+
+	_, err, _ := c.group.Do(name, func() (any, error) {
+		err := c.create(ctx, name)
+		c.recordResult(name, err)
+		return nil, err
+	})
+	return err
+}
+
+// recordResult caches the result of the creation of the data stream.
+func (c *dataStreamCreator) recordResult(name string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil {
+		c.known.Store(name, struct{}{})
+		delete(c.failures, name)
+		return
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		// the request was interrupted, it says nothing about the data stream
+		return
+	}
+	backoff := dataStreamInitialBackoff
+	if failure, ok := c.failures[name]; ok {
+		backoff = min(2*failure.backoff, dataStreamMaxBackoff)
+	}
+	c.failures[name] = &dataStreamFailure{err: err, backoff: backoff, retryAt: c.now().Add(backoff)}
+}
+
+// create checks whether the data stream exists, and creates it otherwise.
+func (c *dataStreamCreator) create(ctx context.Context, name string) error {
+	status, _, err := c.do(ctx, http.MethodGet, "/_data_stream/"+url.PathEscape(name), nil)
+	if err != nil {
+		return fmt.Errorf("failed to get data stream %q: %w", name, err)
+	}
+	if status == http.StatusOK {
+		return nil
+	}
+	if status != http.StatusNotFound {
+		return fmt.Errorf("failed to get data stream %q: unexpected status code %d", name, status)
+	}
+
+	template := map[string]any{
+		"index_patterns": []string{name},
+		"data_stream":    map[string]any{},
+		"priority":       c.settings.Priority,
+	}
+	if len(c.settings.ComposedOf) > 0 {
+		template["composed_of"] = c.settings.ComposedOf
+	}
+	if len(c.settings.Template) > 0 {
+		template["template"] = c.settings.Template
+	}
+	body, err := json.Marshal(template)
+	if err != nil {
+		return fmt.Errorf("failed to encode index template for data stream %q: %w", name, err)
+	}
+	templateName := dataStreamTemplateNamePrefix + name
+	if err := c.put(ctx, "/_index_template/"+url.PathEscape(templateName), body); err != nil {
+		return fmt.Errorf("failed to put index template %q: %w", templateName, err)
+	}
+	if err := c.put(ctx, "/_data_stream/"+url.PathEscape(name), nil); err != nil {
+		return fmt.Errorf("failed to create data stream %q: %w", name, err)
+	}
+
+	c.logger.Info("created data stream", zap.String("data_stream", name), zap.String("index_template", templateName))
+	return nil
+}
+
+// put performs a PUT request, tolerating resources that already exist.
+func (c *dataStreamCreator) put(ctx context.Context, path string, body []byte) error {
+	status, respBody, err := c.do(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return err
+	}
+	if status >= 200 && status < 300 {
+		return nil
+	}
+	if status == http.StatusBadRequest && strings.Contains(string(respBody), "resource_already_exists_exception") {
+		return nil
+	}
+	return fmt.Errorf("unexpected status code %d: %s", status, respBody)
+}
+
+func (c *dataStreamCreator) do(ctx context.Context, method, path string, body []byte) (int, []byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, path, reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.client.Perform(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, respBody, nil
+}
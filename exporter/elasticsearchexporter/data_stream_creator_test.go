@@ -0,0 +1,229 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/elastic/elastic-transport-go/v8/elastictransport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDataStreamCreator(t *testing.T) {
+	tests := []struct {
+		name string
+		// statuses and responses map "<method> <path>" to the returned
+		// status code and body.
+		statuses         map[string]int
+		responses        map[string]string
+		expectedRequests []string
+		expectedErr      string
+	}{
+		{
+			name: "existing data stream",
+			statuses: map[string]int{
+				"GET /_data_stream/logs-foo-default": http.StatusOK,
+			},
+			expectedRequests: []string{
+				"GET /_data_stream/logs-foo-default",
+			},
+		},
+		{
+			name: "missing data stream",
+			statuses: map[string]int{
+				"GET /_data_stream/logs-foo-default":         http.StatusNotFound,
+				"PUT /_index_template/otel-logs-foo-default": http.StatusOK,
+				"PUT /_data_stream/logs-foo-default":         http.StatusOK,
+			},
+			expectedRequests: []string{
+				"GET /_data_stream/logs-foo-default",
+				"PUT /_index_template/otel-logs-foo-default",
+				"PUT /_data_stream/logs-foo-default",
+			},
+		},
+		{
+			name: "data stream created concurrently",
+			statuses: map[string]int{
+				"GET /_data_stream/logs-foo-default":         http.StatusNotFound,
+				"PUT /_index_template/otel-logs-foo-default": http.StatusOK,
+				"PUT /_data_stream/logs-foo-default":         http.StatusBadRequest,
+			},
+			responses: map[string]string{
+				"PUT /_data_stream/logs-foo-default": `{"error":{"type":"resource_already_exists_exception"}}`,
+			},
+			expectedRequests: []string{
+				"GET /_data_stream/logs-foo-default",
+				"PUT /_index_template/otel-logs-foo-default",
+				"PUT /_data_stream/logs-foo-default",
+			},
+		},
+		{
+			name: "index template rejected",
+			statuses: map[string]int{
+				"GET /_data_stream/logs-foo-default":         http.StatusNotFound,
+				"PUT /_index_template/otel-logs-foo-default": http.StatusBadRequest,
+			},
+			responses: map[string]string{
+				"PUT /_index_template/otel-logs-foo-default": `{"error":{"type":"illegal_argument_exception"}}`,
+			},
+			expectedRequests: []string{
+				"GET /_data_stream/logs-foo-default",
+				"PUT /_index_template/otel-logs-foo-default",
+			},
+			expectedErr: `failed to put index template "otel-logs-foo-default": unexpected status code 400`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var requests []string
+			var templateBody map[string]any
+			esClient, err := elastictransport.New(elastictransport.Config{
+				URLs: []*url.URL{{Scheme: "http", Host: "localhost:9200"}},
+				Transport: &mockTransport{
+					RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+						key := r.Method + " " + r.URL.Path
+						mu.Lock()
+						requests = append(requests, key)
+						mu.Unlock()
+						if strings.HasPrefix(r.URL.Path, "/_index_template/") {
+							require.NoError(t, json.NewDecoder(r.Body).Decode(&templateBody))
+						}
+						return &http.Response{
+							Header:     http.Header{"X-Elastic-Product": []string{"Elasticsearch"}},
+							Body:       io.NopCloser(strings.NewReader(tt.responses[key])),
+							StatusCode: tt.statuses[key],
+						}, nil
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			creator := newDataStreamCreator(esClient, DataStreamAutoCreateSettings{
+				Enabled:    true,
+				Priority:   300,
+				ComposedOf: []string{"logs@mappings"},
+			}, zap.NewNop())
+
+			err = creator.ensure(t.Context(), "logs-foo-default")
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				// The data stream is known to exist, no further requests are sent.
+				require.NoError(t, creator.ensure(t.Context(), "logs-foo-default"))
+			}
+			assert.Equal(t, tt.expectedRequests, requests)
+
+			if templateBody != nil {
+				assert.Equal(t, map[string]any{
+					"index_patterns": []any{"logs-foo-default"},
+					"data_stream":    map[string]any{},
+					"priority":       float64(300),
+					"composed_of":    []any{"logs@mappings"},
+				}, templateBody)
+			}
+		})
+	}
+}
+
+func TestDataStreamCreatorFailureBackoff(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	status := http.StatusInternalServerError
+	esClient, err := elastictransport.New(elastictransport.Config{
+		URLs: []*url.URL{{Scheme: "http", Host: "localhost:9200"}},
+		Transport: &mockTransport{
+			RoundTripFunc: func(*http.Request) (*http.Response, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				requests++
+				return &http.Response{
+					Header:     http.Header{"X-Elastic-Product": []string{"Elasticsearch"}},
+					Body:       io.NopCloser(strings.NewReader("")),
+					StatusCode: status,
+				}, nil
+			},
+		},
+		DisableRetry: true,
+	})
+	require.NoError(t, err)
+
+	creator := newDataStreamCreator(esClient, DataStreamAutoCreateSettings{Enabled: true}, zap.NewNop())
+	now := time.Now()
+	creator.now = func() time.Time { return now }
+
+	// the failure is returned without retrying it during the backoff
+	require.ErrorContains(t, creator.ensure(t.Context(), "logs-foo-default"), "unexpected status code 500")
+	require.ErrorContains(t, creator.ensure(t.Context(), "logs-foo-default"), "unexpected status code 500")
+	assert.Equal(t, 1, requests)
+
+	// the backoff doubles after each failure
+	now = now.Add(dataStreamInitialBackoff)
+	require.Error(t, creator.ensure(t.Context(), "logs-foo-default"))
+	assert.Equal(t, 2, requests)
+	now = now.Add(dataStreamInitialBackoff)
+	require.Error(t, creator.ensure(t.Context(), "logs-foo-default"))
+	assert.Equal(t, 2, requests)
+
+	now = now.Add(dataStreamInitialBackoff)
+	mu.Lock()
+	status = http.StatusOK
+	mu.Unlock()
+	require.NoError(t, creator.ensure(t.Context(), "logs-foo-default"))
+	assert.Equal(t, 3, requests)
+	assert.Empty(t, creator.failures)
+}
+
+func TestDataStreamCreatorConcurrent(t *testing.T) {
+	var requests sync.Map
+	release := make(chan struct{})
+	esClient, err := elastictransport.New(elastictransport.Config{
+		URLs: []*url.URL{{Scheme: "http", Host: "localhost:9200"}},
+		Transport: &mockTransport{
+			RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+				count, _ := requests.LoadOrStore(r.URL.Path, new(atomic.Int32))
+				count.(*atomic.Int32).Add(1)
+				if r.URL.Path == "/_data_stream/logs-slow-default" {
+					<-release
+				}
+				return &http.Response{
+					Header:     http.Header{"X-Elastic-Product": []string{"Elasticsearch"}},
+					Body:       io.NopCloser(strings.NewReader("")),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		},
+	})
+	require.NoError(t, err)
+	creator := newDataStreamCreator(esClient, DataStreamAutoCreateSettings{Enabled: true}, zap.NewNop())
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, creator.ensure(t.Context(), "logs-slow-default"))
+		}()
+	}
+
+	// a slow data stream doesn't block the others
+	require.NoError(t, creator.ensure(t.Context(), "logs-fast-default"))
+	close(release)
+	wg.Wait()
+
+	count, _ := requests.Load("/_data_stream/logs-fast-default")
+	assert.Equal(t, int32(1), count.(*atomic.Int32).Load())
+}
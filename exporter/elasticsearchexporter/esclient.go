@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/rand/v2"
 	"net/http"
 	"net/url"
@@ -266,7 +267,12 @@ func createElasticsearchBackoffFunc(config *RetrySettings) func(int) time.Durati
 	}
 
 	return func(attempts int) time.Duration {
-		next := min(config.MaxInterval, config.InitialInterval*(1<<(attempts-1)))
+		// Compute the exponential backoff in floating point so that the
+		// unbounded number of attempts of retry::backpressure cannot overflow.
+		next := time.Duration(min(
+			float64(config.MaxInterval),
+			float64(config.InitialInterval)*math.Pow(2, float64(attempts-1)),
+		))
 		nextWithJitter := next/2 + time.Duration(rand.Float64()*float64(next/2))
 		return nextWithJitter
 	}
@@ -87,3 +87,29 @@ func (tsr *testStatusReporter) Report(event *componentstatus.Event) {
 func (*testStatusReporter) GetExtensions() map[component.ID]component.Component {
 	return make(map[component.ID]component.Component)
 }
+
+func TestCreateElasticsearchBackoffFunc(t *testing.T) {
+	backoff := createElasticsearchBackoffFunc(&RetrySettings{
+		Enabled:         true,
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     time.Minute,
+	})
+	require.NotNil(t, backoff)
+
+	for _, tt := range []struct {
+		attempts int
+		max      time.Duration
+	}{
+		{attempts: 1, max: 100 * time.Millisecond},
+		{attempts: 3, max: 400 * time.Millisecond},
+		{attempts: 20, max: time.Minute},
+		// retry::backpressure allows an unbounded number of attempts
+		{attempts: 1000, max: time.Minute},
+	} {
+		next := backoff(tt.attempts)
+		assert.GreaterOrEqual(t, next, tt.max/2, "attempts %d", tt.attempts)
+		assert.LessOrEqual(t, next, tt.max, "attempts %d", tt.attempts)
+	}
+
+	assert.Nil(t, createElasticsearchBackoffFunc(&RetrySettings{Enabled: false}))
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/metricgroup"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/pool"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/serializer/otelserializer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/flatten"
 )
 
 type elasticsearchExporter struct {
@@ -43,6 +45,7 @@ type elasticsearchExporter struct {
 	documentEncoders         [NumMappingModes]documentEncoder
 	documentRouters          [NumMappingModes]documentRouter
 	spanEventDocumentRouters [NumMappingModes]documentRouter
+	indexExpressions         *indexExpressions
 
 	telemetryBuilder *metadata.TelemetryBuilder
 }
@@ -52,6 +55,17 @@ func newExporter(cfg *Config, set exporter.Settings, index string) (*elasticsear
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize internal telemetry: %w", err)
 	}
+	breaker, err := circuitbreaker.New(cfg.CircuitBreaker, metadata.Meter(set.TelemetrySettings))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize circuit breaker: %w", err)
+	}
+	var bodyFlattener *flatten.Flattener
+	if cfg.BodyFlattening.HasLimits() {
+		bodyFlattener, err = flatten.New(cfg.BodyFlattening, metadata.Meter(set.TelemetrySettings))
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize body flattening: %w", err)
+		}
+	}
 
 	allowedMappingModes := cfg.allowedMappingModes()
 	defaultMappingMode := allowedMappingModes[canonicalMappingModeName(cfg.Mapping.Mode)]
@@ -63,11 +77,16 @@ func newExporter(cfg *Config, set exporter.Settings, index string) (*elasticsear
 		allowedMappingModes: allowedMappingModes,
 		defaultMappingMode:  defaultMappingMode,
 		bufferPool:          pool.NewBufferPool(),
-		bulkIndexers:        bulkIndexers{telemetryBuilder: telemetryBuilder},
+		bulkIndexers:        bulkIndexers{telemetryBuilder: telemetryBuilder, breaker: breaker},
 		telemetryBuilder:    telemetryBuilder,
 	}
+	indexExpressions, err := newIndexExpressions(cfg, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	exporter.indexExpressions = indexExpressions
 	for mappingMode := range NumMappingModes {
-		encoder, err := newEncoder(mappingMode)
+		encoder, err := newEncoder(mappingMode, bodyFlattener)
 		if err != nil {
 			return nil, err
 		}
@@ -93,9 +112,21 @@ func (e *elasticsearchExporter) Shutdown(ctx context.Context) error {
 		e.telemetryBuilder.Shutdown()
 		e.telemetryBuilder = nil
 	}
+	if err := e.bulkIndexers.breaker.Shutdown(); err != nil {
+		return fmt.Errorf("error shutting down circuit breaker: %w", err)
+	}
 	return nil
 }
 
+// ensureDataStream creates the data stream targeted by an index expression
+// if it does not exist yet and data_stream_auto_create is enabled.
+func (e *elasticsearchExporter) ensureDataStream(ctx context.Context, index elasticsearch.Index) error {
+	if e.bulkIndexers.dataStreamCreator == nil || !index.IsDataStream() {
+		return nil
+	}
+	return e.bulkIndexers.dataStreamCreator.ensure(ctx, index.Index)
+}
+
 func (e *elasticsearchExporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
 	defaultMappingMode, err := e.getRequestMappingMode(ctx)
 	if err != nil {
@@ -125,7 +156,7 @@ func (e *elasticsearchExporter) pushLogsData(ctx context.Context, ld plog.Logs)
 			}
 
 			for _, lr := range ill.LogRecords().All() {
-				if err := e.pushLogRecord(ctx, router, encoder, ec, lr, session); err != nil {
+				if err := e.pushLogRecord(ctx, router, encoder, ec, rl, ill, lr, session); err != nil {
 					if cerr := ctx.Err(); cerr != nil {
 						return cerr
 					}
@@ -155,13 +186,22 @@ func (e *elasticsearchExporter) pushLogRecord(
 	router documentRouter,
 	encoder documentEncoder,
 	ec encodingContext,
+	rl plog.ResourceLogs,
+	sl plog.ScopeLogs,
 	record plog.LogRecord,
 	bulkIndexerSession bulkIndexerSession,
 ) error {
-	index, err := router.routeLogRecord(ec.resource, ec.scope, record.Attributes())
+	index, ok, err := e.indexExpressions.evalLogRecord(ctx, rl, sl, record)
 	if err != nil {
 		return err
 	}
+	if ok {
+		if err := e.ensureDataStream(ctx, index); err != nil {
+			return err
+		}
+	} else if index, err = router.routeLogRecord(ec.resource, ec.scope, record.Attributes()); err != nil {
+		return err
+	}
 
 	buf := e.bufferPool.NewPooledBuffer()
 	docID := e.extractDocumentIDAttribute(record.Attributes())
@@ -224,11 +264,18 @@ func (e *elasticsearchExporter) pushMetricsData(ctx context.Context, metrics pme
 
 			hasher.UpdateScope(scope)
 			for _, metric := range scopeMetrics.Metrics().All() {
-				upsertDataPoint := func(dp datapoints.DataPoint) error {
-					index, err := router.routeDataPoint(resource, scope, dp.Attributes())
+				upsertDataPoint := func(dp datapoints.DataPoint, rawDP any) error {
+					index, ok, err := e.indexExpressions.evalDataPoint(ctx, resourceMetrics, scopeMetrics, metric, rawDP)
 					if err != nil {
 						return err
 					}
+					if ok {
+						if err := e.ensureDataStream(ctx, index); err != nil {
+							return err
+						}
+					} else if index, err = router.routeDataPoint(resource, scope, dp.Attributes()); err != nil {
+						return err
+					}
 					key := mappingIndexKey{
 						mappingMode: mappingMode,
 						index:       index,
@@ -258,14 +305,14 @@ func (e *elasticsearchExporter) pushMetricsData(ctx context.Context, metrics pme
 				switch metric.Type() {
 				case pmetric.MetricTypeSum:
 					for _, dp := range metric.Sum().DataPoints().All() {
-						if err := upsertDataPoint(datapoints.NewNumber(metric, dp)); err != nil {
+						if err := upsertDataPoint(datapoints.NewNumber(metric, dp), dp); err != nil {
 							validationErrs = append(validationErrs, err)
 							continue
 						}
 					}
 				case pmetric.MetricTypeGauge:
 					for _, dp := range metric.Gauge().DataPoints().All() {
-						if err := upsertDataPoint(datapoints.NewNumber(metric, dp)); err != nil {
+						if err := upsertDataPoint(datapoints.NewNumber(metric, dp), dp); err != nil {
 							validationErrs = append(validationErrs, err)
 							continue
 						}
@@ -276,7 +323,7 @@ func (e *elasticsearchExporter) pushMetricsData(ctx context.Context, metrics pme
 						continue
 					}
 					for _, dp := range metric.ExponentialHistogram().DataPoints().All() {
-						if err := upsertDataPoint(datapoints.NewExponentialHistogram(metric, dp)); err != nil {
+						if err := upsertDataPoint(datapoints.NewExponentialHistogram(metric, dp), dp); err != nil {
 							validationErrs = append(validationErrs, err)
 							continue
 						}
@@ -287,14 +334,14 @@ func (e *elasticsearchExporter) pushMetricsData(ctx context.Context, metrics pme
 						continue
 					}
 					for _, dp := range metric.Histogram().DataPoints().All() {
-						if err := upsertDataPoint(datapoints.NewHistogram(metric, dp)); err != nil {
+						if err := upsertDataPoint(datapoints.NewHistogram(metric, dp), dp); err != nil {
 							validationErrs = append(validationErrs, err)
 							continue
 						}
 					}
 				case pmetric.MetricTypeSummary:
 					for _, dp := range metric.Summary().DataPoints().All() {
-						if err := upsertDataPoint(datapoints.NewSummary(metric, dp)); err != nil {
+						if err := upsertDataPoint(datapoints.NewSummary(metric, dp), dp); err != nil {
 							validationErrs = append(validationErrs, err)
 							continue
 						}
@@ -384,7 +431,7 @@ func (e *elasticsearchExporter) pushTraceData(
 			}
 
 			for _, span := range scopeSpan.Spans().All() {
-				if err := e.pushTraceRecord(ctx, router, encoder, ec, span, session); err != nil {
+				if err := e.pushTraceRecord(ctx, router, encoder, ec, il, scopeSpan, span, session); err != nil {
 					if cerr := ctx.Err(); cerr != nil {
 						return cerr
 					}
@@ -413,13 +460,22 @@ func (e *elasticsearchExporter) pushTraceRecord(
 	router documentRouter,
 	encoder documentEncoder,
 	ec encodingContext,
+	rs ptrace.ResourceSpans,
+	ss ptrace.ScopeSpans,
 	span ptrace.Span,
 	bulkIndexerSession bulkIndexerSession,
 ) error {
-	index, err := router.routeSpan(ec.resource, ec.scope, span.Attributes())
+	index, ok, err := e.indexExpressions.evalSpan(ctx, rs, ss, span)
 	if err != nil {
 		return err
 	}
+	if ok {
+		if err := e.ensureDataStream(ctx, index); err != nil {
+			return err
+		}
+	} else if index, err = router.routeSpan(ec.resource, ec.scope, span.Attributes()); err != nil {
+		return err
+	}
 
 	buf := e.bufferPool.NewPooledBuffer()
 	if err := encoder.encodeSpan(ec, span, index, buf.Buffer); err != nil {
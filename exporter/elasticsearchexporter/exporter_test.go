@@ -381,6 +381,60 @@ func TestExporterLogs(t *testing.T) {
 		rec.WaitItems(1)
 	})
 
+	t.Run("publish with index expression", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.Mapping.Mode = "otel"
+			cfg.LogsIndexExpression = `attributes["index"]`
+		})
+		logs := newLogsWithAttributes(map[string]any{"index": "logs-checkout-prod"}, nil, nil)
+		// Records for which the expression evaluates to nil use the default routing.
+		logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		mustSendLogs(t, exporter, logs)
+
+		docs := rec.WaitItems(2)
+		assert.Equal(t, "logs-checkout-prod", actionJSONToIndex(t, docs[0].Action))
+		assert.JSONEq(t, `{"type":"logs","dataset":"checkout","namespace":"prod"}`, gjson.GetBytes(docs[0].Document, "data_stream").Raw)
+		assert.Equal(t, "logs-generic.otel-default", actionJSONToIndex(t, docs[1].Action))
+	})
+
+	t.Run("publish with index expression, non data stream index", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.LogsIndexExpression = `Concat(["audit", attributes["app"]], "_")`
+		})
+		mustSendLogs(t, exporter, newLogsWithAttributes(map[string]any{"app": "checkout"}, nil, nil))
+
+		docs := rec.WaitItems(1)
+		assert.Equal(t, "audit_checkout", actionJSONToIndex(t, docs[0].Action))
+	})
+
+	t.Run("publish with index expression, non string result", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.QueueBatchConfig.Get().WaitForResult = true
+			cfg.LogsIndexExpression = `attributes["enabled"]`
+		})
+		logs := newLogsWithAttributes(map[string]any{"enabled": true}, nil, nil)
+		err := exporter.ConsumeLogs(t.Context(), logs)
+		assert.ErrorContains(t, err, "index expression must evaluate to a string, got bool")
+	})
+
 	t.Run("publish with logstash index format enabled", func(t *testing.T) {
 		index := "someindex"
 		rec := newBulkRecorder()
@@ -779,6 +833,38 @@ func TestExporterLogs(t *testing.T) {
 		rec.WaitItems(1)
 	})
 
+	t.Run("retry failed items until indexed with backpressure", func(t *testing.T) {
+		const failedAttempts = 5
+		var attempts atomic.Int64
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			if attempts.Add(1) <= failedAttempts {
+				return itemsReportStatus(docs, http.StatusServiceUnavailable)
+			}
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.Retry.MaxRetries = 1
+			cfg.Retry.Backpressure = true
+			cfg.Retry.RetryOnStatus = []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}
+			cfg.Retry.InitialInterval = 1 * time.Millisecond
+			cfg.Retry.MaxInterval = 5 * time.Millisecond
+
+			// use sync flushing
+			cfg.QueueBatchConfig.Get().WaitForResult = true
+		})
+
+		logs := plog.NewLogs()
+		logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		// as sync bulk indexer is used, the export request is blocked until the document is indexed
+		require.NoError(t, exporter.ConsumeLogs(t.Context(), logs))
+
+		assert.Equal(t, 1, rec.countItems())
+		assert.Equal(t, int64(failedAttempts+1), attempts.Load())
+	})
+
 	t.Run("do not retry bad item", func(t *testing.T) {
 		attempts := &atomic.Int64{}
 		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
@@ -1083,6 +1169,25 @@ func TestExporterMetrics(t *testing.T) {
 		rec.WaitItems(1)
 	})
 
+	t.Run("publish with index expression", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestMetricsExporter(t, server.URL, func(cfg *Config) {
+			cfg.Mapping.Mode = "otel"
+			cfg.MetricsIndexExpression = `Concat(["metrics", metric.name, "default"], "-")`
+		})
+		metrics := newMetricsWithAttributes(nil, nil, nil)
+		metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).SetName("my.metric")
+		mustSendMetrics(t, exporter, metrics)
+
+		docs := rec.WaitItems(1)
+		assert.Equal(t, "metrics-my.metric-default", actionJSONToIndex(t, docs[0].Action))
+	})
+
 	t.Run("publish histogram", func(t *testing.T) {
 		rec := newBulkRecorder()
 		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
@@ -2045,6 +2150,23 @@ func TestExporterTraces(t *testing.T) {
 		rec.WaitItems(1)
 	})
 
+	t.Run("publish with index expression", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestTracesExporter(t, server.URL, func(cfg *Config) {
+			cfg.Mapping.Mode = "otel"
+			cfg.TracesIndexExpression = `Concat(["traces", resource.attributes["service.name"], "default"], "-")`
+		})
+		mustSendTraces(t, exporter, newTracesWithAttributes(nil, nil, map[string]any{"service.name": "checkout"}))
+
+		docs := rec.WaitItems(1)
+		assert.Equal(t, "traces-checkout-default", actionJSONToIndex(t, docs[0].Action))
+	})
+
 	t.Run("publish with logstash format index, default traces index", func(t *testing.T) {
 		var defaultCfg Config
 
@@ -23,6 +23,8 @@ import (
 	"go.opentelemetry.io/collector/exporter/xexporter"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/flatten"
 )
 
 // NewFactory creates a factory for Elastic exporter.
@@ -71,6 +73,8 @@ func createDefaultConfig() component.Config {
 				http.StatusTooManyRequests,
 			},
 		},
+		CircuitBreaker: circuitbreaker.NewDefaultConfig(),
+		BodyFlattening: flatten.NewDefaultConfig(),
 		Mapping: MappingsSettings{
 			Mode:         "otel",
 			AllowedModes: slices.Sorted(maps.Keys(canonicalMappingModes)),
@@ -80,6 +84,10 @@ func createDefaultConfig() component.Config {
 			PrefixSeparator: "-",
 			DateFormat:      "%Y.%m.%d",
 		},
+		DataStreamAutoCreate: DataStreamAutoCreateSettings{
+			Enabled:  false,
+			Priority: defaultDataStreamTemplatePriority,
+		},
 		TelemetrySettings: TelemetrySettings{
 			LogRequestBody:              false,
 			LogResponseBody:             false,
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/elasticsearch"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// indexExpressions holds the parsed OTTL value expressions used to compute
+// the target index of a document. A nil expression means that the default
+// routing applies.
+type indexExpressions struct {
+	logs    *ottl.ValueExpression[*ottllog.TransformContext]
+	metrics *ottl.ValueExpression[*ottldatapoint.TransformContext]
+	traces  *ottl.ValueExpression[*ottlspan.TransformContext]
+}
+
+func newIndexExpressions(cfg *Config, set component.TelemetrySettings) (*indexExpressions, error) {
+	var exprs indexExpressions
+	if cfg.LogsIndexExpression != "" {
+		parser, err := ottllog.NewParser(ottlfuncs.StandardConverters[*ottllog.TransformContext](), set)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create logs parser: %w", err)
+		}
+		if exprs.logs, err = parser.ParseValueExpression(cfg.LogsIndexExpression); err != nil {
+			return nil, fmt.Errorf("invalid logs_index_expression: %w", err)
+		}
+	}
+	if cfg.MetricsIndexExpression != "" {
+		parser, err := ottldatapoint.NewParser(ottlfuncs.StandardConverters[*ottldatapoint.TransformContext](), set)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create metrics parser: %w", err)
+		}
+		if exprs.metrics, err = parser.ParseValueExpression(cfg.MetricsIndexExpression); err != nil {
+			return nil, fmt.Errorf("invalid metrics_index_expression: %w", err)
+		}
+	}
+	if cfg.TracesIndexExpression != "" {
+		parser, err := ottlspan.NewParser(ottlfuncs.StandardConverters[*ottlspan.TransformContext](), set)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create traces parser: %w", err)
+		}
+		if exprs.traces, err = parser.ParseValueExpression(cfg.TracesIndexExpression); err != nil {
+			return nil, fmt.Errorf("invalid traces_index_expression: %w", err)
+		}
+	}
+	return &exprs, nil
+}
+
+// evalLogRecord evaluates the logs index expression. It returns false if no
+// expression is configured or the expression evaluated to an empty value.
+func (e *indexExpressions) evalLogRecord(ctx context.Context, rl plog.ResourceLogs, sl plog.ScopeLogs, lr plog.LogRecord) (elasticsearch.Index, bool, error) {
+	if e == nil || e.logs == nil {
+		return elasticsearch.Index{}, false, nil
+	}
+	tCtx := ottllog.NewTransformContextPtr(rl, sl, lr)
+	defer tCtx.Close()
+	val, err := e.logs.Eval(ctx, tCtx)
+	return indexFromExpressionResult(val, err)
+}
+
+// evalDataPoint evaluates the metrics index expression. It returns false if no
+// expression is configured or the expression evaluated to an empty value.
+func (e *indexExpressions) evalDataPoint(ctx context.Context, rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, metric pmetric.Metric, dp any) (elasticsearch.Index, bool, error) {
+	if e == nil || e.metrics == nil {
+		return elasticsearch.Index{}, false, nil
+	}
+	tCtx := ottldatapoint.NewTransformContextPtr(rm, sm, metric, dp)
+	defer tCtx.Close()
+	val, err := e.metrics.Eval(ctx, tCtx)
+	return indexFromExpressionResult(val, err)
+}
+
+// evalSpan evaluates the traces index expression. It returns false if no
+// expression is configured or the expression evaluated to an empty value.
+func (e *indexExpressions) evalSpan(ctx context.Context, rs ptrace.ResourceSpans, ss ptrace.ScopeSpans, span ptrace.Span) (elasticsearch.Index, bool, error) {
+	if e == nil || e.traces == nil {
+		return elasticsearch.Index{}, false, nil
+	}
+	tCtx := ottlspan.NewTransformContextPtr(rs, ss, span)
+	defer tCtx.Close()
+	val, err := e.traces.Eval(ctx, tCtx)
+	return indexFromExpressionResult(val, err)
+}
+
+// indexFromExpressionResult converts the result of an index expression to an
+// index. Names following the `<type>-<dataset>-<namespace>` data stream naming
+// scheme with a known type are returned as data stream indices so that the
+// `data_stream.*` fields of the document are kept consistent with the target.
+func indexFromExpressionResult(val any, err error) (elasticsearch.Index, bool, error) {
+	if err != nil {
+		return elasticsearch.Index{}, false, fmt.Errorf("failed to evaluate index expression: %w", err)
+	}
+	if val == nil {
+		return elasticsearch.Index{}, false, nil
+	}
+	name, ok := val.(string)
+	if !ok {
+		return elasticsearch.Index{}, false, fmt.Errorf("index expression must evaluate to a string, got %T", val)
+	}
+	if name == "" {
+		return elasticsearch.Index{}, false, nil
+	}
+
+	parts := strings.SplitN(name, "-", 3)
+	if len(parts) == 3 && parts[1] != "" && parts[2] != "" {
+		switch parts[0] {
+		case defaultDataStreamTypeLogs, defaultDataStreamTypeMetrics, defaultDataStreamTypeTraces:
+			return elasticsearch.NewDataStreamIndex(parts[0], parts[1], parts[2]), true, nil
+		}
+	}
+	return elasticsearch.Index{Index: name}, true, nil
+}
@@ -5,6 +5,7 @@ package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -22,6 +23,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/objmodel"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/serializer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/serializer/otelserializer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/flatten"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/traceutil"
 )
 
@@ -97,7 +99,9 @@ type encodingContext struct {
 	scopeSchemaURL    string
 }
 
-func newEncoder(mode MappingMode) (documentEncoder, error) {
+// newEncoder creates the encoder of the mapping mode. The bodyFlattener limits the flattening
+// of the map log bodies in the none and raw mapping modes, it is nil if there are no limits.
+func newEncoder(mode MappingMode, bodyFlattener *flatten.Flattener) (documentEncoder, error) {
 	switch mode {
 	case MappingNone:
 		return legacyModeEncoder{
@@ -108,6 +112,7 @@ func newEncoder(mode MappingMode) (documentEncoder, error) {
 				eventsPrefix:     "Events",
 			},
 			attributesPrefix: "Attributes",
+			bodyFlattener:    bodyFlattener,
 		}, nil
 	case MappingRaw:
 		return legacyModeEncoder{
@@ -118,6 +123,7 @@ func newEncoder(mode MappingMode) (documentEncoder, error) {
 				eventsPrefix:     "",
 			},
 			attributesPrefix: "",
+			bodyFlattener:    bodyFlattener,
 		}, nil
 	case MappingECS:
 		return ecsModeEncoder{
@@ -144,6 +150,7 @@ type legacyModeEncoder struct {
 	metricsUnsupportedEncoder
 	profilesUnsupportedEncoder
 	attributesPrefix string
+	bodyFlattener    *flatten.Flattener
 }
 
 type ecsModeEncoder struct {
@@ -181,7 +188,11 @@ func (e legacyModeEncoder) encodeLog(ec encodingContext, record plog.LogRecord,
 	document.AddInt("TraceFlags", int64(record.Flags()))
 	document.AddString("SeverityText", record.SeverityText())
 	document.AddInt("SeverityNumber", int64(record.SeverityNumber()))
-	document.AddAttribute("Body", record.Body())
+	if body := record.Body(); e.bodyFlattener != nil && body.Type() == pcommon.ValueTypeMap {
+		document.AddAttributes("Body", e.bodyFlattener.Flatten(context.Background(), body.Map()))
+	} else {
+		document.AddAttribute("Body", body)
+	}
 	document.AddAttributes("Resource", ec.resource.Attributes())
 	document.AddAttributes("Scope", scopeToAttributes(ec.scope))
 	encodeAttributes(e.attributesPrefix, &document, record.Attributes(), idx)
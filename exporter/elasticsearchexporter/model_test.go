@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -26,6 +27,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/elasticsearch"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/metricgroup"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/objmodel"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/flatten"
 )
 
 const (
@@ -57,7 +59,7 @@ const expectedMetricsEncoded = `{"@timestamp":"2024-06-12T10:20:16.419290690Z","
 
 func TestEncodeSpan(t *testing.T) {
 	t.Run("non data stream", func(t *testing.T) {
-		encoder, _ := newEncoder(MappingNone)
+		encoder, _ := newEncoder(MappingNone, nil)
 		td := mockResourceSpans()
 		var buf bytes.Buffer
 		err := encoder.encodeSpan(
@@ -74,7 +76,7 @@ func TestEncodeSpan(t *testing.T) {
 
 	// See https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/42454.
 	t.Run("data stream", func(t *testing.T) {
-		encoder, _ := newEncoder(MappingNone)
+		encoder, _ := newEncoder(MappingNone, nil)
 		td := mockResourceSpans()
 		var buf bytes.Buffer
 		err := encoder.encodeSpan(
@@ -93,7 +95,7 @@ func TestEncodeSpan(t *testing.T) {
 
 func TestEncodeLog(t *testing.T) {
 	t.Run("empty timestamp with observedTimestamp override", func(t *testing.T) {
-		encoder, _ := newEncoder(MappingNone)
+		encoder, _ := newEncoder(MappingNone, nil)
 		td := mockResourceLogs()
 		td.ScopeLogs().At(0).LogRecords().At(0).SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Date(2023, 4, 19, 3, 4, 5, 6, time.UTC)))
 		var buf bytes.Buffer
@@ -112,7 +114,7 @@ func TestEncodeLog(t *testing.T) {
 	})
 
 	t.Run("both timestamp and observedTimestamp empty", func(t *testing.T) {
-		encoder, _ := newEncoder(MappingNone)
+		encoder, _ := newEncoder(MappingNone, nil)
 		td := mockResourceLogs()
 		var buf bytes.Buffer
 		err := encoder.encodeLog(
@@ -128,6 +130,30 @@ func TestEncodeLog(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, expectedLogBodyWithEmptyTimestamp, buf.String())
 	})
+
+	t.Run("body flattening limits", func(t *testing.T) {
+		cfg := flatten.NewDefaultConfig()
+		cfg.MaxDepth = 2
+		cfg.MaxKeys = 2
+		cfg.MaxValueSize = 5
+		flattener, err := flatten.New(cfg, componenttest.NewNopTelemetrySettings().MeterProvider.Meter(""))
+		require.NoError(t, err)
+		encoder, _ := newEncoder(MappingRaw, flattener)
+
+		record := plog.NewLogRecord()
+		record.SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2023, 4, 19, 3, 4, 5, 6, time.UTC)))
+		body := record.Body().SetEmptyMap()
+		body.PutStr("message", "hello world")
+		body.PutEmptyMap("http").PutEmptyMap("request").PutStr("method", "GET")
+		body.PutStr("dropped", "value")
+		var buf bytes.Buffer
+		err = encoder.encodeLog(
+			encodingContext{resource: pcommon.NewResource(), scope: pcommon.NewInstrumentationScope()},
+			record, elasticsearch.Index{}, &buf,
+		)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"@timestamp":"2023-04-19T03:04:05.000000006Z","Body.http.request":"{\"met","Body.message":"hello","Scope.name":"","Scope.version":"","SeverityNumber":0,"TraceFlags":0}`, buf.String())
+	})
 }
 
 func TestEncodeMetric(t *testing.T) {
@@ -135,7 +161,7 @@ func TestEncodeMetric(t *testing.T) {
 	metrics := createTestMetrics(t)
 
 	// Encode the metrics.
-	encoder, _ := newEncoder(MappingECS)
+	encoder, _ := newEncoder(MappingECS, nil)
 	hasher := newDataPointHasher(MappingECS)
 
 	groupedDataPoints := make(map[metricgroup.HashKey][]datapoints.DataPoint)
@@ -323,7 +349,7 @@ func TestEncodeAttributes(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			encoder, err := newEncoder(test.mappingMode)
+			encoder, err := newEncoder(test.mappingMode, nil)
 			require.NoError(t, err)
 
 			var buf bytes.Buffer
@@ -390,7 +416,7 @@ func TestEncodeSpan_Events(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			encoder, err := newEncoder(test.mappingMode)
+			encoder, err := newEncoder(test.mappingMode, nil)
 			require.NoError(t, err)
 
 			var buf bytes.Buffer
@@ -436,7 +462,7 @@ func TestEncodeLogECSModeDuplication(t *testing.T) {
 	logs.MarkReadOnly()
 
 	var buf bytes.Buffer
-	encoder, _ := newEncoder(MappingECS)
+	encoder, _ := newEncoder(MappingECS, nil)
 	err = encoder.encodeLog(
 		encodingContext{resource: resource, scope: scope},
 		record, elasticsearch.Index{}, &buf,
@@ -447,7 +473,7 @@ func TestEncodeLogECSModeDuplication(t *testing.T) {
 }
 
 func TestEncodeSpanECSMode(t *testing.T) {
-	encoder, _ := newEncoder(MappingECS)
+	encoder, _ := newEncoder(MappingECS, nil)
 
 	resource := pcommon.NewResource()
 	err := resource.Attributes().FromRaw(map[string]any{
@@ -665,7 +691,7 @@ func TestEncodeLogECSMode(t *testing.T) {
 	logs.MarkReadOnly()
 
 	var buf bytes.Buffer
-	encoder, _ := newEncoder(MappingECS)
+	encoder, _ := newEncoder(MappingECS, nil)
 	err = encoder.encodeLog(encodingContext{
 		resource: resource,
 		scope:    scope,
@@ -841,7 +867,7 @@ func TestEncodeLogECSModeAgentName(t *testing.T) {
 			logs.MarkReadOnly()
 
 			var buf bytes.Buffer
-			encoder, _ := newEncoder(MappingECS)
+			encoder, _ := newEncoder(MappingECS, nil)
 			err := encoder.encodeLog(
 				encodingContext{resource: resource, scope: scope},
 				record, elasticsearch.Index{}, &buf,
@@ -898,7 +924,7 @@ func TestEncodeLogECSModeAgentVersion(t *testing.T) {
 			logs.MarkReadOnly()
 
 			var buf bytes.Buffer
-			encoder, _ := newEncoder(MappingECS)
+			encoder, _ := newEncoder(MappingECS, nil)
 			err := encoder.encodeLog(
 				encodingContext{resource: resource, scope: scope},
 				record, elasticsearch.Index{}, &buf,
@@ -1009,7 +1035,7 @@ func TestEncodeLogECSModeHostOSType(t *testing.T) {
 			logs.MarkReadOnly()
 
 			var buf bytes.Buffer
-			encoder, _ := newEncoder(MappingECS)
+			encoder, _ := newEncoder(MappingECS, nil)
 			err := encoder.encodeLog(
 				encodingContext{resource: resource, scope: scope},
 				record, elasticsearch.Index{}, &buf,
@@ -1075,7 +1101,7 @@ func TestEncodeLogECSModeTimestamps(t *testing.T) {
 			}
 
 			var buf bytes.Buffer
-			encoder, _ := newEncoder(MappingECS)
+			encoder, _ := newEncoder(MappingECS, nil)
 			err := encoder.encodeLog(
 				encodingContext{resource: resource, scope: scope},
 				record, elasticsearch.Index{}, &buf,
@@ -1429,7 +1455,7 @@ func TestEncodeLogOtelMode(t *testing.T) {
 		},
 	}
 
-	encoder, _ := newEncoder(MappingOTel)
+	encoder, _ := newEncoder(MappingOTel, nil)
 
 	for _, tc := range tests {
 		record, scope, resource := createTestOTelLogRecord(t, tc.rec)
@@ -1573,7 +1599,7 @@ func assignDatastreamData(or oTelRecord, a ...string) oTelRecord {
 func TestEncodeLogScalarObjectConflict(t *testing.T) {
 	// If there is an attribute named "foo", and another called "foo.bar",
 	// then "foo" will be renamed to "foo.value".
-	encoder, _ := newEncoder(MappingNone)
+	encoder, _ := newEncoder(MappingNone, nil)
 	td := mockResourceLogs()
 	td.ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("foo", "scalar")
 	td.ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("foo.bar", "baz")
@@ -1627,7 +1653,7 @@ func TestEncodeLogBodyMapMode(t *testing.T) {
 	bodyMap.PutDouble("pi", 3.14)
 	bodyMap.CopyTo(logRecord.Body().SetEmptyMap())
 
-	encoder, _ := newEncoder(MappingBodyMap)
+	encoder, _ := newEncoder(MappingBodyMap, nil)
 	var buf bytes.Buffer
 	err := encoder.encodeLog(
 		encodingContext{resource: resourceLogs.Resource(), scope: scopeLogs.Scope()},
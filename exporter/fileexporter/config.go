@@ -28,6 +28,8 @@ var (
 // Config defines configuration for file exporter.
 type Config struct {
 	// Path of the file to write to. Path is relative to current directory.
+	// Path may be a template referencing resource attributes and the current time,
+	// e.g. `/var/log/otel/{service.name}/{yyyy-MM-dd}.json`.
 	Path string `mapstructure:"path"`
 
 	// Mode defines whether the exporter should append to the file.
@@ -37,7 +39,7 @@ type Config struct {
 	Append bool `mapstructure:"append"`
 
 	// Rotation defines an option about rotation of telemetry files. Ignored
-	// when GroupByAttribute is used.
+	// when GroupByAttribute is used, but applied to each file when Path is a template.
 	Rotation *Rotation `mapstructure:"rotation"`
 
 	// FormatType define the data format of encoded telemetry data
@@ -90,6 +92,11 @@ type Rotation struct {
 	// backup files is the computer's local time.  The default is to use UTC
 	// time.
 	LocalTime bool `mapstructure:"localtime"`
+
+	// Interval is the interval at which the file is rotated, regardless of its
+	// size. Rotations are aligned on multiples of the interval, e.g. every hour
+	// on the hour. The default is not to rotate files based on time.
+	Interval time.Duration `mapstructure:"interval"`
 }
 
 type GroupBy struct {
@@ -129,6 +136,18 @@ func (cfg *Config) Validate() error {
 	if cfg.FlushInterval < 0 {
 		return errors.New("flush_interval must be larger than zero")
 	}
+	if cfg.Rotation != nil && cfg.Rotation.Interval < 0 {
+		return errors.New("rotation interval must not be negative")
+	}
+
+	if isPathTemplate(cfg.Path) {
+		if cfg.GroupBy != nil && cfg.GroupBy.Enabled {
+			return errors.New("path templates are not supported when group_by is enabled")
+		}
+		if _, err := parsePathTemplate(cfg.Path); err != nil {
+			return err
+		}
+	}
 
 	if cfg.GroupBy != nil && cfg.GroupBy.Enabled {
 		pathParts := strings.Split(cfg.Path, "*")
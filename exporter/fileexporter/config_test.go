@@ -186,6 +186,35 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "group_by_empty_resource_attribute"),
 			errorMessage: "resource_attribute must not be empty when group_by is enabled",
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "path_template"),
+			expected: &Config{
+				Path: "./archive/{service.name}/{yyyy-MM-dd}.json",
+				Rotation: &Rotation{
+					MaxMegabytes: 10,
+					MaxBackups:   defaultMaxBackups,
+					Interval:     time.Hour,
+				},
+				FlushInterval: time.Second,
+				FormatType:    formatTypeJSON,
+				GroupBy: &GroupBy{
+					MaxOpenFiles:      defaultMaxOpenFiles,
+					ResourceAttribute: defaultResourceAttribute,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "path_template_group_by"),
+			errorMessage: "path templates are not supported when group_by is enabled",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "path_template_unclosed"),
+			errorMessage: `unclosed placeholder in path "./archive/{service.name.json"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "rotation_negative_interval"),
+			errorMessage: "rotation interval must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
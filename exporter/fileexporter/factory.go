@@ -155,7 +155,7 @@ func getOrCreateFileExporter(cfg component.Config, logger *zap.Logger) FileExpor
 }
 
 func newFileExporter(conf *Config, logger *zap.Logger) FileExporter {
-	if conf.GroupBy == nil || !conf.GroupBy.Enabled {
+	if (conf.GroupBy == nil || !conf.GroupBy.Enabled) && !isPathTemplate(conf.Path) {
 		return &fileExporter{
 			conf: conf,
 		}
@@ -191,12 +191,18 @@ func newFileWriter(path string, shouldAppend bool, rotation *Rotation, flushInte
 		}
 	}
 
-	return &fileWriter{
+	w := &fileWriter{
 		path:          path,
 		file:          wc,
 		exporter:      export,
 		flushInterval: flushInterval,
-	}, nil
+		now:           time.Now,
+	}
+	if rotation != nil && rotation.Interval > 0 {
+		w.rotationInterval = rotation.Interval
+		w.nextRotation = w.now().Truncate(rotation.Interval).Add(rotation.Interval)
+	}
+	return w, nil
 }
 
 // This is the map of already created File exporters for particular configurations.
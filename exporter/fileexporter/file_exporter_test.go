@@ -825,6 +825,35 @@ func TestFlushing(t *testing.T) {
 	assert.NoError(t, fe.Shutdown(ctx))
 }
 
+func TestRotationInterval(t *testing.T) {
+	path := tempFileName(t)
+	now := time.Date(2024, time.March, 7, 13, 30, 0, 0, time.UTC)
+	w, err := newFileWriter(path, false, &Rotation{Interval: time.Hour}, 0, exportMessageAsLine)
+	require.NoError(t, err)
+	w.now = func() time.Time { return now }
+	w.nextRotation = now.Truncate(time.Hour).Add(time.Hour)
+
+	require.NoError(t, w.export([]byte("first")))
+	now = now.Add(20 * time.Minute)
+	require.NoError(t, w.export([]byte("second")))
+	// the rotation happens on the hour, before the next export
+	now = now.Add(20 * time.Minute)
+	require.NoError(t, w.export([]byte("third")))
+	assert.Equal(t, time.Date(2024, time.March, 7, 15, 0, 0, 0, time.UTC), w.nextRotation)
+	require.NoError(t, w.shutdown())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "third\n", string(content))
+
+	backups, err := filepath.Glob(filepath.Join(filepath.Dir(path), "fileexporter_test-*.tmp"))
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	content, err = os.ReadFile(backups[0])
+	require.NoError(t, err)
+	assert.Equal(t, "first\nsecond\n", string(content))
+}
+
 func TestAppend(t *testing.T) {
 	cfg := &Config{
 		Path:          tempFileName(t),
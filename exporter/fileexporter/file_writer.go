@@ -23,6 +23,11 @@ type fileWriter struct {
 	flushInterval time.Duration
 	flushTicker   *time.Ticker
 	stopTicker    chan struct{}
+
+	// rotationInterval is the interval at which the file is rotated, regardless of its size.
+	rotationInterval time.Duration
+	nextRotation     time.Time
+	now              func() time.Time
 }
 
 func exportMessageAsLine(w *fileWriter, buf []byte) error {
@@ -51,9 +56,32 @@ func exportMessageAsBuffer(w *fileWriter, buf []byte) error {
 }
 
 func (w *fileWriter) export(buf []byte) error {
+	if err := w.rotateIfDue(); err != nil {
+		return err
+	}
 	return w.exporter(w, buf)
 }
 
+// rotateIfDue rotates the file when the rotation interval elapsed.
+// Rotations are aligned on multiples of the interval, e.g. every hour on the hour.
+func (w *fileWriter) rotateIfDue() error {
+	if w.rotationInterval <= 0 {
+		return nil
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	now := w.now()
+	if now.Before(w.nextRotation) {
+		return nil
+	}
+	w.nextRotation = now.Truncate(w.rotationInterval).Add(w.rotationInterval)
+	rf, ok := w.file.(interface{ Rotate() error })
+	if !ok {
+		return nil
+	}
+	return rf.Rotate()
+}
+
 // startFlusher starts the flusher.
 // It does not check the flushInterval
 func (w *fileWriter) startFlusher() {
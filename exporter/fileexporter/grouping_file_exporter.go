@@ -11,6 +11,7 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/golang-lru/v2/simplelru"
 	"go.opentelemetry.io/collector/component"
@@ -30,6 +31,7 @@ type groupingFileExporter struct {
 	pathPrefix    string
 	pathSuffix    string
 	attribute     string
+	template      *pathTemplate
 	maxOpenFiles  int
 	newFileWriter func(path string) (*fileWriter, error)
 
@@ -204,7 +206,10 @@ func (e *groupingFileExporter) write(_ context.Context, pathSegment string, buf
 }
 
 func (e *groupingFileExporter) getWriter(pathSegment string) (*fileWriter, error) {
-	fullPath := e.fullPath(pathSegment)
+	fullPath := pathSegment
+	if e.template == nil {
+		fullPath = e.fullPath(pathSegment)
+	}
 
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
@@ -268,6 +273,17 @@ func (e *groupingFileExporter) onEvict(_ string, writer *fileWriter) {
 }
 
 func group[T any](e *groupingFileExporter, groups map[string][]T, resource pcommon.Resource, resourceEntries T) {
+	if e.template != nil {
+		// the rendered template is the full path of the file
+		fullPath, ok := e.template.render(resource.Attributes(), time.Now())
+		if !ok {
+			e.logger.Debug("Resource does not contain the attributes referenced by the path template, dropping it", zap.String("path", e.conf.Path))
+			return
+		}
+		groups[fullPath] = append(groups[fullPath], resourceEntries)
+		return
+	}
+
 	var pathSegment string
 	v, ok := resource.Attributes().Get(e.attribute)
 	if ok {
@@ -295,6 +311,10 @@ func (e *groupingFileExporter) Start(_ context.Context, host component.Host) err
 	}
 	export := buildExportFunc(e.conf)
 
+	if isPathTemplate(e.conf.Path) {
+		return e.startTemplate(export)
+	}
+
 	pathParts := strings.Split(e.conf.Path, "*")
 
 	e.pathPrefix = cleanPathPrefix(pathParts[0])
@@ -315,6 +335,26 @@ func (e *groupingFileExporter) Start(_ context.Context, host component.Host) err
 	return nil
 }
 
+// startTemplate sets up the exporter to write to the files rendered from the path template.
+// Unlike group_by, the rotation settings apply to each of the files.
+func (e *groupingFileExporter) startTemplate(export exportFunc) error {
+	var err error
+	e.template, err = parsePathTemplate(e.conf.Path)
+	if err != nil {
+		return err
+	}
+	e.maxOpenFiles = defaultMaxOpenFiles
+	if e.conf.GroupBy != nil && e.conf.GroupBy.MaxOpenFiles > 0 {
+		e.maxOpenFiles = e.conf.GroupBy.MaxOpenFiles
+	}
+	e.newFileWriter = func(path string) (*fileWriter, error) {
+		return newFileWriter(path, e.conf.Append, e.conf.Rotation, e.conf.FlushInterval, export)
+	}
+
+	e.writers, err = simplelru.NewLRU(e.maxOpenFiles, e.onEvict)
+	return err
+}
+
 // Shutdown stops the exporter and is invoked during shutdown.
 // It stops flushes and closes all underlying writers.
 func (e *groupingFileExporter) Shutdown(context.Context) error {
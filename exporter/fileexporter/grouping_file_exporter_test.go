@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -373,6 +374,51 @@ func TestGroupingFileMetricsExporter(t *testing.T) {
 	}
 }
 
+func TestPathTemplateFileExporter(t *testing.T) {
+	tmpDir := t.TempDir()
+	conf := &Config{
+		Path:       tmpDir + "/{service.name}/{yyyy-MM-dd}.json",
+		FormatType: formatTypeJSON,
+		Rotation:   &Rotation{MaxBackups: defaultMaxBackups, Interval: time.Hour},
+	}
+	require.NoError(t, conf.Validate())
+	zapCore, logs := observer.New(zap.DebugLevel)
+	feI := newFileExporter(conf, zap.New(zapCore))
+	require.IsType(t, &groupingFileExporter{}, feI)
+	gfe := feI.(*groupingFileExporter)
+
+	ld := testdata.GenerateLogsTwoLogRecordsSameResource()
+	testdata.GenerateLogsOneLogRecord().ResourceLogs().At(0).CopyTo(ld.ResourceLogs().AppendEmpty())
+	testdata.GenerateLogsOneLogRecord().ResourceLogs().At(0).CopyTo(ld.ResourceLogs().AppendEmpty())
+	ld.ResourceLogs().At(0).Resource().Attributes().PutStr("service.name", "checkout")
+	ld.ResourceLogs().At(1).Resource().Attributes().PutStr("service.name", "../payments")
+
+	require.NoError(t, gfe.Start(t.Context(), componenttest.NewNopHost()))
+	day := time.Now().UTC().Format("2006-01-02")
+	require.NoError(t, gfe.consumeLogs(t.Context(), ld))
+	assert.Equal(t, defaultMaxOpenFiles, gfe.maxOpenFiles)
+	for _, writer := range gfe.writers.Values() {
+		assert.Equal(t, time.Hour, writer.rotationInterval)
+	}
+	require.NoError(t, gfe.Shutdown(t.Context()))
+
+	// the resource without service.name is dropped
+	assert.Equal(t, 1, logs.FilterLevelExact(zap.DebugLevel).Len())
+
+	pathResourceLogs := map[string]plog.ResourceLogs{
+		tmpDir + "/checkout/" + day + ".json":    ld.ResourceLogs().At(0),
+		tmpDir + "/.._payments/" + day + ".json": ld.ResourceLogs().At(1),
+	}
+	for path, wantResourceLogs := range pathResourceLogs {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		got, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(bytes.TrimSpace(content))
+		require.NoError(t, err)
+		require.Equal(t, 1, got.ResourceLogs().Len())
+		assert.Equal(t, wantResourceLogs, got.ResourceLogs().At(0))
+	}
+}
+
 func TestFullPath(t *testing.T) {
 	tests := []struct {
 		prefix      string
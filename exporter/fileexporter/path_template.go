@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// timePlaceholderRegexp matches the placeholders rendered as the current time, e.g. `{yyyy-MM-dd}`.
+var timePlaceholderRegexp = regexp.MustCompile(`^(yyyy|yy|MM|dd|HH|mm|ss|[-_.: ])+$`)
+
+// timePlaceholderReplacer converts a time placeholder to a Go time layout.
+var timePlaceholderReplacer = strings.NewReplacer(
+	"yyyy", "2006",
+	"yy", "06",
+	"MM", "01",
+	"dd", "02",
+	"HH", "15",
+	"mm", "04",
+	"ss", "05",
+)
+
+// pathTemplate renders the path of the output file from resource attributes and the current time.
+// Resource attributes are referenced with `{attribute.name}`, and the current time with a date pattern
+// made of yyyy, yy, MM, dd, HH, mm and ss, e.g. `{yyyy-MM-dd}`.
+type pathTemplate struct {
+	parts []pathTemplatePart
+}
+
+// pathTemplatePart is either a literal, a resource attribute, or a time layout.
+type pathTemplatePart struct {
+	literal    string
+	attribute  string
+	timeLayout string
+}
+
+// isPathTemplate returns true if the path contains placeholders.
+func isPathTemplate(path string) bool {
+	return strings.Contains(path, "{")
+}
+
+func parsePathTemplate(tmpl string) (*pathTemplate, error) {
+	t := &pathTemplate{}
+	rest := tmpl
+	for {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			t.parts = append(t.parts, pathTemplatePart{literal: rest})
+			break
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed placeholder in path %q", tmpl)
+		}
+		placeholder := strings.TrimSpace(rest[start+1 : start+end])
+		if placeholder == "" {
+			return nil, fmt.Errorf("empty placeholder in path %q", tmpl)
+		}
+		if start == 0 && len(t.parts) == 0 {
+			return nil, fmt.Errorf("path %q must not start with a placeholder", tmpl)
+		}
+		t.parts = append(t.parts, pathTemplatePart{literal: rest[:start]})
+		if timePlaceholderRegexp.MatchString(placeholder) {
+			t.parts = append(t.parts, pathTemplatePart{timeLayout: timePlaceholderReplacer.Replace(placeholder)})
+		} else {
+			t.parts = append(t.parts, pathTemplatePart{attribute: placeholder})
+		}
+		rest = rest[start+end+1:]
+	}
+	return t, nil
+}
+
+// render returns the path for the given resource attributes and time, or false if one of the
+// referenced resource attributes is missing. Slashes in attribute values are replaced so that
+// each value stays within a single path segment.
+func (t *pathTemplate) render(attrs pcommon.Map, now time.Time) (string, bool) {
+	var sb strings.Builder
+	for _, part := range t.parts {
+		switch {
+		case part.attribute != "":
+			v, ok := attrs.Get(part.attribute)
+			if !ok || v.AsString() == "" {
+				return "", false
+			}
+			sb.WriteString(sanitizePathSegment(v.AsString()))
+		case part.timeLayout != "":
+			sb.WriteString(now.UTC().Format(part.timeLayout))
+		default:
+			sb.WriteString(part.literal)
+		}
+	}
+	return sb.String(), true
+}
+
+func sanitizePathSegment(segment string) string {
+	segment = strings.ReplaceAll(segment, "/", "_")
+	segment = strings.ReplaceAll(segment, `\`, "_")
+	if segment == "." || segment == ".." {
+		return "_"
+	}
+	return segment
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestParsePathTemplateErrors(t *testing.T) {
+	tests := []struct {
+		path    string
+		wantErr string
+	}{
+		{path: "/var/log/{service.name.json", wantErr: `unclosed placeholder in path "/var/log/{service.name.json"`},
+		{path: "/var/log/{}.json", wantErr: `empty placeholder in path "/var/log/{}.json"`},
+		{path: "{service.name}/data.json", wantErr: `path "{service.name}/data.json" must not start with a placeholder`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			_, err := parsePathTemplate(tt.path)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPathTemplateRender(t *testing.T) {
+	now := time.Date(2024, time.March, 7, 13, 4, 5, 0, time.UTC)
+	attrs := pcommon.NewMap()
+	attrs.PutStr("service.name", "checkout")
+	attrs.PutStr("k8s.namespace.name", "../etc")
+	attrs.PutInt("shard", 3)
+	attrs.PutStr("dot", "..")
+
+	tests := []struct {
+		path   string
+		want   string
+		wantOk bool
+	}{
+		{path: "/var/log/otel/{service.name}/{yyyy-MM-dd}.json", want: "/var/log/otel/checkout/2024-03-07.json", wantOk: true},
+		{path: "/var/log/otel/{yyyy}/{MM}/{dd}/{HH_mm_ss}-{service.name}.json", want: "/var/log/otel/2024/03/07/13_04_05-checkout.json", wantOk: true},
+		{path: "/var/log/otel/{yy}-{shard}.json", want: "/var/log/otel/24-3.json", wantOk: true},
+		{path: "/var/log/otel/{ service.name }.json", want: "/var/log/otel/checkout.json", wantOk: true},
+		// attribute values never span several path segments
+		{path: "/var/log/otel/{k8s.namespace.name}/data.json", want: "/var/log/otel/.._etc/data.json", wantOk: true},
+		{path: "/var/log/otel/{dot}/data.json", want: "/var/log/otel/_/data.json", wantOk: true},
+		{path: "/var/log/otel/{missing}/data.json", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			tmpl, err := parsePathTemplate(tt.path)
+			require.NoError(t, err)
+			got, ok := tmpl.render(attrs, now)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
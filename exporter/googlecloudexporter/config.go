@@ -18,11 +18,26 @@ type Config struct {
 	// Timeout for all API calls. If not set, defaults to 12 seconds.
 	TimeoutSettings exporterhelper.TimeoutConfig                             `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
 	QueueSettings   configoptional.Optional[exporterhelper.QueueBatchConfig] `mapstructure:"sending_queue"`
+
+	// MetricDescriptors are created in Cloud Monitoring when the exporter starts, and restrict
+	// the attributes of their metrics to the declared labels.
+	MetricDescriptors []MetricDescriptorConfig `mapstructure:"metric_descriptors"`
 }
 
 func (cfg *Config) Validate() error {
 	if err := collector.ValidateConfig(cfg.Config); err != nil {
 		return fmt.Errorf("googlecloud exporter settings are invalid :%w", err)
 	}
+	names := make(map[string]struct{}, len(cfg.MetricDescriptors))
+	for i := range cfg.MetricDescriptors {
+		md := &cfg.MetricDescriptors[i]
+		if err := md.validate(); err != nil {
+			return fmt.Errorf("metric_descriptors[%d]: %w", i, err)
+		}
+		if _, ok := names[md.Name]; ok {
+			return fmt.Errorf("metric_descriptors[%d]: duplicate metric %q", i, md.Name)
+		}
+		names[md.Name] = struct{}{}
+	}
 	return nil
 }
@@ -72,10 +72,68 @@ func TestLoadConfig(t *testing.T) {
 				queue.Sizer = exporterhelper.RequestSizerTypeRequests
 				return queue
 			}()),
+			MetricDescriptors: []MetricDescriptorConfig{
+				{
+					Name:        "http.server.request.duration",
+					Kind:        "cumulative",
+					ValueType:   "distribution",
+					Unit:        "s",
+					Description: "Duration of HTTP server requests.",
+					Labels:      []string{"http.request.method", "http.response.status_code"},
+				},
+			},
 		},
 		sanitize(cfg.(*Config)))
 }
 
+func TestValidateMetricDescriptors(t *testing.T) {
+	tests := []struct {
+		name        string
+		descriptors []MetricDescriptorConfig
+		err         string
+	}{
+		{
+			name:        "valid",
+			descriptors: []MetricDescriptorConfig{{Name: "requests", Kind: "delta", ValueType: "int64", Labels: []string{"method"}}},
+		},
+		{
+			name:        "missing name",
+			descriptors: []MetricDescriptorConfig{{Kind: "gauge", ValueType: "double"}},
+			err:         "metric_descriptors[0]: name must not be empty",
+		},
+		{
+			name:        "invalid kind",
+			descriptors: []MetricDescriptorConfig{{Name: "requests", Kind: "counter", ValueType: "int64"}},
+			err:         `metric_descriptors[0]: invalid kind "counter", must be one of gauge, cumulative or delta`,
+		},
+		{
+			name:        "invalid value type",
+			descriptors: []MetricDescriptorConfig{{Name: "requests", Kind: "delta", ValueType: "string"}},
+			err:         `metric_descriptors[0]: invalid value_type "string", must be one of bool, int64, double or distribution`,
+		},
+		{
+			name: "duplicate metric",
+			descriptors: []MetricDescriptorConfig{
+				{Name: "requests", Kind: "delta", ValueType: "int64"},
+				{Name: "requests", Kind: "cumulative", ValueType: "int64"},
+			},
+			err: `metric_descriptors[1]: duplicate metric "requests"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.MetricDescriptors = tt.descriptors
+			err := cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
 func sanitize(cfg *Config) *Config {
 	cfg.MetricConfig.MapMonitoredResource = nil
 	cfg.LogConfig.MapMonitoredResource = nil
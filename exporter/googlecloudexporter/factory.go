@@ -7,6 +7,7 @@ package googlecloudexporter // import "github.com/open-telemetry/opentelemetry-c
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/collector"
@@ -16,6 +17,7 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter/internal/resourcemapping"
@@ -121,13 +123,25 @@ func createMetricsExporter(
 	if err != nil {
 		return nil, err
 	}
+	descriptors := newMetricDescriptors(eCfg, params.TelemetrySettings)
 	return exporterhelper.NewMetrics(
 		ctx,
 		params,
 		cfg,
-		mExp.PushMetrics,
-		exporterhelper.WithStart(mExp.Start),
-		exporterhelper.WithShutdown(mExp.Shutdown),
+		func(ctx context.Context, md pmetric.Metrics) error {
+			descriptors.filter(md)
+			return mExp.PushMetrics(ctx, md)
+		},
+		exporterhelper.WithStart(func(ctx context.Context, host component.Host) error {
+			if err := mExp.Start(ctx, host); err != nil {
+				return err
+			}
+			descriptors.create(ctx)
+			return nil
+		}),
+		exporterhelper.WithShutdown(func(ctx context.Context) error {
+			return errors.Join(mExp.Shutdown(ctx), descriptors.shutdown(ctx))
+		}),
 		// Disable exporterhelper Timeout, since we are using a custom mechanism
 		// within exporter itself
 		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
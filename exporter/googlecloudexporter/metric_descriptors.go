@@ -0,0 +1,430 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlecloudexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	monitoring "cloud.google.com/go/monitoring/apiv3/v2"
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+	"google.golang.org/genproto/googleapis/api/label"
+	metricpb "google.golang.org/genproto/googleapis/api/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// MetricDescriptorConfig declares a metric descriptor created in Cloud Monitoring when the exporter
+// starts, and the attributes allowed on the data points of the metric.
+type MetricDescriptorConfig struct {
+	// Name of the OTLP metric. The metric type is derived from it with the `metric.prefix`,
+	// like it is for the descriptors created by the exporter.
+	Name string `mapstructure:"name"`
+	// Kind of the metric: `gauge`, `cumulative` or `delta`.
+	Kind string `mapstructure:"kind"`
+	// ValueType of the metric: `bool`, `int64`, `double` or `distribution`.
+	ValueType string `mapstructure:"value_type"`
+	// Unit of the metric, in the UCUM format.
+	Unit string `mapstructure:"unit"`
+	// Description of the metric.
+	Description string `mapstructure:"description"`
+	// Labels are the data point attributes kept on the metric. The other attributes are dropped,
+	// and the data points that become identical are merged.
+	Labels []string `mapstructure:"labels"`
+}
+
+var metricKinds = map[string]metricpb.MetricDescriptor_MetricKind{
+	"gauge":      metricpb.MetricDescriptor_GAUGE,
+	"cumulative": metricpb.MetricDescriptor_CUMULATIVE,
+	"delta":      metricpb.MetricDescriptor_DELTA,
+}
+
+var metricValueTypes = map[string]metricpb.MetricDescriptor_ValueType{
+	"bool":         metricpb.MetricDescriptor_BOOL,
+	"int64":        metricpb.MetricDescriptor_INT64,
+	"double":       metricpb.MetricDescriptor_DOUBLE,
+	"distribution": metricpb.MetricDescriptor_DISTRIBUTION,
+}
+
+func (cfg *MetricDescriptorConfig) validate() error {
+	if cfg.Name == "" {
+		return errors.New("name must not be empty")
+	}
+	if _, ok := metricKinds[cfg.Kind]; !ok {
+		return fmt.Errorf("invalid kind %q, must be one of gauge, cumulative or delta", cfg.Kind)
+	}
+	if _, ok := metricValueTypes[cfg.ValueType]; !ok {
+		return fmt.Errorf("invalid value_type %q, must be one of bool, int64, double or distribution", cfg.ValueType)
+	}
+	return nil
+}
+
+// metricDescriptors pre-creates the declared metric descriptors, and removes the attributes
+// that are not allowed from the data points of the declared metrics.
+type metricDescriptors struct {
+	cfg     *Config
+	logger  *zap.Logger
+	allowed map[string]map[string]struct{}
+
+	client *monitoring.MetricClient
+}
+
+func newMetricDescriptors(cfg *Config, set component.TelemetrySettings) *metricDescriptors {
+	allowed := make(map[string]map[string]struct{}, len(cfg.MetricDescriptors))
+	for _, md := range cfg.MetricDescriptors {
+		labels := make(map[string]struct{}, len(md.Labels))
+		for _, l := range md.Labels {
+			labels[l] = struct{}{}
+		}
+		allowed[md.Name] = labels
+	}
+	return &metricDescriptors{cfg: cfg, logger: set.Logger, allowed: allowed}
+}
+
+// create creates the declared metric descriptors in the project of the exporter. Failures are
+// logged and don't prevent the exporter from starting, as the descriptors are still created on
+// the first write otherwise.
+func (m *metricDescriptors) create(ctx context.Context) {
+	if len(m.cfg.MetricDescriptors) == 0 || m.cfg.MetricConfig.SkipCreateMetricDescriptor || m.cfg.MetricConfig.CreateServiceTimeSeries {
+		return
+	}
+	client, projectID, err := newMetricClient(ctx, m.cfg)
+	if err != nil {
+		m.logger.Warn("Failed to create the client to pre-create the metric descriptors", zap.Error(err))
+		return
+	}
+	m.client = client
+
+	for i := range m.cfg.MetricDescriptors {
+		md := m.metricDescriptor(&m.cfg.MetricDescriptors[i])
+		_, err := client.CreateMetricDescriptor(ctx, &monitoringpb.CreateMetricDescriptorRequest{
+			Name:             "projects/" + projectID,
+			MetricDescriptor: md,
+		})
+		if err != nil {
+			m.logger.Warn("Failed to pre-create the metric descriptor", zap.String("type", md.Type), zap.Error(err))
+		}
+	}
+}
+
+// shutdown closes the client used to pre-create the metric descriptors, along with its connection.
+func (m *metricDescriptors) shutdown(context.Context) error {
+	if m.client == nil {
+		return nil
+	}
+	err := m.client.Close()
+	m.client = nil
+	return err
+}
+
+func (m *metricDescriptors) metricDescriptor(cfg *MetricDescriptorConfig) *metricpb.MetricDescriptor {
+	metricType := cfg.Name
+	if !m.hasKnownDomain(cfg.Name) {
+		metricType = path.Join(m.cfg.MetricConfig.Prefix, cfg.Name)
+	}
+	var labels []*label.LabelDescriptor
+	seen := map[string]struct{}{}
+	addLabel := func(key string) {
+		key = sanitizeLabelKey(key)
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		labels = append(labels, &label.LabelDescriptor{Key: key})
+	}
+	for _, l := range cfg.Labels {
+		addLabel(l)
+	}
+	// the labels added by the exporter must be declared too, or the time series are rejected
+	if m.cfg.MetricConfig.InstrumentationLibraryLabels {
+		addLabel("instrumentation_source")
+		addLabel("instrumentation_version")
+	}
+	if m.cfg.MetricConfig.ServiceResourceLabels {
+		addLabel("service.name")
+		addLabel("service.namespace")
+		addLabel("service.instance.id")
+	}
+	return &metricpb.MetricDescriptor{
+		Name:        metricType,
+		Type:        metricType,
+		Labels:      labels,
+		MetricKind:  metricKinds[cfg.Kind],
+		ValueType:   metricValueTypes[cfg.ValueType],
+		Unit:        cfg.Unit,
+		Description: cfg.Description,
+		DisplayName: strings.TrimPrefix(metricType, m.cfg.MetricConfig.Prefix+"/"),
+	}
+}
+
+func (m *metricDescriptors) hasKnownDomain(name string) bool {
+	for _, domain := range m.cfg.MetricConfig.KnownDomains {
+		if strings.Contains(name, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// filter removes the attributes that are not allowed from the data points of the declared metrics,
+// merging the data points that become identical so that no duplicate time series are written.
+func (m *metricDescriptors) filter(md pmetric.Metrics) {
+	if len(m.allowed) == 0 {
+		return
+	}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				if allowed, ok := m.allowed[metric.Name()]; ok {
+					filterMetric(metric, allowed)
+				}
+			}
+		}
+	}
+}
+
+func filterMetric(metric pmetric.Metric, allowed map[string]struct{}) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		filterNumberDataPoints(metric.Gauge().DataPoints(), allowed, false)
+	case pmetric.MetricTypeSum:
+		filterNumberDataPoints(metric.Sum().DataPoints(), allowed, true)
+	case pmetric.MetricTypeHistogram:
+		filterHistogramDataPoints(metric.Histogram().DataPoints(), allowed)
+	case pmetric.MetricTypeExponentialHistogram:
+		latest := map[string]pmetric.ExponentialHistogramDataPoint{}
+		metric.ExponentialHistogram().DataPoints().RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+			key := retainAttributes(dp.Attributes(), allowed)
+			first, ok := latest[key]
+			if !ok {
+				latest[key] = dp
+				return false
+			}
+			if dp.Timestamp() > first.Timestamp() {
+				dp.CopyTo(first)
+			}
+			return true
+		})
+	case pmetric.MetricTypeSummary:
+		latest := map[string]pmetric.SummaryDataPoint{}
+		metric.Summary().DataPoints().RemoveIf(func(dp pmetric.SummaryDataPoint) bool {
+			key := retainAttributes(dp.Attributes(), allowed)
+			first, ok := latest[key]
+			if !ok {
+				latest[key] = dp
+				return false
+			}
+			if dp.Timestamp() > first.Timestamp() {
+				dp.CopyTo(first)
+			}
+			return true
+		})
+	}
+}
+
+// filterNumberDataPoints merges the number data points with the same attributes: sums are added,
+// and the latest value of gauges is kept.
+func filterNumberDataPoints(dps pmetric.NumberDataPointSlice, allowed map[string]struct{}, add bool) {
+	firsts := map[string]pmetric.NumberDataPoint{}
+	dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+		key := retainAttributes(dp.Attributes(), allowed)
+		first, ok := firsts[key]
+		if !ok {
+			firsts[key] = dp
+			return false
+		}
+		switch {
+		case add:
+			if first.ValueType() == pmetric.NumberDataPointValueTypeInt && dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+				first.SetIntValue(first.IntValue() + dp.IntValue())
+			} else {
+				first.SetDoubleValue(numberValue(first) + numberValue(dp))
+			}
+			first.SetStartTimestamp(min(first.StartTimestamp(), dp.StartTimestamp()))
+			first.SetTimestamp(max(first.Timestamp(), dp.Timestamp()))
+		case dp.Timestamp() > first.Timestamp():
+			dp.CopyTo(first)
+		}
+		return true
+	})
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue())
+	}
+	return dp.DoubleValue()
+}
+
+// filterHistogramDataPoints merges the histogram data points with the same attributes and bounds,
+// and keeps the latest one when the bounds differ.
+func filterHistogramDataPoints(dps pmetric.HistogramDataPointSlice, allowed map[string]struct{}) {
+	firsts := map[string]pmetric.HistogramDataPoint{}
+	dps.RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+		key := retainAttributes(dp.Attributes(), allowed)
+		first, ok := firsts[key]
+		if !ok {
+			firsts[key] = dp
+			return false
+		}
+		if !first.ExplicitBounds().Equal(dp.ExplicitBounds()) || first.BucketCounts().Len() != dp.BucketCounts().Len() {
+			if dp.Timestamp() > first.Timestamp() {
+				dp.CopyTo(first)
+			}
+			return true
+		}
+		for i := 0; i < first.BucketCounts().Len(); i++ {
+			first.BucketCounts().SetAt(i, first.BucketCounts().At(i)+dp.BucketCounts().At(i))
+		}
+		first.SetCount(first.Count() + dp.Count())
+		first.SetSum(first.Sum() + dp.Sum())
+		if dp.HasMin() && (!first.HasMin() || dp.Min() < first.Min()) {
+			first.SetMin(dp.Min())
+		}
+		if dp.HasMax() && (!first.HasMax() || dp.Max() > first.Max()) {
+			first.SetMax(dp.Max())
+		}
+		dp.Exemplars().MoveAndAppendTo(first.Exemplars())
+		first.SetStartTimestamp(min(first.StartTimestamp(), dp.StartTimestamp()))
+		first.SetTimestamp(max(first.Timestamp(), dp.Timestamp()))
+		return true
+	})
+}
+
+// retainAttributes removes the attributes that are not allowed, and returns a key identifying the
+// remaining ones.
+func retainAttributes(attrs pcommon.Map, allowed map[string]struct{}) string {
+	attrs.RemoveIf(func(k string, _ pcommon.Value) bool {
+		_, ok := allowed[k]
+		return !ok
+	})
+	keys := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		v, _ := attrs.Get(k)
+		sb.WriteString(strconv.Quote(k))
+		sb.WriteByte('=')
+		sb.WriteString(strconv.Quote(v.AsString()))
+		sb.WriteByte(',')
+	}
+	return sb.String()
+}
+
+// sanitizeLabelKey converts an attribute key to a label key, the same way the exporter does.
+func sanitizeLabelKey(s string) string {
+	if s == "" {
+		return s
+	}
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, s)
+	if unicode.IsDigit(rune(s[0])) {
+		s = "key_" + s
+	}
+	if s[0] == '_' {
+		s = "key" + s
+	}
+	return s
+}
+
+// newMetricClient creates a Cloud Monitoring client from the metric client configuration, and
+// returns it along with the project the descriptors are created in. The client options are the
+// ones of the metrics exporter, which doesn't export them. Closing the client closes the insecure
+// connection it is created with.
+func newMetricClient(ctx context.Context, cfg *Config) (*monitoring.MetricClient, string, error) {
+	clientCfg := cfg.MetricConfig.ClientConfig
+	projectID := cfg.ProjectID
+	scopes := monitoring.DefaultAuthScopes()
+	opts := []option.ClientOption{option.WithTelemetryDisabled()}
+	if cfg.UserAgent != "" {
+		opts = append(opts, option.WithGRPCDialOption(grpc.WithUserAgent(cfg.UserAgent)))
+	}
+	var conn *grpc.ClientConn
+	if clientCfg.Endpoint != "" {
+		if clientCfg.UseInsecure {
+			dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+			if cfg.UserAgent != "" {
+				dialOpts = append(dialOpts, grpc.WithUserAgent(cfg.UserAgent))
+			}
+			var err error
+			conn, err = grpc.NewClient(clientCfg.Endpoint, dialOpts...)
+			if err != nil {
+				return nil, "", fmt.Errorf("cannot configure grpc conn: %w", err)
+			}
+			opts = append(opts, option.WithGRPCConn(conn))
+		} else {
+			opts = append(opts, option.WithEndpoint(clientCfg.Endpoint))
+		}
+	}
+	closeConn := func() {
+		if conn != nil {
+			_ = conn.Close()
+		}
+	}
+	switch {
+	case cfg.ImpersonateConfig.TargetPrincipal != "":
+		tokenSource, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+			TargetPrincipal: cfg.ImpersonateConfig.TargetPrincipal,
+			Delegates:       cfg.ImpersonateConfig.Delegates,
+			Subject:         cfg.ImpersonateConfig.Subject,
+			Scopes:          scopes,
+		})
+		if err != nil {
+			closeConn()
+			return nil, "", err
+		}
+		opts = append(opts, option.WithTokenSource(tokenSource))
+	case !clientCfg.UseInsecure && (clientCfg.GetClientOptions == nil || len(clientCfg.GetClientOptions()) == 0):
+		creds, err := google.FindDefaultCredentials(ctx, scopes...)
+		if err != nil {
+			closeConn()
+			return nil, "", fmt.Errorf("error finding default application credentials: %w", err)
+		}
+		opts = append(opts, option.WithCredentials(creds))
+		if projectID == "" {
+			projectID = creds.ProjectID
+		}
+	}
+	if clientCfg.GRPCPoolSize > 0 {
+		opts = append(opts, option.WithGRPCConnectionPool(clientCfg.GRPCPoolSize))
+	}
+	if clientCfg.GetClientOptions != nil {
+		opts = append(opts, clientCfg.GetClientOptions()...)
+	}
+	if projectID == "" {
+		closeConn()
+		return nil, "", errors.New("the project of the metric descriptors is unknown, set `project`")
+	}
+	client, err := monitoring.NewMetricClient(ctx, opts...)
+	if err != nil {
+		closeConn()
+		return nil, "", err
+	}
+	return client, projectID, nil
+}
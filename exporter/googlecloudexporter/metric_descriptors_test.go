@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlecloudexporter
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	metricpb "google.golang.org/genproto/googleapis/api/metric"
+	"google.golang.org/grpc"
+)
+
+type fakeMetricService struct {
+	monitoringpb.UnimplementedMetricServiceServer
+
+	mu   sync.Mutex
+	reqs []*monitoringpb.CreateMetricDescriptorRequest
+}
+
+func (s *fakeMetricService) CreateMetricDescriptor(_ context.Context, req *monitoringpb.CreateMetricDescriptorRequest) (*metricpb.MetricDescriptor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reqs = append(s.reqs, req)
+	return req.MetricDescriptor, nil
+}
+
+func startFakeMetricService(t *testing.T) (*fakeMetricService, string) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	svc := &fakeMetricService{}
+	monitoringpb.RegisterMetricServiceServer(srv, svc)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	return svc, lis.Addr().String()
+}
+
+func TestMetricDescriptorsCreate(t *testing.T) {
+	svc, endpoint := startFakeMetricService(t)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.ProjectID = "my-project"
+	cfg.MetricConfig.ClientConfig.Endpoint = endpoint
+	cfg.MetricConfig.ClientConfig.UseInsecure = true
+	cfg.MetricDescriptors = []MetricDescriptorConfig{
+		{
+			Name:        "http.server.request.duration",
+			Kind:        "cumulative",
+			ValueType:   "distribution",
+			Unit:        "s",
+			Description: "Duration of HTTP server requests.",
+			Labels:      []string{"http.request.method", "http.response.status_code"},
+		},
+		{
+			Name:      "kubernetes.io/container/restarts",
+			Kind:      "gauge",
+			ValueType: "int64",
+		},
+	}
+	descriptors := newMetricDescriptors(cfg, componenttest.NewNopTelemetrySettings())
+	descriptors.create(t.Context())
+	defer func() {
+		require.NoError(t, descriptors.shutdown(t.Context()))
+	}()
+
+	require.Len(t, svc.reqs, 2)
+	assert.Equal(t, "projects/my-project", svc.reqs[0].Name)
+	md := svc.reqs[0].MetricDescriptor
+	assert.Equal(t, "workload.googleapis.com/http.server.request.duration", md.Type)
+	assert.Equal(t, metricpb.MetricDescriptor_CUMULATIVE, md.MetricKind)
+	assert.Equal(t, metricpb.MetricDescriptor_DISTRIBUTION, md.ValueType)
+	assert.Equal(t, "s", md.Unit)
+	assert.Equal(t, "http.server.request.duration", md.DisplayName)
+	var keys []string
+	for _, l := range md.Labels {
+		keys = append(keys, l.Key)
+	}
+	assert.Equal(t, []string{
+		"http_request_method", "http_response_status_code",
+		"instrumentation_source", "instrumentation_version",
+		"service_name", "service_namespace", "service_instance_id",
+	}, keys)
+	assert.Equal(t, "kubernetes.io/container/restarts", svc.reqs[1].MetricDescriptor.Type)
+}
+
+func TestMetricDescriptorsCreateSkipped(t *testing.T) {
+	svc, endpoint := startFakeMetricService(t)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.ProjectID = "my-project"
+	cfg.MetricConfig.ClientConfig.Endpoint = endpoint
+	cfg.MetricConfig.ClientConfig.UseInsecure = true
+	cfg.MetricConfig.SkipCreateMetricDescriptor = true
+	cfg.MetricDescriptors = []MetricDescriptorConfig{{Name: "requests", Kind: "delta", ValueType: "int64"}}
+	descriptors := newMetricDescriptors(cfg, componenttest.NewNopTelemetrySettings())
+	descriptors.create(t.Context())
+	require.NoError(t, descriptors.shutdown(t.Context()))
+
+	assert.Empty(t, svc.reqs)
+}
+
+func TestMetricDescriptorsFilter(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricDescriptors = []MetricDescriptorConfig{
+		{Name: "requests", Kind: "cumulative", ValueType: "int64", Labels: []string{"method"}},
+		{Name: "temperature", Kind: "gauge", ValueType: "double", Labels: []string{"room"}},
+		{Name: "latency", Kind: "cumulative", ValueType: "distribution", Labels: []string{"method"}},
+	}
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+
+	requests := metrics.AppendEmpty()
+	requests.SetName("requests")
+	requests.SetEmptySum()
+	for _, p := range []struct {
+		method, path string
+		value        int64
+	}{{"GET", "/a", 1}, {"GET", "/b", 2}, {"POST", "/a", 4}} {
+		dp := requests.Sum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("method", p.method)
+		dp.Attributes().PutStr("path", p.path)
+		dp.SetIntValue(p.value)
+	}
+	requests.Sum().DataPoints().At(1).SetTimestamp(20)
+
+	temperature := metrics.AppendEmpty()
+	temperature.SetName("temperature")
+	temperature.SetEmptyGauge()
+	for i, v := range []float64{20.5, 21.5} {
+		dp := temperature.Gauge().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("room", "kitchen")
+		dp.Attributes().PutStr("sensor", []string{"a", "b"}[i])
+		dp.SetDoubleValue(v)
+		dp.SetTimestamp(pcommon.Timestamp(10 * (2 - i)))
+	}
+
+	latency := metrics.AppendEmpty()
+	latency.SetName("latency")
+	latency.SetEmptyHistogram()
+	for _, path := range []string{"/a", "/b"} {
+		dp := latency.Histogram().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("method", "GET")
+		dp.Attributes().PutStr("path", path)
+		dp.ExplicitBounds().FromRaw([]float64{1, 10})
+		dp.BucketCounts().FromRaw([]uint64{1, 2, 3})
+		dp.SetCount(6)
+		dp.SetSum(30)
+	}
+
+	other := metrics.AppendEmpty()
+	other.SetName("other")
+	other.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("path", "/a")
+
+	newMetricDescriptors(cfg, componenttest.NewNopTelemetrySettings()).filter(md)
+
+	sums := requests.Sum().DataPoints()
+	require.Equal(t, 2, sums.Len())
+	assert.Equal(t, map[string]any{"method": "GET"}, sums.At(0).Attributes().AsRaw())
+	assert.Equal(t, int64(3), sums.At(0).IntValue())
+	assert.Equal(t, pcommon.Timestamp(20), sums.At(0).Timestamp())
+	assert.Equal(t, map[string]any{"method": "POST"}, sums.At(1).Attributes().AsRaw())
+	assert.Equal(t, int64(4), sums.At(1).IntValue())
+
+	gauges := temperature.Gauge().DataPoints()
+	require.Equal(t, 1, gauges.Len())
+	assert.Equal(t, map[string]any{"room": "kitchen"}, gauges.At(0).Attributes().AsRaw())
+	assert.Equal(t, 20.5, gauges.At(0).DoubleValue(), "the latest value is kept")
+
+	histograms := latency.Histogram().DataPoints()
+	require.Equal(t, 1, histograms.Len())
+	assert.Equal(t, []uint64{2, 4, 6}, histograms.At(0).BucketCounts().AsRaw())
+	assert.Equal(t, uint64(12), histograms.At(0).Count())
+	assert.Equal(t, float64(60), histograms.At(0).Sum())
+
+	assert.Equal(t, map[string]any{"path": "/a"}, other.Gauge().DataPoints().At(0).Attributes().AsRaw(), "undeclared metrics are not filtered")
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/twmb/franz-go/pkg/kerr"
 	"github.com/twmb/franz-go/pkg/kgo"
@@ -17,8 +18,21 @@ import (
 // the Producer interface. Allowing us to use the franz-go client while
 // maintaining compatibility with the existing Kafka exporter code.
 type FranzSyncProducer struct {
-	client       *kgo.Client
+	client       franzClient
 	metadataKeys []string
+
+	// transactional is true if each batch is produced in a transaction.
+	// The client supports a single transaction at a time, hence txnMu.
+	transactional bool
+	txnMu         sync.Mutex
+}
+
+// franzClient is the subset of the kgo.Client methods used by FranzSyncProducer.
+type franzClient interface {
+	ProduceSync(ctx context.Context, rs ...*kgo.Record) kgo.ProduceResults
+	BeginTransaction() error
+	EndTransaction(ctx context.Context, commit kgo.TransactionEndTry) error
+	Close()
 }
 
 // NewFranzSyncProducer Franz-go producer from a kgo.Client and a Messenger.
@@ -31,6 +45,21 @@ func NewFranzSyncProducer(client *kgo.Client,
 	}
 }
 
+// NewFranzTransactionalProducer creates a producer which produces each batch
+// of messages in a Kafka transaction, from a kgo.Client configured with a
+// transactional ID. The transaction is committed only if all the messages
+// of the batch were produced, otherwise it is aborted, so that retrying the
+// batch doesn't duplicate messages for read_committed consumers.
+func NewFranzTransactionalProducer(client *kgo.Client,
+	metadataKeys []string,
+) *FranzSyncProducer {
+	return &FranzSyncProducer{
+		client:        client,
+		metadataKeys:  metadataKeys,
+		transactional: true,
+	}
+}
+
 // ExportData sends a batch of messages to Kafka
 func (p *FranzSyncProducer) ExportData(ctx context.Context, msgs Messages) error {
 	messages := makeFranzMessages(msgs)
@@ -41,6 +70,13 @@ func (p *FranzSyncProducer) ExportData(ctx context.Context, msgs Messages) error
 		func(m *kgo.Record) []kgo.RecordHeader { return m.Headers },
 		func(m *kgo.Record, h []kgo.RecordHeader) { m.Headers = h },
 	)
+	if p.transactional {
+		p.txnMu.Lock()
+		defer p.txnMu.Unlock()
+		if err := p.client.BeginTransaction(); err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+	}
 	result := p.client.ProduceSync(ctx, messages...)
 	var errs []error
 	for _, r := range result {
@@ -54,9 +90,29 @@ func (p *FranzSyncProducer) ExportData(ctx context.Context, msgs Messages) error
 			errs = append(errs, err)
 		}
 	}
+	if p.transactional {
+		if err := p.endTransaction(ctx, len(errs) == 0); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	return errors.Join(errs...)
 }
 
+// endTransaction commits the current transaction if commit is true,
+// or aborts it otherwise.
+func (p *FranzSyncProducer) endTransaction(ctx context.Context, commit bool) error {
+	if !commit {
+		if err := p.client.EndTransaction(ctx, kgo.TryAbort); err != nil {
+			return fmt.Errorf("failed to abort transaction: %w", err)
+		}
+		return nil
+	}
+	if err := p.client.EndTransaction(ctx, kgo.TryCommit); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
 // Close shuts down the producer and flushes any remaining messages.
 func (p *FranzSyncProducer) Close() error {
 	p.client.Close()
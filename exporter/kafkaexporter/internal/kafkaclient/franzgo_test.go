@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter/internal/marshaler"
+)
+
+// fakeTransactionalClient records the transactions of the producer, failing
+// the records of the topics in produceErrs.
+type fakeTransactionalClient struct {
+	produceErrs map[string]error
+	beginErr    error
+	endErr      error
+
+	inTransaction bool
+	produced      []*kgo.Record
+	ends          []kgo.TransactionEndTry
+}
+
+func (c *fakeTransactionalClient) ProduceSync(_ context.Context, rs ...*kgo.Record) kgo.ProduceResults {
+	results := make(kgo.ProduceResults, 0, len(rs))
+	for _, r := range rs {
+		err := c.produceErrs[r.Topic]
+		if err == nil {
+			c.produced = append(c.produced, r)
+		}
+		results = append(results, kgo.ProduceResult{Record: r, Err: err})
+	}
+	return results
+}
+
+func (c *fakeTransactionalClient) BeginTransaction() error {
+	if c.beginErr != nil {
+		return c.beginErr
+	}
+	if c.inTransaction {
+		return errors.New("already in a transaction")
+	}
+	c.inTransaction = true
+	return nil
+}
+
+func (c *fakeTransactionalClient) EndTransaction(_ context.Context, commit kgo.TransactionEndTry) error {
+	if !c.inTransaction {
+		return errors.New("not in a transaction")
+	}
+	c.inTransaction = false
+	c.ends = append(c.ends, commit)
+	return c.endErr
+}
+
+func (*fakeTransactionalClient) Close() {}
+
+func testMessages(topics ...string) Messages {
+	var msgs Messages
+	for _, topic := range topics {
+		msgs.Count++
+		msgs.TopicMessages = append(msgs.TopicMessages, TopicMessages{
+			Topic:    topic,
+			Messages: []marshaler.Message{{Value: []byte(topic)}},
+		})
+	}
+	return msgs
+}
+
+func TestFranzTransactionalProducer(t *testing.T) {
+	client := &fakeTransactionalClient{}
+	p := &FranzSyncProducer{client: client, transactional: true}
+
+	require.NoError(t, p.ExportData(t.Context(), testMessages("a", "b")))
+	require.NoError(t, p.ExportData(t.Context(), testMessages("c")))
+	assert.Len(t, client.produced, 3)
+	assert.Equal(t, []kgo.TransactionEndTry{kgo.TryCommit, kgo.TryCommit}, client.ends)
+	assert.False(t, client.inTransaction)
+}
+
+func TestFranzTransactionalProducerAbort(t *testing.T) {
+	client := &fakeTransactionalClient{produceErrs: map[string]error{
+		"b": kerr.NotEnoughReplicas,
+		"c": kerr.MessageTooLarge,
+	}}
+	p := &FranzSyncProducer{client: client, transactional: true}
+
+	err := p.ExportData(t.Context(), testMessages("a", "b"))
+	require.ErrorIs(t, err, kerr.NotEnoughReplicas)
+	assert.False(t, consumererror.IsPermanent(err))
+	assert.Equal(t, []kgo.TransactionEndTry{kgo.TryAbort}, client.ends)
+
+	err = p.ExportData(t.Context(), testMessages("c"))
+	require.ErrorIs(t, err, kerr.MessageTooLarge)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.Equal(t, []kgo.TransactionEndTry{kgo.TryAbort, kgo.TryAbort}, client.ends)
+	assert.False(t, client.inTransaction)
+}
+
+func TestFranzTransactionalProducerErrors(t *testing.T) {
+	client := &fakeTransactionalClient{beginErr: errors.New("fenced")}
+	p := &FranzSyncProducer{client: client, transactional: true}
+	err := p.ExportData(t.Context(), testMessages("a"))
+	require.EqualError(t, err, "failed to begin transaction: fenced")
+	assert.Empty(t, client.produced)
+
+	client = &fakeTransactionalClient{endErr: errors.New("timed out")}
+	p = &FranzSyncProducer{client: client, transactional: true}
+	err = p.ExportData(t.Context(), testMessages("a"))
+	require.EqualError(t, err, "failed to commit transaction: timed out")
+	assert.Equal(t, []kgo.TransactionEndTry{kgo.TryCommit}, client.ends)
+}
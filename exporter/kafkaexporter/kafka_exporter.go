@@ -54,6 +54,7 @@ type messenger[T any] interface {
 type kafkaExporter[T any] struct {
 	cfg          Config
 	set          exporter.Settings
+	signal       string
 	tb           *metadata.TelemetryBuilder
 	logger       *zap.Logger
 	newMessenger func(host component.Host) (messenger[T], error)
@@ -64,11 +65,13 @@ type kafkaExporter[T any] struct {
 func newKafkaExporter[T any](
 	config Config,
 	set exporter.Settings,
+	signal string,
 	newMessenger func(component.Host) (messenger[T], error),
 ) *kafkaExporter[T] {
 	return &kafkaExporter[T]{
 		cfg:          config,
 		set:          set,
+		signal:       signal,
 		logger:       set.Logger,
 		newMessenger: newMessenger,
 	}
@@ -85,11 +88,17 @@ func (e *kafkaExporter[T]) Start(ctx context.Context, host component.Host) (err
 		return err
 	}
 
+	producerCfg := e.cfg.Producer
+	if producerCfg.Transactions.Enabled {
+		// Each signal has its own producer, which needs its own transactional ID,
+		// otherwise the producers would fence each other.
+		producerCfg.Transactions.TransactionalID += "-" + e.signal
+	}
 	producer, err := kafka.NewFranzSyncProducer(
 		ctx,
 		host,
 		e.cfg.ClientConfig,
-		e.cfg.Producer,
+		producerCfg,
 		e.cfg.TimeoutSettings.Timeout,
 		e.logger,
 		kgo.WithHooks(kafkaclient.NewFranzProducerMetrics(tb)),
@@ -97,6 +106,12 @@ func (e *kafkaExporter[T]) Start(ctx context.Context, host component.Host) (err
 	if err != nil {
 		return err
 	}
+	if producerCfg.Transactions.Enabled {
+		e.producer = kafkaclient.NewFranzTransactionalProducer(producer,
+			e.cfg.IncludeMetadataKeys,
+		)
+		return nil
+	}
 	e.producer = kafkaclient.NewFranzSyncProducer(producer,
 		e.cfg.IncludeMetadataKeys,
 	)
@@ -171,7 +186,7 @@ func newTracesExporter(config Config, set exporter.Settings) *kafkaExporter[ptra
 	case "jaeger_proto", "jaeger_json":
 		config.PartitionTracesByID = false
 	}
-	return newKafkaExporter(config, set, func(host component.Host) (messenger[ptrace.Traces], error) {
+	return newKafkaExporter(config, set, "traces", func(host component.Host) (messenger[ptrace.Traces], error) {
 		marshaler, err := getTracesMarshaler(config.Traces.Encoding, host)
 		if err != nil {
 			return nil, err
@@ -216,7 +231,7 @@ func (e *kafkaTracesMessenger) partitionData(td ptrace.Traces) iter.Seq2[[]byte,
 }
 
 func newLogsExporter(config Config, set exporter.Settings) *kafkaExporter[plog.Logs] {
-	return newKafkaExporter(config, set, func(host component.Host) (messenger[plog.Logs], error) {
+	return newKafkaExporter(config, set, "logs", func(host component.Host) (messenger[plog.Logs], error) {
 		marshaler, err := getLogsMarshaler(config.Logs.Encoding, host)
 		if err != nil {
 			return nil, err
@@ -272,7 +287,7 @@ func (e *kafkaLogsMessenger) partitionData(ld plog.Logs) iter.Seq2[[]byte, plog.
 }
 
 func newMetricsExporter(config Config, set exporter.Settings) *kafkaExporter[pmetric.Metrics] {
-	return newKafkaExporter(config, set, func(host component.Host) (messenger[pmetric.Metrics], error) {
+	return newKafkaExporter(config, set, "metrics", func(host component.Host) (messenger[pmetric.Metrics], error) {
 		marshaler, err := getMetricsMarshaler(config.Metrics.Encoding, host)
 		if err != nil {
 			return nil, err
@@ -315,7 +330,7 @@ func (e *kafkaMetricsMessenger) partitionData(md pmetric.Metrics) iter.Seq2[[]by
 }
 
 func newProfilesExporter(config Config, set exporter.Settings) *kafkaExporter[pprofile.Profiles] {
-	return newKafkaExporter(config, set, func(host component.Host) (messenger[pprofile.Profiles], error) {
+	return newKafkaExporter(config, set, "profiles", func(host component.Host) (messenger[pprofile.Profiles], error) {
 		marshaler, err := getProfilesMarshaler(config.Profiles.Encoding, host)
 		if err != nil {
 			return nil, err
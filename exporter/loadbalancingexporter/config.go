@@ -4,6 +4,8 @@
 package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
@@ -50,6 +52,60 @@ type Config struct {
 	// Supports all attributes available (both resource and span), as well as the pseudo attributes "span.kind" and
 	// "span.name".
 	RoutingAttributes []string `mapstructure:"routing_attributes"`
+
+	// RoutingAttributesFallback is an OTTL value expression, evaluated in the resource context, computing the
+	// routing key of metrics whose resource has none of the RoutingAttributes.
+	RoutingAttributesFallback string `mapstructure:"routing_attributes_fallback"`
+
+	// VirtualNodes is the number of positions of each backend in the consistent hash ring. Higher values
+	// spread the keys more evenly across the backends, at the cost of a larger ring. Default is 100.
+	VirtualNodes int `mapstructure:"virtual_nodes"`
+
+	// EndpointHealth enables the health-aware routing, draining the unhealthy backends and slow starting
+	// the new ones. Disabled by default.
+	EndpointHealth configoptional.Optional[EndpointHealthConfig] `mapstructure:"endpoint_health"`
+}
+
+// EndpointHealthConfig defines the configuration of the health-aware routing.
+type EndpointHealthConfig struct {
+	// MaxConsecutiveFailures is the number of consecutive failed exports after which a backend is drained.
+	MaxConsecutiveFailures int `mapstructure:"max_consecutive_failures"`
+	// MaxLatency is the moving average of the export latency above which a backend is drained.
+	// Zero disables the latency check.
+	MaxLatency time.Duration `mapstructure:"max_latency"`
+	// DrainDuration is how long an unhealthy backend is drained before receiving data again.
+	DrainDuration time.Duration `mapstructure:"drain_duration"`
+	// SlowStart is the duration over which the share of data routed to a newly added backend is ramped up.
+	// Zero disables the slow start.
+	SlowStart time.Duration `mapstructure:"slow_start"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.RoutingAttributesFallback != "" && cfg.RoutingKey != attrRoutingStr {
+		return errors.New("routing_attributes_fallback requires the routing_key to be attributes")
+	}
+	if cfg.VirtualNodes < 0 {
+		return errors.New("virtual_nodes must be positive")
+	}
+	if cfg.VirtualNodes > int(maxPositions) {
+		return fmt.Errorf("virtual_nodes must not be greater than %d", maxPositions)
+	}
+	if cfg.EndpointHealth.HasValue() {
+		health := cfg.EndpointHealth.Get()
+		if health.MaxConsecutiveFailures <= 0 {
+			return errors.New("endpoint_health: max_consecutive_failures must be positive")
+		}
+		if health.DrainDuration <= 0 {
+			return errors.New("endpoint_health: drain_duration must be positive")
+		}
+		if health.MaxLatency < 0 || health.SlowStart < 0 {
+			return errors.New("endpoint_health: max_latency and slow_start must not be negative")
+		}
+	}
+	return nil
 }
 
 // Protocol holds the individual protocol-specific settings. Only OTLP is supported at the moment.
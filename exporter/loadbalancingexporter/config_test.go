@@ -6,9 +6,11 @@ package loadbalancingexporter
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
@@ -25,3 +27,30 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, sub.Unmarshal(cfg))
 	require.NotNil(t, cfg)
 }
+
+func TestConfigValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, cfg.Validate())
+
+	cfg.VirtualNodes = -1
+	require.EqualError(t, cfg.Validate(), "virtual_nodes must be positive")
+
+	cfg.VirtualNodes = 40000
+	require.EqualError(t, cfg.Validate(), "virtual_nodes must not be greater than 36000")
+
+	cfg.VirtualNodes = defaultWeight
+	cfg.RoutingAttributesFallback = `resource.attributes["k8s.namespace.name"]`
+	require.EqualError(t, cfg.Validate(), "routing_attributes_fallback requires the routing_key to be attributes")
+
+	cfg.RoutingKey = attrRoutingStr
+	require.NoError(t, cfg.Validate())
+
+	cfg.EndpointHealth = configoptional.Some(EndpointHealthConfig{DrainDuration: time.Second})
+	require.EqualError(t, cfg.Validate(), "endpoint_health: max_consecutive_failures must be positive")
+
+	cfg.EndpointHealth = configoptional.Some(EndpointHealthConfig{MaxConsecutiveFailures: 1})
+	require.EqualError(t, cfg.Validate(), "endpoint_health: drain_duration must be positive")
+
+	cfg.EndpointHealth = configoptional.Some(EndpointHealthConfig{MaxConsecutiveFailures: 1, DrainDuration: time.Second, SlowStart: -time.Second})
+	require.EqualError(t, cfg.Validate(), "endpoint_health: max_latency and slow_start must not be negative")
+}
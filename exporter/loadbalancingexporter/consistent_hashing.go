@@ -6,12 +6,14 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 import (
 	"encoding/binary"
 	"hash/crc32"
+	"hash/fnv"
+	"math"
 	"sort"
 )
 
 const (
 	maxPositions     uint32 = 36000 // 360 degrees with two decimal places
-	defaultWeight    int    = 100   // the number of points in the ring for each entry. For better results, it should be greater than 100.
+	defaultWeight    int    = 100   // the default number of points in the ring for each entry. For better results, it should be greater than 100.
 	linearProbeLimit int    = 10    // The number of times to probe ahead in the hash ring if there is a collision while constructing the hash ring
 )
 
@@ -32,8 +34,12 @@ type hashRing struct {
 }
 
 // newHashRing builds a new immutable consistent hash ring based on the given endpoints.
-func newHashRing(endpoints []string) *hashRing {
-	items := positionsForEndpoints(endpoints, defaultWeight)
+// Each endpoint is placed at virtualNodes positions in the ring, defaultWeight being used when it isn't positive.
+func newHashRing(endpoints []string, virtualNodes int) *hashRing {
+	if virtualNodes <= 0 {
+		virtualNodes = defaultWeight
+	}
+	items := positionsForEndpoints(endpoints, virtualNodes)
 	return &hashRing{
 		items: items,
 	}
@@ -53,6 +59,80 @@ func (h *hashRing) endpointFor(identifier []byte) string {
 	return h.findEndpoint(position(pos))
 }
 
+// endpointForWeighted calculates which backend is responsible for the given identifier, given the weight of the
+// backends. Starting from the position of the identifier, the first backend whose weight is greater than the key
+// fraction of the identifier is returned: backends with a weight of 0 are skipped, and backends with a weight
+// between 0 and 1 only receive the matching fraction of the keys of their positions. If no backend is accepted,
+// the weights are ignored.
+func (h *hashRing) endpointForWeighted(identifier []byte, weight func(endpoint string) float64) string {
+	if h == nil || len(h.items) == 0 {
+		return ""
+	}
+	pos := position(crc32.ChecksumIEEE(identifier) % maxPositions)
+	fraction := keyFraction(identifier)
+
+	start := sort.Search(len(h.items), func(i int) bool {
+		return h.items[i].pos >= pos
+	})
+	for i := range h.items {
+		item := h.items[(start+i)%len(h.items)]
+		if fraction < weight(item.endpoint) {
+			return item.endpoint
+		}
+	}
+	return h.findEndpoint(pos)
+}
+
+// shares calculates the fraction of the keyspace routed to each backend by endpointForWeighted.
+func (h *hashRing) shares(weight func(endpoint string) float64) map[string]float64 {
+	shares := map[string]float64{}
+	if h == nil || len(h.items) == 0 {
+		return shares
+	}
+	weights := map[string]float64{}
+	for _, item := range h.items {
+		if _, ok := weights[item.endpoint]; !ok {
+			weights[item.endpoint] = weight(item.endpoint)
+			shares[item.endpoint] = 0
+		}
+	}
+
+	for i, item := range h.items {
+		// the positions from the previous item (excluded) to this item (included) are routed the same way
+		segment := float64(item.pos)
+		if i == 0 {
+			segment += float64(maxPositions) - float64(h.items[len(h.items)-1].pos)
+		} else {
+			segment -= float64(h.items[i-1].pos)
+		}
+
+		// the keys whose fraction is lower than the highest weight seen so far are already routed
+		covered := 0.0
+		for j := 0; j < len(h.items) && covered < 1; j++ {
+			endpoint := h.items[(i+j)%len(h.items)].endpoint
+			if w := weights[endpoint]; w > covered {
+				shares[endpoint] += segment * (w - covered)
+				covered = w
+			}
+		}
+		if covered < 1 {
+			shares[item.endpoint] += segment * (1 - covered)
+		}
+	}
+
+	for endpoint, share := range shares {
+		shares[endpoint] = share / float64(maxPositions)
+	}
+	return shares
+}
+
+// keyFraction maps the identifier to [0, 1), independently of its position in the ring.
+func keyFraction(identifier []byte) float64 {
+	hasher := fnv.New32a()
+	hasher.Write(identifier)
+	return float64(hasher.Sum32()) / (math.MaxUint32 + 1)
+}
+
 // findEndpoint returns the "next" endpoint starting from the given position, or an empty string in case no endpoints are available
 func (h *hashRing) findEndpoint(pos position) string {
 	ringSize := len(h.items)
@@ -119,32 +199,46 @@ func positionsFor(endpoint string, numPoints int) []position {
 	return res
 }
 
-// positionsForEndpoints calculates all the positions for all the given endpoints
+// positionsForEndpoints calculates all the positions for all the given endpoints.
+// Collisions are resolved in the order of the positions, and then of the endpoints, so that the
+// position of an endpoint doesn't depend on the order of the given endpoints. Adding or removing
+// an endpoint only moves the keys owned by the positions of that endpoint.
 func positionsForEndpoints(endpoints []string, weight int) []ringItem {
-	var items []ringItem
-	positions := map[position]bool{} // tracking the used positions
+	candidates := make([]ringItem, 0, len(endpoints)*weight)
 	for _, endpoint := range endpoints {
 		// for this initial implementation, we don't allow endpoints to have custom weights
 		for _, pos := range positionsFor(endpoint, weight) {
-			// if this position is occupied already, look ahead in the array for a free position
-			actualPos := pos
-			positionsProbed := 0
-			for positions[actualPos] && positionsProbed < linearProbeLimit {
-				actualPos = (actualPos + 1) % position(maxPositions)
-				positionsProbed++
-			}
-			if positionsProbed >= linearProbeLimit {
-				continue // Not able to find a free spot; skip this item
-			}
+			candidates = append(candidates, ringItem{pos: pos, endpoint: endpoint})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].pos != candidates[j].pos {
+			return candidates[i].pos < candidates[j].pos
+		}
+		return candidates[i].endpoint < candidates[j].endpoint
+	})
 
-			positions[actualPos] = true
+	var items []ringItem
+	positions := map[position]bool{} // tracking the used positions
+	for _, candidate := range candidates {
+		// if this position is occupied already, look ahead in the array for a free position
+		actualPos := candidate.pos
+		positionsProbed := 0
+		for positions[actualPos] && positionsProbed < linearProbeLimit {
+			actualPos = (actualPos + 1) % position(maxPositions)
+			positionsProbed++
+		}
+		if positionsProbed >= linearProbeLimit {
+			continue // Not able to find a free spot; skip this item
+		}
 
-			item := ringItem{
-				pos:      actualPos,
-				endpoint: endpoint,
-			}
-			items = append(items, item)
+		positions[actualPos] = true
+
+		item := ringItem{
+			pos:      actualPos,
+			endpoint: candidate.endpoint,
 		}
+		items = append(items, item)
 	}
 
 	sort.Slice(items, func(i, j int) bool {
@@ -154,6 +248,21 @@ func positionsForEndpoints(endpoints []string, weight int) []ringItem {
 	return items
 }
 
+// keyspaceMoved returns the fraction of the ring positions that are assigned to a different endpoint in the
+// candidate ring. Building the first ring doesn't move any key.
+func (h *hashRing) keyspaceMoved(candidate *hashRing) float64 {
+	if h == nil || len(h.items) == 0 {
+		return 0
+	}
+	moved := 0
+	for pos := range maxPositions {
+		if h.findEndpoint(position(pos)) != candidate.findEndpoint(position(pos)) {
+			moved++
+		}
+	}
+	return float64(moved) / float64(maxPositions)
+}
+
 func (h *hashRing) equal(candidate *hashRing) bool {
 	if candidate == nil {
 		return false
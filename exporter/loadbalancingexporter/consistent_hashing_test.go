@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewHashRing(t *testing.T) {
@@ -15,7 +16,7 @@ func TestNewHashRing(t *testing.T) {
 	endpoints := []string{"endpoint-1", "endpoint-2"}
 
 	// test
-	ring := newHashRing(endpoints)
+	ring := newHashRing(endpoints, defaultWeight)
 
 	// verify
 	assert.Len(t, ring.items, 2*defaultWeight)
@@ -24,7 +25,7 @@ func TestNewHashRing(t *testing.T) {
 func TestEndpointFor(t *testing.T) {
 	// prepare
 	endpoints := []string{"endpoint-1", "endpoint-2"}
-	ring := newHashRing(endpoints)
+	ring := newHashRing(endpoints, defaultWeight)
 
 	for _, tt := range []struct {
 		id       []byte
@@ -225,3 +226,133 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestNewHashRingVirtualNodes(t *testing.T) {
+	endpoints := []string{"endpoint-1", "endpoint-2"}
+
+	assert.Len(t, newHashRing(endpoints, 500).items, 2*500)
+	assert.Len(t, newHashRing(endpoints, 0).items, 2*defaultWeight)
+}
+
+func TestPositionsForEndpointsOrderIndependent(t *testing.T) {
+	endpoints := make([]string, 0, 50)
+	for i := range 50 {
+		endpoints = append(endpoints, fmt.Sprintf("endpoint-%d", i))
+	}
+	reversed := make([]string, len(endpoints))
+	for i, endpoint := range endpoints {
+		reversed[len(endpoints)-1-i] = endpoint
+	}
+
+	assert.Equal(t, positionsForEndpoints(endpoints, defaultWeight), positionsForEndpoints(reversed, defaultWeight))
+}
+
+func TestKeyspaceMoved(t *testing.T) {
+	endpoints := []string{"endpoint-1", "endpoint-2", "endpoint-3"}
+	ring := newHashRing(endpoints, defaultWeight)
+
+	// the first ring doesn't move any key
+	var noRing *hashRing
+	assert.Zero(t, noRing.keyspaceMoved(ring))
+	assert.Zero(t, ring.keyspaceMoved(newHashRing(endpoints, defaultWeight)))
+
+	// scaling out only moves keys to the new endpoint
+	scaledOut := newHashRing(append(endpoints, "endpoint-4"), defaultWeight)
+	moved := ring.keyspaceMoved(scaledOut)
+	assert.InDelta(t, 0.25, moved, 0.1)
+	for pos := range maxPositions {
+		before, after := ring.findEndpoint(position(pos)), scaledOut.findEndpoint(position(pos))
+		if before != after {
+			require.Equal(t, "endpoint-4", after)
+		}
+	}
+
+	// scaling in only moves the keys of the removed endpoint
+	assert.InDelta(t, moved, scaledOut.keyspaceMoved(ring), 1e-9)
+
+	// removing all endpoints moves the whole keyspace
+	assert.Equal(t, 1.0, ring.keyspaceMoved(newHashRing(nil, defaultWeight)))
+}
+
+func TestEndpointForWeighted(t *testing.T) {
+	ring := newHashRing([]string{"endpoint-1", "endpoint-2", "endpoint-3"}, defaultWeight)
+	healthy := func(string) float64 { return 1 }
+	drained := func(endpoint string) float64 {
+		if endpoint == "endpoint-2" {
+			return 0
+		}
+		return 1
+	}
+	slowStarting := func(endpoint string) float64 {
+		if endpoint == "endpoint-2" {
+			return 0.5
+		}
+		return 1
+	}
+
+	routedToSlowStarting := 0
+	for i := range 10000 {
+		id := fmt.Appendf(nil, "trace-%d", i)
+		endpoint := ring.endpointFor(id)
+
+		// healthy backends are routed as by the unweighted ring
+		assert.Equal(t, endpoint, ring.endpointForWeighted(id, healthy))
+
+		// only the keys of the drained backend are moved
+		if endpoint == "endpoint-2" {
+			assert.NotEqual(t, "endpoint-2", ring.endpointForWeighted(id, drained))
+		} else {
+			assert.Equal(t, endpoint, ring.endpointForWeighted(id, drained))
+		}
+
+		// the slow starting backend only receives part of its keys
+		weighted := ring.endpointForWeighted(id, slowStarting)
+		if endpoint != "endpoint-2" {
+			assert.Equal(t, endpoint, weighted)
+		} else if weighted == "endpoint-2" {
+			routedToSlowStarting++
+		}
+	}
+	assert.InDelta(t, 0.5*10000/3, routedToSlowStarting, 0.1*10000/3)
+
+	// when all the backends are drained, the weights are ignored
+	id := []byte("trace-1")
+	assert.Equal(t, ring.endpointFor(id), ring.endpointForWeighted(id, func(string) float64 { return 0 }))
+}
+
+func TestShares(t *testing.T) {
+	ring := newHashRing([]string{"endpoint-1", "endpoint-2", "endpoint-3"}, defaultWeight)
+
+	// without weights, the shares are the fraction of positions owned by each backend
+	expected := map[string]float64{}
+	for pos := range maxPositions {
+		expected[ring.findEndpoint(position(pos))] += 1.0 / float64(maxPositions)
+	}
+	shares := ring.shares(func(string) float64 { return 1 })
+	require.Len(t, shares, 3)
+	for endpoint, share := range expected {
+		assert.InDelta(t, share, shares[endpoint], 1e-9)
+	}
+
+	// drained backends don't receive any data
+	shares = ring.shares(func(endpoint string) float64 {
+		if endpoint == "endpoint-2" {
+			return 0
+		}
+		return 1
+	})
+	assert.Zero(t, shares["endpoint-2"])
+	assert.InDelta(t, 1.0, shares["endpoint-1"]+shares["endpoint-3"], 1e-9)
+
+	// slow starting backends receive part of their share
+	shares = ring.shares(func(endpoint string) float64 {
+		if endpoint == "endpoint-2" {
+			return 0.5
+		}
+		return 1
+	})
+	assert.InDelta(t, expected["endpoint-2"]/2, shares["endpoint-2"], 1e-9)
+	assert.InDelta(t, 1.0, shares["endpoint-1"]+shares["endpoint-2"]+shares["endpoint-3"], 1e-9)
+
+	assert.Empty(t, newHashRing(nil, defaultWeight).shares(func(string) float64 { return 1 }))
+}
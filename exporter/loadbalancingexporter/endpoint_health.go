@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// latencyEWMAWeight is the weight of the latest export latency in the moving average of the latency of a backend.
+const latencyEWMAWeight = 0.3
+
+// endpointHealth tracks the health of the backends, based on the outcome and latency of the exports.
+// Unhealthy backends are drained for a while, and newly added backends receive a ramped-up share of the data.
+type endpointHealth struct {
+	cfg    EndpointHealthConfig
+	logger *zap.Logger
+	now    func() time.Time
+
+	mu          sync.Mutex
+	initialized bool
+	endpoints   map[string]*endpointState
+}
+
+type endpointState struct {
+	addedAt             time.Time
+	consecutiveFailures int
+	latencyEWMA         float64 // in milliseconds
+	drainedUntil        time.Time
+}
+
+func newEndpointHealth(cfg EndpointHealthConfig, logger *zap.Logger) *endpointHealth {
+	return &endpointHealth{
+		cfg:       cfg,
+		logger:    logger,
+		now:       time.Now,
+		endpoints: map[string]*endpointState{},
+	}
+}
+
+// update tracks the given endpoints, forgetting about the removed ones. The endpoints added after the
+// first update are slow started.
+func (h *endpointHealth) update(endpoints []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	states := make(map[string]*endpointState, len(endpoints))
+	for _, endpoint := range endpoints {
+		state, ok := h.endpoints[endpoint]
+		if !ok {
+			state = &endpointState{}
+			if h.initialized {
+				state.addedAt = now
+			}
+		}
+		states[endpoint] = state
+	}
+	h.endpoints = states
+	h.initialized = true
+}
+
+// record updates the health of the endpoint with the outcome of an export.
+func (h *endpointHealth) record(endpoint string, latency time.Duration, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	state, ok := h.endpoints[endpoint]
+	if !ok {
+		return
+	}
+
+	if err != nil {
+		state.consecutiveFailures++
+	} else {
+		state.consecutiveFailures = 0
+	}
+	ms := float64(latency) / float64(time.Millisecond)
+	if state.latencyEWMA == 0 {
+		state.latencyEWMA = ms
+	} else {
+		state.latencyEWMA = latencyEWMAWeight*ms + (1-latencyEWMAWeight)*state.latencyEWMA
+	}
+
+	failing := state.consecutiveFailures >= h.cfg.MaxConsecutiveFailures
+	slow := h.cfg.MaxLatency > 0 && state.latencyEWMA > float64(h.cfg.MaxLatency)/float64(time.Millisecond)
+	if failing || slow {
+		h.logger.Warn("draining unhealthy backend",
+			zap.String("endpoint", endpoint),
+			zap.Int("consecutive_failures", state.consecutiveFailures),
+			zap.Float64("latency_ewma_ms", state.latencyEWMA),
+			zap.Duration("drain_duration", h.cfg.DrainDuration))
+		// the backend is probed again once the drain duration elapsed, starting from a clean state
+		state.drainedUntil = h.now().Add(h.cfg.DrainDuration)
+		state.consecutiveFailures = 0
+		state.latencyEWMA = 0
+	}
+}
+
+// weight returns the fraction of the keys of its ring positions that is routed to the endpoint:
+// 0 for drained endpoints, ramping up from 0 to 1 during the slow start of new endpoints, and 1 otherwise.
+func (h *endpointHealth) weight(endpoint string) float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	state, ok := h.endpoints[endpoint]
+	if !ok {
+		return 1
+	}
+	now := h.now()
+	if now.Before(state.drainedUntil) {
+		return 0
+	}
+	if h.cfg.SlowStart > 0 && !state.addedAt.IsZero() {
+		if elapsed := now.Sub(state.addedAt); elapsed < h.cfg.SlowStart {
+			return float64(elapsed) / float64(h.cfg.SlowStart)
+		}
+	}
+	return 1
+}
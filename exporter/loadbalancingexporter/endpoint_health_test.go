@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func newTestEndpointHealth(cfg EndpointHealthConfig) (*endpointHealth, *time.Time) {
+	now := time.Unix(1700000000, 0)
+	h := newEndpointHealth(cfg, zap.NewNop())
+	h.now = func() time.Time { return now }
+	return h, &now
+}
+
+func TestEndpointHealthConsecutiveFailures(t *testing.T) {
+	h, now := newTestEndpointHealth(EndpointHealthConfig{
+		MaxConsecutiveFailures: 3,
+		DrainDuration:          30 * time.Second,
+	})
+	h.update([]string{"endpoint-1:4317", "endpoint-2:4317"})
+
+	errExport := errors.New("export failed")
+	h.record("endpoint-1:4317", time.Millisecond, errExport)
+	h.record("endpoint-1:4317", time.Millisecond, errExport)
+	// a successful export resets the consecutive failures
+	h.record("endpoint-1:4317", time.Millisecond, nil)
+	h.record("endpoint-1:4317", time.Millisecond, errExport)
+	h.record("endpoint-1:4317", time.Millisecond, errExport)
+	assert.Equal(t, 1.0, h.weight("endpoint-1:4317"))
+
+	h.record("endpoint-1:4317", time.Millisecond, errExport)
+	assert.Zero(t, h.weight("endpoint-1:4317"))
+	assert.Equal(t, 1.0, h.weight("endpoint-2:4317"))
+
+	// the backend receives data again once the drain duration elapsed
+	*now = now.Add(30 * time.Second)
+	assert.Equal(t, 1.0, h.weight("endpoint-1:4317"))
+	h.record("endpoint-1:4317", time.Millisecond, errExport)
+	assert.Equal(t, 1.0, h.weight("endpoint-1:4317"))
+}
+
+func TestEndpointHealthLatency(t *testing.T) {
+	h, _ := newTestEndpointHealth(EndpointHealthConfig{
+		MaxConsecutiveFailures: 3,
+		MaxLatency:             100 * time.Millisecond,
+		DrainDuration:          30 * time.Second,
+	})
+	h.update([]string{"endpoint-1:4317"})
+
+	h.record("endpoint-1:4317", 50*time.Millisecond, nil)
+	// a single slow export doesn't drain the backend: 0.3*200 + 0.7*50 = 95
+	h.record("endpoint-1:4317", 200*time.Millisecond, nil)
+	assert.Equal(t, 1.0, h.weight("endpoint-1:4317"))
+
+	h.record("endpoint-1:4317", 200*time.Millisecond, nil)
+	assert.Zero(t, h.weight("endpoint-1:4317"))
+}
+
+func TestEndpointHealthSlowStart(t *testing.T) {
+	h, now := newTestEndpointHealth(EndpointHealthConfig{
+		MaxConsecutiveFailures: 3,
+		DrainDuration:          30 * time.Second,
+		SlowStart:              time.Minute,
+	})
+
+	// the initial backends are not slow started
+	h.update([]string{"endpoint-1:4317"})
+	assert.Equal(t, 1.0, h.weight("endpoint-1:4317"))
+
+	h.update([]string{"endpoint-1:4317", "endpoint-2:4317"})
+	assert.Equal(t, 1.0, h.weight("endpoint-1:4317"))
+	assert.Zero(t, h.weight("endpoint-2:4317"))
+
+	*now = now.Add(15 * time.Second)
+	assert.InDelta(t, 0.25, h.weight("endpoint-2:4317"), 1e-9)
+
+	*now = now.Add(45 * time.Second)
+	assert.Equal(t, 1.0, h.weight("endpoint-2:4317"))
+
+	// removed backends are forgotten, and slow started again when added back
+	h.update([]string{"endpoint-1:4317"})
+	h.update([]string{"endpoint-1:4317", "endpoint-2:4317"})
+	assert.Zero(t, h.weight("endpoint-2:4317"))
+}
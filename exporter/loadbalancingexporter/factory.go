@@ -8,6 +8,7 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configoptional"
@@ -48,6 +49,11 @@ func createDefaultConfig() component.Config {
 			OTLP: *otlpDefaultCfg,
 		},
 		QueueSettings: configoptional.Default(exporterhelper.NewDefaultQueueConfig()),
+		VirtualNodes:  defaultWeight,
+		EndpointHealth: configoptional.Default(EndpointHealthConfig{
+			MaxConsecutiveFailures: 5,
+			DrainDuration:          30 * time.Second,
+		}),
 	}
 }
 
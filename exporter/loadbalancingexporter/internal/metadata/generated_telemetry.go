@@ -3,11 +3,13 @@
 package metadata
 
 import (
+	"context"
 	"errors"
 	"sync"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -27,6 +29,8 @@ type TelemetryBuilder struct {
 	registrations                 []metric.Registration
 	LoadbalancerBackendLatency    metric.Int64Histogram
 	LoadbalancerBackendOutcome    metric.Int64Counter
+	LoadbalancerEndpointShare     metric.Float64ObservableGauge
+	LoadbalancerKeyspaceMoved     metric.Float64Gauge
 	LoadbalancerNumBackendUpdates metric.Int64Counter
 	LoadbalancerNumBackends       metric.Int64Gauge
 	LoadbalancerNumResolutions    metric.Int64Counter
@@ -43,6 +47,31 @@ func (tbof telemetryBuilderOptionFunc) apply(mb *TelemetryBuilder) {
 	tbof(mb)
 }
 
+// RegisterLoadbalancerEndpointShareCallback sets callback for observable LoadbalancerEndpointShare metric.
+func (builder *TelemetryBuilder) RegisterLoadbalancerEndpointShareCallback(cb metric.Float64Callback) error {
+	reg, err := builder.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		cb(ctx, &observerFloat64{inst: builder.LoadbalancerEndpointShare, obs: o})
+		return nil
+	}, builder.LoadbalancerEndpointShare)
+	if err != nil {
+		return err
+	}
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	builder.registrations = append(builder.registrations, reg)
+	return nil
+}
+
+type observerFloat64 struct {
+	embedded.Float64Observer
+	inst metric.Float64Observable
+	obs  metric.Observer
+}
+
+func (oi *observerFloat64) Observe(value float64, opts ...metric.ObserveOption) {
+	oi.obs.ObserveFloat64(oi.inst, value, opts...)
+}
+
 // Shutdown unregister all registered callbacks for async instruments.
 func (builder *TelemetryBuilder) Shutdown() {
 	builder.mu.Lock()
@@ -74,6 +103,18 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 		metric.WithUnit("{outcomes}"),
 	)
 	errs = errors.Join(errs, err)
+	builder.LoadbalancerEndpointShare, err = builder.meter.Float64ObservableGauge(
+		"otelcol_loadbalancer_endpoint_share",
+		metric.WithDescription("Fraction of the routing keyspace routed to the backend, accounting for drained and slow starting backends. [Development]"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerKeyspaceMoved, err = builder.meter.Float64Gauge(
+		"otelcol_loadbalancer_keyspace_moved",
+		metric.WithDescription("Fraction of the routing keyspace assigned to a different backend by the last update of the list of backends. [Development]"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
 	builder.LoadbalancerNumBackendUpdates, err = builder.meter.Int64Counter(
 		"otelcol_loadbalancer_num_backend_updates",
 		metric.WithDescription("Number of times the list of backends was updated. [Development]"),
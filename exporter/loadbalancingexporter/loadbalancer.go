@@ -10,8 +10,11 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
@@ -32,8 +35,11 @@ type loadBalancer struct {
 	logger *zap.Logger
 	host   component.Host
 
-	res  resolver
-	ring *hashRing
+	res          resolver
+	ring         *hashRing
+	virtualNodes int
+	health       *endpointHealth
+	telemetry    *metadata.TelemetryBuilder
 
 	componentFactory componentFactory
 	exporters        map[string]*wrappedExporter
@@ -136,12 +142,21 @@ func newLoadBalancer(logger *zap.Logger, cfg component.Config, factory component
 		return nil, errNoResolver
 	}
 
-	return &loadBalancer{
+	lb := &loadBalancer{
 		logger:           logger,
 		res:              res,
+		virtualNodes:     oCfg.VirtualNodes,
+		telemetry:        telemetry,
 		componentFactory: factory,
 		exporters:        map[string]*wrappedExporter{},
-	}, nil
+	}
+	if oCfg.EndpointHealth.HasValue() {
+		lb.health = newEndpointHealth(*oCfg.EndpointHealth.Get(), logger)
+	}
+	if err := telemetry.RegisterLoadbalancerEndpointShareCallback(lb.observeEndpointShare); err != nil {
+		return nil, err
+	}
+	return lb, nil
 }
 
 func (lb *loadBalancer) Start(ctx context.Context, host component.Host) error {
@@ -151,13 +166,25 @@ func (lb *loadBalancer) Start(ctx context.Context, host component.Host) error {
 }
 
 func (lb *loadBalancer) onBackendChanges(resolved []string) {
-	newRing := newHashRing(resolved)
+	newRing := newHashRing(resolved, lb.virtualNodes)
 
 	if !newRing.equal(lb.ring) {
 		lb.updateLock.Lock()
 		defer lb.updateLock.Unlock()
 
+		moved := lb.ring.keyspaceMoved(newRing)
+		lb.telemetry.LoadbalancerKeyspaceMoved.Record(context.Background(), moved)
+		if moved > 0 {
+			lb.logger.Debug("backends changed, keyspace moved to different backends", zap.Float64("keyspace_moved", moved))
+		}
 		lb.ring = newRing
+		if lb.health != nil {
+			endpoints := make([]string, len(resolved))
+			for i, endpoint := range resolved {
+				endpoints[i] = endpointWithPort(endpoint)
+			}
+			lb.health.update(endpoints)
+		}
 
 		// TODO: set a timeout?
 		ctx := context.Background()
@@ -215,6 +242,7 @@ func (lb *loadBalancer) removeExtraExporters(ctx context.Context, endpoints []st
 func (lb *loadBalancer) Shutdown(ctx context.Context) error {
 	err := lb.res.shutdown(ctx)
 	lb.stopped = true
+	lb.telemetry.Shutdown()
 
 	for _, e := range lb.exporters {
 		err = errors.Join(err, e.Shutdown(ctx))
@@ -229,7 +257,12 @@ func (lb *loadBalancer) exporterAndEndpoint(identifier []byte) (*wrappedExporter
 	// for details: https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/1690
 	lb.updateLock.RLock()
 	defer lb.updateLock.RUnlock()
-	endpoint := lb.ring.endpointFor(identifier)
+	var endpoint string
+	if lb.health != nil {
+		endpoint = lb.ring.endpointForWeighted(identifier, lb.endpointWeight)
+	} else {
+		endpoint = lb.ring.endpointFor(identifier)
+	}
 	exp, found := lb.exporters[endpointWithPort(endpoint)]
 	if !found {
 		// something is really wrong... how come we couldn't find the exporter??
@@ -238,3 +271,29 @@ func (lb *loadBalancer) exporterAndEndpoint(identifier []byte) (*wrappedExporter
 
 	return exp, endpoint, nil
 }
+
+// recordOutcome updates the health of the backend with the outcome of an export.
+func (lb *loadBalancer) recordOutcome(exp *wrappedExporter, latency time.Duration, err error) {
+	if lb.health != nil {
+		lb.health.record(exp.endpoint, latency, err)
+	}
+}
+
+// endpointWeight returns the weight of the given ring endpoint.
+func (lb *loadBalancer) endpointWeight(endpoint string) float64 {
+	return lb.health.weight(endpointWithPort(endpoint))
+}
+
+func (lb *loadBalancer) observeEndpointShare(_ context.Context, observer metric.Float64Observer) error {
+	lb.updateLock.RLock()
+	defer lb.updateLock.RUnlock()
+
+	weight := func(string) float64 { return 1 }
+	if lb.health != nil {
+		weight = lb.endpointWeight
+	}
+	for endpoint, share := range lb.ring.shares(weight) {
+		observer.Observe(share, metric.WithAttributes(attribute.String("endpoint", endpointWithPort(endpoint))))
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,9 +17,14 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.uber.org/zap"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadatatest"
 )
 
 func TestNewLoadBalancerNoResolver(t *testing.T) {
@@ -238,6 +244,75 @@ func TestOnBackendChanges(t *testing.T) {
 	assert.Len(t, p.ring.items, 2*defaultWeight)
 }
 
+func TestOnBackendChangesKeyspaceMoved(t *testing.T) {
+	// prepare
+	tt := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tt.Shutdown(context.Background())) })
+	tb, err := metadata.NewTelemetryBuilder(tt.NewTelemetrySettings())
+	require.NoError(t, err)
+	cfg := simpleConfig()
+	cfg.VirtualNodes = 200
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+
+	p, err := newLoadBalancer(zap.NewNop(), cfg, componentFactory, tb)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+	require.Len(t, p.ring.items, 2*200)
+	metadatatest.AssertEqualLoadbalancerKeyspaceMoved(t, tt, []metricdata.DataPoint[float64]{{Value: 0}}, metricdatatest.IgnoreTimestamp())
+
+	p.onBackendChanges([]string{"endpoint-1"})
+
+	// verify
+	expected := newHashRing([]string{"endpoint-1", "endpoint-2"}, 200).keyspaceMoved(p.ring)
+	assert.Greater(t, expected, 0.0)
+	metadatatest.AssertEqualLoadbalancerKeyspaceMoved(t, tt, []metricdata.DataPoint[float64]{{Value: expected}}, metricdatatest.IgnoreTimestamp())
+}
+
+func TestLoadBalancerEndpointHealth(t *testing.T) {
+	// prepare
+	tt := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tt.Shutdown(context.Background())) })
+	tb, err := metadata.NewTelemetryBuilder(tt.NewTelemetrySettings())
+	require.NoError(t, err)
+	cfg := simpleConfig()
+	cfg.EndpointHealth = configoptional.Some(EndpointHealthConfig{
+		MaxConsecutiveFailures: 1,
+		DrainDuration:          time.Minute,
+	})
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+
+	p, err := newLoadBalancer(zap.NewNop(), cfg, componentFactory, tb)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+
+	id := []byte("get-recommendations-1")
+	exp, endpoint, err := p.exporterAndEndpoint(id)
+	require.NoError(t, err)
+
+	// test
+	p.recordOutcome(exp, time.Millisecond, errors.New("export failed"))
+
+	// verify
+	_, drainedEndpoint, err := p.exporterAndEndpoint(id)
+	require.NoError(t, err)
+	assert.NotEqual(t, endpoint, drainedEndpoint)
+
+	shares := p.ring.shares(p.endpointWeight)
+	assert.Zero(t, shares[endpoint])
+	metadatatest.AssertEqualLoadbalancerEndpointShare(t, tt, []metricdata.DataPoint[float64]{
+		{Attributes: attribute.NewSet(attribute.String("endpoint", endpointWithPort(drainedEndpoint))), Value: 1},
+		{Attributes: attribute.NewSet(attribute.String("endpoint", endpointWithPort(endpoint))), Value: 0},
+	}, metricdatatest.IgnoreTimestamp())
+}
+
 func TestRemoveExtraExporters(t *testing.T) {
 	// prepare
 	ts, tb := getTelemetryAssets(t)
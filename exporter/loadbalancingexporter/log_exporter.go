@@ -111,6 +111,7 @@ func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 	err = le.ConsumeLogs(ctx, ld)
 	duration := time.Since(start)
 	e.telemetry.LoadbalancerBackendLatency.Record(ctx, duration.Milliseconds(), metric.WithAttributeSet(le.endpointAttr))
+	e.loadBalancer.recordOutcome(le, duration, err)
 	if err == nil {
 		e.telemetry.LoadbalancerBackendOutcome.Add(ctx, 1, metric.WithAttributeSet(le.successAttr))
 	} else {
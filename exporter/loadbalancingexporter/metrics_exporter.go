@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,8 +30,10 @@ import (
 var _ exporter.Metrics = (*metricExporterImp)(nil)
 
 type metricExporterImp struct {
-	loadBalancer *loadBalancer
-	routingKey   routingKey
+	loadBalancer    *loadBalancer
+	routingKey      routingKey
+	routingAttrs    []string
+	routingFallback *routingFallback
 
 	logger     *zap.Logger
 	stopped    bool
@@ -73,9 +76,19 @@ func newMetricsExporter(params exporter.Settings, cfg component.Config) (*metric
 		metricExporter.routingKey = metricNameRouting
 	case streamIDRoutingStr:
 		metricExporter.routingKey = streamIDRouting
+	case attrRoutingStr:
+		metricExporter.routingKey = attrRouting
+		metricExporter.routingAttrs = cfg.(*Config).RoutingAttributes
 	default:
 		return nil, fmt.Errorf("unsupported routing_key: %q", cfg.(*Config).RoutingKey)
 	}
+
+	if fallback := cfg.(*Config).RoutingAttributesFallback; fallback != "" {
+		metricExporter.routingFallback, err = newRoutingFallback(fallback, params.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &metricExporter, nil
 }
 
@@ -115,6 +128,17 @@ func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 		batches = splitMetricsByMetricName(md)
 	case streamIDRouting:
 		batches = splitMetricsByStreamID(md)
+	case attrRouting:
+		var errs []error
+		batches, errs = splitMetricsByResourceAttributes(ctx, md, e.routingAttrs, e.routingFallback)
+		if len(errs) > 0 {
+			for _, ee := range errs {
+				e.logger.Error("failed to export metric", zap.Error(ee))
+			}
+			if len(batches) == 0 {
+				return consumererror.NewPermanent(errors.Join(errs...))
+			}
+		}
 	}
 
 	// Now assign each batch to an exporter, and merge as we go
@@ -147,6 +171,7 @@ func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 		exp.consumeWG.Done()
 		errs = multierr.Append(errs, err)
 		e.telemetry.LoadbalancerBackendLatency.Record(ctx, duration.Milliseconds(), metric.WithAttributeSet(exp.endpointAttr))
+		e.loadBalancer.recordOutcome(exp, duration, err)
 		if err == nil {
 			e.telemetry.LoadbalancerBackendOutcome.Add(ctx, 1, metric.WithAttributeSet(exp.successAttr))
 		} else {
@@ -209,6 +234,50 @@ func splitMetricsByResourceID(md pmetric.Metrics) map[string]pmetric.Metrics {
 	return results
 }
 
+// splitMetricsByResourceAttributes groups the resource metrics by the composite key of the given resource
+// attributes. When none of the attributes are found on a resource, the key is computed by the fallback
+// expression, if any.
+func splitMetricsByResourceAttributes(ctx context.Context, md pmetric.Metrics, attrs []string, fallback *routingFallback) (map[string]pmetric.Metrics, []error) {
+	results := map[string]pmetric.Metrics{}
+	var errs []error
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+
+		var rKey strings.Builder
+		found := false
+		for _, a := range attrs {
+			if v, ok := rm.Resource().Attributes().Get(a); ok {
+				rKey.WriteString(v.AsString())
+				found = true
+			}
+		}
+
+		key := rKey.String()
+		if !found && fallback != nil {
+			var err error
+			key, err = fallback.key(ctx, rm)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("unable to evaluate routing_attributes_fallback for resource metric with attributes %v: %w", rm.Resource().Attributes().AsRaw(), err))
+				continue
+			}
+		}
+
+		newMD := pmetric.NewMetrics()
+		rmClone := newMD.ResourceMetrics().AppendEmpty()
+		rm.CopyTo(rmClone)
+
+		existing, ok := results[key]
+		if ok {
+			metrics.Merge(existing, newMD)
+		} else {
+			results[key] = newMD
+		}
+	}
+
+	return results, errs
+}
+
 func splitMetricsByMetricName(md pmetric.Metrics) map[string]pmetric.Metrics {
 	results := map[string]pmetric.Metrics{}
 
@@ -253,6 +253,45 @@ func TestSplitMetricsByResourceServiceNameFailsIfMissingServiceNameAttribute(t *
 	require.NotNil(t, errs)
 }
 
+func TestSplitMetricsByResourceAttributes(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join("testdata", "metrics", "split_metrics", "basic_resource_attributes")
+	input, err := golden.ReadMetrics(filepath.Join(dir, "input.yaml"))
+	require.NoError(t, err)
+	expectedOutput := loadMetricsMap(t, filepath.Join(dir, "output.yaml"))
+
+	fallback, err := newRoutingFallback(`resource.attributes["k8s.namespace.name"]`, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	output, errs := splitMetricsByResourceAttributes(t.Context(), input, []string{"tenant", "service.name"}, fallback)
+	require.Nil(t, errs)
+	compareMetricsMaps(t, expectedOutput, output)
+
+	// without fallback, resources without the routing attributes share the empty key
+	output, errs = splitMetricsByResourceAttributes(t.Context(), input, []string{"tenant", "service.name"}, nil)
+	require.Nil(t, errs)
+	assert.Len(t, output, 3)
+	assert.Contains(t, output, "")
+}
+
+func TestNewMetricsExporterRoutingAttributesFallback(t *testing.T) {
+	ts, _ := getTelemetryAssets(t)
+	cfg := simpleConfig()
+	cfg.RoutingKey = attrRoutingStr
+	cfg.RoutingAttributes = []string{"tenant"}
+	cfg.RoutingAttributesFallback = `Concat([resource.attributes["k8s.cluster.name"], resource.attributes["k8s.namespace.name"]], "/")`
+
+	p, err := newMetricsExporter(ts, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, p.routingFallback)
+	assert.Equal(t, attrRouting, p.routingKey)
+
+	cfg.RoutingAttributesFallback = `resource.attributes[`
+	_, err = newMetricsExporter(ts, cfg)
+	assert.ErrorContains(t, err, "invalid routing_attributes_fallback")
+}
+
 func TestSplitMetrics(t *testing.T) {
 	t.Parallel()
 
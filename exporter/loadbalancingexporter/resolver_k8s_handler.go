@@ -136,10 +136,15 @@ func (h handler) OnDelete(obj any) {
 	}
 }
 
+// convertToEndpoints returns the ready endpoints of the EndpointSlices. The endpoints which are not ready,
+// e.g. starting or terminating pods, are left out until they become ready.
 func convertToEndpoints(retNames bool, eps ...*discoveryv1.EndpointSlice) (bool, map[string]bool) {
 	res := map[string]bool{}
 	for _, ep := range eps {
 		for _, endpoint := range ep.Endpoints {
+			if !isReady(endpoint) {
+				continue
+			}
 			for _, addr := range endpoint.Addresses {
 				if retNames {
 					if endpoint.Hostname == nil || *endpoint.Hostname == "" {
@@ -154,3 +159,9 @@ func convertToEndpoints(retNames bool, eps ...*discoveryv1.EndpointSlice) (bool,
 	}
 	return true, res
 }
+
+// isReady reports whether the endpoint can receive traffic. An unknown readiness is interpreted as ready,
+// as described by the EndpointConditions API.
+func isReady(endpoint discoveryv1.Endpoint) bool {
+	return endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+}
@@ -52,6 +52,27 @@ func TestConvertToEndpoints(tst *testing.T) {
 		},
 	}
 
+	notReady := false
+	ready := true
+	endpoints4 := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-endpoints-4",
+			Namespace: "test-namespace",
+		},
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{"192.168.10.104"},
+				Hostname:   &hostname1,
+				Conditions: discoveryv1.EndpointConditions{Ready: &ready},
+			},
+			{
+				// Not ready endpoints are left out, even without a hostname.
+				Addresses:  []string{"192.168.10.105"},
+				Conditions: discoveryv1.EndpointConditions{Ready: &notReady},
+			},
+		},
+	}
+
 	tests := []struct {
 		name              string
 		returnNames       bool
@@ -73,6 +94,20 @@ func TestConvertToEndpoints(tst *testing.T) {
 			expectedEndpoints: map[string]bool{"192.168.10.101": true, "192.168.10.102": true, "192.168.10.103": true},
 			wantNil:           false,
 		},
+		{
+			name:              "ready IPs",
+			returnNames:       false,
+			includedEndpoints: []*discoveryv1.EndpointSlice{endpoints4},
+			expectedEndpoints: map[string]bool{"192.168.10.104": true},
+			wantNil:           false,
+		},
+		{
+			name:              "ready hostnames",
+			returnNames:       true,
+			includedEndpoints: []*discoveryv1.EndpointSlice{endpoints4},
+			expectedEndpoints: map[string]bool{"pod-1": true},
+			wantNil:           false,
+		},
 		{
 			name:              "missing hostname",
 			returnNames:       true,
@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -212,6 +213,34 @@ func TestK8sResolve(t *testing.T) {
 				"10.10.0.11:4317",
 			},
 		},
+		{
+			name: "remove backend which is not ready anymore",
+			args: args{
+				logger:    zap.NewNop(),
+				service:   "lb",
+				namespace: "default",
+				ports:     []int32{4317},
+			},
+			simulateFn: func(suiteCtx *suiteContext, args args) error {
+				endpoint, exist := suiteCtx.endpoint.DeepCopy(), suiteCtx.endpoint.DeepCopy()
+				endpoint.Endpoints = []discoveryv1.Endpoint{
+					{Addresses: []string{"192.168.10.100"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false), Terminating: ptr.To(true)}},
+					{Addresses: []string{"10.10.0.11"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}},
+					{Addresses: []string{"10.10.0.12"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}},
+				}
+				patch := client.MergeFrom(exist)
+				data, err := patch.Data(endpoint)
+				if err != nil {
+					return err
+				}
+				_, err = suiteCtx.clientset.DiscoveryV1().EndpointSlices(args.namespace).
+					Patch(t.Context(), args.service, types.MergePatchType, data, metav1.PatchOptions{})
+				return err
+			},
+			expectedEndpoints: []string{
+				"10.10.0.12:4317",
+			},
+		},
 		{
 			name: "simulate deletion of backends",
 			args: args{
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// routingFallback computes the routing key of a resource when none of the routing attributes are found,
+// by evaluating an OTTL value expression in the resource context.
+type routingFallback struct {
+	expr *ottl.ValueExpression[*ottlresource.TransformContext]
+}
+
+func newRoutingFallback(expression string, settings component.TelemetrySettings) (*routingFallback, error) {
+	parser, err := ottlresource.NewParser(ottlfuncs.StandardConverters[*ottlresource.TransformContext](), settings)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := parser.ParseValueExpression(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid routing_attributes_fallback: %w", err)
+	}
+	return &routingFallback{expr: expr}, nil
+}
+
+// key evaluates the expression for the given resource metrics. Nil values result in an empty key.
+func (f *routingFallback) key(ctx context.Context, rm pmetric.ResourceMetrics) (string, error) {
+	tCtx := ottlresource.NewTransformContextPtr(rm.Resource(), rm)
+	defer tCtx.Close()
+
+	value, err := f.expr.Eval(ctx, tCtx)
+	if err != nil {
+		return "", err
+	}
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
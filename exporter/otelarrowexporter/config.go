@@ -99,6 +99,11 @@ type ArrowConfig struct {
 	// Prioritizer is a policy name for how load is distributed
 	// across streams.
 	Prioritizer arrow.PrioritizerName `mapstructure:"prioritizer"`
+
+	// AdaptiveStreams varies the number of streams between a
+	// minimum and NumStreams, based on the depth of the queue of
+	// senders waiting for a stream and on the downstream latency.
+	AdaptiveStreams arrow.AdaptiveStreamsConfig `mapstructure:"adaptive_streams"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -141,6 +146,10 @@ func (cfg *ArrowConfig) Validate() error {
 		return fmt.Errorf("invalid prioritizer: %w", err)
 	}
 
+	if err := cfg.AdaptiveStreams.Validate(cfg.NumStreams); err != nil {
+		return fmt.Errorf("invalid adaptive_streams: %w", err)
+	}
+
 	// The cfg.PayloadCompression field is validated by the underlying library,
 	// but we only support Zstd or none.
 	switch cfg.PayloadCompression {
@@ -99,6 +99,12 @@ func TestUnmarshalConfig(t *testing.T) {
 				PayloadCompression: configcompression.TypeZstd,
 				Zstd:               zstd.DefaultEncoderConfig(),
 				Prioritizer:        "leastloaded8",
+				AdaptiveStreams: arrow.AdaptiveStreamsConfig{
+					Enabled:       true,
+					MinStreams:    1,
+					Interval:      30 * time.Second,
+					TargetLatency: 500 * time.Millisecond,
+				},
 			},
 		}, cfg)
 }
@@ -131,6 +137,24 @@ func TestArrowConfigValidate(t *testing.T) {
 	require.Error(t, settings(true, math.MaxInt, 10*time.Second, zstd.MaxLevel+1).Validate())
 }
 
+func TestArrowConfigValidateAdaptiveStreams(t *testing.T) {
+	settings := func(adaptive arrow.AdaptiveStreamsConfig) *ArrowConfig {
+		return &ArrowConfig{
+			NumStreams:        4,
+			MaxStreamLifetime: 10 * time.Second,
+			Zstd:              zstd.DefaultEncoderConfig(),
+			AdaptiveStreams:   adaptive,
+		}
+	}
+	require.NoError(t, settings(arrow.AdaptiveStreamsConfig{}).Validate())
+	require.NoError(t, settings(arrow.AdaptiveStreamsConfig{Enabled: true, MinStreams: 4, Interval: time.Second}).Validate())
+
+	require.ErrorContains(t, settings(arrow.AdaptiveStreamsConfig{Enabled: true, MinStreams: 0, Interval: time.Second}).Validate(), "min_streams must be between 1 and num_streams")
+	require.ErrorContains(t, settings(arrow.AdaptiveStreamsConfig{Enabled: true, MinStreams: 5, Interval: time.Second}).Validate(), "min_streams must be between 1 and num_streams")
+	require.ErrorContains(t, settings(arrow.AdaptiveStreamsConfig{Enabled: true, MinStreams: 1}).Validate(), "interval must be > 0")
+	require.ErrorContains(t, settings(arrow.AdaptiveStreamsConfig{Enabled: true, MinStreams: 1, Interval: time.Second, TargetLatency: -1}).Validate(), "target_latency must not be negative")
+}
+
 func TestDefaultConfigValid(t *testing.T) {
 	cfg := createDefaultConfig()
 	// this must be set by the user and config
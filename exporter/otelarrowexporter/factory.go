@@ -77,8 +77,9 @@ func createDefaultConfig() component.Config {
 			NumStreams:        arrow.DefaultNumStreams,
 			MaxStreamLifetime: arrow.DefaultMaxStreamLifetime,
 
-			Zstd:        zstd.DefaultEncoderConfig(),
-			Prioritizer: arrow.DefaultPrioritizer,
+			Zstd:            zstd.DefaultEncoderConfig(),
+			Prioritizer:     arrow.DefaultPrioritizer,
+			AdaptiveStreams: arrow.DefaultAdaptiveStreamsConfig(),
 
 			// Note the default payload compression is
 			PayloadCompression: arrow.DefaultPayloadCompression,
@@ -47,6 +47,7 @@ func TestCreateDefaultConfig(t *testing.T) {
 		PayloadCompression: "zstd",
 		Zstd:               zstd.DefaultEncoderConfig(),
 		Prioritizer:        arrow.DefaultPrioritizer,
+		AdaptiveStreams:    arrow.DefaultAdaptiveStreamsConfig(),
 	}, ocfg.Arrow)
 }
 
@@ -0,0 +1,295 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package arrow // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter/internal/arrow"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter/internal/metadata"
+)
+
+// DefaultAdaptiveInterval is the default interval between two
+// adaptive stream concurrency decisions.
+const DefaultAdaptiveInterval = 10 * time.Second
+
+// AdaptiveStreamsConfig configures adaptive stream concurrency.  When
+// enabled, the number of streams varies between MinStreams and the
+// exporter's num_streams, instead of staying fixed at num_streams.
+type AdaptiveStreamsConfig struct {
+	// Enabled turns on adaptive stream concurrency.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MinStreams is the number of streams the exporter starts
+	// with, and never goes below.
+	MinStreams int `mapstructure:"min_streams"`
+
+	// Interval is the duration between two scaling decisions.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// TargetLatency is the average batch latency, measured from
+	// the send of a batch to the receipt of its status, above
+	// which the downstream is considered to apply backpressure.
+	// Streams are then removed instead of added.  Zero disables
+	// the latency signal.
+	TargetLatency time.Duration `mapstructure:"target_latency"`
+}
+
+// DefaultAdaptiveStreamsConfig returns the default, disabled, adaptive
+// stream concurrency settings.
+func DefaultAdaptiveStreamsConfig() AdaptiveStreamsConfig {
+	return AdaptiveStreamsConfig{
+		MinStreams: 1,
+		Interval:   DefaultAdaptiveInterval,
+	}
+}
+
+// Validate checks the settings against the maximum number of streams.
+func (cfg AdaptiveStreamsConfig) Validate(numStreams int) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.MinStreams < 1 || cfg.MinStreams > numStreams {
+		return fmt.Errorf("min_streams must be between 1 and num_streams (%d): %d", numStreams, cfg.MinStreams)
+	}
+	if cfg.Interval <= 0 {
+		return errors.New("interval must be > 0")
+	}
+	if cfg.TargetLatency < 0 {
+		return errors.New("target_latency must not be negative")
+	}
+	return nil
+}
+
+const (
+	scaleReasonQueueDepth = "queue_depth"
+	scaleReasonLatency    = "latency"
+	scaleReasonIdle       = "idle"
+)
+
+type slotState int
+
+const (
+	// slotRunning is a slot with a stream receiving work.
+	slotRunning slotState = iota
+	// slotRetiring is a slot whose stream was asked to finish
+	// its outstanding work and stop.
+	slotRetiring
+	// slotStopped is a slot without a stream.  Late items
+	// written to it are returned to their sender for retry.
+	slotStopped
+)
+
+// streamSlot tracks one of the exporter's stream work states.
+type streamSlot struct {
+	ws    *streamWorkState
+	state slotState
+
+	// stopDrain and drained control the goroutine returning the
+	// items of a stopped slot.
+	stopDrain chan struct{}
+	drained   chan struct{}
+}
+
+// streamScaler decides the number of active streams.  Except for the
+// queue depth observations, it is only used by the stream controller
+// goroutine.
+type streamScaler struct {
+	cfg        AdaptiveStreamsConfig
+	maxStreams int
+	logger     *zap.Logger
+	telemetry  *metadata.TelemetryBuilder
+
+	// inflight is the number of callers in SendAndWait.
+	inflight atomic.Int64
+	// depthSum and depthCount accumulate the number of callers
+	// in SendAndWait observed by each new caller.
+	depthSum   atomic.Int64
+	depthCount atomic.Int64
+
+	// slots has one entry per stream work state, the first
+	// active ones being eligible for work.
+	slots  []*streamSlot
+	active int
+}
+
+func newStreamScaler(cfg AdaptiveStreamsConfig, sws []*streamWorkState, logger *zap.Logger, telemetry *metadata.TelemetryBuilder) *streamScaler {
+	s := &streamScaler{
+		cfg:        cfg,
+		maxStreams: len(sws),
+		logger:     logger,
+		telemetry:  telemetry,
+		active:     cfg.MinStreams,
+	}
+	for i, ws := range sws {
+		slot := &streamSlot{ws: ws}
+		if i >= s.active {
+			slot.state = slotStopped
+		}
+		s.slots = append(s.slots, slot)
+	}
+	return s
+}
+
+// enter is called when a caller begins SendAndWait.
+func (s *streamScaler) enter() {
+	n := s.inflight.Add(1)
+	s.depthSum.Add(n)
+	s.depthCount.Add(1)
+}
+
+// exit is called when a caller returns from SendAndWait.
+func (s *streamScaler) exit() {
+	s.inflight.Add(-1)
+}
+
+// averageDepth returns the average number of callers in SendAndWait
+// since the last call, and resets the observations.
+func (s *streamScaler) averageDepth() float64 {
+	sum := s.depthSum.Swap(0)
+	count := s.depthCount.Swap(0)
+	if count == 0 {
+		return float64(s.inflight.Load())
+	}
+	return float64(sum) / float64(count)
+}
+
+// averageLatency returns the average batch latency of the active
+// streams since the last call, and resets the observations.
+func (s *streamScaler) averageLatency() (time.Duration, bool) {
+	var sum time.Duration
+	var count int64
+	for _, slot := range s.slots[:s.active] {
+		ls, lc := slot.ws.takeLatency()
+		sum += ls
+		count += lc
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return sum / time.Duration(count), true
+}
+
+// decide returns the number of streams to use next and the reason of
+// the change.  Streams are added one at a time while callers queue up
+// for the streams, and removed one at a time when the downstream
+// latency exceeds the target or when streams are idle.
+func (s *streamScaler) decide(active int, depth float64, latency time.Duration, hasLatency bool) (int, string) {
+	overTarget := s.cfg.TargetLatency > 0 && hasLatency && latency > s.cfg.TargetLatency
+	switch {
+	case overTarget && active > s.cfg.MinStreams:
+		return active - 1, scaleReasonLatency
+	case !overTarget && depth > float64(active) && active < s.maxStreams:
+		return active + 1, scaleReasonQueueDepth
+	case depth < float64(active-1) && active > s.cfg.MinStreams:
+		return active - 1, scaleReasonIdle
+	}
+	return active, ""
+}
+
+// scale takes one scaling decision, starting or retiring a stream.  It
+// returns the change in the number of running streams.
+func (e *Exporter) scale(downCtx context.Context) int {
+	s := e.scaler
+	depth := s.averageDepth()
+	latency, hasLatency := s.averageLatency()
+	next, reason := s.decide(s.active, depth, latency, hasLatency)
+
+	switch {
+	case next > s.active:
+		slot := s.slots[s.active]
+		if slot.state == slotRetiring {
+			// Wait for the previous stream in this slot to finish.
+			return 0
+		}
+		if slot.state == slotStopped && slot.stopDrain != nil {
+			close(slot.stopDrain)
+			<-slot.drained
+			slot.stopDrain, slot.drained = nil, nil
+		}
+		slot.state = slotRunning
+		e.startArrowStream(downCtx, slot.ws)
+		s.active++
+		e.ready.setActive(s.active)
+		s.record(1, reason, depth, latency)
+		return 1
+
+	case next < s.active:
+		s.active--
+		e.ready.setActive(s.active)
+		s.record(-1, reason, depth, latency)
+		slot := s.slots[s.active]
+		if slot.state != slotRunning {
+			// The stream already stopped.
+			return 0
+		}
+		slot.state = slotRetiring
+		slot.ws.retireStream()
+	}
+	return 0
+}
+
+// stopped marks the slot of a stream that returned and was not
+// restarted, because the endpoint does not support Arrow.
+func (s *streamScaler) stopped(ws *streamWorkState) {
+	for _, slot := range s.slots {
+		if slot.ws == ws {
+			slot.state = slotStopped
+		}
+	}
+}
+
+// retired handles a stream returning to the controller, and returns
+// true if its slot was retiring, in which case it is not restarted.
+func (s *streamScaler) retired(ws *streamWorkState, done <-chan struct{}) bool {
+	for _, slot := range s.slots {
+		if slot.ws != ws || slot.state != slotRetiring {
+			continue
+		}
+		slot.state = slotStopped
+		slot.stopDrain = make(chan struct{})
+		slot.drained = make(chan struct{})
+		go func(toWrite <-chan writeItem, stop <-chan struct{}, drained chan<- struct{}) {
+			defer close(drained)
+			for {
+				select {
+				case <-stop:
+					return
+				case <-done:
+					return
+				case item := <-toWrite:
+					// The sender will pick another stream.
+					item.errCh <- ErrStreamRestarting
+				}
+			}
+		}(ws.toWrite, slot.stopDrain, slot.drained)
+		return true
+	}
+	return false
+}
+
+func (s *streamScaler) record(delta int, reason string, depth float64, latency time.Duration) {
+	direction := "up"
+	if delta < 0 {
+		direction = "down"
+	}
+	s.logger.Debug("adjusting the number of arrow streams",
+		zap.Int("streams", s.active),
+		zap.String("reason", reason),
+		zap.Float64("queue_depth", depth),
+		zap.Duration("latency", latency),
+	)
+	s.telemetry.OtelarrowExporterStreams.Add(context.Background(), int64(delta))
+	s.telemetry.OtelarrowExporterStreamScalingDecisions.Add(context.Background(), 1, metric.WithAttributeSet(attribute.NewSet(
+		attribute.String("direction", direction),
+		attribute.String("reason", reason),
+	)))
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package arrow
+
+import (
+	"context"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter/internal/metadatatest"
+)
+
+func TestStreamScalerDecide(t *testing.T) {
+	s := &streamScaler{
+		cfg: AdaptiveStreamsConfig{
+			MinStreams:    2,
+			TargetLatency: time.Second,
+		},
+		maxStreams: 4,
+	}
+	for _, tc := range []struct {
+		name       string
+		active     int
+		depth      float64
+		latency    time.Duration
+		hasLatency bool
+		want       int
+		reason     string
+	}{
+		{name: "queue depth", active: 2, depth: 3, latency: 100 * time.Millisecond, hasLatency: true, want: 3, reason: scaleReasonQueueDepth},
+		{name: "queue depth without latency", active: 2, depth: 3, want: 3, reason: scaleReasonQueueDepth},
+		{name: "at max", active: 4, depth: 10, want: 4},
+		{name: "latency", active: 3, depth: 10, latency: 2 * time.Second, hasLatency: true, want: 2, reason: scaleReasonLatency},
+		{name: "latency at min", active: 2, depth: 10, latency: 2 * time.Second, hasLatency: true, want: 2},
+		{name: "idle", active: 4, depth: 1, want: 3, reason: scaleReasonIdle},
+		{name: "idle at min", active: 2, depth: 0, want: 2},
+		{name: "steady", active: 3, depth: 2.5, want: 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, reason := s.decide(tc.active, tc.depth, tc.latency, tc.hasLatency)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func TestStreamScalerRetire(t *testing.T) {
+	tt := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tt.Shutdown(context.Background())) })
+	tb, err := metadata.NewTelemetryBuilder(tt.NewTelemetrySettings())
+	require.NoError(t, err)
+
+	_, dc := newDoneCancel(t.Context())
+	defer dc.cancel()
+	prio, sws := newStreamPrioritizer(dc, DefaultPrioritizer, 3, time.Minute)
+	for _, ws := range sws {
+		ws.retire = make(chan struct{})
+	}
+	cfg := AdaptiveStreamsConfig{Enabled: true, MinStreams: 3, Interval: time.Second}
+	e := &Exporter{ready: prio, scaler: newStreamScaler(cfg, sws, zap.NewNop(), tb)}
+	e.scaler.cfg.MinStreams = 1
+
+	// Without callers, a stream is idle and retired.
+	assert.Equal(t, 0, e.scale(t.Context()))
+	assert.Equal(t, 2, e.scaler.active)
+	assert.Equal(t, slotRetiring, e.scaler.slots[2].state)
+	select {
+	case <-sws[2].retire:
+	default:
+		assert.Fail(t, "the stream was not asked to retire")
+	}
+
+	// New work goes to the active streams only.
+	rnd := rand.New(rand.NewPCG(1, 2))
+	tmp := make([]streamSorter, len(sws))
+	for range 100 {
+		assert.NotSame(t, sws[2], prio.(*bestOfNPrioritizer).streamFor(writeItem{}, rnd, tmp))
+	}
+
+	// Work written to the retired stream is returned to its sender.
+	assert.True(t, e.scaler.retired(sws[2], t.Context().Done()))
+	assert.Equal(t, slotStopped, e.scaler.slots[2].state)
+	errCh := make(chan error, 1)
+	sws[2].toWrite <- writeItem{errCh: errCh}
+	assert.ErrorIs(t, <-errCh, ErrStreamRestarting)
+	assert.False(t, e.scaler.retired(sws[0], t.Context().Done()), "running streams are restarted")
+
+	metadatatest.AssertEqualOtelarrowExporterStreamScalingDecisions(t, tt, []metricdata.DataPoint[int64]{{
+		Value: 1,
+		Attributes: attribute.NewSet(
+			attribute.String("direction", "down"),
+			attribute.String("reason", scaleReasonIdle),
+		),
+	}}, metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualOtelarrowExporterStreams(t, tt, []metricdata.DataPoint[int64]{{Value: -1}}, metricdatatest.IgnoreTimestamp())
+}
+
+func TestStreamWorkStateLatency(t *testing.T) {
+	_, dc := newDoneCancel(t.Context())
+	defer dc.cancel()
+	_, sws := newStreamPrioritizer(dc, DefaultPrioritizer, 1, time.Minute)
+	ws := sws[0]
+
+	ws.waiters[1] = make(chan error, 1)
+	ws.sent[1] = time.Now().Add(-time.Second)
+	_, err := ws.getSenderChannel(statusOKFor(1))
+	require.NoError(t, err)
+
+	sum, count := ws.takeLatency()
+	assert.Equal(t, int64(1), count)
+	assert.GreaterOrEqual(t, sum, time.Second)
+
+	sum, count = ws.takeLatency()
+	assert.Zero(t, sum)
+	assert.Zero(t, count)
+}
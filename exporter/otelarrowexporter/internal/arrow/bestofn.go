@@ -8,6 +8,7 @@ import (
 	"math/rand/v2"
 	"runtime"
 	"sort"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -35,6 +36,10 @@ type bestOfNPrioritizer struct {
 
 	// loadFunc is the load function.
 	loadFunc loadFunc
+
+	// active is the number of streams, at the start of state,
+	// eligible for new work.
+	active atomic.Int32
 }
 
 type loadFunc func(*streamWorkState) float64
@@ -56,6 +61,7 @@ func newBestOfNPrioritizer(dc doneCancel, numChoices, numStreams int, lf loadFun
 		ws := &streamWorkState{
 			maxStreamLifetime: addJitter(maxLifetime),
 			waiters:           map[int64]chan<- error{},
+			sent:              map[int64]time.Time{},
 			toWrite:           make(chan writeItem, 1),
 		}
 
@@ -69,6 +75,7 @@ func newBestOfNPrioritizer(dc doneCancel, numChoices, numStreams int, lf loadFun
 		numChoices: numChoices,
 		loadFunc:   lf,
 	}
+	lp.active.Store(int32(numStreams))
 
 	for range numStreams {
 		// TODO It's not clear if/when the prioritizer can
@@ -85,6 +92,10 @@ func (lp *bestOfNPrioritizer) downgrade(ctx context.Context) {
 	}
 }
 
+func (lp *bestOfNPrioritizer) setActive(n int) {
+	lp.active.Store(int32(n))
+}
+
 func (lp *bestOfNPrioritizer) sendOne(item writeItem, rnd *rand.Rand, tmp []streamSorter) {
 	stream := lp.streamFor(item, rnd, tmp)
 	writeCh := stream.toWrite
@@ -136,21 +147,23 @@ func (lp *bestOfNPrioritizer) nextWriter() streamWriter {
 }
 
 func (lp *bestOfNPrioritizer) streamFor(_ writeItem, rnd *rand.Rand, tmp []streamSorter) *streamWorkState {
-	// Place all streams into the temporary slice.
-	for idx, item := range lp.state {
+	// Place the active streams into the temporary slice.
+	active := int(lp.active.Load())
+	for idx, item := range lp.state[:active] {
 		tmp[idx].work = item
 	}
+	numChoices := min(lp.numChoices, active)
 	// Select numChoices at random by shifting the selection into the start
 	// of the temporary slice.
-	for i := 0; i < lp.numChoices; i++ {
-		pick := rnd.IntN(lp.numChoices - i)
+	for i := 0; i < numChoices; i++ {
+		pick := rnd.IntN(active - i)
 		tmp[i], tmp[i+pick] = tmp[i+pick], tmp[i]
 	}
-	for i := 0; i < lp.numChoices; i++ {
+	for i := 0; i < numChoices; i++ {
 		// TODO: skip channels w/ a pending item (maybe)
 		tmp[i].load = lp.loadFunc(tmp[i].work)
 	}
-	sort.Slice(tmp[0:lp.numChoices], func(i, j int) bool {
+	sort.Slice(tmp[0:numChoices], func(i, j int) bool {
 		return tmp[i].load < tmp[j].load
 	})
 	return tmp[0].work
@@ -25,6 +25,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/grpcutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/otelarrow/netstats"
 )
@@ -120,6 +121,13 @@ type Exporter struct {
 
 	// netReporter measures network traffic.
 	netReporter netstats.Interface
+
+	// adaptive configures adaptive stream concurrency.
+	adaptive AdaptiveStreamsConfig
+
+	// scaler decides the number of active streams, when adaptive
+	// stream concurrency is enabled.
+	scaler *streamScaler
 }
 
 // doneCancel is used to store the done signal and cancelation
@@ -164,6 +172,7 @@ func NewExporter(
 	streamClient StreamClientFunc,
 	perRPCCredentials credentials.PerRPCCredentials,
 	netReporter netstats.Interface,
+	adaptive AdaptiveStreamsConfig,
 ) *Exporter {
 	return &Exporter{
 		maxStreamLifetime: maxStreamLifetime,
@@ -177,12 +186,21 @@ func NewExporter(
 		perRPCCredentials: perRPCCredentials,
 		returning:         make(chan *Stream, numStreams),
 		netReporter:       netReporter,
+		adaptive:          adaptive,
 	}
 }
 
 // Start creates the background context used by all streams and starts
 // a stream controller, which initializes the initial set of streams.
 func (e *Exporter) Start(ctx context.Context) error {
+	var telemetryBuilder *metadata.TelemetryBuilder
+	if e.adaptive.Enabled {
+		var err error
+		if telemetryBuilder, err = metadata.NewTelemetryBuilder(e.telemetry); err != nil {
+			return err
+		}
+	}
+
 	// this is the background context
 	ctx, e.doneCancel = newDoneCancel(ctx)
 
@@ -195,11 +213,19 @@ func (e *Exporter) Start(ctx context.Context) error {
 	var sws []*streamWorkState
 	e.ready, sws = newStreamPrioritizer(downDc, e.prioritizerName, e.numStreams, e.maxStreamLifetime)
 
-	for _, ws := range sws {
+	running := e.numStreams
+	if e.adaptive.Enabled {
+		e.scaler = newStreamScaler(e.adaptive, sws, e.telemetry.Logger, telemetryBuilder)
+		running = e.adaptive.MinStreams
+		e.ready.setActive(running)
+		telemetryBuilder.OtelarrowExporterStreams.Add(ctx, int64(running))
+	}
+
+	for _, ws := range sws[:running] {
 		e.startArrowStream(downCtx, ws)
 	}
 
-	go e.runStreamController(ctx, downCtx, downDc)
+	go e.runStreamController(ctx, downCtx, downDc, running)
 
 	return nil
 }
@@ -207,6 +233,7 @@ func (e *Exporter) Start(ctx context.Context) error {
 func (e *Exporter) startArrowStream(ctx context.Context, ws *streamWorkState) {
 	// this is the new stream context
 	ctx, dc := newDoneCancel(ctx)
+	ws.retire = make(chan struct{})
 
 	e.wg.Add(1)
 
@@ -217,15 +244,32 @@ func (e *Exporter) startArrowStream(ctx context.Context, ws *streamWorkState) {
 // terminate one at a time and restarts them.  If streams come back with a nil
 // client (meaning that OTel-Arrow was not supported by the endpoint), it will
 // not be restarted.
-func (e *Exporter) runStreamController(exportCtx, downCtx context.Context, downDc doneCancel) {
+//
+// When adaptive stream concurrency is enabled, it also periodically adds
+// or retires streams.
+func (e *Exporter) runStreamController(exportCtx, downCtx context.Context, downDc doneCancel, running int) {
 	defer e.cancel()
 	defer e.wg.Done()
 
-	running := e.numStreams
+	var scaleCh <-chan time.Time
+	if e.scaler != nil {
+		ticker := time.NewTicker(e.adaptive.Interval)
+		defer ticker.Stop()
+		scaleCh = ticker.C
+	}
 
 	for {
 		select {
+		case <-scaleCh:
+			running += e.scale(downCtx)
+
 		case stream := <-e.returning:
+			if e.scaler != nil && e.scaler.retired(stream.workState, exportCtx.Done()) {
+				// The stream was retired by adaptive
+				// stream concurrency.
+				running--
+				continue
+			}
 			if stream.client != nil || e.disableDowngrade {
 				// The stream closed or broken.  Restart it.
 				e.startArrowStream(downCtx, stream.workState)
@@ -234,6 +278,9 @@ func (e *Exporter) runStreamController(exportCtx, downCtx context.Context, downD
 			// Otherwise, the stream never got started.  It was
 			// downgraded and senders will use the standard OTLP path.
 			running--
+			if e.scaler != nil {
+				e.scaler.stopped(stream.workState)
+			}
 
 			// None of the streams were able to connect to
 			// an Arrow endpoint.
@@ -302,6 +349,11 @@ func (e *Exporter) SendAndWait(ctx context.Context, data any) (bool, error) {
 	default:
 	}
 
+	if e.scaler != nil {
+		e.scaler.enter()
+		defer e.scaler.exit()
+	}
+
 	errCh := make(chan error, 1)
 
 	// Note that if the OTLP exporter's gRPC Headers field was
@@ -157,7 +157,7 @@ func newExporterTestCaseCommon(t zaptest.TestingT, pname PrioritizerName, noisy
 		})
 	}
 
-	exp := NewExporter(maxLifetime, numStreams, pname, disableDowngrade, ctc.telset, nil, mockArrowProducer(ctc), ctc.traceClient, ctc.perRPCCredentials, netstats.Noop{})
+	exp := NewExporter(maxLifetime, numStreams, pname, disableDowngrade, ctc.telset, nil, mockArrowProducer(ctc), ctc.traceClient, ctc.perRPCCredentials, netstats.Noop{}, AdaptiveStreamsConfig{})
 
 	return &exporterTestCase{
 		commonTestCase: ctc,
@@ -42,6 +42,9 @@ type streamPrioritizer interface {
 	// and may block indefinitely.  this allows the prioritizer to
 	// drain its channel(s) until the exporter shuts down.
 	downgrade(context.Context)
+
+	// setActive limits new work to the first n streams.
+	setActive(n int)
 }
 
 // streamWriter is the caller's interface to a stream.
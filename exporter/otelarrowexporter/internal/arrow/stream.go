@@ -80,6 +80,36 @@ type streamWorkState struct {
 
 	// waiters is the response channel for each active batch.
 	waiters map[int64]chan<- error
+
+	// sent is the send time of each active batch, used to
+	// measure the latency of its response.
+	sent map[int64]time.Time
+
+	// latencySum and latencyCount accumulate the latency of the
+	// responses, for adaptive stream concurrency.
+	latencySum   time.Duration
+	latencyCount int64
+
+	// retire is closed to ask the stream to stop taking work and
+	// to shut down gracefully.  It is replaced for each stream.
+	retire chan struct{}
+}
+
+// retireStream asks the current stream to finish its outstanding work
+// and to stop.
+func (sws *streamWorkState) retireStream() {
+	close(sws.retire)
+}
+
+// takeLatency returns the accumulated response latency and count, and
+// resets them.
+func (sws *streamWorkState) takeLatency() (time.Duration, int64) {
+	sws.lock.Lock()
+	defer sws.lock.Unlock()
+
+	sum, count := sws.latencySum, sws.latencyCount
+	sws.latencySum, sws.latencyCount = 0, 0
+	return sum, count
 }
 
 // writeItem is passed from the sender (a pipeline consumer) to the
@@ -126,6 +156,9 @@ func (s *Stream) setBatchChannel(batchID int64, errCh chan<- error) {
 	defer s.workState.lock.Unlock()
 
 	s.workState.waiters[batchID] = errCh
+	if s.workState.sent != nil {
+		s.workState.sent[batchID] = time.Now()
+	}
 }
 
 // logStreamError decides how to log an error.  `where` indicates the
@@ -238,6 +271,9 @@ func (s *Stream) run(ctx context.Context, dc doneCancel, streamClient StreamClie
 	}
 
 	s.workState.waiters = map[int64]chan<- error{}
+	if s.workState.sent != nil {
+		s.workState.sent = map[int64]time.Time{}
+	}
 }
 
 // write repeatedly places this stream into the next-available queue, then
@@ -266,6 +302,10 @@ func (s *Stream) write(ctx context.Context) (retErr error) {
 		case <-timerCh:
 			return nil
 		case wri = <-s.workState.toWrite:
+		case <-s.workState.retire:
+			// Adaptive stream concurrency removed this
+			// stream, close it like at the end of its lifetime.
+			return nil
 		case <-ctx.Done():
 			return status.Errorf(codes.Canceled, "stream input: %v", ctx.Err())
 		}
@@ -394,6 +434,11 @@ func (sws *streamWorkState) getSenderChannel(bstat *arrowpb.BatchStatus) (chan<-
 	}
 
 	delete(sws.waiters, bstat.BatchId)
+	if sent, ok := sws.sent[bstat.BatchId]; ok {
+		sws.latencySum += time.Since(sent)
+		sws.latencyCount++
+		delete(sws.sent, bstat.BatchId)
+	}
 	return ch, nil
 }
 
@@ -0,0 +1,74 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Meter(settings component.TelemetrySettings) metric.Meter {
+	return settings.MeterProvider.Meter("github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter")
+}
+
+func Tracer(settings component.TelemetrySettings) trace.Tracer {
+	return settings.TracerProvider.Tracer("github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter")
+}
+
+// TelemetryBuilder provides an interface for components to report telemetry
+// as defined in metadata and user config.
+type TelemetryBuilder struct {
+	meter                                   metric.Meter
+	mu                                      sync.Mutex
+	registrations                           []metric.Registration
+	OtelarrowExporterStreamScalingDecisions metric.Int64Counter
+	OtelarrowExporterStreams                metric.Int64UpDownCounter
+}
+
+// TelemetryBuilderOption applies changes to default builder.
+type TelemetryBuilderOption interface {
+	apply(*TelemetryBuilder)
+}
+
+type telemetryBuilderOptionFunc func(mb *TelemetryBuilder)
+
+func (tbof telemetryBuilderOptionFunc) apply(mb *TelemetryBuilder) {
+	tbof(mb)
+}
+
+// Shutdown unregister all registered callbacks for async instruments.
+func (builder *TelemetryBuilder) Shutdown() {
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	for _, reg := range builder.registrations {
+		reg.Unregister()
+	}
+}
+
+// NewTelemetryBuilder provides a struct with methods to update all internal telemetry
+// for a component
+func NewTelemetryBuilder(settings component.TelemetrySettings, options ...TelemetryBuilderOption) (*TelemetryBuilder, error) {
+	builder := TelemetryBuilder{}
+	for _, op := range options {
+		op.apply(&builder)
+	}
+	builder.meter = Meter(settings)
+	var err, errs error
+	builder.OtelarrowExporterStreamScalingDecisions, err = builder.meter.Int64Counter(
+		"otelcol_otelarrow_exporter_stream_scaling_decisions",
+		metric.WithDescription("Number of adaptive stream concurrency decisions. [Development]"),
+		metric.WithUnit("{decisions}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.OtelarrowExporterStreams, err = builder.meter.Int64UpDownCounter(
+		"otelcol_otelarrow_exporter_streams",
+		metric.WithDescription("Number of active OTel-Arrow streams, when adaptive stream concurrency is enabled. [Development]"),
+		metric.WithUnit("{streams}"),
+	)
+	errs = errors.Join(errs, err)
+	return &builder, errs
+}
@@ -164,7 +164,7 @@ func (e *baseExporter) start(ctx context.Context, host component.Host) (err erro
 
 		e.arrow = arrow.NewExporter(e.config.Arrow.MaxStreamLifetime, e.config.Arrow.NumStreams, e.config.Arrow.Prioritizer, e.config.Arrow.DisableDowngrade, e.settings.TelemetrySettings, arrowCallOpts, func() arrowRecord.ProducerAPI {
 			return arrowRecord.NewProducerWithOptions(arrowOpts...)
-		}, e.streamClientFactory(e.clientConn), perRPCCreds, e.netReporter)
+		}, e.streamClientFactory(e.clientConn), perRPCCreds, e.netReporter, e.config.Arrow.AdaptiveStreams)
 
 		if err := e.arrow.Start(ctx); err != nil {
 			return err
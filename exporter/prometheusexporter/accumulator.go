@@ -62,6 +62,8 @@ type accumulator interface {
 	// Collect returns a slice with relevant aggregated metrics and their resource attributes.
 	// The number or metrics and attributes returned will be the same.
 	Collect() (metrics []pmetric.Metric, resourceAttrs []pcommon.Map, scopeNames, scopeVersions, scopeSchemaURLs []string, scopeAttributes []pcommon.Map)
+	// Reset deletes all the accumulated metrics.
+	Reset()
 }
 
 // LastValueAccumulator keeps last value for accumulated metrics
@@ -73,13 +75,16 @@ type lastValueAccumulator struct {
 	// metricExpiration contains duration for which metric
 	// should be served after it was updated
 	metricExpiration time.Duration
+	// metricExpirationOverrides replaces metricExpiration for the metrics of the given names
+	metricExpirationOverrides map[string]time.Duration
 }
 
 // NewAccumulator returns LastValueAccumulator
-func newAccumulator(logger *zap.Logger, metricExpiration time.Duration) accumulator {
+func newAccumulator(logger *zap.Logger, metricExpiration time.Duration, metricExpirationOverrides map[string]time.Duration) accumulator {
 	return &lastValueAccumulator{
-		logger:           logger,
-		metricExpiration: metricExpiration,
+		logger:                    logger,
+		metricExpiration:          metricExpiration,
+		metricExpirationOverrides: metricExpirationOverrides,
 	}
 }
 
@@ -403,11 +408,12 @@ func (a *lastValueAccumulator) Collect() ([]pmetric.Metric, []pcommon.Map, []str
 	var scopeVersions []string
 	var scopeSchemaURLs []string
 	var scopeAttributes []pcommon.Map
-	expirationTime := time.Now().Add(-a.metricExpiration)
+	now := time.Now()
 
 	a.registeredMetrics.Range(func(key, value any) bool {
 		v := value.(*accumulatedValue)
-		if expirationTime.After(v.updated) {
+		expiration := metricExpiration(v.value.Name(), a.metricExpiration, a.metricExpirationOverrides)
+		if now.Add(-expiration).After(v.updated) {
 			a.logger.Debug(fmt.Sprintf("metric expired: %s", v.value.Name()))
 			a.registeredMetrics.Delete(key)
 			return true
@@ -425,6 +431,11 @@ func (a *lastValueAccumulator) Collect() ([]pmetric.Metric, []pcommon.Map, []str
 	return metrics, resourceAttrs, scopeNames, scopeVersions, scopeSchemaURLs, scopeAttributes
 }
 
+// Reset deletes all the accumulated metrics.
+func (a *lastValueAccumulator) Reset() {
+	a.registeredMetrics.Clear()
+}
+
 func timeseriesSignature(scopeName, scopeVersion, scopeSchemaURL string, scopeAttributes pcommon.Map, metric pmetric.Metric, attributes, resourceAttrs pcommon.Map) string {
 	// Get a string builder from the pool
 	sb := stringBuilderPool.Get().(*strings.Builder)
@@ -99,7 +99,7 @@ func BenchmarkAccumulatorConcurrent(b *testing.B) {
 			oldMaxProcs := runtime.GOMAXPROCS(concurrency)
 			defer runtime.GOMAXPROCS(oldMaxProcs)
 
-			accumulator := newAccumulator(zap.NewNop(), 5*time.Minute)
+			accumulator := newAccumulator(zap.NewNop(), 5*time.Minute, nil)
 
 			// Create test metrics (11,697 metrics as in issue #36574)
 			resourceMetrics := createTestResourceMetrics(11697, 5)
@@ -129,7 +129,7 @@ func BenchmarkAccumulatorConcurrent(b *testing.B) {
 // BenchmarkAccumulateScrape simulates a complete scrape cycle as it would happen in production.
 // This includes creating the ResourceMetrics, accumulating, and collecting.
 func BenchmarkAccumulateScrape(b *testing.B) {
-	accumulator := newAccumulator(zap.NewNop(), 5*time.Minute)
+	accumulator := newAccumulator(zap.NewNop(), 5*time.Minute, nil)
 
 	b.Run("SmallScrape", func(b *testing.B) {
 		// Small scrape: 100 metrics
@@ -171,7 +171,7 @@ func BenchmarkAccumulateScrape(b *testing.B) {
 // BenchmarkAccumulateAndCollect benchmarks the full cycle of accumulating and collecting metrics.
 // This measures the memory retention behavior.
 func BenchmarkAccumulateAndCollect(b *testing.B) {
-	accumulator := newAccumulator(zap.NewNop(), 5*time.Minute)
+	accumulator := newAccumulator(zap.NewNop(), 5*time.Minute, nil)
 	resourceMetrics := createTestResourceMetrics(11697, 5)
 
 	b.ReportAllocs()
@@ -187,7 +187,7 @@ func BenchmarkAccumulateAndCollect(b *testing.B) {
 // BenchmarkMemoryGrowth tests memory growth over repeated scrapes.
 // This is a stress test to reproduce the 5MB-per-scrape leak.
 func BenchmarkMemoryGrowth(b *testing.B) {
-	accumulator := newAccumulator(zap.NewNop(), 5*time.Minute)
+	accumulator := newAccumulator(zap.NewNop(), 5*time.Minute, nil)
 	resourceMetrics := createTestResourceMetrics(11697, 7)
 
 	// Force GC before starting
@@ -231,7 +231,7 @@ func TestAccumulateMetrics(t *testing.T) {
 
 			tt.metric(ts1, 13, ilm2.Metrics())
 
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 
 			// 2 metric arrived
 			n := a.Accumulate(resourceMetrics2)
@@ -335,7 +335,7 @@ func TestAccumulateDeltaToCumulative(t *testing.T) {
 			resourceMetrics := pmetric.NewResourceMetrics()
 			ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
 			ilm.Scope().SetName("test")
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 
 			dataPointValue1 := float64(11)
 			dataPointValue2 := float64(32)
@@ -410,7 +410,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		m2 := ilm.Metrics().At(1).Histogram().DataPoints().At(0)
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Scope().Version(), ilm.SchemaUrl(), ilm.Scope().Attributes(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 2, n)
 
@@ -444,7 +444,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Scope().Version(), ilm.SchemaUrl(), ilm.Scope().Attributes(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
 		// should ignore metric with different buckets from the past
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 1, n)
 
@@ -478,7 +478,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Scope().Version(), ilm.SchemaUrl(), ilm.Scope().Attributes(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
 		// should ignore metric with different buckets from the past
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 2, n)
 
@@ -512,7 +512,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		m1 := ilm.Metrics().At(0).Histogram().DataPoints().At(0)
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Scope().Version(), ilm.SchemaUrl(), ilm.Scope().Attributes(), ilm.Metrics().At(0), m1.Attributes(), pcommon.NewMap())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 1, n)
 
@@ -546,7 +546,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		m2 := ilm.Metrics().At(1).Histogram().DataPoints().At(0)
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Scope().Version(), ilm.SchemaUrl(), ilm.Scope().Attributes(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 2, n)
 
@@ -631,7 +631,7 @@ func TestAccumulateDroppedMetrics(t *testing.T) {
 			ilm.Scope().SetName("test")
 			tt.fillMetric(time.Now(), ilm.Metrics().AppendEmpty())
 
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 			n := a.Accumulate(resourceMetrics)
 			require.Equal(t, 0, n)
 
@@ -643,6 +643,42 @@ func TestAccumulateDroppedMetrics(t *testing.T) {
 	}
 }
 
+func TestCollectMetricExpirationOverrides(t *testing.T) {
+	resourceMetrics := pmetric.NewResourceMetrics()
+	ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
+	ilm.Scope().SetName("test")
+	for _, name := range []string{"short_lived", "long_lived"} {
+		metric := ilm.Metrics().AppendEmpty()
+		metric.SetName(name)
+		dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetIntValue(42)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	}
+
+	a := newAccumulator(zap.NewNop(), 1*time.Hour, map[string]time.Duration{"short_lived": time.Millisecond})
+	require.Equal(t, 2, a.Accumulate(resourceMetrics))
+	time.Sleep(2 * time.Millisecond)
+
+	metrics, _, _, _, _, _ := a.Collect()
+	require.Len(t, metrics, 1)
+	require.Equal(t, "long_lived", metrics[0].Name())
+}
+
+func TestAccumulatorReset(t *testing.T) {
+	resourceMetrics := pmetric.NewResourceMetrics()
+	ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
+	metric := ilm.Metrics().AppendEmpty()
+	metric.SetName("test_metric")
+	metric.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(42)
+
+	a := newAccumulator(zap.NewNop(), 1*time.Hour, nil)
+	require.Equal(t, 1, a.Accumulate(resourceMetrics))
+	a.Reset()
+
+	metrics, _, _, _, _, _ := a.Collect()
+	require.Empty(t, metrics)
+}
+
 func TestAccumulateDeltaToCumulativeExponentialHistogram(t *testing.T) {
 	appendDeltaNative := func(startTs, ts time.Time, scale, posOff int32, pos []uint64, negOff int32, neg []uint64,
 		zeroCount, count uint64, sum float64, minSet bool, minim float64, maxSet bool, maxim float64, metrics pmetric.MetricSlice,
@@ -689,7 +725,7 @@ func TestAccumulateDeltaToCumulativeExponentialHistogram(t *testing.T) {
 		// Second delta: scale=1, positive offset -1 counts [4,5]; negative offset 0 counts [1,1]
 		m2 := appendDeltaNative(ts1, ts2, 1, -1, []uint64{4, 5}, 0, []uint64{1, 1}, 2, 7, 4.5, true, 0.5, false, 0, ilm.Metrics())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(rm)
 		require.Equal(t, 2, n)
 
@@ -758,7 +794,7 @@ func TestAccumulateDeltaToCumulativeExponentialHistogram(t *testing.T) {
 		dp2.SetTimestamp(pcommon.NewTimestampFromTime(now))
 		dp2.Attributes().PutStr("label_1", "1")
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(rm)
 		require.Equal(t, 2, n)
 
@@ -793,7 +829,7 @@ func TestAccumulateDeltaToCumulativeExponentialHistogram(t *testing.T) {
 		_ = m2
 		_ = m3
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(rm)
 		// First stored, second dropped, third reset and stored: total 2
 		require.Equal(t, 2, n)
@@ -855,7 +891,7 @@ func TestAccumulateExponentialHistogramZeroThresholds(t *testing.T) {
 		// Only buckets [2,4) and [4,8) should remain
 		m2 := appendDeltaNativeWithZeroThreshold(ts1, ts2, 0, 0, []uint64{1, 2, 4}, 0, nil, 2, 9, 20.0, 3.0, ilm.Metrics())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(rm)
 		require.Equal(t, 2, n)
 
@@ -901,7 +937,7 @@ func TestAccumulateExponentialHistogramZeroThresholds(t *testing.T) {
 		appendDeltaNativeWithZeroThreshold(startTs, ts1, 0, 0, []uint64{2, 3}, 0, nil, 1, 6, 10.0, 1.0, ilm.Metrics())
 		m2 := appendDeltaNativeWithZeroThreshold(ts1, ts2, 0, 0, []uint64{1, 2}, 0, nil, 2, 5, 8.0, 1.0, ilm.Metrics())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(rm)
 		require.Equal(t, 2, n)
 
@@ -947,7 +983,7 @@ func TestAccumulateExponentialHistogramZeroThresholds(t *testing.T) {
 		// Buckets: [4,8), [8,16) with counts [1, 1]
 		m2 := appendDeltaNativeWithZeroThreshold(ts1, ts2, 0, 2, []uint64{1, 1}, 0, nil, 5, 7, 12.0, 10.0, ilm.Metrics())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(rm)
 		require.Equal(t, 2, n)
 
@@ -992,7 +1028,7 @@ func TestAccumulateExponentialHistogramZeroThresholds(t *testing.T) {
 		// Second histogram: has buckets, higher zero threshold
 		m2 := appendDeltaNativeWithZeroThreshold(ts1, ts2, 0, 1, []uint64{2, 3}, 0, nil, 3, 8, 15.0, 2.0, ilm.Metrics())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(rm)
 		require.Equal(t, 2, n)
 
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"sync"
 	"time"
@@ -39,28 +40,37 @@ type collector struct {
 	metricExpiration time.Duration
 	withoutScopeInfo bool
 
+	metricExpirationOverrides map[string]time.Duration
+
 	metricNamer otlptranslator.MetricNamer
 	labelNamer  otlptranslator.LabelNamer
+
+	// filter selects the served metrics, and resourceLabels lists the resource attributes
+	// added as labels. They are only set for the collectors of additional scrape paths.
+	filter         *metricFilter
+	resourceLabels []resourceLabel
 }
 
 type metricFamily struct {
-	lastSeen time.Time
-	mf       *dto.MetricFamily
+	lastSeen   time.Time
+	expiration time.Duration
+	mf         *dto.MetricFamily
 }
 
 func newCollector(config *Config, logger *zap.Logger) *collector {
 	labelNamer := configureLabelNamer(config)
 
 	return &collector{
-		accumulator:      newAccumulator(logger, config.MetricExpiration),
-		logger:           logger,
-		namespace:        normalizeNamespace(config.Namespace, labelNamer, logger),
-		sendTimestamps:   config.SendTimestamps,
-		constLabels:      config.ConstLabels,
-		metricExpiration: config.MetricExpiration,
-		withoutScopeInfo: config.WithoutScopeInfo,
-		metricNamer:      configureMetricNamer(config),
-		labelNamer:       labelNamer,
+		accumulator:               newAccumulator(logger, config.MetricExpiration, config.MetricExpirationOverrides),
+		logger:                    logger,
+		namespace:                 normalizeNamespace(config.Namespace, labelNamer, logger),
+		sendTimestamps:            config.SendTimestamps,
+		constLabels:               config.ConstLabels,
+		metricExpiration:          config.MetricExpiration,
+		metricExpirationOverrides: config.MetricExpirationOverrides,
+		withoutScopeInfo:          config.WithoutScopeInfo,
+		metricNamer:               configureMetricNamer(config),
+		labelNamer:                labelNamer,
 	}
 }
 
@@ -285,7 +295,7 @@ func (c *collector) getMetricMetadata(metric pmetric.Metric, mType *dto.MetricTy
 	if err != nil {
 		return nil, nil, err
 	}
-	help, err := c.validateMetrics(name, metric.Description(), mType)
+	help, err := c.validateMetrics(name, metric.Description(), mType, metricExpiration(metric.Name(), c.metricExpiration, c.metricExpirationOverrides))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -331,6 +341,14 @@ func (c *collector) getMetricMetadata(metric pmetric.Metric, mType *dto.MetricTy
 		keys = append(keys, model.InstanceLabel)
 		values = append(values, instance)
 	}
+	for _, rl := range c.resourceLabels {
+		v, ok := resourceAttrs.Get(rl.key)
+		if !ok || slices.Contains(keys, rl.label) {
+			continue
+		}
+		keys = append(keys, rl.label)
+		values = append(values, v.AsString())
+	}
 	if multiErrs != nil {
 		return nil, nil, multiErrs
 	}
@@ -604,6 +622,9 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 	c.logger.Debug("collect called")
 
 	inMetrics, resourceAttrs, scopeNames, scopeVersions, scopeSchemaURLs, scopeAttributes := c.accumulator.Collect()
+	if c.filter != nil {
+		inMetrics, resourceAttrs, scopeNames, scopeVersions, scopeSchemaURLs, scopeAttributes = c.filterMetrics(inMetrics, resourceAttrs, scopeNames, scopeVersions, scopeSchemaURLs, scopeAttributes)
+	}
 
 	targetMetrics, err := c.createTargetInfoMetrics(resourceAttrs)
 	if err != nil {
@@ -630,12 +651,32 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 	c.cleanupMetricFamilies()
 }
 
-func (c *collector) validateMetrics(name, description string, metricType *dto.MetricType) (help string, err error) {
+// filterMetrics keeps the collected metrics matching the filter of the collector.
+// Target info metrics are only created for the resources of the kept metrics.
+func (c *collector) filterMetrics(metrics []pmetric.Metric, resourceAttrs []pcommon.Map, scopeNames, scopeVersions, scopeSchemaURLs []string, scopeAttributes []pcommon.Map) ([]pmetric.Metric, []pcommon.Map, []string, []string, []string, []pcommon.Map) {
+	n := 0
+	for i := range metrics {
+		if !c.filter.matches(metrics[i], resourceAttrs[i], scopeNames[i], scopeVersions[i], scopeSchemaURLs[i], scopeAttributes[i]) {
+			continue
+		}
+		metrics[n] = metrics[i]
+		resourceAttrs[n] = resourceAttrs[i]
+		scopeNames[n] = scopeNames[i]
+		scopeVersions[n] = scopeVersions[i]
+		scopeSchemaURLs[n] = scopeSchemaURLs[i]
+		scopeAttributes[n] = scopeAttributes[i]
+		n++
+	}
+	return metrics[:n], resourceAttrs[:n], scopeNames[:n], scopeVersions[:n], scopeSchemaURLs[:n], scopeAttributes[:n]
+}
+
+func (c *collector) validateMetrics(name, description string, metricType *dto.MetricType, expiration time.Duration) (help string, err error) {
 	now := time.Now()
 	v, exist := c.metricFamilies.Load(name)
 	if !exist {
 		c.metricFamilies.Store(name, metricFamily{
-			lastSeen: now,
+			lastSeen:   now,
+			expiration: expiration,
 			mf: &dto.MetricFamily{
 				Name: proto.String(name),
 				Help: proto.String(description),
@@ -649,6 +690,7 @@ func (c *collector) validateMetrics(name, description string, metricType *dto.Me
 		return "", fmt.Errorf("instrument type conflict, using existing type definition. instrument: %s, existing: %s, dropped: %s", name, emf.mf.GetType(), *metricType)
 	}
 	emf.lastSeen = now
+	emf.expiration = expiration
 	c.metricFamilies.Store(name, emf)
 	if emf.mf.GetHelp() != description {
 		c.logger.Info(
@@ -662,11 +704,11 @@ func (c *collector) validateMetrics(name, description string, metricType *dto.Me
 }
 
 func (c *collector) cleanupMetricFamilies() {
-	expirationTime := time.Now().Add(-c.metricExpiration)
+	now := time.Now()
 
 	c.metricFamilies.Range(func(key, value any) bool {
 		v := value.(metricFamily)
-		if expirationTime.After(v.lastSeen) {
+		if now.Add(-v.expiration).After(v.lastSeen) {
 			c.logger.Debug("metric expired", zap.String("instrument", key.(string)))
 			c.metricFamilies.Delete(key)
 			return true
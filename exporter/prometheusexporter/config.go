@@ -36,6 +36,14 @@ type Config struct {
 	// MetricExpiration defines how long metrics are kept without updates
 	MetricExpiration time.Duration `mapstructure:"metric_expiration"`
 
+	// MetricExpirationOverrides defines how long the series of the metrics are kept without updates, by metric
+	// name, instead of MetricExpiration.
+	MetricExpirationOverrides map[string]time.Duration `mapstructure:"metric_expiration_overrides"`
+
+	// DeleteOnShutdown deletes all the series when the exporter is shut down, so that they are not served
+	// anymore by the scrapes still handled while the server shuts down.
+	DeleteOnShutdown bool `mapstructure:"delete_on_shutdown"`
+
 	// ResourceToTelemetrySettings defines configuration for converting resource attributes to metric labels.
 	ResourceToTelemetrySettings resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
 
@@ -53,6 +61,10 @@ type Config struct {
 	// TranslationStrategy controls how OTLP metric and attribute names are translated into Prometheus metric and label names.
 	// When set, this takes precedence over AddMetricSuffixes.
 	TranslationStrategy translationStrategy `mapstructure:"translation_strategy"`
+
+	// ScrapePaths defines additional HTTP paths, each serving the subset of the metrics selected by its filters.
+	// The /metrics path keeps serving all the metrics.
+	ScrapePaths []ScrapePathConfig `mapstructure:"scrape_paths"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -67,7 +79,12 @@ func (cfg *Config) Validate() error {
 			return fmt.Errorf("invalid translation_strategy: %s", cfg.TranslationStrategy)
 		}
 	}
-	return nil
+	for name, expiration := range cfg.MetricExpirationOverrides {
+		if expiration <= 0 {
+			return fmt.Errorf("metric_expiration_overrides: expiration of metric %q must be positive", name)
+		}
+	}
+	return validateScrapePaths(cfg.ScrapePaths)
 }
 
 type translationStrategy string
@@ -56,11 +56,42 @@ func TestLoadConfig(t *testing.T) {
 					"label1":        "value1",
 					"another label": "spaced value",
 				},
-				SendTimestamps:    true,
-				MetricExpiration:  60 * time.Minute,
+				SendTimestamps:   true,
+				MetricExpiration: 60 * time.Minute,
+				MetricExpirationOverrides: map[string]time.Duration{
+					"k8s.job.duration": 10 * time.Minute,
+				},
+				DeleteOnShutdown:  true,
 				AddMetricSuffixes: false,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "scrape_paths"),
+			expected: &Config{
+				ServerConfig: confighttp.ServerConfig{
+					NetAddr: confignet.AddrConfig{
+						Transport: "tcp",
+						Endpoint:  "1.2.3.4:1234",
+					},
+				},
+				ConstLabels:       map[string]string{},
+				MetricExpiration:  5 * time.Minute,
+				AddMetricSuffixes: true,
+				ScrapePaths: []ScrapePathConfig{
+					{
+						Path:        "/federate/team-a",
+						MetricNames: []string{`^team_a\..*`},
+						ResourceAttributes: []ResourceAttributeLabel{
+							{Key: "k8s.namespace.name", Label: "namespace"},
+						},
+					},
+					{
+						Path:       "/federate/payments",
+						Conditions: []string{`resource.attributes["service.namespace"] == "payments"`},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -77,3 +108,51 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMetricExpirationOverrides(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricExpirationOverrides = map[string]time.Duration{"k8s.job.duration": 0}
+	assert.EqualError(t, cfg.Validate(), `metric_expiration_overrides: expiration of metric "k8s.job.duration" must be positive`)
+}
+
+func TestValidateScrapePaths(t *testing.T) {
+	tests := []struct {
+		name        string
+		scrapePaths []ScrapePathConfig
+		expectedErr string
+	}{
+		{
+			name:        "missing leading slash",
+			scrapePaths: []ScrapePathConfig{{Path: "federate"}},
+			expectedErr: `path must start with '/': "federate"`,
+		},
+		{
+			name:        "default path",
+			scrapePaths: []ScrapePathConfig{{Path: "/metrics"}},
+			expectedErr: `duplicate scrape path "/metrics"`,
+		},
+		{
+			name:        "duplicate path",
+			scrapePaths: []ScrapePathConfig{{Path: "/federate"}, {Path: "/federate"}},
+			expectedErr: `duplicate scrape path "/federate"`,
+		},
+		{
+			name:        "invalid metric name expression",
+			scrapePaths: []ScrapePathConfig{{Path: "/federate", MetricNames: []string{"("}}},
+			expectedErr: `invalid metric_names expression "("`,
+		},
+		{
+			name:        "empty resource attribute key",
+			scrapePaths: []ScrapePathConfig{{Path: "/federate", ResourceAttributes: []ResourceAttributeLabel{{Label: "namespace"}}}},
+			expectedErr: "resource_attributes key must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.ScrapePaths = tt.scrapePaths
+			assert.ErrorContains(t, xconfmap.Validate(cfg), tt.expectedErr)
+		})
+	}
+}
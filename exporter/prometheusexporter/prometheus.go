@@ -23,6 +23,8 @@ type prometheusExporter struct {
 	shutdownFunc func(ctx context.Context) error
 	handler      http.Handler
 	collector    *collector
+	// pathHandlers serves the additional scrape paths, by path.
+	pathHandlers map[string]http.Handler
 	registry     *prometheus.Registry
 	settings     component.TelemetrySettings
 }
@@ -44,6 +46,18 @@ func newPrometheusExporter(config *Config, set exporter.Settings) (*prometheusEx
 	collector := newCollector(config, set.Logger)
 	registry := prometheus.NewRegistry()
 	_ = registry.Register(collector)
+
+	pathHandlers := make(map[string]http.Handler, len(config.ScrapePaths))
+	for _, path := range config.ScrapePaths {
+		pathCollector, err := newScrapePathCollector(config, path, collector.accumulator, set.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+		pathRegistry := prometheus.NewRegistry()
+		_ = pathRegistry.Register(pathCollector)
+		pathHandlers[path.Path] = newHandler(pathRegistry, config, set)
+	}
+
 	return &prometheusExporter{
 		config:       *config,
 		name:         set.ID.String(),
@@ -51,18 +65,23 @@ func newPrometheusExporter(config *Config, set exporter.Settings) (*prometheusEx
 		collector:    collector,
 		registry:     registry,
 		shutdownFunc: func(_ context.Context) error { return nil },
-		handler: promhttp.HandlerFor(
-			registry,
-			promhttp.HandlerOpts{
-				ErrorHandling:     promhttp.ContinueOnError,
-				ErrorLog:          newPromLogger(set.Logger),
-				EnableOpenMetrics: config.EnableOpenMetrics,
-			},
-		),
-		settings: set.TelemetrySettings,
+		handler:      newHandler(registry, config, set),
+		pathHandlers: pathHandlers,
+		settings:     set.TelemetrySettings,
 	}, nil
 }
 
+func newHandler(registry *prometheus.Registry, config *Config, set exporter.Settings) http.Handler {
+	return promhttp.HandlerFor(
+		registry,
+		promhttp.HandlerOpts{
+			ErrorHandling:     promhttp.ContinueOnError,
+			ErrorLog:          newPromLogger(set.Logger),
+			EnableOpenMetrics: config.EnableOpenMetrics,
+		},
+	)
+}
+
 func (pe *prometheusExporter) Start(ctx context.Context, host component.Host) error {
 	ln, err := pe.config.ToListener(ctx)
 	if err != nil {
@@ -70,7 +89,10 @@ func (pe *prometheusExporter) Start(ctx context.Context, host component.Host) er
 	}
 
 	mux := http.NewServeMux()
-	mux.Handle("/metrics", pe.handler)
+	mux.Handle(defaultMetricsPath, pe.handler)
+	for path, handler := range pe.pathHandlers {
+		mux.Handle(path, handler)
+	}
 	srv, err := pe.config.ToServer(ctx, host.GetExtensions(), pe.settings, mux)
 	if err != nil {
 		lnerr := ln.Close()
@@ -97,5 +119,9 @@ func (pe *prometheusExporter) ConsumeMetrics(_ context.Context, md pmetric.Metri
 }
 
 func (pe *prometheusExporter) Shutdown(ctx context.Context) error {
+	if pe.config.DeleteOnShutdown {
+		// The accumulator is shared by the collectors of the additional scrape paths
+		pe.collector.accumulator.Reset()
+	}
 	return pe.shutdownFunc(ctx)
 }
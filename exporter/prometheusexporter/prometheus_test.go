@@ -720,3 +720,25 @@ this_one_there_where_{arch="x86",instance="test-instance",job="test-service",os=
 		})
 	}
 }
+
+func TestPrometheusExporter_DeleteOnShutdown(t *testing.T) {
+	for _, deleteOnShutdown := range []bool{false, true} {
+		t.Run(fmt.Sprintf("delete_on_shutdown=%v", deleteOnShutdown), func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.NetAddr.Endpoint = testutil.GetAvailableLocalAddress(t)
+			cfg.DeleteOnShutdown = deleteOnShutdown
+			exp, err := newPrometheusExporter(cfg, exportertest.NewNopSettings(metadata.Type))
+			require.NoError(t, err)
+
+			require.NoError(t, exp.ConsumeMetrics(t.Context(), testdata.GenerateMetricsOneMetric()))
+			require.NoError(t, exp.Shutdown(t.Context()))
+
+			metrics, _, _, _, _, _ := exp.collector.accumulator.Collect()
+			if deleteOnShutdown {
+				assert.Empty(t, metrics)
+			} else {
+				assert.NotEmpty(t, metrics)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+)
+
+const defaultMetricsPath = "/metrics"
+
+// ScrapePathConfig defines an additional HTTP path serving a subset of the exported metrics.
+type ScrapePathConfig struct {
+	// Path is the HTTP path of the endpoint, for example "/federate/team-a".
+	Path string `mapstructure:"path"`
+
+	// MetricNames is a list of regular expressions matched against the OTLP metric names.
+	// A metric is served if its name matches any of the expressions.
+	MetricNames []string `mapstructure:"metric_names"`
+
+	// Conditions is a list of OTTL conditions evaluated in the metric context.
+	// A metric is served if any of the conditions is true.
+	Conditions []string `mapstructure:"conditions"`
+
+	// ResourceAttributes lists the resource attributes added as labels to the metrics served by the path.
+	ResourceAttributes []ResourceAttributeLabel `mapstructure:"resource_attributes"`
+}
+
+// ResourceAttributeLabel promotes a resource attribute to a metric label.
+type ResourceAttributeLabel struct {
+	// Key is the resource attribute key.
+	Key string `mapstructure:"key"`
+
+	// Label is the name of the label. It defaults to the translated attribute key.
+	Label string `mapstructure:"label"`
+}
+
+// Validate checks if the scrape path configuration is valid.
+func (cfg *ScrapePathConfig) Validate() error {
+	if !strings.HasPrefix(cfg.Path, "/") {
+		return fmt.Errorf("path must start with '/': %q", cfg.Path)
+	}
+	for _, expr := range cfg.MetricNames {
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("invalid metric_names expression %q: %w", expr, err)
+		}
+	}
+	for _, attr := range cfg.ResourceAttributes {
+		if attr.Key == "" {
+			return errors.New("resource_attributes key must not be empty")
+		}
+	}
+	return nil
+}
+
+func validateScrapePaths(paths []ScrapePathConfig) error {
+	seen := map[string]struct{}{defaultMetricsPath: {}}
+	for _, p := range paths {
+		if _, ok := seen[p.Path]; ok {
+			return fmt.Errorf("duplicate scrape path %q", p.Path)
+		}
+		seen[p.Path] = struct{}{}
+	}
+	return nil
+}
+
+// metricFilter selects the metrics served by a scrape path.
+type metricFilter struct {
+	names      []*regexp.Regexp
+	conditions *ottl.ConditionSequence[*ottlmetric.TransformContext]
+	logger     *zap.Logger
+}
+
+func newMetricFilter(cfg ScrapePathConfig, set component.TelemetrySettings) (*metricFilter, error) {
+	if len(cfg.MetricNames) == 0 && len(cfg.Conditions) == 0 {
+		return nil, nil
+	}
+	f := &metricFilter{logger: set.Logger}
+	for _, expr := range cfg.MetricNames {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		f.names = append(f.names, re)
+	}
+	if len(cfg.Conditions) > 0 {
+		conditions, err := filterottl.NewBoolExprForMetric(cfg.Conditions, filterottl.StandardMetricFuncs(), ottl.IgnoreError, set)
+		if err != nil {
+			return nil, fmt.Errorf("invalid conditions for scrape path %q: %w", cfg.Path, err)
+		}
+		f.conditions = conditions
+	}
+	return f, nil
+}
+
+// matches returns true if the metric matches both the name expressions and the conditions, when set.
+func (f *metricFilter) matches(metric pmetric.Metric, resourceAttrs pcommon.Map, scopeName, scopeVersion, scopeSchemaURL string, scopeAttributes pcommon.Map) bool {
+	if len(f.names) > 0 && !f.matchesName(metric.Name()) {
+		return false
+	}
+	if f.conditions == nil {
+		return true
+	}
+
+	rm := pmetric.NewResourceMetrics()
+	resourceAttrs.CopyTo(rm.Resource().Attributes())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.SetSchemaUrl(scopeSchemaURL)
+	sm.Scope().SetName(scopeName)
+	sm.Scope().SetVersion(scopeVersion)
+	scopeAttributes.CopyTo(sm.Scope().Attributes())
+
+	tCtx := ottlmetric.NewTransformContextPtr(rm, sm, metric)
+	defer tCtx.Close()
+	match, err := f.conditions.Eval(context.Background(), tCtx)
+	if err != nil {
+		f.logger.Debug("Failed to evaluate the scrape path conditions", zap.String("metric", metric.Name()), zap.Error(err))
+		return false
+	}
+	return match
+}
+
+func (f *metricFilter) matchesName(name string) bool {
+	for _, re := range f.names {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceLabel is a resource attribute promoted to the label with the given name.
+type resourceLabel struct {
+	key   string
+	label string
+}
+
+// newScrapePathCollector returns a collector serving the metrics of the accumulator selected by the scrape path.
+func newScrapePathCollector(config *Config, path ScrapePathConfig, acc accumulator, set component.TelemetrySettings) (*collector, error) {
+	c := newCollector(config, set.Logger)
+	c.accumulator = acc
+
+	filter, err := newMetricFilter(path, set)
+	if err != nil {
+		return nil, err
+	}
+	c.filter = filter
+
+	for _, attr := range path.ResourceAttributes {
+		label := attr.Label
+		if label == "" {
+			if label, err = c.labelNamer.Build(attr.Key); err != nil {
+				return nil, fmt.Errorf("invalid resource attribute %q for scrape path %q: %w", attr.Key, path.Path, err)
+			}
+		}
+		c.resourceLabels = append(c.resourceLabels, resourceLabel{key: attr.Key, label: label})
+	}
+	return c, nil
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusexporter
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func TestPrometheusExporter_ScrapePaths(t *testing.T) {
+	addr := testutil.GetAvailableLocalAddress(t)
+	cfg := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			NetAddr: confignet.AddrConfig{
+				Transport: "tcp",
+				Endpoint:  addr,
+			},
+		},
+		MetricExpiration:  120 * time.Minute,
+		AddMetricSuffixes: false,
+		ScrapePaths: []ScrapePathConfig{
+			{
+				Path:        "/federate/team-a",
+				MetricNames: []string{`^team_a\.`},
+				ResourceAttributes: []ResourceAttributeLabel{
+					{Key: "k8s.namespace.name", Label: "namespace"},
+				},
+			},
+			{
+				Path:       "/federate/payments",
+				Conditions: []string{`resource.attributes["service.namespace"] == "payments"`},
+				ResourceAttributes: []ResourceAttributeLabel{
+					{Key: "service.namespace"},
+				},
+			},
+		},
+	}
+
+	factory := NewFactory()
+	set := exportertest.NewNopSettings(metadata.Type)
+	exp, err := factory.CreateMetrics(t.Context(), set, cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, exp.Shutdown(t.Context()))
+	}()
+
+	require.NoError(t, exp.Start(t.Context(), componenttest.NewNopHost()))
+
+	md := pmetric.NewMetrics()
+	appendGauge(md, "team_a.requests", map[string]string{"service.name": "frontend", "service.instance.id": "fe-1", "k8s.namespace.name": "team-a"})
+	appendGauge(md, "team_b.requests", map[string]string{"service.name": "backend", "service.instance.id": "be-1", "k8s.namespace.name": "team-b"})
+	appendGauge(md, "payments.transactions", map[string]string{"service.name": "checkout", "service.instance.id": "co-1", "service.namespace": "payments"})
+	require.NoError(t, exp.ConsumeMetrics(t.Context(), md))
+
+	all := scrape(t, "http://"+addr+"/metrics")
+	assert.Contains(t, all, "team_a_requests{")
+	assert.Contains(t, all, "team_b_requests{")
+	assert.Contains(t, all, "payments_transactions{")
+	assert.NotContains(t, all, `namespace="team-a"`)
+
+	teamA := scrape(t, "http://"+addr+"/federate/team-a")
+	assert.Contains(t, teamA, `team_a_requests{instance="fe-1",job="frontend",namespace="team-a"`)
+	assert.NotContains(t, teamA, "team_b_requests")
+	assert.NotContains(t, teamA, "payments_transactions")
+	assert.Contains(t, teamA, `target_info{instance="fe-1",job="frontend",k8s_namespace_name="team-a"}`)
+	assert.NotContains(t, teamA, `job="backend"`)
+
+	payments := scrape(t, "http://"+addr+"/federate/payments")
+	assert.Contains(t, payments, `payments_transactions{instance="co-1",job="payments/checkout",`)
+	assert.Contains(t, payments, `service_namespace="payments"`)
+	assert.NotContains(t, payments, "team_a_requests")
+	assert.NotContains(t, payments, "team_b_requests")
+}
+
+func appendGauge(md pmetric.Metrics, name string, resourceAttrs map[string]string) {
+	rm := md.ResourceMetrics().AppendEmpty()
+	for k, v := range resourceAttrs {
+		rm.Resource().Attributes().PutStr(k, v)
+	}
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName(name)
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	dp.SetIntValue(1)
+}
+
+func scrape(t *testing.T, url string) string {
+	rsp, err := http.Get(url)
+	require.NoError(t, err, "Failed to perform a scrape")
+	defer rsp.Body.Close()
+	require.Equal(t, http.StatusOK, rsp.StatusCode, "Mismatched HTTP response status code")
+	blob, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	return string(blob)
+}
@@ -5,6 +5,7 @@ package prometheusexporter // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	conventions "go.opentelemetry.io/otel/semconv/v1.38.0"
@@ -39,3 +40,11 @@ func extractJob(attributes pcommon.Map) (string, bool) {
 	}
 	return "", false
 }
+
+// metricExpiration returns how long the series of the metric are kept without updates.
+func metricExpiration(name string, defaultExpiration time.Duration, overrides map[string]time.Duration) time.Duration {
+	if expiration, ok := overrides[name]; ok {
+		return expiration
+	}
+	return defaultExpiration
+}
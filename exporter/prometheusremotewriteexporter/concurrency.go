@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewriteexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter"
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// concurrencyWindow is the number of requests after which the concurrency limit is adjusted.
+const concurrencyWindow = 20
+
+// concurrencyController limits the number of requests in flight to the remote write endpoint,
+// adjusting the limit to the endpoint latency: the limit grows by one request after each window
+// of requests whose 90th percentile latency stays below the target, and is halved after a window
+// exceeding the target or throttled with a 429 response.
+type concurrencyController struct {
+	minLimit      int
+	maxLimit      int
+	targetLatency time.Duration
+	telemetry     prwTelemetry
+
+	mu        sync.Mutex
+	limit     int
+	inFlight  int
+	released  chan struct{}
+	latencies []time.Duration
+	throttled bool
+}
+
+func newConcurrencyController(ctx context.Context, cfg AdaptiveConcurrencyConfig, initial int, telemetry prwTelemetry) *concurrencyController {
+	c := &concurrencyController{
+		minLimit:      cfg.MinConcurrency,
+		maxLimit:      cfg.MaxConcurrency,
+		targetLatency: cfg.TargetLatency,
+		telemetry:     telemetry,
+		limit:         min(max(initial, cfg.MinConcurrency), cfg.MaxConcurrency),
+		released:      make(chan struct{}),
+		latencies:     make([]time.Duration, 0, concurrencyWindow),
+	}
+	telemetry.recordConcurrency(ctx, int64(c.limit))
+	return c
+}
+
+// acquire blocks until a request can be sent without exceeding the limit.
+func (c *concurrencyController) acquire(ctx context.Context) error {
+	for {
+		c.mu.Lock()
+		if c.inFlight < c.limit {
+			c.inFlight++
+			c.mu.Unlock()
+			return nil
+		}
+		released := c.released
+		c.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// release records the latency of a completed request and whether the endpoint throttled it.
+func (c *concurrencyController) release(ctx context.Context, latency time.Duration, throttled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inFlight--
+	c.latencies = append(c.latencies, latency)
+	c.throttled = c.throttled || throttled
+	if len(c.latencies) >= concurrencyWindow {
+		c.adjust(ctx)
+	}
+	close(c.released)
+	c.released = make(chan struct{})
+}
+
+func (c *concurrencyController) adjust(ctx context.Context) {
+	slices.Sort(c.latencies)
+	p90 := c.latencies[(len(c.latencies)*9+9)/10-1]
+	limit := c.limit
+	if c.throttled || p90 > c.targetLatency {
+		limit = max(limit/2, c.minLimit)
+	} else {
+		limit = min(limit+1, c.maxLimit)
+	}
+	c.latencies = c.latencies[:0]
+	c.throttled = false
+	if limit != c.limit {
+		c.limit = limit
+		c.telemetry.recordConcurrency(ctx, int64(limit))
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewriteexporter
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/metadatatest"
+)
+
+func TestConcurrencyControllerAdjust(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) }) //nolint:usetesting
+	endpoint, err := url.Parse("http://localhost:9090/api/v1/write")
+	require.NoError(t, err)
+	telemetry, err := newPRWTelemetry(metadatatest.NewSettings(tel), endpoint)
+	require.NoError(t, err)
+
+	c := newConcurrencyController(t.Context(), AdaptiveConcurrencyConfig{
+		MinConcurrency: 2,
+		MaxConcurrency: 4,
+		TargetLatency:  100 * time.Millisecond,
+	}, 5, telemetry)
+	assert.Equal(t, 4, c.limit)
+
+	sendWindow := func(latency time.Duration, throttled int) {
+		for i := range concurrencyWindow {
+			require.NoError(t, c.acquire(t.Context()))
+			c.release(t.Context(), latency, i < throttled)
+		}
+	}
+
+	// the limit is halved when the 90th percentile latency exceeds the target
+	sendWindow(time.Second, 0)
+	assert.Equal(t, 2, c.limit)
+
+	// and does not go below the minimum
+	sendWindow(time.Second, 0)
+	assert.Equal(t, 2, c.limit)
+
+	sendWindow(10*time.Millisecond, 0)
+	assert.Equal(t, 3, c.limit)
+	sendWindow(10*time.Millisecond, 0)
+	sendWindow(10*time.Millisecond, 0)
+	assert.Equal(t, 4, c.limit)
+
+	// a single throttled request is enough to decrease the limit
+	sendWindow(10*time.Millisecond, 1)
+	assert.Equal(t, 2, c.limit)
+	assert.Zero(t, c.inFlight)
+
+	metadatatest.AssertEqualExporterPrometheusremotewriteConcurrency(t, tel, []metricdata.DataPoint[int64]{{
+		Value:      2,
+		Attributes: attribute.NewSet(attribute.String("exporter", "prometheusremotewrite"), attribute.String("endpoint", endpoint.String())),
+	}}, metricdatatest.IgnoreTimestamp())
+}
+
+func TestConcurrencyControllerAcquire(t *testing.T) {
+	telemetry, err := newPRWTelemetry(metadatatest.NewSettings(componenttest.NewTelemetry()), &url.URL{})
+	require.NoError(t, err)
+	c := newConcurrencyController(t.Context(), AdaptiveConcurrencyConfig{
+		MinConcurrency: 1,
+		MaxConcurrency: 1,
+		TargetLatency:  time.Second,
+	}, 1, telemetry)
+	require.NoError(t, c.acquire(t.Context()))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	require.ErrorIs(t, c.acquire(ctx), context.Canceled)
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- c.acquire(t.Context())
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("acquire must block while the limit is reached")
+	case <-time.After(50 * time.Millisecond):
+	}
+	c.release(t.Context(), time.Millisecond, false)
+	require.NoError(t, <-acquired)
+}
+
+func TestAdaptiveConcurrencyLimitsRequestsInFlight(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	var requests sync.WaitGroup
+	requests.Add(10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		defer requests.Done()
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			current := maxInFlight.Load()
+			if n <= current || maxInFlight.CompareAndSwap(current, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.ClientConfig.Endpoint = server.URL
+	cfg.MaxBatchRequestParallelism = toPtr(2)
+	cfg.AdaptiveConcurrency.Enabled = true
+	cfg.AdaptiveConcurrency.MaxConcurrency = 10
+	prwe, err := newPRWExporter(cfg, metadatatest.NewSettings(componenttest.NewTelemetry()))
+	require.NoError(t, err)
+	assert.Equal(t, 10, prwe.concurrency)
+	require.NoError(t, prwe.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, prwe.Shutdown(t.Context()))
+	}()
+
+	writeRequests := make([]*prompb.WriteRequest, 10)
+	for i := range writeRequests {
+		writeRequests[i] = &prompb.WriteRequest{Timeseries: []prompb.TimeSeries{*getTimeSeries(
+			getPromLabels(label11, value11), getSample(floatVal1, msTime1))}}
+	}
+	require.NoError(t, prwe.export(t.Context(), writeRequests))
+	requests.Wait()
+
+	// the workers are bounded by the maximum concurrency, the requests in flight by the initial limit
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
@@ -6,6 +6,7 @@ package prometheusremotewriteexporter // import "github.com/open-telemetry/opent
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	remoteapi "github.com/prometheus/client_golang/exp/api/remote"
 	"go.opentelemetry.io/collector/component"
@@ -38,9 +39,15 @@ type Config struct {
 	// maximum size in bytes of time series batch sent to remote storage
 	MaxBatchSizeBytes int `mapstructure:"max_batch_size_bytes"`
 
+	// maximum number of time series in a batch sent to remote storage, 0 means no limit
+	MaxBatchSeries int `mapstructure:"max_batch_series"`
+
 	// maximum amount of parallel requests to do when handling large batch request
 	MaxBatchRequestParallelism *int `mapstructure:"max_batch_request_parallelism"`
 
+	// AdaptiveConcurrency adjusts the number of requests in flight to the remote write endpoint to its latency.
+	AdaptiveConcurrency AdaptiveConcurrencyConfig `mapstructure:"adaptive_concurrency"`
+
 	// ResourceToTelemetrySettings is the option for converting resource attributes to telemetry attributes.
 	// "Enabled" - A boolean field to enable/disable this option. Default is `false`.
 	// If enabled, all the resource attributes will be converted to metric labels by default.
@@ -48,6 +55,10 @@ type Config struct {
 	// which are already converted to `job` and `instance` labels respectively, will be excluded from the final metrics.
 	ResourceToTelemetrySettings resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
 
+	// PromoteResourceAttributes lists the resource attributes which are added as labels to all the series,
+	// unlike ResourceToTelemetrySettings which converts all of them.
+	PromoteResourceAttributes []string `mapstructure:"promote_resource_attributes"`
+
 	// WAL enables persisting metrics to a write-ahead-log before sending to the remote storage.
 	WAL configoptional.Optional[WALConfig] `mapstructure:"wal"`
 
@@ -68,6 +79,10 @@ type TargetInfo struct {
 	// Enabled if false the target_info metric is not generated by the exporter
 	Enabled bool `mapstructure:"enabled"`
 
+	// Interval is the minimum interval between two samples of the target_info metric of a resource,
+	// 0 meaning a sample is generated on every export.
+	Interval time.Duration `mapstructure:"interval"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -90,6 +105,25 @@ type RemoteWriteQueue struct {
 	_ struct{}
 }
 
+// AdaptiveConcurrencyConfig allows to configure the adaptive concurrency of the remote write requests.
+type AdaptiveConcurrencyConfig struct {
+	// Enabled if true the number of requests in flight is adjusted between MinConcurrency and MaxConcurrency,
+	// replacing the fixed concurrency of max_batch_request_parallelism or remote_write_queue::num_consumers.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MinConcurrency is the lower bound of the number of requests in flight.
+	MinConcurrency int `mapstructure:"min_concurrency"`
+
+	// MaxConcurrency is the upper bound of the number of requests in flight.
+	MaxConcurrency int `mapstructure:"max_concurrency"`
+
+	// TargetLatency is the 90th percentile request latency above which the concurrency is decreased.
+	TargetLatency time.Duration `mapstructure:"target_latency"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 // TODO(jbd): Add capacity, max_samples_per_send to QueueConfig.
 
 var _ component.Config = (*Config)(nil)
@@ -100,6 +134,18 @@ func (cfg *Config) Validate() error {
 		return errors.New("max_batch_request_parallelism can't be set to below 1")
 	}
 
+	if cfg.AdaptiveConcurrency.Enabled {
+		if cfg.AdaptiveConcurrency.MinConcurrency < 1 {
+			return errors.New("adaptive_concurrency min_concurrency can't be set to below 1")
+		}
+		if cfg.AdaptiveConcurrency.MaxConcurrency < cfg.AdaptiveConcurrency.MinConcurrency {
+			return errors.New("adaptive_concurrency max_concurrency can't be lower than min_concurrency")
+		}
+		if cfg.AdaptiveConcurrency.TargetLatency <= 0 {
+			return errors.New("adaptive_concurrency target_latency must be greater than 0")
+		}
+	}
+
 	if cfg.RemoteWriteQueue.QueueSize < 0 {
 		return errors.New("remote write queue size can't be negative")
 	}
@@ -120,6 +166,18 @@ func (cfg *Config) Validate() error {
 		cfg.MaxBatchSizeBytes = 3000000
 	}
 
+	if cfg.MaxBatchSeries < 0 {
+		return errors.New("max_batch_series can't be negative")
+	}
+
+	if cfg.TargetInfo.Interval < 0 {
+		return errors.New("target_info interval can't be negative")
+	}
+
+	if len(cfg.PromoteResourceAttributes) > 0 && cfg.ResourceToTelemetrySettings.Enabled {
+		return errors.New("promote_resource_attributes can't be used when resource_to_telemetry_conversion is enabled")
+	}
+
 	if len(cfg.ClientConfig.Compression) > 0 && cfg.ClientConfig.Compression != "snappy" {
 		return errors.New("compression type must be snappy")
 	}
@@ -60,7 +60,13 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				MaxBatchSizeBytes:          3000000,
 				MaxBatchRequestParallelism: toPtr(10),
-				TimeoutSettings:            exporterhelper.NewDefaultTimeoutConfig(),
+				MaxBatchSeries:             2000,
+				AdaptiveConcurrency: AdaptiveConcurrencyConfig{
+					MinConcurrency: 1,
+					MaxConcurrency: 20,
+					TargetLatency:  time.Second,
+				},
+				TimeoutSettings: exporterhelper.NewDefaultTimeoutConfig(),
 				BackOffConfig: configretry.BackOffConfig{
 					Enabled:             true,
 					InitialInterval:     10 * time.Second,
@@ -85,6 +91,24 @@ func TestLoadConfig(t *testing.T) {
 				RemoteWriteProtoMsg: remoteapi.WriteV1MessageType,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "target_info_interval"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.ClientConfig.Endpoint = "localhost:8888"
+				cfg.TargetInfo.Interval = 5 * time.Minute
+				cfg.PromoteResourceAttributes = []string{"k8s.namespace.name", "k8s.pod.name"}
+				return cfg
+			}(),
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_target_info_interval"),
+			errorMessage: "target_info interval can't be negative",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "promote_resource_attributes_with_conversion"),
+			errorMessage: "promote_resource_attributes can't be used when resource_to_telemetry_conversion is enabled",
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "negative_queue_size"),
 			errorMessage: "remote write queue size can't be negative",
@@ -97,6 +121,28 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "less_than_1_max_batch_request_parallelism"),
 			errorMessage: "max_batch_request_parallelism can't be set to below 1",
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "adaptive_concurrency"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.ClientConfig.Endpoint = "localhost:8888"
+				cfg.AdaptiveConcurrency = AdaptiveConcurrencyConfig{
+					Enabled:        true,
+					MinConcurrency: 2,
+					MaxConcurrency: 50,
+					TargetLatency:  500 * time.Millisecond,
+				}
+				return cfg
+			}(),
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "adaptive_concurrency_max_below_min"),
+			errorMessage: "adaptive_concurrency max_concurrency can't be lower than min_concurrency",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_max_batch_series"),
+			errorMessage: "max_batch_series can't be negative",
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "non_snappy_compression_type"),
 			errorMessage: "compression type must be snappy",
@@ -42,6 +42,7 @@ type prwTelemetry interface {
 	recordTranslatedTimeSeries(ctx context.Context, numTS int)
 	recordRemoteWriteSentBatch(ctx context.Context)
 	setNumberConsumer(ctx context.Context, n int64)
+	recordConcurrency(ctx context.Context, n int64)
 	recordWrittenSamples(ctx context.Context, numSamples int64)
 	recordWrittenHistograms(ctx context.Context, numHistograms int64)
 	recordWrittenExemplars(ctx context.Context, numExemplars int64)
@@ -56,6 +57,10 @@ func (p *prwTelemetryOtel) setNumberConsumer(ctx context.Context, n int64) {
 	p.telemetryBuilder.ExporterPrometheusremotewriteConsumers.Add(ctx, n, metric.WithAttributes(p.otelAttrs...))
 }
 
+func (p *prwTelemetryOtel) recordConcurrency(ctx context.Context, n int64) {
+	p.telemetryBuilder.ExporterPrometheusremotewriteConcurrency.Record(ctx, n, metric.WithAttributes(p.otelAttrs...))
+}
+
 func (p *prwTelemetryOtel) recordRemoteWriteSentBatch(ctx context.Context) {
 	p.telemetryBuilder.ExporterPrometheusremotewriteSentBatches.Add(ctx, 1, metric.WithAttributes(p.otelAttrs...))
 }
@@ -129,8 +134,10 @@ type prwExporter struct {
 	wg                  *sync.WaitGroup
 	closeChan           chan struct{}
 	concurrency         int
+	concurrencyCtrl     *concurrencyController
 	userAgentHeader     string
 	maxBatchSizeBytes   int
+	maxBatchSeries      int
 	clientSettings      *confighttp.ClientConfig
 	settings            component.TelemetrySettings
 	retrySettings       configretry.BackOffConfig
@@ -196,6 +203,14 @@ func newPRWExporter(cfg *Config, set exporter.Settings) (*prwExporter, error) {
 		concurrency = 1
 	}
 
+	// With adaptive concurrency, the workers are bounded by the maximum concurrency
+	// and the requests in flight by the controller.
+	var concurrencyCtrl *concurrencyController
+	if cfg.AdaptiveConcurrency.Enabled {
+		concurrencyCtrl = newConcurrencyController(context.Background(), cfg.AdaptiveConcurrency, concurrency, telemetry)
+		concurrency = cfg.AdaptiveConcurrency.MaxConcurrency
+	}
+
 	// Set the desired number of consumers as a metric for the exporter.
 	telemetry.setNumberConsumer(context.Background(), int64(concurrency))
 
@@ -205,23 +220,30 @@ func newPRWExporter(cfg *Config, set exporter.Settings) (*prwExporter, error) {
 		closeChan:           make(chan struct{}),
 		userAgentHeader:     userAgentHeader,
 		maxBatchSizeBytes:   cfg.MaxBatchSizeBytes,
+		maxBatchSeries:      cfg.MaxBatchSeries,
 		concurrency:         concurrency,
+		concurrencyCtrl:     concurrencyCtrl,
 		clientSettings:      &cfg.ClientConfig,
 		settings:            set.TelemetrySettings,
 		retrySettings:       cfg.BackOffConfig,
 		retryOnHTTP429:      retryOn429FeatureGate.IsEnabled(),
 		RemoteWriteProtoMsg: cfg.RemoteWriteProtoMsg,
 		exporterSettings: prometheusremotewrite.Settings{
-			Namespace:         cfg.Namespace,
-			ExternalLabels:    sanitizedLabels,
-			DisableTargetInfo: !cfg.TargetInfo.Enabled,
-			AddMetricSuffixes: cfg.AddMetricSuffixes,
-			SendMetadata:      cfg.SendMetadata,
+			Namespace:                 cfg.Namespace,
+			ExternalLabels:            sanitizedLabels,
+			DisableTargetInfo:         !cfg.TargetInfo.Enabled,
+			AddMetricSuffixes:         cfg.AddMetricSuffixes,
+			SendMetadata:              cfg.SendMetadata,
+			PromoteResourceAttributes: cfg.PromoteResourceAttributes,
 		},
 		telemetry:      telemetry,
 		batchStatePool: sync.Pool{New: func() any { return newBatchTimeServicesState() }},
 	}
 
+	if cfg.TargetInfo.Enabled && cfg.TargetInfo.Interval > 0 {
+		prwe.exporterSettings.TargetInfoLimiter = prometheusremotewrite.NewTargetInfoLimiter(cfg.TargetInfo.Interval)
+	}
+
 	prwe.settings.Logger.Info("starting prometheus remote write exporter", zap.Any("ProtoMsg", cfg.RemoteWriteProtoMsg))
 
 	prwe.wal, err = newWAL(cfg.WAL.Get(), set, prwe.export)
@@ -336,7 +358,7 @@ func (prwe *prwExporter) handleExport(ctx context.Context, tsMap map[string]*pro
 	state := prwe.batchStatePool.Get().(*batchTimeSeriesState)
 	defer prwe.batchStatePool.Put(state)
 	// Calls the helper function to convert and batch the TsMap to the desired format
-	requests, err := batchTimeSeries(tsMap, prwe.maxBatchSizeBytes, m, state)
+	requests, err := batchTimeSeries(tsMap, prwe.maxBatchSizeBytes, prwe.maxBatchSeries, m, state)
 	if err != nil {
 		return err
 	}
@@ -454,7 +476,16 @@ func (prwe *prwExporter) execute(ctx context.Context, buf []byte) error {
 			return http.StatusBadRequest, fmt.Errorf("unsupported remote-write protobuf message: %v (should be validated earlier)", prwe.RemoteWriteProtoMsg)
 		}
 
+		if prwe.concurrencyCtrl != nil {
+			if err = prwe.concurrencyCtrl.acquire(ctx); err != nil {
+				return http.StatusGatewayTimeout, backoff.Permanent(err)
+			}
+		}
+		start := time.Now()
 		resp, err := prwe.client.Do(req)
+		if prwe.concurrencyCtrl != nil {
+			prwe.concurrencyCtrl.release(ctx, time.Since(start), err == nil && resp.StatusCode == http.StatusTooManyRequests)
+		}
 		prwe.telemetry.recordRemoteWriteSentBatch(ctx)
 		if err != nil {
 			return http.StatusBadRequest, err
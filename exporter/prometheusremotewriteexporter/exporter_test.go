@@ -35,6 +35,7 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
@@ -827,6 +828,69 @@ func Test_PushMetrics(t *testing.T) {
 	}
 }
 
+func TestTargetInfoIntervalAndPromotedAttributes(t *testing.T) {
+	var mu sync.Mutex
+	var received [][]prompb.TimeSeries
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		dest, err := snappy.Decode(nil, body)
+		assert.NoError(t, err)
+		wr := &prompb.WriteRequest{}
+		assert.NoError(t, proto.Unmarshal(dest, wr))
+		mu.Lock()
+		received = append(received, wr.Timeseries)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.ClientConfig.Endpoint = server.URL
+	cfg.RemoteWriteQueue.NumConsumers = 1
+	cfg.TargetInfo.Interval = 5 * time.Minute
+	cfg.PromoteResourceAttributes = []string{"k8s.namespace.name"}
+	prwe, err := newPRWExporter(cfg, exportertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+	require.NoError(t, prwe.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, prwe.Shutdown(context.Background())) }()
+
+	start := time.Unix(1000, 0)
+	for _, elapsed := range []time.Duration{0, time.Minute, 5 * time.Minute} {
+		md := pmetric.NewMetrics()
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", "svc")
+		rm.Resource().Attributes().PutStr("k8s.namespace.name", "ns")
+		rm.Resource().Attributes().PutStr("k8s.pod.name", "pod")
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("test_gauge")
+		dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(start.Add(elapsed)))
+		dp.SetDoubleValue(1)
+		require.NoError(t, prwe.PushMetrics(t.Context(), md))
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 3)
+	var targetInfos []int
+	for i, tss := range received {
+		for _, ts := range tss {
+			labels := map[string]string{}
+			for _, l := range ts.Labels {
+				labels[l.Name] = l.Value
+			}
+			if labels["__name__"] == "target_info" {
+				targetInfos = append(targetInfos, i)
+				continue
+			}
+			assert.Equal(t, "ns", labels["k8s_namespace_name"])
+			assert.NotContains(t, labels, "k8s_pod_name")
+		}
+	}
+	assert.Equal(t, []int{0, 2}, targetInfos)
+}
+
 func Test_validateAndSanitizeExternalLabels(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -98,7 +98,7 @@ func (prwe *prwExporter) handleExportV2(ctx context.Context, symbolsTable writev
 
 	state := prwe.batchStatePool.Get().(*batchTimeSeriesState)
 	defer prwe.batchStatePool.Put(state)
-	requests, err := batchTimeSeriesV2(tsMap, symbolsTable, prwe.maxBatchSizeBytes, state)
+	requests, err := batchTimeSeriesV2(tsMap, symbolsTable, prwe.maxBatchSizeBytes, prwe.maxBatchSeries, state)
 	if err != nil {
 		return err
 	}
@@ -117,6 +117,11 @@ func createDefaultConfig() component.Config {
 		MaxBatchSizeBytes: 3000000,
 		// To set this as default once `exporter.prometheusremotewritexporter.EnableMultipleWorkers` is removed
 		// MaxBatchRequestParallelism: 5,
+		AdaptiveConcurrency: AdaptiveConcurrencyConfig{
+			MinConcurrency: 1,
+			MaxConcurrency: 20,
+			TargetLatency:  time.Second,
+		},
 		TimeoutSettings:     exporterhelper.NewDefaultTimeoutConfig(),
 		BackOffConfig:       retrySettings,
 		AddMetricSuffixes:   true,
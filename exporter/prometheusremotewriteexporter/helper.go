@@ -28,7 +28,7 @@ func newBatchTimeServicesState() *batchTimeSeriesState {
 }
 
 // batchTimeSeries splits series into multiple batch write requests.
-func batchTimeSeries(tsMap map[string]*prompb.TimeSeries, maxBatchByteSize int, m []*prompb.MetricMetadata, state *batchTimeSeriesState) ([]*prompb.WriteRequest, error) {
+func batchTimeSeries(tsMap map[string]*prompb.TimeSeries, maxBatchByteSize, maxBatchSeries int, m []*prompb.MetricMetadata, state *batchTimeSeriesState) ([]*prompb.WriteRequest, error) {
 	if len(tsMap) == 0 {
 		return nil, errors.New("invalid tsMap: cannot be empty map")
 	}
@@ -36,37 +36,15 @@ func batchTimeSeries(tsMap map[string]*prompb.TimeSeries, maxBatchByteSize int,
 	// Allocate a buffer size of at least 10, or twice the last # of requests we sent
 	requests := make([]*prompb.WriteRequest, 0, max(10, state.nextRequestBufferSize))
 
-	// Allocate a time series buffer 2x the last time series batch size or the length of the input if smaller
-	tsArray := make([]prompb.TimeSeries, 0, min(state.nextTimeSeriesBufferSize, len(tsMap)))
-	sizeOfCurrentBatch := 0
-
-	i := 0
-	for _, v := range tsMap {
-		sizeOfSeries := v.Size()
-
-		if sizeOfCurrentBatch+sizeOfSeries >= maxBatchByteSize {
-			state.nextTimeSeriesBufferSize = max(10, 2*len(tsArray))
-			wrapped := convertTimeseriesToRequest(tsArray)
-			requests = append(requests, wrapped)
-
-			tsArray = make([]prompb.TimeSeries, 0, min(state.nextTimeSeriesBufferSize, len(tsMap)-i))
-			sizeOfCurrentBatch = 0
-		}
-
-		tsArray = append(tsArray, *v)
-		sizeOfCurrentBatch += sizeOfSeries
-		i++
-	}
-
-	if len(tsArray) != 0 {
-		wrapped := convertTimeseriesToRequest(tsArray)
-		requests = append(requests, wrapped)
+	batches := packTimeSeries(tsMap, (*prompb.TimeSeries).Size, 0, maxBatchByteSize, maxBatchSeries, state)
+	for _, tsArray := range batches {
+		requests = append(requests, convertTimeseriesToRequest(tsArray))
 	}
 
 	// Allocate a metric metadata buffer 2x the last metric metadata batch size or the length of the input if smaller
 	mArray := make([]prompb.MetricMetadata, 0, min(state.nextMetricMetadataBufferSize, len(m)))
-	sizeOfCurrentBatch = 0
-	i = 0
+	sizeOfCurrentBatch := 0
+	i := 0
 	for _, v := range m {
 		sizeOfM := v.Size()
 
@@ -93,6 +71,55 @@ func batchTimeSeries(tsMap map[string]*prompb.TimeSeries, maxBatchByteSize int,
 	return requests, nil
 }
 
+// packTimeSeries groups the time series into batches of less than maxBatchByteSize bytes and at most
+// maxBatchSeries series, if not 0. The series are placed from the largest to the smallest, each one in the
+// first batch with enough room left, so that the large series, such as native histograms, don't split the
+// batches early. All the samples of a series are sent in the same batch, and a series larger than
+// maxBatchByteSize is sent alone. batchOverhead is the size added to every batch.
+func packTimeSeries[T any](tsMap map[string]*T, size func(*T) int, batchOverhead, maxBatchByteSize, maxBatchSeries int, state *batchTimeSeriesState) [][]T {
+	type sizedSeries struct {
+		ts   *T
+		size int
+	}
+	series := make([]sizedSeries, 0, len(tsMap))
+	for _, v := range tsMap {
+		series = append(series, sizedSeries{ts: v, size: size(v)})
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].size > series[j].size
+	})
+	smallest := series[len(series)-1].size
+
+	var batches [][]T
+	var batchSizes []int
+	fits := func(b, sizeOfSeries int) bool {
+		return (maxBatchSeries == 0 || len(batches[b]) < maxBatchSeries) && batchSizes[b]+sizeOfSeries < maxBatchByteSize
+	}
+	// Batches before firstOpen have no room left for any of the remaining series.
+	firstOpen := 0
+	for i, s := range series {
+		for firstOpen < len(batches) && !fits(firstOpen, smallest) {
+			firstOpen++
+		}
+
+		b := firstOpen
+		for b < len(batches) && !fits(b, s.size) {
+			b++
+		}
+		if b == len(batches) {
+			if b > 0 {
+				state.nextTimeSeriesBufferSize = max(10, 2*len(batches[b-1]))
+			}
+			batches = append(batches, make([]T, 0, min(state.nextTimeSeriesBufferSize, len(series)-i)))
+			batchSizes = append(batchSizes, batchOverhead)
+		}
+
+		batches[b] = append(batches[b], *s.ts)
+		batchSizes[b] += s.size
+	}
+	return batches
+}
+
 func convertTimeseriesToRequest(tsArray []prompb.TimeSeries) *prompb.WriteRequest {
 	// the remote_write endpoint only requires the timeseries.
 	// otlp defines its own way to handle metric metadata
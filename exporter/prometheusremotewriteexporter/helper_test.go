@@ -9,6 +9,7 @@ import (
 
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Test_batchTimeSeries checks batchTimeSeries return the correct number of requests
@@ -59,7 +60,7 @@ func Test_batchTimeSeries(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			state := newBatchTimeServicesState()
-			requests, err := batchTimeSeries(tt.tsMap, tt.maxBatchByteSize, nil, state)
+			requests, err := batchTimeSeries(tt.tsMap, tt.maxBatchByteSize, 0, nil, state)
 			if tt.returnErr {
 				assert.Error(t, err)
 				return
@@ -97,7 +98,7 @@ func Test_batchTimeSeriesUpdatesStateForLargeBatches(t *testing.T) {
 	tsMap1 := getTimeseriesMap(tsArray)
 
 	state := newBatchTimeServicesState()
-	requests, err := batchTimeSeries(tsMap1, 1000000, nil, state)
+	requests, err := batchTimeSeries(tsMap1, 1000000, 0, nil, state)
 
 	assert.NoError(t, err)
 	assert.Len(t, requests, 18)
@@ -106,6 +107,59 @@ func Test_batchTimeSeriesUpdatesStateForLargeBatches(t *testing.T) {
 	assert.Equal(t, 36, state.nextRequestBufferSize)
 }
 
+// Test_batchTimeSeriesPacking checks that the large series don't split the batches early, and that the
+// number of series per request is limited.
+func Test_batchTimeSeriesPacking(t *testing.T) {
+	labels := getPromLabels(label11, value11, label12, value12)
+	small := getTimeSeries(labels, getSample(floatVal1, msTime1))
+	samples := make([]prompb.Sample, 0, 100)
+	for i := range 100 {
+		samples = append(samples, getSample(floatVal1, msTime1+int64(i)))
+	}
+	large := getTimeSeries(labels, samples...)
+
+	tsArray := []*prompb.TimeSeries{large}
+	for range 10 {
+		tsArray = append(tsArray, getTimeSeries(labels, getSample(floatVal1, msTime1)))
+	}
+	tsMap := getTimeseriesMap(tsArray)
+	// The large series and 5 small ones fit in a batch.
+	maxBatchByteSize := large.Size() + 5*small.Size() + 1
+
+	tests := []struct {
+		name                string
+		maxBatchSeries      int
+		numExpectedRequests int
+	}{
+		{
+			name:                "bytes_only",
+			numExpectedRequests: 2,
+		},
+		{
+			name:                "max_batch_series",
+			maxBatchSeries:      3,
+			numExpectedRequests: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests, err := batchTimeSeries(tsMap, maxBatchByteSize, tt.maxBatchSeries, nil, newBatchTimeServicesState())
+			require.NoError(t, err)
+			require.Len(t, requests, tt.numExpectedRequests)
+
+			numSeries := 0
+			for _, req := range requests {
+				if tt.maxBatchSeries > 0 {
+					assert.LessOrEqual(t, len(req.Timeseries), tt.maxBatchSeries)
+				}
+				numSeries += len(req.Timeseries)
+			}
+			assert.Equal(t, len(tsMap), numSeries)
+			assert.Len(t, requests[0].Timeseries[0].Samples, 100)
+		})
+	}
+}
+
 // Benchmark_batchTimeSeries checks batchTimeSeries
 // To run and gather alloc data:
 // go test -bench ^Benchmark_batchTimeSeries$ -benchmem -benchtime=100x -run=^$ -count=10 -memprofile memprofile.out
@@ -131,7 +185,7 @@ func Benchmark_batchTimeSeries(b *testing.B) {
 	state := newBatchTimeServicesState()
 	// Run batchTimeSeries 100 times with a 1mb max request size
 	for b.Loop() {
-		requests, err := batchTimeSeries(tsMap1, 1000000, nil, state)
+		requests, err := batchTimeSeries(tsMap1, 1000000, 0, nil, state)
 		assert.NoError(b, err)
 		assert.Len(b, requests, 18)
 	}
@@ -10,13 +10,12 @@ import (
 	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 )
 
-func batchTimeSeriesV2(tsMap map[string]*writev2.TimeSeries, symbolsTable writev2.SymbolsTable, maxBatchByteSize int, state *batchTimeSeriesState) ([]*writev2.Request, error) {
+func batchTimeSeriesV2(tsMap map[string]*writev2.TimeSeries, symbolsTable writev2.SymbolsTable, maxBatchByteSize, maxBatchSeries int, state *batchTimeSeriesState) ([]*writev2.Request, error) {
 	if len(tsMap) == 0 {
 		return nil, errors.New("invalid tsMap: cannot be empty map")
 	}
 
 	requests := make([]*writev2.Request, 0, max(10, state.nextRequestBufferSize))
-	tsArray := make([]writev2.TimeSeries, 0, min(state.nextTimeSeriesBufferSize, len(tsMap)))
 
 	// Calculate symbols table size once since it's shared across batches
 	symbolsSize := 0
@@ -24,30 +23,10 @@ func batchTimeSeriesV2(tsMap map[string]*writev2.TimeSeries, symbolsTable writev
 		symbolsSize += len(symbol)
 	}
 
-	sizeOfCurrentBatch := symbolsSize // Initialize with symbols table size
-	i := 0
-
-	for _, v := range tsMap {
-		sizeOfSeries := v.Size()
-
-		if sizeOfCurrentBatch+sizeOfSeries >= maxBatchByteSize {
-			state.nextTimeSeriesBufferSize = max(10, 2*len(tsArray))
-			wrapped := convertTimeseriesToRequestV2(tsArray, symbolsTable)
-			requests = append(requests, wrapped)
-
-			tsArray = make([]writev2.TimeSeries, 0, min(state.nextTimeSeriesBufferSize, len(tsMap)-i))
-			sizeOfCurrentBatch = symbolsSize // Reset to symbols table size for new batch
-		}
-
-		tsArray = append(tsArray, *v)
-		sizeOfCurrentBatch += sizeOfSeries
-		i++
-	}
-
-	if len(tsArray) != 0 {
+	batches := packTimeSeries(tsMap, (*writev2.TimeSeries).Size, symbolsSize, maxBatchByteSize, maxBatchSeries, state)
+	for _, tsArray := range batches {
 		// TODO only sent necessary part of the symbolsTable
-		wrapped := convertTimeseriesToRequestV2(tsArray, symbolsTable)
-		requests = append(requests, wrapped)
+		requests = append(requests, convertTimeseriesToRequestV2(tsArray, symbolsTable))
 	}
 
 	state.nextRequestBufferSize = 2 * len(requests)
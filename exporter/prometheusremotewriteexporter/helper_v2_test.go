@@ -59,7 +59,7 @@ func Test_batchTimeSeriesV2(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			state := newBatchTimeServicesState()
-			requests, err := batchTimeSeriesV2(tt.tsMap, smb1, tt.maxBatchByteSize, state)
+			requests, err := batchTimeSeriesV2(tt.tsMap, smb1, tt.maxBatchByteSize, 0, state)
 			if tt.returnErr {
 				assert.Error(t, err)
 				return
@@ -102,7 +102,7 @@ func Test_batchTimeSeriesV2UpdatesStateForLargeBatches(t *testing.T) {
 
 	tsMap1 := getTimeseriesMapV2(tsArray)
 	state := newBatchTimeServicesState()
-	requests, err := batchTimeSeriesV2(tsMap1, smb, 1000000, state)
+	requests, err := batchTimeSeriesV2(tsMap1, smb, 1000000, 0, state)
 
 	assert.NoError(t, err)
 	assert.Len(t, requests, 7)
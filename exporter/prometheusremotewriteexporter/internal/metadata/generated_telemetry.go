@@ -25,6 +25,7 @@ type TelemetryBuilder struct {
 	meter                                             metric.Meter
 	mu                                                sync.Mutex
 	registrations                                     []metric.Registration
+	ExporterPrometheusremotewriteConcurrency          metric.Int64Gauge
 	ExporterPrometheusremotewriteConsumers            metric.Int64UpDownCounter
 	ExporterPrometheusremotewriteFailedTranslations   metric.Int64Counter
 	ExporterPrometheusremotewriteSentBatches          metric.Int64Counter
@@ -72,6 +73,12 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 	}
 	builder.meter = Meter(settings)
 	var err, errs error
+	builder.ExporterPrometheusremotewriteConcurrency, err = builder.meter.Int64Gauge(
+		"otelcol_exporter_prometheusremotewrite_concurrency",
+		metric.WithDescription("Maximum number of requests in flight to the remote write endpoint set by the adaptive concurrency [Development]"),
+		metric.WithUnit("{request}"),
+	)
+	errs = errors.Join(errs, err)
 	builder.ExporterPrometheusremotewriteConsumers, err = builder.meter.Int64UpDownCounter(
 		"otelcol_exporter_prometheusremotewrite_consumers",
 		metric.WithDescription("Number of configured workers to use to fan out the outgoing requests [Development]"),
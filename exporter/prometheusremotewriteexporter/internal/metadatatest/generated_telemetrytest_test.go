@@ -19,6 +19,7 @@ func TestSetupTelemetry(t *testing.T) {
 	tb, err := metadata.NewTelemetryBuilder(testTel.NewTelemetrySettings())
 	require.NoError(t, err)
 	defer tb.Shutdown()
+	tb.ExporterPrometheusremotewriteConcurrency.Record(context.Background(), 1)
 	tb.ExporterPrometheusremotewriteConsumers.Add(context.Background(), 1)
 	tb.ExporterPrometheusremotewriteFailedTranslations.Add(context.Background(), 1)
 	tb.ExporterPrometheusremotewriteSentBatches.Add(context.Background(), 1)
@@ -35,6 +36,9 @@ func TestSetupTelemetry(t *testing.T) {
 	tb.ExporterPrometheusremotewriteWrittenExemplars.Add(context.Background(), 1)
 	tb.ExporterPrometheusremotewriteWrittenHistograms.Add(context.Background(), 1)
 	tb.ExporterPrometheusremotewriteWrittenSamples.Add(context.Background(), 1)
+	AssertEqualExporterPrometheusremotewriteConcurrency(t, testTel,
+		[]metricdata.DataPoint[int64]{{Value: 1}},
+		metricdatatest.IgnoreTimestamp())
 	AssertEqualExporterPrometheusremotewriteConsumers(t, testTel,
 		[]metricdata.DataPoint[int64]{{Value: 1}},
 		metricdatatest.IgnoreTimestamp())
@@ -5,6 +5,7 @@ package rabbitmqexporter // import "github.com/open-telemetry/opentelemetry-coll
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -12,8 +13,16 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 )
 
+const (
+	// protocolAMQP publishes the messages to an exchange with AMQP 0.9.1
+	protocolAMQP = "amqp"
+	// protocolStream publishes the messages to a stream with the RabbitMQ stream protocol
+	protocolStream = "stream"
+)
+
 type Config struct {
 	Connection          ConnectionConfig          `mapstructure:"connection"`
+	Protocol            string                    `mapstructure:"protocol"`
 	Routing             RoutingConfig             `mapstructure:"routing"`
 	EncodingExtensionID *component.ID             `mapstructure:"encoding_extension"`
 	Durable             bool                      `mapstructure:"durable"`
@@ -60,5 +69,16 @@ func (cfg *Config) Validate() error {
 		return errors.New("connection.auth.plain.username is required")
 	}
 
+	switch cfg.Protocol {
+	case protocolAMQP:
+	case protocolStream:
+		// Streams are published to directly, without routing
+		if cfg.Routing.Exchange != "" {
+			return errors.New("routing.exchange is not supported with the stream protocol")
+		}
+	default:
+		return fmt.Errorf("protocol must be %q or %q", protocolAMQP, protocolStream)
+	}
+
 	return nil
 }
@@ -41,6 +41,14 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "missing_plainauth_username"),
 			errorMessage: "connection.auth.plain.username is required",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_protocol"),
+			errorMessage: `protocol must be "amqp" or "stream"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "stream_protocol_with_exchange"),
+			errorMessage: "routing.exchange is not supported with the stream protocol",
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "all_fields"),
 			expected: &Config{
@@ -67,6 +75,7 @@ func TestLoadConfig(t *testing.T) {
 					Exchange:   "amq.direct",
 					RoutingKey: "custom_routing_key",
 				},
+				Protocol:            protocolAMQP,
 				EncodingExtensionID: &encodingComponentID,
 				Durable:             false,
 				RetrySettings: configretry.BackOffConfig{
@@ -90,7 +99,30 @@ func TestLoadConfig(t *testing.T) {
 					Heartbeat:                  defaultConnectionHeartbeat,
 					PublishConfirmationTimeout: defaultPublishConfirmationTimeout,
 				},
-				Durable: true,
+				Protocol: protocolAMQP,
+				Durable:  true,
+				RetrySettings: configretry.BackOffConfig{
+					Enabled: false,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "stream_protocol"),
+			expected: &Config{
+				Connection: ConnectionConfig{
+					Endpoint: "rabbitmq-stream://localhost:5552",
+					Auth: AuthConfig{
+						Plain: PlainAuth{
+							Username: "user",
+							Password: "pass",
+						},
+					},
+					ConnectionTimeout:          defaultConnectionTimeout,
+					Heartbeat:                  defaultConnectionHeartbeat,
+					PublishConfirmationTimeout: defaultPublishConfirmationTimeout,
+				},
+				Protocol: protocolStream,
+				Durable:  true,
 				RetrySettings: configretry.BackOffConfig{
 					Enabled: false,
 				},
@@ -48,6 +48,7 @@ func createDefaultConfig() component.Config {
 		Enabled: false,
 	}
 	return &Config{
+		Protocol:      protocolAMQP,
 		Durable:       true,
 		RetrySettings: retrySettings,
 		Connection: ConnectionConfig{
@@ -147,6 +148,9 @@ func getRoutingKeyOrDefault(config *Config, fallback string) string {
 
 func newPublisherFactory(set exporter.Settings) publisherFactory {
 	return func(dialConfig publisher.DialConfig) (publisher.Publisher, error) {
+		if dialConfig.Stream != "" {
+			return publisher.NewStreamConnection(set.Logger, dialConfig)
+		}
 		return publisher.NewConnection(set.Logger, rabbitmq.NewAmqpClient(set.Logger), dialConfig)
 	}
 }
@@ -19,6 +19,8 @@ type DialConfig struct {
 	otelrabbitmq.DialConfig
 	Durable                    bool
 	PublishConfirmationTimeout time.Duration
+	// Stream is the name of the stream to publish to with the stream protocol, instead of AMQP 0.9.1
+	Stream string
 }
 
 type Message struct {
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package publisher // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/rabbitmqexporter/internal/publisher"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	streamamqp "github.com/rabbitmq/rabbitmq-stream-go-client/pkg/amqp"
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/message"
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/stream"
+	"go.uber.org/zap"
+)
+
+// streamProducer is the subset of the stream producer used to publish messages.
+type streamProducer interface {
+	Send(message.StreamMessage) error
+	Close() error
+}
+
+// streamPublisher publishes messages to a RabbitMQ stream with the stream protocol. Unlike the AMQP publisher,
+// the stream client handles the reconnections itself, and a single producer is shared by all the publications.
+type streamPublisher struct {
+	logger              *zap.Logger
+	producer            streamProducer
+	closeEnvironment    func() error
+	confirmationTimeout time.Duration
+
+	// pending holds the channels waiting for the confirmation of the messages being published
+	pendingLock sync.Mutex
+	pending     map[message.StreamMessage]chan error
+}
+
+// NewStreamConnection connects to RabbitMQ with the stream protocol, and creates a producer publishing to the
+// stream of the config.
+func NewStreamConnection(logger *zap.Logger, config DialConfig) (Publisher, error) {
+	options := stream.NewEnvironmentOptions().
+		SetUri(config.URL).
+		SetRequestedHeartbeat(config.Heartbeat).
+		SetRPCTimeout(config.ConnectionTimeout)
+	if config.Vhost != "" {
+		options.SetVHost(config.Vhost)
+	}
+	if auth, ok := config.Auth.(*amqp.PlainAuth); ok {
+		options.SetUser(auth.Username).SetPassword(auth.Password)
+	}
+	if config.TLS != nil {
+		options.IsTLS(true).SetTLSConfig(config.TLS)
+	}
+
+	env, err := stream.NewEnvironment(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RabbitMQ stream endpoint: %w", err)
+	}
+	producer, err := env.NewProducer(config.Stream, stream.NewProducerOptions().
+		SetClientProvidedName(config.ConnectionName).
+		SetConfirmationTimeOut(config.PublishConfirmationTimeout))
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to create producer for stream %q: %w", config.Stream, err), env.Close())
+	}
+
+	p := newStreamPublisher(logger, producer, env.Close, config.PublishConfirmationTimeout)
+	go p.handleConfirmations(producer.NotifyPublishConfirmation())
+	return p, nil
+}
+
+func newStreamPublisher(logger *zap.Logger, producer streamProducer, closeEnvironment func() error, confirmationTimeout time.Duration) *streamPublisher {
+	return &streamPublisher{
+		logger:              logger,
+		producer:            producer,
+		closeEnvironment:    closeEnvironment,
+		confirmationTimeout: confirmationTimeout,
+		pending:             make(map[message.StreamMessage]chan error),
+	}
+}
+
+// Publish sends the message to the stream and waits for its confirmation. The exchange and routing key of the
+// message are ignored, since the producer publishes to a single stream.
+func (p *streamPublisher) Publish(ctx context.Context, m Message) error {
+	streamMessage := streamamqp.NewMessage(m.Body)
+	confirmation := make(chan error, 1)
+	p.pendingLock.Lock()
+	p.pending[streamMessage] = confirmation
+	p.pendingLock.Unlock()
+	defer func() {
+		p.pendingLock.Lock()
+		delete(p.pending, streamMessage)
+		p.pendingLock.Unlock()
+	}()
+
+	if err := p.producer.Send(streamMessage); err != nil {
+		return errors.Join(errors.New("error publishing message"), err)
+	}
+
+	select {
+	case err := <-confirmation:
+		if err != nil {
+			p.logger.Warn("Received nack from rabbitmq stream publishing confirmation", zap.Error(err))
+		}
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(p.confirmationTimeout):
+		p.logger.Warn("Timeout waiting for publish confirmation", zap.Duration("timeout", p.confirmationTimeout))
+		return fmt.Errorf("timeout waiting for publish confirmation after %s", p.confirmationTimeout)
+	}
+}
+
+// handleConfirmations dispatches the confirmations of the producer to the publications waiting for them, until
+// the producer is closed.
+func (p *streamPublisher) handleConfirmations(confirmations stream.ChannelPublishConfirm) {
+	for statuses := range confirmations {
+		for _, status := range statuses {
+			var err error
+			if !status.IsConfirmed() {
+				err = fmt.Errorf("received nack from rabbitmq stream publishing confirmation: %w", status.GetError())
+			}
+			p.confirm(status.GetMessage(), err)
+		}
+	}
+}
+
+func (p *streamPublisher) confirm(streamMessage message.StreamMessage, err error) {
+	p.pendingLock.Lock()
+	defer p.pendingLock.Unlock()
+	if confirmation, ok := p.pending[streamMessage]; ok {
+		confirmation <- err
+	}
+}
+
+func (p *streamPublisher) Close() error {
+	return errors.Join(p.producer.Close(), p.closeEnvironment())
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package publisher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeStreamProducer confirms the messages it sends with confirmErr, unless noConfirm is set.
+type fakeStreamProducer struct {
+	publisher  *streamPublisher
+	sendErr    error
+	confirmErr error
+	noConfirm  bool
+	sent       []message.StreamMessage
+	closed     bool
+}
+
+func (f *fakeStreamProducer) Send(m message.StreamMessage) error {
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+	f.sent = append(f.sent, m)
+	if !f.noConfirm {
+		go f.publisher.confirm(m, f.confirmErr)
+	}
+	return nil
+}
+
+func (f *fakeStreamProducer) Close() error {
+	f.closed = true
+	return nil
+}
+
+func newTestStreamPublisher(producer *fakeStreamProducer, timeout time.Duration) *streamPublisher {
+	p := newStreamPublisher(zap.NewNop(), producer, func() error { return nil }, timeout)
+	producer.publisher = p
+	return p
+}
+
+func TestStreamPublishConfirmed(t *testing.T) {
+	producer := &fakeStreamProducer{}
+	p := newTestStreamPublisher(producer, time.Second)
+
+	err := p.Publish(t.Context(), Message{Exchange: exchange, RoutingKey: routingKey, Body: []byte("data")})
+	require.NoError(t, err)
+	require.Len(t, producer.sent, 1)
+	assert.Equal(t, [][]byte{[]byte("data")}, producer.sent[0].GetData())
+	assert.Empty(t, p.pending)
+}
+
+func TestStreamPublishNotConfirmed(t *testing.T) {
+	producer := &fakeStreamProducer{confirmErr: errors.New("stream not available")}
+	p := newTestStreamPublisher(producer, time.Second)
+
+	err := p.Publish(t.Context(), Message{Body: []byte("data")})
+	assert.EqualError(t, err, "stream not available")
+}
+
+func TestStreamPublishTimeoutBeforeConfirmation(t *testing.T) {
+	producer := &fakeStreamProducer{noConfirm: true}
+	p := newTestStreamPublisher(producer, 10*time.Millisecond)
+
+	err := p.Publish(t.Context(), Message{Body: []byte("data")})
+	assert.EqualError(t, err, "timeout waiting for publish confirmation after 10ms")
+	assert.Empty(t, p.pending)
+}
+
+func TestStreamPublishContextCanceled(t *testing.T) {
+	producer := &fakeStreamProducer{noConfirm: true}
+	p := newTestStreamPublisher(producer, time.Minute)
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	err := p.Publish(ctx, Message{Body: []byte("data")})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestStreamPublishSendError(t *testing.T) {
+	producer := &fakeStreamProducer{sendErr: errors.New("producer closed")}
+	p := newTestStreamPublisher(producer, time.Second)
+
+	err := p.Publish(t.Context(), Message{Body: []byte("data")})
+	assert.EqualError(t, err, "error publishing message\nproducer closed")
+}
+
+func TestStreamClose(t *testing.T) {
+	producer := &fakeStreamProducer{}
+	environmentClosed := false
+	p := newStreamPublisher(zap.NewNop(), producer, func() error {
+		environmentClosed = true
+		return errors.New("already closed")
+	}, time.Second)
+
+	assert.EqualError(t, p.Close(), "already closed")
+	assert.True(t, producer.closed)
+	assert.True(t, environmentClosed)
+}
@@ -68,6 +68,11 @@ func (e *rabbitmqExporter) start(ctx context.Context, host component.Host) error
 		},
 	}
 
+	if e.config.Protocol == protocolStream {
+		// The stream is named after the routing key, like the queue the default exchange routes to
+		dialConfig.Stream = e.routingKey
+	}
+
 	tlsConfig, err := e.tlsFactory(ctx)
 	if err != nil {
 		return err
@@ -45,6 +45,27 @@ func TestStartAndShutdown(t *testing.T) {
 	pub.AssertExpectations(t)
 }
 
+func TestStart_StreamProtocol(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Protocol = protocolStream
+	pub := mockPublisher{}
+	var dialConfig publisher.DialConfig
+	pubFactory := func(config publisher.DialConfig) (publisher.Publisher, error) {
+		dialConfig = config
+		return &pub, nil
+	}
+	exporter := newRabbitmqExporter(cfg, exportertest.NewNopSettings(metadata.Type).TelemetrySettings, pubFactory, newTLSFactory(cfg), routingKey, connectionName)
+
+	err := exporter.start(t.Context(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	assert.Equal(t, routingKey, dialConfig.Stream)
+
+	pub.On("Close").Return(nil)
+	err = exporter.shutdown(t.Context())
+	require.NoError(t, err)
+}
+
 func TestStart_UnknownMarshallerEncoding(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig().(*Config)
@@ -152,6 +152,10 @@ type Config struct {
 	// Whether to send histogram metrics in OTLP format to Splunk Observability.
 	// Default value is set to false.
 	SendOTLPHistograms bool `mapstructure:"send_otlp_histograms"`
+
+	// The aggregation temporality of the histograms sent in OTLP format: "cumulative" or "delta".
+	// Default value is empty, which sends the histograms with the temporality they are received with.
+	HistogramTemporality string `mapstructure:"histogram_temporality"`
 }
 
 type DimensionClientConfig struct {
@@ -241,6 +245,16 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	switch cfg.HistogramTemporality {
+	case "", histogramTemporalityCumulative, histogramTemporalityDelta:
+	default:
+		return fmt.Errorf(`"histogram_temporality" must be %q or %q, got %q`, histogramTemporalityCumulative, histogramTemporalityDelta, cfg.HistogramTemporality)
+	}
+
+	if cfg.HistogramTemporality != "" && !cfg.SendOTLPHistograms {
+		return errors.New(`"histogram_temporality" requires "send_otlp_histograms" to be enabled`)
+	}
+
 	for k, v := range cfg.DefaultProperties {
 		if v == "" {
 			return fmt.Errorf(`"default_properties" contains an empty value under key %q`, k)
@@ -474,6 +474,23 @@ func TestConfigValidateErrors(t *testing.T) {
 				SyncHostMetadata: true,
 			},
 		},
+		{
+			name: "Invalid histogram_temporality",
+			cfg: &Config{
+				Realm:                "us0",
+				AccessToken:          "access_token",
+				SendOTLPHistograms:   true,
+				HistogramTemporality: "foo",
+			},
+		},
+		{
+			name: "histogram_temporality without send_otlp_histograms",
+			cfg: &Config{
+				Realm:                "us0",
+				AccessToken:          "access_token",
+				HistogramTemporality: "delta",
+			},
+		},
 		{
 			name: "Empty default property",
 			cfg: &Config{
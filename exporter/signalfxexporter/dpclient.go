@@ -67,6 +67,7 @@ type sfxDPClient struct {
 	accessTokenPassthrough bool
 	converter              *translation.MetricsConverter
 	sendOTLPHistograms     bool
+	histogramConverter     *histogramTemporalityConverter
 }
 
 func (s *sfxDPClient) pushMetricsData(
@@ -102,12 +103,18 @@ func (s *sfxDPClient) pushMetricsData(
 	// export any histograms in otlp if sendOTLPHistograms is true
 	if s.sendOTLPHistograms {
 		histogramData, metricCount := getHistograms(md)
+		commit := func() {}
+		if metricCount > 0 && s.histogramConverter != nil {
+			commit = s.histogramConverter.convert(histogramData)
+			metricCount = histogramData.MetricCount()
+		}
 		if metricCount > 0 {
 			droppedCount, err := s.pushOTLPMetricsDataForToken(ctx, histogramData, metricToken)
 			if err != nil {
 				return droppedCount, err
 			}
 		}
+		commit()
 	}
 
 	return 0, nil
@@ -124,6 +124,7 @@ func (se *signalfxExporter) start(ctx context.Context, host component.Host) (err
 		accessTokenPassthrough: se.config.AccessTokenPassthrough,
 		converter:              se.converter,
 		sendOTLPHistograms:     se.config.SendOTLPHistograms,
+		histogramConverter:     newHistogramTemporalityConverter(se.config.HistogramTemporality),
 	}
 
 	apiTLSCfg, err := se.config.APITLSs.LoadTLSConfig(ctx)
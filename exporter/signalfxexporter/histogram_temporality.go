@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package signalfxexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter"
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+const (
+	// histogramTemporalityCumulative converts the delta histograms to cumulative histograms
+	histogramTemporalityCumulative = "cumulative"
+	// histogramTemporalityDelta converts the cumulative histograms to delta histograms
+	histogramTemporalityDelta = "delta"
+
+	// histogramStateTTL is the time after which the state of a series which isn't received anymore is dropped
+	histogramStateTTL = 5 * time.Minute
+)
+
+// histogramPoint is the state of a histogram series: the previous data point of the series, received with the
+// source temporality for the conversion to delta, or accumulated for the conversion to cumulative.
+type histogramPoint struct {
+	start        pcommon.Timestamp
+	timestamp    pcommon.Timestamp
+	count        uint64
+	sum          float64
+	hasSum       bool
+	min          float64
+	hasMin       bool
+	max          float64
+	hasMax       bool
+	bounds       []float64
+	bucketCounts []uint64
+	lastSeen     time.Time
+}
+
+// histogramTemporalityConverter converts the histograms sent in OTLP format to the configured temporality.
+// It keeps the state of each series, so it must be shared by all the exports.
+type histogramTemporalityConverter struct {
+	temporality pmetric.AggregationTemporality
+
+	lock      sync.Mutex
+	states    map[[16]byte]*histogramPoint
+	lastSweep time.Time
+}
+
+// newHistogramTemporalityConverter returns the converter to the temporality of the config, or nil if the histograms
+// are sent with the temporality they are received with.
+func newHistogramTemporalityConverter(temporality string) *histogramTemporalityConverter {
+	c := &histogramTemporalityConverter{
+		states:    map[[16]byte]*histogramPoint{},
+		lastSweep: time.Now(),
+	}
+	switch temporality {
+	case histogramTemporalityCumulative:
+		c.temporality = pmetric.AggregationTemporalityCumulative
+	case histogramTemporalityDelta:
+		c.temporality = pmetric.AggregationTemporalityDelta
+	default:
+		return nil
+	}
+	return c
+}
+
+// convert converts in place the histograms of md to the configured temporality. The state of the series isn't
+// updated until the returned function is called, once md is sent, so that a retry of the export converts the same
+// data points again rather than accumulating them twice.
+func (c *histogramTemporalityConverter) convert(md pmetric.Metrics) (commit func()) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	updates := map[[16]byte]*histogramPoint{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				if m.Type() != pmetric.MetricTypeHistogram {
+					return false
+				}
+				h := m.Histogram()
+				if h.AggregationTemporality() == c.temporality || h.AggregationTemporality() == pmetric.AggregationTemporalityUnspecified {
+					return false
+				}
+				h.DataPoints().RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+					key := pdatautil.Hash(
+						pdatautil.WithMap(rm.Resource().Attributes()),
+						pdatautil.WithString(sm.Scope().Name()),
+						pdatautil.WithString(sm.Scope().Version()),
+						pdatautil.WithString(m.Name()),
+						pdatautil.WithString(m.Unit()),
+						pdatautil.WithMap(dp.Attributes()),
+					)
+					// A series may have several data points in the same batch
+					prev, ok := updates[key]
+					if !ok {
+						prev, ok = c.states[key]
+					}
+					if c.temporality == pmetric.AggregationTemporalityDelta {
+						updates[key] = newHistogramPoint(dp, now)
+						return !toDelta(dp, prev, ok)
+					}
+					toCumulative(dp, prev, ok)
+					updates[key] = newHistogramPoint(dp, now)
+					return false
+				})
+				h.SetAggregationTemporality(c.temporality)
+				// The metrics whose data points were all dropped aren't sent
+				return h.DataPoints().Len() == 0
+			})
+		}
+	}
+
+	return func() {
+		c.commit(updates, now)
+	}
+}
+
+// commit stores the state of the series converted by a successful export.
+func (c *histogramTemporalityConverter) commit(updates map[[16]byte]*histogramPoint, now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for key, state := range updates {
+		c.states[key] = state
+	}
+
+	if now.Sub(c.lastSweep) > histogramStateTTL {
+		for key, state := range c.states {
+			if now.Sub(state.lastSeen) > histogramStateTTL {
+				delete(c.states, key)
+			}
+		}
+		c.lastSweep = now
+	}
+}
+
+// toDelta converts a cumulative data point to a delta data point, and returns false if it must be dropped because it
+// is the first data point of its series.
+func toDelta(dp pmetric.HistogramDataPoint, prev *histogramPoint, ok bool) bool {
+	if !ok {
+		// The first data point only tells the start of the series
+		return false
+	}
+
+	if dp.StartTimestamp() != prev.start || dp.Count() < prev.count ||
+		!slices.Equal(dp.ExplicitBounds().AsRaw(), prev.bounds) || dp.BucketCounts().Len() != len(prev.bucketCounts) {
+		// The series restarted: the data point is the delta since the restart
+		return true
+	}
+	for i := 0; i < dp.BucketCounts().Len(); i++ {
+		if dp.BucketCounts().At(i) < prev.bucketCounts[i] {
+			// A bucket count decreased, which only happens when the series restarted
+			return true
+		}
+	}
+
+	dp.SetStartTimestamp(prev.timestamp)
+	dp.SetCount(dp.Count() - prev.count)
+	if dp.HasSum() && prev.hasSum {
+		dp.SetSum(dp.Sum() - prev.sum)
+	} else {
+		dp.RemoveSum()
+	}
+	for i := 0; i < dp.BucketCounts().Len(); i++ {
+		dp.BucketCounts().SetAt(i, dp.BucketCounts().At(i)-prev.bucketCounts[i])
+	}
+	// The min and max of the interval can't be derived from the cumulative values
+	dp.RemoveMin()
+	dp.RemoveMax()
+	return true
+}
+
+// toCumulative converts a delta data point to a cumulative data point, by adding it to the previous data points of
+// its series.
+func toCumulative(dp pmetric.HistogramDataPoint, prev *histogramPoint, ok bool) {
+	if !ok || !slices.Equal(dp.ExplicitBounds().AsRaw(), prev.bounds) || dp.BucketCounts().Len() != len(prev.bucketCounts) {
+		return
+	}
+	dp.SetStartTimestamp(prev.start)
+	dp.SetCount(dp.Count() + prev.count)
+	if dp.HasSum() && prev.hasSum {
+		dp.SetSum(dp.Sum() + prev.sum)
+	} else {
+		dp.RemoveSum()
+	}
+	if prev.hasMin && (!dp.HasMin() || prev.min < dp.Min()) {
+		dp.SetMin(prev.min)
+	}
+	if prev.hasMax && (!dp.HasMax() || prev.max > dp.Max()) {
+		dp.SetMax(prev.max)
+	}
+	for i := 0; i < dp.BucketCounts().Len(); i++ {
+		dp.BucketCounts().SetAt(i, dp.BucketCounts().At(i)+prev.bucketCounts[i])
+	}
+}
+
+func newHistogramPoint(dp pmetric.HistogramDataPoint, now time.Time) *histogramPoint {
+	return &histogramPoint{
+		start:        dp.StartTimestamp(),
+		timestamp:    dp.Timestamp(),
+		count:        dp.Count(),
+		sum:          dp.Sum(),
+		hasSum:       dp.HasSum(),
+		min:          dp.Min(),
+		hasMin:       dp.HasMin(),
+		max:          dp.Max(),
+		hasMax:       dp.HasMax(),
+		bounds:       dp.ExplicitBounds().AsRaw(),
+		bucketCounts: dp.BucketCounts().AsRaw(),
+		lastSeen:     now,
+	}
+}
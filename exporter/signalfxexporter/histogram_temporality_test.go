@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package signalfxexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func newTemporalityTestHistogram(temporality pmetric.AggregationTemporality, start, ts pcommon.Timestamp, sum float64, buckets ...uint64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("host.name", "host-1")
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("http.server.duration")
+	h := m.SetEmptyHistogram()
+	h.SetAggregationTemporality(temporality)
+	dp := h.DataPoints().AppendEmpty()
+	dp.Attributes().PutStr("http.route", "/")
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.ExplicitBounds().FromRaw([]float64{10, 100})
+	dp.BucketCounts().FromRaw(buckets)
+	var count uint64
+	for _, b := range buckets {
+		count += b
+	}
+	dp.SetCount(count)
+	dp.SetSum(sum)
+	dp.SetMin(1)
+	dp.SetMax(sum)
+	return md
+}
+
+func TestNewHistogramTemporalityConverter(t *testing.T) {
+	assert.Nil(t, newHistogramTemporalityConverter(""))
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, newHistogramTemporalityConverter(histogramTemporalityDelta).temporality)
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, newHistogramTemporalityConverter(histogramTemporalityCumulative).temporality)
+}
+
+func TestHistogramTemporalityToDelta(t *testing.T) {
+	c := newHistogramTemporalityConverter(histogramTemporalityDelta)
+
+	// The first data point of a series is dropped
+	md := newTemporalityTestHistogram(pmetric.AggregationTemporalityCumulative, 1, 10, 50, 1, 2, 0)
+	c.convert(md)()
+	assert.Equal(t, 0, md.MetricCount())
+
+	md = newTemporalityTestHistogram(pmetric.AggregationTemporalityCumulative, 1, 20, 80, 3, 5, 1)
+	c.convert(md)()
+	require.Equal(t, 1, md.DataPointCount())
+	h := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram()
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, h.AggregationTemporality())
+	dp := h.DataPoints().At(0)
+	assert.Equal(t, pcommon.Timestamp(10), dp.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(20), dp.Timestamp())
+	assert.Equal(t, uint64(6), dp.Count())
+	assert.Equal(t, 30.0, dp.Sum())
+	assert.Equal(t, []uint64{2, 3, 1}, dp.BucketCounts().AsRaw())
+	assert.False(t, dp.HasMin())
+	assert.False(t, dp.HasMax())
+
+	// A restart of the series is sent as is
+	md = newTemporalityTestHistogram(pmetric.AggregationTemporalityCumulative, 25, 30, 5, 1, 0, 0)
+	c.convert(md)()
+	require.Equal(t, 1, md.DataPointCount())
+	dp = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram().DataPoints().At(0)
+	assert.Equal(t, pcommon.Timestamp(25), dp.StartTimestamp())
+	assert.Equal(t, uint64(1), dp.Count())
+	assert.Equal(t, []uint64{1, 0, 0}, dp.BucketCounts().AsRaw())
+}
+
+func TestHistogramTemporalityToCumulative(t *testing.T) {
+	c := newHistogramTemporalityConverter(histogramTemporalityCumulative)
+
+	md := newTemporalityTestHistogram(pmetric.AggregationTemporalityDelta, 1, 10, 50, 1, 2, 0)
+	c.convert(md)()
+	require.Equal(t, 1, md.DataPointCount())
+
+	md = newTemporalityTestHistogram(pmetric.AggregationTemporalityDelta, 10, 20, 200, 0, 1, 1)
+	c.convert(md)()
+	require.Equal(t, 1, md.DataPointCount())
+	h := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram()
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, h.AggregationTemporality())
+	dp := h.DataPoints().At(0)
+	assert.Equal(t, pcommon.Timestamp(1), dp.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(20), dp.Timestamp())
+	assert.Equal(t, uint64(5), dp.Count())
+	assert.Equal(t, 250.0, dp.Sum())
+	assert.Equal(t, []uint64{1, 3, 1}, dp.BucketCounts().AsRaw())
+	assert.Equal(t, 1.0, dp.Min())
+	assert.Equal(t, 200.0, dp.Max())
+}
+
+func TestHistogramTemporalityUnchanged(t *testing.T) {
+	c := newHistogramTemporalityConverter(histogramTemporalityDelta)
+	md := newTemporalityTestHistogram(pmetric.AggregationTemporalityDelta, 1, 10, 50, 1, 2, 0)
+	expected := pmetric.NewMetrics()
+	md.CopyTo(expected)
+	c.convert(md)()
+	assert.Equal(t, expected, md)
+	assert.Empty(t, c.states)
+}
+
+func TestHistogramTemporalityRetry(t *testing.T) {
+	c := newHistogramTemporalityConverter(histogramTemporalityCumulative)
+	c.convert(newTemporalityTestHistogram(pmetric.AggregationTemporalityDelta, 1, 10, 50, 1, 2, 0))()
+
+	// A failed export doesn't update the state, so that the retry is converted the same way
+	var dps []pmetric.HistogramDataPoint
+	for range 2 {
+		md := newTemporalityTestHistogram(pmetric.AggregationTemporalityDelta, 10, 20, 200, 0, 1, 1)
+		c.convert(md)
+		require.Equal(t, 1, md.DataPointCount())
+		dps = append(dps, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram().DataPoints().At(0))
+	}
+	assert.Equal(t, dps[0], dps[1])
+	assert.Equal(t, uint64(5), dps[1].Count())
+	assert.Equal(t, []uint64{1, 3, 1}, dps[1].BucketCounts().AsRaw())
+}
+
+func TestHistogramTemporalityBatch(t *testing.T) {
+	c := newHistogramTemporalityConverter(histogramTemporalityDelta)
+
+	// The data points of a series in the same batch are converted from each other
+	md := newTemporalityTestHistogram(pmetric.AggregationTemporalityCumulative, 1, 10, 50, 1, 2, 0)
+	newTemporalityTestHistogram(pmetric.AggregationTemporalityCumulative, 1, 20, 80, 3, 5, 1).
+		ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+	c.convert(md)()
+	require.Equal(t, 1, md.DataPointCount())
+	// The first data point of the series is dropped
+	dp := md.ResourceMetrics().At(1).ScopeMetrics().At(0).Metrics().At(0).Histogram().DataPoints().At(0)
+	assert.Equal(t, uint64(6), dp.Count())
+	assert.Equal(t, []uint64{2, 3, 1}, dp.BucketCounts().AsRaw())
+}
+
+func TestHistogramTemporalityBucketDecrease(t *testing.T) {
+	c := newHistogramTemporalityConverter(histogramTemporalityDelta)
+	c.convert(newTemporalityTestHistogram(pmetric.AggregationTemporalityCumulative, 1, 10, 50, 1, 2, 0))()
+
+	// A decreasing bucket count is a restart of the series, even if the total count didn't decrease
+	md := newTemporalityTestHistogram(pmetric.AggregationTemporalityCumulative, 1, 20, 80, 0, 4, 0)
+	c.convert(md)()
+	require.Equal(t, 1, md.DataPointCount())
+	dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram().DataPoints().At(0)
+	assert.Equal(t, pcommon.Timestamp(1), dp.StartTimestamp())
+	assert.Equal(t, uint64(4), dp.Count())
+	assert.Equal(t, []uint64{0, 4, 0}, dp.BucketCounts().AsRaw())
+}
@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -24,7 +25,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	translator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/splunk"
 )
 
@@ -54,9 +59,14 @@ type client struct {
 	hecWorker         hecWorker
 	buildInfo         component.BuildInfo
 	heartbeater       *heartbeater
+	breaker           *circuitbreaker.Breaker
 	bufferPool        bufferPool
 	exporterName      string
 	meter             metric.Meter
+	tokens            *hecTokens
+	logsRouting       *routingExpressions[*ottllog.TransformContext]
+	tracesRouting     *routingExpressions[*ottlspan.TransformContext]
+	metricsRouting    *routingExpressions[*ottlmetric.TransformContext]
 }
 
 func newClient(set exporter.Settings, cfg *Config, maxContentLength uint) *client {
@@ -68,6 +78,7 @@ func newClient(set exporter.Settings, cfg *Config, maxContentLength uint) *clien
 		bufferPool:        newBufferPool(maxContentLength, !cfg.DisableCompression),
 		exporterName:      set.ID.String(),
 		meter:             metadata.Meter(set.TelemetrySettings),
+		tokens:            newHecTokens(cfg, set.Logger),
 	}
 }
 
@@ -176,7 +187,7 @@ func (c *client) pushLogDataInBatches(ctx context.Context, ld plog.Logs, headers
 
 	for !is.done {
 		buf.Reset()
-		latestIterState, batchPermanentErrors := c.fillLogsBuffer(ld, buf, is)
+		latestIterState, batchPermanentErrors := c.fillLogsBuffer(ctx, ld, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
 			if err := c.postEvents(ctx, buf, headers); err != nil {
@@ -190,7 +201,7 @@ func (c *client) pushLogDataInBatches(ctx context.Context, ld plog.Logs, headers
 }
 
 // fillLogsBuffer fills the buffer with Splunk events until the buffer is full or all logs are processed.
-func (c *client) fillLogsBuffer(logs plog.Logs, buf buffer, is iterState) (iterState, []error) {
+func (c *client) fillLogsBuffer(ctx context.Context, logs plog.Logs, buf buffer, is iterState) (iterState, []error) {
 	var b []byte
 	var permanentErrors []error
 
@@ -212,6 +223,7 @@ func (c *client) fillLogsBuffer(logs plog.Logs, buf buffer, is iterState) (iterS
 						// TODO record this drop as a metric
 						continue
 					}
+					c.routeLogEvent(ctx, rl, sl, logRecord, event)
 
 					// JSON encoding event and writing to buffer.
 					var err error
@@ -245,7 +257,7 @@ func (c *client) fillLogsBuffer(logs plog.Logs, buf buffer, is iterState) (iterS
 	return iterState{done: true}, permanentErrors
 }
 
-func (c *client) fillMetricsBuffer(metrics pmetric.Metrics, buf buffer, is iterState) (iterState, []error) {
+func (c *client) fillMetricsBuffer(ctx context.Context, metrics pmetric.Metrics, buf buffer, is iterState) (iterState, []error) {
 	var permanentErrors []error
 
 	tempBuf := bytes.NewBuffer(make([]byte, 0, c.config.MaxContentLengthMetrics))
@@ -260,6 +272,7 @@ func (c *client) fillMetricsBuffer(metrics pmetric.Metrics, buf buffer, is iterS
 
 				// Parsing metric record to Splunk event.
 				events := translator.MetricToSplunkEvent(rm.Resource(), metric, c.logger, c.config.OtelAttrsToHec, c.config.Source, c.config.SourceType, c.config.Index)
+				c.routeMetricEvents(ctx, rm, sm, metric, events)
 				tempBuf.Reset()
 				for _, event := range events {
 					// JSON encoding event and writing to buffer.
@@ -330,7 +343,7 @@ func (c *client) fillMetricsBufferMultiMetrics(events []*translator.Event, buf b
 	return iterState{done: true}, permanentErrors
 }
 
-func (c *client) fillTracesBuffer(traces ptrace.Traces, buf buffer, is iterState) (iterState, []error) {
+func (c *client) fillTracesBuffer(ctx context.Context, traces ptrace.Traces, buf buffer, is iterState) (iterState, []error) {
 	var permanentErrors []error
 
 	for i := is.resource; i < traces.ResourceSpans().Len(); i++ {
@@ -344,6 +357,7 @@ func (c *client) fillTracesBuffer(traces ptrace.Traces, buf buffer, is iterState
 
 				// Parsing span record to Splunk event.
 				event := translator.SpanToSplunkEvent(rs.Resource(), span, c.config.OtelAttrsToHec, c.config.Source, c.config.SourceType, c.config.Index)
+				c.routeSpanEvent(ctx, rs, ss, span, event)
 
 				// JSON encoding event and writing to buffer.
 				b, err := marshalEvent(event, c.config.MaxEventSize)
@@ -393,7 +407,9 @@ func (c *client) pushMultiMetricsDataInBatches(ctx context.Context, md pmetric.M
 				metric := sm.Metrics().At(k)
 
 				// Parsing metric record to Splunk event.
-				events = append(events, translator.MetricToSplunkEvent(rm.Resource(), metric, c.logger, c.config.OtelAttrsToHec, c.config.Source, c.config.SourceType, c.config.Index)...)
+				metricEvents := translator.MetricToSplunkEvent(rm.Resource(), metric, c.logger, c.config.OtelAttrsToHec, c.config.Source, c.config.SourceType, c.config.Index)
+				c.routeMetricEvents(ctx, rm, sm, metric, metricEvents)
+				events = append(events, metricEvents...)
 			}
 		}
 	}
@@ -431,7 +447,7 @@ func (c *client) pushMetricsDataInBatches(ctx context.Context, md pmetric.Metric
 
 	for !is.done {
 		buf.Reset()
-		latestIterState, batchPermanentErrors := c.fillMetricsBuffer(md, buf, is)
+		latestIterState, batchPermanentErrors := c.fillMetricsBuffer(ctx, md, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
 			if err := c.postEvents(ctx, buf, headers); err != nil {
@@ -456,7 +472,7 @@ func (c *client) pushTracesDataInBatches(ctx context.Context, td ptrace.Traces,
 
 	for !is.done {
 		buf.Reset()
-		latestIterState, batchPermanentErrors := c.fillTracesBuffer(td, buf, is)
+		latestIterState, batchPermanentErrors := c.fillTracesBuffer(ctx, td, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
 			if err := c.postEvents(ctx, buf, headers); err != nil {
@@ -473,7 +489,53 @@ func (c *client) postEvents(ctx context.Context, buf buffer, headers map[string]
 	if err := buf.Close(); err != nil {
 		return err
 	}
-	return c.hecWorker.send(ctx, buf, headers)
+	if err := c.breaker.Allow(ctx); err != nil {
+		var openErr *circuitbreaker.OpenError
+		if errors.As(err, &openErr) {
+			return exporterhelper.NewThrottleRetry(err, openErr.RetryAfter)
+		}
+		return err
+	}
+	err := c.sendEvents(ctx, buf, headers)
+	if ctx.Err() == nil {
+		c.breaker.Record(endpointFailure(err))
+	}
+	return err
+}
+
+// endpointFailure returns the error if it shows that Splunk HEC is unhealthy, that is if the
+// request could not be sent or failed with a retryable status code.
+func endpointFailure(err error) error {
+	if consumererror.IsPermanent(err) || isForbidden(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *client) sendEvents(ctx context.Context, buf buffer, headers map[string]string) error {
+	// Tokens set per resource take precedence over the configured ones, and are not rotated.
+	if _, ok := headers["Authorization"]; ok || c.tokens == nil {
+		return c.hecWorker.send(ctx, buf, headers)
+	}
+	token := c.tokens.current()
+	err := c.hecWorker.send(ctx, buf, withAuthorization(headers, token))
+	if !isForbidden(err) {
+		return err
+	}
+	if retryToken := c.tokens.failover(token); retryToken != token {
+		return c.hecWorker.send(ctx, buf, withAuthorization(headers, retryToken))
+	}
+	return err
+}
+
+// withAuthorization returns a copy of the headers authenticating with the token.
+func withAuthorization(headers map[string]string, token string) map[string]string {
+	h := make(map[string]string, len(headers)+1)
+	for k, v := range headers {
+		h[k] = v
+	}
+	h["Authorization"] = splunk.HECTokenHeader + " " + token
+	return h
 }
 
 // subLogs returns a subset of logs starting from the state.
@@ -609,7 +671,7 @@ func (c *client) stop(context.Context) error {
 	if c.heartbeater != nil {
 		c.heartbeater.shutdown()
 	}
-	return nil
+	return c.breaker.Shutdown()
 }
 
 func (c *client) start(ctx context.Context, host component.Host) (err error) {
@@ -625,6 +687,10 @@ func (c *client) start(ctx context.Context, host component.Host) (err error) {
 			return fmt.Errorf("%s: health check failed: %w", c.exporterName, err)
 		}
 	}
+	c.breaker, err = circuitbreaker.New(c.config.CircuitBreaker, c.meter)
+	if err != nil {
+		return fmt.Errorf("%s: failed to create circuit breaker: %w", c.exporterName, err)
+	}
 	url, _ := c.config.getURL()
 	c.hecWorker = &defaultHecWorker{url, httpClient, buildHTTPHeaders(c.config, c.buildInfo), c.logger}
 	c.heartbeater = newHeartbeater(c.config, c.buildInfo, getPushLogFn(c), c.meter)
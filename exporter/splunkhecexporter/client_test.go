@@ -36,6 +36,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 )
 
@@ -978,6 +979,54 @@ func TestReceiveSpanEvent(t *testing.T) {
 	compareWithTestData(t, actual[0].body, "testdata/hec_span_event.json")
 }
 
+func TestReceiveLogEventWithRouting(t *testing.T) {
+	logs := createLogData(1, 1, 1)
+	logs.ResourceLogs().At(0).Resource().Attributes().PutStr("k8s.namespace.name", "payments")
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.DisableCompression = true
+	cfg.Routing = RoutingConfig{
+		Index:      `Concat(["k8s", resource.attributes["k8s.namespace.name"]], "_")`,
+		SourceType: `attributes["missing"]`,
+	}
+
+	actual, err := runLogExport(t, cfg, logs, 1)
+	assert.Len(t, actual, 1)
+	assert.NoError(t, err)
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal(actual[0].body, &event))
+	assert.Equal(t, "k8s_payments", event["index"])
+	assert.Equal(t, "myapp-type", event["sourcetype"], "nil values keep the sourcetype")
+	assert.Equal(t, "myapp", event["source"])
+}
+
+func TestReceiveMetricEventWithRouting(t *testing.T) {
+	metrics := createMetricsData(1, 1)
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.DisableCompression = true
+	cfg.Routing = RoutingConfig{
+		Source: `Concat(["metrics", name], ":")`,
+	}
+
+	actual, err := runMetricsExport(t, cfg, metrics, 1, false)
+	assert.Len(t, actual, 1)
+	assert.NoError(t, err)
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal(actual[0].body, &event))
+	assert.Equal(t, "metrics:gauge_double_with_dims_0", event["source"])
+}
+
+func TestCreateExporterInvalidRouting(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Endpoint = "http://localhost:8088"
+	cfg.Token = "1234-1234"
+	cfg.Routing.Index = `attributes[`
+
+	_, err := NewFactory().CreateLogs(t.Context(), exportertest.NewNopSettings(metadata.Type), cfg)
+	assert.ErrorContains(t, err, `invalid "routing::index"`)
+}
+
 // compareWithTestData compares hec output with a json file using maps instead of strings to avoid key ordering
 func compareWithTestData(t *testing.T, actual []byte, file string) {
 	wantStr, err := os.ReadFile(file)
@@ -1678,6 +1727,71 @@ func Test_pushLogData_ShouldAddResponseTo400Error(t *testing.T) {
 	assert.NotContains(t, err.Error(), responseBody)
 }
 
+func Test_pushLogData_SecondaryTokenFailover(t *testing.T) {
+	config := NewFactory().CreateDefaultConfig().(*Config)
+	config.Token = "primary"
+	config.SecondaryToken = "secondary"
+	url := &url.URL{Scheme: "http", Host: "splunk"}
+	c := newLogsClient(exportertest.NewNopSettings(metadata.Type), config)
+
+	// The primary token is rejected, then the secondary token is rejected too.
+	httpClient, headers := newTestClientWithPresetResponses([]int{403, 200, 200, 403, 200}, []string{"NOK", "OK", "OK", "NOK", "OK"})
+	c.hecWorker = &defaultHecWorker{url, httpClient, buildHTTPHeaders(config, component.NewDefaultBuildInfo()), zap.NewNop()}
+
+	require.NoError(t, c.pushLogData(t.Context(), createLogData(1, 1, 1)))
+	require.NoError(t, c.pushLogData(t.Context(), createLogData(1, 1, 1)))
+	require.NoError(t, c.pushLogData(t.Context(), createLogData(1, 1, 1)))
+
+	var tokens []string
+	for _, h := range *headers {
+		tokens = append(tokens, h.Get("Authorization"))
+	}
+	assert.Equal(t, []string{"Splunk primary", "Splunk secondary", "Splunk secondary", "Splunk secondary", "Splunk primary"}, tokens)
+
+	// Tokens set on the resource are not rotated.
+	httpClient, headers = newTestClient(403, "NOK")
+	c.hecWorker = &defaultHecWorker{url, httpClient, buildHTTPHeaders(config, component.NewDefaultBuildInfo()), zap.NewNop()}
+	logs := createLogData(1, 1, 1)
+	logs.ResourceLogs().At(0).Resource().Attributes().PutStr(splunk.HecTokenLabel, "resource-token")
+	err := c.pushLogData(t.Context(), logs)
+	assert.True(t, consumererror.IsPermanent(err))
+	require.Len(t, *headers, 1)
+	assert.Equal(t, "Splunk resource-token", (*headers)[0].Get("Authorization"))
+}
+
+func Test_pushLogData_CircuitBreaker(t *testing.T) {
+	config := NewFactory().CreateDefaultConfig().(*Config)
+	config.CircuitBreaker.Enabled = true
+	config.CircuitBreaker.FailureThreshold = 2
+	url := &url.URL{Scheme: "http", Host: "splunk"}
+	c := newLogsClient(exportertest.NewNopSettings(metadata.Type), config)
+	var err error
+	c.breaker, err = circuitbreaker.New(config.CircuitBreaker, c.meter)
+	require.NoError(t, err)
+
+	// Client errors don't open the circuit breaker.
+	httpClient, headers := newTestClient(400, "NOK")
+	c.hecWorker = &defaultHecWorker{url, httpClient, buildHTTPHeaders(config, component.NewDefaultBuildInfo()), zap.NewNop()}
+	for range 3 {
+		assert.True(t, consumererror.IsPermanent(c.pushLogData(t.Context(), createLogData(1, 1, 1))))
+	}
+	assert.Len(t, *headers, 3)
+	assert.Equal(t, circuitbreaker.StateClosed, c.breaker.State())
+
+	httpClient, headers = newTestClient(500, "NOK")
+	c.hecWorker = &defaultHecWorker{url, httpClient, buildHTTPHeaders(config, component.NewDefaultBuildInfo()), zap.NewNop()}
+	for range 3 {
+		assert.Error(t, c.pushLogData(t.Context(), createLogData(1, 1, 1)))
+	}
+	assert.Len(t, *headers, 2, "the open circuit breaker rejects the third request")
+	assert.Equal(t, circuitbreaker.StateOpen, c.breaker.State())
+
+	err = c.pushLogData(t.Context(), createLogData(1, 1, 1))
+	assert.ErrorIs(t, err, circuitbreaker.ErrOpen)
+	assert.False(t, consumererror.IsPermanent(err))
+	require.NoError(t, c.stop(t.Context()))
+}
+
 func Test_pushLogData_ShouldReturnUnsentLogsOnly(t *testing.T) {
 	config := NewFactory().CreateDefaultConfig().(*Config)
 
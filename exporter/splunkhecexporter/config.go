@@ -17,6 +17,7 @@ import (
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
 	translator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/splunk"
 )
 
@@ -82,6 +83,10 @@ type Config struct {
 	// HEC Token is the authentication token provided by Splunk: https://docs.splunk.com/Documentation/Splunk/latest/Data/UsetheHTTPEventCollector.
 	Token configopaque.String `mapstructure:"token"`
 
+	// SecondaryToken is used when Splunk rejects the token with a 403 status code, and the other way around,
+	// so that HEC tokens can be rotated without interruption.
+	SecondaryToken configopaque.String `mapstructure:"secondary_token"`
+
 	// Optional Splunk source: https://docs.splunk.com/Splexicon:Source.
 	// Sources identify the incoming data.
 	Source string `mapstructure:"source"`
@@ -92,6 +97,9 @@ type Config struct {
 	// Splunk index, optional name of the Splunk index.
 	Index string `mapstructure:"index"`
 
+	// Routing computes the index, source and source type of each event with OTTL expressions.
+	Routing RoutingConfig `mapstructure:"routing"`
+
 	// Disable GZip compression. Defaults to false.
 	DisableCompression bool `mapstructure:"disable_compression"`
 
@@ -140,6 +148,10 @@ type Config struct {
 
 	// Telemetry is the configuration for splunk hec exporter telemetry
 	Telemetry HecTelemetry `mapstructure:"telemetry"`
+
+	// CircuitBreaker stops sending requests to Splunk HEC after consecutive failed requests,
+	// until a probe request succeeds.
+	CircuitBreaker circuitbreaker.Config `mapstructure:"circuit_breaker"`
 }
 
 func (cfg *Config) Unmarshal(conf *confmap.Conf) error {
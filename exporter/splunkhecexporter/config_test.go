@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
 	translator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/splunk"
 )
 
@@ -66,10 +67,15 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "allsettings"),
 			expected: &Config{
-				Token:                   "00000000-0000-0000-0000-0000000000000",
-				Source:                  "otel",
-				SourceType:              "otel",
-				Index:                   "metrics",
+				Token:          "00000000-0000-0000-0000-0000000000000",
+				Source:         "otel",
+				SourceType:     "otel",
+				Index:          "metrics",
+				SecondaryToken: "11111111-1111-1111-1111-1111111111111",
+				Routing: RoutingConfig{
+					Index:      `resource.attributes["splunk.index"]`,
+					SourceType: `Concat(["otel", resource.attributes["service.name"]], ":")`,
+				},
 				SplunkAppName:           "OpenTelemetry-Collector Splunk Exporter",
 				SplunkAppVersion:        "v0.0.1",
 				LogDataEnabled:          true,
@@ -124,6 +130,11 @@ func TestLoadConfig(t *testing.T) {
 						"customKey": "customVal",
 					},
 				},
+				CircuitBreaker: circuitbreaker.Config{
+					Enabled:          true,
+					FailureThreshold: 3,
+					OpenTimeout:      time.Minute,
+				},
 			},
 		},
 	}
@@ -17,6 +17,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchperresourceattr"
 	translator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/splunk"
@@ -93,6 +94,7 @@ func createDefaultConfig() component.Config {
 			OverrideMetricsNames: map[string]string{},
 			ExtraAttributes:      map[string]string{},
 		},
+		CircuitBreaker: circuitbreaker.NewDefaultConfig(),
 	}
 }
 
@@ -104,6 +106,11 @@ func createTracesExporter(
 	cfg := config.(*Config)
 	showDeprecationWarnings(cfg, set.Logger)
 	c := newTracesClient(set, cfg)
+	routing, err := newTracesRouting(cfg.Routing, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	c.tracesRouting = routing
 
 	e, err := exporterhelper.NewTraces(
 		ctx,
@@ -137,6 +144,11 @@ func createMetricsExporter(
 	cfg := config.(*Config)
 	showDeprecationWarnings(cfg, set.Logger)
 	c := newMetricsClient(set, cfg)
+	routing, err := newMetricsRouting(cfg.Routing, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	c.metricsRouting = routing
 
 	e, err := exporterhelper.NewMetrics(
 		ctx,
@@ -170,6 +182,11 @@ func createLogsExporter(
 	cfg := config.(*Config)
 	showDeprecationWarnings(cfg, set.Logger)
 	c := newLogsClient(set, cfg)
+	routing, err := newLogsRouting(cfg.Routing, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	c.logsRouting = routing
 
 	logsExporter, err := exporterhelper.NewLogs(
 		ctx,
@@ -64,6 +64,9 @@ func (hec *defaultHecWorker) send(ctx context.Context, buf buffer, headers map[s
 
 	err = splunk.HandleHTTPCode(resp)
 	if err != nil {
+		if resp.StatusCode == http.StatusForbidden {
+			return &errForbidden{err: err}
+		}
 		return err
 	}
 
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	translator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/splunk"
+)
+
+// RoutingConfig defines OTTL value expressions computing the HEC metadata of each event.
+// Expressions are evaluated in the log, span or metric context, depending on the signal.
+// An expression evaluating to nil or an empty string keeps the value that would be used otherwise.
+type RoutingConfig struct {
+	// Index is the expression computing the Splunk index of the event.
+	Index string `mapstructure:"index"`
+
+	// Source is the expression computing the Splunk source of the event.
+	Source string `mapstructure:"source"`
+
+	// SourceType is the expression computing the Splunk source type of the event.
+	SourceType string `mapstructure:"sourcetype"`
+}
+
+func (cfg RoutingConfig) isEmpty() bool {
+	return cfg.Index == "" && cfg.Source == "" && cfg.SourceType == ""
+}
+
+// routingExpressions holds the parsed routing expressions for the transform context K.
+type routingExpressions[K any] struct {
+	index      *ottl.ValueExpression[K]
+	source     *ottl.ValueExpression[K]
+	sourceType *ottl.ValueExpression[K]
+	logger     *zap.Logger
+}
+
+func newRoutingExpressions[K any](cfg RoutingConfig, logger *zap.Logger, parse func(string) (*ottl.ValueExpression[K], error)) (*routingExpressions[K], error) {
+	if cfg.isEmpty() {
+		return nil, nil
+	}
+	r := &routingExpressions[K]{logger: logger}
+	for _, e := range []struct {
+		name       string
+		expression string
+		dst        **ottl.ValueExpression[K]
+	}{
+		{"index", cfg.Index, &r.index},
+		{"source", cfg.Source, &r.source},
+		{"sourcetype", cfg.SourceType, &r.sourceType},
+	} {
+		if e.expression == "" {
+			continue
+		}
+		expr, err := parse(e.expression)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid "routing::%s": %w`, e.name, err)
+		}
+		*e.dst = expr
+	}
+	return r, nil
+}
+
+func newLogsRouting(cfg RoutingConfig, set component.TelemetrySettings) (*routingExpressions[*ottllog.TransformContext], error) {
+	parser, err := ottllog.NewParser(ottlfuncs.StandardConverters[*ottllog.TransformContext](), set)
+	if err != nil {
+		return nil, err
+	}
+	return newRoutingExpressions(cfg, set.Logger, parser.ParseValueExpression)
+}
+
+func newTracesRouting(cfg RoutingConfig, set component.TelemetrySettings) (*routingExpressions[*ottlspan.TransformContext], error) {
+	parser, err := ottlspan.NewParser(ottlfuncs.StandardConverters[*ottlspan.TransformContext](), set)
+	if err != nil {
+		return nil, err
+	}
+	return newRoutingExpressions(cfg, set.Logger, parser.ParseValueExpression)
+}
+
+func newMetricsRouting(cfg RoutingConfig, set component.TelemetrySettings) (*routingExpressions[*ottlmetric.TransformContext], error) {
+	parser, err := ottlmetric.NewParser(ottlfuncs.StandardConverters[*ottlmetric.TransformContext](), set)
+	if err != nil {
+		return nil, err
+	}
+	return newRoutingExpressions(cfg, set.Logger, parser.ParseValueExpression)
+}
+
+// apply overrides the metadata of the events with the values computed for the transform context.
+// Evaluation errors are logged, and the metadata of the events are left unchanged.
+func (r *routingExpressions[K]) apply(ctx context.Context, tCtx K, events ...*translator.Event) {
+	index := r.eval(ctx, tCtx, r.index)
+	source := r.eval(ctx, tCtx, r.source)
+	sourceType := r.eval(ctx, tCtx, r.sourceType)
+	for _, event := range events {
+		if index != "" {
+			event.Index = index
+		}
+		if source != "" {
+			event.Source = source
+		}
+		if sourceType != "" {
+			event.SourceType = sourceType
+		}
+	}
+}
+
+func (r *routingExpressions[K]) eval(ctx context.Context, tCtx K, expr *ottl.ValueExpression[K]) string {
+	if expr == nil {
+		return ""
+	}
+	value, err := expr.Eval(ctx, tCtx)
+	if err != nil {
+		r.logger.Debug("Failed to evaluate the routing expression", zap.Error(err))
+		return ""
+	}
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (c *client) routeLogEvent(ctx context.Context, rl plog.ResourceLogs, sl plog.ScopeLogs, lr plog.LogRecord, event *translator.Event) {
+	if c.logsRouting == nil {
+		return
+	}
+	tCtx := ottllog.NewTransformContextPtr(rl, sl, lr)
+	defer tCtx.Close()
+	c.logsRouting.apply(ctx, tCtx, event)
+}
+
+func (c *client) routeSpanEvent(ctx context.Context, rs ptrace.ResourceSpans, ss ptrace.ScopeSpans, span ptrace.Span, event *translator.Event) {
+	if c.tracesRouting == nil {
+		return
+	}
+	tCtx := ottlspan.NewTransformContextPtr(rs, ss, span)
+	defer tCtx.Close()
+	c.tracesRouting.apply(ctx, tCtx, event)
+}
+
+func (c *client) routeMetricEvents(ctx context.Context, rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, metric pmetric.Metric, events []*translator.Event) {
+	if c.metricsRouting == nil || len(events) == 0 {
+		return
+	}
+	tCtx := ottlmetric.NewTransformContextPtr(rm, sm, metric)
+	defer tCtx.Close()
+	c.metricsRouting.apply(ctx, tCtx, events...)
+}
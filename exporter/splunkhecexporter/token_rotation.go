@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
+
+import (
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// errForbidden wraps the errors of the requests rejected by Splunk with a 403 status code,
+// which is returned when the HEC token is invalid or disabled.
+type errForbidden struct {
+	err error
+}
+
+func (e *errForbidden) Error() string {
+	return e.err.Error()
+}
+
+func (e *errForbidden) Unwrap() error {
+	return e.err
+}
+
+func isForbidden(err error) bool {
+	var forbidden *errForbidden
+	return errors.As(err, &forbidden)
+}
+
+// hecTokens holds the primary and secondary HEC tokens. Requests use the active token, and switch to
+// the other one when the active token is rejected, so that tokens can be rotated without data loss.
+type hecTokens struct {
+	mu     sync.Mutex
+	tokens [2]string
+	active int
+	logger *zap.Logger
+}
+
+// newHecTokens returns nil if no secondary token is configured.
+func newHecTokens(cfg *Config, logger *zap.Logger) *hecTokens {
+	if cfg.SecondaryToken == "" {
+		return nil
+	}
+	return &hecTokens{
+		tokens: [2]string{string(cfg.Token), string(cfg.SecondaryToken)},
+		logger: logger,
+	}
+}
+
+func (t *hecTokens) current() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tokens[t.active]
+}
+
+// failover switches to the other token if the rejected token is still the active one, and returns
+// the token to retry with.
+func (t *hecTokens) failover(rejected string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.tokens[t.active] == rejected {
+		t.active = 1 - t.active
+		if t.active == 0 {
+			t.logger.Warn("The secondary HEC token was rejected, failing over to the primary token")
+		} else {
+			t.logger.Warn("The primary HEC token was rejected, failing over to the secondary token")
+		}
+	}
+	return t.tokens[t.active]
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sumologicexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"go.opentelemetry.io/collector/config/configcompression"
+	"go.uber.org/zap"
+)
+
+// compressor compresses request bodies with the configured encoding.
+// The exporter compresses the bodies itself, instead of leaving it to the HTTP client,
+// so that it can measure the compression ratio and fall back to gzip when zstd
+// is not accepted by the receiver.
+type compressor struct {
+	mu       sync.RWMutex
+	encoding configcompression.Type
+	level    configcompression.Level
+	logger   *zap.Logger
+}
+
+func newCompressor(encoding configcompression.Type, level configcompression.Level, logger *zap.Logger) *compressor {
+	return &compressor{
+		encoding: encoding,
+		level:    level,
+		logger:   logger,
+	}
+}
+
+// current returns the encoding to use for the next request.
+func (c *compressor) current() configcompression.Type {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.encoding
+}
+
+// fallback switches from zstd to gzip after the receiver rejected a zstd encoded request.
+// It returns true if the request should be retried with the new encoding.
+func (c *compressor) fallback(rejected configcompression.Type) bool {
+	if rejected != configcompression.TypeZstd {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.encoding == configcompression.TypeZstd {
+		c.logger.Warn("The receiver does not accept zstd encoded requests, falling back to gzip")
+		c.encoding = configcompression.TypeGzip
+	}
+	return true
+}
+
+// compress returns the data compressed with the given encoding.
+func (c *compressor) compress(encoding configcompression.Type, data []byte) ([]byte, error) {
+	switch encoding {
+	case NoCompression:
+		return data, nil
+	case configcompression.TypeZstd:
+		opts := []zstd.EOption{zstd.WithEncoderConcurrency(1)}
+		if c.level != 0 {
+			opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(int(c.level))))
+		}
+		enc, err := zstd.NewWriter(nil, opts...)
+		if err != nil {
+			return nil, err
+		}
+		defer enc.Close()
+		return enc.EncodeAll(data, make([]byte, 0, len(data)/2)), nil
+	}
+
+	var (
+		buf = bytes.NewBuffer(make([]byte, 0, len(data)/2))
+		w   io.WriteCloser
+		err error
+	)
+	switch encoding {
+	case configcompression.TypeGzip:
+		w, err = gzip.NewWriterLevel(buf, c.writerLevel())
+	case configcompression.TypeDeflate:
+		w, err = zlib.NewWriterLevel(buf, c.writerLevel())
+	default:
+		return nil, fmt.Errorf("unsupported compression encoding: %v", encoding)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(data); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *compressor) writerLevel() int {
+	if c.level == 0 {
+		return gzip.DefaultCompression
+	}
+	return int(c.level)
+}
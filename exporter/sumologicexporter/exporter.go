@@ -244,6 +244,9 @@ func (se *sumologicexporter) configure(ctx context.Context) error {
 		return errors.New("no auth extension and no endpoint specified")
 	}
 
+	// Request bodies are compressed by the sender.
+	httpSettings.Compression = NoCompression
+
 	client, err := httpSettings.ToClient(ctx, se.host.GetExtensions(), componenttest.NewNopTelemetrySettings())
 	if err != nil {
 		return fmt.Errorf("failed to create HTTP Client: %w", err)
@@ -304,9 +307,14 @@ func (*sumologicexporter) shutdown(context.Context) error {
 func (se *sumologicexporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
 	// Follow different execution path for OTLP format
 	if se.sender.config.LogFormat == OTLPLogFormat {
-		if err := se.sender.sendOTLPLogs(ctx, ld); err != nil {
+		failed, err := se.sender.sendOTLPLogs(ctx, ld)
+		if err != nil {
 			se.handleUnauthorizedErrors(ctx, err)
-			return consumererror.NewLogs(err, ld)
+			if consumererror.IsPermanent(err) {
+				return err
+			}
+			// Only the logs which failed to be sent are retried.
+			return consumererror.NewLogs(err, failed)
 		}
 		return nil
 	}
@@ -22,13 +22,14 @@ func Tracer(settings component.TelemetrySettings) trace.Tracer {
 // TelemetryBuilder provides an interface for components to report telemetry
 // as defined in metadata and user config.
 type TelemetryBuilder struct {
-	meter                    metric.Meter
-	mu                       sync.Mutex
-	registrations            []metric.Registration
-	ExporterRequestsBytes    metric.Int64Counter
-	ExporterRequestsDuration metric.Int64Counter
-	ExporterRequestsRecords  metric.Int64Counter
-	ExporterRequestsSent     metric.Int64Counter
+	meter                            metric.Meter
+	mu                               sync.Mutex
+	registrations                    []metric.Registration
+	ExporterRequestsBytes            metric.Int64Counter
+	ExporterRequestsCompressionRatio metric.Float64Histogram
+	ExporterRequestsDuration         metric.Int64Counter
+	ExporterRequestsRecords          metric.Int64Counter
+	ExporterRequestsSent             metric.Int64Counter
 }
 
 // TelemetryBuilderOption applies changes to default builder.
@@ -66,6 +67,13 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 		metric.WithUnit("By"),
 	)
 	errs = errors.Join(errs, err)
+	builder.ExporterRequestsCompressionRatio, err = builder.meter.Float64Histogram(
+		"otelcol_exporter_requests_compression_ratio",
+		metric.WithDescription("Ratio between the size of request bodies before and after compression [Development]"),
+		metric.WithUnit("1"),
+		metric.WithExplicitBucketBoundaries([]float64{1, 1.5, 2, 3, 4, 5, 7.5, 10, 15, 20, 30, 50}...),
+	)
+	errs = errors.Join(errs, err)
 	builder.ExporterRequestsDuration, err = builder.meter.Int64Counter(
 		"otelcol_exporter_requests_duration",
 		metric.WithDescription("Duration of HTTP requests (in milliseconds) [Development]"),
@@ -20,12 +20,16 @@ func TestSetupTelemetry(t *testing.T) {
 	require.NoError(t, err)
 	defer tb.Shutdown()
 	tb.ExporterRequestsBytes.Add(context.Background(), 1)
+	tb.ExporterRequestsCompressionRatio.Record(context.Background(), 1)
 	tb.ExporterRequestsDuration.Add(context.Background(), 1)
 	tb.ExporterRequestsRecords.Add(context.Background(), 1)
 	tb.ExporterRequestsSent.Add(context.Background(), 1)
 	AssertEqualExporterRequestsBytes(t, testTel,
 		[]metricdata.DataPoint[int64]{{Value: 1}},
 		metricdatatest.IgnoreTimestamp())
+	AssertEqualExporterRequestsCompressionRatio(t, testTel,
+		[]metricdata.HistogramDataPoint[float64]{{}}, metricdatatest.IgnoreValue(),
+		metricdatatest.IgnoreTimestamp())
 	AssertEqualExporterRequestsDuration(t, testTel,
 		[]metricdata.DataPoint[int64]{{Value: 1}},
 		metricdatatest.IgnoreTimestamp())
@@ -13,9 +13,11 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -125,6 +127,10 @@ type sender struct {
 	setStickySessionCookieFunc func(string)
 	id                         component.ID
 	telemetryBuilder           *metadata.TelemetryBuilder
+	compressor                 *compressor
+	// loweredMaxRequestBodySize is set when the receiver rejects requests as too large.
+	loweredMaxRequestBodySize   int
+	loweredMaxRequestBodySizeMu sync.Mutex
 }
 
 const (
@@ -170,17 +176,81 @@ func newSender(
 		setStickySessionCookieFunc: setStickySessionCookieFunc,
 		id:                         id,
 		telemetryBuilder:           telemetryBuilder,
+		compressor:                 newCompressor(cfg.Compression, cfg.CompressionParams.Level, logger),
 	}
 }
 
-var errUnauthorized = errors.New("unauthorized")
+var (
+	errUnauthorized          = errors.New("unauthorized")
+	errRequestEntityTooLarge = errors.New("request entity too large")
+	errUnsupportedMediaType  = errors.New("unsupported media type")
+)
 
-// send sends data to sumologic
+// send sends data to sumologic. Line based data rejected by the receiver as too large
+// is split in two halves which are sent separately.
 func (s *sender) send(ctx context.Context, pipeline PipelineType, reader *countingReader, flds fields) error {
-	req, err := s.createRequest(ctx, pipeline, reader.reader)
+	data, err := io.ReadAll(reader.reader)
 	if err != nil {
 		return err
 	}
+	return s.sendWithSplit(ctx, pipeline, data, reader.counter, flds)
+}
+
+func (s *sender) sendWithSplit(ctx context.Context, pipeline PipelineType, data []byte, count int64, flds fields) error {
+	err := s.sendData(ctx, pipeline, data, count, flds)
+	if !errors.Is(err, errRequestEntityTooLarge) || !s.isLineBased(pipeline) {
+		return err
+	}
+
+	s.lowerMaxRequestBodySize(len(data) / 2)
+
+	// Split on the first newline after the middle of the data, or on the last one before it.
+	i := bytes.IndexByte(data[len(data)/2:], '\n')
+	if i >= 0 {
+		i += len(data) / 2
+	} else {
+		i = bytes.LastIndexByte(data, '\n')
+	}
+	if i < 0 {
+		// A single line can't be split any further.
+		return consumererror.NewPermanent(err)
+	}
+
+	left, right := data[:i], data[i+1:]
+	leftCount := count * int64(bytes.Count(left, []byte{'\n'})+1) / int64(bytes.Count(data, []byte{'\n'})+1)
+	return errors.Join(
+		s.sendWithSplit(ctx, pipeline, left, leftCount, flds),
+		s.sendWithSplit(ctx, pipeline, right, count-leftCount, flds),
+	)
+}
+
+// sendData compresses and sends the data, falling back to another compression
+// encoding if the receiver doesn't accept the current one.
+func (s *sender) sendData(ctx context.Context, pipeline PipelineType, data []byte, count int64, flds fields) error {
+	encoding := s.compressor.current()
+	err := s.sendCompressed(ctx, pipeline, data, count, flds, encoding)
+	if errors.Is(err, errUnsupportedMediaType) && s.compressor.fallback(encoding) {
+		err = s.sendCompressed(ctx, pipeline, data, count, flds, s.compressor.current())
+	}
+	return err
+}
+
+func (s *sender) sendCompressed(ctx context.Context, pipeline PipelineType, data []byte, count int64, flds fields, encoding configcompression.Type) error {
+	body, err := s.compressor.compress(encoding, data)
+	if err != nil {
+		return err
+	}
+	if encoding != NoCompression {
+		s.recordCompressionRatio(len(data), len(body), pipeline, encoding)
+	}
+
+	req, err := s.createRequest(ctx, pipeline, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if encoding != NoCompression {
+		req.Header.Set("Content-Encoding", string(encoding))
+	}
 
 	err = s.addRequestHeaders(req, pipeline, flds)
 	if err != nil {
@@ -199,12 +269,12 @@ func (s *sender) send(ctx context.Context, pipeline PipelineType, reader *counti
 	start := time.Now()
 	resp, err := s.client.Do(req)
 	if err != nil {
-		s.recordMetrics(time.Since(start), reader.counter, req, nil, pipeline)
+		s.recordMetrics(time.Since(start), count, int64(len(data)), req, nil, pipeline)
 		return err
 	}
 	defer resp.Body.Close()
 
-	s.recordMetrics(time.Since(start), reader.counter, req, resp, pipeline)
+	s.recordMetrics(time.Since(start), count, int64(len(data)), req, resp, pipeline)
 
 	return s.handleReceiverResponse(resp)
 }
@@ -305,9 +375,14 @@ func (s *sender) handleReceiverResponse(resp *http.Response) error {
 
 		err := fmt.Errorf("failed sending data: %s", strings.Join(errMsgs, ", "))
 
-		if resp.StatusCode == http.StatusBadRequest {
+		switch resp.StatusCode {
+		case http.StatusBadRequest:
 			// Report the failure as permanent if the server thinks the request is malformed.
 			return consumererror.NewPermanent(err)
+		case http.StatusRequestEntityTooLarge:
+			return fmt.Errorf("%w: %w", errRequestEntityTooLarge, err)
+		case http.StatusUnsupportedMediaType:
+			return fmt.Errorf("%w: %w", errUnsupportedMediaType, err)
 		}
 
 		return err
@@ -447,14 +522,74 @@ func (s *sender) formatLogLine(lr plog.LogRecord) (string, error) {
 	return formattedLine, err
 }
 
-// TODO: add support for HTTP limits
-func (s *sender) sendOTLPLogs(ctx context.Context, ld plog.Logs) error {
+// sendOTLPLogs sends logs in OTLP format. Logs rejected by the receiver as too large
+// are split in two halves which are sent separately. It returns the logs which failed
+// to be sent and can be retried, the logs rejected permanently being dropped.
+func (s *sender) sendOTLPLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
 	body, err := logsMarshaler.MarshalLogs(ld)
 	if err != nil {
-		return err
+		return plog.NewLogs(), consumererror.NewPermanent(err)
+	}
+
+	err = s.send(ctx, LogsPipeline, newCountingReader(ld.LogRecordCount()).withBytes(body), fields{})
+	switch {
+	case err == nil:
+		return plog.NewLogs(), nil
+	case errors.Is(err, errRequestEntityTooLarge) && ld.LogRecordCount() < 2:
+		return plog.NewLogs(), consumererror.NewPermanent(err)
+	case errors.Is(err, errRequestEntityTooLarge):
+	case consumererror.IsPermanent(err):
+		return plog.NewLogs(), err
+	default:
+		return ld, err
 	}
 
-	return s.send(ctx, LogsPipeline, newCountingReader(ld.LogRecordCount()).withBytes(body), fields{})
+	left, right := splitLogs(ld)
+	failedLeft, errLeft := s.sendOTLPLogs(ctx, left)
+	failedRight, errRight := s.sendOTLPLogs(ctx, right)
+	failed := plog.NewLogs()
+	failedLeft.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+	failedRight.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+	if failed.LogRecordCount() == 0 {
+		return failed, errors.Join(errLeft, errRight)
+	}
+
+	// Only the errors of the logs to retry are returned, so that the whole error isn't permanent.
+	var errs []error
+	for _, e := range []error{errLeft, errRight} {
+		if consumererror.IsPermanent(e) {
+			s.logger.Warn("Dropping logs rejected permanently", zap.Error(e))
+			continue
+		}
+		errs = append(errs, e)
+	}
+	return failed, errors.Join(errs...)
+}
+
+// splitLogs splits the log records in two halves, keeping their resources and scopes.
+func splitLogs(ld plog.Logs) (plog.Logs, plog.Logs) {
+	half := ld.LogRecordCount() / 2
+	left, right := plog.NewLogs(), plog.NewLogs()
+	ld.CopyTo(left)
+	ld.CopyTo(right)
+	removeLogRecords(left, func(i int) bool { return i >= half })
+	removeLogRecords(right, func(i int) bool { return i < half })
+	return left, right
+}
+
+// removeLogRecords removes the log records whose index in ld matches f, along with the emptied scopes and resources.
+func removeLogRecords(ld plog.Logs, f func(int) bool) {
+	i := 0
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			sl.LogRecords().RemoveIf(func(plog.LogRecord) bool {
+				i++
+				return f(i - 1)
+			})
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
 }
 
 // sendNonOTLPMetrics sends metrics in right format basing on the s.config.MetricFormat
@@ -572,7 +707,8 @@ func (s *sender) sendOTLPMetrics(ctx context.Context, md pmetric.Metrics) error
 }
 
 // appendAndMaybeSend appends line to the request body that will be sent and sends
-// the accumulated data if the internal logBuffer has been filled (with config.MaxRequestBodySize bytes).
+// the accumulated data if the internal logBuffer has been filled (with config.MaxRequestBodySize bytes,
+// or less if the receiver rejected bigger requests).
 // It returns a boolean indicating if the data was sent and an error
 func (s *sender) appendAndMaybeSend(
 	ctx context.Context,
@@ -586,7 +722,7 @@ func (s *sender) appendAndMaybeSend(
 		linesTotalLength += len(line) + 1 // count the newline as well
 	}
 
-	if body.Len() > 0 && body.Len()+linesTotalLength >= s.config.MaxRequestBodySize {
+	if body.Len() > 0 && body.Len()+linesTotalLength >= s.maxRequestBodySize() {
 		sent = true
 		err = s.send(ctx, pipeline, body.toCountingReader(), flds)
 		body.Reset()
@@ -704,7 +840,61 @@ func (s *sender) addRequestHeaders(req *http.Request, pipeline PipelineType, fld
 	return nil
 }
 
-func (s *sender) recordMetrics(duration time.Duration, count int64, req *http.Request, resp *http.Response, pipeline PipelineType) {
+// isLineBased returns true if the data of the pipeline is sent as lines, which can be split between requests.
+func (s *sender) isLineBased(pipeline PipelineType) bool {
+	switch pipeline {
+	case LogsPipeline:
+		return s.config.LogFormat != OTLPLogFormat
+	case MetricsPipeline:
+		return s.config.MetricFormat == PrometheusFormat
+	default:
+		return false
+	}
+}
+
+// maxRequestBodySize returns the size above which line based data is split between requests.
+func (s *sender) maxRequestBodySize() int {
+	s.loweredMaxRequestBodySizeMu.Lock()
+	defer s.loweredMaxRequestBodySizeMu.Unlock()
+	return s.maxRequestBodySizeLocked()
+}
+
+func (s *sender) maxRequestBodySizeLocked() int {
+	if s.loweredMaxRequestBodySize > 0 && s.loweredMaxRequestBodySize < s.config.MaxRequestBodySize {
+		return s.loweredMaxRequestBodySize
+	}
+	return s.config.MaxRequestBodySize
+}
+
+// lowerMaxRequestBodySize lowers the size above which line based data is split between requests.
+func (s *sender) lowerMaxRequestBodySize(size int) {
+	s.loweredMaxRequestBodySizeMu.Lock()
+	defer s.loweredMaxRequestBodySizeMu.Unlock()
+
+	current := s.maxRequestBodySizeLocked()
+	if size <= 0 || size >= current {
+		return
+	}
+	s.loweredMaxRequestBodySize = size
+	s.logger.Warn("The receiver rejected a request as too large, lowering the maximum request body size",
+		zap.Int("previous_size", current),
+		zap.Int("size", size),
+	)
+}
+
+func (s *sender) recordCompressionRatio(size, compressedSize int, pipeline PipelineType, encoding configcompression.Type) {
+	if compressedSize == 0 {
+		return
+	}
+	attrs := attribute.NewSet(
+		attribute.String("compression", string(encoding)),
+		attribute.String("pipeline", string(pipeline)),
+		attribute.String("exporter", s.id.String()),
+	)
+	s.telemetryBuilder.ExporterRequestsCompressionRatio.Record(context.Background(), float64(size)/float64(compressedSize), metric.WithAttributeSet(attrs))
+}
+
+func (s *sender) recordMetrics(duration time.Duration, count, size int64, req *http.Request, resp *http.Response, pipeline PipelineType) {
 	statusCode := 0
 
 	if resp != nil {
@@ -720,7 +910,7 @@ func (s *sender) recordMetrics(duration time.Duration, count int64, req *http.Re
 		attribute.String("exporter", id),
 	)
 	s.telemetryBuilder.ExporterRequestsDuration.Add(context.Background(), duration.Milliseconds(), metric.WithAttributeSet(attrs))
-	s.telemetryBuilder.ExporterRequestsBytes.Add(context.Background(), req.ContentLength, metric.WithAttributeSet(attrs))
+	s.telemetryBuilder.ExporterRequestsBytes.Add(context.Background(), size, metric.WithAttributeSet(attrs))
 	s.telemetryBuilder.ExporterRequestsRecords.Add(context.Background(), count, metric.WithAttributeSet(attrs))
 	s.telemetryBuilder.ExporterRequestsSent.Add(context.Background(), 1, metric.WithAttributeSet(attrs))
 }
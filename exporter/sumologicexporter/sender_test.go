@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"compress/zlib"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -29,6 +30,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
@@ -76,6 +78,7 @@ func prepareSenderTest(t *testing.T, compression configcompression.Type, cb []fu
 	}
 	cfg.Auth = configoptional.None[configauth.Config]()
 	httpSettings := cfg.ClientConfig
+	httpSettings.Compression = NoCompression
 	host := componenttest.NewNopHost()
 	client, err := httpSettings.ToClient(t.Context(), host.GetExtensions(), componenttest.NewNopTelemetrySettings())
 	require.NoError(t, err)
@@ -843,7 +846,8 @@ func TestSendLogsOTLP(t *testing.T) {
 	test.s.config.LogFormat = "otlp"
 
 	l.MarkReadOnly()
-	assert.NoError(t, test.s.sendOTLPLogs(t.Context(), l))
+	_, err := test.s.sendOTLPLogs(t.Context(), l)
+	assert.NoError(t, err)
 	assert.EqualValues(t, 1, *test.reqCounter)
 }
 
@@ -1069,6 +1073,199 @@ func TestSendCompressDeflate(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSendCompressZstdFallback(t *testing.T) {
+	test := prepareSenderTest(t, configcompression.TypeZstd, []func(res http.ResponseWriter, req *http.Request){
+		func(res http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "zstd", req.Header.Get("Content-Encoding"))
+			res.WriteHeader(http.StatusUnsupportedMediaType)
+		},
+		func(_ http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"))
+			assert.Equal(t, "Some example log", decodeGzip(t, req.Body))
+		},
+		func(_ http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"), "the fallback encoding is kept")
+			assert.Equal(t, "Another example log", decodeGzip(t, req.Body))
+		},
+	})
+
+	require.NoError(t, test.s.send(t.Context(), LogsPipeline, newCountingReader(1).withString("Some example log"), fields{}))
+	require.NoError(t, test.s.send(t.Context(), LogsPipeline, newCountingReader(1).withString("Another example log"), fields{}))
+	assert.EqualValues(t, 3, *test.reqCounter)
+}
+
+func TestSendCompressionRatio(t *testing.T) {
+	test := prepareSenderTest(t, configcompression.TypeGzip, []func(res http.ResponseWriter, req *http.Request){
+		func(http.ResponseWriter, *http.Request) {},
+	})
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(tel.NewTelemetrySettings())
+	require.NoError(t, err)
+	test.s.telemetryBuilder = telemetryBuilder
+
+	data := strings.Repeat("Some example log\n", 1000)
+	require.NoError(t, test.s.send(t.Context(), LogsPipeline, newCountingReader(1000).withString(data), fields{}))
+
+	got, err := tel.GetMetric("otelcol_exporter_requests_compression_ratio")
+	require.NoError(t, err)
+	dps := got.Data.(metricdata.Histogram[float64]).DataPoints
+	require.Len(t, dps, 1)
+	assert.Equal(t, uint64(1), dps[0].Count)
+	assert.Greater(t, dps[0].Sum, float64(10))
+	compression, _ := dps[0].Attributes.Value("compression")
+	assert.Equal(t, "gzip", compression.AsString())
+}
+
+func TestSendLogsSplitOnRequestEntityTooLarge(t *testing.T) {
+	test := prepareSenderTest(t, NoCompression, []func(w http.ResponseWriter, req *http.Request){
+		func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "Log 1\nLog 2\nLog 3\nLog 4", extractBody(t, req))
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		},
+		func(_ http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "Log 1\nLog 2", extractBody(t, req))
+		},
+		func(_ http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "Log 3\nLog 4", extractBody(t, req))
+		},
+		func(_ http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "Log 5", extractBody(t, req), "the lowered size is used for the next requests")
+		},
+		func(_ http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "Log 6", extractBody(t, req))
+		},
+	})
+
+	rls := plog.NewResourceLogs()
+	logRecords := rls.ScopeLogs().AppendEmpty().LogRecords()
+	for i := 1; i <= 4; i++ {
+		logRecords.AppendEmpty().Body().SetStr(fmt.Sprintf("Log %d", i))
+	}
+	_, err := test.s.sendNonOTLPLogs(t.Context(), rls, fields{})
+	require.NoError(t, err)
+	assert.Equal(t, 11, test.s.maxRequestBodySize())
+
+	rls = plog.NewResourceLogs()
+	logRecords = rls.ScopeLogs().AppendEmpty().LogRecords()
+	for i := 5; i <= 6; i++ {
+		logRecords.AppendEmpty().Body().SetStr(fmt.Sprintf("Log %d", i))
+	}
+	_, err = test.s.sendNonOTLPLogs(t.Context(), rls, fields{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, *test.reqCounter)
+}
+
+func TestSendLogsRequestEntityTooLargeSingleLine(t *testing.T) {
+	test := prepareSenderTest(t, NoCompression, []func(w http.ResponseWriter, req *http.Request){
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		},
+	})
+
+	rls := plog.NewResourceLogs()
+	rls.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("Example log")
+	dropped, err := test.s.sendNonOTLPLogs(t.Context(), rls, fields{})
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.Len(t, dropped, 1)
+}
+
+func TestSendOTLPLogsSplitOnRequestEntityTooLarge(t *testing.T) {
+	l := plog.NewLogs()
+	rl := l.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("host", "a")
+	for i := 1; i <= 3; i++ {
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(fmt.Sprintf("Log %d", i))
+	}
+
+	var bodies [][]string
+	handler := func(_ http.ResponseWriter, req *http.Request) {
+		ld, err := (&plog.ProtoUnmarshaler{}).UnmarshalLogs([]byte(extractBody(t, req)))
+		assert.NoError(t, err)
+		var records []string
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			host, _ := ld.ResourceLogs().At(i).Resource().Attributes().Get("host")
+			assert.Equal(t, "a", host.Str())
+			sls := ld.ResourceLogs().At(i).ScopeLogs()
+			for j := 0; j < sls.Len(); j++ {
+				records = append(records, sls.At(j).LogRecords().At(0).Body().Str())
+			}
+		}
+		bodies = append(bodies, records)
+	}
+	test := prepareSenderTest(t, NoCompression, []func(w http.ResponseWriter, req *http.Request){
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		},
+		handler,
+		handler,
+	})
+	test.s.config.LogFormat = OTLPLogFormat
+
+	l.MarkReadOnly()
+	failed, err := test.s.sendOTLPLogs(t.Context(), l)
+	require.NoError(t, err)
+	assert.Equal(t, 0, failed.LogRecordCount())
+	assert.Equal(t, [][]string{{"Log 1"}, {"Log 2", "Log 3"}}, bodies)
+}
+
+func TestSendOTLPLogsSplitPartialFailure(t *testing.T) {
+	l := plog.NewLogs()
+	sl := l.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	for i := 1; i <= 4; i++ {
+		sl.LogRecords().AppendEmpty().Body().SetStr(fmt.Sprintf("Log %d", i))
+	}
+
+	test := prepareSenderTest(t, NoCompression, []func(w http.ResponseWriter, req *http.Request){
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		},
+		func(_ http.ResponseWriter, _ *http.Request) {},
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		},
+	})
+	test.s.config.LogFormat = OTLPLogFormat
+
+	l.MarkReadOnly()
+	failed, err := test.s.sendOTLPLogs(t.Context(), l)
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+	records := failed.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, failed.LogRecordCount())
+	assert.Equal(t, "Log 3", records.At(0).Body().Str())
+	assert.Equal(t, "Log 4", records.At(1).Body().Str())
+}
+
+func TestSendOTLPLogsSplitPermanentFailure(t *testing.T) {
+	l := plog.NewLogs()
+	sl := l.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	for i := 1; i <= 3; i++ {
+		sl.LogRecords().AppendEmpty().Body().SetStr(fmt.Sprintf("Log %d", i))
+	}
+
+	test := prepareSenderTest(t, NoCompression, []func(w http.ResponseWriter, req *http.Request){
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		},
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		},
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		},
+	})
+	test.s.config.LogFormat = OTLPLogFormat
+
+	// the record too large is dropped, only the other records are retried
+	l.MarkReadOnly()
+	failed, err := test.s.sendOTLPLogs(t.Context(), l)
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+	require.Equal(t, 2, failed.LogRecordCount())
+	assert.Equal(t, "Log 2", failed.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
+
 func TestSendMetrics(t *testing.T) {
 	test := prepareSenderTest(t, NoCompression, []func(w http.ResponseWriter, req *http.Request){
 		func(_ http.ResponseWriter, req *http.Request) {
@@ -38,6 +38,9 @@ type Config struct {
 	// Whether or not to enable RFC 6587 Octet Counting.
 	EnableOctetCounting bool `mapstructure:"enable_octet_counting"`
 
+	// Priority configures the priority of the messages without the priority attribute.
+	Priority PriorityConfig `mapstructure:"priority"`
+
 	// TLS struct exposes TLS client configuration.
 	TLS configtls.ClientConfig `mapstructure:"tls"`
 
@@ -35,11 +35,16 @@ func initExporter(cfg *Config, createSettings exporter.Settings) (*syslogexporte
 		}
 	}
 
+	priorities, err := newPriorityMapper(cfg.Priority)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &syslogexporter{
 		config:    cfg,
 		logger:    createSettings.Logger,
 		tlsConfig: loadedTLSConfig,
-		formatter: createFormatter(cfg.Protocol, cfg.EnableOctetCounting),
+		formatter: createFormatter(cfg.Protocol, cfg.EnableOctetCounting, priorities),
 	}
 
 	s.logger.Info("Syslog Exporter configured",
@@ -29,9 +29,13 @@ func createDefaultConfig() component.Config {
 	qs := configoptional.Default(exporterhelper.NewDefaultQueueConfig())
 
 	return &Config{
-		Port:            DefaultPort,
-		Network:         DefaultNetwork,
-		Protocol:        DefaultProtocol,
+		Port:     DefaultPort,
+		Network:  DefaultNetwork,
+		Protocol: DefaultProtocol,
+		Priority: PriorityConfig{
+			DefaultFacility: defaultFacility,
+			DefaultSeverity: defaultSeverity,
+		},
 		BackOffConfig:   configretry.NewDefaultBackOffConfig(),
 		QueueSettings:   qs,
 		TimeoutSettings: exporterhelper.NewDefaultTimeoutConfig(),
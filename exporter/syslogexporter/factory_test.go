@@ -29,6 +29,10 @@ func TestCreateDefaultConfig(t *testing.T) {
 		Port:     514,
 		Network:  "tcp",
 		Protocol: "rfc5424",
+		Priority: PriorityConfig{
+			DefaultFacility: "local4",
+			DefaultSeverity: "notice",
+		},
 		QueueSettings: configoptional.Default(func() exporterhelper.QueueBatchConfig {
 			queue := exporterhelper.NewDefaultQueueConfig()
 			queue.NumConsumers = 10
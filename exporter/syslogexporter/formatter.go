@@ -7,11 +7,11 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 )
 
-func createFormatter(protocol string, octetCounting bool) formatter {
+func createFormatter(protocol string, octetCounting bool, priorities *priorityMapper) formatter {
 	if protocol == protocolRFC5424Str {
-		return newRFC5424Formatter(octetCounting)
+		return newRFC5424Formatter(octetCounting, priorities)
 	}
-	return newRFC3164Formatter()
+	return newRFC3164Formatter(priorities)
 }
 
 type formatter interface {
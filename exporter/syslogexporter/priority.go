@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	defaultFacility = "local4"
+	defaultSeverity = "notice"
+)
+
+// facilities are the facility keywords of RFC5424, indexed by their code.
+var facilities = []string{
+	"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news",
+	"uucp", "cron", "authpriv", "ftp", "ntp", "security", "console", "solaris-cron",
+	"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+}
+
+// severities are the severity keywords of RFC5424, indexed by their code.
+var severities = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
+// PriorityConfig configures the priority of the syslog messages created from the log records
+// without the priority attribute.
+type PriorityConfig struct {
+	// FacilityAttribute is the log record attribute holding the facility of the message,
+	// as a code between 0 and 23 or as a keyword such as local4.
+	FacilityAttribute string `mapstructure:"facility_attribute"`
+	// SeverityAttribute is the log record attribute holding the severity of the message,
+	// as a code between 0 and 7 or as a keyword such as err.
+	SeverityAttribute string `mapstructure:"severity_attribute"`
+	// MapSeverityNumber maps the severity number of the log records without the severity
+	// attribute to the severity of the message.
+	MapSeverityNumber bool `mapstructure:"map_severity_number"`
+	// DefaultFacility is the facility of the messages without facility attribute. Defaults to local4.
+	DefaultFacility string `mapstructure:"default_facility"`
+	// DefaultSeverity is the severity of the messages without severity. Defaults to notice.
+	DefaultSeverity string `mapstructure:"default_severity"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+func (cfg *PriorityConfig) Validate() error {
+	_, err := newPriorityMapper(*cfg)
+	return err
+}
+
+// priorityMapper computes the priority of the syslog messages.
+type priorityMapper struct {
+	facilityAttribute string
+	severityAttribute string
+	mapSeverityNumber bool
+	facility          int
+	severity          int
+}
+
+func newPriorityMapper(cfg PriorityConfig) (*priorityMapper, error) {
+	m := &priorityMapper{
+		facilityAttribute: cfg.FacilityAttribute,
+		severityAttribute: cfg.SeverityAttribute,
+		mapSeverityNumber: cfg.MapSeverityNumber,
+	}
+	var ok bool
+	if cfg.DefaultFacility == "" {
+		cfg.DefaultFacility = defaultFacility
+	}
+	if m.facility, ok = parseCode(pcommon.NewValueStr(cfg.DefaultFacility), facilities); !ok {
+		return nil, fmt.Errorf("invalid `default_facility` %q", cfg.DefaultFacility)
+	}
+	if cfg.DefaultSeverity == "" {
+		cfg.DefaultSeverity = defaultSeverity
+	}
+	if m.severity, ok = parseCode(pcommon.NewValueStr(cfg.DefaultSeverity), severities); !ok {
+		return nil, fmt.Errorf("invalid `default_severity` %q", cfg.DefaultSeverity)
+	}
+	return m, nil
+}
+
+// priority returns the priority attribute of the log record if it is set, otherwise it
+// computes the priority from the facility and the severity of the log record.
+func (m *priorityMapper) priority(logRecord plog.LogRecord) string {
+	if value, found := logRecord.Attributes().Get(priority); found {
+		return value.AsString()
+	}
+
+	facility := m.facility
+	if code, ok := m.attributeCode(logRecord, m.facilityAttribute, facilities); ok {
+		facility = code
+	}
+
+	severity := m.severity
+	if code, ok := m.attributeCode(logRecord, m.severityAttribute, severities); ok {
+		severity = code
+	} else if m.mapSeverityNumber && logRecord.SeverityNumber() != plog.SeverityNumberUnspecified {
+		severity = severityFromNumber(logRecord.SeverityNumber())
+	}
+
+	return strconv.Itoa(facility*8 + severity)
+}
+
+func (*priorityMapper) attributeCode(logRecord plog.LogRecord, attribute string, keywords []string) (int, bool) {
+	if attribute == "" {
+		return 0, false
+	}
+	value, found := logRecord.Attributes().Get(attribute)
+	if !found {
+		return 0, false
+	}
+	return parseCode(value, keywords)
+}
+
+// parseCode returns the code of the value, which is either a code or one of the keywords.
+func parseCode(value pcommon.Value, keywords []string) (int, bool) {
+	var code int64
+	switch value.Type() {
+	case pcommon.ValueTypeInt:
+		code = value.Int()
+	case pcommon.ValueTypeStr:
+		if idx := slices.Index(keywords, value.Str()); idx >= 0 {
+			return idx, true
+		}
+		var err error
+		if code, err = strconv.ParseInt(value.Str(), 10, 64); err != nil {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	if code < 0 || code >= int64(len(keywords)) {
+		return 0, false
+	}
+	return int(code), true
+}
+
+// severityFromNumber maps the severity number of a log record to the syslog severity, so that
+// the messages parsed by the syslog receiver keep their severity.
+func severityFromNumber(number plog.SeverityNumber) int {
+	switch {
+	case number >= plog.SeverityNumberFatal:
+		return 0 // emerg
+	case number >= plog.SeverityNumberError3:
+		return 1 // alert
+	case number >= plog.SeverityNumberError2:
+		return 2 // crit
+	case number >= plog.SeverityNumberError:
+		return 3 // err
+	case number >= plog.SeverityNumberWarn:
+		return 4 // warning
+	case number >= plog.SeverityNumberInfo2:
+		return 5 // notice
+	case number >= plog.SeverityNumberInfo:
+		return 6 // info
+	default:
+		return 7 // debug
+	}
+}
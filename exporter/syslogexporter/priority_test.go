@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package syslogexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func defaultPriorityMapper(t *testing.T) *priorityMapper {
+	m, err := newPriorityMapper(PriorityConfig{})
+	require.NoError(t, err)
+	return m
+}
+
+func TestPriorityConfigValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  PriorityConfig
+		err  string
+	}{
+		{
+			name: "empty defaults",
+			cfg:  PriorityConfig{},
+		},
+		{
+			name: "keywords",
+			cfg: PriorityConfig{
+				DefaultFacility: "daemon",
+				DefaultSeverity: "warning",
+			},
+		},
+		{
+			name: "codes",
+			cfg: PriorityConfig{
+				DefaultFacility: "23",
+				DefaultSeverity: "0",
+			},
+		},
+		{
+			name: "invalid facility",
+			cfg: PriorityConfig{
+				DefaultFacility: "local8",
+			},
+			err: "invalid `default_facility` \"local8\"",
+		},
+		{
+			name: "invalid severity",
+			cfg: PriorityConfig{
+				DefaultSeverity: "8",
+			},
+			err: "invalid `default_severity` \"8\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPriority(t *testing.T) {
+	cfg := PriorityConfig{
+		FacilityAttribute: "syslog.facility",
+		SeverityAttribute: "syslog.severity",
+		DefaultFacility:   "daemon",
+		DefaultSeverity:   "info",
+	}
+	tests := []struct {
+		name              string
+		mapSeverityNumber bool
+		setup             func(plog.LogRecord)
+		expected          string
+	}{
+		{
+			name:     "defaults",
+			setup:    func(plog.LogRecord) {},
+			expected: "30",
+		},
+		{
+			name: "priority attribute",
+			setup: func(lr plog.LogRecord) {
+				lr.Attributes().PutInt(priority, 86)
+				lr.Attributes().PutStr("syslog.facility", "kern")
+			},
+			expected: "86",
+		},
+		{
+			name: "keywords",
+			setup: func(lr plog.LogRecord) {
+				lr.Attributes().PutStr("syslog.facility", "local0")
+				lr.Attributes().PutStr("syslog.severity", "err")
+			},
+			expected: "131",
+		},
+		{
+			name: "codes",
+			setup: func(lr plog.LogRecord) {
+				lr.Attributes().PutInt("syslog.facility", 1)
+				lr.Attributes().PutStr("syslog.severity", "2")
+			},
+			expected: "10",
+		},
+		{
+			name: "invalid attributes",
+			setup: func(lr plog.LogRecord) {
+				lr.Attributes().PutInt("syslog.facility", 24)
+				lr.Attributes().PutStr("syslog.severity", "error")
+			},
+			expected: "30",
+		},
+		{
+			name: "severity number ignored",
+			setup: func(lr plog.LogRecord) {
+				lr.SetSeverityNumber(plog.SeverityNumberError)
+			},
+			expected: "30",
+		},
+		{
+			name:              "severity number",
+			mapSeverityNumber: true,
+			setup: func(lr plog.LogRecord) {
+				lr.SetSeverityNumber(plog.SeverityNumberError)
+			},
+			expected: "27",
+		},
+		{
+			name:              "severity attribute before severity number",
+			mapSeverityNumber: true,
+			setup: func(lr plog.LogRecord) {
+				lr.SetSeverityNumber(plog.SeverityNumberError)
+				lr.Attributes().PutStr("syslog.severity", "debug")
+			},
+			expected: "31",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := cfg
+			cfg.MapSeverityNumber = tt.mapSeverityNumber
+			m, err := newPriorityMapper(cfg)
+			require.NoError(t, err)
+
+			lr := plog.NewLogRecord()
+			tt.setup(lr)
+			assert.Equal(t, tt.expected, m.priority(lr))
+		})
+	}
+}
+
+func TestSeverityFromNumber(t *testing.T) {
+	tests := []struct {
+		number   plog.SeverityNumber
+		expected int
+	}{
+		{plog.SeverityNumberTrace, 7},
+		{plog.SeverityNumberDebug4, 7},
+		{plog.SeverityNumberInfo, 6},
+		{plog.SeverityNumberInfo2, 5},
+		{plog.SeverityNumberInfo4, 5},
+		{plog.SeverityNumberWarn, 4},
+		{plog.SeverityNumberError, 3},
+		{plog.SeverityNumberError2, 2},
+		{plog.SeverityNumberError3, 1},
+		{plog.SeverityNumberError4, 1},
+		{plog.SeverityNumberFatal, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.number.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, severityFromNumber(tt.number))
+		})
+	}
+}
@@ -5,15 +5,18 @@ package syslogexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"fmt"
-	"strconv"
 
 	"go.opentelemetry.io/collector/pdata/plog"
 )
 
-type rfc3164Formatter struct{}
+type rfc3164Formatter struct {
+	priorities *priorityMapper
+}
 
-func newRFC3164Formatter() *rfc3164Formatter {
-	return &rfc3164Formatter{}
+func newRFC3164Formatter(priorities *priorityMapper) *rfc3164Formatter {
+	return &rfc3164Formatter{
+		priorities: priorities,
+	}
 }
 
 func (f *rfc3164Formatter) format(logRecord plog.LogRecord) string {
@@ -30,8 +33,8 @@ func (f *rfc3164Formatter) format(logRecord plog.LogRecord) string {
 	return formatted
 }
 
-func (*rfc3164Formatter) formatPriority(logRecord plog.LogRecord) string {
-	return getAttributeValueOrDefault(logRecord, priority, strconv.Itoa(defaultPriority))
+func (f *rfc3164Formatter) formatPriority(logRecord plog.LogRecord) string {
+	return f.priorities.priority(logRecord)
 }
 
 func (*rfc3164Formatter) formatTimestamp(logRecord plog.LogRecord) string {
@@ -24,7 +24,7 @@ func TestRFC3164Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual := newRFC3164Formatter().format(logRecord)
+	actual := newRFC3164Formatter(defaultPriorityMapper(t)).format(logRecord)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 
@@ -35,7 +35,7 @@ func TestRFC3164Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC3164Formatter().format(logRecord)
+	actual = newRFC3164Formatter(defaultPriorityMapper(t)).format(logRecord)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
@@ -15,11 +15,13 @@ import (
 
 type rfc5424Formatter struct {
 	octetCounting bool
+	priorities    *priorityMapper
 }
 
-func newRFC5424Formatter(octetCounting bool) *rfc5424Formatter {
+func newRFC5424Formatter(octetCounting bool, priorities *priorityMapper) *rfc5424Formatter {
 	return &rfc5424Formatter{
 		octetCounting: octetCounting,
+		priorities:    priorities,
 	}
 }
 
@@ -42,8 +44,8 @@ func (f *rfc5424Formatter) format(logRecord plog.LogRecord) string {
 	return formatted
 }
 
-func (*rfc5424Formatter) formatPriority(logRecord plog.LogRecord) string {
-	return getAttributeValueOrDefault(logRecord, priority, strconv.Itoa(defaultPriority))
+func (f *rfc5424Formatter) formatPriority(logRecord plog.LogRecord) string {
+	return f.priorities.priority(logRecord)
 }
 
 func (*rfc5424Formatter) formatVersion(logRecord plog.LogRecord) string {
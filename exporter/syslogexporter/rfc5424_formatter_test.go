@@ -30,9 +30,9 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual := newRFC5424Formatter(false).format(logRecord)
+	actual := newRFC5424Formatter(false, defaultPriorityMapper(t)).format(logRecord)
 	assert.Equal(t, expected, actual)
-	octetCounting := newRFC5424Formatter(true).format(logRecord)
+	octetCounting := newRFC5424Formatter(true, defaultPriorityMapper(t)).format(logRecord)
 	assert.Equal(t, fmt.Sprintf("%d %s", len(expected), expected), octetCounting)
 
 	expected = "<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog 111 ID47 - BOMAn application event log entry...\n"
@@ -48,9 +48,9 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, defaultPriorityMapper(t)).format(logRecord)
 	assert.Equal(t, expected, actual)
-	octetCounting = newRFC5424Formatter(true).format(logRecord)
+	octetCounting = newRFC5424Formatter(true, defaultPriorityMapper(t)).format(logRecord)
 	assert.Equal(t, fmt.Sprintf("%d %s", len(expected), expected), octetCounting)
 
 	// Test structured data
@@ -77,7 +77,7 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, defaultPriorityMapper(t)).format(logRecord)
 	assert.NoError(t, err)
 	matched, err := regexp.MatchString(expectedRegex, actual)
 	assert.NoError(t, err)
@@ -117,7 +117,7 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, defaultPriorityMapper(t)).format(logRecord)
 	assert.NoError(t, err)
 
 	// check that the output message is of the right form
@@ -156,7 +156,7 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, defaultPriorityMapper(t)).format(logRecord)
 	assert.Equal(t, expected, actual)
 }
 
@@ -174,7 +174,7 @@ func TestRFC5424Formatter_NanoFractionIsTruncatedToMicro(t *testing.T) {
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
 	expectedPrefix := "<14>1 2025-10-02T20:04:11.51887Z myhost myapp 1234 - - nano->micro"
-	actual := newRFC5424Formatter(false).format(logRecord)
+	actual := newRFC5424Formatter(false, defaultPriorityMapper(t)).format(logRecord)
 
 	// The formatted output should contain the truncated (not rounded) timestamp
 	assert.Contains(t, actual, expectedPrefix)
@@ -186,6 +186,6 @@ func TestRFC5424Formatter_NanoFractionIsTruncatedToMicro(t *testing.T) {
 	require.NoError(t, err)
 
 	// Check that octet counting mode also works correctly
-	octetCounting := newRFC5424Formatter(true).format(logRecord)
+	octetCounting := newRFC5424Formatter(true, defaultPriorityMapper(t)).format(logRecord)
 	assert.True(t, strings.HasPrefix(octetCounting, fmt.Sprintf("%d ", len(actual))))
 }
@@ -16,8 +16,7 @@ import (
 )
 
 const (
-	defaultPriority = 165
-	versionRFC5424  = 1
+	versionRFC5424 = 1
 )
 
 const (
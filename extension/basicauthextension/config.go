@@ -12,6 +12,8 @@ import (
 var (
 	errNoCredentialSource     = errors.New("no credential source provided")
 	errMultipleAuthenticators = errors.New("only one of `htpasswd` or `client_auth` can be specified")
+	errServerSettings         = errors.New("`rate_limit` and `username_metadata_key` can only be specified with `htpasswd`")
+	errInvalidRateLimit       = errors.New("`rate_limit::requests_per_second` and `rate_limit::burst` must be greater than 0")
 )
 
 type HtpasswdSettings struct {
@@ -23,6 +25,16 @@ type HtpasswdSettings struct {
 	_ struct{}
 }
 
+// RateLimitSettings limits the rate of the requests authenticated for each user.
+type RateLimitSettings struct {
+	// RequestsPerSecond is the number of requests allowed per second for each user.
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+	// Burst is the maximum number of requests allowed at once for each user.
+	Burst int `mapstructure:"burst"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 type ClientAuthSettings struct {
 	// Username holds the username to use for client authentication.
 	Username string `mapstructure:"username"`
@@ -37,6 +49,13 @@ type Config struct {
 
 	// ClientAuth settings
 	ClientAuth *ClientAuthSettings `mapstructure:"client_auth,omitempty"`
+
+	// RateLimit settings, only used with htpasswd.
+	RateLimit *RateLimitSettings `mapstructure:"rate_limit,omitempty"`
+
+	// UsernameMetadataKey is the client metadata key the authenticated username is
+	// reported with, only used with htpasswd.
+	UsernameMetadataKey string `mapstructure:"username_metadata_key"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -53,5 +72,13 @@ func (cfg *Config) Validate() error {
 		return errNoCredentialSource
 	}
 
+	if !serverCondition && (cfg.RateLimit != nil || cfg.UsernameMetadataKey != "") {
+		return errServerSettings
+	}
+
+	if cfg.RateLimit != nil && (cfg.RateLimit.RequestsPerSecond <= 0 || cfg.RateLimit.Burst <= 0) {
+		return errInvalidRateLimit
+	}
+
 	return nil
 }
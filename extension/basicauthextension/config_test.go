@@ -49,6 +49,23 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(metadata.Type, "both"),
 			expectedErr: true,
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "rate_limit"),
+			expected: &Config{
+				Htpasswd: &HtpasswdSettings{
+					File: "/etc/otelcol/htpasswd",
+				},
+				RateLimit: &RateLimitSettings{
+					RequestsPerSecond: 10,
+					Burst:             20,
+				},
+				UsernameMetadataKey: "x-username",
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "client_rate_limit"),
+			expectedErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -68,3 +85,17 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRateLimit(t *testing.T) {
+	cfg := &Config{
+		Htpasswd:  &HtpasswdSettings{Inline: "username:password"},
+		RateLimit: &RateLimitSettings{RequestsPerSecond: 10},
+	}
+	assert.ErrorIs(t, cfg.Validate(), errInvalidRateLimit)
+
+	cfg = &Config{
+		ClientAuth:          &ClientAuthSettings{Username: "username"},
+		UsernameMetadataKey: "x-username",
+	}
+	assert.ErrorIs(t, cfg.Validate(), errServerSettings)
+}
@@ -11,14 +11,21 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/tg123/go-htpasswd"
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
 	"go.opentelemetry.io/collector/extension/extensionauth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	creds "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -26,20 +33,29 @@ var (
 	errInvalidCredentials  = errors.New("invalid credentials")
 	errInvalidSchemePrefix = errors.New("invalid authorization scheme prefix")
 	errInvalidFormat       = errors.New("invalid authorization format")
+	// errRateLimited is a gRPC status, so that the gRPC servers reply with ResourceExhausted
+	// rather than Unauthenticated, and the clients retry the requests later.
+	errRateLimited = status.Error(codes.ResourceExhausted, "rate limit exceeded")
 )
 
 func newClientAuthExtension(cfg *Config) *basicAuthClient {
 	return &basicAuthClient{clientAuth: cfg.ClientAuth}
 }
 
-func newServerAuthExtension(cfg *Config) (*basicAuthServer, error) {
+func newServerAuthExtension(cfg *Config, logger *zap.Logger) (*basicAuthServer, error) {
 	if cfg.Htpasswd == nil || (cfg.Htpasswd.File == "" && cfg.Htpasswd.Inline == "") {
 		return nil, errNoCredentialSource
 	}
 
-	return &basicAuthServer{
-		htpasswd: cfg.Htpasswd,
-	}, nil
+	ba := &basicAuthServer{
+		htpasswd:            cfg.Htpasswd,
+		usernameMetadataKey: cfg.UsernameMetadataKey,
+		logger:              logger,
+	}
+	if cfg.RateLimit != nil {
+		ba.rateLimiter = newUserRateLimiter(cfg.RateLimit)
+	}
+	return ba, nil
 }
 
 var (
@@ -48,18 +64,52 @@ var (
 )
 
 type basicAuthServer struct {
-	htpasswd  *HtpasswdSettings
-	matchFunc func(username, password string) bool
-	component.ShutdownFunc
+	htpasswd            *HtpasswdSettings
+	usernameMetadataKey string
+	rateLimiter         *userRateLimiter
+	logger              *zap.Logger
+
+	htp        atomic.Pointer[htpasswd.File]
+	shutdownCH chan struct{}
+	watcherWg  sync.WaitGroup
 }
 
 func (ba *basicAuthServer) Start(_ context.Context, _ component.Host) error {
+	htp, err := ba.load()
+	if err != nil {
+		return err
+	}
+	ba.htp.Store(htp)
+
+	if ba.htpasswd.File == "" {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	// Watch the parent directory instead of the file directly, to handle the files replaced
+	// atomically and the files mounted from Kubernetes secrets, updated by swapping symbolic links.
+	if err := watcher.Add(filepath.Dir(ba.htpasswd.File)); err != nil {
+		_ = watcher.Close()
+		return err
+	}
+
+	ba.shutdownCH = make(chan struct{})
+	ba.watcherWg.Add(1)
+	go ba.startWatcher(watcher)
+	return nil
+}
+
+// load reads the htpasswd file and inline content.
+func (ba *basicAuthServer) load() (*htpasswd.File, error) {
 	var rs []io.Reader
 
 	if ba.htpasswd.File != "" {
 		f, err := os.Open(ba.htpasswd.File)
 		if err != nil {
-			return fmt.Errorf("open htpasswd file: %w", err)
+			return nil, fmt.Errorf("open htpasswd file: %w", err)
 		}
 		defer f.Close()
 
@@ -73,11 +123,58 @@ func (ba *basicAuthServer) Start(_ context.Context, _ component.Host) error {
 
 	htp, err := htpasswd.NewFromReader(mr, htpasswd.DefaultSystems, nil)
 	if err != nil {
-		return fmt.Errorf("read htpasswd content: %w", err)
+		return nil, fmt.Errorf("read htpasswd content: %w", err)
 	}
+	return htp, nil
+}
 
-	ba.matchFunc = htp.Match
+func (ba *basicAuthServer) startWatcher(watcher *fsnotify.Watcher) {
+	defer ba.watcherWg.Done()
+	defer watcher.Close()
+
+	dir := filepath.Clean(filepath.Dir(ba.htpasswd.File))
+	for {
+		select {
+		case <-ba.shutdownCH:
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			// Events for the other files of the directory are not ignored, as the files mounted
+			// from Kubernetes secrets are updated by swapping the ..data link of the directory.
+			if filepath.Clean(filepath.Dir(event.Name)) != dir ||
+				!event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) && !event.Has(fsnotify.Remove) && !event.Has(fsnotify.Rename) {
+				continue
+			}
+			ba.reload()
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			ba.logger.Error("htpasswd file watcher error", zap.Error(err))
+		}
+	}
+}
 
+// reload replaces the credentials with the content of the htpasswd file. The previous credentials
+// are kept if the file can't be read, e.g. while it is being replaced.
+func (ba *basicAuthServer) reload() {
+	htp, err := ba.load()
+	if err != nil {
+		ba.logger.Warn("Failed to reload the htpasswd file, keeping the previous credentials", zap.Error(err))
+		return
+	}
+	ba.htp.Store(htp)
+	ba.logger.Debug("Reloaded the htpasswd file", zap.String("file", ba.htpasswd.File))
+}
+
+func (ba *basicAuthServer) Shutdown(context.Context) error {
+	if ba.shutdownCH != nil {
+		close(ba.shutdownCH)
+		ba.watcherWg.Wait()
+		ba.shutdownCH = nil
+	}
 	return nil
 }
 
@@ -92,15 +189,33 @@ func (ba *basicAuthServer) Authenticate(ctx context.Context, headers map[string]
 		return ctx, err
 	}
 
-	if !ba.matchFunc(authData.username, authData.password) {
+	htp := ba.htp.Load()
+	if htp == nil || !htp.Match(authData.username, authData.password) {
 		return ctx, errInvalidCredentials
 	}
 
+	if ba.rateLimiter != nil && !ba.rateLimiter.allow(authData.username) {
+		return ctx, errRateLimited
+	}
+
 	cl := client.FromContext(ctx)
 	cl.Auth = authData
+	if ba.usernameMetadataKey != "" {
+		cl.Metadata = withMetadata(cl.Metadata, ba.usernameMetadataKey, authData.username)
+	}
 	return client.NewContext(ctx, cl), nil
 }
 
+// withMetadata returns a copy of the given metadata with the key set to the given value.
+func withMetadata(md client.Metadata, key, value string) client.Metadata {
+	m := map[string][]string{}
+	for k := range md.Keys() {
+		m[k] = md.Get(k)
+	}
+	m[key] = []string{value}
+	return client.NewMetadata(m)
+}
+
 func getAuthHeader(h map[string][]string) string {
 	const (
 		canonicalHeaderKey = "Authorization"
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package basicauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/basicauthextension"
+
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// userRateLimiter limits the rate of the requests of each user.
+type userRateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newUserRateLimiter(cfg *RateLimitSettings) *userRateLimiter {
+	return &userRateLimiter{
+		limit:    rate.Limit(cfg.RequestsPerSecond),
+		burst:    cfg.Burst,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// allow reports whether a request of the given user is allowed now.
+func (l *userRateLimiter) allow(username string) bool {
+	l.mu.Lock()
+	limiter, ok := l.limiters[username]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[username] = limiter
+	}
+	l.mu.Unlock()
+	return limiter.Allow()
+}
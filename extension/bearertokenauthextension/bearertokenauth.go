@@ -9,8 +9,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync/atomic"
 
@@ -30,8 +32,18 @@ type perRPCAuth struct {
 }
 
 // GetRequestMetadata returns the request metadata to be used with the RPC.
-func (c *perRPCAuth) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
-	return map[string]string{strings.ToLower(c.auth.header): c.auth.authorizationValue()}, nil
+func (c *perRPCAuth) GetRequestMetadata(_ context.Context, uri ...string) (map[string]string, error) {
+	var host string
+	if len(uri) > 0 {
+		if u, err := url.Parse(uri[0]); err == nil {
+			host = u.Host
+		}
+	}
+	value, err := c.auth.authorizationValueForHost(host)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{strings.ToLower(c.auth.header): value}, nil
 }
 
 // RequireTransportSecurity always returns true for this implementation. Passing bearer tokens in plain-text connections is a bad idea.
@@ -56,6 +68,9 @@ type bearerTokenAuth struct {
 
 	filename string
 	logger   *zap.Logger
+
+	// namedTokens are used instead of the above for the requests to their endpoints
+	namedTokens []*namedToken
 }
 
 func newBearerTokenAuth(cfg *Config, logger *zap.Logger) *bearerTokenAuth {
@@ -68,6 +83,13 @@ func newBearerTokenAuth(cfg *Config, logger *zap.Logger) *bearerTokenAuth {
 		filename: cfg.Filename,
 		logger:   logger,
 	}
+	a.setAuthorizationValues([]string{})
+	a.namedTokens = newNamedTokens(cfg)
+	for _, nt := range a.namedTokens {
+		if nt.filename != "" {
+			a.refreshNamedToken(nt)
+		}
+	}
 	switch {
 	case len(cfg.Tokens) > 0:
 		tokens := make([]string, len(cfg.Tokens))
@@ -83,11 +105,26 @@ func newBearerTokenAuth(cfg *Config, logger *zap.Logger) *bearerTokenAuth {
 	return a
 }
 
+// watchedFiles returns the files containing the tokens, including the named ones.
+func (b *bearerTokenAuth) watchedFiles() []string {
+	var files []string
+	if b.filename != "" {
+		files = append(files, b.filename)
+	}
+	for _, nt := range b.namedTokens {
+		if nt.filename != "" {
+			files = append(files, nt.filename)
+		}
+	}
+	return files
+}
+
 // Start of BearerTokenAuth does nothing and returns nil if no filename
-// is specified. Otherwise a routine is started to monitor the file containing
-// the token to be transferred.
+// is specified. Otherwise a routine is started to monitor the files containing
+// the tokens to be transferred.
 func (b *bearerTokenAuth) Start(ctx context.Context, _ component.Host) error {
-	if b.filename == "" {
+	files := b.watchedFiles()
+	if len(files) == 0 {
 		return nil
 	}
 
@@ -95,8 +132,8 @@ func (b *bearerTokenAuth) Start(ctx context.Context, _ component.Host) error {
 		return errors.New("bearerToken file monitoring is already running")
 	}
 
-	// Read file once
-	b.refreshToken()
+	// Read files once
+	b.refreshFiles(files)
 
 	b.shutdownCH = make(chan struct{})
 
@@ -107,10 +144,20 @@ func (b *bearerTokenAuth) Start(ctx context.Context, _ component.Host) error {
 	// start file watcher
 	go b.startWatcher(ctx, watcher)
 
-	// Watch the parent directory instead of the file directly to handle atomic replacements
+	// Watch the parent directories instead of the files directly to handle atomic replacements
 	// This eliminates race conditions with fsnotify when files are atomically replaced
-	watchDir := filepath.Dir(b.filename)
-	return watcher.Add(watchDir)
+	watchDirs := make(map[string]struct{})
+	for _, file := range files {
+		watchDir := filepath.Dir(file)
+		if _, ok := watchDirs[watchDir]; ok {
+			continue
+		}
+		watchDirs[watchDir] = struct{}{}
+		if err := watcher.Add(watchDir); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (b *bearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Watcher) {
@@ -127,20 +174,43 @@ func (b *bearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Wa
 				continue
 			}
 
-			// Only process events for our target file by filtering events
-			// Since we're watching the parent directory, we get events for all files in it
-			if event.Name != b.filename {
+			// Only process events for the directories of our target files
+			// Since we're watching the parent directories, we get events for all files in them.
+			// Events for other files are not ignored, as files mounted from Kubernetes secrets and
+			// projected service account tokens are symbolic links updated by swapping the ..data
+			// link in the same directory, which does not produce events for the files themselves.
+			var files []string
+			for _, file := range b.watchedFiles() {
+				if filepath.Clean(filepath.Dir(file)) == filepath.Clean(filepath.Dir(event.Name)) {
+					files = append(files, file)
+				}
+			}
+			if len(files) == 0 {
 				continue
 			}
 
-			// Handle file events for our target file
+			// Handle file events for our target files
 			// Since we're watching the directory, we don't need to manage watch add/remove
 			// The directory watch persists even when files are atomically replaced
 			if event.Op&fsnotify.Write == fsnotify.Write ||
 				event.Op&fsnotify.Create == fsnotify.Create ||
 				event.Op&fsnotify.Remove == fsnotify.Remove ||
 				event.Op&fsnotify.Chmod == fsnotify.Chmod {
-				b.refreshToken()
+				b.refreshFiles(files)
+			}
+		}
+	}
+}
+
+// refreshFiles reloads the tokens read from the given files
+func (b *bearerTokenAuth) refreshFiles(files []string) {
+	for _, file := range files {
+		if file == b.filename {
+			b.refreshToken()
+		}
+		for _, nt := range b.namedTokens {
+			if file == nt.filename {
+				b.refreshNamedToken(nt)
 			}
 		}
 	}
@@ -148,30 +218,48 @@ func (b *bearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Wa
 
 // Reloads token from file
 func (b *bearerTokenAuth) refreshToken() {
+	tokens, err := readTokens(b.filename)
+	if err != nil {
+		b.logger.Error(err.Error())
+		return
+	}
+	if slices.Equal(b.authorizationValues(), authorizationValues(b.scheme, tokens)) {
+		return
+	}
 	b.logger.Info("refresh token", zap.String("filename", b.filename))
-	tokenData, err := os.ReadFile(b.filename)
+	b.setAuthorizationValues(tokens) // Stores new tokens
+}
+
+// Reloads named token from file
+func (b *bearerTokenAuth) refreshNamedToken(nt *namedToken) {
+	tokens, err := readTokens(nt.filename)
 	if err != nil {
 		b.logger.Error(err.Error())
 		return
 	}
+	values := authorizationValues(b.scheme, tokens)
+	if current, ok := nt.authorizationValuesAtomic.Load().([]string); ok && slices.Equal(current, values) {
+		return
+	}
+	b.logger.Info("refresh token", zap.String("name", nt.name), zap.String("filename", nt.filename))
+	nt.authorizationValuesAtomic.Store(values)
+}
+
+func readTokens(filename string) ([]string, error) {
+	tokenData, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
 
 	tokens := strings.Split(string(tokenData), "\n")
 	for i, token := range tokens {
 		tokens[i] = strings.TrimSpace(token)
 	}
-	b.setAuthorizationValues(tokens) // Stores new tokens
+	return tokens, nil
 }
 
 func (b *bearerTokenAuth) setAuthorizationValues(tokens []string) {
-	values := make([]string, len(tokens))
-	for i, token := range tokens {
-		if b.scheme != "" {
-			values[i] = b.scheme + " " + token
-		} else {
-			values[i] = token
-		}
-	}
-	b.authorizationValuesAtomic.Store(values)
+	b.authorizationValuesAtomic.Store(authorizationValues(b.scheme, tokens))
 }
 
 // authorizationValues returns the Authorization header/metadata values
@@ -190,9 +278,29 @@ func (b *bearerTokenAuth) authorizationValue() string {
 	return ""
 }
 
+// authorizationValueForHost returns the Authorization header/metadata value to set for client auth
+// of the requests to the given host, selecting the named token configured for it if any.
+func (b *bearerTokenAuth) authorizationValueForHost(host string) (string, error) {
+	if len(b.namedTokens) == 0 {
+		return b.authorizationValue(), nil
+	}
+	for _, nt := range b.namedTokens {
+		if host != "" && nt.matches(host) {
+			if values := nt.authorizationValues(); len(values) > 0 {
+				return values[0], nil
+			}
+			return "", fmt.Errorf("bearer token %q is not loaded", nt.name)
+		}
+	}
+	if value := b.authorizationValue(); value != "" {
+		return value, nil
+	}
+	return "", fmt.Errorf("no bearer token configured for endpoint %q", host)
+}
+
 // Shutdown of BearerTokenAuth does nothing and returns nil
 func (b *bearerTokenAuth) Shutdown(_ context.Context) error {
-	if b.filename == "" {
+	if len(b.watchedFiles()) == 0 {
 		return nil
 	}
 
@@ -231,8 +339,8 @@ func (b *bearerTokenAuth) Authenticate(ctx context.Context, headers map[string][
 		return ctx, fmt.Errorf("missing or empty authorization header: %s", b.header)
 	}
 	token := auth[0] // Extract token from authorization header
-	expectedTokens := b.authorizationValues()
-	for _, expectedToken := range expectedTokens {
+	// The named tokens authenticate the collector to its backends, not the clients to the collector
+	for _, expectedToken := range b.authorizationValues() {
 		if subtle.ConstantTimeCompare([]byte(expectedToken), []byte(token)) == 1 {
 			return ctx, nil // Authentication successful, token is valid
 		}
@@ -253,6 +361,14 @@ func (interceptor *bearerAuthRoundTripper) RoundTrip(req *http.Request) (*http.R
 	if req2.Header == nil {
 		req2.Header = make(http.Header)
 	}
-	req2.Header.Set(interceptor.header, interceptor.auth.authorizationValue())
+	var host string
+	if req2.URL != nil {
+		host = req2.URL.Host
+	}
+	value, err := interceptor.auth.authorizationValueForHost(host)
+	if err != nil {
+		return nil, err
+	}
+	req2.Header.Set(interceptor.header, value)
 	return interceptor.baseTransport.RoundTrip(req2)
 }
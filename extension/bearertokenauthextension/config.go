@@ -5,6 +5,7 @@ package bearertokenauthextension // import "github.com/open-telemetry/openteleme
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -27,23 +28,69 @@ type Config struct {
 	// Filename points to a file that contains the bearer token(s) to use for every RPC.
 	Filename string `mapstructure:"filename,omitempty"`
 
+	// NamedTokens specifies bearer tokens used instead of the above for the requests to their endpoints,
+	// so that a single extension can authenticate the exporters sending data to different backends.
+	NamedTokens map[string]NamedTokenConfig `mapstructure:"named_tokens,omitempty"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// NamedTokenConfig specifies a bearer token used for the requests to its endpoints.
+type NamedTokenConfig struct {
+	// BearerToken specifies the bearer token to use.
+	BearerToken configopaque.String `mapstructure:"token,omitempty"`
+
+	// Filename points to a file that contains the bearer token to use.
+	Filename string `mapstructure:"filename,omitempty"`
+
+	// Endpoints specifies the endpoints the token is used for, either as URLs or as host[:port].
+	// Endpoints without a port match any port of the host.
+	Endpoints []string `mapstructure:"endpoints,omitempty"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
 
 var (
-	_                         component.Config = (*Config)(nil)
-	errNoTokenProvided                         = errors.New("no bearer token provided")
-	errTokensAndTokenProvided                  = errors.New("either tokens or token should be provided, not both")
+	_                           component.Config = (*Config)(nil)
+	errNoTokenProvided                           = errors.New("no bearer token provided")
+	errTokensAndTokenProvided                    = errors.New("either tokens or token should be provided, not both")
+	errTokenAndFilenameProvided                  = errors.New("either token or filename should be provided, not both")
+	errNoEndpointsProvided                       = errors.New("no endpoints provided")
 )
 
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
-	if cfg.BearerToken == "" && len(cfg.Tokens) == 0 && cfg.Filename == "" {
+	if cfg.BearerToken == "" && len(cfg.Tokens) == 0 && cfg.Filename == "" && len(cfg.NamedTokens) == 0 {
 		return errNoTokenProvided
 	}
 	if cfg.BearerToken != "" && len(cfg.Tokens) > 0 {
 		return errTokensAndTokenProvided
 	}
+	for name, namedToken := range cfg.NamedTokens {
+		if err := namedToken.Validate(); err != nil {
+			return fmt.Errorf("named token %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks if the named token configuration is valid
+func (cfg *NamedTokenConfig) Validate() error {
+	if cfg.BearerToken == "" && cfg.Filename == "" {
+		return errNoTokenProvided
+	}
+	if cfg.BearerToken != "" && cfg.Filename != "" {
+		return errTokenAndFilenameProvided
+	}
+	if len(cfg.Endpoints) == 0 {
+		return errNoEndpointsProvided
+	}
+	for _, endpoint := range cfg.Endpoints {
+		if endpointHost(endpoint) == "" {
+			return fmt.Errorf("invalid endpoint %q", endpoint)
+		}
+	}
 	return nil
 }
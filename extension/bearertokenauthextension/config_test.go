@@ -97,6 +97,28 @@ func TestLoadConfig(t *testing.T) {
 				BearerToken: "my-token",
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "namedtokens"),
+			expected: &Config{
+				Header:      defaultHeader,
+				Scheme:      defaultScheme,
+				BearerToken: "default-token",
+				NamedTokens: map[string]NamedTokenConfig{
+					"backend_a": {
+						BearerToken: "token-a",
+						Endpoints:   []string{"https://a.example.com:4318", "a.example.com:4317"},
+					},
+					"backend_b": {
+						Filename:  "/var/run/secrets/tokens/backend-b",
+						Endpoints: []string{"b.example.com"},
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "namedtokennoendpoints"),
+			expectedErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -117,3 +139,37 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateNamedToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         NamedTokenConfig
+		expectedErr string
+	}{
+		{
+			name:        "no token",
+			cfg:         NamedTokenConfig{Endpoints: []string{"a.example.com"}},
+			expectedErr: errNoTokenProvided.Error(),
+		},
+		{
+			name:        "token and filename",
+			cfg:         NamedTokenConfig{BearerToken: "token", Filename: "token.txt", Endpoints: []string{"a.example.com"}},
+			expectedErr: errTokenAndFilenameProvided.Error(),
+		},
+		{
+			name:        "no endpoints",
+			cfg:         NamedTokenConfig{BearerToken: "token"},
+			expectedErr: errNoEndpointsProvided.Error(),
+		},
+		{
+			name:        "invalid endpoint",
+			cfg:         NamedTokenConfig{BearerToken: "token", Endpoints: []string{"https://"}},
+			expectedErr: `invalid endpoint "https://"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.cfg.Validate(), tt.expectedErr)
+		})
+	}
+}
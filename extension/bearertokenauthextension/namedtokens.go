@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bearertokenauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/bearertokenauthextension"
+
+import (
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// namedToken is a bearer token used for the requests to its endpoints.
+type namedToken struct {
+	name      string
+	filename  string
+	endpoints []string
+
+	authorizationValuesAtomic atomic.Value
+}
+
+func newNamedTokens(cfg *Config) []*namedToken {
+	names := make([]string, 0, len(cfg.NamedTokens))
+	for name := range cfg.NamedTokens {
+		names = append(names, name)
+	}
+	// the tokens are matched in a deterministic order when several of them list the same endpoint
+	sort.Strings(names)
+
+	tokens := make([]*namedToken, 0, len(names))
+	for _, name := range names {
+		tokenCfg := cfg.NamedTokens[name]
+		nt := &namedToken{
+			name:     name,
+			filename: tokenCfg.Filename,
+		}
+		for _, endpoint := range tokenCfg.Endpoints {
+			nt.endpoints = append(nt.endpoints, endpointHost(endpoint))
+		}
+		if tokenCfg.BearerToken != "" {
+			nt.authorizationValuesAtomic.Store(authorizationValues(cfg.Scheme, []string{string(tokenCfg.BearerToken)}))
+		} else {
+			nt.authorizationValuesAtomic.Store([]string{})
+		}
+		tokens = append(tokens, nt)
+	}
+	return tokens
+}
+
+func (nt *namedToken) authorizationValues() []string {
+	return nt.authorizationValuesAtomic.Load().([]string)
+}
+
+// matches returns whether the token is used for the requests to the given host.
+func (nt *namedToken) matches(host string) bool {
+	hostname, _, err := net.SplitHostPort(host)
+	if err != nil {
+		hostname = host
+	}
+	for _, endpoint := range nt.endpoints {
+		if strings.EqualFold(endpoint, host) || strings.EqualFold(endpoint, hostname) {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointHost returns the host[:port] of an endpoint given either as a URL or as host[:port].
+func endpointHost(endpoint string) string {
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return ""
+		}
+		return u.Host
+	}
+	return strings.TrimSuffix(endpoint, "/")
+}
+
+// authorizationValues returns the Authorization header/metadata values for the given tokens.
+func authorizationValues(scheme string, tokens []string) []string {
+	values := make([]string, len(tokens))
+	for i, token := range tokens {
+		if scheme != "" {
+			values[i] = scheme + " " + token
+		} else {
+			values[i] = token
+		}
+	}
+	return values
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bearertokenauthextension
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestNamedTokens(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.BearerToken = "default-token"
+	cfg.NamedTokens = map[string]NamedTokenConfig{
+		"backend_a": {
+			BearerToken: "token-a",
+			Endpoints:   []string{"https://a.example.com:4318", "a.example.com:4317"},
+		},
+		"backend_b": {
+			BearerToken: "token-b",
+			Endpoints:   []string{"b.example.com"},
+		},
+	}
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+
+	rt, err := bauth.RoundTripper(&mockRoundTripper{})
+	require.NoError(t, err)
+	perRPCAuth, err := bauth.PerRPCCredentials()
+	require.NoError(t, err)
+
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{url: "https://a.example.com:4318/v1/traces", expected: "Bearer token-a"},
+		{url: "https://a.example.com:4317/opentelemetry.proto.collector.trace.v1.TraceService", expected: "Bearer token-a"},
+		{url: "https://A.example.com:4318/v1/traces", expected: "Bearer token-a"},
+		{url: "https://b.example.com/v1/traces", expected: "Bearer token-b"},
+		{url: "https://b.example.com:8443/v1/traces", expected: "Bearer token-b"},
+		// the port of backend_a is not matching
+		{url: "https://a.example.com/v1/traces", expected: "Bearer default-token"},
+		{url: "https://c.example.com/v1/traces", expected: "Bearer default-token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, http.NoBody)
+			require.NoError(t, err)
+			resp, err := rt.RoundTrip(req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.Header.Get("Authorization"))
+
+			md, err := perRPCAuth.GetRequestMetadata(t.Context(), tt.url)
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"authorization": tt.expected}, md)
+		})
+	}
+
+	// incoming requests can't use the named tokens
+	_, err = bauth.Authenticate(t.Context(), map[string][]string{"authorization": {"Bearer default-token"}})
+	assert.NoError(t, err)
+	for _, token := range []string{"token-a", "token-b", "token-c"} {
+		_, err = bauth.Authenticate(t.Context(), map[string][]string{"authorization": {"Bearer " + token}})
+		assert.Error(t, err)
+	}
+}
+
+func TestNamedTokensWithoutDefault(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.NamedTokens = map[string]NamedTokenConfig{
+		"backend_a": {
+			BearerToken: "token-a",
+			Endpoints:   []string{"a.example.com"},
+		},
+	}
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	require.NoError(t, bauth.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, bauth.Shutdown(t.Context())) }()
+
+	perRPCAuth, err := bauth.PerRPCCredentials()
+	require.NoError(t, err)
+	_, err = perRPCAuth.GetRequestMetadata(t.Context(), "https://c.example.com:4317/service")
+	assert.EqualError(t, err, `no bearer token configured for endpoint "c.example.com:4317"`)
+}
+
+// TestNamedTokenFileSymlinkUpdate updates the token file the way Kubernetes updates projected
+// service account tokens, by swapping the symbolic link of the directory containing it.
+func TestNamedTokenFileSymlinkUpdate(t *testing.T) {
+	dir := t.TempDir()
+	writeData := func(name, token string) {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, name), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name, "token"), []byte(token), 0o600))
+		require.NoError(t, os.Symlink(name, filepath.Join(dir, "..data_tmp")))
+		require.NoError(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+	}
+	writeData("..2024_01_01", "token-1")
+	require.NoError(t, os.Symlink(filepath.Join("..data", "token"), filepath.Join(dir, "token")))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.NamedTokens = map[string]NamedTokenConfig{
+		"backend_a": {
+			Filename:  filepath.Join(dir, "token"),
+			Endpoints: []string{"a.example.com"},
+		},
+	}
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	require.NoError(t, bauth.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, bauth.Shutdown(t.Context())) }()
+
+	value, err := bauth.authorizationValueForHost("a.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-1", value)
+
+	writeData("..2024_01_02", "token-2")
+	assert.Eventually(t, func() bool {
+		value, err := bauth.authorizationValueForHost("a.example.com")
+		return err == nil && value == "Bearer token-2"
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -5,16 +5,24 @@ package headerssetterextension // import "github.com/open-telemetry/opentelemetr
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configopaque"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
 )
 
 var (
 	errMissingHeader        = errors.New("missing header name")
 	errMissingHeadersConfig = errors.New("missing headers configuration")
-	errMissingSource        = errors.New("missing header source, must be 'from_context', 'from_attribute' or 'value'")
-	errConflictingSources   = errors.New("invalid header source, must either 'from_context', 'from_attribute' or 'value'")
+	errMissingSource        = errors.New("missing header source, must be 'from_context', 'from_attribute', 'from_expression' or 'value'")
+	errConflictingSources   = errors.New("invalid header source, must either 'from_context', 'from_attribute', 'from_expression' or 'value'")
+	errMixedValuesSource    = errors.New("'mixed_values' can only be set with 'from_expression'")
+	// errExpressionUnsupported is returned when 'from_expression' is used by an exporter whose
+	// requests don't carry an OTLP batch the resources can be read from.
+	errExpressionUnsupported = errors.New("'from_expression' is only supported by OTLP/HTTP exporters")
 )
 
 type Config struct {
@@ -26,12 +34,18 @@ type Config struct {
 }
 
 type HeaderConfig struct {
-	Action        ActionValue          `mapstructure:"action"`
-	Key           *string              `mapstructure:"key"`
-	Value         *string              `mapstructure:"value"`
-	FromContext   *string              `mapstructure:"from_context"`
-	FromAttribute *string              `mapstructure:"from_attribute"`
-	DefaultValue  *configopaque.String `mapstructure:"default_value"`
+	Action        ActionValue `mapstructure:"action"`
+	Key           *string     `mapstructure:"key"`
+	Value         *string     `mapstructure:"value"`
+	FromContext   *string     `mapstructure:"from_context"`
+	FromAttribute *string     `mapstructure:"from_attribute"`
+	// FromExpression is an OTTL value expression evaluated over the resources
+	// of the outgoing batch, e.g. `resource.attributes["k8s.namespace.name"]`.
+	FromExpression *string `mapstructure:"from_expression"`
+	// MixedValues defines the value used when the resources of the outgoing batch
+	// evaluate to different values: "default" (the default), "first" or "error".
+	MixedValues  source.MixedBatch    `mapstructure:"mixed_values"`
+	DefaultValue *configopaque.String `mapstructure:"default_value"`
 }
 
 // ActionValue is the enum to capture the four types of actions to perform on a header
@@ -63,15 +77,34 @@ func (cfg *Config) Validate() error {
 		}
 
 		if header.Action != DELETE {
-			if header.FromContext == nil && header.FromAttribute == nil && header.Value == nil {
+			sources := 0
+			for _, s := range []*string{header.FromContext, header.FromAttribute, header.FromExpression, header.Value} {
+				if s != nil {
+					sources++
+				}
+			}
+			if sources == 0 {
 				return errMissingSource
 			}
-			if (header.FromContext != nil && header.FromAttribute != nil) ||
-				(header.FromContext != nil && header.Value != nil) ||
-				(header.Value != nil && header.FromAttribute != nil) {
+			if sources > 1 {
 				return errConflictingSources
 			}
 		}
+
+		if header.FromExpression == nil {
+			if header.MixedValues != "" {
+				return errMixedValuesSource
+			}
+			continue
+		}
+		switch header.MixedValues {
+		case "", source.MixedBatchDefault, source.MixedBatchFirst, source.MixedBatchError:
+		default:
+			return fmt.Errorf("invalid 'mixed_values' %q, must be %q, %q or %q", header.MixedValues, source.MixedBatchDefault, source.MixedBatchFirst, source.MixedBatchError)
+		}
+		if _, err := source.NewExpressionSource(*header.FromExpression, "", header.MixedValues, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
+			return fmt.Errorf("invalid 'from_expression' %q: %w", *header.FromExpression, err)
+		}
 	}
 	return nil
 }
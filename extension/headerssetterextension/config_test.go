@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -64,6 +65,20 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "3"),
+			expected: &Config{
+				HeadersConfig: []HeaderConfig{
+					{
+						Key:            stringp("X-Scope-OrgID"),
+						Action:         UPSERT,
+						FromExpression: stringp(`resource.attributes["k8s.namespace.name"]`),
+						MixedValues:    source.MixedBatchError,
+						DefaultValue:   opaquep("anonymous"),
+					},
+				},
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "2"),
 			expected: &Config{
@@ -215,6 +230,42 @@ func TestValidateConfig(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"header value from expression",
+			[]HeaderConfig{
+				{
+					Key:            stringp("name"),
+					Action:         INSERT,
+					FromExpression: stringp(`resource.attributes["k8s.namespace.name"]`),
+					MixedValues:    source.MixedBatchError,
+				},
+			},
+			nil,
+		},
+		{
+			"header value from expression and context",
+			[]HeaderConfig{
+				{
+					Key:            stringp("name"),
+					Action:         INSERT,
+					FromContext:    stringp("from context"),
+					FromExpression: stringp(`resource.attributes["k8s.namespace.name"]`),
+				},
+			},
+			errConflictingSources,
+		},
+		{
+			"mixed values without expression",
+			[]HeaderConfig{
+				{
+					Key:         stringp("name"),
+					Action:      INSERT,
+					FromContext: stringp("from context"),
+					MixedValues: source.MixedBatchFirst,
+				},
+			},
+			errMixedValuesSource,
+		},
 		{
 			"headers configuration is missing",
 			nil,
@@ -228,3 +279,18 @@ func TestValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfigInvalidExpression(t *testing.T) {
+	cfg := Config{HeadersConfig: []HeaderConfig{
+		{
+			Key:            stringp("name"),
+			FromExpression: stringp(`resource.attributes["k8s.namespace.name"]`),
+			MixedValues:    "last",
+		},
+	}}
+	require.ErrorContains(t, cfg.Validate(), `invalid 'mixed_values' "last"`)
+
+	cfg.HeadersConfig[0].MixedValues = ""
+	cfg.HeadersConfig[0].FromExpression = stringp(`resource.unknown`)
+	require.ErrorContains(t, cfg.Validate(), `invalid 'from_expression' "resource.unknown"`)
+}
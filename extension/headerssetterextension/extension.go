@@ -40,6 +40,8 @@ type headerSetterExtension struct {
 	headers        []header
 	additionalAuth *component.ID
 	host           component.Host
+	// readResources is set when a header is computed from the resources of the outgoing batch.
+	readResources bool
 }
 
 // Dependencies implements extensioncapabilities.Dependent.
@@ -73,6 +75,11 @@ func (h *headerSetterExtension) getAdditionalAuthExtension() (component.Componen
 
 // PerRPCCredentials implements extensionauth.GRPCClient.
 func (h *headerSetterExtension) PerRPCCredentials() (credentials.PerRPCCredentials, error) {
+	// the gRPC request metadata is computed without access to the request message
+	if h.readResources {
+		return nil, errExpressionUnsupported
+	}
+
 	var baseCredentials credentials.PerRPCCredentials
 
 	// If additional_auth is configured, chain with it first
@@ -118,8 +125,9 @@ func (h *headerSetterExtension) RoundTripper(base http.RoundTripper) (http.Round
 
 	// Now wrap with our headers
 	return &headersRoundTripper{
-		base:    baseRT,
-		headers: h.headers,
+		base:          baseRT,
+		headers:       h.headers,
+		readResources: h.readResources,
 	}, nil
 }
 
@@ -128,6 +136,7 @@ func newHeadersSetterExtension(cfg *Config, logger *zap.Logger) (*headerSetterEx
 		return nil, errors.New("extension configuration is not provided")
 	}
 
+	readResources := false
 	headers := make([]header, 0, len(cfg.HeadersConfig))
 	for _, h := range cfg.HeadersConfig {
 		var s source.Source
@@ -154,6 +163,21 @@ func newHeadersSetterExtension(cfg *Config, logger *zap.Logger) (*headerSetterEx
 				Key:          *h.FromContext,
 				DefaultValue: defaultValue,
 			}
+		case h.FromExpression != nil:
+			defaultValue := ""
+			if h.DefaultValue != nil {
+				defaultValue = string(*h.DefaultValue)
+			}
+			mixedValues := h.MixedValues
+			if mixedValues == "" {
+				mixedValues = source.MixedBatchDefault
+			}
+			var err error
+			s, err = source.NewExpressionSource(*h.FromExpression, defaultValue, mixedValues, component.TelemetrySettings{Logger: logger})
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse the expression %q: %w", *h.FromExpression, err)
+			}
+			readResources = true
 		}
 
 		var a action.Action
@@ -177,6 +201,7 @@ func newHeadersSetterExtension(cfg *Config, logger *zap.Logger) (*headerSetterEx
 	ext := &headerSetterExtension{
 		headers:        headers,
 		additionalAuth: cfg.AdditionalAuth,
+		readResources:  readResources,
 	}
 
 	// Enable Start method if additional_auth is configured
@@ -234,8 +259,9 @@ func (h *headersPerRPC) RequireTransportSecurity() bool {
 // headersRoundTripper intercepts downstream requests and sets headers with
 // values extracted from configured sources.
 type headersRoundTripper struct {
-	base    http.RoundTripper
-	headers []header
+	base          http.RoundTripper
+	headers       []header
+	readResources bool
 }
 
 // RoundTrip copies the original request and sets headers of the new requests
@@ -245,8 +271,17 @@ func (h *headersRoundTripper) RoundTrip(req *http.Request) (*http.Response, erro
 	if req2.Header == nil {
 		req2.Header = make(http.Header)
 	}
+	ctx := req.Context()
+	if h.readResources {
+		resources, err := requestResources(req2)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the resources of the request: %w", err)
+		}
+		defer closeResources(resources)
+		ctx = source.ContextWithResources(ctx, resources)
+	}
 	for _, header := range h.headers {
-		value, err := header.source.Get(req.Context())
+		value, err := header.source.Get(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to determine the source: %w", err)
 		}
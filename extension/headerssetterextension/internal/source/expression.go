@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+var _ Source = (*ExpressionSource)(nil)
+
+// MixedBatch defines how the value of a header is determined when the resources
+// of the outgoing batch evaluate to different values.
+type MixedBatch string
+
+const (
+	// MixedBatchDefault uses the default value.
+	MixedBatchDefault MixedBatch = "default"
+	// MixedBatchFirst uses the value of the first resource.
+	MixedBatchFirst MixedBatch = "first"
+	// MixedBatchError fails the request.
+	MixedBatchError MixedBatch = "error"
+)
+
+type resourcesContextKey struct{}
+
+// ContextWithResources returns a context giving access to the resources of the outgoing batch.
+func ContextWithResources(ctx context.Context, resources []*ottlresource.TransformContext) context.Context {
+	return context.WithValue(ctx, resourcesContextKey{}, resources)
+}
+
+func resourcesFromContext(ctx context.Context) []*ottlresource.TransformContext {
+	resources, _ := ctx.Value(resourcesContextKey{}).([]*ottlresource.TransformContext)
+	return resources
+}
+
+// ExpressionSource computes the value of a header with an OTTL value expression
+// over the resources of the outgoing batch.
+type ExpressionSource struct {
+	Expression   *ottl.ValueExpression[*ottlresource.TransformContext]
+	DefaultValue string
+	MixedBatch   MixedBatch
+}
+
+// NewExpressionSource parses the given OTTL value expression.
+func NewExpressionSource(expression, defaultValue string, mixedBatch MixedBatch, set component.TelemetrySettings) (*ExpressionSource, error) {
+	parser, err := ottlresource.NewParser(
+		ottlfuncs.StandardConverters[*ottlresource.TransformContext](),
+		set,
+		ottlresource.EnablePathContextNames(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := parser.ParseValueExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	return &ExpressionSource{
+		Expression:   parsed,
+		DefaultValue: defaultValue,
+		MixedBatch:   mixedBatch,
+	}, nil
+}
+
+// Get evaluates the expression for each resource of the outgoing batch. Resources for which the
+// expression evaluates to nil or an empty string are ignored, and the default value is used
+// when none of them has a value or when the batch is not available.
+func (ts *ExpressionSource) Get(ctx context.Context) (string, error) {
+	var value string
+	found := false
+	for _, tCtx := range resourcesFromContext(ctx) {
+		v, err := ts.Expression.Eval(ctx, tCtx)
+		if err != nil {
+			return "", err
+		}
+		s, err := toString(v)
+		if err != nil {
+			return "", err
+		}
+		if s == "" {
+			continue
+		}
+		switch {
+		case !found:
+			value = s
+			found = true
+			if ts.MixedBatch == MixedBatchFirst {
+				return value, nil
+			}
+		case s != value:
+			if ts.MixedBatch == MixedBatchError {
+				return "", fmt.Errorf("the resources of the batch have different values: %q and %q", value, s)
+			}
+			return ts.DefaultValue, nil
+		}
+	}
+	if !found {
+		return ts.DefaultValue, nil
+	}
+	return value, nil
+}
+
+func toString(v any) (string, error) {
+	switch val := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return val, nil
+	case pcommon.Value:
+		return val.AsString(), nil
+	case pcommon.Map:
+		b, err := json.Marshal(val.AsRaw())
+		return string(b), err
+	case pcommon.Slice:
+		b, err := json.Marshal(val.AsRaw())
+		return string(b), err
+	default:
+		b, err := json.Marshal(val)
+		return string(b), err
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+)
+
+func newResources(t *testing.T, namespaces ...string) []*ottlresource.TransformContext {
+	traces := ptrace.NewTraces()
+	resources := make([]*ottlresource.TransformContext, 0, len(namespaces))
+	for _, ns := range namespaces {
+		rs := traces.ResourceSpans().AppendEmpty()
+		if ns != "" {
+			rs.Resource().Attributes().PutStr("k8s.namespace.name", ns)
+		}
+		resources = append(resources, ottlresource.NewTransformContextPtr(rs.Resource(), rs))
+	}
+	t.Cleanup(func() {
+		for _, tCtx := range resources {
+			tCtx.Close()
+		}
+	})
+	return resources
+}
+
+func TestExpressionSource(t *testing.T) {
+	tests := []struct {
+		name       string
+		mixedBatch MixedBatch
+		namespaces []string
+		expected   string
+		err        string
+	}{
+		{
+			name:     "no batch",
+			expected: "default",
+		},
+		{
+			name:       "single resource",
+			namespaces: []string{"acme"},
+			expected:   "acme",
+		},
+		{
+			name:       "same values",
+			namespaces: []string{"acme", "", "acme"},
+			expected:   "acme",
+		},
+		{
+			name:       "no values",
+			namespaces: []string{"", ""},
+			expected:   "default",
+		},
+		{
+			name:       "mixed values use the default value",
+			mixedBatch: MixedBatchDefault,
+			namespaces: []string{"acme", "globex"},
+			expected:   "default",
+		},
+		{
+			name:       "mixed values use the first value",
+			mixedBatch: MixedBatchFirst,
+			namespaces: []string{"", "acme", "globex"},
+			expected:   "acme",
+		},
+		{
+			name:       "mixed values fail",
+			mixedBatch: MixedBatchError,
+			namespaces: []string{"acme", "globex"},
+			err:        `the resources of the batch have different values: "acme" and "globex"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := NewExpressionSource(`resource.attributes["k8s.namespace.name"]`, "default", tt.mixedBatch, component.TelemetrySettings{Logger: zap.NewNop()})
+			require.NoError(t, err)
+
+			ctx := t.Context()
+			if tt.namespaces != nil {
+				ctx = ContextWithResources(ctx, newResources(t, tt.namespaces...))
+			}
+			val, err := ts.Get(ctx)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, val)
+		})
+	}
+}
+
+func TestExpressionSourceConverter(t *testing.T) {
+	ts, err := NewExpressionSource(`Concat(["tenant", resource.attributes["k8s.namespace.name"]], "-")`, "", MixedBatchDefault, component.TelemetrySettings{Logger: zap.NewNop()})
+	require.NoError(t, err)
+
+	val, err := ts.Get(ContextWithResources(t.Context(), newResources(t, "acme")))
+	assert.NoError(t, err)
+	assert.Equal(t, "tenant-acme", val)
+}
+
+func TestExpressionSourceInvalid(t *testing.T) {
+	_, err := NewExpressionSource(`resource.unknown`, "", MixedBatchDefault, component.TelemetrySettings{Logger: zap.NewNop()})
+	assert.Error(t, err)
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package headerssetterextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+)
+
+// snappyFramingHeader is the first 10 bytes of a snappy framed stream.
+var snappyFramingHeader = []byte{0xff, 0x06, 0x00, 0x00, 's', 'N', 'a', 'P', 'p', 'Y'}
+
+// requestResources returns the resources of the OTLP batch sent with the request, leaving the
+// request body readable by the next round trippers. It fails for requests that are not OTLP/HTTP
+// export requests, whose resources can't be read.
+func requestResources(req *http.Request) ([]*ottlresource.TransformContext, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	var unmarshal func(data []byte, json bool) ([]*ottlresource.TransformContext, error)
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/v1/traces"):
+		unmarshal = tracesResources
+	case strings.HasSuffix(req.URL.Path, "/v1/metrics"):
+		unmarshal = metricsResources
+	case strings.HasSuffix(req.URL.Path, "/v1/logs"):
+		unmarshal = logsResources
+	default:
+		return nil, fmt.Errorf("%w, the request to %q is not an OTLP export request", errExpressionUnsupported, req.URL.Path)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = req.Body.Close(); err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	encoding := req.Header.Get("Content-Encoding")
+	data, err := decompress(encoding, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress the request body: %w", err)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+	return unmarshal(data, strings.HasPrefix(req.Header.Get("Content-Type"), "application/json"))
+}
+
+// decompress returns the decompressed payload, or nil if the content encoding is not supported.
+func decompress(encoding string, body []byte) ([]byte, error) {
+	var r io.Reader
+	switch encoding {
+	case "", "identity":
+		return body, nil
+	case "gzip":
+		zr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	case "zlib", "deflate":
+		zr, err := zlib.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	case "zstd":
+		zr, err := zstd.NewReader(bytes.NewReader(body), zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	case "snappy", "x-snappy-framed":
+		if bytes.HasPrefix(body, snappyFramingHeader) {
+			r = snappy.NewReader(bytes.NewReader(body))
+		} else {
+			return snappy.Decode(nil, body)
+		}
+	default:
+		return nil, nil
+	}
+	return io.ReadAll(r)
+}
+
+func tracesResources(data []byte, json bool) ([]*ottlresource.TransformContext, error) {
+	req := ptraceotlp.NewExportRequest()
+	var err error
+	if json {
+		err = req.UnmarshalJSON(data)
+	} else {
+		err = req.UnmarshalProto(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rss := req.Traces().ResourceSpans()
+	resources := make([]*ottlresource.TransformContext, 0, rss.Len())
+	for i := 0; i < rss.Len(); i++ {
+		resources = append(resources, ottlresource.NewTransformContextPtr(rss.At(i).Resource(), rss.At(i)))
+	}
+	return resources, nil
+}
+
+func metricsResources(data []byte, json bool) ([]*ottlresource.TransformContext, error) {
+	req := pmetricotlp.NewExportRequest()
+	var err error
+	if json {
+		err = req.UnmarshalJSON(data)
+	} else {
+		err = req.UnmarshalProto(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rms := req.Metrics().ResourceMetrics()
+	resources := make([]*ottlresource.TransformContext, 0, rms.Len())
+	for i := 0; i < rms.Len(); i++ {
+		resources = append(resources, ottlresource.NewTransformContextPtr(rms.At(i).Resource(), rms.At(i)))
+	}
+	return resources, nil
+}
+
+func logsResources(data []byte, json bool) ([]*ottlresource.TransformContext, error) {
+	req := plogotlp.NewExportRequest()
+	var err error
+	if json {
+		err = req.UnmarshalJSON(data)
+	} else {
+		err = req.UnmarshalProto(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rls := req.Logs().ResourceLogs()
+	resources := make([]*ottlresource.TransformContext, 0, rls.Len())
+	for i := 0; i < rls.Len(); i++ {
+		resources = append(resources, ottlresource.NewTransformContextPtr(rls.At(i).Resource(), rls.At(i)))
+	}
+	return resources, nil
+}
+
+func closeResources(resources []*ottlresource.TransformContext) {
+	for _, tCtx := range resources {
+		tCtx.Close()
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package headerssetterextension
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"go.uber.org/zap"
+)
+
+type bodyRoundTripper struct {
+	header http.Header
+	body   []byte
+}
+
+func (rt *bodyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	rt.header = req.Header
+	rt.body = body
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func tracesBody(t *testing.T, namespaces ...string) []byte {
+	traces := ptrace.NewTraces()
+	for _, ns := range namespaces {
+		traces.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("k8s.namespace.name", ns)
+	}
+	body, err := ptraceotlp.NewExportRequestFromTraces(traces).MarshalProto()
+	require.NoError(t, err)
+	return body
+}
+
+func gzipBody(t *testing.T, body []byte) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func zstdBody(t *testing.T, body []byte) []byte {
+	zw, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	defer zw.Close()
+	return zw.EncodeAll(body, nil)
+}
+
+func TestRoundTripperFromExpression(t *testing.T) {
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("k8s.namespace.name", "acme")
+	logsJSON, err := plogotlp.NewExportRequestFromLogs(logs).MarshalJSON()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		url             string
+		contentType     string
+		contentEncoding string
+		body            []byte
+		expected        string
+	}{
+		{
+			name:        "protobuf",
+			url:         "http://localhost:4318/v1/traces",
+			contentType: "application/x-protobuf",
+			body:        tracesBody(t, "acme", "acme"),
+			expected:    "acme",
+		},
+		{
+			name:            "gzip",
+			url:             "http://localhost:4318/v1/traces",
+			contentType:     "application/x-protobuf",
+			contentEncoding: "gzip",
+			body:            gzipBody(t, tracesBody(t, "acme")),
+			expected:        "acme",
+		},
+		{
+			name:            "zstd",
+			url:             "http://localhost:4318/v1/traces",
+			contentType:     "application/x-protobuf",
+			contentEncoding: "zstd",
+			body:            zstdBody(t, tracesBody(t, "acme")),
+			expected:        "acme",
+		},
+		{
+			name:            "snappy",
+			url:             "http://localhost:4318/v1/traces",
+			contentType:     "application/x-protobuf",
+			contentEncoding: "snappy",
+			body:            snappy.Encode(nil, tracesBody(t, "acme")),
+			expected:        "acme",
+		},
+		{
+			name:        "json",
+			url:         "http://localhost:4318/prefix/v1/logs",
+			contentType: "application/json",
+			body:        logsJSON,
+			expected:    "acme",
+		},
+		{
+			name:        "mixed batch",
+			url:         "http://localhost:4318/v1/traces",
+			contentType: "application/x-protobuf",
+			body:        tracesBody(t, "acme", "globex"),
+			expected:    "anonymous",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ext, err := newHeadersSetterExtension(&Config{
+				HeadersConfig: []HeaderConfig{
+					{
+						Key:            stringp("X-Scope-OrgID"),
+						Action:         UPSERT,
+						FromExpression: stringp(`resource.attributes["k8s.namespace.name"]`),
+						DefaultValue:   opaquep("anonymous"),
+					},
+				},
+			}, zap.NewNop())
+			require.NoError(t, err)
+
+			base := &bodyRoundTripper{}
+			rt, err := ext.RoundTripper(base)
+			require.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, tt.url, bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", tt.contentType)
+			if tt.contentEncoding != "" {
+				req.Header.Set("Content-Encoding", tt.contentEncoding)
+			}
+
+			_, err = rt.RoundTrip(req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, base.header.Get("X-Scope-OrgID"))
+			// the body is sent unchanged
+			assert.Equal(t, tt.body, base.body)
+		})
+	}
+}
+
+func TestRoundTripperFromExpressionInvalidRequest(t *testing.T) {
+	tests := []struct {
+		name            string
+		url             string
+		contentEncoding string
+		body            []byte
+		err             string
+	}{
+		{
+			name:            "invalid body",
+			url:             "http://localhost:4318/v1/traces",
+			contentEncoding: "gzip",
+			body:            []byte("not gzip"),
+			err:             "failed to read the resources of the request: failed to decompress the request body",
+		},
+		{
+			name: "not an OTLP request",
+			url:  "http://localhost:9090/api/v1/push",
+			body: tracesBody(t, "acme"),
+			err:  `'from_expression' is only supported by OTLP/HTTP exporters, the request to "/api/v1/push" is not an OTLP export request`,
+		},
+		{
+			name:            "unsupported encoding",
+			url:             "http://localhost:4318/v1/traces",
+			contentEncoding: "br",
+			body:            tracesBody(t, "acme"),
+			err:             `unsupported content encoding "br"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ext, err := newHeadersSetterExtension(&Config{
+				HeadersConfig: []HeaderConfig{
+					{
+						Key:            stringp("X-Scope-OrgID"),
+						FromExpression: stringp(`resource.attributes["k8s.namespace.name"]`),
+					},
+				},
+			}, zap.NewNop())
+			require.NoError(t, err)
+
+			rt, err := ext.RoundTripper(&bodyRoundTripper{})
+			require.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, tt.url, bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			if tt.contentEncoding != "" {
+				req.Header.Set("Content-Encoding", tt.contentEncoding)
+			}
+			_, err = rt.RoundTrip(req)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestPerRPCCredentialsFromExpression(t *testing.T) {
+	ext, err := newHeadersSetterExtension(&Config{
+		HeadersConfig: []HeaderConfig{
+			{
+				Key:            stringp("X-Scope-OrgID"),
+				FromExpression: stringp(`resource.attributes["k8s.namespace.name"]`),
+			},
+		},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	// the gRPC exporters fail to start rather than always sending the default value
+	_, err = ext.PerRPCCredentials()
+	assert.ErrorIs(t, err, errExpressionUnsupported)
+}
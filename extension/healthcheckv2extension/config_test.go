@@ -156,6 +156,53 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "v2httpprobes"),
+			expected: &Config{
+				LegacyConfig: healthcheck.HTTPLegacyConfig{
+					UseV2: true,
+					ServerConfig: confighttp.ServerConfig{
+						NetAddr: confignet.AddrConfig{
+							Transport: "tcp",
+							Endpoint:  testutil.EndpointForPort(healthcheck.DefaultHTTPPort),
+						},
+					},
+					Path: "/",
+				},
+				HTTPConfig: &healthcheck.HTTPConfig{
+					ServerConfig: confighttp.ServerConfig{
+						NetAddr: confignet.AddrConfig{
+							Transport: "tcp",
+							Endpoint:  "localhost:13",
+						},
+					},
+					Status: healthcheck.PathConfig{
+						Enabled: true,
+						Path:    "/status",
+					},
+					Config: healthcheck.PathConfig{
+						Enabled: false,
+						Path:    "/config",
+					},
+					Readiness: healthcheck.ReadinessConfig{
+						Enabled:    true,
+						Pipelines:  []string{"traces"},
+						Components: []string{"exporter:otlp"},
+						ComponentHealthConfig: healthcheck.ComponentHealthConfig{
+							IncludePermanent: true,
+						},
+					},
+					Liveness: healthcheck.PathConfig{
+						Enabled: true,
+						Path:    "/livez",
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "v2invalidreadinesspath"),
+			expectedErr: healthcheck.ErrInvalidPath,
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "v2httpmissingendpoint"),
 			expectedErr: healthcheck.ErrHTTPEndpointRequired,
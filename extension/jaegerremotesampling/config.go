@@ -10,11 +10,14 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source/rulesource"
 )
 
 var (
 	errTooManySources     = errors.New("too many sources specified, has to be either 'file' or 'remote'")
-	errNoSources          = errors.New("no sources specified, has to be either 'file' or 'remote'")
+	errNoSources          = errors.New("no sources specified, has to be either 'file', 'remote', 'rules' or 'rules_file'")
 	errAtLeastOneProtocol = errors.New("no protocols selected to serve the strategies, use 'grpc', 'http', or both")
 )
 
@@ -24,7 +27,8 @@ type Config struct {
 	HTTPServerConfig *confighttp.ServerConfig `mapstructure:"http"`
 	GRPCServerConfig *configgrpc.ServerConfig `mapstructure:"grpc"`
 
-	// Source configures the source for the strategies file. One of `remote` or `file` has to be specified.
+	// Source configures the source for the strategies file. One of `remote` or `file` has to be specified,
+	// unless the strategies are resolved from `rules` or a `rules_file` only.
 	Source Source `mapstructure:"source"`
 }
 
@@ -35,8 +39,46 @@ type Source struct {
 	// File specifies a local file as the strategies source
 	File string `mapstructure:"file"`
 
-	// ReloadInterval determines the periodicity to refresh the strategies
+	// ReloadInterval determines the periodicity to refresh the strategies and the rules file
 	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+
+	// Rules resolve the strategies of the services from OTTL conditions. They are evaluated in order
+	// and take precedence over the `remote` or `file` strategies.
+	Rules []StrategyRule `mapstructure:"rules"`
+
+	// RulesFile specifies a local JSON file holding more rules under the `rules` key. They are evaluated
+	// after the `rules` of the configuration.
+	RulesFile string `mapstructure:"rules_file"`
+
+	// MatchAttributes are the attributes of the request, taken from the query parameters of HTTP requests
+	// or the metadata of gRPC requests, that the conditions of the rules can match on.
+	MatchAttributes []string `mapstructure:"match_attributes"`
+}
+
+// StrategyRule assigns a sampling strategy to the services matching its conditions.
+type StrategyRule struct {
+	// Name identifies the rule in the resolved strategies. It defaults to the index of the rule.
+	Name string `mapstructure:"name"`
+
+	// Conditions are OTTL conditions in the resource context, evaluated against a resource with the
+	// `service.name` attribute of the requesting service and its `match_attributes`. The rule applies
+	// if any condition matches.
+	Conditions []string `mapstructure:"conditions"`
+
+	// Type of the strategy, either `probabilistic` or `ratelimiting`.
+	Type string `mapstructure:"type"`
+
+	// Param is the sampling probability or the maximum number of traces per second, depending on the type.
+	Param float64 `mapstructure:"param"`
+}
+
+func (r StrategyRule) toRule() rulesource.Rule {
+	return rulesource.Rule{
+		Name:       r.Name,
+		Conditions: r.Conditions,
+		Type:       r.Type,
+		Param:      r.Param,
+	}
 }
 
 var _ component.Config = (*Config)(nil)
@@ -51,9 +93,15 @@ func (cfg *Config) Validate() error {
 		return errTooManySources
 	}
 
-	if cfg.Source.File == "" && cfg.Source.Remote == nil {
+	if cfg.Source.File == "" && cfg.Source.Remote == nil && len(cfg.Source.Rules) == 0 && cfg.Source.RulesFile == "" {
 		return errNoSources
 	}
 
+	for _, rule := range cfg.Source.Rules {
+		if err := rulesource.ValidateRule(rule.toRule(), component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
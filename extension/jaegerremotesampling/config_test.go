@@ -62,6 +62,38 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "rules"),
+			expected: &Config{
+				HTTPServerConfig: &confighttp.ServerConfig{NetAddr: confignet.AddrConfig{
+					Endpoint:  "localhost:5778",
+					Transport: confignet.TransportTypeTCP,
+				}},
+				GRPCServerConfig: &configgrpc.ServerConfig{NetAddr: confignet.AddrConfig{
+					Endpoint:  "localhost:14250",
+					Transport: confignet.TransportTypeTCP,
+				}},
+				Source: Source{
+					ReloadInterval:  30 * time.Second,
+					File:            "/etc/otelcol/sampling_strategies.json",
+					RulesFile:       "/etc/otelcol/sampling_rules.json",
+					MatchAttributes: []string{"service.namespace"},
+					Rules: []StrategyRule{
+						{
+							Name:       "payments",
+							Conditions: []string{`IsMatch(resource.attributes["service.name"], "^payments-.*")`},
+							Type:       "ratelimiting",
+							Param:      10,
+						},
+						{
+							Conditions: []string{`resource.attributes["service.name"] == "frontend"`},
+							Type:       "probabilistic",
+							Param:      1,
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -107,6 +139,32 @@ func TestValidate(t *testing.T) {
 			},
 			expected: errTooManySources,
 		},
+		{
+			desc: "rules file only",
+			cfg: Config{
+				GRPCServerConfig: &configgrpc.ServerConfig{},
+				Source: Source{
+					RulesFile: "/etc/otelcol/sampling_rules.json",
+				},
+			},
+			expected: nil,
+		},
+		{
+			desc: "rules only",
+			cfg: Config{
+				GRPCServerConfig: &configgrpc.ServerConfig{},
+				Source: Source{
+					Rules: []StrategyRule{
+						{
+							Conditions: []string{`resource.attributes["service.name"] == "frontend"`},
+							Type:       "probabilistic",
+							Param:      0.5,
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
 	}
 	for _, tC := range testCases {
 		t.Run(tC.desc, func(t *testing.T) {
@@ -115,3 +173,19 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRules(t *testing.T) {
+	cfg := Config{
+		GRPCServerConfig: &configgrpc.ServerConfig{},
+		Source: Source{
+			Rules: []StrategyRule{
+				{
+					Name:       "invalid",
+					Conditions: []string{`resource.attributes["service.name"] == "frontend"`},
+					Type:       "adaptive",
+				},
+			},
+		},
+	}
+	assert.ErrorContains(t, cfg.Validate(), `rule "invalid": unknown strategy type "adaptive"`)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source/filesource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source/remotesource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source/rulesource"
 )
 
 var _ extension.Extension = (*jrsExtension)(nil)
@@ -75,6 +76,34 @@ func (jrse *jrsExtension) Start(ctx context.Context, host component.Host) error
 		jrse.samplingStore = remoteStore
 	}
 
+	if len(jrse.cfg.Source.Rules) > 0 || jrse.cfg.Source.RulesFile != "" {
+		fallback := jrse.samplingStore
+		if fallback == nil {
+			// without a file or remote source, the services not matching any rule get the default strategy
+			var err error
+			fallback, err = filesource.NewFileSource(filesource.Options{}, jrse.telemetry.Logger)
+			if err != nil {
+				return fmt.Errorf("failed to create the default strategy store: %w", err)
+			}
+		}
+
+		rules := make([]rulesource.Rule, 0, len(jrse.cfg.Source.Rules))
+		for _, rule := range jrse.cfg.Source.Rules {
+			rules = append(rules, rule.toRule())
+		}
+		opts := rulesource.Options{
+			Rules:           rules,
+			RulesFile:       jrse.cfg.Source.RulesFile,
+			ReloadInterval:  jrse.cfg.Source.ReloadInterval,
+			MatchAttributes: jrse.cfg.Source.MatchAttributes,
+		}
+		ruleStore, err := rulesource.NewRuleSource(opts, fallback, jrse.telemetry)
+		if err != nil {
+			return fmt.Errorf("failed to create the rule strategy store: %w", err)
+		}
+		jrse.samplingStore = ruleStore
+	}
+
 	if jrse.cfg.HTTPServerConfig != nil {
 		httpServer, err := http.NewHTTP(jrse.telemetry, *jrse.cfg.HTTPServerConfig, jrse.samplingStore)
 		if err != nil {
@@ -46,6 +46,33 @@ func TestStartAndShutdownLocalFile(t *testing.T) {
 	assert.NoError(t, e.Shutdown(t.Context()))
 }
 
+func TestStartAndShutdownRules(t *testing.T) {
+	// prepare
+	cfg := testConfig()
+	cfg.Source.Rules = []StrategyRule{
+		{
+			Conditions: []string{`resource.attributes["service.name"] == "foo"`},
+			Type:       "probabilistic",
+			Param:      1,
+		},
+	}
+
+	e := newExtension(cfg, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, e)
+	require.NoError(t, e.Start(t.Context(), componenttest.NewNopHost()))
+
+	// test and verify
+	strategy, err := e.samplingStore.GetSamplingStrategy(t.Context(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, strategy.ProbabilisticSampling.SamplingRate)
+
+	strategy, err = e.samplingStore.GetSamplingStrategy(t.Context(), "bar")
+	require.NoError(t, err)
+	assert.Equal(t, 0.001, strategy.ProbabilisticSampling.SamplingRate)
+
+	assert.NoError(t, e.Shutdown(t.Context()))
+}
+
 func TestRemote(t *testing.T) {
 	for _, tc := range []struct {
 		name                          string
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/jaegertracing/jaeger-idl/proto-gen/api_v2"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source"
 )
@@ -26,5 +27,20 @@ func NewGRPCHandler(provider source.Source) GRPCHandler {
 
 // GetSamplingStrategy returns sampling decision from store.
 func (s GRPCHandler) GetSamplingStrategy(ctx context.Context, param *api_v2.SamplingStrategyParameters) (*api_v2.SamplingStrategyResponse, error) {
-	return s.samplingProvider.GetSamplingStrategy(ctx, param.GetServiceName())
+	return s.samplingProvider.GetSamplingStrategy(contextWithMetadata(ctx), param.GetServiceName())
+}
+
+// contextWithMetadata makes the metadata of the request available to the source as attributes.
+func contextWithMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	attributes := make(map[string]string, len(md))
+	for key, values := range md {
+		if len(values) > 0 {
+			attributes[key] = values[0]
+		}
+	}
+	return source.ContextWithAttributes(ctx, attributes)
 }
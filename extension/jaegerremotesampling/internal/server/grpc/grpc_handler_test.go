@@ -12,6 +12,10 @@ import (
 	"github.com/jaegertracing/jaeger-idl/proto-gen/api_v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/mocks"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source"
 )
 
 type mockSamplingStore struct{}
@@ -52,3 +56,18 @@ func TestNewGRPCHandler(t *testing.T) {
 		}
 	}
 }
+
+func TestGRPCHandlerMetadataAttributes(t *testing.T) {
+	var attributes map[string]string
+	h := NewGRPCHandler(&mocks.MockCfgMgr{
+		GetSamplingStrategyFunc: func(ctx context.Context, _ string) (*api_v2.SamplingStrategyResponse, error) {
+			attributes = source.AttributesFromContext(ctx)
+			return &api_v2.SamplingStrategyResponse{}, nil
+		},
+	})
+
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("service.namespace", "payments"))
+	_, err := h.GetSamplingStrategy(ctx, &api_v2.SamplingStrategyParameters{ServiceName: "foo"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"service.namespace": "payments"}, attributes)
+}
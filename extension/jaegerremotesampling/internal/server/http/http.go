@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/jaegertracing/jaeger-idl/proto-gen/api_v2"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componentstatus"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -23,6 +24,14 @@ var errMissingStrategyStore = errors.New("the strategy store has not been provid
 
 var _ component.Component = (*SamplingHTTPServer)(nil)
 
+// resolvedStrategy is the response of the endpoint inspecting the strategy resolved for a service.
+type resolvedStrategy struct {
+	Service string `json:"service"`
+	// Rule is the name of the rule the strategy was resolved from, empty when no rule applies.
+	Rule     string                           `json:"rule,omitempty"`
+	Strategy *api_v2.SamplingStrategyResponse `json:"strategy"`
+}
+
 type SamplingHTTPServer struct {
 	telemetry     component.TelemetrySettings
 	settings      confighttp.ServerConfig
@@ -50,6 +59,7 @@ func NewHTTP(telemetry component.TelemetrySettings, settings confighttp.ServerCo
 
 	// SEE: https://www.jaegertracing.io/docs/1.41/apis/#remote-sampling-configuration-stable
 	srv.mux.Handle("/sampling", http.HandlerFunc(srv.samplingStrategyHandler))
+	srv.mux.Handle("/sampling/resolved", http.HandlerFunc(srv.resolvedStrategyHandler))
 
 	return srv, nil
 }
@@ -93,7 +103,7 @@ func (h *SamplingHTTPServer) samplingStrategyHandler(rw http.ResponseWriter, r *
 		return
 	}
 
-	resp, err := h.strategyStore.GetSamplingStrategy(r.Context(), svc)
+	resp, err := h.strategyStore.GetSamplingStrategy(contextWithQuery(r), svc)
 	if err != nil {
 		err = fmt.Errorf("failed to get sampling strategy for service %q: %w", svc, err)
 		http.Error(rw, err.Error(), http.StatusInternalServerError)
@@ -114,3 +124,52 @@ func (h *SamplingHTTPServer) samplingStrategyHandler(rw http.ResponseWriter, r *
 		return
 	}
 }
+
+func (h *SamplingHTTPServer) resolvedStrategyHandler(rw http.ResponseWriter, r *http.Request) {
+	svc := r.URL.Query().Get("service")
+	if svc == "" {
+		err := errors.New("'service' parameter must be provided")
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resolved := resolvedStrategy{Service: svc}
+	var err error
+	if resolver, ok := h.strategyStore.(source.Resolver); ok {
+		resolved.Strategy, resolved.Rule, err = resolver.ResolveSamplingStrategy(contextWithQuery(r), svc)
+	} else {
+		resolved.Strategy, err = h.strategyStore.GetSamplingStrategy(contextWithQuery(r), svc)
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to get sampling strategy for service %q: %w", svc, err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonBytes, err := json.Marshal(resolved)
+	if err != nil {
+		err = fmt.Errorf("cannot convert resolved sampling strategy to JSON: %w", err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	if _, err := rw.Write(jsonBytes); err != nil {
+		err = fmt.Errorf("cannot write response to client: %w", err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// contextWithQuery makes the query parameters of the request, other than the service, available to the
+// source as attributes.
+func contextWithQuery(r *http.Request) context.Context {
+	query := r.URL.Query()
+	attributes := make(map[string]string, len(query))
+	for key, values := range query {
+		if key != "service" && len(values) > 0 {
+			attributes[key] = values[0]
+		}
+	}
+	return source.ContextWithAttributes(r.Context(), attributes)
+}
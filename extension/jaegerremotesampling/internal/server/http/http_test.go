@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/config/confignet"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/mocks"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source"
 )
 
 func TestMissingClientConfigManagerHTTP(t *testing.T) {
@@ -135,3 +136,94 @@ func TestErrorFromClientConfigManager(t *testing.T) {
 	body, _ := io.ReadAll(rw.Body)
 	assert.Contains(t, string(body), "failed to get sampling strategy for service")
 }
+
+type resolverMock struct {
+	mocks.MockCfgMgr
+}
+
+func (*resolverMock) ResolveSamplingStrategy(_ context.Context, serviceName string) (*api_v2.SamplingStrategyResponse, string, error) {
+	if serviceName != "checkout" {
+		return &api_v2.SamplingStrategyResponse{}, "", nil
+	}
+	return &api_v2.SamplingStrategyResponse{
+		StrategyType: api_v2.SamplingStrategyType_RATE_LIMITING,
+		RateLimitingSampling: &api_v2.RateLimitingSamplingStrategy{
+			MaxTracesPerSecond: 5,
+		},
+	}, "payments", nil
+}
+
+func TestResolvedStrategy(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		store    source.Source
+		service  string
+		expected string
+	}{
+		{
+			desc:     "matching rule",
+			store:    &resolverMock{},
+			service:  "checkout",
+			expected: `{"service":"checkout","rule":"payments","strategy":{"strategyType":1,"rateLimitingSampling":{"maxTracesPerSecond":5}}}`,
+		},
+		{
+			desc:     "no matching rule",
+			store:    &resolverMock{},
+			service:  "frontend",
+			expected: `{"service":"frontend","strategy":{}}`,
+		},
+		{
+			desc: "source without rules",
+			store: &mocks.MockCfgMgr{
+				GetSamplingStrategyFunc: func(_ context.Context, _ string) (*api_v2.SamplingStrategyResponse, error) {
+					return &api_v2.SamplingStrategyResponse{
+						ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+							SamplingRate: 1,
+						},
+					}, nil
+				},
+			},
+			service:  "frontend",
+			expected: `{"service":"frontend","strategy":{"probabilisticSampling":{"samplingRate":1}}}`,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			s, err := NewHTTP(componenttest.NewNopTelemetrySettings(), confighttp.ServerConfig{}, tC.store)
+			require.NoError(t, err)
+
+			srv := httptest.NewServer(s.mux)
+			defer srv.Close()
+
+			resp, err := srv.Client().Get(fmt.Sprintf("%s/sampling/resolved?service=%s", srv.URL, tC.service))
+			require.NoError(t, err)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.JSONEq(t, tC.expected, string(body))
+		})
+	}
+}
+
+func TestQueryAttributes(t *testing.T) {
+	var attributes map[string]string
+	s, err := NewHTTP(componenttest.NewNopTelemetrySettings(), confighttp.ServerConfig{}, &mocks.MockCfgMgr{
+		GetSamplingStrategyFunc: func(ctx context.Context, _ string) (*api_v2.SamplingStrategyResponse, error) {
+			attributes = source.AttributesFromContext(ctx)
+			return &api_v2.SamplingStrategyResponse{}, nil
+		},
+	})
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(s.mux)
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL + "/sampling?service=foo&service.namespace=payments")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, map[string]string{"service.namespace": "payments"}, attributes)
+}
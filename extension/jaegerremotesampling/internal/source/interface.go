@@ -19,3 +19,24 @@ type Source interface {
 	// GetSamplingStrategy retrieves the sampling strategy for the specified service.
 	GetSamplingStrategy(ctx context.Context, serviceName string) (*api_v2.SamplingStrategyResponse, error)
 }
+
+// Resolver is implemented by the sources that can report how the sampling strategy of a service is resolved.
+type Resolver interface {
+	// ResolveSamplingStrategy retrieves the sampling strategy for the specified service, together with
+	// the name of the rule it was resolved from. The name is empty when no rule applies to the service.
+	ResolveSamplingStrategy(ctx context.Context, serviceName string) (*api_v2.SamplingStrategyResponse, string, error)
+}
+
+type attributesKey struct{}
+
+// ContextWithAttributes returns a copy of ctx carrying the attributes sent along with the request for a
+// sampling strategy, such as the query parameters of an HTTP request or the metadata of a gRPC request.
+func ContextWithAttributes(ctx context.Context, attributes map[string]string) context.Context {
+	return context.WithValue(ctx, attributesKey{}, attributes)
+}
+
+// AttributesFromContext returns the attributes sent along with the request for a sampling strategy.
+func AttributesFromContext(ctx context.Context) map[string]string {
+	attributes, _ := ctx.Value(attributesKey{}).(map[string]string)
+	return attributes
+}
@@ -0,0 +1,303 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rulesource // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source/rulesource"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jaegertracing/jaeger-idl/proto-gen/api_v2"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+)
+
+const (
+	// SamplerTypeProbabilistic is the type of sampler that samples traces
+	// with a certain fixed probability.
+	SamplerTypeProbabilistic = "probabilistic"
+
+	// SamplerTypeRateLimiting is the type of sampler that samples
+	// only up to a fixed number of traces per second.
+	SamplerTypeRateLimiting = "ratelimiting"
+
+	serviceNameKey = "service.name"
+)
+
+// Options holds the configuration of the rule source.
+type Options struct {
+	// Rules are evaluated before the rules of the RulesFile.
+	Rules []Rule
+	// RulesFile is the path of a JSON file holding more rules under the "rules" key.
+	RulesFile string
+	// ReloadInterval is the time interval to check and reload the RulesFile. The file is not reloaded when zero.
+	ReloadInterval time.Duration
+	// MatchAttributes are the attributes of the request set on the resource the conditions are evaluated
+	// against, along with the service.name of the requesting service.
+	MatchAttributes []string
+}
+
+// Rule assigns a sampling strategy to the services matching its conditions.
+type Rule struct {
+	// Name identifies the rule when inspecting the resolved strategies. It defaults to the index of the rule.
+	Name string `json:"name"`
+	// Conditions are OTTL conditions in the resource context, any of them has to match.
+	Conditions []string `json:"conditions"`
+	// Type is either "probabilistic" or "ratelimiting".
+	Type string `json:"type"`
+	// Param is the sampling probability or the maximum number of traces per second, depending on Type.
+	Param float64 `json:"param"`
+}
+
+type rulesFile struct {
+	Rules []Rule `json:"rules"`
+}
+
+type compiledRule struct {
+	name       string
+	conditions *ottl.ConditionSequence[*ottlresource.TransformContext]
+	strategy   *api_v2.SamplingStrategyResponse
+}
+
+type ruleSource struct {
+	// configRules are the rules of the configuration, rules holds them followed by the rules of the file.
+	configRules     []compiledRule
+	rules           atomic.Pointer[[]compiledRule]
+	matchAttributes []string
+	fallback        source.Source
+	set             component.TelemetrySettings
+	logger          *zap.Logger
+
+	cancelFunc context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+var (
+	_ source.Source   = (*ruleSource)(nil)
+	_ source.Resolver = (*ruleSource)(nil)
+)
+
+// NewRuleSource creates a source resolving the strategy of a service from the first matching rule.
+// The strategies of the services not matching any rule are retrieved from the fallback source, which
+// keeps reloading its own strategies. The fallback source is closed along with the returned source.
+func NewRuleSource(options Options, fallback source.Source, set component.TelemetrySettings) (source.Source, error) {
+	rs := &ruleSource{
+		matchAttributes: options.MatchAttributes,
+		fallback:        fallback,
+		set:             set,
+		logger:          set.Logger,
+	}
+	rules, err := compileRules("rules", options.Rules, set)
+	if err != nil {
+		return nil, err
+	}
+	rs.configRules = rules
+	rs.rules.Store(&rules)
+
+	if options.RulesFile == "" {
+		return rs, nil
+	}
+
+	data, err := readRulesFile(options.RulesFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := rs.updateFileRules(data); err != nil {
+		return nil, err
+	}
+
+	if options.ReloadInterval > 0 {
+		var ctx context.Context
+		ctx, rs.cancelFunc = context.WithCancel(context.Background())
+		rs.wg.Add(1)
+		go rs.autoUpdateRules(ctx, options.ReloadInterval, options.RulesFile, string(data))
+	}
+	return rs, nil
+}
+
+func readRulesFile(rulesFile string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Clean(rulesFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules file %s: %w", rulesFile, err)
+	}
+	return data, nil
+}
+
+func (rs *ruleSource) autoUpdateRules(ctx context.Context, interval time.Duration, rulesFile, lastValue string) {
+	defer rs.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			lastValue = rs.reloadRules(rulesFile, lastValue)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reloadRules replaces the rules of the file when its content changed. The previous rules are kept
+// when the file cannot be read or holds invalid rules.
+func (rs *ruleSource) reloadRules(rulesFile, lastValue string) string {
+	newValue, err := readRulesFile(rulesFile)
+	if err != nil {
+		rs.logger.Error("failed to re-load sampling strategy rules", zap.Error(err))
+		return lastValue
+	}
+	if lastValue == string(newValue) {
+		return lastValue
+	}
+	if err := rs.updateFileRules(newValue); err != nil {
+		rs.logger.Error("failed to update sampling strategy rules", zap.Error(err))
+		return lastValue
+	}
+	rs.logger.Info("Updated sampling strategy rules", zap.String("filename", rulesFile))
+	return string(newValue)
+}
+
+func (rs *ruleSource) updateFileRules(data []byte) error {
+	var file rulesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to unmarshal sampling strategy rules: %w", err)
+	}
+	fileRules, err := compileRules("rules_file", file.Rules, rs.set)
+	if err != nil {
+		return err
+	}
+	rules := make([]compiledRule, 0, len(rs.configRules)+len(fileRules))
+	rules = append(rules, rs.configRules...)
+	rules = append(rules, fileRules...)
+	rs.rules.Store(&rules)
+	return nil
+}
+
+func compileRules(prefix string, rules []Rule, set component.TelemetrySettings) ([]compiledRule, error) {
+	compiled := make([]compiledRule, 0, len(rules))
+	for i, rule := range rules {
+		c, err := compileRule(fmt.Sprintf("%s[%d]", prefix, i), rule, set)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// ValidateRule checks that the rule can be compiled, without creating a source.
+func ValidateRule(rule Rule, set component.TelemetrySettings) error {
+	_, err := compileRule("rules[0]", rule, set)
+	return err
+}
+
+func compileRule(defaultName string, rule Rule, set component.TelemetrySettings) (compiledRule, error) {
+	name := rule.Name
+	if name == "" {
+		name = defaultName
+	}
+	if len(rule.Conditions) == 0 {
+		return compiledRule{}, fmt.Errorf("rule %q: at least one condition is required", name)
+	}
+	strategy, err := strategyResponse(rule.Type, rule.Param)
+	if err != nil {
+		return compiledRule{}, fmt.Errorf("rule %q: %w", name, err)
+	}
+	conditions, err := filterottl.NewBoolExprForResource(rule.Conditions, filterottl.StandardResourceFuncs(), ottl.PropagateError, set)
+	if err != nil {
+		return compiledRule{}, fmt.Errorf("rule %q: invalid conditions: %w", name, err)
+	}
+	return compiledRule{
+		name:       name,
+		conditions: conditions,
+		strategy:   strategy,
+	}, nil
+}
+
+func strategyResponse(strategyType string, param float64) (*api_v2.SamplingStrategyResponse, error) {
+	switch strategyType {
+	case SamplerTypeProbabilistic:
+		if param < 0 || param > 1 {
+			return nil, fmt.Errorf("the sampling probability must be between 0 and 1, got %v", param)
+		}
+		return &api_v2.SamplingStrategyResponse{
+			StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+			ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+				SamplingRate: param,
+			},
+		}, nil
+	case SamplerTypeRateLimiting:
+		if param < 0 {
+			return nil, fmt.Errorf("the maximum number of traces per second must not be negative, got %v", param)
+		}
+		// the Jaeger API only supports whole numbers of traces per second
+		if param != math.Trunc(param) || param > math.MaxInt32 {
+			return nil, fmt.Errorf("the maximum number of traces per second must be a whole number up to %d, got %v", math.MaxInt32, param)
+		}
+		return &api_v2.SamplingStrategyResponse{
+			StrategyType: api_v2.SamplingStrategyType_RATE_LIMITING,
+			RateLimitingSampling: &api_v2.RateLimitingSamplingStrategy{
+				MaxTracesPerSecond: int32(param),
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown strategy type %q, has to be either %q or %q", strategyType, SamplerTypeProbabilistic, SamplerTypeRateLimiting)
+	}
+}
+
+// GetSamplingStrategy implements source.Source.
+func (rs *ruleSource) GetSamplingStrategy(ctx context.Context, serviceName string) (*api_v2.SamplingStrategyResponse, error) {
+	strategy, _, err := rs.ResolveSamplingStrategy(ctx, serviceName)
+	return strategy, err
+}
+
+// ResolveSamplingStrategy implements source.Resolver.
+func (rs *ruleSource) ResolveSamplingStrategy(ctx context.Context, serviceName string) (*api_v2.SamplingStrategyResponse, string, error) {
+	// The rules are evaluated on every request, the clients only poll for their
+	// strategies periodically.
+	rss := ptrace.NewResourceSpans()
+	attributes := source.AttributesFromContext(ctx)
+	for _, key := range rs.matchAttributes {
+		if value, ok := attributes[key]; ok {
+			rss.Resource().Attributes().PutStr(key, value)
+		}
+	}
+	rss.Resource().Attributes().PutStr(serviceNameKey, serviceName)
+	for _, rule := range *rs.rules.Load() {
+		tCtx := ottlresource.NewTransformContextPtr(rss.Resource(), rss)
+		matched, err := rule.conditions.Eval(ctx, tCtx)
+		tCtx.Close()
+		if err != nil {
+			rs.logger.Warn("failed to evaluate the sampling strategy rule",
+				zap.String("rule", rule.name), zap.String("service", serviceName), zap.Error(err))
+			continue
+		}
+		if matched {
+			return rule.strategy, rule.name, nil
+		}
+	}
+
+	strategy, err := rs.fallback.GetSamplingStrategy(ctx, serviceName)
+	return strategy, "", err
+}
+
+// Close stops reloading the rules file and closes the fallback source.
+func (rs *ruleSource) Close() error {
+	if rs.cancelFunc != nil {
+		rs.cancelFunc()
+		rs.wg.Wait()
+	}
+	return rs.fallback.Close()
+}
@@ -0,0 +1,249 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rulesource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jaegertracing/jaeger-idl/proto-gen/api_v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/mocks"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal/source"
+)
+
+func TestResolveSamplingStrategy(t *testing.T) {
+	fallback := &api_v2.SamplingStrategyResponse{
+		StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+		ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+			SamplingRate: 0.5,
+		},
+	}
+	rules := []Rule{
+		{
+			Name:       "payments",
+			Conditions: []string{`IsMatch(resource.attributes["service.name"], "^payments-.*")`},
+			Type:       SamplerTypeRateLimiting,
+			Param:      10,
+		},
+		{
+			Conditions: []string{
+				`resource.attributes["service.name"] == "frontend"`,
+				`resource.attributes["service.name"] == "payments-frontend"`,
+			},
+			Type:  SamplerTypeProbabilistic,
+			Param: 1,
+		},
+	}
+	s, err := NewRuleSource(Options{Rules: rules}, &mocks.MockCfgMgr{
+		GetSamplingStrategyFunc: func(_ context.Context, _ string) (*api_v2.SamplingStrategyResponse, error) {
+			return fallback, nil
+		},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	testCases := []struct {
+		service  string
+		rule     string
+		expected *api_v2.SamplingStrategyResponse
+	}{
+		{
+			service: "payments-api",
+			rule:    "payments",
+			expected: &api_v2.SamplingStrategyResponse{
+				StrategyType: api_v2.SamplingStrategyType_RATE_LIMITING,
+				RateLimitingSampling: &api_v2.RateLimitingSamplingStrategy{
+					MaxTracesPerSecond: 10,
+				},
+			},
+		},
+		{
+			// the first matching rule wins
+			service: "payments-frontend",
+			rule:    "payments",
+			expected: &api_v2.SamplingStrategyResponse{
+				StrategyType: api_v2.SamplingStrategyType_RATE_LIMITING,
+				RateLimitingSampling: &api_v2.RateLimitingSamplingStrategy{
+					MaxTracesPerSecond: 10,
+				},
+			},
+		},
+		{
+			service: "frontend",
+			rule:    "rules[1]",
+			expected: &api_v2.SamplingStrategyResponse{
+				StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+				ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+					SamplingRate: 1,
+				},
+			},
+		},
+		{
+			service:  "inventory",
+			expected: fallback,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.service, func(t *testing.T) {
+			strategy, rule, err := s.(*ruleSource).ResolveSamplingStrategy(t.Context(), tC.service)
+			require.NoError(t, err)
+			assert.Equal(t, tC.rule, rule)
+			assert.Equal(t, tC.expected, strategy)
+
+			strategy, err = s.GetSamplingStrategy(t.Context(), tC.service)
+			require.NoError(t, err)
+			assert.Equal(t, tC.expected, strategy)
+		})
+	}
+}
+
+func TestValidateRule(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		rule     Rule
+		expected string
+	}{
+		{
+			desc:     "no conditions",
+			rule:     Rule{Name: "empty", Type: SamplerTypeProbabilistic},
+			expected: `rule "empty": at least one condition is required`,
+		},
+		{
+			desc:     "unknown type",
+			rule:     Rule{Conditions: []string{"true"}, Type: "adaptive"},
+			expected: `rule "rules[0]": unknown strategy type "adaptive"`,
+		},
+		{
+			desc:     "probability out of range",
+			rule:     Rule{Conditions: []string{"true"}, Type: SamplerTypeProbabilistic, Param: 2},
+			expected: "the sampling probability must be between 0 and 1",
+		},
+		{
+			desc:     "negative rate",
+			rule:     Rule{Conditions: []string{"true"}, Type: SamplerTypeRateLimiting, Param: -1},
+			expected: "the maximum number of traces per second must not be negative",
+		},
+		{
+			desc:     "fractional rate",
+			rule:     Rule{Conditions: []string{"true"}, Type: SamplerTypeRateLimiting, Param: 2.5},
+			expected: "the maximum number of traces per second must be a whole number",
+		},
+		{
+			desc:     "rate out of range",
+			rule:     Rule{Conditions: []string{"true"}, Type: SamplerTypeRateLimiting, Param: 1e10},
+			expected: "the maximum number of traces per second must be a whole number",
+		},
+		{
+			desc:     "invalid condition",
+			rule:     Rule{Conditions: []string{"resource.attributes["}, Type: SamplerTypeProbabilistic},
+			expected: "invalid conditions",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			assert.ErrorContains(t, ValidateRule(tC.rule, componenttest.NewNopTelemetrySettings()), tC.expected)
+		})
+	}
+}
+
+func TestResolveSamplingStrategyMatchAttributes(t *testing.T) {
+	rules := []Rule{
+		{
+			Name:       "payments",
+			Conditions: []string{`resource.attributes["service.namespace"] == "payments"`},
+			Type:       SamplerTypeProbabilistic,
+			Param:      1,
+		},
+		{
+			Name:       "ignored",
+			Conditions: []string{`resource.attributes["user-agent"] != nil`},
+			Type:       SamplerTypeProbabilistic,
+			Param:      0.5,
+		},
+	}
+	s, err := NewRuleSource(Options{
+		Rules:           rules,
+		MatchAttributes: []string{"service.namespace"},
+	}, &mocks.MockCfgMgr{}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	ctx := source.ContextWithAttributes(t.Context(), map[string]string{
+		"service.namespace": "payments",
+		"user-agent":        "jaeger-client",
+	})
+	_, rule, err := s.(*ruleSource).ResolveSamplingStrategy(ctx, "api")
+	require.NoError(t, err)
+	assert.Equal(t, "payments", rule)
+
+	// the attributes which are not matched on are not set on the resource
+	ctx = source.ContextWithAttributes(t.Context(), map[string]string{
+		"service.namespace": "checkout",
+		"user-agent":        "jaeger-client",
+	})
+	_, rule, err = s.(*ruleSource).ResolveSamplingStrategy(ctx, "api")
+	require.NoError(t, err)
+	assert.Empty(t, rule)
+}
+
+func TestRulesFileReload(t *testing.T) {
+	rulesFile := filepath.Join(t.TempDir(), "rules.json")
+	require.NoError(t, os.WriteFile(rulesFile, []byte(`{"rules": [
+		{"name": "frontend", "conditions": ["resource.attributes[\"service.name\"] == \"frontend\""], "type": "probabilistic", "param": 0.5}
+	]}`), 0o600))
+
+	s, err := NewRuleSource(Options{
+		Rules: []Rule{
+			{
+				Conditions: []string{`resource.attributes["service.name"] == "frontend"`},
+				Type:       SamplerTypeRateLimiting,
+				Param:      0,
+			},
+		},
+		RulesFile:      rulesFile,
+		ReloadInterval: 10 * time.Millisecond,
+	}, &mocks.MockCfgMgr{}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+	resolver := s.(*ruleSource)
+
+	// the rules of the configuration are evaluated first
+	_, rule, err := resolver.ResolveSamplingStrategy(t.Context(), "frontend")
+	require.NoError(t, err)
+	assert.Equal(t, "rules[0]", rule)
+
+	_, rule, err = resolver.ResolveSamplingStrategy(t.Context(), "backend")
+	require.NoError(t, err)
+	assert.Empty(t, rule)
+
+	require.NoError(t, os.WriteFile(rulesFile, []byte(`{"rules": [
+		{"conditions": ["resource.attributes[\"service.name\"] == \"backend\""], "type": "ratelimiting", "param": 5}
+	]}`), 0o600))
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		strategy, rule, err := resolver.ResolveSamplingStrategy(t.Context(), "backend")
+		require.NoError(c, err)
+		assert.Equal(c, "rules_file[0]", rule)
+		assert.Equal(c, int32(5), strategy.GetRateLimitingSampling().GetMaxTracesPerSecond())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// invalid rules are not loaded, the previous rules are kept
+	require.NoError(t, os.WriteFile(rulesFile, []byte(`{"rules": [{"conditions": ["true"], "type": "adaptive"}]}`), 0o600))
+	assert.Equal(t, "previous", resolver.reloadRules(rulesFile, "previous"))
+	_, rule, err = resolver.ResolveSamplingStrategy(t.Context(), "backend")
+	require.NoError(t, err)
+	assert.Equal(t, "rules_file[0]", rule)
+}
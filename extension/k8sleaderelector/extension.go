@@ -5,12 +5,17 @@ package k8sleaderelector // import "github.com/open-telemetry/opentelemetry-coll
 
 import (
 	"context"
+	"slices"
 	"sync"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 	"k8s.io/client-go/kubernetes"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector/internal/metadata"
 )
 
 type (
@@ -25,6 +30,14 @@ type LeaderElection interface {
 	SetCallBackFuncs(StartCallback, StopCallback)
 }
 
+// CallBackRegistry Interface allows the invoker to remove the callback functions it set,
+// so that components created and shut down while the collector is running don't leak them.
+type CallBackRegistry interface {
+	// RegisterCallBackFuncs sets the callback functions like SetCallBackFuncs, and returns a
+	// function removing them.
+	RegisterCallBackFuncs(StartCallback, StopCallback) (unregister func())
+}
+
 type callBackFuncs struct {
 	onStartLeading StartCallback
 	onStopLeading  StopCallback
@@ -39,53 +52,99 @@ type leaderElectionExtension struct {
 	cancel        context.CancelFunc
 	waitGroup     sync.WaitGroup
 
-	callBackFuncs []callBackFuncs
+	telemetryBuilder *metadata.TelemetryBuilder
+
+	callBackFuncs []*callBackFuncs
 
 	isLeader bool
 
-	mu sync.Mutex
+	// transitionMu serializes the leadership transitions and the registration of the callbacks, so that
+	// the callbacks are invoked in order. They are invoked without holding mu, so that they can unregister.
+	transitionMu sync.Mutex
+	mu           sync.Mutex
 }
 
 // SetCallBackFuncs set the functions that can be invoked when the leader wins or loss the election
 func (lee *leaderElectionExtension) SetCallBackFuncs(onStartLeading StartCallback, onStopLeading StopCallback) {
-	// Have a write lock while setting the callbacks.
-	lee.mu.Lock()
-	defer lee.mu.Unlock()
-	callBack := callBackFuncs{
+	lee.RegisterCallBackFuncs(onStartLeading, onStopLeading)
+}
+
+// RegisterCallBackFuncs set the functions that can be invoked when the leader wins or loss the election,
+// and returns a function removing them.
+func (lee *leaderElectionExtension) RegisterCallBackFuncs(onStartLeading StartCallback, onStopLeading StopCallback) func() {
+	// Don't let the leadership change until the callback is invoked.
+	lee.transitionMu.Lock()
+	defer lee.transitionMu.Unlock()
+	callBack := &callBackFuncs{
 		onStartLeading: onStartLeading,
 		onStopLeading:  onStopLeading,
 	}
 
+	lee.mu.Lock()
 	lee.callBackFuncs = append(lee.callBackFuncs, callBack)
+	isLeader := lee.isLeader
+	lee.mu.Unlock()
 
-	if lee.isLeader {
+	if isLeader {
 		// Immediately invoke the callback since we are already leader
 		onStartLeading(context.Background())
 	}
+
+	return func() {
+		lee.mu.Lock()
+		defer lee.mu.Unlock()
+		lee.callBackFuncs = slices.DeleteFunc(lee.callBackFuncs, func(c *callBackFuncs) bool {
+			return c == callBack
+		})
+	}
 }
 
 // If the receiver sets a callback function then it would be invoked when the leader wins the election
 func (lee *leaderElectionExtension) startedLeading(ctx context.Context) {
-	// Have read lock so that we no new callbacks can be added while we are invoking the callbacks.
-	lee.mu.Lock()
-	defer lee.mu.Unlock()
-	lee.isLeader = true
-	for _, callback := range lee.callBackFuncs {
+	// Serialize with the other transitions so that no new callbacks can be added while we are invoking the callbacks.
+	lee.transitionMu.Lock()
+	defer lee.transitionMu.Unlock()
+	callbacks := lee.setLeader(true)
+	lee.logger.Info("Acquired the leader lease",
+		zap.String("lease", lee.config.LeaseNamespace+"/"+lee.config.LeaseName),
+		zap.String("UUID", lee.leaseHolderID),
+		zap.Int("callbacks", len(callbacks)))
+	lee.recordTransition(ctx, 1, "acquired")
+	for _, callback := range callbacks {
 		callback.onStartLeading(ctx)
 	}
 }
 
 // If the receiver sets a callback function then it would be invoked when the leader loss the election
 func (lee *leaderElectionExtension) stoppedLeading() {
-	// Have a read lock while stopping the receivers. This would make sure that if we have executed any onStartLeading callbacks
-	// after becoming leader, we would execute the onStopLeading callbacks for them as well.
+	// Serialize with the other transitions while stopping the receivers. This would make sure that if we have executed any
+	// onStartLeading callbacks after becoming leader, we would execute the onStopLeading callbacks for them as well.
+	lee.transitionMu.Lock()
+	defer lee.transitionMu.Unlock()
+
+	callbacks := lee.setLeader(false)
+	lee.logger.Info("Lost the leader lease",
+		zap.String("lease", lee.config.LeaseNamespace+"/"+lee.config.LeaseName),
+		zap.String("UUID", lee.leaseHolderID),
+		zap.Int("callbacks", len(callbacks)))
+	lee.recordTransition(context.Background(), 0, "lost")
+	for _, callback := range callbacks {
+		callback.onStopLeading()
+	}
+}
+
+// setLeader records the leadership, and returns a copy of the callbacks to invoke once mu is released.
+func (lee *leaderElectionExtension) setLeader(isLeader bool) []*callBackFuncs {
 	lee.mu.Lock()
 	defer lee.mu.Unlock()
+	lee.isLeader = isLeader
+	return slices.Clone(lee.callBackFuncs)
+}
 
-	lee.isLeader = false
-	for _, callback := range lee.callBackFuncs {
-		callback.onStopLeading()
-	}
+func (lee *leaderElectionExtension) recordTransition(ctx context.Context, leader int64, transition string) {
+	lee.telemetryBuilder.K8sLeaderElectorLeader.Record(ctx, leader)
+	lee.telemetryBuilder.K8sLeaderElectorLeadershipTransitions.Add(ctx, 1,
+		metric.WithAttributes(attribute.String("transition", transition)))
 }
 
 // Start begins the extension's processing.
@@ -126,5 +185,6 @@ func (lee *leaderElectionExtension) Shutdown(context.Context) error {
 		lee.cancel()
 	}
 	lee.waitGroup.Wait()
+	lee.telemetryBuilder.Shutdown()
 	return nil
 }
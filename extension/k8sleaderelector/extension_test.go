@@ -12,7 +12,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,6 +24,8 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/ptr"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector/internal/metadatatest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
@@ -41,10 +47,11 @@ func TestExtension(t *testing.T) {
 	observedZapCore, _ := observer.New(zap.WarnLevel)
 
 	leaderElection := leaderElectionExtension{
-		config:        config,
-		client:        fakeClient,
-		logger:        zap.New(observedZapCore),
-		leaseHolderID: "foo",
+		config:           config,
+		client:           fakeClient,
+		logger:           zap.New(observedZapCore),
+		leaseHolderID:    "foo",
+		telemetryBuilder: newTestTelemetryBuilder(t, componenttest.NewNopTelemetrySettings()),
 	}
 
 	var onStartLeadingInvoked atomic.Bool
@@ -91,10 +98,11 @@ func TestExtension_WithDelay(t *testing.T) {
 	observedZapCore, _ := observer.New(zap.WarnLevel)
 
 	leaderElection := leaderElectionExtension{
-		config:        config,
-		client:        fakeClient,
-		logger:        zap.New(observedZapCore),
-		leaseHolderID: "foo",
+		config:           config,
+		client:           fakeClient,
+		logger:           zap.New(observedZapCore),
+		leaseHolderID:    "foo",
+		telemetryBuilder: newTestTelemetryBuilder(t, componenttest.NewNopTelemetrySettings()),
 	}
 
 	var onStartLeadingInvoked atomic.Bool
@@ -128,3 +136,93 @@ func TestExtension_WithDelay(t *testing.T) {
 	require.True(t, onStartLeadingInvoked.Load())
 	require.NoError(t, leaderElection.Shutdown(ctx))
 }
+
+func newTestTelemetryBuilder(t *testing.T, set component.TelemetrySettings) *metadata.TelemetryBuilder {
+	t.Helper()
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set)
+	require.NoError(t, err)
+	return telemetryBuilder
+}
+
+func TestLeadershipTransitions(t *testing.T) {
+	tt := componenttest.NewTelemetry()
+	leaderElection := leaderElectionExtension{
+		config: &Config{
+			LeaseName:      "foo",
+			LeaseNamespace: "default",
+		},
+		logger:           zap.NewNop(),
+		leaseHolderID:    "foo",
+		telemetryBuilder: newTestTelemetryBuilder(t, tt.NewTelemetrySettings()),
+	}
+
+	var started, stopped int
+	unregister := leaderElection.RegisterCallBackFuncs(
+		func(context.Context) { started++ },
+		func() { stopped++ },
+	)
+	leaderElection.startedLeading(t.Context())
+	leaderElection.stoppedLeading()
+	unregister()
+	leaderElection.startedLeading(t.Context())
+
+	assert.Equal(t, 1, started)
+	assert.Equal(t, 1, stopped)
+	metadatatest.AssertEqualK8sLeaderElectorLeader(t, tt,
+		[]metricdata.DataPoint[int64]{{Value: 1}},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualK8sLeaderElectorLeadershipTransitions(t, tt,
+		[]metricdata.DataPoint[int64]{
+			{Value: 2, Attributes: attribute.NewSet(attribute.String("transition", "acquired"))},
+			{Value: 1, Attributes: attribute.NewSet(attribute.String("transition", "lost"))},
+		},
+		metricdatatest.IgnoreTimestamp())
+	require.NoError(t, tt.Shutdown(t.Context()))
+}
+
+func TestCallbacksUnregisterThemselves(t *testing.T) {
+	leaderElection := leaderElectionExtension{
+		config: &Config{
+			LeaseName:      "foo",
+			LeaseNamespace: "default",
+		},
+		logger:           zap.NewNop(),
+		leaseHolderID:    "foo",
+		telemetryBuilder: newTestTelemetryBuilder(t, componenttest.NewNopTelemetrySettings()),
+	}
+
+	var started, stopped int
+	var unregisterStart, unregisterStop func()
+	unregisterStart = leaderElection.RegisterCallBackFuncs(
+		func(context.Context) {
+			started++
+			unregisterStart()
+		},
+		func() {},
+	)
+	unregisterStop = leaderElection.RegisterCallBackFuncs(
+		func(context.Context) {},
+		func() {
+			stopped++
+			unregisterStop()
+		},
+	)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		leaderElection.startedLeading(t.Context())
+		leaderElection.stoppedLeading()
+		leaderElection.startedLeading(t.Context())
+		leaderElection.stoppedLeading()
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "the callbacks unregistering themselves deadlocked")
+	}
+
+	assert.Equal(t, 1, started)
+	assert.Equal(t, 1, stopped)
+	assert.Empty(t, leaderElection.callBackFuncs)
+}
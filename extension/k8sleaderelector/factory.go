@@ -55,12 +55,18 @@ func createExtension(
 
 	leaseHolderID := uuid.New().String()
 
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+
 	return &leaderElectionExtension{
-		config:        baseCfg,
-		logger:        set.Logger,
-		client:        client,
-		leaseHolderID: leaseHolderID,
-		waitGroup:     sync.WaitGroup{},
+		config:           baseCfg,
+		logger:           set.Logger,
+		client:           client,
+		leaseHolderID:    leaseHolderID,
+		waitGroup:        sync.WaitGroup{},
+		telemetryBuilder: telemetryBuilder,
 	}, nil
 }
 
@@ -0,0 +1,74 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Meter(settings component.TelemetrySettings) metric.Meter {
+	return settings.MeterProvider.Meter("github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector")
+}
+
+func Tracer(settings component.TelemetrySettings) trace.Tracer {
+	return settings.TracerProvider.Tracer("github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector")
+}
+
+// TelemetryBuilder provides an interface for components to report telemetry
+// as defined in metadata and user config.
+type TelemetryBuilder struct {
+	meter                                metric.Meter
+	mu                                   sync.Mutex
+	registrations                        []metric.Registration
+	K8sLeaderElectorLeader               metric.Int64Gauge
+	K8sLeaderElectorLeadershipTransitions metric.Int64Counter
+}
+
+// TelemetryBuilderOption applies changes to default builder.
+type TelemetryBuilderOption interface {
+	apply(*TelemetryBuilder)
+}
+
+type telemetryBuilderOptionFunc func(mb *TelemetryBuilder)
+
+func (tbof telemetryBuilderOptionFunc) apply(mb *TelemetryBuilder) {
+	tbof(mb)
+}
+
+// Shutdown unregister all registered callbacks for async instruments.
+func (builder *TelemetryBuilder) Shutdown() {
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	for _, reg := range builder.registrations {
+		reg.Unregister()
+	}
+}
+
+// NewTelemetryBuilder provides a struct with methods to update all internal telemetry
+// for a component
+func NewTelemetryBuilder(settings component.TelemetrySettings, options ...TelemetryBuilderOption) (*TelemetryBuilder, error) {
+	builder := TelemetryBuilder{}
+	for _, op := range options {
+		op.apply(&builder)
+	}
+	builder.meter = Meter(settings)
+	var err, errs error
+	builder.K8sLeaderElectorLeader, err = builder.meter.Int64Gauge(
+		"otelcol_k8s_leader_elector_leader",
+		metric.WithDescription("Whether the collector instance holds the lease (1) or not (0). [Development]"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
+	builder.K8sLeaderElectorLeadershipTransitions, err = builder.meter.Int64Counter(
+		"otelcol_k8s_leader_elector_leadership_transitions",
+		metric.WithDescription("Number of times the collector instance acquired or lost the lease. [Development]"),
+		metric.WithUnit("{transitions}"),
+	)
+	errs = errors.Join(errs, err)
+	return &builder, errs
+}
@@ -0,0 +1,23 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sleaderelector // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// GetLeaderElection returns the leader elector extension with the given ID from the host.
+func GetLeaderElection(host component.Host, id component.ID) (LeaderElection, error) {
+	ext, ok := host.GetExtensions()[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown k8s leader elector %q", id)
+	}
+	elector, ok := ext.(LeaderElection)
+	if !ok {
+		return nil, fmt.Errorf("the extension %q does not implement k8sleaderelector.LeaderElection", id)
+	}
+	return elector, nil
+}
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sleaderelector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+)
+
+type testHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *testHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+type testComponent struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func TestGetLeaderElection(t *testing.T) {
+	electorID := component.MustNewID("k8s_leader_elector")
+	otherID := component.MustNewID("other")
+	host := &testHost{extensions: map[component.ID]component.Component{
+		electorID: &leaderElectionExtension{},
+		otherID:   &testComponent{},
+	}}
+
+	elector, err := GetLeaderElection(host, electorID)
+	require.NoError(t, err)
+	assert.NotNil(t, elector)
+
+	_, err = GetLeaderElection(host, component.MustNewID("missing"))
+	assert.EqualError(t, err, `unknown k8s leader elector "missing"`)
+
+	_, err = GetLeaderElection(host, otherID)
+	assert.EqualError(t, err, `the extension "other" does not implement k8sleaderelector.LeaderElection`)
+}
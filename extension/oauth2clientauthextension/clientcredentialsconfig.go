@@ -31,6 +31,7 @@ func newClientCredentialsGrantTypeConfig(cfg *Config) *clientCredentialsConfig {
 		ClientIDFile:     cfg.ClientIDFile,
 		ClientSecretFile: cfg.ClientSecretFile,
 		ExpiryBuffer:     cfg.ExpiryBuffer,
+		TLSClientAuth:    cfg.usesTLSClientAuth(),
 	}
 }
 
@@ -57,6 +58,10 @@ type clientCredentialsConfig struct {
 	ClientIDFile     string
 	ClientSecretFile string
 	ExpiryBuffer     time.Duration
+
+	// TLSClientAuth specifies that the client authenticates with its TLS client certificate,
+	// sending its ID in the request body without a secret.
+	TLSClientAuth bool
 }
 
 type clientCredentialsTokenSource struct {
@@ -96,6 +101,16 @@ func (c *clientCredentialsConfig) createConfig() (*clientcredentials.Config, err
 		return nil, multierr.Combine(errNoClientIDProvided, err)
 	}
 
+	if c.TLSClientAuth {
+		return &clientcredentials.Config{
+			ClientID:       clientID,
+			TokenURL:       c.TokenURL,
+			Scopes:         c.Scopes,
+			EndpointParams: c.EndpointParams,
+			AuthStyle:      oauth2.AuthStyleInParams,
+		}, nil
+	}
+
 	clientSecret, err := getActualValue(c.ClientSecret, c.ClientSecretFile)
 	if err != nil {
 		return nil, multierr.Combine(errNoClientSecretProvided, err)
@@ -19,6 +19,18 @@ var (
 	errInvalidSignatureAlg         = errors.New("invalid signature algorithm")
 	errNoTokenURLProvided          = errors.New("no TokenURL provided in OAuth Client Credentials configuration")
 	errNoClientSecretProvided      = errors.New("no ClientSecret provided in OAuth Client Credentials configuration")
+	errInvalidClientAuthMethod     = errors.New("invalid client authentication method")
+	errTLSClientAuthGrantType      = errors.New("TLS client authentication is only supported with the client_credentials grant type")
+	errNoTLSClientCertificate      = errors.New("no TLS client certificate provided for TLS client authentication")
+)
+
+const (
+	// clientAuthMethodSecret authenticates the client with its secret.
+	clientAuthMethodSecret = "client_secret"
+	// clientAuthMethodTLS authenticates the client with a PKI certificate (RFC 8705 section 2.1).
+	clientAuthMethodTLS = "tls_client_auth"
+	// clientAuthMethodSelfSignedTLS authenticates the client with a self-signed certificate (RFC 8705 section 2.2).
+	clientAuthMethodSelfSignedTLS = "self_signed_tls_client_auth"
 )
 
 // Config stores the configuration for OAuth2 Client Credentials (2-legged OAuth2 flow) setup.
@@ -95,6 +107,20 @@ type Config struct {
 
 	// ExpiryBuffer specifies the time buffer before token expiry to refresh it.
 	ExpiryBuffer time.Duration `mapstructure:"expiry_buffer,omitempty"`
+
+	// ClientAuthMethod is the method used to authenticate the client at the token endpoint. It can be
+	// "client_secret", or "tls_client_auth" and "self_signed_tls_client_auth" to authenticate with the
+	// TLS client certificate (RFC 8705), in which case no client secret is sent. Only used if
+	// GrantType is set to "client_credentials". Default value is "client_secret"
+	ClientAuthMethod string `mapstructure:"client_auth_method,omitempty"`
+
+	// StorageID is the ID of a storage extension persisting the acquired tokens, so that they
+	// are reused after the collector restarts instead of being requested again.
+	StorageID *component.ID `mapstructure:"storage,omitempty"`
+}
+
+func (cfg *Config) usesTLSClientAuth() bool {
+	return cfg.ClientAuthMethod == clientAuthMethodTLS || cfg.ClientAuthMethod == clientAuthMethodSelfSignedTLS
 }
 
 var _ component.Config = (*Config)(nil)
@@ -104,10 +130,22 @@ func (cfg *Config) Validate() error {
 	if cfg.ClientID == "" && cfg.ClientIDFile == "" {
 		return errNoClientIDProvided
 	}
+	switch cfg.ClientAuthMethod {
+	case "", clientAuthMethodSecret, clientAuthMethodTLS, clientAuthMethodSelfSignedTLS:
+	default:
+		return errInvalidClientAuthMethod
+	}
 	if cfg.GrantType == grantTypeJWTBearer {
 		if cfg.ClientCertificateKey == "" && cfg.ClientCertificateKeyFile == "" {
 			return errNoClientCertificateProvided
 		}
+		if cfg.usesTLSClientAuth() {
+			return errTLSClientAuthGrantType
+		}
+	} else if cfg.usesTLSClientAuth() {
+		if cfg.TLS.CertFile == "" && cfg.TLS.CertPem == "" {
+			return errNoTLSClientCertificate
+		}
 	} else {
 		if cfg.ClientSecret == "" && cfg.ClientSecretFile == "" {
 			return errNoClientSecretProvided
@@ -22,6 +22,7 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	storageID := component.MustNewID("file_storage")
 	tests := []struct {
 		id          component.ID
 		expected    component.Config
@@ -73,6 +74,35 @@ func TestLoadConfig(t *testing.T) {
 				ExpiryBuffer: 15 * time.Second,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "tlsclientauth"),
+			expected: &Config{
+				ClientID:         "someclientid",
+				ClientAuthMethod: "tls_client_auth",
+				Scopes:           []string{"api.metrics"},
+				TokenURL:         "https://example.com/oauth2/default/v1/token",
+				TLS: configtls.ClientConfig{
+					Config: configtls.Config{
+						CertFile: "certfile",
+						KeyFile:  "keyfile",
+					},
+				},
+				ExpiryBuffer: 5 * time.Minute,
+				StorageID:    &storageID,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "tlsclientauthmissingcertificate"),
+			expectedErr: errNoTLSClientCertificate,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "tlsclientauthjwt"),
+			expectedErr: errTLSClientAuthGrantType,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalidclientauthmethod"),
+			expectedErr: errInvalidClientAuthMethod,
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "missingurl"),
 			expectedErr: errNoTokenURLProvided,
@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
 	"go.opentelemetry.io/collector/extension/extensionauth"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
@@ -33,12 +34,14 @@ type TokenSourceConfiguration interface {
 // clientAuthenticator provides implementation for providing client authentication using OAuth2 client credentials
 // workflow for both gRPC and HTTP clients.
 type clientAuthenticator struct {
-	component.StartFunc
-	component.ShutdownFunc
-
 	credentials TokenSourceConfiguration
 	logger      *zap.Logger
 	client      *http.Client
+
+	// id and storageID are used to persist the acquired tokens
+	id         component.ID
+	storageID  *component.ID
+	tokenCache *tokenCache
 }
 
 type errorWrappingTokenSource struct {
@@ -70,10 +73,7 @@ func newClientAuthenticator(cfg *Config, logger *zap.Logger) (*clientAuthenticat
 			return nil, err
 		}
 	case grantTypeClientCredentials, "":
-		credentials, err = newJwtGrantTypeConfig(cfg)
-		if err != nil {
-			return nil, err
-		}
+		credentials = newClientCredentialsGrantTypeConfig(cfg)
 	default:
 		return nil, fmt.Errorf("unknown grant type %q", cfg.GrantType)
 	}
@@ -85,9 +85,38 @@ func newClientAuthenticator(cfg *Config, logger *zap.Logger) (*clientAuthenticat
 			Transport: transport,
 			Timeout:   cfg.Timeout,
 		},
+		storageID:  cfg.StorageID,
+		tokenCache: newTokenCache(cfg, logger),
 	}, nil
 }
 
+// Start opens the storage client used to persist the acquired tokens, if configured
+func (o *clientAuthenticator) Start(ctx context.Context, host component.Host) error {
+	if o.storageID == nil {
+		return nil
+	}
+
+	ext, ok := host.GetExtensions()[*o.storageID]
+	if !ok {
+		return fmt.Errorf("storage extension %q not found", o.storageID)
+	}
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension %q found", o.storageID)
+	}
+	client, err := storageExt.GetClient(ctx, component.KindExtension, o.id, "")
+	if err != nil {
+		return fmt.Errorf("failed to get storage client: %w", err)
+	}
+	o.tokenCache.setClient(client)
+	return nil
+}
+
+// Shutdown closes the storage client used to persist the acquired tokens
+func (o *clientAuthenticator) Shutdown(ctx context.Context) error {
+	return o.tokenCache.close(ctx)
+}
+
 func (ewts errorWrappingTokenSource) Token() (*oauth2.Token, error) {
 	tok, err := ewts.ts.Token()
 	if err != nil {
@@ -101,24 +130,26 @@ func (ewts errorWrappingTokenSource) Token() (*oauth2.Token, error) {
 // RoundTripper returns oauth2.Transport, an http.RoundTripper that performs "client-credential" OAuth flow and
 // also auto refreshes OAuth tokens as needed.
 func (o *clientAuthenticator) RoundTripper(base http.RoundTripper) (http.RoundTripper, error) {
-	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, o.client)
 	return &oauth2.Transport{
-		Source: errorWrappingTokenSource{
-			ts:       o.credentials.TokenSource(ctx),
-			tokenURL: o.credentials.TokenEndpoint(),
-		},
-		Base: base,
+		Source: o.tokenSource(),
+		Base:   base,
 	}, nil
 }
 
 // PerRPCCredentials returns gRPC PerRPCCredentials that supports "client-credential" OAuth flow. The underneath
 // oauth2.clientcredentials.Config instance will manage tokens performing auto refresh as necessary.
 func (o *clientAuthenticator) PerRPCCredentials() (credentials.PerRPCCredentials, error) {
-	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, o.client)
 	return grpcOAuth.TokenSource{
-		TokenSource: errorWrappingTokenSource{
-			ts:       o.credentials.TokenSource(ctx),
-			tokenURL: o.credentials.TokenEndpoint(),
-		},
+		TokenSource: o.tokenSource(),
 	}, nil
 }
+
+// tokenSource returns the source of the tokens requested from the token endpoint,
+// persisting them when a storage extension is configured.
+func (o *clientAuthenticator) tokenSource() oauth2.TokenSource {
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, o.client)
+	return o.tokenCache.tokenSource(errorWrappingTokenSource{
+		ts:       o.credentials.TokenSource(ctx),
+		tokenURL: o.credentials.TokenEndpoint(),
+	})
+}
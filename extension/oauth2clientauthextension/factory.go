@@ -30,5 +30,10 @@ func createDefaultConfig() component.Config {
 }
 
 func createExtension(_ context.Context, set extension.Settings, cfg component.Config) (extension.Extension, error) {
-	return newClientAuthenticator(cfg.(*Config), set.Logger)
+	auth, err := newClientAuthenticator(cfg.(*Config), set.Logger)
+	if err != nil {
+		return nil, err
+	}
+	auth.id = set.ID
+	return auth, nil
 }
@@ -15,6 +15,7 @@ require (
 	go.opentelemetry.io/collector/extension v1.50.1-0.20260121161034-55399d4743af
 	go.opentelemetry.io/collector/extension/extensionauth v1.50.1-0.20260121161034-55399d4743af
 	go.opentelemetry.io/collector/extension/extensiontest v0.144.1-0.20260121161034-55399d4743af
+	go.opentelemetry.io/collector/extension/xextension v0.144.1-0.20260121161034-55399d4743af
 	go.uber.org/goleak v1.3.0
 	go.uber.org/multierr v1.11.0
 	go.uber.org/zap v1.27.1
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2clientauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oauth2clientauthextension"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+// tokenCache persists the tokens acquired from the token endpoint in a storage extension, so that
+// the collector instances reuse them after a rolling restart instead of all requesting a new token.
+type tokenCache struct {
+	key          string
+	expiryBuffer time.Duration
+	logger       *zap.Logger
+
+	mu     sync.Mutex
+	client storage.Client
+}
+
+func newTokenCache(cfg *Config, logger *zap.Logger) *tokenCache {
+	return &tokenCache{
+		key:          tokenCacheKey(cfg),
+		expiryBuffer: cfg.ExpiryBuffer,
+		logger:       logger,
+	}
+}
+
+// tokenCacheKey identifies the tokens requested with the given configuration, so that tokens
+// persisted before the configuration changed are not reused.
+func tokenCacheKey(cfg *Config) string {
+	h := sha256.New()
+	for _, v := range []string{cfg.TokenURL, cfg.GrantType, cfg.ClientID, cfg.ClientIDFile, strings.Join(cfg.Scopes, " ")} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	return "token_" + hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *tokenCache) setClient(client storage.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.client = client
+}
+
+func (c *tokenCache) storageClient() storage.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.client
+}
+
+func (c *tokenCache) close(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.client == nil {
+		return nil
+	}
+	err := c.client.Close(ctx)
+	c.client = nil
+	return err
+}
+
+// tokenSource returns a source reusing the persisted token while it is valid, and persisting
+// the tokens acquired from ts afterwards.
+func (c *tokenCache) tokenSource(ts oauth2.TokenSource) oauth2.TokenSource {
+	if c.storageClient() == nil {
+		return ts
+	}
+	return oauth2.ReuseTokenSourceWithExpiry(c.load(), persistingTokenSource{ts: ts, cache: c}, c.expiryBuffer)
+}
+
+// load returns the persisted token, or nil if there is none.
+func (c *tokenCache) load() *oauth2.Token {
+	client := c.storageClient()
+	if client == nil {
+		return nil
+	}
+
+	data, err := client.Get(context.Background(), c.key)
+	if err != nil {
+		c.logger.Warn("Failed to load the persisted token", zap.Error(err))
+		return nil
+	}
+	if data == nil {
+		return nil
+	}
+
+	tok := &oauth2.Token{}
+	if err := json.Unmarshal(data, tok); err != nil {
+		c.logger.Warn("Failed to decode the persisted token", zap.Error(err))
+		return nil
+	}
+	return tok
+}
+
+// store persists the given token. Failures are only logged, as the token can be used regardless.
+func (c *tokenCache) store(tok *oauth2.Token) {
+	client := c.storageClient()
+	if client == nil {
+		return
+	}
+
+	data, err := json.Marshal(tok)
+	if err != nil {
+		c.logger.Warn("Failed to encode the token", zap.Error(err))
+		return
+	}
+	if err := client.Set(context.Background(), c.key, data); err != nil {
+		c.logger.Warn("Failed to persist the token", zap.Error(err))
+	}
+}
+
+type persistingTokenSource struct {
+	ts    oauth2.TokenSource
+	cache *tokenCache
+}
+
+// persistingTokenSource implements TokenSource
+var _ oauth2.TokenSource = (*persistingTokenSource)(nil)
+
+func (pts persistingTokenSource) Token() (*oauth2.Token, error) {
+	tok, err := pts.ts.Token()
+	if err != nil {
+		return tok, err
+	}
+	pts.cache.store(tok)
+	return tok, nil
+}
@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2clientauthextension
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+)
+
+type memoryStorage struct {
+	component.StartFunc
+	component.ShutdownFunc
+
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+func (s *memoryStorage) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return &memoryClient{storage: s}, nil
+}
+
+type memoryClient struct {
+	storage *memoryStorage
+}
+
+func (c *memoryClient) Get(_ context.Context, key string) ([]byte, error) {
+	c.storage.mu.Lock()
+	defer c.storage.mu.Unlock()
+	return c.storage.data[key], nil
+}
+
+func (c *memoryClient) Set(_ context.Context, key string, value []byte) error {
+	c.storage.mu.Lock()
+	defer c.storage.mu.Unlock()
+	c.storage.data[key] = value
+	return nil
+}
+
+func (c *memoryClient) Delete(_ context.Context, key string) error {
+	c.storage.mu.Lock()
+	defer c.storage.mu.Unlock()
+	delete(c.storage.data, key)
+	return nil
+}
+
+func (*memoryClient) Batch(context.Context, ...*storage.Operation) error {
+	return nil
+}
+
+func (*memoryClient) Close(context.Context) error {
+	return nil
+}
+
+type nopExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+type storageHost struct {
+	extensions map[component.ID]component.Component
+}
+
+func (h storageHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func newTokenServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := requests.Add(1)
+		w.Header().Add("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "token-" + string(rune('0'+n)),
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		}))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTokenCache(t *testing.T) {
+	var requests atomic.Int32
+	server := newTokenServer(t, &requests)
+
+	storageID := component.MustNewID("file_storage")
+	host := storageHost{extensions: map[component.ID]component.Component{
+		storageID: &memoryStorage{data: make(map[string][]byte)},
+	}}
+	cfg := &Config{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		TokenURL:     server.URL,
+		ExpiryBuffer: time.Minute,
+		StorageID:    &storageID,
+	}
+
+	token := func() string {
+		auth, err := newClientAuthenticator(cfg, zap.NewNop())
+		require.NoError(t, err)
+		require.NoError(t, auth.Start(t.Context(), host))
+		defer func() {
+			require.NoError(t, auth.Shutdown(t.Context()))
+		}()
+
+		tok, err := auth.tokenSource().Token()
+		require.NoError(t, err)
+		return tok.AccessToken
+	}
+
+	// the token acquired before the restart is reused
+	assert.Equal(t, "token-1", token())
+	assert.Equal(t, "token-1", token())
+	assert.Equal(t, int32(1), requests.Load())
+
+	// tokens requested with a different configuration are not reused
+	cfg.Scopes = []string{"api.metrics"}
+	assert.Equal(t, "token-2", token())
+	assert.Equal(t, int32(2), requests.Load())
+
+	// tokens expiring within the expiry buffer are not reused
+	cfg.ExpiryBuffer = 2 * time.Hour
+	assert.Equal(t, "token-3", token())
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestTokenCacheInvalidStorage(t *testing.T) {
+	storageID := component.MustNewID("file_storage")
+	auth, err := newClientAuthenticator(&Config{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		TokenURL:     "https://example.com/v1/token",
+		StorageID:    &storageID,
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	assert.EqualError(t, auth.Start(t.Context(), componenttest.NewNopHost()), `storage extension "file_storage" not found`)
+
+	host := storageHost{extensions: map[component.ID]component.Component{
+		storageID: &nopExtension{},
+	}}
+	assert.EqualError(t, auth.Start(t.Context(), host), `non-storage extension "file_storage" found`)
+}
+
+func TestTLSClientAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "id", r.PostForm.Get("client_id"))
+		assert.False(t, r.PostForm.Has("client_secret"))
+
+		w.Header().Add("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "12345",
+			"token_type":   "Bearer",
+		}))
+	}))
+	defer server.Close()
+
+	auth, err := newClientAuthenticator(&Config{
+		ClientID:         "id",
+		ClientAuthMethod: clientAuthMethodTLS,
+		TokenURL:         server.URL,
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	tok, err := auth.tokenSource().Token()
+	require.NoError(t, err)
+	assert.Equal(t, "12345", tok.AccessToken)
+}
@@ -27,6 +27,8 @@ const (
 	PodContainerType EndpointType = "pod.container"
 	// K8sServiceType is a service endpoint.
 	K8sServiceType EndpointType = "k8s.service"
+	// K8sServicePortType is a service's port endpoint.
+	K8sServicePortType EndpointType = "k8s.service.port"
 	// K8sIngressType is a ingress endpoint.
 	K8sIngressType EndpointType = "k8s.ingress"
 	// K8sNodeType is a Kubernetes Node endpoint.
@@ -43,6 +45,7 @@ var (
 	_ EndpointDetails = (*Pod)(nil)
 	_ EndpointDetails = (*Port)(nil)
 	_ EndpointDetails = (*K8sService)(nil)
+	_ EndpointDetails = (*K8sServicePort)(nil)
 	_ EndpointDetails = (*K8sNode)(nil)
 	_ EndpointDetails = (*HostPort)(nil)
 	_ EndpointDetails = (*Container)(nil)
@@ -120,17 +123,24 @@ type K8sService struct {
 	ClusterIP string
 	// ServiceType is the type of the service: ClusterIP, NodePort, LoadBalancer, ExternalName
 	ServiceType string
+	// ExternalName is the DNS name an ExternalName service is an alias for.
+	ExternalName string
+	// ExternalAddresses are the IPs and hostnames under which the service is reachable from
+	// outside the cluster: its external IPs and the ingress points of its load balancer.
+	ExternalAddresses []string
 }
 
 func (s *K8sService) Env() EndpointEnv {
 	return map[string]any{
-		"uid":          s.UID,
-		"name":         s.Name,
-		"labels":       s.Labels,
-		"annotations":  s.Annotations,
-		"namespace":    s.Namespace,
-		"cluster_ip":   s.ClusterIP,
-		"service_type": s.ServiceType,
+		"uid":                s.UID,
+		"name":               s.Name,
+		"labels":             s.Labels,
+		"annotations":        s.Annotations,
+		"namespace":          s.Namespace,
+		"cluster_ip":         s.ClusterIP,
+		"service_type":       s.ServiceType,
+		"external_name":      s.ExternalName,
+		"external_addresses": s.ExternalAddresses,
 	}
 }
 
@@ -138,6 +148,37 @@ func (*K8sService) Type() EndpointType {
 	return K8sServiceType
 }
 
+// K8sServicePort is a port exposed by a discovered k8s service.
+type K8sServicePort struct {
+	// Name is the name of the service port.
+	Name string
+	// Service is the k8s service exposing the port.
+	Service K8sService
+	// Port number exposed by the service.
+	Port uint16
+	// NodePort is the port exposed on each node for NodePort and LoadBalancer services, 0 otherwise.
+	NodePort uint16
+	// TargetPort is the port or the name of the port targeted on the service's pods.
+	TargetPort string
+	// Transport is the transport protocol used by the Endpoint. (TCP or UDP).
+	Transport Transport
+}
+
+func (s *K8sServicePort) Env() EndpointEnv {
+	return map[string]any{
+		"name":        s.Name,
+		"port":        s.Port,
+		"node_port":   s.NodePort,
+		"target_port": s.TargetPort,
+		"transport":   s.Transport,
+		"service":     s.Service.Env(),
+	}
+}
+
+func (*K8sServicePort) Type() EndpointType {
+	return K8sServicePortType
+}
+
 // K8sIngress is a discovered k8s ingress.
 type K8sIngress struct {
 	// Name of the ingress.
@@ -270,6 +311,11 @@ type HostPort struct {
 	ProcessName string
 	// Command used to invoke the process using the Endpoint.
 	Command string
+	// Args is the command line used to invoke the process split into arguments,
+	// starting with the executable.
+	Args []string
+	// Environment holds the allowlisted environment variables of the process.
+	Environment map[string]string
 	// Port number of the endpoint.
 	Port uint16
 	// Transport is the transport protocol used by the Endpoint. (TCP or UDP).
@@ -282,6 +328,8 @@ func (h *HostPort) Env() EndpointEnv {
 	return map[string]any{
 		"process_name": h.ProcessName,
 		"command":      h.Command,
+		"args":         h.Args,
+		"environment":  h.Environment,
 		"is_ipv6":      h.IsIPv6,
 		"port":         h.Port,
 		"transport":    h.Transport,
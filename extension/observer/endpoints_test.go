@@ -112,9 +112,10 @@ func TestEndpointEnv(t *testing.T) {
 					Annotations: map[string]string{
 						"annotation_1": "value_1",
 					},
-					Namespace:   "service-namespace",
-					ServiceType: "LoadBalancer",
-					ClusterIP:   "192.68.73.2",
+					Namespace:         "service-namespace",
+					ServiceType:       "LoadBalancer",
+					ClusterIP:         "192.68.73.2",
+					ExternalAddresses: []string{"203.0.113.10"},
 				},
 			},
 			want: EndpointEnv{
@@ -128,11 +129,56 @@ func TestEndpointEnv(t *testing.T) {
 				"annotations": map[string]string{
 					"annotation_1": "value_1",
 				},
-				"uid":          "service-uid",
-				"namespace":    "service-namespace",
-				"cluster_ip":   "192.68.73.2",
-				"service_type": "LoadBalancer",
-				"host":         "service.namespace",
+				"uid":                "service-uid",
+				"namespace":          "service-namespace",
+				"cluster_ip":         "192.68.73.2",
+				"service_type":       "LoadBalancer",
+				"external_name":      "",
+				"external_addresses": []string{"203.0.113.10"},
+				"host":               "service.namespace",
+			},
+		},
+		{
+			name: "Service port",
+			endpoint: Endpoint{
+				ID:     EndpointID("service_port_id"),
+				Target: "service.namespace:8080",
+				Details: &K8sServicePort{
+					Name: "http",
+					Service: K8sService{
+						Name:        "service_name",
+						UID:         "service-uid",
+						Namespace:   "service-namespace",
+						ServiceType: "NodePort",
+						ClusterIP:   "192.68.73.2",
+					},
+					Port:       8080,
+					NodePort:   30080,
+					TargetPort: "http-server",
+					Transport:  ProtocolTCP,
+				},
+			},
+			want: EndpointEnv{
+				"type":     "k8s.service.port",
+				"endpoint": "service.namespace:8080",
+				"id":       "service_port_id",
+				"name":     "http",
+				"service": EndpointEnv{
+					"name":               "service_name",
+					"labels":             map[string]string(nil),
+					"annotations":        map[string]string(nil),
+					"uid":                "service-uid",
+					"namespace":          "service-namespace",
+					"cluster_ip":         "192.68.73.2",
+					"service_type":       "NodePort",
+					"external_name":      "",
+					"external_addresses": []string(nil),
+				},
+				"port":        uint16(8080),
+				"node_port":   uint16(30080),
+				"target_port": "http-server",
+				"transport":   ProtocolTCP,
+				"host":        "service.namespace",
 			},
 		},
 		{
@@ -143,6 +189,8 @@ func TestEndpointEnv(t *testing.T) {
 				Details: &HostPort{
 					ProcessName: "process_name",
 					Command:     "./cmd --config config.yaml",
+					Args:        []string{"./cmd", "--config", "config.yaml"},
+					Environment: map[string]string{"SPRING_PROFILES_ACTIVE": "prod"},
 					Port:        2379,
 					Transport:   ProtocolUDP,
 					IsIPv6:      true,
@@ -154,6 +202,8 @@ func TestEndpointEnv(t *testing.T) {
 				"id":           "port_id",
 				"process_name": "process_name",
 				"command":      "./cmd --config config.yaml",
+				"args":         []string{"./cmd", "--config", "config.yaml"},
+				"environment":  map[string]string{"SPRING_PROFILES_ACTIVE": "prod"},
 				"is_ipv6":      true,
 				"port":         uint16(2379),
 				"transport":    ProtocolUDP,
@@ -13,6 +13,10 @@ type Config struct {
 	// needs to poll for collecting information about new processes.
 	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
 
+	// EnvAllowlist lists the names of the environment variables of the processes
+	// that are exposed as endpoint variables. No environment variable is exposed by default.
+	EnvAllowlist []string `mapstructure:"env_allowlist"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
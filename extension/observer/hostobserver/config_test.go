@@ -32,6 +32,7 @@ func TestLoadConfig(t *testing.T) {
 			id: component.NewIDWithName(metadata.Type, "all_settings"),
 			expected: &Config{
 				RefreshInterval: 20 * time.Second,
+				EnvAllowlist:    []string{"SPRING_PROFILES_ACTIVE", "OTEL_SERVICE_NAME"},
 			},
 		},
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"strings"
 	"syscall"
 
 	"github.com/shirou/gopsutil/v4/net"
@@ -26,11 +27,13 @@ type hostObserver struct {
 type endpointsLister struct {
 	logger       *zap.Logger
 	observerName string
+	envAllowlist []string
 
 	// For testing
 	getConnections        func() ([]net.ConnectionStat, error)
 	getProcess            func(pid int32) (*process.Process, error)
 	collectProcessDetails func(proc *process.Process) (*processDetails, error)
+	getProcessEnviron     func(proc *process.Process) ([]string, error)
 }
 
 var _ extension.Extension = (*hostObserver)(nil)
@@ -41,9 +44,11 @@ func newObserver(params extension.Settings, config *Config) (extension.Extension
 			endpointsLister{
 				logger:                params.Logger,
 				observerName:          params.ID.String(),
+				envAllowlist:          config.EnvAllowlist,
 				getConnections:        getConnections,
 				getProcess:            process.NewProcess,
 				collectProcessDetails: collectProcessDetails,
+				getProcessEnviron:     getProcessEnviron,
 			},
 			config.RefreshInterval,
 			params.Logger,
@@ -145,6 +150,8 @@ func (e endpointsLister) collectEndpoints(conns []net.ConnectionStat) []observer
 			continue
 		}
 
+		environment := e.collectProcessEnvironment(proc)
+
 		for _, c := range conns {
 			cd := collectConnectionDetails(c)
 
@@ -161,6 +168,8 @@ func (e endpointsLister) collectEndpoints(conns []net.ConnectionStat) []observer
 				Details: &observer.HostPort{
 					ProcessName: pd.name,
 					Command:     pd.args,
+					Args:        pd.argsSlice,
+					Environment: environment,
 					Port:        cd.port,
 					Transport:   cd.transport,
 					// TODO: Move this field to observer.Endpoint and
@@ -213,8 +222,9 @@ func collectConnectionDetails(c *net.ConnectionStat) connectionDetails {
 }
 
 type processDetails struct {
-	name string
-	args string
+	name      string
+	args      string
+	argsSlice []string
 }
 
 func collectProcessDetails(proc *process.Process) (*processDetails, error) {
@@ -228,12 +238,52 @@ func collectProcessDetails(proc *process.Process) (*processDetails, error) {
 		return nil, fmt.Errorf("could not get process args: %w", err)
 	}
 
+	argsSlice, err := proc.CmdlineSlice()
+	if err != nil {
+		return nil, fmt.Errorf("could not get process args: %w", err)
+	}
+
 	return &processDetails{
-		name: name,
-		args: args,
+		name:      name,
+		args:      args,
+		argsSlice: argsSlice,
 	}, nil
 }
 
+func getProcessEnviron(proc *process.Process) ([]string, error) {
+	return proc.Environ()
+}
+
+// collectProcessEnvironment returns the allowlisted environment variables of a process.
+// Reading the environment of a process may not be permitted, in which case the endpoint
+// is still reported without environment variables.
+func (e endpointsLister) collectProcessEnvironment(proc *process.Process) map[string]string {
+	if len(e.envAllowlist) == 0 {
+		return nil
+	}
+
+	environ, err := e.getProcessEnviron(proc)
+	if err != nil {
+		e.logger.Debug("Could not get process environment", zap.Int32("pid", proc.Pid), zap.Error(err))
+		return nil
+	}
+
+	environment := map[string]string{}
+	for _, kv := range environ {
+		name, value, found := strings.Cut(kv, "=")
+		if !found {
+			continue
+		}
+		for _, allowed := range e.envAllowlist {
+			if name == allowed {
+				environment[name] = value
+				break
+			}
+		}
+	}
+	return environment
+}
+
 func portTypeToProtocol(t uint32) observer.Transport {
 	switch t {
 	case syscall.SOCK_STREAM:
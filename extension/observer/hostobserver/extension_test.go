@@ -163,6 +163,7 @@ func startAndStopObserver(
 		getConnections:        getConnections,
 		getProcess:            process.NewProcess,
 		collectProcessDetails: collectProcessDetails,
+		getProcessEnviron:     getProcessEnviron,
 	}
 
 	if getConnectionsOverride != nil {
@@ -425,11 +426,13 @@ func TestCollectConnectionDetails(t *testing.T) {
 
 func TestCollectEndpoints(t *testing.T) {
 	tests := []struct {
-		name        string
-		conns       []psnet.ConnectionStat
-		newProc     func(pid int32) (*process.Process, error)
-		procDetails func(proc *process.Process) (*processDetails, error)
-		want        []observer.Endpoint
+		name         string
+		conns        []psnet.ConnectionStat
+		newProc      func(pid int32) (*process.Process, error)
+		procDetails  func(proc *process.Process) (*processDetails, error)
+		procEnviron  func(proc *process.Process) ([]string, error)
+		envAllowlist []string
+		want         []observer.Endpoint
 	}{
 		{
 			name: "Listening TCP socket without process info",
@@ -515,19 +518,104 @@ func TestCollectEndpoints(t *testing.T) {
 			},
 			want: []observer.Endpoint{},
 		},
+		{
+			name: "Process with allowlisted environment variables",
+			conns: []psnet.ConnectionStat{
+				{
+					Family: syscall.AF_INET,
+					Type:   syscall.SOCK_STREAM,
+					Laddr: psnet.Addr{
+						IP:   "123.345.567.789",
+						Port: 8080,
+					},
+					Status: "LISTEN",
+					Pid:    9999,
+				},
+			},
+			newProc: func(pid int32) (*process.Process, error) {
+				return &process.Process{Pid: pid}, nil
+			},
+			procDetails: func(_ *process.Process) (*processDetails, error) {
+				return &processDetails{
+					name:      "java",
+					args:      "java -jar app.jar",
+					argsSlice: []string{"java", "-jar", "app.jar"},
+				}, nil
+			},
+			procEnviron: func(_ *process.Process) ([]string, error) {
+				return []string{"SPRING_PROFILES_ACTIVE=prod", "SECRET=changeme", "EMPTY=", "MALFORMED"}, nil
+			},
+			envAllowlist: []string{"SPRING_PROFILES_ACTIVE", "EMPTY", "MALFORMED", "MISSING"},
+			want: []observer.Endpoint{
+				{
+					ID:     observer.EndpointID("()123.345.567.789-8080-TCP-9999"),
+					Target: "123.345.567.789:8080",
+					Details: &observer.HostPort{
+						ProcessName: "java",
+						Command:     "java -jar app.jar",
+						Args:        []string{"java", "-jar", "app.jar"},
+						Environment: map[string]string{"SPRING_PROFILES_ACTIVE": "prod", "EMPTY": ""},
+						Port:        8080,
+						Transport:   observer.ProtocolTCP,
+					},
+				},
+			},
+		},
+		{
+			name: "Fails to get process environment",
+			conns: []psnet.ConnectionStat{
+				{
+					Family: syscall.AF_INET,
+					Type:   syscall.SOCK_STREAM,
+					Laddr: psnet.Addr{
+						IP:   "123.345.567.789",
+						Port: 8080,
+					},
+					Status: "LISTEN",
+					Pid:    9999,
+				},
+			},
+			newProc: func(pid int32) (*process.Process, error) {
+				return &process.Process{Pid: pid}, nil
+			},
+			procDetails: func(_ *process.Process) (*processDetails, error) {
+				return &processDetails{name: "java"}, nil
+			},
+			procEnviron: func(_ *process.Process) ([]string, error) {
+				return nil, errors.New("permission denied")
+			},
+			envAllowlist: []string{"SPRING_PROFILES_ACTIVE"},
+			want: []observer.Endpoint{
+				{
+					ID:     observer.EndpointID("()123.345.567.789-8080-TCP-9999"),
+					Target: "123.345.567.789:8080",
+					Details: &observer.HostPort{
+						ProcessName: "java",
+						Port:        8080,
+						Transport:   observer.ProtocolTCP,
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := endpointsLister{
 				logger:                zap.NewNop(),
+				envAllowlist:          tt.envAllowlist,
 				getProcess:            process.NewProcess,
 				collectProcessDetails: collectProcessDetails,
+				getProcessEnviron:     getProcessEnviron,
 			}
 
 			if tt.procDetails != nil {
 				e.collectProcessDetails = tt.procDetails
 			}
 
+			if tt.procEnviron != nil {
+				e.getProcessEnviron = tt.procEnviron
+			}
+
 			if tt.newProc != nil {
 				e.getProcess = tt.newProc
 			}
@@ -8,6 +8,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // newPod is a helper function for creating Pods for testing.
@@ -138,6 +139,33 @@ var serviceWithClusterIPV2 = func() *v1.Service {
 	return service
 }()
 
+var serviceWithPorts = func() *v1.Service {
+	service := newService("service-2")
+	service.Spec.Type = v1.ServiceTypeLoadBalancer
+	service.Spec.ExternalIPs = []string{"10.0.0.1"}
+	service.Spec.Ports = []v1.ServicePort{
+		{
+			Name:       "http",
+			Protocol:   v1.ProtocolTCP,
+			Port:       80,
+			TargetPort: intstr.FromString("http-server"),
+			NodePort:   30080,
+		},
+		{
+			Name:       "dns",
+			Protocol:   v1.ProtocolUDP,
+			Port:       53,
+			TargetPort: intstr.FromInt32(5353),
+			NodePort:   30053,
+		},
+	}
+	service.Status.LoadBalancer.Ingress = []v1.LoadBalancerIngress{
+		{IP: "203.0.113.10"},
+		{Hostname: "service-2.example.com"},
+	}
+	return service
+}()
+
 var ingress = &networkingv1.Ingress{
 	ObjectMeta: metav1.ObjectMeta{
 		Namespace: "default",
@@ -12,29 +12,64 @@ import (
 )
 
 // convertServiceToEndpoints converts a service instance into a slice of endpoints. The endpoints
-// include the service itself only.
+// include the service itself as well as an endpoint for each port exposed by the service.
 func convertServiceToEndpoints(idNamespace string, service *v1.Service) []observer.Endpoint {
 	serviceID := observer.EndpointID(fmt.Sprintf("%s/%s", idNamespace, service.UID))
 
 	serviceDetails := observer.K8sService{
-		UID:         string(service.UID),
-		Annotations: service.Annotations,
-		Labels:      service.Labels,
-		Name:        service.Name,
-		Namespace:   service.Namespace,
-		ClusterIP:   service.Spec.ClusterIP,
-		ServiceType: string(service.Spec.Type),
+		UID:               string(service.UID),
+		Annotations:       service.Annotations,
+		Labels:            service.Labels,
+		Name:              service.Name,
+		Namespace:         service.Namespace,
+		ClusterIP:         service.Spec.ClusterIP,
+		ServiceType:       string(service.Spec.Type),
+		ExternalName:      service.Spec.ExternalName,
+		ExternalAddresses: getExternalAddresses(service),
 	}
 
+	serviceTarget := generateServiceTarget(&serviceDetails)
 	endpoints := []observer.Endpoint{{
 		ID:      serviceID,
-		Target:  generateServiceTarget(&serviceDetails),
+		Target:  serviceTarget,
 		Details: &serviceDetails,
 	}}
 
+	// Create endpoint for each service port.
+	for _, port := range service.Spec.Ports {
+		endpoints = append(endpoints, observer.Endpoint{
+			ID:     observer.EndpointID(fmt.Sprintf("%s/%s(%d)", serviceID, port.Name, port.Port)),
+			Target: fmt.Sprintf("%s:%d", serviceTarget, port.Port),
+			Details: &observer.K8sServicePort{
+				Name:       port.Name,
+				Service:    serviceDetails,
+				Port:       uint16(port.Port),
+				NodePort:   uint16(port.NodePort),
+				TargetPort: port.TargetPort.String(),
+				Transport:  getTransport(port.Protocol),
+			},
+		})
+	}
+
 	return endpoints
 }
 
 func generateServiceTarget(service *observer.K8sService) string {
 	return fmt.Sprintf("%s.%s.svc.cluster.local", service.Name, service.Namespace)
 }
+
+// getExternalAddresses returns the external IPs of a service followed by the IPs and hostnames
+// of its load balancer ingress points.
+func getExternalAddresses(service *v1.Service) []string {
+	var addresses []string
+	addresses = append(addresses, service.Spec.ExternalIPs...)
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			addresses = append(addresses, ingress.IP)
+		}
+		if ingress.Hostname != "" {
+			addresses = append(addresses, ingress.Hostname)
+		}
+	}
+	return addresses
+}
@@ -30,3 +30,49 @@ func TestServiceObjectToEndpoint(t *testing.T) {
 	endpoints := convertServiceToEndpoints("namespace", serviceWithClusterIP)
 	require.Equal(t, expectedEndpoints, endpoints)
 }
+
+func TestServiceWithPortsToEndpoints(t *testing.T) {
+	service := observer.K8sService{
+		Name:              "service-2",
+		Namespace:         "default",
+		UID:               "service-2-UID",
+		Labels:            map[string]string{"env": "prod"},
+		ServiceType:       "LoadBalancer",
+		ClusterIP:         "1.2.3.4",
+		ExternalAddresses: []string{"10.0.0.1", "203.0.113.10", "service-2.example.com"},
+	}
+	expectedEndpoints := []observer.Endpoint{
+		{
+			ID:      "namespace/service-2-UID",
+			Target:  "service-2.default.svc.cluster.local",
+			Details: &service,
+		},
+		{
+			ID:     "namespace/service-2-UID/http(80)",
+			Target: "service-2.default.svc.cluster.local:80",
+			Details: &observer.K8sServicePort{
+				Name:       "http",
+				Service:    service,
+				Port:       80,
+				NodePort:   30080,
+				TargetPort: "http-server",
+				Transport:  observer.ProtocolTCP,
+			},
+		},
+		{
+			ID:     "namespace/service-2-UID/dns(53)",
+			Target: "service-2.default.svc.cluster.local:53",
+			Details: &observer.K8sServicePort{
+				Name:       "dns",
+				Service:    service,
+				Port:       53,
+				NodePort:   30053,
+				TargetPort: "5353",
+				Transport:  observer.ProtocolUDP,
+			},
+		},
+	}
+
+	endpoints := convertServiceToEndpoints("namespace", serviceWithPorts)
+	require.Equal(t, expectedEndpoints, endpoints)
+}
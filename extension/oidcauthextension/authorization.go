@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oidcauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oidcauthextension"
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/collector/client"
+)
+
+const defaultScopeClaim = "scope"
+
+// authorize checks that the token has one of the allowed audiences and one of the allowed scopes.
+func (a *AuthorizationCfg) authorize(audiences []string, claims map[string]any) error {
+	if len(a.AllowedAudiences) > 0 && !containsAny(a.AllowedAudiences, audiences) {
+		return errAudienceNotAllowed
+	}
+
+	if len(a.AllowedScopes) > 0 {
+		scopeClaim := a.ScopeClaim
+		if scopeClaim == "" {
+			scopeClaim = defaultScopeClaim
+		}
+		if !containsAny(a.AllowedScopes, getScopesFromClaims(claims, scopeClaim)) {
+			return errScopeNotAllowed
+		}
+	}
+	return nil
+}
+
+// getScopesFromClaims returns the scopes held by the given claim, either as a space-separated
+// string as defined by RFC 8693, or as a list as issued by some providers.
+func getScopesFromClaims(claims map[string]any, scopeClaim string) []string {
+	switch v := claims[scopeClaim].(type) {
+	case string:
+		return strings.Fields(v)
+	case []any:
+		scopes := make([]string, 0, len(v))
+		for _, scope := range v {
+			if s, ok := scope.(string); ok {
+				scopes = append(scopes, s)
+			}
+		}
+		return scopes
+	default:
+		return nil
+	}
+}
+
+func containsAny(allowed, values []string) bool {
+	for _, v := range values {
+		if slices.Contains(allowed, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// claimsToMetadata returns a copy of the given metadata with the values of the claims set to
+// their metadata keys. The claims missing from the token are not set.
+func claimsToMetadata(md client.Metadata, claims map[string]any, claimsToMetadata map[string]string) client.Metadata {
+	m := map[string][]string{}
+	for k := range md.Keys() {
+		m[k] = md.Get(k)
+	}
+	for claim, key := range claimsToMetadata {
+		value, ok := claims[claim]
+		if !ok {
+			continue
+		}
+		m[key] = claimValues(value)
+	}
+	return client.NewMetadata(m)
+}
+
+// claimValues returns the metadata values of a claim, with one value per element of the lists.
+func claimValues(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, elem := range v {
+			values = append(values, claimValues(elem)...)
+		}
+		return values
+	case map[string]any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		return []string{string(b)}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oidcauthextension
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/extensionauth"
+)
+
+func authenticateWithClaims(t *testing.T, cfg *Config, claims map[string]any) (context.Context, error) {
+	t.Helper()
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.Start()
+	t.Cleanup(oidcServer.Close)
+
+	cfg.IssuerURL = oidcServer.URL
+	cfg.Audience = "unit-test"
+	cfg.IgnoreAudience = true
+	p := newTestExtension(t, cfg)
+	require.NoError(t, p.Start(t.Context(), componenttest.NewNopHost()))
+
+	payload := map[string]any{
+		"sub": "jdoe@example.com",
+		"iss": oidcServer.URL,
+		"aud": "unit-test",
+		"exp": time.Now().Add(time.Minute).Unix(),
+	}
+	for k, v := range claims {
+		payload[k] = v
+	}
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	token, err := oidcServer.token(data)
+	require.NoError(t, err)
+
+	ctx := client.NewContext(t.Context(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-existing": {"value"}}),
+	})
+	return p.(extensionauth.Server).Authenticate(ctx, map[string][]string{"authorization": {fmt.Sprintf("Bearer %s", token)}})
+}
+
+func TestClaimsToMetadata(t *testing.T) {
+	cfg := &Config{
+		ClaimsToMetadata: map[string]string{
+			"tenant":  "x-tenant",
+			"regions": "x-regions",
+			"level":   "x-level",
+			"org":     "x-org",
+			"missing": "x-missing",
+		},
+	}
+	ctx, err := authenticateWithClaims(t, cfg, map[string]any{
+		"tenant":  "acme",
+		"regions": []string{"eu", "us"},
+		"level":   3,
+		"org":     map[string]any{"id": "42"},
+	})
+	require.NoError(t, err)
+
+	md := client.FromContext(ctx).Metadata
+	assert.Equal(t, []string{"acme"}, md.Get("x-tenant"))
+	assert.Equal(t, []string{"eu", "us"}, md.Get("x-regions"))
+	assert.Equal(t, []string{"3"}, md.Get("x-level"))
+	assert.Equal(t, []string{`{"id":"42"}`}, md.Get("x-org"))
+	assert.Empty(t, md.Get("x-missing"))
+	assert.Equal(t, []string{"value"}, md.Get("x-existing"))
+}
+
+func TestAuthorizationRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization AuthorizationCfg
+		claims        map[string]any
+		expectedErr   error
+	}{
+		{
+			name:          "no rules",
+			authorization: AuthorizationCfg{},
+		},
+		{
+			name:          "allowed audience",
+			authorization: AuthorizationCfg{AllowedAudiences: []string{"other", "unit-test"}},
+		},
+		{
+			name:          "allowed audience in list",
+			authorization: AuthorizationCfg{AllowedAudiences: []string{"other"}},
+			claims:        map[string]any{"aud": []string{"unit-test", "other"}},
+		},
+		{
+			name:          "audience not allowed",
+			authorization: AuthorizationCfg{AllowedAudiences: []string{"other"}},
+			expectedErr:   errAudienceNotAllowed,
+		},
+		{
+			name:          "allowed scope in string",
+			authorization: AuthorizationCfg{AllowedScopes: []string{"telemetry:write"}},
+			claims:        map[string]any{"scope": "openid telemetry:write"},
+		},
+		{
+			name:          "allowed scope in list with custom claim",
+			authorization: AuthorizationCfg{AllowedScopes: []string{"telemetry:write"}, ScopeClaim: "scp"},
+			claims:        map[string]any{"scp": []string{"openid", "telemetry:write"}},
+		},
+		{
+			name:          "scope not allowed",
+			authorization: AuthorizationCfg{AllowedScopes: []string{"telemetry:write"}},
+			claims:        map[string]any{"scope": "openid telemetry:read"},
+			expectedErr:   errScopeNotAllowed,
+		},
+		{
+			name:          "scope missing",
+			authorization: AuthorizationCfg{AllowedScopes: []string{"telemetry:write"}},
+			expectedErr:   errScopeNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := authenticateWithClaims(t, &Config{Authorization: tt.authorization}, tt.claims)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
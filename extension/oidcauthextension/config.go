@@ -48,6 +48,31 @@ type Config struct {
 	// Providers allows configuring multiple OIDC providers.
 	// Use the getProviderConfigs() method to get the full list of providers, including the legacy configuration.
 	Providers []ProviderCfg `mapstructure:"providers"`
+
+	// ClaimsToMetadata maps the names of the token claims to the client metadata keys they are
+	// exposed with, so that processors can use them, e.g. to route the telemetry by tenant.
+	// Optional.
+	ClaimsToMetadata map[string]string `mapstructure:"claims_to_metadata"`
+
+	// Authorization holds the rules the verified tokens must satisfy.
+	// Optional.
+	Authorization AuthorizationCfg `mapstructure:"authorization"`
+}
+
+// AuthorizationCfg holds the rules the verified tokens must satisfy to be accepted. Use distinct
+// instances of the extension to apply distinct rules to the receivers.
+type AuthorizationCfg struct {
+	// AllowedAudiences is the list of audiences allowed, the token must have at least one of them.
+	// Optional.
+	AllowedAudiences []string `mapstructure:"allowed_audiences"`
+
+	// AllowedScopes is the list of scopes allowed, the token must have at least one of them.
+	// Optional.
+	AllowedScopes []string `mapstructure:"allowed_scopes"`
+
+	// ScopeClaim is the claim holding the scopes of the token, either as a space-separated string
+	// or as a list. Optional, default value: "scope".
+	ScopeClaim string `mapstructure:"scope_claim"`
 }
 
 func (cfg *Config) getLegacyProviderConfig() *ProviderCfg {
@@ -89,6 +114,12 @@ func (cfg *Config) Validate() (errs error) {
 		seenIssuers[provider.IssuerURL] = struct{}{}
 		errs = multierr.Append(errs, provider.Validate())
 	}
+	for claim, key := range cfg.ClaimsToMetadata {
+		if claim == "" || key == "" {
+			errs = multierr.Append(errs, errEmptyClaimToMetadata)
+			break
+		}
+	}
 	return errs
 }
 
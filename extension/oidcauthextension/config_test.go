@@ -25,3 +25,12 @@ func TestDuplicateIssuers(t *testing.T) {
 	}
 	require.Error(t, config.Validate())
 }
+
+func TestEmptyClaimToMetadata(t *testing.T) {
+	config := &Config{
+		IssuerURL:        "https://example.com",
+		Audience:         "https://example.com",
+		ClaimsToMetadata: map[string]string{"tenant": ""},
+	}
+	require.ErrorIs(t, config.Validate(), errEmptyClaimToMetadata)
+}
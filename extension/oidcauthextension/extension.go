@@ -57,6 +57,9 @@ var (
 	errUsernameNotString                 = errors.New("the username returned by the OIDC provider isn't a regular string")
 	errGroupsClaimNotFound               = errors.New("groups claim from the OIDC configuration not found on the token returned by the OIDC provider")
 	errNotAuthenticated                  = errors.New("authentication didn't succeed")
+	errEmptyClaimToMetadata              = errors.New("claims_to_metadata must not contain empty claim names or metadata keys")
+	errAudienceNotAllowed                = errors.New("none of the audiences of the token is allowed")
+	errScopeNotAllowed                   = errors.New("none of the scopes of the token is allowed")
 )
 
 func newExtension(cfg *Config, logger *zap.Logger) extension.Extension {
@@ -150,6 +153,10 @@ func (e *oidcExtension) Authenticate(ctx context.Context, headers map[string][]s
 		return ctx, fmt.Errorf("failed to get groups from claims in the token: %w", err)
 	}
 
+	if err = e.cfg.Authorization.authorize(idToken.Audience, claims); err != nil {
+		return ctx, err
+	}
+
 	cl := client.FromContext(ctx)
 	cl.Auth = &authData{
 		raw:        raw,
@@ -157,6 +164,9 @@ func (e *oidcExtension) Authenticate(ctx context.Context, headers map[string][]s
 		subject:    subject,
 		membership: membership,
 	}
+	if len(e.cfg.ClaimsToMetadata) > 0 {
+		cl.Metadata = claimsToMetadata(cl.Metadata, claims, e.cfg.ClaimsToMetadata)
+	}
 	return client.NewContext(ctx, cl), nil
 }
 
@@ -46,6 +46,21 @@ type Config struct {
 
 	// PPIDPollInterval is the time between polling for whether PPID is running.
 	PPIDPollInterval time.Duration `mapstructure:"ppid_poll_interval"`
+
+	// RemoteConfig contains options to apply the configuration offered by the OpAMP server.
+	RemoteConfig RemoteConfig `mapstructure:"remote_config"`
+}
+
+// RemoteConfig contains options to apply the configuration offered by the OpAMP server.
+type RemoteConfig struct {
+	// Enabled enables the OpAMP AcceptsRemoteConfig and ReportsRemoteConfig Capabilities. (default: false)
+	Enabled bool `mapstructure:"enabled"`
+	// ConfigFile is the file the remote configuration is written to. The collector must be
+	// started with this file as one of its configuration sources.
+	ConfigFile string `mapstructure:"config_file"`
+	// CanaryWindow is how long the health of the collector is watched after applying a remote
+	// configuration. The previous configuration is restored if the health degrades meanwhile. (default: 30s)
+	CanaryWindow time.Duration `mapstructure:"canary_window"`
 }
 
 type AgentDescription struct {
@@ -224,5 +239,17 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	if cfg.RemoteConfig.Enabled {
+		if cfg.RemoteConfig.ConfigFile == "" {
+			return errors.New("remote_config::config_file must be provided")
+		}
+		if cfg.RemoteConfig.CanaryWindow < 0 {
+			return errors.New("remote_config::canary_window must be 0 or greater")
+		}
+		if !cfg.Capabilities.ReportsHealth {
+			return errors.New("remote_config requires the reports_health capability")
+		}
+	}
+
 	return nil
 }
@@ -44,6 +44,9 @@ func TestUnmarshalConfig(t *testing.T) {
 				ReportsAvailableComponents: true,
 			},
 			PPIDPollInterval: 5 * time.Second,
+			RemoteConfig: RemoteConfig{
+				CanaryWindow: 30 * time.Second,
+			},
 		}, cfg)
 }
 
@@ -70,6 +73,9 @@ func TestUnmarshalHttpConfig(t *testing.T) {
 				ReportsAvailableComponents: true,
 			},
 			PPIDPollInterval: 5 * time.Second,
+			RemoteConfig: RemoteConfig{
+				CanaryWindow: 30 * time.Second,
+			},
 		}, cfg)
 }
 
@@ -217,6 +223,7 @@ func TestConfig_Validate(t *testing.T) {
 		Server       *OpAMPServer
 		InstanceUID  string
 		Capabilities Capabilities
+		RemoteConfig RemoteConfig
 	}
 	tests := []struct {
 		name    string
@@ -339,6 +346,74 @@ func TestConfig_Validate(t *testing.T) {
 				return assert.Equal(t, "opamp server must have only ws or http set", err.Error())
 			},
 		},
+		{
+			name: "remote config valid",
+			fields: fields{
+				Server: &OpAMPServer{
+					WS: &commonFields{
+						Endpoint: "wss://127.0.0.1:4320/v1/opamp",
+					},
+				},
+				Capabilities: Capabilities{ReportsHealth: true},
+				RemoteConfig: RemoteConfig{
+					Enabled:      true,
+					ConfigFile:   "/etc/otelcol/remote.yaml",
+					CanaryWindow: time.Minute,
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "remote config must have config file",
+			fields: fields{
+				Server: &OpAMPServer{
+					WS: &commonFields{
+						Endpoint: "wss://127.0.0.1:4320/v1/opamp",
+					},
+				},
+				Capabilities: Capabilities{ReportsHealth: true},
+				RemoteConfig: RemoteConfig{Enabled: true},
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.Equal(t, "remote_config::config_file must be provided", err.Error())
+			},
+		},
+		{
+			name: "remote config invalid canary window",
+			fields: fields{
+				Server: &OpAMPServer{
+					WS: &commonFields{
+						Endpoint: "wss://127.0.0.1:4320/v1/opamp",
+					},
+				},
+				Capabilities: Capabilities{ReportsHealth: true},
+				RemoteConfig: RemoteConfig{
+					Enabled:      true,
+					ConfigFile:   "/etc/otelcol/remote.yaml",
+					CanaryWindow: -1,
+				},
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.Equal(t, "remote_config::canary_window must be 0 or greater", err.Error())
+			},
+		},
+		{
+			name: "remote config requires health reporting",
+			fields: fields{
+				Server: &OpAMPServer{
+					WS: &commonFields{
+						Endpoint: "wss://127.0.0.1:4320/v1/opamp",
+					},
+				},
+				RemoteConfig: RemoteConfig{
+					Enabled:    true,
+					ConfigFile: "/etc/otelcol/remote.yaml",
+				},
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.Equal(t, "remote_config requires the reports_health capability", err.Error())
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -346,6 +421,7 @@ func TestConfig_Validate(t *testing.T) {
 				Server:       tt.fields.Server,
 				InstanceUID:  tt.fields.InstanceUID,
 				Capabilities: tt.fields.Capabilities,
+				RemoteConfig: tt.fields.RemoteConfig,
 			}
 			tt.wantErr(t, cfg.Validate())
 		})
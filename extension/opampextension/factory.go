@@ -31,6 +31,9 @@ func createDefaultConfig() component.Config {
 			ReportsAvailableComponents: true,
 		},
 		PPIDPollInterval: 5 * time.Second,
+		RemoteConfig: RemoteConfig{
+			CanaryWindow: 30 * time.Second,
+		},
 	}
 }
 
@@ -79,6 +79,9 @@ type opampAgent struct {
 
 	customCapabilityRegistry *customCapabilityRegistry
 
+	// remoteConfig applies the configuration offered by the OpAMP server, nil if disabled.
+	remoteConfig *remoteConfigApplier
+
 	statusAggregator     statusAggregator
 	statusSubscriptionWg *sync.WaitGroup
 	componentHealthWg    *sync.WaitGroup
@@ -127,12 +130,20 @@ func (o *opampAgent) Start(ctx context.Context, host component.Host) error {
 		return err
 	}
 
+	var remoteConfigStatus *protobufs.RemoteConfigStatus
+	if o.remoteConfig != nil {
+		if remoteConfigStatus, err = o.remoteConfig.load(); err != nil {
+			return err
+		}
+	}
+
 	settings := types.StartSettings{
-		Header:         header,
-		HeaderFunc:     headerFunc,
-		TLSConfig:      tls,
-		OpAMPServerURL: o.cfg.Server.GetEndpoint(),
-		InstanceUid:    types.InstanceUid(o.instanceID),
+		Header:             header,
+		HeaderFunc:         headerFunc,
+		TLSConfig:          tls,
+		OpAMPServerURL:     o.cfg.Server.GetEndpoint(),
+		InstanceUid:        types.InstanceUid(o.instanceID),
+		RemoteConfigStatus: remoteConfigStatus,
 		Callbacks: types.Callbacks{
 			OnConnect: func(_ context.Context) {
 				o.logger.Debug("Connected to the OpAMP server")
@@ -172,6 +183,10 @@ func (o *opampAgent) Start(ctx context.Context, host component.Host) error {
 	}
 
 	capabilities := o.capabilities.toAgentCapabilities()
+	if o.remoteConfig != nil {
+		capabilities |= protobufs.AgentCapabilities_AgentCapabilities_AcceptsRemoteConfig |
+			protobufs.AgentCapabilities_AgentCapabilities_ReportsRemoteConfig
+	}
 	if err := o.opampClient.SetCapabilities(&capabilities); err != nil {
 		return err
 	}
@@ -191,6 +206,9 @@ func (o *opampAgent) Shutdown(ctx context.Context) error {
 	if o.lifetimeCtxCancel != nil {
 		o.lifetimeCtxCancel()
 	}
+	if o.remoteConfig != nil {
+		o.remoteConfig.stop()
+	}
 
 	o.statusSubscriptionWg.Wait()
 	o.componentHealthWg.Wait()
@@ -243,6 +261,9 @@ func (o *opampAgent) Register(capability string, opts ...opampcustommessages.Cus
 func (o *opampAgent) Ready() error {
 	o.setHealth(&protobufs.ComponentHealth{Healthy: true})
 	close(o.readyCh)
+	if o.remoteConfig != nil {
+		o.remoteConfig.startCanary()
+	}
 	return nil
 }
 
@@ -337,6 +358,11 @@ func newOpampAgent(cfg *Config, set extension.Settings) (*opampAgent, error) {
 
 	agent.lifetimeCtx, agent.lifetimeCtxCancel = context.WithCancel(context.Background())
 
+	if cfg.RemoteConfig.Enabled {
+		agent.remoteConfig = newRemoteConfigApplier(cfg.RemoteConfig, set.Logger)
+		agent.remoteConfig.setStatus = opampClient.SetRemoteConfigStatus
+	}
+
 	if agent.capabilities.ReportsHealth {
 		agent.initHealthReporting()
 	}
@@ -464,6 +490,14 @@ func (o *opampAgent) onMessage(_ context.Context, msg *types.MessageData) {
 	if msg.CustomMessage != nil {
 		o.customCapabilityRegistry.ProcessMessage(msg.CustomMessage)
 	}
+
+	if msg.RemoteConfig != nil {
+		if o.remoteConfig == nil {
+			o.logger.Debug("Ignoring the remote configuration offered by the OpAMP server, remote_config is disabled")
+		} else {
+			o.remoteConfig.apply(msg.RemoteConfig)
+		}
+	}
 }
 
 func (o *opampAgent) setHealth(ch *protobufs.ComponentHealth) {
@@ -659,6 +693,10 @@ func (o *opampAgent) statusAggregatorEventLoop(unsubscribeFunc status.Unsubscrib
 			componentHealth := convertComponentHealth(statusUpdate)
 
 			o.setHealth(componentHealth)
+
+			if o.remoteConfig != nil {
+				o.remoteConfig.onStatus(statusUpdate.Status(), statusUpdate.Err())
+			}
 		}
 	}
 }
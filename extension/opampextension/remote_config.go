@@ -0,0 +1,346 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension"
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// remoteConfigStateSuffix is appended to the configuration file name to persist the state of
+	// the rollout across the reloads of the collector.
+	remoteConfigStateSuffix = ".rollout.json"
+	// remoteConfigPreviousSuffix is appended to the configuration file name to keep the
+	// configuration restored on rollback.
+	remoteConfigPreviousSuffix = ".previous"
+)
+
+// remoteConfigState is the state of the last remote configuration rollout.
+type remoteConfigState struct {
+	Hash         []byte                         `json:"hash"`
+	Status       protobufs.RemoteConfigStatuses `json:"status"`
+	ErrorMessage string                         `json:"error_message,omitempty"`
+}
+
+func (s *remoteConfigState) toStatus() *protobufs.RemoteConfigStatus {
+	return &protobufs.RemoteConfigStatus{
+		LastRemoteConfigHash: s.Hash,
+		Status:               s.Status,
+		ErrorMessage:         s.ErrorMessage,
+	}
+}
+
+// remoteConfigApplier writes the configuration offered by the OpAMP server to the configuration
+// file and reloads the collector. The new configuration is watched for the canary window after
+// the collector restarted, and the previous configuration is restored if the health of a
+// component degrades meanwhile.
+type remoteConfigApplier struct {
+	cfg    RemoteConfig
+	logger *zap.Logger
+
+	// reload makes the collector reload its configuration.
+	reload func() error
+	// setStatus reports the status of the rollout to the OpAMP server.
+	setStatus func(*protobufs.RemoteConfigStatus) error
+
+	mu          sync.Mutex
+	state       *remoteConfigState
+	canaryTimer *time.Timer
+}
+
+func newRemoteConfigApplier(cfg RemoteConfig, logger *zap.Logger) *remoteConfigApplier {
+	return &remoteConfigApplier{
+		cfg:       cfg,
+		logger:    logger,
+		reload:    reloadCollector,
+		setStatus: func(*protobufs.RemoteConfigStatus) error { return nil },
+	}
+}
+
+// reloadCollector makes the collector reload its configuration by sending SIGHUP to its own process.
+func reloadCollector() error {
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		return err
+	}
+	return p.Signal(syscall.SIGHUP)
+}
+
+func (r *remoteConfigApplier) statePath() string {
+	return r.cfg.ConfigFile + remoteConfigStateSuffix
+}
+
+func (r *remoteConfigApplier) previousPath() string {
+	return r.cfg.ConfigFile + remoteConfigPreviousSuffix
+}
+
+// load reads the state of the last rollout, and returns its status to report on start.
+func (r *remoteConfigApplier) load() (*protobufs.RemoteConfigStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := os.ReadFile(r.statePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the remote configuration state: %w", err)
+	}
+
+	state := &remoteConfigState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to decode the remote configuration state: %w", err)
+	}
+	r.state = state
+	return state.toStatus(), nil
+}
+
+// apply writes the given configuration and reloads the collector, unless it was already applied.
+func (r *remoteConfigApplier) apply(rc *protobufs.AgentRemoteConfig) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.state != nil && bytes.Equal(r.state.Hash, rc.ConfigHash) {
+		return
+	}
+
+	state := &remoteConfigState{Hash: rc.ConfigHash, Status: protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLYING}
+	if err := r.write(rc); err != nil {
+		r.logger.Error("Failed to apply the remote configuration", zap.Error(err))
+		state.Status = protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED
+		state.ErrorMessage = err.Error()
+		r.updateState(state)
+		return
+	}
+	r.updateState(state)
+
+	r.logger.Info("Applying the remote configuration, reloading the collector", zap.Duration("canary_window", r.cfg.CanaryWindow))
+	if err := r.reload(); err != nil {
+		r.logger.Error("Failed to reload the collector", zap.Error(err))
+		r.rollback(state, fmt.Sprintf("failed to reload the collector: %v", err), false)
+	}
+}
+
+// write stores the previous configuration and replaces it with the given one. A missing
+// configuration file is stored as an empty one, which is restored on rollback.
+func (r *remoteConfigApplier) write(rc *protobufs.AgentRemoteConfig) error {
+	body, err := composeRemoteConfig(rc.GetConfig())
+	if err != nil {
+		return err
+	}
+
+	previous, err := os.ReadFile(r.cfg.ConfigFile)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read the previous configuration: %w", err)
+	}
+	if err = writeFileAtomic(r.previousPath(), previous); err != nil {
+		return fmt.Errorf("failed to save the previous configuration: %w", err)
+	}
+
+	if err := writeFileAtomic(r.cfg.ConfigFile, body); err != nil {
+		return fmt.Errorf("failed to write the configuration: %w", err)
+	}
+	return nil
+}
+
+// composeRemoteConfig merges the files of the remote configuration in the order of their names.
+func composeRemoteConfig(configMap *protobufs.AgentConfigMap) ([]byte, error) {
+	if configMap == nil || len(configMap.ConfigMap) == 0 {
+		return nil, errors.New("the remote configuration is empty")
+	}
+
+	names := make([]string, 0, len(configMap.ConfigMap))
+	for name := range configMap.ConfigMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	conf := confmap.New()
+	for _, name := range names {
+		var raw map[string]any
+		if err := yaml.Unmarshal(configMap.ConfigMap[name].Body, &raw); err != nil {
+			return nil, fmt.Errorf("invalid remote configuration file %q: %w", name, err)
+		}
+		if err := conf.Merge(confmap.NewFromStringMap(raw)); err != nil {
+			return nil, fmt.Errorf("invalid remote configuration file %q: %w", name, err)
+		}
+	}
+	if err := validateRemoteConfig(conf); err != nil {
+		return nil, fmt.Errorf("invalid remote configuration: %w", err)
+	}
+	return yaml.Marshal(conf.ToStringMap())
+}
+
+// validateRemoteConfig checks the structure of the configuration before it replaces the current
+// one: the components themselves can only be validated by the Collector loading it.
+func validateRemoteConfig(conf *confmap.Conf) error {
+	for _, key := range conf.AllKeys() {
+		section, _, _ := strings.Cut(key, confmap.KeyDelimiter)
+		switch section {
+		case "receivers", "processors", "exporters", "connectors", "extensions", "service":
+		default:
+			return fmt.Errorf("unknown section %q", section)
+		}
+	}
+	for _, section := range []string{"receivers", "processors", "exporters", "connectors", "extensions"} {
+		components, ok := conf.Get(section).(map[string]any)
+		if !ok {
+			if conf.Get(section) != nil {
+				return fmt.Errorf("the section %q must be a map", section)
+			}
+			continue
+		}
+		for name := range components {
+			var id component.ID
+			if err := id.UnmarshalText([]byte(name)); err != nil {
+				return fmt.Errorf("invalid component %q in %q: %w", name, section, err)
+			}
+		}
+	}
+	return nil
+}
+
+// startCanary starts watching the configuration being applied once the pipelines are ready.
+func (r *remoteConfigApplier) startCanary() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.state == nil || r.state.Status != protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLYING {
+		return
+	}
+	state := r.state
+	r.canaryTimer = time.AfterFunc(r.cfg.CanaryWindow, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.state != state || r.canaryTimer == nil {
+			return
+		}
+		r.canaryTimer = nil
+		r.logger.Info("The remote configuration is applied")
+		if err := os.Remove(r.previousPath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+			r.logger.Warn("Failed to remove the previous configuration", zap.Error(err))
+		}
+		r.updateState(&remoteConfigState{Hash: state.Hash, Status: protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED})
+	})
+}
+
+// onStatus rolls the configuration being applied back if the health of the collector degrades
+// during the canary window.
+func (r *remoteConfigApplier) onStatus(st componentstatus.Status, err error) {
+	if !isDegraded(st) {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.canaryTimer == nil {
+		return
+	}
+	r.canaryTimer.Stop()
+	r.canaryTimer = nil
+
+	msg := "the health of the collector degraded to " + st.String()
+	if err != nil {
+		msg += ": " + err.Error()
+	}
+	r.logger.Warn("Rolling the remote configuration back", zap.String("reason", msg))
+	r.rollback(r.state, msg, true)
+}
+
+// rollback restores the previous configuration and reports the rollout as failed.
+func (r *remoteConfigApplier) rollback(state *remoteConfigState, msg string, reload bool) {
+	previous, err := os.ReadFile(r.previousPath())
+	if err == nil {
+		err = writeFileAtomic(r.cfg.ConfigFile, previous)
+	}
+	if err != nil {
+		r.logger.Error("Failed to restore the previous configuration", zap.Error(err))
+		msg += fmt.Sprintf("; failed to restore the previous configuration: %v", err)
+	}
+
+	r.updateState(&remoteConfigState{
+		Hash:         state.Hash,
+		Status:       protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED,
+		ErrorMessage: msg,
+	})
+
+	if reload && err == nil {
+		if err = r.reload(); err != nil {
+			r.logger.Error("Failed to reload the collector", zap.Error(err))
+		}
+	}
+}
+
+// stop stops watching the configuration being applied, e.g. when the collector is reloaded.
+func (r *remoteConfigApplier) stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.canaryTimer != nil {
+		r.canaryTimer.Stop()
+		r.canaryTimer = nil
+	}
+}
+
+// updateState persists and reports the given state. It must be called with the lock held.
+func (r *remoteConfigApplier) updateState(state *remoteConfigState) {
+	r.state = state
+
+	data, err := json.Marshal(state)
+	if err == nil {
+		err = writeFileAtomic(r.statePath(), data)
+	}
+	if err != nil {
+		r.logger.Error("Failed to persist the remote configuration state", zap.Error(err))
+	}
+
+	if err := r.setStatus(state.toStatus()); err != nil {
+		r.logger.Error("Failed to report the remote configuration status", zap.Error(err))
+	}
+}
+
+func isDegraded(st componentstatus.Status) bool {
+	switch st {
+	case componentstatus.StatusRecoverableError, componentstatus.StatusPermanentError, componentstatus.StatusFatalError:
+		return true
+	default:
+		return false
+	}
+}
+
+// writeFileAtomic replaces the content of the given file, so that it is never read partially written.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return err
+	}
+	if err = f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
@@ -0,0 +1,236 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.uber.org/zap"
+)
+
+type remoteConfigRecorder struct {
+	mu       sync.Mutex
+	reloads  int
+	statuses []*protobufs.RemoteConfigStatus
+}
+
+func (r *remoteConfigRecorder) reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reloads++
+	return nil
+}
+
+func (r *remoteConfigRecorder) setStatus(status *protobufs.RemoteConfigStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statuses = append(r.statuses, status)
+	return nil
+}
+
+func (r *remoteConfigRecorder) lastStatus() *protobufs.RemoteConfigStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.statuses) == 0 {
+		return nil
+	}
+	return r.statuses[len(r.statuses)-1]
+}
+
+func (r *remoteConfigRecorder) reloadCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.reloads
+}
+
+// newTestRemoteConfigApplier returns an applier as created on each start of the collector.
+func newTestRemoteConfigApplier(t *testing.T, cfg RemoteConfig) (*remoteConfigApplier, *remoteConfigRecorder) {
+	recorder := &remoteConfigRecorder{}
+	r := newRemoteConfigApplier(cfg, zap.NewNop())
+	r.reload = recorder.reload
+	r.setStatus = recorder.setStatus
+	_, err := r.load()
+	require.NoError(t, err)
+	t.Cleanup(r.stop)
+	return r, recorder
+}
+
+func remoteConfig(hash, body string) *protobufs.AgentRemoteConfig {
+	return &protobufs.AgentRemoteConfig{
+		ConfigHash: []byte(hash),
+		Config: &protobufs.AgentConfigMap{
+			ConfigMap: map[string]*protobufs.AgentConfigFile{
+				"": {Body: []byte(body), ContentType: "text/yaml"},
+			},
+		},
+	}
+}
+
+func TestRemoteConfigApplied(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "remote.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("receivers: {}\n"), 0o600))
+	cfg := RemoteConfig{Enabled: true, ConfigFile: configFile, CanaryWindow: 10 * time.Millisecond}
+
+	r, recorder := newTestRemoteConfigApplier(t, cfg)
+	r.apply(remoteConfig("v1", "exporters:\n  debug: {}\n"))
+	assert.Equal(t, 1, recorder.reloadCount())
+	assert.Equal(t, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLYING, recorder.lastStatus().Status)
+
+	body, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+	assert.Equal(t, "exporters:\n    debug: {}\n", string(body))
+
+	// the collector is reloaded with the new configuration
+	r, recorder = newTestRemoteConfigApplier(t, cfg)
+	status, err := r.load()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("v1"), status.LastRemoteConfigHash)
+	assert.Equal(t, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLYING, status.Status)
+
+	// the configuration offered again is not applied again
+	r.apply(remoteConfig("v1", "exporters:\n  debug: {}\n"))
+	assert.Zero(t, recorder.reloadCount())
+
+	r.startCanary()
+	r.onStatus(componentstatus.StatusOK, nil)
+	assert.Eventually(t, func() bool {
+		status := recorder.lastStatus()
+		return status != nil && status.Status == protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NoFileExists(t, configFile+remoteConfigPreviousSuffix)
+
+	// the health degrading after the canary window does not roll the configuration back
+	r.onStatus(componentstatus.StatusPermanentError, errors.New("failure"))
+	assert.Zero(t, recorder.reloadCount())
+}
+
+func TestRemoteConfigRollback(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "remote.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("receivers: {}\n"), 0o600))
+	cfg := RemoteConfig{Enabled: true, ConfigFile: configFile, CanaryWindow: time.Hour}
+
+	r, _ := newTestRemoteConfigApplier(t, cfg)
+	r.apply(remoteConfig("v1", "exporters:\n  debug: {}\n"))
+
+	r, recorder := newTestRemoteConfigApplier(t, cfg)
+	r.startCanary()
+	r.onStatus(componentstatus.StatusStarting, nil)
+	assert.Zero(t, recorder.reloadCount())
+
+	r.onStatus(componentstatus.StatusRecoverableError, errors.New("connection refused"))
+	assert.Equal(t, 1, recorder.reloadCount())
+	assert.Equal(t, &protobufs.RemoteConfigStatus{
+		LastRemoteConfigHash: []byte("v1"),
+		Status:               protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED,
+		ErrorMessage:         "the health of the collector degraded to StatusRecoverableError: connection refused",
+	}, recorder.lastStatus())
+
+	body, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+	assert.Equal(t, "receivers: {}\n", string(body))
+
+	// the collector is reloaded with the previous configuration and keeps reporting the failure
+	r, recorder = newTestRemoteConfigApplier(t, cfg)
+	status, err := r.load()
+	require.NoError(t, err)
+	assert.Equal(t, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, status.Status)
+	r.apply(remoteConfig("v1", "exporters:\n  debug: {}\n"))
+	assert.Zero(t, recorder.reloadCount())
+
+	// a new configuration is applied
+	r.apply(remoteConfig("v2", "exporters:\n  nop: {}\n"))
+	assert.Equal(t, 1, recorder.reloadCount())
+}
+
+func TestRemoteConfigRollbackNoPreviousFile(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "remote.yaml")
+	cfg := RemoteConfig{Enabled: true, ConfigFile: configFile, CanaryWindow: time.Hour}
+
+	r, _ := newTestRemoteConfigApplier(t, cfg)
+	r.apply(remoteConfig("v1", "exporters:\n  debug: {}\n"))
+	assert.FileExists(t, configFile)
+
+	r, _ = newTestRemoteConfigApplier(t, cfg)
+	r.startCanary()
+	r.onStatus(componentstatus.StatusPermanentError, nil)
+	// the configuration file is emptied rather than removed, to be loaded again by the Collector
+	body, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+	assert.Empty(t, body)
+}
+
+func TestRemoteConfigInvalid(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "remote.yaml")
+	cfg := RemoteConfig{Enabled: true, ConfigFile: configFile, CanaryWindow: time.Hour}
+
+	r, recorder := newTestRemoteConfigApplier(t, cfg)
+	r.apply(remoteConfig("v1", "exporters: ["))
+	assert.Zero(t, recorder.reloadCount())
+	assert.Equal(t, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, recorder.lastStatus().Status)
+	assert.Contains(t, recorder.lastStatus().ErrorMessage, "invalid remote configuration file")
+	assert.NoFileExists(t, configFile)
+}
+
+func TestComposeRemoteConfig(t *testing.T) {
+	body, err := composeRemoteConfig(&protobufs.AgentConfigMap{
+		ConfigMap: map[string]*protobufs.AgentConfigFile{
+			"b.yaml": {Body: []byte("exporters:\n  debug:\n    verbosity: detailed\n")},
+			"a.yaml": {Body: []byte("exporters:\n  debug:\n    verbosity: basic\n  nop: {}\n")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "exporters:\n    debug:\n        verbosity: detailed\n    nop: {}\n", string(body))
+
+	_, err = composeRemoteConfig(&protobufs.AgentConfigMap{})
+	assert.EqualError(t, err, "the remote configuration is empty")
+}
+
+func TestComposeRemoteConfigValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		err  string
+	}{
+		{
+			name: "unknown section",
+			body: "exporter:\n  debug: {}\n",
+			err:  `invalid remote configuration: unknown section "exporter"`,
+		},
+		{
+			name: "section not a map",
+			body: "exporters: [debug]\n",
+			err:  `invalid remote configuration: the section "exporters" must be a map`,
+		},
+		{
+			name: "invalid component",
+			body: "exporters:\n  \"debug/\": {}\n",
+			err:  `invalid remote configuration: invalid component "debug/" in "exporters"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := composeRemoteConfig(&protobufs.AgentConfigMap{
+				ConfigMap: map[string]*protobufs.AgentConfigFile{"": {Body: []byte(tt.body)}},
+			})
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	body, err := composeRemoteConfig(&protobufs.AgentConfigMap{
+		ConfigMap: map[string]*protobufs.AgentConfigFile{
+			"": {Body: []byte("receivers:\n  otlp/2: {}\nservice:\n  pipelines:\n    traces:\n      receivers: [otlp/2]\n")},
+		},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, body)
+}
@@ -4,10 +4,32 @@
 package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configoptional"
+)
+
+const (
+	cpuProfile = "cpu"
+
+	defaultSelfProfilingInterval    = time.Minute
+	defaultSelfProfilingCPUDuration = 10 * time.Second
 )
 
+// runtimeProfiles are the profiles captured from runtime/pprof.Lookup, next to the CPU profile.
+var runtimeProfiles = map[string]struct{}{
+	"heap":         {},
+	"allocs":       {},
+	"goroutine":    {},
+	"block":        {},
+	"mutex":        {},
+	"threadcreate": {},
+}
+
 // Config has the configuration for the extension enabling the golang
 // net/http/pprof (Performance Profiler) extension.
 type Config struct {
@@ -28,11 +50,52 @@ type Config struct {
 	// Optional file name to save the CPU profile to. The profiling starts when the
 	// Collector starts and is saved to the file when the Collector is terminated.
 	SaveToFile string `mapstructure:"save_to_file"`
+
+	// SelfProfiling periodically captures profiles of the Collector, to be emitted
+	// into a profiles pipeline by the pprof receiver.
+	SelfProfiling configoptional.Optional[SelfProfilingConfig] `mapstructure:"self_profiling"`
+}
+
+// SelfProfilingConfig configures the periodic capture of the Collector profiles.
+type SelfProfilingConfig struct {
+	// Interval between two captures. The default is 1m.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// CPUDuration is how long the CPU profile is recorded on each capture. The default is 10s.
+	CPUDuration time.Duration `mapstructure:"cpu_duration"`
+
+	// Profiles are the profiles captured, among "cpu", "heap", "allocs", "goroutine",
+	// "block", "mutex" and "threadcreate". The default is "cpu" and "heap".
+	Profiles []string `mapstructure:"profiles"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the extension configuration is valid
-func (*Config) Validate() error {
+func (cfg *Config) Validate() error {
+	if !cfg.SelfProfiling.HasValue() {
+		return nil
+	}
+	sp := cfg.SelfProfiling.Get()
+	if sp.Interval <= 0 {
+		return errors.New("self_profiling::interval must be positive")
+	}
+	if len(sp.Profiles) == 0 {
+		return errors.New("self_profiling::profiles must not be empty")
+	}
+	for _, name := range sp.Profiles {
+		if name == cpuProfile {
+			if sp.CPUDuration <= 0 || sp.CPUDuration >= sp.Interval {
+				return errors.New("self_profiling::cpu_duration must be positive and shorter than the interval")
+			}
+			if cfg.SaveToFile != "" {
+				return errors.New("the cpu profile cannot be captured by self_profiling when save_to_file is set")
+			}
+			continue
+		}
+		if _, ok := runtimeProfiles[name]; !ok {
+			return fmt.Errorf("self_profiling::profiles: unknown profile %q", name)
+		}
+	}
 	return nil
 }
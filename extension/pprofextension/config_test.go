@@ -6,11 +6,13 @@ package pprofextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 
@@ -34,6 +36,18 @@ func TestLoadConfig(t *testing.T) {
 				TCPAddr:              confignet.TCPAddrConfig{Endpoint: "127.0.0.1:1777"},
 				BlockProfileFraction: 3,
 				MutexProfileFraction: 5,
+				SelfProfiling:        createDefaultConfig().(*Config).SelfProfiling,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "self_profiling"),
+			expected: &Config{
+				TCPAddr: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				SelfProfiling: configoptional.Some(SelfProfilingConfig{
+					Interval:    5 * time.Minute,
+					CPUDuration: 10 * time.Second,
+					Profiles:    []string{"cpu", "heap", "goroutine"},
+				}),
 			},
 		},
 	}
@@ -52,3 +66,47 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSelfProfiling(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      SelfProfilingConfig
+		saveToFile  string
+		expectedErr string
+	}{
+		{
+			name:        "no interval",
+			config:      SelfProfilingConfig{Profiles: []string{"heap"}},
+			expectedErr: "self_profiling::interval must be positive",
+		},
+		{
+			name:        "no profiles",
+			config:      SelfProfilingConfig{Interval: time.Minute},
+			expectedErr: "self_profiling::profiles must not be empty",
+		},
+		{
+			name:        "unknown profile",
+			config:      SelfProfilingConfig{Interval: time.Minute, Profiles: []string{"wall"}},
+			expectedErr: `self_profiling::profiles: unknown profile "wall"`,
+		},
+		{
+			name:        "cpu duration longer than interval",
+			config:      SelfProfilingConfig{Interval: time.Minute, CPUDuration: 2 * time.Minute, Profiles: []string{"cpu"}},
+			expectedErr: "self_profiling::cpu_duration must be positive and shorter than the interval",
+		},
+		{
+			name:        "cpu profile saved to file",
+			config:      SelfProfilingConfig{Interval: time.Minute, CPUDuration: time.Second, Profiles: []string{"cpu"}},
+			saveToFile:  "cpu.prof",
+			expectedErr: "the cpu profile cannot be captured by self_profiling when save_to_file is set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.SaveToFile = tt.saveToFile
+			cfg.SelfProfiling = configoptional.Some(tt.config)
+			assert.EqualError(t, xconfmap.Validate(cfg), tt.expectedErr)
+		})
+	}
+}
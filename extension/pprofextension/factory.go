@@ -9,6 +9,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/extension"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension/internal/metadata"
@@ -33,6 +34,11 @@ func createDefaultConfig() component.Config {
 		TCPAddr: confignet.TCPAddrConfig{
 			Endpoint: defaultEndpoint,
 		},
+		SelfProfiling: configoptional.Default(SelfProfilingConfig{
+			Interval:    defaultSelfProfilingInterval,
+			CPUDuration: defaultSelfProfilingCPUDuration,
+			Profiles:    []string{cpuProfile, "heap"},
+		}),
 	}
 }
 
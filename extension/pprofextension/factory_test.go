@@ -5,11 +5,13 @@ package pprofextension
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/extension/extensiontest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
@@ -19,6 +21,11 @@ func TestFactory_CreateDefaultConfig(t *testing.T) {
 	cfg := createDefaultConfig()
 	assert.Equal(t, &Config{
 		TCPAddr: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+		SelfProfiling: configoptional.Default(SelfProfilingConfig{
+			Interval:    time.Minute,
+			CPUDuration: 10 * time.Second,
+			Profiles:    []string{"cpu", "heap"},
+		}),
 	},
 		cfg)
 
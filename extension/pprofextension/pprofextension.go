@@ -16,6 +16,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/consumer/xconsumer"
 	"go.uber.org/zap"
 )
 
@@ -27,6 +28,7 @@ type pprofExtension struct {
 	server            http.Server
 	stopCh            chan struct{}
 	telemetrySettings component.TelemetrySettings
+	selfProfiler      *selfProfiler
 }
 
 func (p *pprofExtension) Start(_ context.Context, host component.Host) error {
@@ -80,6 +82,13 @@ func (p *pprofExtension) Start(_ context.Context, host component.Host) error {
 		}
 		p.file = f
 		startErr = pprof.StartCPUProfile(f)
+		if startErr != nil {
+			return startErr
+		}
+	}
+
+	if p.selfProfiler != nil {
+		p.selfProfiler.start()
 	}
 
 	return startErr
@@ -87,6 +96,9 @@ func (p *pprofExtension) Start(_ context.Context, host component.Host) error {
 
 func (p *pprofExtension) Shutdown(context.Context) error {
 	defer running.Store(false)
+	if p.selfProfiler != nil {
+		p.selfProfiler.shutdown()
+	}
 	if p.file != nil {
 		pprof.StopCPUProfile()
 		_ = p.file.Close() // ignore the error
@@ -98,9 +110,22 @@ func (p *pprofExtension) Shutdown(context.Context) error {
 	return err
 }
 
+// RegisterProfilesConsumer implements SelfProfiles.
+func (p *pprofExtension) RegisterProfilesConsumer(consumer xconsumer.Profiles) func() {
+	if p.selfProfiler == nil {
+		p.telemetrySettings.Logger.Warn("No profiles are captured, self_profiling is not configured")
+		return func() {}
+	}
+	return p.selfProfiler.register(consumer)
+}
+
 func newServer(config Config, params component.TelemetrySettings) *pprofExtension {
-	return &pprofExtension{
+	p := &pprofExtension{
 		config:            config,
 		telemetrySettings: params,
 	}
+	if config.SelfProfiling.HasValue() {
+		p.selfProfiler = newSelfProfiler(*config.SelfProfiling.Get(), params.Resource, params.Logger)
+	}
+	return p
 }
@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/google/pprof/profile"
+	"go.opentelemetry.io/collector/consumer/xconsumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.uber.org/zap"
+
+	pproftranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/pprof"
+)
+
+// SelfProfiles is implemented by the extension to hand the profiles of the Collector captured
+// with self_profiling over to the receivers emitting them into a profiles pipeline.
+type SelfProfiles interface {
+	// RegisterProfilesConsumer registers a consumer of the captured profiles. The returned
+	// function unregisters it.
+	RegisterProfilesConsumer(consumer xconsumer.Profiles) (unregister func())
+}
+
+var _ SelfProfiles = (*pprofExtension)(nil)
+
+type selfProfiler struct {
+	config   SelfProfilingConfig
+	resource pcommon.Resource
+	logger   *zap.Logger
+
+	mu        sync.Mutex
+	consumers map[int]xconsumer.Profiles
+	nextID    int
+
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+func newSelfProfiler(config SelfProfilingConfig, resource pcommon.Resource, logger *zap.Logger) *selfProfiler {
+	return &selfProfiler{
+		config:    config,
+		resource:  resource,
+		logger:    logger,
+		consumers: make(map[int]xconsumer.Profiles),
+	}
+}
+
+func (sp *selfProfiler) register(consumer xconsumer.Profiles) func() {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	id := sp.nextID
+	sp.nextID++
+	sp.consumers[id] = consumer
+	return func() {
+		sp.mu.Lock()
+		defer sp.mu.Unlock()
+		delete(sp.consumers, id)
+	}
+}
+
+func (sp *selfProfiler) registeredConsumers() []xconsumer.Profiles {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	consumers := make([]xconsumer.Profiles, 0, len(sp.consumers))
+	for _, c := range sp.consumers {
+		consumers = append(consumers, c)
+	}
+	return consumers
+}
+
+func (sp *selfProfiler) start() {
+	var ctx context.Context
+	ctx, sp.cancel = context.WithCancel(context.Background())
+	sp.doneCh = make(chan struct{})
+	go func() {
+		defer close(sp.doneCh)
+		ticker := time.NewTicker(sp.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				sp.capture(ctx)
+			}
+		}
+	}()
+}
+
+func (sp *selfProfiler) shutdown() {
+	if sp.cancel == nil {
+		return
+	}
+	sp.cancel()
+	<-sp.doneCh
+}
+
+// capture records the configured profiles and hands them over to the registered consumers.
+func (sp *selfProfiler) capture(ctx context.Context) {
+	// The profiles are not captured unless a receiver consumes them.
+	if len(sp.registeredConsumers()) == 0 {
+		return
+	}
+
+	for _, name := range sp.config.Profiles {
+		pd, err := sp.profile(ctx, name)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			sp.logger.Warn("Failed to capture the profile", zap.String("profile", name), zap.Error(err))
+			continue
+		}
+
+		consumers := sp.registeredConsumers()
+		for i, consumer := range consumers {
+			data := pd
+			if i < len(consumers)-1 {
+				data = pprofile.NewProfiles()
+				pd.CopyTo(data)
+			}
+			if err := consumer.ConsumeProfiles(ctx, data); err != nil {
+				sp.logger.Warn("Failed to consume the profile", zap.String("profile", name), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (sp *selfProfiler) profile(ctx context.Context, name string) (pprofile.Profiles, error) {
+	var buf bytes.Buffer
+	if name == cpuProfile {
+		if err := pprof.StartCPUProfile(&buf); err != nil {
+			return pprofile.Profiles{}, err
+		}
+		timer := time.NewTimer(sp.config.CPUDuration)
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+		timer.Stop()
+		pprof.StopCPUProfile()
+	} else if err := pprof.Lookup(name).WriteTo(&buf, 0); err != nil {
+		return pprofile.Profiles{}, err
+	}
+
+	p, err := profile.Parse(&buf)
+	if err != nil {
+		return pprofile.Profiles{}, fmt.Errorf("failed to parse the profile: %w", err)
+	}
+	pd, err := pproftranslator.ConvertPprofToProfiles(p)
+	if err != nil {
+		return pprofile.Profiles{}, err
+	}
+	for i := 0; i < pd.ResourceProfiles().Len(); i++ {
+		sp.resource.CopyTo(pd.ResourceProfiles().At(i).Resource())
+	}
+	return pd, nil
+}
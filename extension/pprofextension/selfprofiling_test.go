@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofextension
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func TestSelfProfiling(t *testing.T) {
+	config := Config{
+		TCPAddr: confignet.TCPAddrConfig{
+			Endpoint: testutil.GetAvailableLocalAddress(t),
+		},
+		SelfProfiling: configoptional.Some(SelfProfilingConfig{
+			Interval:    100 * time.Millisecond,
+			CPUDuration: 50 * time.Millisecond,
+			Profiles:    []string{"cpu", "goroutine"},
+		}),
+	}
+	set := componenttest.NewNopTelemetrySettings()
+	set.Resource.Attributes().PutStr("service.name", "otelcol")
+
+	pprofExt := newServer(config, set)
+	require.NoError(t, pprofExt.Start(t.Context(), componenttest.NewNopHost()))
+
+	sink := new(consumertest.ProfilesSink)
+	unregister := pprofExt.RegisterProfilesConsumer(sink)
+
+	assert.Eventually(t, func() bool {
+		return len(sink.AllProfiles()) >= 2
+	}, 10*time.Second, 10*time.Millisecond)
+
+	unregister()
+	require.NoError(t, pprofExt.Shutdown(t.Context()))
+
+	for _, pd := range sink.AllProfiles() {
+		require.Positive(t, pd.ResourceProfiles().Len())
+		serviceName, ok := pd.ResourceProfiles().At(0).Resource().Attributes().Get("service.name")
+		require.True(t, ok)
+		assert.Equal(t, "otelcol", serviceName.Str())
+		assert.Positive(t, pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().Len())
+	}
+}
+
+func TestSelfProfilingWithoutConsumer(t *testing.T) {
+	sp := newSelfProfiler(SelfProfilingConfig{
+		Interval:    time.Minute,
+		CPUDuration: time.Second,
+		Profiles:    []string{"cpu"},
+	}, componenttest.NewNopTelemetrySettings().Resource, componenttest.NewNopTelemetrySettings().Logger)
+
+	// nothing is captured, so the CPU profile is not recorded for a second
+	start := time.Now()
+	sp.capture(t.Context())
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestRegisterProfilesConsumerWithoutSelfProfiling(t *testing.T) {
+	pprofExt := newServer(Config{}, componenttest.NewNopTelemetrySettings())
+	unregister := pprofExt.RegisterProfilesConsumer(new(consumertest.ProfilesSink))
+	require.NotNil(t, unregister)
+	unregister()
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -38,6 +39,14 @@ type fileStorageClient struct {
 	stopCh          chan struct{}
 	wg              sync.WaitGroup
 	closed          bool
+
+	// the following fields are set by enableEviction
+	ttl              TTLConfig
+	maxSize          int64
+	dataSize         atomic.Int64
+	evictionEnabled  bool
+	callbacksMutex   sync.Mutex
+	evictionCallback []func(key string, reason EvictionReason)
 }
 
 func bboltOptions(timeout time.Duration, noSync bool) *bbolt.Options {
@@ -103,17 +112,32 @@ func (c *fileStorageClient) Delete(ctx context.Context, key string) error {
 
 // Batch executes the specified operations in order. Get operation results are updated in place
 func (c *fileStorageClient) Batch(_ context.Context, ops ...*storage.Operation) error {
+	var sizeDelta int64
+	var evicted []string
 	batch := func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket(defaultBucket)
 		if bucket == nil {
 			return errors.New("storage not initialized")
 		}
 
+		// timestamps is only maintained when eviction is enabled
+		var timestamps *bbolt.Bucket
+		if c.evictionEnabled {
+			if timestamps = tx.Bucket(timestampsBucket); timestamps == nil {
+				return errors.New("storage not initialized")
+			}
+		}
+
+		now := time.Now()
 		var err error
 		for _, op := range ops {
 			switch op.Type {
 			case storage.Get:
 				value := bucket.Get([]byte(op.Key))
+				if value != nil && c.isExpired(timestamps, []byte(op.Key), now) {
+					// the entry has not been removed by the expiration loop yet
+					value = nil
+				}
 				if value != nil {
 					// the output of Bucket.Get is only valid within a transaction, so we need to make a copy
 					// to be able to return the value
@@ -123,9 +147,21 @@ func (c *fileStorageClient) Batch(_ context.Context, ops ...*storage.Operation)
 					op.Value = nil
 				}
 			case storage.Set:
-				err = bucket.Put([]byte(op.Key), op.Value)
+				if timestamps != nil {
+					sizeDelta += entrySize(op.Key, op.Value) - storedSize(bucket, []byte(op.Key))
+					err = timestamps.Put([]byte(op.Key), encodeTimestamp(now))
+				}
+				if err == nil {
+					err = bucket.Put([]byte(op.Key), op.Value)
+				}
 			case storage.Delete:
-				err = bucket.Delete([]byte(op.Key))
+				if timestamps != nil {
+					sizeDelta -= storedSize(bucket, []byte(op.Key))
+					err = timestamps.Delete([]byte(op.Key))
+				}
+				if err == nil {
+					err = bucket.Delete([]byte(op.Key))
+				}
 			default:
 				return errors.New("wrong operation type")
 			}
@@ -135,12 +171,30 @@ func (c *fileStorageClient) Batch(_ context.Context, ops ...*storage.Operation)
 			}
 		}
 
+		if timestamps != nil && c.maxSize > 0 {
+			if excess := c.dataSize.Load() + sizeDelta - c.maxSize; excess > 0 {
+				var freed int64
+				evicted, freed, err = evictOldest(bucket, timestamps, excess, ops)
+				if err != nil {
+					return err
+				}
+				sizeDelta -= freed
+			}
+		}
+
 		return nil
 	}
 
 	c.compactionMutex.RLock()
-	defer c.compactionMutex.RUnlock()
-	return c.db.Update(batch)
+	err := c.db.Update(batch)
+	c.compactionMutex.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	c.dataSize.Add(sizeDelta)
+	c.notifyEviction(evicted, EvictionReasonQuota)
+	return nil
 }
 
 // Close will close the database
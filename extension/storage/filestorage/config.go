@@ -10,6 +10,8 @@ import (
 	"os"
 	"strconv"
 	"time"
+
+	"go.opentelemetry.io/collector/component"
 )
 
 var (
@@ -33,6 +35,40 @@ type Config struct {
 	directoryPermissionsParsed int64  `mapstructure:"-,omitempty"`
 
 	Recreate bool `mapstructure:"recreate,omitempty"`
+
+	// TTL configures the expiration of the entries which were not updated for a while
+	TTL TTLConfig `mapstructure:"ttl,omitempty"`
+
+	// Quota configures the maximum size of the entries stored by each client
+	Quota QuotaConfig `mapstructure:"quota,omitempty"`
+}
+
+// TTLConfig defines configuration for the expiration of the stored entries.
+type TTLConfig struct {
+	// Duration specifies how long an entry is kept after it was last set. Zero disables the expiration
+	Duration time.Duration `mapstructure:"duration,omitempty"`
+	// CheckInterval specifies frequency of the removal of the expired entries
+	CheckInterval time.Duration `mapstructure:"check_interval,omitempty"`
+	// CompactOnExpiration specifies that the database is compacted after expired entries were removed,
+	// using the compaction directory and transaction size
+	CompactOnExpiration bool `mapstructure:"compact_on_expiration,omitempty"`
+}
+
+// QuotaConfig defines configuration for the maximum size of the entries stored by each client.
+// When a client exceeds its quota, its least recently set entries are evicted.
+type QuotaConfig struct {
+	// MaxSizeMiB specifies the quota of each client. Zero disables the quota
+	MaxSizeMiB int64 `mapstructure:"max_size_mib,omitempty"`
+	// Components overrides MaxSizeMiB for the clients of the components with the given IDs
+	Components map[string]int64 `mapstructure:"components,omitempty"`
+}
+
+// maxSizeBytes returns the quota of the clients of the given component, in bytes.
+func (cfg QuotaConfig) maxSizeBytes(id component.ID) int64 {
+	if maxSize, ok := cfg.Components[id.String()]; ok {
+		return maxSize * oneMiB
+	}
+	return cfg.MaxSizeMiB * oneMiB
 }
 
 // CompactionConfig defines configuration for optional file storage compaction.
@@ -64,9 +100,14 @@ type CompactionConfig struct {
 	CleanupOnStart bool `mapstructure:"cleanup_on_start,omitempty"`
 }
 
+// usesCompactionDirectory returns whether the compaction directory is used with the configured settings
+func (cfg *Config) usesCompactionDirectory() bool {
+	return cfg.Compaction.OnStart || cfg.Compaction.OnRebound || (cfg.TTL.Duration > 0 && cfg.TTL.CompactOnExpiration)
+}
+
 func (cfg *Config) Validate() error {
 	var dirs []string
-	if cfg.Compaction.OnStart || cfg.Compaction.OnRebound {
+	if cfg.usesCompactionDirectory() {
 		dirs = []string{cfg.Directory, cfg.Compaction.Directory}
 	} else {
 		dirs = []string{cfg.Directory}
@@ -94,6 +135,27 @@ func (cfg *Config) Validate() error {
 		return errors.New("compaction check interval must be positive when rebound compaction is set")
 	}
 
+	if cfg.TTL.Duration < 0 {
+		return errors.New("ttl duration cannot be less than 0")
+	}
+
+	if cfg.TTL.Duration > 0 && cfg.TTL.CheckInterval <= 0 {
+		return errors.New("ttl check interval must be positive when ttl duration is set")
+	}
+
+	if cfg.Quota.MaxSizeMiB < 0 {
+		return errors.New("quota max size cannot be less than 0")
+	}
+
+	for id, maxSize := range cfg.Quota.Components {
+		if err := new(component.ID).UnmarshalText([]byte(id)); err != nil {
+			return fmt.Errorf("invalid component %q in quota: %w", id, err)
+		}
+		if maxSize < 0 {
+			return fmt.Errorf("quota max size of component %q cannot be less than 0", id)
+		}
+	}
+
 	if cfg.CreateDirectory {
 		permissions, err := strconv.ParseInt(cfg.DirectoryPermissions, 8, 32)
 		if err != nil {
@@ -54,6 +54,15 @@ func TestLoadConfig(t *testing.T) {
 				FSync:                true,
 				CreateDirectory:      false,
 				DirectoryPermissions: "0750",
+				TTL: TTLConfig{
+					Duration:            24 * time.Hour,
+					CheckInterval:       10 * time.Minute,
+					CompactOnExpiration: true,
+				},
+				Quota: QuotaConfig{
+					MaxSizeMiB: 64,
+					Components: map[string]int64{"filelog/app": 256},
+				},
 			},
 		},
 	}
@@ -253,6 +262,18 @@ func TestCompactionDirectory(t *testing.T) {
 			},
 			err: os.ErrNotExist,
 		},
+		{
+			name: "directory-must-exists-error-on-expiration",
+			config: func(t *testing.T) *Config {
+				cfg := f.CreateDefaultConfig().(*Config)
+				cfg.Directory = t.TempDir()             // actual directory
+				cfg.Compaction.Directory = "/not/a/dir" // not a directory
+				cfg.TTL.Duration = time.Hour
+				cfg.TTL.CompactOnExpiration = true
+				return cfg
+			},
+			err: os.ErrNotExist,
+		},
 		{
 			name: "compaction-disabled-no-error",
 			config: func(t *testing.T) *Config {
@@ -272,3 +293,57 @@ func TestCompactionDirectory(t *testing.T) {
 		})
 	}
 }
+
+func TestEvictionConfig(t *testing.T) {
+	f := NewFactory()
+	tests := []struct {
+		name   string
+		update func(*Config)
+		err    string
+	}{
+		{
+			name: "negative ttl",
+			update: func(cfg *Config) {
+				cfg.TTL.Duration = -time.Second
+			},
+			err: "ttl duration cannot be less than 0",
+		},
+		{
+			name: "ttl without check interval",
+			update: func(cfg *Config) {
+				cfg.TTL.Duration = time.Hour
+				cfg.TTL.CheckInterval = 0
+			},
+			err: "ttl check interval must be positive when ttl duration is set",
+		},
+		{
+			name: "negative quota",
+			update: func(cfg *Config) {
+				cfg.Quota.MaxSizeMiB = -1
+			},
+			err: "quota max size cannot be less than 0",
+		},
+		{
+			name: "negative component quota",
+			update: func(cfg *Config) {
+				cfg.Quota.Components = map[string]int64{"filelog": -1}
+			},
+			err: `quota max size of component "filelog" cannot be less than 0`,
+		},
+		{
+			name: "invalid component",
+			update: func(cfg *Config) {
+				cfg.Quota.Components = map[string]int64{"file log/": 1}
+			},
+			err: `invalid component "file log/" in quota`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := f.CreateDefaultConfig().(*Config)
+			cfg.Directory = t.TempDir()
+			test.update(cfg)
+			require.ErrorContains(t, xconfmap.Validate(cfg), test.err)
+		})
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+
+import (
+	"encoding/binary"
+	"errors"
+	"slices"
+	"time"
+
+	"go.etcd.io/bbolt"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+)
+
+// timestampsBucket maps the keys of the default bucket to the time they were last set
+var timestampsBucket = []byte(`timestamps`)
+
+// EvictionReason describes why an entry was removed by the extension.
+type EvictionReason string
+
+const (
+	// EvictionReasonTTL is used for the entries which expired.
+	EvictionReasonTTL EvictionReason = "ttl"
+	// EvictionReasonQuota is used for the entries evicted because the client exceeded its quota.
+	EvictionReasonQuota EvictionReason = "quota"
+)
+
+// EvictionNotifier is implemented by the storage clients of the extension, so that components
+// can be notified of the entries the extension removed on their behalf.
+type EvictionNotifier interface {
+	// OnEviction registers a callback called with each key removed because it expired
+	// or exceeded the quota of the client.
+	OnEviction(callback func(key string, reason EvictionReason))
+}
+
+// Ensure the storage client notifies of evictions
+var _ EvictionNotifier = (*fileStorageClient)(nil)
+
+// OnEviction registers a callback called with each key removed because it expired
+// or exceeded the quota of the client.
+func (c *fileStorageClient) OnEviction(callback func(key string, reason EvictionReason)) {
+	c.callbacksMutex.Lock()
+	defer c.callbacksMutex.Unlock()
+	c.evictionCallback = append(c.evictionCallback, callback)
+}
+
+func (c *fileStorageClient) notifyEviction(keys []string, reason EvictionReason) {
+	if len(keys) == 0 {
+		return
+	}
+	c.logger.Debug("evicted entries",
+		zap.String("reason", string(reason)),
+		zap.Int("count", len(keys)))
+
+	c.callbacksMutex.Lock()
+	callbacks := slices.Clone(c.evictionCallback)
+	c.callbacksMutex.Unlock()
+	for _, key := range keys {
+		for _, callback := range callbacks {
+			callback(key, reason)
+		}
+	}
+}
+
+// enableEviction starts tracking when the entries are set, so that they can expire after the ttl
+// and the least recently set ones can be evicted when the size of the entries exceeds maxSize.
+// It must be called before the client is used.
+func (c *fileStorageClient) enableEviction(ttl TTLConfig, maxSize int64) error {
+	var dataSize int64
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(defaultBucket)
+		if bucket == nil {
+			return errors.New("storage not initialized")
+		}
+		timestamps, err := tx.CreateBucketIfNotExists(timestampsBucket)
+		if err != nil {
+			return err
+		}
+
+		// entries set while eviction was disabled are considered set now
+		now := encodeTimestamp(time.Now())
+		return bucket.ForEach(func(k, v []byte) error {
+			dataSize += int64(len(k) + len(v))
+			if timestamps.Get(k) != nil {
+				return nil
+			}
+			return timestamps.Put(k, now)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	c.ttl = ttl
+	c.maxSize = maxSize
+	c.dataSize.Store(dataSize)
+	c.evictionEnabled = true
+	if ttl.Duration > 0 {
+		c.startExpirationLoop()
+	}
+	return nil
+}
+
+// startExpirationLoop provides asynchronous removal of the expired entries
+func (c *fileStorageClient) startExpirationLoop() {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.logger.Debug("starting expiration loop",
+			zap.Duration("ttl_check_interval", c.ttl.CheckInterval))
+
+		expirationTicker := time.NewTicker(c.ttl.CheckInterval)
+		defer expirationTicker.Stop()
+
+		for {
+			select {
+			case <-expirationTicker.C:
+				expired, err := c.removeExpired(time.Now())
+				if err != nil {
+					c.logger.Error("failed to remove expired entries", zap.Error(err))
+					continue
+				}
+				if expired > 0 && c.ttl.CompactOnExpiration {
+					err = c.Compact(c.compactionCfg.Directory, c.openTimeout, c.compactionCfg.MaxTransactionSize)
+					if err != nil {
+						c.logger.Error("compaction failure",
+							zap.String(directoryKey, c.compactionCfg.Directory),
+							zap.Error(err))
+					}
+				}
+			case <-c.stopCh:
+				c.logger.Debug("shutting down expiration loop")
+				return
+			}
+		}
+	}()
+}
+
+// removeExpired removes the entries which expired at the given time and returns how many were removed
+func (c *fileStorageClient) removeExpired(now time.Time) (int, error) {
+	var sizeDelta int64
+	var expired []string
+	removeExpired := func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(defaultBucket)
+		timestamps := tx.Bucket(timestampsBucket)
+		if bucket == nil || timestamps == nil {
+			return errors.New("storage not initialized")
+		}
+
+		var keys [][]byte
+		err := timestamps.ForEach(func(k, _ []byte) error {
+			if c.isExpired(timestamps, k, now) {
+				keys = append(keys, slices.Clone(k))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range keys {
+			sizeDelta -= storedSize(bucket, k)
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+			if err := timestamps.Delete(k); err != nil {
+				return err
+			}
+			expired = append(expired, string(k))
+		}
+		return nil
+	}
+
+	c.compactionMutex.RLock()
+	if c.closed {
+		c.compactionMutex.RUnlock()
+		return 0, nil
+	}
+	err := c.db.Update(removeExpired)
+	c.compactionMutex.RUnlock()
+	if err != nil {
+		return 0, err
+	}
+
+	c.dataSize.Add(sizeDelta)
+	c.notifyEviction(expired, EvictionReasonTTL)
+	return len(expired), nil
+}
+
+// isExpired returns whether the entry with the given key expired at the given time
+func (c *fileStorageClient) isExpired(timestamps *bbolt.Bucket, key []byte, now time.Time) bool {
+	if timestamps == nil || c.ttl.Duration <= 0 {
+		return false
+	}
+	ts := timestamps.Get(key)
+	if ts == nil {
+		return false
+	}
+	return now.Sub(decodeTimestamp(ts)) >= c.ttl.Duration
+}
+
+// evictOldest removes the least recently set entries until at least the given number of bytes is freed.
+// The entries set by the given operations are not evicted.
+func evictOldest(bucket, timestamps *bbolt.Bucket, excess int64, ops []*storage.Operation) (evicted []string, freed int64, err error) {
+	written := make(map[string]struct{}, len(ops))
+	for _, op := range ops {
+		if op.Type == storage.Set {
+			written[op.Key] = struct{}{}
+		}
+	}
+
+	type entry struct {
+		key []byte
+		ts  time.Time
+	}
+	var entries []entry
+	err = timestamps.ForEach(func(k, v []byte) error {
+		if _, ok := written[string(k)]; !ok {
+			entries = append(entries, entry{key: slices.Clone(k), ts: decodeTimestamp(v)})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return a.ts.Compare(b.ts)
+	})
+
+	for _, e := range entries {
+		if freed >= excess {
+			break
+		}
+		freed += storedSize(bucket, e.key)
+		if err = bucket.Delete(e.key); err != nil {
+			return nil, 0, err
+		}
+		if err = timestamps.Delete(e.key); err != nil {
+			return nil, 0, err
+		}
+		evicted = append(evicted, string(e.key))
+	}
+	return evicted, freed, nil
+}
+
+// entrySize is the size accounted for an entry in the quota of a client
+func entrySize(key string, value []byte) int64 {
+	return int64(len(key) + len(value))
+}
+
+// storedSize returns the size of the entry stored with the given key, or zero if there is none
+func storedSize(bucket *bbolt.Bucket, key []byte) int64 {
+	value := bucket.Get(key)
+	if value == nil {
+		return 0
+	}
+	return int64(len(key) + len(value))
+}
+
+func encodeTimestamp(t time.Time) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(t.UnixNano()))
+}
+
+func decodeTimestamp(b []byte) time.Time {
+	if len(b) != 8 {
+		// entries with an invalid timestamp are the first to expire or be evicted
+		return time.Time{}
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(b)))
+}
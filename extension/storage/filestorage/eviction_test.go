@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorage
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+)
+
+type evictionRecorder struct {
+	mu      sync.Mutex
+	evicted map[string]EvictionReason
+}
+
+func (r *evictionRecorder) record(key string, reason EvictionReason) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.evicted[key] = reason
+}
+
+func (r *evictionRecorder) get() map[string]EvictionReason {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	evicted := make(map[string]EvictionReason, len(r.evicted))
+	for k, v := range r.evicted {
+		evicted[k] = v
+	}
+	return evicted
+}
+
+func newEvictionTestClient(t *testing.T, ttl TTLConfig, maxSize int64) (*fileStorageClient, *evictionRecorder) {
+	dbFile := filepath.Join(t.TempDir(), "my_db")
+
+	client, err := newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, false)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(t.Context()))
+	})
+	require.NoError(t, client.enableEviction(ttl, maxSize))
+
+	recorder := &evictionRecorder{evicted: make(map[string]EvictionReason)}
+	client.OnEviction(recorder.record)
+	return client, recorder
+}
+
+func TestClientTTL(t *testing.T) {
+	ctx := t.Context()
+	client, recorder := newEvictionTestClient(t, TTLConfig{Duration: time.Hour, CheckInterval: time.Hour}, 0)
+
+	require.NoError(t, client.Set(ctx, "old", []byte("value")))
+	require.NoError(t, client.Set(ctx, "new", []byte("value")))
+
+	// entries are not removed before they expire
+	removed, err := client.removeExpired(time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+
+	// expired entries are not returned even before they are removed
+	client.ttl.Duration = time.Millisecond
+	time.Sleep(10 * time.Millisecond)
+	value, err := client.Get(ctx, "old")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	// setting an entry again renews it
+	require.NoError(t, client.Set(ctx, "new", []byte("value")))
+	removed, err = client.removeExpired(time.Now().Add(-5 * time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, map[string]EvictionReason{"old": EvictionReasonTTL}, recorder.get())
+
+	client.ttl.Duration = time.Hour
+	value, err = client.Get(ctx, "new")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestClientExpirationLoop(t *testing.T) {
+	ctx := t.Context()
+	client, recorder := newEvictionTestClient(t, TTLConfig{Duration: 10 * time.Millisecond, CheckInterval: 10 * time.Millisecond}, 0)
+
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	assert.Eventually(t, func() bool {
+		return recorder.get()["key"] == EvictionReasonTTL
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Zero(t, client.dataSize.Load())
+}
+
+func TestClientQuota(t *testing.T) {
+	ctx := t.Context()
+	value := make([]byte, 97)
+	// each entry is 100 bytes
+	client, recorder := newEvictionTestClient(t, TTLConfig{}, 300)
+
+	require.NoError(t, client.Set(ctx, "k-1", value))
+	require.NoError(t, client.Set(ctx, "k-2", value))
+	require.NoError(t, client.Set(ctx, "k-3", value))
+	assert.Equal(t, int64(300), client.dataSize.Load())
+	assert.Empty(t, recorder.get())
+
+	// overwriting an entry does not change the size
+	require.NoError(t, client.Set(ctx, "k-1", value))
+	assert.Empty(t, recorder.get())
+
+	// the least recently set entries are evicted
+	require.NoError(t, client.Batch(ctx,
+		storage.SetOperation("k-4", value),
+		storage.SetOperation("k-5", value),
+	))
+	assert.Equal(t, map[string]EvictionReason{"k-2": EvictionReasonQuota, "k-3": EvictionReasonQuota}, recorder.get())
+	assert.Equal(t, int64(300), client.dataSize.Load())
+
+	for _, key := range []string{"k-1", "k-4", "k-5"} {
+		got, err := client.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, value, got, key)
+	}
+
+	require.NoError(t, client.Delete(ctx, "k-1"))
+	assert.Equal(t, int64(200), client.dataSize.Load())
+}
+
+func TestEnableEvictionExistingEntries(t *testing.T) {
+	ctx := t.Context()
+	dbFile := filepath.Join(t.TempDir(), "my_db")
+
+	client, err := newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, false)
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	require.NoError(t, client.Close(ctx))
+
+	client, err = newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, false)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(t.Context()))
+	})
+	require.NoError(t, client.enableEviction(TTLConfig{Duration: time.Hour, CheckInterval: time.Hour}, 0))
+	assert.Equal(t, int64(len("key")+len("value")), client.dataSize.Load())
+
+	removed, err := client.removeExpired(time.Now().Add(2 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+}
+
+func TestExtensionComponentQuota(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	cfg.Quota = QuotaConfig{
+		MaxSizeMiB: 1,
+		Components: map[string]int64{"nop/big": 2},
+	}
+
+	ext, err := f.Create(t.Context(), extensiontest.NewNopSettings(f.Type()), cfg)
+	require.NoError(t, err)
+	se := ext.(storage.Extension)
+
+	tests := []struct {
+		id      component.ID
+		maxSize int64
+	}{
+		{id: newTestEntity("small"), maxSize: oneMiB},
+		{id: newTestEntity("big"), maxSize: 2 * oneMiB},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			client, err := se.GetClient(t.Context(), component.KindReceiver, tt.id, "")
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, client.Close(t.Context()))
+			})
+
+			_, ok := client.(EvictionNotifier)
+			require.True(t, ok)
+			assert.Equal(t, tt.maxSize, client.(*fileStorageClient).maxSize)
+		})
+	}
+}
@@ -32,7 +32,7 @@ var _ storage.Extension = (*localFileStorage)(nil)
 func newLocalFileStorage(logger *zap.Logger, config *Config) (extension.Extension, error) {
 	if config.CreateDirectory {
 		var dirs []string
-		if config.Compaction.OnStart || config.Compaction.OnRebound {
+		if config.usesCompactionDirectory() {
 			dirs = []string{config.Directory, config.Compaction.Directory}
 		} else {
 			dirs = []string{config.Directory}
@@ -92,6 +92,13 @@ func (lfs *localFileStorage) GetClient(_ context.Context, kind component.Kind, e
 		return nil, err
 	}
 
+	if lfs.cfg.TTL.Duration > 0 || lfs.cfg.Quota.maxSizeBytes(ent) > 0 {
+		if err = client.enableEviction(lfs.cfg.TTL, lfs.cfg.Quota.maxSizeBytes(ent)); err != nil {
+			_ = client.Close(context.Background())
+			return nil, err
+		}
+	}
+
 	// return if compaction is not required
 	if lfs.cfg.Compaction.OnStart {
 		compactionErr := client.Compact(lfs.cfg.Compaction.Directory, lfs.cfg.Timeout, lfs.cfg.Compaction.MaxTransactionSize)
@@ -22,6 +22,7 @@ const (
 	defaultReboundTriggerThresholdMib = 10
 	defaultReboundNeededThresholdMib  = 100
 	defaultCompactionInterval         = time.Second * 5
+	defaultTTLCheckInterval           = time.Minute
 )
 
 // NewFactory creates a factory for HostObserver extension.
@@ -51,6 +52,9 @@ func createDefaultConfig() component.Config {
 		FSync:                false,
 		CreateDirectory:      false,
 		DirectoryPermissions: "0750",
+		TTL: TTLConfig{
+			CheckInterval: defaultTTLCheckInterval,
+		},
 	}
 }
 
@@ -44,6 +44,7 @@ type Client struct {
 	svc          cloudWatchClient
 	logRetention int32
 	tags         map[string]string
+	kmsKeyID     string
 	logger       *zap.Logger
 }
 
@@ -51,6 +52,7 @@ type ClientOption func(*cwLogClientConfig)
 
 type cwLogClientConfig struct {
 	userAgentExtras []string
+	kmsKeyID        string
 }
 
 func WithUserAgentExtras(userAgentExtras ...string) ClientOption {
@@ -59,6 +61,13 @@ func WithUserAgentExtras(userAgentExtras ...string) ClientOption {
 	}
 }
 
+// WithKMSKeyID sets the ARN of the KMS key used to encrypt the log groups created by the client.
+func WithKMSKeyID(kmsKeyID string) ClientOption {
+	return func(config *cwLogClientConfig) {
+		config.kmsKeyID = kmsKeyID
+	}
+}
+
 // Create a log client based on the actual cloudwatch logs client.
 func newCloudWatchLogClient(svc cloudWatchClient, logRetention int32, tags map[string]string, logger *zap.Logger) *Client {
 	logClient := &Client{
@@ -101,7 +110,14 @@ func NewClient(logger *zap.Logger, awsConfig aws.Config, buildInfo component.Bui
 		AddToUserAgentHeader("otel.collector.UserAgentHandler", newCollectorUserAgent(buildInfo, logGroupName, componentName, opts...), middleware.Before),
 	)
 
-	return newCloudWatchLogClient(client, logRetention, tags, logger)
+	option := &cwLogClientConfig{}
+	for _, opt := range opts {
+		opt(option)
+	}
+
+	logClient := newCloudWatchLogClient(client, logRetention, tags, logger)
+	logClient.kmsKeyID = option.kmsKeyID
+	return logClient
 }
 
 // PutLogEvents mainly handles different possible error could be returned from server side, and retries them
@@ -197,11 +213,15 @@ func (client *Client) CreateStream(ctx context.Context, logGroup, streamName *st
 		client.logger.Debug("cwlog_client: creating stream fail", zap.Error(err))
 		var rnf *types.ResourceNotFoundException
 		if errors.As(err, &rnf) {
-			// Create Log Group with tags if they exist and were specified in the config
-			_, err = client.svc.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+			// Create Log Group with tags and KMS key if they were specified in the config
+			input := &cloudwatchlogs.CreateLogGroupInput{
 				LogGroupName: logGroup,
 				Tags:         client.tags,
-			})
+			}
+			if client.kmsKeyID != "" {
+				input.KmsKeyId = aws.String(client.kmsKeyID)
+			}
+			_, err = client.svc.CreateLogGroup(ctx, input)
 			if err == nil {
 				// For newly created log groups, set the log retention policy if specified or non-zero. Otherwise, set to Never Expire
 				if client.logRetention != 0 {
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/aws/smithy-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/zap"
 )
@@ -379,6 +380,30 @@ func TestCreateStream(t *testing.T) {
 	}
 }
 
+func TestCreateStreamWithKMSKey(t *testing.T) {
+	var createLogGroupInput *cloudwatchlogs.CreateLogGroupInput
+	client := newCloudWatchLogClient(&mockCloudWatchClient{
+		createLogGroup: func(_ context.Context, params *cloudwatchlogs.CreateLogGroupInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.CreateLogGroupOutput, error) {
+			createLogGroupInput = params
+			return &cloudwatchlogs.CreateLogGroupOutput{}, nil
+		},
+		createLogStreamFuncs: []func(_ context.Context, _ *cloudwatchlogs.CreateLogStreamInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.CreateLogStreamOutput, error){
+			func(_ context.Context, _ *cloudwatchlogs.CreateLogStreamInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.CreateLogStreamOutput, error) {
+				return nil, &types.ResourceNotFoundException{}
+			},
+		},
+		createLogStream: func(_ context.Context, _ *cloudwatchlogs.CreateLogStreamInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.CreateLogStreamOutput, error) {
+			return &cloudwatchlogs.CreateLogStreamOutput{}, nil
+		},
+	}, 0, map[string]string{"key": "value"}, zap.NewNop())
+	client.kmsKeyID = "arn:aws:kms:us-east-1:123456789012:key/abcd"
+
+	require.NoError(t, client.CreateStream(t.Context(), &logGroup, &logStreamName))
+	require.NotNil(t, createLogGroupInput)
+	assert.Equal(t, "arn:aws:kms:us-east-1:123456789012:key/abcd", *createLogGroupInput.KmsKeyId)
+	assert.Equal(t, map[string]string{"key": "value"}, createLogGroupInput.Tags)
+}
+
 type UnknownError struct {
 	otherField string
 }
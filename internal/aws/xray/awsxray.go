@@ -30,6 +30,24 @@ const (
 	// AWSXRayTracedAttribute is the `traced` field in an X-Ray subsegment
 	AWSXRayTracedAttribute = "aws.xray.traced"
 
+	// AWSXRayNamespaceAttribute is the `namespace` field in an X-Ray subsegment
+	AWSXRayNamespaceAttribute = "aws.xray.namespace"
+
+	// AWSXRayFaultAttribute is the `fault` flag in an X-Ray segment
+	AWSXRayFaultAttribute = "aws.xray.fault"
+
+	// AWSXRayErrorAttribute is the `error` flag in an X-Ray segment
+	AWSXRayErrorAttribute = "aws.xray.error"
+
+	// AWSXRayThrottleAttribute is the `throttle` flag in an X-Ray segment
+	AWSXRayThrottleAttribute = "aws.xray.throttle"
+
+	// AWSXRayOriginAttribute is the `origin` field in an X-Ray segment
+	AWSXRayOriginAttribute = "aws.xray.origin"
+
+	// AWSXRayPrecursorIDsAttribute is the `precursor_ids` field in an X-Ray subsegment
+	AWSXRayPrecursorIDsAttribute = "aws.xray.precursor_ids"
+
 	// AWSXraySegmentAnnotationsAttribute is the attribute that
 	// will be treated by the X-Ray exporter as the annotation keys.
 	AWSXraySegmentAnnotationsAttribute = "aws.xray.annotations"
@@ -59,6 +59,9 @@ type ActionKeyValue struct {
 	// If the value cannot be converted, the original value will be left as-is
 	ConvertedType string `mapstructure:"converted_type"`
 
+	// Hash configures the algorithm, the salt and the encoding of the HASH action.
+	Hash HashSettings `mapstructure:"hash"`
+
 	// Action specifies the type of action to perform.
 	// The set of values are {INSERT, UPDATE, UPSERT, DELETE, HASH}.
 	// Both lower case and upper case are supported.
@@ -75,10 +78,9 @@ type ActionKeyValue struct {
 	//           Either Value, FromAttribute or FromContext must be set.
 	// DELETE  - Deletes the attribute. If the key doesn't exist,
 	//           no action is performed.
-	// HASH    - Calculates the SHA-1 hash of an existing value and overwrites the
-	//           value with its SHA-1 hash result. If the feature gate
-	//           `coreinternal.attraction.hash.sha256` is enabled, it uses SHA2-256
-	//           instead.
+	// HASH    - Calculates the hash of an existing value and overwrites the
+	//           value with its hash result. The hash is computed with SHA2-256
+	//           unless configured otherwise by Hash.
 	// EXTRACT - Extracts values using a regular expression rule from the input
 	//           'key' to target keys specified in the 'rule'. If a target key
 	//           already exists, it will be overridden.
@@ -125,8 +127,8 @@ const (
 	// Supports pattern which is matched against attribute key.
 	DELETE Action = "delete"
 
-	// HASH calculates the SHA-256 hash of an existing value and overwrites the
-	// value with it's SHA-256 hash result.
+	// HASH calculates the hash of an existing value and overwrites the
+	// value with its hash result, SHA-256 by default.
 	// Supports pattern which is matched against attribute key.
 	HASH Action = "hash"
 
@@ -156,6 +158,8 @@ type attributeAction struct {
 	// and could impact performance.
 	Action         Action
 	AttributeValue *pcommon.Value
+	// hasher computes the hashes of the HASH action.
+	hasher *hasher
 }
 
 // AttrProc is an attribute processor.
@@ -192,6 +196,10 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 
 		valueSourceCount := a.valueSourceCount()
 
+		if a.Action != HASH && a.Hash != (HashSettings{}) {
+			return nil, fmt.Errorf("error with key %q (%d-th action): error creating AttrProc. Action \"%s\" does not use the \"hash\" field. This must not be specified", a.Key, i, a.Action)
+		}
+
 		switch a.Action {
 		case INSERT, UPDATE, UPSERT:
 			if valueSourceCount == 0 {
@@ -234,6 +242,13 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 			if a.ConvertedType != "" {
 				return nil, fmt.Errorf("error with key %q (%d-th action): error creating AttrProc. Action \"%s\" does not use the \"converted_type\" field. This must not be specified", a.Key, i, a.Action)
 			}
+			if a.Action == HASH {
+				h, err := newHasher(a.Hash)
+				if err != nil {
+					return nil, fmt.Errorf("error with key %q (%d-th action): error creating AttrProc: %w", a.Key, i, err)
+				}
+				action.hasher = h
+			}
 		case EXTRACT:
 			if valueSourceCount > 0 {
 				return nil, fmt.Errorf("error with key %q (%d-th action): error creating AttrProc. Action \"%s\" does not use a value source field. These must not be specified", a.Key, i, a.Action)
@@ -331,13 +346,13 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 			}
 		case HASH:
 			if value, exists := attrs.Get(action.Key); exists {
-				sha2Hasher(value)
+				action.hasher.hash(value)
 			}
 
 			if action.Regex != nil {
 				for key, val := range attrs.All() {
 					if action.Regex.MatchString(key) {
-						sha2Hasher(val)
+						action.hasher.hash(val)
 					}
 				}
 			}
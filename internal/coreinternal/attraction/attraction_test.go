@@ -898,6 +898,43 @@ func TestInvalidConfig(t *testing.T) {
 	}
 }
 
+func TestInvalidHashConfig(t *testing.T) {
+	testcase := []struct {
+		name        string
+		action      ActionKeyValue
+		errorString string
+	}{
+		{
+			name:        "hash settings for another action",
+			action:      ActionKeyValue{Key: "key", Action: DELETE, Hash: HashSettings{Algorithm: HashSHA512}},
+			errorString: "error with key \"key\" (0-th action): error creating AttrProc. Action \"delete\" does not use the \"hash\" field. This must not be specified",
+		},
+		{
+			name:        "invalid algorithm",
+			action:      ActionKeyValue{Key: "key", Action: HASH, Hash: HashSettings{Algorithm: "md5"}},
+			errorString: "error with key \"key\" (0-th action): error creating AttrProc: invalid hash algorithm \"md5\"",
+		},
+		{
+			name:        "invalid encoding",
+			action:      ActionKeyValue{Key: "key", Action: HASH, Hash: HashSettings{Encoding: "base32"}},
+			errorString: "error with key \"key\" (0-th action): error creating AttrProc: invalid hash encoding \"base32\"",
+		},
+		{
+			name:        "negative length",
+			action:      ActionKeyValue{Key: "key", Action: HASH, Hash: HashSettings{Length: -1}},
+			errorString: "error with key \"key\" (0-th action): error creating AttrProc: invalid hash length -1, must not be negative",
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			ap, err := NewAttrProc(&Settings{Actions: []ActionKeyValue{tc.action}})
+			assert.Nil(t, ap)
+			assert.EqualError(t, err, tc.errorString)
+		})
+	}
+}
+
 func TestValidConfiguration(t *testing.T) {
 	cfg := &Settings{
 		Actions: []ActionKeyValue{
@@ -4,11 +4,19 @@
 package attraction // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
 
 import (
+	"crypto/hmac"
+	"crypto/sha1" //#nosec G505 -- SHA-1 is only used when explicitly configured for compatibility
 	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
+	"fmt"
+	stdhash "hash"
 	"math"
 
+	"github.com/cespare/xxhash/v2"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
@@ -22,43 +30,145 @@ var (
 	byteFalse = [1]byte{0}
 )
 
-// sha2Hasher hashes an AttributeValue using SHA2-256 and returns a
-// hashed version of the attribute. In practice, this would mostly be used
+// HashAlgorithm is the algorithm used by the HASH action.
+type HashAlgorithm string
+
+const (
+	// HashSHA256 hashes the values with SHA2-256. This is the default.
+	HashSHA256 HashAlgorithm = "sha256"
+	// HashSHA512 hashes the values with SHA2-512.
+	HashSHA512 HashAlgorithm = "sha512"
+	// HashSHA1 hashes the values with SHA-1.
+	HashSHA1 HashAlgorithm = "sha1"
+	// HashXXHash hashes the values with the 64-bit XXH64 non-cryptographic hash.
+	HashXXHash HashAlgorithm = "xxhash"
+)
+
+// HashEncoding is the encoding of the hashes computed by the HASH action.
+type HashEncoding string
+
+const (
+	// HashHex encodes the hashes in lowercase hexadecimal. This is the default.
+	HashHex HashEncoding = "hex"
+	// HashBase64 encodes the hashes in unpadded standard base64.
+	HashBase64 HashEncoding = "base64"
+	// HashBase64URL encodes the hashes in unpadded URL-safe base64.
+	HashBase64URL HashEncoding = "base64url"
+)
+
+// HashSettings configures the HASH action.
+type HashSettings struct {
+	// Algorithm is the hash algorithm, one of {sha256, sha512, sha1, xxhash}.
+	// Default is sha256.
+	Algorithm HashAlgorithm `mapstructure:"algorithm"`
+
+	// Salt is mixed into the hashes so that they cannot be reversed with precomputed tables.
+	// The SHA algorithms compute an HMAC keyed with the salt, xxhash prepends it to the value.
+	// It is usually sourced from an environment variable or a file, such as ${env:HASH_SALT}.
+	Salt configopaque.String `mapstructure:"salt"`
+
+	// Encoding is the encoding of the hashes, one of {hex, base64, base64url}.
+	// Default is hex.
+	Encoding HashEncoding `mapstructure:"encoding"`
+
+	// Length truncates the encoded hashes to the given number of characters.
+	// Default is 0, keeping the full hashes.
+	Length int `mapstructure:"length"`
+}
+
+// hasher hashes attribute values as configured by HashSettings.
+type hasher struct {
+	newHash func() stdhash.Hash
+	encode  func([]byte) string
+	length  int
+}
+
+// defaultHasher hashes with SHA2-256 and hex encoding.
+var defaultHasher = &hasher{newHash: sha256.New, encode: hex.EncodeToString}
+
+func newHasher(settings HashSettings) (*hasher, error) {
+	h := &hasher{length: settings.Length}
+	if settings.Length < 0 {
+		return nil, fmt.Errorf("invalid hash length %d, must not be negative", settings.Length)
+	}
+
+	var newHash func() stdhash.Hash
+	switch settings.Algorithm {
+	case HashSHA256, "":
+		newHash = sha256.New
+	case HashSHA512:
+		newHash = sha512.New
+	case HashSHA1:
+		newHash = sha1.New
+	case HashXXHash:
+		salt := []byte(settings.Salt)
+		h.newHash = func() stdhash.Hash {
+			d := xxhash.New()
+			_, _ = d.Write(salt)
+			return d
+		}
+	default:
+		return nil, fmt.Errorf("invalid hash algorithm %q", settings.Algorithm)
+	}
+	if newHash != nil {
+		h.newHash = newHash
+		if settings.Salt != "" {
+			salt := []byte(settings.Salt)
+			h.newHash = func() stdhash.Hash { return hmac.New(newHash, salt) }
+		}
+	}
+
+	switch settings.Encoding {
+	case HashHex, "":
+		h.encode = hex.EncodeToString
+	case HashBase64:
+		h.encode = base64.RawStdEncoding.EncodeToString
+	case HashBase64URL:
+		h.encode = base64.RawURLEncoding.EncodeToString
+	default:
+		return nil, fmt.Errorf("invalid hash encoding %q", settings.Encoding)
+	}
+	return h, nil
+}
+
+// hash overwrites an AttributeValue with its hash. In practice, this would mostly be used
 // for string attributes, but we support all types for completeness/correctness
 // and eliminate any surprises.
-func sha2Hasher(attr pcommon.Value) {
-	var sum [sha256.Size]byte
-	ok := true
+func (h *hasher) hash(attr pcommon.Value) {
+	digest := h.newHash()
 
 	switch attr.Type() {
 	case pcommon.ValueTypeStr:
-		sum = sha256.Sum256([]byte(attr.Str()))
+		_, _ = digest.Write([]byte(attr.Str()))
 	case pcommon.ValueTypeBool:
 		if attr.Bool() {
-			sum = sha256.Sum256(byteTrue[:])
+			_, _ = digest.Write(byteTrue[:])
 		} else {
-			sum = sha256.Sum256(byteFalse[:])
+			_, _ = digest.Write(byteFalse[:])
 		}
 	case pcommon.ValueTypeInt:
 		var b [int64ByteSize]byte
 		binary.LittleEndian.PutUint64(b[:], uint64(attr.Int()))
-		sum = sha256.Sum256(b[:])
+		_, _ = digest.Write(b[:])
 	case pcommon.ValueTypeDouble:
 		var b [float64ByteSize]byte
 		binary.LittleEndian.PutUint64(b[:], math.Float64bits(attr.Double()))
-		sum = sha256.Sum256(b[:])
+		_, _ = digest.Write(b[:])
 	default:
 		// No-op for empty, maps, slices, bytes.
-		ok = false
-	}
-
-	if !ok {
 		attr.SetStr("")
 		return
 	}
 
-	// Hex encoding is always 64 bytes for SHA-256.
-	var hashedBytes [sha256.Size * 2]byte
-	hex.Encode(hashedBytes[:], sum[:])
-	attr.SetStr(string(hashedBytes[:]))
+	encoded := h.encode(digest.Sum(nil))
+	if h.length > 0 && h.length < len(encoded) {
+		encoded = encoded[:h.length]
+	}
+	attr.SetStr(encoded)
+}
+
+// sha2Hasher hashes an AttributeValue using SHA2-256 and returns a
+// hashed version of the attribute.
+func sha2Hasher(attr pcommon.Value) {
+	defaultHasher.hash(attr)
 }
@@ -4,12 +4,16 @@
 package attraction
 
 import (
+	"crypto/hmac"
 	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
 	"math"
 	"testing"
 
+	"github.com/cespare/xxhash/v2"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
@@ -83,3 +87,65 @@ func sha256Hex(t *testing.T, b []byte) string {
 	hex.Encode(out[:], sum[:])
 	return string(out[:])
 }
+
+func TestHasher(t *testing.T) {
+	xxh := xxhash.Sum64String("saltfoo")
+	var xxhBytes [8]byte
+	binary.BigEndian.PutUint64(xxhBytes[:], xxh)
+
+	mac := hmac.New(sha512.New, []byte("salt"))
+	mac.Write([]byte("foo"))
+
+	tests := []struct {
+		name     string
+		settings HashSettings
+		want     string
+	}{
+		{
+			name: "default",
+			want: sha256Hex(t, []byte("foo")),
+		},
+		{
+			name:     "sha1",
+			settings: HashSettings{Algorithm: HashSHA1},
+			want:     "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33",
+		},
+		{
+			name:     "sha512 with salt",
+			settings: HashSettings{Algorithm: HashSHA512, Salt: "salt"},
+			want:     hex.EncodeToString(mac.Sum(nil)),
+		},
+		{
+			name:     "xxhash with salt",
+			settings: HashSettings{Algorithm: HashXXHash, Salt: "salt"},
+			want:     hex.EncodeToString(xxhBytes[:]),
+		},
+		{
+			name:     "base64url truncated",
+			settings: HashSettings{Encoding: HashBase64URL, Length: 10},
+			want: func() string {
+				sum := sha256.Sum256([]byte("foo"))
+				return base64.RawURLEncoding.EncodeToString(sum[:])[:10]
+			}(),
+		},
+		{
+			name:     "base64",
+			settings: HashSettings{Algorithm: HashXXHash, Encoding: HashBase64, Length: 100},
+			want: func() string {
+				var b [8]byte
+				binary.BigEndian.PutUint64(b[:], xxhash.Sum64String("foo"))
+				return base64.RawStdEncoding.EncodeToString(b[:])
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := newHasher(tt.settings)
+			require.NoError(t, err)
+			v := pcommon.NewValueStr("foo")
+			h.hash(v)
+			require.Equal(t, tt.want, v.Str())
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package circuitbreaker implements a circuit breaker for the exporters, so that an unhealthy
+// endpoint is not sent requests that would consume the retry budget of the exporter.
+package circuitbreaker // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// State is the state of a circuit breaker.
+type State int64
+
+const (
+	// StateClosed lets all the requests through.
+	StateClosed State = iota
+	// StateHalfOpen lets a single probe request through.
+	StateHalfOpen
+	// StateOpen rejects all the requests.
+	StateOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	}
+	return fmt.Sprintf("State(%d)", int64(s))
+}
+
+// ErrOpen is matched by the errors returned by Allow while the circuit breaker is open.
+var ErrOpen = errors.New("circuit breaker is open")
+
+// OpenError is returned by Allow while the circuit breaker is open.
+type OpenError struct {
+	// RetryAfter is the time left before the circuit breaker lets a probe request through.
+	RetryAfter time.Duration
+}
+
+func (e *OpenError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrOpen, e.RetryAfter)
+}
+
+func (*OpenError) Is(target error) bool {
+	return target == ErrOpen
+}
+
+// Breaker tracks the consecutive failed requests to an endpoint. It opens after
+// Config.FailureThreshold failures and rejects the requests for Config.OpenTimeout, then
+// lets a single probe request through: it closes if the probe succeeds, and opens again otherwise.
+// A probe whose outcome is not recorded within Config.OpenTimeout is replaced by a new one.
+// A nil Breaker lets all the requests through.
+type Breaker struct {
+	cfg Config
+	now func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	// openedAt is the time the circuit breaker opened, or the time the probe was let through
+	// while it is half-open.
+	openedAt time.Time
+
+	rejected     metric.Int64Counter
+	registration metric.Registration
+}
+
+// New creates a Breaker reporting its state with the otelcol.exporter.circuit_breaker.* metrics
+// of the meter.
+func New(cfg Config, meter metric.Meter) (*Breaker, error) {
+	b := &Breaker{cfg: cfg, now: time.Now}
+	if !cfg.Enabled {
+		return b, nil
+	}
+
+	var err error
+	b.rejected, err = meter.Int64Counter(
+		"otelcol.exporter.circuit_breaker.rejected_requests",
+		metric.WithDescription("Number of requests rejected by the open circuit breaker of the exporter."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	state, err := meter.Int64ObservableGauge(
+		"otelcol.exporter.circuit_breaker.state",
+		metric.WithDescription("State of the circuit breaker of the exporter: 0 for closed, 1 for half-open, 2 for open."),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	b.registration, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(state, int64(b.State()))
+		return nil
+	}, state)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Allow returns an *OpenError if the request must not be sent. Otherwise, the outcome of the
+// request must be recorded with Record.
+func (b *Breaker) Allow(ctx context.Context) error {
+	if b == nil || !b.cfg.Enabled {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == StateClosed {
+		return nil
+	}
+	elapsed := b.now().Sub(b.openedAt)
+	if elapsed < b.cfg.OpenTimeout {
+		b.rejected.Add(ctx, 1)
+		return &OpenError{RetryAfter: b.cfg.OpenTimeout - elapsed}
+	}
+	// let a probe through
+	b.state = StateHalfOpen
+	b.openedAt = b.now()
+	return nil
+}
+
+// Record records the outcome of a request allowed by Allow. The callers only pass the errors
+// showing that the endpoint is unhealthy, such as connection errors or server errors.
+func (b *Breaker) Record(err error) {
+	if b == nil || !b.cfg.Enabled {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err == nil {
+		b.state = StateClosed
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.state = StateOpen
+		b.openedAt = b.now()
+	}
+}
+
+// State returns the current state of the circuit breaker.
+func (b *Breaker) State() State {
+	if b == nil {
+		return StateClosed
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Shutdown unregisters the metrics of the circuit breaker.
+func (b *Breaker) Shutdown() error {
+	if b == nil || b.registration == nil {
+		return nil
+	}
+	return b.registration.Unregister()
+}
@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+var errUnhealthy = errors.New("unhealthy")
+
+func newTestBreaker(t *testing.T) (*Breaker, *time.Time) {
+	cfg := NewDefaultConfig()
+	cfg.Enabled = true
+	cfg.FailureThreshold = 2
+	b, err := New(cfg, noop.NewMeterProvider().Meter(""))
+	require.NoError(t, err)
+	now := time.Unix(0, 0)
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func TestBreaker(t *testing.T) {
+	b, now := newTestBreaker(t)
+
+	require.NoError(t, b.Allow(t.Context()))
+	b.Record(errUnhealthy)
+	assert.Equal(t, StateClosed, b.State())
+	require.NoError(t, b.Allow(t.Context()))
+	b.Record(nil)
+	require.NoError(t, b.Allow(t.Context()))
+	b.Record(errUnhealthy)
+	assert.Equal(t, StateClosed, b.State(), "the success resets the failures")
+	require.NoError(t, b.Allow(t.Context()))
+	b.Record(errUnhealthy)
+	assert.Equal(t, StateOpen, b.State())
+
+	*now = now.Add(10 * time.Second)
+	err := b.Allow(t.Context())
+	require.ErrorIs(t, err, ErrOpen)
+	var openErr *OpenError
+	require.ErrorAs(t, err, &openErr)
+	assert.Equal(t, 20*time.Second, openErr.RetryAfter)
+
+	*now = now.Add(20 * time.Second)
+	require.NoError(t, b.Allow(t.Context()))
+	assert.Equal(t, StateHalfOpen, b.State())
+	require.ErrorIs(t, b.Allow(t.Context()), ErrOpen, "a single probe is let through")
+	b.Record(errUnhealthy)
+	assert.Equal(t, StateOpen, b.State())
+	require.ErrorIs(t, b.Allow(t.Context()), ErrOpen)
+
+	*now = now.Add(30 * time.Second)
+	require.NoError(t, b.Allow(t.Context()))
+	b.Record(nil)
+	assert.Equal(t, StateClosed, b.State())
+	require.NoError(t, b.Allow(t.Context()))
+}
+
+func TestBreakerUnrecordedProbe(t *testing.T) {
+	b, now := newTestBreaker(t)
+	b.Record(errUnhealthy)
+	b.Record(errUnhealthy)
+
+	*now = now.Add(30 * time.Second)
+	require.NoError(t, b.Allow(t.Context()))
+	require.ErrorIs(t, b.Allow(t.Context()), ErrOpen)
+
+	*now = now.Add(30 * time.Second)
+	require.NoError(t, b.Allow(t.Context()), "the probe is replaced after the open timeout")
+}
+
+func TestBreakerDisabled(t *testing.T) {
+	b, err := New(NewDefaultConfig(), noop.NewMeterProvider().Meter(""))
+	require.NoError(t, err)
+	for range 10 {
+		require.NoError(t, b.Allow(t.Context()))
+		b.Record(errUnhealthy)
+	}
+	assert.Equal(t, StateClosed, b.State())
+	require.NoError(t, b.Shutdown())
+}
+
+func TestBreakerMetrics(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	cfg := NewDefaultConfig()
+	cfg.Enabled = true
+	cfg.FailureThreshold = 1
+	b, err := New(cfg, tel.NewTelemetrySettings().MeterProvider.Meter("test"))
+	require.NoError(t, err)
+	b.Record(errUnhealthy)
+	require.ErrorIs(t, b.Allow(t.Context()), ErrOpen)
+
+	state, err := tel.GetMetric("otelcol.exporter.circuit_breaker.state")
+	require.NoError(t, err)
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "otelcol.exporter.circuit_breaker.state",
+		Description: "State of the circuit breaker of the exporter: 0 for closed, 1 for half-open, 2 for open.",
+		Unit:        "1",
+		Data: metricdata.Gauge[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{{Value: int64(StateOpen)}},
+		},
+	}, state, metricdatatest.IgnoreTimestamp())
+
+	rejected, err := tel.GetMetric("otelcol.exporter.circuit_breaker.rejected_requests")
+	require.NoError(t, err)
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "otelcol.exporter.circuit_breaker.rejected_requests",
+		Description: "Number of requests rejected by the open circuit breaker of the exporter.",
+		Unit:        "{request}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: 1}},
+		},
+	}, rejected, metricdatatest.IgnoreTimestamp())
+
+	require.NoError(t, b.Shutdown())
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := NewDefaultConfig()
+	require.NoError(t, cfg.Validate())
+	cfg.FailureThreshold = 0
+	require.NoError(t, cfg.Validate(), "the disabled circuit breaker is not validated")
+	cfg.Enabled = true
+	cfg.OpenTimeout = 0
+	err := cfg.Validate()
+	assert.ErrorContains(t, err, "`failure_threshold` must be positive")
+	assert.ErrorContains(t, err, "`open_timeout` must be positive")
+}
+
+func TestNilBreaker(t *testing.T) {
+	var b *Breaker
+	require.NoError(t, b.Allow(t.Context()))
+	b.Record(errUnhealthy)
+	assert.Equal(t, StateClosed, b.State())
+	require.NoError(t, b.Shutdown())
+}
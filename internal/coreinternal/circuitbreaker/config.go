@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package circuitbreaker // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/circuitbreaker"
+
+import (
+	"errors"
+	"time"
+)
+
+// Config defines the configuration of a circuit breaker stopping the requests to an endpoint
+// after consecutive failures, until a probe request succeeds.
+type Config struct {
+	// Enabled indicates whether the circuit breaker is enabled. Default is false.
+	Enabled bool `mapstructure:"enabled"`
+	// FailureThreshold is the number of consecutive failed requests opening the circuit breaker.
+	// Default value is 5.
+	FailureThreshold int `mapstructure:"failure_threshold"`
+	// OpenTimeout is the time the circuit breaker stays open before letting a probe request
+	// through. Default value is 30 seconds.
+	OpenTimeout time.Duration `mapstructure:"open_timeout"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// NewDefaultConfig returns the default Config.
+func NewDefaultConfig() Config {
+	return Config{
+		Enabled:          false,
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+	}
+}
+
+func (cfg *Config) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	var errs []error
+	if cfg.FailureThreshold <= 0 {
+		errs = append(errs, errors.New("`failure_threshold` must be positive"))
+	}
+	if cfg.OpenTimeout <= 0 {
+		errs = append(errs, errors.New("`open_timeout` must be positive"))
+	}
+	return errors.Join(errs...)
+}
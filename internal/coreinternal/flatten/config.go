@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package flatten // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/flatten"
+
+import "errors"
+
+// Config limits the flattening of nested maps. A zero limit means no limit.
+type Config struct {
+	// MaxDepth is the maximum depth of the flattened keys. The maps nested deeper
+	// are kept as JSON strings.
+	MaxDepth int `mapstructure:"max_depth"`
+	// MaxKeys is the maximum number of flattened keys. The following keys are dropped.
+	MaxKeys int `mapstructure:"max_keys"`
+	// MaxValueSize is the maximum size in bytes of the string and bytes values.
+	// The larger values are truncated.
+	MaxValueSize int `mapstructure:"max_value_size"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// NewDefaultConfig returns the default Config, which does not limit the flattening.
+func NewDefaultConfig() Config {
+	return Config{}
+}
+
+// HasLimits returns whether any limit is configured.
+func (cfg *Config) HasLimits() bool {
+	return cfg.MaxDepth > 0 || cfg.MaxKeys > 0 || cfg.MaxValueSize > 0
+}
+
+func (cfg *Config) Validate() error {
+	var errs []error
+	if cfg.MaxDepth < 0 {
+		errs = append(errs, errors.New("`max_depth` must not be negative"))
+	}
+	if cfg.MaxKeys < 0 {
+		errs = append(errs, errors.New("`max_keys` must not be negative"))
+	}
+	if cfg.MaxValueSize < 0 {
+		errs = append(errs, errors.New("`max_value_size` must not be negative"))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package flatten flattens the nested maps of the log bodies into dotted keys for the
+// exporters sending flat documents, with limits on the depth, the number of keys and
+// the size of the values.
+package flatten // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/flatten"
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	reasonMaxDepth     = "max_depth"
+	reasonMaxValueSize = "max_value_size"
+)
+
+var (
+	maxDepthAttributes     = metric.WithAttributeSet(attribute.NewSet(attribute.String("reason", reasonMaxDepth)))
+	maxValueSizeAttributes = metric.WithAttributeSet(attribute.NewSet(attribute.String("reason", reasonMaxValueSize)))
+)
+
+// Flattener flattens nested maps into dotted keys. The maps nested deeper than
+// Config.MaxDepth are kept as JSON strings, the keys beyond Config.MaxKeys are dropped,
+// and the string and bytes values larger than Config.MaxValueSize are truncated.
+// The slices are kept as is.
+type Flattener struct {
+	cfg Config
+
+	droppedKeys     metric.Int64Counter
+	truncatedValues metric.Int64Counter
+}
+
+// New creates a Flattener reporting the dropped keys and the truncated values with the
+// otelcol.exporter.flatten.* metrics of the meter.
+func New(cfg Config, meter metric.Meter) (*Flattener, error) {
+	f := &Flattener{cfg: cfg}
+
+	var err error
+	f.droppedKeys, err = meter.Int64Counter(
+		"otelcol.exporter.flatten.dropped_keys",
+		metric.WithDescription("Number of keys dropped while flattening the log bodies because of the max_keys limit."),
+		metric.WithUnit("{key}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	f.truncatedValues, err = meter.Int64Counter(
+		"otelcol.exporter.flatten.truncated_values",
+		metric.WithDescription("Number of values truncated while flattening the log bodies because of the max_depth or max_value_size limits."),
+		metric.WithUnit("{value}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// flattening holds the state of a single Flatten call.
+type flattening struct {
+	cfg Config
+	out pcommon.Map
+
+	droppedKeys    int64
+	truncatedDepth int64
+	truncatedSize  int64
+}
+
+// Flatten returns a new map holding the values of m under dotted keys.
+func (f *Flattener) Flatten(ctx context.Context, m pcommon.Map) pcommon.Map {
+	fl := &flattening{cfg: f.cfg, out: pcommon.NewMap()}
+	fl.out.EnsureCapacity(m.Len())
+	fl.flattenMap("", m, 1)
+
+	if fl.droppedKeys > 0 {
+		f.droppedKeys.Add(ctx, fl.droppedKeys)
+	}
+	if fl.truncatedDepth > 0 {
+		f.truncatedValues.Add(ctx, fl.truncatedDepth, maxDepthAttributes)
+	}
+	if fl.truncatedSize > 0 {
+		f.truncatedValues.Add(ctx, fl.truncatedSize, maxValueSizeAttributes)
+	}
+	return fl.out
+}
+
+func (fl *flattening) flattenMap(prefix string, m pcommon.Map, depth int) {
+	for k, v := range m.All() {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if v.Type() == pcommon.ValueTypeMap && v.Map().Len() > 0 {
+			if fl.cfg.MaxDepth == 0 || depth < fl.cfg.MaxDepth {
+				fl.flattenMap(key, v.Map(), depth+1)
+				continue
+			}
+			// keep the deeper levels as a JSON string
+			if fl.put(key, pcommon.NewValueStr(v.AsString())) {
+				fl.truncatedDepth++
+			}
+			continue
+		}
+		fl.put(key, v)
+	}
+}
+
+// put copies the value under the key, truncating it if needed. It returns false if the
+// key is dropped.
+func (fl *flattening) put(key string, v pcommon.Value) bool {
+	if fl.cfg.MaxKeys > 0 && fl.out.Len() >= fl.cfg.MaxKeys {
+		fl.droppedKeys++
+		return false
+	}
+	dest := fl.out.PutEmpty(key)
+	maxSize := fl.cfg.MaxValueSize
+	switch {
+	case maxSize > 0 && v.Type() == pcommon.ValueTypeStr && len(v.Str()) > maxSize:
+		dest.SetStr(truncateString(v.Str(), maxSize))
+		fl.truncatedSize++
+	case maxSize > 0 && v.Type() == pcommon.ValueTypeBytes && v.Bytes().Len() > maxSize:
+		dest.SetEmptyBytes().FromRaw(v.Bytes().AsRaw()[:maxSize])
+		fl.truncatedSize++
+	default:
+		v.CopyTo(dest)
+	}
+	return true
+}
+
+// truncateString truncates s to at most size bytes without splitting a UTF-8 character.
+func truncateString(s string, size int) string {
+	for size > 0 && !utf8.RuneStart(s[size]) {
+		size--
+	}
+	return s[:size]
+}
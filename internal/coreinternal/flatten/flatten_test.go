@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package flatten
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func newTestBody(t *testing.T) pcommon.Map {
+	m := pcommon.NewMap()
+	require.NoError(t, m.FromRaw(map[string]any{
+		"message": "héllo world",
+		"http": map[string]any{
+			"status": 200,
+			"request": map[string]any{
+				"method":  "GET",
+				"headers": map[string]any{"accept": "*/*"},
+			},
+		},
+		"tags":  []any{"a", "b"},
+		"empty": map[string]any{},
+		"raw":   []byte("0123456789"),
+	}))
+	return m
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      func(*Config)
+		expected map[string]any
+	}{
+		{
+			name: "no limits",
+			cfg:  func(*Config) {},
+			expected: map[string]any{
+				"message":                     "héllo world",
+				"http.status":                 int64(200),
+				"http.request.method":         "GET",
+				"http.request.headers.accept": "*/*",
+				"tags":                        []any{"a", "b"},
+				"empty":                       map[string]any{},
+				"raw":                         []byte("0123456789"),
+			},
+		},
+		{
+			name: "max depth",
+			cfg:  func(cfg *Config) { cfg.MaxDepth = 2 },
+			expected: map[string]any{
+				"message":      "héllo world",
+				"http.status":  int64(200),
+				"http.request": `{"headers":{"accept":"*/*"},"method":"GET"}`,
+				"tags":         []any{"a", "b"},
+				"empty":        map[string]any{},
+				"raw":          []byte("0123456789"),
+			},
+		},
+		{
+			name: "max value size",
+			cfg:  func(cfg *Config) { cfg.MaxValueSize = 2 },
+			expected: map[string]any{
+				"message":                     "h",
+				"http.status":                 int64(200),
+				"http.request.method":         "GE",
+				"http.request.headers.accept": "*/",
+				"tags":                        []any{"a", "b"},
+				"empty":                       map[string]any{},
+				"raw":                         []byte("01"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewDefaultConfig()
+			tt.cfg(&cfg)
+			f, err := New(cfg, noop.NewMeterProvider().Meter(""))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, f.Flatten(t.Context(), newTestBody(t)).AsRaw())
+		})
+	}
+}
+
+func TestFlattenMaxKeys(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.MaxKeys = 3
+	f, err := New(cfg, noop.NewMeterProvider().Meter(""))
+	require.NoError(t, err)
+	assert.Equal(t, 3, f.Flatten(t.Context(), newTestBody(t)).Len())
+}
+
+func TestTruncateString(t *testing.T) {
+	assert.Equal(t, "h", truncateString("héllo", 2))
+	assert.Equal(t, "hé", truncateString("héllo", 3))
+	assert.Empty(t, truncateString("é", 1))
+}
+
+func TestFlattenMetrics(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	cfg := NewDefaultConfig()
+	cfg.MaxDepth = 1
+	cfg.MaxKeys = 3
+	cfg.MaxValueSize = 5
+	f, err := New(cfg, tel.NewTelemetrySettings().MeterProvider.Meter("test"))
+	require.NoError(t, err)
+	body := pcommon.NewMap()
+	body.PutStr("message", "hello world")
+	body.PutEmptyMap("http").PutInt("status", 200)
+	body.PutStr("level", "info")
+	body.PutStr("dropped", "value")
+	// the http map is kept as JSON and truncated
+	assert.Equal(t, map[string]any{
+		"message": "hello",
+		"http":    `{"sta`,
+		"level":   "info",
+	}, f.Flatten(t.Context(), body).AsRaw())
+
+	dropped, err := tel.GetMetric("otelcol.exporter.flatten.dropped_keys")
+	require.NoError(t, err)
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "otelcol.exporter.flatten.dropped_keys",
+		Description: "Number of keys dropped while flattening the log bodies because of the max_keys limit.",
+		Unit:        "{key}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: 1}},
+		},
+	}, dropped, metricdatatest.IgnoreTimestamp())
+
+	truncated, err := tel.GetMetric("otelcol.exporter.flatten.truncated_values")
+	require.NoError(t, err)
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "otelcol.exporter.flatten.truncated_values",
+		Description: "Number of values truncated while flattening the log bodies because of the max_depth or max_value_size limits.",
+		Unit:        "{value}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{
+				{Attributes: attribute.NewSet(attribute.String("reason", "max_depth")), Value: 1},
+				{Attributes: attribute.NewSet(attribute.String("reason", "max_value_size")), Value: 2},
+			},
+		},
+	}, truncated, metricdatatest.IgnoreTimestamp())
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := NewDefaultConfig()
+	require.NoError(t, cfg.Validate())
+	assert.False(t, cfg.HasLimits())
+	cfg.MaxDepth = -1
+	cfg.MaxKeys = -1
+	cfg.MaxValueSize = -1
+	err := cfg.Validate()
+	assert.ErrorContains(t, err, "`max_depth` must not be negative")
+	assert.ErrorContains(t, err, "`max_keys` must not be negative")
+	assert.ErrorContains(t, err, "`max_value_size` must not be negative")
+	cfg.MaxValueSize = 10
+	assert.True(t, cfg.HasLimits())
+}
@@ -210,11 +210,17 @@ func (dc *Client) Events(ctx context.Context, options etypes.ListOptions) (<-cha
 	return dc.client.Events(ctx, options)
 }
 
-func (dc *Client) ContainerEventLoop(ctx context.Context) {
+// ContainerEventHandler is notified of every container event processed by ContainerEventLoop,
+// after the container cache has been updated.
+type ContainerEventHandler func(event etypes.Message)
+
+// ContainerEventLoop keeps the container cache up to date with the container events until ctx is canceled.
+func (dc *Client) ContainerEventLoop(ctx context.Context, handlers ...ContainerEventHandler) {
 	filters := dfilters.NewArgs([]dfilters.KeyValuePair{
 		{Key: "type", Value: "container"},
 		{Key: "event", Value: "destroy"},
 		{Key: "event", Value: "die"},
+		{Key: "event", Value: "oom"},
 		{Key: "event", Value: "pause"},
 		{Key: "event", Value: "rename"},
 		{Key: "event", Value: "stop"},
@@ -251,6 +257,10 @@ EVENT_LOOP:
 					dc.InspectAndPersistContainer(ctx, event.Actor.ID)
 				}
 
+				for _, handler := range handlers {
+					handler(event)
+				}
+
 				if event.TimeNano > lastTime.UnixNano() {
 					lastTime = time.Unix(0, event.TimeNano)
 				}
@@ -332,6 +342,11 @@ func (dc *Client) RemoveContainer(cid string) {
 	dc.logger.Debug("Removed container from stores.", zap.String("id", cid))
 }
 
+// IsExcludedImage returns whether the image matches one of the configured excluded images.
+func (dc *Client) IsExcludedImage(image string) bool {
+	return dc.shouldBeExcluded(image)
+}
+
 func (dc *Client) shouldBeExcluded(image string) bool {
 	return dc.excludedImageMatcher != nil && dc.excludedImageMatcher.matches(image)
 }
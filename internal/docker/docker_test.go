@@ -16,6 +16,7 @@ import (
 	"time"
 
 	ctypes "github.com/docker/docker/api/types/container"
+	etypes "github.com/docker/docker/api/types/events"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -220,6 +221,38 @@ func TestEventLoopHandlesError(t *testing.T) {
 	}
 }
 
+func TestEventLoopNotifiesHandlers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/events") {
+			_, err := w.Write([]byte(`{"Type":"container","Action":"oom","Actor":{"ID":"abc"},"timeNano":1}` + "\n"))
+			assert.NoError(t, err)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	cli, err := NewDockerClient(&Config{Endpoint: srv.URL, Timeout: 50 * time.Millisecond}, zap.NewNop())
+	require.NoError(t, err)
+
+	received := make(chan etypes.Message, 1)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go cli.ContainerEventLoop(ctx, func(event etypes.Message) {
+		received <- event
+	})
+
+	select {
+	case event := <-received:
+		assert.Equal(t, etypes.Action("oom"), event.Action)
+		assert.Equal(t, "abc", event.Actor.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler was not notified of the event")
+	}
+}
+
 func portableEndpoint(addr string) string {
 	endpoint := fmt.Sprintf("unix://%s", addr)
 	if runtime.GOOS == "windows" {
@@ -28,6 +28,7 @@ type (
 	ComponentHealthConfig        = common.ComponentHealthConfig
 	CheckCollectorPipelineConfig = http.CheckCollectorPipelineConfig
 	ResponseBodyConfig           = http.ResponseBodyConfig
+	ReadinessConfig              = http.ReadinessConfig
 )
 
 const (
@@ -93,6 +94,12 @@ func (c *Config) Validate() error {
 		if c.HTTPConfig.Config.Enabled && !strings.HasPrefix(c.HTTPConfig.Config.Path, "/") {
 			return ErrInvalidPath
 		}
+		if c.HTTPConfig.Readiness.Enabled && c.HTTPConfig.Readiness.Path != "" && !strings.HasPrefix(c.HTTPConfig.Readiness.Path, "/") {
+			return ErrInvalidPath
+		}
+		if c.HTTPConfig.Liveness.Enabled && c.HTTPConfig.Liveness.Path != "" && !strings.HasPrefix(c.HTTPConfig.Liveness.Path, "/") {
+			return ErrInvalidPath
+		}
 	}
 
 	if c.GRPCConfig != nil && c.GRPCConfig.NetAddr.Endpoint == "" {
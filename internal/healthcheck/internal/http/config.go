@@ -3,7 +3,16 @@
 
 package http // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/http"
 
-import "go.opentelemetry.io/collector/config/confighttp"
+import (
+	"go.opentelemetry.io/collector/config/confighttp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/common"
+)
+
+const (
+	defaultReadinessPath = "/ready"
+	defaultLivenessPath  = "/live"
+)
 
 // Config contains the v2 config for the http healthcheck service
 type Config struct {
@@ -11,6 +20,12 @@ type Config struct {
 
 	Config PathConfig `mapstructure:"config"`
 	Status PathConfig `mapstructure:"status"`
+
+	// Readiness configures the readiness endpoint, served on /ready by default.
+	Readiness ReadinessConfig `mapstructure:"readiness"`
+
+	// Liveness configures the liveness endpoint, served on /live by default.
+	Liveness PathConfig `mapstructure:"liveness"`
 }
 
 type PathConfig struct {
@@ -18,6 +33,24 @@ type PathConfig struct {
 	Path    string `mapstructure:"path"`
 }
 
+// ReadinessConfig contains the config for the readiness endpoint. The collector is ready when the
+// selected pipelines are running and none of the selected components report a failure.
+type ReadinessConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Path    string `mapstructure:"path"`
+
+	// Pipelines are the pipelines considered for readiness, e.g. "traces" or "metrics/internal".
+	// All the pipelines are considered when empty.
+	Pipelines []string `mapstructure:"pipelines"`
+
+	// Components are the components whose failures flip readiness, in the "kind:id" form of the
+	// status endpoint, e.g. "exporter:otlp". All the components are considered when empty.
+	Components []string `mapstructure:"components"`
+
+	// ComponentHealthConfig selects which errors, in addition to fatal errors, flip readiness.
+	common.ComponentHealthConfig `mapstructure:",squash"`
+}
+
 // LegacyConfig contains the config for the original healthcheck extension. We plan to migrate
 // incrementally towards the v2 config and behavior. LegacyConfig is intentionally handled
 // separately here and elsewhere to facilitate its eventual removal.
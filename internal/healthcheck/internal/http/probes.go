@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package http // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/http"
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component/componentstatus"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/status"
+)
+
+const pipelineKeyPrefix = "pipeline:"
+
+type livenessResponse struct {
+	Alive        bool   `json:"alive"`
+	StatusString string `json:"status"`
+}
+
+type readinessResponse struct {
+	Ready     bool                          `json:"ready"`
+	Pipelines map[string]*pipelineReadiness `json:"pipelines"`
+}
+
+type pipelineReadiness struct {
+	Ready        bool   `json:"ready"`
+	StatusString string `json:"status"`
+	// NotReady maps the components preventing readiness to their status.
+	NotReady map[string]string `json:"not_ready,omitempty"`
+}
+
+// livenessHandler reports the collector as alive unless a component reported a fatal error.
+// Other errors are left to readiness, as restarting the collector is unlikely to resolve them.
+func (s *Server) livenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		st, _ := s.aggregator.AggregateStatus(status.ScopeAll, status.Concise)
+		resp := &livenessResponse{
+			Alive:        st.Status() != componentstatus.StatusFatalError,
+			StatusString: st.Status().String(),
+		}
+
+		code := http.StatusOK
+		if !resp.Alive {
+			code = http.StatusServiceUnavailable
+		}
+		if err := respondWithJSON(code, resp, w); err != nil {
+			s.telemetry.Logger.Warn(err.Error())
+		}
+	})
+}
+
+// readinessHandler reports the collector as ready when all the selected pipelines have started
+// and none of the selected components in them report a failure, as configured.
+func (s *Server) readinessHandler(config ReadinessConfig) http.Handler {
+	pipelines := make(map[string]struct{}, len(config.Pipelines))
+	for _, p := range config.Pipelines {
+		pipelines[p] = struct{}{}
+	}
+	components := make(map[string]struct{}, len(config.Components))
+	for _, c := range config.Components {
+		components[c] = struct{}{}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		st, _ := s.aggregator.AggregateStatus(status.ScopeAll, status.Verbose)
+		now := time.Now()
+
+		resp := &readinessResponse{
+			Ready:     true,
+			Pipelines: make(map[string]*pipelineReadiness),
+		}
+		for key, pst := range st.ComponentStatusMap {
+			name, ok := strings.CutPrefix(key, pipelineKeyPrefix)
+			if !ok {
+				// extensions are not part of the pipelines
+				continue
+			}
+			if _, ok := pipelines[name]; len(pipelines) > 0 && !ok {
+				continue
+			}
+
+			pr := &pipelineReadiness{
+				Ready:        true,
+				StatusString: pst.Status().String(),
+			}
+			for componentKey, cst := range pst.ComponentStatusMap {
+				_, selected := components[componentKey]
+				if !isReady(cst.Event, config, len(components) == 0 || selected, now) {
+					if pr.NotReady == nil {
+						pr.NotReady = make(map[string]string)
+					}
+					pr.NotReady[componentKey] = cst.Status().String()
+					pr.Ready = false
+				}
+			}
+			resp.Pipelines[name] = pr
+			resp.Ready = resp.Ready && pr.Ready
+		}
+
+		// The selected pipelines are not ready until their components report a status.
+		for name := range pipelines {
+			if _, ok := resp.Pipelines[name]; !ok {
+				resp.Pipelines[name] = &pipelineReadiness{
+					StatusString: componentstatus.StatusNone.String(),
+				}
+				resp.Ready = false
+			}
+		}
+		if len(resp.Pipelines) == 0 {
+			resp.Ready = false
+		}
+
+		code := http.StatusOK
+		if !resp.Ready {
+			code = http.StatusServiceUnavailable
+		}
+		if err := respondWithJSON(code, resp, w); err != nil {
+			s.telemetry.Logger.Warn(err.Error())
+		}
+	})
+}
+
+// isReady returns whether a component allows readiness. A component which is not running prevents
+// readiness, while its errors only do if the component is selected and the error type is included.
+func isReady(ev status.Event, config ReadinessConfig, selected bool, now time.Time) bool {
+	switch ev.Status() {
+	case componentstatus.StatusOK:
+		return true
+	case componentstatus.StatusPermanentError:
+		return !selected || !config.IncludePermanent
+	case componentstatus.StatusRecoverableError:
+		return !selected || !config.IncludeRecoverable || now.Before(ev.Timestamp().Add(config.RecoveryDuration))
+	case componentstatus.StatusFatalError:
+		return !selected
+	default:
+		// StatusNone, StatusStarting, StatusStopping and StatusStopped
+		return false
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pipeline"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/status"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/status/testhelpers"
+)
+
+func newProbeServer(readiness ReadinessConfig) *Server {
+	return NewServer(
+		&Config{
+			Readiness: readiness,
+			Liveness:  PathConfig{Enabled: true},
+		},
+		LegacyConfig{UseV2: true},
+		nil,
+		componenttest.NewNopTelemetrySettings(),
+		status.NewAggregator(status.PriorityPermanent),
+	)
+}
+
+func probe[T any](t *testing.T, server *Server, path string) (int, *T) {
+	rw := httptest.NewRecorder()
+	server.mux.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, path, http.NoBody))
+	resp := new(T)
+	require.NoError(t, json.Unmarshal(rw.Body.Bytes(), resp))
+	return rw.Code, resp
+}
+
+func TestReadiness(t *testing.T) {
+	traces := testhelpers.NewPipelineMetadata(pipeline.SignalTraces)
+	metrics := testhelpers.NewPipelineMetadata(pipeline.SignalMetrics)
+
+	t.Run("all pipelines", func(t *testing.T) {
+		server := newProbeServer(ReadinessConfig{
+			Enabled:               true,
+			ComponentHealthConfig: common.ComponentHealthConfig{IncludePermanent: true},
+		})
+
+		code, resp := probe[readinessResponse](t, server, "/ready")
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.False(t, resp.Ready)
+
+		testhelpers.SeedAggregator(server.aggregator, traces.InstanceIDs(), componentstatus.StatusStarting)
+		testhelpers.SeedAggregator(server.aggregator, metrics.InstanceIDs(), componentstatus.StatusStarting, componentstatus.StatusOK)
+		code, resp = probe[readinessResponse](t, server, "/ready")
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.False(t, resp.Pipelines["traces"].Ready)
+		assert.Equal(t, "StatusStarting", resp.Pipelines["traces"].NotReady["exporter:traces/out"])
+		assert.True(t, resp.Pipelines["metrics"].Ready)
+
+		testhelpers.SeedAggregator(server.aggregator, traces.InstanceIDs(), componentstatus.StatusOK)
+		code, resp = probe[readinessResponse](t, server, "/ready")
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, resp.Ready)
+
+		server.aggregator.RecordStatus(metrics.ExporterID, componentstatus.NewPermanentErrorEvent(errors.New("invalid endpoint")))
+		code, resp = probe[readinessResponse](t, server, "/ready")
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, map[string]string{"exporter:metrics/out": "StatusPermanentError"}, resp.Pipelines["metrics"].NotReady)
+		assert.True(t, resp.Pipelines["traces"].Ready)
+	})
+
+	t.Run("selected pipelines and components", func(t *testing.T) {
+		server := newProbeServer(ReadinessConfig{
+			Enabled:    true,
+			Path:       "/readyz",
+			Pipelines:  []string{"traces"},
+			Components: []string{"exporter:traces/out"},
+			ComponentHealthConfig: common.ComponentHealthConfig{
+				IncludeRecoverable: true,
+				RecoveryDuration:   time.Minute,
+			},
+		})
+
+		testhelpers.SeedAggregator(server.aggregator, metrics.InstanceIDs(), componentstatus.StatusStarting, componentstatus.StatusOK)
+		code, resp := probe[readinessResponse](t, server, "/readyz")
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "StatusNone", resp.Pipelines["traces"].StatusString)
+		assert.NotContains(t, resp.Pipelines, "metrics")
+
+		testhelpers.SeedAggregator(server.aggregator, traces.InstanceIDs(), componentstatus.StatusStarting, componentstatus.StatusOK)
+		server.aggregator.RecordStatus(traces.ReceiverID, componentstatus.NewPermanentErrorEvent(errors.New("not selected")))
+		code, _ = probe[readinessResponse](t, server, "/readyz")
+		assert.Equal(t, http.StatusOK, code)
+
+		// a recoverable error flips readiness once the recovery duration elapsed
+		server.aggregator.RecordStatus(traces.ExporterID, componentstatus.NewRecoverableErrorEvent(errors.New("retrying")))
+		code, _ = probe[readinessResponse](t, server, "/readyz")
+		assert.Equal(t, http.StatusOK, code)
+
+		assert.False(t, isReady(
+			componentstatus.NewRecoverableErrorEvent(errors.New("retrying")),
+			ReadinessConfig{ComponentHealthConfig: common.ComponentHealthConfig{IncludeRecoverable: true, RecoveryDuration: time.Minute}},
+			true,
+			time.Now().Add(2*time.Minute),
+		))
+	})
+}
+
+func TestLiveness(t *testing.T) {
+	traces := testhelpers.NewPipelineMetadata(pipeline.SignalTraces)
+	server := newProbeServer(ReadinessConfig{})
+
+	testhelpers.SeedAggregator(server.aggregator, traces.InstanceIDs(), componentstatus.StatusStarting)
+	code, resp := probe[livenessResponse](t, server, "/live")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, resp.Alive)
+
+	server.aggregator.RecordStatus(traces.ExporterID, componentstatus.NewPermanentErrorEvent(errors.New("invalid endpoint")))
+	code, _ = probe[livenessResponse](t, server, "/live")
+	assert.Equal(t, http.StatusOK, code)
+
+	server.aggregator.RecordStatus(traces.ExporterID, componentstatus.NewFatalErrorEvent(errors.New("boom")))
+	code, resp = probe[livenessResponse](t, server, "/live")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, resp.Alive)
+	assert.Equal(t, "StatusFatalError", resp.StatusString)
+
+	// the readiness endpoint is disabled
+	rw := httptest.NewRecorder()
+	server.mux.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/ready", http.NoBody))
+	assert.Equal(t, http.StatusNotFound, rw.Code)
+}
@@ -71,6 +71,12 @@ func NewServer(
 		if config.Config.Enabled {
 			srv.mux.Handle(config.Config.Path, srv.configHandler())
 		}
+		if config.Readiness.Enabled {
+			srv.mux.Handle(pathOrDefault(config.Readiness.Path, defaultReadinessPath), srv.readinessHandler(config.Readiness))
+		}
+		if config.Liveness.Enabled {
+			srv.mux.Handle(pathOrDefault(config.Liveness.Path, defaultLivenessPath), srv.livenessHandler())
+		}
 	} else {
 		srv.httpConfig = legacyConfig.ServerConfig
 		if legacyConfig.ResponseBody != nil {
@@ -84,6 +90,13 @@ func NewServer(
 	return srv
 }
 
+func pathOrDefault(path, defaultPath string) string {
+	if path == "" {
+		return defaultPath
+	}
+	return path
+}
+
 // Start implements the component.Component interface.
 func (s *Server) Start(ctx context.Context, host component.Host) error {
 	var err error
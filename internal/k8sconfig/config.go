@@ -10,6 +10,8 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	quotaclientset "github.com/openshift/client-go/quota/clientset/versioned"
@@ -64,6 +66,11 @@ type APIConfig struct {
 	// from `~/.kube/config`.
 	AuthType AuthType `mapstructure:"auth_type"`
 
+	// When using auth_type `kubeConfig`, the path of the kubeconfig file. Defaults to the files of the
+	// KUBECONFIG environment variable, or `~/.kube/config`. The users of the kubeconfig can authenticate with
+	// exec credential plugins, such as `aws eks get-token` or `gke-gcloud-auth-plugin`.
+	KubeConfigPath string `mapstructure:"kube_config_path"`
+
 	// When using auth_type `kubeConfig`, override the current context.
 	Context string `mapstructure:"context"`
 }
@@ -73,6 +80,9 @@ func (c APIConfig) Validate() error {
 	if !authTypes[c.AuthType] {
 		return fmt.Errorf("invalid authType for kubernetes: %v", c.AuthType)
 	}
+	if c.KubeConfigPath != "" && c.AuthType != AuthTypeKubeConfig {
+		return fmt.Errorf("kube_config_path can only be set with auth_type %s", AuthTypeKubeConfig)
+	}
 
 	return nil
 }
@@ -96,6 +106,12 @@ func CreateRestConfig(apiConf APIConfig) (*rest.Config, error) {
 	switch authType {
 	case AuthTypeKubeConfig:
 		loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+		if apiConf.KubeConfigPath != "" {
+			loadingRules.ExplicitPath, err = expandHome(apiConf.KubeConfigPath)
+			if err != nil {
+				return nil, err
+			}
+		}
 		configOverrides := &clientcmd.ConfigOverrides{}
 		if apiConf.Context != "" {
 			configOverrides.CurrentContext = apiConf.Context
@@ -118,6 +134,12 @@ func CreateRestConfig(apiConf APIConfig) (*rest.Config, error) {
 		}
 	}
 
+	if authConf.ExecProvider != nil {
+		// The users authenticating with exec credential plugins target the API server
+		// of a managed cloud cluster, out of the cluster, so the proxy settings apply
+		return authConf, nil
+	}
+
 	authConf.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
 		// Don't use system proxy settings since the API is local to the
 		// cluster
@@ -130,6 +152,18 @@ func CreateRestConfig(apiConf APIConfig) (*rest.Config, error) {
 	return authConf, nil
 }
 
+// expandHome expands the `~` prefix of a path to the home directory of the user.
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to expand the kubeconfig path %q: %w", path, err)
+	}
+	return filepath.Join(home, path[1:]), nil
+}
+
 // MakeClient can take configuration if needed for other types of auth
 func MakeClient(apiConf APIConfig) (k8s.Interface, error) {
 	if err := apiConf.Validate(); err != nil {
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sconfig
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, APIConfig{AuthType: AuthTypeKubeConfig, KubeConfigPath: "kubeconfig"}.Validate())
+	assert.ErrorContains(t, APIConfig{AuthType: "foo"}.Validate(), "invalid authType")
+	assert.ErrorContains(t, APIConfig{AuthType: AuthTypeServiceAccount, KubeConfigPath: "kubeconfig"}.Validate(), "kube_config_path can only be set")
+}
+
+func TestCreateRestConfigKubeConfig(t *testing.T) {
+	path := filepath.Join("testdata", "kubeconfig.yaml")
+
+	restConfig, err := CreateRestConfig(APIConfig{AuthType: AuthTypeKubeConfig, KubeConfigPath: path})
+	require.NoError(t, err)
+	assert.Equal(t, "https://127.0.0.1:6443", restConfig.Host)
+	assert.Equal(t, "local-token", restConfig.BearerToken)
+	assert.Nil(t, restConfig.ExecProvider)
+	assert.NotNil(t, restConfig.WrapTransport)
+
+	restConfig, err = CreateRestConfig(APIConfig{AuthType: AuthTypeKubeConfig, KubeConfigPath: path, Context: "eks"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://eks.example.com", restConfig.Host)
+	require.NotNil(t, restConfig.ExecProvider)
+	assert.Equal(t, "aws", restConfig.ExecProvider.Command)
+	assert.Equal(t, []string{"eks", "get-token", "--cluster-name", "my-cluster"}, restConfig.ExecProvider.Args)
+	assert.Nil(t, restConfig.WrapTransport)
+
+	_, err = CreateRestConfig(APIConfig{AuthType: AuthTypeKubeConfig, KubeConfigPath: path, Context: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestExpandHome(t *testing.T) {
+	t.Setenv("HOME", "/home/otel")
+	path, err := expandHome("~/.kube/config")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("/home/otel", ".kube", "config"), path)
+
+	path, err = expandHome("/etc/kubeconfig")
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/kubeconfig", path)
+}
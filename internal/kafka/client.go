@@ -6,6 +6,7 @@ package kafka // import "github.com/open-telemetry/opentelemetry-collector-contr
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 
 	"github.com/IBM/sarama"
 
@@ -66,6 +67,9 @@ func newSaramaClientConfig(ctx context.Context, config configkafka.ClientConfig)
 		saramaConfig.Net.TLS.Config = &tls.Config{}
 		saramaConfig.Net.TLS.Enable = true
 	}
+	if config.Authentication.SASL != nil && config.Authentication.SASL.Mechanism == OAUTHBEARER {
+		return nil, errors.New("the OAUTHBEARER mechanism is not supported by this component")
+	}
 	configureSaramaAuthentication(ctx, config.Authentication, saramaConfig)
 	return saramaConfig, nil
 }
@@ -5,6 +5,7 @@ package kafka // import "github.com/open-telemetry/opentelemetry-collector-contr
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"hash/fnv"
 	"strings"
@@ -36,6 +37,7 @@ const (
 	SCRAMSHA256          = "SCRAM-SHA-256"
 	PLAIN                = "PLAIN"
 	AWSMSKIAMOAUTHBEARER = "AWS_MSK_IAM_OAUTHBEARER" //nolint:gosec // These aren't credentials.
+	OAUTHBEARER          = "OAUTHBEARER"
 )
 
 // NewFranzSyncProducer creates a new Kafka client using the franz-go library.
@@ -81,6 +83,13 @@ func NewFranzSyncProducer(
 		opts = append(opts, kgo.DisableIdempotentWrite(), kgo.RequiredAcks(kgo.LeaderAck()))
 	}
 
+	if cfg.Transactions.Enabled {
+		opts = append(opts,
+			kgo.TransactionalID(cfg.Transactions.TransactionalID),
+			kgo.TransactionTimeout(cfg.Transactions.Timeout),
+		)
+	}
+
 	// Configure auto topic creation
 	if cfg.AllowAutoTopicCreation {
 		opts = append(opts, kgo.AllowAutoTopicCreation())
@@ -203,7 +212,7 @@ func NewFranzClusterAdminClient(
 
 func commonOpts(
 	ctx context.Context,
-	_ component.Host,
+	host component.Host,
 	clientCfg configkafka.ClientConfig,
 	logger *zap.Logger,
 	opts ...kgo.Opt,
@@ -229,6 +238,10 @@ func commonOpts(
 		if tlsCfg != nil {
 			opts = append(opts, kgo.DialTLSConfig(tlsCfg))
 		}
+	} else if clientCfg.Authentication.SASL != nil && clientCfg.Authentication.SASL.Mechanism == AWSMSKIAMOAUTHBEARER {
+		// MSK only accepts IAM authentication over TLS, so enable it
+		// with the default settings, as Sarama does.
+		opts = append(opts, kgo.DialTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12}))
 	}
 	// Configure authentication
 	if clientCfg.Authentication.PlainText != nil {
@@ -239,7 +252,7 @@ func commonOpts(
 		opts = append(opts, kgo.SASL(auth.AsMechanism()))
 	}
 	if clientCfg.Authentication.SASL != nil {
-		saslOpt, err := configureKgoSASL(clientCfg.Authentication.SASL)
+		saslOpt, err := configureKgoSASL(ctx, host, clientCfg.Authentication.SASL)
 		if err != nil {
 			return nil, fmt.Errorf("failed to configure SASL: %w", err)
 		}
@@ -282,7 +295,7 @@ func commonOpts(
 	return opts, nil
 }
 
-func configureKgoSASL(cfg *configkafka.SASLConfig) (kgo.Opt, error) {
+func configureKgoSASL(ctx context.Context, host component.Host, cfg *configkafka.SASLConfig) (kgo.Opt, error) {
 	var m sasl.Mechanism
 	switch cfg.Mechanism {
 	case PLAIN:
@@ -296,6 +309,11 @@ func configureKgoSASL(cfg *configkafka.SASLConfig) (kgo.Opt, error) {
 			token, _, err := signer.GenerateAuthToken(ctx, cfg.AWSMSK.Region)
 			return oauth.Auth{Token: token}, err
 		})
+	case OAUTHBEARER:
+		var err error
+		if m, err = newOAuthBearerMechanism(ctx, host, cfg.OAuthBearer.Authenticator); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unsupported SASL mechanism: %s", cfg.Mechanism)
 	}
@@ -281,6 +281,29 @@ func TestNewFranzSyncProducerRequiredAcks(t *testing.T) {
 	}
 }
 
+func TestNewFranzSyncProducerTransactions(t *testing.T) {
+	_, clientConfig := kafkatest.NewCluster(t)
+	prodCfg := configkafka.NewDefaultProducerConfig()
+	prodCfg.RequiredAcks = configkafka.WaitForAll
+	prodCfg.Transactions.Enabled = true
+	prodCfg.Transactions.TransactionalID = "otelcol"
+	prodCfg.Transactions.Timeout = 10 * time.Second
+
+	tl := zaptest.NewLogger(t, zaptest.Level(zap.WarnLevel))
+	client, err := NewFranzSyncProducer(
+		t.Context(), componenttest.NewNopHost(), clientConfig,
+		prodCfg, time.Second, tl,
+	)
+	require.NoError(t, err)
+	defer client.Close()
+
+	// kfake doesn't support transactions, so only the options are checked.
+	transactionalID, ok := client.OptValue(kgo.TransactionalID).(*string)
+	require.True(t, ok)
+	assert.Equal(t, "otelcol", *transactionalID)
+	assert.Equal(t, 10*time.Second, client.OptValue(kgo.TransactionTimeout))
+}
+
 func acksToString(tb testing.TB, acks configkafka.RequiredAcks) string {
 	switch acks {
 	case configkafka.NoResponse:
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/kafka"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/oauth"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
+)
+
+// newOAuthBearerMechanism returns an OAUTHBEARER mechanism obtaining its tokens
+// from the gRPC client authentication extension with the given ID.
+//
+// The token is requested each time a connection authenticates, including the
+// re-authentications triggered by the brokers before the session expires, so
+// the extension is responsible for caching and refreshing the token before its
+// expiry, as the oauth2client extension does.
+func newOAuthBearerMechanism(ctx context.Context, host component.Host, id component.ID) (sasl.Mechanism, error) {
+	if host == nil {
+		return nil, errors.New("the OAUTHBEARER mechanism requires a host to look up the authenticator")
+	}
+	authCfg := configauth.Config{AuthenticatorID: id}
+	authenticator, err := authCfg.GetGRPCClientAuthenticator(ctx, host.GetExtensions())
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := authenticator.PerRPCCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credentials from authenticator %q: %w", id, err)
+	}
+	return oauth.Oauth(func(ctx context.Context) (oauth.Auth, error) {
+		metadata, err := credentials.GetRequestMetadata(ctx)
+		if err != nil {
+			return oauth.Auth{}, fmt.Errorf("failed to get token from authenticator %q: %w", id, err)
+		}
+		for key, value := range metadata {
+			if !strings.EqualFold(key, "authorization") {
+				continue
+			}
+			if scheme, token, ok := strings.Cut(value, " "); ok && strings.EqualFold(scheme, "Bearer") {
+				return oauth.Auth{Token: token}, nil
+			}
+		}
+		return oauth.Auth{}, fmt.Errorf("authenticator %q did not provide a bearer token", id)
+	}), nil
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/extensionauth"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/kafka/configkafka"
+)
+
+type extensionsHost map[component.ID]component.Component
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h
+}
+
+type nopExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+type tokenAuthenticator struct {
+	component.StartFunc
+	component.ShutdownFunc
+	extensionauth.ClientPerRPCCredentialsFunc
+}
+
+type tokenCredentials func() (map[string]string, error)
+
+func (c tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return c()
+}
+
+func (tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+func newTokenAuthenticator(metadata func() (map[string]string, error)) *tokenAuthenticator {
+	return &tokenAuthenticator{
+		ClientPerRPCCredentialsFunc: func() (credentials.PerRPCCredentials, error) {
+			return tokenCredentials(metadata), nil
+		},
+	}
+}
+
+func TestOAuthBearerMechanism(t *testing.T) {
+	id := component.MustNewID("oauth2client")
+	tokens := []string{"token1", "token2"}
+	host := extensionsHost{
+		id: newTokenAuthenticator(func() (map[string]string, error) {
+			token := tokens[0]
+			tokens = tokens[1:]
+			return map[string]string{"Authorization": "Bearer " + token}, nil
+		}),
+		component.MustNewID("nop"): nopExtension{},
+	}
+
+	m, err := newOAuthBearerMechanism(t.Context(), host, id)
+	require.NoError(t, err)
+	assert.Equal(t, OAUTHBEARER, m.Name())
+
+	// a new token is requested on each authentication, letting the
+	// authenticator refresh it before it expires
+	for _, token := range []string{"token1", "token2"} {
+		_, clientWrite, err := m.Authenticate(t.Context(), "broker:9092")
+		require.NoError(t, err)
+		assert.Equal(t, "n,,\x01auth=Bearer "+token+"\x01\x01", string(clientWrite))
+	}
+}
+
+func TestOAuthBearerMechanismErrors(t *testing.T) {
+	id := component.MustNewID("oauth2client")
+
+	_, err := newOAuthBearerMechanism(t.Context(), extensionsHost{}, id)
+	require.ErrorContains(t, err, `failed to resolve authenticator "oauth2client"`)
+
+	_, err = newOAuthBearerMechanism(t.Context(), extensionsHost{id: nopExtension{}}, id)
+	require.EqualError(t, err, "requested authenticator is not a gRPC client authenticator")
+
+	host := extensionsHost{id: newTokenAuthenticator(func() (map[string]string, error) {
+		return map[string]string{"authorization": "Basic dXNlcjpwYXNz"}, nil
+	})}
+	m, err := newOAuthBearerMechanism(t.Context(), host, id)
+	require.NoError(t, err)
+	_, _, err = m.Authenticate(t.Context(), "broker:9092")
+	require.EqualError(t, err, `authenticator "oauth2client" did not provide a bearer token`)
+
+	host = extensionsHost{id: newTokenAuthenticator(func() (map[string]string, error) {
+		return nil, assert.AnError
+	})}
+	m, err = newOAuthBearerMechanism(t.Context(), host, id)
+	require.NoError(t, err)
+	_, _, err = m.Authenticate(t.Context(), "broker:9092")
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestNewFranzSyncProducer_OAuthBearerAuthenticatorNotFound(t *testing.T) {
+	clientConfig := configkafka.NewDefaultClientConfig()
+	clientConfig.Authentication.SASL = &configkafka.SASLConfig{
+		Mechanism: OAUTHBEARER,
+		OAuthBearer: configkafka.OAuthBearerConfig{
+			Authenticator: component.MustNewID("oauth2client"),
+		},
+	}
+	_, err := NewFranzSyncProducer(t.Context(), componenttest.NewNopHost(), clientConfig,
+		configkafka.NewDefaultProducerConfig(), time.Second, zaptest.NewLogger(t))
+	require.ErrorContains(t, err, "failed to configure SASL")
+}
+
+func TestNewSaramaClientConfig_OAuthBearerUnsupported(t *testing.T) {
+	clientConfig := configkafka.NewDefaultClientConfig()
+	clientConfig.Authentication.SASL = &configkafka.SASLConfig{Mechanism: OAUTHBEARER}
+	_, err := newSaramaClientConfig(t.Context(), clientConfig)
+	require.EqualError(t, err, "the OAUTHBEARER mechanism is not supported by this component")
+}
@@ -13,6 +13,11 @@ import (
 // FlattenResourceLogs moves each LogRecord onto a dedicated ResourceLogs and ScopeLogs.
 // Modifications are made in place. Order of LogRecords is preserved.
 func FlattenLogs(rls plog.ResourceLogsSlice) {
+	FlattenLogsFunc(rls, func(pcommon.Resource, pcommon.Resource) {})
+}
+
+// FlattenLogsFunc is FlattenLogs calling onFlatten with each dedicated Resource and the Resource it was copied from.
+func FlattenLogsFunc(rls plog.ResourceLogsSlice, onFlatten func(flat, grouped pcommon.Resource)) {
 	tmp := plog.NewResourceLogsSlice()
 	rls.MoveAndAppendTo(tmp)
 	for i := 0; i < tmp.Len(); i++ {
@@ -22,6 +27,7 @@ func FlattenLogs(rls plog.ResourceLogsSlice) {
 			for k := 0; k < groupedScope.LogRecords().Len(); k++ {
 				flatResource := rls.AppendEmpty()
 				groupedResource.Resource().Attributes().CopyTo(flatResource.Resource().Attributes())
+				onFlatten(flatResource.Resource(), groupedResource.Resource())
 				flatScope := flatResource.ScopeLogs().AppendEmpty()
 				flatScope.SetSchemaUrl(groupedScope.SchemaUrl())
 				flatScope.Scope().SetName(groupedScope.Scope().Name())
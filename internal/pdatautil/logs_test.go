@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
@@ -129,6 +130,23 @@ func TestFlattenResourceLogs(t *testing.T) {
 	}
 }
 
+func TestFlattenLogsFunc(t *testing.T) {
+	actual := setupResourceLogsSlice([]resourceLogs{
+		newResourceLogs(1, newScopeLogs(11, 101, 102)),
+		newResourceLogs(2, newScopeLogs(11, 103)),
+	})
+	grouped := []pcommon.Resource{actual.At(0).Resource(), actual.At(0).Resource(), actual.At(1).Resource()}
+	var flattened []pcommon.Resource
+	FlattenLogsFunc(actual, func(flat, original pcommon.Resource) {
+		assert.Equal(t, grouped[len(flattened)].Attributes().AsRaw(), original.Attributes().AsRaw())
+		flattened = append(flattened, flat)
+	})
+	require.Len(t, flattened, actual.Len())
+	for i, flat := range flattened {
+		assert.Equal(t, actual.At(i).Resource(), flat)
+	}
+}
+
 func TestGroupByResourceLogs(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -6,6 +6,7 @@ package apmstats // import "github.com/open-telemetry/opentelemetry-collector-co
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/DataDog/datadog-go/v5/statsd"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/otel/metric/noop"
@@ -48,6 +50,12 @@ type traceToMetricConnector struct {
 	// peerTagKeys are peer tag keys to group APM stats
 	peerTagKeys []string
 
+	// statsSpanKinds are the span kinds of the spans included in APM stats, all span kinds are included if nil.
+	statsSpanKinds map[string]struct{}
+
+	// statsConcurrency is the number of groups of resources converted concurrently for APM stats.
+	statsConcurrency int
+
 	// translator specifies the translator used to transform APM Stats Payloads
 	// from the agent to OTLP Metrics.
 	// We use the deprecated Translator type because it's the only one that provides
@@ -87,7 +95,8 @@ func newTraceToMetricConnector(set component.TelemetrySettings, cfg component.Co
 		return nil, fmt.Errorf("failed to create metrics translator: %w", err)
 	}
 
-	tcfg := getTraceAgentCfg(set.Logger, cfg.(*datadogconfig.ConnectorComponentConfig).Traces, attributesTranslator, tagger, hostnameOpt)
+	tracesCfg := cfg.(*datadogconfig.ConnectorComponentConfig).Traces
+	tcfg := getTraceAgentCfg(set.Logger, tracesCfg, attributesTranslator, tagger, hostnameOpt)
 	oconf := tcfg.Obfuscation.Export(tcfg)
 	oconf.Statsd = metricsClient
 	oconf.Redis.Enabled = true
@@ -98,19 +107,44 @@ func newTraceToMetricConnector(set component.TelemetrySettings, cfg component.Co
 	}
 
 	return &traceToMetricConnector{
-		logger:          set.Logger,
-		translator:      trans,
-		tcfg:            tcfg,
-		ctagKeys:        cfg.(*datadogconfig.ConnectorComponentConfig).Traces.ResourceAttributesAsContainerTags,
-		peerTagKeys:     tcfg.ConfiguredPeerTags(),
-		concentrator:    concentrator,
-		statsout:        statsout,
-		metricsConsumer: metricsConsumer,
-		obfuscator:      obfuscate.NewObfuscator(oconf),
-		exit:            make(chan struct{}),
+		logger:           set.Logger,
+		translator:       trans,
+		tcfg:             tcfg,
+		ctagKeys:         tracesCfg.ResourceAttributesAsContainerTags,
+		peerTagKeys:      peerTagKeys(tcfg, tracesCfg.ExcludedPeerTags),
+		statsSpanKinds:   statsSpanKinds(tracesCfg.StatsSpanKinds),
+		statsConcurrency: tracesCfg.StatsConcurrency,
+		concentrator:     concentrator,
+		statsout:         statsout,
+		metricsConsumer:  metricsConsumer,
+		obfuscator:       obfuscate.NewObfuscator(oconf),
+		exit:             make(chan struct{}),
 	}, nil
 }
 
+// peerTagKeys returns the configured peer tags, without the excluded ones.
+// The excluded peer tags are not set on the spans, so the concentrator doesn't aggregate them.
+func peerTagKeys(tcfg *config.AgentConfig, excluded []string) []string {
+	keys := tcfg.ConfiguredPeerTags()
+	if len(excluded) == 0 {
+		return keys
+	}
+	return slices.DeleteFunc(keys, func(key string) bool {
+		return slices.Contains(excluded, key)
+	})
+}
+
+func statsSpanKinds(kinds []string) map[string]struct{} {
+	if len(kinds) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{}, len(kinds))
+	for _, kind := range kinds {
+		set[kind] = struct{}{}
+	}
+	return set
+}
+
 func getTraceAgentCfg(logger *zap.Logger, cfg datadogconfig.TracesConnectorConfig, attributesTranslator *attributes.Translator, tagger types.TaggerClient, hostnameOpt option.Option[string]) *config.AgentConfig {
 	acfg := config.New()
 	acfg.OTLPReceiver.AttributesTranslator = attributesTranslator
@@ -194,11 +228,102 @@ func (*traceToMetricConnector) Capabilities() consumer.Capabilities {
 }
 
 func (c *traceToMetricConnector) ConsumeTraces(_ context.Context, traces ptrace.Traces) error {
+	if c.statsConcurrency <= 1 || traces.ResourceSpans().Len() <= 1 {
+		c.addToConcentrator(traces)
+		return nil
+	}
+	groups := groupResourcesByTrace(traces)
+	if len(groups) == 1 {
+		c.addToConcentrator(traces)
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, c.statsConcurrency)
+	for _, group := range groups {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			td := ptrace.NewTraces()
+			td.ResourceSpans().EnsureCapacity(len(group))
+			for _, i := range group {
+				traces.ResourceSpans().At(i).CopyTo(td.ResourceSpans().AppendEmpty())
+			}
+			c.addToConcentrator(td)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// addToConcentrator converts the spans of the traces and adds them to the concentrator.
+func (c *traceToMetricConnector) addToConcentrator(traces ptrace.Traces) {
 	inputs := stats.OTLPTracesToConcentratorInputsWithObfuscation(traces, c.tcfg, c.ctagKeys, c.peerTagKeys, c.obfuscator)
 	for _, input := range inputs {
+		if c.statsSpanKinds != nil {
+			for _, trace := range input.Traces {
+				trace.TraceChunk.Spans = slices.DeleteFunc(trace.TraceChunk.Spans, func(span *pb.Span) bool {
+					_, ok := c.statsSpanKinds[span.Meta["span.kind"]]
+					return !ok
+				})
+			}
+		}
 		c.concentrator.Add(input)
 	}
-	return nil
+}
+
+// groupResourcesByTrace returns the indexes of the resources of the traces, grouped so that the resources
+// sharing a trace are in the same group, in the order of their first resource.
+func groupResourcesByTrace(traces ptrace.Traces) [][]int {
+	rss := traces.ResourceSpans()
+	// parents is a union-find of the resources.
+	parents := make([]int, rss.Len())
+	for i := range parents {
+		parents[i] = i
+	}
+	find := func(i int) int {
+		for parents[i] != i {
+			parents[i] = parents[parents[i]]
+			i = parents[i]
+		}
+		return i
+	}
+
+	resourceByTrace := make(map[pcommon.TraceID]int)
+	for i := 0; i < rss.Len(); i++ {
+		sss := rss.At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				traceID := spans.At(k).TraceID()
+				if r, ok := resourceByTrace[traceID]; ok {
+					if root, other := find(i), find(r); root != other {
+						parents[max(root, other)] = min(root, other)
+					}
+					continue
+				}
+				resourceByTrace[traceID] = i
+			}
+		}
+	}
+
+	var groups [][]int
+	groupByRoot := make(map[int]int)
+	for i := range parents {
+		root := find(i)
+		g, ok := groupByRoot[root]
+		if !ok {
+			g = len(groups)
+			groupByRoot[root] = g
+			groups = append(groups, nil)
+		}
+		groups[g] = append(groups[g], i)
+	}
+	return groups
 }
 
 // run awaits incoming stats resulting from the agent's ingestion, converts them
@@ -6,6 +6,7 @@ package apmstats // import "github.com/open-telemetry/opentelemetry-collector-co
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"sync"
 	"testing"
@@ -571,3 +572,134 @@ func TestError(t *testing.T) {
 	err = conn.Shutdown(t.Context())
 	require.NoError(t, err)
 }
+
+// consumeAndGetStats consumes the traces with a new connector and returns the APM stats it computed, sorted by resource.
+func consumeAndGetStats(t *testing.T, cfg *datadogconfig.ConnectorComponentConfig, td ptrace.Traces) []*pb.ClientGroupedStats {
+	connector, metricsSink := createConnectorCfg(t, cfg)
+	require.NoError(t, connector.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, connector.Shutdown(t.Context()))
+	}()
+
+	require.NoError(t, connector.ConsumeTraces(t.Context(), td))
+	require.Eventually(t, func() bool {
+		return len(metricsSink.AllMetrics()) > 0
+	}, time.Minute, 100*time.Millisecond)
+
+	ch := make(chan []byte, 100)
+	tr := newTranslatorWithStatsChannel(t, zap.NewNop(), ch)
+	var cgss []*pb.ClientGroupedStats
+	for _, metrics := range metricsSink.AllMetrics() {
+		_, err := tr.MapMetrics(t.Context(), metrics, nil, nil)
+		require.NoError(t, err)
+		sp := &pb.StatsPayload{}
+		require.NoError(t, proto.Unmarshal(<-ch, sp))
+		for _, csp := range sp.Stats {
+			for _, bucket := range csp.Stats {
+				cgss = append(cgss, bucket.Stats...)
+			}
+		}
+	}
+	sort.Slice(cgss, func(i, j int) bool {
+		return cgss[i].Resource < cgss[j].Resource
+	})
+	return cgss
+}
+
+func TestStatsSpanKinds(t *testing.T) {
+	cfg := NewConnectorFactory(datadogComponentType, component.StabilityLevelBeta, component.StabilityLevelBeta, nil, nil, nil).CreateDefaultConfig().(*datadogconfig.ConnectorComponentConfig)
+	cfg.Traces.StatsSpanKinds = []string{"server", "consumer"}
+
+	td := ptrace.NewTraces()
+	res := td.ResourceSpans().AppendEmpty().Resource()
+	res.Attributes().PutStr("service.name", "svc")
+	ss := td.ResourceSpans().At(0).ScopeSpans().AppendEmpty().Spans()
+	s1 := ss.AppendEmpty()
+	s1.SetName("parent")
+	s1.SetKind(ptrace.SpanKindServer)
+	s1.SetTraceID(testTraceID)
+	s1.SetSpanID(testSpanID1)
+	// Client spans are excluded from the stats
+	s2 := ss.AppendEmpty()
+	s2.SetName("child")
+	s2.SetKind(ptrace.SpanKindClient)
+	s2.SetTraceID(testTraceID)
+	s2.SetSpanID(testSpanID2)
+	s2.SetParentSpanID(testSpanID1)
+
+	cgss := consumeAndGetStats(t, cfg, td)
+	require.Len(t, cgss, 1)
+	assert.Equal(t, "parent", cgss[0].Resource)
+	assert.Equal(t, "server", cgss[0].SpanKind)
+}
+
+func TestExcludedPeerTags(t *testing.T) {
+	cfg := NewConnectorFactory(datadogComponentType, component.StabilityLevelBeta, component.StabilityLevelBeta, nil, nil, nil).CreateDefaultConfig().(*datadogconfig.ConnectorComponentConfig)
+	cfg.Traces.PeerTags = []string{"extra.peer.tag"}
+	cfg.Traces.ExcludedPeerTags = []string{"db.instance", "extra.peer.tag"}
+	connector, _ := createConnectorCfg(t, cfg)
+
+	assert.Contains(t, connector.peerTagKeys, "peer.service")
+	assert.NotContains(t, connector.peerTagKeys, "db.instance")
+	assert.NotContains(t, connector.peerTagKeys, "extra.peer.tag")
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("service.name", "svc")
+	span := td.ResourceSpans().At(0).ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("query")
+	span.SetKind(ptrace.SpanKindClient)
+	span.SetTraceID(testTraceID)
+	span.SetSpanID(testSpanID1)
+	span.Attributes().PutStr("peer.service", "db")
+	span.Attributes().PutStr("db.instance", "instance-1")
+
+	cgss := consumeAndGetStats(t, cfg, td)
+	require.Len(t, cgss, 1)
+	assert.Equal(t, []string{"peer.service:db"}, cgss[0].PeerTags)
+}
+
+func TestStatsConcurrency(t *testing.T) {
+	cfg := NewConnectorFactory(datadogComponentType, component.StabilityLevelBeta, component.StabilityLevelBeta, nil, nil, nil).CreateDefaultConfig().(*datadogconfig.ConnectorComponentConfig)
+	cfg.Traces.StatsConcurrency = 4
+
+	td := ptrace.NewTraces()
+	for i := 0; i < 8; i++ {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", "svc")
+		span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		span.SetName(fmt.Sprintf("span-%d", i))
+		span.SetKind(ptrace.SpanKindServer)
+		span.SetTraceID([16]byte{byte(i + 1)})
+		span.SetSpanID([8]byte{byte(i + 1)})
+	}
+	// The child span is in another resource of the same service, it is not top-level since its parent is in the payload.
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "svc")
+	child := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	child.SetName("span-child")
+	child.SetKind(ptrace.SpanKindServer)
+	child.SetTraceID([16]byte{1})
+	child.SetSpanID([8]byte{9})
+	child.SetParentSpanID([8]byte{1})
+	cfg.Traces.ComputeStatsBySpanKind = true
+
+	cgss := consumeAndGetStats(t, cfg, td)
+	require.Len(t, cgss, 9)
+	for i := 0; i < 8; i++ {
+		assert.Equal(t, fmt.Sprintf("span-%d", i), cgss[i].Resource)
+		assert.Equal(t, uint64(1), cgss[i].TopLevelHits)
+	}
+	assert.Equal(t, "span-child", cgss[8].Resource)
+	assert.Equal(t, uint64(0), cgss[8].TopLevelHits)
+}
+
+func TestGroupResourcesByTrace(t *testing.T) {
+	td := ptrace.NewTraces()
+	for _, traceIDs := range [][]byte{{1}, {2}, {3, 1}, {4}, {2, 4}, {5}} {
+		spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+		for _, id := range traceIDs {
+			spans.AppendEmpty().SetTraceID([16]byte{id})
+		}
+	}
+	assert.Equal(t, [][]int{{0, 2}, {1, 3, 4}, {5}}, groupResourcesByTrace(td))
+}
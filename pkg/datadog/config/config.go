@@ -20,6 +20,8 @@ import (
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 var (
@@ -113,6 +115,10 @@ type Config struct {
 
 	OrchestratorExplorer OrchestratorExplorerConfig `mapstructure:"orchestrator_explorer"`
 
+	// ContainerTagging defines the configuration of the local tagger, which resolves container
+	// and pod tags without the Datadog Agent.
+	ContainerTagging ContainerTaggingConfig `mapstructure:"container_tagging"`
+
 	// Non-fatal warnings found during configuration loading.
 	warnings []error
 }
@@ -178,6 +184,10 @@ func (c *Config) Validate() error {
 		return errors.New("reporter_period must be 5 minutes or higher")
 	}
 
+	if err := c.ContainerTagging.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -417,6 +427,16 @@ func CreateDefaultConfig() component.Config {
 			Enabled: false,
 		},
 
+		ContainerTagging: ContainerTaggingConfig{
+			OriginDetection: true,
+			ProcRoot:        "/proc",
+			Kubernetes: KubernetesTaggingConfig{
+				APIConfig: k8sconfig.APIConfig{
+					AuthType: k8sconfig.AuthTypeServiceAccount,
+				},
+			},
+		},
+
 		HostnameDetectionTimeout: 25 * time.Second, // set to 25 to prevent 30-second pod restart on K8s as reported in issue #40372 and #40373
 	}
 }
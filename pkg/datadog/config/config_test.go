@@ -23,6 +23,8 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 func TestValidate(t *testing.T) {
@@ -201,6 +203,38 @@ func TestValidate(t *testing.T) {
 			},
 			err: "reporter_period must be 5 minutes or higher",
 		},
+		{
+			name: "container tagging disabled",
+			cfg: &Config{
+				API:              APIConfig{Key: "abcdef0"},
+				HostMetadata:     HostMetadataConfig{Enabled: true, ReporterPeriod: 10 * time.Minute},
+				ContainerTagging: ContainerTaggingConfig{OriginDetection: true},
+			},
+		},
+		{
+			name: "container tagging without proc_root",
+			cfg: &Config{
+				API:              APIConfig{Key: "abcdef0"},
+				HostMetadata:     HostMetadataConfig{Enabled: true, ReporterPeriod: 10 * time.Minute},
+				ContainerTagging: ContainerTaggingConfig{Enabled: true, OriginDetection: true},
+			},
+			err: "container_tagging::proc_root must not be empty when origin detection is enabled",
+		},
+		{
+			name: "container tagging with invalid kubernetes auth_type",
+			cfg: &Config{
+				API:          APIConfig{Key: "abcdef0"},
+				HostMetadata: HostMetadataConfig{Enabled: true, ReporterPeriod: 10 * time.Minute},
+				ContainerTagging: ContainerTaggingConfig{
+					Enabled: true,
+					Kubernetes: KubernetesTaggingConfig{
+						Enabled:   true,
+						APIConfig: k8sconfig.APIConfig{AuthType: "invalid"},
+					},
+				},
+			},
+			err: "container_tagging::kubernetes: invalid authType for kubernetes: invalid",
+		},
 	}
 	for _, testInstance := range tests {
 		t.Run(testInstance.name, func(t *testing.T) {
@@ -259,6 +293,34 @@ func TestValidateConnectorComponentConfig(t *testing.T) {
 			},
 			err: "trace buffer must be non-negative",
 		},
+		{
+			name: "valid stats_span_kinds in connector",
+			cfg: &ConnectorComponentConfig{
+				Traces: TracesConnectorConfig{
+					StatsSpanKinds:   []string{"server", "consumer"},
+					ExcludedPeerTags: []string{"db.instance"},
+					StatsConcurrency: 4,
+				},
+			},
+		},
+		{
+			name: "invalid stats_span_kinds in connector",
+			cfg: &ConnectorComponentConfig{
+				Traces: TracesConnectorConfig{
+					StatsSpanKinds: []string{"server", "SPAN_KIND_CLIENT"},
+				},
+			},
+			err: "'SPAN_KIND_CLIENT' is not a valid span kind for stats_span_kinds, valid values are: server, client, producer, consumer, internal, unspecified",
+		},
+		{
+			name: "invalid stats_concurrency in connector",
+			cfg: &ConnectorComponentConfig{
+				Traces: TracesConnectorConfig{
+					StatsConcurrency: -1,
+				},
+			},
+			err: "stats concurrency must be non-negative",
+		},
 		{
 			name: "invalid bucket_interval in connector",
 			cfg: &ConnectorComponentConfig{
@@ -538,6 +600,15 @@ func TestCreateDefaultConfig(t *testing.T) {
 			},
 			Enabled: false,
 		},
+		ContainerTagging: ContainerTaggingConfig{
+			OriginDetection: true,
+			ProcRoot:        "/proc",
+			Kubernetes: KubernetesTaggingConfig{
+				APIConfig: k8sconfig.APIConfig{
+					AuthType: k8sconfig.AuthTypeServiceAccount,
+				},
+			},
+		},
 	}, cfg, "failed to create default config")
 
 	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
@@ -620,6 +691,15 @@ func TestLoadConfig(t *testing.T) {
 					},
 					Enabled: false,
 				},
+				ContainerTagging: ContainerTaggingConfig{
+					OriginDetection: true,
+					ProcRoot:        "/proc",
+					Kubernetes: KubernetesTaggingConfig{
+						APIConfig: k8sconfig.APIConfig{
+							AuthType: k8sconfig.AuthTypeServiceAccount,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -694,6 +774,15 @@ func TestLoadConfig(t *testing.T) {
 					},
 					Enabled: false,
 				},
+				ContainerTagging: ContainerTaggingConfig{
+					OriginDetection: true,
+					ProcRoot:        "/proc",
+					Kubernetes: KubernetesTaggingConfig{
+						APIConfig: k8sconfig.APIConfig{
+							AuthType: k8sconfig.AuthTypeServiceAccount,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -766,6 +855,15 @@ func TestLoadConfig(t *testing.T) {
 					},
 					Enabled: false,
 				},
+				ContainerTagging: ContainerTaggingConfig{
+					OriginDetection: true,
+					ProcRoot:        "/proc",
+					Kubernetes: KubernetesTaggingConfig{
+						APIConfig: k8sconfig.APIConfig{
+							AuthType: k8sconfig.AuthTypeServiceAccount,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -833,6 +931,15 @@ func TestLoadConfig(t *testing.T) {
 					},
 					Enabled: false,
 				},
+				ContainerTagging: ContainerTaggingConfig{
+					OriginDetection: true,
+					ProcRoot:        "/proc",
+					Kubernetes: KubernetesTaggingConfig{
+						APIConfig: k8sconfig.APIConfig{
+							AuthType: k8sconfig.AuthTypeServiceAccount,
+						},
+					},
+				},
 			},
 		},
 	}
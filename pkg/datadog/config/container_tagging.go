@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/datadog/config"
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// ContainerTaggingConfig defines configuration for the local tagger, which adds container and pod
+// resource attributes to the telemetry, the same way the Datadog Agent tagger would.
+type ContainerTaggingConfig struct {
+	// Enabled enables the local tagger.
+	// The default value is false.
+	Enabled bool `mapstructure:"enabled"`
+
+	// OriginDetection resolves the container ID of the resources that have a `process.pid` but no
+	// `container.id` resource attribute by inspecting the cgroup of the process.
+	// The default value is true.
+	OriginDetection bool `mapstructure:"origin_detection"`
+
+	// ProcRoot is the path of the proc filesystem used by origin detection. The proc filesystem of the
+	// host must be mounted when the collector doesn't run in the PID namespace of the host.
+	// The default value is "/proc".
+	ProcRoot string `mapstructure:"proc_root"`
+
+	// Kubernetes defines how pod tags are resolved from the Kubernetes API.
+	Kubernetes KubernetesTaggingConfig `mapstructure:"kubernetes"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// KubernetesTaggingConfig defines how the local tagger resolves pod tags from the Kubernetes API.
+type KubernetesTaggingConfig struct {
+	// Enabled enables watching the pods from the Kubernetes API.
+	// The default value is false.
+	Enabled bool `mapstructure:"enabled"`
+
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	// NodeName restricts the watched pods to the ones scheduled on the given node, usually set
+	// with the downward API. All the pods of the cluster are watched when unset.
+	NodeName string `mapstructure:"node_name"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// Validate the container tagging configuration.
+func (c *ContainerTaggingConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.OriginDetection && c.ProcRoot == "" {
+		return errors.New("container_tagging::proc_root must not be empty when origin detection is enabled")
+	}
+	if c.Kubernetes.Enabled {
+		if err := c.Kubernetes.APIConfig.Validate(); err != nil {
+			return fmt.Errorf("container_tagging::kubernetes: %w", err)
+		}
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/config/confignet"
@@ -158,8 +160,27 @@ type TracesConnectorConfig struct {
 	// OTLP semantic convention attributes. If it is true, we will only populate a field if its associated "datadog."
 	// OTLP span attribute exists, otherwise we will leave it empty.
 	IgnoreMissingDatadogFields bool `mapstructure:"ignore_missing_datadog_fields"`
+
+	// StatsSpanKinds specifies the span kinds (server, client, producer, consumer, internal or unspecified) of the spans
+	// which are included in the computed APM stats. Spans of other kinds are ignored.
+	// All span kinds are included if unset.
+	StatsSpanKinds []string `mapstructure:"stats_span_kinds"`
+
+	// ExcludedPeerTags specifies peer tags, among the default ones and those in `peer_tags`, which are not used as
+	// dimensions of the computed APM stats. It only applies when `peer_tags_aggregation` is enabled.
+	// Excluding high cardinality peer tags reduces the number of trace metrics.
+	ExcludedPeerTags []string `mapstructure:"excluded_peer_tags"`
+
+	// StatsConcurrency specifies the number of resources whose spans are converted for the APM stats computation
+	// concurrently. Resources sharing traces are always converted together so that top-level spans are preserved.
+	// Increasing it reduces the latency of large trace payloads made of many resources.
+	// Default is 1 if unset, meaning the resources are converted sequentially.
+	StatsConcurrency int `mapstructure:"stats_concurrency"`
 }
 
+// statsSpanKinds are the span kinds supported by StatsSpanKinds.
+var statsSpanKinds = []string{"server", "client", "producer", "consumer", "internal", "unspecified"}
+
 func (c *TracesConnectorConfig) Validate() error {
 	if err := c.TracesConfig.Validate(); err != nil {
 		return err
@@ -176,6 +197,16 @@ func (c *TracesConnectorConfig) Validate() error {
 	if c.BucketInterval < 0 {
 		return errors.New("bucket interval must be non-negative")
 	}
+
+	for _, kind := range c.StatsSpanKinds {
+		if !slices.Contains(statsSpanKinds, kind) {
+			return fmt.Errorf("'%s' is not a valid span kind for stats_span_kinds, valid values are: %s", kind, strings.Join(statsSpanKinds, ", "))
+		}
+	}
+
+	if c.StatsConcurrency < 0 {
+		return errors.New("stats concurrency must be non-negative")
+	}
 	return nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM/sarama"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap"
@@ -252,6 +253,40 @@ type ProducerConfig struct {
 	// Linger controls the linger time for the producer.
 	// (default 10ms).
 	Linger time.Duration `mapstructure:"linger"`
+
+	// Transactions configures the producer to produce the records of each
+	// batch in a Kafka transaction, so that consumers using the read_committed
+	// isolation level see either all or none of the records of the batch.
+	// Transactions require RequiredAcks to be WaitForAll.
+	Transactions TransactionsConfig `mapstructure:"transactions"`
+}
+
+// TransactionsConfig configures the transactional producer.
+type TransactionsConfig struct {
+	// Whether or not to produce records in transactions (default disabled).
+	Enabled bool `mapstructure:"enabled"`
+
+	// TransactionalID identifies the producer across restarts, allowing the
+	// broker to fence previous instances of the producer and to abort their
+	// pending transactions. It must be unique for each producer.
+	TransactionalID string `mapstructure:"transactional_id"`
+
+	// Timeout is the maximum duration of a transaction before the broker
+	// aborts it (default 40s).
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+func (c TransactionsConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.TransactionalID == "" {
+		return errors.New("transactional_id must be specified when transactions are enabled")
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("timeout (%s) must be positive", c.Timeout)
+	}
+	return nil
 }
 
 func NewDefaultProducerConfig() ProducerConfig {
@@ -262,6 +297,9 @@ func NewDefaultProducerConfig() ProducerConfig {
 		FlushMaxMessages:       10000,
 		AllowAutoTopicCreation: true,
 		Linger:                 10 * time.Millisecond,
+		Transactions: TransactionsConfig{
+			Timeout: 40 * time.Second,
+		},
 	}
 }
 
@@ -286,6 +324,10 @@ func (c ProducerConfig) Validate() error {
 	if c.FlushMaxMessages < 1 {
 		return fmt.Errorf("flush_max_messages (%d) must be at least 1", c.FlushMaxMessages)
 	}
+	if c.Transactions.Enabled && c.RequiredAcks != WaitForAll {
+		// Transactions require idempotent writes, which require all the in-sync replicas to acknowledge.
+		return errors.New("required_acks must be 'all' when transactions are enabled")
+	}
 	return nil
 }
 
@@ -397,18 +439,24 @@ type SASLConfig struct {
 	Username string `mapstructure:"username"`
 	// Password to be used on authentication
 	Password string `mapstructure:"password"`
-	// SASL Mechanism to be used, possible values are: (PLAIN, AWS_MSK_IAM_OAUTHBEARER, SCRAM-SHA-256 or SCRAM-SHA-512).
+	// SASL Mechanism to be used, possible values are: (PLAIN, AWS_MSK_IAM_OAUTHBEARER, OAUTHBEARER, SCRAM-SHA-256 or SCRAM-SHA-512).
 	Mechanism string `mapstructure:"mechanism"`
 	// SASL Protocol Version to be used, possible values are: (0, 1). Defaults to 0.
 	Version int `mapstructure:"version"`
 	// AWSMSK holds configuration specific to AWS MSK.
 	AWSMSK AWSMSKConfig `mapstructure:"aws_msk"`
+	// OAuthBearer holds configuration specific to the OAUTHBEARER mechanism.
+	OAuthBearer OAuthBearerConfig `mapstructure:"oauthbearer"`
 }
 
 func (c SASLConfig) Validate() error {
 	switch c.Mechanism {
 	case "AWS_MSK_IAM_OAUTHBEARER":
 		// TODO validate c.AWSMSK
+	case "OAUTHBEARER":
+		if c.OAuthBearer.Authenticator == (component.ID{}) {
+			return errors.New("oauthbearer::authenticator is required")
+		}
 	case "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512":
 		// Do nothing, valid mechanism
 		if c.Username == "" {
@@ -419,7 +467,7 @@ func (c SASLConfig) Validate() error {
 		}
 	default:
 		return fmt.Errorf(
-			"mechanism should be one of 'PLAIN', 'AWS_MSK_IAM_OAUTHBEARER', 'SCRAM-SHA-256' or 'SCRAM-SHA-512'. configured value %v",
+			"mechanism should be one of 'PLAIN', 'AWS_MSK_IAM_OAUTHBEARER', 'OAUTHBEARER', 'SCRAM-SHA-256' or 'SCRAM-SHA-512'. configured value %v",
 			c.Mechanism,
 		)
 	}
@@ -438,6 +486,16 @@ type AWSMSKConfig struct {
 	_ struct{}
 }
 
+// OAuthBearerConfig defines the configuration of the OAUTHBEARER mechanism,
+// whose tokens are obtained from a client authentication extension.
+type OAuthBearerConfig struct {
+	// Authenticator is the ID of the client authentication extension providing
+	// the bearer tokens, such as the oauth2client extension.
+	Authenticator component.ID `mapstructure:"authenticator"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 // KerberosConfig defines kerberos configuration.
 type KerberosConfig struct {
 	ServiceName     string `mapstructure:"service_name"`
@@ -76,6 +76,18 @@ func TestClientConfig(t *testing.T) {
 				return cfg
 			}(),
 		},
+		"sasl_oauthbearer": {
+			expected: func() ClientConfig {
+				cfg := NewDefaultClientConfig()
+				cfg.Authentication.SASL = &SASLConfig{
+					Mechanism: "OAUTHBEARER",
+					OAuthBearer: OAuthBearerConfig{
+						Authenticator: component.MustNewID("oauth2client"),
+					},
+				}
+				return cfg
+			}(),
+		},
 		"sasl_plain": {
 			expected: func() ClientConfig {
 				cfg := NewDefaultClientConfig()
@@ -127,11 +139,14 @@ func TestClientConfig(t *testing.T) {
 			expectedErr: "invalid protocol version: invalid version `none`",
 		},
 		"sasl_invalid_mechanism": {
-			expectedErr: "auth::sasl: mechanism should be one of 'PLAIN', 'AWS_MSK_IAM_OAUTHBEARER', 'SCRAM-SHA-256' or 'SCRAM-SHA-512'. configured value FANCY",
+			expectedErr: "auth::sasl: mechanism should be one of 'PLAIN', 'AWS_MSK_IAM_OAUTHBEARER', 'OAUTHBEARER', 'SCRAM-SHA-256' or 'SCRAM-SHA-512'. configured value FANCY",
 		},
 		"sasl_invalid_version": {
 			expectedErr: "auth::sasl: version has to be either 0 or 1. configured value -1",
 		},
+		"sasl_oauthbearer_authenticator_required": {
+			expectedErr: "auth::sasl: oauthbearer::authenticator is required",
+		},
 		"sasl_plain_username_required": {
 			expectedErr: "auth::sasl: username is required",
 		},
@@ -283,6 +298,24 @@ func TestProducerConfig(t *testing.T) {
 		"flush_max_messages_zero": {
 			expectedErr: "flush_max_messages (0) must be at least 1",
 		},
+		"transactions": {
+			expected: func() ProducerConfig {
+				cfg := NewDefaultProducerConfig()
+				cfg.RequiredAcks = WaitForAll
+				cfg.Transactions = TransactionsConfig{
+					Enabled:         true,
+					TransactionalID: "otelcol-1",
+					Timeout:         10 * time.Second,
+				}
+				return cfg
+			}(),
+		},
+		"transactions_required_acks": {
+			expectedErr: "required_acks must be 'all' when transactions are enabled",
+		},
+		"transactions_missing_id": {
+			expectedErr: "transactions: transactional_id must be specified when transactions are enabled",
+		},
 		"flush_max_messages_negative": {
 			expectedErr: "flush_max_messages (-1) must be at least 1",
 		},
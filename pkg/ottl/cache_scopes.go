@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+type cacheScopesKey struct{}
+
+// cacheScopes holds the caches shared by the transform contexts of a batch of telemetry.
+type cacheScopes struct {
+	lock      sync.Mutex
+	request   *pcommon.Map
+	resources map[pcommon.Resource]pcommon.Map
+}
+
+// ContextWithCacheScopes returns a copy of ctx holding the caches shared by the transform contexts of a batch of
+// telemetry: the request cache, shared by all the telemetry of the batch, and the resource caches, shared by all
+// the telemetry of a resource. The statements access them with the `request.cache` and `resource.cache` paths,
+// which allows parsing a value once and using it for all the telemetry of the batch or of the resource.
+//
+// The caches are only reachable through the returned context, so components must call ContextWithCacheScopes once
+// per batch: the batches processed concurrently never share their caches. The caches are created under a lock,
+// but their values must not be modified concurrently.
+//
+// Experimental: *NOTE* this function is subject to change or removal in the future.
+func ContextWithCacheScopes(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheScopesKey{}, &cacheScopes{
+		resources: map[pcommon.Resource]pcommon.Map{},
+	})
+}
+
+// RequestCache returns the cache shared by all the telemetry of the batch. It returns false if ctx wasn't created
+// by ContextWithCacheScopes.
+//
+// Experimental: *NOTE* this function is subject to change or removal in the future.
+func RequestCache(ctx context.Context) (pcommon.Map, bool) {
+	scopes, ok := ctx.Value(cacheScopesKey{}).(*cacheScopes)
+	if !ok {
+		return pcommon.Map{}, false
+	}
+	scopes.lock.Lock()
+	defer scopes.lock.Unlock()
+	if scopes.request == nil {
+		cache := pcommon.NewMap()
+		scopes.request = &cache
+	}
+	return *scopes.request, true
+}
+
+// ResourceCache returns the cache shared by all the telemetry of the resource in the batch. It returns false if
+// ctx wasn't created by ContextWithCacheScopes.
+//
+// Experimental: *NOTE* this function is subject to change or removal in the future.
+func ResourceCache(ctx context.Context, resource pcommon.Resource) (pcommon.Map, bool) {
+	scopes, ok := ctx.Value(cacheScopesKey{}).(*cacheScopes)
+	if !ok {
+		return pcommon.Map{}, false
+	}
+	scopes.lock.Lock()
+	defer scopes.lock.Unlock()
+	cache, ok := scopes.resources[resource]
+	if !ok {
+		cache = pcommon.NewMap()
+		scopes.resources[resource] = cache
+	}
+	return cache, true
+}
+
+// ShareResourceCache makes the telemetry of resource share the cache of the telemetry of shared in the batch, for
+// the components copying a resource to several ones. It does nothing if ctx wasn't created by
+// ContextWithCacheScopes.
+//
+// Experimental: *NOTE* this function is subject to change or removal in the future.
+func ShareResourceCache(ctx context.Context, resource, shared pcommon.Resource) {
+	scopes, ok := ctx.Value(cacheScopesKey{}).(*cacheScopes)
+	if !ok {
+		return
+	}
+	scopes.lock.Lock()
+	defer scopes.lock.Unlock()
+	cache, ok := scopes.resources[shared]
+	if !ok {
+		cache = pcommon.NewMap()
+		scopes.resources[shared] = cache
+	}
+	scopes.resources[resource] = cache
+}
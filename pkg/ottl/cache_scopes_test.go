@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestCacheScopesNotAvailable(t *testing.T) {
+	_, ok := RequestCache(t.Context())
+	assert.False(t, ok)
+	_, ok = ResourceCache(t.Context(), plog.NewResourceLogs().Resource())
+	assert.False(t, ok)
+}
+
+func TestRequestCache(t *testing.T) {
+	ctx := ContextWithCacheScopes(t.Context())
+	cache, ok := RequestCache(ctx)
+	require.True(t, ok)
+	cache.PutStr("key", "value")
+
+	cache, ok = RequestCache(ctx)
+	require.True(t, ok)
+	assert.Equal(t, map[string]any{"key": "value"}, cache.AsRaw())
+
+	// Each batch has its own cache
+	cache, ok = RequestCache(ContextWithCacheScopes(t.Context()))
+	require.True(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestResourceCache(t *testing.T) {
+	logs := plog.NewLogs()
+	first := logs.ResourceLogs().AppendEmpty()
+	second := logs.ResourceLogs().AppendEmpty()
+
+	ctx := ContextWithCacheScopes(t.Context())
+	cache, ok := ResourceCache(ctx, first.Resource())
+	require.True(t, ok)
+	cache.PutStr("key", "value")
+
+	cache, ok = ResourceCache(ctx, logs.ResourceLogs().At(0).Resource())
+	require.True(t, ok)
+	assert.Equal(t, map[string]any{"key": "value"}, cache.AsRaw())
+
+	cache, ok = ResourceCache(ctx, second.Resource())
+	require.True(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestShareResourceCache(t *testing.T) {
+	logs := plog.NewLogs()
+	original := logs.ResourceLogs().AppendEmpty()
+	first := logs.ResourceLogs().AppendEmpty()
+	second := logs.ResourceLogs().AppendEmpty()
+
+	ShareResourceCache(t.Context(), first.Resource(), original.Resource())
+
+	ctx := ContextWithCacheScopes(t.Context())
+	ShareResourceCache(ctx, first.Resource(), original.Resource())
+	ShareResourceCache(ctx, second.Resource(), original.Resource())
+	cache, ok := ResourceCache(ctx, first.Resource())
+	require.True(t, ok)
+	cache.PutStr("key", "value")
+
+	for _, rl := range []plog.ResourceLogs{original, second} {
+		cache, ok = ResourceCache(ctx, rl.Resource())
+		require.True(t, ok)
+		assert.Equal(t, map[string]any{"key": "value"}, cache.AsRaw())
+	}
+}
+
+func TestCacheScopesConcurrentAccess(t *testing.T) {
+	logs := plog.NewLogs()
+	resource := logs.ResourceLogs().AppendEmpty().Resource()
+	ctx := ContextWithCacheScopes(t.Context())
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, ok := RequestCache(ctx)
+			assert.True(t, ok)
+			_, ok = ResourceCache(ctx, resource)
+			assert.True(t, ok)
+		}()
+	}
+	wg.Wait()
+
+	cache, _ := ResourceCache(ctx, resource)
+	cache.PutStr("key", "value")
+	cache, _ = ResourceCache(ctx, resource)
+	assert.Equal(t, 1, cache.Len())
+}
@@ -5,6 +5,7 @@ package ctxcache // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"context"
+	"fmt"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
@@ -14,9 +15,51 @@ import (
 
 const Name = "cache"
 
+// The names of the contexts whose cache is shared by the telemetry of their lower contexts: the resource cache is
+// shared by all the telemetry of a resource, and the request cache by all the telemetry of the batch. They must
+// match the names of the resource and request contexts.
+const (
+	ResourceScope = "resource"
+	RequestScope  = "request"
+)
+
 type Getter[K any] func(K) pcommon.Map
 
+// ScopedGetter returns the shared cache of the scope of tCtx from the caches held by ctx, and false if ctx
+// doesn't hold them.
+type ScopedGetter[K any] func(ctx context.Context, tCtx K) (pcommon.Map, bool)
+
+// IsScope returns whether the cache of the context is shared by the telemetry of its lower contexts.
+func IsScope(contextName string) bool {
+	return contextName == ResourceScope || contextName == RequestScope
+}
+
 func PathExpressionParser[K any](cacheGetter Getter[K]) ottl.PathExpressionParser[K] {
+	return pathExpressionParser(func(_ context.Context, tCtx K) (pcommon.Map, error) {
+		return cacheGetter(tCtx), nil
+	})
+}
+
+// ScopedPathExpressionParser returns the parser of the paths of the shared cache of the scope. Parsing them
+// returns an error when the parser wasn't created with the ottl.EnableCacheScopes option, and accessing them
+// returns an error when the component executing the statements doesn't provide the shared caches.
+func ScopedPathExpressionParser[K any](scope string, cacheGetter ScopedGetter[K]) ottl.PathExpressionParser[K] {
+	parser := pathExpressionParser(func(ctx context.Context, tCtx K) (pcommon.Map, error) {
+		cache, ok := cacheGetter(ctx, tCtx)
+		if !ok {
+			return pcommon.Map{}, fmt.Errorf("%s.%s is not available, the component executing the statements doesn't provide the shared caches", scope, Name)
+		}
+		return cache, nil
+	})
+	return func(path ottl.Path[K]) (ottl.GetSetter[K], error) {
+		if !ottl.CacheScopesEnabled(path) {
+			return nil, fmt.Errorf("%s.%s is not supported, the component executing the statements doesn't provide the shared caches", scope, Name)
+		}
+		return parser(path)
+	}
+}
+
+func pathExpressionParser[K any](cacheGetter func(context.Context, K) (pcommon.Map, error)) ottl.PathExpressionParser[K] {
 	return func(path ottl.Path[K]) (ottl.GetSetter[K], error) {
 		if path.Keys() == nil {
 			return accessCache(cacheGetter), nil
@@ -25,24 +68,40 @@ func PathExpressionParser[K any](cacheGetter Getter[K]) ottl.PathExpressionParse
 	}
 }
 
-func accessCache[K any](cacheGetter Getter[K]) ottl.StandardGetSetter[K] {
+func accessCache[K any](cacheGetter func(context.Context, K) (pcommon.Map, error)) ottl.StandardGetSetter[K] {
 	return ottl.StandardGetSetter[K]{
-		Getter: func(_ context.Context, tCtx K) (any, error) {
-			return cacheGetter(tCtx), nil
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			cache, err := cacheGetter(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			return cache, nil
 		},
-		Setter: func(_ context.Context, tCtx K, val any) error {
-			return ctxutil.SetMap(cacheGetter(tCtx), val)
+		Setter: func(ctx context.Context, tCtx K, val any) error {
+			cache, err := cacheGetter(ctx, tCtx)
+			if err != nil {
+				return err
+			}
+			return ctxutil.SetMap(cache, val)
 		},
 	}
 }
 
-func accessCacheKey[K any](cacheGetter Getter[K], key []ottl.Key[K]) ottl.StandardGetSetter[K] {
+func accessCacheKey[K any](cacheGetter func(context.Context, K) (pcommon.Map, error), key []ottl.Key[K]) ottl.StandardGetSetter[K] {
 	return ottl.StandardGetSetter[K]{
 		Getter: func(ctx context.Context, tCtx K) (any, error) {
-			return ctxutil.GetMapValue(ctx, tCtx, cacheGetter(tCtx), key)
+			cache, err := cacheGetter(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			return ctxutil.GetMapValue(ctx, tCtx, cache, key)
 		},
 		Setter: func(ctx context.Context, tCtx K, val any) error {
-			return ctxutil.SetMapValue(ctx, tCtx, cacheGetter(tCtx), key, val)
+			cache, err := cacheGetter(ctx, tCtx)
+			if err != nil {
+				return err
+			}
+			return ctxutil.SetMapValue(ctx, tCtx, cache, key, val)
 		},
 	}
 }
@@ -4,6 +4,7 @@
 package ctxcache
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -200,3 +201,58 @@ func newTestContext(cache pcommon.Map) testContext {
 		cache: cache,
 	}
 }
+
+func Test_ScopedPathExpressionParser(t *testing.T) {
+	cache := pcommon.NewMap()
+	cache.PutStr("key", "value")
+	parser := ScopedPathExpressionParser(RequestScope, func(ctx context.Context, _ testContext) (pcommon.Map, bool) {
+		if ctx.Value(testCacheKey{}) == nil {
+			return pcommon.Map{}, false
+		}
+		return cache, true
+	})
+	path := &pathtest.Path[testContext]{
+		N: "cache",
+		KeySlice: []ottl.Key[testContext]{
+			&pathtest.Key[testContext]{
+				S: ottltest.Strp("key"),
+			},
+		},
+		CacheScopes: true,
+	}
+	getter, err := parser(path)
+	require.NoError(t, err)
+
+	t.Run("cache scopes not enabled", func(t *testing.T) {
+		_, err := parser(&pathtest.Path[testContext]{N: "cache"})
+		assert.EqualError(t, err, "request.cache is not supported, the component executing the statements doesn't provide the shared caches")
+	})
+
+	t.Run("shared cache available", func(t *testing.T) {
+		ctx := context.WithValue(t.Context(), testCacheKey{}, true)
+		val, err := getter.Get(ctx, testContext{})
+		require.NoError(t, err)
+		assert.Equal(t, "value", val)
+
+		require.NoError(t, getter.Set(ctx, testContext{}, "new value"))
+		v, ok := cache.Get("key")
+		require.True(t, ok)
+		assert.Equal(t, "new value", v.Str())
+	})
+
+	t.Run("shared cache not available", func(t *testing.T) {
+		_, err := getter.Get(t.Context(), testContext{})
+		assert.EqualError(t, err, "request.cache is not available, the component executing the statements doesn't provide the shared caches")
+		err = getter.Set(t.Context(), testContext{}, "new value")
+		assert.EqualError(t, err, "request.cache is not available, the component executing the statements doesn't provide the shared caches")
+	})
+}
+
+func Test_IsScope(t *testing.T) {
+	assert.True(t, IsScope("resource"))
+	assert.True(t, IsScope("request"))
+	assert.False(t, IsScope("scope"))
+	assert.False(t, IsScope("log"))
+}
+
+type testCacheKey struct{}
@@ -60,12 +60,15 @@ func PathExpressionParser[K any](
 			}
 		}
 
-		// Allow cache access only on this context
+		// Allow cache access only on this context, and on the contexts sharing their cache with the lower contexts,
+		// whose parsers provide the shared cache.
 		if path.Name() == ctxcache.Name {
 			if pathContext == contextName {
 				return ctxcache.PathExpressionParser(cacheGetter)(path)
 			}
-			return nil, ctxcache.NewError(contextName, pathContext, fullPath)
+			if !ctxcache.IsScope(pathContext) {
+				return nil, ctxcache.NewError(contextName, pathContext, fullPath)
+			}
 		}
 
 		parser, ok := contextParsers[pathContext]
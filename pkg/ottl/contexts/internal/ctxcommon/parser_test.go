@@ -80,6 +80,9 @@ func TestPathExpressionParser(t *testing.T) {
 		otherContextName: func(_ ottl.Path[testContext]) (ottl.GetSetter[testContext], error) {
 			return &testGetSetter{value: "other-context-value"}, nil
 		},
+		ctxcache.ResourceScope: func(_ ottl.Path[testContext]) (ottl.GetSetter[testContext], error) {
+			return &testGetSetter{value: "resource-context-value"}, nil
+		},
 	}
 
 	parser := PathExpressionParser(
@@ -125,6 +128,17 @@ func TestPathExpressionParser(t *testing.T) {
 			wantErr:     true,
 			errContains: "access to cache must be performed using the same context",
 		},
+		{
+			name: "cache access from shared scope context",
+			path: &testPath{
+				ctx:      ctxcache.ResourceScope,
+				pathName: ctxcache.Name,
+				pathStr:  ctxcache.ResourceScope + "." + ctxcache.Name,
+			},
+			expectedType:  "string",
+			expectedValue: "resource-context-value",
+			wantErr:       false,
+		},
 		{
 			name: "valid path with current context",
 			path: &testPath{
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxrequest // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxrequest"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/clientutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcache"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxutil"
+)
+
+const (
+	Name   = "request"
+	DocRef = "https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/pkg/ottl/contexts#request"
+)
+
+// valuesSeparator joins the values of the metadata keys and auth attributes having several values,
+// like the attributes processor does for the values read from the context.
+const valuesSeparator = ";"
+
+var errReadOnly = errors.New("the request paths are read-only")
+
+// PathGetSetter returns the read-only accessors of the information of the request that carried the
+// telemetry, as set by the receivers in the client.Info of the context, and the accessors of the cache
+// shared by all the telemetry of the batch.
+func PathGetSetter[K any](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	if path == nil {
+		return nil, ctxerror.New("nil", "nil", Name, DocRef)
+	}
+	switch path.Name() {
+	case "metadata":
+		if path.Keys() == nil {
+			return accessMetadata[K](), nil
+		}
+		return accessMetadataKey(path)
+	case "auth":
+		if path.Keys() == nil {
+			return accessAuth[K](), nil
+		}
+		return accessAuthKey(path)
+	case "client_address":
+		return accessClientAddress[K](), nil
+	case ctxcache.Name:
+		return ctxcache.ScopedPathExpressionParser(Name, getRequestCache[K])(path)
+	default:
+		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
+	}
+}
+
+// getRequestCache returns the cache shared by all the telemetry of the batch.
+func getRequestCache[K any](ctx context.Context, _ K) (pcommon.Map, bool) {
+	return ottl.RequestCache(ctx)
+}
+
+func accessMetadata[K any]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			md := client.FromContext(ctx).Metadata
+			m := pcommon.NewMap()
+			for key := range md.Keys() {
+				m.PutStr(key, strings.Join(md.Get(key), valuesSeparator))
+			}
+			return m, nil
+		},
+		Setter: readOnly[K],
+	}
+}
+
+func accessMetadataKey[K any](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	keys := path.Keys()
+	if len(keys) > 1 {
+		return nil, fmt.Errorf("cannot index the value of %q: the request metadata values are strings", path.String())
+	}
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			key, err := ctxutil.GetMapKeyName(ctx, tCtx, keys[0])
+			if err != nil {
+				return nil, err
+			}
+			return joinValues(client.FromContext(ctx).Metadata.Get(*key)), nil
+		},
+		Setter: readOnly[K],
+	}, nil
+}
+
+func accessAuth[K any]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			m := pcommon.NewMap()
+			auth := client.FromContext(ctx).Auth
+			if auth == nil {
+				return m, nil
+			}
+			for _, name := range auth.GetAttributeNames() {
+				if val := authValue(auth, name); val != nil {
+					m.PutStr(name, *val)
+				}
+			}
+			return m, nil
+		},
+		Setter: readOnly[K],
+	}
+}
+
+func accessAuthKey[K any](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	keys := path.Keys()
+	if len(keys) > 1 {
+		return nil, fmt.Errorf("cannot index the value of %q: the request auth attributes are strings", path.String())
+	}
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			name, err := ctxutil.GetMapKeyName(ctx, tCtx, keys[0])
+			if err != nil {
+				return nil, err
+			}
+			auth := client.FromContext(ctx).Auth
+			if auth == nil {
+				return nil, nil
+			}
+			if val := authValue(auth, *name); val != nil {
+				return *val, nil
+			}
+			return nil, nil
+		},
+		Setter: readOnly[K],
+	}, nil
+}
+
+func accessClientAddress[K any]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			if addr := clientutil.Address(client.FromContext(ctx)); addr != "" {
+				return addr, nil
+			}
+			return nil, nil
+		},
+		Setter: readOnly[K],
+	}
+}
+
+// authValue returns the value of the given auth attribute, or nil if it is not set or is neither a
+// string nor a slice of strings.
+func authValue(auth client.AuthData, name string) *string {
+	var val string
+	switch attr := auth.GetAttribute(name).(type) {
+	case string:
+		val = attr
+	case []string:
+		if len(attr) == 0 {
+			return nil
+		}
+		val = strings.Join(attr, valuesSeparator)
+	default:
+		return nil
+	}
+	return &val
+}
+
+func joinValues(vals []string) any {
+	if len(vals) == 0 {
+		return nil
+	}
+	return strings.Join(vals, valuesSeparator)
+}
+
+func readOnly[K any](context.Context, K, any) error {
+	return errReadOnly
+}
@@ -0,0 +1,215 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxrequest_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcache"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxrequest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+type testAuthData map[string]any
+
+func (a testAuthData) GetAttribute(name string) any {
+	return a[name]
+}
+
+func (a testAuthData) GetAttributeNames() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestPathGetSetter(t *testing.T) {
+	ctx := client.NewContext(t.Context(), client.Info{
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4317},
+		Metadata: client.NewMetadata(map[string][]string{
+			"x-tenant": {"acme"},
+			"x-team":   {"a", "b"},
+		}),
+		Auth: testAuthData{
+			"subject": "user@acme.org",
+			"groups":  []string{"admin", "dev"},
+			"expiry":  42,
+		},
+	})
+
+	expectedMetadata := pcommon.NewMap()
+	expectedMetadata.PutStr("x-tenant", "acme")
+	expectedMetadata.PutStr("x-team", "a;b")
+
+	expectedAuth := pcommon.NewMap()
+	expectedAuth.PutStr("subject", "user@acme.org")
+	expectedAuth.PutStr("groups", "admin;dev")
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		path     ottl.Path[any]
+		expected any
+	}{
+		{
+			name:     "metadata",
+			ctx:      ctx,
+			path:     &pathtest.Path[any]{N: "metadata"},
+			expected: expectedMetadata,
+		},
+		{
+			name: "metadata key",
+			ctx:  ctx,
+			path: &pathtest.Path[any]{
+				N:        "metadata",
+				KeySlice: []ottl.Key[any]{&pathtest.Key[any]{S: ottltest.Strp("x-tenant")}},
+			},
+			expected: "acme",
+		},
+		{
+			name: "metadata key with several values",
+			ctx:  ctx,
+			path: &pathtest.Path[any]{
+				N:        "metadata",
+				KeySlice: []ottl.Key[any]{&pathtest.Key[any]{S: ottltest.Strp("x-team")}},
+			},
+			expected: "a;b",
+		},
+		{
+			name: "missing metadata key",
+			ctx:  ctx,
+			path: &pathtest.Path[any]{
+				N:        "metadata",
+				KeySlice: []ottl.Key[any]{&pathtest.Key[any]{S: ottltest.Strp("x-missing")}},
+			},
+			expected: nil,
+		},
+		{
+			name:     "auth",
+			ctx:      ctx,
+			path:     &pathtest.Path[any]{N: "auth"},
+			expected: expectedAuth,
+		},
+		{
+			name: "auth attribute",
+			ctx:  ctx,
+			path: &pathtest.Path[any]{
+				N:        "auth",
+				KeySlice: []ottl.Key[any]{&pathtest.Key[any]{S: ottltest.Strp("subject")}},
+			},
+			expected: "user@acme.org",
+		},
+		{
+			name: "auth attribute with several values",
+			ctx:  ctx,
+			path: &pathtest.Path[any]{
+				N:        "auth",
+				KeySlice: []ottl.Key[any]{&pathtest.Key[any]{S: ottltest.Strp("groups")}},
+			},
+			expected: "admin;dev",
+		},
+		{
+			name: "auth attribute of unsupported type",
+			ctx:  ctx,
+			path: &pathtest.Path[any]{
+				N:        "auth",
+				KeySlice: []ottl.Key[any]{&pathtest.Key[any]{S: ottltest.Strp("expiry")}},
+			},
+			expected: nil,
+		},
+		{
+			name: "auth attribute without auth",
+			ctx:  t.Context(),
+			path: &pathtest.Path[any]{
+				N:        "auth",
+				KeySlice: []ottl.Key[any]{&pathtest.Key[any]{S: ottltest.Strp("subject")}},
+			},
+			expected: nil,
+		},
+		{
+			name:     "client_address",
+			ctx:      ctx,
+			path:     &pathtest.Path[any]{N: "client_address"},
+			expected: "10.0.0.1",
+		},
+		{
+			name:     "client_address without client",
+			ctx:      t.Context(),
+			path:     &pathtest.Path[any]{N: "client_address"},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := ctxrequest.PathGetSetter(tt.path)
+			require.NoError(t, err)
+
+			got, err := accessor.Get(tt.ctx, nil)
+			require.NoError(t, err)
+			// The metadata keys and the auth attribute names aren't ordered.
+			if expected, ok := tt.expected.(pcommon.Map); ok {
+				require.IsType(t, pcommon.Map{}, got)
+				assert.Equal(t, expected.AsRaw(), got.(pcommon.Map).AsRaw())
+				return
+			}
+			assert.Equal(t, tt.expected, got)
+
+			assert.Error(t, accessor.Set(tt.ctx, nil, "value"))
+		})
+	}
+}
+
+func TestRequestCache(t *testing.T) {
+	assert.Equal(t, ctxrequest.Name, ctxcache.RequestScope)
+
+	path := &pathtest.Path[any]{
+		N: "cache",
+		KeySlice: []ottl.Key[any]{
+			&pathtest.Key[any]{S: ottltest.Strp("key")},
+		},
+	}
+	_, err := ctxrequest.PathGetSetter(path)
+	assert.ErrorContains(t, err, "request.cache is not supported")
+
+	path.CacheScopes = true
+	accessor, err := ctxrequest.PathGetSetter(path)
+	require.NoError(t, err)
+
+	ctx := ottl.ContextWithCacheScopes(t.Context())
+	require.NoError(t, accessor.Set(ctx, nil, "value"))
+	got, err := accessor.Get(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "value", got)
+
+	got, err = accessor.Get(ottl.ContextWithCacheScopes(t.Context()), nil)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = accessor.Get(t.Context(), nil)
+	assert.ErrorContains(t, err, "request.cache is not available")
+}
+
+func TestPathGetSetterInvalid(t *testing.T) {
+	_, err := ctxrequest.PathGetSetter(&pathtest.Path[any]{N: "unknown"})
+	assert.Error(t, err)
+
+	_, err = ctxrequest.PathGetSetter(&pathtest.Path[any]{
+		N: "metadata",
+		KeySlice: []ottl.Key[any]{
+			&pathtest.Key[any]{S: ottltest.Strp("x-tenant")},
+			&pathtest.Key[any]{I: ottltest.Intp(0)},
+		},
+	})
+	assert.Error(t, err)
+}
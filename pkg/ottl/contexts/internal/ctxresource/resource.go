@@ -6,7 +6,10 @@ package ctxresource // import "github.com/open-telemetry/opentelemetry-collector
 import (
 	"context"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxutil"
 )
@@ -25,11 +28,18 @@ func PathGetSetter[K Context](path ottl.Path[K]) (ottl.GetSetter[K], error) {
 		return accessResourceDroppedAttributesCount[K](), nil
 	case "schema_url":
 		return accessResourceSchemaURLItem[K](), nil
+	case ctxcache.Name:
+		return ctxcache.ScopedPathExpressionParser(Name, getResourceCache[K])(path)
 	default:
 		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
 	}
 }
 
+// getResourceCache returns the cache shared by all the telemetry of the resource of the transform context.
+func getResourceCache[K Context](ctx context.Context, tCtx K) (pcommon.Map, bool) {
+	return ottl.ResourceCache(ctx, tCtx.GetResource())
+}
+
 func accessResourceAttributes[K Context]() ottl.StandardGetSetter[K] {
 	return ottl.StandardGetSetter[K]{
 		Getter: func(_ context.Context, tCtx K) (any, error) {
@@ -11,6 +11,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcommon"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
@@ -345,6 +346,40 @@ func TestPathGetSetter(t *testing.T) {
 	}
 }
 
+func TestResourceCache(t *testing.T) {
+	assert.Equal(t, ctxresource.Name, ctxcache.ResourceScope)
+
+	path := &pathtest.Path[*testContext]{
+		N: "cache",
+		KeySlice: []ottl.Key[*testContext]{
+			&pathtest.Key[*testContext]{
+				S: ottltest.Strp("key"),
+			},
+		},
+	}
+	_, err := ctxresource.PathGetSetter(path)
+	assert.ErrorContains(t, err, "resource.cache is not supported")
+
+	path.CacheScopes = true
+	accessor, err := ctxresource.PathGetSetter(path)
+	require.NoError(t, err)
+
+	first := newTestContext(createResource())
+	second := newTestContext(pcommon.NewResource())
+	ctx := ottl.ContextWithCacheScopes(t.Context())
+	require.NoError(t, accessor.Set(ctx, first, "value"))
+
+	got, err := accessor.Get(ctx, newTestContext(first.GetResource()))
+	require.NoError(t, err)
+	assert.Equal(t, "value", got)
+	got, err = accessor.Get(ctx, second)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = accessor.Get(t.Context(), first)
+	assert.ErrorContains(t, err, "resource.cache is not available")
+}
+
 func createResource() pcommon.Resource {
 	resource := pcommon.NewResource()
 	resource.Attributes().PutStr("str", "val")
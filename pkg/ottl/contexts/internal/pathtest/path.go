@@ -12,11 +12,12 @@ import (
 var _ ottl.Path[any] = &Path[any]{}
 
 type Path[K any] struct {
-	C        string
-	N        string
-	KeySlice []ottl.Key[K]
-	NextPath *Path[K]
-	FullPath string
+	C           string
+	N           string
+	KeySlice    []ottl.Key[K]
+	NextPath    *Path[K]
+	FullPath    string
+	CacheScopes bool
 }
 
 func (p *Path[K]) Name() string {
@@ -45,6 +46,10 @@ func (p *Path[K]) String() string {
 	return p.N
 }
 
+func (p *Path[K]) CacheScopesEnabled() bool {
+	return p.CacheScopes
+}
+
 var _ ottl.Key[any] = &Key[any]{}
 
 type Key[K any] struct {
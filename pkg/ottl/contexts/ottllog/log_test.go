@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 
@@ -935,3 +936,21 @@ func Test_ParseEnum_False(t *testing.T) {
 		})
 	}
 }
+
+func Test_SharedCachePathsRequireCacheScopes(t *testing.T) {
+	conditions := []string{`resource.cache["key"] == nil`, `request.cache["key"] == nil`}
+
+	parser, err := NewParser(nil, componenttest.NewNopTelemetrySettings(), EnablePathContextNames())
+	require.NoError(t, err)
+	for _, condition := range conditions {
+		_, err = parser.ParseCondition(condition)
+		assert.ErrorContains(t, err, "cache is not supported")
+	}
+
+	parser, err = NewParser(nil, componenttest.NewNopTelemetrySettings(), EnablePathContextNames(), ottl.EnableCacheScopes[*TransformContext]())
+	require.NoError(t, err)
+	for _, condition := range conditions {
+		_, err = parser.ParseCondition(condition)
+		assert.NoError(t, err)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcommon"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxprofile"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxrequest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/logging"
@@ -126,6 +127,7 @@ func EnablePathContextNames() ottl.Option[TransformContext] {
 			ctxscope.LegacyName,
 			ctxscope.Name,
 			ctxresource.Name,
+			ctxrequest.Name,
 		})(p)
 	}
 }
@@ -189,5 +191,6 @@ func pathExpressionParser(cacheGetter ctxcache.Getter[TransformContext]) ottl.Pa
 			ctxscope.Name:       ctxscope.PathGetSetter[TransformContext],
 			ctxscope.LegacyName: ctxscope.PathGetSetter[TransformContext],
 			ctxprofile.Name:     ctxprofile.PathGetSetter[TransformContext],
+			ctxrequest.Name:     ctxrequest.PathGetSetter[TransformContext],
 		})
 }
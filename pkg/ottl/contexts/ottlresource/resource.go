@@ -14,6 +14,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxrequest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/logging"
 )
@@ -37,19 +38,30 @@ var (
 type TransformContext struct {
 	resource      pcommon.Resource
 	cache         pcommon.Map
+	sharedCache   *pcommon.Map
 	schemaURLItem ctxcommon.SchemaURLItem
 }
 
 // MarshalLogObject serializes the TransformContext into a zapcore.ObjectEncoder for logging.
 func (tCtx *TransformContext) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
 	err := encoder.AddObject("resource", logging.Resource(tCtx.resource))
-	err = errors.Join(err, encoder.AddObject("cache", logging.Map(tCtx.cache)))
+	err = errors.Join(err, encoder.AddObject("cache", logging.Map(getCache(tCtx))))
 	return err
 }
 
 // TransformContextOption represents an option for configuring a TransformContext.
 type TransformContextOption func(*TransformContext)
 
+// WithCache sets the cache of the TransformContext to the provided map, e.g. to the cache shared by the lower
+// contexts of the resource returned by ottl.ResourceCache. The map isn't cleared when the context is closed.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithCache(cache *pcommon.Map) TransformContextOption {
+	return func(tCtx *TransformContext) {
+		tCtx.sharedCache = cache
+	}
+}
+
 // NewTransformContextPtr returns a new TransformContext with the provided parameters from a pool of contexts.
 // Caller must call TransformContext.Close on the returned TransformContext.
 func NewTransformContextPtr(resource pcommon.Resource, schemaURLItem ctxcommon.SchemaURLItem, options ...TransformContextOption) *TransformContext {
@@ -67,6 +79,7 @@ func NewTransformContextPtr(resource pcommon.Resource, schemaURLItem ctxcommon.S
 func (tCtx *TransformContext) Close() {
 	tCtx.resource = pcommon.Resource{}
 	tCtx.cache.Clear()
+	tCtx.sharedCache = nil
 	tCtx.schemaURLItem = nil
 	tcPool.Put(tCtx)
 }
@@ -88,7 +101,10 @@ func (tCtx *TransformContext) GetResourceSchemaURLItem() ctxcommon.SchemaURLItem
 // Experimental: *NOTE* this option is subject to change or removal in the future.
 func EnablePathContextNames() ottl.Option[*TransformContext] {
 	return func(p *ottl.Parser[*TransformContext]) {
-		ottl.WithPathContextNames[*TransformContext]([]string{ContextName})(p)
+		ottl.WithPathContextNames[*TransformContext]([]string{
+			ContextName,
+			ctxrequest.Name,
+		})(p)
 	}
 }
 
@@ -150,6 +166,9 @@ func parseEnum(_ *ottl.EnumSymbol) (*ottl.Enum, error) {
 }
 
 func getCache(tCtx *TransformContext) pcommon.Map {
+	if tCtx.sharedCache != nil {
+		return *tCtx.sharedCache
+	}
 	return tCtx.cache
 }
 
@@ -160,5 +179,6 @@ func pathExpressionParser(cacheGetter ctxcache.Getter[*TransformContext]) ottl.P
 		cacheGetter,
 		map[string]ottl.PathExpressionParser[*TransformContext]{
 			ctxresource.Name: ctxresource.PathGetSetter[*TransformContext],
+			ctxrequest.Name:  ctxrequest.PathGetSetter[*TransformContext],
 		})
 }
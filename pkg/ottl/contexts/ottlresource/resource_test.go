@@ -400,6 +400,30 @@ func Test_newPathGetSetter(t *testing.T) {
 	}
 }
 
+func TestWithCache(t *testing.T) {
+	sharedCache := pcommon.NewMap()
+	tCtx := NewTransformContextPtr(createTelemetry(), pmetric.NewResourceMetrics(), WithCache(&sharedCache))
+	accessor, err := pathExpressionParser(getCache)(&pathtest.Path[*TransformContext]{
+		N: "cache",
+		KeySlice: []ottl.Key[*TransformContext]{
+			&pathtest.Key[*TransformContext]{
+				S: ottltest.Strp("key"),
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, accessor.Set(t.Context(), tCtx, "value"))
+	tCtx.Close()
+
+	// The shared cache outlives the context, which gets back its own cache
+	assert.Equal(t, map[string]any{"key": "value"}, sharedCache.AsRaw())
+	tCtx = NewTransformContextPtr(createTelemetry(), pmetric.NewResourceMetrics())
+	defer tCtx.Close()
+	got, err := accessor.Get(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
 func createTelemetry() pcommon.Resource {
 	resource := pcommon.NewResource()
 
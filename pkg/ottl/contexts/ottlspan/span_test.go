@@ -16,7 +16,9 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxrequest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
@@ -768,21 +770,52 @@ func Test_newPathGetSetter_higherContextPath(t *testing.T) {
 func TestHigherContextCacheAccessError(t *testing.T) {
 	path := &pathtest.Path[*TransformContext]{
 		N: "cache",
-		C: ctxresource.Name,
+		C: ctxscope.Name,
 		KeySlice: []ottl.Key[*TransformContext]{
 			&pathtest.Key[*TransformContext]{
 				S: ottltest.Strp("key"),
 			},
 		},
-		FullPath: fmt.Sprintf("%s.cache[key]", ctxresource.Name),
+		FullPath: fmt.Sprintf("%s.cache[key]", ctxscope.Name),
 	}
 
 	_, err := pathExpressionParser(getCache)(path)
 	require.Error(t, err)
-	expectError := fmt.Sprintf(`replace "%s.cache[key]" with "span.cache[key]"`, ctxresource.Name)
+	expectError := fmt.Sprintf(`replace "%s.cache[key]" with "span.cache[key]"`, ctxscope.Name)
 	require.ErrorContains(t, err, expectError)
 }
 
+func TestSharedCacheAccess(t *testing.T) {
+	resourceSpans, scopeSpans, span := createTelemetry()
+	tCtx := NewTransformContextPtr(resourceSpans, scopeSpans, span)
+	defer tCtx.Close()
+	ctx := ottl.ContextWithCacheScopes(t.Context())
+
+	for _, contextName := range []string{ctxresource.Name, ctxrequest.Name} {
+		t.Run(contextName, func(t *testing.T) {
+			path := &pathtest.Path[*TransformContext]{
+				N: "cache",
+				C: contextName,
+				KeySlice: []ottl.Key[*TransformContext]{
+					&pathtest.Key[*TransformContext]{
+						S: ottltest.Strp("key"),
+					},
+				},
+				FullPath:    fmt.Sprintf("%s.cache[key]", contextName),
+				CacheScopes: true,
+			}
+			accessor, err := pathExpressionParser(getCache)(path)
+			require.NoError(t, err)
+
+			require.NoError(t, accessor.Set(ctx, tCtx, contextName))
+			got, err := accessor.Get(ctx, tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, contextName, got)
+			assert.Equal(t, 0, tCtx.cache.Len())
+		})
+	}
+}
+
 func createTelemetry() (ptrace.ResourceSpans, ptrace.ScopeSpans, ptrace.Span) {
 	rs := ptrace.NewResourceSpans()
 
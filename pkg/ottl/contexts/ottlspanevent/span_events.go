@@ -17,6 +17,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxrequest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspan"
@@ -151,6 +152,7 @@ func EnablePathContextNames() ottl.Option[*TransformContext] {
 			ctxresource.Name,
 			ctxscope.LegacyName,
 			ctxscope.Name,
+			ctxrequest.Name,
 		})(p)
 	}
 }
@@ -233,6 +235,7 @@ func pathExpressionParser(cacheGetter ctxcache.Getter[*TransformContext]) ottl.P
 			ctxscope.LegacyName: ctxscope.PathGetSetter[*TransformContext],
 			ctxspan.Name:        ctxspan.PathGetSetter[*TransformContext],
 			ctxspanevent.Name:   spanEventGetSetterWithIndex,
+			ctxrequest.Name:     ctxrequest.PathGetSetter[*TransformContext],
 		})
 }
 
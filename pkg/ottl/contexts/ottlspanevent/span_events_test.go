@@ -15,7 +15,6 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspanevent"
@@ -609,7 +608,6 @@ func Test_setAndGetEventIndex(t *testing.T) {
 
 func TestHigherContextCacheAccessError(t *testing.T) {
 	higherContexts := []string{
-		ctxresource.Name,
 		ctxscope.Name,
 		ctxscope.LegacyName,
 		ctxspan.Name,
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -2107,6 +2108,47 @@ func Test_ProcessSpanEvents(t *testing.T) {
 	}
 }
 
+func Test_e2e_request_context(t *testing.T) {
+	ctx := client.NewContext(t.Context(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-tenant": {"acme"}}),
+	})
+
+	tests := []struct {
+		statement string
+		want      func(tCtx *ottllog.TransformContext)
+	}{
+		{
+			statement: `set(attributes["tenant"], request.metadata["x-tenant"])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("tenant", "acme")
+			},
+		},
+		{
+			statement: `set(attributes["tenant"], "unknown") where request.metadata["x-missing"] == nil`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("tenant", "unknown")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			statements, err := parseStatementWithAndWithoutPathContext(tt.statement)
+			require.NoError(t, err)
+
+			for _, statement := range statements {
+				tCtx := constructLogTransformContext()
+				_, _, _ = statement.Execute(ctx, tCtx)
+
+				exTCtx := constructLogTransformContext()
+				tt.want(exTCtx)
+
+				assert.NoError(t, plogtest.CompareResourceLogs(newResourceLogs(exTCtx), newResourceLogs(tCtx)))
+			}
+		})
+	}
+}
+
 func parseStatementWithAndWithoutPathContext(statement string) ([]*ottl.Statement[*ottllog.TransformContext], error) {
 	settings := componenttest.NewNopTelemetrySettings()
 	parserWithoutPathCtx, err := ottllog.NewParser(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), settings)
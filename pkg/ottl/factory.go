@@ -49,6 +49,7 @@ type factory[K any] struct {
 	name               string
 	args               Arguments
 	createFunctionFunc CreateFunctionFunc[K]
+	deprecationMessage string
 }
 
 //nolint:unused
@@ -66,9 +67,21 @@ func (f *factory[K]) CreateFunction(fCtx FunctionContext, args Arguments) (ExprF
 	return f.createFunctionFunc(fCtx, args)
 }
 
+func (f *factory[K]) deprecation() string {
+	return f.deprecationMessage
+}
+
 // FactoryOption is an option for a Factory
 type FactoryOption[K any] func(factory *factory[K])
 
+// WithDeprecation marks the function as deprecated, with a message telling what to use instead.
+// The deprecated functions can still be used, but are reported by LintStatements and LintConditions.
+func WithDeprecation[K any](message string) FactoryOption[K] {
+	return func(factory *factory[K]) {
+		factory.deprecationMessage = message
+	}
+}
+
 // NewFactory creates a new Factory
 func NewFactory[K any](name string, args Arguments, createFunctionFunc CreateFunctionFunc[K], options ...FactoryOption[K]) Factory[K] {
 	f := &factory[K]{
@@ -97,6 +97,7 @@ func (p *Parser[K]) newPath(path *path) (*basePath[K], error) {
 			keys:         keys,
 			nextPath:     current,
 			originalText: originalText,
+			cacheScopes:  p.cacheScopes,
 		}
 	}
 	current.fetched = true
@@ -171,6 +172,15 @@ type Path[K any] interface {
 
 var _ Path[any] = &basePath[any]{}
 
+// CacheScopesEnabled returns whether the Path can access the caches shared by the lower contexts, that is whether
+// its parser was created with the EnableCacheScopes option.
+//
+// Experimental: *NOTE* this function is subject to change or removal in the future.
+func CacheScopesEnabled[K any](path Path[K]) bool {
+	p, ok := path.(interface{ CacheScopesEnabled() bool })
+	return ok && p.CacheScopesEnabled()
+}
+
 type basePath[K any] struct {
 	context      string
 	name         string
@@ -179,6 +189,7 @@ type basePath[K any] struct {
 	fetched      bool
 	fetchedKeys  bool
 	originalText string
+	cacheScopes  bool
 }
 
 func (p *basePath[K]) Context() string {
@@ -209,6 +220,11 @@ func (p *basePath[K]) String() string {
 	return p.originalText
 }
 
+// CacheScopesEnabled returns whether the parser of this Path was created with the EnableCacheScopes option.
+func (p *basePath[K]) CacheScopesEnabled() bool {
+	return p.cacheScopes
+}
+
 func (p *basePath[K]) isComplete() error {
 	if !p.fetched {
 		return fmt.Errorf("the path section %q was not used by the context - this likely means you are using extra path sections", p.name)
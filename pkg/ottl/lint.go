@@ -0,0 +1,349 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"errors"
+	"fmt"
+)
+
+// LintRule is the name of a rule checked by LintStatements and LintConditions.
+type LintRule string
+
+const (
+	// LintRuleUnusedCondition reports conditions and where clauses which are always true or always false,
+	// making them useless or the statement never executed.
+	LintRuleUnusedCondition LintRule = "unused_condition"
+	// LintRuleAlwaysFalseComparison reports comparisons which can never be true, such as comparisons
+	// between two different literals or ordering comparisons with nil.
+	LintRuleAlwaysFalseComparison LintRule = "always_false_comparison"
+	// LintRuleDeprecatedFunction reports the use of functions created with WithDeprecation.
+	LintRuleDeprecatedFunction LintRule = "deprecated_function"
+	// LintRuleHighCardinality reports statements setting data point attributes to values which are unique
+	// for each data point, creating a new time series every time.
+	LintRuleHighCardinality LintRule = "high_cardinality"
+)
+
+// LintWarning is a potential issue found in an OTTL statement or condition. Unlike parsing errors,
+// lint warnings don't prevent the statement or condition from being parsed and executed.
+type LintWarning struct {
+	// Rule is the rule that reported the warning.
+	Rule LintRule
+	// Source is the statement or condition the warning is about.
+	Source string
+	// Message describes the issue.
+	Message string
+}
+
+func (w LintWarning) String() string {
+	return fmt.Sprintf("%s: %s (%s)", w.Source, w.Message, w.Rule)
+}
+
+// LintOption is an option for LintStatements and LintConditions.
+type LintOption func(*lintOptions)
+
+type lintOptions struct {
+	context string
+}
+
+// WithLintContext sets the context of the paths without explicit context, such as the context configured
+// for a group of statements. Without it, only the paths with an explicit context are known to
+// belong to a context.
+func WithLintContext(context string) LintOption {
+	return func(o *lintOptions) {
+		o.context = context
+	}
+}
+
+// highCardinalityConverters are the converters returning a different value on every call.
+var highCardinalityConverters = map[string]struct{}{
+	"Now":    {},
+	"UUID":   {},
+	"UUIDv7": {},
+}
+
+// highCardinalityDataPointFields are the data point fields which are usually different for every data point.
+var highCardinalityDataPointFields = map[string]struct{}{
+	"time":                 {},
+	"time_unix_nano":       {},
+	"start_time":           {},
+	"start_time_unix_nano": {},
+	"value_double":         {},
+	"value_int":            {},
+}
+
+// dataPointContextName is the name of the data point context, the only one in which attributes define
+// the identity of the time series.
+const dataPointContextName = "datapoint"
+
+// LintStatements checks the given statements for potential issues which are not errors, such as
+// where clauses which are always true, comparisons which are always false, deprecated functions from
+// the given functions, and data point attributes with high cardinality values. It returns the
+// warnings of the statements which could be parsed, and the syntax errors of the others.
+func LintStatements[K any](statements []string, functions map[string]Factory[K], options ...LintOption) ([]LintWarning, error) {
+	l := newLinter(functions, options)
+	var errs []error
+	for _, statement := range statements {
+		parsed, err := parseStatement(statement)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		l.source = statement
+		l.lintStatement(parsed)
+	}
+	return l.warnings, errors.Join(errs...)
+}
+
+// LintConditions checks the given conditions for potential issues which are not errors, such as
+// conditions which are always true, comparisons which are always false and deprecated functions from
+// the given functions. It returns the warnings of the conditions which could be parsed, and the
+// syntax errors of the others.
+func LintConditions[K any](conditions []string, functions map[string]Factory[K], options ...LintOption) ([]LintWarning, error) {
+	l := newLinter(functions, options)
+	var errs []error
+	for _, condition := range conditions {
+		parsed, err := parseCondition(condition)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		l.source = condition
+		l.lintFunctions(parsed.accept)
+		if value, constant := l.lintBooleanExpression(parsed); constant {
+			if value {
+				l.warn(LintRuleUnusedCondition, "the condition is always true")
+			} else {
+				l.warn(LintRuleUnusedCondition, "the condition is always false")
+			}
+		}
+	}
+	return l.warnings, errors.Join(errs...)
+}
+
+// deprecatedFactory is implemented by the factories which may be deprecated.
+type deprecatedFactory interface {
+	deprecation() string
+}
+
+type linter[K any] struct {
+	functions map[string]Factory[K]
+	options   lintOptions
+	source    string
+	warnings  []LintWarning
+}
+
+func newLinter[K any](functions map[string]Factory[K], options []LintOption) *linter[K] {
+	l := &linter[K]{functions: functions}
+	for _, option := range options {
+		option(&l.options)
+	}
+	return l
+}
+
+func (l *linter[K]) warn(rule LintRule, format string, args ...any) {
+	l.warnings = append(l.warnings, LintWarning{
+		Rule:    rule,
+		Source:  l.source,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter[K]) lintStatement(parsed *parsedStatement) {
+	l.lintFunctions(parsed.Editor.accept)
+	l.lintHighCardinality(&parsed.Editor)
+	if parsed.WhereClause == nil {
+		return
+	}
+	l.lintFunctions(parsed.WhereClause.accept)
+	if value, constant := l.lintBooleanExpression(parsed.WhereClause); constant {
+		if value {
+			l.warn(LintRuleUnusedCondition, "the where clause is always true and can be removed")
+		} else {
+			l.warn(LintRuleUnusedCondition, "the where clause is always false, the statement is never executed")
+		}
+	}
+}
+
+// lintFunctions reports the deprecated functions called in the grammar node accepting the visitor.
+func (l *linter[K]) lintFunctions(accept func(grammarVisitor)) {
+	visitor := &grammarFunctionsVisitor{}
+	accept(visitor)
+	for _, name := range visitor.functions {
+		f, ok := l.functions[name]
+		if !ok {
+			continue
+		}
+		if d, ok := f.(deprecatedFactory); ok && d.deprecation() != "" {
+			l.warn(LintRuleDeprecatedFunction, "function %s is deprecated: %s", name, d.deprecation())
+		}
+	}
+}
+
+// lintHighCardinality reports data point attributes set to a high cardinality value.
+func (l *linter[K]) lintHighCardinality(e *editor) {
+	if e.Function != "set" || len(e.Arguments) != 2 {
+		return
+	}
+	target := e.Arguments[0].Value.Literal
+	if target == nil || target.Path == nil || !l.isDataPointPath(target.Path, "attributes") {
+		return
+	}
+
+	visitor := &grammarFunctionsVisitor{}
+	e.Arguments[1].Value.accept(visitor)
+	for _, name := range visitor.functions {
+		if _, ok := highCardinalityConverters[name]; ok {
+			l.warn(LintRuleHighCardinality, "setting data point attributes with %s creates a new time series for every data point", name)
+			return
+		}
+	}
+	for i := range visitor.paths {
+		p := &visitor.paths[i]
+		if _, ok := highCardinalityDataPointFields[p.Fields[0].Name]; ok && l.isDataPointPath(p, p.Fields[0].Name) {
+			l.warn(LintRuleHighCardinality, "setting data point attributes with %s creates a new time series for every data point", p.Fields[0].Name)
+			return
+		}
+	}
+}
+
+func (l *linter[K]) isDataPointPath(p *path, field string) bool {
+	if len(p.Fields) == 0 || p.Fields[0].Name != field {
+		return false
+	}
+	if p.Context != "" {
+		return p.Context == dataPointContextName
+	}
+	return l.options.context == dataPointContextName
+}
+
+// lintBooleanExpression reports the comparisons which are always false, and returns the value of the
+// expression if it is constant.
+func (l *linter[K]) lintBooleanExpression(be *booleanExpression) (value, constant bool) {
+	value, constant = l.lintTerm(be.Left)
+	for _, r := range be.Right {
+		rv, rc := l.lintTerm(r.Term)
+		switch {
+		case (constant && value) || (rc && rv):
+			value, constant = true, true
+		case constant && rc:
+			value = false
+		default:
+			value, constant = false, false
+		}
+	}
+	return value, constant
+}
+
+func (l *linter[K]) lintTerm(t *term) (value, constant bool) {
+	value, constant = l.lintBooleanValue(t.Left)
+	for _, r := range t.Right {
+		rv, rc := l.lintBooleanValue(r.Value)
+		switch {
+		case (constant && !value) || (rc && !rv):
+			value, constant = false, true
+		case constant && rc:
+			value = true
+		default:
+			value, constant = false, false
+		}
+	}
+	return value, constant
+}
+
+func (l *linter[K]) lintBooleanValue(b *booleanValue) (value, constant bool) {
+	switch {
+	case b.Comparison != nil:
+		value, constant = l.lintComparison(b.Comparison)
+	case b.ConstExpr != nil && b.ConstExpr.Boolean != nil:
+		value, constant = bool(*b.ConstExpr.Boolean), true
+	case b.SubExpr != nil:
+		value, constant = l.lintBooleanExpression(b.SubExpr)
+	}
+	if constant && b.Negation != nil {
+		value = !value
+	}
+	return value, constant
+}
+
+func (l *linter[K]) lintComparison(c *comparison) (value, constant bool) {
+	left, leftLiteral := literalValue(c.Left)
+	right, rightLiteral := literalValue(c.Right)
+	switch {
+	case leftLiteral && rightLiteral:
+		value = (&ottlValueComparator{}).compare(left, right, c.Op)
+		if !value {
+			l.warn(LintRuleAlwaysFalseComparison, "the comparison of %s and %s with %s is always false", formatLiteral(left), formatLiteral(right), compareOpSymbol(c.Op))
+		}
+		return value, true
+	case (c.Op == lt || c.Op == gt) && ((leftLiteral && left == nil) || (rightLiteral && right == nil)):
+		l.warn(LintRuleAlwaysFalseComparison, "the comparison with nil with %s is always false", compareOpSymbol(c.Op))
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// literalValue returns the value of the given grammar value if it is a literal.
+func literalValue(v value) (any, bool) {
+	switch {
+	case v.IsNil != nil:
+		return nil, true
+	case v.String != nil:
+		return *v.String, true
+	case v.Bool != nil:
+		return bool(*v.Bool), true
+	case v.Bytes != nil:
+		return []byte(*v.Bytes), true
+	case v.Literal != nil && v.Literal.Int != nil:
+		return *v.Literal.Int, true
+	case v.Literal != nil && v.Literal.Float != nil:
+		return *v.Literal.Float, true
+	default:
+		return nil, false
+	}
+}
+
+func compareOpSymbol(op compareOp) string {
+	for symbol, o := range compareOpTable {
+		if o == op {
+			return symbol
+		}
+	}
+	return op.String()
+}
+
+func formatLiteral(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return fmt.Sprintf("%q", val)
+	case []byte:
+		return fmt.Sprintf("0x%x", val)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// grammarFunctionsVisitor is used to extract the functions called and the paths used in a grammar node.
+type grammarFunctionsVisitor struct {
+	functions []string
+	paths     []path
+}
+
+func (*grammarFunctionsVisitor) visitValue(*value)                     {}
+func (*grammarFunctionsVisitor) visitMathExprLiteral(*mathExprLiteral) {}
+
+func (v *grammarFunctionsVisitor) visitEditor(e *editor) {
+	v.functions = append(v.functions, e.Function)
+}
+
+func (v *grammarFunctionsVisitor) visitConverter(c *converter) {
+	v.functions = append(v.functions, c.Function)
+}
+
+func (v *grammarFunctionsVisitor) visitPath(p *path) {
+	v.paths = append(v.paths, *p)
+}
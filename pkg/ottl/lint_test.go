@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lintTestFunctions() map[string]Factory[any] {
+	create := func(FunctionContext, Arguments) (ExprFunc[any], error) { return nil, nil }
+	return CreateFactoryMap(
+		NewFactory[any]("set", nil, create),
+		NewFactory[any]("Concat", nil, create),
+		NewFactory[any]("OldFunc", nil, create, WithDeprecation[any]("use NewFunc instead")),
+		NewFactory[any]("old_editor", nil, create, WithDeprecation[any]("use set instead")),
+	)
+}
+
+func Test_LintStatements(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		options   []LintOption
+		expected  []LintWarning
+	}{
+		{
+			name:      "no warnings",
+			statement: `set(attributes["a"], "b") where name == "foo" and attributes["c"] != nil`,
+		},
+		{
+			name:      "where clause always true",
+			statement: `set(attributes["a"], "b") where true`,
+			expected: []LintWarning{
+				{Rule: LintRuleUnusedCondition, Message: "the where clause is always true and can be removed"},
+			},
+		},
+		{
+			name:      "where clause always true with or",
+			statement: `set(attributes["a"], "b") where name == "foo" or 1 == 1`,
+			expected: []LintWarning{
+				{Rule: LintRuleUnusedCondition, Message: "the where clause is always true and can be removed"},
+			},
+		},
+		{
+			name:      "where clause always false",
+			statement: `set(attributes["a"], "b") where name == "foo" and "a" == 1`,
+			expected: []LintWarning{
+				{Rule: LintRuleAlwaysFalseComparison, Message: `the comparison of "a" and 1 with == is always false`},
+				{Rule: LintRuleUnusedCondition, Message: "the where clause is always false, the statement is never executed"},
+			},
+		},
+		{
+			name:      "negated where clause",
+			statement: `set(attributes["a"], "b") where not (false)`,
+			expected: []LintWarning{
+				{Rule: LintRuleUnusedCondition, Message: "the where clause is always true and can be removed"},
+			},
+		},
+		{
+			name:      "ordering comparison with nil",
+			statement: `set(attributes["a"], "b") where attributes["c"] > nil or name == "foo"`,
+			expected: []LintWarning{
+				{Rule: LintRuleAlwaysFalseComparison, Message: "the comparison with nil with > is always false"},
+			},
+		},
+		{
+			name:      "deprecated functions",
+			statement: `old_editor(attributes["a"], OldFunc(name)) where Concat([OldFunc(name)], "") == "a"`,
+			expected: []LintWarning{
+				{Rule: LintRuleDeprecatedFunction, Message: "function old_editor is deprecated: use set instead"},
+				{Rule: LintRuleDeprecatedFunction, Message: "function OldFunc is deprecated: use NewFunc instead"},
+				{Rule: LintRuleDeprecatedFunction, Message: "function OldFunc is deprecated: use NewFunc instead"},
+			},
+		},
+		{
+			name:      "data point attribute set with converter",
+			statement: `set(datapoint.attributes["id"], Concat([UUID()], ""))`,
+			expected: []LintWarning{
+				{Rule: LintRuleHighCardinality, Message: "setting data point attributes with UUID creates a new time series for every data point"},
+			},
+		},
+		{
+			name:      "data point attribute set with value",
+			statement: `set(attributes["value"], value_double)`,
+			options:   []LintOption{WithLintContext("datapoint")},
+			expected: []LintWarning{
+				{Rule: LintRuleHighCardinality, Message: "setting data point attributes with value_double creates a new time series for every data point"},
+			},
+		},
+		{
+			name:      "log attribute set with converter",
+			statement: `set(attributes["id"], UUID())`,
+			options:   []LintOption{WithLintContext("log")},
+		},
+		{
+			name:      "resource attribute set with converter",
+			statement: `set(resource.attributes["id"], UUID())`,
+			options:   []LintOption{WithLintContext("datapoint")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := LintStatements([]string{tt.statement}, lintTestFunctions(), tt.options...)
+			require.NoError(t, err)
+			for i := range tt.expected {
+				tt.expected[i].Source = tt.statement
+			}
+			assert.Equal(t, tt.expected, warnings)
+		})
+	}
+}
+
+func Test_LintStatements_InvalidSyntax(t *testing.T) {
+	warnings, err := LintStatements([]string{`set(attributes["a"], "b") where true`, `set(`}, lintTestFunctions())
+	assert.ErrorContains(t, err, "statement has invalid syntax")
+	assert.Len(t, warnings, 1)
+}
+
+func Test_LintConditions(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		expected  []LintWarning
+	}{
+		{
+			name:      "no warnings",
+			condition: `name == "foo"`,
+		},
+		{
+			name:      "always true",
+			condition: `1 < 2`,
+			expected: []LintWarning{
+				{Rule: LintRuleUnusedCondition, Message: "the condition is always true"},
+			},
+		},
+		{
+			name:      "always false",
+			condition: `nil != nil`,
+			expected: []LintWarning{
+				{Rule: LintRuleAlwaysFalseComparison, Message: "the comparison of nil and nil with != is always false"},
+				{Rule: LintRuleUnusedCondition, Message: "the condition is always false"},
+			},
+		},
+		{
+			name:      "deprecated function",
+			condition: `OldFunc(name) == "foo"`,
+			expected: []LintWarning{
+				{Rule: LintRuleDeprecatedFunction, Message: "function OldFunc is deprecated: use NewFunc instead"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := LintConditions([]string{tt.condition}, lintTestFunctions())
+			require.NoError(t, err)
+			for i := range tt.expected {
+				tt.expected[i].Source = tt.condition
+			}
+			assert.Equal(t, tt.expected, warnings)
+		})
+	}
+}
+
+func Test_LintWarning_String(t *testing.T) {
+	w := LintWarning{Rule: LintRuleUnusedCondition, Source: "true", Message: "the condition is always true"}
+	assert.Equal(t, "true: the condition is always true (unused_condition)", w.String())
+}
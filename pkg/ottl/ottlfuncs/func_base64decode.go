@@ -16,7 +16,7 @@ type Base64DecodeArguments[K any] struct {
 }
 
 func NewBase64DecodeFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Base64Decode", &Base64DecodeArguments[K]{}, createBase64DecodeFunction[K])
+	return ottl.NewFactory("Base64Decode", &Base64DecodeArguments[K]{}, createBase64DecodeFunction[K], ottl.WithDeprecation[K]("use Decode instead"))
 }
 
 func createBase64DecodeFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
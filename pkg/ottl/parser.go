@@ -69,6 +69,7 @@ type Parser[K any] struct {
 	enumParser        EnumParser
 	telemetrySettings component.TelemetrySettings
 	pathContextNames  map[string]struct{}
+	cacheScopes       bool
 }
 
 // NewParser creates a new Parser
@@ -121,6 +122,17 @@ func WithPathContextNames[K any](contexts []string) Option[K] {
 	}
 }
 
+// EnableCacheScopes allows the statements to access the caches shared by the lower contexts, with the
+// `resource.cache` and `request.cache` paths. Without this option, those paths are rejected when parsing, so
+// only the components providing the shared caches with ContextWithCacheScopes must set it.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func EnableCacheScopes[K any]() Option[K] {
+	return func(p *Parser[K]) {
+		p.cacheScopes = true
+	}
+}
+
 // ParseStatements parses string statements into ottl.Statement objects ready for execution.
 // Returns a slice of statements and a nil error on successful parsing.
 // If parsing fails, returns nil and a joined error containing each error per failed statement.
@@ -148,6 +148,20 @@ func (c *FromPdataConverter) Batch(pLogs plog.Logs) error {
 	return nil
 }
 
+// ConvertFromResourceLogs converts the log records of a plog.ResourceLogs into a slice of entry.Entry, in order.
+func ConvertFromResourceLogs(rls plog.ResourceLogs) []*entry.Entry {
+	var result []*entry.Entry
+	for i := 0; i < rls.ScopeLogs().Len(); i++ {
+		scope := rls.ScopeLogs().At(i)
+		result = append(result, convertFromLogs(fromConverterWorkerItem{
+			Resource:       rls.Resource(),
+			Scope:          scope,
+			LogRecordSlice: scope.LogRecords(),
+		})...)
+	}
+	return result
+}
+
 // convertFromLogs converts the contents of a fromConverterWorkerItem into a slice of entry.Entry
 func convertFromLogs(workerItem fromConverterWorkerItem) []*entry.Entry {
 	result := make([]*entry.Entry, 0, workerItem.LogRecordSlice.Len())
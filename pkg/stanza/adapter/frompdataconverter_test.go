@@ -112,6 +112,26 @@ func TestConvertFromSeverity(t *testing.T) {
 	}
 }
 
+func TestConvertFromResourceLogs(t *testing.T) {
+	rls := plog.NewResourceLogs()
+	rls.Resource().Attributes().PutStr("host", "host-1")
+	first := rls.ScopeLogs().AppendEmpty()
+	first.Scope().SetName("first")
+	first.LogRecords().AppendEmpty().Body().SetStr("one")
+	first.LogRecords().AppendEmpty().Body().SetStr("two")
+	second := rls.ScopeLogs().AppendEmpty()
+	second.Scope().SetName("second")
+	second.LogRecords().AppendEmpty().Body().SetStr("three")
+
+	entries := ConvertFromResourceLogs(rls)
+	require.Len(t, entries, 3)
+	for i, expected := range []struct{ scope, body string }{{"first", "one"}, {"first", "two"}, {"second", "three"}} {
+		assert.Equal(t, expected.scope, entries[i].ScopeName)
+		assert.Equal(t, expected.body, entries[i].Body)
+		assert.Equal(t, map[string]any{"host": "host-1"}, entries[i].Resource)
+	}
+}
+
 func BenchmarkFromPdataConverter(b *testing.B) {
 	const (
 		entryCount = 1_000_000
@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/journald/internal/metadata"
 )
 
 const waitDuration = 1 * time.Second
@@ -46,15 +47,21 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		return nil, err
 	}
 
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Input{
 		InputOperator:       inputOperator,
 		newCmd:              newCmdFunc,
 		convertMessageBytes: c.ConvertMessageBytes,
+		telemetryBuilder:    telemetryBuilder,
 	}, nil
 }
 
 func (c Config) validate() error {
-	if c.StartAt != "end" && c.StartAt != "beginning" {
+	if c.StartAt != "end" && c.StartAt != "beginning" && c.StartAt != "boot" {
 		return fmt.Errorf("invalid value '%s' for parameter 'start_at'", c.StartAt)
 	}
 
@@ -93,7 +100,7 @@ func (c Config) buildArgs() ([]string, error) {
 		"--follow",      // Continue watching logs until cancelled
 	)
 
-	if c.StartAt == "beginning" {
+	if c.StartAt == "beginning" || c.StartAt == "boot" {
 		args = append(args, "--no-tail")
 	}
 
@@ -186,17 +193,23 @@ func (c Config) buildMatchesConfig() ([]string, error) {
 	return matches, nil
 }
 
-func (c Config) buildNewCmdFunc() (func(ctx context.Context, cursor []byte) cmd, error) {
+func (c Config) buildNewCmdFunc() (func(ctx context.Context, cursor []byte, since time.Time) cmd, error) {
 	args, err := c.buildArgs()
 	if err != nil {
 		return nil, err
 	}
 
-	return func(ctx context.Context, cursor []byte) cmd {
-		// Copy args and if needed, add the cursor flag
+	return func(ctx context.Context, cursor []byte, since time.Time) cmd {
+		// Copy args and if needed, add the flag of the position to start from.
+		// The cursor and the timestamp can be in a previous boot, so --boot is only used without them.
 		journalArgs := append([]string{}, args...)
-		if len(bytes.TrimSpace(cursor)) > 0 {
+		switch {
+		case len(bytes.TrimSpace(cursor)) > 0:
 			journalArgs = append(journalArgs, "--after-cursor", string(cursor))
+		case !since.IsZero():
+			journalArgs = append(journalArgs, "--since", fmt.Sprintf("@%d", since.Unix()))
+		case c.StartAt == "boot":
+			journalArgs = append(journalArgs, "--boot")
 		}
 		cmd := exec.CommandContext(ctx, c.JournalctlPath, journalArgs...) // #nosec - ...
 		// journalctl is an executable that is required for this operator to function
@@ -7,6 +7,7 @@ package journald // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -17,24 +18,31 @@ import (
 	"time"
 
 	gojson "github.com/goccy/go-json"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/journald/internal/metadata"
 )
 
 // Input is an operator that process logs using journald
 type Input struct {
 	helper.InputOperator
 
-	newCmd func(ctx context.Context, cursor []byte) cmd
+	newCmd func(ctx context.Context, cursor []byte, since time.Time) cmd
 
 	persister           operator.Persister
 	convertMessageBytes bool
+	telemetryBuilder    *metadata.TelemetryBuilder
 	cancel              context.CancelFunc
 	wg                  sync.WaitGroup
 	errChan             chan error
+
+	// cursorNotFound is set when journalctl failed to seek to the persisted cursor, e.g. because
+	// the journal file it belongs to was removed. The reading then resumes from the persisted timestamp.
+	cursorNotFound bool
 }
 
 type cmd interface {
@@ -48,9 +56,25 @@ type journalctl struct {
 	cmd    cmd
 	stdout io.ReadCloser
 	stderr io.ReadCloser
+
+	// withCursor is set when the command started after the persisted cursor.
+	withCursor bool
+	// cursorNotFound is set when the command reported that it could not find the cursor.
+	cursorNotFound bool
+	// When resuming from a timestamp, the entries up to the last one read are skipped: skipUntil is
+	// its timestamp in microseconds, and skipCursor its cursor.
+	skipUntil  int64
+	skipCursor string
 }
 
-var lastReadCursorKey = "lastReadCursor"
+var (
+	lastReadCursorKey = "lastReadCursor"
+	// lastReadTimestampKey is the realtime timestamp of the last entry read, in microseconds.
+	lastReadTimestampKey = "lastReadTimestamp"
+	// cursorSeekFailedMessage starts the error journalctl writes to stderr when it can't seek to the
+	// cursor of --after-cursor, e.g. "Failed to seek to cursor: Invalid argument".
+	cursorSeekFailedMessage = []byte("Failed to seek to cursor")
+)
 
 // Start will start generating log entries.
 func (operator *Input) Start(persister operator.Persister) error {
@@ -100,6 +124,11 @@ func (operator *Input) run(ctx context.Context) {
 					operator.Logger().Info("journalctl command exited")
 				}
 			}
+			if jctl.withCursor && jctl.cursorNotFound {
+				operator.Logger().Warn("The persisted cursor was not found in the journal, resuming from the timestamp of the last entry read")
+				operator.cursorNotFound = true
+				operator.telemetryBuilder.JournaldCursorFallbacks.Add(ctx, 1)
+			}
 			// Backoff before restart.
 			select {
 			case <-ctx.Done():
@@ -118,10 +147,24 @@ func (operator *Input) newJournalctl(ctx context.Context) (*journalctl, error) {
 		return nil, fmt.Errorf("failed to get journalctl state: %w", err)
 	}
 
-	journal := operator.newCmd(ctx, cursor)
-	jctl := &journalctl{
-		cmd: journal,
+	jctl := &journalctl{}
+	var since time.Time
+	if operator.cursorNotFound {
+		timestamp, err := operator.persister.Get(ctx, lastReadTimestampKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get journalctl state: %w", err)
+		}
+		// Without a timestamp, e.g. persisted by a previous version, the reading starts at start_at.
+		if micros, parseErr := strconv.ParseInt(string(timestamp), 10, 64); parseErr == nil {
+			since = time.UnixMicro(micros)
+			jctl.skipUntil, jctl.skipCursor = micros, string(cursor)
+		}
+		cursor = nil
 	}
+	jctl.withCursor = len(bytes.TrimSpace(cursor)) > 0
+
+	journal := operator.newCmd(ctx, cursor, since)
+	jctl.cmd = journal
 
 	jctl.stdout, err = journal.StdoutPipe()
 	if err != nil {
@@ -160,6 +203,9 @@ func (operator *Input) runJournalctl(ctx context.Context, jctl *journalctl) erro
 				}
 				return
 			}
+			if bytes.HasPrefix(line, cursorSeekFailedMessage) {
+				jctl.cursorNotFound = true
+			}
 			operator.Logger().Error("Received from journalctl stderr", zap.ByteString("stderr", line))
 		}
 	}()
@@ -189,12 +235,23 @@ func (operator *Input) runJournalctl(ctx context.Context, jctl *journalctl) erro
 				operator.Logger().Warn("Failed to parse journal entry", zap.Error(err))
 				continue
 			}
-			if err = operator.persister.Set(ctx, lastReadCursorKey, []byte(cursor)); err != nil {
-				operator.Logger().Warn("Failed to set offset", zap.Error(err))
+			timestamp := entry.Timestamp.UnixMicro()
+			if timestamp < jctl.skipUntil || (timestamp == jctl.skipUntil && cursor == jctl.skipCursor) {
+				continue
 			}
+			operator.telemetryBuilder.JournaldLag.Record(ctx, time.Since(entry.Timestamp).Seconds())
 			if err = operator.Write(ctx, entry); err != nil {
 				operator.Logger().Error("failed to write entry", zap.Error(err))
 			}
+			// The position is persisted once the entry is written, so that it is read again
+			// if the collector stops in between.
+			if err = operator.persister.Batch(ctx,
+				storage.SetOperation(lastReadCursorKey, []byte(cursor)),
+				storage.SetOperation(lastReadTimestampKey, []byte(strconv.FormatInt(timestamp, 10))),
+			); err != nil {
+				operator.Logger().Warn("Failed to set offset", zap.Error(err))
+			}
+			operator.cursorNotFound = false
 		}
 	}()
 
@@ -268,5 +325,6 @@ func (operator *Input) Stop() error {
 	if operator.cancel != nil {
 		operator.cancel()
 	}
+	operator.telemetryBuilder.Shutdown()
 	return nil
 }
@@ -18,16 +18,23 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/journald/internal/metadatatest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
 )
 
+const fakeJournaldEntry = `{ "_BOOT_ID": "c4fa36de06824d21835c05ff80c54468", "_CAP_EFFECTIVE": "0", "_TRANSPORT": "journal", "_UID": "1000", "_EXE": "/usr/lib/systemd/systemd", "_AUDIT_LOGINUID": "1000", "MESSAGE": "run-docker-netns-4f76d707d45f.mount: Succeeded.", "_PID": "13894", "_CMDLINE": "/lib/systemd/systemd --user", "_MACHINE_ID": "d777d00e7caf45fbadedceba3975520d", "_SELINUX_CONTEXT": "unconfined\n", "CODE_FUNC": "unit_log_success", "SYSLOG_IDENTIFIER": "systemd", "_HOSTNAME": "myhostname", "MESSAGE_ID": "7ad2d189f7e94e70a38c781354912448", "_SYSTEMD_CGROUP": "/user.slice/user-1000.slice/user@1000.service/init.scope", "_SOURCE_REALTIME_TIMESTAMP": "1587047866229317", "USER_UNIT": "run-docker-netns-4f76d707d45f.mount", "SYSLOG_FACILITY": "3", "_SYSTEMD_SLICE": "user-1000.slice", "_AUDIT_SESSION": "286", "CODE_FILE": "../src/core/unit.c", "_SYSTEMD_USER_UNIT": "init.scope", "_COMM": "systemd", "USER_INVOCATION_ID": "88f7ca6bbf244dc8828fa901f9fe9be1", "CODE_LINE": "5487", "_SYSTEMD_INVOCATION_ID": "83f7fc7799064520b26eb6de1630429c", "PRIORITY": "6", "_GID": "1000", "__REALTIME_TIMESTAMP": "1587047866229555", "_SYSTEMD_UNIT": "user@1000.service", "_SYSTEMD_USER_SLICE": "-.slice", "__CURSOR": "s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30;b=c4fa36de06824d21835c05ff80c54468;m=9f9d630205;t=5a369604ee333;x=16c2d4fd4fdb7c36", "__MONOTONIC_TIMESTAMP": "685540311557", "_SYSTEMD_OWNER_UID": "1000" }
+`
+
 type fakeJournaldCmd struct {
 	startError error
 	exitError  *exec.ExitError
+	stdOut     string
 	stdErr     string
 }
 
@@ -35,10 +42,8 @@ func (f *fakeJournaldCmd) Start() error {
 	return f.startError
 }
 
-func (*fakeJournaldCmd) StdoutPipe() (io.ReadCloser, error) {
-	response := `{ "_BOOT_ID": "c4fa36de06824d21835c05ff80c54468", "_CAP_EFFECTIVE": "0", "_TRANSPORT": "journal", "_UID": "1000", "_EXE": "/usr/lib/systemd/systemd", "_AUDIT_LOGINUID": "1000", "MESSAGE": "run-docker-netns-4f76d707d45f.mount: Succeeded.", "_PID": "13894", "_CMDLINE": "/lib/systemd/systemd --user", "_MACHINE_ID": "d777d00e7caf45fbadedceba3975520d", "_SELINUX_CONTEXT": "unconfined\n", "CODE_FUNC": "unit_log_success", "SYSLOG_IDENTIFIER": "systemd", "_HOSTNAME": "myhostname", "MESSAGE_ID": "7ad2d189f7e94e70a38c781354912448", "_SYSTEMD_CGROUP": "/user.slice/user-1000.slice/user@1000.service/init.scope", "_SOURCE_REALTIME_TIMESTAMP": "1587047866229317", "USER_UNIT": "run-docker-netns-4f76d707d45f.mount", "SYSLOG_FACILITY": "3", "_SYSTEMD_SLICE": "user-1000.slice", "_AUDIT_SESSION": "286", "CODE_FILE": "../src/core/unit.c", "_SYSTEMD_USER_UNIT": "init.scope", "_COMM": "systemd", "USER_INVOCATION_ID": "88f7ca6bbf244dc8828fa901f9fe9be1", "CODE_LINE": "5487", "_SYSTEMD_INVOCATION_ID": "83f7fc7799064520b26eb6de1630429c", "PRIORITY": "6", "_GID": "1000", "__REALTIME_TIMESTAMP": "1587047866229555", "_SYSTEMD_UNIT": "user@1000.service", "_SYSTEMD_USER_SLICE": "-.slice", "__CURSOR": "s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30;b=c4fa36de06824d21835c05ff80c54468;m=9f9d630205;t=5a369604ee333;x=16c2d4fd4fdb7c36", "__MONOTONIC_TIMESTAMP": "685540311557", "_SYSTEMD_OWNER_UID": "1000" }
-`
-	reader := bytes.NewReader([]byte(response))
+func (f *fakeJournaldCmd) StdoutPipe() (io.ReadCloser, error) {
+	reader := bytes.NewReader([]byte(f.stdOut))
 	return io.NopCloser(reader), nil
 }
 
@@ -72,8 +77,8 @@ func TestInputJournald(t *testing.T) {
 	err = op.SetOutputs([]operator.Operator{mockOutput})
 	require.NoError(t, err)
 
-	op.(*Input).newCmd = func(_ context.Context, _ []byte) cmd {
-		return &fakeJournaldCmd{}
+	op.(*Input).newCmd = func(context.Context, []byte, time.Time) cmd {
+		return &fakeJournaldCmd{stdOut: fakeJournaldEntry}
 	}
 
 	require.NoError(t, op.Start(testutil.NewUnscopedMockPersister()))
@@ -305,7 +310,7 @@ func TestBuildConfigCmd(t *testing.T) {
 			newCmdFunc, err := cfg.buildNewCmdFunc()
 
 			require.NoError(t, err)
-			cmd := newCmdFunc(t.Context(), nil).(*exec.Cmd)
+			cmd := newCmdFunc(t.Context(), nil, time.Time{}).(*exec.Cmd)
 			tt.RequireCmd(cmd)
 		})
 	}
@@ -316,17 +321,38 @@ func TestBuildConfigCmdCursor(t *testing.T) {
 	newCmdFunc, err := cfg.buildNewCmdFunc()
 	require.NoError(t, err)
 
-	cmd := newCmdFunc(t.Context(), []byte("cursor-value")).(*exec.Cmd)
+	cmd := newCmdFunc(t.Context(), []byte("cursor-value"), time.Time{}).(*exec.Cmd)
 	assert.Contains(t, cmd.Args, "--after-cursor")
 	assert.Contains(t, cmd.Args, "cursor-value")
 
-	cmd = newCmdFunc(t.Context(), []byte("  ")).(*exec.Cmd)
+	cmd = newCmdFunc(t.Context(), []byte("  "), time.Time{}).(*exec.Cmd)
 	assert.NotContains(t, cmd.Args, "--after-cursor")
 
-	cmd = newCmdFunc(t.Context(), []byte{}).(*exec.Cmd)
+	cmd = newCmdFunc(t.Context(), []byte{}, time.Time{}).(*exec.Cmd)
 	assert.NotContains(t, cmd.Args, "--after-cursor")
 }
 
+func TestBuildConfigCmdSince(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	cfg.StartAt = "boot"
+	newCmdFunc, err := cfg.buildNewCmdFunc()
+	require.NoError(t, err)
+
+	cmd := newCmdFunc(t.Context(), nil, time.Time{}).(*exec.Cmd)
+	assert.Contains(t, cmd.Args, "--boot")
+	assert.Contains(t, cmd.Args, "--no-tail")
+
+	// The position can be in a previous boot.
+	cmd = newCmdFunc(t.Context(), nil, time.Unix(1587047866, 0)).(*exec.Cmd)
+	assert.Contains(t, cmd.Args, "--since")
+	assert.Contains(t, cmd.Args, "@1587047866")
+	assert.NotContains(t, cmd.Args, "--boot")
+
+	cmd = newCmdFunc(t.Context(), []byte("cursor-value"), time.Time{}).(*exec.Cmd)
+	assert.Contains(t, cmd.Args, "--after-cursor")
+	assert.NotContains(t, cmd.Args, "--boot")
+}
+
 func TestConfigValidation(t *testing.T) {
 	testCases := []struct {
 		Name          string
@@ -367,6 +393,12 @@ func TestConfigValidation(t *testing.T) {
 			},
 			ExpectedError: "invalid value 'middle' for parameter 'start_at'",
 		},
+		{
+			Name: "start_at boot",
+			Config: func(cfg *Config) {
+				cfg.StartAt = "boot"
+			},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -403,7 +435,7 @@ func TestInputJournaldError(t *testing.T) {
 	err = op.SetOutputs([]operator.Operator{mockOutput})
 	require.NoError(t, err)
 
-	op.(*Input).newCmd = func(_ context.Context, _ []byte) cmd {
+	op.(*Input).newCmd = func(context.Context, []byte, time.Time) cmd {
 		return &fakeJournaldCmd{
 			exitError:  &exec.ExitError{},
 			startError: errors.New("fail to start"),
@@ -414,3 +446,110 @@ func TestInputJournaldError(t *testing.T) {
 	assert.EqualError(t, err, "journalctl command failed: start journalctl: fail to start")
 	require.NoError(t, op.Stop())
 }
+
+func TestInputJournaldCursorFallback(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	cfg.OutputIDs = []string{"output"}
+
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() {
+		require.NoError(t, tel.Shutdown(context.Background()))
+	})
+	op, err := cfg.Build(tel.NewTelemetrySettings())
+	require.NoError(t, err)
+
+	mockOutput := testutil.NewMockOperator("output")
+	received := make(chan *entry.Entry)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		received <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+	require.NoError(t, op.SetOutputs([]operator.Operator{mockOutput}))
+
+	type cmdArgs struct {
+		cursor []byte
+		since  time.Time
+	}
+	calls := make(chan cmdArgs, 2)
+	op.(*Input).newCmd = func(_ context.Context, cursor []byte, since time.Time) cmd {
+		calls <- cmdArgs{cursor: cursor, since: since}
+		if len(cursor) > 0 {
+			return &fakeJournaldCmd{
+				exitError: &exec.ExitError{},
+				stdErr:    "Failed to seek to cursor: Invalid argument\n",
+			}
+		}
+		return &fakeJournaldCmd{stdOut: fakeJournaldEntry}
+	}
+
+	persister := testutil.NewUnscopedMockPersister()
+	require.NoError(t, persister.Set(t.Context(), lastReadCursorKey, []byte("invalid-cursor")))
+	require.NoError(t, persister.Set(t.Context(), lastReadTimestampKey, []byte("1587047866229000")))
+
+	require.NoError(t, op.Start(persister))
+	defer func() {
+		require.NoError(t, op.Stop())
+	}()
+
+	assert.Equal(t, cmdArgs{cursor: []byte("invalid-cursor")}, <-calls)
+	// The command is restarted from the timestamp of the last entry read.
+	select {
+	case call := <-calls:
+		assert.Nil(t, call.cursor)
+		assert.Equal(t, time.UnixMicro(1587047866229000), call.since)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "Timed out waiting for journalctl to be restarted")
+	}
+	select {
+	case e := <-received:
+		assert.Equal(t, "run-docker-netns-4f76d707d45f.mount: Succeeded.", e.Body.(map[string]any)["MESSAGE"])
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry to be read")
+	}
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		cursor, err := persister.Get(t.Context(), lastReadCursorKey)
+		assert.NoError(c, err)
+		assert.Equal(c, "s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30;b=c4fa36de06824d21835c05ff80c54468;m=9f9d630205;t=5a369604ee333;x=16c2d4fd4fdb7c36", string(cursor))
+		timestamp, err := persister.Get(t.Context(), lastReadTimestampKey)
+		assert.NoError(c, err)
+		assert.Equal(c, "1587047866229555", string(timestamp))
+	}, time.Second, 10*time.Millisecond)
+
+	metadatatest.AssertEqualJournaldCursorFallbacks(t, tel, []metricdata.DataPoint[int64]{{Value: 1}}, metricdatatest.IgnoreTimestamp())
+}
+
+func TestInputJournaldSkipsLastEntryRead(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	cfg.OutputIDs = []string{"output"}
+
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	mockOutput := testutil.NewMockOperator("output")
+	received := make(chan *entry.Entry, 1)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		received <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+	require.NoError(t, op.SetOutputs([]operator.Operator{mockOutput}))
+
+	input := op.(*Input)
+	input.newCmd = func(context.Context, []byte, time.Time) cmd {
+		return &fakeJournaldCmd{stdOut: fakeJournaldEntry}
+	}
+	// The entry is the last one read before the cursor was lost.
+	input.cursorNotFound = true
+	persister := testutil.NewUnscopedMockPersister()
+	require.NoError(t, persister.Set(t.Context(), lastReadCursorKey, []byte("s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30;b=c4fa36de06824d21835c05ff80c54468;m=9f9d630205;t=5a369604ee333;x=16c2d4fd4fdb7c36")))
+	require.NoError(t, persister.Set(t.Context(), lastReadTimestampKey, []byte("1587047866229555")))
+
+	require.NoError(t, op.Start(persister))
+	defer func() {
+		require.NoError(t, op.Stop())
+	}()
+
+	select {
+	case <-received:
+		require.FailNow(t, "The last entry read was read again")
+	case <-time.After(500 * time.Millisecond):
+	}
+}
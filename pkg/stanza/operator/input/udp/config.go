@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 
@@ -27,6 +28,11 @@ const (
 	defaultReaders        = 1
 	defaultProcessors     = 1
 	defaultMaxQueueLength = 100
+
+	defaultReassemblyTerminator = "\n"
+	defaultReassemblyTimeout    = time.Second
+	defaultReassemblyMaxLogSize = 1024 * 1024
+	defaultReassemblyMaxSenders = 1000
 )
 
 func init() {
@@ -64,15 +70,24 @@ type AsyncConfig struct {
 	MaxQueueLength int `mapstructure:"max_queue_length,omitempty"`
 }
 
+// ReassemblyConfig is the configuration of the reassembly of messages split across datagrams.
+type ReassemblyConfig struct {
+	Terminator string          `mapstructure:"terminator,omitempty"`
+	Timeout    time.Duration   `mapstructure:"timeout,omitempty"`
+	MaxLogSize helper.ByteSize `mapstructure:"max_log_size,omitempty"`
+	MaxSenders int             `mapstructure:"max_senders,omitempty"`
+}
+
 // BaseConfig is the details configuration of a udp input operator.
 type BaseConfig struct {
-	ListenAddress   string       `mapstructure:"listen_address,omitempty"`
-	OneLogPerPacket bool         `mapstructure:"one_log_per_packet,omitempty"`
-	AddAttributes   bool         `mapstructure:"add_attributes,omitempty"`
-	Encoding        string       `mapstructure:"encoding,omitempty"`
-	SplitConfig     split.Config `mapstructure:"multiline,omitempty"`
-	TrimConfig      trim.Config  `mapstructure:",squash"`
-	AsyncConfig     *AsyncConfig `mapstructure:"async,omitempty"`
+	ListenAddress   string            `mapstructure:"listen_address,omitempty"`
+	OneLogPerPacket bool              `mapstructure:"one_log_per_packet,omitempty"`
+	AddAttributes   bool              `mapstructure:"add_attributes,omitempty"`
+	Encoding        string            `mapstructure:"encoding,omitempty"`
+	SplitConfig     split.Config      `mapstructure:"multiline,omitempty"`
+	TrimConfig      trim.Config       `mapstructure:",squash"`
+	AsyncConfig     *AsyncConfig      `mapstructure:"async,omitempty"`
+	Reassembly      *ReassemblyConfig `mapstructure:"reassembly,omitempty"`
 }
 
 // Build will build a udp input operator.
@@ -120,6 +135,28 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		}
 	}
 
+	var reassembly *reassembler
+	if c.Reassembly != nil {
+		if c.AsyncConfig != nil && c.AsyncConfig.Processors > 1 {
+			return nil, errors.New("reassembly requires a single async processor to preserve the order of the datagrams")
+		}
+		// the defaults are applied to a copy, to leave the configuration unchanged
+		reassemblyCfg := *c.Reassembly
+		if reassemblyCfg.Terminator == "" {
+			reassemblyCfg.Terminator = defaultReassemblyTerminator
+		}
+		if reassemblyCfg.Timeout <= 0 {
+			reassemblyCfg.Timeout = defaultReassemblyTimeout
+		}
+		if reassemblyCfg.MaxLogSize <= 0 {
+			reassemblyCfg.MaxLogSize = defaultReassemblyMaxLogSize
+		}
+		if reassemblyCfg.MaxSenders <= 0 {
+			reassemblyCfg.MaxSenders = defaultReassemblyMaxSenders
+		}
+		reassembly = newReassembler(reassemblyCfg)
+	}
+
 	udpInput := &Input{
 		InputOperator:   inputOperator,
 		address:         address,
@@ -130,6 +167,7 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		resolver:        resolver,
 		OneLogPerPacket: c.OneLogPerPacket,
 		AsyncConfig:     c.AsyncConfig,
+		reassembler:     reassembly,
 	}
 
 	if c.AsyncConfig != nil {
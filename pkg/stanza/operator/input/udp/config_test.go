@@ -6,6 +6,7 @@ package udp
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
 )
@@ -51,6 +52,22 @@ func TestUnmarshal(t *testing.T) {
 					return cfg
 				}(),
 			},
+			{
+				Name:               "reassembly",
+				ExpectUnmarshalErr: false,
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.ListenAddress = "10.0.0.1:9000"
+					cfg.AddAttributes = true
+					cfg.Reassembly = &ReassemblyConfig{
+						Terminator: "\x00",
+						Timeout:    5 * time.Second,
+						MaxLogSize: 256 * 1024,
+						MaxSenders: 100,
+					}
+					return cfg
+				}(),
+			},
 		},
 	}.Run(t)
 }
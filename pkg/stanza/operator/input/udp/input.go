@@ -11,6 +11,7 @@ import (
 	"net"
 	"strconv"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/text/encoding"
@@ -38,6 +39,8 @@ type Input struct {
 	splitFunc bufio.SplitFunc
 	resolver  *helper.IPResolver
 
+	reassembler *reassembler
+
 	messageQueue   chan messageAndAddress
 	readBufferPool sync.Pool
 	stopOnce       sync.Once
@@ -61,6 +64,10 @@ func (i *Input) Start(_ operator.Persister) error {
 	i.connection = conn
 
 	i.goHandleMessages(ctx)
+	if i.reassembler != nil {
+		i.wg.Add(1)
+		go i.flushExpiredMessages(ctx)
+	}
 	return nil
 }
 
@@ -91,8 +98,6 @@ func (i *Input) readAndProcessMessages(ctx context.Context) {
 	scannerBuffer := make([]byte, 0, MaxUDPSize)
 	for {
 		message, remoteAddr, bufferLength, err := i.readMessage(readBuffer)
-		message = i.removeTrailingCharactersAndNULsFromBuffer(message, bufferLength)
-
 		if err != nil {
 			select {
 			case <-ctx.Done():
@@ -103,7 +108,48 @@ func (i *Input) readAndProcessMessages(ctx context.Context) {
 			break
 		}
 
+		i.processDatagram(ctx, message, bufferLength, remoteAddr, dec, scannerBuffer)
+	}
+}
+
+// processDatagram processes the messages of a datagram, or of the datagrams it completes when reassembly is enabled.
+func (i *Input) processDatagram(ctx context.Context, datagram []byte, n int, remoteAddr net.Addr, dec *encoding.Decoder, scannerBuffer []byte) {
+	if i.reassembler == nil {
+		message := i.removeTrailingCharactersAndNULsFromBuffer(datagram, n)
 		i.processMessage(ctx, message, remoteAddr, dec, scannerBuffer)
+		return
+	}
+
+	for _, m := range i.reassembler.add(remoteAddr, datagram[:n], time.Now()) {
+		i.processReassembledMessage(ctx, m, dec)
+	}
+}
+
+// processReassembledMessage handles a reassembled message as a single log, its boundaries being already known.
+func (i *Input) processReassembledMessage(ctx context.Context, m reassembledMessage, dec *encoding.Decoder) {
+	message := i.removeTrailingCharactersAndNULsFromBuffer(m.message, len(m.message))
+	if len(message) == 0 {
+		return
+	}
+	i.handleMessage(ctx, m.remoteAddr, dec, message)
+}
+
+// flushExpiredMessages periodically processes the incomplete messages of the senders which stopped sending datagrams.
+func (i *Input) flushExpiredMessages(ctx context.Context) {
+	defer i.wg.Done()
+
+	dec := i.encoding.NewDecoder()
+	ticker := time.NewTicker(i.reassembler.timeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, m := range i.reassembler.expired(now) {
+				i.processReassembledMessage(ctx, m, dec)
+			}
+		}
 	}
 }
 
@@ -168,8 +214,7 @@ func (i *Input) processMessagesAsync(ctx context.Context) {
 			return // Channel closed, exit the goroutine.
 		}
 
-		trimmedMessage := i.removeTrailingCharactersAndNULsFromBuffer(*messageAndAddr.Message, messageAndAddr.MessageLength)
-		i.processMessage(ctx, trimmedMessage, messageAndAddr.RemoteAddr, dec, scannerBuffer)
+		i.processDatagram(ctx, *messageAndAddr.Message, messageAndAddr.MessageLength, messageAndAddr.RemoteAddr, dec, scannerBuffer)
 		i.readBufferPool.Put(messageAndAddr.Message)
 	}
 }
@@ -259,6 +304,13 @@ func (i *Input) Stop() error {
 		}
 
 		i.wg.Wait()
+		if i.reassembler != nil {
+			// process the incomplete messages rather than dropping them
+			dec := i.encoding.NewDecoder()
+			for _, m := range i.reassembler.flush() {
+				i.processReassembledMessage(context.Background(), m, dec)
+			}
+		}
 		if i.resolver != nil {
 			i.resolver.Stop()
 		}
@@ -163,6 +163,83 @@ func TestInputAttributes(t *testing.T) {
 	t.Run("NewlineInMessage", udpInputAttributesTest([]byte("message1\nmessage2\n"), []string{"message1\nmessage2"}))
 }
 
+func TestInputReassembly(t *testing.T) {
+	cfg := NewConfigWithID("test_input")
+	cfg.ListenAddress = ":0"
+	cfg.AddAttributes = true
+	cfg.Reassembly = &ReassemblyConfig{Timeout: 200 * time.Millisecond}
+
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	udpInput := op.(*Input)
+	mockOutput := testutil.Operator{}
+	udpInput.OutputOperators = []operator.Operator{&mockOutput}
+	entryChan := make(chan *entry.Entry, 10)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		entryChan <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	require.NoError(t, udpInput.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, udpInput.Stop(), "expected to stop udp input operator without error")
+	}()
+
+	conn1, err := net.Dial("udp", udpInput.connection.LocalAddr().String())
+	require.NoError(t, err)
+	defer conn1.Close()
+	conn2, err := net.Dial("udp", udpInput.connection.LocalAddr().String())
+	require.NoError(t, err)
+	defer conn2.Close()
+
+	for _, datagram := range []struct {
+		conn net.Conn
+		data string
+	}{
+		{conn1, "message1 part1 "},
+		{conn2, "message2 part1 "},
+		{conn1, "part2\nmessage3"},
+		{conn2, "part2\r\n"},
+	} {
+		_, err = datagram.conn.Write([]byte(datagram.data))
+		require.NoError(t, err)
+	}
+
+	expectEntry := func(body string, sender net.Conn) {
+		select {
+		case e := <-entryChan:
+			require.Equal(t, body, e.Body)
+			addr := sender.LocalAddr().(*net.UDPAddr)
+			require.Equal(t, addr.IP.String(), e.Attributes["net.peer.ip"])
+			require.Equal(t, strconv.Itoa(addr.Port), e.Attributes["net.peer.port"])
+		case <-time.After(time.Second):
+			require.FailNow(t, "Timed out waiting for message to be written")
+		}
+	}
+	expectEntry("message1 part1 part2", conn1)
+	expectEntry("message2 part1 part2", conn2)
+	// the incomplete message is flushed after the timeout
+	expectEntry("message3", conn1)
+}
+
+func TestBuildReassemblyWithAsyncProcessors(t *testing.T) {
+	cfg := NewConfigWithID("test_input")
+	cfg.ListenAddress = ":0"
+	cfg.Reassembly = &ReassemblyConfig{}
+	cfg.AsyncConfig = &AsyncConfig{Processors: 2}
+	_, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.EqualError(t, err, "reassembly requires a single async processor to preserve the order of the datagrams")
+
+	cfg.AsyncConfig.Processors = 1
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.Equal(t, []byte("\n"), op.(*Input).reassembler.terminator)
+	require.Equal(t, time.Second, op.(*Input).reassembler.timeout)
+	require.Equal(t, 1024*1024, op.(*Input).reassembler.maxLogSize)
+	require.Equal(t, 1000, op.(*Input).reassembler.maxSenders)
+	// the defaults aren't written to the configuration
+	require.Equal(t, &ReassemblyConfig{}, cfg.Reassembly)
+}
+
 func TestFailToBind(t *testing.T) {
 	ip := "localhost"
 	port := 0
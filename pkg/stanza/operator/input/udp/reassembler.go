@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package udp // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/udp"
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"time"
+)
+
+// reassembledMessage is a logical message of a sender, possibly spanning several datagrams.
+type reassembledMessage struct {
+	message    []byte
+	remoteAddr net.Addr
+}
+
+// senderBuffer holds the incomplete message of a sender.
+type senderBuffer struct {
+	data       []byte
+	remoteAddr net.Addr
+	deadline   time.Time
+}
+
+// reassembler concatenates the datagrams of each sender until the terminator is received,
+// the sender stops sending for the timeout, or the message reaches the maximum size.
+// When the maximum number of senders is reached, the incomplete message of the sender
+// which sent the least recently is emitted to make room for a new sender.
+type reassembler struct {
+	terminator []byte
+	timeout    time.Duration
+	maxLogSize int
+	maxSenders int
+
+	mu      sync.Mutex
+	senders map[string]*senderBuffer
+}
+
+func newReassembler(cfg ReassemblyConfig) *reassembler {
+	return &reassembler{
+		terminator: []byte(cfg.Terminator),
+		timeout:    cfg.Timeout,
+		maxLogSize: int(cfg.MaxLogSize),
+		maxSenders: cfg.MaxSenders,
+		senders:    make(map[string]*senderBuffer),
+	}
+}
+
+// add appends a datagram to the buffer of its sender and returns the messages it completes.
+func (r *reassembler) add(remoteAddr net.Addr, datagram []byte, now time.Time) []reassembledMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var messages []reassembledMessage
+	key := remoteAddr.String()
+	sender, ok := r.senders[key]
+	if !ok {
+		if r.maxSenders > 0 && len(r.senders) >= r.maxSenders {
+			messages = append(messages, r.evictOldest())
+		}
+		sender = &senderBuffer{remoteAddr: remoteAddr}
+		r.senders[key] = sender
+	}
+	sender.data = append(sender.data, datagram...)
+	sender.deadline = now.Add(r.timeout)
+
+	for {
+		end := bytes.Index(sender.data, r.terminator)
+		if end < 0 {
+			break
+		}
+		messages = append(messages, reassembledMessage{message: bytes.Clone(sender.data[:end]), remoteAddr: remoteAddr})
+		sender.data = sender.data[end+len(r.terminator):]
+	}
+
+	for len(sender.data) >= r.maxLogSize {
+		messages = append(messages, reassembledMessage{message: bytes.Clone(sender.data[:r.maxLogSize]), remoteAddr: remoteAddr})
+		sender.data = sender.data[r.maxLogSize:]
+	}
+
+	if len(sender.data) == 0 {
+		delete(r.senders, key)
+	}
+	return messages
+}
+
+// evictOldest removes and returns the incomplete message of the sender with the earliest deadline.
+func (r *reassembler) evictOldest() reassembledMessage {
+	var oldestKey string
+	var oldest *senderBuffer
+	for key, sender := range r.senders {
+		if oldest == nil || sender.deadline.Before(oldest.deadline) {
+			oldestKey, oldest = key, sender
+		}
+	}
+	delete(r.senders, oldestKey)
+	return reassembledMessage{message: oldest.data, remoteAddr: oldest.remoteAddr}
+}
+
+// expired removes and returns the incomplete messages of the senders which did not send a datagram within the timeout.
+func (r *reassembler) expired(now time.Time) []reassembledMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var messages []reassembledMessage
+	for key, sender := range r.senders {
+		if now.Before(sender.deadline) {
+			continue
+		}
+		messages = append(messages, reassembledMessage{message: sender.data, remoteAddr: sender.remoteAddr})
+		delete(r.senders, key)
+	}
+	return messages
+}
+
+// flush removes and returns all the incomplete messages.
+func (r *reassembler) flush() []reassembledMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	messages := make([]reassembledMessage, 0, len(r.senders))
+	for key, sender := range r.senders {
+		messages = append(messages, reassembledMessage{message: sender.data, remoteAddr: sender.remoteAddr})
+		delete(r.senders, key)
+	}
+	return messages
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package udp
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReassembler(t *testing.T) {
+	r := newReassembler(ReassemblyConfig{
+		Terminator: "\n",
+		Timeout:    time.Second,
+		MaxLogSize: 16,
+	})
+	sender1 := &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5000}
+	sender2 := &net.UDPAddr{IP: net.IPv4(10, 0, 0, 2), Port: 5000}
+	now := time.Now()
+
+	assert.Empty(t, r.add(sender1, []byte("first "), now))
+	assert.Empty(t, r.add(sender2, []byte("other "), now))
+	assert.Equal(t, []reassembledMessage{
+		{message: []byte("first message"), remoteAddr: sender1},
+		{message: []byte("second"), remoteAddr: sender1},
+	}, r.add(sender1, []byte("message\nsecond\nthird"), now))
+
+	// messages reaching the maximum size are split
+	assert.Equal(t, []reassembledMessage{
+		{message: []byte("other message is"), remoteAddr: sender2},
+	}, r.add(sender2, []byte("message is too long"), now))
+
+	// the deadline of a sender is reset by each datagram
+	assert.Empty(t, r.add(sender1, []byte(" message"), now.Add(500*time.Millisecond)))
+	assert.Equal(t, []reassembledMessage{
+		{message: []byte(" too long"), remoteAddr: sender2},
+	}, r.expired(now.Add(time.Second)))
+	assert.Equal(t, []reassembledMessage{
+		{message: []byte("third message"), remoteAddr: sender1},
+	}, r.flush())
+	assert.Empty(t, r.senders)
+}
+
+func TestReassemblerMultiByteTerminator(t *testing.T) {
+	r := newReassembler(ReassemblyConfig{
+		Terminator: "\r\n\r\n",
+		Timeout:    time.Second,
+		MaxLogSize: 1024,
+	})
+	sender := &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5000}
+
+	assert.Empty(t, r.add(sender, []byte("line1\r\nline2\r\n"), time.Now()))
+	messages := r.add(sender, []byte("\r\nnext"), time.Now())
+	require.Len(t, messages, 1)
+	assert.Equal(t, "line1\r\nline2", string(messages[0].message))
+	assert.Len(t, r.senders, 1)
+}
+
+func TestReassemblerMaxSenders(t *testing.T) {
+	r := newReassembler(ReassemblyConfig{
+		Terminator: "\n",
+		Timeout:    time.Second,
+		MaxLogSize: 1024,
+		MaxSenders: 2,
+	})
+	sender1 := &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5000}
+	sender2 := &net.UDPAddr{IP: net.IPv4(10, 0, 0, 2), Port: 5000}
+	sender3 := &net.UDPAddr{IP: net.IPv4(10, 0, 0, 3), Port: 5000}
+	now := time.Now()
+
+	assert.Empty(t, r.add(sender1, []byte("first"), now))
+	assert.Empty(t, r.add(sender2, []byte("second"), now.Add(time.Millisecond)))
+	assert.Empty(t, r.add(sender1, []byte(" message"), now.Add(2*time.Millisecond)))
+
+	// the sender which sent the least recently is emitted to make room for the new sender
+	assert.Equal(t, []reassembledMessage{
+		{message: []byte("second"), remoteAddr: sender2},
+	}, r.add(sender3, []byte("third"), now.Add(3*time.Millisecond)))
+	assert.Len(t, r.senders, 2)
+	assert.Contains(t, r.senders, sender1.String())
+	assert.Contains(t, r.senders, sender3.String())
+}
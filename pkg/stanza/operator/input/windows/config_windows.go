@@ -7,8 +7,12 @@ package windows // import "github.com/open-telemetry/opentelemetry-collector-con
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/jpillora/backoff"
 	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 )
@@ -40,9 +44,59 @@ func (c *Config) Build(set component.TelemetrySettings) (operator.Operator, erro
 		return nil, errors.New("the `start_at` field must be set to `beginning` or `end`")
 	}
 
-	if (c.Remote.Server != "" || c.Remote.Username != "" || c.Remote.Password != "") && // any not empty
-		(c.Remote.Server == "" || c.Remote.Username == "" || c.Remote.Password == "") { // any empty
-		return nil, errors.New("remote configuration must have non-empty `username` and `password`")
+	if err = validateRemote(c.Remote); err != nil {
+		return nil, err
+	}
+
+	if len(c.Remotes) == 0 {
+		return c.newInput(set, c.Remote)
+	}
+
+	if c.Remote.Server != "" {
+		return nil, errors.New("either `remote` or `remotes` must be set, but not both")
+	}
+
+	remotes := &remoteInputs{
+		InputOperator: inputOperator,
+		backoff:       backoff.Backoff{Min: 5 * time.Second, Max: 5 * time.Minute},
+	}
+	servers := make(map[string]struct{}, len(c.Remotes))
+	for _, remote := range c.Remotes {
+		if remote.Server == "" {
+			return nil, errors.New("each of the `remotes` must have a non-empty `server`")
+		}
+		if err = validateRemote(remote); err != nil {
+			return nil, err
+		}
+		if _, ok := servers[remote.Server]; ok {
+			return nil, fmt.Errorf("the server %q is configured more than once in `remotes`", remote.Server)
+		}
+		servers[remote.Server] = struct{}{}
+
+		remoteSet := set
+		remoteSet.Logger = set.Logger.With(zap.String("server", remote.Server))
+		input, err := c.newInput(remoteSet, remote)
+		if err != nil {
+			return nil, err
+		}
+		remotes.inputs = append(remotes.inputs, input)
+	}
+	return remotes, nil
+}
+
+func validateRemote(remote RemoteConfig) error {
+	if (remote.Server != "" || remote.Username != "" || remote.Password != "") && // any not empty
+		(remote.Server == "" || remote.Username == "" || remote.Password == "") { // any empty
+		return errors.New("remote configuration must have non-empty `username` and `password`")
+	}
+	return nil
+}
+
+// newInput builds the input reading the events of the given remote server, or the local ones if it is empty.
+func (c *Config) newInput(set component.TelemetrySettings, remote RemoteConfig) (*Input, error) {
+	inputOperator, err := c.InputConfig.Build(set)
+	if err != nil {
+		return nil, err
 	}
 
 	input := &Input{
@@ -58,7 +112,7 @@ func (c *Config) Build(set component.TelemetrySettings) (operator.Operator, erro
 		raw:                      c.Raw,
 		includeLogRecordOriginal: c.IncludeLogRecordOriginal,
 		excludeProviders:         excludeProvidersSet(c.ExcludeProviders),
-		remote:                   c.Remote,
+		remote:                   remote,
 		query:                    c.Query,
 	}
 	input.startRemoteSession = input.defaultStartRemoteSession
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package windows // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/windows"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jpillora/backoff"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+// remoteInputs is an operator that reads the events of several remote servers, with an input per server.
+type remoteInputs struct {
+	helper.InputOperator
+	inputs []*Input
+	// backoff is the delay between the attempts to connect to a server which can't be read from.
+	backoff backoff.Backoff
+
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	started []*Input
+}
+
+// SetOutputIDs sets the output IDs of the operator and of the input of every server.
+func (r *remoteInputs) SetOutputIDs(opIDs []string) {
+	r.InputOperator.SetOutputIDs(opIDs)
+	for _, input := range r.inputs {
+		input.SetOutputIDs(opIDs)
+	}
+}
+
+// SetOutputs sets the outputs of the operator and of the input of every server.
+func (r *remoteInputs) SetOutputs(operators []operator.Operator) error {
+	if err := r.InputOperator.SetOutputs(operators); err != nil {
+		return err
+	}
+	for _, input := range r.inputs {
+		if err := input.SetOutputs(operators); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start will start reading the events of every server. The bookmarks of each server are persisted
+// separately. A server which can't be read from is retried in the background with a backoff, unless
+// none of them can be read from.
+func (r *remoteInputs) Start(persister operator.Persister) error {
+	var errs error
+	var failed []*Input
+	for _, input := range r.inputs {
+		if err := r.startInput(input, persister); err != nil {
+			r.Logger().Error("Failed to start reading events from remote server, retrying", zap.String("server", input.remote.Server), zap.Error(err))
+			errs = multierr.Append(errs, err)
+			failed = append(failed, input)
+		}
+	}
+	if len(failed) == len(r.inputs) && errs != nil {
+		return fmt.Errorf("failed to start reading events from any remote server: %w", errs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	for _, input := range failed {
+		r.wg.Add(1)
+		go r.reconnect(ctx, input, persister)
+	}
+	return nil
+}
+
+// startInput starts reading the events of a server.
+func (r *remoteInputs) startInput(input *Input, persister operator.Persister) error {
+	if err := input.Start(operator.NewScopedPersister(input.remote.Server, persister)); err != nil {
+		if stopErr := input.Stop(); stopErr != nil {
+			r.Logger().Debug("Failed to stop the input of remote server", zap.String("server", input.remote.Server), zap.Error(stopErr))
+		}
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started = append(r.started, input)
+	return nil
+}
+
+// reconnect starts reading the events of a server which couldn't be read from, until it succeeds or
+// the operator is stopped.
+func (r *remoteInputs) reconnect(ctx context.Context, input *Input, persister operator.Persister) {
+	defer r.wg.Done()
+	b := r.backoff
+	for {
+		timer := time.NewTimer(b.Duration())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		err := r.startInput(input, persister)
+		if err == nil {
+			r.Logger().Info("Started reading events from remote server", zap.String("server", input.remote.Server))
+			return
+		}
+		r.Logger().Debug("Failed to start reading events from remote server, retrying", zap.String("server", input.remote.Server), zap.Error(err))
+	}
+}
+
+// Stop will stop reading the events of every server.
+func (r *remoteInputs) Stop() error {
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+	r.wg.Wait()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var errs error
+	for _, input := range r.started {
+		if err := input.Stop(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to stop reading events from remote server %s: %w", input.remote.Server, err))
+		}
+	}
+	r.started = nil
+	return errs
+}
+
+// startedInputs returns the inputs of the servers being read from.
+func (r *remoteInputs) startedInputs() []*Input {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Input(nil), r.started...)
+}
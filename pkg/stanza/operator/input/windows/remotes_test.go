@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jpillora/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestBuildRemotes(t *testing.T) {
+	testCases := []struct {
+		name        string
+		remote      RemoteConfig
+		remotes     []RemoteConfig
+		expectedErr string
+	}{
+		{
+			name: "remotes",
+			remotes: []RemoteConfig{
+				{Server: "server-1", Username: "user", Password: "password"},
+				{Server: "server-2", Username: "user", Password: "password", Domain: "domain"},
+			},
+		},
+		{
+			name:   "remote and remotes",
+			remote: RemoteConfig{Server: "server-1", Username: "user", Password: "password"},
+			remotes: []RemoteConfig{
+				{Server: "server-2", Username: "user", Password: "password"},
+			},
+			expectedErr: "either `remote` or `remotes` must be set, but not both",
+		},
+		{
+			name: "missing server",
+			remotes: []RemoteConfig{
+				{Username: "user", Password: "password"},
+			},
+			expectedErr: "each of the `remotes` must have a non-empty `server`",
+		},
+		{
+			name: "missing password",
+			remotes: []RemoteConfig{
+				{Server: "server-1", Username: "user"},
+			},
+			expectedErr: "remote configuration must have non-empty `username` and `password`",
+		},
+		{
+			name: "duplicate server",
+			remotes: []RemoteConfig{
+				{Server: "server-1", Username: "user", Password: "password"},
+				{Server: "server-1", Username: "other", Password: "password"},
+			},
+			expectedErr: `the server "server-1" is configured more than once in ` + "`remotes`",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			cfg.Channel = "application"
+			cfg.Remote = tc.remote
+			cfg.Remotes = tc.remotes
+
+			op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			remotes, ok := op.(*remoteInputs)
+			require.True(t, ok)
+			require.Len(t, remotes.inputs, len(tc.remotes))
+			for i, input := range remotes.inputs {
+				assert.Equal(t, tc.remotes[i], input.remote)
+			}
+		})
+	}
+}
+
+func TestRemotesStart(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Channel = "test-channel"
+	cfg.StartAt = "beginning"
+	cfg.IgnoreChannelErrors = true
+	cfg.Remotes = []RemoteConfig{
+		{Server: "server-1", Username: "user", Password: "password"},
+		{Server: "server-2", Username: "user", Password: "password"},
+	}
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	remotes := op.(*remoteInputs)
+
+	output := testutil.NewMockOperator("output")
+	remotes.SetOutputIDs([]string{"output"})
+	require.NoError(t, remotes.SetOutputs([]operator.Operator{output}))
+	for _, input := range remotes.inputs {
+		assert.Equal(t, []operator.Operator{output}, input.Outputs())
+	}
+
+	// The servers which can't be reached are retried in the background.
+	remotes.backoff = backoff.Backoff{Min: 10 * time.Millisecond, Max: 10 * time.Millisecond}
+	var attempts atomic.Int32
+	remotes.inputs[0].startRemoteSession = func() error {
+		if attempts.Add(1) < 3 {
+			return errors.New("unreachable")
+		}
+		return nil
+	}
+	remotes.inputs[1].startRemoteSession = func() error { return nil }
+	require.NoError(t, remotes.Start(testutil.NewMockPersister("")))
+	assert.Contains(t, remotes.startedInputs(), remotes.inputs[1])
+	assert.Eventually(t, func() bool {
+		return len(remotes.startedInputs()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(3), attempts.Load())
+	require.NoError(t, remotes.Stop())
+	assert.Empty(t, remotes.startedInputs())
+
+	// The retries end when the operator is stopped.
+	remotes.inputs[0].startRemoteSession = func() error { return errors.New("unreachable") }
+	require.NoError(t, remotes.Start(testutil.NewMockPersister("")))
+	require.NoError(t, remotes.Stop())
+	assert.Empty(t, remotes.startedInputs())
+
+	remotes.inputs[1].startRemoteSession = func() error { return errors.New("unreachable") }
+	err = remotes.Start(testutil.NewMockPersister(""))
+	assert.ErrorContains(t, err, "failed to start reading events from any remote server")
+	require.NoError(t, remotes.Stop())
+}
@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/cef"
+
+import (
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "cef_parser"
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new CEF parser config with default values.
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new CEF parser config with default values.
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a CEF parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+
+	// MapCustomLabels renames the custom extensions, such as cs1, to the value of their label extension, such as cs1Label.
+	MapCustomLabels bool `mapstructure:"map_custom_labels"`
+}
+
+// Build will build a CEF parser operator.
+func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(set)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parser{
+		ParserOperator:  parserOperator,
+		mapCustomLabels: c.MapCustomLabels,
+	}, nil
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/cef"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	cefPrefix = "CEF:"
+
+	// labelSuffix is the suffix of the extensions holding the name of a custom extension, such as cs1Label for cs1.
+	labelSuffix = "Label"
+)
+
+// headerFields are the names of the pipe separated fields preceding the extensions.
+var headerFields = []string{
+	"version",
+	"device_vendor",
+	"device_product",
+	"device_version",
+	"device_event_class_id",
+	"name",
+	"severity",
+}
+
+// Parser is an operator that parses ArcSight Common Event Format messages.
+type Parser struct {
+	helper.ParserOperator
+	mapCustomLabels bool
+}
+
+func (p *Parser) ProcessBatch(ctx context.Context, entries []*entry.Entry) error {
+	return p.ProcessBatchWith(ctx, entries, p.parse)
+}
+
+// Process will parse an entry for CEF.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a CEF message.
+func (p *Parser) parse(value any) (any, error) {
+	var message string
+	switch m := value.(type) {
+	case string:
+		message = m
+	case []byte:
+		message = string(m)
+	default:
+		return nil, fmt.Errorf("type '%T' cannot be parsed as CEF", value)
+	}
+
+	message = strings.TrimSpace(message)
+	if !strings.HasPrefix(message, cefPrefix) {
+		return nil, fmt.Errorf("message does not start with %q", cefPrefix)
+	}
+	message = message[len(cefPrefix):]
+
+	parsed := make(map[string]any, len(headerFields)+1)
+	for _, field := range headerFields {
+		end := headerFieldEnd(message)
+		if end < 0 {
+			return nil, fmt.Errorf("expected %d header fields separated by '|'", len(headerFields))
+		}
+		parsed[field] = unescapeHeader(message[:end])
+		message = message[end+1:]
+	}
+
+	extensions, err := parseExtensions(message)
+	if err != nil {
+		return nil, err
+	}
+	if p.mapCustomLabels {
+		mapCustomLabels(extensions)
+	}
+	parsed["extensions"] = extensions
+	return parsed, nil
+}
+
+// headerFieldEnd returns the index of the first unescaped pipe, or -1 if there is none.
+func headerFieldEnd(s string) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '|':
+			return i
+		}
+	}
+	return -1
+}
+
+func unescapeHeader(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	return strings.NewReplacer(`\|`, `|`, `\\`, `\`).Replace(s)
+}
+
+func unescapeExtension(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	return strings.NewReplacer(`\=`, `=`, `\\`, `\`, `\n`, "\n", `\r`, "\r").Replace(s)
+}
+
+// parseExtensions parses the space separated key=value extensions. The values may contain spaces,
+// so a value ends where the next key starts, that is at the last space preceding the next unescaped equal sign.
+func parseExtensions(s string) (map[string]any, error) {
+	extensions := make(map[string]any)
+	key := ""
+	valueStart := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '=':
+			keyStart := strings.LastIndexByte(s[:i], ' ') + 1
+			if keyStart < valueStart || !isValidKey(s[keyStart:i]) {
+				// an unescaped equal sign within a value
+				continue
+			}
+			if key != "" {
+				extensions[key] = unescapeExtension(strings.TrimRight(s[valueStart:keyStart], " "))
+			} else if strings.TrimSpace(s[:keyStart]) != "" {
+				return nil, errors.New("extensions must be key=value pairs")
+			}
+			key = s[keyStart:i]
+			valueStart = i + 1
+		}
+	}
+
+	if key != "" {
+		extensions[key] = unescapeExtension(strings.TrimRight(s[valueStart:], " "))
+	} else if strings.TrimSpace(s) != "" {
+		return nil, errors.New("extensions must be key=value pairs")
+	}
+	return extensions, nil
+}
+
+func isValidKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, c := range key {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_', c == '.', c == '-', c == '[', c == ']':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// mapCustomLabels renames the extensions having a label extension to the value of the label.
+func mapCustomLabels(extensions map[string]any) {
+	labels := make(map[string]string)
+	for key, value := range extensions {
+		label, ok := value.(string)
+		if !ok || label == "" || !strings.HasSuffix(key, labelSuffix) {
+			continue
+		}
+		if _, ok := extensions[strings.TrimSuffix(key, labelSuffix)]; ok {
+			labels[strings.TrimSuffix(key, labelSuffix)] = label
+		}
+	}
+	for key, label := range labels {
+		value := extensions[key]
+		delete(extensions, key)
+		delete(extensions, key+labelSuffix)
+		extensions[label] = value
+	}
+}
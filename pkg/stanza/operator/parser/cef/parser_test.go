@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cef
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+)
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("cef_parser")
+	require.True(t, ok, "expected cef_parser to be registered")
+	require.Equal(t, "cef_parser", builder().Type())
+}
+
+func TestParserBuildFailure(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.OnError = "invalid_on_error"
+	set := componenttest.NewNopTelemetrySettings()
+	_, err := cfg.Build(set)
+	require.ErrorContains(t, err, "invalid `on_error` field")
+}
+
+func TestParserParse(t *testing.T) {
+	cases := []struct {
+		name            string
+		input           any
+		mapCustomLabels bool
+		expected        map[string]any
+		expectErr       string
+	}{
+		{
+			name:  "extensions",
+			input: `CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|src=10.0.0.1 dst=2.1.2.2 spt=1232`,
+			expected: map[string]any{
+				"version":               "0",
+				"device_vendor":         "Security",
+				"device_product":        "threatmanager",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "worm successfully stopped",
+				"severity":              "10",
+				"extensions": map[string]any{
+					"src": "10.0.0.1",
+					"dst": "2.1.2.2",
+					"spt": "1232",
+				},
+			},
+		},
+		{
+			name:  "bytes_without_extensions",
+			input: []byte("CEF:1|Vendor|Product|2.3|login|User login|Low|\n"),
+			expected: map[string]any{
+				"version":               "1",
+				"device_vendor":         "Vendor",
+				"device_product":        "Product",
+				"device_version":        "2.3",
+				"device_event_class_id": "login",
+				"name":                  "User login",
+				"severity":              "Low",
+				"extensions":            map[string]any{},
+			},
+		},
+		{
+			name:  "escaped",
+			input: `CEF:0|security\|inc|threat\\manager|1.0|100|detected a \| in message|10|msg=detected a \\ and a \= in\nmessage filePath=/tmp/a=b act=blocked a b`,
+			expected: map[string]any{
+				"version":               "0",
+				"device_vendor":         "security|inc",
+				"device_product":        `threat\manager`,
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "detected a | in message",
+				"severity":              "10",
+				"extensions": map[string]any{
+					"msg":      "detected a \\ and a = in\nmessage",
+					"filePath": "/tmp/a=b",
+					"act":      "blocked a b",
+				},
+			},
+		},
+		{
+			name:  "empty_value",
+			input: `CEF:0|Vendor|Product|1.0|100|name|5|suser= duser=bob  `,
+			expected: map[string]any{
+				"version":               "0",
+				"device_vendor":         "Vendor",
+				"device_product":        "Product",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "name",
+				"severity":              "5",
+				"extensions": map[string]any{
+					"suser": "",
+					"duser": "bob",
+				},
+			},
+		},
+		{
+			name:  "custom_labels_not_mapped",
+			input: `CEF:0|Vendor|Product|1.0|100|name|5|cs1=admin cs1Label=Role`,
+			expected: map[string]any{
+				"version":               "0",
+				"device_vendor":         "Vendor",
+				"device_product":        "Product",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "name",
+				"severity":              "5",
+				"extensions": map[string]any{
+					"cs1":      "admin",
+					"cs1Label": "Role",
+				},
+			},
+		},
+		{
+			name:            "custom_labels_mapped",
+			input:           `CEF:0|Vendor|Product|1.0|100|name|5|cs1Label=User Role cs1=admin cn1=3 cn1Label= cs2Label=Unused`,
+			mapCustomLabels: true,
+			expected: map[string]any{
+				"version":               "0",
+				"device_vendor":         "Vendor",
+				"device_product":        "Product",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "name",
+				"severity":              "5",
+				"extensions": map[string]any{
+					"User Role": "admin",
+					"cn1":       "3",
+					"cn1Label":  "",
+					"cs2Label":  "Unused",
+				},
+			},
+		},
+		{
+			name:      "not_cef",
+			input:     `LEEF:1.0|Vendor|Product|1.0|100|`,
+			expectErr: `message does not start with "CEF:"`,
+		},
+		{
+			name:      "missing_header_fields",
+			input:     `CEF:0|Vendor|Product|1.0|100|name`,
+			expectErr: "expected 7 header fields separated by '|'",
+		},
+		{
+			name:      "invalid_extensions",
+			input:     `CEF:0|Vendor|Product|1.0|100|name|5|no extension`,
+			expectErr: "extensions must be key=value pairs",
+		},
+		{
+			name:      "invalid_type",
+			input:     map[string]any{},
+			expectErr: "type 'map[string]interface {}' cannot be parsed as CEF",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parser := Parser{mapCustomLabels: tc.mapCustomLabels}
+			parsed, err := parser.parse(tc.input)
+			if tc.expectErr != "" {
+				require.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, parsed)
+		})
+	}
+}
+
+func TestProcess(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.ParseTo = entry.RootableField{Field: entry.NewAttributeField("cef")}
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	e := entry.New()
+	e.Body = `CEF:0|Vendor|Product|1.0|100|name|5|src=10.0.0.1`
+	require.NoError(t, op.Process(t.Context(), e))
+	require.Equal(t, map[string]any{
+		"cef": map[string]any{
+			"version":               "0",
+			"device_vendor":         "Vendor",
+			"device_product":        "Product",
+			"device_version":        "1.0",
+			"device_event_class_id": "100",
+			"name":                  "name",
+			"severity":              "5",
+			"extensions": map[string]any{
+				"src": "10.0.0.1",
+			},
+		},
+	}, e.Attributes)
+}
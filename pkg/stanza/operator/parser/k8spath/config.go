@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8spath // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/k8spath"
+
+import (
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "k8s_path_parser"
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new k8s path parser config with default values.
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new k8s path parser config with default values.
+func NewConfigWithID(operatorID string) *Config {
+	parserConfig := helper.NewParserConfig(operatorID, operatorType)
+	parserConfig.ParseFrom = entry.NewAttributeField(attrs.LogFilePath)
+	parserConfig.ParseTo = entry.RootableField{Field: entry.NewResourceField()}
+	return &Config{
+		ParserConfig:    parserConfig,
+		ResolveSymlinks: true,
+	}
+}
+
+// Config is the configuration of a k8s path parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+
+	// ResolveSymlinks resolves the paths of /var/log/containers, which are symlinks to the log files of
+	// /var/log/pods, to extract the metadata only available in the latter.
+	ResolveSymlinks bool `mapstructure:"resolve_symlinks"`
+}
+
+// Build will build a k8s path parser operator.
+func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(set)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parser{
+		ParserOperator:  parserOperator,
+		resolveSymlinks: c.ResolveSymlinks,
+		resolved:        map[string]string{},
+	}, nil
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8spath // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/k8spath"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sync"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+// maxResolvedPaths bounds the number of symlinks whose target is cached
+const maxResolvedPaths = 4096
+
+var (
+	// podsPathMatcher matches the log files of /var/log/pods: <namespace>_<pod>_<uid>/<container>/<restart>.log,
+	// including the files rotated by the kubelet.
+	podsPathMatcher = regexp.MustCompile(`(?:^|[/\\])(?P<namespace>[^_/\\]+)_(?P<pod_name>[^_/\\]+)_(?P<uid>[a-fA-F0-9\-]+)[/\\](?P<container_name>[^/\\]+)[/\\](?P<restart_count>\d+)\.log(?:\.\d{8}-\d{6})?(?:\.gz)?$`)
+	// containersPathMatcher matches the symlinks of /var/log/containers: <pod>_<namespace>_<container>-<id>.log
+	containersPathMatcher = regexp.MustCompile(`(?:^|[/\\])(?P<pod_name>[^_/\\]+)_(?P<namespace>[^_/\\]+)_(?P<container_name>[^/\\]+)-(?P<container_id>[a-fA-F0-9]{64})\.log$`)
+
+	k8sMetadataMapping = map[string]string{
+		"container_name": "k8s.container.name",
+		"namespace":      "k8s.namespace.name",
+		"pod_name":       "k8s.pod.name",
+		"restart_count":  "k8s.container.restart_count",
+		"uid":            "k8s.pod.uid",
+		"container_id":   "container.id",
+	}
+)
+
+// Parser is an operator that parses the Kubernetes metadata of the path of a container log file.
+type Parser struct {
+	helper.ParserOperator
+	resolveSymlinks bool
+
+	mu       sync.Mutex
+	resolved map[string]string
+}
+
+func (p *Parser) ProcessBatch(ctx context.Context, entries []*entry.Entry) error {
+	return p.ProcessBatchWith(ctx, entries, p.parse)
+}
+
+// Process will parse an entry.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse the Kubernetes metadata of a log file path.
+func (p *Parser) parse(value any) (any, error) {
+	path, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("type '%T' cannot be parsed as log path", value)
+	}
+
+	if values, err := helper.MatchValues(path, podsPathMatcher); err == nil {
+		return mapMetadata(values), nil
+	}
+
+	values, err := helper.MatchValues(path, containersPathMatcher)
+	if err != nil {
+		return nil, errors.New("failed to detect a valid Kubernetes log path")
+	}
+	metadata := mapMetadata(values)
+	if p.resolveSymlinks {
+		// The target of the symlink holds the pod uid and restart count
+		if target := p.resolve(path); target != "" {
+			if podValues, err := helper.MatchValues(target, podsPathMatcher); err == nil {
+				for k, v := range mapMetadata(podValues) {
+					metadata[k] = v
+				}
+			}
+		}
+	}
+	return metadata, nil
+}
+
+// resolve returns the target of a symlink, or an empty string if it can't be resolved.
+func (p *Parser) resolve(path string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if target, ok := p.resolved[path]; ok {
+		return target
+	}
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		// The symlink is removed with the container, don't cache the failure
+		return ""
+	}
+	if len(p.resolved) >= maxResolvedPaths {
+		clear(p.resolved)
+	}
+	p.resolved[path] = target
+	return target
+}
+
+func mapMetadata(values map[string]any) map[string]any {
+	metadata := make(map[string]any, len(values))
+	for k, v := range values {
+		if attribute, ok := k8sMetadataMapping[k]; ok {
+			metadata[attribute] = v
+		}
+	}
+	return metadata
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8spath
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+const containerID = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func newTestParser(t *testing.T) *Parser {
+	cfg := NewConfigWithID("test")
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := cfg.Build(set)
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("k8s_path_parser")
+	require.True(t, ok, "expected k8s_path_parser to be registered")
+	require.Equal(t, "k8s_path_parser", builder().Type())
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.ErrorContains(t, err, "type '[]int' cannot be parsed as log path")
+}
+
+func TestParserInvalidPath(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse("/var/log/syslog")
+	require.ErrorContains(t, err, "failed to detect a valid Kubernetes log path")
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name   string
+		path   string
+		expect map[string]any
+	}{
+		{
+			name: "pods",
+			path: "/var/log/pods/default_my-pod_49cc7c1fd3702c40b2686ea7486091d6/my-container/1.log",
+			expect: map[string]any{
+				"k8s.namespace.name":          "default",
+				"k8s.pod.name":                "my-pod",
+				"k8s.pod.uid":                 "49cc7c1fd3702c40b2686ea7486091d6",
+				"k8s.container.name":          "my-container",
+				"k8s.container.restart_count": "1",
+			},
+		},
+		{
+			name: "pods rotated",
+			path: "/var/log/pods/kube-system_coredns-5d78c9869d-x2x6p_0c1d5d4e-6b6e-4f0b-9f7e-1e0b2e6f7a8b/coredns/0.log.20240102-030405.gz",
+			expect: map[string]any{
+				"k8s.namespace.name":          "kube-system",
+				"k8s.pod.name":                "coredns-5d78c9869d-x2x6p",
+				"k8s.pod.uid":                 "0c1d5d4e-6b6e-4f0b-9f7e-1e0b2e6f7a8b",
+				"k8s.container.name":          "coredns",
+				"k8s.container.restart_count": "0",
+			},
+		},
+		{
+			name: "pods windows",
+			path: `C:\var\log\pods\default_my-pod_49cc7c1fd3702c40b2686ea7486091d6\my-container\2.log`,
+			expect: map[string]any{
+				"k8s.namespace.name":          "default",
+				"k8s.pod.name":                "my-pod",
+				"k8s.pod.uid":                 "49cc7c1fd3702c40b2686ea7486091d6",
+				"k8s.container.name":          "my-container",
+				"k8s.container.restart_count": "2",
+			},
+		},
+		{
+			name: "containers",
+			path: "/var/log/containers/my-pod_default_my-container-" + containerID + ".log",
+			expect: map[string]any{
+				"k8s.namespace.name": "default",
+				"k8s.pod.name":       "my-pod",
+				"k8s.container.name": "my-container",
+				"container.id":       containerID,
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parser := newTestParser(t)
+			parsed, err := parser.parse(tc.path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, parsed)
+		})
+	}
+}
+
+func TestProcessResolveSymlinks(t *testing.T) {
+	dir := t.TempDir()
+	podDir := filepath.Join(dir, "pods", "default_my-pod_49cc7c1fd3702c40b2686ea7486091d6", "my-container")
+	require.NoError(t, os.MkdirAll(podDir, 0o755))
+	target := filepath.Join(podDir, "3.log")
+	require.NoError(t, os.WriteFile(target, nil, 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "containers"), 0o755))
+	link := filepath.Join(dir, "containers", "my-pod_default_my-container-"+containerID+".log")
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	parser := newTestParser(t)
+	fake := testutil.NewFakeOutput(t)
+	parser.OutputOperators = []operator.Operator{fake}
+
+	e := entry.New()
+	e.Attributes = map[string]any{"log.file.path": link}
+	require.NoError(t, parser.Process(t.Context(), e))
+	assert.Equal(t, map[string]any{
+		"k8s.namespace.name":          "default",
+		"k8s.pod.name":                "my-pod",
+		"k8s.pod.uid":                 "49cc7c1fd3702c40b2686ea7486091d6",
+		"k8s.container.name":          "my-container",
+		"k8s.container.restart_count": "3",
+		"container.id":                containerID,
+	}, e.Resource)
+	assert.Equal(t, map[string]string{link: target}, parser.resolved)
+}
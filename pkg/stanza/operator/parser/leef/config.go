@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/leef"
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "leef_parser"
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new LEEF parser config with default values.
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new LEEF parser config with default values.
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a LEEF parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+
+	// Delimiter is the attribute delimiter of LEEF 1.0 messages, and of LEEF 2.0 messages without delimiter header field.
+	Delimiter string `mapstructure:"delimiter"`
+}
+
+// Build will build a LEEF parser operator.
+func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(set)
+	if err != nil {
+		return nil, err
+	}
+
+	delimiter := defaultDelimiter
+	if c.Delimiter != "" {
+		if utf8.RuneCountInString(c.Delimiter) != 1 {
+			return nil, errors.New("delimiter must be a single character")
+		}
+		delimiter, _ = utf8.DecodeRuneInString(c.Delimiter)
+	}
+
+	return &Parser{
+		ParserOperator: parserOperator,
+		delimiter:      delimiter,
+	}, nil
+}
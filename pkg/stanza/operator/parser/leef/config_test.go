@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leef
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
+)
+
+func TestConfig(t *testing.T) {
+	operatortest.ConfigUnmarshalTests{
+		DefaultConfig: NewConfig(),
+		TestsFile:     filepath.Join(".", "testdata", "config.yaml"),
+		Tests: []operatortest.ConfigUnmarshalTest{
+			{
+				Name:   "default",
+				Expect: NewConfig(),
+			},
+			{
+				Name: "delimiter",
+				Expect: func() *Config {
+					p := NewConfig()
+					p.Delimiter = "^"
+					return p
+				}(),
+			},
+			{
+				Name: "on_error_drop",
+				Expect: func() *Config {
+					p := NewConfig()
+					p.OnError = "drop"
+					return p
+				}(),
+			},
+			{
+				Name: "parse_from_simple",
+				Expect: func() *Config {
+					p := NewConfig()
+					p.ParseFrom = entry.NewBodyField("from")
+					return p
+				}(),
+			},
+			{
+				Name: "parse_to_simple",
+				Expect: func() *Config {
+					p := NewConfig()
+					p.ParseTo = entry.RootableField{Field: entry.NewBodyField("log")}
+					return p
+				}(),
+			},
+		},
+	}.Run(t)
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/leef"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	leefPrefix       = "LEEF:"
+	defaultDelimiter = '\t'
+)
+
+// headerFields are the names of the pipe separated fields preceding the attributes.
+var headerFields = []string{
+	"version",
+	"vendor",
+	"product",
+	"product_version",
+	"event_id",
+}
+
+// Parser is an operator that parses IBM Log Event Extended Format messages.
+type Parser struct {
+	helper.ParserOperator
+	delimiter rune
+}
+
+func (p *Parser) ProcessBatch(ctx context.Context, entries []*entry.Entry) error {
+	return p.ProcessBatchWith(ctx, entries, p.parse)
+}
+
+// Process will parse an entry for LEEF.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a LEEF message.
+func (p *Parser) parse(value any) (any, error) {
+	var message string
+	switch m := value.(type) {
+	case string:
+		message = m
+	case []byte:
+		message = string(m)
+	default:
+		return nil, fmt.Errorf("type '%T' cannot be parsed as LEEF", value)
+	}
+
+	message = strings.TrimRight(strings.TrimLeft(message, " "), "\r\n")
+	if !strings.HasPrefix(message, leefPrefix) {
+		return nil, fmt.Errorf("message does not start with %q", leefPrefix)
+	}
+	message = message[len(leefPrefix):]
+
+	parsed := make(map[string]any, len(headerFields)+1)
+	for _, field := range headerFields {
+		end := strings.IndexByte(message, '|')
+		if end < 0 {
+			return nil, fmt.Errorf("expected %d header fields separated by '|'", len(headerFields))
+		}
+		parsed[field] = message[:end]
+		message = message[end+1:]
+	}
+
+	delimiter := p.delimiter
+	if strings.HasPrefix(parsed["version"].(string), "2") {
+		// LEEF 2.0 messages may specify the delimiter in an additional header field
+		if end := strings.IndexByte(message, '|'); end >= 0 {
+			if d, ok := parseDelimiter(message[:end], delimiter); ok {
+				delimiter = d
+				message = message[end+1:]
+			}
+		}
+	}
+
+	attributes := make(map[string]any)
+	for attribute := range strings.SplitSeq(message, string(delimiter)) {
+		if strings.TrimSpace(attribute) == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(attribute, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("attribute %q is not a key=value pair", attribute)
+		}
+		attributes[key] = value
+	}
+	parsed["attributes"] = attributes
+	return parsed, nil
+}
+
+// parseDelimiter parses the delimiter header field of LEEF 2.0, which is either
+// a single character or its hexadecimal code prefixed by x or 0x. An empty field
+// stands for the configured delimiter.
+func parseDelimiter(field string, configured rune) (rune, bool) {
+	if field == "" {
+		return configured, true
+	}
+	if utf8.RuneCountInString(field) == 1 {
+		r, _ := utf8.DecodeRuneInString(field)
+		return r, true
+	}
+	hex, ok := strings.CutPrefix(strings.ToLower(field), "0x")
+	if !ok {
+		hex, ok = strings.CutPrefix(strings.ToLower(field), "x")
+	}
+	if !ok || len(hex) > 4 {
+		return 0, false
+	}
+	code, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, false
+	}
+	return rune(code), true
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leef
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+)
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("leef_parser")
+	require.True(t, ok, "expected leef_parser to be registered")
+	require.Equal(t, "leef_parser", builder().Type())
+}
+
+func TestParserBuildFailure(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.OnError = "invalid_on_error"
+	set := componenttest.NewNopTelemetrySettings()
+	_, err := cfg.Build(set)
+	require.ErrorContains(t, err, "invalid `on_error` field")
+
+	cfg = NewConfigWithID("test")
+	cfg.Delimiter = "||"
+	_, err = cfg.Build(set)
+	require.EqualError(t, err, "delimiter must be a single character")
+}
+
+func TestParserParse(t *testing.T) {
+	header := func(version string) map[string]any {
+		return map[string]any{
+			"version":         version,
+			"vendor":          "Lancope",
+			"product":         "StealthWatch",
+			"product_version": "1.0",
+			"event_id":        "41",
+		}
+	}
+	withAttributes := func(parsed map[string]any, attributes map[string]any) map[string]any {
+		parsed["attributes"] = attributes
+		return parsed
+	}
+
+	cases := []struct {
+		name      string
+		input     any
+		delimiter rune
+		expected  map[string]any
+		expectErr string
+	}{
+		{
+			name:  "leef_1",
+			input: "LEEF:1.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8\tdst=10.0.0.5\tsev=5\tmsg=a=b c\r\n",
+			expected: withAttributes(header("1.0"), map[string]any{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+				"sev": "5",
+				"msg": "a=b c",
+			}),
+		},
+		{
+			name:      "leef_1_configured_delimiter",
+			input:     []byte("LEEF:1.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8^dst=10.0.0.5^"),
+			delimiter: '^',
+			expected: withAttributes(header("1.0"), map[string]any{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+			}),
+		},
+		{
+			name:  "leef_2_character_delimiter",
+			input: "LEEF:2.0|Lancope|StealthWatch|1.0|41|^|src=10.0.1.8^dst=10.0.0.5^msg=a|b",
+			expected: withAttributes(header("2.0"), map[string]any{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+				"msg": "a|b",
+			}),
+		},
+		{
+			name:  "leef_2_hex_delimiter",
+			input: "LEEF:2.0|Lancope|StealthWatch|1.0|41|0x7C|src=10.0.1.8|dst=10.0.0.5",
+			expected: withAttributes(header("2.0"), map[string]any{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+			}),
+		},
+		{
+			name:  "leef_2_short_hex_delimiter",
+			input: "LEEF:2.0|Lancope|StealthWatch|1.0|41|x5E|src=10.0.1.8^dst=10.0.0.5",
+			expected: withAttributes(header("2.0"), map[string]any{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+			}),
+		},
+		{
+			name:  "leef_2_empty_delimiter",
+			input: "LEEF:2.0|Lancope|StealthWatch|1.0|41||src=10.0.1.8\tdst=10.0.0.5",
+			expected: withAttributes(header("2.0"), map[string]any{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+			}),
+		},
+		{
+			name:  "leef_2_without_delimiter",
+			input: "LEEF:2.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8\tmsg=a|b",
+			expected: withAttributes(header("2.0"), map[string]any{
+				"src": "10.0.1.8",
+				"msg": "a|b",
+			}),
+		},
+		{
+			name:     "without_attributes",
+			input:    "LEEF:1.0|Lancope|StealthWatch|1.0|41|",
+			expected: withAttributes(header("1.0"), map[string]any{}),
+		},
+		{
+			name:      "not_leef",
+			input:     "CEF:0|Lancope|StealthWatch|1.0|41|name|5|",
+			expectErr: `message does not start with "LEEF:"`,
+		},
+		{
+			name:      "missing_header_fields",
+			input:     "LEEF:1.0|Lancope|StealthWatch|1.0|41",
+			expectErr: "expected 5 header fields separated by '|'",
+		},
+		{
+			name:      "invalid_attribute",
+			input:     "LEEF:1.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8\tinvalid",
+			expectErr: `attribute "invalid" is not a key=value pair`,
+		},
+		{
+			name:      "invalid_type",
+			input:     map[string]any{},
+			expectErr: "type 'map[string]interface {}' cannot be parsed as LEEF",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parser := Parser{delimiter: defaultDelimiter}
+			if tc.delimiter != 0 {
+				parser.delimiter = tc.delimiter
+			}
+			parsed, err := parser.parse(tc.input)
+			if tc.expectErr != "" {
+				require.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, parsed)
+		})
+	}
+}
+
+func TestProcess(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Delimiter = "^"
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	e := entry.New()
+	e.Body = "LEEF:1.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8^dst=10.0.0.5"
+	require.NoError(t, op.Process(t.Context(), e))
+	require.Equal(t, map[string]any{
+		"version":         "1.0",
+		"vendor":          "Lancope",
+		"product":         "StealthWatch",
+		"product_version": "1.0",
+		"event_id":        "41",
+		"attributes": map[string]any{
+			"src": "10.0.1.8",
+			"dst": "10.0.0.5",
+		},
+	}, e.Attributes)
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonsplitter // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/transformer/jsonsplitter"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+// Transformer is an operator that splits a JSON array or concatenated JSON values into multiple entries.
+type Transformer struct {
+	helper.TransformerOperator
+	field entry.Field
+}
+
+func (t *Transformer) ProcessBatch(ctx context.Context, entries []*entry.Entry) error {
+	splitEntries := make([]*entry.Entry, 0, len(entries))
+	write := func(_ context.Context, ent *entry.Entry) error {
+		splitEntries = append(splitEntries, ent)
+		return nil
+	}
+	var errs []error
+	for _, ent := range entries {
+		errs = append(errs, t.processWithWrite(ctx, ent, write))
+	}
+	errs = append(errs, t.WriteBatch(ctx, splitEntries))
+	return errors.Join(errs...)
+}
+
+// Process will split an entry into an entry per JSON value
+func (t *Transformer) Process(ctx context.Context, ent *entry.Entry) error {
+	return t.processWithWrite(ctx, ent, t.Write)
+}
+
+func (t *Transformer) processWithWrite(ctx context.Context, ent *entry.Entry, write helper.WriteFunction) error {
+	skip, err := t.Skip(ctx, ent)
+	if err != nil {
+		return t.HandleEntryErrorWithWrite(ctx, ent, err, write)
+	}
+	if skip {
+		return write(ctx, ent)
+	}
+
+	value, ok := t.field.Get(ent)
+	if !ok {
+		return write(ctx, ent)
+	}
+
+	values, err := split(value)
+	if err != nil {
+		return t.HandleEntryErrorWithWrite(ctx, ent, err, write)
+	}
+
+	var errs []error
+	for i, v := range values {
+		splitEntry := ent
+		if i < len(values)-1 {
+			splitEntry = ent.Copy()
+		}
+		if err := t.field.Set(splitEntry, v); err != nil {
+			errs = append(errs, t.HandleEntryErrorWithWrite(ctx, splitEntry, err, write))
+			continue
+		}
+		errs = append(errs, write(ctx, splitEntry))
+	}
+	return errors.Join(errs...)
+}
+
+// split decodes the JSON values of the input one at a time, expanding the top-level arrays into their elements.
+func split(value any) ([]string, error) {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return nil, fmt.Errorf("type %T cannot be split", value)
+	}
+
+	var values []string
+	var decoded bool
+	dec := json.NewDecoder(strings.NewReader(s))
+	for {
+		rest := strings.TrimLeft(s[dec.InputOffset():], " \t\r\n")
+		if rest == "" {
+			if !decoded {
+				return nil, errors.New("no JSON value to split")
+			}
+			return values, nil
+		}
+		decoded = true
+
+		if rest[0] != '[' {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return nil, fmt.Errorf("failed to decode JSON value: %w", err)
+			}
+			values = append(values, string(raw))
+			continue
+		}
+
+		// consume the opening bracket, then the elements one by one
+		if _, err := dec.Token(); err != nil {
+			return nil, fmt.Errorf("failed to decode JSON array: %w", err)
+		}
+		for dec.More() {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return nil, fmt.Errorf("failed to decode JSON array element: %w", err)
+			}
+			values = append(values, string(raw))
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, fmt.Errorf("failed to decode JSON array: %w", err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonsplitter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestSplit(t *testing.T) {
+	cases := []struct {
+		name      string
+		input     any
+		expected  []string
+		expectErr string
+	}{
+		{
+			name:     "array",
+			input:    `[{"a":1},{"b":[2,3]},"c",4,null]`,
+			expected: []string{`{"a":1}`, `{"b":[2,3]}`, `"c"`, `4`, `null`},
+		},
+		{
+			name:     "array_bytes",
+			input:    []byte(` [ {"a":1} , {"b":2} ] `),
+			expected: []string{`{"a":1}`, `{"b":2}`},
+		},
+		{
+			name:     "ndjson",
+			input:    "{\"a\":1}\n{\"b\":2}\r\n{\"c\":3}\n",
+			expected: []string{`{"a":1}`, `{"b":2}`, `{"c":3}`},
+		},
+		{
+			name:     "concatenated",
+			input:    `{"a":1}{"b":2}[{"c":3},{"d":4}]`,
+			expected: []string{`{"a":1}`, `{"b":2}`, `{"c":3}`, `{"d":4}`},
+		},
+		{
+			name:     "single_object",
+			input:    `{"a":[1,2]}`,
+			expected: []string{`{"a":[1,2]}`},
+		},
+		{
+			name:  "empty_array",
+			input: `[]`,
+		},
+		{
+			name:      "empty",
+			input:     " \n",
+			expectErr: "no JSON value to split",
+		},
+		{
+			name:      "truncated_array",
+			input:     `[{"a":1},{"b"`,
+			expectErr: "failed to decode JSON array element",
+		},
+		{
+			name:      "unterminated_array",
+			input:     `[{"a":1}`,
+			expectErr: "failed to decode JSON array",
+		},
+		{
+			name:      "invalid",
+			input:     `{"a":1} not json`,
+			expectErr: "failed to decode JSON value",
+		},
+		{
+			name:      "map",
+			input:     map[string]any{"a": 1},
+			expectErr: "type map[string]interface {} cannot be split",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			values, err := split(tc.input)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, values)
+		})
+	}
+}
+
+func TestProcess(t *testing.T) {
+	now := time.Now()
+	newTestEntry := func(body any) *entry.Entry {
+		e := entry.New()
+		e.ObservedTimestamp = now
+		e.Timestamp = time.Unix(1586632809, 0)
+		e.Attributes = map[string]any{"source": "vendor"}
+		e.Body = body
+		// the split entries are copies of the input entry
+		return e.Copy()
+	}
+
+	cfg := NewConfigWithID("test")
+	cfg.OutputIDs = []string{"fake"}
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+	require.NoError(t, op.Process(t.Context(), newTestEntry(`[{"a":1},{"b":2}]`)))
+	fake.ExpectEntry(t, newTestEntry(`{"a":1}`))
+	fake.ExpectEntry(t, newTestEntry(`{"b":2}`))
+
+	// the entry is sent unchanged on error
+	require.Error(t, op.Process(t.Context(), newTestEntry(`[{"a":1}`)))
+	fake.ExpectEntry(t, newTestEntry(`[{"a":1}`))
+
+	require.NoError(t, op.ProcessBatch(t.Context(), []*entry.Entry{
+		newTestEntry("{\"a\":1}\n{\"b\":2}"),
+		newTestEntry(`[]`),
+		newTestEntry(`[{"c":3}]`),
+	}))
+	fake.ExpectEntries(t, []*entry.Entry{
+		newTestEntry(`{"a":1}`),
+		newTestEntry(`{"b":2}`),
+		newTestEntry(`{"c":3}`),
+	})
+	fake.ExpectNoEntry(t, 100*time.Millisecond)
+}
+
+func TestProcessAttributeField(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.OutputIDs = []string{"fake"}
+	cfg.Field = entry.NewAttributeField("records")
+	cfg.IfExpr = `attributes.split == true`
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+	e := entry.New()
+	e.Body = "body"
+	e.Attributes = map[string]any{"split": true, "records": `[1,2]`}
+	require.NoError(t, op.Process(t.Context(), e))
+	fake.ExpectBody(t, "body")
+	fake.ExpectBody(t, "body")
+	require.Empty(t, fake.Received)
+
+	e = entry.New()
+	e.Attributes = map[string]any{"records": `[1,2]`}
+	require.NoError(t, op.Process(t.Context(), e))
+	fake.ExpectEntry(t, e)
+
+	// entries without the field are sent unchanged
+	e = entry.New()
+	e.Attributes = map[string]any{"split": true}
+	require.NoError(t, op.Process(t.Context(), e))
+	fake.ExpectEntry(t, e)
+}
@@ -15,10 +15,12 @@ import (
 )
 
 const (
-	hintAttributes = "loki.attribute.labels"
-	hintResources  = "loki.resource.labels"
-	hintTenant     = "loki.tenant"
-	hintFormat     = "loki.format"
+	hintAttributes                   = "loki.attribute.labels"
+	hintResources                    = "loki.resource.labels"
+	hintStructuredMetadataAttributes = "loki.attribute.structured_metadata"
+	hintStructuredMetadataResources  = "loki.resource.structured_metadata"
+	hintTenant                       = "loki.tenant"
+	hintFormat                       = "loki.format"
 )
 
 const (
@@ -58,6 +60,27 @@ func convertAttributesAndMerge(logAttrs, resAttrs pcommon.Map, defaultLabelsEnab
 	return out
 }
 
+// convertAttributesToStructuredMetadata selects the attributes to send as Loki
+// structured metadata, based on the "loki.attribute.structured_metadata" and
+// "loki.resource.structured_metadata" hints. The hints work like the label hints.
+func convertAttributesToStructuredMetadata(logAttrs, resAttrs pcommon.Map) model.LabelSet {
+	out := model.LabelSet{}
+
+	if resourcesToSelect, found := resAttrs.Get(hintStructuredMetadataResources); found {
+		out = out.Merge(convertAttributesToLabels(resAttrs, resourcesToSelect))
+	}
+
+	if resourcesToSelect, found := logAttrs.Get(hintStructuredMetadataResources); found {
+		out = out.Merge(convertAttributesToLabels(resAttrs, resourcesToSelect))
+	}
+
+	if attributesToSelect, found := logAttrs.Get(hintStructuredMetadataAttributes); found {
+		out = out.Merge(convertAttributesToLabels(logAttrs, attributesToSelect))
+	}
+
+	return out
+}
+
 func getDefaultLabels(resAttrs pcommon.Map, defaultLabelsEnabled map[string]bool) model.LabelSet {
 	out := model.LabelSet{}
 	if enabled, ok := defaultLabelsEnabled[exporterLabel]; enabled || !ok {
@@ -138,7 +161,8 @@ func parseAttributeNames(attrsToSelect pcommon.Value) []string {
 
 func removeAttributes(attrs pcommon.Map, labels model.LabelSet) {
 	attrs.RemoveIf(func(s string, _ pcommon.Value) bool {
-		if s == hintAttributes || s == hintResources || s == hintTenant || s == hintFormat {
+		switch s {
+		case hintAttributes, hintResources, hintStructuredMetadataAttributes, hintStructuredMetadataResources, hintTenant, hintFormat:
 			return true
 		}
 
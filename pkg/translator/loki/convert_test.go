@@ -173,6 +173,55 @@ func TestConvertAttributesAndMerge(t *testing.T) {
 	}
 }
 
+func TestConvertAttributesToStructuredMetadata(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		logAttrs map[string]any
+		resAttrs map[string]any
+		expected model.LabelSet
+	}{
+		{
+			desc:     "no hints should select nothing",
+			logAttrs: map[string]any{"trace_id": "4bf92f3577b34da6"},
+			expected: model.LabelSet{},
+		},
+		{
+			desc: "selected log attributes should be included",
+			logAttrs: map[string]any{
+				"trace_id":                       "4bf92f3577b34da6",
+				"pod.name":                       "should-be-ignored",
+				hintStructuredMetadataAttributes: "trace_id",
+			},
+			expected: model.LabelSet{"trace_id": "4bf92f3577b34da6"},
+		},
+		{
+			desc: "selected resource attributes from log and resource hints should be included",
+			logAttrs: map[string]any{
+				hintStructuredMetadataResources: "k8s.pod.uid",
+			},
+			resAttrs: map[string]any{
+				hintStructuredMetadataResources: []any{"host.id"},
+				"k8s.pod.uid":                   "9f7a1f5e",
+				"host.id":                       "i-0123",
+				"pod.name":                      "should-be-ignored",
+			},
+			expected: model.LabelSet{
+				"k8s.pod.uid": "9f7a1f5e",
+				"host.id":     "i-0123",
+			},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			logAttrs := pcommon.NewMap()
+			assert.NoError(t, logAttrs.FromRaw(tC.logAttrs))
+			resAttrs := pcommon.NewMap()
+			assert.NoError(t, resAttrs.FromRaw(tC.resAttrs))
+			assert.Equal(t, tC.expected, convertAttributesToStructuredMetadata(logAttrs, resAttrs))
+		})
+	}
+}
+
 func TestConvertAttributesToLabels(t *testing.T) {
 	attrsToSelectSlice := pcommon.NewValueSlice()
 	attrsToSelectSlice.Slice().AppendEmpty()
@@ -255,11 +304,13 @@ func TestRemoveAttributes(t *testing.T) {
 		{
 			desc: "remove hints",
 			attrs: map[string]any{
-				hintAttributes: "some.field",
-				hintResources:  "some.other.field",
-				hintFormat:     "logfmt",
-				hintTenant:     "some_tenant",
-				"host.name":    "guarana",
+				hintAttributes:                   "some.field",
+				hintResources:                    "some.other.field",
+				hintStructuredMetadataAttributes: "trace_id",
+				hintStructuredMetadataResources:  "k8s.pod.uid",
+				hintFormat:                       "logfmt",
+				hintTenant:                       "some_tenant",
+				"host.name":                      "guarana",
 			},
 			labels: model.LabelSet{},
 			expected: map[string]any{
@@ -5,6 +5,8 @@ package loki // import "github.com/open-telemetry/opentelemetry-collector-contri
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/grafana/loki/pkg/push"
 	"github.com/prometheus/common/model"
@@ -37,7 +39,9 @@ const (
 // and "loki.resource.labels". Each hint might contain a comma-separated list of
 // attributes (resource or record) that should be promoted to a Loki label. Those
 // attributes are removed from the body as a result, otherwise they would be shown
-// in duplicity in Loki.
+// in duplicity in Loki. Likewise, the hints "loki.attribute.structured_metadata"
+// and "loki.resource.structured_metadata" select attributes sent as structured
+// metadata of the entry, which does not add to the cardinality of the streams.
 // PushStreams are created based on the labels: all records containing the same
 // set of labels are part of the same stream. All streams are then packed within
 // the resulting PushRequest.
@@ -137,17 +141,32 @@ func LogToLokiEntry(lr plog.LogRecord, rl pcommon.Resource, scope pcommon.Instru
 	format := getFormatFromFormatHint(log.Attributes(), resource.Attributes())
 
 	mergedLabels := convertAttributesAndMerge(log.Attributes(), resource.Attributes(), defaultLabelsEnabled)
-	// remove the attributes that were promoted to labels
+	structuredMetadata := convertAttributesToStructuredMetadata(log.Attributes(), resource.Attributes())
+	// remove the attributes that were promoted to labels or structured metadata
 	removeAttributes(log.Attributes(), mergedLabels)
 	removeAttributes(resource.Attributes(), mergedLabels)
+	removeAttributes(log.Attributes(), structuredMetadata)
+	removeAttributes(resource.Attributes(), structuredMetadata)
 
 	entry, err := convertLogToLokiEntry(log, resource, format, scope)
 	if err != nil {
 		return nil, err
 	}
 
-	labels := model.LabelSet{}
 	namer := otlptranslator.LabelNamer{}
+	for _, name := range slices.Sorted(maps.Keys(structuredMetadata)) {
+		// structured metadata names follow the same rules as label names
+		metadataName, err := namer.Build(string(name))
+		if err != nil {
+			return nil, err
+		}
+		entry.StructuredMetadata = append(entry.StructuredMetadata, push.LabelAdapter{
+			Name:  metadataName,
+			Value: string(structuredMetadata[name]),
+		})
+	}
+
+	labels := model.LabelSet{}
 	for label := range mergedLabels {
 		// Loki doesn't support dots in label names
 		// labelName is normalized label name to follow Prometheus label names standard
@@ -551,6 +551,39 @@ func TestLogToLokiEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "with attributes and resources to structured metadata",
+			timestamp: time.Unix(0, 1677592916000000000),
+			res: map[string]any{
+				"k8s.pod.uid": "9f7a1f5e",
+				"region.az":   "eu-west-1a",
+			},
+			attrs: map[string]any{
+				"trace_id":    "4bf92f3577b34da6",
+				"host.name":   "guarana",
+				"http.status": 200,
+			},
+			hints: map[string]any{
+				hintAttributes:                   "host.name",
+				hintStructuredMetadataAttributes: "trace_id,http.status",
+				hintStructuredMetadataResources:  "k8s.pod.uid",
+			},
+			expected: &PushEntry{
+				Entry: &push.Entry{
+					Timestamp: time.Unix(0, 1677592916000000000),
+					Line:      `{"resources":{"region.az":"eu-west-1a"}}`,
+					StructuredMetadata: push.LabelsAdapter{
+						{Name: "http_status", Value: "200"},
+						{Name: "k8s_pod_uid", Value: "9f7a1f5e"},
+						{Name: "trace_id", Value: "4bf92f3577b34da6"},
+					},
+				},
+				Labels: model.LabelSet{
+					"exporter":  "OTLP",
+					"host_name": "guarana",
+				},
+			},
+		},
 		{
 			name:      "with logfmt format",
 			timestamp: time.Unix(0, 1677592916000000000),
@@ -95,6 +95,16 @@ type lookupTables struct {
 	lastStackTableIdx int32
 }
 
+// ConvertPprofToProfiles converts a pprof profile to pprofile.Profiles, with a
+// dedicated pprofile.Profile for each sample type of the pprof profile.
+func ConvertPprofToProfiles(src *profile.Profile) (pprofile.Profiles, error) {
+	dst, err := convertPprofToPprofile(src)
+	if err != nil {
+		return pprofile.Profiles{}, err
+	}
+	return *dst, nil
+}
+
 func convertPprofToPprofile(src *profile.Profile) (*pprofile.Profiles, error) {
 	if err := src.CheckValid(); err != nil {
 		return nil, fmt.Errorf("%w: %w", err, errPprofInvalid)
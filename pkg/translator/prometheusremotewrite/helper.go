@@ -98,7 +98,7 @@ var seps = []byte{'\xff'}
 // createAttributes creates a slice of Prometheus Labels with OTLP attributes and pairs of string values.
 // Unpaired string values are ignored. String pairs overwrite OTLP labels if collisions happen and
 // if logOnOverwrite is true, the overwrite is logged. Resulting label names are sanitized.
-func createAttributes(resource pcommon.Resource, attributes pcommon.Map, externalLabels map[string]string,
+func createAttributes(resource pcommon.Resource, attributes pcommon.Map, settings Settings,
 	ignoreAttrs []string, logOnOverwrite bool, labelNamer otlptranslator.LabelNamer, extras ...string,
 ) ([]prompb.Label, error) {
 	resourceAttrs := resource.Attributes()
@@ -106,7 +106,7 @@ func createAttributes(resource pcommon.Resource, attributes pcommon.Map, externa
 	instance, haveInstanceID := resourceAttrs.Get(string(conventions.ServiceInstanceIDKey))
 
 	// Calculate the maximum possible number of labels we could return so we can preallocate l
-	maxLabelCount := attributes.Len() + len(externalLabels) + len(extras)/2
+	maxLabelCount := attributes.Len() + len(settings.PromoteResourceAttributes) + len(settings.ExternalLabels) + len(extras)/2
 
 	if haveServiceName {
 		maxLabelCount++
@@ -146,6 +146,21 @@ func createAttributes(resource pcommon.Resource, attributes pcommon.Map, externa
 		}
 	}
 
+	// Promoted resource attributes don't override the attributes of the data point.
+	for _, key := range settings.PromoteResourceAttributes {
+		value, ok := resourceAttrs.Get(key)
+		if !ok || slices.Contains(ignoreAttrs, key) {
+			continue
+		}
+		finalKey, err := labelNamer.Build(key)
+		if err != nil {
+			return nil, err
+		}
+		if _, alreadyExists := l[finalKey]; !alreadyExists {
+			l[finalKey] = value.AsString()
+		}
+	}
+
 	// Map service.name + service.namespace to job
 	if haveServiceName {
 		val := serviceName.AsString()
@@ -158,7 +173,7 @@ func createAttributes(resource pcommon.Resource, attributes pcommon.Map, externa
 	if haveInstanceID {
 		l[model.InstanceLabel] = instance.AsString()
 	}
-	for key, value := range externalLabels {
+	for key, value := range settings.ExternalLabels {
 		// External labels have already been sanitized
 		if _, alreadyExists := l[key]; alreadyExists {
 			// Skip external labels if they are overridden by metric attributes
@@ -219,7 +234,7 @@ func (c *prometheusConverter) addHistogramDataPoints(dataPoints pmetric.Histogra
 	for x := 0; x < dataPoints.Len(); x++ {
 		pt := dataPoints.At(x)
 		timestamp := convertTimeStamp(pt.Timestamp())
-		baseLabels, err := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nil, false, c.labelNamer)
+		baseLabels, err := createAttributes(resource, pt.Attributes(), settings, nil, false, c.labelNamer)
 		if err != nil {
 			errs = multierr.Append(errs, err)
 			continue
@@ -402,7 +417,7 @@ func (c *prometheusConverter) addSummaryDataPoints(dataPoints pmetric.SummaryDat
 	for x := 0; x < dataPoints.Len(); x++ {
 		pt := dataPoints.At(x)
 		timestamp := convertTimeStamp(pt.Timestamp())
-		baseLabels, err := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nil, false, c.labelNamer)
+		baseLabels, err := createAttributes(resource, pt.Attributes(), settings, nil, false, c.labelNamer)
 		if err != nil {
 			errs = multierr.Append(errs, err)
 			continue
@@ -531,7 +546,7 @@ func addResourceTargetInfo(resource pcommon.Resource, settings Settings, timesta
 		name = settings.Namespace + "_" + name
 	}
 
-	labels, err := createAttributes(resource, attributes, settings.ExternalLabels, identifyingAttrs, false, otlptranslator.LabelNamer{PreserveMultipleUnderscores: !prometheustranslator.DropSanitizationGate.IsEnabled()}, model.MetricNameLabel, name)
+	labels, err := createAttributes(resource, attributes, settings, identifyingAttrs, false, otlptranslator.LabelNamer{PreserveMultipleUnderscores: !prometheustranslator.DropSanitizationGate.IsEnabled()}, model.MetricNameLabel, name)
 	if err != nil {
 		return err
 	}
@@ -553,6 +568,9 @@ func addResourceTargetInfo(resource pcommon.Resource, settings Settings, timesta
 		// convert ns to ms
 		Timestamp: convertTimeStamp(timestamp),
 	}
+	if !settings.TargetInfoLimiter.allow(labels, sample.Timestamp) {
+		return nil
+	}
 	converter.addSample(sample, labels)
 	return nil
 }
@@ -247,6 +247,7 @@ func Test_createLabelSet(t *testing.T) {
 		resource                    pcommon.Resource
 		orig                        pcommon.Map
 		externalLabels              map[string]string
+		promote                     []string
 		extras                      []string
 		want                        []prompb.Label
 		expectErr                   bool
@@ -286,6 +287,20 @@ func Test_createLabelSet(t *testing.T) {
 			extras:         []string{label31, value31, label32, value32},
 			want:           getPromLabels(label11, value11, label12, value12, label31, value31, label32, value32, "job", "12345", "instance", "true"),
 		},
+		{
+			name: "promoted_resource_attributes",
+			resource: func() pcommon.Resource {
+				res := pcommon.NewResource()
+				res.Attributes().PutStr("k8s.namespace.name", "namespace")
+				res.Attributes().PutStr("k8s.pod.name", "pod")
+				res.Attributes().PutStr(label11, "resource-value")
+				return res
+			}(),
+			orig:           lbs1,
+			externalLabels: map[string]string{},
+			promote:        []string{"k8s.namespace.name", label11, "missing"},
+			want:           getPromLabels(label11, value11, label12, value12, "k8s_namespace_name", "namespace"),
+		},
 		{
 			name:           "labels_duplicate_in_extras",
 			resource:       pcommon.NewResource(),
@@ -391,7 +406,7 @@ func Test_createLabelSet(t *testing.T) {
 			labelNamer := otlptranslator.LabelNamer{
 				UnderscoreLabelSanitization: tt.underscoreLabelSanitization,
 			}
-			got, err := createAttributes(tt.resource, tt.orig, tt.externalLabels, nil, true, labelNamer, tt.extras...)
+			got, err := createAttributes(tt.resource, tt.orig, Settings{ExternalLabels: tt.externalLabels, PromoteResourceAttributes: tt.promote}, nil, true, labelNamer, tt.extras...)
 			if tt.expectErr {
 				require.Error(t, err)
 				return
@@ -404,8 +419,6 @@ func Test_createLabelSet(t *testing.T) {
 
 func BenchmarkCreateAttributes(b *testing.B) {
 	r := pcommon.NewResource()
-	ext := map[string]string{}
-
 	m := pcommon.NewMap()
 	m.PutStr("test-string-key2", "test-value-2")
 	m.PutStr("test-string-key1", "test-value-1")
@@ -416,7 +429,7 @@ func BenchmarkCreateAttributes(b *testing.B) {
 
 	for b.Loop() {
 		//nolint:errcheck
-		createAttributes(r, m, ext, nil, true, otlptranslator.LabelNamer{})
+		createAttributes(r, m, Settings{}, nil, true, otlptranslator.LabelNamer{})
 	}
 }
 
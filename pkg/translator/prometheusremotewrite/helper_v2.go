@@ -48,7 +48,7 @@ func (c *prometheusConverterV2) addResourceTargetInfoV2(resource pcommon.Resourc
 		name = settings.Namespace + "_" + name
 	}
 
-	labels, err := createAttributes(resource, attributes, settings.ExternalLabels, identifyingAttrs, false, c.labelNamer, model.MetricNameLabel, name)
+	labels, err := createAttributes(resource, attributes, settings, identifyingAttrs, false, c.labelNamer, model.MetricNameLabel, name)
 	if err != nil {
 		return err
 	}
@@ -70,6 +70,9 @@ func (c *prometheusConverterV2) addResourceTargetInfoV2(resource pcommon.Resourc
 		// convert ns to ms
 		Timestamp: convertTimeStamp(timestamp),
 	}
+	if !settings.TargetInfoLimiter.allow(labels, sample.Timestamp) {
+		return nil
+	}
 	c.addSample(sample, labels, metadata{
 		Type: writev2.Metadata_METRIC_TYPE_GAUGE,
 		Help: "Target metadata",
@@ -102,7 +105,7 @@ func (c *prometheusConverterV2) addSummaryDataPoints(dataPoints pmetric.SummaryD
 	for x := 0; x < dataPoints.Len(); x++ {
 		pt := dataPoints.At(x)
 		timestamp := convertTimeStamp(pt.Timestamp())
-		baseLabels, err := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nil, false, c.labelNamer)
+		baseLabels, err := createAttributes(resource, pt.Attributes(), settings, nil, false, c.labelNamer)
 		if err != nil {
 			errs = multierr.Append(errs, err)
 			continue
@@ -130,7 +133,7 @@ func (c *prometheusConverterV2) addHistogramDataPoints(dataPoints pmetric.Histog
 	for x := 0; x < dataPoints.Len(); x++ {
 		pt := dataPoints.At(x)
 		timestamp := convertTimeStamp(pt.Timestamp())
-		baseLabels, err := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nil, false, c.labelNamer)
+		baseLabels, err := createAttributes(resource, pt.Attributes(), settings, nil, false, c.labelNamer)
 		if err != nil {
 			errs = multierr.Append(errs, err)
 			continue
@@ -23,7 +23,7 @@ func (c *prometheusConverter) addExponentialHistogramDataPoints(dataPoints pmetr
 	var errs error
 	for x := 0; x < dataPoints.Len(); x++ {
 		pt := dataPoints.At(x)
-		lbls, err := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nil, true, c.labelNamer, model.MetricNameLabel, baseName)
+		lbls, err := createAttributes(resource, pt.Attributes(), settings, nil, true, c.labelNamer, model.MetricNameLabel, baseName)
 		if err != nil {
 			errs = multierr.Append(errs, err)
 			continue
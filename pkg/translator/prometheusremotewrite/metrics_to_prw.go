@@ -25,6 +25,11 @@ type Settings struct {
 	DisableTargetInfo bool
 	AddMetricSuffixes bool
 	SendMetadata      bool
+	// PromoteResourceAttributes lists the resource attributes added as labels to all the series,
+	// unless the data points already have an attribute with the same label name.
+	PromoteResourceAttributes []string
+	// TargetInfoLimiter, if set, limits how often the target_info series of each resource is generated.
+	TargetInfoLimiter *TargetInfoLimiter
 }
 
 // FromMetrics converts pmetric.Metrics to Prometheus remote write format.
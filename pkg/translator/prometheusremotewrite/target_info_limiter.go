@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/prompb"
+)
+
+// TargetInfoLimiter limits the generation of the target_info series to one sample per interval
+// for each set of labels, since the attributes of a resource rarely change. A resource whose
+// attributes change gets a new set of labels, and its target_info series is generated right away.
+// It is safe for concurrent use.
+type TargetInfoLimiter struct {
+	// interval in milliseconds, like the timestamps of the samples.
+	interval int64
+
+	mu sync.Mutex
+	// last is the timestamp of the last sample generated for each signature of labels.
+	last      map[uint64]int64
+	lastSweep int64
+}
+
+// NewTargetInfoLimiter creates a TargetInfoLimiter generating at most one target_info sample per interval for each resource.
+func NewTargetInfoLimiter(interval time.Duration) *TargetInfoLimiter {
+	return &TargetInfoLimiter{
+		interval: interval.Milliseconds(),
+		last:     map[uint64]int64{},
+	}
+}
+
+// allow returns whether the target_info sample with the labels and the timestamp, in milliseconds,
+// should be generated.
+func (l *TargetInfoLimiter) allow(labels []prompb.Label, timestamp int64) bool {
+	if l == nil {
+		return true
+	}
+	signature := timeSeriesSignature(labels)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if last, ok := l.last[signature]; ok && timestamp >= last && timestamp-last < l.interval {
+		return false
+	}
+	l.last[signature] = timestamp
+
+	// The signatures which would be allowed again are forgotten, so that the resources
+	// which are gone don't accumulate.
+	if timestamp-l.lastSweep >= l.interval {
+		for s, last := range l.last {
+			if timestamp-last >= l.interval {
+				delete(l.last, s)
+			}
+		}
+		l.lastSweep = timestamp
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestTargetInfoLimiter(t *testing.T) {
+	var nilLimiter *TargetInfoLimiter
+	assert.True(t, nilLimiter.allow(nil, 0))
+
+	limiter := NewTargetInfoLimiter(time.Minute)
+	labelsA := []prompb.Label{{Name: "job", Value: "a"}}
+	labelsB := []prompb.Label{{Name: "job", Value: "b"}}
+
+	assert.True(t, limiter.allow(labelsA, 1000))
+	assert.False(t, limiter.allow(labelsA, 30_000))
+	assert.True(t, limiter.allow(labelsB, 30_000))
+	assert.True(t, limiter.allow(labelsA, 61_000))
+	assert.False(t, limiter.allow(labelsA, 120_000))
+	// Samples older than the last one are allowed, e.g. after a restart of the target.
+	assert.True(t, limiter.allow(labelsA, 10_000))
+
+	// The signatures which would be allowed again are forgotten.
+	assert.True(t, limiter.allow(labelsB, 1_000_000))
+	assert.Len(t, limiter.last, 1)
+}
+
+func TestAddResourceTargetInfoWithLimiter(t *testing.T) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "service-name")
+	resource.Attributes().PutStr("resource_attr", "resource-attr-val-1")
+	start := time.Unix(1000, 0)
+
+	for name, generate := range map[string]func(Settings, pcommon.Timestamp) int{
+		"v1": func(settings Settings, timestamp pcommon.Timestamp) int {
+			converter := newPrometheusConverter(settings)
+			require.NoError(t, addResourceTargetInfo(resource, settings, timestamp, converter))
+			return len(converter.timeSeries())
+		},
+		"v2": func(settings Settings, timestamp pcommon.Timestamp) int {
+			converter := newPrometheusConverterV2(settings)
+			require.NoError(t, converter.addResourceTargetInfoV2(resource, settings, timestamp))
+			return len(converter.timeSeries())
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			settings := Settings{TargetInfoLimiter: NewTargetInfoLimiter(5 * time.Minute)}
+			assert.Equal(t, 1, generate(settings, pcommon.NewTimestampFromTime(start)))
+			assert.Equal(t, 0, generate(settings, pcommon.NewTimestampFromTime(start.Add(time.Minute))))
+			assert.Equal(t, 1, generate(settings, pcommon.NewTimestampFromTime(start.Add(5*time.Minute))))
+		})
+	}
+}
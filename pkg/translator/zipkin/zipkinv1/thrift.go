@@ -13,6 +13,7 @@ import (
 	"math"
 	"net"
 
+	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/jaegertracing/jaeger-idl/thrift-gen/zipkincore"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -23,8 +24,16 @@ import (
 
 type thriftUnmarshaler struct{}
 
-// UnmarshalTraces from Thrift bytes.
+// UnmarshalTraces from Thrift bytes. The bytes are either a list of spans, or a
+// single span as sent by the legacy Zipkin Kafka reporters.
 func (thriftUnmarshaler) UnmarshalTraces(buf []byte) (ptrace.Traces, error) {
+	if len(buf) > 0 && buf[0] != byte(thrift.STRUCT) {
+		span, err := deserializeThriftSpan(context.TODO(), buf)
+		if err != nil {
+			return ptrace.Traces{}, err
+		}
+		return thriftBatchToTraces([]*zipkincore.Span{span})
+	}
 	spans, err := jaegerzipkin.DeserializeThrift(context.TODO(), buf)
 	if err != nil {
 		return ptrace.Traces{}, err
@@ -32,6 +41,21 @@ func (thriftUnmarshaler) UnmarshalTraces(buf []byte) (ptrace.Traces, error) {
 	return thriftBatchToTraces(spans)
 }
 
+// deserializeThriftSpan decodes a single TBinaryProtocol encoded span. A list of
+// spans starts with its element type, thrift.STRUCT, while a span starts with
+// the type of its first field.
+func deserializeThriftSpan(ctx context.Context, b []byte) (*zipkincore.Span, error) {
+	buffer := thrift.NewTMemoryBuffer()
+	if _, err := buffer.Write(b); err != nil {
+		return nil, err
+	}
+	zs := &zipkincore.Span{}
+	if err := zs.Read(ctx, thrift.NewTBinaryProtocolConf(buffer, &thrift.TConfiguration{})); err != nil {
+		return nil, err
+	}
+	return zs, nil
+}
+
 // NewThriftTracesUnmarshaler returns an unmarshaler for Zipkin Thrift.
 func NewThriftTracesUnmarshaler() ptrace.Unmarshaler {
 	return thriftUnmarshaler{}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/jaegertracing/jaeger-idl/thrift-gen/zipkincore"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -57,6 +58,25 @@ func TestV1ThriftToTraces(t *testing.T) {
 	assert.Equal(t, 5, td.SpanCount())
 }
 
+func TestV1ThriftSingleSpanToTraces(t *testing.T) {
+	blob, err := os.ReadFile("./testdata/zipkin_v1_thrift_single_batch.json")
+	require.NoError(t, err, "Failed to load test data")
+
+	var zSpans []*zipkincore.Span
+	require.NoError(t, json.Unmarshal(blob, &zSpans), "failed to unmarshal json test file")
+
+	buffer := thrift.NewTMemoryBuffer()
+	require.NoError(t, zSpans[0].Write(t.Context(), thrift.NewTBinaryProtocolConf(buffer, &thrift.TConfiguration{})))
+
+	td, err := thriftUnmarshaler{}.UnmarshalTraces(buffer.Bytes())
+	require.NoError(t, err, "Failed to translate a single zipkinv1 thrift span")
+	require.Equal(t, 1, td.SpanCount())
+	assert.Equal(t, zSpans[0].Name, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+
+	_, err = thriftUnmarshaler{}.UnmarshalTraces([]byte{0x0a, 0x00})
+	assert.Error(t, err)
+}
+
 func TestZipkinThriftFallbackToLocalComponent(t *testing.T) {
 	blob, err := os.ReadFile("./testdata/zipkin_v1_thrift_local_component.json")
 	require.NoError(t, err, "Failed to load test data")
@@ -6,12 +6,14 @@ package filterprocessor // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
@@ -311,6 +313,11 @@ type ProfileFilters struct {
 
 var _ component.Config = (*Config)(nil)
 
+// lintLogger logs the lint warnings found when the configuration is validated. The configuration is
+// validated before the logger of the collector is created, so they are written to the standard error.
+var lintLogger = zap.New(zapcore.NewCore(
+	zapcore.NewConsoleEncoder(zap.NewProductionEncoderConfig()), zapcore.Lock(os.Stderr), zapcore.WarnLevel))
+
 // Validate checks if the processor configuration is valid
 func (cfg *Config) Validate() error {
 	if (cfg.Traces.ResourceConditions != nil || cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil) && (cfg.Spans.Include != nil || cfg.Spans.Exclude != nil) {
@@ -383,5 +390,35 @@ func (cfg *Config) Validate() error {
 		errors = multierr.Append(errors, cfg.Logs.Exclude.validate())
 	}
 
+	if errors == nil {
+		cfg.logLintWarnings(lintLogger)
+	}
+
 	return errors
 }
+
+// logLintWarnings logs the potential issues found in the conditions, which don't prevent them from being executed.
+func (cfg *Config) logLintWarnings(logger *zap.Logger) {
+	logConditionsLintWarnings(logger, cfg.Traces.ResourceConditions, cfg.resourceFunctions)
+	logConditionsLintWarnings(logger, cfg.Traces.SpanConditions, cfg.spanFunctions)
+	logConditionsLintWarnings(logger, cfg.Traces.SpanEventConditions, cfg.spanEventFunctions)
+	logConditionsLintWarnings(logger, cfg.Metrics.ResourceConditions, cfg.resourceFunctions)
+	logConditionsLintWarnings(logger, cfg.Metrics.MetricConditions, cfg.metricFunctions)
+	logConditionsLintWarnings(logger, cfg.Metrics.DataPointConditions, cfg.dataPointFunctions)
+	logConditionsLintWarnings(logger, cfg.Logs.ResourceConditions, cfg.resourceFunctions)
+	logConditionsLintWarnings(logger, cfg.Logs.LogConditions, cfg.logFunctions)
+	logConditionsLintWarnings(logger, cfg.Profiles.ResourceConditions, cfg.resourceFunctions)
+	logConditionsLintWarnings(logger, cfg.Profiles.ProfileConditions, cfg.profileFunctions)
+}
+
+func logConditionsLintWarnings[K any](logger *zap.Logger, conditions []string, functions map[string]ottl.Factory[K]) {
+	// Invalid conditions are reported when the conditions are parsed.
+	warnings, _ := ottl.LintConditions(conditions, functions)
+	for _, w := range warnings {
+		logger.Warn("Potential issue in OTTL condition",
+			zap.String("condition", w.Source),
+			zap.String("rule", string(w.Rule)),
+			zap.String("issue", w.Message),
+		)
+	}
+}
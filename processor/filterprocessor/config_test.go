@@ -13,6 +13,9 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
@@ -977,3 +980,22 @@ func TestLoadingConfigOTTL(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLintWarnings(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Logs.LogConditions = []string{
+		`severity_number < SEVERITY_NUMBER_WARN`,
+		`body == nil or 1 == 1`,
+	}
+	core, logs := observer.New(zapcore.WarnLevel)
+	prevLogger := lintLogger
+	lintLogger = zap.New(core)
+	defer func() { lintLogger = prevLogger }()
+
+	require.NoError(t, xconfmap.Validate(cfg))
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, `body == nil or 1 == 1`, fields["condition"])
+	assert.Equal(t, string(ottl.LintRuleUnusedCondition), fields["rule"])
+	assert.Equal(t, "the condition is always true", fields["issue"])
+}
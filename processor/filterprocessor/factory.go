@@ -242,7 +242,8 @@ func (f *filterProcessorFactory) createMetricsProcessor(
 			zap.Bool("datapoint", f.defaultDataPointFunctionsOverridden),
 		)
 	}
-	fp, err := newFilterMetricProcessor(set, cfg.(*Config))
+	oCfg := cfg.(*Config)
+	fp, err := newFilterMetricProcessor(set, oCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +268,8 @@ func (f *filterProcessorFactory) createLogsProcessor(
 			zap.Bool("log", f.defaultLogFunctionsOverridden),
 		)
 	}
-	fp, err := newFilterLogsProcessor(set, cfg.(*Config))
+	oCfg := cfg.(*Config)
+	fp, err := newFilterLogsProcessor(set, oCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +295,8 @@ func (f *filterProcessorFactory) createTracesProcessor(
 			zap.Bool("spanevent", f.defaultSpanEventFunctionsOverridden),
 		)
 	}
-	fp, err := newFilterSpansProcessor(set, cfg.(*Config))
+	oCfg := cfg.(*Config)
+	fp, err := newFilterSpansProcessor(set, oCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -318,7 +321,8 @@ func (f *filterProcessorFactory) createProfilesProcessor(
 			zap.Bool("profile", f.defaultProfileFunctionsOverridden),
 		)
 	}
-	fp, err := newFilterProfilesProcessor(set, cfg.(*Config))
+	oCfg := cfg.(*Config)
+	fp, err := newFilterProfilesProcessor(set, oCfg)
 	if err != nil {
 		return nil, err
 	}
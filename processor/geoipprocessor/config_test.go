@@ -7,6 +7,7 @@ import (
 	"errors"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -76,6 +77,21 @@ func TestLoadConfig(t *testing.T) {
 				Attributes: []attribute.Key{"client.address", "source.address", "custom.address"},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "multiple_providers"),
+			expected: &Config{
+				Context: resource,
+				Providers: map[string]provider.Config{
+					"maxmind":     &maxmind.Config{DatabasePath: "/tmp/city.mmdb", Locales: []string{"de", "en"}, ReloadInterval: time.Hour},
+					"maxmind/asn": &maxmind.Config{DatabasePath: "/tmp/asn.mmdb"},
+				},
+				Attributes: defaultAttributes,
+			},
+		},
+		{
+			id:                    component.NewIDWithName(metadata.Type, "invalid_provider_name"),
+			unmarshalErrorMessage: "invalid provider key: maxmind/",
+		},
 	}
 
 	for _, tt := range tests {
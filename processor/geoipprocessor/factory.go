@@ -6,6 +6,9 @@ package geoipprocessor // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -42,10 +45,15 @@ func NewFactory() processor.Factory {
 	return processor.NewFactory(metadata.Type, createDefaultConfig, processor.WithMetrics(createMetricsProcessor, metadata.MetricsStability), processor.WithLogs(createLogsProcessor, metadata.LogsStability), processor.WithTraces(createTracesProcessor, metadata.TracesStability))
 }
 
-// getProviderFactory retrieves the GeoIPProviderFactory for the given key.
+// getProviderFactory retrieves the GeoIPProviderFactory for the given key. The key is the provider type,
+// optionally followed by a slash and a name to configure several providers of the same type, e.g. "maxmind/asn".
 // It returns the factory and a boolean indicating whether the factory was found.
 func getProviderFactory(key string) (provider.GeoIPProviderFactory, bool) {
-	if factory, ok := providerFactories[key]; ok {
+	providerType, name, named := strings.Cut(key, "/")
+	if named && name == "" {
+		return nil, false
+	}
+	if factory, ok := providerFactories[providerType]; ok {
 		return factory, true
 	}
 
@@ -69,8 +77,12 @@ func createGeoIPProviders(
 ) ([]provider.GeoIPProvider, error) {
 	providers := make([]provider.GeoIPProvider, 0, len(config.Providers))
 
-	for key, cfg := range config.Providers {
-		factory := factories[key]
+	// The providers are queried in the order of their keys, the attributes of the last ones taking precedence.
+	keys := slices.Sorted(maps.Keys(config.Providers))
+	for _, key := range keys {
+		cfg := config.Providers[key]
+		providerType, _, _ := strings.Cut(key, "/")
+		factory := factories[providerType]
 		if factory == nil {
 			return nil, fmt.Errorf("geoIP provider factory not found for key: %q", key)
 		}
@@ -110,6 +110,8 @@ func (g *geoIPProcessor) processAttributes(ctx context.Context, metadata pcommon
 			metadata.PutDouble(string(geoAttr.Key), geoAttr.Value.AsFloat64())
 		case attribute.STRING:
 			metadata.PutStr(string(geoAttr.Key), geoAttr.Value.AsString())
+		case attribute.INT64:
+			metadata.PutInt(string(geoAttr.Key), geoAttr.Value.AsInt64())
 		}
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.opentelemetry.io/otel/attribute"
@@ -25,6 +26,8 @@ import (
 	conventions "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+	maxmind "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
+	maxmindtestdata "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider/testdata"
 )
 
 type providerConfigMock struct {
@@ -268,3 +271,35 @@ func TestProcessorShutdownError(t *testing.T) {
 
 	assert.EqualError(t, processor.shutdown(t.Context()), "test error 1; test error 2")
 }
+
+func TestProcessorMultipleProviders(t *testing.T) {
+	dbDir := maxmindtestdata.GenerateLocalDB(t, filepath.Join("internal", "provider", "maxmindprovider", "testdata"))
+	cfg := &Config{
+		Context: resource,
+		Providers: map[string]provider.Config{
+			"maxmind":     &maxmind.Config{DatabasePath: filepath.Join(dbDir, "GeoLite2-City-Test.mmdb"), Locales: []string{"de", "en"}},
+			"maxmind/asn": &maxmind.Config{DatabasePath: filepath.Join(dbDir, "GeoLite2-ASN-Test.mmdb")},
+		},
+		Attributes: defaultAttributes,
+	}
+
+	sink := new(consumertest.TracesSink)
+	tp, err := NewFactory().CreateTraces(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("source.address", "1.2.3.4")
+	require.NoError(t, tp.ConsumeTraces(t.Context(), td))
+	require.NoError(t, tp.Shutdown(t.Context()))
+
+	require.Len(t, sink.AllTraces(), 1)
+	attrs := sink.AllTraces()[0].ResourceSpans().At(0).Resource().Attributes()
+	cityName, _ := attrs.Get(conventions.AttributeGeoCityName)
+	assert.Equal(t, "Boxford", cityName.Str())
+	countryName, _ := attrs.Get(conventions.AttributeGeoCountryName)
+	assert.Equal(t, "Vereinigtes Königreich", countryName.Str())
+	asNumber, _ := attrs.Get(conventions.AttributeASNumber)
+	assert.Equal(t, int64(1221), asNumber.Int())
+	asOrganization, _ := attrs.Get(conventions.AttributeASOrganizationName)
+	assert.Equal(t, "Telstra Pty Ltd", asOrganization.Str())
+}
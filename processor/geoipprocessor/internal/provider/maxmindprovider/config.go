@@ -5,6 +5,8 @@ package maxmind // import "github.com/open-telemetry/opentelemetry-collector-con
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
 )
@@ -14,6 +16,14 @@ type Config struct {
 	// DatabasePath section allows specifying a local GeoIP database
 	// file to retrieve the geographical metadata from.
 	DatabasePath string `mapstructure:"database_path"`
+
+	// Locales specifies the languages of the location names by order of preference, the first
+	// name available in one of them is used. Defaults to English.
+	Locales []string `mapstructure:"locales"`
+
+	// ReloadInterval specifies how often the database file is checked for changes. When its
+	// modification time or size changed, the database is reopened. Disabled when 0.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
 }
 
 var _ provider.Config = (*Config)(nil)
@@ -23,5 +33,13 @@ func (c *Config) Validate() error {
 	if c.DatabasePath == "" {
 		return errors.New("a local geoIP database path must be provided")
 	}
+	for _, locale := range c.Locales {
+		if _, ok := localizedNames[locale]; !ok {
+			return fmt.Errorf("unsupported locale %q, available values: %s", locale, supportedLocales)
+		}
+	}
+	if c.ReloadInterval < 0 {
+		return errors.New("the reload interval must not be negative")
+	}
 	return nil
 }
@@ -8,9 +8,14 @@ import (
 	"errors"
 	"fmt"
 	"net/netip"
+	"os"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/oschwald/geoip2-golang/v2"
 	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
 
 	conventions "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
@@ -21,52 +26,161 @@ var (
 	defaultLanguageCode = "en"
 	geoIP2CityDBType    = "GeoIP2-City"
 	geoLite2CityDBType  = "GeoLite2-City"
+	geoIP2ASNDBType     = "GeoIP2-ASN"
+	geoLite2ASNDBType   = "GeoLite2-ASN"
 
 	errUnsupportedDB = errors.New("unsupported geo IP database type")
+
+	// localizedNames returns the name in a given language, for the languages available in the databases.
+	localizedNames = map[string]func(geoip2.Names) string{
+		"de":    func(n geoip2.Names) string { return n.German },
+		"en":    func(n geoip2.Names) string { return n.English },
+		"es":    func(n geoip2.Names) string { return n.Spanish },
+		"fr":    func(n geoip2.Names) string { return n.French },
+		"ja":    func(n geoip2.Names) string { return n.Japanese },
+		"pt-BR": func(n geoip2.Names) string { return n.BrazilianPortuguese },
+		"ru":    func(n geoip2.Names) string { return n.Russian },
+		"zh-CN": func(n geoip2.Names) string { return n.SimplifiedChinese },
+	}
+	supportedLocales = strings.Join([]string{"de", "en", "es", "fr", "ja", "pt-BR", "ru", "zh-CN"}, ", ")
 )
 
 type maxMindProvider struct {
+	databasePath string
+	logger       *zap.Logger
+	// language codes to be used in name retrieval by order of preference, e.g. "en" or "pt-BR"
+	langCodes []string
+
+	// mu protects the reader, which is replaced when the database file changes.
+	mu        sync.RWMutex
 	geoReader *geoip2.Reader
-	// language code to be used in name retrieval, e.g. "en" or "pt-BR"
-	langCode string
+	// modTime and size identify the version of the database file which is open.
+	modTime time.Time
+	size    int64
+
+	stopReload chan struct{}
+	reloadWG   sync.WaitGroup
 }
 
 var _ provider.GeoIPProvider = (*maxMindProvider)(nil)
 
-func newMaxMindProvider(cfg *Config) (*maxMindProvider, error) {
-	geoReader, err := geoip2.Open(cfg.DatabasePath)
-	if err != nil {
+func newMaxMindProvider(cfg *Config, logger *zap.Logger) (*maxMindProvider, error) {
+	langCodes := cfg.Locales
+	if len(langCodes) == 0 {
+		langCodes = []string{defaultLanguageCode}
+	}
+	g := &maxMindProvider{databasePath: cfg.DatabasePath, logger: logger, langCodes: langCodes}
+
+	var err error
+	if g.geoReader, err = geoip2.Open(cfg.DatabasePath); err != nil {
 		return nil, fmt.Errorf("could not open geoip database: %w", err)
 	}
+	if info, statErr := os.Stat(cfg.DatabasePath); statErr == nil {
+		g.modTime, g.size = info.ModTime(), info.Size()
+	}
+
+	if cfg.ReloadInterval > 0 {
+		g.stopReload = make(chan struct{})
+		g.reloadWG.Add(1)
+		go g.reloadOnChange(cfg.ReloadInterval)
+	}
+	return g, nil
+}
 
-	return &maxMindProvider{geoReader: geoReader, langCode: defaultLanguageCode}, nil
+// reloadOnChange reopens the database every time its file changes, until the provider is closed.
+func (g *maxMindProvider) reloadOnChange(interval time.Duration) {
+	defer g.reloadWG.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-g.stopReload:
+			return
+		case <-ticker.C:
+			g.reloadIfChanged()
+		}
+	}
 }
 
-// Location implements provider.GeoIPProvider for MaxMind. If a non City database type is used or no metadata is found in the database, an error will be returned.
+// reloadIfChanged reopens the database if its file changed since it was opened. The current database
+// is kept if the new one cannot be opened, and the reload is retried at the next check.
+func (g *maxMindProvider) reloadIfChanged() {
+	info, err := os.Stat(g.databasePath)
+	if err != nil {
+		g.logger.Warn("Failed to check the geoip database for changes", zap.String("path", g.databasePath), zap.Error(err))
+		return
+	}
+	if info.ModTime().Equal(g.modTime) && info.Size() == g.size {
+		return
+	}
+
+	geoReader, err := geoip2.Open(g.databasePath)
+	if err != nil {
+		g.logger.Warn("Failed to reload the geoip database, keeping the previous one", zap.String("path", g.databasePath), zap.Error(err))
+		return
+	}
+
+	g.mu.Lock()
+	previous := g.geoReader
+	g.geoReader = geoReader
+	g.modTime, g.size = info.ModTime(), info.Size()
+	g.mu.Unlock()
+
+	if err = previous.Close(); err != nil {
+		g.logger.Debug("Failed to close the previous geoip database", zap.Error(err))
+	}
+	g.logger.Info("Reloaded the geoip database", zap.String("path", g.databasePath), zap.String("type", geoReader.Metadata().DatabaseType))
+}
+
+// Location implements provider.GeoIPProvider for MaxMind. If a non City or ASN database type is used or no metadata is found in the database, an error will be returned.
 func (g *maxMindProvider) Location(_ context.Context, ipAddress netip.Addr) (attribute.Set, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var attrs *[]attribute.KeyValue
+	var err error
 	switch g.geoReader.Metadata().DatabaseType {
 	case geoIP2CityDBType, geoLite2CityDBType:
-		attrs, err := g.cityAttributes(ipAddress)
-		if err != nil {
-			return attribute.Set{}, err
-		} else if len(*attrs) == 0 {
-			return attribute.Set{}, provider.ErrNoMetadataFound
-		}
-		return attribute.NewSet(*attrs...), nil
+		attrs, err = g.cityAttributes(ipAddress)
+	case geoIP2ASNDBType, geoLite2ASNDBType:
+		attrs, err = g.asnAttributes(ipAddress)
 	default:
 		return attribute.Set{}, fmt.Errorf("%w type: %s", errUnsupportedDB, g.geoReader.Metadata().DatabaseType)
 	}
+	if err != nil {
+		return attribute.Set{}, err
+	} else if len(*attrs) == 0 {
+		return attribute.Set{}, provider.ErrNoMetadataFound
+	}
+	return attribute.NewSet(*attrs...), nil
 }
 
 // Close unmaps the geo database file from virtual memory and returns the
 // resources to the system.
 func (g *maxMindProvider) Close(context.Context) error {
+	if g.stopReload != nil {
+		close(g.stopReload)
+		g.reloadWG.Wait()
+		g.stopReload = nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
 	if g.geoReader != nil {
 		return g.geoReader.Close()
 	}
 	return nil
 }
 
+// name returns the name in the first configured language it is available in.
+func (g *maxMindProvider) name(names geoip2.Names) string {
+	for _, langCode := range g.langCodes {
+		if name := localizedNames[langCode](names); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
 // cityAttributes returns a list of key-values containing geographical metadata associated to the provided IP. The key names are populated using the internal geo IP conventions package. If an invalid or nil IP is provided, an error is returned.
 func (g *maxMindProvider) cityAttributes(ipAddress netip.Addr) (*[]attribute.KeyValue, error) {
 	attributes := make([]attribute.KeyValue, 0, 11)
@@ -84,12 +198,12 @@ func (g *maxMindProvider) cityAttributes(ipAddress netip.Addr) (*[]attribute.Key
 	}
 
 	// city
-	appendIfNotEmpty(conventions.AttributeGeoCityName, city.City.Names.English)
+	appendIfNotEmpty(conventions.AttributeGeoCityName, g.name(city.City.Names))
 	// country
-	appendIfNotEmpty(conventions.AttributeGeoCountryName, city.Country.Names.English)
+	appendIfNotEmpty(conventions.AttributeGeoCountryName, g.name(city.Country.Names))
 	appendIfNotEmpty(conventions.AttributeGeoCountryIsoCode, city.Country.ISOCode)
 	// continent
-	appendIfNotEmpty(conventions.AttributeGeoContinentName, city.Continent.Names.English)
+	appendIfNotEmpty(conventions.AttributeGeoContinentName, g.name(city.Continent.Names))
 	appendIfNotEmpty(conventions.AttributeGeoContinentCode, city.Continent.Code)
 	// postal code
 	appendIfNotEmpty(conventions.AttributeGeoPostalCode, city.Postal.Code)
@@ -97,7 +211,7 @@ func (g *maxMindProvider) cityAttributes(ipAddress netip.Addr) (*[]attribute.Key
 	if len(city.Subdivisions) > 0 {
 		// The most specific subdivision is located at the last array position, see https://github.com/maxmind/GeoIP2-java/blob/2fe4c65424fed2c3c2449e5530381b6452b0560f/src/main/java/com/maxmind/geoip2/model/AbstractCityResponse.java#L112
 		mostSpecificSubdivision := city.Subdivisions[len(city.Subdivisions)-1]
-		appendIfNotEmpty(conventions.AttributeGeoRegionName, mostSpecificSubdivision.Names.English)
+		appendIfNotEmpty(conventions.AttributeGeoRegionName, g.name(mostSpecificSubdivision.Names))
 		appendIfNotEmpty(conventions.AttributeGeoRegionIsoCode, mostSpecificSubdivision.ISOCode)
 	}
 
@@ -109,3 +223,22 @@ func (g *maxMindProvider) cityAttributes(ipAddress netip.Addr) (*[]attribute.Key
 
 	return &attributes, err
 }
+
+// asnAttributes returns a list of key-values containing the autonomous system associated to the provided IP.
+func (g *maxMindProvider) asnAttributes(ipAddress netip.Addr) (*[]attribute.KeyValue, error) {
+	attributes := make([]attribute.KeyValue, 0, 2)
+
+	asn, err := g.geoReader.ASN(ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if asn.AutonomousSystemNumber != 0 {
+		attributes = append(attributes, attribute.Int64(conventions.AttributeASNumber, int64(asn.AutonomousSystemNumber)))
+	}
+	if asn.AutonomousSystemOrganization != "" {
+		attributes = append(attributes, attribute.String(conventions.AttributeASOrganizationName, asn.AutonomousSystemOrganization))
+	}
+
+	return &attributes, nil
+}
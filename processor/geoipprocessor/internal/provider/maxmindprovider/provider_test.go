@@ -6,26 +6,29 @@ package maxmind
 import (
 	"net/netip"
 	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
 
 	conventions "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider/testdata"
 )
 
 func TestInvalidNewProvider(t *testing.T) {
-	_, err := newMaxMindProvider(&Config{})
+	_, err := newMaxMindProvider(&Config{}, zap.NewNop())
 	expectedErrMsgSuffix := "no such file or directory"
 	if runtime.GOOS == "windows" {
 		expectedErrMsgSuffix = "The system cannot find the file specified."
 	}
 	require.ErrorContains(t, err, "could not open geoip database: open : "+expectedErrMsgSuffix)
 
-	_, err = newMaxMindProvider(&Config{DatabasePath: "no valid path"})
+	_, err = newMaxMindProvider(&Config{DatabasePath: "no valid path"}, zap.NewNop())
 	require.ErrorContains(t, err, "could not open geoip database: open no valid path: "+expectedErrMsgSuffix)
 }
 
@@ -39,6 +42,7 @@ func TestProviderLocation(t *testing.T) {
 	tests := []struct {
 		name               string
 		testDatabase       string
+		locales            []string
 		sourceIP           netip.Addr
 		expectedAttributes attribute.Set
 		expectedErrMsg     string
@@ -73,6 +77,40 @@ func TestProviderLocation(t *testing.T) {
 				attribute.Float64(conventions.AttributeGeoLocationLon, 5678),
 			}...),
 		},
+		{
+			name:         "names in the first available locale",
+			sourceIP:     netip.AddrFrom4([4]byte{1, 2, 3, 4}),
+			testDatabase: "GeoLite2-City-Test.mmdb",
+			locales:      []string{"pt-BR", "en"},
+			expectedAttributes: attribute.NewSet([]attribute.KeyValue{
+				attribute.String(conventions.AttributeGeoCityName, "Boxford"),
+				attribute.String(conventions.AttributeGeoContinentCode, "EU"),
+				attribute.String(conventions.AttributeGeoContinentName, "Europa"),
+				attribute.String(conventions.AttributeGeoCountryIsoCode, "GB"),
+				attribute.String(conventions.AttributeGeoCountryName, "Reino Unido"),
+				attribute.String(conventions.AttributeGeoTimezone, "Europe/London"),
+				attribute.String(conventions.AttributeGeoRegionIsoCode, "WBK"),
+				attribute.String(conventions.AttributeGeoRegionName, "West Berkshire"),
+				attribute.String(conventions.AttributeGeoPostalCode, "OX1"),
+				attribute.Float64(conventions.AttributeGeoLocationLat, 1234),
+				attribute.Float64(conventions.AttributeGeoLocationLon, 5678),
+			}...),
+		},
+		{
+			name:         "autonomous system using GeoLite2-ASN database",
+			sourceIP:     netip.AddrFrom4([4]byte{1, 2, 3, 4}),
+			testDatabase: "GeoLite2-ASN-Test.mmdb",
+			expectedAttributes: attribute.NewSet([]attribute.KeyValue{
+				attribute.Int64(conventions.AttributeASNumber, 1221),
+				attribute.String(conventions.AttributeASOrganizationName, "Telstra Pty Ltd"),
+			}...),
+		},
+		{
+			name:           "no IP metadata in ASN database",
+			sourceIP:       netip.AddrFrom4([4]byte{0, 0, 0, 0}),
+			testDatabase:   "GeoLite2-ASN-Test.mmdb",
+			expectedErrMsg: "no geo IP metadata found",
+		},
 		{
 			name:         "subset attributes for IPv6 IP using GeoIP2-City database",
 			sourceIP:     netip.MustParseAddr("2001:220::"),
@@ -92,7 +130,7 @@ func TestProviderLocation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// prepare provider
-			provider, err := newMaxMindProvider(&Config{DatabasePath: tmpDBfiles + "/" + tt.testDatabase})
+			provider, err := newMaxMindProvider(&Config{DatabasePath: tmpDBfiles + "/" + tt.testDatabase, Locales: tt.locales}, zap.NewNop())
 			assert.NoError(t, err)
 
 			// assert metrics
@@ -108,3 +146,54 @@ func TestProviderLocation(t *testing.T) {
 		})
 	}
 }
+
+// TestProviderReload asserts that the database is reopened when its file changes on disk.
+func TestProviderReload(t *testing.T) {
+	tmpDBfiles := testdata.GenerateLocalDB(t, "./testdata")
+	dbPath := filepath.Join(t.TempDir(), "geo.mmdb")
+	writeFile := func(data []byte) {
+		// write to a temporary file and rename it, as database update tools do
+		require.NoError(t, os.WriteFile(dbPath+".tmp", data, 0o600))
+		require.NoError(t, os.Rename(dbPath+".tmp", dbPath))
+	}
+	copyFile := func(src string) {
+		data, err := os.ReadFile(filepath.Join(tmpDBfiles, src))
+		require.NoError(t, err)
+		writeFile(data)
+	}
+	copyFile("GeoLite2-City-Test.mmdb")
+
+	provider, err := newMaxMindProvider(&Config{DatabasePath: dbPath, ReloadInterval: 10 * time.Millisecond}, zap.NewNop())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, provider.Close(t.Context())) }()
+
+	ip := netip.AddrFrom4([4]byte{1, 2, 3, 4})
+	attrs, err := provider.Location(t.Context(), ip)
+	require.NoError(t, err)
+	_, ok := attrs.Value(conventions.AttributeGeoCityName)
+	assert.True(t, ok)
+
+	copyFile("GeoLite2-ASN-Test.mmdb")
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		attrs, err := provider.Location(t.Context(), ip)
+		assert.NoError(c, err)
+		value, ok := attrs.Value(conventions.AttributeASNumber)
+		assert.True(c, ok)
+		assert.Equal(c, int64(1221), value.AsInt64())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// An invalid database is not loaded.
+	writeFile([]byte("not a database"))
+	time.Sleep(100 * time.Millisecond)
+	attrs, err = provider.Location(t.Context(), ip)
+	require.NoError(t, err)
+	_, ok = attrs.Value(conventions.AttributeASNumber)
+	assert.True(t, ok)
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, (&Config{DatabasePath: "/tmp/db", Locales: []string{"de", "en"}, ReloadInterval: time.Minute}).Validate())
+	assert.EqualError(t, (&Config{}).Validate(), "a local geoIP database path must be provided")
+	assert.EqualError(t, (&Config{DatabasePath: "/tmp/db", Locales: []string{"it"}}).Validate(), `unsupported locale "it", available values: de, en, es, fr, ja, pt-BR, ru, zh-CN`)
+	assert.EqualError(t, (&Config{DatabasePath: "/tmp/db", ReloadInterval: -time.Second}).Validate(), "the reload interval must not be negative")
+}
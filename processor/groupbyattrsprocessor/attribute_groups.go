@@ -95,7 +95,7 @@ func (lg *logsGroup) findOrCreateResourceLogs(originResource pcommon.Resource, r
 }
 
 func instrumentationLibrariesEqual(il1, il2 pcommon.InstrumentationScope) bool {
-	return il1.Name() == il2.Name() && il1.Version() == il2.Version()
+	return il1.Name() == il2.Name() && il1.Version() == il2.Version() && il1.Attributes().Equal(il2.Attributes())
 }
 
 // matchingScopeSpans searches for a ptrace.ScopeSpans instance matching
@@ -156,3 +156,17 @@ func buildReferenceResource(originResource pcommon.Resource, requiredAttributes
 	}
 	return referenceResource
 }
+
+// buildReferenceScope returns the instrumentation scope that we'll be looking for in existing ScopeSpans,
+// ScopeLogs or ScopeMetrics, as a merge of the Attributes of the original scope with the requested Attributes.
+func buildReferenceScope(originScope pcommon.InstrumentationScope, requiredAttributes pcommon.Map) pcommon.InstrumentationScope {
+	if requiredAttributes.Len() == 0 {
+		return originScope
+	}
+	referenceScope := pcommon.NewInstrumentationScope()
+	originScope.CopyTo(referenceScope)
+	for k, v := range requiredAttributes.All() {
+		v.CopyTo(referenceScope.Attributes().PutEmpty(k))
+	}
+	return referenceScope
+}
@@ -144,6 +144,18 @@ func TestInstrumentationLibraryMatching(t *testing.T) {
 	assert.Equal(t, il1, ill1.Scope())
 	assert.Equal(t, il1, ils1.Scope())
 	assert.Equal(t, il1, ilm1.Scope())
+
+	// Scopes with the same name but different attributes don't match
+	scopeAttributes := pcommon.NewMap()
+	scopeAttributes.PutStr("tier", "gold")
+	il3 := buildReferenceScope(il1, scopeAttributes)
+	matchingScopeLogs(rl, il3)
+	matchingScopeSpans(rs, il3)
+	matchingScopeMetrics(rm, il3)
+	assert.Equal(t, 3, rl.ScopeLogs().Len())
+	assert.Equal(t, 3, rs.ScopeSpans().Len())
+	assert.Equal(t, 3, rm.ScopeMetrics().Len())
+	assert.Equal(t, 0, il1.Attributes().Len())
 }
 
 func BenchmarkAttrGrouping(b *testing.B) {
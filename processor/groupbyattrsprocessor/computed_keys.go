@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// computedKey is a grouping attribute computed with an OTTL value expression.
+type computedKey[K any] struct {
+	key     string
+	toScope bool
+	value   *ottl.ValueExpression[K]
+}
+
+// parseComputedKeys parses the value expressions of the computed keys in the context of the parser.
+func parseComputedKeys[K any](parser ottl.Parser[K], configs []ComputedKeyConfig) ([]computedKey[K], error) {
+	keys := make([]computedKey[K], 0, len(configs))
+	for _, cfg := range configs {
+		value, err := parser.ParseValueExpression(cfg.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the value of the computed key %q: %w", cfg.Key, err)
+		}
+		keys = append(keys, computedKey[K]{key: cfg.Key, toScope: cfg.Target == targetScope, value: value})
+	}
+	return keys, nil
+}
+
+// computeGroupingAttributes evaluates the computed keys for the record, and adds the non-nil values to the
+// resource or scope grouping attributes.
+func computeGroupingAttributes[K any](ctx context.Context, logger *zap.Logger, keys []computedKey[K], tCtx K, attributes groupingAttributes) {
+	for _, computed := range keys {
+		val, err := computed.value.Eval(ctx, tCtx)
+		if err != nil {
+			logger.Debug("Failed to compute grouping key", zap.String("key", computed.key), zap.Error(err))
+			continue
+		}
+		target := attributes.resource
+		if computed.toScope {
+			target = attributes.scope
+		}
+		if err := putValue(target, computed.key, val); err != nil {
+			logger.Debug("Unsupported value for grouping key", zap.String("key", computed.key), zap.Error(err))
+		}
+	}
+}
+
+// putValue sets the value returned by an OTTL expression to the key of the map. Nil values are ignored.
+func putValue(attributes pcommon.Map, key string, val any) error {
+	switch v := val.(type) {
+	case nil:
+	case pcommon.Value:
+		v.CopyTo(attributes.PutEmpty(key))
+	case pcommon.Map:
+		v.CopyTo(attributes.PutEmptyMap(key))
+	case pcommon.Slice:
+		v.CopyTo(attributes.PutEmptySlice(key))
+	default:
+		value := pcommon.NewValueEmpty()
+		if err := value.FromRaw(v); err != nil {
+			return err
+		}
+		value.CopyTo(attributes.PutEmpty(key))
+	}
+	return nil
+}
@@ -3,12 +3,71 @@
 
 package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+const (
+	// targetResource moves the grouping attributes to the resource attributes.
+	targetResource = "resource"
+	// targetScope moves the grouping attributes to the instrumentation scope attributes.
+	targetScope = "scope"
+)
+
 // Config is the configuration for the processor.
 type Config struct {
 	// GroupByKeys describes the attribute names that are going to be used for grouping.
 	// Empty value is allowed, since processor in such case can compact data
 	GroupByKeys []string `mapstructure:"keys"`
 
+	// ScopeKeys describes the attribute names that are going to be used for grouping,
+	// and moved to the instrumentation scope attributes instead of the resource attributes.
+	ScopeKeys []string `mapstructure:"scope_keys"`
+
+	// ComputedKeys describes the attributes computed with OTTL value expressions that are
+	// going to be used for grouping.
+	ComputedKeys []ComputedKeyConfig `mapstructure:"computed_keys"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
+
+// ComputedKeyConfig describes a grouping attribute computed from the span, log record or data point.
+type ComputedKeyConfig struct {
+	// Key is the name of the grouping attribute.
+	Key string `mapstructure:"key"`
+
+	// Value is the OTTL value expression computing the grouping attribute. The expression is
+	// evaluated in the span, log or datapoint context, depending on the signal. The records
+	// for which it evaluates to nil are not grouped by this attribute.
+	Value string `mapstructure:"value"`
+
+	// Target is where the grouping attribute is set: "resource" (default) or "scope".
+	Target string `mapstructure:"target"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	var errs error
+	for _, key := range cfg.ScopeKeys {
+		if key != "" && slices.Contains(cfg.GroupByKeys, key) {
+			errs = errors.Join(errs, fmt.Errorf("key %q can't be used in both keys and scope_keys", key))
+		}
+	}
+	for i, computed := range cfg.ComputedKeys {
+		if computed.Key == "" {
+			errs = errors.Join(errs, fmt.Errorf("computed_keys[%d]: key must not be empty", i))
+		}
+		if computed.Value == "" {
+			errs = errors.Join(errs, fmt.Errorf("computed_keys[%d]: value must not be empty", i))
+		}
+		switch computed.Target {
+		case "", targetResource, targetScope:
+		default:
+			errs = errors.Join(errs, fmt.Errorf("computed_keys[%d]: unsupported target %q, must be %q or %q", i, computed.Target, targetResource, targetScope))
+		}
+	}
+	return errs
+}
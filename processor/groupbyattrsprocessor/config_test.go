@@ -35,6 +35,17 @@ func TestLoadingConfig(t *testing.T) {
 				GroupByKeys: []string{},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "computed"),
+			expected: &Config{
+				GroupByKeys: []string{"host.name"},
+				ScopeKeys:   []string{"scope.tier"},
+				ComputedKeys: []ComputedKeyConfig{
+					{Key: "service.namespace", Value: `attributes["team"]`},
+					{Key: "scope.kind", Value: `attributes["kind"]`, Target: targetScope},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -54,3 +65,18 @@ func TestLoadingConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	cfg := NewFactory().CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "invalid").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	err = xconfmap.Validate(cfg)
+	assert.ErrorContains(t, err, `key "host.name" can't be used in both keys and scope_keys`)
+	assert.ErrorContains(t, err, "computed_keys[0]: key must not be empty")
+	assert.ErrorContains(t, err, `computed_keys[0]: unsupported target "record", must be "resource" or "scope"`)
+}
@@ -12,6 +12,10 @@ import (
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor/internal/metadata"
 )
 
@@ -34,7 +38,8 @@ func createDefaultConfig() component.Config {
 	}
 }
 
-func createGroupByAttrsProcessor(set processor.Settings, attributes []string) (*groupByAttrsProcessor, error) {
+// uniqueKeys returns the non-empty keys without duplicates.
+func uniqueKeys(logger *zap.Logger, attributes []string) []string {
 	var nonEmptyAttributes []string
 	presentAttributes := make(map[string]struct{})
 
@@ -42,19 +47,33 @@ func createGroupByAttrsProcessor(set processor.Settings, attributes []string) (*
 		if str != "" {
 			_, isPresent := presentAttributes[str]
 			if isPresent {
-				set.Logger.Warn("A grouping key is already present", zap.String("key", str))
+				logger.Warn("A grouping key is already present", zap.String("key", str))
 			} else {
 				nonEmptyAttributes = append(nonEmptyAttributes, str)
 				presentAttributes[str] = struct{}{}
 			}
 		}
 	}
+	return nonEmptyAttributes
+}
 
+func createGroupByAttrsProcessor(set processor.Settings, attributes []string) (*groupByAttrsProcessor, error) {
 	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
 	if err != nil {
 		return nil, err
 	}
-	return &groupByAttrsProcessor{logger: set.Logger, groupByKeys: nonEmptyAttributes, telemetryBuilder: telemetryBuilder}, nil
+	return &groupByAttrsProcessor{logger: set.Logger, groupByKeys: uniqueKeys(set.Logger, attributes), telemetryBuilder: telemetryBuilder}, nil
+}
+
+// createConfiguredProcessor creates the processor grouping by the keys and the scope keys of the config.
+// The computed keys are parsed by the signal specific factories.
+func createConfiguredProcessor(set processor.Settings, cfg *Config) (*groupByAttrsProcessor, error) {
+	gap, err := createGroupByAttrsProcessor(set, cfg.GroupByKeys)
+	if err != nil {
+		return nil, err
+	}
+	gap.scopeKeys = uniqueKeys(set.Logger, cfg.ScopeKeys)
+	return gap, nil
 }
 
 // createTracesProcessor creates a trace processor based on this config.
@@ -65,10 +84,19 @@ func createTracesProcessor(
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
 	oCfg := cfg.(*Config)
-	gap, err := createGroupByAttrsProcessor(set, oCfg.GroupByKeys)
+	gap, err := createConfiguredProcessor(set, oCfg)
 	if err != nil {
 		return nil, err
 	}
+	if len(oCfg.ComputedKeys) > 0 {
+		parser, err := ottlspan.NewParser(ottlfuncs.StandardConverters[*ottlspan.TransformContext](), set.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+		if gap.spanKeys, err = parseComputedKeys(parser, oCfg.ComputedKeys); err != nil {
+			return nil, err
+		}
+	}
 
 	return processorhelper.NewTraces(
 		ctx,
@@ -87,10 +115,19 @@ func createLogsProcessor(
 	nextConsumer consumer.Logs,
 ) (processor.Logs, error) {
 	oCfg := cfg.(*Config)
-	gap, err := createGroupByAttrsProcessor(set, oCfg.GroupByKeys)
+	gap, err := createConfiguredProcessor(set, oCfg)
 	if err != nil {
 		return nil, err
 	}
+	if len(oCfg.ComputedKeys) > 0 {
+		parser, err := ottllog.NewParser(ottlfuncs.StandardConverters[*ottllog.TransformContext](), set.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+		if gap.logKeys, err = parseComputedKeys(parser, oCfg.ComputedKeys); err != nil {
+			return nil, err
+		}
+	}
 
 	return processorhelper.NewLogs(
 		ctx,
@@ -109,10 +146,19 @@ func createMetricsProcessor(
 	nextConsumer consumer.Metrics,
 ) (processor.Metrics, error) {
 	oCfg := cfg.(*Config)
-	gap, err := createGroupByAttrsProcessor(set, oCfg.GroupByKeys)
+	gap, err := createConfiguredProcessor(set, oCfg)
 	if err != nil {
 		return nil, err
 	}
+	if len(oCfg.ComputedKeys) > 0 {
+		parser, err := ottldatapoint.NewParser(ottlfuncs.StandardConverters[*ottldatapoint.TransformContext](), set.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+		if gap.dataPointKeys, err = parseComputedKeys(parser, oCfg.ComputedKeys); err != nil {
+			return nil, err
+		}
+	}
 
 	return processorhelper.NewMetrics(
 		ctx,
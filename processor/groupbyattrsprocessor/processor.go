@@ -12,15 +12,32 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor/internal/metadata"
 )
 
 type groupByAttrsProcessor struct {
 	logger           *zap.Logger
 	groupByKeys      []string
+	scopeKeys        []string
+	spanKeys         []computedKey[*ottlspan.TransformContext]
+	logKeys          []computedKey[*ottllog.TransformContext]
+	dataPointKeys    []computedKey[*ottldatapoint.TransformContext]
 	telemetryBuilder *metadata.TelemetryBuilder
 }
 
+// groupingAttributes holds the attributes of a record moved to its resource and instrumentation scope.
+type groupingAttributes struct {
+	resource pcommon.Map
+	scope    pcommon.Map
+}
+
+func (ga groupingAttributes) isEmpty() bool {
+	return ga.resource.Len() == 0 && ga.scope.Len() == 0
+}
+
 // ProcessTraces process traces and groups traces by attribute.
 func (gap *groupByAttrsProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 	rss := td.ResourceSpans()
@@ -35,20 +52,26 @@ func (gap *groupByAttrsProcessor) processTraces(ctx context.Context, td ptrace.T
 			for k := 0; k < ils.Spans().Len(); k++ {
 				span := ils.Spans().At(k)
 
-				toBeGrouped, requiredAttributes := gap.extractGroupingAttributes(span.Attributes())
-				if toBeGrouped {
+				requiredAttributes := gap.extractGroupingAttributes(span.Attributes())
+				if len(gap.spanKeys) > 0 {
+					tCtx := ottlspan.NewTransformContextPtr(rs, ils, span)
+					computeGroupingAttributes(ctx, gap.logger, gap.spanKeys, tCtx, requiredAttributes)
+					tCtx.Close()
+				}
+				if !requiredAttributes.isEmpty() {
 					gap.telemetryBuilder.ProcessorGroupbyattrsNumGroupedSpans.Add(ctx, 1)
-					// Some attributes are going to be moved from span to resource level,
+					// Some attributes are going to be moved from span to resource or scope level,
 					// so we can delete those on the record level
-					deleteAttributes(requiredAttributes, span.Attributes())
+					gap.deleteGroupingAttributes(span.Attributes())
 				} else {
 					gap.telemetryBuilder.ProcessorGroupbyattrsNumNonGroupedSpans.Add(ctx, 1)
 				}
 
 				// Lets combine the base resource attributes + the extracted (grouped) attributes
 				// and keep them in the grouping entry
-				groupedResourceSpans := tg.findOrCreateResourceSpans(rs.Resource(), requiredAttributes)
-				sp := matchingScopeSpans(groupedResourceSpans, ils.Scope()).Spans().AppendEmpty()
+				groupedResourceSpans := tg.findOrCreateResourceSpans(rs.Resource(), requiredAttributes.resource)
+				scope := buildReferenceScope(ils.Scope(), requiredAttributes.scope)
+				sp := matchingScopeSpans(groupedResourceSpans, scope).Spans().AppendEmpty()
 				span.CopyTo(sp)
 			}
 		}
@@ -73,20 +96,26 @@ func (gap *groupByAttrsProcessor) processLogs(ctx context.Context, ld plog.Logs)
 			for k := 0; k < sl.LogRecords().Len(); k++ {
 				log := sl.LogRecords().At(k)
 
-				toBeGrouped, requiredAttributes := gap.extractGroupingAttributes(log.Attributes())
-				if toBeGrouped {
+				requiredAttributes := gap.extractGroupingAttributes(log.Attributes())
+				if len(gap.logKeys) > 0 {
+					tCtx := ottllog.NewTransformContextPtr(ls, sl, log)
+					computeGroupingAttributes(ctx, gap.logger, gap.logKeys, tCtx, requiredAttributes)
+					tCtx.Close()
+				}
+				if !requiredAttributes.isEmpty() {
 					gap.telemetryBuilder.ProcessorGroupbyattrsNumGroupedLogs.Add(ctx, 1)
-					// Some attributes are going to be moved from log record to resource level,
+					// Some attributes are going to be moved from log record to resource or scope level,
 					// so we can delete those on the record level
-					deleteAttributes(requiredAttributes, log.Attributes())
+					gap.deleteGroupingAttributes(log.Attributes())
 				} else {
 					gap.telemetryBuilder.ProcessorGroupbyattrsNumNonGroupedLogs.Add(ctx, 1)
 				}
 
 				// Lets combine the base resource attributes + the extracted (grouped) attributes
 				// and keep them in the grouping entry
-				groupedResourceLogs := lg.findOrCreateResourceLogs(ls.Resource(), requiredAttributes)
-				lr := matchingScopeLogs(groupedResourceLogs, sl.Scope()).LogRecords().AppendEmpty()
+				groupedResourceLogs := lg.findOrCreateResourceLogs(ls.Resource(), requiredAttributes.resource)
+				scope := buildReferenceScope(sl.Scope(), requiredAttributes.scope)
+				lr := matchingScopeLogs(groupedResourceLogs, scope).LogRecords().AppendEmpty()
 				log.CopyTo(lr)
 			}
 		}
@@ -116,35 +145,35 @@ func (gap *groupByAttrsProcessor) processMetrics(ctx context.Context, md pmetric
 				case pmetric.MetricTypeGauge:
 					for pointIndex := 0; pointIndex < metric.Gauge().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Gauge().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Gauge().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeSum:
 					for pointIndex := 0; pointIndex < metric.Sum().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Sum().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Sum().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeSummary:
 					for pointIndex := 0; pointIndex < metric.Summary().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Summary().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Summary().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeHistogram:
 					for pointIndex := 0; pointIndex < metric.Histogram().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Histogram().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Histogram().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeExponentialHistogram:
 					for pointIndex := 0; pointIndex < metric.ExponentialHistogram().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.ExponentialHistogram().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.ExponentialHistogram().DataPoints().AppendEmpty())
 					}
 
@@ -159,30 +188,37 @@ func (gap *groupByAttrsProcessor) processMetrics(ctx context.Context, md pmetric
 	return mg.metrics, nil
 }
 
-func deleteAttributes(attrsForRemoval, targetAttrs pcommon.Map) {
-	for key := range attrsForRemoval.All() {
+// deleteGroupingAttributes deletes the attributes moved to the resource or scope level from the record.
+// The computed attributes are kept, since they are not read from the record attributes.
+func (gap *groupByAttrsProcessor) deleteGroupingAttributes(targetAttrs pcommon.Map) {
+	for _, key := range gap.groupByKeys {
+		targetAttrs.Remove(key)
+	}
+	for _, key := range gap.scopeKeys {
 		targetAttrs.Remove(key)
 	}
 }
 
 // extractGroupingAttributes extracts the keys and values of the specified Attributes
-// that match with the attributes keys that is used for grouping
-// Returns:
-//   - whether any attribute matched (true) or none (false)
-//   - the extracted AttributeMap of matching keys and their corresponding values
-func (gap *groupByAttrsProcessor) extractGroupingAttributes(attrMap pcommon.Map) (bool, pcommon.Map) {
-	groupingAttributes := pcommon.NewMap()
-	foundMatch := false
+// that match with the attributes keys that is used for grouping, split between the
+// attributes moved to the resource and the ones moved to the instrumentation scope
+func (gap *groupByAttrsProcessor) extractGroupingAttributes(attrMap pcommon.Map) groupingAttributes {
+	attributes := groupingAttributes{resource: pcommon.NewMap(), scope: pcommon.NewMap()}
 
 	for _, attrKey := range gap.groupByKeys {
 		attrVal, found := attrMap.Get(attrKey)
 		if found {
-			attrVal.CopyTo(groupingAttributes.PutEmpty(attrKey))
-			foundMatch = true
+			attrVal.CopyTo(attributes.resource.PutEmpty(attrKey))
+		}
+	}
+	for _, attrKey := range gap.scopeKeys {
+		attrVal, found := attrMap.Get(attrKey)
+		if found {
+			attrVal.CopyTo(attributes.scope.PutEmpty(attrKey))
 		}
 	}
 
-	return foundMatch, groupingAttributes
+	return attributes
 }
 
 // Searches for metric with same name in the specified InstrumentationLibrary and returns it. If nothing is found, create it.
@@ -235,23 +271,29 @@ func (gap *groupByAttrsProcessor) getGroupedMetricsFromAttributes(
 	originResourceMetrics pmetric.ResourceMetrics,
 	ilm pmetric.ScopeMetrics,
 	metric pmetric.Metric,
+	dataPoint any,
 	attributes pcommon.Map,
 ) pmetric.Metric {
-	toBeGrouped, requiredAttributes := gap.extractGroupingAttributes(attributes)
-	if toBeGrouped {
+	requiredAttributes := gap.extractGroupingAttributes(attributes)
+	if len(gap.dataPointKeys) > 0 {
+		tCtx := ottldatapoint.NewTransformContextPtr(originResourceMetrics, ilm, metric, dataPoint)
+		computeGroupingAttributes(ctx, gap.logger, gap.dataPointKeys, tCtx, requiredAttributes)
+		tCtx.Close()
+	}
+	if !requiredAttributes.isEmpty() {
 		gap.telemetryBuilder.ProcessorGroupbyattrsNumGroupedMetrics.Add(ctx, 1)
-		// These attributes are going to be moved from datapoint to resource level,
+		// These attributes are going to be moved from datapoint to resource or scope level,
 		// so we can delete those on the datapoint
-		deleteAttributes(requiredAttributes, attributes)
+		gap.deleteGroupingAttributes(attributes)
 	} else {
 		gap.telemetryBuilder.ProcessorGroupbyattrsNumNonGroupedMetrics.Add(ctx, 1)
 	}
 
 	// Get the ResourceMetrics matching with these attributes
-	groupedResourceMetrics := mg.findOrCreateResourceMetrics(originResourceMetrics.Resource(), requiredAttributes)
+	groupedResourceMetrics := mg.findOrCreateResourceMetrics(originResourceMetrics.Resource(), requiredAttributes.resource)
 
 	// Get the corresponding instrumentation library
-	groupedInstrumentationLibrary := matchingScopeMetrics(groupedResourceMetrics, ilm.Scope())
+	groupedInstrumentationLibrary := matchingScopeMetrics(groupedResourceMetrics, buildReferenceScope(ilm.Scope(), requiredAttributes.scope))
 
 	// Return the metric in this resource
 	return getMetricInInstrumentationLibrary(groupedInstrumentationLibrary, metric)
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -1044,3 +1045,126 @@ func BenchmarkCompacting(bb *testing.B) {
 		})
 	}
 }
+
+func TestScopeKeys(t *testing.T) {
+	cfg := &Config{
+		GroupByKeys: []string{"host.name"},
+		ScopeKeys:   []string{"scope.tier"},
+	}
+	gap, err := createConfiguredProcessor(processortest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	sl := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("lib")
+	sl.Scope().Attributes().PutStr("origin", "app")
+	for _, tier := range []string{"gold", "silver", "gold", ""} {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Attributes().PutStr("host.name", "host-A")
+		lr.Attributes().PutStr("id", "eth0")
+		if tier != "" {
+			lr.Attributes().PutStr("scope.tier", tier)
+		}
+	}
+
+	processedLogs, err := gap.processLogs(t.Context(), logs)
+	require.NoError(t, err)
+	require.Equal(t, 1, processedLogs.ResourceLogs().Len())
+	rl := processedLogs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{"host.name": "host-A"}, rl.Resource().Attributes().AsRaw())
+
+	require.Equal(t, 3, rl.ScopeLogs().Len())
+	expectedScopes := []struct {
+		attributes map[string]any
+		records    int
+	}{
+		{attributes: map[string]any{"origin": "app", "scope.tier": "gold"}, records: 2},
+		{attributes: map[string]any{"origin": "app", "scope.tier": "silver"}, records: 1},
+		{attributes: map[string]any{"origin": "app"}, records: 1},
+	}
+	for i, expected := range expectedScopes {
+		scopeLogs := rl.ScopeLogs().At(i)
+		assert.Equal(t, "lib", scopeLogs.Scope().Name())
+		assert.Equal(t, expected.attributes, scopeLogs.Scope().Attributes().AsRaw())
+		require.Equal(t, expected.records, scopeLogs.LogRecords().Len())
+		for j := 0; j < scopeLogs.LogRecords().Len(); j++ {
+			assert.Equal(t, map[string]any{"id": "eth0"}, scopeLogs.LogRecords().At(j).Attributes().AsRaw())
+		}
+	}
+}
+
+func TestComputedKeys(t *testing.T) {
+	cfg := &Config{
+		GroupByKeys: []string{},
+		ComputedKeys: []ComputedKeyConfig{
+			{Key: "service.namespace", Value: `ToUpperCase(attributes["team"])`},
+			{Key: "scope.kind", Value: `attributes["kind"]`, Target: targetScope},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+	set := processortest.NewNopSettings(metadata.Type)
+
+	assertGrouped := func(t *testing.T, resource pcommon.Resource, scope pcommon.InstrumentationScope, attributes pcommon.Map) {
+		assert.Equal(t, map[string]any{"service.namespace": "PAYMENTS"}, resource.Attributes().AsRaw())
+		assert.Equal(t, map[string]any{"scope.kind": "server"}, scope.Attributes().AsRaw())
+		// The attributes used by the expressions are kept on the records
+		assert.Equal(t, map[string]any{"team": "payments", "env": "prod", "kind": "server"}, attributes.AsRaw())
+	}
+	putAttributes := func(attributes pcommon.Map) {
+		attributes.PutStr("team", "payments")
+		attributes.PutStr("env", "prod")
+		attributes.PutStr("kind", "server")
+	}
+
+	t.Run("traces", func(t *testing.T) {
+		sink := new(consumertest.TracesSink)
+		tp, err := createTracesProcessor(t.Context(), set, cfg, sink)
+		require.NoError(t, err)
+		traces := ptrace.NewTraces()
+		putAttributes(traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().Attributes())
+		require.NoError(t, tp.ConsumeTraces(t.Context(), traces))
+
+		rs := sink.AllTraces()[0].ResourceSpans().At(0)
+		assertGrouped(t, rs.Resource(), rs.ScopeSpans().At(0).Scope(), rs.ScopeSpans().At(0).Spans().At(0).Attributes())
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		sink := new(consumertest.LogsSink)
+		lp, err := createLogsProcessor(t.Context(), set, cfg, sink)
+		require.NoError(t, err)
+		logs := plog.NewLogs()
+		putAttributes(logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes())
+		require.NoError(t, lp.ConsumeLogs(t.Context(), logs))
+
+		rl := sink.AllLogs()[0].ResourceLogs().At(0)
+		assertGrouped(t, rl.Resource(), rl.ScopeLogs().At(0).Scope(), rl.ScopeLogs().At(0).LogRecords().At(0).Attributes())
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		sink := new(consumertest.MetricsSink)
+		mp, err := createMetricsProcessor(t.Context(), set, cfg, sink)
+		require.NoError(t, err)
+		metrics := pmetric.NewMetrics()
+		metric := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		metric.SetName("gauge")
+		putAttributes(metric.SetEmptyGauge().DataPoints().AppendEmpty().Attributes())
+		// The expressions fail or evaluate to nil for this data point, which is not grouped
+		metric.Gauge().DataPoints().AppendEmpty().Attributes().PutStr("id", "eth0")
+		require.NoError(t, mp.ConsumeMetrics(t.Context(), metrics))
+
+		rms := sink.AllMetrics()[0].ResourceMetrics()
+		require.Equal(t, 2, rms.Len())
+		sm := rms.At(0).ScopeMetrics().At(0)
+		assertGrouped(t, rms.At(0).Resource(), sm.Scope(), sm.Metrics().At(0).Gauge().DataPoints().At(0).Attributes())
+		assert.Equal(t, 0, rms.At(1).Resource().Attributes().Len())
+		assert.Equal(t, 0, rms.At(1).ScopeMetrics().At(0).Scope().Attributes().Len())
+	})
+}
+
+func TestComputedKeysInvalidExpression(t *testing.T) {
+	cfg := &Config{
+		ComputedKeys: []ComputedKeyConfig{{Key: "key", Value: `UnknownFunction(attributes["key"])`}},
+	}
+	_, err := createLogsProcessor(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	assert.ErrorContains(t, err, `failed to parse the value of the computed key "key"`)
+}
@@ -10,7 +10,10 @@ import (
 	"go.opentelemetry.io/collector/component"
 )
 
-var ErrInvalidIntervalValue = errors.New("invalid interval value")
+var (
+	ErrInvalidIntervalValue     = errors.New("invalid interval value")
+	ErrInvalidMaxStalenessValue = errors.New("invalid max_staleness value")
+)
 
 var _ component.Config = (*Config)(nil)
 
@@ -21,6 +24,10 @@ type Config struct {
 	// PassThrough is a configuration that determines whether gauge and summary metrics should be passed through
 	// as they are or aggregated.
 	PassThrough PassThrough `mapstructure:"pass_through"`
+	// MaxStaleness is the time after which a series which did not receive any new data point is evicted.
+	// When set, the last value of each series is kept and exported at every interval until it is evicted,
+	// instead of clearing the state after each export. Zero disables this behavior.
+	MaxStaleness time.Duration `mapstructure:"max_staleness"`
 }
 
 type PassThrough struct {
@@ -39,5 +46,9 @@ func (config *Config) Validate() error {
 		return ErrInvalidIntervalValue
 	}
 
+	if config.MaxStaleness < 0 {
+		return ErrInvalidMaxStalenessValue
+	}
+
 	return nil
 }
@@ -37,6 +37,7 @@ type intervalProcessor struct {
 	histogramLookup    map[identity.Stream]pmetric.HistogramDataPoint
 	expHistogramLookup map[identity.Stream]pmetric.ExponentialHistogramDataPoint
 	summaryLookup      map[identity.Stream]pmetric.SummaryDataPoint
+	lastSeen           map[identity.Stream]time.Time
 
 	config *Config
 
@@ -61,6 +62,7 @@ func newProcessor(config *Config, log *zap.Logger, nextConsumer consumer.Metrics
 		histogramLookup:    map[identity.Stream]pmetric.HistogramDataPoint{},
 		expHistogramLookup: map[identity.Stream]pmetric.ExponentialHistogramDataPoint{},
 		summaryLookup:      map[identity.Stream]pmetric.SummaryDataPoint{},
+		lastSeen:           map[identity.Stream]time.Time{},
 
 		config: config,
 
@@ -100,6 +102,7 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 	p.stateLock.Lock()
 	defer p.stateLock.Unlock()
 
+	now := time.Now()
 	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
 		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
 			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
@@ -110,7 +113,7 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 					}
 
 					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
-					aggregateDataPoints(m.Summary().DataPoints(), mClone.Summary().DataPoints(), metricID, p.summaryLookup)
+					aggregateDataPoints(m.Summary().DataPoints(), mClone.Summary().DataPoints(), metricID, p.summaryLookup, p.lastSeen, now)
 					return true
 				case pmetric.MetricTypeGauge:
 					if p.config.PassThrough.Gauge {
@@ -118,7 +121,7 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 					}
 
 					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
-					aggregateDataPoints(m.Gauge().DataPoints(), mClone.Gauge().DataPoints(), metricID, p.numberLookup)
+					aggregateDataPoints(m.Gauge().DataPoints(), mClone.Gauge().DataPoints(), metricID, p.numberLookup, p.lastSeen, now)
 					return true
 				case pmetric.MetricTypeSum:
 					// Check if we care about this value
@@ -135,7 +138,7 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
 					cloneSum := mClone.Sum()
 
-					aggregateDataPoints(sum.DataPoints(), cloneSum.DataPoints(), metricID, p.numberLookup)
+					aggregateDataPoints(sum.DataPoints(), cloneSum.DataPoints(), metricID, p.numberLookup, p.lastSeen, now)
 					return true
 				case pmetric.MetricTypeHistogram:
 					histogram := m.Histogram()
@@ -147,7 +150,7 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
 					cloneHistogram := mClone.Histogram()
 
-					aggregateDataPoints(histogram.DataPoints(), cloneHistogram.DataPoints(), metricID, p.histogramLookup)
+					aggregateDataPoints(histogram.DataPoints(), cloneHistogram.DataPoints(), metricID, p.histogramLookup, p.lastSeen, now)
 					return true
 				case pmetric.MetricTypeExponentialHistogram:
 					expHistogram := m.ExponentialHistogram()
@@ -159,7 +162,7 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
 					cloneExpHistogram := mClone.ExponentialHistogram()
 
-					aggregateDataPoints(expHistogram.DataPoints(), cloneExpHistogram.DataPoints(), metricID, p.expHistogramLookup)
+					aggregateDataPoints(expHistogram.DataPoints(), cloneExpHistogram.DataPoints(), metricID, p.expHistogramLookup, p.lastSeen, now)
 					return true
 				default:
 					errs = errors.Join(fmt.Errorf("invalid MetricType %d", m.Type()))
@@ -178,11 +181,12 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 	return errs
 }
 
-func aggregateDataPoints[DPS metrics.DataPointSlice[DP], DP metrics.DataPoint[DP]](dataPoints, mCloneDataPoints DPS, metricID identity.Metric, dpLookup map[identity.Stream]DP) {
+func aggregateDataPoints[DPS metrics.DataPointSlice[DP], DP metrics.DataPoint[DP]](dataPoints, mCloneDataPoints DPS, metricID identity.Metric, dpLookup map[identity.Stream]DP, lastSeen map[identity.Stream]time.Time, now time.Time) {
 	for i := 0; i < dataPoints.Len(); i++ {
 		dp := dataPoints.At(i)
 
 		streamID := identity.OfStream(metricID, dp)
+		lastSeen[streamID] = now
 		existingDP, ok := dpLookup[streamID]
 		if !ok {
 			dpClone := mCloneDataPoints.AppendEmpty()
@@ -206,6 +210,14 @@ func (p *intervalProcessor) exportMetrics() {
 		p.stateLock.Lock()
 		defer p.stateLock.Unlock()
 
+		if p.config.MaxStaleness > 0 {
+			// Keep the last value of the series until they become stale
+			p.evictStaleStreams(time.Now())
+			out := pmetric.NewMetrics()
+			p.md.CopyTo(out)
+			return out
+		}
+
 		// ConsumeMetrics() has prepared our own pmetric.Metrics instance ready for us to use
 		// Take it and clear replace it with a new empty one
 		out := p.md
@@ -219,6 +231,7 @@ func (p *intervalProcessor) exportMetrics() {
 		clear(p.histogramLookup)
 		clear(p.expHistogramLookup)
 		clear(p.summaryLookup)
+		clear(p.lastSeen)
 
 		return out
 	}()
@@ -228,6 +241,67 @@ func (p *intervalProcessor) exportMetrics() {
 	}
 }
 
+// evictStaleStreams removes the series which did not receive any data point within the max staleness,
+// along with the metrics, scopes and resources left without any series.
+func (p *intervalProcessor) evictStaleStreams(now time.Time) {
+	for streamID, lastSeen := range p.lastSeen {
+		if now.Sub(lastSeen) < p.config.MaxStaleness {
+			continue
+		}
+		delete(p.lastSeen, streamID)
+		delete(p.numberLookup, streamID)
+		delete(p.histogramLookup, streamID)
+		delete(p.expHistogramLookup, streamID)
+		delete(p.summaryLookup, streamID)
+	}
+
+	p.md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		resID := identity.OfResource(rm.Resource())
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			scopeID := identity.OfScope(resID, sm.Scope())
+			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				metricID := identity.OfMetric(scopeID, m)
+				var empty bool
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					empty = removeStaleDataPoints(m.Gauge().DataPoints(), metricID, p.lastSeen)
+				case pmetric.MetricTypeSum:
+					empty = removeStaleDataPoints(m.Sum().DataPoints(), metricID, p.lastSeen)
+				case pmetric.MetricTypeHistogram:
+					empty = removeStaleDataPoints(m.Histogram().DataPoints(), metricID, p.lastSeen)
+				case pmetric.MetricTypeExponentialHistogram:
+					empty = removeStaleDataPoints(m.ExponentialHistogram().DataPoints(), metricID, p.lastSeen)
+				case pmetric.MetricTypeSummary:
+					empty = removeStaleDataPoints(m.Summary().DataPoints(), metricID, p.lastSeen)
+				}
+				if empty {
+					delete(p.mLookup, metricID)
+				}
+				return empty
+			})
+			if sm.Metrics().Len() == 0 {
+				delete(p.smLookup, scopeID)
+				return true
+			}
+			return false
+		})
+		if rm.ScopeMetrics().Len() == 0 {
+			delete(p.rmLookup, resID)
+			return true
+		}
+		return false
+	})
+}
+
+// removeStaleDataPoints removes the data points of the evicted series and reports whether none is left.
+func removeStaleDataPoints[DPS metrics.DataPointSlice[DP], DP metrics.DataPoint[DP]](dataPoints DPS, metricID identity.Metric, lastSeen map[identity.Stream]time.Time) bool {
+	dataPoints.RemoveIf(func(dp DP) bool {
+		_, ok := lastSeen[identity.OfStream(metricID, dp)]
+		return !ok
+	})
+	return dataPoints.Len() == 0
+}
+
 func (p *intervalProcessor) getOrCloneMetric(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) (pmetric.Metric, identity.Metric) {
 	// Find the ResourceMetrics
 	resID := identity.OfResource(rm.Resource())
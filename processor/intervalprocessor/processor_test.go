@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/processor/processortest"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
@@ -107,3 +108,66 @@ func TestAggregation(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxStaleness(t *testing.T) {
+	next := &consumertest.MetricsSink{}
+	config := &Config{Interval: time.Second, MaxStaleness: time.Minute}
+	processor := newProcessor(config, zap.NewNop(), next)
+
+	dir := filepath.Join("testdata", "gauges_are_aggregated")
+	md, err := golden.ReadMetrics(filepath.Join(dir, "input.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, processor.ConsumeMetrics(t.Context(), md))
+
+	expectedExportData, err := golden.ReadMetrics(filepath.Join(dir, "output.yaml"))
+	require.NoError(t, err)
+
+	// The last value of the series is exported at every interval until it becomes stale
+	processor.exportMetrics()
+	processor.exportMetrics()
+	allMetrics := next.AllMetrics()
+	require.Len(t, allMetrics, 3)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedExportData, allMetrics[1]))
+	require.NoError(t, pmetrictest.CompareMetrics(expectedExportData, allMetrics[2]))
+	require.Len(t, processor.numberLookup, 1)
+
+	backdate := func() {
+		for streamID, lastSeen := range processor.lastSeen {
+			processor.lastSeen[streamID] = lastSeen.Add(-config.MaxStaleness)
+		}
+	}
+
+	// Only the series updated within the max staleness are kept
+	backdate()
+	other := pmetric.NewMetrics()
+	m := other.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("other.gauge")
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(100)
+	dp.SetIntValue(42)
+	expectedOther := pmetric.NewMetrics()
+	other.CopyTo(expectedOther)
+	require.NoError(t, processor.ConsumeMetrics(t.Context(), other))
+
+	processor.exportMetrics()
+	allMetrics = next.AllMetrics()
+	require.Len(t, allMetrics, 5)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedOther, allMetrics[4]))
+	require.Len(t, processor.rmLookup, 1)
+	require.Len(t, processor.smLookup, 1)
+	require.Len(t, processor.mLookup, 1)
+	require.Len(t, processor.numberLookup, 1)
+	require.Len(t, processor.lastSeen, 1)
+
+	// Evicting all the series empties the state
+	backdate()
+	processor.exportMetrics()
+	allMetrics = next.AllMetrics()
+	require.Len(t, allMetrics, 6)
+	require.NoError(t, pmetrictest.CompareMetrics(pmetric.NewMetrics(), allMetrics[5]))
+	require.Empty(t, processor.rmLookup)
+	require.Empty(t, processor.smLookup)
+	require.Empty(t, processor.mLookup)
+	require.Empty(t, processor.numberLookup)
+	require.Empty(t, processor.lastSeen)
+}
@@ -34,6 +34,7 @@ type fakeClient struct {
 	DaemonSets         map[string]*kube.DaemonSet
 	ReplicaSets        map[string]*kube.ReplicaSet
 	Jobs               map[string]*kube.Job
+	NotSynced          bool
 	StopCh             chan struct{}
 	stopOnce           sync.Once
 	stopWg             sync.WaitGroup
@@ -45,7 +46,7 @@ func selectors() (labels.Selector, fields.Selector) {
 }
 
 // newFakeClient instantiates a new FakeClient object and satisfies the ClientProvider type
-func newFakeClient(_ component.TelemetrySettings, _ k8sconfig.APIConfig, rules kube.ExtractionRules, filters kube.Filters, associations []kube.Association, _ kube.Excludes, _ kube.APIClientsetProvider, _ kube.InformersFactoryList, _ bool, _ time.Duration) (kube.Client, error) {
+func newFakeClient(_ component.TelemetrySettings, _ k8sconfig.APIConfig, rules kube.ExtractionRules, filters kube.Filters, associations []kube.Association, _ kube.Excludes, _ kube.APIClientsetProvider, _ kube.InformersFactoryList, _ bool, _ time.Duration, _ kube.WatchBackoff) (kube.Client, error) {
 	cs := fake.NewClientset()
 
 	ls, fs := selectors()
@@ -104,6 +105,11 @@ func (f *fakeClient) GetJob(jobUID string) (*kube.Job, bool) {
 	return j, ok
 }
 
+// HasSynced returns whether the metadata of the FakeClient are synced, which they are unless NotSynced is set.
+func (f *fakeClient) HasSynced() bool {
+	return !f.NotSynced
+}
+
 // Start is a noop for FakeClient.
 func (f *fakeClient) Start() error {
 	startInformer := func(informer cache.SharedInformer) {
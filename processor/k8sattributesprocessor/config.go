@@ -4,11 +4,14 @@
 package k8sattributesprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor"
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
 	"time"
 
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/pipeline/xpipeline"
 	conventions "go.opentelemetry.io/otel/semconv/v1.39.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -46,6 +49,47 @@ type Config struct {
 
 	// WaitForMetadataTimeout is the maximum time the processor will wait for the k8s metadata to be synced.
 	WaitForMetadataTimeout time.Duration `mapstructure:"wait_for_metadata_timeout"`
+
+	// WaitForMetadataSignals restricts WaitForMetadata to the pipelines of the given signals: traces, metrics,
+	// logs or profiles. The processors of the other signals start without waiting, and forward the data received
+	// before the k8s metadata are synced without enriching it. Defaults to all the signals.
+	WaitForMetadataSignals []string `mapstructure:"wait_for_metadata_signals"`
+
+	// WatchBackoff configures the backoff of the informers after the API server failed a list or a watch,
+	// for instance because it throttled the requests or expired the watch.
+	WatchBackoff WatchBackoffConfig `mapstructure:"watch_backoff"`
+}
+
+// WatchBackoffConfig configures the exponential backoff with jitter applied to the list and watch calls
+// of the informers after a failure.
+type WatchBackoffConfig struct {
+	// InitialInterval is the delay after the first failure.
+	InitialInterval time.Duration `mapstructure:"initial_interval"`
+
+	// MaxInterval caps the delay between two calls.
+	MaxInterval time.Duration `mapstructure:"max_interval"`
+
+	// Multiplier is applied to the delay after each consecutive failure.
+	Multiplier float64 `mapstructure:"multiplier"`
+
+	// RandomizationFactor spreads the delays by up to this fraction of their value.
+	RandomizationFactor float64 `mapstructure:"randomization_factor"`
+}
+
+func (cfg *WatchBackoffConfig) Validate() error {
+	if cfg.InitialInterval <= 0 {
+		return errors.New("initial_interval must be positive")
+	}
+	if cfg.MaxInterval < cfg.InitialInterval {
+		return errors.New("max_interval must not be lower than initial_interval")
+	}
+	if cfg.Multiplier < 1 {
+		return errors.New("multiplier must be greater than or equal to 1")
+	}
+	if cfg.RandomizationFactor < 0 || cfg.RandomizationFactor > 1 {
+		return errors.New("randomization_factor must be between 0 and 1")
+	}
+	return nil
 }
 
 func (cfg *Config) Validate() error {
@@ -81,7 +125,7 @@ func (cfg *Config) Validate() error {
 	for _, field := range cfg.Extract.Metadata {
 		switch field {
 		case string(conventions.K8SNamespaceNameKey), string(conventions.K8SPodNameKey), string(conventions.K8SPodUIDKey),
-			specPodHostName, metadataPodStartTime, metadataPodIP,
+			specPodHostName, metadataPodStartTime, metadataPodIP, metadataPodPhase, metadataPodReady,
 			string(conventions.K8SDeploymentNameKey), string(conventions.K8SDeploymentUIDKey),
 			string(conventions.K8SReplicaSetNameKey), string(conventions.K8SReplicaSetUIDKey),
 			string(conventions.K8SDaemonSetNameKey), string(conventions.K8SDaemonSetUIDKey),
@@ -99,6 +143,17 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	for _, signal := range cfg.WaitForMetadataSignals {
+		switch signal {
+		case pipeline.SignalTraces.String(), pipeline.SignalMetrics.String(), pipeline.SignalLogs.String(), xpipeline.SignalProfiles.String():
+		default:
+			return fmt.Errorf("%q is not a valid signal for wait_for_metadata_signals. Must be one of: traces, metrics, logs, profiles", signal)
+		}
+	}
+	if len(cfg.WaitForMetadataSignals) > 0 && !cfg.WaitForMetadata {
+		return errors.New("wait_for_metadata_signals requires wait_for_metadata to be enabled")
+	}
+
 	for _, f := range cfg.Filter.Labels {
 		switch f.Op {
 		case "", filterOPEquals, filterOPNotEquals, filterOPExists, filterOPDoesNotExist:
@@ -33,6 +33,7 @@ func TestLoadConfig(t *testing.T) {
 					Metadata: enabledAttributes(),
 				},
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -105,6 +106,7 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -128,6 +130,7 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -140,6 +143,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -179,6 +183,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -191,8 +196,49 @@ func TestLoadConfig(t *testing.T) {
 				Exclude:                defaultExcludes,
 				WaitForMetadata:        true,
 				WaitForMetadataTimeout: 30 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "wait_for_metadata_signals"),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				Extract: ExtractConfig{
+					Metadata: enabledAttributes(),
+				},
+				Exclude:                defaultExcludes,
+				WaitForMetadata:        true,
+				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
+				WaitForMetadataSignals: []string{"traces", "metrics"},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "watch_backoff"),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				Extract: ExtractConfig{
+					Metadata: enabledAttributes(),
+				},
+				Exclude:                defaultExcludes,
+				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff: WatchBackoffConfig{
+					InitialInterval:     5 * time.Second,
+					MaxInterval:         5 * time.Minute,
+					Multiplier:          1.5,
+					RandomizationFactor: 0.2,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_watch_backoff"),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_wait_for_metadata_signals"),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "wait_for_metadata_signals_without_wait"),
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "passthrough_mode"),
 			expected: &Config{
@@ -203,6 +249,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -219,6 +266,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -235,6 +283,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -252,6 +301,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -266,6 +316,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -280,6 +331,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -294,6 +346,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -308,6 +361,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -322,6 +376,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -342,6 +397,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Exclude:                defaultExcludes,
 				WaitForMetadataTimeout: 10 * time.Second,
+				WatchBackoff:           defaultWatchBackoff(),
 			},
 		},
 		{
@@ -5,11 +5,14 @@ package k8sattributesprocessor // import "github.com/open-telemetry/opentelemetr
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/xconsumer"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/pipeline/xpipeline"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.opentelemetry.io/collector/processor/processorhelper/xprocessorhelper"
@@ -46,6 +49,16 @@ func createDefaultConfig() component.Config {
 			Metadata: enabledAttributes(),
 		},
 		WaitForMetadataTimeout: 10 * time.Second,
+		WatchBackoff:           defaultWatchBackoff(),
+	}
+}
+
+func defaultWatchBackoff() WatchBackoffConfig {
+	return WatchBackoffConfig{
+		InitialInterval:     time.Second,
+		MaxInterval:         time.Minute,
+		Multiplier:          2,
+		RandomizationFactor: 0.5,
 	}
 }
 
@@ -92,7 +105,7 @@ func createTracesProcessorWithOptions(
 	next consumer.Traces,
 	options ...option,
 ) (processor.Traces, error) {
-	kp := createKubernetesProcessor(set, cfg, options...)
+	kp := createKubernetesProcessor(set, cfg, pipeline.SignalTraces, options...)
 
 	return processorhelper.NewTraces(
 		ctx,
@@ -112,7 +125,7 @@ func createMetricsProcessorWithOptions(
 	nextMetricsConsumer consumer.Metrics,
 	options ...option,
 ) (processor.Metrics, error) {
-	kp := createKubernetesProcessor(set, cfg, options...)
+	kp := createKubernetesProcessor(set, cfg, pipeline.SignalMetrics, options...)
 
 	return processorhelper.NewMetrics(
 		ctx,
@@ -132,7 +145,7 @@ func createLogsProcessorWithOptions(
 	nextLogsConsumer consumer.Logs,
 	options ...option,
 ) (processor.Logs, error) {
-	kp := createKubernetesProcessor(set, cfg, options...)
+	kp := createKubernetesProcessor(set, cfg, pipeline.SignalLogs, options...)
 
 	return processorhelper.NewLogs(
 		ctx,
@@ -152,7 +165,7 @@ func createProfilesProcessorWithOptions(
 	nextProfilesConsumer xconsumer.Profiles,
 	options ...option,
 ) (xprocessor.Profiles, error) {
-	kp := createKubernetesProcessor(set, cfg, options...)
+	kp := createKubernetesProcessor(set, cfg, xpipeline.SignalProfiles, options...)
 
 	return xprocessorhelper.NewProfiles(
 		ctx,
@@ -169,11 +182,13 @@ func createProfilesProcessorWithOptions(
 func createKubernetesProcessor(
 	params processor.Settings,
 	cfg component.Config,
+	signal pipeline.Signal,
 	options ...option,
 ) *kubernetesprocessor {
 	kp := &kubernetesprocessor{
 		logger:            params.Logger,
 		cfg:               cfg,
+		signal:            signal,
 		options:           options,
 		telemetrySettings: params.TelemetrySettings,
 	}
@@ -181,7 +196,7 @@ func createKubernetesProcessor(
 	return kp
 }
 
-func createProcessorOpts(cfg component.Config) []option {
+func createProcessorOpts(cfg component.Config, signal pipeline.Signal) []option {
 	oCfg := cfg.(*Config)
 	var opts []option
 	if oCfg.Passthrough {
@@ -203,11 +218,18 @@ func createProcessorOpts(cfg component.Config) []option {
 		withAPIConfig(oCfg.APIConfig),
 		withExtractPodAssociations(oCfg.Association...),
 		withExcludes(oCfg.Exclude),
-		withWaitForMetadataTimeout(oCfg.WaitForMetadataTimeout))
+		withWaitForMetadataTimeout(oCfg.WaitForMetadataTimeout),
+		withWatchBackoff(oCfg.WatchBackoff))
 
-	if oCfg.WaitForMetadata {
+	if oCfg.WaitForMetadata && waitsForMetadata(oCfg.WaitForMetadataSignals, signal) {
 		opts = append(opts, withWaitForMetadata(true))
 	}
 
 	return opts
 }
+
+// waitsForMetadata returns whether the processor of the given signal waits for the k8s metadata to be synced
+// when starting.
+func waitsForMetadata(signals []string, signal pipeline.Signal) bool {
+	return len(signals) == 0 || slices.Contains(signals, signal.String())
+}
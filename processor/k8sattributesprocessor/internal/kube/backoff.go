@@ -0,0 +1,356 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kube // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	apps_v1 "k8s.io/api/apps/v1"
+	batch_v1 "k8s.io/api/batch/v1"
+	api_v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	typedappsv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
+	typedbatchv1 "k8s.io/client-go/kubernetes/typed/batch/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/metadata"
+)
+
+// Reasons of the watch restarts.
+const (
+	watchRestartClosed          = "closed"
+	watchRestartExpired         = "expired"
+	watchRestartTooManyRequests = "too_many_requests"
+	watchRestartError           = "error"
+)
+
+// WatchBackoff configures the exponential backoff applied to the list and watch calls
+// of the informers after the API server failed one of them.
+type WatchBackoff struct {
+	// InitialInterval is the delay after the first failure, the backoff is disabled if zero.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between the calls.
+	MaxInterval time.Duration
+	// Multiplier is applied to the delay after each consecutive failure.
+	Multiplier float64
+	// RandomizationFactor spreads the delays by up to this fraction, so that the informers
+	// of many collectors don't retry at the same time.
+	RandomizationFactor float64
+}
+
+// resourceBackoff tracks the failures of the list and watch calls of a resource.
+type resourceBackoff struct {
+	resource         string
+	cfg              WatchBackoff
+	telemetryBuilder *metadata.TelemetryBuilder
+
+	mu         sync.Mutex
+	failures   int
+	retryAfter time.Duration
+	reason     string
+	watched    bool
+}
+
+func (r *resourceBackoff) delay() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures == 0 {
+		return 0
+	}
+	interval := float64(r.cfg.InitialInterval) * math.Pow(r.cfg.Multiplier, float64(r.failures-1))
+	interval = math.Min(interval, float64(r.cfg.MaxInterval))
+	delta := r.cfg.RandomizationFactor * interval
+	return max(time.Duration(interval-delta+rand.Float64()*2*delta), r.retryAfter)
+}
+
+// wait blocks until the next call is allowed.
+func (r *resourceBackoff) wait(ctx context.Context) error {
+	delay := r.delay()
+	if delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (r *resourceBackoff) fail(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures++
+	r.retryAfter = 0
+	if seconds, ok := apierrors.SuggestsClientDelay(err); ok {
+		r.retryAfter = time.Duration(seconds) * time.Second
+	}
+	switch {
+	case apierrors.IsResourceExpired(err), apierrors.IsGone(err):
+		r.reason = watchRestartExpired
+	case apierrors.IsTooManyRequests(err):
+		r.reason = watchRestartTooManyRequests
+	default:
+		r.reason = watchRestartError
+	}
+}
+
+func (r *resourceBackoff) succeed() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures = 0
+	r.retryAfter = 0
+}
+
+func list[T any](ctx context.Context, r *resourceBackoff, call func() (T, error)) (T, error) {
+	if err := r.wait(ctx); err != nil {
+		var zero T
+		return zero, err
+	}
+	result, err := call()
+	if err != nil {
+		r.fail(err)
+	} else {
+		r.succeed()
+	}
+	return result, err
+}
+
+func (r *resourceBackoff) watch(ctx context.Context, opts metav1.ListOptions, call func(context.Context, metav1.ListOptions) (watch.Interface, error)) (watch.Interface, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	if r.watched {
+		r.telemetryBuilder.OtelsvcK8sWatchRestarts.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("resource", r.resource),
+			attribute.String("reason", r.reason),
+		))
+	}
+	r.watched = true
+	r.reason = watchRestartClosed
+	r.mu.Unlock()
+
+	// the bookmarks keep the resource version fresh, avoiding expired watches and full relists
+	opts.AllowWatchBookmarks = true
+	w, err := call(ctx, opts)
+	if err != nil {
+		r.fail(err)
+		return nil, err
+	}
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		if event.Type == watch.Error {
+			r.fail(apierrors.FromObject(event.Object))
+		} else {
+			r.succeed()
+		}
+		return event, true
+	}), nil
+}
+
+// backoffClientset wraps the list and watch calls used by the informers with a backoff,
+// so that the informers don't hot-loop when the API server throttles them or expires
+// their watches.
+type backoffClientset struct {
+	kubernetes.Interface
+	cfg              WatchBackoff
+	telemetryBuilder *metadata.TelemetryBuilder
+
+	mu        sync.Mutex
+	resources map[string]*resourceBackoff
+}
+
+func newBackoffClientset(client kubernetes.Interface, cfg WatchBackoff, telemetryBuilder *metadata.TelemetryBuilder) *backoffClientset {
+	return &backoffClientset{
+		Interface:        client,
+		cfg:              cfg,
+		telemetryBuilder: telemetryBuilder,
+		resources:        map[string]*resourceBackoff{},
+	}
+}
+
+func (c *backoffClientset) backoff(resource string) *resourceBackoff {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r, ok := c.resources[resource]
+	if !ok {
+		r = &resourceBackoff{resource: resource, cfg: c.cfg, telemetryBuilder: c.telemetryBuilder}
+		c.resources[resource] = r
+	}
+	return r
+}
+
+func (c *backoffClientset) CoreV1() typedcorev1.CoreV1Interface {
+	return &backoffCoreV1{CoreV1Interface: c.Interface.CoreV1(), c: c}
+}
+
+func (c *backoffClientset) AppsV1() typedappsv1.AppsV1Interface {
+	return &backoffAppsV1{AppsV1Interface: c.Interface.AppsV1(), c: c}
+}
+
+func (c *backoffClientset) BatchV1() typedbatchv1.BatchV1Interface {
+	return &backoffBatchV1{BatchV1Interface: c.Interface.BatchV1(), c: c}
+}
+
+type backoffCoreV1 struct {
+	typedcorev1.CoreV1Interface
+	c *backoffClientset
+}
+
+func (c *backoffCoreV1) Pods(namespace string) typedcorev1.PodInterface {
+	return &backoffPods{PodInterface: c.CoreV1Interface.Pods(namespace), r: c.c.backoff("pods")}
+}
+
+func (c *backoffCoreV1) Namespaces() typedcorev1.NamespaceInterface {
+	return &backoffNamespaces{NamespaceInterface: c.CoreV1Interface.Namespaces(), r: c.c.backoff("namespaces")}
+}
+
+func (c *backoffCoreV1) Nodes() typedcorev1.NodeInterface {
+	return &backoffNodes{NodeInterface: c.CoreV1Interface.Nodes(), r: c.c.backoff("nodes")}
+}
+
+type backoffAppsV1 struct {
+	typedappsv1.AppsV1Interface
+	c *backoffClientset
+}
+
+func (c *backoffAppsV1) ReplicaSets(namespace string) typedappsv1.ReplicaSetInterface {
+	return &backoffReplicaSets{ReplicaSetInterface: c.AppsV1Interface.ReplicaSets(namespace), r: c.c.backoff("replicasets")}
+}
+
+func (c *backoffAppsV1) Deployments(namespace string) typedappsv1.DeploymentInterface {
+	return &backoffDeployments{DeploymentInterface: c.AppsV1Interface.Deployments(namespace), r: c.c.backoff("deployments")}
+}
+
+func (c *backoffAppsV1) StatefulSets(namespace string) typedappsv1.StatefulSetInterface {
+	return &backoffStatefulSets{StatefulSetInterface: c.AppsV1Interface.StatefulSets(namespace), r: c.c.backoff("statefulsets")}
+}
+
+func (c *backoffAppsV1) DaemonSets(namespace string) typedappsv1.DaemonSetInterface {
+	return &backoffDaemonSets{DaemonSetInterface: c.AppsV1Interface.DaemonSets(namespace), r: c.c.backoff("daemonsets")}
+}
+
+type backoffBatchV1 struct {
+	typedbatchv1.BatchV1Interface
+	c *backoffClientset
+}
+
+func (c *backoffBatchV1) Jobs(namespace string) typedbatchv1.JobInterface {
+	return &backoffJobs{JobInterface: c.BatchV1Interface.Jobs(namespace), r: c.c.backoff("jobs")}
+}
+
+type backoffPods struct {
+	typedcorev1.PodInterface
+	r *resourceBackoff
+}
+
+func (p *backoffPods) List(ctx context.Context, opts metav1.ListOptions) (*api_v1.PodList, error) {
+	return list(ctx, p.r, func() (*api_v1.PodList, error) { return p.PodInterface.List(ctx, opts) })
+}
+
+func (p *backoffPods) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return p.r.watch(ctx, opts, p.PodInterface.Watch)
+}
+
+type backoffNamespaces struct {
+	typedcorev1.NamespaceInterface
+	r *resourceBackoff
+}
+
+func (n *backoffNamespaces) List(ctx context.Context, opts metav1.ListOptions) (*api_v1.NamespaceList, error) {
+	return list(ctx, n.r, func() (*api_v1.NamespaceList, error) { return n.NamespaceInterface.List(ctx, opts) })
+}
+
+func (n *backoffNamespaces) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return n.r.watch(ctx, opts, n.NamespaceInterface.Watch)
+}
+
+type backoffNodes struct {
+	typedcorev1.NodeInterface
+	r *resourceBackoff
+}
+
+func (n *backoffNodes) List(ctx context.Context, opts metav1.ListOptions) (*api_v1.NodeList, error) {
+	return list(ctx, n.r, func() (*api_v1.NodeList, error) { return n.NodeInterface.List(ctx, opts) })
+}
+
+func (n *backoffNodes) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return n.r.watch(ctx, opts, n.NodeInterface.Watch)
+}
+
+type backoffReplicaSets struct {
+	typedappsv1.ReplicaSetInterface
+	r *resourceBackoff
+}
+
+func (s *backoffReplicaSets) List(ctx context.Context, opts metav1.ListOptions) (*apps_v1.ReplicaSetList, error) {
+	return list(ctx, s.r, func() (*apps_v1.ReplicaSetList, error) { return s.ReplicaSetInterface.List(ctx, opts) })
+}
+
+func (s *backoffReplicaSets) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return s.r.watch(ctx, opts, s.ReplicaSetInterface.Watch)
+}
+
+type backoffDeployments struct {
+	typedappsv1.DeploymentInterface
+	r *resourceBackoff
+}
+
+func (d *backoffDeployments) List(ctx context.Context, opts metav1.ListOptions) (*apps_v1.DeploymentList, error) {
+	return list(ctx, d.r, func() (*apps_v1.DeploymentList, error) { return d.DeploymentInterface.List(ctx, opts) })
+}
+
+func (d *backoffDeployments) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return d.r.watch(ctx, opts, d.DeploymentInterface.Watch)
+}
+
+type backoffStatefulSets struct {
+	typedappsv1.StatefulSetInterface
+	r *resourceBackoff
+}
+
+func (s *backoffStatefulSets) List(ctx context.Context, opts metav1.ListOptions) (*apps_v1.StatefulSetList, error) {
+	return list(ctx, s.r, func() (*apps_v1.StatefulSetList, error) { return s.StatefulSetInterface.List(ctx, opts) })
+}
+
+func (s *backoffStatefulSets) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return s.r.watch(ctx, opts, s.StatefulSetInterface.Watch)
+}
+
+type backoffDaemonSets struct {
+	typedappsv1.DaemonSetInterface
+	r *resourceBackoff
+}
+
+func (d *backoffDaemonSets) List(ctx context.Context, opts metav1.ListOptions) (*apps_v1.DaemonSetList, error) {
+	return list(ctx, d.r, func() (*apps_v1.DaemonSetList, error) { return d.DaemonSetInterface.List(ctx, opts) })
+}
+
+func (d *backoffDaemonSets) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return d.r.watch(ctx, opts, d.DaemonSetInterface.Watch)
+}
+
+type backoffJobs struct {
+	typedbatchv1.JobInterface
+	r *resourceBackoff
+}
+
+func (j *backoffJobs) List(ctx context.Context, opts metav1.ListOptions) (*batch_v1.JobList, error) {
+	return list(ctx, j.r, func() (*batch_v1.JobList, error) { return j.JobInterface.List(ctx, opts) })
+}
+
+func (j *backoffJobs) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return j.r.watch(ctx, opts, j.JobInterface.Watch)
+}
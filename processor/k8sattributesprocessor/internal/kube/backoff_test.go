@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kube
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	api_v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/metadatatest"
+)
+
+func TestResourceBackoffDelay(t *testing.T) {
+	r := &resourceBackoff{cfg: WatchBackoff{
+		InitialInterval: time.Second,
+		MaxInterval:     10 * time.Second,
+		Multiplier:      2,
+	}}
+	assert.Zero(t, r.delay())
+
+	r.fail(apierrors.NewResourceExpired("expired"))
+	assert.Equal(t, time.Second, r.delay())
+	assert.Equal(t, watchRestartExpired, r.reason)
+
+	r.fail(apierrors.NewInternalError(assert.AnError))
+	r.fail(apierrors.NewInternalError(assert.AnError))
+	assert.Equal(t, 4*time.Second, r.delay())
+	assert.Equal(t, watchRestartError, r.reason)
+
+	for range 100 {
+		r.fail(apierrors.NewInternalError(assert.AnError))
+	}
+	assert.Equal(t, 10*time.Second, r.delay())
+
+	// the delay suggested by the API server takes precedence when it is longer
+	r.succeed()
+	r.fail(apierrors.NewTooManyRequests("slow down", 30))
+	assert.Equal(t, 30*time.Second, r.delay())
+	assert.Equal(t, watchRestartTooManyRequests, r.reason)
+
+	r.succeed()
+	assert.Zero(t, r.delay())
+
+	r.cfg.RandomizationFactor = 0.5
+	r.fail(apierrors.NewResourceExpired("expired"))
+	for range 100 {
+		delay := r.delay()
+		assert.GreaterOrEqual(t, delay, 500*time.Millisecond)
+		assert.LessOrEqual(t, delay, 1500*time.Millisecond)
+	}
+}
+
+func TestResourceBackoffWaitCanceled(t *testing.T) {
+	r := &resourceBackoff{cfg: WatchBackoff{InitialInterval: time.Hour, MaxInterval: time.Hour, Multiplier: 1}}
+	r.fail(apierrors.NewResourceExpired("expired"))
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	require.ErrorIs(t, r.wait(ctx), context.Canceled)
+}
+
+func TestBackoffClientset(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(tel.NewTelemetrySettings())
+	require.NoError(t, err)
+
+	client := fake.NewClientset()
+	listCalls := 0
+	client.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		listCalls++
+		if listCalls == 1 {
+			return true, nil, apierrors.NewTooManyRequests("slow down", 0)
+		}
+		return true, &api_v1.PodList{}, nil
+	})
+	watcher := watch.NewFake()
+	client.PrependWatchReactor("pods", k8stesting.DefaultWatchReactor(watcher, nil))
+
+	cs := newBackoffClientset(client, WatchBackoff{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+		Multiplier:      1,
+	}, telemetryBuilder)
+	pods := cs.CoreV1().Pods("ns")
+
+	_, err = pods.List(t.Context(), metav1.ListOptions{})
+	require.Error(t, err)
+	r := cs.backoff("pods")
+	assert.Equal(t, 1, r.failures)
+	_, err = pods.List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Zero(t, r.failures)
+
+	w, err := pods.Watch(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	go watcher.Error(&apierrors.NewResourceExpired("too old resource version").ErrStatus)
+	event := <-w.ResultChan()
+	assert.Equal(t, watch.Error, event.Type)
+	w.Stop()
+	assert.Equal(t, 1, r.failures)
+
+	_, err = pods.Watch(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	metadatatest.AssertEqualOtelsvcK8sWatchRestarts(t, tel, []metricdata.DataPoint[int64]{{
+		Value: 1,
+		Attributes: attribute.NewSet(
+			attribute.String("resource", "pods"),
+			attribute.String("reason", watchRestartExpired),
+		),
+	}}, metricdatatest.IgnoreTimestamp())
+
+	// the other resources have their own backoff
+	_, err = cs.AppsV1().Deployments("ns").List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Zero(t, cs.backoff("deployments").failures)
+}
+
+func TestResourceBackoffWatchBookmarks(t *testing.T) {
+	r := &resourceBackoff{}
+	var opts metav1.ListOptions
+	_, err := r.watch(t.Context(), metav1.ListOptions{}, func(_ context.Context, o metav1.ListOptions) (watch.Interface, error) {
+		opts = o
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "")
+	})
+	require.Error(t, err)
+	assert.True(t, opts.AllowWatchBookmarks)
+	assert.Equal(t, 1, r.failures)
+}
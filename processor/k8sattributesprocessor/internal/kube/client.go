@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/distribution/reference"
@@ -84,6 +85,8 @@ type WatchClient struct {
 	stopCh                 chan struct{}
 	waitForMetadata        bool
 	waitForMetadataTimeout time.Duration
+	// podsSynced reports whether the pod informer, and therefore the informers it depends on, synced.
+	podsSynced atomic.Pointer[cache.InformerSynced]
 
 	// A map containing Pod related data, used to associate them with resources.
 	// Key can be either an IP address or Pod UID
@@ -156,6 +159,7 @@ func New(
 	informersFactory InformersFactoryList,
 	waitForMetadata bool,
 	waitForMetadataTimeout time.Duration,
+	watchBackoff WatchBackoff,
 ) (Client, error) {
 	telemetryBuilder, err := metadata.NewTelemetryBuilder(set)
 	if err != nil {
@@ -192,6 +196,9 @@ func New(
 		return nil, err
 	}
 	c.kc = kc
+	if watchBackoff.InitialInterval > 0 {
+		c.kc = newBackoffClientset(kc, watchBackoff, telemetryBuilder)
+	}
 
 	labelSelector, fieldSelector, err := selectorsFromFilters(c.Filters)
 	if err != nil {
@@ -389,6 +396,9 @@ func (c *WatchClient) Start() error {
 		return err
 	}
 
+	podsSynced := cache.InformerSynced(reg.HasSynced)
+	c.podsSynced.Store(&podsSynced)
+
 	// start the podInformer with the prerequisite of the other informers to be finished first
 	go c.runInformerWithDependencies(c.informer, synced)
 
@@ -408,6 +418,12 @@ func (c *WatchClient) Start() error {
 	return nil
 }
 
+// HasSynced returns whether the k8s metadata were synced since the client started.
+func (c *WatchClient) HasSynced() bool {
+	podsSynced := c.podsSynced.Load()
+	return podsSynced != nil && (*podsSynced)()
+}
+
 // Stop signals the k8s watcher/informer to stop watching for new events.
 func (c *WatchClient) Stop() {
 	close(c.stopCh)
@@ -785,6 +801,10 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 		tags[K8sIPLabelName] = pod.Status.PodIP
 	}
 
+	if c.Rules.PodPhase && pod.Status.Phase != "" {
+		tags[tagPodPhase] = string(pod.Status.Phase)
+	}
+
 	if c.Rules.Namespace {
 		tags[string(conventions.K8SNamespaceNameKey)] = pod.GetNamespace()
 	}
@@ -977,6 +997,19 @@ func removeUnnecessaryPodData(pod *api_v1.Pod, rules ExtractionRules) *api_v1.Po
 		transformedPod.SetCreationTimestamp(pod.GetCreationTimestamp())
 	}
 
+	if rules.PodPhase {
+		transformedPod.Status.Phase = pod.Status.Phase
+	}
+
+	if rules.PodReady {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == api_v1.PodReady {
+				transformedPod.Status.Conditions = []api_v1.PodCondition{{Type: condition.Type, Status: condition.Status}}
+				break
+			}
+		}
+	}
+
 	if rules.PodUID {
 		transformedPod.SetUID(pod.GetUID())
 	}
@@ -1300,6 +1333,9 @@ func (c *WatchClient) podFromAPI(pod *api_v1.Pod) *Pod {
 		newPod.Ignore = true
 	} else {
 		newPod.Attributes = c.extractPodAttributes(pod)
+		if c.Rules.PodReady {
+			newPod.Ready = isPodReady(pod)
+		}
 		if needContainerAttributes(c.Rules) {
 			newPod.Containers = c.extractPodContainersAttributes(pod)
 		}
@@ -1308,6 +1344,16 @@ func (c *WatchClient) podFromAPI(pod *api_v1.Pod) *Pod {
 	return newPod
 }
 
+// isPodReady returns whether the Ready condition of the pod is true.
+func isPodReady(pod *api_v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == api_v1.PodReady {
+			return condition.Status == api_v1.ConditionTrue
+		}
+	}
+	return false
+}
+
 func getPodReplicaSetUID(pod *api_v1.Pod) string {
 	for _, ref := range pod.OwnerReferences {
 		if ref.Kind == "ReplicaSet" {
@@ -151,11 +151,11 @@ func nodeAddAndUpdateTest(t *testing.T, c *WatchClient, handler func(obj any)) {
 }
 
 func TestDefaultClientset(t *testing.T) {
-	c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, InformersFactoryList{}, false, 10*time.Second)
+	c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, InformersFactoryList{}, false, 10*time.Second, WatchBackoff{})
 	require.EqualError(t, err, "invalid authType for kubernetes: ")
 	assert.Nil(t, c)
 
-	c, err = New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, InformersFactoryList{}, false, 10*time.Second)
+	c, err = New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, InformersFactoryList{}, false, 10*time.Second, WatchBackoff{})
 	assert.NoError(t, err)
 	assert.NotNil(t, c)
 }
@@ -166,7 +166,7 @@ func TestBadFilters(t *testing.T) {
 		newNamespaceInformer:  NewFakeNamespaceInformer,
 		newReplicaSetInformer: NewFakeReplicaSetInformer,
 	}
-	c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{Fields: []FieldFilter{{Op: selection.Exists}}}, []Association{}, Excludes{}, newFakeAPIClientset, factory, false, 10*time.Second)
+	c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{Fields: []FieldFilter{{Op: selection.Exists}}}, []Association{}, Excludes{}, newFakeAPIClientset, factory, false, 10*time.Second, WatchBackoff{})
 	assert.Error(t, err)
 	assert.Nil(t, c)
 }
@@ -206,7 +206,7 @@ func TestConstructorErrors(t *testing.T) {
 			newInformer:          NewFakeInformer,
 			newNamespaceInformer: NewFakeNamespaceInformer,
 		}
-		c, err := New(componenttest.NewNopTelemetrySettings(), apiCfg, er, ff, []Association{}, Excludes{}, clientProvider, factory, false, 10*time.Second)
+		c, err := New(componenttest.NewNopTelemetrySettings(), apiCfg, er, ff, []Association{}, Excludes{}, clientProvider, factory, false, 10*time.Second, WatchBackoff{})
 		assert.Nil(t, c)
 		require.EqualError(t, err, "error creating k8s client")
 		assert.Equal(t, apiCfg, gotAPIConfig)
@@ -1109,6 +1109,49 @@ func TestExtractionRules(t *testing.T) {
 	}
 }
 
+func TestPodStatusExtractionRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+	c.Rules = ExtractionRules{PodPhase: true, PodReady: true}
+
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "auth-service-abc12-xyz3",
+			UID:       "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			Namespace: "ns1",
+		},
+		Status: api_v1.PodStatus{
+			PodIP: "1.1.1.1",
+			Phase: api_v1.PodRunning,
+			Conditions: []api_v1.PodCondition{
+				{Type: api_v1.PodScheduled, Status: api_v1.ConditionTrue},
+				{Type: api_v1.PodReady, Status: api_v1.ConditionFalse},
+			},
+		},
+	}
+	c.handlePodAdd(removeUnnecessaryPodData(pod, c.Rules))
+	p, ok := c.GetPod(newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1"))
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"k8s.pod.phase": "Running"}, p.Attributes)
+	assert.False(t, p.Ready)
+
+	// The readiness is maintained from the updates of the pod status.
+	updated := pod.DeepCopy()
+	updated.Status.Conditions[1].Status = api_v1.ConditionTrue
+	c.handlePodUpdate(pod, removeUnnecessaryPodData(updated, c.Rules))
+	p, ok = c.GetPod(newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1"))
+	require.True(t, ok)
+	assert.True(t, p.Ready)
+
+	updated = updated.DeepCopy()
+	updated.Status.Phase = api_v1.PodFailed
+	updated.Status.Conditions = nil
+	c.handlePodUpdate(pod, removeUnnecessaryPodData(updated, c.Rules))
+	p, ok = c.GetPod(newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1"))
+	require.True(t, ok)
+	assert.Equal(t, "Failed", p.Attributes["k8s.pod.phase"])
+	assert.False(t, p.Ready)
+}
+
 func TestReplicaSetExtractionRules(t *testing.T) {
 	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
 	// Disable saving ip into k8s.pod.ip
@@ -3038,7 +3081,7 @@ func newTestClientWithRulesAndFilters(t *testing.T, f Filters) (*WatchClient, *o
 		newNamespaceInformer:  NewFakeNamespaceInformer,
 		newReplicaSetInformer: NewFakeReplicaSetInformer,
 	}
-	c, err := New(set, k8sconfig.APIConfig{}, ExtractionRules{}, f, associations, exclude, newFakeAPIClientset, factory, false, 10*time.Second)
+	c, err := New(set, k8sconfig.APIConfig{}, ExtractionRules{}, f, associations, exclude, newFakeAPIClientset, factory, false, 10*time.Second, WatchBackoff{})
 	require.NoError(t, err)
 	return c.(*WatchClient), logs
 }
@@ -3086,7 +3129,7 @@ func TestWaitForMetadata(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, InformersFactoryList{newInformer: tc.informerProvider}, true, 1*time.Second)
+			c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, InformersFactoryList{newInformer: tc.informerProvider}, true, 1*time.Second, WatchBackoff{})
 			require.NoError(t, err)
 
 			err = c.Start()
@@ -3633,7 +3676,7 @@ func TestReplicaSetInformerConditionalStart(t *testing.T) {
 				},
 			}
 
-			c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, tt.rules, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, factory, false, 10*time.Second)
+			c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, tt.rules, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, factory, false, 10*time.Second, WatchBackoff{})
 			require.NoError(t, err)
 			wc := c.(*WatchClient)
 
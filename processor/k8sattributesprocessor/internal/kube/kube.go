@@ -21,6 +21,9 @@ const (
 	ignoreAnnotation string = "opentelemetry.io/k8s-processor/ignore"
 	tagStartTime            = "k8s.pod.start_time"
 	tagHostName             = "k8s.pod.hostname"
+	tagPodPhase             = "k8s.pod.phase"
+	// TagPodReady is the attribute holding whether the Ready condition of the pod is true.
+	TagPodReady = "k8s.pod.ready"
 	// MetadataFromPod is used to specify to extract metadata/labels/annotations from pod
 	MetadataFromPod = "pod"
 	// MetadataFromNamespace is used to specify to extract metadata/labels/annotations from namespace
@@ -101,12 +104,14 @@ type Client interface {
 	GetStatefulSet(string) (*StatefulSet, bool)
 	GetDaemonSet(string) (*DaemonSet, bool)
 	GetJob(string) (*Job, bool)
+	// HasSynced returns whether the k8s metadata were synced since the client started.
+	HasSynced() bool
 	Start() error
 	Stop()
 }
 
 // ClientProvider defines a func type that returns a new Client.
-type ClientProvider func(component.TelemetrySettings, k8sconfig.APIConfig, ExtractionRules, Filters, []Association, Excludes, APIClientsetProvider, InformersFactoryList, bool, time.Duration) (Client, error)
+type ClientProvider func(component.TelemetrySettings, k8sconfig.APIConfig, ExtractionRules, Filters, []Association, Excludes, APIClientsetProvider, InformersFactoryList, bool, time.Duration, WatchBackoff) (Client, error)
 
 // APIClientsetProvider defines a func type that initializes and return a new kubernetes
 // Clientset object.
@@ -127,6 +132,9 @@ type Pod struct {
 	DaemonSetUID   string
 	JobUID         string
 	HostNetwork    bool
+	// Ready is whether the Ready condition of the pod is true, it is only
+	// maintained if the PodReady extraction rule is enabled.
+	Ready bool
 
 	// Containers specifies all containers in this pod.
 	Containers PodContainers
@@ -242,6 +250,8 @@ type ExtractionRules struct {
 	PodUID                    bool
 	PodHostName               bool
 	PodIP                     bool
+	PodPhase                  bool
+	PodReady                  bool
 	ReplicaSetID              bool
 	ReplicaSetName            bool
 	StatefulSetUID            bool
@@ -47,6 +47,8 @@ type ResourceAttributesConfig struct {
 	K8sPodHostname            ResourceAttributeConfig `mapstructure:"k8s.pod.hostname"`
 	K8sPodIP                  ResourceAttributeConfig `mapstructure:"k8s.pod.ip"`
 	K8sPodName                ResourceAttributeConfig `mapstructure:"k8s.pod.name"`
+	K8sPodPhase               ResourceAttributeConfig `mapstructure:"k8s.pod.phase"`
+	K8sPodReady               ResourceAttributeConfig `mapstructure:"k8s.pod.ready"`
 	K8sPodStartTime           ResourceAttributeConfig `mapstructure:"k8s.pod.start_time"`
 	K8sPodUID                 ResourceAttributeConfig `mapstructure:"k8s.pod.uid"`
 	K8sReplicasetName         ResourceAttributeConfig `mapstructure:"k8s.replicaset.name"`
@@ -121,6 +123,12 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sPodName: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sPodPhase: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sPodReady: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		K8sPodStartTime: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -44,6 +44,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sPodHostname:            ResourceAttributeConfig{Enabled: true},
 				K8sPodIP:                  ResourceAttributeConfig{Enabled: true},
 				K8sPodName:                ResourceAttributeConfig{Enabled: true},
+				K8sPodPhase:               ResourceAttributeConfig{Enabled: true},
+				K8sPodReady:               ResourceAttributeConfig{Enabled: true},
 				K8sPodStartTime:           ResourceAttributeConfig{Enabled: true},
 				K8sPodUID:                 ResourceAttributeConfig{Enabled: true},
 				K8sReplicasetName:         ResourceAttributeConfig{Enabled: true},
@@ -79,6 +81,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sPodHostname:            ResourceAttributeConfig{Enabled: false},
 				K8sPodIP:                  ResourceAttributeConfig{Enabled: false},
 				K8sPodName:                ResourceAttributeConfig{Enabled: false},
+				K8sPodPhase:               ResourceAttributeConfig{Enabled: false},
+				K8sPodReady:               ResourceAttributeConfig{Enabled: false},
 				K8sPodStartTime:           ResourceAttributeConfig{Enabled: false},
 				K8sPodUID:                 ResourceAttributeConfig{Enabled: false},
 				K8sReplicasetName:         ResourceAttributeConfig{Enabled: false},
@@ -161,6 +161,20 @@ func (rb *ResourceBuilder) SetK8sPodName(val string) {
 	}
 }
 
+// SetK8sPodPhase sets provided value as "k8s.pod.phase" attribute.
+func (rb *ResourceBuilder) SetK8sPodPhase(val string) {
+	if rb.config.K8sPodPhase.Enabled {
+		rb.res.Attributes().PutStr("k8s.pod.phase", val)
+	}
+}
+
+// SetK8sPodReady sets provided value as "k8s.pod.ready" attribute.
+func (rb *ResourceBuilder) SetK8sPodReady(val bool) {
+	if rb.config.K8sPodReady.Enabled {
+		rb.res.Attributes().PutBool("k8s.pod.ready", val)
+	}
+}
+
 // SetK8sPodStartTime sets provided value as "k8s.pod.start_time" attribute.
 func (rb *ResourceBuilder) SetK8sPodStartTime(val string) {
 	if rb.config.K8sPodStartTime.Enabled {
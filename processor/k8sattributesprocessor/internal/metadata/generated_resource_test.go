@@ -33,6 +33,8 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sPodHostname("k8s.pod.hostname-val")
 			rb.SetK8sPodIP("k8s.pod.ip-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
+			rb.SetK8sPodPhase("k8s.pod.phase-val")
+			rb.SetK8sPodReady(true)
 			rb.SetK8sPodStartTime("k8s.pod.start_time-val")
 			rb.SetK8sPodUID("k8s.pod.uid-val")
 			rb.SetK8sReplicasetName("k8s.replicaset.name-val")
@@ -51,7 +53,7 @@ func TestResourceBuilder(t *testing.T) {
 			case "default":
 				assert.Equal(t, 8, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 30, res.Attributes().Len())
+				assert.Equal(t, 32, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -159,6 +161,16 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.pod.name-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.pod.phase")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.pod.phase-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.pod.ready")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.True(t, val.Bool())
+			}
 			val, ok = res.Attributes().Get("k8s.pod.start_time")
 			assert.True(t, ok)
 			if ok {
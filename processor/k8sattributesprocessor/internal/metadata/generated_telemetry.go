@@ -51,6 +51,8 @@ type TelemetryBuilder struct {
 	OtelsvcK8sStatefulsetAdded   metric.Int64Counter
 	OtelsvcK8sStatefulsetDeleted metric.Int64Counter
 	OtelsvcK8sStatefulsetUpdated metric.Int64Counter
+	OtelsvcK8sUnenrichedRecords  metric.Int64Counter
+	OtelsvcK8sWatchRestarts      metric.Int64Counter
 }
 
 // TelemetryBuilderOption applies changes to default builder.
@@ -238,5 +240,17 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 		metric.WithUnit("1"),
 	)
 	errs = errors.Join(errs, err)
+	builder.OtelsvcK8sUnenrichedRecords, err = builder.meter.Int64Counter(
+		"otelcol_otelsvc_k8s_unenriched_records",
+		metric.WithDescription("Number of spans, data points, log records and profile samples forwarded before the k8s metadata were synced, and therefore possibly not enriched [Development]"),
+		metric.WithUnit("{records}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.OtelsvcK8sWatchRestarts, err = builder.meter.Int64Counter(
+		"otelcol_otelsvc_k8s_watch_restarts",
+		metric.WithDescription("Number of times the informers restarted a watch, by resource and by reason of the restart (closed, expired, too_many_requests or error) [Development]"),
+		metric.WithUnit("{restarts}"),
+	)
+	errs = errors.Join(errs, err)
 	return &builder, errs
 }
@@ -24,6 +24,8 @@ const (
 	filterOPExists       = "exists"
 	filterOPDoesNotExist = "does-not-exist"
 	metadataPodIP        = "k8s.pod.ip"
+	metadataPodPhase     = "k8s.pod.phase"
+	metadataPodReady     = "k8s.pod.ready"
 	metadataPodStartTime = "k8s.pod.start_time"
 	specPodHostName      = "k8s.pod.hostname"
 
@@ -113,6 +115,12 @@ func enabledAttributes() (attributes []string) {
 	if defaultConfig.K8sPodName.Enabled {
 		attributes = append(attributes, string(conventions.K8SPodNameKey))
 	}
+	if defaultConfig.K8sPodPhase.Enabled {
+		attributes = append(attributes, metadataPodPhase)
+	}
+	if defaultConfig.K8sPodReady.Enabled {
+		attributes = append(attributes, metadataPodReady)
+	}
 	if defaultConfig.K8sPodStartTime.Enabled {
 		attributes = append(attributes, metadataPodStartTime)
 	}
@@ -167,6 +175,10 @@ func withExtractMetadata(fields ...string) option {
 				p.rules.StartTime = true
 			case metadataPodIP:
 				p.rules.PodIP = true
+			case metadataPodPhase:
+				p.rules.PodPhase = true
+			case metadataPodReady:
+				p.rules.PodReady = true
 			case string(conventions.K8SDeploymentNameKey):
 				p.rules.DeploymentName = true
 			case string(conventions.K8SDeploymentUIDKey):
@@ -433,3 +445,16 @@ func withWaitForMetadataTimeout(timeout time.Duration) option {
 		return nil
 	}
 }
+
+// withWatchBackoff allows specifying the backoff of the informers after a failed list or watch.
+func withWatchBackoff(cfg WatchBackoffConfig) option {
+	return func(p *kubernetesprocessor) error {
+		p.watchBackoff = kube.WatchBackoff{
+			InitialInterval:     cfg.InitialInterval,
+			MaxInterval:         cfg.MaxInterval,
+			Multiplier:          cfg.Multiplier,
+			RandomizationFactor: cfg.RandomizationFactor,
+		}
+		return nil
+	}
+}
@@ -363,6 +363,13 @@ func TestWithExtractMetadata(t *testing.T) {
 	assert.False(t, p.rules.StartTime)
 	assert.False(t, p.rules.DeploymentName)
 	assert.False(t, p.rules.Node)
+	assert.False(t, p.rules.PodPhase)
+	assert.False(t, p.rules.PodReady)
+
+	p = &kubernetesprocessor{}
+	assert.NoError(t, withExtractMetadata("k8s.pod.phase", "k8s.pod.ready")(p))
+	assert.True(t, p.rules.PodPhase)
+	assert.True(t, p.rules.PodReady)
 }
 
 func TestWithFilterLabels(t *testing.T) {
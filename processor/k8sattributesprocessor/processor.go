@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -16,11 +17,13 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
 	conventions "go.opentelemetry.io/otel/semconv/v1.39.0"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/metadata"
 )
 
 const (
@@ -41,6 +44,11 @@ type kubernetesprocessor struct {
 	podIgnore              kube.Excludes
 	waitForMetadata        bool
 	waitForMetadataTimeout time.Duration
+	watchBackoff           kube.WatchBackoff
+	signal                 pipeline.Signal
+	telemetryBuilder       *metadata.TelemetryBuilder
+	// metadataSynced caches whether the k8s metadata were synced, to stop checking the client once they are.
+	metadataSynced atomic.Bool
 }
 
 func (kp *kubernetesprocessor) initKubeClient(set component.TelemetrySettings, kubeClient kube.ClientProvider) error {
@@ -48,7 +56,7 @@ func (kp *kubernetesprocessor) initKubeClient(set component.TelemetrySettings, k
 		kubeClient = kube.New
 	}
 	if !kp.passthroughMode {
-		kc, err := kubeClient(set, kp.apiConfig, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore, nil, kube.InformersFactoryList{}, kp.waitForMetadata, kp.waitForMetadataTimeout)
+		kc, err := kubeClient(set, kp.apiConfig, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore, nil, kube.InformersFactoryList{}, kp.waitForMetadata, kp.waitForMetadataTimeout, kp.watchBackoff)
 		if err != nil {
 			return err
 		}
@@ -58,7 +66,7 @@ func (kp *kubernetesprocessor) initKubeClient(set component.TelemetrySettings, k
 }
 
 func (kp *kubernetesprocessor) Start(_ context.Context, host component.Host) error {
-	allOptions := append(createProcessorOpts(kp.cfg), kp.options...)
+	allOptions := append(createProcessorOpts(kp.cfg, kp.signal), kp.options...)
 
 	for _, opt := range allOptions {
 		if err := opt(kp); err != nil {
@@ -68,9 +76,16 @@ func (kp *kubernetesprocessor) Start(_ context.Context, host component.Host) err
 		}
 	}
 
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(kp.telemetrySettings)
+	if err != nil {
+		componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(err))
+		return err
+	}
+	kp.telemetryBuilder = telemetryBuilder
+
 	// This might have been set by an option already
 	if kp.kc == nil {
-		err := kp.initKubeClient(kp.telemetrySettings, kubeClientProvider)
+		err = kp.initKubeClient(kp.telemetrySettings, kubeClientProvider)
 		if err != nil {
 			kp.logger.Error("Could not initialize kube client", zap.Error(err))
 			componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(err))
@@ -78,7 +93,7 @@ func (kp *kubernetesprocessor) Start(_ context.Context, host component.Host) err
 		}
 	}
 	if !kp.passthroughMode {
-		err := kp.kc.Start()
+		err = kp.kc.Start()
 		if err != nil {
 			componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(err))
 			return err
@@ -88,6 +103,9 @@ func (kp *kubernetesprocessor) Start(_ context.Context, host component.Host) err
 }
 
 func (kp *kubernetesprocessor) Shutdown(context.Context) error {
+	if kp.telemetryBuilder != nil {
+		kp.telemetryBuilder.Shutdown()
+	}
 	if kp.kc == nil {
 		return nil
 	}
@@ -99,6 +117,7 @@ func (kp *kubernetesprocessor) Shutdown(context.Context) error {
 
 // processTraces process traces and add k8s metadata using resource IP or incoming IP as pod origin.
 func (kp *kubernetesprocessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	kp.countUnsynced(ctx, td.SpanCount())
 	rss := td.ResourceSpans()
 	for i := 0; i < rss.Len(); i++ {
 		kp.processResource(ctx, rss.At(i).Resource())
@@ -109,6 +128,7 @@ func (kp *kubernetesprocessor) processTraces(ctx context.Context, td ptrace.Trac
 
 // processMetrics process metrics and add k8s metadata using resource IP, hostname or incoming IP as pod origin.
 func (kp *kubernetesprocessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	kp.countUnsynced(ctx, md.DataPointCount())
 	rm := md.ResourceMetrics()
 	for i := 0; i < rm.Len(); i++ {
 		kp.processResource(ctx, rm.At(i).Resource())
@@ -119,6 +139,7 @@ func (kp *kubernetesprocessor) processMetrics(ctx context.Context, md pmetric.Me
 
 // processLogs process logs and add k8s metadata using resource IP, hostname or incoming IP as pod origin.
 func (kp *kubernetesprocessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	kp.countUnsynced(ctx, ld.LogRecordCount())
 	rl := ld.ResourceLogs()
 	for i := 0; i < rl.Len(); i++ {
 		kp.processResource(ctx, rl.At(i).Resource())
@@ -129,6 +150,7 @@ func (kp *kubernetesprocessor) processLogs(ctx context.Context, ld plog.Logs) (p
 
 // processProfiles process profiles and add k8s metadata using resource IP, hostname or incoming IP as pod origin.
 func (kp *kubernetesprocessor) processProfiles(ctx context.Context, pd pprofile.Profiles) (pprofile.Profiles, error) {
+	kp.countUnsynced(ctx, pd.SampleCount())
 	rp := pd.ResourceProfiles()
 	for i := 0; i < rp.Len(); i++ {
 		kp.processResource(ctx, rp.At(i).Resource())
@@ -137,6 +159,18 @@ func (kp *kubernetesprocessor) processProfiles(ctx context.Context, pd pprofile.
 	return pd, nil
 }
 
+// countUnsynced counts the records forwarded before the k8s metadata were synced, which are possibly not enriched.
+func (kp *kubernetesprocessor) countUnsynced(ctx context.Context, count int) {
+	if kp.passthroughMode || kp.metadataSynced.Load() {
+		return
+	}
+	if kp.kc.HasSynced() {
+		kp.metadataSynced.Store(true)
+		return
+	}
+	kp.telemetryBuilder.OtelsvcK8sUnenrichedRecords.Add(ctx, int64(count))
+}
+
 // processResource adds Pod metadata tags to resource based on pod association configuration
 func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pcommon.Resource) {
 	podIdentifierValue := extractPodID(ctx, resource.Attributes(), kp.podAssociations)
@@ -163,6 +197,11 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 			for key, val := range pod.Attributes {
 				setResourceAttribute(resource.Attributes(), key, val)
 			}
+			if kp.rules.PodReady {
+				if _, found := resource.Attributes().Get(kube.TagPodReady); !found {
+					resource.Attributes().PutBool(kube.TagPodReady, pod.Ready)
+				}
+			}
 			kp.addContainerAttributes(resource.Attributes(), pod)
 		} else {
 			kp.logger.Debug("unable to find pod based on identifier", zap.Any("value", podIdentifierValue))
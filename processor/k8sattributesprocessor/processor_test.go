@@ -27,10 +27,13 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.opentelemetry.io/collector/processor/xprocessor"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/metadatatest"
 )
 
 func newPodIdentifier(from, name, value string) kube.PodIdentifier {
@@ -285,7 +288,7 @@ func TestNewProcessor(t *testing.T) {
 }
 
 func TestProcessorBadClientProvider(t *testing.T) {
-	clientProvider := func(_ component.TelemetrySettings, _ k8sconfig.APIConfig, _ kube.ExtractionRules, _ kube.Filters, _ []kube.Association, _ kube.Excludes, _ kube.APIClientsetProvider, _ kube.InformersFactoryList, _ bool, _ time.Duration) (kube.Client, error) {
+	clientProvider := func(_ component.TelemetrySettings, _ k8sconfig.APIConfig, _ kube.ExtractionRules, _ kube.Filters, _ []kube.Association, _ kube.Excludes, _ kube.APIClientsetProvider, _ kube.InformersFactoryList, _ bool, _ time.Duration, _ kube.WatchBackoff) (kube.Client, error) {
 		return nil, errors.New("bad client error")
 	}
 
@@ -744,6 +747,50 @@ func TestPodUID(t *testing.T) {
 	})
 }
 
+func TestPodReady(t *testing.T) {
+	m := newMultiTest(
+		t,
+		NewFactory().CreateDefaultConfig(),
+		nil,
+	)
+	m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+		kp.rules.PodReady = true
+		kp.podAssociations = []kube.Association{
+			{
+				Sources: []kube.AssociationSource{
+					{
+						From: "resource_attribute",
+						Name: "k8s.pod.uid",
+					},
+				},
+			},
+		}
+		kp.kc.(*fakeClient).Pods[newPodIdentifier("resource_attribute", "k8s.pod.uid", "ef10d10b-2da5-4030-812e-5f45c1531227")] = &kube.Pod{
+			Name: "PodA",
+			Attributes: map[string]string{
+				"k8s.pod.phase": "Running",
+			},
+			Ready: false,
+		}
+	})
+
+	m.testConsume(t.Context(),
+		generateTraces(withPodUID("ef10d10b-2da5-4030-812e-5f45c1531227")),
+		generateMetrics(withPodUID("ef10d10b-2da5-4030-812e-5f45c1531227")),
+		generateLogs(withPodUID("ef10d10b-2da5-4030-812e-5f45c1531227")),
+		generateProfiles(withPodUID("ef10d10b-2da5-4030-812e-5f45c1531227")),
+		nil)
+
+	m.assertBatchesLen(1)
+	m.assertResource(0, func(r pcommon.Resource) {
+		assertResourceHasStringAttribute(t, r, "k8s.pod.phase", "Running")
+		ready, ok := r.Attributes().Get("k8s.pod.ready")
+		require.True(t, ok)
+		assert.Equal(t, pcommon.ValueTypeBool, ready.Type())
+		assert.False(t, ready.Bool())
+	})
+}
+
 func TestAddPodLabels(t *testing.T) {
 	m := newMultiTest(
 		t,
@@ -1720,6 +1767,50 @@ func TestPassthroughStart(t *testing.T) {
 	assert.NoError(t, p.Shutdown(t.Context()))
 }
 
+func TestWaitForMetadataSignals(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.WaitForMetadata = true
+	cfg.WaitForMetadataSignals = []string{"traces"}
+
+	// The processors waiting for the metadata are synced when started, the others are not.
+	waited := map[bool]*fakeClient{}
+	provider := func(set component.TelemetrySettings, apiCfg k8sconfig.APIConfig, rules kube.ExtractionRules, filters kube.Filters, associations []kube.Association, exclude kube.Excludes, clientset kube.APIClientsetProvider, informers kube.InformersFactoryList, waitForMetadata bool, waitForMetadataTimeout time.Duration, watchBackoff kube.WatchBackoff) (kube.Client, error) {
+		kc, err := newFakeClient(set, apiCfg, rules, filters, associations, exclude, clientset, informers, waitForMetadata, waitForMetadataTimeout, watchBackoff)
+		if err != nil {
+			return nil, err
+		}
+		kc.(*fakeClient).NotSynced = !waitForMetadata
+		waited[waitForMetadata] = kc.(*fakeClient)
+		return kc, nil
+	}
+
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	tp, err := createTracesProcessorWithOptions(t.Context(), metadatatest.NewSettings(tel), cfg, new(consumertest.TracesSink), withKubeClientProvider(provider))
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	require.Contains(t, waited, true)
+
+	lp, err := createLogsProcessorWithOptions(t.Context(), metadatatest.NewSettings(tel), cfg, new(consumertest.LogsSink), withKubeClientProvider(provider))
+	require.NoError(t, err)
+	require.NoError(t, lp.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, lp.Shutdown(context.Background())) })
+	require.Contains(t, waited, false)
+
+	require.NoError(t, tp.ConsumeTraces(t.Context(), generateTraces()))
+	require.NoError(t, lp.ConsumeLogs(t.Context(), generateLogs()))
+
+	// Once synced, the records are no longer counted.
+	waited[false].NotSynced = false
+	require.NoError(t, lp.ConsumeLogs(t.Context(), generateLogs()))
+
+	metadatatest.AssertEqualOtelsvcK8sUnenrichedRecords(t, tel, []metricdata.DataPoint[int64]{
+		{Value: 1},
+	}, metricdatatest.IgnoreTimestamp())
+}
+
 func TestRealClient(t *testing.T) {
 	newMultiTest(
 		t,
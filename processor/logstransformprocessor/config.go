@@ -14,6 +14,10 @@ import (
 // Config defines configuration for Resource processor.
 type Config struct {
 	adapter.BaseConfig `mapstructure:",squash"`
+
+	// Workers is the number of operator pipelines processing the logs concurrently. The logs of a resource are
+	// always processed by the same pipeline, so that their order is preserved.
+	Workers int `mapstructure:"workers"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -23,5 +27,8 @@ func (cfg *Config) Validate() error {
 	if len(cfg.Operators) == 0 {
 		return errors.New("no operators were configured for this logs transform processor")
 	}
+	if cfg.Workers < 1 {
+		return errors.New("workers must be at least 1")
+	}
 	return nil
 }
@@ -45,5 +45,17 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		Workers: 4,
 	}, cfg)
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	assert.ErrorContains(t, cfg.Validate(), "no operators were configured")
+
+	cfg.Operators = []operator.Config{{Builder: regex.NewConfig()}}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Workers = 0
+	assert.ErrorContains(t, cfg.Validate(), "workers must be at least 1")
+}
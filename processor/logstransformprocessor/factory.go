@@ -6,6 +6,7 @@ package logstransformprocessor // import "github.com/open-telemetry/opentelemetr
 import (
 	"context"
 	"errors"
+	"runtime"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -30,6 +31,7 @@ func createDefaultConfig() component.Config {
 		BaseConfig: adapter.BaseConfig{
 			Operators: []operator.Config{},
 		},
+		Workers: runtime.NumCPU(),
 	}
 }
 
@@ -6,8 +6,6 @@ package logstransformprocessor // import "github.com/open-telemetry/opentelemetr
 import (
 	"context"
 	"errors"
-	"math"
-	"runtime"
 	"sync"
 
 	"go.opentelemetry.io/collector/component"
@@ -16,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
@@ -29,11 +28,23 @@ type logsTransformProcessor struct {
 
 	consumer consumer.Logs
 
+	workers     []*pipelineWorker
+	shutdownFns []component.ShutdownFunc
+}
+
+// pipelineWorker runs the logs of its resources through its own operator pipeline. The pipeline processes a
+// single batch of logs at a time, so that the logs of a resource are emitted in the order they are received.
+type pipelineWorker struct {
+	ltp *logsTransformProcessor
+
 	pipe          *pipeline.DirectedPipeline
 	firstOperator operator.Operator
-	emitter       helper.LogEmitter
-	fromConverter *adapter.FromPdataConverter
-	shutdownFns   []component.ShutdownFunc
+	processLock   sync.Mutex
+
+	// outputLock protects the entries emitted by the pipeline while a batch is processed
+	outputLock sync.Mutex
+	processing bool
+	output     []*entry.Entry
 }
 
 func newProcessor(config *Config, nextConsumer consumer.Logs, set component.TelemetrySettings) (*logsTransformProcessor, error) {
@@ -43,19 +54,19 @@ func newProcessor(config *Config, nextConsumer consumer.Logs, set component.Tele
 		consumer: nextConsumer,
 	}
 
-	baseCfg := p.config.BaseConfig
-
-	p.emitter = helper.NewBatchingLogEmitter(p.set, p.consumeStanzaLogEntries)
-	pipe, err := pipeline.Config{
-		Operators:     baseCfg.Operators,
-		DefaultOutput: p.emitter,
-	}.Build(set)
-	if err != nil {
-		return nil, err
+	for range max(1, config.Workers) {
+		w := &pipelineWorker{ltp: p}
+		pipe, err := pipeline.Config{
+			Operators:     config.Operators,
+			DefaultOutput: helper.NewSynchronousLogEmitter(set, w.consumeStanzaLogEntries),
+		}.Build(set)
+		if err != nil {
+			return nil, err
+		}
+		w.pipe = pipe
+		p.workers = append(p.workers, w)
 	}
 
-	p.pipe = pipe
-
 	return p, nil
 }
 
@@ -78,107 +89,109 @@ func (ltp *logsTransformProcessor) Shutdown(ctx context.Context) error {
 	return nil
 }
 
-func (ltp *logsTransformProcessor) Start(ctx context.Context, _ component.Host) error {
-	wkrCount := int(math.Max(1, float64(runtime.NumCPU())))
-	ltp.fromConverter = adapter.NewFromPdataConverter(ltp.set, wkrCount)
-
-	// data flows in this order:
-	// ConsumeLogs: receives logs and forwards them for conversion to stanza format ->
-	// fromConverter: converts logs to stanza format ->
-	// converterLoop: forwards converted logs to the stanza pipeline ->
-	// pipeline: performs user configured operations on the logs ->
-	// transformProcessor: receives []*entry.Entries, converts them to plog.Logs and sends the converted OTLP logs to the next consumer
-	//
-	// We should start these components in reverse order of the data flow, then stop them in order of the data flow,
-	// in order to allow for pipeline draining.
-	err := ltp.startPipeline()
-	if err != nil {
-		return err
+func (ltp *logsTransformProcessor) Start(_ context.Context, _ component.Host) error {
+	for _, w := range ltp.workers {
+		if err := w.start(); err != nil {
+			return err
+		}
+		ltp.shutdownFns = append(ltp.shutdownFns, func(_ context.Context) error {
+			return w.stop()
+		})
 	}
-	ltp.startConverterLoop(ctx)
-	ltp.startFromConverter()
-
 	return nil
 }
 
-func (ltp *logsTransformProcessor) startFromConverter() {
-	ltp.fromConverter.Start()
-
-	ltp.shutdownFns = append(ltp.shutdownFns, func(_ context.Context) error {
-		ltp.fromConverter.Stop()
-		return nil
-	})
-}
+// ConsumeLogs runs the logs through the operator pipelines and sends the processed logs to the next consumer.
+// The resources are spread across the workers by the hash of their attributes, and the workers process their
+// resources concurrently.
+func (ltp *logsTransformProcessor) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	batches := make([][]*entry.Entry, len(ltp.workers))
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rls := ld.ResourceLogs().At(i)
+		idx := 0
+		if len(batches) > 1 {
+			idx = int(pdatautil.Hash64(pdatautil.WithMap(rls.Resource().Attributes())) % uint64(len(batches)))
+		}
+		batches[idx] = append(batches[idx], adapter.ConvertFromResourceLogs(rls)...)
+	}
 
-// startConverterLoop starts the converter loop, which reads all the logs translated by the fromConverter and then forwards
-// them to pipeline
-func (ltp *logsTransformProcessor) startConverterLoop(ctx context.Context) {
-	wg := &sync.WaitGroup{}
-	wg.Add(1)
-	go ltp.converterLoop(ctx, wg)
+	outputs := make([][]*entry.Entry, len(ltp.workers))
+	wg := sync.WaitGroup{}
+	for i, batch := range batches {
+		if len(batch) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			outputs[i] = ltp.workers[i].process(ctx, batch)
+		}()
+	}
+	wg.Wait()
 
-	ltp.shutdownFns = append(ltp.shutdownFns, func(_ context.Context) error {
-		wg.Wait()
+	var processed []*entry.Entry
+	for _, output := range outputs {
+		processed = append(processed, output...)
+	}
+	if len(processed) == 0 {
 		return nil
-	})
+	}
+	return ltp.consumer.ConsumeLogs(ctx, adapter.ConvertEntries(processed))
 }
 
-func (ltp *logsTransformProcessor) startPipeline() error {
+func (w *pipelineWorker) start() error {
 	// There is no need for this processor to use storage
-	err := ltp.pipe.Start(storage.NewNopClient())
-	if err != nil {
+	if err := w.pipe.Start(storage.NewNopClient()); err != nil {
 		return err
 	}
 
-	ltp.shutdownFns = append(ltp.shutdownFns, func(_ context.Context) error {
-		return ltp.pipe.Stop()
-	})
-
-	pipelineOperators := ltp.pipe.Operators()
+	pipelineOperators := w.pipe.Operators()
 	if len(pipelineOperators) == 0 {
 		return errors.New("processor requires at least one operator to be configured")
 	}
-	ltp.firstOperator = pipelineOperators[0]
-
+	w.firstOperator = pipelineOperators[0]
 	return nil
 }
 
-func (ltp *logsTransformProcessor) ConsumeLogs(_ context.Context, ld plog.Logs) error {
-	// Add the logs to the chain
-	return ltp.fromConverter.Batch(ld)
+func (w *pipelineWorker) stop() error {
+	return w.pipe.Stop()
 }
 
-// converterLoop reads the log entries produced by the fromConverter and sends them
-// into the pipeline
-func (ltp *logsTransformProcessor) converterLoop(ctx context.Context, wg *sync.WaitGroup) {
-	defer wg.Done()
-
-	for {
-		select {
-		case <-ctx.Done():
-			ltp.set.Logger.Debug("converter loop stopped")
-			return
-
-		case entries, ok := <-ltp.fromConverter.OutChannel():
-			if !ok {
-				ltp.set.Logger.Debug("fromConverter channel got closed")
-				return
-			}
-
-			for _, e := range entries {
-				// Add item to the first operator of the pipeline manually
-				if err := ltp.firstOperator.Process(ctx, e); err != nil {
-					ltp.set.Logger.Error("processor encountered an issue with the pipeline", zap.Error(err))
-					break
-				}
-			}
+// process runs a batch of entries through the pipeline, and returns the entries emitted by the pipeline meanwhile.
+func (w *pipelineWorker) process(ctx context.Context, entries []*entry.Entry) []*entry.Entry {
+	w.processLock.Lock()
+	defer w.processLock.Unlock()
+
+	w.outputLock.Lock()
+	w.processing = true
+	w.outputLock.Unlock()
+
+	for _, e := range entries {
+		if err := w.firstOperator.Process(ctx, e); err != nil {
+			w.ltp.set.Logger.Error("processor encountered an issue with the pipeline", zap.Error(err))
 		}
 	}
+
+	w.outputLock.Lock()
+	defer w.outputLock.Unlock()
+	w.processing = false
+	output := w.output
+	w.output = nil
+	return output
 }
 
-func (ltp *logsTransformProcessor) consumeStanzaLogEntries(ctx context.Context, entries []*entry.Entry) {
-	pLogs := adapter.ConvertEntries(entries)
-	if err := ltp.consumer.ConsumeLogs(ctx, pLogs); err != nil {
-		ltp.set.Logger.Error("processor encountered an issue with next consumer", zap.Error(err))
+// consumeStanzaLogEntries receives the entries emitted by the pipeline. The entries emitted outside the
+// processing of a batch, e.g. flushed by a timer of an operator, are sent to the next consumer on their own.
+func (w *pipelineWorker) consumeStanzaLogEntries(ctx context.Context, entries []*entry.Entry) {
+	w.outputLock.Lock()
+	if w.processing {
+		w.output = append(w.output, entries...)
+		w.outputLock.Unlock()
+		return
+	}
+	w.outputLock.Unlock()
+
+	if err := w.ltp.consumer.ConsumeLogs(ctx, adapter.ConvertEntries(entries)); err != nil {
+		w.ltp.set.Logger.Error("processor encountered an issue with next consumer", zap.Error(err))
 	}
 }
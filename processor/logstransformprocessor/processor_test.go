@@ -6,6 +6,7 @@ package logstransformprocessor
 import (
 	"context"
 	"errors"
+	"strconv"
 	"testing"
 	"time"
 
@@ -68,13 +69,7 @@ type testLogMessage struct {
 	attributes   *map[string]pcommon.Value
 }
 
-// This func is a workaround to avoid the "unused" lint error while the test is skipped
-var skip = func(t *testing.T, why string) {
-	t.Skip(why)
-}
-
 func TestLogsTransformProcessor(t *testing.T) {
-	skip(t, "See https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/9761")
 	baseMessage := pcommon.NewValueStr("2022-01-01 01:02:03 INFO this is a test message")
 	spanID := pcommon.SpanID([8]byte{0x32, 0xf0, 0xa2, 0x2b, 0x6a, 0x81, 0x2c, 0xff})
 	traceID := pcommon.TraceID([16]byte{0x48, 0x01, 0x40, 0xf3, 0xd7, 0x70, 0xa5, 0xae, 0x32, 0xf0, 0xa2, 0x2b, 0x6a, 0x81, 0x2c, 0xff})
@@ -155,14 +150,68 @@ func TestLogsTransformProcessor(t *testing.T) {
 			wantLogData := generateLogData(tt.parsedMessages)
 			err = ltp.ConsumeLogs(t.Context(), sourceLogData)
 			require.NoError(t, err)
-			time.Sleep(200 * time.Millisecond)
 			logs := tln.AllLogs()
 			require.Len(t, logs, 1)
 			assert.NoError(t, plogtest.CompareLogs(wantLogData, logs[0]))
+			require.NoError(t, ltp.Shutdown(t.Context()))
 		})
 	}
 }
 
+func TestProcessorOrderingPerResource(t *testing.T) {
+	config := &Config{
+		BaseConfig: adapter.BaseConfig{
+			Operators: []operator.Config{{Builder: regex.NewConfig()}},
+		},
+		Workers: 4,
+	}
+	config.Operators[0].Builder.(*regex.Config).Regex = "^(?P<seq>\\d+)$"
+
+	tln := new(consumertest.LogsSink)
+	ltp, err := NewFactory().CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), config, tln)
+	require.NoError(t, err)
+	require.NoError(t, ltp.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, ltp.Shutdown(t.Context())) }()
+
+	ld := plog.NewLogs()
+	for r := range 10 {
+		rls := ld.ResourceLogs().AppendEmpty()
+		rls.Resource().Attributes().PutInt("resource", int64(r))
+		records := rls.ScopeLogs().AppendEmpty().LogRecords()
+		for i := range 100 {
+			records.AppendEmpty().Body().SetStr(strconv.Itoa(i))
+		}
+	}
+	require.NoError(t, ltp.ConsumeLogs(t.Context(), ld))
+
+	// The processed logs are sent to the next consumer before ConsumeLogs returns
+	require.Len(t, tln.AllLogs(), 1)
+	processed := tln.AllLogs()[0]
+	assert.Equal(t, 1000, processed.LogRecordCount())
+	next := map[int64]int{}
+	for i := 0; i < processed.ResourceLogs().Len(); i++ {
+		rls := processed.ResourceLogs().At(i)
+		resource, _ := rls.Resource().Attributes().Get("resource")
+		records := rls.ScopeLogs().At(0).LogRecords()
+		for j := 0; j < records.Len(); j++ {
+			seq, _ := records.At(j).Attributes().Get("seq")
+			assert.Equal(t, strconv.Itoa(next[resource.Int()]), seq.Str())
+			next[resource.Int()]++
+		}
+	}
+	assert.Len(t, next, 10)
+}
+
+func TestProcessorNextConsumerError(t *testing.T) {
+	ltp, err := NewFactory().CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewErr(errors.New("consumer error")))
+	require.NoError(t, err)
+	require.NoError(t, ltp.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, ltp.Shutdown(t.Context())) }()
+
+	ld := generateLogData([]testLogMessage{{body: pcommon.NewValueStr("2022-01-01 01:02:03 INFO this is a test message")}})
+	assert.EqualError(t, ltp.ConsumeLogs(t.Context(), ld), "consumer error")
+}
+
 func generateLogData(messages []testLogMessage) plog.Logs {
 	ld := testdata.GenerateLogsOneEmptyResourceLogs()
 	scope := ld.ResourceLogs().At(0).ScopeLogs().AppendEmpty()
@@ -5,7 +5,10 @@ package metricsgenerationprocessor // import "github.com/open-telemetry/opentele
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor/internal/expr"
 )
 
 const (
@@ -26,6 +29,9 @@ const (
 
 	// operationFieldName is the mapstructure field name for Operation field
 	operationFieldName = "operation"
+
+	// formulaFieldName is the mapstructure field name for Formula field
+	formulaFieldName = "formula"
 )
 
 // Config defines the configuration for the processor.
@@ -47,17 +53,21 @@ type Rule struct {
 	// The rule type following which the new metric will be generated. This is a required field.
 	Type GenerationType `mapstructure:"type"`
 
-	// First operand metric to use in the calculation. This is a required field.
+	// First operand metric to use in the calculation. A required field if the type is calculate or scale.
 	Metric1 string `mapstructure:"metric1"`
 
 	// Second operand metric to use in the calculation. A required field if the type is calculate.
 	Metric2 string `mapstructure:"metric2"`
 
-	// The arithmetic operation to apply for the calculation. This is a required field.
+	// The arithmetic operation to apply for the calculation. This is a required field unless the type is formula.
 	Operation OperationType `mapstructure:"operation"`
 
 	// A constant number by which the first operand will be scaled. A required field if the type is scale.
 	ScaleBy float64 `mapstructure:"scale_by"`
+
+	// The formula computing the new metric from other metrics, e.g. `rate(errors) / rate(requests) * 100`.
+	// A required field if the type is formula.
+	Formula string `mapstructure:"formula"`
 }
 
 type GenerationType string
@@ -69,9 +79,12 @@ const (
 
 	// Generates a new metric scaling the value of s given metric with a provided constant
 	scale GenerationType = "scale"
+
+	// Generates a new metric evaluating a formula referencing any number of metrics
+	formula GenerationType = "formula"
 )
 
-var generationTypes = map[GenerationType]struct{}{calculate: {}, scale: {}}
+var generationTypes = map[GenerationType]struct{}{calculate: {}, scale: {}, formula: {}}
 
 func (gt GenerationType) isValid() bool {
 	_, ok := generationTypes[gt]
@@ -149,6 +162,13 @@ func (config *Config) Validate() error {
 			return fmt.Errorf("%q must be in %q", typeFieldName, generationTypeKeys())
 		}
 
+		if rule.Type == formula {
+			if err := rule.validateFormula(); err != nil {
+				return err
+			}
+			continue
+		}
+
 		if rule.Metric1 == "" {
 			return fmt.Errorf("missing required field %q", metric1FieldName)
 		}
@@ -174,3 +194,18 @@ func (config *Config) Validate() error {
 	}
 	return nil
 }
+
+// validateFormula checks the fields of a rule of type formula.
+func (rule *Rule) validateFormula() error {
+	if rule.Formula == "" {
+		return fmt.Errorf("missing required field %q for generation type %q", formulaFieldName, formula)
+	}
+	parsed, err := expr.Parse(rule.Formula)
+	if err != nil {
+		return fmt.Errorf("invalid %q: %w", formulaFieldName, err)
+	}
+	if slices.Contains(parsed.Metrics(), rule.Name) {
+		return fmt.Errorf("value of field %q may not be referenced by field %q", nameFieldName, formulaFieldName)
+	}
+	return nil
+}
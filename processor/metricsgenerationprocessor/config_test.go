@@ -48,6 +48,31 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "formula"),
+			expected: &Config{
+				Rules: []Rule{
+					{
+						Name:    "http.server.error_ratio",
+						Unit:    "%",
+						Type:    "formula",
+						Formula: "rate(http.server.errors) / rate(http.server.requests) * 100",
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_formula"),
+			errorMessage: fmt.Sprintf("missing required field %q for generation type %q", formulaFieldName, formula),
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_formula"),
+			errorMessage: fmt.Sprintf("invalid %q: unexpected \"end of formula\" at position 9", formulaFieldName),
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "formula_references_name"),
+			errorMessage: fmt.Sprintf("value of field %q may not be referenced by field %q", nameFieldName, formulaFieldName),
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "missing_new_metric"),
 			errorMessage: fmt.Sprintf("missing required field %q", nameFieldName),
@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor/internal/expr"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor/internal/metadata"
 )
 
@@ -65,6 +66,10 @@ func buildInternalConfig(config *Config) []internalRule {
 			operation: string(rule.Operation),
 			scaleBy:   rule.ScaleBy,
 		}
+		if rule.Type == formula {
+			// The formula is validated during config validation, an invalid one leaves the rule skipped.
+			customRule.expression, _ = expr.Parse(rule.Formula)
+		}
 		internalRules[i] = customRule
 	}
 	return internalRules
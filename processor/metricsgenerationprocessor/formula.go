@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricsgenerationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor"
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor/internal/expr"
+)
+
+// rateStaleness is the duration after which the previous value of a series is forgotten if it isn't updated.
+const rateStaleness = 15 * time.Minute
+
+// generateFormulaMetrics creates a new gauge metric evaluating the formula of the rule on the metrics of the resource,
+// and adds it to the scope metrics of the first metric referenced by the formula.
+func generateFormulaMetrics(rm pmetric.ResourceMetrics, nameToMetricMap map[string]pmetric.Metric, rates *rateTracker, rule internalRule, logger *zap.Logger) {
+	for _, name := range rule.expression.Metrics() {
+		metric, ok := nameToMetricMap[name]
+		if !ok {
+			logger.Debug("Missing metric referenced by formula", zap.String("metric_name", name), zap.String("rule", rule.name))
+			return
+		}
+		if metric.Type() != pmetric.MetricTypeGauge && metric.Type() != pmetric.MetricTypeSum {
+			logger.Debug("Formulas are only supported on gauge or sum metric types", zap.String("metric_name", name), zap.String("metric_type", metric.Type().String()))
+			return
+		}
+	}
+
+	samples := rule.expression.Eval(&resourceEnv{resource: rm.Resource(), metrics: nameToMetricMap, rates: rates})
+	newMetric := pmetric.NewMetric()
+	dataPoints := newMetric.SetEmptyGauge().DataPoints()
+	for _, sample := range samples {
+		dp := dataPoints.AppendEmpty()
+		sample.Attributes.CopyTo(dp.Attributes())
+		dp.SetTimestamp(sample.Timestamp)
+		dp.SetDoubleValue(sample.Value)
+	}
+
+	first := rule.expression.Metrics()[0]
+	ilms := rm.ScopeMetrics()
+	for i := 0; i < ilms.Len(); i++ {
+		ilm := ilms.At(i)
+		metricSlice := ilm.Metrics()
+		for j := 0; j < metricSlice.Len(); j++ {
+			if metricSlice.At(j).Name() == first {
+				appendNewMetric(ilm, newMetric, rule.name, rule.unit)
+				return
+			}
+		}
+	}
+}
+
+// resourceEnv provides the samples of the metrics of a resource to formulas.
+type resourceEnv struct {
+	resource pcommon.Resource
+	metrics  map[string]pmetric.Metric
+	rates    *rateTracker
+}
+
+func (e *resourceEnv) Samples(name string) []expr.Sample {
+	dataPoints := numberDataPoints(e.metrics[name])
+	samples := make([]expr.Sample, 0, dataPoints.Len())
+	for i := 0; i < dataPoints.Len(); i++ {
+		dp := dataPoints.At(i)
+		samples = append(samples, expr.Sample{Attributes: dp.Attributes(), Timestamp: dp.Timestamp(), Value: dataPointValue(dp)})
+	}
+	return samples
+}
+
+func (e *resourceEnv) Rates(name string) []expr.Sample {
+	metric := e.metrics[name]
+	dataPoints := numberDataPoints(metric)
+	samples := make([]expr.Sample, 0, dataPoints.Len())
+	for i := 0; i < dataPoints.Len(); i++ {
+		dp := dataPoints.At(i)
+		if rate, ok := e.rates.rate(e.resource, metric, dp); ok {
+			samples = append(samples, expr.Sample{Attributes: dp.Attributes(), Timestamp: dp.Timestamp(), Value: rate})
+		}
+	}
+	return samples
+}
+
+func numberDataPoints(metric pmetric.Metric) pmetric.NumberDataPointSlice {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		return metric.Gauge().DataPoints()
+	case pmetric.MetricTypeSum:
+		return metric.Sum().DataPoints()
+	default:
+		return pmetric.NewNumberDataPointSlice()
+	}
+}
+
+// rateTracker computes the per-second rate of change of series. The rate of delta sums is computed from
+// the duration of each data point, while the rate of the other metrics needs the previous value of the series.
+type rateTracker struct {
+	mu        sync.Mutex
+	previous  map[string]trackedPoint
+	lastSweep time.Time
+}
+
+type trackedPoint struct {
+	startTimestamp pcommon.Timestamp
+	timestamp      pcommon.Timestamp
+	value          float64
+	seen           time.Time
+	// rate is the rate computed for the data point, returned again when the same data point is evaluated
+	// by several formulas, or several times by the same formula.
+	rate    float64
+	hasRate bool
+}
+
+func newRateTracker() *rateTracker {
+	return &rateTracker{previous: map[string]trackedPoint{}}
+}
+
+// rate returns the rate of the series of the data point, it returns false if no rate can be computed yet.
+func (r *rateTracker) rate(resource pcommon.Resource, metric pmetric.Metric, dp pmetric.NumberDataPoint) (float64, bool) {
+	value := dataPointValue(dp)
+	if metric.Type() == pmetric.MetricTypeSum && metric.Sum().AggregationTemporality() == pmetric.AggregationTemporalityDelta {
+		if dp.StartTimestamp() == 0 || dp.Timestamp() <= dp.StartTimestamp() {
+			return 0, false
+		}
+		return value / dp.Timestamp().AsTime().Sub(dp.StartTimestamp().AsTime()).Seconds(), true
+	}
+
+	resourceHash := pdatautil.MapHash(resource.Attributes())
+	attributesHash := pdatautil.MapHash(dp.Attributes())
+	key := metric.Name() + "\x00" + string(resourceHash[:]) + string(attributesHash[:])
+	current := trackedPoint{startTimestamp: dp.StartTimestamp(), timestamp: dp.Timestamp(), value: value, seen: time.Now()}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	previous, ok := r.previous[key]
+	if ok && dp.Timestamp() < previous.timestamp {
+		// Out of order data points are ignored.
+		return 0, false
+	}
+	if ok && dp.Timestamp() == previous.timestamp && dp.StartTimestamp() == previous.startTimestamp && value == previous.value {
+		// The data point was already evaluated.
+		previous.seen = current.seen
+		r.previous[key] = previous
+		return previous.rate, previous.hasRate
+	}
+	switch {
+	case !ok || dp.Timestamp() == previous.timestamp:
+	case metric.Type() == pmetric.MetricTypeSum && (value < previous.value || dp.StartTimestamp() != previous.startTimestamp):
+		// A reset of a cumulative sum starts a new series.
+	default:
+		current.rate = (value - previous.value) / dp.Timestamp().AsTime().Sub(previous.timestamp.AsTime()).Seconds()
+		current.hasRate = true
+	}
+	r.previous[key] = current
+	return current.rate, current.hasRate
+}
+
+// removeStale forgets the series which were not updated recently.
+func (r *rateTracker) removeStale(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now.Sub(r.lastSweep) < time.Minute {
+		return
+	}
+	r.lastSweep = now
+	for key, point := range r.previous {
+		if now.Sub(point.seen) > rateStaleness {
+			delete(r.previous, key)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricsgenerationprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+var formulaStart = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newCounters returns cumulative sums of errors and requests per route, observed at the given offset from formulaStart.
+func newCounters(offset time.Duration, errors, requests map[string]int64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "api")
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+	for name, values := range map[string]map[string]int64{"http.server.errors": errors, "http.server.requests": requests} {
+		metric := metrics.AppendEmpty()
+		metric.SetName(name)
+		sum := metric.SetEmptySum()
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		sum.SetIsMonotonic(true)
+		for route, value := range values {
+			dp := sum.DataPoints().AppendEmpty()
+			dp.Attributes().PutStr("http.route", route)
+			dp.SetStartTimestamp(pcommon.NewTimestampFromTime(formulaStart))
+			dp.SetTimestamp(pcommon.NewTimestampFromTime(formulaStart.Add(offset)))
+			dp.SetIntValue(value)
+		}
+	}
+	return md
+}
+
+func TestFormulaRate(t *testing.T) {
+	mgp := newMetricsGenerationProcessor(buildInternalConfig(&Config{Rules: []Rule{{
+		Name:    "http.server.error_ratio",
+		Type:    formula,
+		Formula: "rate(http.server.errors) / rate(http.server.requests) * 100",
+	}}}), zap.NewNop())
+
+	// No rate can be computed from the first values of cumulative sums.
+	md, err := mgp.processMetrics(t.Context(), newCounters(0, map[string]int64{"/a": 10, "/b": 0}, map[string]int64{"/a": 100, "/b": 100}))
+	require.NoError(t, err)
+	assert.Equal(t, 2, md.MetricCount())
+
+	md, err = mgp.processMetrics(t.Context(), newCounters(10*time.Second, map[string]int64{"/a": 15, "/b": 0}, map[string]int64{"/a": 150, "/b": 100}))
+	require.NoError(t, err)
+	require.Equal(t, 3, md.MetricCount())
+	metric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(2)
+	assert.Equal(t, "http.server.error_ratio", metric.Name())
+	require.Equal(t, pmetric.MetricTypeGauge, metric.Type())
+
+	// The ratio of /b can't be computed since it received no requests.
+	dps := metric.Gauge().DataPoints()
+	require.Equal(t, 1, dps.Len())
+	assert.InDelta(t, 10, dps.At(0).DoubleValue(), 1e-9)
+	assert.Equal(t, map[string]any{"http.route": "/a"}, dps.At(0).Attributes().AsRaw())
+	assert.Equal(t, pcommon.NewTimestampFromTime(formulaStart.Add(10*time.Second)), dps.At(0).Timestamp())
+}
+
+func TestRateTracker(t *testing.T) {
+	r := newRateTracker()
+	rateOf := func(temporality pmetric.AggregationTemporality, start, offset time.Duration, value float64) (float64, bool) {
+		metric := pmetric.NewMetric()
+		metric.SetName("requests")
+		metric.SetEmptySum().SetAggregationTemporality(temporality)
+		dp := metric.Sum().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(pcommon.NewTimestampFromTime(formulaStart.Add(start)))
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(formulaStart.Add(offset)))
+		dp.SetDoubleValue(value)
+		return r.rate(pcommon.NewResource(), metric, dp)
+	}
+
+	rate, ok := rateOf(pmetric.AggregationTemporalityDelta, 0, 5*time.Second, 10)
+	require.True(t, ok)
+	assert.InDelta(t, 2, rate, 1e-9)
+
+	_, ok = rateOf(pmetric.AggregationTemporalityCumulative, 0, 10*time.Second, 100)
+	assert.False(t, ok)
+	rate, ok = rateOf(pmetric.AggregationTemporalityCumulative, 0, 20*time.Second, 150)
+	require.True(t, ok)
+	assert.InDelta(t, 5, rate, 1e-9)
+
+	// The same data point evaluated again, e.g. by another formula, has the same rate.
+	rate, ok = rateOf(pmetric.AggregationTemporalityCumulative, 0, 20*time.Second, 150)
+	require.True(t, ok)
+	assert.InDelta(t, 5, rate, 1e-9)
+
+	// A reset of the sum starts a new series.
+	_, ok = rateOf(pmetric.AggregationTemporalityCumulative, 25*time.Second, 30*time.Second, 10)
+	assert.False(t, ok)
+	rate, ok = rateOf(pmetric.AggregationTemporalityCumulative, 25*time.Second, 40*time.Second, 30)
+	require.True(t, ok)
+	assert.InDelta(t, 2, rate, 1e-9)
+
+	// Stale series are forgotten.
+	r.removeStale(time.Now().Add(rateStaleness + time.Minute))
+	assert.Empty(t, r.previous)
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package expr // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor/internal/expr"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// Sample is a value of a metric, with the attributes of its data point.
+type Sample struct {
+	Attributes pcommon.Map
+	Timestamp  pcommon.Timestamp
+	Value      float64
+}
+
+// Env provides the samples of the metrics referenced by a formula.
+type Env interface {
+	// Samples returns the samples of the data points of the metric.
+	Samples(metric string) []Sample
+	// Rates returns the per-second rate of change of the data points of the metric.
+	Rates(metric string) []Sample
+}
+
+// Eval evaluates the formula. The operations between two metrics are applied to each pair of samples whose
+// common attributes have the same values, and the resulting samples have the attributes of both samples.
+// The samples for which a division by zero happens are dropped.
+func (e *Expression) Eval(env Env) []Sample {
+	return e.root.eval(env).samples
+}
+
+// operand is either a number or the samples of a metric.
+type operand struct {
+	isNumber bool
+	number   float64
+	samples  []Sample
+}
+
+type node interface {
+	eval(env Env) operand
+}
+
+type numberNode float64
+
+func (n numberNode) eval(Env) operand {
+	return operand{isNumber: true, number: float64(n)}
+}
+
+type metricNode string
+
+func (n metricNode) eval(env Env) operand {
+	return operand{samples: env.Samples(string(n))}
+}
+
+type rateNode string
+
+func (n rateNode) eval(env Env) operand {
+	return operand{samples: env.Rates(string(n))}
+}
+
+type binaryNode struct {
+	op          byte
+	left, right node
+}
+
+func (n *binaryNode) eval(env Env) operand {
+	left, right := n.left.eval(env), n.right.eval(env)
+	switch {
+	case left.isNumber && right.isNumber:
+		value, ok := apply(n.op, left.number, right.number)
+		if !ok {
+			return operand{}
+		}
+		return operand{isNumber: true, number: value}
+	case right.isNumber:
+		return operand{samples: mapSamples(left.samples, func(value float64) (float64, bool) {
+			return apply(n.op, value, right.number)
+		})}
+	case left.isNumber:
+		return operand{samples: mapSamples(right.samples, func(value float64) (float64, bool) {
+			return apply(n.op, left.number, value)
+		})}
+	}
+
+	var samples []Sample
+	for _, l := range left.samples {
+		for _, r := range right.samples {
+			if !attributesMatch(l.Attributes, r.Attributes) {
+				continue
+			}
+			value, ok := apply(n.op, l.Value, r.Value)
+			if !ok {
+				continue
+			}
+			attrs := pcommon.NewMap()
+			l.Attributes.CopyTo(attrs)
+			for k, v := range r.Attributes.All() {
+				v.CopyTo(attrs.PutEmpty(k))
+			}
+			samples = append(samples, Sample{Attributes: attrs, Timestamp: max(l.Timestamp, r.Timestamp), Value: value})
+		}
+	}
+	return operand{samples: samples}
+}
+
+func mapSamples(samples []Sample, f func(float64) (float64, bool)) []Sample {
+	mapped := make([]Sample, 0, len(samples))
+	for _, sample := range samples {
+		if value, ok := f(sample.Value); ok {
+			sample.Value = value
+			mapped = append(mapped, sample)
+		}
+	}
+	return mapped
+}
+
+// apply applies the operator, it returns false on a division by zero.
+func apply(op byte, a, b float64) (float64, bool) {
+	switch op {
+	case '+':
+		return a + b, true
+	case '-':
+		return a - b, true
+	case '*':
+		return a * b, true
+	case '/':
+		if b == 0 {
+			return 0, false
+		}
+		return a / b, true
+	}
+	return 0, false
+}
+
+// attributesMatch returns whether the attributes which are in both maps have the same values.
+func attributesMatch(a, b pcommon.Map) bool {
+	for k, v := range a.All() {
+		if other, ok := b.Get(k); ok && !other.Equal(v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package expr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+type testEnv struct {
+	samples map[string][]Sample
+	rates   map[string][]Sample
+}
+
+func (e *testEnv) Samples(metric string) []Sample {
+	return e.samples[metric]
+}
+
+func (e *testEnv) Rates(metric string) []Sample {
+	return e.rates[metric]
+}
+
+func newSample(value float64, timestamp pcommon.Timestamp, attrs map[string]any) Sample {
+	m := pcommon.NewMap()
+	_ = m.FromRaw(attrs)
+	return Sample{Attributes: m, Timestamp: timestamp, Value: value}
+}
+
+func TestEval(t *testing.T) {
+	env := &testEnv{
+		samples: map[string][]Sample{
+			"capacity": {
+				newSample(1000, 1, map[string]any{"device": "sda"}),
+				newSample(2000, 1, map[string]any{"device": "sdb"}),
+			},
+			"used": {
+				newSample(250, 2, map[string]any{"device": "sda", "mode": "rw"}),
+				newSample(0, 2, map[string]any{"device": "sdc"}),
+			},
+			"limit": {newSample(0, 1, nil)},
+		},
+		rates: map[string][]Sample{
+			"errors":   {newSample(1, 3, map[string]any{"route": "/a"}), newSample(2, 3, map[string]any{"route": "/b"})},
+			"requests": {newSample(10, 3, map[string]any{"route": "/a"}), newSample(0, 3, map[string]any{"route": "/b"})},
+		},
+	}
+
+	tests := []struct {
+		formula  string
+		expected []Sample
+	}{
+		{
+			formula:  "used / capacity * 100",
+			expected: []Sample{newSample(25, 2, map[string]any{"device": "sda", "mode": "rw"})},
+		},
+		{
+			formula: "-capacity + 1",
+			expected: []Sample{
+				newSample(-999, 1, map[string]any{"device": "sda"}),
+				newSample(-1999, 1, map[string]any{"device": "sdb"}),
+			},
+		},
+		{
+			formula:  "rate(errors) / rate(requests)",
+			expected: []Sample{newSample(0.1, 3, map[string]any{"route": "/a"})},
+		},
+		{
+			formula:  "capacity / limit",
+			expected: nil,
+		},
+		{
+			formula:  "capacity / (2 - 2)",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.formula, func(t *testing.T) {
+			e, err := Parse(tt.formula)
+			require.NoError(t, err)
+			samples := e.Eval(env)
+			require.Len(t, samples, len(tt.expected))
+			for i, expected := range tt.expected {
+				assert.InDelta(t, expected.Value, samples[i].Value, 1e-9)
+				assert.Equal(t, expected.Timestamp, samples[i].Timestamp)
+				assert.Equal(t, expected.Attributes.AsRaw(), samples[i].Attributes.AsRaw())
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package expr parses and evaluates the arithmetic formulas used to generate metrics from other metrics,
+// like `rate(http.server.errors) / rate(http.server.requests) * 100`.
+package expr // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor/internal/expr"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expression is a parsed formula.
+type Expression struct {
+	root    node
+	metrics []string
+}
+
+// Metrics returns the names of the metrics referenced by the formula, in their order of first appearance.
+func (e *Expression) Metrics() []string {
+	return e.metrics
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenName
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+	pos   int
+}
+
+// functions are the functions which can be used in formulas, taking a metric as argument.
+var functions = map[string]struct{}{
+	"rate": {},
+}
+
+// Parse parses a formula. The operands of a formula are numbers and metric names, which may be quoted with
+// double quotes if they contain other characters than letters, digits, underscores and dots.
+// The supported operators are +, -, * and / with the usual precedence, and parentheses.
+func Parse(formula string) (*Expression, error) {
+	tokens, err := tokenize(formula)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+	}
+	if len(p.metrics) == 0 {
+		return nil, errors.New("formula must reference at least one metric")
+	}
+	return &Expression{root: root, metrics: p.metrics}, nil
+}
+
+func tokenize(formula string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(formula); {
+		c := rune(formula[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case strings.ContainsRune("+-*/(),", c):
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), pos: i})
+			i++
+		case c == '"':
+			end := strings.IndexByte(formula[i+1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated metric name at position %d", i)
+			}
+			name := formula[i+1 : i+1+end]
+			if name == "" {
+				return nil, fmt.Errorf("empty metric name at position %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenName, text: name, pos: i})
+			i += end + 2
+		case unicode.IsDigit(c) || c == '.':
+			start := i
+			for i < len(formula) && (isDigit(formula[i]) || formula[i] == '.' ||
+				((formula[i] == 'e' || formula[i] == 'E') && i > start) ||
+				((formula[i] == '+' || formula[i] == '-') && (formula[i-1] == 'e' || formula[i-1] == 'E'))) {
+				i++
+			}
+			value, err := strconv.ParseFloat(formula[start:i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", formula[start:i], start)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: formula[start:i], value: value, pos: start})
+		case isNameStart(c):
+			start := i
+			for i < len(formula) && isNamePart(rune(formula[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenName, text: formula[start:i], pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, text: "end of formula", pos: len(formula)}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNameStart(c rune) bool {
+	return c == '_' || (c < unicode.MaxASCII && unicode.IsLetter(c))
+}
+
+func isNamePart(c rune) bool {
+	return isNameStart(c) || c == '.' || (c < unicode.MaxASCII && unicode.IsDigit(c))
+}
+
+// parser is a recursive descent parser of the grammar:
+//
+//	expression = term { ("+" | "-") term }
+//	term       = unary { ("*" | "/") unary }
+//	unary      = "-" unary | primary
+//	primary    = number | metric | function "(" metric ")" | "(" expression ")"
+type parser struct {
+	tokens  []token
+	pos     int
+	metrics []string
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) accept(operators string) (string, bool) {
+	tok := p.peek()
+	if tok.kind == tokenOperator && strings.Contains(operators, tok.text) {
+		p.pos++
+		return tok.text, true
+	}
+	return "", false
+}
+
+func (p *parser) expect(operator string) error {
+	if _, ok := p.accept(operator); !ok {
+		tok := p.peek()
+		return fmt.Errorf("expected %q at position %d, got %q", operator, tok.pos, tok.text)
+	}
+	return nil
+}
+
+func (p *parser) parseExpression() (node, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept("+-")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op[0], left: left, right: right}
+	}
+}
+
+func (p *parser) parseTerm() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept("*/")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op[0], left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if _, ok := p.accept("-"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &binaryNode{op: '*', left: numberNode(-1), right: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenNumber:
+		return numberNode(tok.value), nil
+	case tokenName:
+		if _, isFunction := functions[tok.text]; isFunction && p.peek().kind == tokenOperator && p.peek().text == "(" {
+			return p.parseFunction(tok)
+		}
+		p.addMetric(tok.text)
+		return metricNode(tok.text), nil
+	case tokenOperator:
+		if tok.text == "(" {
+			expr, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			return expr, p.expect(")")
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+}
+
+func (p *parser) parseFunction(function token) (node, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	arg := p.next()
+	if arg.kind != tokenName {
+		return nil, fmt.Errorf("the argument of %s at position %d must be a metric", function.text, function.pos)
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	p.addMetric(arg.text)
+	return rateNode(arg.text), nil
+}
+
+func (p *parser) addMetric(name string) {
+	if !slices.Contains(p.metrics, name) {
+		p.metrics = append(p.metrics, name)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package expr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		formula string
+		metrics []string
+	}{
+		{formula: "a", metrics: []string{"a"}},
+		{formula: "rate(http.server.errors) / rate(http.server.requests) * 100", metrics: []string{"http.server.errors", "http.server.requests"}},
+		{formula: `"system.disk-io" / 1e3 - -(a + b) / a`, metrics: []string{"system.disk-io", "a", "b"}},
+		{formula: "rate", metrics: []string{"rate"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.formula, func(t *testing.T) {
+			e, err := Parse(tt.formula)
+			require.NoError(t, err)
+			assert.Equal(t, tt.metrics, e.Metrics())
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		formula string
+		err     string
+	}{
+		{formula: "", err: `unexpected "end of formula" at position 0`},
+		{formula: "1 + 2", err: "formula must reference at least one metric"},
+		{formula: "a +", err: `unexpected "end of formula" at position 3`},
+		{formula: "(a + b", err: `expected ")" at position 6, got "end of formula"`},
+		{formula: "a b", err: `unexpected "b" at position 2`},
+		{formula: "rate(a + b)", err: `expected ")" at position 7, got "+"`},
+		{formula: "rate(1)", err: "the argument of rate at position 0 must be a metric"},
+		{formula: `"a`, err: "unterminated metric name at position 0"},
+		{formula: "a % b", err: `unexpected character '%' at position 2`},
+		{formula: "1.2.3 * a", err: `invalid number "1.2.3" at position 0`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.formula, func(t *testing.T) {
+			_, err := Parse(tt.formula)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -6,11 +6,14 @@ package metricsgenerationprocessor // import "github.com/open-telemetry/opentele
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor/internal/expr"
 )
 
 var matchAttributes = featuregate.GlobalRegistry().MustRegister(
@@ -23,6 +26,7 @@ var matchAttributes = featuregate.GlobalRegistry().MustRegister(
 
 type metricsGenerationProcessor struct {
 	rules  []internalRule
+	rates  *rateTracker
 	logger *zap.Logger
 }
 
@@ -34,11 +38,14 @@ type internalRule struct {
 	metric2   string
 	operation string
 	scaleBy   float64
+	// expression is the parsed formula of the rules of type formula.
+	expression *expr.Expression
 }
 
 func newMetricsGenerationProcessor(rules []internalRule, logger *zap.Logger) *metricsGenerationProcessor {
 	return &metricsGenerationProcessor{
 		rules:  rules,
+		rates:  newRateTracker(),
 		logger: logger,
 	}
 }
@@ -51,12 +58,22 @@ func (*metricsGenerationProcessor) Start(context.Context, component.Host) error
 // processMetrics implements the ProcessMetricsFunc type.
 func (mgp *metricsGenerationProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	resourceMetricsSlice := md.ResourceMetrics()
+	mgp.rates.removeStale(time.Now())
 
 	for i := 0; i < resourceMetricsSlice.Len(); i++ {
 		rm := resourceMetricsSlice.At(i)
 		nameToMetricMap := getNameToMetricMap(rm)
 
 		for _, rule := range mgp.rules {
+			if rule.ruleType == string(formula) {
+				if rule.expression == nil {
+					mgp.logger.Debug(fmt.Sprintf("Invalid formula specified for rule: %s. This rule is skipped.", rule.name))
+					continue
+				}
+				generateFormulaMetrics(rm, nameToMetricMap, mgp.rates, rule, mgp.logger)
+				continue
+			}
+
 			_, ok := nameToMetricMap[rule.metric1]
 			if !ok {
 				mgp.logger.Debug("Missing first metric", zap.String("metric_name", rule.metric1))
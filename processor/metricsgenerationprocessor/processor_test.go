@@ -402,6 +402,7 @@ func TestGoldenFileMetrics(t *testing.T) {
 	//		value is 0.
 	// 	match_attributes: These tests are to ensure the correct data points are generated when the
 	//		match attributes feature gate is enabled.
+	// 	formula: These tests are to ensure formulas are evaluated on the data points with matching attributes.
 	testCaseNames := []goldenTestCases{
 		{
 			// Keep this test case to show that existing behavior has remained unchanged when
@@ -510,6 +511,10 @@ func TestGoldenFileMetrics(t *testing.T) {
 			testDir:                    "match_attributes",
 			matchAttributesFlagEnabled: true,
 		},
+		{
+			name:    "formula",
+			testDir: "formula",
+		},
 	}
 
 	for _, testCase := range testCaseNames {
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package remotetapprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/remotetapprocessor"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// annotate appends id to the slice attribute key of the resource attributes. An existing
+// attribute that is not a slice is kept as the first element of the slice.
+func annotate(attrs pcommon.Map, key, id string) {
+	v, ok := attrs.Get(key)
+	if ok && v.Type() == pcommon.ValueTypeSlice {
+		v.Slice().AppendEmpty().SetStr(id)
+		return
+	}
+	s := pcommon.NewSlice()
+	if ok {
+		v.CopyTo(s.AppendEmpty())
+	}
+	s.AppendEmpty().SetStr(id)
+	s.MoveAndAppendTo(attrs.PutEmptySlice(key))
+}
+
+func (w *wsprocessor) annotateMetrics(md pmetric.Metrics) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		annotate(rms.At(i).Resource().Attributes(), w.config.Annotation.Attribute, w.id)
+	}
+}
+
+func (w *wsprocessor) annotateLogs(ld plog.Logs) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		annotate(rls.At(i).Resource().Attributes(), w.config.Annotation.Attribute, w.id)
+	}
+}
+
+func (w *wsprocessor) annotateTraces(td ptrace.Traces) {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		annotate(rss.At(i).Resource().Attributes(), w.config.Annotation.Attribute, w.id)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package remotetapprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/remotetapprocessor/internal/metadata"
+)
+
+func TestAnnotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing func(pcommon.Map)
+		expected []any
+	}{
+		{
+			name:     "missing",
+			existing: func(pcommon.Map) {},
+			expected: []any{"remotetap/after"},
+		},
+		{
+			name: "slice",
+			existing: func(attrs pcommon.Map) {
+				attrs.PutEmptySlice("observed_at").AppendEmpty().SetStr("remotetap/before")
+			},
+			expected: []any{"remotetap/before", "remotetap/after"},
+		},
+		{
+			name: "string",
+			existing: func(attrs pcommon.Map) {
+				attrs.PutStr("observed_at", "other")
+			},
+			expected: []any{"other", "remotetap/after"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := pcommon.NewMap()
+			tt.existing(attrs)
+			annotate(attrs, "observed_at", "remotetap/after")
+			v, ok := attrs.Get("observed_at")
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, v.Slice().AsRaw())
+		})
+	}
+}
+
+func TestConsumeAnnotated(t *testing.T) {
+	settings := processortest.NewNopSettings(metadata.Type)
+	settings.ID = component.MustNewIDWithName(metadata.Type.String(), "after_filter")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Annotation.Enabled = true
+	processor := newProcessor(settings, cfg)
+
+	assertAnnotated := func(t *testing.T, attrs pcommon.Map) {
+		v, ok := attrs.Get(defaultAnnotationAttribute)
+		require.True(t, ok)
+		assert.Equal(t, []any{"remotetap/after_filter"}, v.Slice().AsRaw())
+	}
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty()
+	md, err := processor.ConsumeMetrics(t.Context(), md)
+	require.NoError(t, err)
+	assertAnnotated(t, md.ResourceMetrics().At(0).Resource().Attributes())
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty()
+	ld, err = processor.ConsumeLogs(t.Context(), ld)
+	require.NoError(t, err)
+	assertAnnotated(t, ld.ResourceLogs().At(0).Resource().Attributes())
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty()
+	td, err = processor.ConsumeTraces(t.Context(), td)
+	require.NoError(t, err)
+	assertAnnotated(t, td.ResourceSpans().At(0).Resource().Attributes())
+}
+
+func TestConsumeNotAnnotated(t *testing.T) {
+	processor := newProcessor(processortest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config))
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty()
+	md, err := processor.ConsumeMetrics(t.Context(), md)
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.ResourceMetrics().At(0).Resource().Attributes().Len())
+}
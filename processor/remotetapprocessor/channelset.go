@@ -3,39 +3,75 @@
 
 package remotetapprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/remotetapprocessor"
 
-import "sync"
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// tapClient is a websocket client of the processor. The clients with a selector receive the
+// telemetry it selects at the rate of their own limiter, the others receive all the telemetry
+// at the rate limit of the processor.
+type tapClient struct {
+	ch       chan []byte
+	selector *tapSelector
+	limiter  *rate.Limiter
+}
 
 // channelSet is a collection of byte channels where adding, removing, and writing to
 // the channels is synchronized.
 type channelSet struct {
 	i       int
 	mu      sync.RWMutex
-	chanmap map[int]chan []byte
+	chanmap map[int]*tapClient
 }
 
 func newChannelSet() *channelSet {
 	return &channelSet{
-		chanmap: map[int]chan []byte{},
+		chanmap: map[int]*tapClient{},
 	}
 }
 
 // add adds the channel to the channelSet and returns a key (just an int) used to
 // remove the channel later.
 func (c *channelSet) add(ch chan []byte) int {
+	return c.addClient(&tapClient{ch: ch})
+}
+
+// addClient adds the client to the channelSet and returns a key used to remove its
+// channel later.
+func (c *channelSet) addClient(cl *tapClient) int {
 	c.mu.Lock()
 	idx := c.i
-	c.chanmap[idx] = ch
+	c.chanmap[idx] = cl
 	c.i++
 	c.mu.Unlock()
 	return idx
 }
 
-// writeBytes writes the passed in bytes to all of the channels in the
-// channelSet.
+// writeBytes writes the passed in bytes to all of the channels of the clients
+// without a selector in the channelSet.
 func (c *channelSet) writeBytes(bytes []byte) {
 	c.mu.RLock()
-	for _, ch := range c.chanmap {
-		ch <- bytes
+	for _, cl := range c.chanmap {
+		if cl.selector == nil {
+			cl.ch <- bytes
+		}
+	}
+	c.mu.RUnlock()
+}
+
+// writeSelected writes the bytes returned by fn for each of the clients with a
+// selector to their channel. fn returns nil to skip the client.
+func (c *channelSet) writeSelected(fn func(cl *tapClient) []byte) {
+	c.mu.RLock()
+	for _, cl := range c.chanmap {
+		if cl.selector == nil {
+			continue
+		}
+		if bytes := fn(cl); bytes != nil {
+			cl.ch <- bytes
+		}
 	}
 	c.mu.RUnlock()
 }
@@ -44,7 +80,7 @@ func (c *channelSet) writeBytes(bytes []byte) {
 // key. Panics if an invalid key is passed in.
 func (c *channelSet) closeAndRemove(key int) {
 	c.mu.Lock()
-	close(c.chanmap[key])
+	close(c.chanmap[key].ch)
 	delete(c.chanmap, key)
 	c.mu.Unlock()
 }
@@ -62,8 +98,8 @@ func (c *channelSet) shutdown() {
 		i++
 	}
 
-	for key := range keys {
-		close(c.chanmap[key])
+	for _, key := range keys {
+		close(c.chanmap[key].ch)
 		delete(c.chanmap, key)
 	}
 }
@@ -4,13 +4,20 @@
 package remotetapprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/remotetapprocessor"
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/confignet"
 	"golang.org/x/time/rate"
 )
 
-const defaultEndpoint = "localhost:12001"
+const (
+	defaultEndpoint            = "localhost:12001"
+	defaultAnnotationAttribute = "otelcol.remotetap.observed_at"
+)
+
+var errEmptyAnnotationAttribute = errors.New("`annotation::attribute` must be set when the annotation is enabled")
 
 type Config struct {
 	confighttp.ServerConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
@@ -19,10 +26,35 @@ type Config struct {
 	// through the websocket by this processor in messages per second. Defaults to 1.
 	Limit rate.Limit `mapstructure:"limit"`
 
+	// Annotation configures the marking of the telemetry passing through this processor.
+	Annotation AnnotationConfig `mapstructure:"annotation"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
 
+// AnnotationConfig configures the resource attribute recording the remote tap processors
+// the telemetry went through, to follow it across the pipelines of a collector.
+type AnnotationConfig struct {
+	// Enabled appends the ID of this processor to the resource attribute of the telemetry
+	// passing through it. Defaults to false.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Attribute is the key of the resource attribute listing the IDs of the remote tap
+	// processors. Defaults to otelcol.remotetap.observed_at.
+	Attribute string `mapstructure:"attribute"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.Annotation.Enabled && cfg.Annotation.Attribute == "" {
+		return errEmptyAnnotationAttribute
+	}
+	return nil
+}
+
 func createDefaultConfig() component.Config {
 	netAddr := confignet.NewDefaultAddrConfig()
 	netAddr.Transport = confignet.TransportTypeTCP
@@ -30,5 +62,8 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		ServerConfig: confighttp.ServerConfig{NetAddr: netAddr},
 		Limit:        1,
+		Annotation: AnnotationConfig{
+			Attribute: defaultAnnotationAttribute,
+		},
 	}
 }
@@ -14,3 +14,15 @@ func TestCreateDefaultConfig(t *testing.T) {
 	assert.Equal(t, "localhost:12001", cfg.NetAddr.Endpoint)
 	assert.EqualValues(t, 1, cfg.Limit)
 }
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.Equal(t, defaultAnnotationAttribute, cfg.Annotation.Attribute)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Annotation.Enabled = true
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Annotation.Attribute = ""
+	assert.ErrorIs(t, cfg.Validate(), errEmptyAnnotationAttribute)
+}
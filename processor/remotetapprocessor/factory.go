@@ -36,7 +36,7 @@ func createMetricsProcessor(ctx context.Context, params processor.Settings, cfg
 	return processorhelper.NewMetrics(ctx, params, cfg, c,
 		fn,
 		processorhelper.WithCapabilities(consumer.Capabilities{
-			MutatesData: false,
+			MutatesData: rCfg.Annotation.Enabled,
 		}),
 		processorhelper.WithStart(p.Start),
 		processorhelper.WithShutdown(p.Shutdown))
@@ -51,7 +51,7 @@ func createLogsProcessor(ctx context.Context, params processor.Settings, cfg com
 	return processorhelper.NewLogs(ctx, params, cfg, c,
 		fn,
 		processorhelper.WithCapabilities(consumer.Capabilities{
-			MutatesData: false,
+			MutatesData: rCfg.Annotation.Enabled,
 		}),
 		processorhelper.WithStart(p.Start),
 		processorhelper.WithShutdown(p.Shutdown))
@@ -66,7 +66,7 @@ func createTraceProcessor(ctx context.Context, params processor.Settings, cfg co
 	return processorhelper.NewTraces(ctx, params, cfg, c,
 		fn,
 		processorhelper.WithCapabilities(consumer.Capabilities{
-			MutatesData: false,
+			MutatesData: rCfg.Annotation.Enabled,
 		}),
 		processorhelper.WithStart(p.Start),
 		processorhelper.WithShutdown(p.Shutdown))
@@ -25,6 +25,7 @@ import (
 
 type wsprocessor struct {
 	config            *Config
+	id                string
 	telemetrySettings component.TelemetrySettings
 	server            *http.Server
 	shutdownWG        sync.WaitGroup
@@ -41,6 +42,7 @@ var (
 func newProcessor(settings processor.Settings, config *Config) *wsprocessor {
 	return &wsprocessor{
 		config:            config,
+		id:                settings.ID.String(),
 		telemetrySettings: settings.TelemetrySettings,
 		cs:                newChannelSet(),
 		limiter:           rate.NewLimiter(config.Limit, int(config.Limit)),
@@ -54,7 +56,7 @@ func (w *wsprocessor) Start(ctx context.Context, host component.Host) error {
 	if err != nil {
 		return fmt.Errorf("failed to bind to address %s: %w", w.config.NetAddr.Endpoint, err)
 	}
-	w.server, err = w.config.ToServer(ctx, host.GetExtensions(), w.telemetrySettings, websocket.Server{Handler: w.handleConn})
+	w.server, err = w.config.ToServer(ctx, host.GetExtensions(), w.telemetrySettings, http.HandlerFunc(w.handleRequest))
 	if err != nil {
 		return err
 	}
@@ -68,15 +70,27 @@ func (w *wsprocessor) Start(ctx context.Context, host component.Host) error {
 	return nil
 }
 
-func (w *wsprocessor) handleConn(conn *websocket.Conn) {
+// handleRequest creates the client from the query parameters of the request before upgrading
+// the connection to a websocket, so that invalid conditions are rejected.
+func (w *wsprocessor) handleRequest(rw http.ResponseWriter, req *http.Request) {
+	cl, err := newTapClient(req.URL.Query(), w.config.Limit, w.telemetrySettings)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	websocket.Server{Handler: func(conn *websocket.Conn) {
+		w.handleConn(conn, cl)
+	}}.ServeHTTP(rw, req)
+}
+
+func (w *wsprocessor) handleConn(conn *websocket.Conn, cl *tapClient) {
 	err := conn.SetDeadline(time.Time{})
 	if err != nil {
 		w.telemetrySettings.Logger.Debug("Error setting deadline", zap.Error(err))
 		return
 	}
-	ch := make(chan []byte)
-	idx := w.cs.add(ch)
-	for bytes := range ch {
+	idx := w.cs.addClient(cl)
+	for bytes := range cl.ch {
 		_, err := conn.Write(bytes)
 		if err != nil {
 			w.telemetrySettings.Logger.Debug("websocket write error: %w", zap.Error(err))
@@ -103,7 +117,10 @@ func (w *wsprocessor) Shutdown(ctx context.Context) error {
 	return err
 }
 
-func (w *wsprocessor) ConsumeMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (w *wsprocessor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	if w.config.Annotation.Enabled {
+		w.annotateMetrics(md)
+	}
 	if w.limiter.Allow() {
 		b, err := metricMarshaler.MarshalMetrics(md)
 		if err != nil {
@@ -112,11 +129,29 @@ func (w *wsprocessor) ConsumeMetrics(_ context.Context, md pmetric.Metrics) (pme
 			w.cs.writeBytes(b)
 		}
 	}
+	w.cs.writeSelected(func(cl *tapClient) []byte {
+		if cl.limiter.Tokens() < 1 {
+			return nil
+		}
+		selected, ok := cl.selector.selectMetrics(ctx, md)
+		if !ok || !cl.limiter.Allow() {
+			return nil
+		}
+		b, err := metricMarshaler.MarshalMetrics(selected)
+		if err != nil {
+			w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
+			return nil
+		}
+		return b
+	})
 
 	return md, nil
 }
 
-func (w *wsprocessor) ConsumeLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+func (w *wsprocessor) ConsumeLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	if w.config.Annotation.Enabled {
+		w.annotateLogs(ld)
+	}
 	if w.limiter.Allow() {
 		b, err := logMarshaler.MarshalLogs(ld)
 		if err != nil {
@@ -125,11 +160,29 @@ func (w *wsprocessor) ConsumeLogs(_ context.Context, ld plog.Logs) (plog.Logs, e
 			w.cs.writeBytes(b)
 		}
 	}
+	w.cs.writeSelected(func(cl *tapClient) []byte {
+		if cl.limiter.Tokens() < 1 {
+			return nil
+		}
+		selected, ok := cl.selector.selectLogs(ctx, ld)
+		if !ok || !cl.limiter.Allow() {
+			return nil
+		}
+		b, err := logMarshaler.MarshalLogs(selected)
+		if err != nil {
+			w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
+			return nil
+		}
+		return b
+	})
 
 	return ld, nil
 }
 
-func (w *wsprocessor) ConsumeTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+func (w *wsprocessor) ConsumeTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	if w.config.Annotation.Enabled {
+		w.annotateTraces(td)
+	}
 	if w.limiter.Allow() {
 		b, err := traceMarshaler.MarshalTraces(td)
 		if err != nil {
@@ -138,6 +191,21 @@ func (w *wsprocessor) ConsumeTraces(_ context.Context, td ptrace.Traces) (ptrace
 			w.cs.writeBytes(b)
 		}
 	}
+	w.cs.writeSelected(func(cl *tapClient) []byte {
+		if cl.limiter.Tokens() < 1 {
+			return nil
+		}
+		selected, ok := cl.selector.selectTraces(ctx, td)
+		if !ok || !cl.limiter.Allow() {
+			return nil
+		}
+		b, err := traceMarshaler.MarshalTraces(selected)
+		if err != nil {
+			w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
+			return nil
+		}
+		return b
+	})
 
 	return td, nil
 }
@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package remotetapprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/remotetapprocessor"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// The query parameters the websocket clients use to select the telemetry they receive.
+const (
+	spansParam   = "spans"
+	logsParam    = "logs"
+	metricsParam = "metrics"
+	limitParam   = "limit"
+)
+
+// tapSelector selects the spans, logs and metrics streamed to a websocket client with OTTL
+// conditions. The signals without a condition are streamed entirely.
+type tapSelector struct {
+	logger  *zap.Logger
+	spans   *ottl.Condition[*ottlspan.TransformContext]
+	logs    *ottl.Condition[*ottllog.TransformContext]
+	metrics *ottl.Condition[*ottlmetric.TransformContext]
+}
+
+// newTapClient creates a client from the query parameters of its websocket request. The
+// clients setting neither a condition nor a limit return a nil selector and limiter, and share
+// the rate limit of the processor.
+func newTapClient(query url.Values, limit rate.Limit, set component.TelemetrySettings) (*tapClient, error) {
+	if !query.Has(spansParam) && !query.Has(logsParam) && !query.Has(metricsParam) && !query.Has(limitParam) {
+		return &tapClient{ch: make(chan []byte)}, nil
+	}
+
+	clientLimit := limit
+	if query.Has(limitParam) {
+		l, err := strconv.ParseFloat(query.Get(limitParam), 64)
+		if err != nil || l <= 0 {
+			return nil, fmt.Errorf("invalid %s %q: must be a number greater than 0", limitParam, query.Get(limitParam))
+		}
+		clientLimit = min(rate.Limit(l), limit)
+	}
+
+	selector := &tapSelector{logger: set.Logger}
+	if query.Has(spansParam) {
+		parser, err := ottlspan.NewParser(ottlfuncs.StandardConverters[*ottlspan.TransformContext](), set, ottlspan.EnablePathContextNames())
+		if err != nil {
+			return nil, err
+		}
+		if selector.spans, err = parser.ParseCondition(query.Get(spansParam)); err != nil {
+			return nil, fmt.Errorf("invalid %s condition: %w", spansParam, err)
+		}
+	}
+	if query.Has(logsParam) {
+		parser, err := ottllog.NewParser(ottlfuncs.StandardConverters[*ottllog.TransformContext](), set, ottllog.EnablePathContextNames())
+		if err != nil {
+			return nil, err
+		}
+		if selector.logs, err = parser.ParseCondition(query.Get(logsParam)); err != nil {
+			return nil, fmt.Errorf("invalid %s condition: %w", logsParam, err)
+		}
+	}
+	if query.Has(metricsParam) {
+		parser, err := ottlmetric.NewParser(ottlfuncs.StandardConverters[*ottlmetric.TransformContext](), set, ottlmetric.EnablePathContextNames())
+		if err != nil {
+			return nil, err
+		}
+		if selector.metrics, err = parser.ParseCondition(query.Get(metricsParam)); err != nil {
+			return nil, fmt.Errorf("invalid %s condition: %w", metricsParam, err)
+		}
+	}
+
+	return &tapClient{
+		ch:       make(chan []byte),
+		selector: selector,
+		limiter:  rate.NewLimiter(clientLimit, max(1, int(clientLimit))),
+	}, nil
+}
+
+// selectTraces returns the spans matching the condition, and whether any span matched.
+func (s *tapSelector) selectTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, bool) {
+	if s.spans == nil {
+		return td, true
+	}
+	selected := ptrace.NewTraces()
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		var selectedRS ptrace.ResourceSpans
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			var selectedSS ptrace.ScopeSpans
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				tCtx := ottlspan.NewTransformContextPtr(rs, ss, span)
+				match, err := s.spans.Eval(ctx, tCtx)
+				tCtx.Close()
+				if err != nil {
+					s.logger.Debug("Error evaluating the spans condition", zap.Error(err))
+				}
+				if !match {
+					continue
+				}
+				if selectedRS == (ptrace.ResourceSpans{}) {
+					selectedRS = selected.ResourceSpans().AppendEmpty()
+					rs.Resource().CopyTo(selectedRS.Resource())
+					selectedRS.SetSchemaUrl(rs.SchemaUrl())
+				}
+				if selectedSS == (ptrace.ScopeSpans{}) {
+					selectedSS = selectedRS.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(selectedSS.Scope())
+					selectedSS.SetSchemaUrl(ss.SchemaUrl())
+				}
+				span.CopyTo(selectedSS.Spans().AppendEmpty())
+			}
+		}
+	}
+	return selected, selected.SpanCount() > 0
+}
+
+// selectLogs returns the log records matching the condition, and whether any record matched.
+func (s *tapSelector) selectLogs(ctx context.Context, ld plog.Logs) (plog.Logs, bool) {
+	if s.logs == nil {
+		return ld, true
+	}
+	selected := plog.NewLogs()
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		var selectedRL plog.ResourceLogs
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			var selectedSL plog.ScopeLogs
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				tCtx := ottllog.NewTransformContextPtr(rl, sl, lr)
+				match, err := s.logs.Eval(ctx, tCtx)
+				tCtx.Close()
+				if err != nil {
+					s.logger.Debug("Error evaluating the logs condition", zap.Error(err))
+				}
+				if !match {
+					continue
+				}
+				if selectedRL == (plog.ResourceLogs{}) {
+					selectedRL = selected.ResourceLogs().AppendEmpty()
+					rl.Resource().CopyTo(selectedRL.Resource())
+					selectedRL.SetSchemaUrl(rl.SchemaUrl())
+				}
+				if selectedSL == (plog.ScopeLogs{}) {
+					selectedSL = selectedRL.ScopeLogs().AppendEmpty()
+					sl.Scope().CopyTo(selectedSL.Scope())
+					selectedSL.SetSchemaUrl(sl.SchemaUrl())
+				}
+				lr.CopyTo(selectedSL.LogRecords().AppendEmpty())
+			}
+		}
+	}
+	return selected, selected.LogRecordCount() > 0
+}
+
+// selectMetrics returns the metrics matching the condition, and whether any metric matched.
+func (s *tapSelector) selectMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, bool) {
+	if s.metrics == nil {
+		return md, true
+	}
+	selected := pmetric.NewMetrics()
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		var selectedRM pmetric.ResourceMetrics
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			var selectedSM pmetric.ScopeMetrics
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				metric := sm.Metrics().At(k)
+				tCtx := ottlmetric.NewTransformContextPtr(rm, sm, metric)
+				match, err := s.metrics.Eval(ctx, tCtx)
+				tCtx.Close()
+				if err != nil {
+					s.logger.Debug("Error evaluating the metrics condition", zap.Error(err))
+				}
+				if !match {
+					continue
+				}
+				if selectedRM == (pmetric.ResourceMetrics{}) {
+					selectedRM = selected.ResourceMetrics().AppendEmpty()
+					rm.Resource().CopyTo(selectedRM.Resource())
+					selectedRM.SetSchemaUrl(rm.SchemaUrl())
+				}
+				if selectedSM == (pmetric.ScopeMetrics{}) {
+					selectedSM = selectedRM.ScopeMetrics().AppendEmpty()
+					sm.Scope().CopyTo(selectedSM.Scope())
+					selectedSM.SetSchemaUrl(sm.SchemaUrl())
+				}
+				metric.CopyTo(selectedSM.Metrics().AppendEmpty())
+			}
+		}
+	}
+	return selected, selected.MetricCount() > 0
+}
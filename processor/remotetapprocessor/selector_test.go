@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package remotetapprocessor
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"golang.org/x/time/rate"
+)
+
+func TestNewTapClient(t *testing.T) {
+	cases := []struct {
+		name          string
+		query         url.Values
+		expectedLimit rate.Limit
+		selective     bool
+		expectedErr   string
+	}{
+		{
+			name: "no selector",
+		},
+		{
+			name:          "conditions",
+			query:         url.Values{"spans": {`span.name == "foo"`}, "logs": {"false"}, "metrics": {`metric.type == METRIC_DATA_TYPE_SUM`}},
+			expectedLimit: 10,
+			selective:     true,
+		},
+		{
+			name:          "lower limit",
+			query:         url.Values{"limit": {"2.5"}},
+			expectedLimit: 2.5,
+			selective:     true,
+		},
+		{
+			name:          "higher limit",
+			query:         url.Values{"limit": {"100"}},
+			expectedLimit: 10,
+			selective:     true,
+		},
+		{
+			name:        "invalid limit",
+			query:       url.Values{"limit": {"0"}},
+			expectedErr: `invalid limit "0": must be a number greater than 0`,
+		},
+		{
+			name:        "invalid condition",
+			query:       url.Values{"spans": {"span.name =="}},
+			expectedErr: "invalid spans condition",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cl, err := newTapClient(c.query, 10, componenttest.NewNopTelemetrySettings())
+			if c.expectedErr != "" {
+				assert.ErrorContains(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			if !c.selective {
+				assert.Nil(t, cl.selector)
+				assert.Nil(t, cl.limiter)
+				return
+			}
+			require.NotNil(t, cl.selector)
+			assert.Equal(t, c.expectedLimit, cl.limiter.Limit())
+		})
+	}
+}
+
+func TestSelectTraces(t *testing.T) {
+	cl, err := newTapClient(url.Values{"spans": {`span.name == "foo"`}}, 1, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetName("foo")
+	spans.AppendEmpty().SetName("bar")
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("baz")
+
+	selected, ok := cl.selector.selectTraces(t.Context(), td)
+	require.True(t, ok)
+	require.Equal(t, 1, selected.ResourceSpans().Len())
+	assert.Equal(t, map[string]any{"service.name": "checkout"}, selected.ResourceSpans().At(0).Resource().Attributes().AsRaw())
+	require.Equal(t, 1, selected.SpanCount())
+	assert.Equal(t, "foo", selected.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+
+	spans.At(0).SetName("qux")
+	_, ok = cl.selector.selectTraces(t.Context(), td)
+	assert.False(t, ok)
+}
+
+func TestSelectLogs(t *testing.T) {
+	cl, err := newTapClient(url.Values{"logs": {`log.severity_number >= SEVERITY_NUMBER_ERROR`}}, 1, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().SetSeverityNumber(plog.SeverityNumberInfo)
+	lr := records.AppendEmpty()
+	lr.SetSeverityNumber(plog.SeverityNumberError)
+	lr.Body().SetStr("failed")
+
+	selected, ok := cl.selector.selectLogs(t.Context(), ld)
+	require.True(t, ok)
+	require.Equal(t, 1, selected.LogRecordCount())
+	assert.Equal(t, "failed", selected.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
+
+func TestSelectMetrics(t *testing.T) {
+	cl, err := newTapClient(url.Values{"metrics": {`metric.name == "foo"`}}, 1, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	metrics.AppendEmpty().SetName("foo")
+	metrics.AppendEmpty().SetName("bar")
+
+	selected, ok := cl.selector.selectMetrics(t.Context(), md)
+	require.True(t, ok)
+	require.Equal(t, 1, selected.MetricCount())
+	assert.Equal(t, "foo", selected.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+
+	// the signals without a condition are not filtered.
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	selectedLogs, ok := cl.selector.selectLogs(t.Context(), ld)
+	assert.True(t, ok)
+	assert.Equal(t, ld, selectedLogs)
+}
@@ -5,6 +5,8 @@ package remotetapprocessor
 
 import (
 	"net"
+	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
@@ -139,3 +141,50 @@ func TestSocketConnectionTraces(t *testing.T) {
 	err = rawConn.Close()
 	require.NoError(t, err)
 }
+
+func TestSocketConnectionSelectedTraces(t *testing.T) {
+	cfg := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			NetAddr: confignet.AddrConfig{
+				Transport: "tcp",
+				Endpoint:  "localhost:12004",
+			},
+		},
+		Limit: 1,
+	}
+	tracesSink := &consumertest.TracesSink{}
+	processor, err := NewFactory().CreateTraces(t.Context(), processortest.NewNopSettings(metadata.Type), cfg,
+		tracesSink)
+	require.NoError(t, err)
+	err = processor.Start(t.Context(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	resp, err := http.Get("http://localhost:12004/?spans=" + url.QueryEscape("span.name =="))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+
+	rawConn, err := net.Dial("tcp", "localhost:12004")
+	require.NoError(t, err)
+	wsConfig, err := websocket.NewConfig("http://localhost:12004/?spans="+url.QueryEscape(`span.name == "foo"`), "http://localhost:12004")
+	require.NoError(t, err)
+	wsConn, err := websocket.NewClient(wsConfig, rawConn)
+	require.NoError(t, err)
+	trace := ptrace.NewTraces()
+	spans := trace.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetName("bar")
+	spans.AppendEmpty().SetName("foo")
+	buf := make([]byte, 1024)
+	err = processor.ConsumeTraces(t.Context(), trace)
+	require.NoError(t, err)
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		n, _ := wsConn.Read(buf)
+		assert.Equal(tt, 100, n)
+	}, 1*time.Second, 100*time.Millisecond)
+	require.JSONEq(t, `{"resourceSpans":[{"resource":{},"scopeSpans":[{"scope":{},"spans":[{"name":"foo","status":{}}]}]}]}`, string(buf[0:100]))
+
+	err = processor.Shutdown(t.Context())
+	require.NoError(t, err)
+	err = rawConn.Close()
+	require.NoError(t, err)
+}
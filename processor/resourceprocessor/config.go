@@ -5,8 +5,11 @@ package resourceprocessor // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configoptional"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
 )
@@ -17,6 +20,10 @@ type Config struct {
 	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT}.
 	AttributesActions []attraction.ActionKeyValue `mapstructure:"attributes"`
 
+	// Enrichment adds resource attributes fetched from an external HTTP endpoint, after the
+	// attributes actions are applied.
+	Enrichment configoptional.Optional[EnrichmentConfig] `mapstructure:"enrichment"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -25,8 +32,56 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks if the processor configuration is valid
 func (cfg *Config) Validate() error {
-	if len(cfg.AttributesActions) == 0 {
-		return errors.New("missing required field \"attributes\"")
+	if len(cfg.AttributesActions) == 0 && !cfg.Enrichment.HasValue() {
+		return errors.New("missing required field \"attributes\" or \"enrichment\"")
+	}
+	return nil
+}
+
+// EnrichmentConfig defines how resource attributes are fetched from an external HTTP endpoint.
+type EnrichmentConfig struct {
+	// ClientConfig configures the HTTP client. Its endpoint is a URL template where the names of
+	// resource attributes between braces, such as {host.name}, are replaced with their escaped values.
+	// Resources missing one of the attributes of the template are not enriched.
+	confighttp.ClientConfig `mapstructure:",squash"`
+
+	// Override replaces the resource attributes which already exist with the fetched ones.
+	// By default, only the missing attributes are inserted.
+	Override bool `mapstructure:"override"`
+
+	// CacheTTL is how long the attributes fetched for an endpoint URL are cached.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+
+	// FailureTTL is how long a failed request is cached before it is retried. Until then, the
+	// attributes previously fetched for the URL are used if any, otherwise FallbackAttributes.
+	FailureTTL time.Duration `mapstructure:"failure_ttl"`
+
+	// MaxCacheSize is the maximum number of endpoint URLs whose response is cached.
+	MaxCacheSize int `mapstructure:"max_cache_size"`
+
+	// FallbackAttributes are inserted when the attributes of a resource could not be fetched.
+	FallbackAttributes map[string]string `mapstructure:"fallback_attributes"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// Validate checks if the enrichment configuration is valid
+func (cfg *EnrichmentConfig) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("missing required field \"endpoint\"")
+	}
+	if _, err := parseEndpointTemplate(cfg.Endpoint); err != nil {
+		return err
+	}
+	if cfg.CacheTTL < 0 {
+		return errors.New("\"cache_ttl\" must not be negative")
+	}
+	if cfg.FailureTTL < 0 {
+		return errors.New("\"failure_ttl\" must not be negative")
+	}
+	if cfg.MaxCacheSize <= 0 {
+		return errors.New("\"max_cache_size\" must be positive")
 	}
 	return nil
 }
@@ -6,6 +6,7 @@ package resourceprocessor
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,15 +28,39 @@ func TestLoadConfig(t *testing.T) {
 	}{
 		{
 			id: component.NewIDWithName(metadata.Type, ""),
-			expected: &Config{
-				AttributesActions: []attraction.ActionKeyValue{
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.AttributesActions = []attraction.ActionKeyValue{
 					{Key: "cloud.availability_zone", Value: "zone-1", Action: attraction.UPSERT},
 					{Key: "k8s.cluster.name", FromAttribute: "k8s-cluster", Action: attraction.INSERT},
 					{Key: "redundant-attribute", Action: attraction.DELETE},
-				},
-			},
+				}
+				return cfg
+			}(),
 			valid: true,
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "enrichment"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				enrichment := cfg.Enrichment.GetOrInsertDefault()
+				enrichment.Endpoint = "http://cmdb.example.com/hosts/{host.name}"
+				enrichment.CacheTTL = 10 * time.Minute
+				enrichment.FailureTTL = time.Minute
+				enrichment.FallbackAttributes = map[string]string{"cmdb.status": "unknown"}
+				return cfg
+			}(),
+			valid: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_enrichment"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				enrichment := cfg.Enrichment.GetOrInsertDefault()
+				enrichment.Endpoint = "http://cmdb.example.com/hosts/{host.name"
+				return cfg
+			}(),
+		},
 		{
 			id:       component.NewIDWithName(metadata.Type, "invalid"),
 			expected: createDefaultConfig(),
@@ -0,0 +1,273 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+// maxResponseSize is the maximum size of the responses of the enrichment endpoint.
+const maxResponseSize = 1 << 20
+
+// endpointTemplate is an endpoint URL where the resource attributes between braces are replaced with
+// their values.
+type endpointTemplate struct {
+	// literals are the parts of the URL around the attributes, there is one more literal than attributes.
+	literals   []string
+	attributes []string
+}
+
+func parseEndpointTemplate(endpoint string) (endpointTemplate, error) {
+	var t endpointTemplate
+	rest := endpoint
+	for {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			if strings.IndexByte(rest, '}') >= 0 {
+				return endpointTemplate{}, fmt.Errorf("unexpected '}' in endpoint %q", endpoint)
+			}
+			t.literals = append(t.literals, rest)
+			return t, nil
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return endpointTemplate{}, fmt.Errorf("unclosed '{' in endpoint %q", endpoint)
+		}
+		name := rest[start+1 : start+end]
+		if name == "" || strings.ContainsAny(name, "{") {
+			return endpointTemplate{}, fmt.Errorf("invalid attribute name %q in endpoint %q", name, endpoint)
+		}
+		t.literals = append(t.literals, rest[:start])
+		t.attributes = append(t.attributes, name)
+		rest = rest[start+end+1:]
+	}
+}
+
+// render returns the URL for the given resource attributes, or false if one of the attributes of the
+// template is missing.
+func (t endpointTemplate) render(attrs pcommon.Map) (string, bool) {
+	var sb strings.Builder
+	for i, name := range t.attributes {
+		v, ok := attrs.Get(name)
+		if !ok {
+			return "", false
+		}
+		sb.WriteString(t.literals[i])
+		sb.WriteString(url.PathEscape(v.AsString()))
+	}
+	sb.WriteString(t.literals[len(t.literals)-1])
+	return sb.String(), true
+}
+
+type cacheEntry struct {
+	// attributes are the last attributes fetched for the URL, nil if none could be fetched.
+	attributes map[string]any
+	expires    time.Time
+}
+
+// enricher adds the attributes returned by an HTTP endpoint to resources, caching the responses.
+type enricher struct {
+	cfg       *EnrichmentConfig
+	template  endpointTemplate
+	fallback  map[string]any
+	telemetry component.TelemetrySettings
+	client    *http.Client
+	now       func() time.Time
+
+	mu      sync.Mutex
+	cache   map[string]cacheEntry
+	pending map[string]chan struct{}
+}
+
+func newEnricher(cfg *EnrichmentConfig, telemetry component.TelemetrySettings) (*enricher, error) {
+	template, err := parseEndpointTemplate(cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var fallback map[string]any
+	if len(cfg.FallbackAttributes) != 0 {
+		fallback = make(map[string]any, len(cfg.FallbackAttributes))
+		for k, v := range cfg.FallbackAttributes {
+			fallback[k] = v
+		}
+	}
+	return &enricher{
+		cfg:       cfg,
+		template:  template,
+		fallback:  fallback,
+		telemetry: telemetry,
+		now:       time.Now,
+		cache:     map[string]cacheEntry{},
+		pending:   map[string]chan struct{}{},
+	}, nil
+}
+
+func (e *enricher) start(ctx context.Context, host component.Host) error {
+	client, err := e.cfg.ToClient(ctx, host.GetExtensions(), e.telemetry)
+	if err != nil {
+		return err
+	}
+	e.client = client
+	return nil
+}
+
+func (e *enricher) shutdown() {
+	if e.client != nil {
+		e.client.CloseIdleConnections()
+	}
+}
+
+// enrich adds the attributes fetched for the resource with the given attributes.
+func (e *enricher) enrich(ctx context.Context, attrs pcommon.Map) {
+	u, ok := e.template.render(attrs)
+	if !ok {
+		return
+	}
+	fetched := e.lookup(ctx, u)
+	if fetched == nil {
+		fetched = e.fallback
+	}
+	for k, v := range fetched {
+		if _, exists := attrs.Get(k); exists && !e.cfg.Override {
+			continue
+		}
+		if err := attrs.PutEmpty(k).FromRaw(v); err != nil {
+			e.telemetry.Logger.Debug("Failed to set a fetched resource attribute", zap.String("key", k), zap.Error(err))
+		}
+	}
+}
+
+// lookup returns the attributes for the URL from the cache, fetching them if they are missing or
+// expired. Concurrent lookups of the same URL wait for a single request.
+func (e *enricher) lookup(ctx context.Context, u string) map[string]any {
+	e.mu.Lock()
+	for {
+		entry, ok := e.cache[u]
+		if ok && e.now().Before(entry.expires) {
+			e.mu.Unlock()
+			return entry.attributes
+		}
+		done, fetching := e.pending[u]
+		if !fetching {
+			break
+		}
+		e.mu.Unlock()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return entry.attributes
+		}
+		e.mu.Lock()
+	}
+	done := make(chan struct{})
+	e.pending[u] = done
+	e.mu.Unlock()
+
+	attributes, err := e.fetch(ctx, u)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.pending, u)
+	close(done)
+	entry := cacheEntry{attributes: attributes, expires: e.now().Add(e.cfg.CacheTTL)}
+	if err != nil {
+		e.telemetry.Logger.Warn("Failed to fetch the resource attributes", zap.String("url", u), zap.Error(err))
+		// Keep using the attributes previously fetched, if any.
+		entry = cacheEntry{attributes: e.cache[u].attributes, expires: e.now().Add(e.cfg.FailureTTL)}
+	}
+	e.store(u, entry)
+	return entry.attributes
+}
+
+// store adds the entry to the cache, evicting the expired entries, or another one, if it is full.
+func (e *enricher) store(u string, entry cacheEntry) {
+	if _, ok := e.cache[u]; !ok && len(e.cache) >= e.cfg.MaxCacheSize {
+		now := e.now()
+		for k, v := range e.cache {
+			if !now.Before(v.expires) {
+				delete(e.cache, k)
+			}
+		}
+		for k := range e.cache {
+			if len(e.cache) < e.cfg.MaxCacheSize {
+				break
+			}
+			delete(e.cache, k)
+		}
+	}
+	e.cache[u] = entry
+}
+
+// fetch requests the attributes from the URL, which must return a JSON object.
+func (e *enricher) fetch(ctx context.Context, u string) (map[string]any, error) {
+	if e.client == nil {
+		return nil, errors.New("the processor is not started")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize))
+	decoder.UseNumber()
+	var body map[string]any
+	if err = decoder.Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode the response: %w", err)
+	}
+	if body == nil {
+		return nil, errors.New("the response is not a JSON object")
+	}
+	attributes := make(map[string]any, len(body))
+	for k, v := range body {
+		attributes[k] = fromJSON(v)
+	}
+	return attributes, nil
+}
+
+// fromJSON converts a decoded JSON value to a value supported by pcommon.Value.FromRaw.
+func fromJSON(v any) any {
+	switch val := v.(type) {
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		f, _ := val.Float64()
+		return f
+	case []any:
+		for i := range val {
+			val[i] = fromJSON(val[i])
+		}
+		return val
+	case map[string]any:
+		for k := range val {
+			val[k] = fromJSON(val[k])
+		}
+		return val
+	default:
+		return v
+	}
+}
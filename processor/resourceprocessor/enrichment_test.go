@@ -0,0 +1,232 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceprocessor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor/internal/metadata"
+)
+
+func TestParseEndpointTemplate(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		attrs    map[string]any
+		expected string
+		rendered bool
+		err      string
+	}{
+		{
+			endpoint: "http://cmdb/hosts",
+			expected: "http://cmdb/hosts",
+			rendered: true,
+		},
+		{
+			endpoint: "http://cmdb/{k8s.cluster.name}/hosts/{host.name}?env={env}",
+			attrs:    map[string]any{"k8s.cluster.name": "prod", "host.name": "a/b c", "env": int64(1)},
+			expected: "http://cmdb/prod/hosts/a%2Fb%20c?env=1",
+			rendered: true,
+		},
+		{
+			endpoint: "http://cmdb/hosts/{host.name}",
+			attrs:    map[string]any{"k8s.cluster.name": "prod"},
+		},
+		{
+			endpoint: "http://cmdb/hosts/{host.name",
+			err:      `unclosed '{' in endpoint "http://cmdb/hosts/{host.name"`,
+		},
+		{
+			endpoint: "http://cmdb/hosts/host.name}",
+			err:      `unexpected '}' in endpoint "http://cmdb/hosts/host.name}"`,
+		},
+		{
+			endpoint: "http://cmdb/hosts/{}",
+			err:      `invalid attribute name "" in endpoint "http://cmdb/hosts/{}"`,
+		},
+		{
+			endpoint: "http://cmdb/hosts/{{host.name}}",
+			err:      `invalid attribute name "{host.name" in endpoint "http://cmdb/hosts/{{host.name}}"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			template, err := parseEndpointTemplate(tt.endpoint)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			attrs := pcommon.NewMap()
+			require.NoError(t, attrs.FromRaw(tt.attrs))
+			rendered, ok := template.render(attrs)
+			assert.Equal(t, tt.rendered, ok)
+			assert.Equal(t, tt.expected, rendered)
+		})
+	}
+}
+
+type enrichmentTest struct {
+	t        *testing.T
+	requests atomic.Int64
+	failing  atomic.Bool
+	now      time.Time
+	proc     *resourceProcessor
+}
+
+func newEnrichmentTest(t *testing.T, configure func(*EnrichmentConfig)) *enrichmentTest {
+	et := &enrichmentTest{t: t, now: time.Unix(1000, 0)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		et.requests.Add(1)
+		if et.failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		switch r.URL.Path {
+		case "/hosts/host-1":
+			_, _ = w.Write([]byte(`{"owner": "team-a", "tier": 1, "cpu": 1.5, "critical": true, "tags": ["a", "b"]}`))
+		case "/hosts/host-2":
+			_, _ = w.Write([]byte(`{"owner": "team-b"}`))
+		case "/hosts/invalid":
+			_, _ = w.Write([]byte(`["not", "an", "object"]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := createDefaultConfig().(*Config)
+	enrichment := cfg.Enrichment.GetOrInsertDefault()
+	enrichment.Endpoint = server.URL + "/hosts/{host.name}"
+	if configure != nil {
+		configure(enrichment)
+	}
+
+	proc, err := newResourceProcessor(processortest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, err)
+	proc.enricher.now = func() time.Time { return et.now }
+	require.NoError(t, proc.start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, proc.shutdown(context.Background()))
+	})
+	et.proc = proc
+	return et
+}
+
+// process returns the attributes of a resource with the given attributes once processed.
+func (et *enrichmentTest) process(attrs map[string]any) map[string]any {
+	td := ptrace.NewTraces()
+	require.NoError(et.t, td.ResourceSpans().AppendEmpty().Resource().Attributes().FromRaw(attrs))
+	td, err := et.proc.processTraces(et.t.Context(), td)
+	require.NoError(et.t, err)
+	return td.ResourceSpans().At(0).Resource().Attributes().AsRaw()
+}
+
+func TestEnrichment(t *testing.T) {
+	et := newEnrichmentTest(t, nil)
+
+	expected := map[string]any{
+		"host.name": "host-1",
+		"owner":     "team-a",
+		"tier":      int64(1),
+		"cpu":       1.5,
+		"critical":  true,
+		"tags":      []any{"a", "b"},
+	}
+	assert.Equal(t, expected, et.process(map[string]any{"host.name": "host-1"}))
+	// The response is cached.
+	assert.Equal(t, expected, et.process(map[string]any{"host.name": "host-1"}))
+	assert.Equal(t, int64(1), et.requests.Load())
+
+	// Existing attributes are not overridden.
+	assert.Equal(t, map[string]any{"host.name": "host-2", "owner": "team-c"}, et.process(map[string]any{"host.name": "host-2", "owner": "team-c"}))
+	assert.Equal(t, int64(2), et.requests.Load())
+
+	// Resources without the attributes of the template are not enriched.
+	assert.Equal(t, map[string]any{"service.name": "svc"}, et.process(map[string]any{"service.name": "svc"}))
+	assert.Equal(t, int64(2), et.requests.Load())
+
+	// The response is fetched again once expired.
+	et.now = et.now.Add(5 * time.Minute)
+	assert.Equal(t, expected, et.process(map[string]any{"host.name": "host-1"}))
+	assert.Equal(t, int64(3), et.requests.Load())
+}
+
+func TestEnrichmentOverride(t *testing.T) {
+	et := newEnrichmentTest(t, func(cfg *EnrichmentConfig) {
+		cfg.Override = true
+	})
+	assert.Equal(t, map[string]any{"host.name": "host-2", "owner": "team-b"}, et.process(map[string]any{"host.name": "host-2", "owner": "team-c"}))
+}
+
+func TestEnrichmentFailure(t *testing.T) {
+	et := newEnrichmentTest(t, func(cfg *EnrichmentConfig) {
+		cfg.FallbackAttributes = map[string]string{"owner": "unknown"}
+	})
+
+	// Failed requests use the fallback attributes, and are cached for the failure TTL.
+	assert.Equal(t, map[string]any{"host.name": "host-3", "owner": "unknown"}, et.process(map[string]any{"host.name": "host-3"}))
+	assert.Equal(t, map[string]any{"host.name": "host-3", "owner": "unknown"}, et.process(map[string]any{"host.name": "host-3"}))
+	assert.Equal(t, int64(1), et.requests.Load())
+	assert.Equal(t, map[string]any{"host.name": "invalid", "owner": "unknown"}, et.process(map[string]any{"host.name": "invalid"}))
+	assert.Equal(t, int64(2), et.requests.Load())
+
+	// The attributes previously fetched are used when the refresh fails.
+	assert.Equal(t, map[string]any{"host.name": "host-2", "owner": "team-b"}, et.process(map[string]any{"host.name": "host-2"}))
+	et.failing.Store(true)
+	et.now = et.now.Add(5 * time.Minute)
+	assert.Equal(t, map[string]any{"host.name": "host-2", "owner": "team-b"}, et.process(map[string]any{"host.name": "host-2"}))
+	assert.Equal(t, int64(4), et.requests.Load())
+
+	// The failed request is retried after the failure TTL.
+	et.failing.Store(false)
+	et.now = et.now.Add(30 * time.Second)
+	assert.Equal(t, map[string]any{"host.name": "host-3", "owner": "unknown"}, et.process(map[string]any{"host.name": "host-3"}))
+	assert.Equal(t, int64(5), et.requests.Load())
+}
+
+func TestEnrichmentCacheSize(t *testing.T) {
+	et := newEnrichmentTest(t, func(cfg *EnrichmentConfig) {
+		cfg.MaxCacheSize = 1
+	})
+	et.process(map[string]any{"host.name": "host-1"})
+	et.process(map[string]any{"host.name": "host-2"})
+	assert.Len(t, et.proc.enricher.cache, 1)
+	et.process(map[string]any{"host.name": "host-1"})
+	assert.Equal(t, int64(3), et.requests.Load())
+}
+
+func TestEnrichmentProcessor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"owner": "team-a"}`))
+	}))
+	defer server.Close()
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Enrichment.GetOrInsertDefault().Endpoint = server.URL + "/hosts/{host.name}"
+	sink := new(consumertest.TracesSink)
+	tp, err := factory.CreateTraces(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, tp.Shutdown(context.Background())) }()
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("host.name", "host-1")
+	require.NoError(t, tp.ConsumeTraces(t.Context(), td))
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, map[string]any{"host.name": "host-1", "owner": "team-a"}, sink.AllTraces()[0].ResourceSpans().At(0).Resource().Attributes().AsRaw())
+}
@@ -5,8 +5,11 @@ package resourceprocessor // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/xconsumer"
 	"go.opentelemetry.io/collector/processor"
@@ -14,7 +17,6 @@ import (
 	"go.opentelemetry.io/collector/processor/processorhelper/xprocessorhelper"
 	"go.opentelemetry.io/collector/processor/xprocessor"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor/internal/metadata"
 )
 
@@ -34,7 +36,16 @@ func NewFactory() processor.Factory {
 
 // Note: This isn't a valid configuration because the processor would do no work.
 func createDefaultConfig() component.Config {
-	return &Config{}
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Timeout = 5 * time.Second
+	return &Config{
+		Enrichment: configoptional.Default(EnrichmentConfig{
+			ClientConfig: clientConfig,
+			CacheTTL:     5 * time.Minute,
+			FailureTTL:   30 * time.Second,
+			MaxCacheSize: 10000,
+		}),
+	}
 }
 
 func createTracesProcessor(
@@ -43,18 +54,19 @@ func createTracesProcessor(
 	cfg component.Config,
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
-	attrProc, err := attraction.NewAttrProc(&attraction.Settings{Actions: cfg.(*Config).AttributesActions})
+	proc, err := newResourceProcessor(set, cfg.(*Config))
 	if err != nil {
 		return nil, err
 	}
-	proc := &resourceProcessor{logger: set.Logger, attrProc: attrProc}
 	return processorhelper.NewTraces(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
 		proc.processTraces,
-		processorhelper.WithCapabilities(processorCapabilities))
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(proc.start),
+		processorhelper.WithShutdown(proc.shutdown))
 }
 
 func createMetricsProcessor(
@@ -63,18 +75,19 @@ func createMetricsProcessor(
 	cfg component.Config,
 	nextConsumer consumer.Metrics,
 ) (processor.Metrics, error) {
-	attrProc, err := attraction.NewAttrProc(&attraction.Settings{Actions: cfg.(*Config).AttributesActions})
+	proc, err := newResourceProcessor(set, cfg.(*Config))
 	if err != nil {
 		return nil, err
 	}
-	proc := &resourceProcessor{logger: set.Logger, attrProc: attrProc}
 	return processorhelper.NewMetrics(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
 		proc.processMetrics,
-		processorhelper.WithCapabilities(processorCapabilities))
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(proc.start),
+		processorhelper.WithShutdown(proc.shutdown))
 }
 
 func createLogsProcessor(
@@ -83,18 +96,19 @@ func createLogsProcessor(
 	cfg component.Config,
 	nextConsumer consumer.Logs,
 ) (processor.Logs, error) {
-	attrProc, err := attraction.NewAttrProc(&attraction.Settings{Actions: cfg.(*Config).AttributesActions})
+	proc, err := newResourceProcessor(set, cfg.(*Config))
 	if err != nil {
 		return nil, err
 	}
-	proc := &resourceProcessor{logger: set.Logger, attrProc: attrProc}
 	return processorhelper.NewLogs(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
 		proc.processLogs,
-		processorhelper.WithCapabilities(processorCapabilities))
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(proc.start),
+		processorhelper.WithShutdown(proc.shutdown))
 }
 
 func createProfilesProcessor(
@@ -103,16 +117,17 @@ func createProfilesProcessor(
 	cfg component.Config,
 	nextConsumer xconsumer.Profiles,
 ) (xprocessor.Profiles, error) {
-	attrProc, err := attraction.NewAttrProc(&attraction.Settings{Actions: cfg.(*Config).AttributesActions})
+	proc, err := newResourceProcessor(set, cfg.(*Config))
 	if err != nil {
 		return nil, err
 	}
-	proc := resourceProcessor{logger: set.Logger, attrProc: attrProc}
 	return xprocessorhelper.NewProfiles(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
 		proc.processProfiles,
-		xprocessorhelper.WithCapabilities(processorCapabilities))
+		xprocessorhelper.WithCapabilities(processorCapabilities),
+		xprocessorhelper.WithStart(proc.start),
+		xprocessorhelper.WithShutdown(proc.shutdown))
 }
@@ -6,10 +6,13 @@ package resourceprocessor // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"context"
 
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
@@ -18,12 +21,49 @@ import (
 type resourceProcessor struct {
 	logger   *zap.Logger
 	attrProc *attraction.AttrProc
+	enricher *enricher
+}
+
+func newResourceProcessor(set processor.Settings, cfg *Config) (*resourceProcessor, error) {
+	attrProc, err := attraction.NewAttrProc(&attraction.Settings{Actions: cfg.AttributesActions})
+	if err != nil {
+		return nil, err
+	}
+	rp := &resourceProcessor{logger: set.Logger, attrProc: attrProc}
+	if cfg.Enrichment.HasValue() {
+		rp.enricher, err = newEnricher(cfg.Enrichment.Get(), set.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rp, nil
+}
+
+func (rp *resourceProcessor) start(ctx context.Context, host component.Host) error {
+	if rp.enricher == nil {
+		return nil
+	}
+	return rp.enricher.start(ctx, host)
+}
+
+func (rp *resourceProcessor) shutdown(context.Context) error {
+	if rp.enricher != nil {
+		rp.enricher.shutdown()
+	}
+	return nil
+}
+
+func (rp *resourceProcessor) processResource(ctx context.Context, attrs pcommon.Map) {
+	rp.attrProc.Process(ctx, rp.logger, attrs)
+	if rp.enricher != nil {
+		rp.enricher.enrich(ctx, attrs)
+	}
 }
 
 func (rp *resourceProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 	rss := td.ResourceSpans()
 	for i := 0; i < rss.Len(); i++ {
-		rp.attrProc.Process(ctx, rp.logger, rss.At(i).Resource().Attributes())
+		rp.processResource(ctx, rss.At(i).Resource().Attributes())
 	}
 	return td, nil
 }
@@ -31,7 +71,7 @@ func (rp *resourceProcessor) processTraces(ctx context.Context, td ptrace.Traces
 func (rp *resourceProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	rms := md.ResourceMetrics()
 	for i := 0; i < rms.Len(); i++ {
-		rp.attrProc.Process(ctx, rp.logger, rms.At(i).Resource().Attributes())
+		rp.processResource(ctx, rms.At(i).Resource().Attributes())
 	}
 	return md, nil
 }
@@ -39,7 +79,7 @@ func (rp *resourceProcessor) processMetrics(ctx context.Context, md pmetric.Metr
 func (rp *resourceProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
 	rls := ld.ResourceLogs()
 	for i := 0; i < rls.Len(); i++ {
-		rp.attrProc.Process(ctx, rp.logger, rls.At(i).Resource().Attributes())
+		rp.processResource(ctx, rls.At(i).Resource().Attributes())
 	}
 	return ld, nil
 }
@@ -47,7 +87,7 @@ func (rp *resourceProcessor) processLogs(ctx context.Context, ld plog.Logs) (plo
 func (rp *resourceProcessor) processProfiles(ctx context.Context, pd pprofile.Profiles) (pprofile.Profiles, error) {
 	rps := pd.ResourceProfiles()
 	for i := 0; i < rps.Len(); i++ {
-		rp.attrProc.Process(ctx, rp.logger, rps.At(i).Resource().Attributes())
+		rp.processResource(ctx, rps.At(i).Resource().Attributes())
 	}
 	return pd, nil
 }
@@ -13,8 +13,9 @@ import (
 )
 
 var (
-	errRequiresTargets  = errors.New("requires schema targets")
-	errDuplicateTargets = errors.New("duplicate targets detected")
+	errRequiresTargets         = errors.New("requires schema targets")
+	errDuplicateTargets        = errors.New("duplicate targets detected")
+	errOfflineRequiresCacheDir = errors.New("offline mode requires a cache directory")
 )
 
 // Config defines the user provided values for the Schema Processor
@@ -31,6 +32,17 @@ type Config struct {
 	// translated to, allowing older and newer formats
 	// to conform to the target schema identifier.
 	Targets []string `mapstructure:"targets"`
+
+	// CacheDirectory is a directory where the schema files
+	// are stored once fetched, so they are not fetched again
+	// after a restart. The schema files in the directory are
+	// used instead of fetching them. (Optional field)
+	CacheDirectory string `mapstructure:"cache_directory"`
+
+	// Offline prevents the processor from fetching schema files,
+	// only the schema files in the CacheDirectory are used.
+	// (Optional field)
+	Offline bool `mapstructure:"offline"`
 }
 
 func (c *Config) Validate() error {
@@ -59,5 +71,9 @@ func (c *Config) Validate() error {
 		families[family] = struct{}{}
 	}
 
+	if c.Offline && c.CacheDirectory == "" {
+		return errOfflineRequiresCacheDir
+	}
+
 	return nil
 }
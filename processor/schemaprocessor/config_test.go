@@ -41,6 +41,20 @@ func TestLoadConfig(t *testing.T) {
 			"https://example.com/otel/schemas/1.2.0",
 		},
 	}, cfg)
+
+	cfg = factory.CreateDefaultConfig()
+	sub, err = cm.Sub(component.NewIDWithName(metadata.Type, "offline").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	assert.Equal(t, &Config{
+		ClientConfig: confighttp.NewDefaultClientConfig(),
+		Targets: []string{
+			"https://opentelemetry.io/schemas/1.26.0",
+		},
+		CacheDirectory: "/var/lib/otelcol/schemas",
+		Offline:        true,
+	}, cfg)
 }
 
 func TestConfigurationValidation(t *testing.T) {
@@ -49,6 +63,8 @@ func TestConfigurationValidation(t *testing.T) {
 	tests := []struct {
 		scenario    string
 		target      []string
+		cacheDir    string
+		offline     bool
 		expectError error
 	}{
 		{scenario: "No targets", target: nil, expectError: errRequiresTargets},
@@ -77,11 +93,26 @@ func TestConfigurationValidation(t *testing.T) {
 			},
 			expectError: errDuplicateTargets,
 		},
+		{
+			scenario:    "Offline without cache directory",
+			target:      []string{"https://opentelemetry.io/schemas/1.9.0"},
+			offline:     true,
+			expectError: errOfflineRequiresCacheDir,
+		},
+		{
+			scenario:    "Offline with cache directory",
+			target:      []string{"https://opentelemetry.io/schemas/1.9.0"},
+			cacheDir:    "schemas",
+			offline:     true,
+			expectError: nil,
+		},
 	}
 
 	for _, tc := range tests {
 		cfg := &Config{
-			Targets: tc.target,
+			Targets:        tc.target,
+			CacheDirectory: tc.cacheDir,
+			Offline:        tc.offline,
 		}
 
 		assert.ErrorIs(t, xconfmap.Validate(cfg), tc.expectError, tc.scenario)
@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/transformer"
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
+)
+
+// MetricSplit is a transformer that powers the [Metric's split] change.  Unlike the other transformers,
+// it acts on all the metrics of a scope since it creates and removes metrics.
+// Applying it moves the data points of the split metric to a new metric per value of the attribute,
+// removing the attribute; rolling it back merges the data points back into the split metric.
+// [Metric's split]: https://opentelemetry.io/docs/specs/otel/schemas/file_format_v1.1.0/#split-metric-transformation
+type MetricSplit struct {
+	// ApplyToMetric is the name of the metric that is split.
+	ApplyToMetric string
+	// ByAttribute is the attribute of the data points which determines the new metric.
+	ByAttribute string
+	// MetricsFromAttributes maps the name of the new metrics to the value of the attribute.
+	MetricsFromAttributes map[string]any
+}
+
+func (MetricSplit) IsMigrator() {}
+
+func (c MetricSplit) Do(ss migrate.StateSelector, metrics pmetric.MetricSlice) error {
+	if ss == migrate.StateSelectorApply {
+		return c.split(metrics)
+	}
+	return c.merge(metrics)
+}
+
+func (c MetricSplit) split(metrics pmetric.MetricSlice) error {
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != c.ApplyToMetric {
+			continue
+		}
+		for _, name := range slices.Sorted(maps.Keys(c.MetricsFromAttributes)) {
+			value := c.MetricsFromAttributes[name]
+			// The new metric is only created once a data point matches.
+			var target pmetric.Metric
+			created := false
+			newTarget := func() pmetric.Metric {
+				if !created {
+					target = metrics.AppendEmpty()
+					copyMetricDefinition(metric, target, name)
+					created = true
+				}
+				return target
+			}
+			matches := func(attrs pcommon.Map) bool {
+				v, ok := attrs.Get(c.ByAttribute)
+				return ok && v.AsString() == fmt.Sprint(value)
+			}
+			if err := moveDataPoints(metric, newTarget, matches, func(attrs pcommon.Map) {
+				attrs.Remove(c.ByAttribute)
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	metrics.RemoveIf(func(metric pmetric.Metric) bool {
+		return metric.Name() == c.ApplyToMetric && dataPointsLen(metric) == 0
+	})
+	return nil
+}
+
+func (c MetricSplit) merge(metrics pmetric.MetricSlice) error {
+	var merged pmetric.Metric
+	found := false
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == c.ApplyToMetric {
+			merged, found = metrics.At(i), true
+			break
+		}
+	}
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		value, ok := c.MetricsFromAttributes[metric.Name()]
+		if !ok {
+			continue
+		}
+		if !found {
+			merged = metrics.AppendEmpty()
+			copyMetricDefinition(metric, merged, c.ApplyToMetric)
+			found = true
+		}
+		if merged.Type() != metric.Type() {
+			return fmt.Errorf("cannot merge metric %q of type %s into metric %q of type %s", metric.Name(), metric.Type(), c.ApplyToMetric, merged.Type())
+		}
+		if err := moveDataPoints(metric, func() pmetric.Metric { return merged }, func(pcommon.Map) bool { return true }, func(attrs pcommon.Map) {
+			// Values which are not supported by pcommon are written as strings.
+			if err := attrs.PutEmpty(c.ByAttribute).FromRaw(value); err != nil {
+				attrs.PutStr(c.ByAttribute, fmt.Sprint(value))
+			}
+		}); err != nil {
+			return err
+		}
+	}
+	metrics.RemoveIf(func(metric pmetric.Metric) bool {
+		_, ok := c.MetricsFromAttributes[metric.Name()]
+		return ok && dataPointsLen(metric) == 0
+	})
+	return nil
+}
+
+// copyMetricDefinition sets the type and metadata of the source metric to the destination one, without its data points.
+func copyMetricDefinition(src, dest pmetric.Metric, name string) {
+	dest.SetName(name)
+	dest.SetDescription(src.Description())
+	dest.SetUnit(src.Unit())
+	src.Metadata().CopyTo(dest.Metadata())
+	switch src.Type() {
+	case pmetric.MetricTypeGauge:
+		dest.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		dest.SetEmptySum().SetAggregationTemporality(src.Sum().AggregationTemporality())
+		dest.Sum().SetIsMonotonic(src.Sum().IsMonotonic())
+	case pmetric.MetricTypeHistogram:
+		dest.SetEmptyHistogram().SetAggregationTemporality(src.Histogram().AggregationTemporality())
+	case pmetric.MetricTypeExponentialHistogram:
+		dest.SetEmptyExponentialHistogram().SetAggregationTemporality(src.ExponentialHistogram().AggregationTemporality())
+	case pmetric.MetricTypeSummary:
+		dest.SetEmptySummary()
+	}
+}
+
+type dataPoint[DP any] interface {
+	Attributes() pcommon.Map
+	CopyTo(dest DP)
+}
+
+// moveDataPoints moves the data points of the metric which match to the destination metric, updating their attributes.
+func moveDataPoints(metric pmetric.Metric, dest func() pmetric.Metric, match func(pcommon.Map) bool, update func(pcommon.Map)) error {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		moveDataPointsOf(metric.Gauge().DataPoints(), func() pmetric.NumberDataPoint { return dest().Gauge().DataPoints().AppendEmpty() }, match, update)
+	case pmetric.MetricTypeSum:
+		moveDataPointsOf(metric.Sum().DataPoints(), func() pmetric.NumberDataPoint { return dest().Sum().DataPoints().AppendEmpty() }, match, update)
+	case pmetric.MetricTypeHistogram:
+		moveDataPointsOf(metric.Histogram().DataPoints(), func() pmetric.HistogramDataPoint { return dest().Histogram().DataPoints().AppendEmpty() }, match, update)
+	case pmetric.MetricTypeExponentialHistogram:
+		moveDataPointsOf(metric.ExponentialHistogram().DataPoints(), func() pmetric.ExponentialHistogramDataPoint {
+			return dest().ExponentialHistogram().DataPoints().AppendEmpty()
+		}, match, update)
+	case pmetric.MetricTypeSummary:
+		moveDataPointsOf(metric.Summary().DataPoints(), func() pmetric.SummaryDataPoint { return dest().Summary().DataPoints().AppendEmpty() }, match, update)
+	default:
+		return fmt.Errorf("unsupported metric type %s", metric.Type())
+	}
+	return nil
+}
+
+func moveDataPointsOf[DP dataPoint[DP]](from interface{ RemoveIf(func(DP) bool) }, appendTo func() DP, match func(pcommon.Map) bool, update func(pcommon.Map)) {
+	from.RemoveIf(func(dp DP) bool {
+		if !match(dp.Attributes()) {
+			return false
+		}
+		moved := appendTo()
+		dp.CopyTo(moved)
+		update(moved.Attributes())
+		return true
+	})
+}
+
+func dataPointsLen(metric pmetric.Metric) int {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		return metric.Gauge().DataPoints().Len()
+	case pmetric.MetricTypeSum:
+		return metric.Sum().DataPoints().Len()
+	case pmetric.MetricTypeHistogram:
+		return metric.Histogram().DataPoints().Len()
+	case pmetric.MetricTypeExponentialHistogram:
+		return metric.ExponentialHistogram().DataPoints().Len()
+	case pmetric.MetricTypeSummary:
+		return metric.Summary().DataPoints().Len()
+	}
+	return 0
+}
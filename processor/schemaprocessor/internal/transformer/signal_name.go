@@ -4,6 +4,7 @@
 package transformer // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/transformer"
 
 import (
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
@@ -38,3 +39,33 @@ func (c MetricSignalNameChange) Do(ss migrate.StateSelector, metric pmetric.Metr
 	c.SignalNameChange.Do(ss, metric)
 	return nil
 }
+
+// LogEventSignalNameChange is an transformer that applies the [Span Event's rename_events] change to
+// log-based events, which are the log records with an event name.
+// [Span Event's rename_events]: https://opentelemetry.io/docs/specs/otel/schemas/file_format_v1.1.0/#rename_events-transformation
+type LogEventSignalNameChange struct {
+	SignalNameChange migrate.SignalNameChange
+}
+
+func (LogEventSignalNameChange) IsMigrator() {}
+
+func (c LogEventSignalNameChange) Do(ss migrate.StateSelector, log plog.LogRecord) error {
+	if log.EventName() == "" {
+		return nil
+	}
+	c.SignalNameChange.Do(ss, logEvent{log})
+	return nil
+}
+
+// logEvent exposes the event name of a log record as its name.
+type logEvent struct {
+	log plog.LogRecord
+}
+
+func (e logEvent) Name() string {
+	return e.log.EventName()
+}
+
+func (e logEvent) SetName(name string) {
+	e.log.SetEventName(name)
+}
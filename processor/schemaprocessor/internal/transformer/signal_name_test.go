@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
@@ -32,3 +33,15 @@ func TestMetricSignalNameChangeTransformer(t *testing.T) {
 	require.NoError(t, c.Do(migrate.StateSelectorApply, s))
 	require.Equal(t, "event_name", s.Name())
 }
+
+func TestLogEventSignalNameChangeTransformer(t *testing.T) {
+	l := plog.NewLogRecord()
+	l.SetEventName("event.name")
+	c := LogEventSignalNameChange{SignalNameChange: migrate.NewSignalNameChange(map[string]string{
+		"event.name": "event_name",
+	})}
+	require.NoError(t, c.Do(migrate.StateSelectorApply, l))
+	require.Equal(t, "event_name", l.EventName())
+	require.NoError(t, c.Do(migrate.StateSelectorRollback, l))
+	require.Equal(t, "event.name", l.EventName())
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+
+	"go.uber.org/zap"
+)
+
+type directoryProvider struct {
+	dir  string
+	next Provider
+	log  *zap.Logger
+}
+
+var _ Provider = (*directoryProvider)(nil)
+
+// NewDirectoryProvider creates a Provider that reads the schema files from a directory,
+// where a schema URL like https://opentelemetry.io/schemas/1.9.0 is stored in the file
+// opentelemetry.io/schemas/1.9.0.
+// The schema files missing from the directory are retrieved with the next provider,
+// and stored in the directory for later use. If next is nil, only the directory is used.
+func NewDirectoryProvider(dir string, next Provider, log *zap.Logger) Provider {
+	return &directoryProvider{dir: dir, next: next, log: log}
+}
+
+func (dp *directoryProvider) Retrieve(ctx context.Context, schemaURL string) (string, error) {
+	file, err := dp.schemaFile(schemaURL)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(file)
+	switch {
+	case err == nil:
+		return string(data), nil
+	case !errors.Is(err, fs.ErrNotExist):
+		return "", fmt.Errorf("failed to read schema file: %w", err)
+	case dp.next == nil:
+		return "", fmt.Errorf("schema file %q does not exist", file)
+	}
+
+	content, err := dp.next.Retrieve(ctx, schemaURL)
+	if err != nil {
+		return "", err
+	}
+	if err := writeFileAtomically(file, []byte(content)); err != nil {
+		dp.log.Warn("Failed to store the schema file",
+			zap.String("schema-url", schemaURL),
+			zap.String("path", file),
+			zap.Error(err),
+		)
+	}
+	return content, nil
+}
+
+// schemaFile returns the path of the file of the schema URL in the directory.
+func (dp *directoryProvider) schemaFile(schemaURL string) (string, error) {
+	u, err := url.Parse(schemaURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid schema URL: %w", err)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("schema URL %q has no host", schemaURL)
+	}
+	// Cleaning the path as an absolute one prevents it from leaving the directory.
+	return filepath.Join(dp.dir, filepath.FromSlash(path.Clean("/"+u.Host+"/"+u.Path))), nil
+}
+
+func writeFileAtomically(file string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0o750); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
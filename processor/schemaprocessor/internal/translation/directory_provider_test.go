@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type countingProvider struct {
+	calls   int
+	content string
+	err     error
+}
+
+func (p *countingProvider) Retrieve(_ context.Context, _ string) (string, error) {
+	p.calls++
+	return p.content, p.err
+}
+
+func TestDirectoryProvider(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	next := &countingProvider{content: "file_format: 1.1.0"}
+	p := NewDirectoryProvider(dir, next, zaptest.NewLogger(t))
+
+	// The schema file is retrieved and stored in the directory.
+	content, err := p.Retrieve(t.Context(), "https://opentelemetry.io/schemas/1.9.0")
+	require.NoError(t, err)
+	assert.Equal(t, "file_format: 1.1.0", content)
+	data, err := os.ReadFile(filepath.Join(dir, "opentelemetry.io", "schemas", "1.9.0"))
+	require.NoError(t, err)
+	assert.Equal(t, "file_format: 1.1.0", string(data))
+
+	// The stored schema file is used afterwards.
+	next.err = errors.New("offline")
+	content, err = p.Retrieve(t.Context(), "https://opentelemetry.io/schemas/1.9.0")
+	require.NoError(t, err)
+	assert.Equal(t, "file_format: 1.1.0", content)
+	assert.Equal(t, 1, next.calls)
+
+	_, err = p.Retrieve(t.Context(), "https://opentelemetry.io/schemas/1.10.0")
+	assert.EqualError(t, err, "offline")
+}
+
+func TestDirectoryProviderOffline(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "example.com", "schemas"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "example.com", "schemas", "1.0.0"), []byte("file_format: 1.1.0"), 0o600))
+	p := NewDirectoryProvider(dir, nil, zaptest.NewLogger(t))
+
+	content, err := p.Retrieve(t.Context(), "https://example.com/schemas/1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "file_format: 1.1.0", content)
+
+	_, err = p.Retrieve(t.Context(), "https://example.com/schemas/1.1.0")
+	assert.ErrorContains(t, err, "does not exist")
+
+	// Schema URLs can't refer to files outside of the directory.
+	_, err = p.Retrieve(t.Context(), "https://../schemas/1.0.0")
+	assert.ErrorContains(t, err, filepath.Join(dir, "schemas", "1.0.0"))
+
+	_, err = p.Retrieve(t.Context(), "/schemas/1.0.0")
+	assert.EqualError(t, err, `schema URL "/schemas/1.0.0" has no host`)
+}
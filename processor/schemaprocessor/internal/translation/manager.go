@@ -91,16 +91,22 @@ func (m *manager) RequestTranslation(ctx context.Context, schemaURL string) (Tra
 	t, exists := m.translatorMap[family]
 	m.rw.RUnlock()
 
-	if exists && t.SupportedVersion(version) {
+	if exists && t.SupportedVersion(version) && t.SupportedVersion(targetTranslation) {
 		return t, nil
 	}
 
+	// A schema file contains all the versions up to its own, so the file of the newest
+	// version between the signal's and the target's allows to translate in both directions.
+	fetchURL := schemaURL
+	if version.LessThan(targetTranslation) {
+		fetchURL = joinSchemaFamilyAndVersion(family, targetTranslation)
+	}
 	for _, p := range m.providers {
-		content, err := p.Retrieve(ctx, schemaURL)
+		content, err := p.Retrieve(ctx, fetchURL)
 		if err != nil {
 			m.log.Error("Failed to lookup schemaURL",
 				zap.Error(err),
-				zap.String("schemaURL", schemaURL),
+				zap.String("schemaURL", fetchURL),
 			)
 			// If we fail to retrieve the schema, we should
 			// try the next provider
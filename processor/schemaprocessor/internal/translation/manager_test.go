@@ -86,3 +86,36 @@ func TestManagerError(t *testing.T) {
 	assert.Error(t, err, "Must error when provider errors")
 	assert.Nil(t, tr, "Must not return a translation")
 }
+
+type recordingProvider struct {
+	content   string
+	requested []string
+}
+
+func (p *recordingProvider) Retrieve(_ context.Context, schemaURL string) (string, error) {
+	p.requested = append(p.requested, schemaURL)
+	return p.content, nil
+}
+
+func TestRequestTranslationRetrievesNewestVersion(t *testing.T) {
+	t.Parallel()
+
+	p := &recordingProvider{content: LoadTranslationVersion(t, "complex_changeset.yml")}
+	m, err := NewManager(
+		[]string{"https://example.com/1.7.0"},
+		zaptest.NewLogger(t),
+		p,
+	)
+	require.NoError(t, err, "Must not error when created manager")
+
+	// Upgrading requires the schema file of the target.
+	tn, err := m.RequestTranslation(t.Context(), "https://example.com/1.0.0")
+	require.NoError(t, err, "Must not error when requesting a valid schema URL")
+	assert.True(t, tn.SupportedVersion(&Version{1, 7, 0}), "Must support the target version")
+	assert.Equal(t, []string{"https://example.com/1.7.0"}, p.requested)
+
+	// The translation is reused for the versions it supports.
+	_, err = m.RequestTranslation(t.Context(), "https://example.com/1.4.0")
+	require.NoError(t, err, "Must not error when requesting a valid schema URL")
+	assert.Equal(t, []string{"https://example.com/1.7.0"}, p.requested)
+}
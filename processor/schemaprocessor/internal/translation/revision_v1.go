@@ -13,8 +13,7 @@ import (
 )
 
 // RevisionV1 represents all changes that are to be applied to a signal at a given version.  V1 represents the fact
-// that this struct only support the Schema Files version 1.0 - not 1.1 which contains split.
-// todo(ankit) implement split and rest of otel schema
+// that this struct supports the Schema Files version 1.x.
 type RevisionV1 struct {
 	ver        *Version
 	all        *changelist.ChangeList
@@ -22,7 +21,11 @@ type RevisionV1 struct {
 	spans      *changelist.ChangeList
 	spanEvents *changelist.ChangeList
 	metrics    *changelist.ChangeList
-	logs       *changelist.ChangeList
+	// metricSplits are applied to all the metrics of a scope, after the metrics changes.
+	metricSplits []transformer.MetricSplit
+	logs         *changelist.ChangeList
+	// logEvents applies the span events renames to log-based events.
+	logEvents *changelist.ChangeList
 }
 
 // NewRevision processes the VersionDef and assigns the version to this revision
@@ -32,13 +35,15 @@ type RevisionV1 struct {
 // Generics would be handy here.
 func NewRevision(ver *Version, def ast11.VersionDef) *RevisionV1 {
 	return &RevisionV1{
-		ver:        ver,
-		all:        newAllChangeList(def.All),
-		resources:  newResourceChangeList(def.Resources),
-		spans:      newSpanChangeList(def.Spans),
-		spanEvents: newSpanEventChangeList(def.SpanEvents),
-		metrics:    newMetricChangeList(def.Metrics),
-		logs:       newLogsChangelist(def.Logs),
+		ver:          ver,
+		all:          newAllChangeList(def.All),
+		resources:    newResourceChangeList(def.Resources),
+		spans:        newSpanChangeList(def.Spans),
+		spanEvents:   newSpanEventChangeList(def.SpanEvents),
+		metrics:      newMetricChangeList(def.Metrics),
+		metricSplits: newMetricSplits(def.Metrics),
+		logs:         newLogsChangelist(def.Logs),
+		logEvents:    newLogEventChangeList(def.SpanEvents),
 	}
 }
 
@@ -94,14 +99,28 @@ func newMetricChangeList(metrics ast11.Metrics) *changelist.ChangeList {
 			signalNameChange := transformer.MetricSignalNameChange{SignalNameChange: migrate.NewSignalNameChange(renamedMetrics)}
 			values = append(values, signalNameChange)
 		}
-		if splitMetrics := at.Split; splitMetrics != nil {
-			// TODO: Implement split
-			continue
-		}
 	}
 	return &changelist.ChangeList{Migrators: values}
 }
 
+func newMetricSplits(metrics ast11.Metrics) []transformer.MetricSplit {
+	var splits []transformer.MetricSplit
+	for _, at := range metrics.Changes {
+		if split := at.Split; split != nil {
+			metricsFromAttributes := make(map[string]any, len(split.MetricsFromAttributes))
+			for name, value := range split.MetricsFromAttributes {
+				metricsFromAttributes[string(name)] = value
+			}
+			splits = append(splits, transformer.MetricSplit{
+				ApplyToMetric:         string(split.ApplyToMetric),
+				ByAttribute:           string(split.ByAttribute),
+				MetricsFromAttributes: metricsFromAttributes,
+			})
+		}
+	}
+	return splits
+}
+
 func newSpanEventChangeList(spanEvents ast10.SpanEvents) *changelist.ChangeList {
 	values := make([]migrate.Migrator, 0)
 	for _, at := range spanEvents.Changes {
@@ -143,3 +162,14 @@ func newLogsChangelist(logs ast10.Logs) *changelist.ChangeList {
 	}
 	return &changelist.ChangeList{Migrators: values}
 }
+
+func newLogEventChangeList(spanEvents ast10.SpanEvents) *changelist.ChangeList {
+	values := make([]migrate.Migrator, 0)
+	for _, at := range spanEvents.Changes {
+		if renamedEvent := at.RenameEvents; renamedEvent != nil {
+			signalNameChange := migrate.NewSignalNameChange(renamedEvent.EventNameMap)
+			values = append(values, transformer.LogEventSignalNameChange{SignalNameChange: signalNameChange})
+		}
+	}
+	return &changelist.ChangeList{Migrators: values}
+}
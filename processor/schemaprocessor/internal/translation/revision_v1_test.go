@@ -9,6 +9,7 @@ import (
 	ast10 "go.opentelemetry.io/otel/schema/v1.0/ast"
 	"go.opentelemetry.io/otel/schema/v1.0/types"
 	ast11 "go.opentelemetry.io/otel/schema/v1.1/ast"
+	types11 "go.opentelemetry.io/otel/schema/v1.1/types"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/changelist"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
@@ -36,6 +37,7 @@ func TestNewRevisionV1(t *testing.T) {
 				spanEvents: &changelist.ChangeList{Migrators: make([]migrate.Migrator, 0)},
 				metrics:    &changelist.ChangeList{Migrators: make([]migrate.Migrator, 0)},
 				logs:       &changelist.ChangeList{Migrators: make([]migrate.Migrator, 0)},
+				logEvents:  &changelist.ChangeList{Migrators: make([]migrate.Migrator, 0)},
 			},
 		},
 		{
@@ -144,6 +146,16 @@ func TestNewRevisionV1(t *testing.T) {
 								},
 							},
 						},
+						{
+							Split: &ast11.SplitMetric{
+								ApplyToMetric: "system.paging.operations",
+								ByAttribute:   "direction",
+								MetricsFromAttributes: map[types.MetricName]types11.AttributeValue{
+									"system.paging.operations.in":  "in",
+									"system.paging.operations.out": "out",
+								},
+							},
+						},
 					},
 				},
 			},
@@ -248,6 +260,16 @@ func TestNewRevisionV1(t *testing.T) {
 						"service.runtime",
 					)},
 				}},
+				metricSplits: []transformer.MetricSplit{
+					{
+						ApplyToMetric: "system.paging.operations",
+						ByAttribute:   "direction",
+						MetricsFromAttributes: map[string]any{
+							"system.paging.operations.in":  "in",
+							"system.paging.operations.out": "out",
+						},
+					},
+				},
 				logs: &changelist.ChangeList{Migrators: []migrate.Migrator{
 					transformer.LogAttributes{
 						AttributeChange: migrate.NewAttributeChangeSet(map[string]string{
@@ -255,6 +277,13 @@ func TestNewRevisionV1(t *testing.T) {
 						}),
 					},
 				}},
+				logEvents: &changelist.ChangeList{Migrators: []migrate.Migrator{
+					transformer.LogEventSignalNameChange{
+						SignalNameChange: migrate.NewSignalNameChange(map[string]string{
+							"started": "application started",
+						}),
+					},
+				}},
 			},
 		},
 	} {
@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/alias"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
 )
 
 // Translation defines the complete abstraction of schema translation file
@@ -194,7 +195,15 @@ func (t *translator) ApplyScopeLogChanges(scopeLogs plog.ScopeLogs, inSchemaURL
 				if err != nil {
 					return err
 				}
+				err = rev.logEvents.Apply(log)
+				if err != nil {
+					return err
+				}
 			case Revert:
+				err = rev.logEvents.Rollback(log)
+				if err != nil {
+					return err
+				}
 				err = rev.logs.Rollback(log)
 				if err != nil {
 					return err
@@ -262,8 +271,8 @@ func (t *translator) ApplyScopeSpanChanges(scopeSpans ptrace.ScopeSpans, inSchem
 				}
 			}
 		}
-		scopeSpans.SetSchemaUrl(t.targetSchemaURL)
 	}
+	scopeSpans.SetSchemaUrl(t.targetSchemaURL)
 	return nil
 }
 
@@ -274,6 +283,14 @@ func (t *translator) ApplyScopeMetricChanges(scopeMetrics pmetric.ScopeMetrics,
 	}
 	it, status := t.iterator(ver)
 	for rev, more := it(); more; rev, more = it() {
+		if status == Revert {
+			// The splits are reverted first since they were applied last.
+			for s := len(rev.metricSplits) - 1; s >= 0; s-- {
+				if err := rev.metricSplits[s].Do(migrate.StateSelectorRollback, scopeMetrics.Metrics()); err != nil {
+					return err
+				}
+			}
+		}
 		for i := 0; i < scopeMetrics.Metrics().Len(); i++ {
 			metric := scopeMetrics.Metrics().At(i)
 			switch status {
@@ -293,6 +310,13 @@ func (t *translator) ApplyScopeMetricChanges(scopeMetrics pmetric.ScopeMetrics,
 				}
 			}
 		}
+		if status == Update {
+			for _, split := range rev.metricSplits {
+				if err := split.Do(migrate.StateSelectorApply, scopeMetrics.Metrics()); err != nil {
+					return err
+				}
+			}
+		}
 	}
 	scopeMetrics.SetSchemaUrl(t.targetSchemaURL)
 	return nil
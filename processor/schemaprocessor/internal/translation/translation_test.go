@@ -546,3 +546,95 @@ func BenchmarkUpgradingLogs(b *testing.B) {
 		}
 	}
 }
+
+func newPagingMetrics(split bool) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.SetSchemaUrl("https://example.com/1.0.0")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	if split {
+		sm.SetSchemaUrl("https://example.com/1.1.0")
+	} else {
+		sm.SetSchemaUrl("https://example.com/1.0.0")
+	}
+	newSum := func(name string) pmetric.Metric {
+		m := sm.Metrics().AppendEmpty()
+		m.SetName(name)
+		m.SetUnit("{operation}")
+		m.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		m.Sum().SetIsMonotonic(true)
+		return m
+	}
+	addPoint := func(m pmetric.Metric, value int64, attrs map[string]any) {
+		dp := m.Sum().DataPoints().AppendEmpty()
+		dp.SetIntValue(value)
+		_ = dp.Attributes().FromRaw(attrs)
+	}
+	if split {
+		in := newSum("system.paging.operations.in")
+		addPoint(in, 1, map[string]any{"type": "major"})
+		addPoint(in, 3, map[string]any{"type": "minor"})
+		addPoint(newSum("system.paging.operations.out"), 2, map[string]any{"type": "major"})
+	} else {
+		m := newSum("system.paging.operations")
+		addPoint(m, 1, map[string]any{"direction": "in", "type": "major"})
+		addPoint(m, 2, map[string]any{"direction": "out", "type": "major"})
+		addPoint(m, 3, map[string]any{"direction": "in", "type": "minor"})
+	}
+	addPoint(newSum("system.paging.faults"), 4, map[string]any{"type": "major"})
+	return md
+}
+
+func TestTranslationMetricSplit(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		scenario      string
+		income        Version
+		target        Version
+		split, expect bool
+	}{
+		{scenario: "Upgrade 1.0.0 -> 1.1.0", income: Version{1, 0, 0}, target: Version{1, 1, 0}, split: false, expect: true},
+		{scenario: "Downgrade 1.1.0 -> 1.0.0", income: Version{1, 1, 0}, target: Version{1, 0, 0}, split: true, expect: false},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			tn, err := newTranslator(
+				zaptest.NewLogger(t),
+				joinSchemaFamilyAndVersion("https://example.com/", &tc.target),
+				LoadTranslationVersion(t, "split_changeset.yml"),
+			)
+			require.NoError(t, err, "Must not error creating translator")
+
+			metrics := newPagingMetrics(tc.split)
+			sm := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0)
+			require.NoError(t, tn.ApplyScopeMetricChanges(sm, joinSchemaFamilyAndVersion("https://example.com/", &tc.income)))
+			expect := newPagingMetrics(tc.expect)
+			assert.NoError(t, pmetrictest.CompareMetrics(expect, metrics,
+				pmetrictest.IgnoreMetricsOrder(),
+				pmetrictest.IgnoreMetricDataPointsOrder(),
+			))
+		})
+	}
+}
+
+func TestTranslationLogEvents(t *testing.T) {
+	t.Parallel()
+
+	upgrade, err := newTranslator(zaptest.NewLogger(t), "https://example.com/1.1.0", LoadTranslationVersion(t, "split_changeset.yml"))
+	require.NoError(t, err, "Must not error creating translator")
+	downgrade, err := newTranslator(zaptest.NewLogger(t), "https://example.com/1.0.0", LoadTranslationVersion(t, "split_changeset.yml"))
+	require.NoError(t, err, "Must not error creating translator")
+
+	logs := plog.NewScopeLogs()
+	logs.LogRecords().AppendEmpty().SetEventName("exception")
+	logs.LogRecords().AppendEmpty().Body().SetStr("exception")
+
+	require.NoError(t, upgrade.ApplyScopeLogChanges(logs, "https://example.com/1.0.0"))
+	assert.Equal(t, "error", logs.LogRecords().At(0).EventName())
+	assert.Empty(t, logs.LogRecords().At(1).EventName())
+	assert.Equal(t, "https://example.com/1.1.0", logs.SchemaUrl())
+
+	require.NoError(t, downgrade.ApplyScopeLogChanges(logs, "https://example.com/1.1.0"))
+	assert.Equal(t, "exception", logs.LogRecords().At(0).EventName())
+	assert.Equal(t, "https://example.com/1.0.0", logs.SchemaUrl())
+}
@@ -175,13 +175,20 @@ func (t schemaProcessor) processTraces(ctx context.Context, td ptrace.Traces) (p
 	return td, nil
 }
 
-// start will add HTTP provider to the manager and prefetch schemas
+// start will add the HTTP and cache directory providers to the manager and prefetch schemas
 func (t *schemaProcessor) start(ctx context.Context, host component.Host) error {
-	client, err := t.config.ToClient(ctx, host.GetExtensions(), t.telemetry)
-	if err != nil {
-		return err
+	var provider translation.Provider
+	if !t.config.Offline {
+		client, err := t.config.ToClient(ctx, host.GetExtensions(), t.telemetry)
+		if err != nil {
+			return err
+		}
+		provider = translation.NewHTTPProvider(client)
+	}
+	if t.config.CacheDirectory != "" {
+		provider = translation.NewDirectoryProvider(t.config.CacheDirectory, provider, t.log.Named("schema-cache"))
 	}
-	t.manager.AddProvider(translation.NewHTTPProvider(client))
+	t.manager.AddProvider(provider)
 
 	go func(ctx context.Context) {
 		for _, schemaURL := range t.config.Prefetch {
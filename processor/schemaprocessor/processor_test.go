@@ -7,6 +7,8 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -112,3 +114,46 @@ func TestSchemaProcessorProcessing(t *testing.T) {
 		assert.Equal(t, in, out, "Must return the same data")
 	})
 }
+
+func TestSchemaProcessorOffline(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "example.com", "schemas"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "example.com", "schemas", "1.1.0"), []byte(`
+file_format: 1.1.0
+schema_url: https://example.com/schemas/1.1.0
+versions:
+  1.1.0:
+    all:
+      changes:
+        - rename_attributes:
+            attribute_map:
+              user.id: enduser.id
+  1.0.0:
+`), 0o600))
+
+	cfg := &Config{
+		Targets:        []string{"https://example.com/schemas/1.1.0"},
+		CacheDirectory: dir,
+		Offline:        true,
+	}
+	trans, err := newSchemaProcessor(t.Context(), cfg, processor.Settings{
+		TelemetrySettings: component.TelemetrySettings{
+			Logger: zaptest.NewLogger(t),
+		},
+	})
+	require.NoError(t, err, "Must not error when creating schemaProcessor")
+	require.NoError(t, trans.start(t.Context(), componenttest.NewNopHost()))
+
+	in := plog.NewLogs()
+	rl := in.ResourceLogs().AppendEmpty()
+	rl.SetSchemaUrl("https://example.com/schemas/1.0.0")
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr("user.id", "alice")
+
+	out, err := trans.processLogs(t.Context(), in)
+	require.NoError(t, err, "Must not error when processing logs")
+	sl := out.ResourceLogs().At(0).ScopeLogs().At(0)
+	assert.Equal(t, "https://example.com/schemas/1.1.0", sl.SchemaUrl())
+	assert.Equal(t, map[string]any{"enduser.id": "alice"}, sl.LogRecords().At(0).Attributes().AsRaw())
+}
@@ -14,6 +14,10 @@ import (
 func getNewCompositePolicy(settings component.TelemetrySettings, config *CompositeCfg, policyExtensions map[string]samplingpolicy.Extension) (samplingpolicy.Evaluator, error) {
 	subPolicyEvalParams := make([]sampling.SubPolicyEvalParams, len(config.SubPolicyCfg))
 	rateAllocationsMap := getRateAllocationMap(config)
+	borrowingPolicies := make(map[string]bool)
+	for _, rAlloc := range config.RateAllocation {
+		borrowingPolicies[rAlloc.Policy] = rAlloc.Borrow
+	}
 	for i := range config.SubPolicyCfg {
 		policyCfg := &config.SubPolicyCfg[i]
 		policy, err := getCompositeSubPolicyEvaluator(settings, policyCfg, policyExtensions)
@@ -25,6 +29,7 @@ func getNewCompositePolicy(settings component.TelemetrySettings, config *Composi
 			Evaluator:         policy,
 			MaxSpansPerSecond: int64(rateAllocationsMap[policyCfg.Name]),
 			Name:              policyCfg.Name,
+			Borrow:            borrowingPolicies[policyCfg.Name],
 		}
 		subPolicyEvalParams[i] = evalParams
 	}
@@ -43,6 +43,7 @@ func TestCompositeHelper(t *testing.T) {
 				{
 					Policy:  "test-composite-policy-2",
 					Percent: 0, // will be populated with default
+					Borrow:  true,
 				},
 			},
 		}, nil)
@@ -58,6 +59,7 @@ func TestCompositeHelper(t *testing.T) {
 				Evaluator:         sampling.NewLatency(componenttest.NewNopTelemetrySettings(), 200, 0),
 				MaxSpansPerSecond: 500,
 				Name:              "test-composite-policy-2",
+				Borrow:            true,
 			},
 		}, sampling.MonotonicClock{}, false)
 		assert.Equal(t, expected, actual)
@@ -144,6 +144,9 @@ type CompositeCfg struct {
 type RateAllocationCfg struct {
 	Policy  string `mapstructure:"policy"`
 	Percent int64  `mapstructure:"percent"`
+	// Borrow allows the policy to sample over its allocated rate by borrowing the
+	// rate left unused by the other policies within the same second.
+	Borrow bool `mapstructure:"borrow"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -198,10 +201,25 @@ type ProbabilisticCfg struct {
 	// SamplingPercentage is the percentage rate at which traces are going to be sampled. Defaults to zero, i.e.: no sample.
 	// Values greater or equal 100 are treated as "sample all traces".
 	SamplingPercentage float64 `mapstructure:"sampling_percentage"`
+	// Mode is the sampling mode, either "hash_salt" to hash the trace ID with HashSalt, or "equalizing" to
+	// use the randomness of the W3C trace context and the OpenTelemetry sampling threshold of the tracestate,
+	// consistently with the other OpenTelemetry samplers. Defaults to "hash_salt".
+	Mode ProbabilisticMode `mapstructure:"mode"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
 
+// ProbabilisticMode is the sampling mode of the probabilistic policy.
+type ProbabilisticMode string
+
+const (
+	// ProbabilisticModeHashSalt samples the traces based on the hash of their trace ID and the hash salt.
+	ProbabilisticModeHashSalt ProbabilisticMode = "hash_salt"
+	// ProbabilisticModeEqualizing samples the traces consistently with the OpenTelemetry samplers,
+	// and writes the sampling threshold to the tracestate of the sampled spans.
+	ProbabilisticModeEqualizing ProbabilisticMode = "equalizing"
+)
+
 // StatusCodeCfg holds the configurable settings to create a status code filter sampling
 // policy evaluator.
 type StatusCodeCfg struct {
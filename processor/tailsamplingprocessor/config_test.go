@@ -124,6 +124,13 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name:             "test-policy-13",
+						Type:             Probabilistic,
+						ProbabilisticCfg: ProbabilisticCfg{Mode: ProbabilisticModeEqualizing, SamplingPercentage: 10},
+					},
+				},
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
 						Name: "and-policy-1",
@@ -201,6 +208,7 @@ func TestLoadConfig(t *testing.T) {
 							{
 								Policy:  "test-composite-policy-2",
 								Percent: 25,
+								Borrow:  true,
 							},
 						},
 					},
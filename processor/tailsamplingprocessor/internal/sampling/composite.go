@@ -22,9 +22,22 @@ type subpolicy struct {
 	// spans per second that each subpolicy sampled in this period
 	sampledSPS int64
 
+	// spans per second of the allocation of the subpolicy which were lent
+	// to its siblings in this period
+	lentSPS int64
+
+	// whether the subpolicy can borrow the unused allocation of its siblings
+	borrow bool
+
 	name string
 }
 
+// unusedSPS returns the spans per second of the allocation which are not used
+// in this period.
+func (s *subpolicy) unusedSPS() int64 {
+	return max(s.allocatedSPS-s.sampledSPS-s.lentSPS, 0)
+}
+
 // Composite evaluator and its internal data
 type Composite struct {
 	// the subpolicy evaluators
@@ -50,6 +63,9 @@ type SubPolicyEvalParams struct {
 	Evaluator         samplingpolicy.Evaluator
 	MaxSpansPerSecond int64
 	Name              string
+	// Borrow allows the sub-policy to sample over its rate by using the unused
+	// rate of the other sub-policies.
+	Borrow bool
 }
 
 // NewComposite creates a policy evaluator that samples all subpolicies.
@@ -67,6 +83,7 @@ func NewComposite(
 		sub.evaluator = subPolicyParams[i].Evaluator
 		sub.allocatedSPS = subPolicyParams[i].MaxSpansPerSecond
 		sub.name = subPolicyParams[i].Name
+		sub.borrow = subPolicyParams[i].Borrow
 		// We are just starting, so there is no previous input, set it to 0
 		sub.sampledSPS = 0
 
@@ -98,6 +115,7 @@ func (c *Composite) Evaluate(ctx context.Context, traceID pcommon.TraceID, trace
 		// Reset counters
 		for i := range c.subpolicies {
 			c.subpolicies[i].sampledSPS = 0
+			c.subpolicies[i].lentSPS = 0
 		}
 	}
 
@@ -115,7 +133,8 @@ func (c *Composite) Evaluate(ctx context.Context, traceID pcommon.TraceID, trace
 			spansInSecondIfSampled := sub.sampledSPS + trace.SpanCount
 
 			// Check if the rate will be within the allocated bandwidth.
-			if spansInSecondIfSampled <= sub.allocatedSPS && spansInSecondIfSampled <= c.maxTotalSPS {
+			withinAllocation := spansInSecondIfSampled+sub.lentSPS <= sub.allocatedSPS && spansInSecondIfSampled <= c.maxTotalSPS
+			if withinAllocation || (sub.borrow && c.borrow(sub, trace.SpanCount)) {
 				sub.sampledSPS = spansInSecondIfSampled
 
 				// Let the sampling happen
@@ -135,3 +154,34 @@ func (c *Composite) Evaluate(ctx context.Context, traceID pcommon.TraceID, trace
 
 	return samplingpolicy.NotSampled, nil
 }
+
+// borrow lends the spans missing from the allocation of the subpolicy to sample
+// a trace, out of the unused allocations of its siblings in this period and in
+// the order of the subpolicies. It returns false, without lending anything, if
+// they are not enough or if the total rate would be exceeded.
+func (c *Composite) borrow(borrower *subpolicy, spans int64) bool {
+	var totalSPS, unusedSPS int64
+	for _, sub := range c.subpolicies {
+		totalSPS += sub.sampledSPS
+		if sub != borrower {
+			unusedSPS += sub.unusedSPS()
+		}
+	}
+	missingSPS := spans - borrower.unusedSPS()
+	if totalSPS+spans > c.maxTotalSPS || unusedSPS < missingSPS {
+		return false
+	}
+
+	for _, sub := range c.subpolicies {
+		if missingSPS == 0 {
+			break
+		}
+		if sub == borrower {
+			continue
+		}
+		lent := min(sub.unusedSPS(), missingSPS)
+		sub.lentSPS += lent
+		missingSPS -= lent
+	}
+	return true
+}
@@ -58,7 +58,7 @@ func TestCompositeEvaluatorNotSampled(t *testing.T) {
 	max100 := int64(100)
 	n1 := NewNumericAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", &min0, &max100, false)
 	n2 := NewNumericAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", &min0, &max100, false)
-	c := NewComposite(zap.NewNop(), 1000, []SubPolicyEvalParams{{n1, 100, "eval-1"}, {n2, 100, "eval-2"}}, FakeTimeProvider{}, false)
+	c := NewComposite(zap.NewNop(), 1000, []SubPolicyEvalParams{{n1, 100, "eval-1", false}, {n2, 100, "eval-2", false}}, FakeTimeProvider{}, false)
 
 	trace := createTrace()
 
@@ -77,7 +77,7 @@ func TestCompositeEvaluatorSampled(t *testing.T) {
 	max100 := int64(100)
 	n1 := NewNumericAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", &min0, &max100, false)
 	n2 := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
-	c := NewComposite(zap.NewNop(), 1000, []SubPolicyEvalParams{{n1, 100, "eval-1"}, {n2, 100, "eval-2"}}, FakeTimeProvider{}, false)
+	c := NewComposite(zap.NewNop(), 1000, []SubPolicyEvalParams{{n1, 100, "eval-1", false}, {n2, 100, "eval-2", false}}, FakeTimeProvider{}, false)
 
 	trace := createTrace()
 
@@ -95,7 +95,7 @@ func TestCompositeEvaluatorSampled_RecordSubPolicy(t *testing.T) {
 	max100 := int64(100)
 	n1 := NewNumericAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", &min0, &max100, false)
 	n2 := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
-	c := NewComposite(zap.NewNop(), 1000, []SubPolicyEvalParams{{n1, 100, "eval-1"}, {n2, 100, "eval-2"}}, FakeTimeProvider{}, true)
+	c := NewComposite(zap.NewNop(), 1000, []SubPolicyEvalParams{{n1, 100, "eval-1", false}, {n2, 100, "eval-2", false}}, FakeTimeProvider{}, true)
 
 	trace := newTraceWithKV(traceID, "test-key", 0)
 
@@ -118,7 +118,7 @@ func TestCompositeEvaluator_OverflowAlwaysSampled(t *testing.T) {
 	max100 := int64(100)
 	n1 := NewNumericAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", &min0, &max100, false)
 	n2 := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
-	c := NewComposite(zap.NewNop(), 3, []SubPolicyEvalParams{{n1, 1, "eval-1"}, {n2, 1, "eval-2"}}, timeProvider, false)
+	c := NewComposite(zap.NewNop(), 3, []SubPolicyEvalParams{{n1, 1, "eval-1", false}, {n2, 1, "eval-2", false}}, timeProvider, false)
 
 	trace := newTraceWithKV(traceID, "tag", int64(10))
 
@@ -153,7 +153,7 @@ func TestCompositeEvaluatorSampled_AlwaysSampled(t *testing.T) {
 	max100 := int64(100)
 	n1 := NewNumericAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", &min0, &max100, false)
 	n2 := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
-	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20, "eval-1"}, {n2, 20, "eval-2"}}, FakeTimeProvider{}, false)
+	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20, "eval-1", false}, {n2, 20, "eval-2", false}}, FakeTimeProvider{}, false)
 
 	for i := 1; i <= 10; i++ {
 		trace := createTrace()
@@ -173,7 +173,7 @@ func TestCompositeEvaluatorInverseSampled_AlwaysSampled(t *testing.T) {
 	require.NoError(t, err)
 	n2, err := NewStringAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", []string{"foo"}, false, 0, true)
 	require.NoError(t, err)
-	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20, "eval-1"}, {n2, 20, "eval-2"}}, FakeTimeProvider{}, false)
+	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20, "eval-1", false}, {n2, 20, "eval-2", false}}, FakeTimeProvider{}, false)
 
 	for i := 1; i <= 10; i++ {
 		trace := createTrace()
@@ -193,7 +193,7 @@ func TestCompositeEvaluatorInverseSampled_AlwaysSampled_RecordSubPolicy(t *testi
 	require.NoError(t, err)
 	n2, err := NewStringAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", []string{"foo"}, false, 0, true)
 	require.NoError(t, err)
-	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20, "eval-1"}, {n2, 20, "eval-2"}}, FakeTimeProvider{}, true)
+	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20, "eval-1", false}, {n2, 20, "eval-2", false}}, FakeTimeProvider{}, true)
 
 	for i := 1; i <= 10; i++ {
 		trace := newTraceWithKV(traceID, "test-key", 0)
@@ -215,7 +215,7 @@ func TestCompositeEvaluatorThrottling(t *testing.T) {
 	n1 := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
 	timeProvider := &FakeTimeProvider{second: 0}
 	const totalSPS = 10
-	c := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, totalSPS, "eval-1"}}, timeProvider, false)
+	c := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, totalSPS, "eval-1", false}}, timeProvider, false)
 
 	trace := createTrace()
 
@@ -257,7 +257,7 @@ func TestCompositeEvaluator2SubpolicyThrottling(t *testing.T) {
 	n2 := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
 	timeProvider := &FakeTimeProvider{second: 0}
 	const totalSPS = 10
-	c := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, totalSPS / 2, "eval-1"}, {n2, totalSPS / 2, "eval-2"}}, timeProvider, false)
+	c := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, totalSPS / 2, "eval-1", false}, {n2, totalSPS / 2, "eval-2", false}}, timeProvider, false)
 
 	trace := createTrace()
 
@@ -314,3 +314,60 @@ func TestCompositeEvaluator2SubpolicyThrottling(t *testing.T) {
 		assert.Equal(t, expected, decision)
 	}
 }
+
+func TestCompositeEvaluatorBorrowing(t *testing.T) {
+	min0 := int64(0)
+	max100 := int64(100)
+	n1 := NewNumericAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", &min0, &max100, false)
+	n2 := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
+	timeProvider := &FakeTimeProvider{second: 0}
+	const totalSPS = 10
+	c := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, totalSPS / 2, "eval-1", false}, {n2, totalSPS / 2, "eval-2", true}}, timeProvider, false)
+
+	evaluate := func(trace *samplingpolicy.TraceData, count int, expected samplingpolicy.Decision) {
+		for range count {
+			decision, err := c.Evaluate(t.Context(), traceID, trace)
+			require.NoError(t, err, "Failed to evaluate composite policy: %v", err)
+			require.Equal(t, expected, decision)
+		}
+	}
+	untagged := createTrace()
+	tagged := newTraceWithKV(traceID, "tag", 10)
+
+	// The first subpolicy is idle, so the second one can use the whole bandwidth
+	evaluate(untagged, totalSPS, samplingpolicy.Sampled)
+	evaluate(untagged, 1, samplingpolicy.NotSampled)
+	// The rate lent by the first subpolicy can't be used by itself anymore
+	evaluate(tagged, 1, samplingpolicy.NotSampled)
+
+	timeProvider.second++
+
+	// The second subpolicy borrows 2 spans of the rate of the first one
+	evaluate(untagged, totalSPS/2+2, samplingpolicy.Sampled)
+	evaluate(tagged, totalSPS/2-2, samplingpolicy.Sampled)
+	evaluate(tagged, 1, samplingpolicy.NotSampled)
+	evaluate(untagged, 1, samplingpolicy.NotSampled)
+
+	timeProvider.second++
+
+	// The first subpolicy doesn't borrow the rate left unused by the second one
+	evaluate(tagged, totalSPS/2, samplingpolicy.Sampled)
+	evaluate(tagged, 1, samplingpolicy.NotSampled)
+}
+
+func TestCompositeEvaluatorBorrowingWithinTotal(t *testing.T) {
+	n1 := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
+	n2 := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
+	// The allocations of the subpolicies exceed the total rate
+	c := NewComposite(zap.NewNop(), 8, []SubPolicyEvalParams{{n1, 5, "eval-1", true}, {n2, 5, "eval-2", false}}, FakeTimeProvider{}, false)
+
+	trace := createTrace()
+	for range 8 {
+		decision, err := c.Evaluate(t.Context(), traceID, trace)
+		require.NoError(t, err, "Failed to evaluate composite policy: %v", err)
+		assert.Equal(t, samplingpolicy.Sampled, decision)
+	}
+	decision, err := c.Evaluate(t.Context(), traceID, trace)
+	require.NoError(t, err, "Failed to evaluate composite policy: %v", err)
+	assert.Equal(t, samplingpolicy.NotSampled, decision)
+}
@@ -5,14 +5,19 @@ package sampling // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"hash/fnv"
 	"math"
 	"math/big"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/sampling"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/pkg/samplingpolicy"
 )
 
@@ -72,3 +77,146 @@ func hashTraceID(salt string, b []byte) uint64 {
 	_, _ = hasher.Write(b)
 	return hasher.Sum64()
 }
+
+// equalizingSamplingPrecision is the number of hex digits of the sampling thresholds, as used by
+// default by the probabilistic sampler processor.
+const equalizingSamplingPrecision = 4
+
+// ThresholdSampler is implemented by the policies sampling the traces consistently with the
+// OpenTelemetry samplers, whose sampling threshold is written to the tracestate of the spans.
+type ThresholdSampler interface {
+	// Threshold returns the sampling threshold of the policy.
+	Threshold() sampling.Threshold
+}
+
+type equalizingProbabilisticSampler struct {
+	logger    *zap.Logger
+	threshold sampling.Threshold
+}
+
+var (
+	_ samplingpolicy.Evaluator = (*equalizingProbabilisticSampler)(nil)
+	_ ThresholdSampler         = (*equalizingProbabilisticSampler)(nil)
+)
+
+// NewEqualizingProbabilisticSampler creates a policy evaluator that samples a percentage of
+// traces consistently with the OpenTelemetry samplers: the decision is based on the explicit
+// randomness of the tracestate (`rv`), or on the randomness of the trace ID otherwise. The traces
+// already sampled at a lower probability upstream, as indicated by their threshold (`th`), are
+// sampled without further reducing their probability.
+func NewEqualizingProbabilisticSampler(settings component.TelemetrySettings, samplingPercentage float64) (samplingpolicy.Evaluator, error) {
+	threshold := sampling.AlwaysSampleThreshold
+	if samplingPercentage < 100 {
+		var err error
+		threshold, err = sampling.ProbabilityToThresholdWithPrecision(samplingPercentage/100, equalizingSamplingPrecision)
+		if errors.Is(err, sampling.ErrProbabilityRange) && samplingPercentage <= 0 {
+			threshold, err = sampling.NeverSampleThreshold, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid sampling percentage %v: %w", samplingPercentage, err)
+		}
+	}
+	return &equalizingProbabilisticSampler{
+		logger:    settings.Logger,
+		threshold: threshold,
+	}, nil
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (s *equalizingProbabilisticSampler) Evaluate(_ context.Context, traceID pcommon.TraceID, trace *samplingpolicy.TraceData) (samplingpolicy.Decision, error) {
+	s.logger.Debug("Evaluating spans in equalizing probabilistic filter")
+
+	if s.threshold.ShouldSample(traceRandomness(traceID, trace.ReceivedBatches)) {
+		return samplingpolicy.Sampled, nil
+	}
+	return samplingpolicy.NotSampled, nil
+}
+
+// Threshold returns the sampling threshold of the policy.
+func (s *equalizingProbabilisticSampler) Threshold() sampling.Threshold {
+	return s.threshold
+}
+
+// traceRandomness returns the explicit randomness of the first span of the trace having one, or the
+// randomness of the trace ID.
+func traceRandomness(traceID pcommon.TraceID, td ptrace.Traces) sampling.Randomness {
+	for _, rs := range td.ResourceSpans().All() {
+		for _, ss := range rs.ScopeSpans().All() {
+			for _, span := range ss.Spans().All() {
+				if rnd, ok := spanRandomness(span); ok {
+					return rnd
+				}
+			}
+		}
+	}
+	return sampling.TraceIDToRandomness(traceID)
+}
+
+func spanRandomness(span ptrace.Span) (sampling.Randomness, bool) {
+	raw := span.TraceState().AsRaw()
+	if raw == "" {
+		return sampling.Randomness{}, false
+	}
+	ts, err := sampling.NewW3CTraceState(raw)
+	if err != nil {
+		return sampling.Randomness{}, false
+	}
+	return ts.OTelValue().RValueRandomness()
+}
+
+// UpdateThresholds writes the sampling threshold to the tracestate of the sampled spans. The spans
+// sampled by the given samplers get the lowest of their thresholds, unless they were already
+// sampled at a lower probability. The threshold of the spans sampled only by other policies is
+// removed, as their adjusted count is unknown.
+func UpdateThresholds(td ptrace.Traces, samplers []ThresholdSampler, logger *zap.Logger) {
+	threshold := samplers[0].Threshold()
+	for _, s := range samplers[1:] {
+		if sampling.ThresholdLessThan(s.Threshold(), threshold) {
+			threshold = s.Threshold()
+		}
+	}
+
+	for _, rs := range td.ResourceSpans().All() {
+		for _, ss := range rs.ScopeSpans().All() {
+			for _, span := range ss.Spans().All() {
+				if err := updateThreshold(span, threshold); err != nil {
+					logger.Debug("Failed to update the sampling threshold of the span", zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+func updateThreshold(span ptrace.Span, threshold sampling.Threshold) error {
+	ts, err := sampling.NewW3CTraceState(span.TraceState().AsRaw())
+	if err != nil {
+		return err
+	}
+	otts := ts.OTelValue()
+	rnd, ok := otts.RValueRandomness()
+	if !ok {
+		rnd = sampling.TraceIDToRandomness(span.TraceID())
+	}
+
+	incoming, hasIncoming := otts.TValueThreshold()
+	switch {
+	case !threshold.ShouldSample(rnd):
+		if !hasIncoming {
+			return nil
+		}
+		otts.ClearTValue()
+	case hasIncoming && !sampling.ThresholdLessThan(incoming, threshold):
+		return nil
+	default:
+		if err = otts.UpdateTValueWithSampling(threshold); err != nil {
+			return err
+		}
+	}
+
+	var w strings.Builder
+	if err = ts.Serialize(&w); err != nil {
+		return err
+	}
+	span.TraceState().FromRaw(w.String())
+	return nil
+}
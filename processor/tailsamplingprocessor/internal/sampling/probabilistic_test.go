@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
@@ -105,3 +106,112 @@ func genRandomTraceIDs(num int) (ids []pcommon.TraceID) {
 	}
 	return ids
 }
+
+func TestEqualizingProbabilisticSampling(t *testing.T) {
+	tests := []struct {
+		name                       string
+		samplingPercentage         float64
+		expectedSamplingPercentage float64
+	}{
+		{"100%", 100, 100},
+		{"0%", 0, 0},
+		{"25%", 25, 25},
+		{"33%", 33, 33},
+		{"-%50", -50, 0},
+		{"150%", 150, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			traceCount := 100_000
+
+			sampler, err := NewEqualizingProbabilisticSampler(componenttest.NewNopTelemetrySettings(), tt.samplingPercentage)
+			require.NoError(t, err)
+
+			sampled := 0
+			for _, traceID := range genRandomTraceIDs(traceCount) {
+				trace := newTraceStringAttrs(nil, "example", "value")
+
+				decision, err := sampler.Evaluate(t.Context(), traceID, trace)
+				assert.NoError(t, err)
+
+				if decision == samplingpolicy.Sampled {
+					sampled++
+				}
+			}
+
+			effectiveSamplingPercentage := float32(sampled) / float32(traceCount) * 100
+			assert.InDelta(t, tt.expectedSamplingPercentage, effectiveSamplingPercentage, 0.2,
+				"Effective sampling percentage is %f, expected %f", effectiveSamplingPercentage, tt.expectedSamplingPercentage,
+			)
+		})
+	}
+}
+
+func TestEqualizingProbabilisticSamplingExplicitRandomness(t *testing.T) {
+	sampler, err := NewEqualizingProbabilisticSampler(componenttest.NewNopTelemetrySettings(), 25)
+	require.NoError(t, err)
+
+	// The trace ID alone would always be sampled.
+	traceID := pcommon.TraceID([16]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+
+	trace := newTraceStringAttrs(nil, "example", "value")
+	decision, err := sampler.Evaluate(t.Context(), traceID, trace)
+	require.NoError(t, err)
+	assert.Equal(t, samplingpolicy.Sampled, decision)
+
+	trace.ReceivedBatches.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceState().FromRaw("ot=rv:00000000000000")
+	decision, err = sampler.Evaluate(t.Context(), traceID, trace)
+	require.NoError(t, err)
+	assert.Equal(t, samplingpolicy.NotSampled, decision)
+}
+
+func TestUpdateThresholds(t *testing.T) {
+	tests := []struct {
+		name       string
+		tracestate string
+		expected   string
+	}{
+		{
+			name:       "no incoming threshold",
+			tracestate: "ot=rv:ffffffffffffff",
+			expected:   "ot=rv:ffffffffffffff;th:8",
+		},
+		{
+			name:       "higher incoming probability",
+			tracestate: "ot=rv:ffffffffffffff;th:4",
+			expected:   "ot=rv:ffffffffffffff;th:8",
+		},
+		{
+			name:       "lower incoming probability",
+			tracestate: "ot=rv:ffffffffffffff;th:c",
+			expected:   "ot=rv:ffffffffffffff;th:c",
+		},
+		{
+			name:       "not sampled by the threshold",
+			tracestate: "ot=rv:00000000000000;th:8",
+			expected:   "ot=rv:00000000000000",
+		},
+		{
+			name:       "other vendors",
+			tracestate: "vendor=value,ot=rv:ffffffffffffff",
+			expected:   "ot=rv:ffffffffffffff;th:8,vendor=value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := componenttest.NewNopTelemetrySettings()
+			s25, err := NewEqualizingProbabilisticSampler(set, 25)
+			require.NoError(t, err)
+			s50, err := NewEqualizingProbabilisticSampler(set, 50)
+			require.NoError(t, err)
+
+			trace := newTraceStringAttrs(nil, "example", "value")
+			span := trace.ReceivedBatches.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+			span.TraceState().FromRaw(tt.tracestate)
+
+			// The spans are sampled at the highest probability of the policies.
+			UpdateThresholds(trace.ReceivedBatches, []ThresholdSampler{s50.(ThresholdSampler), s25.(ThresholdSampler)}, set.Logger)
+			assert.Equal(t, tt.expected, span.TraceState().AsRaw())
+		})
+	}
+}
@@ -369,7 +369,14 @@ func getSharedPolicyEvaluator(settings component.TelemetrySettings, cfg *sharedP
 		return sampling.NewNumericAttributeFilter(settings, nafCfg.Key, minValuePtr, maxValuePtr, nafCfg.InvertMatch), nil
 	case Probabilistic:
 		pCfg := cfg.ProbabilisticCfg
-		return sampling.NewProbabilisticSampler(settings, pCfg.HashSalt, pCfg.SamplingPercentage), nil
+		switch pCfg.Mode {
+		case "", ProbabilisticModeHashSalt:
+			return sampling.NewProbabilisticSampler(settings, pCfg.HashSalt, pCfg.SamplingPercentage), nil
+		case ProbabilisticModeEqualizing:
+			return sampling.NewEqualizingProbabilisticSampler(settings, pCfg.SamplingPercentage)
+		default:
+			return nil, fmt.Errorf("unknown probabilistic sampling mode %q", pCfg.Mode)
+		}
 	case StringAttribute:
 		safCfg := cfg.StringAttributeCfg
 		return sampling.NewStringAttributeFilter(settings, safCfg.Key, safCfg.Values, safCfg.EnabledRegexMatching, safCfg.CacheMaxSize, safCfg.InvertMatch)
@@ -530,6 +537,7 @@ func (tsp *tailSamplingSpanProcessor) processCachedTrace(traceID pcommon.TraceID
 			}
 			sampling.SetBoolAttrOnScopeSpans(traceTd, "tailsampling.cached_decision", true)
 		}
+		tsp.updateThresholds(traceTd)
 		tsp.forwardSpans(tsp.ctx, traceTd)
 		tsp.telemetry.ProcessorTailSamplingEarlyReleasesFromCacheDecision.
 			Add(tsp.ctx, spanCount, attrSampledTrue)
@@ -832,6 +840,7 @@ func (tsp *tailSamplingSpanProcessor) processTrace(id pcommon.TraceID, rss ptrac
 	case samplingpolicy.Sampled:
 		traceTd := ptrace.NewTraces()
 		appendToTraces(traceTd, rss)
+		tsp.updateThresholds(traceTd)
 		tsp.forwardSpans(tsp.ctx, traceTd)
 	case samplingpolicy.NotSampled:
 		tsp.releaseNotSampledTrace(id, actualData.policyName)
@@ -896,11 +905,28 @@ func (tsp *tailSamplingSpanProcessor) forwardSpans(ctx context.Context, td ptrac
 	}
 }
 
+// updateThresholds writes the sampling thresholds of the equalizing probabilistic policies to the
+// tracestate of the sampled spans, so that the downstream consistent samplers and backends know
+// their adjusted count.
+func (tsp *tailSamplingSpanProcessor) updateThresholds(td ptrace.Traces) {
+	var samplers []sampling.ThresholdSampler
+	for _, p := range tsp.policies {
+		if ts, ok := p.evaluator.(sampling.ThresholdSampler); ok {
+			samplers = append(samplers, ts)
+		}
+	}
+	if len(samplers) == 0 {
+		return
+	}
+	sampling.UpdateThresholds(td, samplers, tsp.logger)
+}
+
 // releaseSampledTrace sends the trace data to the next consumer. It
 // additionally adds the trace ID to the cache of sampled trace IDs. If the
 // trace ID is cached, it deletes the spans from the internal map.
 func (tsp *tailSamplingSpanProcessor) releaseSampledTrace(ctx context.Context, id pcommon.TraceID, td ptrace.Traces, policyName string) {
 	tsp.sampledIDCache.Put(id, cache.DecisionMetadata{PolicyName: policyName})
+	tsp.updateThresholds(td)
 	tsp.forwardSpans(ctx, td)
 	_, ok := tsp.sampledIDCache.Get(id)
 	if ok {
@@ -1258,6 +1258,56 @@ func TestRootReceivedBatcher(t *testing.T) {
 	assert.Greater(t, len(allSampledTraces), len(traceIDs)*4/10)
 }
 
+func TestEqualizingProbabilisticThreshold(t *testing.T) {
+	controller := newTestTSPController()
+
+	traces := ptrace.NewTraces()
+	ss := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty()
+	sampled := ss.Spans().AppendEmpty()
+	sampled.SetTraceID(pcommon.TraceID([16]byte{1, 2, 3, 4}))
+	sampled.TraceState().FromRaw("ot=rv:ffffffffffffff")
+	notSampled := ss.Spans().AppendEmpty()
+	notSampled.SetTraceID(pcommon.TraceID([16]byte{1, 2, 3, 5}))
+	notSampled.TraceState().FromRaw("ot=rv:00000000000000")
+
+	cfg := Config{
+		DecisionWait:            defaultTestDecisionWait,
+		NumTraces:               uint64(4),
+		ExpectedNewTracesPerSec: 64,
+		PolicyCfgs: []PolicyCfg{
+			{sharedPolicyCfg: sharedPolicyCfg{
+				Name: "test-policy",
+				Type: Probabilistic,
+				ProbabilisticCfg: ProbabilisticCfg{
+					Mode:               ProbabilisticModeEqualizing,
+					SamplingPercentage: 50,
+				},
+			}},
+		},
+		Options: []Option{
+			withTestController(controller),
+		},
+	}
+	nextConsumer := new(consumertest.TracesSink)
+	processor, err := newTracesProcessor(t.Context(), processortest.NewNopSettings(metadata.Type), nextConsumer, cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, processor.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, processor.Shutdown(t.Context()))
+	}()
+
+	require.NoError(t, processor.ConsumeTraces(t.Context(), traces))
+	controller.waitForTick()
+	controller.waitForTick()
+
+	allSampledTraces := nextConsumer.AllTraces()
+	require.Len(t, allSampledTraces, 1)
+	span := allSampledTraces[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, sampled.TraceID(), span.TraceID())
+	assert.Equal(t, "ot=rv:ffffffffffffff;th:8", span.TraceState().AsRaw())
+}
+
 func TestExtension(t *testing.T) {
 	controller := newTestTSPController()
 	msp := new(consumertest.TracesSink)
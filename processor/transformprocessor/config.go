@@ -6,6 +6,7 @@ package transformprocessor // import "github.com/open-telemetry/opentelemetry-co
 import (
 	"errors"
 	"fmt"
+	"os"
 	"reflect"
 
 	"go.opentelemetry.io/collector/component"
@@ -13,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/featuregate"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
@@ -31,6 +33,11 @@ var (
 		featuregate.WithRegisterReferenceURL("https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/32080#issuecomment-2120764953"),
 	)
 	errFlatLogsGateDisabled = errors.New("'flatten_data' requires the 'transform.flatten.logs' feature gate to be enabled")
+
+	// lintLogger logs the lint warnings found when the configuration is validated. The configuration is
+	// validated before the logger of the collector is created, so they are written to the standard error.
+	lintLogger = zap.New(zapcore.NewCore(
+		zapcore.NewConsoleEncoder(zap.NewProductionEncoderConfig()), zapcore.Lock(os.Stderr), zapcore.WarnLevel))
 )
 
 // Config defines the configuration for the processor.
@@ -200,5 +207,48 @@ func (c *Config) Validate() error {
 		errors = multierr.Append(errors, errFlatLogsGateDisabled)
 	}
 
+	if errors == nil {
+		c.logLintWarnings(lintLogger)
+	}
+
 	return errors
 }
+
+// logLintWarnings logs the potential issues found in the statements and conditions, which don't prevent
+// them from being executed. They are linted with the functions of the context of their group, so that
+// the functions deprecated in this context are reported.
+func (c *Config) logLintWarnings(logger *zap.Logger) {
+	for _, cs := range c.TraceStatements {
+		if cs.Context == common.SpanEvent {
+			logContextLintWarnings(logger, cs, c.spanEventFunctions)
+		} else {
+			logContextLintWarnings(logger, cs, c.spanFunctions)
+		}
+	}
+	for _, cs := range c.MetricStatements {
+		if cs.Context == common.DataPoint {
+			logContextLintWarnings(logger, cs, c.dataPointFunctions)
+		} else {
+			logContextLintWarnings(logger, cs, c.metricFunctions)
+		}
+	}
+	for _, cs := range c.LogStatements {
+		logContextLintWarnings(logger, cs, c.logFunctions)
+	}
+	for _, cs := range c.ProfileStatements {
+		logContextLintWarnings(logger, cs, c.profileFunctions)
+	}
+}
+
+func logContextLintWarnings[K any](logger *zap.Logger, cs common.ContextStatements, functions map[string]ottl.Factory[K]) {
+	// Invalid statements and conditions are reported when they are parsed.
+	warnings, _ := ottl.LintStatements(cs.Statements, functions, ottl.WithLintContext(string(cs.Context)))
+	conditionWarnings, _ := ottl.LintConditions(cs.Conditions, functions, ottl.WithLintContext(string(cs.Context)))
+	for _, w := range append(warnings, conditionWarnings...) {
+		logger.Warn("Potential issue in OTTL statement",
+			zap.String("statement", w.Source),
+			zap.String("rule", string(w.Rule)),
+			zap.String("issue", w.Message),
+		)
+	}
+}
@@ -4,6 +4,7 @@
 package transformprocessor
 
 import (
+	"context"
 	"errors"
 	"path/filepath"
 	"testing"
@@ -13,8 +14,12 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
 )
@@ -405,3 +410,42 @@ func Test_MixedConfigurationStyles(t *testing.T) {
 	require.NoError(t, err)
 	assert.ErrorContains(t, sub.Unmarshal(cfg), "configuring multiple configuration styles is not supported")
 }
+
+func TestValidateLintWarnings(t *testing.T) {
+	createOldFunc := func(ottl.FunctionContext, ottl.Arguments) (ottl.ExprFunc[*ottlmetric.TransformContext], error) {
+		return func(context.Context, *ottlmetric.TransformContext) (any, error) {
+			return nil, nil
+		}, nil
+	}
+	factory := NewFactoryWithOptions(WithMetricFunctionsNew([]ottl.Factory[*ottlmetric.TransformContext]{
+		ottl.NewFactory("old_func", nil, createOldFunc, ottl.WithDeprecation[*ottlmetric.TransformContext]("use set instead")),
+	}))
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.MetricStatements = []common.ContextStatements{
+		{
+			Context:    "metric",
+			Statements: []string{`old_func()`},
+		},
+		{
+			Context: "datapoint",
+			Statements: []string{
+				`set(attributes["test"], "pass") where metric.name == "operationA"`,
+				`set(attributes["id"], UUID())`,
+			},
+		},
+	}
+
+	core, logs := observer.New(zapcore.WarnLevel)
+	prevLogger := lintLogger
+	lintLogger = zap.New(core)
+	defer func() { lintLogger = prevLogger }()
+
+	require.NoError(t, xconfmap.Validate(cfg))
+	require.Equal(t, 2, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, `old_func()`, fields["statement"])
+	assert.Equal(t, string(ottl.LintRuleDeprecatedFunction), fields["rule"])
+	fields = logs.All()[1].ContextMap()
+	assert.Equal(t, `set(attributes["id"], UUID())`, fields["statement"])
+	assert.Equal(t, string(ottl.LintRuleHighCardinality), fields["rule"])
+}
@@ -62,7 +62,7 @@ type LogParserCollectionOption ottl.ParserCollectionOption[LogsConsumer]
 
 func WithLogParser(functions map[string]ottl.Factory[*ottllog.TransformContext]) LogParserCollectionOption {
 	return func(pc *ottl.ParserCollection[LogsConsumer]) error {
-		logParser, err := ottllog.NewParser(functions, pc.Settings, ottllog.EnablePathContextNames())
+		logParser, err := ottllog.NewParser(functions, pc.Settings, ottllog.EnablePathContextNames(), ottl.EnableCacheScopes[*ottllog.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -102,7 +102,7 @@ func convertLogStatements(pc *ottl.ParserCollection[LogsConsumer], statements ot
 	if contextStatements.ErrorMode != "" {
 		errorMode = contextStatements.ErrorMode
 	}
-	var parserOptions []ottl.Option[*ottllog.TransformContext]
+	parserOptions := []ottl.Option[*ottllog.TransformContext]{ottl.EnableCacheScopes[*ottllog.TransformContext]()}
 	if contextStatements.Context == "" {
 		parserOptions = append(parserOptions, ottllog.EnablePathContextNames())
 	}
@@ -183,7 +183,7 @@ type MetricParserCollectionOption ottl.ParserCollectionOption[MetricsConsumer]
 
 func WithMetricParser(functions map[string]ottl.Factory[*ottlmetric.TransformContext]) MetricParserCollectionOption {
 	return func(pc *ottl.ParserCollection[MetricsConsumer]) error {
-		metricParser, err := ottlmetric.NewParser(functions, pc.Settings, ottlmetric.EnablePathContextNames())
+		metricParser, err := ottlmetric.NewParser(functions, pc.Settings, ottlmetric.EnablePathContextNames(), ottl.EnableCacheScopes[*ottlmetric.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -193,7 +193,7 @@ func WithMetricParser(functions map[string]ottl.Factory[*ottlmetric.TransformCon
 
 func WithDataPointParser(functions map[string]ottl.Factory[*ottldatapoint.TransformContext]) MetricParserCollectionOption {
 	return func(pc *ottl.ParserCollection[MetricsConsumer]) error {
-		dataPointParser, err := ottldatapoint.NewParser(functions, pc.Settings, ottldatapoint.EnablePathContextNames())
+		dataPointParser, err := ottldatapoint.NewParser(functions, pc.Settings, ottldatapoint.EnablePathContextNames(), ottl.EnableCacheScopes[*ottldatapoint.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -233,7 +233,7 @@ func convertMetricStatements(pc *ottl.ParserCollection[MetricsConsumer], stateme
 	if contextStatements.ErrorMode != "" {
 		errorMode = contextStatements.ErrorMode
 	}
-	var parserOptions []ottl.Option[*ottlmetric.TransformContext]
+	parserOptions := []ottl.Option[*ottlmetric.TransformContext]{ottl.EnableCacheScopes[*ottlmetric.TransformContext]()}
 	if contextStatements.Context == "" {
 		parserOptions = append(parserOptions, ottlmetric.EnablePathContextNames())
 	}
@@ -254,7 +254,7 @@ func convertDataPointStatements(pc *ottl.ParserCollection[MetricsConsumer], stat
 	if contextStatements.ErrorMode != "" {
 		errorMode = contextStatements.ErrorMode
 	}
-	var parserOptions []ottl.Option[*ottldatapoint.TransformContext]
+	parserOptions := []ottl.Option[*ottldatapoint.TransformContext]{ottl.EnableCacheScopes[*ottldatapoint.TransformContext]()}
 	if contextStatements.Context == "" {
 		parserOptions = append(parserOptions, ottldatapoint.EnablePathContextNames())
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pprofile"
@@ -21,6 +22,21 @@ import (
 
 var _ baseContext = &resourceStatements{}
 
+// WithCacheScopes returns a copy of ctx providing the caches shared by the statements processing a batch. The
+// shared caches live as long as the batch, so that the batches processed concurrently never share them.
+func WithCacheScopes(ctx context.Context) context.Context {
+	return ottl.ContextWithCacheScopes(ctx)
+}
+
+// resourceCacheOptions shares the cache of the resource statements with the lower contexts of the resource, when
+// the batch provides the shared caches.
+func resourceCacheOptions(ctx context.Context, resource pcommon.Resource) []ottlresource.TransformContextOption {
+	if cache, ok := ottl.ResourceCache(ctx, resource); ok {
+		return []ottlresource.TransformContextOption{ottlresource.WithCache(&cache)}
+	}
+	return nil
+}
+
 type resourceStatements struct {
 	ottl.StatementSequence[*ottlresource.TransformContext]
 	expr.BoolExpr[*ottlresource.TransformContext]
@@ -32,7 +48,7 @@ func (resourceStatements) Context() ContextID {
 
 func (r resourceStatements) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	for _, rspans := range td.ResourceSpans().All() {
-		tCtx := ottlresource.NewTransformContextPtr(rspans.Resource(), rspans)
+		tCtx := ottlresource.NewTransformContextPtr(rspans.Resource(), rspans, resourceCacheOptions(ctx, rspans.Resource())...)
 		condition, err := r.Eval(ctx, tCtx)
 		if err != nil {
 			tCtx.Close()
@@ -52,7 +68,7 @@ func (r resourceStatements) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 
 func (r resourceStatements) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 	for _, rmetrics := range md.ResourceMetrics().All() {
-		tCtx := ottlresource.NewTransformContextPtr(rmetrics.Resource(), rmetrics)
+		tCtx := ottlresource.NewTransformContextPtr(rmetrics.Resource(), rmetrics, resourceCacheOptions(ctx, rmetrics.Resource())...)
 		condition, err := r.Eval(ctx, tCtx)
 		if err != nil {
 			tCtx.Close()
@@ -72,7 +88,7 @@ func (r resourceStatements) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 
 func (r resourceStatements) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 	for _, rlogs := range ld.ResourceLogs().All() {
-		tCtx := ottlresource.NewTransformContextPtr(rlogs.Resource(), rlogs)
+		tCtx := ottlresource.NewTransformContextPtr(rlogs.Resource(), rlogs, resourceCacheOptions(ctx, rlogs.Resource())...)
 		condition, err := r.Eval(ctx, tCtx)
 		if err != nil {
 			tCtx.Close()
@@ -92,7 +108,7 @@ func (r resourceStatements) ConsumeLogs(ctx context.Context, ld plog.Logs) error
 
 func (r resourceStatements) ConsumeProfiles(ctx context.Context, ld pprofile.Profiles) error {
 	for _, rprofiles := range ld.ResourceProfiles().All() {
-		tCtx := ottlresource.NewTransformContextPtr(rprofiles.Resource(), rprofiles)
+		tCtx := ottlresource.NewTransformContextPtr(rprofiles.Resource(), rprofiles, resourceCacheOptions(ctx, rprofiles.Resource())...)
 		condition, err := r.Eval(ctx, tCtx)
 		if err != nil {
 			tCtx.Close()
@@ -218,11 +234,11 @@ type baseContext interface {
 
 func withCommonContextParsers[R any]() ottl.ParserCollectionOption[R] {
 	return func(pc *ottl.ParserCollection[R]) error {
-		rp, err := ottlresource.NewParser(ResourceFunctions(), pc.Settings, ottlresource.EnablePathContextNames())
+		rp, err := ottlresource.NewParser(ResourceFunctions(), pc.Settings, ottlresource.EnablePathContextNames(), ottl.EnableCacheScopes[*ottlresource.TransformContext]())
 		if err != nil {
 			return err
 		}
-		sp, err := ottlscope.NewParser(ScopeFunctions(), pc.Settings, ottlscope.EnablePathContextNames())
+		sp, err := ottlscope.NewParser(ScopeFunctions(), pc.Settings, ottlscope.EnablePathContextNames(), ottl.EnableCacheScopes[*ottlscope.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -254,7 +270,7 @@ func parseResourceContextStatements[R any](
 	if contextStatements.ErrorMode != "" {
 		errorMode = contextStatements.ErrorMode
 	}
-	var parserOptions []ottl.Option[*ottlresource.TransformContext]
+	parserOptions := []ottl.Option[*ottlresource.TransformContext]{ottl.EnableCacheScopes[*ottlresource.TransformContext]()}
 	if contextStatements.Context == "" {
 		parserOptions = append(parserOptions, ottlresource.EnablePathContextNames())
 	}
@@ -280,7 +296,7 @@ func parseScopeContextStatements[R any](
 	if contextStatements.ErrorMode != "" {
 		errorMode = contextStatements.ErrorMode
 	}
-	var parserOptions []ottl.Option[*ottlscope.TransformContext]
+	parserOptions := []ottl.Option[*ottlscope.TransformContext]{ottl.EnableCacheScopes[*ottlscope.TransformContext]()}
 	if contextStatements.Context == "" {
 		parserOptions = append(parserOptions, ottlscope.EnablePathContextNames())
 	}
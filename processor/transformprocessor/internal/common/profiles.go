@@ -57,7 +57,7 @@ type ProfileParserCollectionOption ottl.ParserCollectionOption[ProfilesConsumer]
 
 func WithProfileParser(functions map[string]ottl.Factory[ottlprofile.TransformContext]) ProfileParserCollectionOption {
 	return func(pc *ottl.ParserCollection[ProfilesConsumer]) error {
-		profileParser, err := ottlprofile.NewParser(functions, pc.Settings, ottlprofile.EnablePathContextNames())
+		profileParser, err := ottlprofile.NewParser(functions, pc.Settings, ottlprofile.EnablePathContextNames(), ottl.EnableCacheScopes[ottlprofile.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -97,7 +97,7 @@ func convertProfileStatements(pc *ottl.ParserCollection[ProfilesConsumer], state
 	if contextStatements.ErrorMode != "" {
 		errorMode = contextStatements.ErrorMode
 	}
-	var parserOptions []ottl.Option[ottlprofile.TransformContext]
+	parserOptions := []ottl.Option[ottlprofile.TransformContext]{ottl.EnableCacheScopes[ottlprofile.TransformContext]()}
 	if contextStatements.Context == "" {
 		parserOptions = append(parserOptions, ottlprofile.EnablePathContextNames())
 	}
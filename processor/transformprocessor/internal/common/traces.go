@@ -103,7 +103,7 @@ type TraceParserCollectionOption ottl.ParserCollectionOption[TracesConsumer]
 
 func WithSpanParser(functions map[string]ottl.Factory[*ottlspan.TransformContext]) TraceParserCollectionOption {
 	return func(pc *ottl.ParserCollection[TracesConsumer]) error {
-		parser, err := ottlspan.NewParser(functions, pc.Settings, ottlspan.EnablePathContextNames())
+		parser, err := ottlspan.NewParser(functions, pc.Settings, ottlspan.EnablePathContextNames(), ottl.EnableCacheScopes[*ottlspan.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -113,7 +113,7 @@ func WithSpanParser(functions map[string]ottl.Factory[*ottlspan.TransformContext
 
 func WithSpanEventParser(functions map[string]ottl.Factory[*ottlspanevent.TransformContext]) TraceParserCollectionOption {
 	return func(pc *ottl.ParserCollection[TracesConsumer]) error {
-		parser, err := ottlspanevent.NewParser(functions, pc.Settings, ottlspanevent.EnablePathContextNames())
+		parser, err := ottlspanevent.NewParser(functions, pc.Settings, ottlspanevent.EnablePathContextNames(), ottl.EnableCacheScopes[*ottlspanevent.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -153,7 +153,7 @@ func convertSpanStatements(pc *ottl.ParserCollection[TracesConsumer], statements
 	if contextStatements.ErrorMode != "" {
 		errorMode = contextStatements.ErrorMode
 	}
-	var parserOptions []ottl.Option[*ottlspan.TransformContext]
+	parserOptions := []ottl.Option[*ottlspan.TransformContext]{ottl.EnableCacheScopes[*ottlspan.TransformContext]()}
 	if contextStatements.Context == "" {
 		parserOptions = append(parserOptions, ottlspan.EnablePathContextNames())
 	}
@@ -174,7 +174,7 @@ func convertSpanEventStatements(pc *ottl.ParserCollection[TracesConsumer], state
 	if contextStatements.ErrorMode != "" {
 		errorMode = contextStatements.ErrorMode
 	}
-	var parserOptions []ottl.Option[*ottlspanevent.TransformContext]
+	parserOptions := []ottl.Option[*ottlspanevent.TransformContext]{ottl.EnableCacheScopes[*ottlspanevent.TransformContext]()}
 	if contextStatements.Context == "" {
 		parserOptions = append(parserOptions, ottlspanevent.EnablePathContextNames())
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -51,8 +52,12 @@ func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.E
 }
 
 func (p *Processor) ProcessLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	ctx = common.WithCacheScopes(ctx)
 	if p.flatMode {
-		pdatautil.FlattenLogs(ld.ResourceLogs())
+		// The flattened logs share the cache of the resource they were copied from
+		pdatautil.FlattenLogsFunc(ld.ResourceLogs(), func(flat, grouped pcommon.Resource) {
+			ottl.ShareResourceCache(ctx, flat, grouped)
+		})
 		defer pdatautil.GroupByResourceLogs(ld.ResourceLogs())
 	}
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -912,6 +913,104 @@ func Test_ProcessLogs_InferredMixContext(t *testing.T) {
 	}
 }
 
+func Test_ProcessLogs_RequestContext(t *testing.T) {
+	ctx := client.NewContext(t.Context(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-tenant": {"acme"}}),
+	})
+
+	tests := []struct {
+		statement string
+		want      func(td plog.Logs)
+	}{
+		{
+			statement: `set(resource.attributes["tenant"], request.metadata["x-tenant"])`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).Resource().Attributes().PutStr("tenant", "acme")
+			},
+		},
+		{
+			statement: `set(log.attributes["tenant"], request.metadata["x-tenant"]) where log.body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("tenant", "acme")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			td := constructLogs()
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			require.NoError(t, err)
+
+			_, err = processor.ProcessLogs(ctx, td)
+			require.NoError(t, err)
+
+			exTd := constructLogs()
+			tt.want(exTd)
+
+			assert.Equal(t, exTd, td)
+		})
+	}
+}
+
+func Test_ProcessLogs_SharedCaches(t *testing.T) {
+	contextStatements := []common.ContextStatements{
+		{
+			Context:    "resource",
+			Statements: []string{`set(resource.cache["host"], resource.attributes["host.name"])`},
+		},
+		{
+			Context: "log",
+			Statements: []string{
+				`set(log.attributes["host"], resource.cache["host"])`,
+				`set(log.attributes["previous"], request.cache["previous"])`,
+				`set(request.cache["previous"], log.body)`,
+			},
+		},
+	}
+	processor, err := NewProcessor(contextStatements, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+	require.NoError(t, err)
+
+	// The request cache is shared by the logs of a batch, and never by different batches
+	for range 2 {
+		td := constructLogs()
+		_, err = processor.ProcessLogs(t.Context(), td)
+		require.NoError(t, err)
+
+		exTd := constructLogs()
+		logs := exTd.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+		logs.At(0).Attributes().PutStr("host", "localhost")
+		logs.At(1).Attributes().PutStr("host", "localhost")
+		logs.At(1).Attributes().PutStr("previous", "operationA")
+		assert.Equal(t, exTd, td)
+	}
+}
+
+func Test_ProcessLogs_SharedResourceCacheFlatMode(t *testing.T) {
+	contextStatements := []common.ContextStatements{
+		{
+			Context: "log",
+			Statements: []string{
+				`set(resource.cache["first"], log.body) where resource.cache["first"] == nil`,
+				`set(log.attributes["first"], resource.cache["first"])`,
+			},
+		},
+	}
+	processor, err := NewProcessor(contextStatements, ottl.PropagateError, true, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+	require.NoError(t, err)
+
+	td := constructLogs()
+	_, err = processor.ProcessLogs(t.Context(), td)
+	require.NoError(t, err)
+
+	// The flattened log records share the cache of their original resource
+	exTd := constructLogs()
+	logs := exTd.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	logs.At(0).Attributes().PutStr("first", "operationA")
+	logs.At(1).Attributes().PutStr("first", "operationA")
+	assert.Equal(t, logs, td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords())
+}
+
 func Test_ProcessLogs_ErrorMode(t *testing.T) {
 	tests := []struct {
 		statement string
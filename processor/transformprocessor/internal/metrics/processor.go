@@ -49,6 +49,7 @@ func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.E
 }
 
 func (p *Processor) ProcessMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	ctx = common.WithCacheScopes(ctx)
 	for _, c := range p.contexts {
 		err := c.ConsumeMetrics(ctx, md)
 		if err != nil {
@@ -48,6 +48,7 @@ func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.E
 }
 
 func (p *Processor) ProcessProfiles(ctx context.Context, ld pprofile.Profiles) (pprofile.Profiles, error) {
+	ctx = common.WithCacheScopes(ctx)
 	for _, c := range p.contexts {
 		err := c.ConsumeProfiles(ctx, ld)
 		if err != nil {
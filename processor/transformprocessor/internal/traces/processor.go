@@ -49,6 +49,7 @@ func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.E
 }
 
 func (p *Processor) ProcessTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	ctx = common.WithCacheScopes(ctx)
 	for _, c := range p.contexts {
 		err := c.ConsumeTraces(ctx, td)
 		if err != nil {
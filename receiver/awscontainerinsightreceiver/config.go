@@ -4,7 +4,18 @@
 package awscontainerinsightreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver"
 
 import (
+	"errors"
+	"fmt"
 	"time"
+
+	ci "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/containerinsight"
+)
+
+const (
+	// computeTypeEC2 collects the metrics of the node the collector runs on.
+	computeTypeEC2 = "ec2"
+	// computeTypeFargate collects the metrics of all the Fargate nodes of the cluster.
+	computeTypeFargate = "fargate"
 )
 
 // Config defines configuration for aws ecs container metrics receiver.
@@ -27,4 +38,30 @@ type Config struct {
 	// If false FullPodName label is not added
 	// The default value is false
 	AddFullPodNameMetricLabel bool `mapstructure:"add_full_pod_name_metric_label"`
+
+	// ComputeType is the type of compute the metrics are collected from, either ec2 or fargate. The default is ec2.
+	// With fargate, the receiver collects the metrics of all the Fargate nodes of an EKS cluster through the API server,
+	// so it must run as a single replica deployment.
+	ComputeType string `mapstructure:"compute_type"`
+
+	// ClusterName is the name of the EKS cluster. It is required with the fargate compute type, as it can't be
+	// detected from the tags of the EC2 instance. It is ignored otherwise.
+	ClusterName string `mapstructure:"cluster_name"`
+}
+
+// Validate checks if the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	switch cfg.ComputeType {
+	case computeTypeEC2:
+	case computeTypeFargate:
+		if cfg.ContainerOrchestrator != ci.EKS {
+			return errors.New("compute_type fargate is only supported with the eks container orchestrator")
+		}
+		if cfg.ClusterName == "" {
+			return errors.New("cluster_name must be specified with compute_type fargate")
+		}
+	default:
+		return fmt.Errorf("unsupported compute_type %q, must be %q or %q", cfg.ComputeType, computeTypeEC2, computeTypeFargate)
+	}
+	return nil
 }
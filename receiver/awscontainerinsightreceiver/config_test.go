@@ -38,6 +38,17 @@ func TestLoadConfig(t *testing.T) {
 				ContainerOrchestrator: "eks",
 				TagService:            true,
 				PrefFullPodName:       false,
+				ComputeType:           "ec2",
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "fargate"),
+			expected: &Config{
+				CollectionInterval:    60 * time.Second,
+				ContainerOrchestrator: "eks",
+				TagService:            true,
+				ComputeType:           "fargate",
+				ClusterName:           "my-cluster",
 			},
 		},
 	}
@@ -56,3 +67,59 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(*Config)
+		expectedErr string
+	}{
+		{
+			name:   "ec2",
+			modify: func(*Config) {},
+		},
+		{
+			name: "fargate",
+			modify: func(cfg *Config) {
+				cfg.ComputeType = "fargate"
+				cfg.ClusterName = "my-cluster"
+			},
+		},
+		{
+			name: "fargate_without_cluster_name",
+			modify: func(cfg *Config) {
+				cfg.ComputeType = "fargate"
+			},
+			expectedErr: "cluster_name must be specified with compute_type fargate",
+		},
+		{
+			name: "fargate_on_ecs",
+			modify: func(cfg *Config) {
+				cfg.ComputeType = "fargate"
+				cfg.ClusterName = "my-cluster"
+				cfg.ContainerOrchestrator = "ecs"
+			},
+			expectedErr: "compute_type fargate is only supported with the eks container orchestrator",
+		},
+		{
+			name: "unknown_compute_type",
+			modify: func(cfg *Config) {
+				cfg.ComputeType = "lambda"
+			},
+			expectedErr: `unsupported compute_type "lambda", must be "ec2" or "fargate"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -38,6 +38,9 @@ const (
 
 	// Don't tag pod full name by default
 	defaultAddFullPodNameMetricLabel = false
+
+	// Collect the metrics of the EC2 instance by default
+	defaultComputeType = computeTypeEC2
 )
 
 // NewFactory creates a factory for AWS container insight receiver
@@ -63,6 +66,7 @@ func createDefaultConfig() component.Config {
 		TagService:                defaultTagService,
 		PrefFullPodName:           defaultPrefFullPodName,
 		AddFullPodNameMetricLabel: defaultAddFullPodNameMetricLabel,
+		ComputeType:               defaultComputeType,
 	}
 }
 
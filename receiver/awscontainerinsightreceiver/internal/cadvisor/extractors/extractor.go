@@ -55,6 +55,11 @@ func newCadvisorMetric(mType string, logger *zap.Logger) *CAdvisorMetric {
 	return metric
 }
 
+// NewCAdvisorMetric creates a metric of the given type, it is used by the sources of metrics other than cadvisor.
+func NewCAdvisorMetric(mType string, logger *zap.Logger) *CAdvisorMetric {
+	return newCadvisorMetric(mType, logger)
+}
+
 func (c *CAdvisorMetric) GetTags() map[string]string {
 	return c.tags
 }
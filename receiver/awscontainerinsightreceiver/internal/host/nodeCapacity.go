@@ -6,6 +6,7 @@ package host // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"context"
 	"os"
+	"runtime"
 
 	"github.com/shirou/gopsutil/v4/common"
 	"github.com/shirou/gopsutil/v4/cpu"
@@ -27,6 +28,7 @@ type nodeCapacity struct {
 	osLstat       func(name string) (os.FileInfo, error)
 	virtualMemory func(ctx context.Context) (*mem.VirtualMemoryStat, error)
 	cpuInfo       func(ctx context.Context) ([]cpu.InfoStat, error)
+	goos          string
 }
 
 type nodeCapacityOption func(*nodeCapacity)
@@ -37,12 +39,21 @@ func newNodeCapacity(logger *zap.Logger, options ...nodeCapacityOption) (nodeCap
 		osLstat:       os.Lstat,
 		virtualMemory: mem.VirtualMemoryWithContext,
 		cpuInfo:       cpu.InfoWithContext,
+		goos:          runtime.GOOS,
 	}
 
 	for _, opt := range options {
 		opt(nc)
 	}
 
+	// The host proc directory doesn't exist on windows, the capacity is read from the system APIs instead
+	if nc.goos == "windows" {
+		ctx := context.Background()
+		nc.parseCPU(ctx)
+		nc.parseMemory(ctx)
+		return nc, nil
+	}
+
 	actualHostProc, ok := os.LookupEnv(string(common.HostProcEnvKey))
 	if !ok {
 		actualHostProc = hostProc
@@ -19,6 +19,7 @@ import (
 func TestNodeCapacity(t *testing.T) {
 	// no proc directory
 	lstatOption := func(nc *nodeCapacity) {
+		nc.goos = "linux"
 		nc.osLstat = func(string) (os.FileInfo, error) {
 			return nil, os.ErrNotExist
 		}
@@ -88,3 +89,24 @@ func TestNodeCapacity_ReadsHostProcEnvVar(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, nc)
 }
+
+func TestNodeCapacity_Windows(t *testing.T) {
+	// the host proc directory isn't needed on windows
+	windowsOption := func(nc *nodeCapacity) {
+		nc.goos = "windows"
+		nc.osLstat = func(string) (os.FileInfo, error) {
+			return nil, os.ErrNotExist
+		}
+		nc.virtualMemory = func(context.Context) (*mem.VirtualMemoryStat, error) {
+			return &mem.VirtualMemoryStat{Total: 1024}, nil
+		}
+		nc.cpuInfo = func(context.Context) ([]cpu.InfoStat, error) {
+			return []cpu.InfoStat{{}, {}}, nil
+		}
+	}
+
+	nc, err := newNodeCapacity(zap.NewNop(), windowsOption)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), nc.getMemoryCapacity())
+	assert.Equal(t, int64(2), nc.getNumCores())
+}
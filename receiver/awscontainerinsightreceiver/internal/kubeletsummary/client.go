@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletsummary // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/kubeletsummary"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	ci "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/containerinsight"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/cadvisor/extractors"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/stores/kubeletutil"
+)
+
+// fargateNodeSelector selects the nodes running the Fargate pods, each of them runs a single pod.
+const fargateNodeSelector = "eks.amazonaws.com/compute-type=fargate"
+
+// NodeSummary is the stats summary of a node, along with the capacity of the node.
+type NodeSummary struct {
+	Summary        *stats.Summary
+	NumCores       int64
+	MemoryCapacity int64
+}
+
+// SummaryClient provides the stats summaries of the nodes to collect the metrics of.
+type SummaryClient interface {
+	// Summaries returns the summaries which could be read, along with the errors of the others.
+	Summaries(ctx context.Context) ([]NodeSummary, error)
+}
+
+type summaryProvider interface {
+	Summary() (*stats.Summary, error)
+}
+
+// kubeletClient reads the stats summary of the local node from its kubelet.
+type kubeletClient struct {
+	client   summaryProvider
+	capacity extractors.CPUMemInfoProvider
+}
+
+// NewKubeletClient creates a SummaryClient reading the stats summary of the node from the kubelet running on hostIP.
+func NewKubeletClient(hostIP string, capacity extractors.CPUMemInfoProvider, logger *zap.Logger) (SummaryClient, error) {
+	client, err := kubeletutil.NewKubeletClient(hostIP, ci.KubeSecurePort, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &kubeletClient{client: client, capacity: capacity}, nil
+}
+
+func (k *kubeletClient) Summaries(context.Context) ([]NodeSummary, error) {
+	summary, err := k.client.Summary()
+	if err != nil {
+		return nil, err
+	}
+	return []NodeSummary{{
+		Summary:        summary,
+		NumCores:       k.capacity.GetNumCores(),
+		MemoryCapacity: k.capacity.GetMemoryCapacity(),
+	}}, nil
+}
+
+// apiServerClient reads the stats summaries of the Fargate nodes of the cluster through the API server proxy,
+// as their kubelet can't be reached from the pods.
+type apiServerClient struct {
+	clientset kubernetes.Interface
+	// getSummary returns the raw stats summary of a node, it is replaced in tests.
+	getSummary func(ctx context.Context, nodeName string) ([]byte, error)
+}
+
+// NewAPIServerClient creates a SummaryClient reading the stats summaries of the Fargate nodes through the API server.
+func NewAPIServerClient(clientset kubernetes.Interface) SummaryClient {
+	c := &apiServerClient{clientset: clientset}
+	c.getSummary = c.proxySummary
+	return c
+}
+
+func (c *apiServerClient) proxySummary(ctx context.Context, nodeName string) ([]byte, error) {
+	return c.clientset.CoreV1().RESTClient().Get().
+		AbsPath("/api/v1/nodes", nodeName, "proxy", "stats", "summary").
+		DoRaw(ctx)
+}
+
+func (c *apiServerClient) Summaries(ctx context.Context) ([]NodeSummary, error) {
+	nodes, err := c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: fargateNodeSelector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Fargate nodes: %w", err)
+	}
+
+	var errs error
+	summaries := make([]NodeSummary, 0, len(nodes.Items))
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		b, err := c.getSummary(ctx, node.Name)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to read stats summary of node %s: %w", node.Name, err))
+			continue
+		}
+		summary := &stats.Summary{}
+		if err := json.Unmarshal(b, summary); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to parse stats summary of node %s: %w", node.Name, err))
+			continue
+		}
+		summaries = append(summaries, NodeSummary{
+			Summary:        summary,
+			NumCores:       node.Status.Capacity.Cpu().Value(),
+			MemoryCapacity: node.Status.Capacity.Memory().Value(),
+		})
+	}
+	return summaries, errs
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletsummary
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+)
+
+type mockSummaryProvider struct {
+	summary *stats.Summary
+	err     error
+}
+
+func (m *mockSummaryProvider) Summary() (*stats.Summary, error) {
+	return m.summary, m.err
+}
+
+type mockCapacity struct{}
+
+func (mockCapacity) GetNumCores() int64 {
+	return 4
+}
+
+func (mockCapacity) GetMemoryCapacity() int64 {
+	return 1024
+}
+
+func TestKubeletClient(t *testing.T) {
+	summary := loadSummary(t)
+	client := &kubeletClient{client: &mockSummaryProvider{summary: summary}, capacity: mockCapacity{}}
+	summaries, err := client.Summaries(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []NodeSummary{{Summary: summary, NumCores: 4, MemoryCapacity: 1024}}, summaries)
+
+	client = &kubeletClient{client: &mockSummaryProvider{err: errors.New("forbidden")}, capacity: mockCapacity{}}
+	_, err = client.Summaries(t.Context())
+	assert.EqualError(t, err, "forbidden")
+}
+
+func newNode(name string, labels map[string]string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: v1.NodeStatus{
+			Capacity: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+		},
+	}
+}
+
+func TestAPIServerClient(t *testing.T) {
+	fargateLabels := map[string]string{"eks.amazonaws.com/compute-type": "fargate"}
+	clientset := fake.NewClientset(
+		newNode("fargate-1", fargateLabels),
+		newNode("fargate-2", fargateLabels),
+		newNode("fargate-3", fargateLabels),
+		newNode("ec2-1", nil),
+	)
+	summary, err := os.ReadFile(filepath.Join("testdata", "summary.json"))
+	require.NoError(t, err)
+
+	client := NewAPIServerClient(clientset).(*apiServerClient)
+	var requested []string
+	client.getSummary = func(_ context.Context, nodeName string) ([]byte, error) {
+		requested = append(requested, nodeName)
+		switch nodeName {
+		case "fargate-2":
+			return nil, errors.New("not found")
+		case "fargate-3":
+			return []byte("{"), nil
+		default:
+			return summary, nil
+		}
+	}
+
+	summaries, err := client.Summaries(t.Context())
+	assert.ElementsMatch(t, []string{"fargate-1", "fargate-2", "fargate-3"}, requested)
+	require.ErrorContains(t, err, "failed to read stats summary of node fargate-2: not found")
+	require.ErrorContains(t, err, "failed to parse stats summary of node fargate-3")
+	require.Len(t, summaries, 1)
+	assert.Equal(t, "node-1", summaries[0].Summary.Node.NodeName)
+	assert.Equal(t, int64(2), summaries[0].NumCores)
+	assert.Equal(t, int64(4*1024*1024*1024), summaries[0].MemoryCapacity)
+}
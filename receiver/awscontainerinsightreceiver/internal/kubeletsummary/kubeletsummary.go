@@ -0,0 +1,290 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kubeletsummary generates the container insights metrics from the stats summary of the kubelet, on the
+// nodes where cadvisor can't be embedded in the collector: the Windows nodes and the Fargate nodes.
+package kubeletsummary // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/kubeletsummary"
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	ci "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/containerinsight"
+	awsmetrics "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/metrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/cadvisor/extractors"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/stores"
+)
+
+const decimalToMillicores = 1000
+
+type clusterNameProvider interface {
+	GetClusterName() string
+}
+
+type Decorator interface {
+	Decorate(*extractors.CAdvisorMetric) *extractors.CAdvisorMetric
+	Shutdown() error
+}
+
+// Option is a function that can be used to configure KubeletSummary struct
+type Option func(*KubeletSummary)
+
+// WithDecorator constructs an option for configuring the metric decorator. Without decorator, the metrics are only
+// tagged with the kubernetes information of the stats summary.
+func WithDecorator(d Decorator) Option {
+	return func(k *KubeletSummary) {
+		k.decorator = d
+	}
+}
+
+// KubeletSummary is a struct that produces metrics from the stats summary of the kubelet
+type KubeletSummary struct {
+	// We save ctx in the struct because GetMetrics() does network requests which need to be canceled on shutdown
+	ctx                 context.Context
+	logger              *zap.Logger
+	client              SummaryClient
+	clusterNameProvider clusterNameProvider
+	decorator           Decorator
+	rateCalculator      awsmetrics.MetricCalculator
+}
+
+// New creates a KubeletSummary which generates the node, pod and container metrics from the summaries of the client
+func New(ctx context.Context, client SummaryClient, clusterNameProvider clusterNameProvider, logger *zap.Logger, options ...Option) *KubeletSummary {
+	k := &KubeletSummary{
+		ctx:                 ctx,
+		logger:              logger,
+		client:              client,
+		clusterNameProvider: clusterNameProvider,
+		rateCalculator:      newFloat64RateCalculator(),
+	}
+	for _, option := range options {
+		option(k)
+	}
+	return k
+}
+
+// GetMetrics generates metrics from the stats summaries
+func (k *KubeletSummary) GetMetrics() []pmetric.Metrics {
+	k.logger.Debug("collect data from kubelet stats summary...")
+	var result []pmetric.Metrics
+
+	// don't emit metrics if the cluster name is not detected
+	clusterName := k.clusterNameProvider.GetClusterName()
+	if clusterName == "" {
+		k.logger.Warn("Failed to detect cluster name. Drop all metrics")
+		return result
+	}
+
+	summaries, err := k.client.Summaries(k.ctx)
+	if err != nil {
+		k.logger.Warn("Failed to read some stats summaries", zap.Error(err))
+	}
+
+	for _, summary := range summaries {
+		for _, m := range k.convert(summary) {
+			m.AddTag(ci.ClusterNameKey, clusterName)
+			m.AddTag(ci.Version, "0")
+			out := k.decorate(m)
+			if out == nil {
+				continue
+			}
+			result = append(result, ci.ConvertToOTLPMetrics(out.GetFields(), out.GetTags(), k.logger))
+		}
+	}
+	return result
+}
+
+func (k *KubeletSummary) decorate(m *extractors.CAdvisorMetric) *extractors.CAdvisorMetric {
+	if k.decorator != nil {
+		return k.decorator.Decorate(m)
+	}
+	if podName := m.GetTag(ci.K8sPodNameKey); podName != "" {
+		m.AddTag(ci.PodNameKey, podName)
+	}
+	stores.AddKubernetesInfo(m, map[string]any{})
+	return m
+}
+
+func (k *KubeletSummary) Shutdown() error {
+	errs := k.rateCalculator.Shutdown()
+	if k.decorator != nil {
+		errs = errors.Join(errs, k.decorator.Shutdown())
+	}
+	return errs
+}
+
+// convert generates the metrics of the node, and of its pods and containers, from its stats summary.
+func (k *KubeletSummary) convert(ns NodeSummary) []*extractors.CAdvisorMetric {
+	summary := ns.Summary
+	nodeName := summary.Node.NodeName
+	var metrics []*extractors.CAdvisorMetric
+
+	node := k.newMetric(ci.TypeNode, nodeName, summary.Node.CPU, summary.Node.Memory)
+	addCPU(node, ci.TypeNode, summary.Node.CPU, ns.NumCores)
+	addMemory(node, ci.TypeNode, summary.Node.Memory, ns.MemoryCapacity)
+	k.addNetwork(node, ci.TypeNode, nodeName, summary.Node.Network)
+	node.AddField(ci.MetricName(ci.TypeNode, ci.CPULimit), ns.NumCores*decimalToMillicores)
+	node.AddField(ci.MetricName(ci.TypeNode, ci.MemLimit), ns.MemoryCapacity)
+	metrics = append(metrics, node)
+	if fs := newFSMetric(ci.TypeNodeFS, summary.Node.Fs, k.logger); fs != nil {
+		fs.AddTag(ci.NodeNameKey, nodeName)
+		fs.AddTag(ci.Timestamp, node.GetTag(ci.Timestamp))
+		metrics = append(metrics, fs)
+	}
+
+	for i := range summary.Pods {
+		podStats := &summary.Pods[i]
+		podTags := map[string]string{
+			ci.PodIDKey:      podStats.PodRef.UID,
+			ci.K8sPodNameKey: podStats.PodRef.Name,
+			ci.K8sNamespace:  podStats.PodRef.Namespace,
+		}
+
+		pod := k.newMetric(ci.TypePod, nodeName, podStats.CPU, podStats.Memory)
+		pod.AddTags(podTags)
+		addCPU(pod, ci.TypePod, podStats.CPU, ns.NumCores)
+		addMemory(pod, ci.TypePod, podStats.Memory, ns.MemoryCapacity)
+		k.addNetwork(pod, ci.TypePod, podStats.PodRef.UID, podStats.Network)
+		metrics = append(metrics, pod)
+
+		for j := range podStats.Containers {
+			containerStats := &podStats.Containers[j]
+			container := k.newMetric(ci.TypeContainer, nodeName, containerStats.CPU, containerStats.Memory)
+			container.AddTags(podTags)
+			container.AddTag(ci.ContainerNamekey, containerStats.Name)
+			addCPU(container, ci.TypeContainer, containerStats.CPU, ns.NumCores)
+			addMemory(container, ci.TypeContainer, containerStats.Memory, ns.MemoryCapacity)
+			metrics = append(metrics, container)
+			if fs := newFSMetric(ci.TypeContainerFS, containerStats.Rootfs, k.logger); fs != nil {
+				fs.AddTags(container.GetTags())
+				fs.AddTag(ci.MetricType, ci.TypeContainerFS)
+				metrics = append(metrics, fs)
+			}
+		}
+	}
+	return metrics
+}
+
+func (k *KubeletSummary) newMetric(mType, nodeName string, cpu *stats.CPUStats, memory *stats.MemoryStats) *extractors.CAdvisorMetric {
+	m := extractors.NewCAdvisorMetric(mType, k.logger)
+	m.AddTag(ci.NodeNameKey, nodeName)
+	m.AddTag(ci.Timestamp, strconv.FormatInt(statsTime(cpu, memory).UnixNano(), 10))
+	return m
+}
+
+// statsTime returns the time at which the stats were collected, or now if they are missing.
+func statsTime(cpu *stats.CPUStats, memory *stats.MemoryStats) time.Time {
+	if cpu != nil && !cpu.Time.IsZero() {
+		return cpu.Time.Time
+	}
+	if memory != nil && !memory.Time.IsZero() {
+		return memory.Time.Time
+	}
+	return time.Now()
+}
+
+func addCPU(m *extractors.CAdvisorMetric, mType string, cpu *stats.CPUStats, numCores int64) {
+	if cpu == nil || cpu.UsageNanoCores == nil {
+		return
+	}
+	// The usage is in nano cores, while the metrics are in millicores
+	total := float64(*cpu.UsageNanoCores) / 1e6
+	m.AddField(ci.MetricName(mType, ci.CPUTotal), total)
+	if numCores != 0 {
+		m.AddField(ci.MetricName(mType, ci.CPUUtilization), total/float64(numCores*decimalToMillicores)*100)
+	}
+}
+
+func addMemory(m *extractors.CAdvisorMetric, mType string, memory *stats.MemoryStats, memoryCapacity int64) {
+	if memory == nil {
+		return
+	}
+	if memory.UsageBytes != nil {
+		m.AddField(ci.MetricName(mType, ci.MemUsage), *memory.UsageBytes)
+	}
+	if memory.RSSBytes != nil {
+		m.AddField(ci.MetricName(mType, ci.MemRss), *memory.RSSBytes)
+	}
+	if memory.WorkingSetBytes != nil {
+		m.AddField(ci.MetricName(mType, ci.MemWorkingset), *memory.WorkingSetBytes)
+		if memoryCapacity != 0 {
+			m.AddField(ci.MetricName(mType, ci.MemUtilization), float64(*memory.WorkingSetBytes)/float64(memoryCapacity)*100)
+		}
+	}
+}
+
+// addNetwork adds the rates of the network stats, aggregated over all interfaces. The key identifies the node or
+// pod the stats belong to.
+func (k *KubeletSummary) addNetwork(m *extractors.CAdvisorMetric, mType, key string, network *stats.NetworkStats) {
+	if network == nil {
+		return
+	}
+	interfaces := network.Interfaces
+	if len(interfaces) == 0 {
+		interfaces = []stats.InterfaceStats{network.InterfaceStats}
+	}
+
+	counters := map[string]float64{}
+	for _, ifce := range interfaces {
+		for name, value := range map[string]*uint64{
+			ci.NetRxBytes:  ifce.RxBytes,
+			ci.NetRxErrors: ifce.RxErrors,
+			ci.NetTxBytes:  ifce.TxBytes,
+			ci.NetTxErrors: ifce.TxErrors,
+		} {
+			if value != nil {
+				counters[name] += float64(*value)
+			}
+		}
+	}
+
+	fields := map[string]any{}
+	for name, value := range counters {
+		mKey := awsmetrics.NewKey(key+mType+name, nil)
+		if rate, ok := k.rateCalculator.Calculate(mKey, value, network.Time.Time); ok {
+			fields[name] = rate.(float64) * float64(time.Second)
+		}
+	}
+	if fields[ci.NetRxBytes] != nil && fields[ci.NetTxBytes] != nil {
+		fields[ci.NetTotalBytes] = fields[ci.NetRxBytes].(float64) + fields[ci.NetTxBytes].(float64)
+	}
+	for name, value := range fields {
+		m.AddField(ci.MetricName(mType, name), value)
+	}
+}
+
+// newFSMetric creates the metric of the filesystem stats, it returns nil if they are missing.
+func newFSMetric(mType string, fs *stats.FsStats, logger *zap.Logger) *extractors.CAdvisorMetric {
+	if fs == nil || fs.UsedBytes == nil || fs.CapacityBytes == nil {
+		return nil
+	}
+	m := extractors.NewCAdvisorMetric(mType, logger)
+	m.AddField(ci.MetricName(mType, ci.FSUsage), *fs.UsedBytes)
+	m.AddField(ci.MetricName(mType, ci.FSCapacity), *fs.CapacityBytes)
+	if fs.AvailableBytes != nil {
+		m.AddField(ci.MetricName(mType, ci.FSAvailable), *fs.AvailableBytes)
+	}
+	if *fs.CapacityBytes != 0 {
+		m.AddField(ci.MetricName(mType, ci.FSUtilization), float64(*fs.UsedBytes)/float64(*fs.CapacityBytes)*100)
+	}
+	return m
+}
+
+func newFloat64RateCalculator() awsmetrics.MetricCalculator {
+	return awsmetrics.NewMetricCalculator(func(prev *awsmetrics.MetricValue, val any, timestamp time.Time) (any, bool) {
+		if prev != nil {
+			deltaNs := timestamp.Sub(prev.Timestamp)
+			deltaValue := val.(float64) - prev.RawValue.(float64)
+			if deltaNs > ci.MinTimeDiff && deltaValue >= 0 {
+				return deltaValue / float64(deltaNs), true
+			}
+		}
+		return float64(0), false
+	})
+}
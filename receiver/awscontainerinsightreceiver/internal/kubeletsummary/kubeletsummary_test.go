@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletsummary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	ci "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/containerinsight"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/cadvisor/extractors"
+)
+
+type mockClusterNameProvider string
+
+func (m mockClusterNameProvider) GetClusterName() string {
+	return string(m)
+}
+
+type mockSummaryClient struct {
+	summaries []NodeSummary
+	err       error
+}
+
+func (m *mockSummaryClient) Summaries(context.Context) ([]NodeSummary, error) {
+	return m.summaries, m.err
+}
+
+// mockDecorator drops the metrics of the containers
+type mockDecorator struct{}
+
+func (mockDecorator) Decorate(m *extractors.CAdvisorMetric) *extractors.CAdvisorMetric {
+	if m.GetMetricType() == ci.TypeContainer || m.GetMetricType() == ci.TypeContainerFS {
+		return nil
+	}
+	m.AddTag("decorated", "true")
+	return m
+}
+
+func (mockDecorator) Shutdown() error {
+	return nil
+}
+
+func loadSummary(t *testing.T) *stats.Summary {
+	b, err := os.ReadFile(filepath.Join("testdata", "summary.json"))
+	require.NoError(t, err)
+	summary := &stats.Summary{}
+	require.NoError(t, json.Unmarshal(b, summary))
+	return summary
+}
+
+func metricsByType(metrics []*extractors.CAdvisorMetric) map[string]*extractors.CAdvisorMetric {
+	result := map[string]*extractors.CAdvisorMetric{}
+	for _, m := range metrics {
+		result[m.GetMetricType()] = m
+	}
+	return result
+}
+
+func TestConvert(t *testing.T) {
+	k := New(t.Context(), &mockSummaryClient{}, mockClusterNameProvider("cluster"), zap.NewNop())
+	defer func() { require.NoError(t, k.Shutdown()) }()
+
+	summary := loadSummary(t)
+	metrics := metricsByType(k.convert(NodeSummary{Summary: summary, NumCores: 2, MemoryCapacity: 10000}))
+	require.Len(t, metrics, 5)
+
+	node := metrics[ci.TypeNode]
+	assert.Equal(t, "node-1", node.GetTag(ci.NodeNameKey))
+	assert.Equal(t, "1767225660000000000", node.GetTag(ci.Timestamp))
+	assert.Equal(t, float64(500), node.GetField("node_cpu_usage_total"))
+	assert.Equal(t, float64(25), node.GetField("node_cpu_utilization"))
+	assert.Equal(t, int64(2000), node.GetField("node_cpu_limit"))
+	assert.Equal(t, uint64(2000), node.GetField("node_memory_working_set"))
+	assert.Equal(t, uint64(3000), node.GetField("node_memory_usage"))
+	assert.Equal(t, float64(20), node.GetField("node_memory_utilization"))
+	assert.Equal(t, int64(10000), node.GetField("node_memory_limit"))
+	// The rates of the network stats need a previous value
+	assert.False(t, node.HasField("node_network_rx_bytes"))
+
+	nodeFS := metrics[ci.TypeNodeFS]
+	assert.Equal(t, uint64(400), nodeFS.GetField("node_filesystem_usage"))
+	assert.Equal(t, uint64(1000), nodeFS.GetField("node_filesystem_capacity"))
+	assert.Equal(t, uint64(600), nodeFS.GetField("node_filesystem_available"))
+	assert.Equal(t, float64(40), nodeFS.GetField("node_filesystem_utilization"))
+
+	pod := metrics[ci.TypePod]
+	assert.Equal(t, "app-0", pod.GetTag(ci.K8sPodNameKey))
+	assert.Equal(t, "default", pod.GetTag(ci.K8sNamespace))
+	assert.Equal(t, "uid-0", pod.GetTag(ci.PodIDKey))
+	assert.Equal(t, float64(250), pod.GetField("pod_cpu_usage_total"))
+	assert.Equal(t, float64(12.5), pod.GetField("pod_cpu_utilization"))
+	assert.Equal(t, uint64(1000), pod.GetField("pod_memory_working_set"))
+	assert.False(t, pod.HasField("pod_cpu_limit"))
+
+	container := metrics[ci.TypeContainer]
+	assert.Equal(t, "app", container.GetTag(ci.ContainerNamekey))
+	assert.Equal(t, "app-0", container.GetTag(ci.K8sPodNameKey))
+	assert.Equal(t, float64(200), container.GetField("container_cpu_usage_total"))
+	assert.Equal(t, uint64(700), container.GetField("container_memory_rss"))
+
+	containerFS := metrics[ci.TypeContainerFS]
+	assert.Equal(t, "app", containerFS.GetTag(ci.ContainerNamekey))
+	assert.Equal(t, uint64(100), containerFS.GetField("container_filesystem_usage"))
+	assert.Equal(t, float64(10), containerFS.GetField("container_filesystem_utilization"))
+
+	// The network counters increase by 1100 received and 2200 sent bytes on the node, and by 500 and 1000 bytes on
+	// the pod, in 10 seconds
+	summary = loadSummary(t)
+	networkTime := summary.Node.Network.Time.Add(10 * time.Second)
+	summary.Node.Network.Time.Time = networkTime
+	for i := range summary.Node.Network.Interfaces {
+		*summary.Node.Network.Interfaces[i].RxBytes *= 2
+		*summary.Node.Network.Interfaces[i].TxBytes *= 2
+	}
+	summary.Pods[0].Network.Time.Time = networkTime
+	*summary.Pods[0].Network.RxBytes *= 2
+	*summary.Pods[0].Network.TxBytes *= 2
+	metrics = metricsByType(k.convert(NodeSummary{Summary: summary, NumCores: 2, MemoryCapacity: 10000}))
+
+	node = metrics[ci.TypeNode]
+	assert.InDelta(t, 110, node.GetField("node_network_rx_bytes"), 1e-9)
+	assert.InDelta(t, 220, node.GetField("node_network_tx_bytes"), 1e-9)
+	assert.InDelta(t, 330, node.GetField("node_network_total_bytes"), 1e-9)
+	pod = metrics[ci.TypePod]
+	assert.InDelta(t, 50, pod.GetField("pod_network_rx_bytes"), 1e-9)
+	assert.InDelta(t, 150, pod.GetField("pod_network_total_bytes"), 1e-9)
+}
+
+func TestGetMetrics(t *testing.T) {
+	client := &mockSummaryClient{
+		summaries: []NodeSummary{{Summary: loadSummary(t), NumCores: 2, MemoryCapacity: 10000}},
+		err:       errors.New("failed to read stats summary of node node-2"),
+	}
+	k := New(t.Context(), client, mockClusterNameProvider("cluster"), zap.NewNop())
+	defer func() { require.NoError(t, k.Shutdown()) }()
+
+	result := k.GetMetrics()
+	require.Len(t, result, 5)
+	for _, md := range result {
+		attributes := md.ResourceMetrics().At(0).Resource().Attributes()
+		clusterName, ok := attributes.Get(ci.ClusterNameKey)
+		require.True(t, ok)
+		assert.Equal(t, "cluster", clusterName.Str())
+		metricType, _ := attributes.Get(ci.MetricType)
+		if metricType.Str() == ci.TypePod {
+			podName, ok := attributes.Get(ci.PodNameKey)
+			require.True(t, ok)
+			assert.Equal(t, "app-0", podName.Str())
+			kubernetes, ok := attributes.Get(ci.Kubernetes)
+			require.True(t, ok)
+			assert.JSONEq(t, `{"host":"node-1","namespace_name":"default","pod_id":"uid-0","pod_name":"app-0"}`, kubernetes.Str())
+		}
+	}
+}
+
+func TestGetMetricsWithDecorator(t *testing.T) {
+	client := &mockSummaryClient{
+		summaries: []NodeSummary{{Summary: loadSummary(t), NumCores: 2, MemoryCapacity: 10000}},
+	}
+	k := New(t.Context(), client, mockClusterNameProvider("cluster"), zap.NewNop(), WithDecorator(mockDecorator{}))
+	defer func() { require.NoError(t, k.Shutdown()) }()
+
+	result := k.GetMetrics()
+	require.Len(t, result, 3)
+	for _, md := range result {
+		decorated, ok := md.ResourceMetrics().At(0).Resource().Attributes().Get("decorated")
+		require.True(t, ok)
+		assert.Equal(t, "true", decorated.Str())
+	}
+}
+
+func TestGetMetricsWithoutClusterName(t *testing.T) {
+	client := &mockSummaryClient{
+		summaries: []NodeSummary{{Summary: loadSummary(t), NumCores: 2, MemoryCapacity: 10000}},
+	}
+	k := New(t.Context(), client, mockClusterNameProvider(""), zap.NewNop())
+	defer func() { require.NoError(t, k.Shutdown()) }()
+
+	assert.Empty(t, k.GetMetrics())
+}
@@ -9,6 +9,7 @@ import (
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/kubelet"
@@ -62,3 +63,19 @@ func (k *KubeletClient) ListPods() ([]corev1.Pod, error) {
 
 	return pods.Items, nil
 }
+
+// Summary returns the stats summary of the node from the /stats/summary endpoint.
+func (k *KubeletClient) Summary() (*stats.Summary, error) {
+	b, err := k.restClient.Get("/stats/summary")
+	if err != nil {
+		return nil, fmt.Errorf("call to /stats/summary endpoint failed: %w", err)
+	}
+
+	summary := &stats.Summary{}
+	err = json.Unmarshal(b, summary)
+	if err != nil {
+		return nil, fmt.Errorf("parsing response failed: %w", err)
+	}
+
+	return summary, nil
+}
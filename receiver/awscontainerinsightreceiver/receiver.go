@@ -6,6 +6,8 @@ package awscontainerinsightreceiver // import "github.com/open-telemetry/opentel
 import (
 	"context"
 	"errors"
+	"os"
+	"runtime"
 	"sync"
 	"time"
 
@@ -16,10 +18,12 @@ import (
 	"go.uber.org/zap"
 
 	ci "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/containerinsight"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/k8s/k8sclient"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/cadvisor"
 	ecsinfo "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/ecsInfo"
 	hostInfo "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/host"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/k8sapiserver"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/kubeletsummary"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/stores"
 )
 
@@ -59,6 +63,14 @@ func newAWSContainerInsightReceiver(
 func (acir *awsContainerInsightReceiver) Start(ctx context.Context, host component.Host) error {
 	ctx, acir.cancel = context.WithCancel(ctx)
 
+	if acir.config.ComputeType == computeTypeFargate {
+		if err := acir.startFargate(ctx); err != nil {
+			return err
+		}
+		acir.startCollection(ctx)
+		return nil
+	}
+
 	hostinfo, err := hostInfo.NewInfo(acir.config.ContainerOrchestrator, acir.config.CollectionInterval, acir.settings.Logger)
 	if err != nil {
 		return err
@@ -70,10 +82,19 @@ func (acir *awsContainerInsightReceiver) Start(ctx context.Context, host compone
 			return err
 		}
 
-		decoratorOption := cadvisor.WithDecorator(k8sDecorator)
-		acir.cadvisor, err = cadvisor.New(acir.config.ContainerOrchestrator, hostinfo, acir.settings.Logger, decoratorOption)
-		if err != nil {
-			return err
+		if runtime.GOOS == "windows" {
+			// cadvisor doesn't support windows, the metrics are generated from the stats summary of the kubelet instead
+			client, err := kubeletsummary.NewKubeletClient(os.Getenv("HOST_IP"), hostinfo, acir.settings.Logger)
+			if err != nil {
+				return err
+			}
+			acir.cadvisor = kubeletsummary.New(ctx, client, hostinfo, acir.settings.Logger, kubeletsummary.WithDecorator(k8sDecorator))
+		} else {
+			decoratorOption := cadvisor.WithDecorator(k8sDecorator)
+			acir.cadvisor, err = cadvisor.New(acir.config.ContainerOrchestrator, hostinfo, acir.settings.Logger, decoratorOption)
+			if err != nil {
+				return err
+			}
 		}
 		acir.k8sapiserver, err = k8sapiserver.New(hostinfo, acir.settings.Logger)
 		if err != nil {
@@ -94,6 +115,28 @@ func (acir *awsContainerInsightReceiver) Start(ctx context.Context, host compone
 		}
 	}
 
+	acir.startCollection(ctx)
+	return nil
+}
+
+// startFargate collects the metrics of the Fargate nodes through the API server. The EC2 instance metadata isn't
+// available on Fargate, so the cluster name is read from the configuration.
+func (acir *awsContainerInsightReceiver) startFargate(ctx context.Context) error {
+	k8sClient := k8sclient.Get(acir.settings.Logger)
+	if k8sClient == nil {
+		return errors.New("failed to start the collection of fargate metrics because k8sclient is nil")
+	}
+
+	clusterName := staticClusterName(acir.config.ClusterName)
+	acir.cadvisor = kubeletsummary.New(ctx, kubeletsummary.NewAPIServerClient(k8sClient.GetClientSet()), clusterName, acir.settings.Logger)
+
+	var err error
+	acir.k8sapiserver, err = k8sapiserver.New(clusterName, acir.settings.Logger)
+	return err
+}
+
+// startCollection collects the metrics at every collection interval until the context is canceled
+func (acir *awsContainerInsightReceiver) startCollection(ctx context.Context) {
 	acir.cancelWg.Add(1)
 	go func() {
 		defer acir.cancelWg.Done()
@@ -117,8 +160,6 @@ func (acir *awsContainerInsightReceiver) Start(ctx context.Context, host compone
 			}
 		}
 	}()
-
-	return nil
 }
 
 // Shutdown stops the awsContainerInsightReceiver receiver.
@@ -141,6 +182,13 @@ func (acir *awsContainerInsightReceiver) Shutdown(context.Context) error {
 	return errs
 }
 
+// staticClusterName provides the cluster name set in the configuration
+type staticClusterName string
+
+func (s staticClusterName) GetClusterName() string {
+	return string(s)
+}
+
 // collectData collects container stats from cAdvisor and k8s api server (if it is an elected leader)
 func (acir *awsContainerInsightReceiver) collectData(ctx context.Context) error {
 	var mds []pmetric.Metrics
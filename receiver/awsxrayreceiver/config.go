@@ -19,6 +19,12 @@ type Config struct {
 	// ProxyServer defines configurations related to the local TCP proxy server.
 	ProxyServer *proxy.Config `mapstructure:"proxy_server"`
 
+	// SegmentFidelity records the fields of the X-Ray segments which have no
+	// OpenTelemetry equivalent, e.g. the subsegment namespace and the fault,
+	// error and throttle flags, as span attributes. The X-Ray exporter restores
+	// them in the segments it sends when its segment fidelity mode is enabled.
+	SegmentFidelity bool `mapstructure:"segment_fidelity"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
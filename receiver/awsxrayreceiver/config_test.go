@@ -80,6 +80,14 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "segment_fidelity"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.SegmentFidelity = true
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
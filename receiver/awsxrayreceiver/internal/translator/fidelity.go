@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsxrayreceiver/internal/translator"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	awsxray "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray"
+)
+
+// addSegmentFields records the fields of the segment which are lost or only
+// approximated in the span, so that the X-Ray exporter can send them back
+// unchanged.
+func addSegmentFields(seg *awsxray.Segment, attrs pcommon.Map) {
+	addString(seg.Namespace, awsxray.AWSXRayNamespaceAttribute, attrs)
+	addBool(seg.Fault, awsxray.AWSXRayFaultAttribute, attrs)
+	addBool(seg.Error, awsxray.AWSXRayErrorAttribute, attrs)
+	addBool(seg.Throttle, awsxray.AWSXRayThrottleAttribute, attrs)
+	addString(seg.Origin, awsxray.AWSXRayOriginAttribute, attrs)
+	if len(seg.PrecursorIDs) > 0 {
+		precursorIDs := attrs.PutEmptySlice(awsxray.AWSXRayPrecursorIDsAttribute)
+		precursorIDs.EnsureCapacity(len(seg.PrecursorIDs))
+		for _, id := range seg.PrecursorIDs {
+			precursorIDs.AppendEmpty().SetStr(id)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	awsxray "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray/telemetry"
+)
+
+const fidelitySegment = `{
+    "trace_id": "1-5f29ab21-d4ebf299219a65bd5c31d6da",
+    "id": "88ad1df59cd7a7be",
+    "name": "frontend",
+    "origin": "AWS::EC2::Instance",
+    "start_time": 1596566305.535414,
+    "end_time": 1596566305.5928545,
+    "throttle": true,
+    "error": true,
+    "subsegments": [
+        {
+            "id": "7df694142c905d8d",
+            "name": "backend",
+            "namespace": "remote",
+            "start_time": 1596566305.5354965,
+            "end_time": 1596566305.5928457,
+            "fault": true,
+            "precursor_ids": ["e0c4d5a6f1f2e3b4"]
+        },
+        {
+            "id": "8ee7e1c4e4bbf3a2",
+            "name": "compute",
+            "namespace": "local",
+            "start_time": 1596566305.5354965,
+            "end_time": 1596566305.5928457
+        }
+    ]
+}`
+
+func spansByName(traces ptrace.Traces) map[string]ptrace.Span {
+	result := map[string]ptrace.Span{}
+	spans := traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	for i := 0; i < spans.Len(); i++ {
+		result[spans.At(i).Name()] = spans.At(i)
+	}
+	return result
+}
+
+func TestToTracesWithSegmentFidelity(t *testing.T) {
+	traces, count, err := ToTraces([]byte(fidelitySegment), telemetry.NewRecorder(), true)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	spans := spansByName(traces)
+
+	frontend := spans["frontend"].Attributes()
+	assert.Equal(t, map[string]any{
+		awsxray.AWSXRayErrorAttribute:    true,
+		awsxray.AWSXRayThrottleAttribute: true,
+		awsxray.AWSXRayOriginAttribute:   "AWS::EC2::Instance",
+	}, fidelityAttributes(frontend))
+
+	backend := spans["backend"].Attributes()
+	assert.Equal(t, map[string]any{
+		awsxray.AWSXRayNamespaceAttribute:    "remote",
+		awsxray.AWSXRayFaultAttribute:        true,
+		awsxray.AWSXRayPrecursorIDsAttribute: []any{"e0c4d5a6f1f2e3b4"},
+	}, fidelityAttributes(backend))
+
+	compute := spans["compute"].Attributes()
+	assert.Equal(t, map[string]any{
+		awsxray.AWSXRayNamespaceAttribute: "local",
+	}, fidelityAttributes(compute))
+}
+
+func TestToTracesWithoutSegmentFidelity(t *testing.T) {
+	traces, _, err := ToTraces([]byte(fidelitySegment), telemetry.NewRecorder(), false)
+	require.NoError(t, err)
+	for name, span := range spansByName(traces) {
+		assert.Empty(t, fidelityAttributes(span.Attributes()), name)
+	}
+}
+
+// fidelityAttributes returns the attributes recorded by the segment fidelity mode.
+func fidelityAttributes(attrs pcommon.Map) map[string]any {
+	result := map[string]any{}
+	for _, key := range []string{
+		awsxray.AWSXRayNamespaceAttribute,
+		awsxray.AWSXRayFaultAttribute,
+		awsxray.AWSXRayErrorAttribute,
+		awsxray.AWSXRayThrottleAttribute,
+		awsxray.AWSXRayOriginAttribute,
+		awsxray.AWSXRayPrecursorIDsAttribute,
+	} {
+		if v, ok := attrs.Get(key); ok {
+			result[key] = v.AsRaw()
+		}
+	}
+	return result
+}
@@ -26,7 +26,9 @@ const (
 // `toPdata` in this receiver to a common package later
 
 // ToTraces converts X-Ray segment (and its subsegments) to an OT ResourceSpans.
-func ToTraces(rawSeg []byte, recorder telemetry.Recorder) (ptrace.Traces, int, error) {
+// With segmentFidelity, the segment fields which have no equivalent in the
+// spans are recorded as span attributes, to be restored by the X-Ray exporter.
+func ToTraces(rawSeg []byte, recorder telemetry.Recorder, segmentFidelity bool) (ptrace.Traces, int, error) {
 	seg := &awsxray.Segment{}
 	err := json.Unmarshal(rawSeg, seg)
 	if err != nil {
@@ -69,7 +71,7 @@ func ToTraces(rawSeg []byte, recorder telemetry.Recorder) (ptrace.Traces, int, e
 	// Sometimes, subsegments are sent separately in an async workflow,
 	// check segment type to determine the proper span kind.
 	isSubsegment := seg.ParentID != nil && seg.Type != nil && strings.EqualFold(*seg.Type, "subsegment")
-	_, err = segToSpans(seg, seg.TraceID, nil, isSubsegment, spans)
+	_, err = segToSpans(seg, seg.TraceID, nil, isSubsegment, segmentFidelity, spans)
 	if err != nil {
 		recorder.RecordSegmentsRejected(count)
 		return ptrace.Traces{}, count, err
@@ -78,10 +80,10 @@ func ToTraces(rawSeg []byte, recorder telemetry.Recorder) (ptrace.Traces, int, e
 	return traceData, count, nil
 }
 
-func segToSpans(seg *awsxray.Segment, traceID, parentID *string, isSubsegment bool, spans ptrace.SpanSlice) (ptrace.Span, error) {
+func segToSpans(seg *awsxray.Segment, traceID, parentID *string, isSubsegment, segmentFidelity bool, spans ptrace.SpanSlice) (ptrace.Span, error) {
 	span := spans.AppendEmpty()
 
-	err := populateSpan(seg, traceID, parentID, isSubsegment, span)
+	err := populateSpan(seg, traceID, parentID, isSubsegment, segmentFidelity, span)
 	if err != nil {
 		return ptrace.Span{}, err
 	}
@@ -90,7 +92,7 @@ func segToSpans(seg *awsxray.Segment, traceID, parentID *string, isSubsegment bo
 	for i := range seg.Subsegments {
 		s := &seg.Subsegments[i]
 		populatedChildSpan, err = segToSpans(s,
-			traceID, seg.ID, true,
+			traceID, seg.ID, true, segmentFidelity,
 			spans)
 		if err != nil {
 			return ptrace.Span{}, err
@@ -114,7 +116,7 @@ func segToSpans(seg *awsxray.Segment, traceID, parentID *string, isSubsegment bo
 	return span, nil
 }
 
-func populateSpan(seg *awsxray.Segment, traceID, parentID *string, isSubsegment bool, span ptrace.Span) error {
+func populateSpan(seg *awsxray.Segment, traceID, parentID *string, isSubsegment, segmentFidelity bool, span ptrace.Span) error {
 	attrs := span.Attributes()
 	attrs.Clear()
 	attrs.EnsureCapacity(initAttrCapacity)
@@ -182,6 +184,9 @@ func populateSpan(seg *awsxray.Segment, traceID, parentID *string, isSubsegment
 	}
 
 	addBool(seg.Traced, awsxray.AWSXRayTracedAttribute, attrs)
+	if segmentFidelity {
+		addSegmentFields(seg, attrs)
+	}
 
 	addAnnotations(seg.Annotations, attrs)
 	return addMetadata(seg.Metadata, attrs)
@@ -1019,7 +1019,7 @@ func TestTranslation(t *testing.T) {
 			}
 
 			recorder := telemetry.NewRecorder()
-			traces, totalSpanCount, err := ToTraces(content, recorder)
+			traces, totalSpanCount, err := ToTraces(content, recorder, false)
 			if err == nil || (!tc.expectedUnmarshallFailure && expectedRs.ScopeSpans().Len() > 0 && expectedRs.ScopeSpans().At(0).Spans().Len() > 0) {
 				assert.Equal(t, totalSpanCount,
 					expectedRs.ScopeSpans().At(0).Spans().Len(),
@@ -36,6 +36,8 @@ type xrayReceiver struct {
 	consumer consumer.Traces
 	obsrecv  *receiverhelper.ObsReport
 	registry telemetry.Registry
+
+	segmentFidelity bool
 }
 
 func newReceiver(config *Config,
@@ -77,6 +79,8 @@ func newReceiver(config *Config,
 		consumer: consumer,
 		obsrecv:  obsrecv,
 		registry: telemetry.GlobalRegistry(),
+
+		segmentFidelity: config.SegmentFidelity,
 	}, nil
 }
 
@@ -107,7 +111,7 @@ func (x *xrayReceiver) start() {
 	incomingSegments := x.poller.SegmentsChan()
 	for seg := range incomingSegments {
 		ctx := x.obsrecv.StartTracesOp(seg.Ctx)
-		traces, totalSpanCount, err := translator.ToTraces(seg.Payload, x.registry.LoadOrNop(x.settings.ID))
+		traces, totalSpanCount, err := translator.ToTraces(seg.Payload, x.registry.LoadOrNop(x.settings.ID), x.segmentFidelity)
 		if err != nil {
 			x.settings.Logger.Warn("X-Ray segment to OT traces conversion failed", zap.Error(err))
 			x.obsrecv.EndTracesOp(ctx, metadata.Type.String(), totalSpanCount, err)
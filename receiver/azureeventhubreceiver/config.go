@@ -6,6 +6,8 @@ package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs/v2"
 	"go.opentelemetry.io/collector/component"
@@ -21,6 +23,7 @@ const (
 
 var (
 	validFormats         = []logFormat{defaultLogFormat, rawLogFormat, azureLogFormat}
+	validStrategies      = []string{"", string(azeventhubs.ProcessorStrategyBalanced), string(azeventhubs.ProcessorStrategyGreedy)}
 	errMissingConnection = errors.New("missing connection")
 )
 
@@ -40,6 +43,27 @@ type Config struct {
 	// azeventhub lib specific
 	PollRate      int `mapstructure:"poll_rate"`
 	MaxPollEvents int `mapstructure:"max_poll_events"`
+
+	// CheckpointStore configures the Azure Blob Storage container used to store the checkpoints
+	// and to balance the partitions between all the receivers consuming the Event Hub.
+	CheckpointStore *CheckpointStoreConfig `mapstructure:"checkpoint_store"`
+}
+
+// CheckpointStoreConfig defines the configuration for storing checkpoints and partition
+// ownership in an Azure Blob Storage container.
+type CheckpointStoreConfig struct {
+	// Connection is the connection string of the storage account. Ignored if auth is specified.
+	Connection string `mapstructure:"connection"`
+	// ServiceURL is the URL of the blob service of the storage account, required when using auth.
+	ServiceURL string `mapstructure:"service_url"`
+	// Container is the name of the blob container. It must already exist.
+	Container string `mapstructure:"container"`
+	// LoadBalancingStrategy is how partitions are claimed, either "balanced" or "greedy".
+	LoadBalancingStrategy string `mapstructure:"load_balancing_strategy"`
+	// UpdateInterval is how often the receiver attempts to claim partitions.
+	UpdateInterval time.Duration `mapstructure:"update_interval"`
+	// PartitionExpiration is how long a partition stays owned after its owner stopped renewing it.
+	PartitionExpiration time.Duration `mapstructure:"partition_expiration"`
 }
 
 // EventHubConfig defines the configuration for an Azure Event Hub when
@@ -84,5 +108,38 @@ func (config *Config) Validate() error {
 	if config.Partition == "" && config.Offset != "" {
 		return errors.New("cannot use 'offset' without 'partition'")
 	}
+
+	if config.CheckpointStore != nil {
+		if config.Partition != "" {
+			return errors.New("cannot use 'partition' with 'checkpoint_store'")
+		}
+		if config.StorageID != nil {
+			return errors.New("cannot use 'storage' with 'checkpoint_store'")
+		}
+		return config.CheckpointStore.validate(config.Auth != nil)
+	}
+	return nil
+}
+
+func (config *CheckpointStoreConfig) validate(useAuth bool) error {
+	if useAuth {
+		if config.ServiceURL == "" {
+			return errors.New("checkpoint_store.service_url is required when using auth")
+		}
+	} else if config.Connection == "" {
+		return errors.New("checkpoint_store.connection is required when not using auth")
+	}
+	if config.Container == "" {
+		return errors.New("checkpoint_store.container is required")
+	}
+	if !slices.Contains(validStrategies, config.LoadBalancingStrategy) {
+		return fmt.Errorf("invalid checkpoint_store.load_balancing_strategy; must be one of %#v", validStrategies)
+	}
+	if config.UpdateInterval < 0 {
+		return errors.New("checkpoint_store.update_interval must not be negative")
+	}
+	if config.PartitionExpiration < 0 {
+		return errors.New("checkpoint_store.partition_expiration must not be negative")
+	}
 	return nil
 }
@@ -6,6 +6,7 @@ package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -77,6 +78,61 @@ func TestLoadConfig(t *testing.T) {
 			id:                  component.NewIDWithName(metadata.Type, "auth_missing_namespace"),
 			expectedErrContains: "event_hub.namespace is required when using auth",
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "checkpoint_store"),
+			expected: &Config{
+				Connection: "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName",
+				CheckpointStore: &CheckpointStoreConfig{
+					Connection:            "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=superSecret1234=;EndpointSuffix=core.windows.net",
+					Container:             "checkpoints",
+					LoadBalancingStrategy: "greedy",
+					UpdateInterval:        5 * time.Second,
+					PartitionExpiration:   30 * time.Second,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "checkpoint_store_auth"),
+			expected: &Config{
+				EventHub: EventHubConfig{
+					Name:      "hubName",
+					Namespace: "namespace.servicebus.windows.net",
+				},
+				Auth: &authID,
+				CheckpointStore: &CheckpointStoreConfig{
+					ServiceURL: "https://account.blob.core.windows.net/",
+					Container:  "checkpoints",
+				},
+			},
+		},
+		{
+			id:                  component.NewIDWithName(metadata.Type, "checkpoint_store_auth_missing_service_url"),
+			expectedErrContains: "checkpoint_store.service_url is required when using auth",
+		},
+		{
+			id:                  component.NewIDWithName(metadata.Type, "checkpoint_store_missing_connection"),
+			expectedErrContains: "checkpoint_store.connection is required when not using auth",
+		},
+		{
+			id:                  component.NewIDWithName(metadata.Type, "checkpoint_store_missing_container"),
+			expectedErrContains: "checkpoint_store.container is required",
+		},
+		{
+			id:                  component.NewIDWithName(metadata.Type, "checkpoint_store_invalid_strategy"),
+			expectedErrContains: "invalid checkpoint_store.load_balancing_strategy",
+		},
+		{
+			id:                  component.NewIDWithName(metadata.Type, "checkpoint_store_negative_update_interval"),
+			expectedErrContains: "checkpoint_store.update_interval must not be negative",
+		},
+		{
+			id:                  component.NewIDWithName(metadata.Type, "checkpoint_store_with_partition"),
+			expectedErrContains: "cannot use 'partition' with 'checkpoint_store'",
+		},
+		{
+			id:                  component.NewIDWithName(metadata.Type, "checkpoint_store_with_storage"),
+			expectedErrContains: "cannot use 'storage' with 'checkpoint_store'",
+		},
 	}
 
 	for _, tt := range tests {
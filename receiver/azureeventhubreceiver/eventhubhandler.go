@@ -40,6 +40,7 @@ var errNoConfig = errors.New("Configuration error, hub not accessible")
 
 type eventhubHandler struct {
 	hub           hubWrapper
+	processor     azProcessor
 	dataConsumer  dataConsumer
 	config        *Config
 	settings      receiver.Settings
@@ -63,6 +64,10 @@ func (h *eventhubHandler) run(ctx context.Context, host component.Host) error {
 		h.storageClient = storageClient
 	}
 
+	if h.config.CheckpointStore != nil {
+		return h.runProcessor(ctx, host)
+	}
+
 	if h.hub == nil { // set manually for testing.
 		newHub, err := newAzeventhubWrapper(h, host)
 		if err != nil {
@@ -139,6 +144,13 @@ func (h *eventhubHandler) close(ctx context.Context) error {
 		}
 		h.hub = nil
 	}
+	if h.processor != nil {
+		err := h.processor.Close(ctx)
+		if err != nil {
+			errs = errors.Join(errs, err)
+		}
+		h.processor = nil
+	}
 	if h.cancel != nil {
 		h.cancel()
 	}
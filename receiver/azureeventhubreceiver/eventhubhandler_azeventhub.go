@@ -70,13 +70,9 @@ func createConsumerClient(
 		if config.Connection != "" {
 			logger.Warn("both 'auth' and 'connection' are specified, 'connection' will be ignored.")
 		}
-		ext, ok := host.GetExtensions()[*config.Auth]
-		if !ok {
-			return nil, fmt.Errorf("failed to resolve auth extension %q", *config.Auth)
-		}
-		credential, ok := ext.(azcore.TokenCredential)
-		if !ok {
-			return nil, fmt.Errorf("extension %q does not implement azcore.TokenCredential", *config.Auth)
+		credential, err := getTokenCredential(config, host)
+		if err != nil {
+			return nil, err
 		}
 
 		return azeventhubs.NewConsumerClient(
@@ -95,6 +91,19 @@ func createConsumerClient(
 	)
 }
 
+// getTokenCredential returns the credential provided by the auth extension.
+func getTokenCredential(config *Config, host component.Host) (azcore.TokenCredential, error) {
+	ext, ok := host.GetExtensions()[*config.Auth]
+	if !ok {
+		return nil, fmt.Errorf("failed to resolve auth extension %q", *config.Auth)
+	}
+	credential, ok := ext.(azcore.TokenCredential)
+	if !ok {
+		return nil, fmt.Errorf("extension %q does not implement azcore.TokenCredential", *config.Auth)
+	}
+	return credential, nil
+}
+
 func newAzeventhubWrapper(h *eventhubHandler, host component.Host) (*hubWrapperAzeventhubImpl, error) {
 	consumerGroup := getConsumerGroup(h.config)
 
@@ -209,17 +218,8 @@ func (h *hubWrapperAzeventhubImpl) Receive(ctx context.Context, partitionID stri
 					return
 				}
 
-				maxPollEvents := 100
-				pollRate := 5
-				if h.config != nil {
-					if h.config.MaxPollEvents != 0 {
-						maxPollEvents = h.config.MaxPollEvents
-					}
-					if h.config.PollRate != 0 {
-						pollRate = h.config.PollRate
-					}
-				}
-				timeout, cancelTimeout := context.WithTimeout(ctx, time.Second*time.Duration(pollRate))
+				maxPollEvents, pollRate := getPollSettings(h.config)
+				timeout, cancelTimeout := context.WithTimeout(ctx, pollRate)
 				events, err := pc.ReceiveEvents(timeout, maxPollEvents, nil)
 				cancelTimeout()
 				if err != nil && !errors.Is(err, context.DeadlineExceeded) {
@@ -259,6 +259,22 @@ func (h *hubWrapperAzeventhubImpl) Receive(ctx context.Context, partitionID stri
 	return nil, errNoConfig
 }
 
+// getPollSettings returns the maximum number of events to retrieve in a single poll, and
+// how long to wait for them.
+func getPollSettings(config *Config) (int, time.Duration) {
+	maxPollEvents := 100
+	pollRate := 5
+	if config != nil {
+		if config.MaxPollEvents != 0 {
+			maxPollEvents = config.MaxPollEvents
+		}
+		if config.PollRate != 0 {
+			pollRate = config.PollRate
+		}
+	}
+	return maxPollEvents, time.Second * time.Duration(pollRate)
+}
+
 func (h *hubWrapperAzeventhubImpl) Close(ctx context.Context) error {
 	if h.hub != nil {
 		return h.hub.Close(ctx)
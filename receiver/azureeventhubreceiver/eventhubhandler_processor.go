@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs/v2"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs/v2/checkpoints"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap"
+)
+
+// azProcessor distributes the partitions of the Event Hub between all the processors sharing
+// the same checkpoint store, and hands out a client for each partition it claims.
+type azProcessor interface {
+	Run(ctx context.Context) error
+	NextPartitionClient(ctx context.Context) azProcessorPartitionClient
+	Close(ctx context.Context) error
+}
+
+type azProcessorPartitionClient interface {
+	PartitionID() string
+	ReceiveEvents(ctx context.Context, count int, options *azeventhubs.ReceiveEventsOptions) ([]*azeventhubs.ReceivedEventData, error)
+	UpdateCheckpoint(ctx context.Context, latestEvent *azeventhubs.ReceivedEventData, options *azeventhubs.UpdateCheckpointOptions) error
+	Close(ctx context.Context) error
+}
+
+type azProcessorWrapper struct {
+	processor *azeventhubs.Processor
+	client    *azeventhubs.ConsumerClient
+}
+
+func (w *azProcessorWrapper) Run(ctx context.Context) error {
+	return w.processor.Run(ctx)
+}
+
+func (w *azProcessorWrapper) NextPartitionClient(ctx context.Context) azProcessorPartitionClient {
+	// avoid returning a non-nil interface holding a nil pointer once the processor stopped
+	if pc := w.processor.NextPartitionClient(ctx); pc != nil {
+		return pc
+	}
+	return nil
+}
+
+func (w *azProcessorWrapper) Close(ctx context.Context) error {
+	return w.client.Close(ctx)
+}
+
+// createCheckpointStore creates the checkpoint store on the configured Azure Blob Storage container.
+// If auth is configured, it uses the auth extension to access the storage account.
+// Otherwise, it uses the storage account connection string.
+func createCheckpointStore(config *Config, host component.Host) (azeventhubs.CheckpointStore, error) {
+	var client *azblob.Client
+	var err error
+	if config.Auth != nil {
+		credential, credErr := getTokenCredential(config, host)
+		if credErr != nil {
+			return nil, credErr
+		}
+		client, err = azblob.NewClient(config.CheckpointStore.ServiceURL, credential, nil)
+	} else {
+		client, err = azblob.NewClientFromConnectionString(config.CheckpointStore.Connection, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return checkpoints.NewBlobStore(client.ServiceClient().NewContainerClient(config.CheckpointStore.Container), nil)
+}
+
+func newAzeventhubProcessor(h *eventhubHandler, host component.Host) (*azProcessorWrapper, error) {
+	checkpointStore, err := createCheckpointStore(h.config, host)
+	if err != nil {
+		h.settings.Logger.Debug("Error connecting to the checkpoint store", zap.Error(err))
+		return nil, err
+	}
+
+	client, err := createConsumerClient(h.config, host, getConsumerGroup(h.config), h.settings.Logger)
+	if err != nil {
+		h.settings.Logger.Debug("Error connecting to Event Hub", zap.Error(err))
+		return nil, err
+	}
+
+	processor, err := azeventhubs.NewProcessor(client, checkpointStore, &azeventhubs.ProcessorOptions{
+		LoadBalancingStrategy:       azeventhubs.ProcessorStrategy(h.config.CheckpointStore.LoadBalancingStrategy),
+		UpdateInterval:              h.config.CheckpointStore.UpdateInterval,
+		PartitionExpirationDuration: h.config.CheckpointStore.PartitionExpiration,
+		// start with the latest events when there is no checkpoint yet, as when consuming without it
+		StartPositions: azeventhubs.StartPositions{
+			Default: azeventhubs.StartPosition{Latest: to.Ptr(true)},
+		},
+	})
+	if err != nil {
+		return nil, errors.Join(err, client.Close(context.Background()))
+	}
+
+	return &azProcessorWrapper{
+		processor: processor,
+		client:    client,
+	}, nil
+}
+
+// runProcessor consumes the partitions claimed by the processor until the context is cancelled.
+func (h *eventhubHandler) runProcessor(ctx context.Context, host component.Host) error {
+	if h.processor == nil { // set manually for testing.
+		processor, err := newAzeventhubProcessor(h, host)
+		if err != nil {
+			return err
+		}
+		h.processor = processor
+	}
+
+	processor := h.processor
+	go h.dispatchPartitionClients(ctx, processor)
+	go func() {
+		if err := processor.Run(ctx); err != nil {
+			h.settings.Logger.Error("Error reported by event hub processor", zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+func (h *eventhubHandler) dispatchPartitionClients(ctx context.Context, processor azProcessor) {
+	for {
+		pc := processor.NextPartitionClient(ctx)
+		if pc == nil {
+			// the processor stopped
+			return
+		}
+		go h.processPartition(ctx, pc)
+	}
+}
+
+// processPartition consumes the events of a claimed partition, and checkpoints them once they
+// are consumed. The events which can't be consumed because of a permanent error, e.g. because they
+// can't be unmarshaled, are skipped. It returns when the ownership of the partition is lost or on
+// a transient error, in which case the processor claims the partition again and the events are
+// consumed from the last checkpoint.
+func (h *eventhubHandler) processPartition(ctx context.Context, pc azProcessorPartitionClient) {
+	defer pc.Close(context.WithoutCancel(ctx))
+
+	maxPollEvents, pollRate := getPollSettings(h.config)
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		timeout, cancelTimeout := context.WithTimeout(ctx, pollRate)
+		events, err := pc.ReceiveEvents(timeout, maxPollEvents, nil)
+		cancelTimeout()
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			var eventHubErr *azeventhubs.Error
+			if errors.As(err, &eventHubErr) && eventHubErr.Code == azeventhubs.ErrorCodeOwnershipLost {
+				h.settings.Logger.Debug("Ownership of partition lost", zap.String("partition", pc.PartitionID()))
+				return
+			}
+			if ctx.Err() == nil {
+				h.settings.Logger.Error("Error reported by event hub", zap.Error(err), zap.String("partition", pc.PartitionID()))
+			}
+			return
+		}
+
+		// the last event which doesn't need to be consumed again
+		var last *azeventhubs.ReceivedEventData
+		failed := false
+		for _, ev := range events {
+			err := h.newMessageHandler(ctx, &azureEvent{
+				AzEventData: ev,
+			})
+			if err != nil && !consumererror.IsPermanent(err) {
+				failed = true
+				break
+			}
+			last = ev
+		}
+
+		if last != nil {
+			if err := pc.UpdateCheckpoint(ctx, last, nil); err != nil {
+				h.settings.Logger.Error("Error updating checkpoint", zap.Error(err), zap.String("partition", pc.PartitionID()))
+				return
+			}
+		}
+		if failed {
+			// the events from the failed one are consumed again by the next owner of the partition
+			return
+		}
+	}
+}
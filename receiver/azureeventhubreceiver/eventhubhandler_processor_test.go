@@ -0,0 +1,297 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver/internal/metadata"
+)
+
+type mockProcessorPartitionClient struct {
+	partitionID string
+	batches     [][]*azeventhubs.ReceivedEventData
+	err         error
+
+	mu         sync.Mutex
+	checkpoint *azeventhubs.ReceivedEventData
+	closed     bool
+}
+
+func (p *mockProcessorPartitionClient) PartitionID() string {
+	return p.partitionID
+}
+
+func (p *mockProcessorPartitionClient) ReceiveEvents(ctx context.Context, _ int, _ *azeventhubs.ReceiveEventsOptions) ([]*azeventhubs.ReceivedEventData, error) {
+	p.mu.Lock()
+	if len(p.batches) > 0 {
+		events := p.batches[0]
+		p.batches = p.batches[1:]
+		p.mu.Unlock()
+		return events, nil
+	}
+	p.mu.Unlock()
+	if p.err != nil {
+		return nil, p.err
+	}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (p *mockProcessorPartitionClient) UpdateCheckpoint(_ context.Context, latestEvent *azeventhubs.ReceivedEventData, _ *azeventhubs.UpdateCheckpointOptions) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.checkpoint = latestEvent
+	return nil
+}
+
+func (p *mockProcessorPartitionClient) Close(context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	return nil
+}
+
+func (p *mockProcessorPartitionClient) state() (*azeventhubs.ReceivedEventData, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.checkpoint, p.closed
+}
+
+type mockProcessor struct {
+	clients chan azProcessorPartitionClient
+	closed  bool
+}
+
+func (*mockProcessor) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (m *mockProcessor) NextPartitionClient(ctx context.Context) azProcessorPartitionClient {
+	select {
+	case pc := <-m.clients:
+		return pc
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+func (m *mockProcessor) Close(context.Context) error {
+	m.closed = true
+	return nil
+}
+
+func newTestProcessorHandler(t *testing.T, sink *consumertest.LogsSink, processor azProcessor) *eventhubHandler {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             component.NewID(metadata.Type),
+		ReceiverCreateSettings: receivertest.NewNopSettings(metadata.Type),
+	})
+	require.NoError(t, err)
+
+	config := createDefaultConfig().(*Config)
+	config.Connection = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName"
+	config.CheckpointStore = &CheckpointStoreConfig{
+		Connection: "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=key;EndpointSuffix=core.windows.net",
+		Container:  "checkpoints",
+	}
+	config.PollRate = 1
+
+	return &eventhubHandler{
+		settings: receivertest.NewNopSettings(metadata.Type),
+		config:   config,
+		dataConsumer: &mockDataConsumer{
+			logsUnmarshaler:  newRawLogsUnmarshaler(zap.NewNop()),
+			nextLogsConsumer: sink,
+			obsrecv:          obsrecv,
+		},
+		processor: processor,
+	}
+}
+
+func newTestEvents(bodies ...string) []*azeventhubs.ReceivedEventData {
+	events := make([]*azeventhubs.ReceivedEventData, 0, len(bodies))
+	for i, body := range bodies {
+		events = append(events, &azeventhubs.ReceivedEventData{
+			EventData:      azeventhubs.EventData{Body: []byte(body)},
+			SequenceNumber: int64(i),
+		})
+	}
+	return events
+}
+
+func TestEventhubHandler_runProcessor(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	processor := &mockProcessor{clients: make(chan azProcessorPartitionClient)}
+	ehHandler := newTestProcessorHandler(t, sink, processor)
+
+	require.NoError(t, ehHandler.run(t.Context(), componenttest.NewNopHost()))
+
+	events := newTestEvents("hello", "world")
+	pc1 := &mockProcessorPartitionClient{partitionID: "0", batches: [][]*azeventhubs.ReceivedEventData{events}}
+	pc2 := &mockProcessorPartitionClient{partitionID: "1", batches: [][]*azeventhubs.ReceivedEventData{newTestEvents("foo")}}
+	processor.clients <- pc1
+	processor.clients <- pc2
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, 3, sink.LogRecordCount())
+		checkpoint, _ := pc1.state()
+		assert.Same(c, events[1], checkpoint)
+		checkpoint, _ = pc2.state()
+		assert.NotNil(c, checkpoint)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, ehHandler.close(t.Context()))
+	assert.True(t, processor.closed)
+	assert.Nil(t, ehHandler.processor)
+	assert.Eventually(t, func() bool {
+		_, closed1 := pc1.state()
+		_, closed2 := pc2.state()
+		return closed1 && closed2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestEventhubHandler_processPartition(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "ownership lost",
+			err:  &azeventhubs.Error{Code: azeventhubs.ErrorCodeOwnershipLost},
+		},
+		{
+			name: "receive error",
+			err:  errors.New("receive error"),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			sink := new(consumertest.LogsSink)
+			ehHandler := newTestProcessorHandler(t, sink, &mockProcessor{})
+
+			events := newTestEvents("hello")
+			pc := &mockProcessorPartitionClient{
+				partitionID: "0",
+				batches:     [][]*azeventhubs.ReceivedEventData{events},
+				err:         test.err,
+			}
+
+			// returns once the partition client fails
+			ehHandler.processPartition(t.Context(), pc)
+
+			assert.Equal(t, 1, sink.LogRecordCount())
+			checkpoint, closed := pc.state()
+			assert.Same(t, events[0], checkpoint)
+			assert.True(t, closed)
+		})
+	}
+}
+
+func TestEventhubHandler_processPartitionConsumeError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		checkpoint int
+		consumed   int
+	}{
+		{
+			// the consumed events are checkpointed, the others are consumed again by the next owner
+			name:       "transient error",
+			err:        errors.New("consume error"),
+			checkpoint: 0,
+			consumed:   2,
+		},
+		{
+			// the events which can't be consumed are skipped
+			name:       "permanent error",
+			err:        consumererror.NewPermanent(errors.New("consume error")),
+			checkpoint: 2,
+			consumed:   3,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			ehHandler := newTestProcessorHandler(t, new(consumertest.LogsSink), &mockProcessor{})
+			consumed := 0
+			next, err := consumer.NewLogs(func(context.Context, plog.Logs) error {
+				consumed++
+				if consumed == 2 {
+					return test.err
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			ehHandler.dataConsumer.(*mockDataConsumer).nextLogsConsumer = next
+
+			events := newTestEvents("hello", "world", "foo")
+			pc := &mockProcessorPartitionClient{
+				partitionID: "0",
+				batches:     [][]*azeventhubs.ReceivedEventData{events},
+				err:         errors.New("receive error"),
+			}
+
+			ehHandler.processPartition(t.Context(), pc)
+
+			assert.Equal(t, test.consumed, consumed)
+			checkpoint, closed := pc.state()
+			assert.Same(t, events[test.checkpoint], checkpoint)
+			assert.True(t, closed)
+		})
+	}
+}
+
+func TestEventhubHandler_processPartitionUnmarshalError(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	ehHandler := newTestProcessorHandler(t, sink, &mockProcessor{})
+	receiver := &eventhubReceiver{
+		eventHandler:     ehHandler,
+		logger:           zap.NewNop(),
+		logsUnmarshaler:  &failingLogsUnmarshaler{},
+		nextLogsConsumer: sink,
+		obsrecv:          ehHandler.dataConsumer.(*mockDataConsumer).obsrecv,
+		signal:           pipeline.SignalLogs,
+	}
+	ehHandler.dataConsumer = receiver
+
+	events := newTestEvents("hello")
+	pc := &mockProcessorPartitionClient{
+		partitionID: "0",
+		batches:     [][]*azeventhubs.ReceivedEventData{events},
+		err:         errors.New("receive error"),
+	}
+
+	ehHandler.processPartition(t.Context(), pc)
+
+	// the events which can't be unmarshaled are checkpointed, so that they aren't received again
+	assert.Zero(t, sink.LogRecordCount())
+	checkpoint, _ := pc.state()
+	assert.Same(t, events[0], checkpoint)
+}
+
+type failingLogsUnmarshaler struct{}
+
+func (*failingLogsUnmarshaler) UnmarshalLogs(*azureEvent) (plog.Logs, error) {
+	return plog.Logs{}, errors.New("invalid event")
+}
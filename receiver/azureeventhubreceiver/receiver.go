@@ -10,6 +10,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -99,7 +100,8 @@ func (receiver *eventhubReceiver) consumeLogs(ctx context.Context, event *azureE
 
 	logs, err := receiver.logsUnmarshaler.UnmarshalLogs(event)
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal logs: %w", err)
+		// the event can't be consumed by retrying it
+		return consumererror.NewPermanent(fmt.Errorf("failed to unmarshal logs: %w", err))
 	}
 
 	receiver.logger.Debug("Log Records", zap.Any("logs", logs))
@@ -122,7 +124,8 @@ func (receiver *eventhubReceiver) consumeMetrics(ctx context.Context, event *azu
 
 	metrics, err := receiver.metricsUnmarshaler.UnmarshalMetrics(event)
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal metrics: %w", err)
+		// the event can't be consumed by retrying it
+		return consumererror.NewPermanent(fmt.Errorf("failed to unmarshal metrics: %w", err))
 	}
 
 	receiver.logger.Debug("Metric Records", zap.Any("metrics", metrics))
@@ -146,7 +149,8 @@ func (receiver *eventhubReceiver) consumeTraces(ctx context.Context, event *azur
 
 	traces, err := receiver.tracesUnmarshaler.UnmarshalTraces(event)
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal traces: %w", err)
+		// the event can't be consumed by retrying it
+		return consumererror.NewPermanent(fmt.Errorf("failed to unmarshal traces: %w", err))
 	}
 
 	receiver.logger.Debug("traces Records", zap.Any("traces", traces))
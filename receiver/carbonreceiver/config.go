@@ -5,6 +5,8 @@ package carbonreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/config/confignet"
@@ -13,6 +15,15 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/protocol"
 )
 
+const (
+	// plaintextProtocol receives one metric per line, see
+	// https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-plaintext-protocol.
+	plaintextProtocol = "plaintext"
+	// pickleProtocol receives batches of metrics serialized with Python pickle, see
+	// https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-pickle-protocol.
+	pickleProtocol = "pickle"
+)
+
 var _ xconfmap.Validator = (*Config)(nil)
 
 // Config defines configuration for the Carbon receiver.
@@ -23,6 +34,10 @@ type Config struct {
 	// if transport being used is UDP.
 	TCPIdleTimeout time.Duration `mapstructure:"tcp_idle_timeout"`
 
+	// Protocol selects the wire protocol used by the clients, either "plaintext"
+	// (the default) or "pickle". The "pickle" protocol is only supported over TCP.
+	Protocol string `mapstructure:"protocol"`
+
 	// Parser specifies a parser and the respective configuration to be used
 	// by the receiver.
 	Parser *protocol.Config `mapstructure:"parser"`
@@ -32,5 +47,14 @@ func (cfg *Config) Validate() error {
 	if cfg.TCPIdleTimeout < 0 {
 		return errors.New("'tcp_idle_timeout' must be non-negative")
 	}
+	switch cfg.Protocol {
+	case "", plaintextProtocol:
+	case pickleProtocol:
+		if transport := strings.ToLower(string(cfg.Transport)); transport != "" && transport != "tcp" {
+			return fmt.Errorf("'protocol' %q requires the tcp transport, got %q", cfg.Protocol, cfg.Transport)
+		}
+	default:
+		return fmt.Errorf("unknown 'protocol' %q, valid protocols: %q, %q", cfg.Protocol, plaintextProtocol, pickleProtocol)
+	}
 	return nil
 }
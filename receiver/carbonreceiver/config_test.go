@@ -41,6 +41,7 @@ func TestLoadConfig(t *testing.T) {
 					Transport: confignet.TransportTypeUDP,
 				},
 				TCPIdleTimeout: 5 * time.Second,
+				Protocol:       "plaintext",
 				Parser: &protocol.Config{
 					Type:   "plaintext",
 					Config: &protocol.PlaintextConfig{},
@@ -55,6 +56,7 @@ func TestLoadConfig(t *testing.T) {
 					Transport: confignet.TransportTypeTCP,
 				},
 				TCPIdleTimeout: 30 * time.Second,
+				Protocol:       "plaintext",
 				Parser: &protocol.Config{
 					Type: "regex",
 					Config: &protocol.RegexParserConfig{
@@ -83,6 +85,21 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "pickle"),
+			expected: &Config{
+				AddrConfig: confignet.AddrConfig{
+					Endpoint:  "localhost:2004",
+					Transport: confignet.TransportTypeTCP,
+				},
+				TCPIdleTimeout: 30 * time.Second,
+				Protocol:       "pickle",
+				Parser: &protocol.Config{
+					Type:   "plaintext",
+					Config: &protocol.PlaintextConfig{},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -114,3 +131,48 @@ func TestConfigValidate(t *testing.T) {
 	}
 	assert.Error(t, cfg.Validate())
 }
+
+func TestConfigValidateProtocol(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport confignet.TransportType
+		protocol  string
+		wantErr   string
+	}{
+		{
+			name:      "plaintext_udp",
+			transport: confignet.TransportTypeUDP,
+			protocol:  "plaintext",
+		},
+		{
+			name:      "pickle_tcp",
+			transport: confignet.TransportTypeTCP,
+			protocol:  "pickle",
+		},
+		{
+			name:      "pickle_udp",
+			transport: confignet.TransportTypeUDP,
+			protocol:  "pickle",
+			wantErr:   `'protocol' "pickle" requires the tcp transport`,
+		},
+		{
+			name:      "unknown",
+			transport: confignet.TransportTypeTCP,
+			protocol:  "json",
+			wantErr:   `unknown 'protocol' "json"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Transport = tt.transport
+			cfg.Protocol = tt.protocol
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -38,6 +38,7 @@ func createDefaultConfig() component.Config {
 			Transport: confignet.TransportTypeTCP,
 		},
 		TCPIdleTimeout: tcpIdleTimeoutDefault,
+		Protocol:       plaintextProtocol,
 		Parser: &protocol.Config{
 			Type:   "plaintext",
 			Config: &protocol.PlaintextConfig{},
@@ -0,0 +1,506 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/internal/transport"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Pickle opcodes used to serialize the builtin types sent by Carbon clients,
+// see https://github.com/python/cpython/blob/main/Lib/pickletools.py.
+// Opcodes that import or call Python objects are not supported.
+const (
+	opMark            = '('
+	opStop            = '.'
+	opPop             = '0'
+	opPopMark         = '1'
+	opDup             = '2'
+	opFloat           = 'F'
+	opInt             = 'I'
+	opBinInt          = 'J'
+	opBinInt1         = 'K'
+	opLong            = 'L'
+	opBinInt2         = 'M'
+	opNone            = 'N'
+	opString          = 'S'
+	opBinString       = 'T'
+	opShortBinString  = 'U'
+	opUnicode         = 'V'
+	opBinUnicode      = 'X'
+	opAppend          = 'a'
+	opAppends         = 'e'
+	opGet             = 'g'
+	opBinGet          = 'h'
+	opLongBinGet      = 'j'
+	opList            = 'l'
+	opEmptyList       = ']'
+	opPut             = 'p'
+	opBinPut          = 'q'
+	opLongBinPut      = 'r'
+	opTuple           = 't'
+	opEmptyTuple      = ')'
+	opBinFloat        = 'G'
+	opBinBytes        = 'B'
+	opShortBinBytes   = 'C'
+	opProto           = 0x80
+	opTuple1          = 0x85
+	opTuple2          = 0x86
+	opTuple3          = 0x87
+	opNewTrue         = 0x88
+	opNewFalse        = 0x89
+	opLong1           = 0x8a
+	opLong4           = 0x8b
+	opShortBinUnicode = 0x8c
+	opBinUnicode8     = 0x8d
+	opBinBytes8       = 0x8e
+	opMemoize         = 0x94
+	opFrame           = 0x95
+)
+
+var errPickleMarkNotFound = errors.New("pickle mark not found")
+
+// pickleList is a Python list, kept as a pointer while unpickling so that items
+// appended after the list was memoized are visible to later references.
+type pickleList struct {
+	items []any
+}
+
+// pickleMark is pushed on the stack by the MARK opcode.
+type pickleMark struct{}
+
+// unpickler decodes the subset of the pickle format needed for Carbon
+// messages. Lists are returned as *pickleList, tuples as []any, strings and
+// bytes as string, integers as int64, floats as float64, booleans as bool and
+// None as nil. Use pickleSequence to access the items of lists and tuples.
+type unpickler struct {
+	r     *bytes.Reader
+	stack []any
+	memo  map[int]any
+}
+
+// unpickle decodes a pickled object.
+func unpickle(data []byte) (any, error) {
+	u := &unpickler{
+		r:    bytes.NewReader(data),
+		memo: map[int]any{},
+	}
+	v, err := u.load()
+	if err != nil {
+		return nil, fmt.Errorf("invalid pickle data: %w", err)
+	}
+	return v, nil
+}
+
+func (u *unpickler) load() (any, error) {
+	for {
+		op, err := u.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case opProto:
+			if _, err = u.r.ReadByte(); err != nil {
+				return nil, err
+			}
+		case opFrame:
+			_, err = u.readN(8)
+		case opStop:
+			return u.pop()
+		case opMark:
+			u.push(pickleMark{})
+		case opPop:
+			_, err = u.pop()
+		case opPopMark:
+			_, err = u.popMark()
+		case opDup:
+			var v any
+			if v, err = u.top(); err == nil {
+				u.push(v)
+			}
+		case opNone:
+			u.push(nil)
+		case opNewTrue:
+			u.push(true)
+		case opNewFalse:
+			u.push(false)
+		case opInt:
+			err = u.loadInt()
+		case opLong:
+			err = u.loadLong()
+		case opBinInt:
+			var b []byte
+			if b, err = u.readN(4); err == nil {
+				u.push(int64(int32(binary.LittleEndian.Uint32(b))))
+			}
+		case opBinInt1:
+			var b byte
+			if b, err = u.r.ReadByte(); err == nil {
+				u.push(int64(b))
+			}
+		case opBinInt2:
+			var b []byte
+			if b, err = u.readN(2); err == nil {
+				u.push(int64(binary.LittleEndian.Uint16(b)))
+			}
+		case opLong1:
+			var n byte
+			if n, err = u.r.ReadByte(); err == nil {
+				err = u.loadBinLong(int(n))
+			}
+		case opLong4:
+			var n int
+			if n, err = u.readSize(4); err == nil {
+				err = u.loadBinLong(n)
+			}
+		case opFloat:
+			var line string
+			if line, err = u.readLine(); err == nil {
+				var f float64
+				if f, err = strconv.ParseFloat(line, 64); err == nil {
+					u.push(f)
+				}
+			}
+		case opBinFloat:
+			var b []byte
+			if b, err = u.readN(8); err == nil {
+				u.push(math.Float64frombits(binary.BigEndian.Uint64(b)))
+			}
+		case opString:
+			err = u.loadString()
+		case opUnicode:
+			var line string
+			if line, err = u.readLine(); err == nil {
+				var s string
+				if s, err = decodeRawUnicodeEscape(line); err == nil {
+					u.push(s)
+				}
+			}
+		case opShortBinString, opShortBinBytes, opShortBinUnicode:
+			err = u.loadBinString(1)
+		case opBinString, opBinBytes, opBinUnicode:
+			err = u.loadBinString(4)
+		case opBinUnicode8, opBinBytes8:
+			err = u.loadBinString(8)
+		case opEmptyList:
+			u.push(&pickleList{})
+		case opList:
+			var items []any
+			if items, err = u.popMark(); err == nil {
+				u.push(&pickleList{items: items})
+			}
+		case opAppend:
+			var v any
+			if v, err = u.pop(); err == nil {
+				err = u.appendToList(v)
+			}
+		case opAppends:
+			var items []any
+			if items, err = u.popMark(); err == nil {
+				err = u.appendToList(items...)
+			}
+		case opEmptyTuple:
+			u.push([]any{})
+		case opTuple:
+			var items []any
+			if items, err = u.popMark(); err == nil {
+				u.push(items)
+			}
+		case opTuple1, opTuple2, opTuple3:
+			err = u.loadTupleN(int(op-opTuple1) + 1)
+		case opPut:
+			var line string
+			if line, err = u.readLine(); err == nil {
+				var idx int
+				if idx, err = strconv.Atoi(line); err == nil {
+					err = u.memoize(idx)
+				}
+			}
+		case opBinPut:
+			var b byte
+			if b, err = u.r.ReadByte(); err == nil {
+				err = u.memoize(int(b))
+			}
+		case opLongBinPut:
+			var idx int
+			if idx, err = u.readSize(4); err == nil {
+				err = u.memoize(idx)
+			}
+		case opMemoize:
+			err = u.memoize(len(u.memo))
+		case opGet:
+			var line string
+			if line, err = u.readLine(); err == nil {
+				var idx int
+				if idx, err = strconv.Atoi(line); err == nil {
+					err = u.loadMemo(idx)
+				}
+			}
+		case opBinGet:
+			var b byte
+			if b, err = u.r.ReadByte(); err == nil {
+				err = u.loadMemo(int(b))
+			}
+		case opLongBinGet:
+			var idx int
+			if idx, err = u.readSize(4); err == nil {
+				err = u.loadMemo(idx)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported pickle opcode 0x%02x", op)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (u *unpickler) push(v any) {
+	u.stack = append(u.stack, v)
+}
+
+func (u *unpickler) top() (any, error) {
+	if len(u.stack) == 0 {
+		return nil, errors.New("pickle stack underflow")
+	}
+	return u.stack[len(u.stack)-1], nil
+}
+
+func (u *unpickler) pop() (any, error) {
+	v, err := u.top()
+	if err != nil {
+		return nil, err
+	}
+	u.stack = u.stack[:len(u.stack)-1]
+	return v, nil
+}
+
+// popMark pops the items pushed after the last mark, and the mark itself.
+func (u *unpickler) popMark() ([]any, error) {
+	for i := len(u.stack) - 1; i >= 0; i-- {
+		if _, ok := u.stack[i].(pickleMark); ok {
+			items := append([]any(nil), u.stack[i+1:]...)
+			u.stack = u.stack[:i]
+			return items, nil
+		}
+	}
+	return nil, errPickleMarkNotFound
+}
+
+func (u *unpickler) appendToList(items ...any) error {
+	v, err := u.top()
+	if err != nil {
+		return err
+	}
+	l, ok := v.(*pickleList)
+	if !ok {
+		return fmt.Errorf("cannot append to %T", v)
+	}
+	l.items = append(l.items, items...)
+	return nil
+}
+
+func (u *unpickler) loadTupleN(n int) error {
+	if len(u.stack) < n {
+		return errors.New("pickle stack underflow")
+	}
+	items := append([]any(nil), u.stack[len(u.stack)-n:]...)
+	u.stack = u.stack[:len(u.stack)-n]
+	u.push(items)
+	return nil
+}
+
+func (u *unpickler) memoize(idx int) error {
+	v, err := u.top()
+	if err != nil {
+		return err
+	}
+	u.memo[idx] = v
+	return nil
+}
+
+func (u *unpickler) loadMemo(idx int) error {
+	v, ok := u.memo[idx]
+	if !ok {
+		return fmt.Errorf("pickle memo key %d not found", idx)
+	}
+	u.push(v)
+	return nil
+}
+
+func (u *unpickler) loadInt() error {
+	line, err := u.readLine()
+	if err != nil {
+		return err
+	}
+	// Protocol 0 encodes booleans as "I01" and "I00".
+	switch line {
+	case "01":
+		u.push(true)
+		return nil
+	case "00":
+		u.push(false)
+		return nil
+	}
+	i, err := strconv.ParseInt(line, 10, 64)
+	if err != nil {
+		return err
+	}
+	u.push(i)
+	return nil
+}
+
+func (u *unpickler) loadLong() error {
+	line, err := u.readLine()
+	if err != nil {
+		return err
+	}
+	i, err := strconv.ParseInt(strings.TrimSuffix(line, "L"), 10, 64)
+	if err != nil {
+		return err
+	}
+	u.push(i)
+	return nil
+}
+
+// loadBinLong loads a little-endian two's complement integer of n bytes.
+func (u *unpickler) loadBinLong(n int) error {
+	if n > 8 {
+		return fmt.Errorf("pickle integer of %d bytes overflows int64", n)
+	}
+	b, err := u.readN(n)
+	if err != nil {
+		return err
+	}
+	var v uint64
+	for i := n - 1; i >= 0; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	if n > 0 && n < 8 && b[n-1]&0x80 != 0 {
+		// Sign extend negative values.
+		v |= math.MaxUint64 << (8 * n)
+	}
+	u.push(int64(v))
+	return nil
+}
+
+// loadString loads a protocol 0 string, which is the quoted repr of a Python string.
+func (u *unpickler) loadString() error {
+	line, err := u.readLine()
+	if err != nil {
+		return err
+	}
+	if len(line) < 2 || line[0] != line[len(line)-1] || (line[0] != '\'' && line[0] != '"') {
+		return fmt.Errorf("invalid pickle string %q", line)
+	}
+	if line[0] == '\'' {
+		inner := strings.ReplaceAll(line[1:len(line)-1], `\'`, `'`)
+		line = `"` + strings.ReplaceAll(inner, `"`, `\"`) + `"`
+	}
+	s, err := strconv.Unquote(line)
+	if err != nil {
+		return fmt.Errorf("invalid pickle string %q: %w", line, err)
+	}
+	u.push(s)
+	return nil
+}
+
+// loadBinString loads a string or bytes prefixed by its length of sizeLen bytes.
+func (u *unpickler) loadBinString(sizeLen int) error {
+	n, err := u.readSize(sizeLen)
+	if err != nil {
+		return err
+	}
+	b, err := u.readN(n)
+	if err != nil {
+		return err
+	}
+	u.push(string(b))
+	return nil
+}
+
+// readSize reads an unsigned little-endian length of n bytes.
+func (u *unpickler) readSize(n int) (int, error) {
+	b, err := u.readN(n)
+	if err != nil {
+		return 0, err
+	}
+	var size uint64
+	for i := n - 1; i >= 0; i-- {
+		size = size<<8 | uint64(b[i])
+	}
+	if size > uint64(u.r.Len()) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return int(size), nil
+}
+
+func (u *unpickler) readN(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(u.r, b); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return b, nil
+}
+
+func (u *unpickler) readLine() (string, error) {
+	var sb strings.Builder
+	for {
+		b, err := u.r.ReadByte()
+		if err != nil {
+			return "", io.ErrUnexpectedEOF
+		}
+		if b == '\n' {
+			return sb.String(), nil
+		}
+		sb.WriteByte(b)
+	}
+}
+
+// decodeRawUnicodeEscape decodes the "raw-unicode-escape" encoding of the
+// protocol 0 UNICODE opcode, where only \uXXXX and \UXXXXXXXX are escaped.
+func decodeRawUnicodeEscape(s string) (string, error) {
+	if !strings.Contains(s, `\u`) && !strings.Contains(s, `\U`) {
+		return s, nil
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 >= len(s) || (s[i+1] != 'u' && s[i+1] != 'U') {
+			sb.WriteByte(s[i])
+			continue
+		}
+		n := 4
+		if s[i+1] == 'U' {
+			n = 8
+		}
+		if i+2+n > len(s) {
+			return "", fmt.Errorf("invalid unicode escape in %q", s)
+		}
+		r, err := strconv.ParseUint(s[i+2:i+2+n], 16, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid unicode escape in %q: %w", s, err)
+		}
+		sb.WriteRune(rune(r))
+		i += 1 + n
+	}
+	return sb.String(), nil
+}
+
+// pickleSequence returns the items of a list or a tuple.
+func pickleSequence(v any) ([]any, bool) {
+	switch t := v.(type) {
+	case *pickleList:
+		return t.items, true
+	case []any:
+		return t, true
+	default:
+		return nil, false
+	}
+}
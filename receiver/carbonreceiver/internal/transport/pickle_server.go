@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/internal/transport"
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/protocol"
+)
+
+// maxPickleMessageSize is the maximum size of a pickle message, the same
+// limit as the Carbon pickle receiver.
+const maxPickleMessageSize = 1 << 20
+
+// NewPickleServer creates a transport.Server receiving the Carbon pickle
+// protocol over TCP, see
+// https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-pickle-protocol.
+func NewPickleServer(
+	addr string,
+	idleTimeout time.Duration,
+) (Server, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	t := tcpServer{
+		ln:          ln,
+		idleTimeout: idleTimeout,
+		pickle:      true,
+	}
+	return &t, nil
+}
+
+// handlePickleConnection reads the messages of a pickle connection. Each
+// message is a 4 bytes big-endian length followed by a pickled list of
+// metrics:
+//
+//	[(<metric_path>, (<metric_timestamp>, <metric_value>)), ...]
+func (t *tcpServer) handlePickleConnection(
+	p protocol.Parser,
+	nextConsumer consumer.Metrics,
+	conn net.Conn,
+) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	header := make([]byte, 4)
+	for {
+		if err := conn.SetDeadline(time.Now().Add(t.idleTimeout)); err != nil {
+			t.reporter.OnDebugf(
+				"Pickle Transport (%s) - conn.SetDeadLine error: %v",
+				t.ln.Addr(),
+				err)
+			return
+		}
+
+		if _, err := io.ReadFull(reader, header); err != nil {
+			t.reporter.OnDebugf("Pickle Transport (%s) - error: %v", t.ln.Addr(), err)
+			return
+		}
+		size := binary.BigEndian.Uint32(header)
+		if size > maxPickleMessageSize {
+			t.reporter.OnDebugf(
+				"Pickle Transport (%s) - message of %d bytes exceeds the maximum of %d bytes",
+				t.ln.Addr(),
+				size,
+				maxPickleMessageSize)
+			return
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			t.reporter.OnDebugf("Pickle Transport (%s) - error: %v", t.ln.Addr(), err)
+			return
+		}
+
+		ctx := t.reporter.OnDataReceived(context.Background())
+		lines, err := pickleToLines(data)
+		if err != nil {
+			t.reporter.OnTranslationError(ctx, err)
+		}
+
+		metrics := pmetric.NewMetrics()
+		sm := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+		for _, line := range lines {
+			metric, err := p.Parse(line)
+			if err != nil {
+				t.reporter.OnTranslationError(ctx, err)
+				continue
+			}
+			metric.MoveTo(sm.Metrics().AppendEmpty())
+		}
+
+		err = nextConsumer.ConsumeMetrics(ctx, metrics)
+		t.reporter.OnMetricsProcessed(ctx, len(lines), err)
+		if err != nil {
+			// As for the plaintext protocol, close the connection to report
+			// the error back to the client.
+			return
+		}
+	}
+}
+
+// pickleToLines converts a pickle message into Carbon plaintext lines, so that
+// the metric paths are handled by the configured parser. Invalid metrics are
+// skipped and reported in the returned error.
+func pickleToLines(data []byte) ([]string, error) {
+	v, err := unpickle(data)
+	if err != nil {
+		return nil, err
+	}
+	items, ok := pickleSequence(v)
+	if !ok {
+		return nil, fmt.Errorf("invalid pickle message: expected a list of metrics, got %T", v)
+	}
+
+	lines := make([]string, 0, len(items))
+	var invalid int
+	for _, item := range items {
+		line, ok := pickleMetricToLine(item)
+		if !ok {
+			invalid++
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if invalid > 0 {
+		return lines, fmt.Errorf("invalid pickle message: skipped %d metrics not in the (path, (timestamp, value)) format", invalid)
+	}
+	return lines, nil
+}
+
+func pickleMetricToLine(item any) (string, bool) {
+	metric, ok := pickleSequence(item)
+	if !ok || len(metric) != 2 {
+		return "", false
+	}
+	path, ok := metric[0].(string)
+	if !ok || path == "" || strings.ContainsAny(path, " \n") {
+		return "", false
+	}
+	datapoint, ok := pickleSequence(metric[1])
+	if !ok || len(datapoint) != 2 {
+		return "", false
+	}
+	timestamp, ok := pickleNumberToString(datapoint[0])
+	if !ok {
+		return "", false
+	}
+	value, ok := pickleNumberToString(datapoint[1])
+	if !ok {
+		return "", false
+	}
+	return path + " " + value + " " + timestamp, true
+}
+
+// pickleNumberToString formats a number as expected by the plaintext parser.
+// Numeric strings are accepted, as Carbon converts the values with float().
+func pickleNumberToString(v any) (string, bool) {
+	switch n := v.(type) {
+	case int64:
+		return strconv.FormatInt(n, 10), true
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64), true
+	case string:
+		s := strings.TrimSpace(n)
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return "", false
+		}
+		return s, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pickleToLines(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantLines []string
+		wantErr   string
+	}{
+		{
+			name: "protocol_0",
+			data: "(lp0\n(Va.b;env=prod\np1\n(I1700000000\nF1.5\ntp2\ntp3\na(Vc.d\np4\n(F1700000001.25\nI7\ntp5\ntp6\na.",
+			wantLines: []string{
+				"a.b;env=prod 1.5 1700000000",
+				"c.d 7 1700000001.25",
+			},
+		},
+		{
+			name: "protocol_2",
+			data: "\x80\x02]q\x00(X\x0c\x00\x00\x00a.b;env=prodq\x01J\x00\xf1SeG?\xf8\x00\x00\x00\x00\x00\x00\x86q\x02\x86q\x03X\x03\x00\x00\x00c.dq\x04GA\xd9T\xfc@P\x00\x00K\x07\x86q\x05\x86q\x06e.",
+			wantLines: []string{
+				"a.b;env=prod 1.5 1700000000",
+				"c.d 7 1700000001.25",
+			},
+		},
+		{
+			name: "protocol_4",
+			data: "\x80\x04\x95;\x00\x00\x00\x00\x00\x00\x00]\x94(\x8c\x0ca.b;env=prod\x94J\x00\xf1SeG?\xf8\x00\x00\x00\x00\x00\x00\x86\x94\x86\x94\x8c\x03c.d\x94GA\xd9T\xfc@P\x00\x00K\x07\x86\x94\x86\x94e.",
+			wantLines: []string{
+				"a.b;env=prod 1.5 1700000000",
+				"c.d 7 1700000001.25",
+			},
+		},
+		{
+			name: "python2_strings",
+			data: "(lp0\n(S'x.y'\np1\n(L-5L\nS'42'\ntp2\ntp3\na(U\x03z.w(J\xfb\xff\xff\xff\x8a\x06\x00\x00\x00\x00\x00\xfftta.",
+			wantLines: []string{
+				"x.y 42 -5",
+				"z.w -1099511627776 -5",
+			},
+		},
+		{
+			name:      "invalid_metric_skipped",
+			data:      "(lp0\n(Va.b\n(I1\nVnot-a-number\nttaS'c.d'\na(Ve.f\n(I1\nI2\nI3\ntta(Vg.h\n(I1\nI2\ntta.",
+			wantLines: []string{"g.h 2 1"},
+			wantErr:   "skipped 3 metrics",
+		},
+		{
+			name:    "not_a_list",
+			data:    "I1\n.",
+			wantErr: "expected a list of metrics",
+		},
+		{
+			name:    "global_not_supported",
+			data:    "\x80\x02c_codecs\nencode\nq\x00.",
+			wantErr: "unsupported pickle opcode 0x63",
+		},
+		{
+			name:    "truncated",
+			data:    "\x80\x02]q\x00(X\x0c\x00\x00\x00a.b",
+			wantErr: "invalid pickle data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := pickleToLines([]byte(tt.data))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantLines != nil {
+				assert.Equal(t, tt.wantLines, lines)
+			}
+		})
+	}
+}
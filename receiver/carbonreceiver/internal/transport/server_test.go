@@ -5,6 +5,8 @@ package transport
 
 import (
 	"context"
+	"encoding/binary"
+	"net"
 	"runtime"
 	"sync"
 	"testing"
@@ -100,6 +102,57 @@ func Test_Server_ListenAndServe(t *testing.T) {
 	}
 }
 
+func Test_PickleServer_ListenAndServe(t *testing.T) {
+	addr := testutil.GetAvailableLocalAddress(t)
+
+	svr, err := NewPickleServer(addr, 1*time.Second)
+	require.NoError(t, err)
+	require.NotNil(t, svr)
+
+	mc := new(consumertest.MetricsSink)
+	p, err := (&protocol.PlaintextConfig{}).BuildParser()
+	require.NoError(t, err)
+	mr := &mockReporter{}
+	mr.wgMetricsProcessed.Add(1)
+
+	wgListenAndServe := sync.WaitGroup{}
+	wgListenAndServe.Add(1)
+	go func() {
+		defer wgListenAndServe.Done()
+		assert.Error(t, svr.ListenAndServe(p, mc, mr))
+	}()
+
+	runtime.Gosched()
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+
+	// [("test.metric;env=prod", (1582230020, 1)), ("other.metric", (1582230020, 2.5))] pickled with protocol 2.
+	data := []byte("\x80\x02]q\x00(X\x14\x00\x00\x00test.metric;env=prodq\x01J\x04\xeaN^K\x01\x86q\x02\x86q\x03X\x0c\x00\x00\x00other.metricq\x04J\x04\xeaN^G@\x04\x00\x00\x00\x00\x00\x00\x86q\x05\x86q\x06e.")
+	header := make([]byte, 4)
+	binary.BigEndian.PutUint32(header, uint32(len(data)))
+	_, err = conn.Write(append(header, data...))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	mr.wgMetricsProcessed.Wait()
+
+	require.NoError(t, svr.Close())
+	wgListenAndServe.Wait()
+
+	mdd := mc.AllMetrics()
+	require.Len(t, mdd, 1)
+	require.Equal(t, 2, mdd[0].MetricCount())
+	metrics := mdd[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, "test.metric", metrics.At(0).Name())
+	env, ok := metrics.At(0).Gauge().DataPoints().At(0).Attributes().Get("env")
+	require.True(t, ok)
+	assert.Equal(t, "prod", env.Str())
+	assert.Equal(t, int64(1), metrics.At(0).Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, "other.metric", metrics.At(1).Name())
+	assert.Equal(t, 2.5, metrics.At(1).Gauge().DataPoints().At(0).DoubleValue())
+}
+
 // mockReporter provides a Reporter that provides some useful functionalities for
 // tests (eg.: wait for certain number of messages).
 type mockReporter struct {
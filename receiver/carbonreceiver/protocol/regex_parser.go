@@ -9,8 +9,6 @@ import (
 	"regexp"
 	"sort"
 	"strings"
-
-	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
 const (
@@ -158,16 +156,23 @@ type regexPathParser struct {
 
 // parsePath converts the <metric_path> of a Carbon line (see pathParserHelper
 // a full description of the line format) according to the RegexParserConfig
-// settings.
-func (rpp *regexPathParser) parsePath(path string, parsedPath *parsedPath) error {
+// settings. The rules are matched against the metric name without the Graphite
+// tags, the tags are added as attributes unless a rule sets the same key.
+func (rpp *regexPathParser) parsePath(path string, pp *parsedPath) error {
+	var tagged parsedPath
+	if err := rpp.plaintextPathParser.parsePath(path, &tagged); err != nil {
+		return err
+	}
+
+	name := tagged.MetricName
 	for _, rule := range rpp.rules {
-		if !rule.compRegexp.MatchString(path) {
+		if !rule.compRegexp.MatchString(name) {
 			continue
 		}
-		ms := rule.compRegexp.FindStringSubmatch(path)
+		ms := rule.compRegexp.FindStringSubmatch(name)
 		nms := rule.compRegexp.SubexpNames() // regexp pre-computes this slice.
 		metricNameLookup := map[string]string{}
-		attributes := pcommon.NewMap()
+		attributes := tagged.Attributes
 
 		for i := 1; i < len(ms); i++ {
 			groupName, groupValue := nms[i], ms[i]
@@ -204,16 +209,17 @@ func (rpp *regexPathParser) parsePath(path string, parsedPath *parsedPath) error
 		}
 
 		if actualMetricName == "" {
-			actualMetricName = path
+			actualMetricName = name
 		}
 
-		parsedPath.MetricName = actualMetricName
-		parsedPath.Attributes = attributes
-		parsedPath.MetricType = TargetMetricType(rule.MetricType)
+		pp.MetricName = actualMetricName
+		pp.Attributes = attributes
+		pp.MetricType = TargetMetricType(rule.MetricType)
 		return nil
 	}
 
-	return rpp.plaintextPathParser.parsePath(path, parsedPath)
+	*pp = tagged
+	return nil
 }
 
 func regexDefaultConfig() ParserConfig {
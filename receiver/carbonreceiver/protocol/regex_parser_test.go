@@ -158,6 +158,34 @@ func Test_regexParser_parsePath(t *testing.T) {
 			}(),
 			wantMetricType: GaugeMetricType,
 		},
+		{
+			name:     "match_rule0_with_tags",
+			path:     "service_name.host00.cpu.seconds;env=prod;k=tag",
+			wantName: "cpu_seconds",
+			wantAttributes: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("env", "prod")
+				m.PutStr("k", "v")
+				m.PutStr("svc", "service_name")
+				m.PutStr("host", "host00")
+				return m
+			}(),
+		},
+		{
+			name:     "no_rule_match_with_tags",
+			path:     "service_name.host01.rpc.duration.seconds;env=prod",
+			wantName: "service_name.host01.rpc.duration.seconds",
+			wantAttributes: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("env", "prod")
+				return m
+			}(),
+		},
+		{
+			name:    "invalid_tags",
+			path:    "service_name.host00.cpu.seconds;env",
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
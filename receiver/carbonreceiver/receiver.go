@@ -21,7 +21,7 @@ import (
 
 var errEmptyEndpoint = errors.New("empty endpoint")
 
-// carbonreceiver implements a receiver.Metrics for Carbon plaintext, aka "line", protocol.
+// carbonreceiver implements a receiver.Metrics for Carbon plaintext, aka "line", and pickle protocols.
 // see https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-plaintext-protocol.
 type carbonReceiver struct {
 	settings receiver.Settings
@@ -77,6 +77,9 @@ func newMetricsReceiver(
 func buildTransportServer(config Config) (transport.Server, error) {
 	switch strings.ToLower(string(config.Transport)) {
 	case "", "tcp":
+		if config.Protocol == pickleProtocol {
+			return transport.NewPickleServer(config.Endpoint, config.TCPIdleTimeout)
+		}
 		return transport.NewTCPServer(config.Endpoint, config.TCPIdleTimeout)
 	case "udp":
 		return transport.NewUDPServer(config.Endpoint)
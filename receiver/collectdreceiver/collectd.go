@@ -7,9 +7,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
@@ -31,6 +33,9 @@ type collectDRecord struct {
 	Dstypes        []*string      `json:"dstypes"`
 	Dsnames        []*string      `json:"dsnames"`
 	Values         []*json.Number `json:"values"`
+
+	// unit is the unit of the metrics, set from the types.db.
+	unit string
 }
 
 type createMetricInfo struct {
@@ -39,6 +44,28 @@ type createMetricInfo struct {
 	Name   string
 }
 
+// applyTypesDB names the values of the record after the data sources of its type in the types.db,
+// and sets the unit of its metrics.
+func (cdr *collectDRecord) applyTypesDB(types typesDB) {
+	if cdr.TypeS == nil {
+		return
+	}
+	t, ok := types[*cdr.TypeS]
+	if !ok {
+		return
+	}
+	cdr.unit = t.unit
+	if len(t.dataSources) != len(cdr.Values) {
+		return
+	}
+	cdr.Dsnames = make([]*string, len(t.dataSources))
+	cdr.Dstypes = make([]*string, len(t.dataSources))
+	for i := range t.dataSources {
+		cdr.Dsnames[i] = &t.dataSources[i].name
+		cdr.Dstypes[i] = &t.dataSources[i].dsType
+	}
+}
+
 func (cdr *collectDRecord) isEvent() bool {
 	return cdr.Time != nil && cdr.Severity != nil && cdr.Message != nil
 }
@@ -97,6 +124,51 @@ func (cdr *collectDRecord) appendToMetrics(logger *zap.Logger, scopeMetrics pmet
 	return nil
 }
 
+// appendToLogs converts the notification to a log record.
+func (cdr *collectDRecord) appendToLogs(scopeLogs plog.ScopeLogs, defaultLabels map[string]string) {
+	labels := make(map[string]string, len(defaultLabels))
+	maps.Copy(labels, defaultLabels)
+
+	addIfNotNullOrEmpty(labels, "plugin", cdr.Plugin)
+	addIfNotNullOrEmpty(labels, "type", cdr.TypeS)
+	// Unlike the values, the notifications may omit the instances
+	if cdr.PluginInstance != nil {
+		parseNameForLabels(labels, "plugin_instance", cdr.PluginInstance)
+	}
+	if cdr.Host != nil {
+		parseNameForLabels(labels, "host", cdr.Host)
+	}
+	if cdr.TypeInstance != nil {
+		parseNameForLabels(labels, "type_instance", cdr.TypeInstance)
+	}
+
+	logRecord := scopeLogs.LogRecords().AppendEmpty()
+	logRecord.SetTimestamp(cdr.protoTime())
+	logRecord.SetSeverityText(*cdr.Severity)
+	logRecord.SetSeverityNumber(severityNumber(*cdr.Severity))
+	logRecord.Body().SetStr(*cdr.Message)
+	setAttributes(labels).MoveTo(logRecord.Attributes())
+	if len(cdr.Meta) > 0 {
+		if err := logRecord.Attributes().PutEmptyMap("meta").FromRaw(cdr.Meta); err != nil {
+			logRecord.Attributes().Remove("meta")
+		}
+	}
+}
+
+// severityNumber maps the severity of a collectd notification to the log severity number.
+func severityNumber(severity string) plog.SeverityNumber {
+	switch strings.ToUpper(severity) {
+	case "FAILURE":
+		return plog.SeverityNumberError
+	case "WARNING":
+		return plog.SeverityNumberWarn
+	case "OKAY":
+		return plog.SeverityNumberInfo
+	default:
+		return plog.SeverityNumberUnspecified
+	}
+}
+
 // Create new metric, get labels, then setting attribute and metric info
 func (cdr *collectDRecord) newMetric(createMetric createMetricInfo, labels map[string]string) (pmetric.Metric, error) {
 	attributes := setAttributes(labels)
@@ -125,6 +197,7 @@ func (cdr *collectDRecord) setMetric(createMetric createMetricInfo, atr pcommon.
 	}
 
 	metric.SetName(createMetric.Name)
+	metric.SetUnit(cdr.unit)
 	dataPoint := setDataPoint(typ, metric)
 	dataPoint.SetTimestamp(cdr.protoTime())
 	atr.CopyTo(dataPoint.Attributes())
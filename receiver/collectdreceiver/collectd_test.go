@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
@@ -140,3 +141,111 @@ func assertMetricsEqual(t *testing.T, actual pmetric.Metrics) {
 		pmetrictest.IgnoreMetricsOrder())
 	require.NoError(t, err)
 }
+
+func TestDecodeNotification(t *testing.T) {
+	logs := plog.NewLogs()
+	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	jsonData, err := os.ReadFile(filepath.Join("testdata", "event.json"))
+	require.NoError(t, err)
+
+	var records []collectDRecord
+	err = json.Unmarshal(jsonData, &records)
+	require.NoError(t, err)
+
+	for _, cdr := range records {
+		require.True(t, cdr.isEvent())
+		cdr.appendToLogs(scopeLogs, map[string]string{"env": "test"})
+	}
+
+	require.Equal(t, 1, logs.LogRecordCount())
+	logRecord := scopeLogs.LogRecords().At(0)
+	assert.Equal(t, "my message", logRecord.Body().Str())
+	assert.Equal(t, "OKAY", logRecord.SeverityText())
+	assert.Equal(t, plog.SeverityNumberInfo, logRecord.SeverityNumber())
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(1435104306, 0)), logRecord.Timestamp())
+	assert.Equal(t, map[string]any{
+		"env":             "test",
+		"host":            "mwp-signalbox",
+		"a":               "b",
+		"plugin":          "my_plugin",
+		"plugin_instance": "my_plugin_instance",
+		"f":               "x",
+		"type":            "imanotify",
+		"type_instance":   "notify_instance",
+		"k":               "v",
+		"meta":            map[string]any{"key": "value"},
+	}, logRecord.Attributes().AsRaw())
+}
+
+func TestNotificationSeverityNumber(t *testing.T) {
+	assert.Equal(t, plog.SeverityNumberError, severityNumber("FAILURE"))
+	assert.Equal(t, plog.SeverityNumberWarn, severityNumber("WARNING"))
+	assert.Equal(t, plog.SeverityNumberInfo, severityNumber("okay"))
+	assert.Equal(t, plog.SeverityNumberUnspecified, severityNumber("UNKNOWN"))
+}
+
+func TestApplyTypesDB(t *testing.T) {
+	types, err := loadTypesDB([]string{filepath.Join("testdata", "types.db")})
+	require.NoError(t, err)
+
+	jsonData := []byte(`[
+		{
+			"host": "i-b13d1e5f",
+			"interval": 10.0,
+			"plugin": "interface",
+			"plugin_instance": "eth0",
+			"time": 1415062577.4960001,
+			"type": "if_octets",
+			"type_instance": "",
+			"values": [12, 34]
+		},
+		{
+			"dsnames": ["value"],
+			"dstypes": ["gauge"],
+			"host": "i-b13d1e5f",
+			"interval": 10.0,
+			"plugin": "memory",
+			"plugin_instance": "",
+			"time": 1415062577.4960001,
+			"type": "memory",
+			"type_instance": "used",
+			"values": [1024]
+		},
+		{
+			"dsnames": ["value"],
+			"dstypes": ["gauge"],
+			"host": "i-b13d1e5f",
+			"interval": 10.0,
+			"plugin": "df",
+			"plugin_instance": "",
+			"time": 1415062577.4960001,
+			"type": "df_inodes",
+			"type_instance": "free",
+			"values": [42]
+		}
+	]`)
+	var records []collectDRecord
+	require.NoError(t, json.Unmarshal(jsonData, &records))
+
+	metrics := pmetric.NewMetrics()
+	scopeMetrics := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	for _, cdr := range records {
+		cdr.applyTypesDB(types)
+		require.NoError(t, cdr.appendToMetrics(zap.NewNop(), scopeMetrics, nil))
+	}
+
+	type nameAndUnit struct {
+		name, unit string
+		typ        pmetric.MetricType
+	}
+	var actual []nameAndUnit
+	for _, metric := range scopeMetrics.Metrics().All() {
+		actual = append(actual, nameAndUnit{name: metric.Name(), unit: metric.Unit(), typ: metric.Type()})
+	}
+	assert.Equal(t, []nameAndUnit{
+		{name: "if_octets.rx", unit: "By", typ: pmetric.MetricTypeSum},
+		{name: "if_octets.tx", unit: "By", typ: pmetric.MetricTypeSum},
+		{name: "memory.used", unit: "By", typ: pmetric.MetricTypeGauge},
+		{name: "df_inodes.free", unit: "", typ: pmetric.MetricTypeGauge},
+	}, actual)
+}
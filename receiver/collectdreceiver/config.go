@@ -17,6 +17,9 @@ type Config struct {
 	Timeout                 time.Duration            `mapstructure:"timeout"`
 	Encoding                string                   `mapstructure:"encoding"`
 	AttributesPrefix        string                   `mapstructure:"attributes_prefix"`
+	// TypesDB is the list of collectd types.db files used to name the values and set the
+	// units of the metrics. The types of the later files override the types of the earlier ones.
+	TypesDB []string `mapstructure:"typesdb"`
 }
 
 func (c *Config) Validate() error {
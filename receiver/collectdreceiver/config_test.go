@@ -44,6 +44,7 @@ func TestLoadConfig(t *testing.T) {
 				Timeout:          50 * time.Second,
 				AttributesPrefix: "dap_",
 				Encoding:         "command",
+				TypesDB:          []string{"/usr/share/collectd/types.db", "/etc/collectd/custom_types.db"},
 			},
 			wantErr: errors.New("CollectD only support JSON encoding format. command is not supported"),
 		},
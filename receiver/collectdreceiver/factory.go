@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver/internal/metadata"
 )
 
@@ -28,7 +29,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -48,6 +50,39 @@ func createMetricsReceiver(
 	cfg component.Config,
 	nextConsumer consumer.Metrics,
 ) (receiver.Metrics, error) {
+	var err error
 	c := cfg.(*Config)
-	return newCollectdReceiver(cs.Logger, c, c.AttributesPrefix, nextConsumer, cs)
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		var recv *collectdReceiver
+		recv, err = newCollectdReceiver(cs.Logger, c, c.AttributesPrefix, nil, cs)
+		return recv
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*collectdReceiver).nextConsumer = nextConsumer
+	return r, nil
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	cs receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	var err error
+	c := cfg.(*Config)
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		var recv *collectdReceiver
+		recv, err = newCollectdReceiver(cs.Logger, c, c.AttributesPrefix, nil, cs)
+		return recv
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*collectdReceiver).logsConsumer = nextConsumer
+	return r, nil
+}
+
+// receivers share the HTTP server between the metrics and logs receivers of the same configuration.
+var receivers = sharedcomponent.NewSharedComponents()
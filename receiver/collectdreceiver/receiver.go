@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componentstatus"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
@@ -23,15 +24,20 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver/internal/metadata"
 )
 
-var _ receiver.Metrics = (*collectdReceiver)(nil)
+var (
+	_ receiver.Metrics = (*collectdReceiver)(nil)
+	_ receiver.Logs    = (*collectdReceiver)(nil)
+)
 
-// collectdReceiver implements the receiver.Metrics for CollectD protocol.
+// collectdReceiver implements the receiver.Metrics and receiver.Logs for CollectD protocol.
 type collectdReceiver struct {
 	logger             *zap.Logger
 	server             *http.Server
 	shutdownWG         sync.WaitGroup
 	defaultAttrsPrefix string
 	nextConsumer       consumer.Metrics
+	logsConsumer       consumer.Logs
+	types              typesDB
 	obsrecv            *receiverhelper.ObsReport
 	createSettings     receiver.Settings
 	config             *Config
@@ -44,11 +50,16 @@ func newCollectdReceiver(
 	defaultAttrsPrefix string,
 	nextConsumer consumer.Metrics,
 	createSettings receiver.Settings,
-) (receiver.Metrics, error) {
+) (*collectdReceiver, error) {
+	types, err := loadTypesDB(cfg.TypesDB)
+	if err != nil {
+		return nil, err
+	}
 	r := &collectdReceiver{
 		logger:             logger,
 		nextConsumer:       nextConsumer,
 		defaultAttrsPrefix: defaultAttrsPrefix,
+		types:              types,
 		config:             cfg,
 		createSettings:     createSettings,
 	}
@@ -125,8 +136,15 @@ func (cdr *collectdReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	metrics := pmetric.NewMetrics()
 	scopeMetrics := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	logs := plog.NewLogs()
+	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
 	for i := range records {
 		record := &records[i]
+		if record.isEvent() && cdr.logsConsumer != nil {
+			record.appendToLogs(scopeLogs, defaultAttrs)
+			continue
+		}
+		record.applyTypesDB(cdr.types)
 		err = record.appendToMetrics(cdr.logger, scopeMetrics, defaultAttrs)
 		if err != nil {
 			cdr.obsrecv.EndMetricsOp(ctx, metadata.Type.String(), len(records), err)
@@ -136,10 +154,25 @@ func (cdr *collectdReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	lenDp := metrics.DataPointCount()
 
-	err = cdr.nextConsumer.ConsumeMetrics(ctx, metrics)
-	if err != nil {
-		cdr.obsrecv.EndMetricsOp(ctx, metadata.Type.String(), lenDp, err)
-		return
+	if cdr.nextConsumer != nil && lenDp > 0 {
+		err = cdr.nextConsumer.ConsumeMetrics(ctx, metrics)
+		if err != nil {
+			cdr.obsrecv.EndMetricsOp(ctx, metadata.Type.String(), lenDp, err)
+			return
+		}
+	}
+
+	if logs.LogRecordCount() > 0 {
+		logsCtx := cdr.obsrecv.StartLogsOp(r.Context())
+		err = cdr.logsConsumer.ConsumeLogs(logsCtx, logs)
+		cdr.obsrecv.EndLogsOp(logsCtx, metadata.Type.String(), logs.LogRecordCount(), err)
+		if err != nil {
+			// The metrics were consumed, but the client must know the notifications were not
+			cdr.obsrecv.EndMetricsOp(ctx, metadata.Type.String(), lenDp, nil)
+			cdr.logger.Error("unable to consume the notifications", zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
 	}
 
 	_, err = w.Write([]byte("OK"))
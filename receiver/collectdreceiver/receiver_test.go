@@ -5,7 +5,11 @@ package collectdreceiver
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -17,10 +21,12 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver/internal/metadata"
 )
@@ -58,6 +64,22 @@ func TestNewReceiver(t *testing.T) {
 				nextConsumer: consumertest.NewNop(),
 			},
 		},
+		{
+			name: "missing types.db",
+			args: args{
+				config: &Config{
+					ServerConfig: confighttp.ServerConfig{
+						NetAddr: confignet.AddrConfig{
+							Transport: "tcp",
+							Endpoint:  ":0",
+						},
+					},
+					TypesDB: []string{filepath.Join("testdata", "missing.db")},
+				},
+				nextConsumer: consumertest.NewNop(),
+			},
+			wantErr: os.ErrNotExist,
+		},
 	}
 	logger := zap.NewNop()
 	for _, tt := range tests {
@@ -197,6 +219,105 @@ func TestCollectDServer(t *testing.T) {
 	}
 }
 
+func TestCollectDServerNotifications(t *testing.T) {
+	config := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			NetAddr: confignet.AddrConfig{
+				Transport: "tcp",
+				Endpoint:  testutil.GetAvailableLocalAddress(t),
+			},
+		},
+	}
+	metricsSink := new(consumertest.MetricsSink)
+	logsSink := new(consumertest.LogsSink)
+
+	cdr, err := newCollectdReceiver(zap.NewNop(), config, "dap_", metricsSink, receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+	cdr.logsConsumer = logsSink
+
+	require.NoError(t, cdr.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, cdr.Shutdown(context.Background()))
+	})
+
+	body := `[
+		{
+			"host": "i-b13d1e5f",
+			"message": "Host i-b13d1e5f, plugin memory type memory (instance used): Data source \"value\" is currently 2147483648.000000. That is above the failure threshold of 1073741824.000000.",
+			"plugin": "memory",
+			"plugin_instance": "",
+			"severity": "FAILURE",
+			"time": 1415062577.4949999,
+			"type": "memory",
+			"type_instance": "used"
+		},
+		{
+			"dsnames": ["value"],
+			"dstypes": ["gauge"],
+			"host": "i-b13d1e5f",
+			"interval": 10.0,
+			"plugin": "memory",
+			"plugin_instance": "",
+			"time": 1415062577.4949999,
+			"type": "memory",
+			"type_instance": "used",
+			"values": [2147483648]
+		}
+	]`
+	req, err := http.NewRequest(http.MethodPost, "http://"+config.NetAddr.Endpoint+"?dap_attr1=attr1val", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Len(t, metricsSink.AllMetrics(), 1)
+	assert.Equal(t, 1, metricsSink.AllMetrics()[0].DataPointCount())
+
+	require.Len(t, logsSink.AllLogs(), 1)
+	logs := logsSink.AllLogs()[0]
+	require.Equal(t, 1, logs.LogRecordCount())
+	logRecord := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberError, logRecord.SeverityNumber())
+	assert.Contains(t, logRecord.Body().Str(), "above the failure threshold")
+	attr, ok := logRecord.Attributes().Get("attr1")
+	require.True(t, ok)
+	assert.Equal(t, "attr1val", attr.Str())
+}
+
+func TestCollectDServerNotificationsError(t *testing.T) {
+	config := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			NetAddr: confignet.AddrConfig{
+				Transport: "tcp",
+				Endpoint:  testutil.GetAvailableLocalAddress(t),
+			},
+		},
+	}
+	metricsSink := new(consumertest.MetricsSink)
+
+	cdr, err := newCollectdReceiver(zap.NewNop(), config, "", metricsSink, receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+	cdr.logsConsumer = consumertest.NewErr(errors.New("logs pipeline failure"))
+
+	require.NoError(t, cdr.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, cdr.Shutdown(context.Background()))
+	})
+
+	body := `[
+		{"host": "i-b13d1e5f", "message": "memory above the failure threshold", "plugin": "memory", "severity": "FAILURE", "time": 1415062577.4949999, "type": "memory"},
+		{"dsnames": ["value"], "dstypes": ["gauge"], "host": "i-b13d1e5f", "interval": 10.0, "plugin": "memory", "plugin_instance": "", "time": 1415062577.4949999, "type": "memory", "type_instance": "used", "values": [2147483648]}
+	]`
+	req, err := http.NewRequest(http.MethodPost, "http://"+config.NetAddr.Endpoint, bytes.NewBufferString(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Len(t, metricsSink.AllMetrics(), 1)
+}
+
 func createWantedMetrics(wantedRequestBody wantedBody) pmetric.Metrics {
 	var dataPoint pmetric.NumberDataPoint
 	testMetrics := pmetric.NewMetrics()
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collectdreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver"
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// typeUnits are the units of the well-known collectd types.
+var typeUnits = map[string]string{
+	"bytes":         "By",
+	"cache_size":    "By",
+	"current":       "A",
+	"delay":         "s",
+	"df_complex":    "By",
+	"disk_octets":   "By",
+	"disk_ops":      "{operation}",
+	"disk_time":     "ms",
+	"duration":      "s",
+	"frequency":     "Hz",
+	"if_dropped":    "{packet}",
+	"if_errors":     "{error}",
+	"if_octets":     "By",
+	"if_packets":    "{packet}",
+	"io_octets":     "By",
+	"latency":       "s",
+	"memory":        "By",
+	"percent":       "%",
+	"percent_bytes": "%",
+	"power":         "W",
+	"response_time": "ms",
+	"swap":          "By",
+	"swap_io":       "{page}",
+	"temperature":   "Cel",
+	"timeleft":      "s",
+	"total_bytes":   "By",
+	"uptime":        "s",
+	"voltage":       "V",
+}
+
+// dataSource is a data source of a collectd type.
+type dataSource struct {
+	name   string
+	dsType string
+}
+
+// collectdType is a type defined in a collectd types.db file.
+type collectdType struct {
+	dataSources []dataSource
+	unit        string
+}
+
+// typesDB holds the collectd types by name.
+type typesDB map[string]collectdType
+
+// loadTypesDB loads the types defined in the given types.db files. The types of
+// the later files override the types of the earlier ones.
+func loadTypesDB(paths []string) (typesDB, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	types := make(typesDB)
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open types.db: %w", err)
+		}
+		err = types.parse(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+	}
+	return types, nil
+}
+
+// parse reads the types of the types.db(5) format, where each line holds a type name
+// followed by its comma separated data sources as name:type:min:max.
+func (types typesDB) parse(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		idx := strings.IndexFunc(line, unicode.IsSpace)
+		if idx < 0 {
+			return fmt.Errorf("line %d: type %q has no data source", lineNumber, line)
+		}
+		name, specs := line[:idx], line[idx:]
+		t := collectdType{unit: typeUnits[name]}
+		for spec := range strings.FieldsFuncSeq(specs, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		}) {
+			fields := strings.Split(spec, ":")
+			if len(fields) != 4 || fields[0] == "" {
+				return fmt.Errorf("line %d: invalid data source %q", lineNumber, spec)
+			}
+			t.dataSources = append(t.dataSources, dataSource{
+				name:   fields[0],
+				dsType: strings.ToLower(fields[1]),
+			})
+		}
+		types[name] = t
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collectdreceiver
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTypesDB(t *testing.T) {
+	types, err := loadTypesDB([]string{
+		filepath.Join("testdata", "types.db"),
+		filepath.Join("testdata", "types_override.db"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, typesDB{
+		"if_octets": {
+			dataSources: []dataSource{{name: "rx", dsType: "derive"}, {name: "tx", dsType: "derive"}},
+			unit:        "By",
+		},
+		"load": {
+			dataSources: []dataSource{
+				{name: "shortterm", dsType: "gauge"},
+				{name: "midterm", dsType: "gauge"},
+				{name: "longterm", dsType: "gauge"},
+			},
+		},
+		"memory": {
+			dataSources: []dataSource{{name: "value", dsType: "gauge"}},
+			unit:        "By",
+		},
+		"temperature": {
+			dataSources: []dataSource{{name: "celsius", dsType: "gauge"}},
+			unit:        "Cel",
+		},
+	}, types)
+}
+
+func TestLoadTypesDBEmpty(t *testing.T) {
+	types, err := loadTypesDB(nil)
+	require.NoError(t, err)
+	assert.Nil(t, types)
+}
+
+func TestLoadTypesDBMissingFile(t *testing.T) {
+	_, err := loadTypesDB([]string{filepath.Join("testdata", "missing.db")})
+	assert.ErrorContains(t, err, "failed to open types.db")
+}
+
+func TestParseTypesDBErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "no data source",
+			data: "# comment\nif_octets\n",
+			err:  `line 2: type "if_octets" has no data source`,
+		},
+		{
+			name: "invalid data source",
+			data: "if_octets rx:DERIVE:0, tx:DERIVE:0:U\n",
+			err:  `line 1: invalid data source "rx:DERIVE:0"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, make(typesDB).parse(strings.NewReader(tt.data)), tt.err)
+		})
+	}
+}
@@ -34,10 +34,20 @@ type Config struct {
 	// present.
 	EnvVarsToMetricLabels map[string]string `mapstructure:"env_vars_to_metric_labels"`
 
+	// ContainerEvents configures the container events emitted as logs when the receiver is part of a logs pipeline.
+	ContainerEvents ContainerEventsConfig `mapstructure:"container_events"`
+
 	// MetricsBuilderConfig config. Enable or disable stats by name.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
 
+// ContainerEventsConfig defines which container events of the Docker events API are emitted as logs.
+type ContainerEventsConfig struct {
+	// Actions are the container event actions to emit, e.g. start, die or oom.
+	// See https://docs.docker.com/reference/cli/docker/system/events/#containers for the list of actions.
+	Actions []string `mapstructure:"actions"`
+}
+
 func (config Config) Validate() error {
 	if err := docker.VersionIsValidAndGTE(config.DockerAPIVersion, minimumRequiredDockerAPIVersion); err != nil {
 		return err
@@ -69,6 +69,9 @@ func TestLoadConfig(t *testing.T) {
 					"MY_ENVIRONMENT_VARIABLE":       "my-metric-label",
 					"MY_OTHER_ENVIRONMENT_VARIABLE": "my-other-metric-label",
 				},
+				ContainerEvents: ContainerEventsConfig{
+					Actions: []string{"die", "oom"},
+				},
 				MetricsBuilderConfig: func() metadata.MetricsBuilderConfig {
 					m := metadata.DefaultMetricsBuilderConfig()
 					m.Metrics.ContainerCPUUsageSystem = metadata.MetricConfig{
@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver"
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	etypes "github.com/docker/docker/api/types/events"
+	dfilters "github.com/docker/docker/api/types/filters"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
+)
+
+// eventsRetryInterval is the time waited before resubscribing to the events API after an error.
+const eventsRetryInterval = 3 * time.Second
+
+// eventsReceiver emits the container events of the Docker events API as logs.
+type eventsReceiver struct {
+	config   *Config
+	settings receiver.Settings
+	consumer consumer.Logs
+	client   *docker.Client
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func newEventsReceiver(set receiver.Settings, config *Config, consumer consumer.Logs) *eventsReceiver {
+	return &eventsReceiver{
+		config:   config,
+		settings: set,
+		consumer: consumer,
+	}
+}
+
+func (r *eventsReceiver) Start(ctx context.Context, _ component.Host) error {
+	var err error
+	r.client, err = docker.NewDockerClient(&r.config.Config, r.settings.Logger, clientOptions(r.config)...)
+	if err != nil {
+		return err
+	}
+
+	cctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.eventLoop(cctx)
+	}()
+	return nil
+}
+
+func (r *eventsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+// eventCursor tracks the time of the last received event and the events received at that time.
+// The events API includes the events of the since time, so the subscription resumed from the
+// cursor skips the events already received.
+type eventCursor struct {
+	time time.Time
+	seen map[string]struct{}
+}
+
+func newEventCursor(start time.Time) *eventCursor {
+	return &eventCursor{
+		time: start,
+		seen: map[string]struct{}{},
+	}
+}
+
+// next moves the cursor to the event, and returns false if the event was already received.
+func (c *eventCursor) next(event etypes.Message) bool {
+	key := event.Actor.ID + "/" + string(event.Action)
+	switch {
+	case event.TimeNano > c.time.UnixNano():
+		c.time = time.Unix(0, event.TimeNano)
+		clear(c.seen)
+	case event.TimeNano < c.time.UnixNano():
+		return true
+	}
+	if _, ok := c.seen[key]; ok {
+		return false
+	}
+	c.seen[key] = struct{}{}
+	return true
+}
+
+// eventLoop consumes the container events until ctx is canceled. The subscription is resumed
+// from the last received event when the stream fails.
+func (r *eventsReceiver) eventLoop(ctx context.Context) {
+	args := dfilters.NewArgs(dfilters.Arg("type", string(etypes.ContainerEventType)))
+	for _, action := range r.config.ContainerEvents.Actions {
+		args.Add("event", action)
+	}
+	cursor := newEventCursor(time.Now())
+
+	for {
+		eventCh, errCh := r.client.Events(ctx, etypes.ListOptions{
+			Filters: args,
+			Since:   cursor.time.Format(time.RFC3339Nano),
+		})
+
+	STREAM:
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventCh:
+				if !cursor.next(event) {
+					continue
+				}
+				if r.client.IsExcludedImage(event.Actor.Attributes["image"]) {
+					continue
+				}
+				if err := r.consumer.ConsumeLogs(ctx, r.eventToLogs(event)); err != nil {
+					r.settings.Logger.Error("Failed to consume docker container event", zap.Error(err))
+				}
+			case err := <-errCh:
+				if ctx.Err() != nil {
+					return
+				}
+				r.settings.Logger.Error("Error watching docker container events", zap.Error(err))
+				select {
+				case <-time.After(eventsRetryInterval):
+					break STREAM
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// eventToLogs converts a container event to a log record. The container resource is built
+// from the event actor attributes, which hold the container name, image and labels.
+func (r *eventsReceiver) eventToLogs(event etypes.Message) plog.Logs {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+
+	rb := metadata.NewResourceBuilder(r.config.ResourceAttributes)
+	rb.SetContainerRuntime("docker")
+	rb.SetContainerID(event.Actor.ID)
+	rb.SetContainerName(event.Actor.Attributes["name"])
+	rb.SetContainerImageName(event.Actor.Attributes["image"])
+	resource := rb.Emit()
+	for k, label := range r.config.ContainerLabelsToMetricLabels {
+		if v := event.Actor.Attributes[k]; v != "" {
+			resource.Attributes().PutStr(label, v)
+		}
+	}
+	resource.MoveTo(rl.Resource())
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(metadata.ScopeName)
+	sl.Scope().SetVersion(r.settings.BuildInfo.Version)
+
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(event.TimeNano))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	lr.SetEventName("container." + eventActionName(event.Action))
+	lr.Body().SetStr(string(event.Action))
+
+	severity := plog.SeverityNumberInfo
+	exitCode, hasExitCode := event.Actor.Attributes["exitCode"]
+	if hasExitCode {
+		lr.Attributes().PutStr("container.exit_code", exitCode)
+	}
+	switch {
+	case event.Action == etypes.ActionOOM:
+		severity = plog.SeverityNumberError
+	case event.Action == etypes.ActionDie && hasExitCode && exitCode != "0":
+		severity = plog.SeverityNumberWarn
+	}
+	lr.SetSeverityNumber(severity)
+	return logs
+}
+
+// eventActionName returns the action without its arguments, e.g. "exec_start" for
+// "exec_start: sh -c ls" or "health_status" for "health_status: healthy".
+func eventActionName(action etypes.Action) string {
+	name, _, _ := strings.Cut(string(action), ":")
+	return name
+}
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerstatsreceiver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	etypes "github.com/docker/docker/api/types/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
+)
+
+func TestEventToLogs(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ContainerLabelsToMetricLabels = map[string]string{"com.example.team": "team"}
+	r := newEventsReceiver(receivertest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+
+	tests := []struct {
+		name          string
+		event         etypes.Message
+		eventName     string
+		severity      plog.SeverityNumber
+		extraAttrs    map[string]any
+		resourceAttrs map[string]any
+	}{
+		{
+			name: "oom",
+			event: etypes.Message{
+				Type:     etypes.ContainerEventType,
+				Action:   etypes.ActionOOM,
+				Actor:    etypes.Actor{ID: "c1", Attributes: map[string]string{"name": "web", "image": "nginx:1.27", "com.example.team": "edge"}},
+				TimeNano: 1700000000000000000,
+			},
+			eventName:  "container.oom",
+			severity:   plog.SeverityNumberError,
+			extraAttrs: map[string]any{},
+			resourceAttrs: map[string]any{
+				"container.runtime":    "docker",
+				"container.id":         "c1",
+				"container.name":       "web",
+				"container.image.name": "nginx:1.27",
+				"team":                 "edge",
+			},
+		},
+		{
+			name: "die with error",
+			event: etypes.Message{
+				Type:     etypes.ContainerEventType,
+				Action:   etypes.ActionDie,
+				Actor:    etypes.Actor{ID: "c1", Attributes: map[string]string{"name": "web", "image": "nginx:1.27", "exitCode": "137"}},
+				TimeNano: 1700000000000000000,
+			},
+			eventName:  "container.die",
+			severity:   plog.SeverityNumberWarn,
+			extraAttrs: map[string]any{"container.exit_code": "137"},
+			resourceAttrs: map[string]any{
+				"container.runtime":    "docker",
+				"container.id":         "c1",
+				"container.name":       "web",
+				"container.image.name": "nginx:1.27",
+			},
+		},
+		{
+			name: "health status",
+			event: etypes.Message{
+				Type:     etypes.ContainerEventType,
+				Action:   etypes.Action("health_status: unhealthy"),
+				Actor:    etypes.Actor{ID: "c1", Attributes: map[string]string{"name": "web", "image": "nginx:1.27"}},
+				TimeNano: 1700000000000000000,
+			},
+			eventName:  "container.health_status",
+			severity:   plog.SeverityNumberInfo,
+			extraAttrs: map[string]any{},
+			resourceAttrs: map[string]any{
+				"container.runtime":    "docker",
+				"container.id":         "c1",
+				"container.name":       "web",
+				"container.image.name": "nginx:1.27",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := r.eventToLogs(tt.event)
+			require.Equal(t, 1, logs.LogRecordCount())
+			rl := logs.ResourceLogs().At(0)
+			assert.Equal(t, tt.resourceAttrs, rl.Resource().Attributes().AsRaw())
+			lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+			assert.Equal(t, tt.eventName, lr.EventName())
+			assert.Equal(t, string(tt.event.Action), lr.Body().Str())
+			assert.Equal(t, tt.severity, lr.SeverityNumber())
+			assert.Equal(t, tt.event.TimeNano, int64(lr.Timestamp()))
+			assert.Equal(t, tt.extraAttrs, lr.Attributes().AsRaw())
+		})
+	}
+}
+
+func TestEventCursor(t *testing.T) {
+	start := time.Unix(0, 1700000000000000000)
+	cursor := newEventCursor(start)
+	event := func(id string, action etypes.Action, timeNano int64) etypes.Message {
+		return etypes.Message{Action: action, Actor: etypes.Actor{ID: id}, TimeNano: timeNano}
+	}
+
+	assert.True(t, cursor.next(event("c1", etypes.ActionStart, 1700000000000000001)))
+	assert.True(t, cursor.next(event("c2", etypes.ActionStart, 1700000000000000001)))
+	assert.True(t, cursor.next(event("c1", etypes.ActionDie, 1700000000000000001)))
+	assert.Equal(t, time.Unix(0, 1700000000000000001), cursor.time)
+
+	// the subscription resumed from the cursor receives the events of its time again
+	assert.False(t, cursor.next(event("c1", etypes.ActionStart, 1700000000000000001)))
+	assert.False(t, cursor.next(event("c2", etypes.ActionStart, 1700000000000000001)))
+	assert.True(t, cursor.next(event("c3", etypes.ActionStart, 1700000000000000001)))
+
+	assert.True(t, cursor.next(event("c1", etypes.ActionStart, 1700000000000000002)))
+	assert.Equal(t, time.Unix(0, 1700000000000000002), cursor.time)
+	assert.True(t, cursor.next(event("c1", etypes.ActionStop, 1700000000000000000)))
+	assert.Equal(t, time.Unix(0, 1700000000000000002), cursor.time)
+}
+
+func TestEventsReceiver(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !strings.HasSuffix(req.URL.Path, "/events") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Contains(t, req.URL.Query().Get("filters"), `"oom":true`)
+		_, _ = w.Write([]byte(`{"Type":"container","Action":"oom","Actor":{"ID":"c1","Attributes":{"name":"web","image":"nginx"}},"timeNano":1700000000000000000}` + "\n"))
+		_, _ = w.Write([]byte(`{"Type":"container","Action":"oom","Actor":{"ID":"c2","Attributes":{"name":"skipped","image":"excluded"}},"timeNano":1700000000000000001}` + "\n"))
+		w.(http.Flusher).Flush()
+		<-req.Context().Done()
+	}))
+	defer srv.Close()
+
+	cfg := newTestConfigBuilder().withEndpoint(srv.URL).withAPIVersion(defaultDockerAPIVersion).config
+	cfg.ExcludedImages = []string{"excluded"}
+	sink := new(consumertest.LogsSink)
+	r := newEventsReceiver(receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(t.Context()))
+
+	id, _ := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get("container.id")
+	assert.Equal(t, "c1", id.Str())
+}
@@ -21,7 +21,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -32,8 +33,11 @@ func createDefaultConfig() component.Config {
 	config.DockerAPIVersion = defaultDockerAPIVersion
 	config.Timeout = scs.Timeout
 	return &Config{
-		ControllerConfig:     scs,
-		Config:               config,
+		ControllerConfig: scs,
+		Config:           config,
+		ContainerEvents: ContainerEventsConfig{
+			Actions: []string{"create", "start", "restart", "stop", "kill", "die", "oom", "destroy", "health_status"},
+		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 	}
 }
@@ -54,3 +58,12 @@ func createMetricsReceiver(
 
 	return scraperhelper.NewMetricsController(&dsr.config.ControllerConfig, params, consumer, scraperhelper.AddMetricsScraper(metadata.Type, scrp))
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.Settings,
+	config component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	return newEventsReceiver(params, config.(*Config), consumer), nil
+}
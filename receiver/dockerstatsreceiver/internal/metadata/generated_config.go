@@ -96,6 +96,7 @@ type MetricsConfig struct {
 	ContainerNetworkIoUsageTxDropped           MetricConfig `mapstructure:"container.network.io.usage.tx_dropped"`
 	ContainerNetworkIoUsageTxErrors            MetricConfig `mapstructure:"container.network.io.usage.tx_errors"`
 	ContainerNetworkIoUsageTxPackets           MetricConfig `mapstructure:"container.network.io.usage.tx_packets"`
+	ContainerOomKills                          MetricConfig `mapstructure:"container.oom_kills"`
 	ContainerPidsCount                         MetricConfig `mapstructure:"container.pids.count"`
 	ContainerPidsLimit                         MetricConfig `mapstructure:"container.pids.limit"`
 	ContainerRestarts                          MetricConfig `mapstructure:"container.restarts"`
@@ -305,6 +306,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		ContainerNetworkIoUsageTxPackets: MetricConfig{
 			Enabled: false,
 		},
+		ContainerOomKills: MetricConfig{
+			Enabled: false,
+		},
 		ContainerPidsCount: MetricConfig{
 			Enabled: false,
 		},
@@ -93,6 +93,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerNetworkIoUsageTxDropped:           MetricConfig{Enabled: true},
 					ContainerNetworkIoUsageTxErrors:            MetricConfig{Enabled: true},
 					ContainerNetworkIoUsageTxPackets:           MetricConfig{Enabled: true},
+					ContainerOomKills:                          MetricConfig{Enabled: true},
 					ContainerPidsCount:                         MetricConfig{Enabled: true},
 					ContainerPidsLimit:                         MetricConfig{Enabled: true},
 					ContainerRestarts:                          MetricConfig{Enabled: true},
@@ -180,6 +181,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerNetworkIoUsageTxDropped:           MetricConfig{Enabled: false},
 					ContainerNetworkIoUsageTxErrors:            MetricConfig{Enabled: false},
 					ContainerNetworkIoUsageTxPackets:           MetricConfig{Enabled: false},
+					ContainerOomKills:                          MetricConfig{Enabled: false},
 					ContainerPidsCount:                         MetricConfig{Enabled: false},
 					ContainerPidsLimit:                         MetricConfig{Enabled: false},
 					ContainerRestarts:                          MetricConfig{Enabled: false},
@@ -215,6 +215,9 @@ var MetricsInfo = metricsInfo{
 	ContainerNetworkIoUsageTxPackets: metricInfo{
 		Name: "container.network.io.usage.tx_packets",
 	},
+	ContainerOomKills: metricInfo{
+		Name: "container.oom_kills",
+	},
 	ContainerPidsCount: metricInfo{
 		Name: "container.pids.count",
 	},
@@ -297,6 +300,7 @@ type metricsInfo struct {
 	ContainerNetworkIoUsageTxDropped           metricInfo
 	ContainerNetworkIoUsageTxErrors            metricInfo
 	ContainerNetworkIoUsageTxPackets           metricInfo
+	ContainerOomKills                          metricInfo
 	ContainerPidsCount                         metricInfo
 	ContainerPidsLimit                         metricInfo
 	ContainerRestarts                          metricInfo
@@ -3831,6 +3835,58 @@ func newMetricContainerNetworkIoUsageTxPackets(cfg MetricConfig) metricContainer
 	return m
 }
 
+type metricContainerOomKills struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.oom_kills metric with initial data.
+func (m *metricContainerOomKills) init() {
+	m.data.SetName("container.oom_kills")
+	m.data.SetDescription("Number of times the container was killed by the OOM killer since the receiver started.")
+	m.data.SetUnit("{kills}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricContainerOomKills) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerOomKills) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerOomKills) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerOomKills(cfg MetricConfig) metricContainerOomKills {
+	m := metricContainerOomKills{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerPidsCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -4114,6 +4170,7 @@ type MetricsBuilder struct {
 	metricContainerNetworkIoUsageTxDropped           metricContainerNetworkIoUsageTxDropped
 	metricContainerNetworkIoUsageTxErrors            metricContainerNetworkIoUsageTxErrors
 	metricContainerNetworkIoUsageTxPackets           metricContainerNetworkIoUsageTxPackets
+	metricContainerOomKills                          metricContainerOomKills
 	metricContainerPidsCount                         metricContainerPidsCount
 	metricContainerPidsLimit                         metricContainerPidsLimit
 	metricContainerRestarts                          metricContainerRestarts
@@ -4137,6 +4194,7 @@ func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
 		mb.startTime = startTime
 	})
 }
+
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
 		config:                                  mbc,
@@ -4210,6 +4268,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricContainerNetworkIoUsageTxDropped:           newMetricContainerNetworkIoUsageTxDropped(mbc.Metrics.ContainerNetworkIoUsageTxDropped),
 		metricContainerNetworkIoUsageTxErrors:            newMetricContainerNetworkIoUsageTxErrors(mbc.Metrics.ContainerNetworkIoUsageTxErrors),
 		metricContainerNetworkIoUsageTxPackets:           newMetricContainerNetworkIoUsageTxPackets(mbc.Metrics.ContainerNetworkIoUsageTxPackets),
+		metricContainerOomKills:                          newMetricContainerOomKills(mbc.Metrics.ContainerOomKills),
 		metricContainerPidsCount:                         newMetricContainerPidsCount(mbc.Metrics.ContainerPidsCount),
 		metricContainerPidsLimit:                         newMetricContainerPidsLimit(mbc.Metrics.ContainerPidsLimit),
 		metricContainerRestarts:                          newMetricContainerRestarts(mbc.Metrics.ContainerRestarts),
@@ -4396,6 +4455,7 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricContainerNetworkIoUsageTxDropped.emit(ils.Metrics())
 	mb.metricContainerNetworkIoUsageTxErrors.emit(ils.Metrics())
 	mb.metricContainerNetworkIoUsageTxPackets.emit(ils.Metrics())
+	mb.metricContainerOomKills.emit(ils.Metrics())
 	mb.metricContainerPidsCount.emit(ils.Metrics())
 	mb.metricContainerPidsLimit.emit(ils.Metrics())
 	mb.metricContainerRestarts.emit(ils.Metrics())
@@ -4766,6 +4826,11 @@ func (mb *MetricsBuilder) RecordContainerNetworkIoUsageTxPacketsDataPoint(ts pco
 	mb.metricContainerNetworkIoUsageTxPackets.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue)
 }
 
+// RecordContainerOomKillsDataPoint adds a data point to container.oom_kills metric.
+func (mb *MetricsBuilder) RecordContainerOomKillsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerOomKills.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordContainerPidsCountDataPoint adds a data point to container.pids.count metric.
 func (mb *MetricsBuilder) RecordContainerPidsCountDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricContainerPidsCount.recordDataPoint(mb.startTime, ts, val)
@@ -282,6 +282,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordContainerNetworkIoUsageTxPacketsDataPoint(ts, 1, "interface-val")
 
+			allMetricsCount++
+			mb.RecordContainerOomKillsDataPoint(ts, 1)
+
 			allMetricsCount++
 			mb.RecordContainerPidsCountDataPoint(ts, 1)
 
@@ -1351,6 +1354,20 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("interface")
 					assert.True(t, ok)
 					assert.Equal(t, "interface-val", attrVal.Str())
+				case "container.oom_kills":
+					assert.False(t, validatedMetrics["container.oom_kills"], "Found a duplicate in the metrics slice: container.oom_kills")
+					validatedMetrics["container.oom_kills"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of times the container was killed by the OOM killer since the receiver started.", ms.At(i).Description())
+					assert.Equal(t, "{kills}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "container.pids.count":
 					assert.False(t, validatedMetrics["container.pids.count"], "Found a duplicate in the metrics slice: container.pids.count")
 					validatedMetrics["container.pids.count"] = true
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	ctypes "github.com/docker/docker/api/types/container"
+	etypes "github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -41,6 +42,10 @@ type metricsReceiver struct {
 	client   *docker.Client
 	mb       *metadata.MetricsBuilder
 	cancel   context.CancelFunc
+
+	// oomKills counts the oom events per container id since the receiver started.
+	oomKills     map[string]int64
+	oomKillsLock sync.Mutex
 }
 
 func newMetricsReceiver(set receiver.Settings, config *Config) *metricsReceiver {
@@ -48,12 +53,18 @@ func newMetricsReceiver(set receiver.Settings, config *Config) *metricsReceiver
 		config:   config,
 		settings: set,
 		mb:       metadata.NewMetricsBuilder(config.MetricsBuilderConfig, set),
+		oomKills: make(map[string]int64),
 	}
 }
 
 func (r *metricsReceiver) clientOptions() []client.Opt {
+	return clientOptions(r.config)
+}
+
+// clientOptions falls back to the DOCKER_HOST environment variable when no endpoint is configured.
+func clientOptions(config *Config) []client.Opt {
 	var opts []client.Opt
-	if r.config.Endpoint == "" {
+	if config.Endpoint == "" {
 		opts = append(opts, client.WithHostFromEnv())
 	}
 	return opts
@@ -74,10 +85,27 @@ func (r *metricsReceiver) start(ctx context.Context, _ component.Host) error {
 	cctx, cancel := context.WithCancel(ctx)
 	r.cancel = cancel
 
-	go r.client.ContainerEventLoop(cctx)
+	go r.client.ContainerEventLoop(cctx, r.handleContainerEvent)
 	return nil
 }
 
+func (r *metricsReceiver) handleContainerEvent(event etypes.Message) {
+	r.oomKillsLock.Lock()
+	defer r.oomKillsLock.Unlock()
+	switch event.Action {
+	case etypes.ActionOOM:
+		r.oomKills[event.Actor.ID]++
+	case etypes.ActionDestroy:
+		delete(r.oomKills, event.Actor.ID)
+	}
+}
+
+func (r *metricsReceiver) oomKillCount(containerID string) int64 {
+	r.oomKillsLock.Lock()
+	defer r.oomKillsLock.Unlock()
+	return r.oomKills[containerID]
+}
+
 func (r *metricsReceiver) shutdown(context.Context) error {
 	if r.cancel != nil {
 		r.cancel()
@@ -142,6 +170,7 @@ func (r *metricsReceiver) recordContainerStats(now pcommon.Timestamp, containerS
 		errs = multierr.Append(errs, err)
 	}
 	r.mb.RecordContainerRestartsDataPoint(now, int64(container.RestartCount))
+	r.mb.RecordContainerOomKillsDataPoint(now, r.oomKillCount(container.ID))
 
 	// Always-present resource attrs + the user-configured resource attrs
 	rb := r.mb.NewResourceBuilder()
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	ctypes "github.com/docker/docker/api/types/container"
+	etypes "github.com/docker/docker/api/types/events"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -414,6 +415,21 @@ func TestRecordBaseMetrics(t *testing.T) {
 	})
 }
 
+func TestOOMKillCount(t *testing.T) {
+	r := newMetricsReceiver(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config))
+
+	r.handleContainerEvent(etypes.Message{Action: etypes.ActionOOM, Actor: etypes.Actor{ID: "a"}})
+	r.handleContainerEvent(etypes.Message{Action: etypes.ActionDie, Actor: etypes.Actor{ID: "a"}})
+	r.handleContainerEvent(etypes.Message{Action: etypes.ActionOOM, Actor: etypes.Actor{ID: "a"}})
+	r.handleContainerEvent(etypes.Message{Action: etypes.ActionOOM, Actor: etypes.Actor{ID: "b"}})
+	assert.Equal(t, int64(2), r.oomKillCount("a"))
+	assert.Equal(t, int64(1), r.oomKillCount("b"))
+	assert.Equal(t, int64(0), r.oomKillCount("c"))
+
+	r.handleContainerEvent(etypes.Message{Action: etypes.ActionDestroy, Actor: etypes.Actor{ID: "a"}})
+	assert.Equal(t, int64(0), r.oomKillCount("a"))
+}
+
 func dockerMockServer(urlToFile *map[string]string) (*httptest.Server, error) {
 	urlToFileContents := make(map[string][]byte, len(*urlToFile))
 	for urlPath, filePath := range *urlToFile {
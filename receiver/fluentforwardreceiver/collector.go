@@ -26,6 +26,13 @@ type collector struct {
 	telemetryBuilder *metadata.TelemetryBuilder
 }
 
+// ackedEvent is an event whose chunk is acknowledged once its records are
+// consumed. The result of the consumption is sent to consumed.
+type ackedEvent struct {
+	event
+	consumed chan<- error
+}
+
 func newCollector(eventCh <-chan event, next consumer.Logs, logger *zap.Logger, obsrecv *receiverhelper.ObsReport, telemetryBuilder *metadata.TelemetryBuilder) *collector {
 	return &collector{
 		nextConsumer:     next,
@@ -49,28 +56,41 @@ func (c *collector) processEvents(ctx context.Context) {
 			out := plog.NewLogs()
 			rls := out.ResourceLogs().AppendEmpty()
 			logSlice := rls.ScopeLogs().AppendEmpty().LogRecords()
-			e.LogRecords().MoveAndAppendTo(logSlice)
+			acked := appendEvent(logSlice, e, nil)
 
 			// Pull out anything waiting on the eventCh to get better
 			// efficiency on LogResource allocations.
-			c.fillBufferUntilChanEmpty(logSlice)
+			acked = c.fillBufferUntilChanEmpty(logSlice, acked)
 
 			logRecordCount := out.LogRecordCount()
 			c.telemetryBuilder.FluentRecordsGenerated.Add(ctx, int64(logRecordCount))
 			obsCtx := c.obsrecv.StartLogsOp(ctx)
 			err := c.nextConsumer.ConsumeLogs(obsCtx, out)
 			c.obsrecv.EndLogsOp(obsCtx, "fluent", logRecordCount, err)
+			for _, ae := range acked {
+				ae.consumed <- err
+			}
 		}
 	}
 }
 
-func (c *collector) fillBufferUntilChanEmpty(dest plog.LogRecordSlice) {
+func (c *collector) fillBufferUntilChanEmpty(dest plog.LogRecordSlice, acked []*ackedEvent) []*ackedEvent {
 	for {
 		select {
 		case e := <-c.eventCh:
-			e.LogRecords().MoveAndAppendTo(dest)
+			acked = appendEvent(dest, e, acked)
 		default:
-			return
+			return acked
 		}
 	}
 }
+
+// appendEvent moves the records of the event to dest, and appends the event
+// to acked if it must be acknowledged.
+func appendEvent(dest plog.LogRecordSlice, e event, acked []*ackedEvent) []*ackedEvent {
+	e.LogRecords().MoveAndAppendTo(dest)
+	if ae, ok := e.(*ackedEvent); ok {
+		acked = append(acked, ae)
+	}
+	return acked
+}
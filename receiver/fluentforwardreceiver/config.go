@@ -3,6 +3,14 @@
 
 package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
 
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
 // Config defines configuration for the fluentforward receiver.
 type Config struct {
 	// The address to listen on for incoming Fluent Forward events.  Should be
@@ -10,6 +18,52 @@ type Config struct {
 	// domain socket).
 	ListenAddress string `mapstructure:"endpoint"`
 
+	// TLS configures the receiver to accept TLS connections only.
+	TLS *configtls.ServerConfig `mapstructure:"tls"`
+
+	// Security enables the handshake of the Forward protocol, which
+	// authenticates the clients with a shared key and optionally with a
+	// username and password.
+	Security *SecurityConfig `mapstructure:"security"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
+
+// SecurityConfig defines the authentication of the handshake of the Forward
+// protocol.
+type SecurityConfig struct {
+	// SelfHostname is the hostname of the receiver sent to the clients during
+	// the handshake. Defaults to the hostname of the host.
+	SelfHostname string `mapstructure:"self_hostname"`
+
+	// SharedKey is the key shared by the receiver and its clients.
+	SharedKey configopaque.String `mapstructure:"shared_key"`
+
+	// Users are the users allowed to connect. If empty, the clients are only
+	// authenticated with the shared key.
+	Users []UserConfig `mapstructure:"users"`
+}
+
+// UserConfig defines the credentials of a user.
+type UserConfig struct {
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+}
+
+func (c *SecurityConfig) Validate() error {
+	if c.SharedKey == "" {
+		return errors.New("shared_key must be specified")
+	}
+	usernames := make(map[string]struct{}, len(c.Users))
+	for _, user := range c.Users {
+		if user.Username == "" {
+			return errors.New("username must be specified")
+		}
+		if _, ok := usernames[user.Username]; ok {
+			return fmt.Errorf("duplicate username %q", user.Username)
+		}
+		usernames[user.Username] = struct{}{}
+	}
+	return nil
+}
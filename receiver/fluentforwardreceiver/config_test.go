@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 )
@@ -28,3 +29,56 @@ func TestLoadConfig(t *testing.T) {
 	assert.NoError(t, xconfmap.Validate(cfg))
 	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
 }
+
+func TestLoadSecureConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    *Config
+		expectedErr string
+	}{
+		{
+			id: component.MustNewIDWithName("fluentforward", "secure"),
+			expected: &Config{
+				ListenAddress: "0.0.0.0:24224",
+				TLS: &configtls.ServerConfig{
+					Config: configtls.Config{
+						CertFile: "server.crt",
+						KeyFile:  "server.key",
+					},
+				},
+				Security: &SecurityConfig{
+					SelfHostname: "collector",
+					SharedKey:    "secret",
+					Users:        []UserConfig{{Username: "fluent", Password: "bit"}},
+				},
+			},
+		},
+		{
+			id:          component.MustNewIDWithName("fluentforward", "missing_shared_key"),
+			expectedErr: "security: shared_key must be specified",
+		},
+		{
+			id:          component.MustNewIDWithName("fluentforward", "duplicate_username"),
+			expectedErr: `security: duplicate username "fluent"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			err = xconfmap.Validate(cfg)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
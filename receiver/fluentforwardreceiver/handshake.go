@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// handshakeTimeout is the maximum duration of the handshake of a connection.
+const handshakeTimeout = 30 * time.Second
+
+var errAuthenticationFailed = errors.New("authentication failed")
+
+// handshaker authenticates the clients with the handshake phase of the
+// Forward protocol: the server sends a HELO message, which the client
+// answers with a PING message holding its credentials, and the server
+// responds with a PONG message holding the result of the authentication.
+type handshaker struct {
+	selfHostname string
+	sharedKey    string
+	// passwords maps the usernames to their password, no user authentication
+	// is required if it is empty.
+	passwords map[string]string
+}
+
+func newHandshaker(cfg *SecurityConfig, selfHostname string) *handshaker {
+	h := &handshaker{
+		selfHostname: selfHostname,
+		sharedKey:    string(cfg.SharedKey),
+		passwords:    make(map[string]string, len(cfg.Users)),
+	}
+	for _, user := range cfg.Users {
+		h.passwords[user.Username] = string(user.Password)
+	}
+	return h
+}
+
+// ping is the PING message of the client.
+type ping struct {
+	hostname        string
+	sharedKeySalt   []byte
+	sharedKeyDigest string
+	username        string
+	passwordDigest  string
+}
+
+// handshake runs the handshake phase on the connection, it returns an error
+// if it fails or if the client isn't authenticated.
+func (h *handshaker) handshake(conn net.Conn, reader *msgp.Reader) error {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return err
+	}
+
+	nonce, err := randomBytes()
+	if err != nil {
+		return err
+	}
+	var authSalt []byte
+	if len(h.passwords) > 0 {
+		if authSalt, err = randomBytes(); err != nil {
+			return err
+		}
+	}
+
+	writer := msgp.NewWriter(conn)
+	if err = writeHelo(writer, nonce, authSalt); err != nil {
+		return fmt.Errorf("failed to send HELO: %w", err)
+	}
+	p, err := readPing(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read PING: %w", err)
+	}
+
+	reason := h.authenticate(p, nonce, authSalt)
+	if err = h.writePong(writer, p, nonce, reason); err != nil {
+		return fmt.Errorf("failed to send PONG: %w", err)
+	}
+	if reason != "" {
+		return fmt.Errorf("%w: %s", errAuthenticationFailed, reason)
+	}
+	return conn.SetDeadline(time.Time{})
+}
+
+// authenticate returns the reason why the client isn't authenticated, or an
+// empty string if it is.
+func (h *handshaker) authenticate(p *ping, nonce, authSalt []byte) string {
+	if !digestEqual(p.sharedKeyDigest, digest(p.sharedKeySalt, []byte(p.hostname), nonce, []byte(h.sharedKey))) {
+		return "shared_key mismatch"
+	}
+	if len(h.passwords) == 0 {
+		return ""
+	}
+	password, ok := h.passwords[p.username]
+	if !ok || !digestEqual(p.passwordDigest, digest(authSalt, []byte(p.username), []byte(password))) {
+		return "username/password mismatch"
+	}
+	return ""
+}
+
+func writeHelo(writer *msgp.Writer, nonce, authSalt []byte) error {
+	if err := writer.WriteArrayHeader(2); err != nil {
+		return err
+	}
+	if err := writer.WriteString("HELO"); err != nil {
+		return err
+	}
+	if err := writer.WriteMapHeader(3); err != nil {
+		return err
+	}
+	if err := writer.WriteString("nonce"); err != nil {
+		return err
+	}
+	if err := writer.WriteBytes(nonce); err != nil {
+		return err
+	}
+	if err := writer.WriteString("auth"); err != nil {
+		return err
+	}
+	if err := writer.WriteBytes(authSalt); err != nil {
+		return err
+	}
+	if err := writer.WriteString("keepalive"); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(true); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+func readPing(reader *msgp.Reader) (*ping, error) {
+	size, err := reader.ReadArrayHeader()
+	if err != nil {
+		return nil, err
+	}
+	if size != 6 {
+		return nil, fmt.Errorf("expected 6 elements, got %d", size)
+	}
+	fields := make([][]byte, size)
+	for i := range fields {
+		if fields[i], err = readStringOrBytes(reader); err != nil {
+			return nil, err
+		}
+	}
+	if string(fields[0]) != "PING" {
+		return nil, fmt.Errorf("unexpected message type %q", fields[0])
+	}
+	return &ping{
+		hostname:        string(fields[1]),
+		sharedKeySalt:   fields[2],
+		sharedKeyDigest: string(fields[3]),
+		username:        string(fields[4]),
+		passwordDigest:  string(fields[5]),
+	}, nil
+}
+
+func (h *handshaker) writePong(writer *msgp.Writer, p *ping, nonce []byte, reason string) error {
+	hostname, sharedKeyDigest := "", ""
+	if reason == "" {
+		hostname = h.selfHostname
+		sharedKeyDigest = digest(p.sharedKeySalt, []byte(h.selfHostname), nonce, []byte(h.sharedKey))
+	}
+	if err := writer.WriteArrayHeader(5); err != nil {
+		return err
+	}
+	if err := writer.WriteString("PONG"); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(reason == ""); err != nil {
+		return err
+	}
+	if err := writer.WriteString(reason); err != nil {
+		return err
+	}
+	if err := writer.WriteString(hostname); err != nil {
+		return err
+	}
+	if err := writer.WriteString(sharedKeyDigest); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// readStringOrBytes reads a str or bin value, as clients use either of them.
+func readStringOrBytes(reader *msgp.Reader) ([]byte, error) {
+	t, err := reader.NextType()
+	if err != nil {
+		return nil, err
+	}
+	if t == msgp.BinType {
+		return reader.ReadBytes(nil)
+	}
+	return reader.ReadStringAsBytes(nil)
+}
+
+// digest returns the hex encoded SHA-512 digest of the concatenation of the parts.
+func digest(parts ...[]byte) string {
+	h := sha512.New()
+	for _, part := range parts {
+		h.Write(part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func digestEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+func randomBytes() ([]byte, error) {
+	b := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fluentforwardreceiver
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tinylib/msgp/msgp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+)
+
+// clientHandshake runs the client side of the handshake, and returns the PONG
+// message of the server.
+func clientHandshake(t *testing.T, conn net.Conn, hostname, sharedKey, username, password string) []any {
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+	reader := msgp.NewReader(conn)
+
+	helo, err := reader.ReadIntf()
+	require.NoError(t, err)
+	heloArr := helo.([]any)
+	require.Len(t, heloArr, 2)
+	assert.Equal(t, "HELO", heloArr[0])
+	options := heloArr[1].(map[string]any)
+	nonce := options["nonce"].([]byte)
+	authSalt := options["auth"].([]byte)
+	assert.Len(t, nonce, 16)
+	assert.Equal(t, true, options["keepalive"])
+
+	sharedKeySalt := []byte("0123456789abcdef")
+	var b []byte
+	b = msgp.AppendArrayHeader(b, 6)
+	b = msgp.AppendString(b, "PING")
+	b = msgp.AppendString(b, hostname)
+	b = msgp.AppendBytes(b, sharedKeySalt)
+	b = msgp.AppendString(b, digest(sharedKeySalt, []byte(hostname), nonce, []byte(sharedKey)))
+	b = msgp.AppendString(b, username)
+	b = msgp.AppendString(b, digest(authSalt, []byte(username), []byte(password)))
+	_, err = conn.Write(b)
+	require.NoError(t, err)
+
+	pong, err := reader.ReadIntf()
+	require.NoError(t, err)
+	pongArr := pong.([]any)
+	require.Len(t, pongArr, 5)
+	assert.Equal(t, "PONG", pongArr[0])
+	if pongArr[1] == true {
+		// The server is authenticated by the client as well.
+		assert.Equal(t, digest(sharedKeySalt, []byte(pongArr[3].(string)), nonce, []byte(sharedKey)), pongArr[4])
+	}
+	require.NoError(t, conn.SetDeadline(time.Time{}))
+	return pongArr
+}
+
+func sendAckedMessage(t *testing.T, conn net.Conn) {
+	var b []byte
+	b = msgp.AppendArrayHeader(b, 4)
+	b = msgp.AppendString(b, "my-tag")
+	b = msgp.AppendInt(b, 5000)
+	b = msgp.AppendMapHeader(b, 1)
+	b = msgp.AppendString(b, "a")
+	b = msgp.AppendFloat64(b, 5.0)
+	b = msgp.AppendMapStrStr(b, map[string]string{"chunk": "chunk-1"})
+	_, err := conn.Write(b)
+	require.NoError(t, err)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	resp := map[string]any{}
+	require.NoError(t, msgp.NewReader(conn).ReadMapStrIntf(resp))
+	assert.Equal(t, "chunk-1", resp["ack"])
+}
+
+func TestHandshake(t *testing.T) {
+	tests := []struct {
+		name           string
+		security       *SecurityConfig
+		sharedKey      string
+		username       string
+		password       string
+		expectedReason string
+	}{
+		{
+			name:      "shared_key",
+			security:  &SecurityConfig{SelfHostname: "collector", SharedKey: "secret"},
+			sharedKey: "secret",
+		},
+		{
+			name:           "shared_key_mismatch",
+			security:       &SecurityConfig{SelfHostname: "collector", SharedKey: "secret"},
+			sharedKey:      "wrong",
+			expectedReason: "shared_key mismatch",
+		},
+		{
+			name: "user",
+			security: &SecurityConfig{
+				SelfHostname: "collector",
+				SharedKey:    "secret",
+				Users:        []UserConfig{{Username: "fluent", Password: "bit"}},
+			},
+			sharedKey: "secret",
+			username:  "fluent",
+			password:  "bit",
+		},
+		{
+			name: "password_mismatch",
+			security: &SecurityConfig{
+				SelfHostname: "collector",
+				SharedKey:    "secret",
+				Users:        []UserConfig{{Username: "fluent", Password: "bit"}},
+			},
+			sharedKey:      "secret",
+			username:       "fluent",
+			password:       "wrong",
+			expectedReason: "username/password mismatch",
+		},
+		{
+			name: "unknown_user",
+			security: &SecurityConfig{
+				SelfHostname: "collector",
+				SharedKey:    "secret",
+				Users:        []UserConfig{{Username: "fluent", Password: "bit"}},
+			},
+			sharedKey:      "secret",
+			username:       "other",
+			password:       "bit",
+			expectedReason: "username/password mismatch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := new(consumertest.LogsSink)
+			connect, _, cancel, _ := setupServerWithConfig(t, &Config{ListenAddress: "127.0.0.1:0", Security: tt.security}, next)
+			defer cancel()
+
+			conn := connect()
+			defer conn.Close()
+			pong := clientHandshake(t, conn, "fluent-bit", tt.sharedKey, tt.username, tt.password)
+			if tt.expectedReason != "" {
+				assert.Equal(t, []any{"PONG", false, tt.expectedReason, "", ""}, pong)
+				waitForConnectionClose(t, conn)
+				return
+			}
+			assert.Equal(t, true, pong[1])
+			assert.Equal(t, "collector", pong[3])
+
+			sendAckedMessage(t, conn)
+			assert.Equal(t, 1, next.LogRecordCount())
+		})
+	}
+}
+
+func TestTLS(t *testing.T) {
+	next := new(consumertest.LogsSink)
+	connect, _, cancel, receiver := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		TLS: &configtls.ServerConfig{
+			Config: configtls.Config{
+				CertFile: filepath.Join("testdata", "server.crt"),
+				KeyFile:  filepath.Join("testdata", "server.key"),
+			},
+		},
+		Security: &SecurityConfig{SelfHostname: "collector", SharedKey: "secret"},
+	}, next)
+	defer cancel()
+
+	// Plain connections are closed without receiving the HELO message.
+	plainConn := connect()
+	defer plainConn.Close()
+	_, err := plainConn.Write(parseHexDump("testdata/message-event"))
+	require.NoError(t, err)
+	require.NoError(t, plainConn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	received, err := io.ReadAll(plainConn)
+	require.NoError(t, err)
+	assert.NotContains(t, string(received), "HELO")
+
+	caCert, err := os.ReadFile(filepath.Join("testdata", "ca.crt"))
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(caCert))
+	conn, err := tls.Dial("tcp", receiver.(*fluentReceiver).listener.Addr().String(), &tls.Config{
+		RootCAs:    roots,
+		ServerName: "localhost",
+		MinVersion: tls.VersionTLS12,
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	pong := clientHandshake(t, conn, "fluent-bit", "secret", "", "")
+	assert.Equal(t, true, pong[1])
+	sendAckedMessage(t, conn)
+	assert.Equal(t, 1, next.LogRecordCount())
+}
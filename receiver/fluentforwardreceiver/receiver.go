@@ -5,7 +5,9 @@ package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
+	"os"
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
@@ -48,7 +50,18 @@ func newFluentReceiver(set receiver.Settings, conf *Config, next consumer.Logs)
 	eventCh := make(chan event, eventChannelLength)
 	collector := newCollector(eventCh, next, set.Logger, obsrecv, telemetryBuilder)
 
-	server := newServer(eventCh, set.Logger, telemetryBuilder)
+	var handshaker *handshaker
+	if conf.Security != nil {
+		selfHostname := conf.Security.SelfHostname
+		if selfHostname == "" {
+			if selfHostname, err = os.Hostname(); err != nil {
+				return nil, err
+			}
+		}
+		handshaker = newHandshaker(conf.Security, selfHostname)
+	}
+
+	server := newServer(eventCh, set.Logger, telemetryBuilder, handshaker)
 
 	return &fluentReceiver{
 		collector: collector,
@@ -82,6 +95,18 @@ func (r *fluentReceiver) Start(ctx context.Context, _ component.Host) error {
 		return err
 	}
 
+	if r.conf.TLS != nil {
+		tlsConfig, tlsErr := r.conf.TLS.LoadTLSConfig(ctx)
+		if tlsErr != nil {
+			_ = listener.Close()
+			if udpListener != nil {
+				_ = udpListener.Close()
+			}
+			return tlsErr
+		}
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
 	r.listener = listener
 
 	r.server.Start(receiverCtx, listener)
@@ -5,6 +5,7 @@ package fluentforwardreceiver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -17,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tinylib/msgp/msgp"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -30,19 +32,20 @@ import (
 )
 
 func setupServer(t *testing.T) (func() net.Conn, *consumertest.LogsSink, *observer.ObservedLogs, context.CancelFunc, receiver.Logs) {
+	next := new(consumertest.LogsSink)
+	connect, observedLogs, cancel, receiver := setupServerWithConfig(t, &Config{ListenAddress: "127.0.0.1:0"}, next)
+	return connect, next, observedLogs, cancel, receiver
+}
+
+func setupServerWithConfig(t *testing.T, conf *Config, next consumer.Logs) (func() net.Conn, *observer.ObservedLogs, context.CancelFunc, receiver.Logs) {
 	ctx, cancel := context.WithCancel(t.Context())
 
-	next := new(consumertest.LogsSink)
 	logCore, logObserver := observer.New(zap.DebugLevel)
 	logger := zap.New(logCore)
 
 	set := receivertest.NewNopSettings(metadata.Type)
 	set.Logger = logger
 
-	conf := &Config{
-		ListenAddress: "127.0.0.1:0",
-	}
-
 	receiver, err := newFluentReceiver(set, conf, next)
 	require.NoError(t, err)
 	require.NoError(t, receiver.Start(ctx, componenttest.NewNopHost()))
@@ -58,7 +61,7 @@ func setupServer(t *testing.T) (func() net.Conn, *consumertest.LogsSink, *observ
 		assert.NoError(t, receiver.Shutdown(ctx))
 	}()
 
-	return connect, next, logObserver, cancel, receiver
+	return connect, logObserver, cancel, receiver
 }
 
 func waitForConnectionClose(t *testing.T, conn net.Conn) {
@@ -203,6 +206,31 @@ func TestEventAcknowledgment(t *testing.T) {
 	require.Equal(t, chunkValue, resp["ack"])
 }
 
+func TestEventNotAcknowledgedOnConsumerError(t *testing.T) {
+	connect, _, cancel, _ := setupServerWithConfig(t, &Config{ListenAddress: "127.0.0.1:0"}, consumertest.NewErr(errors.New("consumer error")))
+	defer cancel()
+
+	var b []byte
+	b = msgp.AppendArrayHeader(b, 4)
+	b = msgp.AppendString(b, "my-tag")
+	b = msgp.AppendInt(b, 5000)
+	b = msgp.AppendMapHeader(b, 1)
+	b = msgp.AppendString(b, "a")
+	b = msgp.AppendFloat64(b, 5.0)
+	b = msgp.AppendMapStrStr(b, map[string]string{"chunk": "abcdef01234576789"})
+
+	conn := connect()
+	_, err := conn.Write(b)
+	require.NoError(t, err)
+
+	// The client must send the chunk again since it isn't acknowledged.
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(500*time.Millisecond)))
+	_, err = msgp.NewReader(conn).ReadMapHeader()
+	var netErr net.Error
+	require.ErrorAs(t, err, &netErr)
+	require.True(t, netErr.Timeout())
+}
+
 func TestForwardPackedEvent(t *testing.T) {
 	connect, next, _, cancel, _ := setupServer(t)
 	defer cancel()
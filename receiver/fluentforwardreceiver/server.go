@@ -28,15 +28,19 @@ type server struct {
 	outCh            chan<- event
 	logger           *zap.Logger
 	telemetryBuilder *metadata.TelemetryBuilder
-	conns            map[net.Conn]struct{}
-	mu               sync.Mutex
+	// handshaker authenticates the connections, it is nil if the handshake
+	// isn't enabled.
+	handshaker *handshaker
+	conns      map[net.Conn]struct{}
+	mu         sync.Mutex
 }
 
-func newServer(outCh chan<- event, logger *zap.Logger, telemetryBuilder *metadata.TelemetryBuilder) *server {
+func newServer(outCh chan<- event, logger *zap.Logger, telemetryBuilder *metadata.TelemetryBuilder, handshaker *handshaker) *server {
 	return &server{
 		outCh:            outCh,
 		logger:           logger,
 		telemetryBuilder: telemetryBuilder,
+		handshaker:       handshaker,
 		conns:            make(map[net.Conn]struct{}),
 	}
 }
@@ -95,6 +99,12 @@ func (s *server) handleConnections(ctx context.Context, listener net.Listener) {
 func (s *server) handleConn(ctx context.Context, conn net.Conn) error {
 	reader := msgp.NewReaderSize(conn, readBufferSize)
 
+	if s.handshaker != nil {
+		if err := s.handshaker.handshake(conn, reader); err != nil {
+			return fmt.Errorf("handshake failed: %w", err)
+		}
+	}
+
 	for {
 		mode, err := determineNextEventMode(reader.R)
 		if err != nil {
@@ -125,17 +135,28 @@ func (s *server) handleConn(ctx context.Context, conn net.Conn) error {
 
 		s.telemetryBuilder.FluentEventsParsed.Add(ctx, 1)
 
-		s.outCh <- e
+		if e.Chunk() == "" {
+			s.outCh <- e
+			continue
+		}
 
-		// We must acknowledge the 'chunk' option if given. We could do this in
-		// another goroutine if it is too much of a bottleneck to reading
-		// messages -- this is the only thing that sends data back to the
-		// client.
-		if e.Chunk() != "" {
-			err := msgp.Encode(conn, internal.AckResponse{Ack: e.Chunk()})
-			if err != nil {
-				return fmt.Errorf("failed to acknowledge chunk %s: %w", e.Chunk(), err)
-			}
+		// We must acknowledge the 'chunk' option if given. The chunk is only
+		// acknowledged once its records were consumed, so that the client
+		// sends it again if they weren't, as per the at-least-once semantics
+		// of the Forward protocol.
+		consumed := make(chan error, 1)
+		s.outCh <- &ackedEvent{event: e, consumed: consumed}
+		select {
+		case err = <-consumed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err != nil {
+			s.logger.Debug("Not acknowledging chunk whose records were not consumed", zap.String("chunk", e.Chunk()), zap.Error(err))
+			continue
+		}
+		if err := msgp.Encode(conn, internal.AckResponse{Ack: e.Chunk()}); err != nil {
+			return fmt.Errorf("failed to acknowledge chunk %s: %w", e.Chunk(), err)
 		}
 	}
 }
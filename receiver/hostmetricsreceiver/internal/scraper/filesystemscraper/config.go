@@ -36,6 +36,17 @@ type Config struct {
 	// When `root_path` is set, the mount points must be from the host's perspective.
 	ExcludeMountPoints MountPointMatchConfig `mapstructure:"exclude_mount_points"`
 
+	// IncludeMountPropagations specifies a filter on the propagation types of the mount points that should be included
+	// in the generated metrics. The propagation types are only known on Linux, and the filter is ignored on other systems.
+	IncludeMountPropagations MountPropagationMatchConfig `mapstructure:"include_mount_propagations"`
+	// ExcludeMountPropagations specifies a filter on the propagation types of the mount points that should be excluded
+	// from the generated metrics. The propagation types are only known on Linux, and the filter is ignored on other systems.
+	ExcludeMountPropagations MountPropagationMatchConfig `mapstructure:"exclude_mount_propagations"`
+
+	// ExcludeContainerMounts excludes the ephemeral filesystems mounted by the container runtimes, such as
+	// the overlay filesystems of the containers and the ephemeral volumes of the Kubernetes pods.
+	ExcludeContainerMounts bool `mapstructure:"exclude_container_mounts"`
+
 	rootPath string `mapstructure:"-"`
 }
 
@@ -57,14 +68,25 @@ type MountPointMatchConfig struct {
 	MountPoints []string `mapstructure:"mount_points"`
 }
 
+// MountPropagationMatchConfig matches the propagation types of the mount points,
+// which are "shared", "slave", "private" and "unbindable".
+type MountPropagationMatchConfig struct {
+	filterset.Config `mapstructure:",squash"`
+
+	Propagations []string `mapstructure:"propagations"`
+}
+
 type fsFilter struct {
-	includeDeviceFilter     filterset.FilterSet
-	excludeDeviceFilter     filterset.FilterSet
-	includeFSTypeFilter     filterset.FilterSet
-	excludeFSTypeFilter     filterset.FilterSet
-	includeMountPointFilter filterset.FilterSet
-	excludeMountPointFilter filterset.FilterSet
-	filtersExist            bool
+	includeDeviceFilter      filterset.FilterSet
+	excludeDeviceFilter      filterset.FilterSet
+	includeFSTypeFilter      filterset.FilterSet
+	excludeFSTypeFilter      filterset.FilterSet
+	includeMountPointFilter  filterset.FilterSet
+	excludeMountPointFilter  filterset.FilterSet
+	includePropagationFilter filterset.FilterSet
+	excludePropagationFilter filterset.FilterSet
+	excludeContainerMounts   bool
+	filtersExist             bool
 }
 
 func (cfg *Config) SetRootPath(rootPath string) {
@@ -118,6 +140,21 @@ func (cfg *Config) createFilter() (*fsFilter, error) {
 		}
 	}
 
+	if len(cfg.IncludeMountPropagations.Propagations) > 0 {
+		filter.includePropagationFilter, err = filterset.CreateFilterSet(cfg.IncludeMountPropagations.Propagations, &cfg.IncludeMountPropagations.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating include_mount_propagations filter: %w", err)
+		}
+	}
+
+	if len(cfg.ExcludeMountPropagations.Propagations) > 0 {
+		filter.excludePropagationFilter, err = filterset.CreateFilterSet(cfg.ExcludeMountPropagations.Propagations, &cfg.ExcludeMountPropagations.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating exclude_mount_propagations filter: %w", err)
+		}
+	}
+
+	filter.excludeContainerMounts = cfg.ExcludeContainerMounts
 	filter.setFiltersExist()
 	return &filter, nil
 }
@@ -125,5 +162,10 @@ func (cfg *Config) createFilter() (*fsFilter, error) {
 func (f *fsFilter) setFiltersExist() {
 	f.filtersExist = f.includeMountPointFilter != nil || f.excludeMountPointFilter != nil ||
 		f.includeFSTypeFilter != nil || f.excludeFSTypeFilter != nil ||
-		f.includeDeviceFilter != nil || f.excludeDeviceFilter != nil
+		f.includeDeviceFilter != nil || f.excludeDeviceFilter != nil ||
+		f.propagationFiltersExist() || f.excludeContainerMounts
+}
+
+func (f *fsFilter) propagationFiltersExist() bool {
+	return f.includePropagationFilter != nil || f.excludePropagationFilter != nil
 }
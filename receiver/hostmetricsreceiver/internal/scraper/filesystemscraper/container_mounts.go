@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystemscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/shirou/gopsutil/v4/disk"
+)
+
+var (
+	// containerFSTypes are the filesystem types used by the container runtimes for the root filesystems of the containers.
+	containerFSTypes = []string{"overlay", "fuse.fuse-overlayfs", "aufs"}
+
+	// containerMountPointPrefixes are the directories where the container runtimes mount the filesystems of the containers.
+	containerMountPointPrefixes = []string{
+		"/run/containerd/",
+		"/run/containers/storage/",
+		"/run/docker/",
+		"/run/k3s/containerd/",
+		"/var/lib/containerd/",
+		"/var/lib/containers/storage/",
+		"/var/lib/docker/",
+		"/var/lib/rancher/k3s/agent/containerd/",
+	}
+
+	// kubeletPodsDir is the directory where the kubelet mounts the volumes of the pods.
+	kubeletPodsDir = "/var/lib/kubelet/pods/"
+
+	// ephemeralPodVolumePlugins are the volume plugins of the kubelet whose volumes live as long as their pod.
+	// The persistent volumes, e.g. of the `kubernetes.io~csi` plugin, are not excluded.
+	ephemeralPodVolumePlugins = []string{
+		"kubernetes.io~configmap",
+		"kubernetes.io~downward-api",
+		"kubernetes.io~empty-dir",
+		"kubernetes.io~projected",
+		"kubernetes.io~secret",
+	}
+)
+
+// isContainerMount returns whether the partition is an ephemeral mount of a container runtime.
+// The root filesystem is never considered as such, since it is the overlay filesystem of the
+// collector when it runs in a container.
+func isContainerMount(partition disk.PartitionStat) bool {
+	if partition.Mountpoint == "/" {
+		return false
+	}
+	if slices.Contains(containerFSTypes, partition.Fstype) {
+		return true
+	}
+	for _, prefix := range containerMountPointPrefixes {
+		if strings.HasPrefix(partition.Mountpoint, prefix) {
+			return true
+		}
+	}
+	return isEphemeralPodVolume(partition.Mountpoint)
+}
+
+// isEphemeralPodVolume returns whether the mount point is an ephemeral volume of a pod,
+// mounted at /var/lib/kubelet/pods/<pod uid>/volumes/<plugin>/<volume name>.
+func isEphemeralPodVolume(mountpoint string) bool {
+	rest, ok := strings.CutPrefix(mountpoint, kubeletPodsDir)
+	if !ok {
+		return false
+	}
+	parts := strings.Split(rest, "/")
+	return len(parts) >= 4 && parts[1] == "volumes" && slices.Contains(ephemeralPodVolumePlugins, parts[2])
+}
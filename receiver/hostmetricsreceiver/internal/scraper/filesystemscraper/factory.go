@@ -21,7 +21,8 @@ func NewFactory() scraper.Factory {
 // createDefaultConfig creates the default configuration for the Scraper.
 func createDefaultConfig() component.Config {
 	return &Config{
-		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		MetricsBuilderConfig:   metadata.DefaultMetricsBuilderConfig(),
+		ExcludeContainerMounts: true,
 	}
 }
 
@@ -37,9 +37,10 @@ type filesystemsScraper struct {
 	fsFilter fsFilter
 
 	// for mocking gopsutil disk.Partitions & disk.Usage
-	bootTime   func(context.Context) (uint64, error)
-	partitions func(context.Context, bool) ([]disk.PartitionStat, error)
-	usage      func(context.Context, string) (*disk.UsageStat, error)
+	bootTime     func(context.Context) (uint64, error)
+	partitions   func(context.Context, bool) ([]disk.PartitionStat, error)
+	usage        func(context.Context, string) (*disk.UsageStat, error)
+	propagations func(context.Context) (map[string][]string, error)
 }
 
 type deviceUsage struct {
@@ -54,7 +55,7 @@ func newFileSystemScraper(_ context.Context, settings scraper.Settings, cfg *Con
 		return nil, err
 	}
 
-	scraper := &filesystemsScraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, partitions: disk.PartitionsWithContext, usage: disk.UsageWithContext, fsFilter: *fsFilter}
+	scraper := &filesystemsScraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, partitions: disk.PartitionsWithContext, usage: disk.UsageWithContext, propagations: mountPropagations, fsFilter: *fsFilter}
 	return scraper, nil
 }
 
@@ -88,6 +89,14 @@ func (s *filesystemsScraper) scrape(ctx context.Context) (pmetric.Metrics, error
 		}
 	}
 
+	var propagations map[string][]string
+	if s.fsFilter.propagationFiltersExist() {
+		propagations, err = s.propagations(ctx)
+		if err != nil {
+			errors.AddPartial(0, err)
+		}
+	}
+
 	usages := make([]*deviceUsage, 0, len(partitions))
 
 	type mountKey struct {
@@ -106,7 +115,7 @@ func (s *filesystemsScraper) scrape(ctx context.Context) (pmetric.Metrics, error
 		}
 		seen[key] = struct{}{}
 
-		if !s.fsFilter.includePartition(partition) {
+		if !s.fsFilter.includePartition(partition, propagations[partition.Mountpoint]) {
 			continue
 		}
 		translatedMountpoint := translateMountpoint(ctx, s.config.rootPath, partition.Mountpoint)
@@ -141,11 +150,15 @@ func getMountMode(opts []string) string {
 	return "unknown"
 }
 
-func (f *fsFilter) includePartition(partition disk.PartitionStat) bool {
+// includePartition returns whether the partition passes the filters. The propagation
+// filters are ignored when the propagation types of the partition are unknown.
+func (f *fsFilter) includePartition(partition disk.PartitionStat, propagations []string) bool {
 	// If filters do not exist, return early.
 	if !f.filtersExist || (f.includeDevice(partition.Device) &&
 		f.includeFSType(partition.Fstype) &&
-		f.includeMountPoint(partition.Mountpoint)) {
+		f.includeMountPoint(partition.Mountpoint) &&
+		f.includePropagations(propagations) &&
+		(!f.excludeContainerMounts || !isContainerMount(partition))) {
 		return true
 	}
 	return false
@@ -166,6 +179,15 @@ func (f *fsFilter) includeMountPoint(mountPoint string) bool {
 		(f.excludeMountPointFilter == nil || !f.excludeMountPointFilter.Matches(mountPoint))
 }
 
+// includePropagations returns whether any of the propagation types is included, and none is excluded.
+func (f *fsFilter) includePropagations(propagations []string) bool {
+	if len(propagations) == 0 {
+		return true
+	}
+	return (f.includePropagationFilter == nil || slices.ContainsFunc(propagations, f.includePropagationFilter.Matches)) &&
+		(f.excludePropagationFilter == nil || !slices.ContainsFunc(propagations, f.excludePropagationFilter.Matches))
+}
+
 // translateMountsRootPath translates a mountpoint from the host perspective to the chrooted perspective.
 func translateMountpoint(ctx context.Context, rootPath, mountpoint string) string {
 	if env, ok := ctx.Value(common.EnvKey).(common.EnvMap); ok {
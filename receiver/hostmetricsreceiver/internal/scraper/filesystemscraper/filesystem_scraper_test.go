@@ -37,6 +37,7 @@ func TestScrape(t *testing.T) {
 		bootTimeFunc             func(context.Context) (uint64, error)
 		partitionsFunc           func(context.Context, bool) ([]disk.PartitionStat, error)
 		usageFunc                func(context.Context, string) (*disk.UsageStat, error)
+		propagationsFunc         func(context.Context) (map[string][]string, error)
 		expectMetrics            bool
 		expectedDeviceDataPoints int
 		expectedDeviceAttributes []map[string]pcommon.Value
@@ -385,6 +386,137 @@ func TestScrape(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Exclude container mounts",
+			config: Config{
+				MetricsBuilderConfig:   metadata.DefaultMetricsBuilderConfig(),
+				ExcludeContainerMounts: true,
+			},
+			usageFunc: func(context.Context, string) (*disk.UsageStat, error) {
+				return &disk.UsageStat{}, nil
+			},
+			partitionsFunc: func(context.Context, bool) ([]disk.PartitionStat, error) {
+				return []disk.PartitionStat{
+					{Device: "overlay", Mountpoint: "/", Fstype: "overlay"},
+					{Device: "/dev/sda1", Mountpoint: "/var/lib/docker", Fstype: "ext4"},
+					{Device: "overlay", Mountpoint: "/var/lib/docker/overlay2/abc/merged", Fstype: "overlay"},
+					{Device: "overlay", Mountpoint: "/mnt/overlay", Fstype: "overlay"},
+					{Device: "shm", Mountpoint: "/run/containerd/io.containerd.grpc.v1.cri/sandboxes/abc/shm", Fstype: "tmpfs"},
+					{Device: "tmpfs", Mountpoint: "/var/lib/kubelet/pods/abc/volumes/kubernetes.io~projected/kube-api-access", Fstype: "tmpfs"},
+					{Device: "/dev/sdb1", Mountpoint: "/var/lib/kubelet/pods/abc/volumes/kubernetes.io~csi/pvc/mount", Fstype: "ext4"},
+				}, nil
+			},
+			expectMetrics:            true,
+			expectedDeviceDataPoints: 3,
+			expectedDeviceAttributes: []map[string]pcommon.Value{
+				{
+					"device":     pcommon.NewValueStr("overlay"),
+					"mountpoint": pcommon.NewValueStr("/"),
+					"type":       pcommon.NewValueStr("overlay"),
+					"mode":       pcommon.NewValueStr("unknown"),
+				},
+				{
+					"device":     pcommon.NewValueStr("/dev/sda1"),
+					"mountpoint": pcommon.NewValueStr("/var/lib/docker"),
+					"type":       pcommon.NewValueStr("ext4"),
+					"mode":       pcommon.NewValueStr("unknown"),
+				},
+				{
+					"device":     pcommon.NewValueStr("/dev/sdb1"),
+					"mountpoint": pcommon.NewValueStr("/var/lib/kubelet/pods/abc/volumes/kubernetes.io~csi/pvc/mount"),
+					"type":       pcommon.NewValueStr("ext4"),
+					"mode":       pcommon.NewValueStr("unknown"),
+				},
+			},
+		},
+		{
+			name: "Include and exclude mount propagations",
+			config: Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				IncludeMountPropagations: MountPropagationMatchConfig{
+					Config:       filterset.Config{MatchType: filterset.Strict},
+					Propagations: []string{"shared", "private"},
+				},
+				ExcludeMountPropagations: MountPropagationMatchConfig{
+					Config:       filterset.Config{MatchType: filterset.Strict},
+					Propagations: []string{"slave"},
+				},
+			},
+			usageFunc: func(context.Context, string) (*disk.UsageStat, error) {
+				return &disk.UsageStat{}, nil
+			},
+			partitionsFunc: func(context.Context, bool) ([]disk.PartitionStat, error) {
+				return []disk.PartitionStat{
+					{Device: "device_a", Mountpoint: "mount_point_a"},
+					{Device: "device_b", Mountpoint: "mount_point_b"},
+					{Device: "device_c", Mountpoint: "mount_point_c"},
+					{Device: "device_d", Mountpoint: "mount_point_d"},
+					{Device: "device_e", Mountpoint: "mount_point_e"},
+				}, nil
+			},
+			propagationsFunc: func(context.Context) (map[string][]string, error) {
+				return map[string][]string{
+					"mount_point_a": {"shared"},
+					"mount_point_b": {"shared", "slave"},
+					"mount_point_c": {"unbindable"},
+					"mount_point_d": {"private"},
+				}, nil
+			},
+			expectMetrics:            true,
+			expectedDeviceDataPoints: 3,
+			expectedDeviceAttributes: []map[string]pcommon.Value{
+				{
+					"device":     pcommon.NewValueStr("device_a"),
+					"mountpoint": pcommon.NewValueStr("mount_point_a"),
+					"type":       pcommon.NewValueStr(""),
+					"mode":       pcommon.NewValueStr("unknown"),
+				},
+				{
+					"device":     pcommon.NewValueStr("device_d"),
+					"mountpoint": pcommon.NewValueStr("mount_point_d"),
+					"type":       pcommon.NewValueStr(""),
+					"mode":       pcommon.NewValueStr("unknown"),
+				},
+				{
+					"device":     pcommon.NewValueStr("device_e"),
+					"mountpoint": pcommon.NewValueStr("mount_point_e"),
+					"type":       pcommon.NewValueStr(""),
+					"mode":       pcommon.NewValueStr("unknown"),
+				},
+			},
+		},
+		{
+			name: "Failed to read mount propagations",
+			config: Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				ExcludeMountPropagations: MountPropagationMatchConfig{
+					Config:       filterset.Config{MatchType: filterset.Strict},
+					Propagations: []string{"slave"},
+				},
+			},
+			usageFunc: func(context.Context, string) (*disk.UsageStat, error) {
+				return &disk.UsageStat{}, nil
+			},
+			partitionsFunc: func(context.Context, bool) ([]disk.PartitionStat, error) {
+				return []disk.PartitionStat{{Device: "device_a", Mountpoint: "mount_point_a"}}, nil
+			},
+			propagationsFunc: func(context.Context) (map[string][]string, error) {
+				return nil, errors.New("err1")
+			},
+			expectedErr:              "err1",
+			failedMetricsLen:         new(int),
+			continueOnErr:            true,
+			expectMetrics:            true,
+			expectedDeviceDataPoints: 1,
+		},
+		{
+			name: "Invalid Include Mount Propagations Filter",
+			config: Config{
+				MetricsBuilderConfig:     metadata.DefaultMetricsBuilderConfig(),
+				IncludeMountPropagations: MountPropagationMatchConfig{Propagations: []string{"shared"}},
+			},
+			newErrRegex: "^error creating include_mount_propagations filter:",
+		},
 	}
 
 	for _, test := range testCases {
@@ -410,6 +542,9 @@ func TestScrape(t *testing.T) {
 			if test.bootTimeFunc != nil {
 				scraper.bootTime = test.bootTimeFunc
 			}
+			if test.propagationsFunc != nil {
+				scraper.propagations = test.propagationsFunc
+			}
 
 			err = scraper.start(ctx, componenttest.NewNopHost())
 			if test.initializationErr != "" {
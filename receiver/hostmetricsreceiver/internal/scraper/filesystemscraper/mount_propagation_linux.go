@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package filesystemscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/shirou/gopsutil/v4/common"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/gopsutilenv"
+)
+
+// mountPropagations returns the propagation types of the mount points, read from the same
+// mountinfo file as the partitions.
+func mountPropagations(ctx context.Context) (map[string][]string, error) {
+	files := []string{
+		gopsutilenv.GetEnvWithContext(ctx, string(common.HostProcEnvKey), "/proc", "1", "mountinfo"),
+		gopsutilenv.GetEnvWithContext(ctx, string(common.HostProcEnvKey), "/proc", "self", "mountinfo"),
+	}
+	if mountInfo := gopsutilenv.GetEnvWithContext(ctx, string(common.HostProcMountinfo), ""); mountInfo != "" {
+		files = []string{filepath.Join(filepath.Dir(mountInfo), "mountinfo")}
+	}
+
+	var data []byte
+	var err error
+	for _, file := range files {
+		if data, err = os.ReadFile(file); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the mount propagations: %w", err)
+	}
+	return parseMountPropagations(strings.Split(string(data), "\n")), nil
+}
+
+// parseMountPropagations parses the mountinfo lines, described in proc_pid_mountinfo(5), like:
+//
+//	36 35 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw,errors=continue
+func parseMountPropagations(lines []string) map[string][]string {
+	propagations := make(map[string][]string, len(lines))
+	for _, line := range lines {
+		fields, _, found := strings.Cut(line, " - ")
+		if !found {
+			continue
+		}
+		parts := strings.Fields(fields)
+		if len(parts) < 5 {
+			continue
+		}
+
+		var types []string
+		for _, optional := range parts[min(6, len(parts)):] {
+			tag, _, _ := strings.Cut(optional, ":")
+			switch tag {
+			case "shared":
+				types = append(types, "shared")
+			case "master":
+				types = append(types, "slave")
+			case "unbindable":
+				types = append(types, "unbindable")
+			}
+		}
+		if len(types) == 0 {
+			types = []string{"private"}
+		}
+		propagations[unescapeMountPoint(parts[4])] = types
+	}
+	return propagations
+}
+
+// unescapeMountPoint replaces the octal escapes of the mountinfo file, like \040 for spaces.
+func unescapeMountPoint(mountPoint string) string {
+	if !strings.Contains(mountPoint, `\`) {
+		return mountPoint
+	}
+	var b strings.Builder
+	for i := 0; i < len(mountPoint); i++ {
+		if mountPoint[i] == '\\' && i+3 < len(mountPoint) {
+			if c, err := strconv.ParseUint(mountPoint[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(mountPoint[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package filesystemscraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMountPropagations(t *testing.T) {
+	lines := []string{
+		"22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw",
+		"23 22 0:21 / /proc rw,nosuid - proc proc rw",
+		"24 22 0:22 / /var/lib/kubelet/pods/abc/volumes rw shared:5 master:2 - tmpfs tmpfs rw",
+		"25 22 0:23 / /mnt/my\\040disk rw unbindable - ext4 /dev/sdb1 rw",
+		"invalid",
+		"",
+	}
+	assert.Equal(t, map[string][]string{
+		"/":                                 {"shared"},
+		"/proc":                             {"private"},
+		"/var/lib/kubelet/pods/abc/volumes": {"shared", "slave"},
+		"/mnt/my disk":                      {"unbindable"},
+	}, parseMountPropagations(lines))
+}
+
+func TestMountPropagations(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "1"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1", "mountinfo"), []byte("22 1 8:1 / / rw shared:1 - ext4 /dev/sda1 rw\n"), 0o600))
+
+	ctx := context.WithValue(t.Context(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: dir})
+	propagations, err := mountPropagations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"/": {"shared"}}, propagations)
+
+	ctx = context.WithValue(t.Context(), common.EnvKey, common.EnvMap{common.HostProcMountinfo: filepath.Join(dir, "missing", "mountinfo")})
+	_, err = mountPropagations(ctx)
+	assert.ErrorContains(t, err, "failed to read the mount propagations")
+}
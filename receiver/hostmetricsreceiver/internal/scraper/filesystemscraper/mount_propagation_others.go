@@ -0,0 +1,13 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package filesystemscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+
+import "context"
+
+// mountPropagations returns no propagation types, since they are only known on Linux.
+func mountPropagations(context.Context) (map[string][]string, error) {
+	return nil, nil
+}
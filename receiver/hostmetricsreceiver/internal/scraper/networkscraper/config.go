@@ -4,10 +4,33 @@
 package networkscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
 
 import (
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper/internal/metadata"
 )
 
+const (
+	interfaceTypePhysical = "physical"
+	interfaceTypeLoopback = "loopback"
+	interfaceTypeBridge   = "bridge"
+	interfaceTypeBond     = "bond"
+	interfaceTypeVeth     = "veth"
+	interfaceTypeVirtual  = "virtual"
+)
+
+var interfaceTypes = []string{
+	interfaceTypePhysical,
+	interfaceTypeLoopback,
+	interfaceTypeBridge,
+	interfaceTypeBond,
+	interfaceTypeVeth,
+	interfaceTypeVirtual,
+}
+
 // Config relating to Network Metric Scraper.
 type Config struct {
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
@@ -15,10 +38,33 @@ type Config struct {
 	Include MatchConfig `mapstructure:"include"`
 	// Exclude specifies a filter on the network interfaces that should be excluded from the generated metrics.
 	Exclude MatchConfig `mapstructure:"exclude"`
+	// ClassifyInterfaces adds the type of the network interface (physical, loopback, bridge, bond, veth or virtual)
+	// as an attribute of the interface metrics. It is only supported on Linux.
+	ClassifyInterfaces bool `mapstructure:"classify_interfaces"`
 }
 
 type MatchConfig struct {
 	filterset.Config `mapstructure:",squash"`
 
 	Interfaces []string `mapstructure:"interfaces"`
+	// InterfaceTypes specifies the types of the network interfaces to match, independently of the match type.
+	// It is only supported on Linux.
+	InterfaceTypes []string `mapstructure:"interface_types"`
+}
+
+var errInterfaceTypesUnsupported = errors.New("interface_types and classify_interfaces are only supported on Linux")
+
+func (cfg *Config) Validate() error {
+	if runtime.GOOS != "linux" && (cfg.ClassifyInterfaces || len(cfg.Include.InterfaceTypes) > 0 || len(cfg.Exclude.InterfaceTypes) > 0) {
+		// the interfaces can't be classified, all of them would be filtered out
+		return errInterfaceTypesUnsupported
+	}
+	for _, match := range []MatchConfig{cfg.Include, cfg.Exclude} {
+		for _, interfaceType := range match.InterfaceTypes {
+			if !slices.Contains(interfaceTypes, interfaceType) {
+				return fmt.Errorf("invalid interface type %q", interfaceType)
+			}
+		}
+	}
+	return nil
 }
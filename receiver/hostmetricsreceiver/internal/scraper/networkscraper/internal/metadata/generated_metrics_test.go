@@ -70,19 +70,19 @@ func TestMetricsBuilder(t *testing.T) {
 
 			defaultMetricsCount++
 			allMetricsCount++
-			mb.RecordSystemNetworkDroppedDataPoint(ts, 1, "device-val", AttributeDirectionReceive)
+			mb.RecordSystemNetworkDroppedDataPoint(ts, 1, "device-val", AttributeDirectionReceive, WithInterfaceTypeMetricAttribute("interface_type-val"))
 
 			defaultMetricsCount++
 			allMetricsCount++
-			mb.RecordSystemNetworkErrorsDataPoint(ts, 1, "device-val", AttributeDirectionReceive)
+			mb.RecordSystemNetworkErrorsDataPoint(ts, 1, "device-val", AttributeDirectionReceive, WithInterfaceTypeMetricAttribute("interface_type-val"))
 
 			defaultMetricsCount++
 			allMetricsCount++
-			mb.RecordSystemNetworkIoDataPoint(ts, 1, "device-val", AttributeDirectionReceive)
+			mb.RecordSystemNetworkIoDataPoint(ts, 1, "device-val", AttributeDirectionReceive, WithInterfaceTypeMetricAttribute("interface_type-val"))
 
 			defaultMetricsCount++
 			allMetricsCount++
-			mb.RecordSystemNetworkPacketsDataPoint(ts, 1, "device-val", AttributeDirectionReceive)
+			mb.RecordSystemNetworkPacketsDataPoint(ts, 1, "device-val", AttributeDirectionReceive, WithInterfaceTypeMetricAttribute("interface_type-val"))
 
 			res := pcommon.NewResource()
 			metrics := mb.Emit(WithResource(res))
@@ -174,6 +174,9 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("direction")
 					assert.True(t, ok)
 					assert.Equal(t, "receive", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("type")
+					assert.True(t, ok)
+					assert.Equal(t, "interface_type-val", attrVal.Str())
 				case "system.network.errors":
 					assert.False(t, validatedMetrics["system.network.errors"], "Found a duplicate in the metrics slice: system.network.errors")
 					validatedMetrics["system.network.errors"] = true
@@ -194,6 +197,9 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("direction")
 					assert.True(t, ok)
 					assert.Equal(t, "receive", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("type")
+					assert.True(t, ok)
+					assert.Equal(t, "interface_type-val", attrVal.Str())
 				case "system.network.io":
 					assert.False(t, validatedMetrics["system.network.io"], "Found a duplicate in the metrics slice: system.network.io")
 					validatedMetrics["system.network.io"] = true
@@ -214,6 +220,9 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("direction")
 					assert.True(t, ok)
 					assert.Equal(t, "receive", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("type")
+					assert.True(t, ok)
+					assert.Equal(t, "interface_type-val", attrVal.Str())
 				case "system.network.packets":
 					assert.False(t, validatedMetrics["system.network.packets"], "Found a duplicate in the metrics slice: system.network.packets")
 					validatedMetrics["system.network.packets"] = true
@@ -234,6 +243,9 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("direction")
 					assert.True(t, ok)
 					assert.Equal(t, "receive", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("type")
+					assert.True(t, ok)
+					assert.Equal(t, "interface_type-val", attrVal.Str())
 				}
 			}
 		})
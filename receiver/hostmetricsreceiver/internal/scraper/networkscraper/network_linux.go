@@ -8,8 +8,12 @@ package networkscraper // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/shirou/gopsutil/v4/common"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
@@ -45,3 +49,60 @@ func (s *networkScraper) recordNetworkConntrackMetrics(ctx context.Context) erro
 	s.mb.RecordSystemNetworkConntrackMaxDataPoint(now, conntrack[0].ConnTrackMax)
 	return nil
 }
+
+// classifyInterface returns the type of a network interface from its sysfs attributes.
+func classifyInterface(ctx context.Context, name string) string {
+	dir := filepath.Join(hostSys(ctx), "class", "net", name)
+	if readSysfsValue(dir, "type") == "772" { // ARPHRD_LOOPBACK
+		return interfaceTypeLoopback
+	}
+	devType := ueventDevType(dir)
+	switch devType {
+	case "bridge":
+		return interfaceTypeBridge
+	case "bond":
+		return interfaceTypeBond
+	}
+	// only the interfaces backed by a device, such as a PCI or USB network card, have a device link
+	if _, err := os.Stat(filepath.Join(dir, "device")); err == nil {
+		return interfaceTypePhysical
+	}
+	// veth interfaces have no device type and are linked to their peer, possibly in another network namespace
+	if devType == "" {
+		if ifindex, iflink := readSysfsValue(dir, "ifindex"), readSysfsValue(dir, "iflink"); iflink != "" && iflink != "0" && iflink != ifindex {
+			return interfaceTypeVeth
+		}
+	}
+	return interfaceTypeVirtual
+}
+
+func hostSys(ctx context.Context) string {
+	if env, ok := ctx.Value(common.EnvKey).(common.EnvMap); ok && env[common.HostSysEnvKey] != "" {
+		return env[common.HostSysEnvKey]
+	}
+	if sys := os.Getenv(string(common.HostSysEnvKey)); sys != "" {
+		return sys
+	}
+	return "/sys"
+}
+
+func readSysfsValue(dir, name string) string {
+	value, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(value))
+}
+
+func ueventDevType(dir string) string {
+	uevent, err := os.ReadFile(filepath.Join(dir, "uevent"))
+	if err != nil {
+		return ""
+	}
+	for line := range strings.SplitSeq(string(uevent), "\n") {
+		if devType, ok := strings.CutPrefix(line, "DEVTYPE="); ok {
+			return devType
+		}
+	}
+	return ""
+}
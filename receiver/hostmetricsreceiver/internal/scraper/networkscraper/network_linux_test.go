@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package networkscraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyInterface(t *testing.T) {
+	sys := t.TempDir()
+	createInterface := func(name string, files map[string]string, dirs ...string) {
+		dir := filepath.Join(sys, "class", "net", name)
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		for file, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(content+"\n"), 0o600))
+		}
+		for _, d := range dirs {
+			require.NoError(t, os.Mkdir(filepath.Join(dir, d), 0o755))
+		}
+	}
+	createInterface("lo", map[string]string{"type": "772", "ifindex": "1", "iflink": "1", "uevent": "INTERFACE=lo\nIFINDEX=1"})
+	createInterface("eth0", map[string]string{"type": "1", "ifindex": "2", "iflink": "2", "uevent": "INTERFACE=eth0\nIFINDEX=2"}, "device")
+	createInterface("wlan0", map[string]string{"type": "1", "ifindex": "3", "iflink": "3", "uevent": "DEVTYPE=wlan\nINTERFACE=wlan0\nIFINDEX=3"}, "device")
+	createInterface("docker0", map[string]string{"type": "1", "ifindex": "4", "iflink": "4", "uevent": "DEVTYPE=bridge\nINTERFACE=docker0\nIFINDEX=4"}, "bridge")
+	createInterface("bond0", map[string]string{"type": "1", "ifindex": "5", "iflink": "5", "uevent": "DEVTYPE=bond\nINTERFACE=bond0\nIFINDEX=5"}, "bonding")
+	createInterface("veth1a2b3c", map[string]string{"type": "1", "ifindex": "6", "iflink": "7", "uevent": "INTERFACE=veth1a2b3c\nIFINDEX=6"})
+	createInterface("eth0.100", map[string]string{"type": "1", "ifindex": "8", "iflink": "2", "uevent": "DEVTYPE=vlan\nINTERFACE=eth0.100\nIFINDEX=8"})
+	createInterface("dummy0", map[string]string{"type": "1", "ifindex": "9", "iflink": "9", "uevent": "INTERFACE=dummy0\nIFINDEX=9"})
+
+	ctx := context.WithValue(t.Context(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sys})
+	for name, expected := range map[string]string{
+		"lo":         interfaceTypeLoopback,
+		"eth0":       interfaceTypePhysical,
+		"wlan0":      interfaceTypePhysical,
+		"docker0":    interfaceTypeBridge,
+		"bond0":      interfaceTypeBond,
+		"veth1a2b3c": interfaceTypeVeth,
+		"eth0.100":   interfaceTypeVirtual,
+		"dummy0":     interfaceTypeVirtual,
+		"missing0":   interfaceTypeVirtual,
+	} {
+		assert.Equal(t, expected, classifyInterface(ctx, name), name)
+	}
+}
@@ -6,6 +6,7 @@ package networkscraper // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/shirou/gopsutil/v4/host"
@@ -35,21 +36,23 @@ type networkScraper struct {
 	excludeFS filterset.FilterSet
 
 	// for mocking
-	bootTime    func(context.Context) (uint64, error)
-	ioCounters  func(context.Context, bool) ([]net.IOCountersStat, error)
-	connections func(context.Context, string) ([]net.ConnectionStat, error)
-	conntrack   func(context.Context) ([]net.FilterStat, error)
+	bootTime      func(context.Context) (uint64, error)
+	ioCounters    func(context.Context, bool) ([]net.IOCountersStat, error)
+	connections   func(context.Context, string) ([]net.ConnectionStat, error)
+	conntrack     func(context.Context) ([]net.FilterStat, error)
+	interfaceType func(context.Context, string) string
 }
 
 // newNetworkScraper creates a set of Network related metrics
 func newNetworkScraper(_ context.Context, settings scraper.Settings, cfg *Config) (*networkScraper, error) {
 	scraper := &networkScraper{
-		settings:    settings,
-		config:      cfg,
-		bootTime:    host.BootTimeWithContext,
-		ioCounters:  net.IOCountersWithContext,
-		connections: net.ConnectionsWithContext,
-		conntrack:   net.FilterCountersWithContext,
+		settings:      settings,
+		config:        cfg,
+		bootTime:      host.BootTimeWithContext,
+		ioCounters:    net.IOCountersWithContext,
+		connections:   net.ConnectionsWithContext,
+		conntrack:     net.FilterCountersWithContext,
+		interfaceType: classifyInterface,
 	}
 
 	var err error
@@ -112,44 +115,50 @@ func (s *networkScraper) recordNetworkCounterMetrics(ctx context.Context) error
 		return fmt.Errorf("failed to read network IO stats: %w", err)
 	}
 
-	// filter network interfaces by name
-	ioCounters = s.filterByInterface(ioCounters)
+	interfaceTypes := s.classifyInterfaces(ctx, ioCounters)
+
+	// filter network interfaces by name and type
+	ioCounters = s.filterByInterface(ioCounters, interfaceTypes)
 
 	if len(ioCounters) > 0 {
-		s.recordNetworkPacketsMetric(now, ioCounters)
-		s.recordNetworkDroppedPacketsMetric(now, ioCounters)
-		s.recordNetworkErrorPacketsMetric(now, ioCounters)
-		s.recordNetworkIOMetric(now, ioCounters)
+		s.recordNetworkPacketsMetric(now, ioCounters, interfaceTypes)
+		s.recordNetworkDroppedPacketsMetric(now, ioCounters, interfaceTypes)
+		s.recordNetworkErrorPacketsMetric(now, ioCounters, interfaceTypes)
+		s.recordNetworkIOMetric(now, ioCounters, interfaceTypes)
 	}
 
 	return nil
 }
 
-func (s *networkScraper) recordNetworkPacketsMetric(now pcommon.Timestamp, ioCountersSlice []net.IOCountersStat) {
+func (s *networkScraper) recordNetworkPacketsMetric(now pcommon.Timestamp, ioCountersSlice []net.IOCountersStat, interfaceTypes map[string]string) {
 	for _, ioCounters := range ioCountersSlice {
-		s.mb.RecordSystemNetworkPacketsDataPoint(now, int64(ioCounters.PacketsSent), ioCounters.Name, metadata.AttributeDirectionTransmit)
-		s.mb.RecordSystemNetworkPacketsDataPoint(now, int64(ioCounters.PacketsRecv), ioCounters.Name, metadata.AttributeDirectionReceive)
+		options := s.interfaceTypeOptions(interfaceTypes, ioCounters.Name)
+		s.mb.RecordSystemNetworkPacketsDataPoint(now, int64(ioCounters.PacketsSent), ioCounters.Name, metadata.AttributeDirectionTransmit, options...)
+		s.mb.RecordSystemNetworkPacketsDataPoint(now, int64(ioCounters.PacketsRecv), ioCounters.Name, metadata.AttributeDirectionReceive, options...)
 	}
 }
 
-func (s *networkScraper) recordNetworkDroppedPacketsMetric(now pcommon.Timestamp, ioCountersSlice []net.IOCountersStat) {
+func (s *networkScraper) recordNetworkDroppedPacketsMetric(now pcommon.Timestamp, ioCountersSlice []net.IOCountersStat, interfaceTypes map[string]string) {
 	for _, ioCounters := range ioCountersSlice {
-		s.mb.RecordSystemNetworkDroppedDataPoint(now, int64(ioCounters.Dropout), ioCounters.Name, metadata.AttributeDirectionTransmit)
-		s.mb.RecordSystemNetworkDroppedDataPoint(now, int64(ioCounters.Dropin), ioCounters.Name, metadata.AttributeDirectionReceive)
+		options := s.interfaceTypeOptions(interfaceTypes, ioCounters.Name)
+		s.mb.RecordSystemNetworkDroppedDataPoint(now, int64(ioCounters.Dropout), ioCounters.Name, metadata.AttributeDirectionTransmit, options...)
+		s.mb.RecordSystemNetworkDroppedDataPoint(now, int64(ioCounters.Dropin), ioCounters.Name, metadata.AttributeDirectionReceive, options...)
 	}
 }
 
-func (s *networkScraper) recordNetworkErrorPacketsMetric(now pcommon.Timestamp, ioCountersSlice []net.IOCountersStat) {
+func (s *networkScraper) recordNetworkErrorPacketsMetric(now pcommon.Timestamp, ioCountersSlice []net.IOCountersStat, interfaceTypes map[string]string) {
 	for _, ioCounters := range ioCountersSlice {
-		s.mb.RecordSystemNetworkErrorsDataPoint(now, int64(ioCounters.Errout), ioCounters.Name, metadata.AttributeDirectionTransmit)
-		s.mb.RecordSystemNetworkErrorsDataPoint(now, int64(ioCounters.Errin), ioCounters.Name, metadata.AttributeDirectionReceive)
+		options := s.interfaceTypeOptions(interfaceTypes, ioCounters.Name)
+		s.mb.RecordSystemNetworkErrorsDataPoint(now, int64(ioCounters.Errout), ioCounters.Name, metadata.AttributeDirectionTransmit, options...)
+		s.mb.RecordSystemNetworkErrorsDataPoint(now, int64(ioCounters.Errin), ioCounters.Name, metadata.AttributeDirectionReceive, options...)
 	}
 }
 
-func (s *networkScraper) recordNetworkIOMetric(now pcommon.Timestamp, ioCountersSlice []net.IOCountersStat) {
+func (s *networkScraper) recordNetworkIOMetric(now pcommon.Timestamp, ioCountersSlice []net.IOCountersStat, interfaceTypes map[string]string) {
 	for _, ioCounters := range ioCountersSlice {
-		s.mb.RecordSystemNetworkIoDataPoint(now, int64(ioCounters.BytesSent), ioCounters.Name, metadata.AttributeDirectionTransmit)
-		s.mb.RecordSystemNetworkIoDataPoint(now, int64(ioCounters.BytesRecv), ioCounters.Name, metadata.AttributeDirectionReceive)
+		options := s.interfaceTypeOptions(interfaceTypes, ioCounters.Name)
+		s.mb.RecordSystemNetworkIoDataPoint(now, int64(ioCounters.BytesSent), ioCounters.Name, metadata.AttributeDirectionTransmit, options...)
+		s.mb.RecordSystemNetworkIoDataPoint(now, int64(ioCounters.BytesRecv), ioCounters.Name, metadata.AttributeDirectionReceive, options...)
 	}
 }
 
@@ -189,21 +198,43 @@ func (s *networkScraper) recordNetworkConnectionsMetric(now pcommon.Timestamp, c
 	}
 }
 
-func (s *networkScraper) filterByInterface(ioCounters []net.IOCountersStat) []net.IOCountersStat {
-	if s.includeFS == nil && s.excludeFS == nil {
+// classifyInterfaces returns the types of the network interfaces, when they are recorded or filtered on.
+func (s *networkScraper) classifyInterfaces(ctx context.Context, ioCounters []net.IOCountersStat) map[string]string {
+	if !s.config.ClassifyInterfaces && len(s.config.Include.InterfaceTypes) == 0 && len(s.config.Exclude.InterfaceTypes) == 0 {
+		return nil
+	}
+
+	interfaceTypes := make(map[string]string, len(ioCounters))
+	for _, io := range ioCounters {
+		interfaceTypes[io.Name] = s.interfaceType(ctx, io.Name)
+	}
+	return interfaceTypes
+}
+
+func (s *networkScraper) interfaceTypeOptions(interfaceTypes map[string]string, interfaceName string) []metadata.MetricAttributeOption {
+	if !s.config.ClassifyInterfaces || interfaceTypes[interfaceName] == "" {
+		return nil
+	}
+	return []metadata.MetricAttributeOption{metadata.WithInterfaceTypeMetricAttribute(interfaceTypes[interfaceName])}
+}
+
+func (s *networkScraper) filterByInterface(ioCounters []net.IOCountersStat, interfaceTypes map[string]string) []net.IOCountersStat {
+	if s.includeFS == nil && s.excludeFS == nil && len(s.config.Include.InterfaceTypes) == 0 && len(s.config.Exclude.InterfaceTypes) == 0 {
 		return ioCounters
 	}
 
 	filteredIOCounters := make([]net.IOCountersStat, 0, len(ioCounters))
 	for _, io := range ioCounters {
-		if s.includeInterface(io.Name) {
+		if s.includeInterface(io.Name, interfaceTypes[io.Name]) {
 			filteredIOCounters = append(filteredIOCounters, io)
 		}
 	}
 	return filteredIOCounters
 }
 
-func (s *networkScraper) includeInterface(interfaceName string) bool {
+func (s *networkScraper) includeInterface(interfaceName, interfaceType string) bool {
 	return (s.includeFS == nil || s.includeFS.Matches(interfaceName)) &&
-		(s.excludeFS == nil || !s.excludeFS.Matches(interfaceName))
+		(len(s.config.Include.InterfaceTypes) == 0 || slices.Contains(s.config.Include.InterfaceTypes, interfaceType)) &&
+		(s.excludeFS == nil || !s.excludeFS.Matches(interfaceName)) &&
+		!slices.Contains(s.config.Exclude.InterfaceTypes, interfaceType)
 }
@@ -6,6 +6,7 @@ package networkscraper
 import (
 	"context"
 	"errors"
+	"runtime"
 	"testing"
 
 	"github.com/shirou/gopsutil/v4/net"
@@ -71,7 +72,7 @@ func TestScrape(t *testing.T) {
 			name: "Include Filter that matches nothing",
 			config: &Config{
 				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
-				Include:              MatchConfig{filterset.Config{MatchType: "strict"}, []string{"@*^#&*$^#)"}},
+				Include:              MatchConfig{Config: filterset.Config{MatchType: "strict"}, Interfaces: []string{"@*^#&*$^#)"}},
 			},
 			expectConntrakMetrics:   false,
 			expectConnectionsMetric: true,
@@ -235,3 +236,101 @@ func assertNetworkConnectionsMetricValid(t *testing.T, metric pmetric.Metric) {
 	assert.LessOrEqual(t, 12, metric.Sum().DataPoints().Len())
 	assert.GreaterOrEqual(t, 13, metric.Sum().DataPoints().Len())
 }
+
+func TestScrapeInterfaceTypes(t *testing.T) {
+	ioCounters := func(context.Context, bool) ([]net.IOCountersStat, error) {
+		return []net.IOCountersStat{{Name: "eth0"}, {Name: "docker0"}, {Name: "veth1a2b3c"}, {Name: "lo"}}, nil
+	}
+	interfaceType := func(_ context.Context, name string) string {
+		return map[string]string{
+			"eth0":       interfaceTypePhysical,
+			"docker0":    interfaceTypeBridge,
+			"veth1a2b3c": interfaceTypeVeth,
+			"lo":         interfaceTypeLoopback,
+		}[name]
+	}
+
+	testCases := []struct {
+		name            string
+		config          *Config
+		expectedDevices map[string]string
+	}{
+		{
+			name:            "not classified",
+			config:          &Config{},
+			expectedDevices: map[string]string{"eth0": "", "docker0": "", "veth1a2b3c": "", "lo": ""},
+		},
+		{
+			name:   "classified",
+			config: &Config{ClassifyInterfaces: true},
+			expectedDevices: map[string]string{
+				"eth0":       interfaceTypePhysical,
+				"docker0":    interfaceTypeBridge,
+				"veth1a2b3c": interfaceTypeVeth,
+				"lo":         interfaceTypeLoopback,
+			},
+		},
+		{
+			name:            "exclude types",
+			config:          &Config{Exclude: MatchConfig{InterfaceTypes: []string{interfaceTypeVeth, interfaceTypeLoopback}}},
+			expectedDevices: map[string]string{"eth0": "", "docker0": ""},
+		},
+		{
+			name: "include types and names",
+			config: &Config{
+				ClassifyInterfaces: true,
+				Include: MatchConfig{
+					Config:         filterset.Config{MatchType: filterset.Regexp},
+					Interfaces:     []string{"^(eth|veth).*"},
+					InterfaceTypes: []string{interfaceTypePhysical, interfaceTypeBridge},
+				},
+			},
+			expectedDevices: map[string]string{"eth0": interfaceTypePhysical},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.config.MetricsBuilderConfig = metadata.DefaultMetricsBuilderConfig()
+			test.config.Metrics.SystemNetworkConnections.Enabled = false
+			scraper, err := newNetworkScraper(t.Context(), scrapertest.NewNopSettings(metadata.Type), test.config)
+			require.NoError(t, err)
+			scraper.ioCounters = ioCounters
+			scraper.interfaceType = interfaceType
+			require.NoError(t, scraper.start(t.Context(), componenttest.NewNopHost()))
+
+			md, err := scraper.scrape(t.Context())
+			require.NoError(t, err)
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			require.Equal(t, 4, metrics.Len())
+			for i := 0; i < metrics.Len(); i++ {
+				devices := map[string]string{}
+				dps := metrics.At(i).Sum().DataPoints()
+				for j := 0; j < dps.Len(); j++ {
+					device, _ := dps.At(j).Attributes().Get("device")
+					interfaceType, ok := dps.At(j).Attributes().Get("type")
+					if ok {
+						devices[device.Str()] = interfaceType.Str()
+					} else {
+						devices[device.Str()] = ""
+					}
+				}
+				assert.Equal(t, test.expectedDevices, devices, metrics.At(i).Name())
+			}
+		})
+	}
+}
+
+func TestConfigValidateInterfaceTypes(t *testing.T) {
+	cfg := &Config{Include: MatchConfig{InterfaceTypes: []string{interfaceTypeVeth}}}
+	if runtime.GOOS != "linux" {
+		require.ErrorIs(t, cfg.Validate(), errInterfaceTypesUnsupported)
+		require.ErrorIs(t, (&Config{ClassifyInterfaces: true}).Validate(), errInterfaceTypesUnsupported)
+		return
+	}
+	require.NoError(t, cfg.Validate())
+	require.NoError(t, (&Config{ClassifyInterfaces: true}).Validate())
+
+	cfg.Exclude.InterfaceTypes = []string{"tunnel"}
+	require.EqualError(t, cfg.Validate(), `invalid interface type "tunnel"`)
+}
@@ -7,10 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 	"go.uber.org/multierr"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
 )
@@ -25,7 +28,8 @@ var (
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
-	Targets                        []*targetConfig `mapstructure:"targets"`
+	Targets                        []*targetConfig   `mapstructure:"targets"`
+	Scenarios                      []*scenarioConfig `mapstructure:"scenarios"`
 
 	// prevent unkeyed literal initialization
 	_ struct{}
@@ -85,12 +89,87 @@ func (cfg *targetConfig) Validate() error {
 	return err
 }
 
-// Validate validates the top-level Config by checking each targetConfig.
+// scenarioConfig defines a sequence of HTTP requests checked together, e.g. a login followed by an API call.
+type scenarioConfig struct {
+	// ClientConfig configures the client shared by the steps. Its endpoint, if set, is the base URL
+	// of the relative endpoints of the steps.
+	confighttp.ClientConfig `mapstructure:",squash"`
+	Name                    string       `mapstructure:"name"`
+	Steps                   []stepConfig `mapstructure:"steps"`
+}
+
+// stepConfig defines an HTTP request of a scenario. The `{{name}}` placeholders of its endpoint, headers
+// and body are replaced by the variables extracted from the responses of the previous steps.
+type stepConfig struct {
+	Name     string            `mapstructure:"name"`
+	Method   string            `mapstructure:"method"`
+	Endpoint string            `mapstructure:"endpoint"`
+	Headers  map[string]string `mapstructure:"headers"`
+	Body     string            `mapstructure:"body"`
+	// Extract maps the name of variables to the OTTL value expressions extracting them from the response.
+	Extract map[string]string `mapstructure:"extract"`
+	// Assertions are OTTL conditions which must be true for the step to succeed.
+	Assertions []string `mapstructure:"assertions"`
+}
+
+var variableNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Validate validates a scenarioConfig.
+func (cfg *scenarioConfig) Validate() error {
+	var err error
+
+	if cfg.Name == "" {
+		err = multierr.Append(err, errors.New("scenario name must be specified"))
+	}
+	if len(cfg.Steps) == 0 {
+		err = multierr.Append(err, fmt.Errorf("scenario %q must have at least one step", cfg.Name))
+	}
+
+	var base *url.URL
+	if cfg.Endpoint != "" {
+		var parseErr error
+		if base, parseErr = url.ParseRequestURI(cfg.Endpoint); parseErr != nil {
+			err = multierr.Append(err, fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr))
+		}
+	}
+
+	parser, parserErr := newStepParser(component.TelemetrySettings{Logger: zap.NewNop()})
+	if parserErr != nil {
+		return multierr.Append(err, parserErr)
+	}
+	steps := make(map[string]struct{}, len(cfg.Steps))
+	for _, step := range cfg.Steps {
+		if step.Name == "" {
+			err = multierr.Append(err, fmt.Errorf("scenario %q: step name must be specified", cfg.Name))
+		} else if _, ok := steps[step.Name]; ok {
+			err = multierr.Append(err, fmt.Errorf("scenario %q: duplicate step %q", cfg.Name, step.Name))
+		}
+		steps[step.Name] = struct{}{}
+
+		if step.Endpoint == "" {
+			err = multierr.Append(err, fmt.Errorf("scenario %q: step %q: %w", cfg.Name, step.Name, errMissingEndpoint))
+		} else if u, parseErr := url.Parse(step.Endpoint); parseErr != nil || (!u.IsAbs() && base == nil) {
+			err = multierr.Append(err, fmt.Errorf("scenario %q: step %q: %w", cfg.Name, step.Name, errInvalidEndpoint))
+		}
+		for name := range step.Extract {
+			if !variableNameRegexp.MatchString(name) {
+				err = multierr.Append(err, fmt.Errorf("scenario %q: step %q: invalid variable name %q", cfg.Name, step.Name, name))
+			}
+		}
+		if _, parseErr := parseStep(parser, step); parseErr != nil {
+			err = multierr.Append(err, fmt.Errorf("scenario %q: step %q: %w", cfg.Name, step.Name, parseErr))
+		}
+	}
+
+	return err
+}
+
+// Validate validates the top-level Config by checking each targetConfig and scenarioConfig.
 func (cfg *Config) Validate() error {
 	var err error
 
-	// Ensure at least one target is configured.
-	if len(cfg.Targets) == 0 {
+	// Ensure at least one target or scenario is configured.
+	if len(cfg.Targets) == 0 && len(cfg.Scenarios) == 0 {
 		err = multierr.Append(err, errors.New("no targets configured"))
 	}
 
@@ -99,5 +178,14 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, target.Validate())
 	}
 
+	scenarios := make(map[string]struct{}, len(cfg.Scenarios))
+	for _, scenario := range cfg.Scenarios {
+		if _, ok := scenarios[scenario.Name]; ok {
+			err = multierr.Append(err, fmt.Errorf("duplicate scenario %q", scenario.Name))
+		}
+		scenarios[scenario.Name] = struct{}{}
+		err = multierr.Append(err, scenario.Validate())
+	}
+
 	return err
 }
@@ -258,3 +258,105 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateScenarios(t *testing.T) {
+	validStep := stepConfig{
+		Name:       "health",
+		Endpoint:   "/health",
+		Assertions: []string{`attributes["status_code"] == 200`},
+	}
+	testCases := []struct {
+		desc        string
+		scenarios   []*scenarioConfig
+		expectedErr string
+	}{
+		{
+			desc: "valid scenario",
+			scenarios: []*scenarioConfig{
+				{
+					ClientConfig: confighttp.ClientConfig{Endpoint: "https://localhost:8080"},
+					Name:         "login",
+					Steps: []stepConfig{
+						{
+							Name:     "login",
+							Method:   "POST",
+							Endpoint: "/login",
+							Body:     `{"user": "otel"}`,
+							Extract:  map[string]string{"token": `body["token"]`},
+						},
+						{
+							Name:       "profile",
+							Endpoint:   "/profile",
+							Headers:    map[string]string{"Authorization": "Bearer {{token}}"},
+							Assertions: []string{`attributes["status_code"] == 200`, `body["name"] == "otel"`},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc:        "missing name and steps",
+			scenarios:   []*scenarioConfig{{}},
+			expectedErr: `scenario name must be specified; scenario "" must have at least one step`,
+		},
+		{
+			desc: "duplicate scenario",
+			scenarios: []*scenarioConfig{
+				{ClientConfig: confighttp.ClientConfig{Endpoint: "https://localhost:8080"}, Name: "login", Steps: []stepConfig{validStep}},
+				{ClientConfig: confighttp.ClientConfig{Endpoint: "https://localhost:8080"}, Name: "login", Steps: []stepConfig{validStep}},
+			},
+			expectedErr: `duplicate scenario "login"`,
+		},
+		{
+			desc: "duplicate step",
+			scenarios: []*scenarioConfig{
+				{ClientConfig: confighttp.ClientConfig{Endpoint: "https://localhost:8080"}, Name: "login", Steps: []stepConfig{validStep, validStep}},
+			},
+			expectedErr: `scenario "login": duplicate step "health"`,
+		},
+		{
+			desc: "relative endpoint without scenario endpoint",
+			scenarios: []*scenarioConfig{
+				{Name: "login", Steps: []stepConfig{validStep}},
+			},
+			expectedErr: `scenario "login": step "health": ` + errInvalidEndpoint.Error(),
+		},
+		{
+			desc: "invalid variable name",
+			scenarios: []*scenarioConfig{
+				{
+					ClientConfig: confighttp.ClientConfig{Endpoint: "https://localhost:8080"},
+					Name:         "login",
+					Steps:        []stepConfig{{Name: "login", Endpoint: "/login", Extract: map[string]string{"my-token": `body["token"]`}}},
+				},
+			},
+			expectedErr: `scenario "login": step "login": invalid variable name "my-token"`,
+		},
+		{
+			desc: "invalid assertion",
+			scenarios: []*scenarioConfig{
+				{
+					ClientConfig: confighttp.ClientConfig{Endpoint: "https://localhost:8080"},
+					Name:         "login",
+					Steps:        []stepConfig{{Name: "login", Endpoint: "/login", Assertions: []string{`attributes["status_code"] ==`}}},
+				},
+			},
+			expectedErr: `scenario "login": step "login": invalid assertion`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := &Config{
+				Scenarios:        tc.scenarios,
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			}
+			err := cfg.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
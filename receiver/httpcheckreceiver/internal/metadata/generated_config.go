@@ -36,6 +36,9 @@ type MetricsConfig struct {
 	HttpcheckResponseDuration         MetricConfig `mapstructure:"httpcheck.response.duration"`
 	HttpcheckResponseSize             MetricConfig `mapstructure:"httpcheck.response.size"`
 	HttpcheckStatus                   MetricConfig `mapstructure:"httpcheck.status"`
+	HttpcheckStepAssertionFailed      MetricConfig `mapstructure:"httpcheck.step.assertion.failed"`
+	HttpcheckStepDuration             MetricConfig `mapstructure:"httpcheck.step.duration"`
+	HttpcheckStepStatus               MetricConfig `mapstructure:"httpcheck.step.status"`
 	HttpcheckTLSCertRemaining         MetricConfig `mapstructure:"httpcheck.tls.cert_remaining"`
 	HttpcheckTLSHandshakeDuration     MetricConfig `mapstructure:"httpcheck.tls.handshake.duration"`
 	HttpcheckValidationFailed         MetricConfig `mapstructure:"httpcheck.validation.failed"`
@@ -68,6 +71,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		HttpcheckStatus: MetricConfig{
 			Enabled: true,
 		},
+		HttpcheckStepAssertionFailed: MetricConfig{
+			Enabled: true,
+		},
+		HttpcheckStepDuration: MetricConfig{
+			Enabled: true,
+		},
+		HttpcheckStepStatus: MetricConfig{
+			Enabled: true,
+		},
 		HttpcheckTLSCertRemaining: MetricConfig{
 			Enabled: false,
 		},
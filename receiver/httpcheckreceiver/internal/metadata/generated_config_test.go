@@ -34,6 +34,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					HttpcheckResponseDuration:         MetricConfig{Enabled: true},
 					HttpcheckResponseSize:             MetricConfig{Enabled: true},
 					HttpcheckStatus:                   MetricConfig{Enabled: true},
+					HttpcheckStepAssertionFailed:      MetricConfig{Enabled: true},
+					HttpcheckStepDuration:             MetricConfig{Enabled: true},
+					HttpcheckStepStatus:               MetricConfig{Enabled: true},
 					HttpcheckTLSCertRemaining:         MetricConfig{Enabled: true},
 					HttpcheckTLSHandshakeDuration:     MetricConfig{Enabled: true},
 					HttpcheckValidationFailed:         MetricConfig{Enabled: true},
@@ -53,6 +56,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					HttpcheckResponseDuration:         MetricConfig{Enabled: false},
 					HttpcheckResponseSize:             MetricConfig{Enabled: false},
 					HttpcheckStatus:                   MetricConfig{Enabled: false},
+					HttpcheckStepAssertionFailed:      MetricConfig{Enabled: false},
+					HttpcheckStepDuration:             MetricConfig{Enabled: false},
+					HttpcheckStepStatus:               MetricConfig{Enabled: false},
 					HttpcheckTLSCertRemaining:         MetricConfig{Enabled: false},
 					HttpcheckTLSHandshakeDuration:     MetricConfig{Enabled: false},
 					HttpcheckValidationFailed:         MetricConfig{Enabled: false},
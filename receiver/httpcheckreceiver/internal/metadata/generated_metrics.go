@@ -36,6 +36,15 @@ var MetricsInfo = metricsInfo{
 	HttpcheckStatus: metricInfo{
 		Name: "httpcheck.status",
 	},
+	HttpcheckStepAssertionFailed: metricInfo{
+		Name: "httpcheck.step.assertion.failed",
+	},
+	HttpcheckStepDuration: metricInfo{
+		Name: "httpcheck.step.duration",
+	},
+	HttpcheckStepStatus: metricInfo{
+		Name: "httpcheck.step.status",
+	},
 	HttpcheckTLSCertRemaining: metricInfo{
 		Name: "httpcheck.tls.cert_remaining",
 	},
@@ -59,6 +68,9 @@ type metricsInfo struct {
 	HttpcheckResponseDuration         metricInfo
 	HttpcheckResponseSize             metricInfo
 	HttpcheckStatus                   metricInfo
+	HttpcheckStepAssertionFailed      metricInfo
+	HttpcheckStepDuration             metricInfo
+	HttpcheckStepStatus               metricInfo
 	HttpcheckTLSCertRemaining         metricInfo
 	HttpcheckTLSHandshakeDuration     metricInfo
 	HttpcheckValidationFailed         metricInfo
@@ -494,6 +506,171 @@ func newMetricHttpcheckStatus(cfg MetricConfig) metricHttpcheckStatus {
 	return m
 }
 
+type metricHttpcheckStepAssertionFailed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.step.assertion.failed metric with initial data.
+func (m *metricHttpcheckStepAssertionFailed) init() {
+	m.data.SetName("httpcheck.step.assertion.failed")
+	m.data.SetDescription("Number of assertions of the scenario step that failed.")
+	m.data.SetUnit("{assertion}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckStepAssertionFailed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpcheckScenarioNameAttributeValue string, httpcheckStepNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("httpcheck.scenario.name", httpcheckScenarioNameAttributeValue)
+	dp.Attributes().PutStr("httpcheck.step.name", httpcheckStepNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckStepAssertionFailed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckStepAssertionFailed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckStepAssertionFailed(cfg MetricConfig) metricHttpcheckStepAssertionFailed {
+	m := metricHttpcheckStepAssertionFailed{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckStepDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.step.duration metric with initial data.
+func (m *metricHttpcheckStepDuration) init() {
+	m.data.SetName("httpcheck.step.duration")
+	m.data.SetDescription("Measures the duration of the request of the scenario step.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckStepDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpcheckScenarioNameAttributeValue string, httpcheckStepNameAttributeValue string, httpURLAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("httpcheck.scenario.name", httpcheckScenarioNameAttributeValue)
+	dp.Attributes().PutStr("httpcheck.step.name", httpcheckStepNameAttributeValue)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckStepDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckStepDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckStepDuration(cfg MetricConfig) metricHttpcheckStepDuration {
+	m := metricHttpcheckStepDuration{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckStepStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.step.status metric with initial data.
+func (m *metricHttpcheckStepStatus) init() {
+	m.data.SetName("httpcheck.step.status")
+	m.data.SetDescription("1 if the request of the scenario step succeeded and all its assertions passed, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckStepStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpcheckScenarioNameAttributeValue string, httpcheckStepNameAttributeValue string, httpStatusCodeAttributeValue int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("httpcheck.scenario.name", httpcheckScenarioNameAttributeValue)
+	dp.Attributes().PutStr("httpcheck.step.name", httpcheckStepNameAttributeValue)
+	dp.Attributes().PutInt("http.status_code", httpStatusCodeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckStepStatus) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckStepStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckStepStatus(cfg MetricConfig) metricHttpcheckStepStatus {
+	m := metricHttpcheckStepStatus{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHttpcheckTLSCertRemaining struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -727,6 +904,9 @@ type MetricsBuilder struct {
 	metricHttpcheckResponseDuration         metricHttpcheckResponseDuration
 	metricHttpcheckResponseSize             metricHttpcheckResponseSize
 	metricHttpcheckStatus                   metricHttpcheckStatus
+	metricHttpcheckStepAssertionFailed      metricHttpcheckStepAssertionFailed
+	metricHttpcheckStepDuration             metricHttpcheckStepDuration
+	metricHttpcheckStepStatus               metricHttpcheckStepStatus
 	metricHttpcheckTLSCertRemaining         metricHttpcheckTLSCertRemaining
 	metricHttpcheckTLSHandshakeDuration     metricHttpcheckTLSHandshakeDuration
 	metricHttpcheckValidationFailed         metricHttpcheckValidationFailed
@@ -764,6 +944,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricHttpcheckResponseDuration:         newMetricHttpcheckResponseDuration(mbc.Metrics.HttpcheckResponseDuration),
 		metricHttpcheckResponseSize:             newMetricHttpcheckResponseSize(mbc.Metrics.HttpcheckResponseSize),
 		metricHttpcheckStatus:                   newMetricHttpcheckStatus(mbc.Metrics.HttpcheckStatus),
+		metricHttpcheckStepAssertionFailed:      newMetricHttpcheckStepAssertionFailed(mbc.Metrics.HttpcheckStepAssertionFailed),
+		metricHttpcheckStepDuration:             newMetricHttpcheckStepDuration(mbc.Metrics.HttpcheckStepDuration),
+		metricHttpcheckStepStatus:               newMetricHttpcheckStepStatus(mbc.Metrics.HttpcheckStepStatus),
 		metricHttpcheckTLSCertRemaining:         newMetricHttpcheckTLSCertRemaining(mbc.Metrics.HttpcheckTLSCertRemaining),
 		metricHttpcheckTLSHandshakeDuration:     newMetricHttpcheckTLSHandshakeDuration(mbc.Metrics.HttpcheckTLSHandshakeDuration),
 		metricHttpcheckValidationFailed:         newMetricHttpcheckValidationFailed(mbc.Metrics.HttpcheckValidationFailed),
@@ -841,6 +1024,9 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricHttpcheckResponseDuration.emit(ils.Metrics())
 	mb.metricHttpcheckResponseSize.emit(ils.Metrics())
 	mb.metricHttpcheckStatus.emit(ils.Metrics())
+	mb.metricHttpcheckStepAssertionFailed.emit(ils.Metrics())
+	mb.metricHttpcheckStepDuration.emit(ils.Metrics())
+	mb.metricHttpcheckStepStatus.emit(ils.Metrics())
 	mb.metricHttpcheckTLSCertRemaining.emit(ils.Metrics())
 	mb.metricHttpcheckTLSHandshakeDuration.emit(ils.Metrics())
 	mb.metricHttpcheckValidationFailed.emit(ils.Metrics())
@@ -906,6 +1092,21 @@ func (mb *MetricsBuilder) RecordHttpcheckStatusDataPoint(ts pcommon.Timestamp, v
 	mb.metricHttpcheckStatus.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, httpStatusCodeAttributeValue, httpMethodAttributeValue, httpStatusClassAttributeValue)
 }
 
+// RecordHttpcheckStepAssertionFailedDataPoint adds a data point to httpcheck.step.assertion.failed metric.
+func (mb *MetricsBuilder) RecordHttpcheckStepAssertionFailedDataPoint(ts pcommon.Timestamp, val int64, httpcheckScenarioNameAttributeValue string, httpcheckStepNameAttributeValue string) {
+	mb.metricHttpcheckStepAssertionFailed.recordDataPoint(mb.startTime, ts, val, httpcheckScenarioNameAttributeValue, httpcheckStepNameAttributeValue)
+}
+
+// RecordHttpcheckStepDurationDataPoint adds a data point to httpcheck.step.duration metric.
+func (mb *MetricsBuilder) RecordHttpcheckStepDurationDataPoint(ts pcommon.Timestamp, val int64, httpcheckScenarioNameAttributeValue string, httpcheckStepNameAttributeValue string, httpURLAttributeValue string) {
+	mb.metricHttpcheckStepDuration.recordDataPoint(mb.startTime, ts, val, httpcheckScenarioNameAttributeValue, httpcheckStepNameAttributeValue, httpURLAttributeValue)
+}
+
+// RecordHttpcheckStepStatusDataPoint adds a data point to httpcheck.step.status metric.
+func (mb *MetricsBuilder) RecordHttpcheckStepStatusDataPoint(ts pcommon.Timestamp, val int64, httpcheckScenarioNameAttributeValue string, httpcheckStepNameAttributeValue string, httpStatusCodeAttributeValue int64) {
+	mb.metricHttpcheckStepStatus.recordDataPoint(mb.startTime, ts, val, httpcheckScenarioNameAttributeValue, httpcheckStepNameAttributeValue, httpStatusCodeAttributeValue)
+}
+
 // RecordHttpcheckTLSCertRemainingDataPoint adds a data point to httpcheck.tls.cert_remaining metric.
 func (mb *MetricsBuilder) RecordHttpcheckTLSCertRemainingDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string, httpTLSIssuerAttributeValue string, httpTLSCnAttributeValue string, httpTLSSanAttributeValue []any) {
 	mb.metricHttpcheckTLSCertRemaining.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, httpTLSIssuerAttributeValue, httpTLSCnAttributeValue, httpTLSSanAttributeValue)
@@ -85,6 +85,18 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordHttpcheckStatusDataPoint(ts, 1, "http.url-val", 16, "http.method-val", "http.status_class-val")
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckStepAssertionFailedDataPoint(ts, 1, "httpcheck.scenario.name-val", "httpcheck.step.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckStepDurationDataPoint(ts, 1, "httpcheck.scenario.name-val", "httpcheck.step.name-val", "http.url-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckStepStatusDataPoint(ts, 1, "httpcheck.scenario.name-val", "httpcheck.step.name-val", 16)
+
 			allMetricsCount++
 			mb.RecordHttpcheckTLSCertRemainingDataPoint(ts, 1, "http.url-val", "http.tls.issuer-val", "http.tls.cn-val", []any{"http.tls.san-item1", "http.tls.san-item2"})
 
@@ -258,6 +270,70 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("http.status_class")
 					assert.True(t, ok)
 					assert.Equal(t, "http.status_class-val", attrVal.Str())
+				case "httpcheck.step.assertion.failed":
+					assert.False(t, validatedMetrics["httpcheck.step.assertion.failed"], "Found a duplicate in the metrics slice: httpcheck.step.assertion.failed")
+					validatedMetrics["httpcheck.step.assertion.failed"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of assertions of the scenario step that failed.", ms.At(i).Description())
+					assert.Equal(t, "{assertion}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("httpcheck.scenario.name")
+					assert.True(t, ok)
+					assert.Equal(t, "httpcheck.scenario.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("httpcheck.step.name")
+					assert.True(t, ok)
+					assert.Equal(t, "httpcheck.step.name-val", attrVal.Str())
+				case "httpcheck.step.duration":
+					assert.False(t, validatedMetrics["httpcheck.step.duration"], "Found a duplicate in the metrics slice: httpcheck.step.duration")
+					validatedMetrics["httpcheck.step.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Measures the duration of the request of the scenario step.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("httpcheck.scenario.name")
+					assert.True(t, ok)
+					assert.Equal(t, "httpcheck.scenario.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("httpcheck.step.name")
+					assert.True(t, ok)
+					assert.Equal(t, "httpcheck.step.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("http.url")
+					assert.True(t, ok)
+					assert.Equal(t, "http.url-val", attrVal.Str())
+				case "httpcheck.step.status":
+					assert.False(t, validatedMetrics["httpcheck.step.status"], "Found a duplicate in the metrics slice: httpcheck.step.status")
+					validatedMetrics["httpcheck.step.status"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "1 if the request of the scenario step succeeded and all its assertions passed, otherwise 0.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("httpcheck.scenario.name")
+					assert.True(t, ok)
+					assert.Equal(t, "httpcheck.scenario.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("httpcheck.step.name")
+					assert.True(t, ok)
+					assert.Equal(t, "httpcheck.step.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("http.status_code")
+					assert.True(t, ok)
+					assert.EqualValues(t, 16, attrVal.Int())
 				case "httpcheck.tls.cert_remaining":
 					assert.False(t, validatedMetrics["httpcheck.tls.cert_remaining"], "Found a duplicate in the metrics slice: httpcheck.tls.cert_remaining")
 					validatedMetrics["httpcheck.tls.cert_remaining"] = true
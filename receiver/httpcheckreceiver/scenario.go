@@ -0,0 +1,323 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// The OTTL expressions of a step are evaluated on a log record representing its response: the body of the
+// record is the response body, decoded if it is a JSON object or array, and its attributes are the following.
+const (
+	// responseStatusCodeAttr is the status code of the response.
+	responseStatusCodeAttr = "status_code"
+	// responseDurationAttr is the duration of the request in milliseconds.
+	responseDurationAttr = "duration_ms"
+	// responseHeadersAttr maps the lowercase name of the response headers to their comma separated values.
+	responseHeadersAttr = "headers"
+)
+
+// maxResponseBodySize is the maximum size of the response body of a step.
+const maxResponseBodySize = 1 << 20
+
+// The errors of the steps are reported with one of these messages, so that the error.message attribute
+// has a bounded cardinality. The detailed error is logged.
+const (
+	stepErrorInvalidRequest = "invalid request"
+	stepErrorRequestFailed  = "request failed"
+	stepErrorTimeout        = "request timed out"
+	stepErrorBodyReadFailed = "failed to read response body"
+	stepErrorBodyTooLarge   = "response body too large"
+	stepErrorExtractFailed  = "failed to extract variable"
+)
+
+var errResponseBodyTooLarge = fmt.Errorf("response body larger than %d bytes", maxResponseBodySize)
+
+var placeholderRegexp = regexp.MustCompile(`{{\s*([A-Za-z_][A-Za-z0-9_]*)\s*}}`)
+
+// scenario is a scenarioConfig ready to be run.
+type scenario struct {
+	name   string
+	client *http.Client
+	base   *url.URL
+	steps  []*step
+}
+
+// step is a stepConfig with its OTTL expressions parsed.
+type step struct {
+	stepConfig
+	// url is the URL of the step before the variables are expanded, reported as the http.url attribute.
+	url        string
+	extract    map[string]*ottl.ValueExpression[*ottllog.TransformContext]
+	assertions []*ottl.Condition[*ottllog.TransformContext]
+}
+
+// stepResult is the outcome of the request of a step.
+type stepResult struct {
+	step             *step
+	url              string
+	statusCode       int
+	duration         time.Duration
+	failedAssertions int
+	err              error
+	// errMessage is the message of err reported as the error.message attribute.
+	errMessage string
+}
+
+func (r *stepResult) fail(message string, err error) {
+	r.err = err
+	r.errMessage = message
+}
+
+func newStepParser(set component.TelemetrySettings) (ottl.Parser[*ottllog.TransformContext], error) {
+	return ottllog.NewParser(ottlfuncs.StandardConverters[*ottllog.TransformContext](), set)
+}
+
+func parseStep(parser ottl.Parser[*ottllog.TransformContext], cfg stepConfig) (*step, error) {
+	s := &step{
+		stepConfig: cfg,
+		extract:    make(map[string]*ottl.ValueExpression[*ottllog.TransformContext], len(cfg.Extract)),
+	}
+	var errs error
+	for name, expression := range cfg.Extract {
+		valueExpression, err := parser.ParseValueExpression(expression)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid expression of variable %q: %w", name, err))
+			continue
+		}
+		s.extract[name] = valueExpression
+	}
+	for _, assertion := range cfg.Assertions {
+		condition, err := parser.ParseCondition(assertion)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid assertion %q: %w", assertion, err))
+			continue
+		}
+		s.assertions = append(s.assertions, condition)
+	}
+	return s, errs
+}
+
+func newScenario(cfg *scenarioConfig, client *http.Client, set component.TelemetrySettings) (*scenario, error) {
+	sc := &scenario{name: cfg.Name, client: client}
+	if cfg.Endpoint != "" {
+		base, err := url.Parse(cfg.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		sc.base = base
+	}
+	parser, err := newStepParser(set)
+	if err != nil {
+		return nil, err
+	}
+	for _, stepCfg := range cfg.Steps {
+		s, err := parseStep(parser, stepCfg)
+		if err != nil {
+			return nil, fmt.Errorf("step %q: %w", stepCfg.Name, err)
+		}
+		s.url = stepURL(sc.base, stepCfg.Endpoint)
+		sc.steps = append(sc.steps, s)
+	}
+	return sc, nil
+}
+
+// run runs the steps of the scenario in order, until one of them fails. The variables extracted from a
+// response are available to the following steps, as well as the cookies set by the responses.
+func (sc *scenario) run(ctx context.Context) []stepResult {
+	client := *sc.client
+	// The cookie jar is renewed at each run, so that each run starts from a new session.
+	client.Jar, _ = cookiejar.New(nil)
+
+	variables := map[string]string{}
+	results := make([]stepResult, 0, len(sc.steps))
+	for _, s := range sc.steps {
+		result := sc.runStep(ctx, &client, s, variables)
+		results = append(results, result)
+		if result.err != nil || result.failedAssertions > 0 {
+			break
+		}
+	}
+	return results
+}
+
+// stepURL returns the URL of a step before its variables are expanded, so that it doesn't depend on the
+// values extracted from the responses.
+func stepURL(base *url.URL, endpoint string) string {
+	if base == nil || strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+	return strings.TrimSuffix(base.String(), "/") + "/" + strings.TrimPrefix(endpoint, "/")
+}
+
+func (sc *scenario) runStep(ctx context.Context, client *http.Client, s *step, variables map[string]string) stepResult {
+	result := stepResult{step: s, url: s.url}
+
+	endpoint, err := expandVariables(s.Endpoint, variables)
+	if err != nil {
+		result.fail(stepErrorInvalidRequest, err)
+		return result
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		result.fail(stepErrorInvalidRequest, err)
+		return result
+	}
+	if sc.base != nil {
+		u = sc.base.ResolveReference(u)
+	}
+
+	var body io.Reader = http.NoBody
+	if s.Body != "" {
+		expanded, expandErr := expandVariables(s.Body, variables)
+		if expandErr != nil {
+			result.fail(stepErrorInvalidRequest, expandErr)
+			return result
+		}
+		body = strings.NewReader(expanded)
+	}
+	req, err := http.NewRequestWithContext(ctx, s.Method, u.String(), body)
+	if err != nil {
+		result.fail(stepErrorInvalidRequest, err)
+		return result
+	}
+	for name, value := range s.Headers {
+		expanded, expandErr := expandVariables(value, variables)
+		if expandErr != nil {
+			result.fail(stepErrorInvalidRequest, expandErr)
+			return result
+		}
+		req.Header.Set(name, expanded)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		result.duration = time.Since(start)
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			result.fail(stepErrorTimeout, err)
+		} else {
+			result.fail(stepErrorRequestFailed, err)
+		}
+		return result
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize+1))
+	result.duration = time.Since(start)
+	result.statusCode = resp.StatusCode
+	if err != nil {
+		result.fail(stepErrorBodyReadFailed, fmt.Errorf("failed to read response body: %w", err))
+		return result
+	}
+	if len(respBody) > maxResponseBodySize {
+		result.fail(stepErrorBodyTooLarge, errResponseBodyTooLarge)
+		return result
+	}
+
+	lr := newResponseLogRecord(resp, respBody, result.duration)
+	tCtx := ottllog.NewTransformContextPtr(plog.NewResourceLogs(), plog.NewScopeLogs(), lr)
+	defer tCtx.Close()
+
+	for _, assertion := range s.assertions {
+		if ok, evalErr := assertion.Eval(ctx, tCtx); evalErr != nil || !ok {
+			result.failedAssertions++
+		}
+	}
+	for name, expression := range s.extract {
+		value, evalErr := expression.Eval(ctx, tCtx)
+		if evalErr != nil {
+			result.fail(stepErrorExtractFailed, multierr.Append(result.err, fmt.Errorf("failed to extract variable %q: %w", name, evalErr)))
+			continue
+		}
+		if value == nil {
+			result.fail(stepErrorExtractFailed, multierr.Append(result.err, fmt.Errorf("failed to extract variable %q: no value", name)))
+			continue
+		}
+		variables[name] = valueToString(value)
+	}
+	return result
+}
+
+// newResponseLogRecord returns the log record representing the response for the OTTL expressions.
+func newResponseLogRecord(resp *http.Response, body []byte, duration time.Duration) plog.LogRecord {
+	lr := plog.NewLogRecord()
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		switch v := decoded.(type) {
+		case map[string]any:
+			_ = lr.Body().SetEmptyMap().FromRaw(v)
+		case []any:
+			_ = lr.Body().SetEmptySlice().FromRaw(v)
+		default:
+			lr.Body().SetStr(string(body))
+		}
+	} else {
+		lr.Body().SetStr(string(body))
+	}
+
+	attrs := lr.Attributes()
+	attrs.PutInt(responseStatusCodeAttr, int64(resp.StatusCode))
+	attrs.PutInt(responseDurationAttr, duration.Milliseconds())
+	headers := attrs.PutEmptyMap(responseHeadersAttr)
+	for name, values := range resp.Header {
+		headers.PutStr(strings.ToLower(name), strings.Join(values, ", "))
+	}
+	return lr
+}
+
+// expandVariables replaces the `{{name}}` placeholders with the value of the variables.
+func expandVariables(s string, variables map[string]string) (string, error) {
+	var errs error
+	expanded := placeholderRegexp.ReplaceAllStringFunc(s, func(placeholder string) string {
+		name := placeholderRegexp.FindStringSubmatch(placeholder)[1]
+		value, ok := variables[name]
+		if !ok {
+			errs = multierr.Append(errs, fmt.Errorf("undefined variable %q", name))
+		}
+		return value
+	})
+	return expanded, errs
+}
+
+func valueToString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case pcommon.Value:
+		return v.AsString()
+	case pcommon.Map:
+		m := pcommon.NewValueMap()
+		v.CopyTo(m.Map())
+		return m.AsString()
+	case pcommon.Slice:
+		s := pcommon.NewValueSlice()
+		v.CopyTo(s.Slice())
+		return s.AsString()
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
+)
+
+func newScenarioServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /login", func(w http.ResponseWriter, _ *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1"})
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"token": "abc", "expires_in": 3600}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("GET /users/{name}", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if r.Header.Get("Authorization") != "Bearer abc" || err != nil || cookie.Value != "s1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err = w.Write([]byte(`{"name": "` + r.PathValue("name") + `", "roles": ["admin"]}`))
+		assert.NoError(t, err)
+	})
+	return httptest.NewServer(mux)
+}
+
+func newTestScenario(endpoint string, assertions ...string) *scenarioConfig {
+	return &scenarioConfig{
+		ClientConfig: confighttp.ClientConfig{Endpoint: endpoint},
+		Name:         "login",
+		Steps: []stepConfig{
+			{
+				Name:       "login",
+				Method:     http.MethodPost,
+				Endpoint:   "/login",
+				Extract:    map[string]string{"token": `body["token"]`, "expires": `body["expires_in"]`},
+				Assertions: []string{`attributes["headers"]["content-type"] == "application/json"`},
+			},
+			{
+				Name:       "user",
+				Endpoint:   "/users/otel",
+				Headers:    map[string]string{"Authorization": "Bearer {{token}}"},
+				Assertions: assertions,
+			},
+			{
+				Name:     "other-user",
+				Endpoint: "/users/other",
+				Headers:  map[string]string{"Authorization": "Bearer {{ token }}"},
+			},
+		},
+	}
+}
+
+func TestScenario(t *testing.T) {
+	server := newScenarioServer(t)
+	defer server.Close()
+
+	sc, err := newScenario(newTestScenario(server.URL, `attributes["status_code"] == 200`, `body["name"] == "otel"`), server.Client(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	// Each run starts a new session.
+	for range 2 {
+		results := sc.run(t.Context())
+		require.Len(t, results, 3)
+		for _, result := range results {
+			require.NoError(t, result.err)
+			assert.Equal(t, http.StatusOK, result.statusCode)
+			assert.Zero(t, result.failedAssertions)
+		}
+		assert.Equal(t, server.URL+"/login", results[0].url)
+		assert.Equal(t, server.URL+"/users/otel", results[1].url)
+	}
+}
+
+func TestScenarioStopsAtFailedStep(t *testing.T) {
+	server := newScenarioServer(t)
+	defer server.Close()
+
+	sc, err := newScenario(newTestScenario(server.URL, `body["name"] == "someone"`, `Len(body["roles"]) == 1`), server.Client(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	results := sc.run(t.Context())
+	require.Len(t, results, 2)
+	assert.Equal(t, 1, results[1].failedAssertions)
+}
+
+func TestScenarioUndefinedVariable(t *testing.T) {
+	server := newScenarioServer(t)
+	defer server.Close()
+
+	cfg := newTestScenario(server.URL)
+	cfg.Steps[1].Headers["Authorization"] = "Bearer {{unknown}}"
+	sc, err := newScenario(cfg, server.Client(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	results := sc.run(t.Context())
+	require.Len(t, results, 2)
+	assert.EqualError(t, results[1].err, `undefined variable "unknown"`)
+	assert.Equal(t, stepErrorInvalidRequest, results[1].errMessage)
+}
+
+func TestScraperScenario(t *testing.T) {
+	server := newScenarioServer(t)
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Scenarios = []*scenarioConfig{newTestScenario(server.URL, `body["name"] == "someone"`)}
+	require.NoError(t, cfg.Validate())
+
+	scraper := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, scraper.start(t.Context(), componenttest.NewNopHost()))
+
+	metrics, err := scraper.scrape(t.Context())
+	require.NoError(t, err)
+
+	status := findMetric(t, metrics, "httpcheck.step.status").Sum().DataPoints()
+	require.Equal(t, 2, status.Len())
+	values := map[string]int64{}
+	for i := 0; i < status.Len(); i++ {
+		step, ok := status.At(i).Attributes().Get("httpcheck.step.name")
+		require.True(t, ok)
+		values[step.Str()] = status.At(i).IntValue()
+	}
+	assert.Equal(t, map[string]int64{"login": 1, "user": 0}, values)
+
+	failed := findMetric(t, metrics, "httpcheck.step.assertion.failed").Sum().DataPoints()
+	require.Equal(t, 2, failed.Len())
+	assert.Equal(t, 2, findMetric(t, metrics, "httpcheck.step.duration").Gauge().DataPoints().Len())
+}
+
+func findMetric(t *testing.T, metrics pmetric.Metrics, name string) pmetric.Metric {
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == name {
+			return ms.At(i)
+		}
+	}
+	require.Failf(t, "metric not found", "metric %q not found", name)
+	return pmetric.NewMetric()
+}
+
+func TestScenarioURLBeforeExpansion(t *testing.T) {
+	server := newScenarioServer(t)
+	defer server.Close()
+
+	cfg := newTestScenario(server.URL)
+	cfg.Steps[1].Endpoint = "/users/{{token}}"
+	sc, err := newScenario(cfg, server.Client(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	results := sc.run(t.Context())
+	require.Len(t, results, 3)
+	require.NoError(t, results[1].err)
+	// the extracted values are not part of the reported URL
+	assert.Equal(t, server.URL+"/users/{{token}}", results[1].url)
+}
+
+func TestScenarioResponseBodyTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write(make([]byte, maxResponseBodySize+1))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	sc, err := newScenario(&scenarioConfig{
+		ClientConfig: confighttp.ClientConfig{Endpoint: server.URL},
+		Name:         "large",
+		Steps:        []stepConfig{{Name: "large", Endpoint: "/"}},
+	}, server.Client(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	results := sc.run(t.Context())
+	require.Len(t, results, 1)
+	assert.ErrorIs(t, results[0].err, errResponseBodyTooLarge)
+	assert.Equal(t, stepErrorBodyTooLarge, results[0].errMessage)
+}
@@ -84,10 +84,11 @@ func (t *timingInfo) getDurations() (dnsMs, tcpMs, tlsMs, requestMs, responseMs
 }
 
 type httpcheckScraper struct {
-	clients  []*http.Client
-	cfg      *Config
-	settings component.TelemetrySettings
-	mb       *metadata.MetricsBuilder
+	clients   []*http.Client
+	scenarios []*scenario
+	cfg       *Config
+	settings  component.TelemetrySettings
+	mb        *metadata.MetricsBuilder
 }
 
 // extractTLSInfo extracts TLS certificate information from the connection state
@@ -235,19 +236,48 @@ func (h *httpcheckScraper) start(ctx context.Context, host component.Host) (err
 	}
 
 	h.cfg.Targets = expandedTargets // Replace targets with expanded targets
+
+	for _, scenarioCfg := range h.cfg.Scenarios {
+		client, clientErr := scenarioCfg.ToClient(ctx, host.GetExtensions(), h.settings)
+		if clientErr != nil {
+			h.settings.Logger.Error("failed to initialize HTTP client", zap.String("scenario", scenarioCfg.Name), zap.Error(clientErr))
+			err = multierr.Append(err, clientErr)
+			continue
+		}
+		sc, scenarioErr := newScenario(scenarioCfg, client, h.settings)
+		if scenarioErr != nil {
+			h.settings.Logger.Error("failed to initialize scenario", zap.String("scenario", scenarioCfg.Name), zap.Error(scenarioErr))
+			err = multierr.Append(err, scenarioErr)
+			continue
+		}
+		h.scenarios = append(h.scenarios, sc)
+	}
 	return err
 }
 
 // scrape performs the HTTP checks and records metrics based on responses.
 func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	if len(h.clients) == 0 {
+	if len(h.clients) == 0 && len(h.scenarios) == 0 {
 		return pmetric.NewMetrics(), errClientNotInit
 	}
 
 	var wg sync.WaitGroup
-	wg.Add(len(h.clients))
+	wg.Add(len(h.clients) + len(h.scenarios))
 	var mux sync.Mutex
 
+	for _, sc := range h.scenarios {
+		go func(sc *scenario) {
+			defer wg.Done()
+
+			now := pcommon.NewTimestampFromTime(time.Now())
+			results := sc.run(ctx)
+
+			mux.Lock()
+			defer mux.Unlock()
+			h.recordScenario(now, sc, results)
+		}(sc)
+	}
+
 	for idx, client := range h.clients {
 		go func(targetClient *http.Client, targetIndex int) {
 			defer wg.Done()
@@ -463,6 +493,25 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 	return metrics, nil
 }
 
+// recordScenario records the metrics of the steps of a scenario run. The steps which were not run, because
+// a previous step failed, are not reported.
+func (h *httpcheckScraper) recordScenario(now pcommon.Timestamp, sc *scenario, results []stepResult) {
+	for _, result := range results {
+		h.mb.RecordHttpcheckStepDurationDataPoint(now, result.duration.Milliseconds(), sc.name, result.step.Name, result.url)
+		if result.err != nil {
+			h.settings.Logger.Debug("scenario step failed", zap.String("scenario", sc.name), zap.String("step", result.step.Name), zap.Error(result.err))
+			h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), result.url, result.errMessage)
+		}
+		h.mb.RecordHttpcheckStepAssertionFailedDataPoint(now, int64(result.failedAssertions), sc.name, result.step.Name)
+
+		succeeded := int64(0)
+		if result.err == nil && result.failedAssertions == 0 {
+			succeeded = 1
+		}
+		h.mb.RecordHttpcheckStepStatusDataPoint(now, succeeded, sc.name, result.step.Name, int64(result.statusCode))
+	}
+}
+
 // removeStatusCodeForZeroValues removes the http.status_code attribute from httpcheck.status metrics
 func removeStatusCodeForZeroValues(metrics pmetric.Metrics) {
 	rms := metrics.ResourceMetrics()
@@ -16,6 +16,7 @@ import (
 	"strconv"
 	"strings"
 
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
@@ -29,9 +30,21 @@ var jmxGathererMainClass = "io.opentelemetry.contrib.jmxmetrics.JmxMetrics"
 // jmxScraperMainClass the class containing the main function for the JMX Scraper JAR
 var jmxScraperMainClass = "io.opentelemetry.contrib.jmxscraper.JmxScraper"
 
+const (
+	// modeSubprocess runs the JMX Metric Gatherer or JMX Scraper JAR as a subprocess
+	modeSubprocess = "subprocess"
+	// modeInline queries the configured MBeans through a Jolokia agent without starting a JVM
+	modeInline = "inline"
+)
+
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 
+	// The collection mode, either `subprocess` (default) or `inline`.
+	Mode string `mapstructure:"mode"`
+	// The settings of the inline mode, unused by the subprocess mode.
+	Inline InlineConfig `mapstructure:"inline"`
+
 	// The path for the JMX Metric Gatherer or JMX Scraper JAR (/opt/opentelemetry-java-contrib-jmx-metrics.jar by default).
 	// Supported by: jmx-scraper and jmx-metric-gatherer
 	JARPath string `mapstructure:"jar_path"`
@@ -96,6 +109,41 @@ type Config struct {
 	LogLevel string `mapstructure:"log_level"`
 }
 
+// InlineConfig defines how MBeans are queried from a Jolokia agent, which exposes JMX over HTTP.
+type InlineConfig struct {
+	// The Jolokia agent endpoint, e.g. http://localhost:8778/jolokia. The `username` and `password`
+	// settings of the receiver are used for basic authentication when set.
+	confighttp.ClientConfig `mapstructure:",squash"`
+	// The MBeans to query on each collection.
+	MBeans []MBeanConfig `mapstructure:"mbeans"`
+}
+
+// MBeanConfig defines the attributes collected from an MBean, or all MBeans matching an object name pattern.
+type MBeanConfig struct {
+	// The MBean object name, which may be a pattern such as `java.lang:type=GarbageCollector,name=*`.
+	ObjectName string `mapstructure:"object_name"`
+	// Maps object name key properties to data point attribute names, e.g. `name: jvm.gc.name`.
+	MetricAttributes map[string]string `mapstructure:"metric_attributes"`
+	// The MBean attributes to record as metrics.
+	Attributes []MBeanAttributeConfig `mapstructure:"attributes"`
+}
+
+// MBeanAttributeConfig maps an MBean attribute to a metric.
+type MBeanAttributeConfig struct {
+	// The MBean attribute name.
+	Name string `mapstructure:"name"`
+	// The slash separated path of the value inside composite data, e.g. `used` for HeapMemoryUsage.
+	Path string `mapstructure:"path"`
+	// The metric name.
+	Metric string `mapstructure:"metric"`
+	// The metric description.
+	Description string `mapstructure:"description"`
+	// The metric unit.
+	Unit string `mapstructure:"unit"`
+	// The metric type, either `gauge` (default) or `sum` for monotonic cumulative counters.
+	Type string `mapstructure:"type"`
+}
+
 // We don't embed the existing OTLP Exporter config as most fields are unsupported
 type otlpExporterConfig struct {
 	// The OTLP Receiver endpoint to send metrics to ("0.0.0.0:<random open port>" by default).
@@ -284,6 +332,14 @@ func initAdditionalTargetSystems() {
 }
 
 func (c *Config) Validate() error {
+	switch c.Mode {
+	case "", modeSubprocess:
+	case modeInline:
+		return c.validateInline()
+	default:
+		return fmt.Errorf("`mode` must be one of '%s', '%s'", modeInline, modeSubprocess)
+	}
+
 	var missingFields []string
 	if c.JARPath == "" {
 		missingFields = append(missingFields, "`jar_path`")
@@ -347,6 +403,41 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+func (c *Config) validateInline() error {
+	if c.Inline.Endpoint == "" {
+		return errors.New("missing required field(s): `inline.endpoint`")
+	}
+	if _, err := url.ParseRequestURI(c.Inline.Endpoint); err != nil {
+		return fmt.Errorf("invalid `inline.endpoint`: %w", err)
+	}
+	if len(c.Inline.MBeans) == 0 {
+		return errors.New("`inline.mbeans` must not be empty")
+	}
+	if c.CollectionInterval < 0 {
+		return fmt.Errorf("`interval` must be positive: %vms", c.CollectionInterval.Milliseconds())
+	}
+
+	for i, mbean := range c.Inline.MBeans {
+		if mbean.ObjectName == "" {
+			return fmt.Errorf("`inline.mbeans[%d].object_name` must not be empty", i)
+		}
+		if len(mbean.Attributes) == 0 {
+			return fmt.Errorf("`inline.mbeans[%d].attributes` must not be empty", i)
+		}
+		for j, attr := range mbean.Attributes {
+			if attr.Name == "" || attr.Metric == "" {
+				return fmt.Errorf("`inline.mbeans[%d].attributes[%d]` requires both `name` and `metric`", i, j)
+			}
+			switch attr.Type {
+			case "", metricTypeGauge, metricTypeSum:
+			default:
+				return fmt.Errorf("`inline.mbeans[%d].attributes[%d].type` must be one of '%s', '%s'", i, j, metricTypeGauge, metricTypeSum)
+			}
+		}
+	}
+	return nil
+}
+
 func listKeys(presenceMap map[string]struct{}) string {
 	list := make([]string, 0, len(presenceMap))
 	for k := range presenceMap {
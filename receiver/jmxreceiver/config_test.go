@@ -26,6 +26,9 @@ func TestLoadConfig(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 	initSupportedJars()
+	defaultInline := createDefaultConfig().(*Config).Inline
+	inlineClientConfig := defaultInline.ClientConfig
+	inlineClientConfig.Endpoint = "http://localhost:8778/jolokia"
 	tests := []struct {
 		id          component.ID
 		expected    component.Config
@@ -44,6 +47,7 @@ func TestLoadConfig(t *testing.T) {
 				TargetSystem: "jvm",
 				TargetSource: "",
 				JmxConfigs:   "",
+				Inline:       defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 15 * time.Second,
 					InitialDelay:       time.Second,
@@ -81,6 +85,7 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				JARPath:  "testdata/fake_jmx_scraper.jar",
 				Endpoint: "myendpoint:55555",
+				Inline:   defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 10 * time.Second,
 					InitialDelay:       time.Second,
@@ -100,6 +105,7 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				JARPath:      "testdata/fake_jmx.jar",
 				TargetSystem: "jvm",
+				Inline:       defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 10 * time.Second,
 					InitialDelay:       time.Second,
@@ -118,6 +124,7 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				JARPath:  "testdata/fake_jmx.jar",
 				Endpoint: "service:jmx:rmi:///jndi/rmi://host:12345/jmxrmi",
+				Inline:   defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 10 * time.Second,
 					InitialDelay:       time.Second,
@@ -136,6 +143,7 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				JARPath:  "testdata/fake_jmx_scraper.jar",
 				Endpoint: "service:jmx:rmi:///jndi/rmi://host:12345/jmxrmi",
+				Inline:   defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 10 * time.Second,
 					InitialDelay:       time.Second,
@@ -155,6 +163,7 @@ func TestLoadConfig(t *testing.T) {
 				JARPath:      "testdata/fake_jmx.jar",
 				Endpoint:     "myendpoint:23456",
 				TargetSystem: "jvm",
+				Inline:       defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: -100 * time.Millisecond,
 					InitialDelay:       time.Second,
@@ -174,6 +183,7 @@ func TestLoadConfig(t *testing.T) {
 				JARPath:      "testdata/fake_jmx.jar",
 				Endpoint:     "myendpoint:34567",
 				TargetSystem: "jvm",
+				Inline:       defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 10 * time.Second,
 					InitialDelay:       time.Second,
@@ -195,6 +205,7 @@ func TestLoadConfig(t *testing.T) {
 				JARPath:      "testdata/file_does_not_exist.jar",
 				Endpoint:     "myendpoint:23456",
 				TargetSystem: "jvm",
+				Inline:       defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 10 * time.Second,
 					InitialDelay:       time.Second,
@@ -214,6 +225,7 @@ func TestLoadConfig(t *testing.T) {
 				JARPath:      "testdata/fake_jmx_wrong.jar",
 				Endpoint:     "myendpoint:23456",
 				TargetSystem: "jvm",
+				Inline:       defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 10 * time.Second,
 					InitialDelay:       time.Second,
@@ -234,6 +246,7 @@ func TestLoadConfig(t *testing.T) {
 				Endpoint:     "myendpoint:55555",
 				TargetSystem: "jvm",
 				LogLevel:     "truth",
+				Inline:       defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 10 * time.Second,
 					InitialDelay:       time.Second,
@@ -254,6 +267,7 @@ func TestLoadConfig(t *testing.T) {
 				Endpoint:     "myendpoint:55555",
 				TargetSystem: "jvm",
 				LogLevel:     "truth",
+				Inline:       defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 10 * time.Second,
 					InitialDelay:       time.Second,
@@ -273,6 +287,150 @@ func TestLoadConfig(t *testing.T) {
 				JARPath:      "testdata/fake_jmx.jar",
 				Endpoint:     "myendpoint:55555",
 				TargetSystem: "jvm,fakejvmtechnology",
+				Inline:       defaultInline,
+				ControllerConfig: scraperhelper.ControllerConfig{
+					CollectionInterval: 10 * time.Second,
+					InitialDelay:       time.Second,
+				},
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutConfig{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "inline"),
+			expected: &Config{
+				Mode:     "inline",
+				JARPath:  "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				Username: "myusername",
+				Password: "mypassword",
+				Inline: InlineConfig{
+					ClientConfig: inlineClientConfig,
+					MBeans: []MBeanConfig{
+						{
+							ObjectName: "java.lang:type=Memory",
+							Attributes: []MBeanAttributeConfig{
+								{Name: "HeapMemoryUsage", Path: "used", Metric: "jvm.memory.heap.used", Unit: "By"},
+							},
+						},
+						{
+							ObjectName:       "java.lang:type=GarbageCollector,name=*",
+							MetricAttributes: map[string]string{"name": "jvm.gc.name"},
+							Attributes: []MBeanAttributeConfig{
+								{
+									Name:        "CollectionCount",
+									Metric:      "jvm.gc.collections.count",
+									Description: "The total number of garbage collections that have occurred",
+									Unit:        "{collection}",
+									Type:        "sum",
+								},
+							},
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.ControllerConfig{
+					CollectionInterval: 15 * time.Second,
+					InitialDelay:       time.Second,
+				},
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutConfig{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "inlinemissingendpoint"),
+			expectedErr: "missing required field(s): `inline.endpoint`",
+			expected: &Config{
+				Mode:    "inline",
+				JARPath: "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				Inline: InlineConfig{
+					ClientConfig: defaultInline.ClientConfig,
+					MBeans: []MBeanConfig{
+						{
+							ObjectName: "java.lang:type=Memory",
+							Attributes: []MBeanAttributeConfig{
+								{Name: "HeapMemoryUsage", Metric: "jvm.memory.heap"},
+							},
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.ControllerConfig{
+					CollectionInterval: 10 * time.Second,
+					InitialDelay:       time.Second,
+				},
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutConfig{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "inlinemissingmbeans"),
+			expectedErr: "`inline.mbeans` must not be empty",
+			expected: &Config{
+				Mode:    "inline",
+				JARPath: "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				Inline: InlineConfig{
+					ClientConfig: inlineClientConfig,
+				},
+				ControllerConfig: scraperhelper.ControllerConfig{
+					CollectionInterval: 10 * time.Second,
+					InitialDelay:       time.Second,
+				},
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutConfig{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "inlineinvalidtype"),
+			expectedErr: "`inline.mbeans[0].attributes[0].type` must be one of 'gauge', 'sum'",
+			expected: &Config{
+				Mode:    "inline",
+				JARPath: "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				Inline: InlineConfig{
+					ClientConfig: inlineClientConfig,
+					MBeans: []MBeanConfig{
+						{
+							ObjectName: "java.lang:type=Memory",
+							Attributes: []MBeanAttributeConfig{
+								{Name: "HeapMemoryUsage", Path: "used", Metric: "jvm.memory.heap.used", Type: "histogram"},
+							},
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.ControllerConfig{
+					CollectionInterval: 10 * time.Second,
+					InitialDelay:       time.Second,
+				},
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutConfig{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalidmode"),
+			expectedErr: "`mode` must be one of 'inline', 'subprocess'",
+			expected: &Config{
+				Mode:         "embedded",
+				JARPath:      "testdata/fake_jmx.jar",
+				Endpoint:     "myendpoint:55555",
+				TargetSystem: "jvm",
+				Inline:       defaultInline,
 				ControllerConfig: scraperhelper.ControllerConfig{
 					CollectionInterval: 10 * time.Second,
 					InitialDelay:       time.Second,
@@ -8,9 +8,11 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver/internal/metadata"
@@ -31,9 +33,14 @@ func createDefaultConfig() component.Config {
 	scs := scraperhelper.NewDefaultControllerConfig()
 	scs.CollectionInterval = 10 * time.Second
 	scs.InitialDelay = 1 * time.Second
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Timeout = 10 * time.Second
 	return &Config{
 		JARPath:          "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
 		ControllerConfig: scs,
+		Inline: InlineConfig{
+			ClientConfig: clientConfig,
+		},
 		OTLPExporterConfig: otlpExporterConfig{
 			Endpoint: otlpEndpoint,
 			TimeoutSettings: exporterhelper.TimeoutConfig{
@@ -50,5 +57,25 @@ func createReceiver(
 	consumer consumer.Metrics,
 ) (receiver.Metrics, error) {
 	jmxConfig := cfg.(*Config)
+	if jmxConfig.Mode == modeInline {
+		return createInlineReceiver(params, jmxConfig, consumer)
+	}
 	return newJMXMetricReceiver(params, jmxConfig, consumer), nil
 }
+
+func createInlineReceiver(
+	params receiver.Settings,
+	cfg *Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	is := newInlineScraper(params, cfg)
+	s, err := scraper.NewMetrics(is.scrape, scraper.WithStart(is.start), scraper.WithShutdown(is.shutdown))
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewMetricsController(
+		&cfg.ControllerConfig, params, consumer,
+		scraperhelper.AddMetricsScraper(metadata.Type, s),
+	)
+}
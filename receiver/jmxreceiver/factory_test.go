@@ -34,3 +34,23 @@ func TestWithValidConfig(t *testing.T) {
 	assert.Same(t, receiver.logger, params.Logger)
 	assert.Same(t, receiver.config, cfg)
 }
+
+func TestWithInlineConfig(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Mode = modeInline
+	cfg.Inline.Endpoint = "http://localhost:8778/jolokia"
+	cfg.Inline.MBeans = []MBeanConfig{
+		{
+			ObjectName: "java.lang:type=Memory",
+			Attributes: []MBeanAttributeConfig{{Name: "HeapMemoryUsage", Path: "used", Metric: "jvm.memory.heap.used"}},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	r, err := f.CreateMetrics(t.Context(), receivertest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	_, isSubprocess := r.(*jmxMetricReceiver)
+	assert.False(t, isSubprocess)
+}
@@ -0,0 +1,345 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jmxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver/internal/metadata"
+)
+
+const (
+	metricTypeGauge = "gauge"
+	metricTypeSum   = "sum"
+)
+
+// jolokiaRequest is a Jolokia read request, see https://jolokia.org/reference/html/manual/jolokia_protocol.html
+type jolokiaRequest struct {
+	Type      string         `json:"type"`
+	MBean     string         `json:"mbean"`
+	Attribute []string       `json:"attribute"`
+	Config    map[string]any `json:"config,omitempty"`
+}
+
+type jolokiaResponse struct {
+	Status int    `json:"status"`
+	Error  string `json:"error"`
+	Value  any    `json:"value"`
+}
+
+// inlineScraper queries MBeans through a Jolokia agent. The HTTP client keeps its connections
+// alive between collections, so no JVM has to be started by the collector.
+type inlineScraper struct {
+	settings   receiver.Settings
+	cfg        *Config
+	httpClient *http.Client
+	requests   []byte
+	startTime  pcommon.Timestamp
+}
+
+func newInlineScraper(settings receiver.Settings, cfg *Config) *inlineScraper {
+	return &inlineScraper{
+		settings: settings,
+		cfg:      cfg,
+	}
+}
+
+func (s *inlineScraper) start(ctx context.Context, host component.Host) error {
+	httpClient, err := s.cfg.Inline.ToClient(ctx, host.GetExtensions(), s.settings.TelemetrySettings)
+	if err != nil {
+		return err
+	}
+	s.httpClient = httpClient
+	s.startTime = pcommon.NewTimestampFromTime(time.Now())
+
+	requests := make([]jolokiaRequest, 0, len(s.cfg.Inline.MBeans))
+	for _, mbean := range s.cfg.Inline.MBeans {
+		attributes := make([]string, 0, len(mbean.Attributes))
+		seen := map[string]bool{}
+		for _, attr := range mbean.Attributes {
+			if !seen[attr.Name] {
+				seen[attr.Name] = true
+				attributes = append(attributes, attr.Name)
+			}
+		}
+		requests = append(requests, jolokiaRequest{
+			Type:      "read",
+			MBean:     mbean.ObjectName,
+			Attribute: attributes,
+			// Report missing attributes as values instead of failing the whole MBean read
+			Config: map[string]any{"ignoreErrors": true},
+		})
+	}
+	s.requests, err = json.Marshal(requests)
+	return err
+}
+
+func (s *inlineScraper) shutdown(context.Context) error {
+	if s.httpClient != nil {
+		s.httpClient.CloseIdleConnections()
+	}
+	return nil
+}
+
+func (s *inlineScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	responses, err := s.read(ctx)
+	if err != nil {
+		return pmetric.NewMetrics(), err
+	}
+
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	for k, v := range s.cfg.ResourceAttributes {
+		rm.Resource().Attributes().PutStr(k, v)
+	}
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(metadata.ScopeName)
+	sm.Scope().SetVersion(s.settings.BuildInfo.Version)
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	metrics := map[string]pmetric.Metric{}
+	errs := &scrapererror.ScrapeErrors{}
+	for i, mbean := range s.cfg.Inline.MBeans {
+		resp := responses[i]
+		if resp.Status != http.StatusOK {
+			errs.AddPartial(len(mbean.Attributes), fmt.Errorf("failed to read MBean %q: %s", mbean.ObjectName, resp.Error))
+			continue
+		}
+
+		values, err := mbeanValues(mbean.ObjectName, resp.Value)
+		if err != nil {
+			errs.AddPartial(len(mbean.Attributes), err)
+			continue
+		}
+
+		objectNames := make([]string, 0, len(values))
+		for objectName := range values {
+			objectNames = append(objectNames, objectName)
+		}
+		sort.Strings(objectNames)
+
+		for _, objectName := range objectNames {
+			properties := keyProperties(objectName)
+			for _, attr := range mbean.Attributes {
+				value, ok := lookupPath(values[objectName][attr.Name], attr.Path)
+				if !ok {
+					errs.AddPartial(1, fmt.Errorf("no value for attribute %q of MBean %q", attr.Name, objectName))
+					continue
+				}
+
+				if !isNumeric(value) {
+					errs.AddPartial(1, fmt.Errorf("value of attribute %q of MBean %q is not numeric: %v", attr.Name, objectName, value))
+					continue
+				}
+
+				dp := s.newDataPoint(sm, metrics, attr)
+				setDataPointValue(dp, value)
+				dp.SetTimestamp(now)
+				if attr.Type == metricTypeSum {
+					dp.SetStartTimestamp(s.startTime)
+				}
+				for property, attributeName := range mbean.MetricAttributes {
+					if v, ok := properties[property]; ok {
+						dp.Attributes().PutStr(attributeName, v)
+					}
+				}
+			}
+		}
+	}
+
+	return md, errs.Combine()
+}
+
+func (s *inlineScraper) read(ctx context.Context) ([]jolokiaResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.Inline.Endpoint, bytes.NewReader(s.requests))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.cfg.Username != "" {
+		req.SetBasicAuth(s.cfg.Username, string(s.cfg.Password))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Jolokia agent: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status from Jolokia agent: %d %s", resp.StatusCode, body)
+	}
+
+	var responses []jolokiaResponse
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&responses); err != nil {
+		return nil, fmt.Errorf("failed to decode Jolokia response: %w", err)
+	}
+	if len(responses) != len(s.cfg.Inline.MBeans) {
+		s.settings.Logger.Debug("Unexpected Jolokia response", zap.Int("expected", len(s.cfg.Inline.MBeans)), zap.Int("actual", len(responses)))
+		return nil, errors.New("the Jolokia agent returned an unexpected number of responses")
+	}
+	return responses, nil
+}
+
+func (*inlineScraper) newDataPoint(sm pmetric.ScopeMetrics, metrics map[string]pmetric.Metric, attr MBeanAttributeConfig) pmetric.NumberDataPoint {
+	m, ok := metrics[attr.Metric]
+	if !ok {
+		m = sm.Metrics().AppendEmpty()
+		m.SetName(attr.Metric)
+		m.SetDescription(attr.Description)
+		m.SetUnit(attr.Unit)
+		if attr.Type == metricTypeSum {
+			m.SetEmptySum().SetIsMonotonic(true)
+			m.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		} else {
+			m.SetEmptyGauge()
+		}
+		metrics[attr.Metric] = m
+	}
+	if m.Type() == pmetric.MetricTypeSum {
+		return m.Sum().DataPoints().AppendEmpty()
+	}
+	return m.Gauge().DataPoints().AppendEmpty()
+}
+
+// mbeanValues returns the attribute values keyed by object name. Jolokia returns the attributes
+// directly for an object name, and keyed by the matching object names for a pattern.
+func mbeanValues(objectName string, value any) (map[string]map[string]any, error) {
+	attrs, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value for MBean %q: %v", objectName, value)
+	}
+	if !isObjectNamePattern(objectName) {
+		return map[string]map[string]any{objectName: attrs}, nil
+	}
+
+	values := make(map[string]map[string]any, len(attrs))
+	for name, v := range attrs {
+		matched, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected value for MBean %q: %v", name, v)
+		}
+		values[name] = matched
+	}
+	return values, nil
+}
+
+func isObjectNamePattern(objectName string) bool {
+	return strings.ContainsAny(objectName, "*?")
+}
+
+// keyProperties parses the key properties of an object name, e.g. `java.lang:type=GarbageCollector,name=G1 Young Generation`.
+// Quoted values such as `name="a,b"` are unquoted and may contain commas, equal signs and escaped characters.
+func keyProperties(objectName string) map[string]string {
+	properties := map[string]string{}
+	_, list, ok := strings.Cut(objectName, ":")
+	if !ok {
+		return properties
+	}
+	for list != "" {
+		key, rest, ok := strings.Cut(list, "=")
+		if !ok {
+			break
+		}
+		var value string
+		value, list = keyPropertyValue(rest)
+		properties[key] = value
+	}
+	return properties
+}
+
+// keyPropertyValue returns the value at the start of s, and the key properties following it.
+func keyPropertyValue(s string) (value, rest string) {
+	if !strings.HasPrefix(s, `"`) {
+		value, rest, _ = strings.Cut(s, ",")
+		return value, rest
+	}
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i++; i < len(s) {
+				if s[i] == 'n' {
+					b.WriteByte('\n')
+				} else {
+					b.WriteByte(s[i])
+				}
+			}
+		case '"':
+			_, rest, _ = strings.Cut(s[i+1:], ",")
+			return b.String(), rest
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String(), ""
+}
+
+// lookupPath walks the slash separated path inside composite data.
+func lookupPath(value any, path string) (any, bool) {
+	if value == nil {
+		return nil, false
+	}
+	if path == "" {
+		return value, true
+	}
+	for key := range strings.SplitSeq(path, "/") {
+		composite, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = composite[key]; !ok || value == nil {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func isNumeric(value any) bool {
+	switch v := value.(type) {
+	case json.Number:
+		_, err := v.Float64()
+		return err == nil
+	case bool:
+		return true
+	}
+	return false
+}
+
+func setDataPointValue(dp pmetric.NumberDataPoint, value any) {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			dp.SetIntValue(i)
+			return
+		}
+		f, _ := v.Float64()
+		dp.SetDoubleValue(f)
+	case bool:
+		if v {
+			dp.SetIntValue(1)
+		} else {
+			dp.SetIntValue(0)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jmxreceiver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver/internal/metadata"
+)
+
+const jolokiaResponseBody = `[
+  {
+    "request": {"mbean": "java.lang:type=Memory", "attribute": ["HeapMemoryUsage"], "type": "read"},
+    "value": {"HeapMemoryUsage": {"init": 262144000, "committed": 268435456, "max": 4164943872, "used": 52428800}},
+    "status": 200
+  },
+  {
+    "request": {"mbean": "java.lang:name=*,type=GarbageCollector", "attribute": ["CollectionCount", "CollectionTime"], "type": "read"},
+    "value": {
+      "java.lang:name=G1 Young Generation,type=GarbageCollector": {"CollectionCount": 12, "CollectionTime": 2.5},
+      "java.lang:name=G1 Old Generation,type=GarbageCollector": {"CollectionCount": 1, "CollectionTime": "n/a"}
+    },
+    "status": 200
+  },
+  {
+    "request": {"mbean": "com.example:type=Missing", "attribute": ["Value"], "type": "read"},
+    "error": "javax.management.InstanceNotFoundException : com.example:type=Missing",
+    "status": 404
+  }
+]`
+
+func TestInlineScraper(t *testing.T) {
+	var requests []jolokiaRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "myusername", user)
+		assert.Equal(t, "mypassword", password)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&requests))
+		_, _ = w.Write([]byte(jolokiaResponseBody))
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Mode = modeInline
+	cfg.Username = "myusername"
+	cfg.Password = "mypassword"
+	cfg.ResourceAttributes = map[string]string{"service.name": "myservice"}
+	cfg.Inline.Endpoint = server.URL
+	cfg.Inline.MBeans = []MBeanConfig{
+		{
+			ObjectName: "java.lang:type=Memory",
+			Attributes: []MBeanAttributeConfig{
+				{Name: "HeapMemoryUsage", Path: "used", Metric: "jvm.memory.heap.used", Unit: "By"},
+				{Name: "HeapMemoryUsage", Path: "committed", Metric: "jvm.memory.heap.committed", Unit: "By"},
+			},
+		},
+		{
+			ObjectName:       "java.lang:name=*,type=GarbageCollector",
+			MetricAttributes: map[string]string{"name": "jvm.gc.name"},
+			Attributes: []MBeanAttributeConfig{
+				{Name: "CollectionCount", Metric: "jvm.gc.collections.count", Type: metricTypeSum},
+				{Name: "CollectionTime", Metric: "jvm.gc.collections.elapsed", Unit: "ms", Type: metricTypeSum},
+			},
+		},
+		{
+			ObjectName: "com.example:type=Missing",
+			Attributes: []MBeanAttributeConfig{{Name: "Value", Metric: "example.value"}},
+		},
+	}
+
+	s := newInlineScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, s.start(t.Context(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, s.shutdown(t.Context())) }()
+
+	md, err := s.scrape(t.Context())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.ErrorContains(t, err, `failed to read MBean "com.example:type=Missing"`)
+	assert.ErrorContains(t, err, `value of attribute "CollectionTime" of MBean "java.lang:name=G1 Old Generation,type=GarbageCollector" is not numeric`)
+
+	require.Len(t, requests, 3)
+	assert.Equal(t, []string{"HeapMemoryUsage"}, requests[0].Attribute)
+	assert.Equal(t, []string{"CollectionCount", "CollectionTime"}, requests[1].Attribute)
+
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	rm := md.ResourceMetrics().At(0)
+	serviceName, ok := rm.Resource().Attributes().Get("service.name")
+	require.True(t, ok)
+	assert.Equal(t, "myservice", serviceName.Str())
+
+	metrics := map[string]pmetric.Metric{}
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+	require.Len(t, metrics, 4)
+
+	heapUsed := metrics["jvm.memory.heap.used"]
+	assert.Equal(t, "By", heapUsed.Unit())
+	require.Equal(t, pmetric.MetricTypeGauge, heapUsed.Type())
+	assert.Equal(t, int64(52428800), heapUsed.Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(268435456), metrics["jvm.memory.heap.committed"].Gauge().DataPoints().At(0).IntValue())
+
+	collections := metrics["jvm.gc.collections.count"]
+	require.Equal(t, pmetric.MetricTypeSum, collections.Type())
+	assert.True(t, collections.Sum().IsMonotonic())
+	require.Equal(t, 2, collections.Sum().DataPoints().Len())
+	for i := 0; i < collections.Sum().DataPoints().Len(); i++ {
+		dp := collections.Sum().DataPoints().At(i)
+		assert.NotZero(t, dp.StartTimestamp())
+		gcName, ok := dp.Attributes().Get("jvm.gc.name")
+		require.True(t, ok)
+		switch gcName.Str() {
+		case "G1 Young Generation":
+			assert.Equal(t, int64(12), dp.IntValue())
+		case "G1 Old Generation":
+			assert.Equal(t, int64(1), dp.IntValue())
+		default:
+			t.Errorf("unexpected gc name %q", gcName.Str())
+		}
+	}
+
+	elapsed := metrics["jvm.gc.collections.elapsed"].Sum().DataPoints()
+	require.Equal(t, 1, elapsed.Len())
+	assert.Equal(t, 2.5, elapsed.At(0).DoubleValue())
+}
+
+func TestInlineScraperUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Inline.Endpoint = server.URL
+	cfg.Inline.MBeans = []MBeanConfig{
+		{
+			ObjectName: "java.lang:type=Memory",
+			Attributes: []MBeanAttributeConfig{{Name: "HeapMemoryUsage", Path: "used", Metric: "jvm.memory.heap.used"}},
+		},
+	}
+
+	s := newInlineScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, s.start(t.Context(), componenttest.NewNopHost()))
+	_, err := s.scrape(t.Context())
+	assert.ErrorContains(t, err, "unexpected status from Jolokia agent: 403")
+}
+
+func TestKeyProperties(t *testing.T) {
+	assert.Equal(t, map[string]string{"type": "GarbageCollector", "name": "G1 Young Generation"},
+		keyProperties("java.lang:type=GarbageCollector,name=G1 Young Generation"))
+	assert.Equal(t, map[string]string{"type": "Queue", "name": "orders"},
+		keyProperties(`org.apache.activemq:type=Queue,name="orders"`))
+	assert.Equal(t, map[string]string{"type": "Queue", "name": "a,b=c", "broker": "main"},
+		keyProperties(`org.apache.activemq:type=Queue,name="a,b=c",broker=main`))
+	assert.Equal(t, map[string]string{"name": `say "hi"\`, "type": "Queue"},
+		keyProperties(`org.apache.activemq:name="say \"hi\"\\",type=Queue`))
+	assert.Equal(t, map[string]string{"name": "line\nbreak"},
+		keyProperties(`org.apache.activemq:name="line\nbreak"`))
+	assert.Empty(t, keyProperties("invalid"))
+}
+
+func TestLookupPath(t *testing.T) {
+	value := map[string]any{"usage": map[string]any{"used": json.Number("10")}}
+	v, ok := lookupPath(value, "usage/used")
+	require.True(t, ok)
+	assert.Equal(t, json.Number("10"), v)
+
+	_, ok = lookupPath(value, "usage/max")
+	assert.False(t, ok)
+	_, ok = lookupPath(nil, "")
+	assert.False(t, ok)
+}
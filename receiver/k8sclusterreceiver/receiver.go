@@ -107,19 +107,9 @@ func (kr *kubernetesReceiver) Start(ctx context.Context, host component.Host) er
 	// if extension is defined start with k8s leader elector
 	if kr.config.K8sLeaderElector != nil {
 		kr.settings.Logger.Info("Starting k8sClusterReceiver with leader election")
-		extList := host.GetExtensions()
-		if extList == nil {
-			return errors.New("extension list is empty")
-		}
-
-		ext := extList[*kr.config.K8sLeaderElector]
-		if ext == nil {
-			return errors.New("extension k8s leader elector not found")
-		}
-
-		leaderElectorExt, ok := ext.(k8sleaderelector.LeaderElection)
-		if !ok {
-			return errors.New("referenced extension is not k8s leader elector")
+		leaderElectorExt, err := k8sleaderelector.GetLeaderElection(host, *kr.config.K8sLeaderElector)
+		if err != nil {
+			return err
 		}
 
 		leaderElectorExt.SetCallBackFuncs(
@@ -21,6 +21,10 @@ func (testRestClient) StatsSummary() ([]byte, error) {
 	return []byte{}, nil
 }
 
+func (testRestClient) ResourceMetrics() ([]byte, error) {
+	return []byte{}, nil
+}
+
 func (f testRestClient) Pods() ([]byte, error) {
 	if f.fail {
 		return []byte{}, errors.New("failed")
@@ -26,6 +26,10 @@ func (fakeRestClient) Pods() ([]byte, error) {
 	return os.ReadFile("../../testdata/pods.json")
 }
 
+func (fakeRestClient) ResourceMetrics() ([]byte, error) {
+	return os.ReadFile("../../testdata/resource-metrics.txt")
+}
+
 func TestMetricAccumulator(t *testing.T) {
 	rc := &fakeRestClient{}
 	statsProvider := NewStatsProvider(rc)
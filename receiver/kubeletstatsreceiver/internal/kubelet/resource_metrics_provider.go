@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+)
+
+// Metrics exposed by the kubelet /metrics/resource endpoint.
+const (
+	nodeCPUUsageSeconds            = "node_cpu_usage_seconds_total"
+	nodeMemoryWorkingSetBytes      = "node_memory_working_set_bytes"
+	podCPUUsageSeconds             = "pod_cpu_usage_seconds_total"
+	podMemoryWorkingSetBytes       = "pod_memory_working_set_bytes"
+	containerCPUUsageSeconds       = "container_cpu_usage_seconds_total"
+	containerMemoryWorkingSetBytes = "container_memory_working_set_bytes"
+	containerStartTimeSeconds      = "container_start_time_seconds"
+)
+
+// nodeKey, podKey and containerKey identify the CPU samples of the node, pods and containers.
+type nodeKey struct{}
+
+type podKey struct {
+	namespace string
+	name      string
+}
+
+type containerKey struct {
+	pod  podKey
+	name string
+}
+
+// cpuSample is a cumulative CPU usage read at a given time.
+type cpuSample struct {
+	seconds float64
+	time    time.Time
+}
+
+// ResourceMetricsProvider wraps a RestClient, building a stats.Summary from the
+// kubelet /metrics/resource endpoint. It is used in place of the /stats/summary
+// endpoint on kubelets which don't serve it anymore.
+//
+// The endpoint only exposes the cumulative CPU usage, so the provider keeps the
+// previous samples to compute the CPU usage rate. It is not safe for concurrent use.
+type ResourceMetricsProvider struct {
+	rc         RestClient
+	cpuSamples map[any]cpuSample
+}
+
+func NewResourceMetricsProvider(rc RestClient) *ResourceMetricsProvider {
+	return &ResourceMetricsProvider{rc: rc, cpuSamples: map[any]cpuSample{}}
+}
+
+// StatsSummary calls the /metrics/resource kubelet endpoint and converts the
+// results into a stats.Summary struct. The pods, if not nil, are used to resolve
+// the pod UIDs and start times which are not part of the endpoint response.
+func (p *ResourceMetricsProvider) StatsSummary(nodeName string, pods *v1.PodList) (*stats.Summary, error) {
+	body, err := p.rc.ResourceMetrics()
+	if err != nil {
+		return nil, err
+	}
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the /metrics/resource response: %w", err)
+	}
+
+	now := time.Now()
+	samples := make(map[any]cpuSample, len(p.cpuSamples))
+	var podKeys []podKey
+	podStats := map[podKey]*stats.PodStats{}
+	getPod := func(m *dto.Metric) *stats.PodStats {
+		key := podKey{namespace: label(m, "namespace"), name: label(m, "pod")}
+		ps, ok := podStats[key]
+		if !ok {
+			ps = &stats.PodStats{PodRef: stats.PodReference{Name: key.name, Namespace: key.namespace}}
+			podStats[key] = ps
+			podKeys = append(podKeys, key)
+		}
+		return ps
+	}
+	containerKeys := map[podKey][]containerKey{}
+	containerStats := map[containerKey]*stats.ContainerStats{}
+	getContainer := func(m *dto.Metric) (containerKey, *stats.ContainerStats) {
+		getPod(m)
+		key := containerKey{pod: podKey{namespace: label(m, "namespace"), name: label(m, "pod")}, name: label(m, "container")}
+		cs, ok := containerStats[key]
+		if !ok {
+			cs = &stats.ContainerStats{Name: key.name}
+			containerStats[key] = cs
+			containerKeys[key.pod] = append(containerKeys[key.pod], key)
+		}
+		return key, cs
+	}
+
+	summary := &stats.Summary{Node: stats.NodeStats{NodeName: nodeName}}
+	for _, m := range families[nodeCPUUsageSeconds].GetMetric() {
+		summary.Node.CPU = p.cpuStats(samples, nodeKey{}, m, now)
+	}
+	for _, m := range families[nodeMemoryWorkingSetBytes].GetMetric() {
+		summary.Node.Memory = memoryStats(m, now)
+	}
+	for _, m := range families[podCPUUsageSeconds].GetMetric() {
+		ps := getPod(m)
+		ps.CPU = p.cpuStats(samples, podKey{namespace: ps.PodRef.Namespace, name: ps.PodRef.Name}, m, now)
+	}
+	for _, m := range families[podMemoryWorkingSetBytes].GetMetric() {
+		getPod(m).Memory = memoryStats(m, now)
+	}
+	for _, m := range families[containerCPUUsageSeconds].GetMetric() {
+		key, cs := getContainer(m)
+		cs.CPU = p.cpuStats(samples, key, m, now)
+	}
+	for _, m := range families[containerMemoryWorkingSetBytes].GetMetric() {
+		_, cs := getContainer(m)
+		cs.Memory = memoryStats(m, now)
+	}
+	for _, m := range families[containerStartTimeSeconds].GetMetric() {
+		_, cs := getContainer(m)
+		cs.StartTime = metav1.NewTime(time.Unix(0, int64(m.GetGauge().GetValue()*float64(time.Second))))
+	}
+	// drop the samples of the objects which are gone
+	p.cpuSamples = samples
+
+	podsByKey := map[podKey]*v1.Pod{}
+	if pods != nil {
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			podsByKey[podKey{namespace: pod.Namespace, name: pod.Name}] = pod
+			if summary.Node.NodeName == "" {
+				summary.Node.NodeName = pod.Spec.NodeName
+			}
+		}
+	}
+	summary.Pods = make([]stats.PodStats, 0, len(podKeys))
+	for _, key := range podKeys {
+		ps := podStats[key]
+		if pod, ok := podsByKey[key]; ok {
+			ps.PodRef.UID = string(pod.UID)
+			if pod.Status.StartTime != nil {
+				ps.StartTime = *pod.Status.StartTime
+			}
+		}
+		for _, ck := range containerKeys[key] {
+			ps.Containers = append(ps.Containers, *containerStats[ck])
+		}
+		summary.Pods = append(summary.Pods, *ps)
+	}
+	return summary, nil
+}
+
+// cpuStats returns the CPU stats of the metric, computing the usage rate from the
+// previous sample of the same object when there is one.
+func (p *ResourceMetricsProvider) cpuStats(samples map[any]cpuSample, key any, m *dto.Metric, now time.Time) *stats.CPUStats {
+	sample := cpuSample{seconds: m.GetCounter().GetValue(), time: sampleTime(m, now)}
+	samples[key] = sample
+	usageCoreNanoSeconds := uint64(sample.seconds * float64(time.Second))
+	s := &stats.CPUStats{
+		Time:                 metav1.NewTime(sample.time),
+		UsageCoreNanoSeconds: &usageCoreNanoSeconds,
+	}
+	if previous, ok := p.cpuSamples[key]; ok && sample.time.After(previous.time) && sample.seconds >= previous.seconds {
+		usageNanoCores := uint64((sample.seconds - previous.seconds) / sample.time.Sub(previous.time).Seconds() * float64(time.Second))
+		s.UsageNanoCores = &usageNanoCores
+	}
+	return s
+}
+
+func memoryStats(m *dto.Metric, now time.Time) *stats.MemoryStats {
+	workingSetBytes := uint64(m.GetGauge().GetValue())
+	return &stats.MemoryStats{
+		Time:            metav1.NewTime(sampleTime(m, now)),
+		WorkingSetBytes: &workingSetBytes,
+	}
+}
+
+func sampleTime(m *dto.Metric, now time.Time) time.Time {
+	if m.TimestampMs == nil {
+		return now
+	}
+	return time.UnixMilli(m.GetTimestampMs())
+}
+
+func label(m *dto.Metric, name string) string {
+	for _, l := range m.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
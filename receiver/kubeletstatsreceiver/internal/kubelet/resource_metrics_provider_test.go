@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type resourceMetricsRestClient struct {
+	fakeRestClient
+	body []byte
+	err  error
+}
+
+func (c *resourceMetricsRestClient) ResourceMetrics() ([]byte, error) {
+	return c.body, c.err
+}
+
+func TestResourceMetricsStatsSummary(t *testing.T) {
+	body, err := os.ReadFile("../../testdata/resource-metrics.txt")
+	require.NoError(t, err)
+	rc := &resourceMetricsRestClient{body: body}
+	startTime := metav1.NewTime(time.Unix(1712000000, 0))
+	pods := &v1.PodList{Items: []v1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Name: "coredns-66bff467f8-szddj", Namespace: "kube-system", UID: types.UID("0adffe8e")},
+		Spec:       v1.PodSpec{NodeName: "minikube"},
+		Status:     v1.PodStatus{StartTime: &startTime},
+	}}}
+
+	provider := NewResourceMetricsProvider(rc)
+	summary, err := provider.StatsSummary("", pods)
+	require.NoError(t, err)
+
+	assert.Equal(t, "minikube", summary.Node.NodeName)
+	require.NotNil(t, summary.Node.CPU)
+	assert.Equal(t, uint64(2047683812000), *summary.Node.CPU.UsageCoreNanoSeconds)
+	// the usage rate requires a previous sample
+	assert.Nil(t, summary.Node.CPU.UsageNanoCores)
+	assert.Equal(t, uint64(1293582336), *summary.Node.Memory.WorkingSetBytes)
+
+	require.Len(t, summary.Pods, 2)
+	coredns := summary.Pods[0]
+	assert.Equal(t, "coredns-66bff467f8-szddj", coredns.PodRef.Name)
+	assert.Equal(t, "kube-system", coredns.PodRef.Namespace)
+	assert.Equal(t, "0adffe8e", coredns.PodRef.UID)
+	assert.Equal(t, startTime, coredns.StartTime)
+	assert.Equal(t, uint64(11530240), *coredns.Memory.WorkingSetBytes)
+	require.Len(t, coredns.Containers, 1)
+	assert.Equal(t, "coredns", coredns.Containers[0].Name)
+	assert.Equal(t, uint64(48121913000), *coredns.Containers[0].CPU.UsageCoreNanoSeconds)
+	assert.Equal(t, uint64(11268096), *coredns.Containers[0].Memory.WorkingSetBytes)
+	assert.Equal(t, time.Unix(1712100000, 0), coredns.Containers[0].StartTime.Time)
+
+	// the pods which are not in the pod list have no UID
+	assert.Equal(t, "kube-scheduler-minikube", summary.Pods[1].PodRef.Name)
+	assert.Empty(t, summary.Pods[1].PodRef.UID)
+
+	rc.body = []byte(`# TYPE node_cpu_usage_seconds_total counter
+node_cpu_usage_seconds_total 2049.683812 1712160010000
+`)
+	summary, err = provider.StatsSummary("minikube", nil)
+	require.NoError(t, err)
+	require.NotNil(t, summary.Node.CPU.UsageNanoCores)
+	assert.InDelta(t, 200_000_000, *summary.Node.CPU.UsageNanoCores, 1)
+	assert.Empty(t, summary.Pods)
+	assert.Len(t, provider.cpuSamples, 1)
+}
+
+func TestResourceMetricsStatsSummaryErrors(t *testing.T) {
+	provider := NewResourceMetricsProvider(&resourceMetricsRestClient{err: errors.New("failed")})
+	_, err := provider.StatsSummary("", nil)
+	require.EqualError(t, err, "failed")
+
+	provider = NewResourceMetricsProvider(&resourceMetricsRestClient{body: []byte("node_cpu_usage_seconds_total{")})
+	_, err = provider.StatsSummary("", nil)
+	require.ErrorContains(t, err, "failed to parse the /metrics/resource response")
+}
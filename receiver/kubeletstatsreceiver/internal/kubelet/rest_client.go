@@ -11,12 +11,13 @@ import (
 type RestClient interface {
 	StatsSummary() ([]byte, error)
 	Pods() ([]byte, error)
+	ResourceMetrics() ([]byte, error)
 }
 
 // HTTPRestClient is a thin wrapper around a kubelet client, encapsulating endpoints
 // and their corresponding http methods. The endpoints /stats/container /spec/
-// are excluded because they require cadvisor. The /metrics endpoint is excluded
-// because it returns Prometheus data.
+// are excluded because they require cadvisor. Among the Prometheus endpoints, only
+// /metrics/resource is used, as a fallback for the /stats/summary endpoint.
 type HTTPRestClient struct {
 	client kube.Client
 }
@@ -32,3 +33,7 @@ func (c *HTTPRestClient) StatsSummary() ([]byte, error) {
 func (c *HTTPRestClient) Pods() ([]byte, error) {
 	return c.client.Get("/pods")
 }
+
+func (c *HTTPRestClient) ResourceMetrics() ([]byte, error) {
+	return c.client.Get("/metrics/resource")
+}
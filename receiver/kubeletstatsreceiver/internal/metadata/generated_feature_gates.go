@@ -0,0 +1,15 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+var ReceiverKubeletstatsResourceMetricsFallbackFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"receiver.kubeletstats.resourceMetricsFallback",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("When enabled, the receiver falls back to the kubelet /metrics/resource endpoint when the /stats/summary endpoint is not available, reporting the CPU and memory metrics it exposes under the same names"),
+	featuregate.WithRegisterReferenceURL("https://github.com/kubernetes/enhancements/issues/2371"),
+	featuregate.WithRegisterFromVersion("v0.145.0"),
+)
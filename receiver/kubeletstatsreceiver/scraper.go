@@ -35,6 +35,7 @@ type scraperOptions struct {
 
 type kubeletScraper struct {
 	statsProvider         *kubelet.StatsProvider
+	resourceMetrics       *kubelet.ResourceMetricsProvider
 	metadataProvider      *kubelet.MetadataProvider
 	logger                *zap.Logger
 	extraMetadataLabels   []kubelet.MetadataLabel
@@ -45,6 +46,7 @@ type kubeletScraper struct {
 	mbs                   *metadata.MetricsBuilders
 	needsResources        bool
 	nodeInformer          cache.SharedInformer
+	nodeName              string
 	stopCh                chan struct{}
 	m                     sync.RWMutex
 
@@ -61,6 +63,7 @@ func newKubeletScraper(
 ) (scraper.Metrics, error) {
 	ks := &kubeletScraper{
 		statsProvider:         kubelet.NewStatsProvider(restClient),
+		resourceMetrics:       kubelet.NewResourceMetricsProvider(restClient),
 		metadataProvider:      kubelet.NewMetadataProvider(restClient),
 		logger:                set.Logger,
 		extraMetadataLabels:   rOptions.extraMetadataLabels,
@@ -82,6 +85,7 @@ func newKubeletScraper(
 			metricsConfig.Metrics.K8sPodMemoryRequestUtilization.Enabled ||
 			metricsConfig.Metrics.K8sContainerMemoryLimitUtilization.Enabled ||
 			metricsConfig.Metrics.K8sContainerMemoryRequestUtilization.Enabled,
+		nodeName: nodeName,
 		stopCh:   make(chan struct{}),
 		nodeInfo: &kubelet.NodeInfo{},
 	}
@@ -101,15 +105,29 @@ func newKubeletScraper(
 }
 
 func (r *kubeletScraper) scrape(context.Context) (pmetric.Metrics, error) {
+	var podsMetadata *v1.PodList
 	summary, err := r.statsProvider.StatsSummary()
 	if err != nil {
-		r.logger.Error("call to /stats/summary endpoint failed", zap.Error(err))
-		return pmetric.Metrics{}, err
+		if !metadata.ReceiverKubeletstatsResourceMetricsFallbackFeatureGate.IsEnabled() {
+			r.logger.Error("call to /stats/summary endpoint failed", zap.Error(err))
+			return pmetric.Metrics{}, err
+		}
+		r.logger.Debug("call to /stats/summary endpoint failed, falling back to /metrics/resource", zap.Error(err))
+		// the pods resolve the UIDs and start times missing from /metrics/resource
+		podsMetadata, err = r.metadataProvider.Pods()
+		if err != nil {
+			r.logger.Error("call to /pods endpoint failed", zap.Error(err))
+			return pmetric.Metrics{}, err
+		}
+		summary, err = r.resourceMetrics.StatsSummary(r.nodeName, podsMetadata)
+		if err != nil {
+			r.logger.Error("call to /metrics/resource endpoint failed", zap.Error(err))
+			return pmetric.Metrics{}, err
+		}
 	}
 
-	var podsMetadata *v1.PodList
 	// fetch metadata only when extra metadata labels are needed
-	if len(r.extraMetadataLabels) > 0 || r.needsResources {
+	if podsMetadata == nil && (len(r.extraMetadataLabels) > 0 || r.needsResources) {
 		podsMetadata, err = r.metadataProvider.Pods()
 		if err != nil {
 			r.logger.Error("call to /pods endpoint failed", zap.Error(err))
@@ -22,6 +22,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	clienttesting "k8s.io/client-go/testing"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
@@ -82,6 +83,40 @@ func TestScraper(t *testing.T) {
 		pmetrictest.IgnoreMetricsOrder()))
 }
 
+func TestScraperResourceMetricsFallback(t *testing.T) {
+	options := &scraperOptions{
+		metricGroupsToCollect: allMetricGroups,
+	}
+	r, err := newKubeletScraper(
+		&fakeRestClient{statsSummaryFail: true},
+		receivertest.NewNopSettings(metadata.Type),
+		options,
+		metadata.DefaultMetricsBuilderConfig(),
+		"worker-42",
+	)
+	require.NoError(t, err)
+
+	_, err = r.ScrapeMetrics(t.Context())
+	require.Error(t, err)
+
+	defer testutil.SetFeatureGateForTest(t, metadata.ReceiverKubeletstatsResourceMetricsFallbackFeatureGate, true)()
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	expectedFile := filepath.Join("testdata", "scraper", "test_scraper_resource_metrics_fallback_expected.yaml")
+
+	// Uncomment to regenerate '*_expected.yaml' files
+	// golden.WriteMetrics(t, expectedFile, md)
+
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, md,
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreMetricsOrder()))
+}
+
 func TestScraperWithInterfacesMetrics(t *testing.T) {
 	options := &scraperOptions{
 		metricGroupsToCollect: allMetricGroups,
@@ -851,8 +886,9 @@ func TestClientErrors(t *testing.T) {
 var _ kubelet.RestClient = (*fakeRestClient)(nil)
 
 type fakeRestClient struct {
-	statsSummaryFail bool
-	podsFail         bool
+	statsSummaryFail    bool
+	podsFail            bool
+	resourceMetricsFail bool
 }
 
 func (f *fakeRestClient) StatsSummary() ([]byte, error) {
@@ -868,3 +904,10 @@ func (f *fakeRestClient) Pods() ([]byte, error) {
 	}
 	return os.ReadFile("testdata/pods.json")
 }
+
+func (f *fakeRestClient) ResourceMetrics() ([]byte, error) {
+	if f.resourceMetricsFail {
+		return nil, errors.New("")
+	}
+	return os.ReadFile("testdata/resource-metrics.txt")
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -26,6 +27,8 @@ type client interface {
 	TopStats(ctx context.Context) (bson.M, error)
 	IndexStats(ctx context.Context, DBName, collectionName string) ([]bson.M, error)
 	RunCommand(ctx context.Context, db string, command bson.M) (bson.M, error)
+	CurrentOp(ctx context.Context, minDuration time.Duration) ([]bson.M, error)
+	ProfiledOperations(ctx context.Context, DBName string, since time.Time, minDuration time.Duration, limit int64) ([]bson.M, error)
 }
 
 // mongodbClient is a mongodb metric scraper client
@@ -104,6 +107,47 @@ func (c *mongodbClient) IndexStats(ctx context.Context, database, collectionName
 	return indexStats, nil
 }
 
+// CurrentOp returns the active operations running for at least minDuration using
+// db.adminCommand({ currentOp: true, active: true, microsecs_running: { $gte: <minDuration> } })
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/command/currentOp/
+func (c *mongodbClient) CurrentOp(ctx context.Context, minDuration time.Duration) ([]bson.M, error) {
+	result := c.Database("admin").RunCommand(ctx, bson.D{
+		{Key: "currentOp", Value: true},
+		{Key: "active", Value: true},
+		{Key: "microsecs_running", Value: bson.M{"$gte": minDuration.Microseconds()}},
+	})
+
+	var document struct {
+		InProg []bson.M `bson:"inprog"`
+	}
+	if err := result.Decode(&document); err != nil {
+		return nil, err
+	}
+	return document.InProg, nil
+}
+
+// ProfiledOperations returns the operations recorded by the database profiler after since that took at least minDuration,
+// oldest first. The profiler must be enabled on the database, e.g. with db.setProfilingLevel(1, { slowms: 100 })
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/database-profiler/
+func (c *mongodbClient) ProfiledOperations(ctx context.Context, database string, since time.Time, minDuration time.Duration, limit int64) ([]bson.M, error) {
+	filter := bson.M{
+		"ts":     bson.M{"$gt": since},
+		"millis": bson.M{"$gte": minDuration.Milliseconds()},
+	}
+	findOpts := options.Find().SetSort(bson.D{{Key: "ts", Value: 1}}).SetLimit(limit)
+	cursor, err := c.Database(database).Collection("system.profile").Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var operations []bson.M
+	if err := cursor.All(ctx, &operations); err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
 // GetVersion returns a result of the version of mongo the client is connected to so adjustments in collection protocol can
 // be determined
 func (c *mongodbClient) GetVersion(ctx context.Context) (*version.Version, error) {
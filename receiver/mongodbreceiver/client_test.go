@@ -8,6 +8,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/stretchr/testify/mock"
@@ -86,6 +87,16 @@ func (fc *fakeClient) RunCommand(ctx context.Context, db string, command bson.M)
 	return result, args.Error(1)
 }
 
+func (fc *fakeClient) CurrentOp(ctx context.Context, minDuration time.Duration) ([]bson.M, error) {
+	args := fc.Called(ctx, minDuration)
+	return args.Get(0).([]bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) ProfiledOperations(ctx context.Context, dbName string, since time.Time, minDuration time.Duration, limit int64) ([]bson.M, error) {
+	args := fc.Called(ctx, dbName, since, minDuration, limit)
+	return args.Get(0).([]bson.M), args.Error(1)
+}
+
 func TestListDatabaseNames(t *testing.T) {
 	mont := drivertest.NewMockDeployment()
 	mont.AddResponses(bson.D{
@@ -26,6 +26,10 @@ type Config struct {
 	configtls.ClientConfig         `mapstructure:"tls,omitempty"`
 	// MetricsBuilderConfig defines which metrics/attributes to enable for the scraper
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+	// LogsBuilderConfig defines which events to enable for the logs receiver
+	metadata.LogsBuilderConfig `mapstructure:",squash"`
+	// SlowOperationCollection configures how slow operations are collected when the receiver is part of a logs pipeline
+	SlowOperationCollection SlowOperationCollection `mapstructure:"slow_operation_collection"`
 	// Deprecated - Transport option will be removed in v0.102.0
 	Hosts            []confignet.TCPAddrConfig `mapstructure:"hosts"`
 	Username         string                    `mapstructure:"username"`
@@ -35,6 +39,25 @@ type Config struct {
 	DirectConnection bool                      `mapstructure:"direct_connection"`
 }
 
+const (
+	slowOperationSourceCurrentOp = "current_op"
+	slowOperationSourceProfiler  = "profiler"
+)
+
+type SlowOperationCollection struct {
+	// Source is where slow operations are read from. "current_op" (default) samples the operations in progress
+	// using the currentOp command, "profiler" reads the completed operations from the system.profile
+	// collections, which requires the database profiler to be enabled.
+	Source string `mapstructure:"source"`
+	// Threshold is the minimum duration of an operation to be reported.
+	Threshold time.Duration `mapstructure:"threshold"`
+	// MaxOperationsPerScrape limits the number of operations reported per collection interval and database,
+	// 0 means no limit.
+	MaxOperationsPerScrape int64 `mapstructure:"max_operations_per_scrape"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 func (c *Config) Validate() error {
 	if len(c.Hosts) == 0 {
 		return errors.New("no hosts were specified in the config")
@@ -53,6 +76,19 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, errors.New("password provided without user"))
 	}
 
+	switch c.SlowOperationCollection.Source {
+	case "", slowOperationSourceCurrentOp, slowOperationSourceProfiler:
+	default:
+		err = multierr.Append(err, fmt.Errorf("slow_operation_collection::source must be one of %q or %q, got %q",
+			slowOperationSourceCurrentOp, slowOperationSourceProfiler, c.SlowOperationCollection.Source))
+	}
+	if c.SlowOperationCollection.Threshold < 0 {
+		err = multierr.Append(err, errors.New("slow_operation_collection::threshold must not be negative"))
+	}
+	if c.SlowOperationCollection.MaxOperationsPerScrape < 0 {
+		err = multierr.Append(err, errors.New("slow_operation_collection::max_operations_per_scrape must not be negative"))
+	}
+
 	if _, tlsErr := c.LoadTLSConfig(context.Background()); tlsErr != nil {
 		err = multierr.Append(err, fmt.Errorf("error loading tls configuration: %w", tlsErr))
 	}
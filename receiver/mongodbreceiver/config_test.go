@@ -216,6 +216,24 @@ func TestLoadConfig(t *testing.T) {
 	expected.Username = "otel"
 	expected.Password = "${env:MONGO_PASSWORD}"
 	expected.CollectionInterval = time.Minute
+	expected.SlowOperationCollection.Source = slowOperationSourceProfiler
+	expected.SlowOperationCollection.Threshold = 500 * time.Millisecond
 
 	require.Equal(t, expected, cfg)
 }
+
+func TestValidateSlowOperationCollection(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, xconfmap.Validate(cfg))
+
+	cfg.SlowOperationCollection.Source = "oplog"
+	require.ErrorContains(t, xconfmap.Validate(cfg), `slow_operation_collection::source must be one of "current_op" or "profiler", got "oplog"`)
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.SlowOperationCollection.Threshold = -time.Second
+	require.ErrorContains(t, xconfmap.Validate(cfg), "slow_operation_collection::threshold must not be negative")
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.SlowOperationCollection.MaxOperationsPerScrape = -1
+	require.ErrorContains(t, xconfmap.Validate(cfg), "slow_operation_collection::max_operations_per_scrape must not be negative")
+}
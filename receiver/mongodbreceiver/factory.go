@@ -28,7 +28,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -41,7 +42,13 @@ func createDefaultConfig() component.Config {
 			},
 		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
-		ClientConfig:         configtls.ClientConfig{},
+		LogsBuilderConfig:    metadata.DefaultLogsBuilderConfig(),
+		SlowOperationCollection: SlowOperationCollection{
+			Source:                 slowOperationSourceCurrentOp,
+			Threshold:              100 * time.Millisecond,
+			MaxOperationsPerScrape: 100,
+		},
+		ClientConfig: configtls.ClientConfig{},
 	}
 }
 
@@ -67,3 +74,32 @@ func createMetricsReceiver(
 		scraperhelper.AddMetricsScraper(metadata.Type, s),
 	)
 }
+
+// createLogsReceiver creates a logs receiver emitting slow operations as events.
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.Settings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg := rConf.(*Config)
+
+	opts := make([]scraperhelper.ControllerOption, 0)
+	if cfg.Events.DbServerSlowOperation.Enabled {
+		ms := newMongodbScraper(params, cfg)
+		s, err := scraper.NewLogs(
+			ms.scrapeSlowOperations,
+			scraper.WithStart(ms.startSlowOperations),
+			scraper.WithShutdown(ms.shutdown))
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, scraperhelper.AddFactoryWithConfig(
+			scraper.NewFactory(metadata.Type, nil,
+				scraper.WithLogs(func(context.Context, scraper.Settings, component.Config) (scraper.Logs, error) {
+					return s, nil
+				}, metadata.LogsStability)), nil))
+	}
+
+	return scraperhelper.NewLogsController(&cfg.ControllerConfig, params, consumer, opts...)
+}
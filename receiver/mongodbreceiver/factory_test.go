@@ -42,3 +42,14 @@ func TestCreateMetrics(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
+
+func TestCreateLogs(t *testing.T) {
+	factory := NewFactory()
+	_, err := factory.CreateLogs(
+		t.Context(),
+		receivertest.NewNopSettings(metadata.Type),
+		factory.CreateDefaultConfig(),
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+}
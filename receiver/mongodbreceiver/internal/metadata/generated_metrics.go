@@ -42,6 +42,28 @@ var MapAttributeConnectionType = map[string]AttributeConnectionType{
 	"current":   AttributeConnectionTypeCurrent,
 }
 
+// AttributeDbSystemName specifies the value db.system.name attribute.
+type AttributeDbSystemName int
+
+const (
+	_ AttributeDbSystemName = iota
+	AttributeDbSystemNameMongodb
+)
+
+// String returns the string representation of the AttributeDbSystemName.
+func (av AttributeDbSystemName) String() string {
+	switch av {
+	case AttributeDbSystemNameMongodb:
+		return "mongodb"
+	}
+	return ""
+}
+
+// MapAttributeDbSystemName is a helper map of string to AttributeDbSystemName attribute value.
+var MapAttributeDbSystemName = map[string]AttributeDbSystemName{
+	"mongodb": AttributeDbSystemNameMongodb,
+}
+
 // AttributeLockMode specifies the value lock_mode attribute.
 type AttributeLockMode int
 
@@ -152,6 +174,32 @@ var MapAttributeMemoryType = map[string]AttributeMemoryType{
 	"virtual":  AttributeMemoryTypeVirtual,
 }
 
+// AttributeMongodbOperationSource specifies the value mongodb.operation.source attribute.
+type AttributeMongodbOperationSource int
+
+const (
+	_ AttributeMongodbOperationSource = iota
+	AttributeMongodbOperationSourceCurrentOp
+	AttributeMongodbOperationSourceProfiler
+)
+
+// String returns the string representation of the AttributeMongodbOperationSource.
+func (av AttributeMongodbOperationSource) String() string {
+	switch av {
+	case AttributeMongodbOperationSourceCurrentOp:
+		return "current_op"
+	case AttributeMongodbOperationSourceProfiler:
+		return "profiler"
+	}
+	return ""
+}
+
+// MapAttributeMongodbOperationSource is a helper map of string to AttributeMongodbOperationSource attribute value.
+var MapAttributeMongodbOperationSource = map[string]AttributeMongodbOperationSource{
+	"current_op": AttributeMongodbOperationSourceCurrentOp,
+	"profiler":   AttributeMongodbOperationSourceProfiler,
+}
+
 // AttributeOperation specifies the value operation attribute.
 type AttributeOperation int
 
@@ -2968,6 +3016,7 @@ func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
 		mb.startTime = startTime
 	})
 }
+
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
 		config:                              mbc,
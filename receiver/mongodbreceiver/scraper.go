@@ -44,12 +44,18 @@ type mongodbScraper struct {
 	secondaryClients   []client
 	mongoVersion       *version.Version
 	mb                 *metadata.MetricsBuilder
+	lb                 *metadata.LogsBuilder
 	prevReplTimestamp  pcommon.Timestamp
 	prevReplCounts     map[string]int64
 	prevTimestamp      pcommon.Timestamp
 	prevFlushTimestamp pcommon.Timestamp
 	prevCounts         map[string]int64
 	prevFlushCount     int64
+	// seenOpIDs holds the ids of the slow operations reported by the previous currentOp scrape.
+	seenOpIDs map[string]struct{}
+	// profilerCursors holds the timestamp of the last reported profiler entry per database.
+	profilerCursors map[string]time.Time
+	startTime       time.Time
 }
 
 func newMongodbScraper(settings receiver.Settings, config *Config) *mongodbScraper {
@@ -57,6 +63,7 @@ func newMongodbScraper(settings receiver.Settings, config *Config) *mongodbScrap
 		logger:             settings.Logger,
 		config:             config,
 		mb:                 metadata.NewMetricsBuilder(config.MetricsBuilderConfig, settings),
+		lb:                 metadata.NewLogsBuilder(config.LogsBuilderConfig, settings),
 		mongoVersion:       unknownVersion(),
 		prevReplTimestamp:  pcommon.Timestamp(0),
 		prevReplCounts:     make(map[string]int64),
@@ -64,6 +71,9 @@ func newMongodbScraper(settings receiver.Settings, config *Config) *mongodbScrap
 		prevFlushTimestamp: pcommon.Timestamp(0),
 		prevCounts:         make(map[string]int64),
 		prevFlushCount:     0,
+		seenOpIDs:          make(map[string]struct{}),
+		profilerCursors:    make(map[string]time.Time),
+		startTime:          time.Now(),
 	}
 }
 
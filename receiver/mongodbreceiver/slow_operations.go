@@ -0,0 +1,305 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver/internal/metadata"
+)
+
+const queryShapePlaceholder = "?"
+
+// operationNames are the command names used to name an operation, in order of precedence.
+var operationNames = []string{
+	"find", "aggregate", "count", "distinct", "findAndModify", "getMore",
+	"insert", "update", "delete", "mapReduce", "createIndexes",
+}
+
+// commandMetadataFields are the command fields that do not contribute to the shape of a query.
+var commandMetadataFields = map[string]struct{}{
+	"$audit":           {},
+	"$client":          {},
+	"$clusterTime":     {},
+	"$db":              {},
+	"$readPreference":  {},
+	"apiVersion":       {},
+	"autocommit":       {},
+	"comment":          {},
+	"lsid":             {},
+	"maxTimeMS":        {},
+	"readConcern":      {},
+	"startTransaction": {},
+	"txnNumber":        {},
+	"writeConcern":     {},
+}
+
+// systemDatabases are never profiled for slow operations.
+var systemDatabases = map[string]struct{}{
+	"admin":  {},
+	"config": {},
+	"local":  {},
+}
+
+// startSlowOperations only connects to the configured hosts, slow operations are not
+// collected from the secondaries discovered by start.
+func (s *mongodbScraper) startSlowOperations(ctx context.Context, _ component.Host) error {
+	c, err := newClient(ctx, s.config, s.logger, false)
+	if err != nil {
+		return fmt.Errorf("create mongo client: %w", err)
+	}
+	s.client = c
+	return nil
+}
+
+func (s *mongodbScraper) scrapeSlowOperations(ctx context.Context) (plog.Logs, error) {
+	if s.client == nil {
+		return plog.NewLogs(), errors.New("no client was initialized before calling scrape")
+	}
+
+	serverStatus, err := s.client.ServerStatus(ctx, "admin")
+	if err != nil {
+		return plog.NewLogs(), fmt.Errorf("failed to fetch server status: %w", err)
+	}
+	serverAddress, serverPort, err := serverAddressAndPort(serverStatus)
+	if err != nil {
+		return plog.NewLogs(), fmt.Errorf("failed to fetch server address and port: %w", err)
+	}
+
+	errs := &scrapererror.ScrapeErrors{}
+	switch s.config.SlowOperationCollection.Source {
+	case slowOperationSourceProfiler:
+		s.collectProfiledOperations(ctx, errs)
+	default:
+		s.collectCurrentOperations(ctx, errs)
+	}
+
+	rb := s.lb.NewResourceBuilder()
+	rb.SetServerAddress(serverAddress)
+	rb.SetServerPort(serverPort)
+	return s.lb.Emit(metadata.WithLogsResource(rb.Emit())), errs.Combine()
+}
+
+// collectCurrentOperations reports the in-progress operations running longer than the threshold.
+// An operation spanning several scrapes is only reported the first time it is seen.
+func (s *mongodbScraper) collectCurrentOperations(ctx context.Context, errs *scrapererror.ScrapeErrors) {
+	operations, err := s.client.CurrentOp(ctx, s.config.SlowOperationCollection.Threshold)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to fetch current operations: %w", err))
+		return
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	seen := make(map[string]struct{}, len(operations))
+	var reported int64
+	for _, op := range operations {
+		opID := fmt.Sprint(op["opid"])
+		if _, ok := s.seenOpIDs[opID]; ok {
+			seen[opID] = struct{}{}
+			continue
+		}
+		// Operations left out by the limit are not marked as seen, so they are reported by a later scrape.
+		if limit := s.config.SlowOperationCollection.MaxOperationsPerScrape; limit > 0 && reported >= limit {
+			continue
+		}
+		durationMs := float64(toInt64(op["microsecs_running"])) / 1e3
+		s.recordSlowOperation(now, op, durationMs, metadata.AttributeMongodbOperationSourceCurrentOp)
+		seen[opID] = struct{}{}
+		reported++
+	}
+	s.seenOpIDs = seen
+}
+
+// collectProfiledOperations reports the operations recorded by the profiler of every user database
+// since the last scrape.
+func (s *mongodbScraper) collectProfiledOperations(ctx context.Context, errs *scrapererror.ScrapeErrors) {
+	dbNames, err := s.client.ListDatabaseNames(ctx, bson.D{})
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to fetch database names: %w", err))
+		return
+	}
+
+	for _, dbName := range dbNames {
+		if _, ok := systemDatabases[dbName]; ok {
+			continue
+		}
+		since, ok := s.profilerCursors[dbName]
+		if !ok {
+			since = s.startTime
+		}
+		operations, err := s.client.ProfiledOperations(ctx, dbName, since,
+			s.config.SlowOperationCollection.Threshold, s.config.SlowOperationCollection.MaxOperationsPerScrape)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to fetch profiled operations for database %s: %w", dbName, err))
+			continue
+		}
+		for _, op := range operations {
+			ts, ok := toTime(op["ts"])
+			if !ok {
+				ts = time.Now()
+			}
+			if ts.After(since) {
+				since = ts
+			}
+			durationMs := float64(toInt64(op["millis"]))
+			s.recordSlowOperation(pcommon.NewTimestampFromTime(ts), op, durationMs, metadata.AttributeMongodbOperationSourceProfiler)
+		}
+		s.profilerCursors[dbName] = since
+	}
+}
+
+func (s *mongodbScraper) recordSlowOperation(ts pcommon.Timestamp, op bson.M, durationMs float64, source metadata.AttributeMongodbOperationSource) {
+	command := toMap(op["command"])
+	operationName := commandOperationName(command)
+	if operationName == "" {
+		operationName, _ = op["op"].(string)
+	}
+	dbName, collectionName := splitNamespace(op["ns"])
+	if name, ok := command[operationName].(string); ok && name != "" {
+		collectionName = name
+	}
+	clientAddress, _ := op["client"].(string)
+	planSummary, _ := op["planSummary"].(string)
+
+	s.lb.RecordDbServerSlowOperationEvent(context.Background(), ts,
+		metadata.AttributeDbSystemNameMongodb,
+		dbName,
+		collectionName,
+		operationName,
+		clientAddress,
+		queryShape(command, operationName),
+		durationMs,
+		source,
+		planSummary,
+	)
+}
+
+// commandOperationName returns the name of the command, e.g. "find" for { find: "orders", filter: {...} }.
+func commandOperationName(command map[string]any) string {
+	for _, name := range operationNames {
+		if _, ok := command[name]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// splitNamespace splits a "<database>.<collection>" namespace.
+func splitNamespace(ns any) (database, collection string) {
+	str, _ := ns.(string)
+	database, collection, _ = strings.Cut(str, ".")
+	return database, collection
+}
+
+// queryShape returns the command as JSON with every literal value replaced by a placeholder,
+// so that operations differing only in their parameters share the same shape.
+// Field names and operators are kept, the collection name of the operation is kept as is.
+func queryShape(command map[string]any, operationName string) string {
+	if len(command) == 0 {
+		return ""
+	}
+	shape := make(map[string]any, len(command))
+	for key, value := range command {
+		if _, ok := commandMetadataFields[key]; ok {
+			continue
+		}
+		if key == operationName {
+			shape[key] = value
+			continue
+		}
+		shape[key] = normalizeQueryValue(value)
+	}
+	b, err := json.Marshal(shape)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func normalizeQueryValue(value any) any {
+	switch v := value.(type) {
+	case bson.M, bson.D, map[string]any:
+		doc := toMap(v)
+		res := make(map[string]any, len(doc))
+		for key, val := range doc {
+			res[key] = normalizeQueryValue(val)
+		}
+		return res
+	case bson.A:
+		return normalizeQueryArray(v)
+	case []any:
+		return normalizeQueryArray(v)
+	default:
+		return queryShapePlaceholder
+	}
+}
+
+// normalizeQueryArray normalizes every element, arrays of literals collapse to a single placeholder.
+func normalizeQueryArray(values []any) any {
+	res := make([]any, 0, len(values))
+	allLiterals := true
+	for _, val := range values {
+		normalized := normalizeQueryValue(val)
+		if normalized != queryShapePlaceholder {
+			allLiterals = false
+		}
+		res = append(res, normalized)
+	}
+	if allLiterals && len(res) > 0 {
+		return []any{queryShapePlaceholder}
+	}
+	return res
+}
+
+func toMap(value any) map[string]any {
+	switch v := value.(type) {
+	case bson.M:
+		return v
+	case map[string]any:
+		return v
+	case bson.D:
+		res := make(map[string]any, len(v))
+		for _, e := range v {
+			res[e.Key] = e.Value
+		}
+		return res
+	default:
+		return nil
+	}
+}
+
+func toInt64(value any) int64 {
+	switch v := value.(type) {
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	default:
+		return 0
+	}
+}
+
+func toTime(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case bson.DateTime:
+		return v.Time().UTC(), true
+	default:
+		return time.Time{}, false
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver"
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver/internal/metadata"
+)
+
+func TestQueryShape(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		command  bson.M
+		expected string
+	}{
+		{
+			desc: "find with nested operators",
+			command: bson.M{
+				"find":   "orders",
+				"filter": bson.D{{Key: "status", Value: "A"}, {Key: "qty", Value: bson.M{"$gt": int32(10)}}},
+				"limit":  int32(5),
+				"lsid":   bson.M{"id": "abc"},
+				"$db":    "shop",
+			},
+			expected: `{"filter":{"qty":{"$gt":"?"},"status":"?"},"find":"orders","limit":"?"}`,
+		},
+		{
+			desc: "arrays of literals collapse",
+			command: bson.M{
+				"find":   "orders",
+				"filter": bson.M{"sku": bson.M{"$in": bson.A{"a", "b", "c"}}},
+			},
+			expected: `{"filter":{"sku":{"$in":["?"]}},"find":"orders"}`,
+		},
+		{
+			desc: "arrays of documents are kept",
+			command: bson.M{
+				"aggregate": "orders",
+				"pipeline": bson.A{
+					bson.M{"$match": bson.M{"status": "A"}},
+					bson.M{"$group": bson.M{"_id": "$cust_id", "total": bson.M{"$sum": "$amount"}}},
+				},
+			},
+			expected: `{"aggregate":"orders","pipeline":[{"$match":{"status":"?"}},{"$group":{"_id":"?","total":{"$sum":"?"}}}]}`,
+		},
+		{
+			desc:     "empty command",
+			command:  bson.M{},
+			expected: "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, queryShape(tc.command, commandOperationName(tc.command)))
+		})
+	}
+}
+
+func TestScrapeSlowOperationsCurrentOp(t *testing.T) {
+	fc := &fakeClient{}
+	fc.On("ServerStatus", mock.Anything, "admin").Return(bson.M{"host": "mongo:27017"}, nil)
+	fc.On("CurrentOp", mock.Anything, 100*time.Millisecond).Return([]bson.M{
+		{
+			"opid":              int32(12),
+			"op":                "query",
+			"ns":                "shop.orders",
+			"client":            "10.0.0.1:50412",
+			"microsecs_running": int64(2500000),
+			"planSummary":       "COLLSCAN",
+			"command":           bson.M{"find": "orders", "filter": bson.M{"status": "A"}},
+		},
+		{
+			"opid":              int32(13),
+			"op":                "command",
+			"ns":                "shop.$cmd",
+			"microsecs_running": int64(150000),
+			"command":           bson.M{"createIndexes": "orders", "indexes": bson.A{bson.M{"key": bson.M{"status": int32(1)}}}},
+		},
+	}, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.client = fc
+
+	logs, err := scraper.scrapeSlowOperations(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 2, logs.LogRecordCount())
+
+	rl := logs.ResourceLogs().At(0)
+	address, _ := rl.Resource().Attributes().Get("server.address")
+	assert.Equal(t, "mongo", address.Str())
+
+	records := rl.ScopeLogs().At(0).LogRecords()
+	find := records.At(0)
+	assert.Equal(t, "db.server.slow_operation", find.EventName())
+	assert.Equal(t, map[string]any{
+		"db.system.name":             "mongodb",
+		"db.namespace":               "shop",
+		"db.collection.name":         "orders",
+		"db.operation.name":          "find",
+		"client.address":             "10.0.0.1:50412",
+		"mongodb.query.shape":        `{"filter":{"status":"?"},"find":"orders"}`,
+		"mongodb.operation.duration": 2500.0,
+		"mongodb.operation.source":   "current_op",
+		"mongodb.plan_summary":       "COLLSCAN",
+	}, find.Attributes().AsRaw())
+
+	createIndexes := records.At(1)
+	name, _ := createIndexes.Attributes().Get("db.operation.name")
+	assert.Equal(t, "createIndexes", name.Str())
+	collection, _ := createIndexes.Attributes().Get("db.collection.name")
+	assert.Equal(t, "orders", collection.Str())
+
+	// operations still running are not reported again
+	logs, err = scraper.scrapeSlowOperations(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 0, logs.LogRecordCount())
+}
+
+func TestScrapeSlowOperationsCurrentOpLimit(t *testing.T) {
+	fc := &fakeClient{}
+	fc.On("ServerStatus", mock.Anything, "admin").Return(bson.M{"host": "mongo:27017"}, nil)
+	fc.On("CurrentOp", mock.Anything, 100*time.Millisecond).Return([]bson.M{
+		{"opid": int32(12), "op": "query", "ns": "shop.orders", "command": bson.M{"find": "orders"}},
+		{"opid": int32(13), "op": "query", "ns": "shop.users", "command": bson.M{"find": "users"}},
+	}, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.SlowOperationCollection.MaxOperationsPerScrape = 1
+	scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.client = fc
+
+	logs, err := scraper.scrapeSlowOperations(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+	collection, _ := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("db.collection.name")
+	assert.Equal(t, "orders", collection.Str())
+
+	// the operation left out by the limit is reported by the next scrape
+	logs, err = scraper.scrapeSlowOperations(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+	collection, _ = logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("db.collection.name")
+	assert.Equal(t, "users", collection.Str())
+
+	logs, err = scraper.scrapeSlowOperations(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 0, logs.LogRecordCount())
+}
+
+func TestScrapeSlowOperationsProfiler(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SlowOperationCollection.Source = slowOperationSourceProfiler
+	scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+
+	opTime := scraper.startTime.Add(time.Second).Truncate(time.Millisecond).UTC()
+	fc := &fakeClient{}
+	fc.On("ServerStatus", mock.Anything, "admin").Return(bson.M{"host": "mongo"}, nil)
+	fc.On("ListDatabaseNames", mock.Anything, mock.Anything, mock.Anything).Return([]string{"admin", "shop"}, nil)
+	fc.On("ProfiledOperations", mock.Anything, "shop", scraper.startTime, 100*time.Millisecond, int64(100)).Return([]bson.M{
+		{
+			"op":      "update",
+			"ns":      "shop.orders",
+			"millis":  int32(320),
+			"ts":      bson.NewDateTimeFromTime(opTime),
+			"command": bson.M{"q": bson.M{"_id": int32(1)}, "u": bson.M{"$set": bson.M{"status": "B"}}},
+		},
+	}, nil).Once()
+	fc.On("ProfiledOperations", mock.Anything, "shop", opTime, 100*time.Millisecond, int64(100)).Return([]bson.M{}, nil).Once()
+	scraper.client = fc
+
+	logs, err := scraper.scrapeSlowOperations(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+
+	lr := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, opTime, lr.Timestamp().AsTime())
+	assertSlowOperationAttr(t, lr, "db.operation.name", "update")
+	assertSlowOperationAttr(t, lr, "db.collection.name", "orders")
+	assertSlowOperationAttr(t, lr, "mongodb.operation.source", "profiler")
+	assertSlowOperationAttr(t, lr, "mongodb.query.shape", `{"q":{"_id":"?"},"u":{"$set":{"status":"?"}}}`)
+
+	// the next scrape resumes after the last reported entry
+	logs, err = scraper.scrapeSlowOperations(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 0, logs.LogRecordCount())
+	fc.AssertExpectations(t)
+}
+
+func assertSlowOperationAttr(t *testing.T, lr plog.LogRecord, key, expected string) {
+	val, ok := lr.Attributes().Get(key)
+	require.True(t, ok)
+	assert.Equal(t, expected, val.Str())
+}
@@ -9,9 +9,11 @@ import (
 	"net"
 	"time"
 
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/nts"
 )
 
 // Config is the configuration for the NSX receiver
@@ -20,13 +22,67 @@ type Config struct {
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
 	Version                        int    `mapstructure:"version"`
 	Endpoint                       string `mapstructure:"endpoint"`
+	// NTS configures Network Time Security for the queries to Endpoint.
+	NTS NTSConfig `mapstructure:"nts"`
+	// Servers are additional NTP servers queried concurrently with Endpoint
+	// to compare their clock offsets.
+	Servers []ServerConfig `mapstructure:"servers"`
+}
+
+// ServerConfig is the configuration of an additional NTP server.
+type ServerConfig struct {
+	Endpoint string `mapstructure:"endpoint"`
+	// NTS configures Network Time Security for the queries to Endpoint.
+	NTS NTSConfig `mapstructure:"nts"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// NTSConfig configures Network Time Security (RFC 8915) for an NTP server.
+type NTSConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// KeyExchangeEndpoint is the endpoint of the NTS key exchange server.
+	// Defaults to the host of the NTP server on port 4460.
+	KeyExchangeEndpoint string `mapstructure:"key_exchange_endpoint"`
+	// TLS configures the connection to the NTS key exchange server.
+	TLS configtls.ClientConfig `mapstructure:"tls"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// keyExchangeEndpoint returns the endpoint of the NTS key exchange server of the NTP server at the endpoint.
+func (c *NTSConfig) keyExchangeEndpoint(endpoint string) string {
+	if c.KeyExchangeEndpoint != "" {
+		return c.KeyExchangeEndpoint
+	}
+	host, _, _ := net.SplitHostPort(endpoint)
+	return net.JoinHostPort(host, nts.DefaultKeyExchangePort)
+}
+
+// servers returns the configuration of all the NTP servers, starting with Endpoint.
+func (c *Config) servers() []ServerConfig {
+	return append([]ServerConfig{{Endpoint: c.Endpoint, NTS: c.NTS}}, c.Servers...)
 }
 
 func (c *Config) Validate() error {
 	var errs []error
-	_, _, err := net.SplitHostPort(c.Endpoint)
-	if err != nil {
-		errs = append(errs, err)
+	endpoints := make(map[string]struct{})
+	for _, server := range c.servers() {
+		_, _, err := net.SplitHostPort(server.Endpoint)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if _, ok := endpoints[server.Endpoint]; ok {
+			errs = append(errs, fmt.Errorf("duplicate server endpoint %q", server.Endpoint))
+		}
+		endpoints[server.Endpoint] = struct{}{}
+		if server.NTS.Enabled && server.NTS.KeyExchangeEndpoint != "" {
+			if _, _, err = net.SplitHostPort(server.NTS.KeyExchangeEndpoint); err != nil {
+				errs = append(errs, fmt.Errorf("invalid NTS key exchange endpoint: %w", err))
+			}
+		}
 	}
 	// respect terms of service https://www.pool.ntp.org/tos.html
 	if c.CollectionInterval < 30*time.Minute {
@@ -52,6 +52,35 @@ func TestValidate(t *testing.T) {
 			},
 			errorExpected: "collection interval 29m0s is less than minimum 30m",
 		},
+		{
+			name: "valid servers with NTS",
+			c: &Config{
+				Version:          4,
+				Endpoint:         "pool.ntp.org:123",
+				Servers:          []ServerConfig{{Endpoint: "time.cloudflare.com:123", NTS: NTSConfig{Enabled: true}}},
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 45 * time.Minute},
+			},
+		},
+		{
+			name: "duplicate server",
+			c: &Config{
+				Version:          4,
+				Endpoint:         "pool.ntp.org:123",
+				Servers:          []ServerConfig{{Endpoint: "pool.ntp.org:123"}},
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 45 * time.Minute},
+			},
+			errorExpected: `duplicate server endpoint "pool.ntp.org:123"`,
+		},
+		{
+			name: "invalid NTS key exchange endpoint",
+			c: &Config{
+				Version:          4,
+				Endpoint:         "time.cloudflare.com:123",
+				NTS:              NTSConfig{Enabled: true, KeyExchangeEndpoint: "time.cloudflare.com"},
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 45 * time.Minute},
+			},
+			errorExpected: "invalid NTS key exchange endpoint: address time.cloudflare.com: missing port in address",
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			err := tt.c.Validate()
@@ -63,3 +92,8 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyExchangeEndpoint(t *testing.T) {
+	require.Equal(t, "time.cloudflare.com:4460", (&NTSConfig{}).keyExchangeEndpoint("time.cloudflare.com:123"))
+	require.Equal(t, "nts.example.com:1234", (&NTSConfig{KeyExchangeEndpoint: "nts.example.com:1234"}).keyExchangeEndpoint("time.example.com:123"))
+}
@@ -29,7 +29,9 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for ntp metrics.
 type MetricsConfig struct {
-	NtpOffset MetricConfig `mapstructure:"ntp.offset"`
+	NtpOffset           MetricConfig `mapstructure:"ntp.offset"`
+	NtpOffsetDeviation  MetricConfig `mapstructure:"ntp.offset.deviation"`
+	NtpOffsetDivergence MetricConfig `mapstructure:"ntp.offset.divergence"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -37,6 +39,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		NtpOffset: MetricConfig{
 			Enabled: true,
 		},
+		NtpOffsetDeviation: MetricConfig{
+			Enabled: false,
+		},
+		NtpOffsetDivergence: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -26,7 +26,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					NtpOffset: MetricConfig{Enabled: true},
+					NtpOffset:           MetricConfig{Enabled: true},
+					NtpOffsetDeviation:  MetricConfig{Enabled: true},
+					NtpOffsetDivergence: MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					NtpHost: ResourceAttributeConfig{Enabled: true},
@@ -37,7 +39,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					NtpOffset: MetricConfig{Enabled: false},
+					NtpOffset:           MetricConfig{Enabled: false},
+					NtpOffsetDeviation:  MetricConfig{Enabled: false},
+					NtpOffsetDivergence: MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					NtpHost: ResourceAttributeConfig{Enabled: false},
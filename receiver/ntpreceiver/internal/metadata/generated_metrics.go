@@ -16,10 +16,18 @@ var MetricsInfo = metricsInfo{
 	NtpOffset: metricInfo{
 		Name: "ntp.offset",
 	},
+	NtpOffsetDeviation: metricInfo{
+		Name: "ntp.offset.deviation",
+	},
+	NtpOffsetDivergence: metricInfo{
+		Name: "ntp.offset.divergence",
+	},
 }
 
 type metricsInfo struct {
-	NtpOffset metricInfo
+	NtpOffset           metricInfo
+	NtpOffsetDeviation  metricInfo
+	NtpOffsetDivergence metricInfo
 }
 
 type metricInfo struct {
@@ -76,6 +84,106 @@ func newMetricNtpOffset(cfg MetricConfig) metricNtpOffset {
 	return m
 }
 
+type metricNtpOffsetDeviation struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills ntp.offset.deviation metric with initial data.
+func (m *metricNtpOffsetDeviation) init() {
+	m.data.SetName("ntp.offset.deviation")
+	m.data.SetDescription("Difference between the clock offset of the NTP server and the median clock offset of all the NTP servers")
+	m.data.SetUnit("ns")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricNtpOffsetDeviation) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNtpOffsetDeviation) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNtpOffsetDeviation) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNtpOffsetDeviation(cfg MetricConfig) metricNtpOffsetDeviation {
+	m := metricNtpOffsetDeviation{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNtpOffsetDivergence struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills ntp.offset.divergence metric with initial data.
+func (m *metricNtpOffsetDivergence) init() {
+	m.data.SetName("ntp.offset.divergence")
+	m.data.SetDescription("Difference between the largest and the smallest clock offsets of the NTP servers")
+	m.data.SetUnit("ns")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricNtpOffsetDivergence) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNtpOffsetDivergence) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNtpOffsetDivergence) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNtpOffsetDivergence(cfg MetricConfig) metricNtpOffsetDivergence {
+	m := metricNtpOffsetDivergence{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -87,6 +195,8 @@ type MetricsBuilder struct {
 	resourceAttributeIncludeFilter map[string]filter.Filter
 	resourceAttributeExcludeFilter map[string]filter.Filter
 	metricNtpOffset                metricNtpOffset
+	metricNtpOffsetDeviation       metricNtpOffsetDeviation
+	metricNtpOffsetDivergence      metricNtpOffsetDivergence
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -113,6 +223,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricsBuffer:                  pmetric.NewMetrics(),
 		buildInfo:                      settings.BuildInfo,
 		metricNtpOffset:                newMetricNtpOffset(mbc.Metrics.NtpOffset),
+		metricNtpOffsetDeviation:       newMetricNtpOffsetDeviation(mbc.Metrics.NtpOffsetDeviation),
+		metricNtpOffsetDivergence:      newMetricNtpOffsetDivergence(mbc.Metrics.NtpOffsetDivergence),
 		resourceAttributeIncludeFilter: make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter: make(map[string]filter.Filter),
 	}
@@ -192,6 +304,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricNtpOffset.emit(ils.Metrics())
+	mb.metricNtpOffsetDeviation.emit(ils.Metrics())
+	mb.metricNtpOffsetDivergence.emit(ils.Metrics())
 
 	for _, op := range options {
 		op.apply(rm)
@@ -228,6 +342,16 @@ func (mb *MetricsBuilder) RecordNtpOffsetDataPoint(ts pcommon.Timestamp, val int
 	mb.metricNtpOffset.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordNtpOffsetDeviationDataPoint adds a data point to ntp.offset.deviation metric.
+func (mb *MetricsBuilder) RecordNtpOffsetDeviationDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricNtpOffsetDeviation.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordNtpOffsetDivergenceDataPoint adds a data point to ntp.offset.divergence metric.
+func (mb *MetricsBuilder) RecordNtpOffsetDivergenceDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricNtpOffsetDivergence.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...MetricBuilderOption) {
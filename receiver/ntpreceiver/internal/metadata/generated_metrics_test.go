@@ -71,6 +71,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordNtpOffsetDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordNtpOffsetDeviationDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordNtpOffsetDivergenceDataPoint(ts, 1)
+
 			rb := mb.NewResourceBuilder()
 			rb.SetNtpHost("ntp.host-val")
 			res := rb.Emit()
@@ -107,6 +113,30 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "ntp.offset.deviation":
+					assert.False(t, validatedMetrics["ntp.offset.deviation"], "Found a duplicate in the metrics slice: ntp.offset.deviation")
+					validatedMetrics["ntp.offset.deviation"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Difference between the clock offset of the NTP server and the median clock offset of all the NTP servers", ms.At(i).Description())
+					assert.Equal(t, "ns", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "ntp.offset.divergence":
+					assert.False(t, validatedMetrics["ntp.offset.divergence"], "Found a duplicate in the metrics slice: ntp.offset.divergence")
+					validatedMetrics["ntp.offset.divergence"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Difference between the largest and the smallest clock offsets of the NTP servers", ms.At(i).Description())
+					assert.Equal(t, "ns", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				}
 			}
 		})
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package nts implements the client side of the Network Time Security for NTP
+// as defined by RFC 8915.
+package nts // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/nts"
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+const (
+	recordEnd          = 0
+	recordNextProtocol = 1
+	recordError        = 2
+	recordWarning      = 3
+	recordAEAD         = 4
+	recordCookie       = 5
+	recordServer       = 6
+	recordPort         = 7
+
+	criticalBit = 0x8000
+
+	protocolNTPv4     = 0
+	aeadAESSIVCMAC256 = 15
+
+	alpnProtocol  = "ntske/1"
+	exporterLabel = "EXPORTER-network-time-security"
+
+	// DefaultKeyExchangePort is the port of the NTS key exchange servers.
+	DefaultKeyExchangePort = "4460"
+
+	// keyLength is the length of the AEAD_AES_SIV_CMAC_256 keys.
+	keyLength = 32
+
+	defaultKeyExchangeTimeout = 10 * time.Second
+)
+
+// keyExchange holds the result of an NTS key exchange.
+type keyExchange struct {
+	server  string
+	port    string
+	c2s     []byte
+	s2c     []byte
+	cookies [][]byte
+}
+
+// exchangeKeys performs the NTS key exchange with the server at the endpoint, within the timeout.
+func exchangeKeys(ctx context.Context, endpoint string, tlsConfig *tls.Config, timeout time.Duration) (*keyExchange, error) {
+	if timeout <= 0 {
+		timeout = defaultKeyExchangeTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cfg := tlsConfig.Clone()
+	cfg.MinVersion = tls.VersionTLS13
+	cfg.NextProtos = []string{alpnProtocol}
+	if cfg.ServerName == "" {
+		host, _, err := net.SplitHostPort(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ServerName = host
+	}
+
+	dialer := tls.Dialer{Config: cfg}
+	conn, err := dialer.DialContext(ctx, "tcp", endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the key exchange server: %w", err)
+	}
+	defer conn.Close()
+	// the context always has a deadline, bound the reads and writes of a stalled server
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	tlsConn := conn.(*tls.Conn)
+	if tlsConn.ConnectionState().NegotiatedProtocol != alpnProtocol {
+		return nil, errors.New("the key exchange server does not support NTS")
+	}
+
+	var request []byte
+	request = appendRecord(request, true, recordNextProtocol, binary.BigEndian.AppendUint16(nil, protocolNTPv4))
+	request = appendRecord(request, true, recordAEAD, binary.BigEndian.AppendUint16(nil, aeadAESSIVCMAC256))
+	request = appendRecord(request, true, recordEnd, nil)
+	if _, err = conn.Write(request); err != nil {
+		return nil, err
+	}
+
+	ke, err := readResponse(bufio.NewReader(conn))
+	if err != nil {
+		return nil, err
+	}
+
+	state := tlsConn.ConnectionState()
+	if ke.c2s, err = state.ExportKeyingMaterial(exporterLabel, exporterContext(0), keyLength); err != nil {
+		return nil, err
+	}
+	if ke.s2c, err = state.ExportKeyingMaterial(exporterLabel, exporterContext(1), keyLength); err != nil {
+		return nil, err
+	}
+	return ke, nil
+}
+
+func exporterContext(direction byte) []byte {
+	return []byte{0, protocolNTPv4, 0, aeadAESSIVCMAC256, direction}
+}
+
+func appendRecord(b []byte, critical bool, recordType uint16, body []byte) []byte {
+	if critical {
+		recordType |= criticalBit
+	}
+	b = binary.BigEndian.AppendUint16(b, recordType)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(body)))
+	return append(b, body...)
+}
+
+func readResponse(r io.Reader) (*keyExchange, error) {
+	ke := &keyExchange{}
+	var protocolAccepted, aeadAccepted bool
+	header := make([]byte, 4)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return nil, fmt.Errorf("failed to read the key exchange response: %w", err)
+		}
+		critical := binary.BigEndian.Uint16(header)&criticalBit != 0
+		recordType := binary.BigEndian.Uint16(header) &^ criticalBit
+		body := make([]byte, binary.BigEndian.Uint16(header[2:]))
+		if _, err := io.ReadFull(r, body); err != nil {
+			return nil, fmt.Errorf("failed to read the key exchange response: %w", err)
+		}
+
+		switch recordType {
+		case recordEnd:
+			switch {
+			case !protocolAccepted:
+				return nil, errors.New("the key exchange server did not accept NTPv4")
+			case !aeadAccepted:
+				return nil, errors.New("the key exchange server did not accept AEAD_AES_SIV_CMAC_256")
+			case len(ke.cookies) == 0:
+				return nil, errors.New("the key exchange server did not return any cookie")
+			}
+			return ke, nil
+		case recordNextProtocol:
+			protocolAccepted = len(body) == 2 && binary.BigEndian.Uint16(body) == protocolNTPv4
+		case recordError:
+			if len(body) != 2 {
+				return nil, errors.New("the key exchange server returned an invalid error")
+			}
+			return nil, fmt.Errorf("the key exchange server returned the error %d", binary.BigEndian.Uint16(body))
+		case recordWarning:
+			// the warnings don't prevent the use of the cookies
+		case recordAEAD:
+			aeadAccepted = len(body) == 2 && binary.BigEndian.Uint16(body) == aeadAESSIVCMAC256
+		case recordCookie:
+			ke.cookies = append(ke.cookies, body)
+		case recordServer:
+			ke.server = string(body)
+		case recordPort:
+			if len(body) != 2 {
+				return nil, errors.New("the key exchange server returned an invalid port")
+			}
+			ke.port = strconv.Itoa(int(binary.BigEndian.Uint16(body)))
+		default:
+			if critical {
+				return nil, fmt.Errorf("the key exchange server returned the unsupported critical record %d", recordType)
+			}
+		}
+	}
+}
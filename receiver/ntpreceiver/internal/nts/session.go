@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nts // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/nts"
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"net"
+	"time"
+)
+
+const (
+	fieldUniqueIdentifier = 0x0104
+	fieldCookie           = 0x0204
+	fieldAuthenticator    = 0x0404
+
+	headerLength = 48
+	nonceLength  = 16
+	uidLength    = 32
+)
+
+var errUnauthenticated = errors.New("the NTP response is not authenticated")
+
+// Session holds the keys and the cookies negotiated with an NTS key exchange server.
+// It performs a new key exchange when it runs out of cookies. A Session is not safe
+// for concurrent use.
+type Session struct {
+	keyExchangeEndpoint string
+	endpoint            string
+	tlsConfig           *tls.Config
+	timeout             time.Duration
+
+	address string
+	c2s     cipher.AEAD
+	s2c     cipher.AEAD
+	cookies [][]byte
+}
+
+// NewSession creates a session for the NTP server at the endpoint, whose keys are
+// negotiated with the key exchange server at keyExchangeEndpoint. The key exchange
+// fails if it does not complete within the timeout, or within a default timeout if zero.
+func NewSession(endpoint, keyExchangeEndpoint string, tlsConfig *tls.Config, timeout time.Duration) *Session {
+	return &Session{
+		keyExchangeEndpoint: keyExchangeEndpoint,
+		endpoint:            endpoint,
+		tlsConfig:           tlsConfig,
+		timeout:             timeout,
+	}
+}
+
+// Query returns the address of the NTP server and the extension authenticating the next
+// query, performing the key exchange if needed.
+func (s *Session) Query(ctx context.Context) (string, *Extension, error) {
+	if len(s.cookies) == 0 {
+		if err := s.exchangeKeys(ctx); err != nil {
+			return "", nil, err
+		}
+	}
+	cookie := s.cookies[0]
+	s.cookies = s.cookies[1:]
+	return s.address, &Extension{session: s, cookie: cookie}, nil
+}
+
+// Reset drops the keys and the cookies of the session, so that the next query performs
+// a new key exchange.
+func (s *Session) Reset() {
+	s.c2s = nil
+	s.s2c = nil
+	s.cookies = nil
+}
+
+func (s *Session) exchangeKeys(ctx context.Context) error {
+	ke, err := exchangeKeys(ctx, s.keyExchangeEndpoint, s.tlsConfig, s.timeout)
+	if err != nil {
+		return err
+	}
+	if s.c2s, err = newAEAD(ke.c2s); err != nil {
+		return err
+	}
+	if s.s2c, err = newAEAD(ke.s2c); err != nil {
+		return err
+	}
+
+	host, port, err := net.SplitHostPort(s.endpoint)
+	if err != nil {
+		return err
+	}
+	if ke.server != "" {
+		host = ke.server
+	}
+	if ke.port != "" {
+		port = ke.port
+	}
+	s.address = net.JoinHostPort(host, port)
+	s.cookies = ke.cookies
+	return nil
+}
+
+// Extension authenticates an NTP query and its response with NTS extension fields.
+// It implements the extension interface of github.com/beevik/ntp.
+type Extension struct {
+	session *Session
+	cookie  []byte
+	uid     []byte
+}
+
+// ProcessQuery appends the unique identifier, the cookie and the authenticator
+// extension fields to the query.
+func (e *Extension) ProcessQuery(buf *bytes.Buffer) error {
+	e.uid = make([]byte, uidLength)
+	if _, err := rand.Read(e.uid); err != nil {
+		return err
+	}
+	nonce := make([]byte, nonceLength)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	writeField(buf, fieldUniqueIdentifier, e.uid)
+	writeField(buf, fieldCookie, e.cookie)
+
+	ciphertext := e.session.c2s.Seal(nil, nonce, nil, buf.Bytes())
+	body := binary.BigEndian.AppendUint16(nil, nonceLength)
+	body = binary.BigEndian.AppendUint16(body, uint16(len(ciphertext)))
+	body = append(body, nonce...)
+	body = append(body, ciphertext...)
+	writeField(buf, fieldAuthenticator, body)
+	return nil
+}
+
+// ProcessResponse authenticates the response and stores the cookies it contains in the session.
+func (e *Extension) ProcessResponse(buf []byte) error {
+	var uidMatches bool
+	for offset := headerLength; offset+4 <= len(buf); {
+		fieldType := binary.BigEndian.Uint16(buf[offset:])
+		length := int(binary.BigEndian.Uint16(buf[offset+2:]))
+		if length < 4 || length%4 != 0 || offset+length > len(buf) {
+			return errUnauthenticated
+		}
+		body := buf[offset+4 : offset+length]
+
+		switch fieldType {
+		case fieldUniqueIdentifier:
+			uidMatches = subtle.ConstantTimeCompare(body, e.uid) == 1
+		case fieldAuthenticator:
+			if !uidMatches {
+				return errUnauthenticated
+			}
+			plaintext, err := e.open(buf[:offset], body)
+			if err != nil {
+				return err
+			}
+			e.storeCookies(plaintext)
+			// the fields following the authenticator are not authenticated
+			return nil
+		}
+		offset += length
+	}
+	return errUnauthenticated
+}
+
+func (e *Extension) open(additionalData, body []byte) ([]byte, error) {
+	if len(body) < 4 {
+		return nil, errUnauthenticated
+	}
+	nonceLen := int(binary.BigEndian.Uint16(body))
+	ciphertextLen := int(binary.BigEndian.Uint16(body[2:]))
+	nonceEnd := 4 + padded(nonceLen)
+	if nonceEnd+ciphertextLen > len(body) {
+		return nil, errUnauthenticated
+	}
+	nonce := body[4 : 4+nonceLen]
+	ciphertext := body[nonceEnd : nonceEnd+ciphertextLen]
+	plaintext, err := e.session.s2c.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, errUnauthenticated
+	}
+	return plaintext, nil
+}
+
+func (e *Extension) storeCookies(fields []byte) {
+	for offset := 0; offset+4 <= len(fields); {
+		fieldType := binary.BigEndian.Uint16(fields[offset:])
+		length := int(binary.BigEndian.Uint16(fields[offset+2:]))
+		if length < 4 || offset+length > len(fields) {
+			return
+		}
+		if fieldType == fieldCookie {
+			cookie := make([]byte, length-4)
+			copy(cookie, fields[offset+4:offset+length])
+			e.session.cookies = append(e.session.cookies, cookie)
+		}
+		offset += length
+	}
+}
+
+// writeField writes an NTP extension field, padded to a multiple of 4 bytes and to
+// the minimum length of 16 bytes.
+func writeField(buf *bytes.Buffer, fieldType uint16, body []byte) {
+	length := max(4+padded(len(body)), 16)
+	field := make([]byte, length)
+	binary.BigEndian.PutUint16(field, fieldType)
+	binary.BigEndian.PutUint16(field[2:], uint16(length))
+	copy(field[4:], body)
+	buf.Write(field)
+}
+
+func padded(length int) int {
+	return (length + 3) &^ 3
+}
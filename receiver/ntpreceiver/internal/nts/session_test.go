@@ -0,0 +1,232 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nts
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keyExchangeServer is a minimal NTS key exchange server.
+type keyExchangeServer struct {
+	listener net.Listener
+	records  []byte
+	keys     chan [2][]byte
+}
+
+func newKeyExchangeServer(t *testing.T, records []byte) (*keyExchangeServer, *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	listener, err := tls.Listen("tcp", "localhost:0", &tls.Config{
+		MinVersion:   tls.VersionTLS13,
+		NextProtos:   []string{alpnProtocol},
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	})
+	require.NoError(t, err)
+	s := &keyExchangeServer{listener: listener, records: records, keys: make(chan [2][]byte, 1)}
+	go s.serve()
+	t.Cleanup(func() { _ = listener.Close() })
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return s, &tls.Config{RootCAs: pool}
+}
+
+func (s *keyExchangeServer) serve() {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	// the request holds the next protocol, AEAD algorithm and end records
+	if _, err = io.ReadFull(conn, make([]byte, 16)); err != nil {
+		return
+	}
+	if _, err = conn.Write(s.records); err != nil {
+		return
+	}
+	state := conn.(*tls.Conn).ConnectionState()
+	c2s, _ := state.ExportKeyingMaterial(exporterLabel, exporterContext(0), 32)
+	s2c, _ := state.ExportKeyingMaterial(exporterLabel, exporterContext(1), 32)
+	s.keys <- [2][]byte{c2s, s2c}
+}
+
+func acceptedRecords(cookies ...string) []byte {
+	var records []byte
+	records = appendRecord(records, true, recordNextProtocol, []byte{0, protocolNTPv4})
+	records = appendRecord(records, false, recordAEAD, []byte{0, aeadAESSIVCMAC256})
+	for _, cookie := range cookies {
+		records = appendRecord(records, false, recordCookie, []byte(cookie))
+	}
+	return records
+}
+
+func TestSessionQuery(t *testing.T) {
+	records := acceptedRecords("cookie-1")
+	records = appendRecord(records, false, recordPort, []byte{0x30, 0x39})
+	records = appendRecord(records, true, recordEnd, nil)
+	server, tlsConfig := newKeyExchangeServer(t, records)
+
+	session := NewSession("localhost:123", server.listener.Addr().String(), tlsConfig, 0)
+	address, ext, err := session.Query(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:12345", address)
+	assert.Equal(t, []byte("cookie-1"), ext.cookie)
+	assert.Empty(t, session.cookies)
+
+	keys := <-server.keys
+	c2s, err := newAEAD(keys[0])
+	require.NoError(t, err)
+	s2c, err := newAEAD(keys[1])
+	require.NoError(t, err)
+
+	// the query carries the unique identifier, the cookie and the authenticator
+	var query bytes.Buffer
+	query.Write(make([]byte, headerLength))
+	require.NoError(t, ext.ProcessQuery(&query))
+	fields := parseFields(t, query.Bytes()[headerLength:])
+	require.Len(t, fields, 3)
+	assert.Equal(t, ext.uid, fields[0].body)
+	assert.Equal(t, []byte("cookie-1"), fields[1].body[:8])
+	nonce, ciphertext := parseAuthenticator(t, fields[2].body)
+	_, err = c2s.Open(nil, nonce, ciphertext, query.Bytes()[:fields[2].offset+headerLength])
+	require.NoError(t, err)
+
+	// the response returns a new cookie in the encrypted extension fields
+	response := bytes.NewBuffer(make([]byte, headerLength))
+	writeField(response, fieldUniqueIdentifier, ext.uid)
+	var encrypted bytes.Buffer
+	writeField(&encrypted, fieldCookie, []byte("cookie-2"))
+	responseNonce := bytes.Repeat([]byte{1}, nonceLength)
+	sealed := s2c.Seal(nil, responseNonce, encrypted.Bytes(), response.Bytes())
+	body := binary.BigEndian.AppendUint16(nil, nonceLength)
+	body = binary.BigEndian.AppendUint16(body, uint16(len(sealed)))
+	body = append(body, responseNonce...)
+	body = append(body, sealed...)
+	writeField(response, fieldAuthenticator, body)
+
+	require.NoError(t, ext.ProcessResponse(response.Bytes()))
+	require.Len(t, session.cookies, 1)
+	assert.Equal(t, []byte("cookie-2"), session.cookies[0][:8])
+
+	// a tampered response is rejected
+	tampered := bytes.Clone(response.Bytes())
+	tampered[0] ^= 1
+	require.ErrorIs(t, ext.ProcessResponse(tampered), errUnauthenticated)
+
+	// a response without authenticator is rejected
+	require.ErrorIs(t, ext.ProcessResponse(response.Bytes()[:headerLength+36]), errUnauthenticated)
+
+	session.Reset()
+	assert.Empty(t, session.cookies)
+}
+
+func TestSessionKeyExchangeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []byte
+		err     string
+	}{
+		{
+			name:    "error record",
+			records: appendRecord(appendRecord(nil, true, recordError, []byte{0, 1}), true, recordEnd, nil),
+			err:     "the key exchange server returned the error 1",
+		},
+		{
+			name:    "no cookie",
+			records: appendRecord(acceptedRecords(), true, recordEnd, nil),
+			err:     "the key exchange server did not return any cookie",
+		},
+		{
+			name: "unsupported AEAD",
+			records: appendRecord(
+				appendRecord(appendRecord(nil, true, recordNextProtocol, []byte{0, protocolNTPv4}), true, recordCookie, []byte("cookie")),
+				true, recordEnd, nil),
+			err: "the key exchange server did not accept AEAD_AES_SIV_CMAC_256",
+		},
+		{
+			name:    "unsupported critical record",
+			records: appendRecord(acceptedRecords("cookie"), true, 0x4000, nil),
+			err:     "the key exchange server returned the unsupported critical record 16384",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, tlsConfig := newKeyExchangeServer(t, tt.records)
+			session := NewSession("localhost:123", server.listener.Addr().String(), tlsConfig, 0)
+			_, _, err := session.Query(t.Context())
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestSessionKeyExchangeTimeout(t *testing.T) {
+	// the server accepts the connection but never answers
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		t.Cleanup(func() { _ = conn.Close() })
+	}()
+
+	session := NewSession("localhost:123", listener.Addr().String(), &tls.Config{}, 100*time.Millisecond)
+	start := time.Now()
+	_, _, err = session.Query(t.Context())
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+type field struct {
+	offset int
+	body   []byte
+}
+
+func parseFields(t *testing.T, b []byte) []field {
+	var fields []field
+	for offset := 0; offset < len(b); {
+		length := int(binary.BigEndian.Uint16(b[offset+2:]))
+		require.Zero(t, length%4)
+		fields = append(fields, field{offset: offset, body: b[offset+4 : offset+length]})
+		offset += length
+	}
+	return fields
+}
+
+func parseAuthenticator(t *testing.T, body []byte) (nonce, ciphertext []byte) {
+	nonceLen := int(binary.BigEndian.Uint16(body))
+	ciphertextLen := int(binary.BigEndian.Uint16(body[2:]))
+	require.Equal(t, nonceLength, nonceLen)
+	return body[4 : 4+nonceLen], body[4+nonceLen : 4+nonceLen+ciphertextLen]
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nts // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/nts"
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+)
+
+var errOpen = errors.New("message authentication failed")
+
+// aesSIV implements AES-SIV (RFC 5297) with the AES block cipher of the standard library.
+// NTS authenticates the nonce as the last component of the associated data (RFC 8915),
+// which the deterministic AES-SIV AEADs of the maintained Go libraries do not support.
+type aesSIV struct {
+	// mac is keyed with the first half of the key, and used by S2V.
+	mac    cipher.Block
+	k1, k2 [aes.BlockSize]byte
+	// ctr is keyed with the second half of the key, and used for the encryption.
+	ctr cipher.Block
+}
+
+// newAEAD returns the AEAD_AES_SIV_CMAC_256 cipher for the key. The nonces are of variable length,
+// and are authenticated as the last associated data as required by RFC 8915.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 && len(key) != 48 && len(key) != 64 {
+		return nil, fmt.Errorf("invalid AES-SIV key length %d", len(key))
+	}
+	mac, err := aes.NewCipher(key[:len(key)/2])
+	if err != nil {
+		return nil, err
+	}
+	ctr, err := aes.NewCipher(key[len(key)/2:])
+	if err != nil {
+		return nil, err
+	}
+
+	// the CMAC subkeys (RFC 4493)
+	s := &aesSIV{mac: mac, ctr: ctr}
+	mac.Encrypt(s.k1[:], s.k1[:])
+	dbl(&s.k1)
+	s.k2 = s.k1
+	dbl(&s.k2)
+	return s, nil
+}
+
+// NonceSize returns the length of the nonces generated by the client, Seal and Open accept
+// nonces of any length.
+func (*aesSIV) NonceSize() int {
+	return nonceLength
+}
+
+func (*aesSIV) Overhead() int {
+	return aes.BlockSize
+}
+
+func (s *aesSIV) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	return s.seal(dst, plaintext, associatedData(nonce, additionalData)...)
+}
+
+func (s *aesSIV) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	return s.open(dst, ciphertext, associatedData(nonce, additionalData)...)
+}
+
+// associatedData returns the associated data, when present, followed by the nonce.
+func associatedData(nonce, additionalData []byte) [][]byte {
+	if additionalData == nil {
+		return [][]byte{nonce}
+	}
+	return [][]byte{additionalData, nonce}
+}
+
+// seal appends the synthetic IV followed by the encrypted plaintext to dst.
+func (s *aesSIV) seal(dst, plaintext []byte, associatedData ...[]byte) []byte {
+	v := s.s2v(associatedData, plaintext)
+	out := append(dst, v[:]...)
+	start := len(out)
+	out = append(out, plaintext...)
+	s.xorKeyStream(out[start:], out[start:], v)
+	return out
+}
+
+// open decrypts and authenticates the ciphertext, and appends the plaintext to dst.
+func (s *aesSIV) open(dst, ciphertext []byte, associatedData ...[]byte) ([]byte, error) {
+	if len(ciphertext) < aes.BlockSize {
+		return nil, errOpen
+	}
+	var v [aes.BlockSize]byte
+	copy(v[:], ciphertext)
+	plaintext := make([]byte, len(ciphertext)-aes.BlockSize)
+	s.xorKeyStream(plaintext, ciphertext[aes.BlockSize:], v)
+
+	t := s.s2v(associatedData, plaintext)
+	if subtle.ConstantTimeCompare(t[:], v[:]) != 1 {
+		clear(plaintext)
+		return nil, errOpen
+	}
+	return append(dst, plaintext...), nil
+}
+
+// xorKeyStream encrypts or decrypts src in AES-CTR mode, with the synthetic IV as the counter
+// after clearing its 31st and 63rd bits.
+func (s *aesSIV) xorKeyStream(dst, src []byte, v [aes.BlockSize]byte) {
+	v[8] &= 0x7f
+	v[12] &= 0x7f
+	cipher.NewCTR(s.ctr, v[:]).XORKeyStream(dst, src)
+}
+
+// s2v derives the synthetic IV from the associated data and the plaintext.
+func (s *aesSIV) s2v(associatedData [][]byte, plaintext []byte) [aes.BlockSize]byte {
+	var zero [aes.BlockSize]byte
+	d := s.cmac(zero[:])
+	for _, data := range associatedData {
+		dbl(&d)
+		mac := s.cmac(data)
+		subtle.XORBytes(d[:], d[:], mac[:])
+	}
+
+	if len(plaintext) >= aes.BlockSize {
+		t := make([]byte, len(plaintext))
+		copy(t, plaintext)
+		end := t[len(t)-aes.BlockSize:]
+		subtle.XORBytes(end, end, d[:])
+		return s.cmac(t)
+	}
+
+	dbl(&d)
+	var padded [aes.BlockSize]byte
+	copy(padded[:], plaintext)
+	padded[len(plaintext)] = 0x80
+	subtle.XORBytes(d[:], d[:], padded[:])
+	return s.cmac(d[:])
+}
+
+// cmac returns the AES-CMAC (RFC 4493) of the message.
+func (s *aesSIV) cmac(msg []byte) [aes.BlockSize]byte {
+	var x [aes.BlockSize]byte
+	for len(msg) > aes.BlockSize {
+		subtle.XORBytes(x[:], x[:], msg[:aes.BlockSize])
+		s.mac.Encrypt(x[:], x[:])
+		msg = msg[aes.BlockSize:]
+	}
+
+	var last [aes.BlockSize]byte
+	copy(last[:], msg)
+	if len(msg) == aes.BlockSize {
+		subtle.XORBytes(last[:], last[:], s.k1[:])
+	} else {
+		last[len(msg)] = 0x80
+		subtle.XORBytes(last[:], last[:], s.k2[:])
+	}
+	subtle.XORBytes(x[:], x[:], last[:])
+	s.mac.Encrypt(x[:], x[:])
+	return x
+}
+
+// dbl multiplies the block by x in GF(2^128).
+func dbl(b *[aes.BlockSize]byte) {
+	carry := b[0] >> 7
+	for i := 0; i < aes.BlockSize-1; i++ {
+		b[i] = b[i]<<1 | b[i+1]>>7
+	}
+	b[aes.BlockSize-1] = b[aes.BlockSize-1]<<1 ^ carry*0x87
+}
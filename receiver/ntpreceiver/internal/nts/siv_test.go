@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nts
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(strings.ReplaceAll(s, " ", ""))
+	require.NoError(t, err)
+	return b
+}
+
+// TestAESSIVVectors uses the test vectors of RFC 5297, appendix A.
+func TestAESSIVVectors(t *testing.T) {
+	tests := []struct {
+		name           string
+		key            string
+		associatedData []string
+		plaintext      string
+		ciphertext     string
+	}{
+		{
+			name:           "deterministic authenticated encryption",
+			key:            "fffefdfc fbfaf9f8 f7f6f5f4 f3f2f1f0 f0f1f2f3 f4f5f6f7 f8f9fafb fcfdfeff",
+			associatedData: []string{"10111213 14151617 18191a1b 1c1d1e1f 20212223 24252627"},
+			plaintext:      "11223344 55667788 99aabbcc ddee",
+			ciphertext:     "85632d07 c6e8f37f 950acd32 0a2ecc93 40c02b96 90c4dc04 daef7f6a fe5c",
+		},
+		{
+			name: "nonce-based authenticated encryption",
+			key:  "7f7e7d7c 7b7a7978 77767574 73727170 40414243 44454647 48494a4b 4c4d4e4f",
+			associatedData: []string{
+				"00112233 44556677 8899aabb ccddeeff deaddada deaddada ffeeddcc bbaa9988 77665544 33221100",
+				"10203040 50607080 90a0",
+				"09f91102 9d74e35b d84156c5 635688c0",
+			},
+			plaintext: "74686973 20697320 736f6d65 20706c61 696e7465 78742074 6f20656e 63727970 74207573 696e6720 5349562d 414553",
+			ciphertext: "7bdb6e3b 432667eb 06f4d14b ff2fbd0f cb900f2f ddbe4043 26601965 c889bf17 dba77ceb 094fa663 b7a3f748 " +
+				"ba8af829 ea64ad54 4a272e9c 485b62a3 fd5c0d",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aead, err := newAEAD(decodeHex(t, tt.key))
+			require.NoError(t, err)
+			s := aead.(*aesSIV)
+
+			var associatedData [][]byte
+			for _, data := range tt.associatedData {
+				associatedData = append(associatedData, decodeHex(t, data))
+			}
+			plaintext := decodeHex(t, tt.plaintext)
+			ciphertext := decodeHex(t, tt.ciphertext)
+
+			assert.Equal(t, ciphertext, s.seal(nil, plaintext, associatedData...))
+			opened, err := s.open(nil, ciphertext, associatedData...)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, opened)
+		})
+	}
+}
+
+func TestAESSIVOpenUnauthenticated(t *testing.T) {
+	aead, err := newAEAD(make([]byte, 32))
+	require.NoError(t, err)
+	nonce := make([]byte, nonceLength)
+	sealed := aead.Seal(nil, nonce, []byte("plaintext"), []byte("associated data"))
+
+	_, err = aead.Open(nil, nonce, sealed, []byte("other data"))
+	assert.ErrorIs(t, err, errOpen)
+
+	_, err = aead.Open(nil, []byte("other nonce"), sealed, []byte("associated data"))
+	assert.ErrorIs(t, err, errOpen)
+
+	sealed[len(sealed)-1] ^= 1
+	_, err = aead.Open(nil, nonce, sealed, []byte("associated data"))
+	assert.ErrorIs(t, err, errOpen)
+
+	_, err = aead.Open(nil, nonce, sealed[:aead.Overhead()-1], nil)
+	assert.ErrorIs(t, err, errOpen)
+
+	_, err = newAEAD(make([]byte, 16))
+	assert.ErrorContains(t, err, "invalid AES-SIV key length 16")
+}
@@ -5,48 +5,154 @@ package ntpreceiver // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/beevik/ntp"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/nts"
 )
 
 var queryWithOptions = ntp.QueryWithOptions
 
-type ntpScraper struct {
-	logger   *zap.Logger
-	mb       *metadata.MetricsBuilder
-	version  int
-	timeout  time.Duration
+// ntpServer is an NTP server queried by the scraper.
+type ntpServer struct {
 	endpoint string
+	// session authenticates the queries with NTS, nil if NTS is disabled.
+	session *nts.Session
+}
+
+type ntpScraper struct {
+	logger  *zap.Logger
+	mb      *metadata.MetricsBuilder
+	version int
+	timeout time.Duration
+	configs []ServerConfig
+	servers []*ntpServer
+}
+
+func (s *ntpScraper) start(ctx context.Context, _ component.Host) error {
+	s.servers = make([]*ntpServer, 0, len(s.configs))
+	for _, cfg := range s.configs {
+		server := &ntpServer{endpoint: cfg.Endpoint}
+		if cfg.NTS.Enabled {
+			tlsConfig, err := cfg.NTS.TLS.LoadTLSConfig(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to load the NTS TLS configuration of %s: %w", cfg.Endpoint, err)
+			}
+			server.session = nts.NewSession(cfg.Endpoint, cfg.NTS.keyExchangeEndpoint(cfg.Endpoint), tlsConfig, s.timeout)
+		}
+		s.servers = append(s.servers, server)
+	}
+	return nil
+}
+
+func (s *ntpScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	responses := make([]*ntp.Response, len(s.servers))
+	errs := make([]error, len(s.servers))
+	var wg sync.WaitGroup
+	for i, server := range s.servers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[i], errs[i] = s.query(ctx, server)
+		}()
+	}
+	wg.Wait()
+
+	offsets := make([]int64, 0, len(s.servers))
+	for _, response := range responses {
+		if response != nil {
+			offsets = append(offsets, response.ClockOffset.Nanoseconds())
+		}
+	}
+	if len(offsets) == 0 {
+		return pmetric.NewMetrics(), errors.Join(errs...)
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	median := medianOffset(offsets)
+	for i, response := range responses {
+		if response == nil {
+			continue
+		}
+		offset := response.ClockOffset.Nanoseconds()
+		s.mb.RecordNtpOffsetDataPoint(now, offset)
+		if len(offsets) > 1 {
+			s.mb.RecordNtpOffsetDeviationDataPoint(now, offset-median)
+		}
+
+		rb := s.mb.NewResourceBuilder()
+		rb.SetNtpHost(s.servers[i].endpoint)
+		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+	if len(offsets) > 1 {
+		s.mb.RecordNtpOffsetDivergenceDataPoint(now, slices.Max(offsets)-slices.Min(offsets))
+		s.mb.EmitForResource()
+	}
+
+	metrics := s.mb.Emit()
+	if failed := len(s.servers) - len(offsets); failed > 0 {
+		return metrics, scrapererror.NewPartialScrapeError(errors.Join(errs...), failed)
+	}
+	return metrics, nil
 }
 
-func (s *ntpScraper) scrape(context.Context) (pmetric.Metrics, error) {
+func (s *ntpScraper) query(ctx context.Context, server *ntpServer) (*ntp.Response, error) {
 	options := ntp.QueryOptions{Version: s.version, Timeout: s.timeout}
-	response, err := queryWithOptions(s.endpoint, options)
-	if err != nil {
-		return pmetric.NewMetrics(), err
+	address := server.endpoint
+	if server.session != nil {
+		if s.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, s.timeout)
+			defer cancel()
+		}
+		var ext *nts.Extension
+		var err error
+		if address, ext, err = server.session.Query(ctx); err != nil {
+			return nil, fmt.Errorf("NTS key exchange for %s failed: %w", server.endpoint, err)
+		}
+		options.Extensions = []ntp.Extension{ext}
 	}
-	s.mb.RecordNtpOffsetDataPoint(pcommon.NewTimestampFromTime(time.Now()), response.ClockOffset.Nanoseconds())
 
-	rb := s.mb.NewResourceBuilder()
-	rb.SetNtpHost(s.endpoint)
+	response, err := queryWithOptions(address, options)
+	if err != nil {
+		if server.session != nil {
+			// the server may have rotated its keys, start over with a new key exchange
+			server.session.Reset()
+		}
+		return nil, fmt.Errorf("failed to query %s: %w", server.endpoint, err)
+	}
+	return response, nil
+}
 
-	s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
-	return s.mb.Emit(), nil
+// medianOffset returns the median of the offsets.
+func medianOffset(offsets []int64) int64 {
+	sorted := slices.Clone(offsets)
+	slices.Sort(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return sorted[middle-1] + (sorted[middle]-sorted[middle-1])/2
+	}
+	return sorted[middle]
 }
 
 func newScraper(cfg *Config, settings receiver.Settings) *ntpScraper {
 	return &ntpScraper{
-		logger:   settings.Logger,
-		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
-		version:  cfg.Version,
-		timeout:  cfg.Timeout,
-		endpoint: cfg.Endpoint,
+		logger:  settings.Logger,
+		mb:      metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		version: cfg.Version,
+		timeout: cfg.Timeout,
+		configs: cfg.servers(),
 	}
 }
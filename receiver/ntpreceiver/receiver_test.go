@@ -5,13 +5,16 @@ package ntpreceiver
 
 import (
 	"errors"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/beevik/ntp"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/metadata"
 )
@@ -20,11 +23,11 @@ import (
 func newTestScraper(cfg *Config) *ntpScraper {
 	settings := receivertest.NewNopSettings(metadata.Type)
 	return &ntpScraper{
-		logger:   settings.Logger,
-		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
-		version:  cfg.Version,
-		endpoint: cfg.Endpoint,
-		timeout:  5 * time.Second, // fixed timeout for tests
+		logger:  settings.Logger,
+		mb:      metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		version: cfg.Version,
+		servers: []*ntpServer{{endpoint: cfg.Endpoint}},
+		timeout: 5 * time.Second, // fixed timeout for tests
 	}
 }
 
@@ -109,3 +112,90 @@ func TestScraper_Error(t *testing.T) {
 	require.NotNil(t, metrics)
 	require.Equal(t, 0, metrics.ResourceMetrics().Len(), "expected no metrics on error")
 }
+
+func TestScraper_MultipleServers(t *testing.T) {
+	old := queryWithOptions
+	defer func() { queryWithOptions = old }()
+	offsets := map[string]time.Duration{
+		"a.example.com:123": 10 * time.Millisecond,
+		"b.example.com:123": 20 * time.Millisecond,
+		"c.example.com:123": 40 * time.Millisecond,
+	}
+	queryWithOptions = func(address string, _ ntp.QueryOptions) (*ntp.Response, error) {
+		offset, ok := offsets[address]
+		if !ok {
+			return nil, errors.New("mock failure")
+		}
+		return &ntp.Response{ClockOffset: offset}, nil
+	}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "a.example.com:123"
+	cfg.Servers = []ServerConfig{
+		{Endpoint: "b.example.com:123"},
+		{Endpoint: "c.example.com:123"},
+		{Endpoint: "d.example.com:123"},
+	}
+	cfg.Metrics.NtpOffsetDeviation.Enabled = true
+	cfg.Metrics.NtpOffsetDivergence.Enabled = true
+
+	s := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, s.start(t.Context(), componenttest.NewNopHost()))
+	metrics, err := s.scrape(t.Context())
+	require.ErrorContains(t, err, "failed to query d.example.com:123: mock failure")
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+
+	type point struct {
+		host  string
+		name  string
+		value int64
+	}
+	var actual []point
+	for _, rm := range metrics.ResourceMetrics().All() {
+		host, _ := rm.Resource().Attributes().Get("ntp.host")
+		for _, m := range rm.ScopeMetrics().At(0).Metrics().All() {
+			actual = append(actual, point{host: host.Str(), name: m.Name(), value: m.Gauge().DataPoints().At(0).IntValue()})
+		}
+	}
+	require.ElementsMatch(t, []point{
+		{host: "a.example.com:123", name: "ntp.offset", value: 10_000_000},
+		{host: "a.example.com:123", name: "ntp.offset.deviation", value: -10_000_000},
+		{host: "b.example.com:123", name: "ntp.offset", value: 20_000_000},
+		{host: "b.example.com:123", name: "ntp.offset.deviation", value: 0},
+		{host: "c.example.com:123", name: "ntp.offset", value: 40_000_000},
+		{host: "c.example.com:123", name: "ntp.offset.deviation", value: 20_000_000},
+		{host: "", name: "ntp.offset.divergence", value: 30_000_000},
+	}, actual)
+}
+
+func TestScraper_NTSKeyExchangeError(t *testing.T) {
+	old := queryWithOptions
+	defer func() { queryWithOptions = old }()
+	queryWithOptions = func(string, ntp.QueryOptions) (*ntp.Response, error) {
+		return &ntp.Response{ClockOffset: time.Millisecond}, nil
+	}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:123"
+	cfg.NTS.Enabled = true
+	// nothing listens on the key exchange endpoint
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	cfg.NTS.KeyExchangeEndpoint = l.Addr().String()
+	require.NoError(t, l.Close())
+	cfg.Servers = []ServerConfig{{Endpoint: "b.example.com:123"}}
+
+	s := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, s.start(t.Context(), componenttest.NewNopHost()))
+	metrics, err := s.scrape(t.Context())
+	require.ErrorContains(t, err, "NTS key exchange for localhost:123 failed")
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+}
+
+func TestMedianOffset(t *testing.T) {
+	require.Equal(t, int64(5), medianOffset([]int64{5}))
+	require.Equal(t, int64(2), medianOffset([]int64{3, 1}))
+	require.Equal(t, int64(3), medianOffset([]int64{9, 1, 3}))
+	require.Equal(t, int64(-2), medianOffset([]int64{-5, 1, -3, 0}))
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oracledbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	// activeSessionHistorySQL counts the samples of the active sessions by wait class and SQL ID.
+	// The sessions on CPU have no wait class, they are reported with the wait class CPU.
+	activeSessionHistorySQL = `
+		SELECT DECODE(SESSION_STATE, 'ON CPU', 'CPU', WAIT_CLASS) AS WAIT_CLASS, SQL_ID, COUNT(*) AS SAMPLES
+		FROM V$ACTIVE_SESSION_HISTORY
+		WHERE SAMPLE_TIME > SYSTIMESTAMP - NUMTODSINTERVAL(:1, 'SECOND')
+		GROUP BY DECODE(SESSION_STATE, 'ON CPU', 'CPU', WAIT_CLASS), SQL_ID`
+
+	waitClassAttr = "WAIT_CLASS"
+	samplesAttr   = "SAMPLES"
+)
+
+type activeSessionSamples struct {
+	sqlID     string
+	waitClass string
+	samples   int64
+}
+
+// scrapeActiveSessionHistory records the average number of active sessions over the collection interval.
+// v$active_session_history samples the active sessions every second, so the average number of active
+// sessions is the number of samples divided by the number of seconds of the interval.
+func (s *oracleScraper) scrapeActiveSessionHistory(ctx context.Context) error {
+	intervalSeconds := int(math.Max(1, math.Ceil(s.scrapeCfg.CollectionInterval.Seconds())))
+	rows, err := s.activeSessionHistoryClient.metricRows(ctx, intervalSeconds)
+	if err != nil {
+		return fmt.Errorf("error executing %s: %w", activeSessionHistorySQL, err)
+	}
+
+	var samples []activeSessionSamples
+	samplesByWaitClass := map[string]int64{}
+	samplesBySQLID := map[string]int64{}
+	for _, row := range rows {
+		count, err := strconv.ParseInt(row[samplesAttr], 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse int64 for active session samples, value was %s: %w", row[samplesAttr], err)
+		}
+		sample := activeSessionSamples{
+			sqlID:     row[sqlIDAttr],
+			waitClass: row[waitClassAttr],
+			samples:   count,
+		}
+		samples = append(samples, sample)
+		samplesByWaitClass[sample.waitClass] += count
+		if sample.sqlID != "" {
+			samplesBySQLID[sample.sqlID] += count
+		}
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for waitClass, count := range samplesByWaitClass {
+		s.mb.RecordOracledbActiveSessionsDataPoint(now, float64(count)/float64(intervalSeconds), waitClass)
+	}
+
+	// Only the SQL IDs with the most samples are reported to limit the cardinality
+	topSQLIDs := make([]string, 0, len(samplesBySQLID))
+	for sqlID := range samplesBySQLID {
+		topSQLIDs = append(topSQLIDs, sqlID)
+	}
+	sort.Slice(topSQLIDs, func(i, j int) bool {
+		if samplesBySQLID[topSQLIDs[i]] != samplesBySQLID[topSQLIDs[j]] {
+			return samplesBySQLID[topSQLIDs[i]] > samplesBySQLID[topSQLIDs[j]]
+		}
+		return topSQLIDs[i] < topSQLIDs[j]
+	})
+	if len(topSQLIDs) > int(s.activeSessionHistoryCfg.TopSQLCount) {
+		topSQLIDs = topSQLIDs[:s.activeSessionHistoryCfg.TopSQLCount]
+	}
+	isTopSQLID := make(map[string]bool, len(topSQLIDs))
+	for _, sqlID := range topSQLIDs {
+		isTopSQLID[sqlID] = true
+	}
+	for _, sample := range samples {
+		if isTopSQLID[sample.sqlID] {
+			s.mb.RecordOracledbActiveSessionsTopSQLDataPoint(now, float64(sample.samples)/float64(intervalSeconds), sample.sqlID, sample.waitClass)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oracledbreceiver
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver/internal/metadata"
+)
+
+func newActiveSessionHistoryScraper(t *testing.T, rows []metricRow) *oracleScraper {
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.Metrics.OracledbActiveSessions.Enabled = true
+	cfg.Metrics.OracledbActiveSessionsTopSQL.Enabled = true
+	scrapeCfg := scraperhelper.NewDefaultControllerConfig()
+	scrapeCfg.CollectionInterval = 10 * time.Second
+
+	scrpr := &oracleScraper{
+		logger: zap.NewNop(),
+		mb:     metadata.NewMetricsBuilder(cfg, receivertest.NewNopSettings(metadata.Type)),
+		dbProviderFunc: func() (*sql.DB, error) {
+			return nil, nil
+		},
+		clientProviderFunc: func(*sql.DB, string, *zap.Logger) dbClient {
+			return &fakeDbClient{Responses: [][]metricRow{rows}}
+		},
+		metricsBuilderConfig:    cfg,
+		scrapeCfg:               scrapeCfg,
+		activeSessionHistoryCfg: ActiveSessionHistory{TopSQLCount: 2},
+	}
+	require.NoError(t, scrpr.start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, scrpr.shutdown(t.Context()))
+	})
+	return scrpr
+}
+
+func TestScrapeActiveSessionHistory(t *testing.T) {
+	scrpr := newActiveSessionHistoryScraper(t, []metricRow{
+		{"WAIT_CLASS": "CPU", "SQL_ID": "sql1", "SAMPLES": "20"},
+		{"WAIT_CLASS": "User I/O", "SQL_ID": "sql1", "SAMPLES": "5"},
+		{"WAIT_CLASS": "CPU", "SQL_ID": "sql2", "SAMPLES": "10"},
+		{"WAIT_CLASS": "Concurrency", "SQL_ID": "sql3", "SAMPLES": "3"},
+		{"WAIT_CLASS": "System I/O", "SQL_ID": "", "SAMPLES": "30"},
+	})
+
+	require.NoError(t, scrpr.scrapeActiveSessionHistory(t.Context()))
+	m := scrpr.mb.Emit()
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	activeSessions := map[string]float64{}
+	topSQL := map[string]float64{}
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		dps := metric.Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			waitClass, _ := dp.Attributes().Get("oracledb.wait_class")
+			switch metric.Name() {
+			case "oracledb.active_sessions":
+				activeSessions[waitClass.Str()] = dp.DoubleValue()
+			case "oracledb.active_sessions.top_sql":
+				sqlID, _ := dp.Attributes().Get("oracledb.sql_id")
+				topSQL[sqlID.Str()+"/"+waitClass.Str()] = dp.DoubleValue()
+			}
+		}
+	}
+
+	assert.Equal(t, map[string]float64{
+		"CPU":         3,
+		"User I/O":    0.5,
+		"Concurrency": 0.3,
+		"System I/O":  3,
+	}, activeSessions)
+	// sql3 is not reported since only the top 2 SQL IDs are kept
+	assert.Equal(t, map[string]float64{
+		"sql1/CPU":      2,
+		"sql1/User I/O": 0.5,
+		"sql2/CPU":      1,
+	}, topSQL)
+}
+
+func TestScrapeActiveSessionHistoryBadSamples(t *testing.T) {
+	scrpr := newActiveSessionHistoryScraper(t, []metricRow{
+		{"WAIT_CLASS": "CPU", "SQL_ID": "sql1", "SAMPLES": "many"},
+	})
+
+	err := scrpr.scrapeActiveSessionHistory(t.Context())
+	require.ErrorContains(t, err, "failed to parse int64 for active session samples, value was many")
+	assert.Equal(t, 0, scrpr.mb.Emit().MetricCount())
+}
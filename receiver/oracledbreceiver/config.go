@@ -27,6 +27,7 @@ var (
 	errEmptyUsername       = errors.New("username must be set")
 	errMaxQuerySampleCount = errors.New("`max_query_sample_count` must be between 1 and 10000")
 	errTopQueryCount       = errors.New("`top_query_count` must be between 1 and 200 and less than or equal to `max_query_sample_count`")
+	errTopSQLCount         = errors.New("`active_session_history.top_sql_count` must be between 1 and 100")
 )
 
 type TopQueryCollection struct {
@@ -42,6 +43,15 @@ type QuerySample struct {
 	_ struct{}
 }
 
+// ActiveSessionHistory configures the metrics sampled from v$active_session_history.
+type ActiveSessionHistory struct {
+	// TopSQLCount is the maximum number of SQL IDs reported by the oracledb.active_sessions.top_sql metric.
+	TopSQLCount uint `mapstructure:"top_sql_count"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 type Config struct {
 	DataSource                     string `mapstructure:"datasource"`
 	Endpoint                       string `mapstructure:"endpoint"`
@@ -52,8 +62,9 @@ type Config struct {
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
 	metadata.LogsBuilderConfig     `mapstructure:",squash"`
 
-	TopQueryCollection `mapstructure:"top_query_collection"`
-	QuerySample        `mapstructure:"query_sample_collection"`
+	TopQueryCollection   `mapstructure:"top_query_collection"`
+	QuerySample          `mapstructure:"query_sample_collection"`
+	ActiveSessionHistory `mapstructure:"active_session_history"`
 }
 
 func (c Config) Validate() error {
@@ -106,5 +117,8 @@ func (c Config) Validate() error {
 	if c.TopQueryCount < 1 || c.TopQueryCount > 200 || c.TopQueryCount > c.MaxQuerySampleCount {
 		allErrs = multierr.Append(allErrs, errTopQueryCount)
 	}
+	if c.TopSQLCount < 1 || c.TopSQLCount > 100 {
+		allErrs = multierr.Append(allErrs, errTopSQLCount)
+	}
 	return allErrs
 }
@@ -47,6 +47,9 @@ func createDefaultConfig() component.Config {
 		QuerySample: QuerySample{
 			MaxRowsPerQuery: 100,
 		},
+		ActiveSessionHistory: ActiveSessionHistory{
+			TopSQLCount: 10,
+		},
 		TopQueryCollection: TopQueryCollection{
 			MaxQuerySampleCount: 1000,
 			TopQueryCount:       200,
@@ -78,7 +81,7 @@ func createReceiverFunc(sqlOpenerFunc sqlOpenerFunc, clientProviderFunc clientPr
 
 		mp, err := newScraper(metricsBuilder, sqlCfg.MetricsBuilderConfig, sqlCfg.ControllerConfig, settings.Logger, func() (*sql.DB, error) {
 			return sqlOpenerFunc(getDataSource(*sqlCfg))
-		}, clientProviderFunc, instanceName, hostName)
+		}, clientProviderFunc, instanceName, hostName, sqlCfg.ActiveSessionHistory)
 		if err != nil {
 			return nil, err
 		}
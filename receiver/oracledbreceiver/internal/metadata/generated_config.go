@@ -29,6 +29,8 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for oracledb metrics.
 type MetricsConfig struct {
+	OracledbActiveSessions                        MetricConfig `mapstructure:"oracledb.active_sessions"`
+	OracledbActiveSessionsTopSQL                  MetricConfig `mapstructure:"oracledb.active_sessions.top_sql"`
 	OracledbConsistentGets                        MetricConfig `mapstructure:"oracledb.consistent_gets"`
 	OracledbCPUTime                               MetricConfig `mapstructure:"oracledb.cpu_time"`
 	OracledbDbBlockGets                           MetricConfig `mapstructure:"oracledb.db_block_gets"`
@@ -75,6 +77,12 @@ type MetricsConfig struct {
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		OracledbActiveSessions: MetricConfig{
+			Enabled: false,
+		},
+		OracledbActiveSessionsTopSQL: MetricConfig{
+			Enabled: false,
+		},
 		OracledbConsistentGets: MetricConfig{
 			Enabled: false,
 		},
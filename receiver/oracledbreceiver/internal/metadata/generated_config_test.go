@@ -26,6 +26,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					OracledbActiveSessions:                        MetricConfig{Enabled: true},
+					OracledbActiveSessionsTopSQL:                  MetricConfig{Enabled: true},
 					OracledbConsistentGets:                        MetricConfig{Enabled: true},
 					OracledbCPUTime:                               MetricConfig{Enabled: true},
 					OracledbDbBlockGets:                           MetricConfig{Enabled: true},
@@ -80,6 +82,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					OracledbActiveSessions:                        MetricConfig{Enabled: false},
+					OracledbActiveSessionsTopSQL:                  MetricConfig{Enabled: false},
 					OracledbConsistentGets:                        MetricConfig{Enabled: false},
 					OracledbCPUTime:                               MetricConfig{Enabled: false},
 					OracledbDbBlockGets:                           MetricConfig{Enabled: false},
@@ -15,6 +15,12 @@ import (
 )
 
 var MetricsInfo = metricsInfo{
+	OracledbActiveSessions: metricInfo{
+		Name: "oracledb.active_sessions",
+	},
+	OracledbActiveSessionsTopSQL: metricInfo{
+		Name: "oracledb.active_sessions.top_sql",
+	},
 	OracledbConsistentGets: metricInfo{
 		Name: "oracledb.consistent_gets",
 	},
@@ -144,6 +150,8 @@ var MetricsInfo = metricsInfo{
 }
 
 type metricsInfo struct {
+	OracledbActiveSessions                        metricInfo
+	OracledbActiveSessionsTopSQL                  metricInfo
 	OracledbConsistentGets                        metricInfo
 	OracledbCPUTime                               metricInfo
 	OracledbDbBlockGets                           metricInfo
@@ -192,6 +200,111 @@ type metricInfo struct {
 	Name string
 }
 
+type metricOracledbActiveSessions struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.active_sessions metric with initial data.
+func (m *metricOracledbActiveSessions) init() {
+	m.data.SetName("oracledb.active_sessions")
+	m.data.SetDescription("Average number of active sessions over the collection interval, sampled from v$active_session_history. Sessions on CPU are reported with the wait class `CPU`.")
+	m.data.SetUnit("{sessions}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbActiveSessions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, oracledbWaitClassAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("oracledb.wait_class", oracledbWaitClassAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbActiveSessions) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbActiveSessions) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbActiveSessions(cfg MetricConfig) metricOracledbActiveSessions {
+	m := metricOracledbActiveSessions{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricOracledbActiveSessionsTopSQL struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.active_sessions.top_sql metric with initial data.
+func (m *metricOracledbActiveSessionsTopSQL) init() {
+	m.data.SetName("oracledb.active_sessions.top_sql")
+	m.data.SetDescription("Average number of active sessions over the collection interval, sampled from v$active_session_history, for the SQL statements with the most samples. The number of SQL statements is limited by `active_session_history.top_sql_count`.")
+	m.data.SetUnit("{sessions}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbActiveSessionsTopSQL) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, oracledbSQLIDAttributeValue string, oracledbWaitClassAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("oracledb.sql_id", oracledbSQLIDAttributeValue)
+	dp.Attributes().PutStr("oracledb.wait_class", oracledbWaitClassAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbActiveSessionsTopSQL) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbActiveSessionsTopSQL) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbActiveSessionsTopSQL(cfg MetricConfig) metricOracledbActiveSessionsTopSQL {
+	m := metricOracledbActiveSessionsTopSQL{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOracledbConsistentGets struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2365,6 +2478,8 @@ type MetricsBuilder struct {
 	buildInfo                                           component.BuildInfo  // contains version information.
 	resourceAttributeIncludeFilter                      map[string]filter.Filter
 	resourceAttributeExcludeFilter                      map[string]filter.Filter
+	metricOracledbActiveSessions                        metricOracledbActiveSessions
+	metricOracledbActiveSessionsTopSQL                  metricOracledbActiveSessionsTopSQL
 	metricOracledbConsistentGets                        metricOracledbConsistentGets
 	metricOracledbCPUTime                               metricOracledbCPUTime
 	metricOracledbDbBlockGets                           metricOracledbDbBlockGets
@@ -2432,6 +2547,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		startTime:                                           pcommon.NewTimestampFromTime(time.Now()),
 		metricsBuffer:                                       pmetric.NewMetrics(),
 		buildInfo:                                           settings.BuildInfo,
+		metricOracledbActiveSessions:                        newMetricOracledbActiveSessions(mbc.Metrics.OracledbActiveSessions),
+		metricOracledbActiveSessionsTopSQL:                  newMetricOracledbActiveSessionsTopSQL(mbc.Metrics.OracledbActiveSessionsTopSQL),
 		metricOracledbConsistentGets:                        newMetricOracledbConsistentGets(mbc.Metrics.OracledbConsistentGets),
 		metricOracledbCPUTime:                               newMetricOracledbCPUTime(mbc.Metrics.OracledbCPUTime),
 		metricOracledbDbBlockGets:                           newMetricOracledbDbBlockGets(mbc.Metrics.OracledbDbBlockGets),
@@ -2564,6 +2681,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	ils.Scope().SetName(ScopeName)
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricOracledbActiveSessions.emit(ils.Metrics())
+	mb.metricOracledbActiveSessionsTopSQL.emit(ils.Metrics())
 	mb.metricOracledbConsistentGets.emit(ils.Metrics())
 	mb.metricOracledbCPUTime.emit(ils.Metrics())
 	mb.metricOracledbDbBlockGets.emit(ils.Metrics())
@@ -2637,6 +2756,16 @@ func (mb *MetricsBuilder) Emit(options ...ResourceMetricsOption) pmetric.Metrics
 	return metrics
 }
 
+// RecordOracledbActiveSessionsDataPoint adds a data point to oracledb.active_sessions metric.
+func (mb *MetricsBuilder) RecordOracledbActiveSessionsDataPoint(ts pcommon.Timestamp, val float64, oracledbWaitClassAttributeValue string) {
+	mb.metricOracledbActiveSessions.recordDataPoint(mb.startTime, ts, val, oracledbWaitClassAttributeValue)
+}
+
+// RecordOracledbActiveSessionsTopSQLDataPoint adds a data point to oracledb.active_sessions.top_sql metric.
+func (mb *MetricsBuilder) RecordOracledbActiveSessionsTopSQLDataPoint(ts pcommon.Timestamp, val float64, oracledbSQLIDAttributeValue string, oracledbWaitClassAttributeValue string) {
+	mb.metricOracledbActiveSessionsTopSQL.recordDataPoint(mb.startTime, ts, val, oracledbSQLIDAttributeValue, oracledbWaitClassAttributeValue)
+}
+
 // RecordOracledbConsistentGetsDataPoint adds a data point to oracledb.consistent_gets metric.
 func (mb *MetricsBuilder) RecordOracledbConsistentGetsDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
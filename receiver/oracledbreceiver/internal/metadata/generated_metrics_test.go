@@ -67,6 +67,12 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			allMetricsCount++
+			mb.RecordOracledbActiveSessionsDataPoint(ts, 1, "oracledb.wait_class-val")
+
+			allMetricsCount++
+			mb.RecordOracledbActiveSessionsTopSQLDataPoint(ts, 1, "oracledb.sql_id-val", "oracledb.wait_class-val")
+
 			allMetricsCount++
 			mb.RecordOracledbConsistentGetsDataPoint(ts, "1")
 
@@ -244,6 +250,39 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
+				case "oracledb.active_sessions":
+					assert.False(t, validatedMetrics["oracledb.active_sessions"], "Found a duplicate in the metrics slice: oracledb.active_sessions")
+					validatedMetrics["oracledb.active_sessions"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Average number of active sessions over the collection interval, sampled from v$active_session_history. Sessions on CPU are reported with the wait class `CPU`.", ms.At(i).Description())
+					assert.Equal(t, "{sessions}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("oracledb.wait_class")
+					assert.True(t, ok)
+					assert.Equal(t, "oracledb.wait_class-val", attrVal.Str())
+				case "oracledb.active_sessions.top_sql":
+					assert.False(t, validatedMetrics["oracledb.active_sessions.top_sql"], "Found a duplicate in the metrics slice: oracledb.active_sessions.top_sql")
+					validatedMetrics["oracledb.active_sessions.top_sql"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Average number of active sessions over the collection interval, sampled from v$active_session_history, for the SQL statements with the most samples. The number of SQL statements is limited by `active_session_history.top_sql_count`.", ms.At(i).Description())
+					assert.Equal(t, "{sessions}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("oracledb.sql_id")
+					assert.True(t, ok)
+					assert.Equal(t, "oracledb.sql_id-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("oracledb.wait_class")
+					assert.True(t, ok)
+					assert.Equal(t, "oracledb.wait_class-val", attrVal.Str())
 				case "oracledb.consistent_gets":
 					assert.False(t, validatedMetrics["oracledb.consistent_gets"], "Found a duplicate in the metrics slice: oracledb.consistent_gets")
 					validatedMetrics["oracledb.consistent_gets"] = true
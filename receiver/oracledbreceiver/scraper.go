@@ -121,6 +121,7 @@ type oracleScraper struct {
 	oracleQueryMetricsClient   dbClient
 	oraclePlanDataClient       dbClient
 	samplesQueryClient         dbClient
+	activeSessionHistoryClient dbClient
 	db                         *sql.DB
 	clientProviderFunc         clientProviderFunc
 	mb                         *metadata.MetricsBuilder
@@ -138,21 +139,23 @@ type oracleScraper struct {
 	topQueryCollectCfg         TopQueryCollection
 	obfuscator                 *obfuscator
 	querySampleCfg             QuerySample
+	activeSessionHistoryCfg    ActiveSessionHistory
 	serviceInstanceID          string
 	lastExecutionTimestamp     time.Time
 }
 
-func newScraper(metricsBuilder *metadata.MetricsBuilder, metricsBuilderConfig metadata.MetricsBuilderConfig, scrapeCfg scraperhelper.ControllerConfig, logger *zap.Logger, providerFunc dbProviderFunc, clientProviderFunc clientProviderFunc, instanceName, hostName string) (scraper.Metrics, error) {
+func newScraper(metricsBuilder *metadata.MetricsBuilder, metricsBuilderConfig metadata.MetricsBuilderConfig, scrapeCfg scraperhelper.ControllerConfig, logger *zap.Logger, providerFunc dbProviderFunc, clientProviderFunc clientProviderFunc, instanceName, hostName string, activeSessionHistoryCfg ActiveSessionHistory) (scraper.Metrics, error) {
 	s := &oracleScraper{
-		mb:                   metricsBuilder,
-		metricsBuilderConfig: metricsBuilderConfig,
-		scrapeCfg:            scrapeCfg,
-		logger:               logger,
-		dbProviderFunc:       providerFunc,
-		clientProviderFunc:   clientProviderFunc,
-		instanceName:         instanceName,
-		hostName:             hostName,
-		serviceInstanceID:    getInstanceID(instanceName, logger),
+		mb:                      metricsBuilder,
+		metricsBuilderConfig:    metricsBuilderConfig,
+		scrapeCfg:               scrapeCfg,
+		logger:                  logger,
+		dbProviderFunc:          providerFunc,
+		clientProviderFunc:      clientProviderFunc,
+		instanceName:            instanceName,
+		hostName:                hostName,
+		serviceInstanceID:       getInstanceID(instanceName, logger),
+		activeSessionHistoryCfg: activeSessionHistoryCfg,
 	}
 	return scraper.NewMetrics(s.scrape, scraper.WithShutdown(s.shutdown), scraper.WithStart(s.start))
 }
@@ -191,6 +194,7 @@ func (s *oracleScraper) start(context.Context, component.Host) error {
 	s.systemResourceLimitsClient = s.clientProviderFunc(s.db, systemResourceLimitsSQL, s.logger)
 	s.tablespaceUsageClient = s.clientProviderFunc(s.db, tablespaceUsageSQL, s.logger)
 	s.samplesQueryClient = s.clientProviderFunc(s.db, samplesQuery, s.logger)
+	s.activeSessionHistoryClient = s.clientProviderFunc(s.db, activeSessionHistorySQL, s.logger)
 	return nil
 }
 
@@ -513,6 +517,13 @@ func (s *oracleScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		}
 	}
 
+	if s.metricsBuilderConfig.Metrics.OracledbActiveSessions.Enabled ||
+		s.metricsBuilderConfig.Metrics.OracledbActiveSessionsTopSQL.Enabled {
+		if err := s.scrapeActiveSessionHistory(ctx); err != nil {
+			scrapeErrors = append(scrapeErrors, err)
+		}
+	}
+
 	rb := s.setupResourceBuilder(s.mb.NewResourceBuilder())
 
 	out := s.mb.Emit(metadata.WithResource(rb.Emit()))
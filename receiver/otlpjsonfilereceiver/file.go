@@ -5,9 +5,11 @@ package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"errors"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/xconsumer"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -21,6 +23,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver/internal/replay"
 )
 
 const (
@@ -42,6 +45,20 @@ type Config struct {
 	fileconsumer.Config `mapstructure:",squash"`
 	StorageID           *component.ID `mapstructure:"storage"`
 	ReplayFile          bool          `mapstructure:"replay_file"`
+	// OrderedReplay replays the matched files in the order of the telemetry they contain, and persists
+	// the position of the replay in the storage extension to resume it without gaps.
+	OrderedReplay bool `mapstructure:"ordered_replay"`
+}
+
+func (c *Config) Validate() error {
+	if c.OrderedReplay && c.ReplayFile {
+		return errors.New("ordered_replay and replay_file cannot be enabled together")
+	}
+	if c.OrderedReplay && c.StorageID == nil {
+		// without storage, the replay would start over from the earliest file after a restart
+		return errors.New("ordered_replay requires a storage extension")
+	}
+	return nil
 }
 
 func createDefaultConfig() component.Config {
@@ -52,19 +69,52 @@ func createDefaultConfig() component.Config {
 
 type otlpjsonfilereceiver struct {
 	input     *fileconsumer.Manager
+	replayer  *replay.Replayer
 	id        component.ID
 	storageID *component.ID
 }
 
+func newReceiver(settings receiver.Settings, cfg *Config, consume consumeFunc, timestamp func([]byte) (int64, error)) (*otlpjsonfilereceiver, error) {
+	if cfg.OrderedReplay {
+		replayer, err := newReplayer(settings, cfg, consume, timestamp)
+		if err != nil {
+			return nil, err
+		}
+		return &otlpjsonfilereceiver{replayer: replayer, id: settings.ID, storageID: cfg.StorageID}, nil
+	}
+
+	opts := make([]fileconsumer.Option, 0)
+	if cfg.ReplayFile {
+		opts = append(opts, fileconsumer.WithNoTracking())
+	}
+	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
+		for _, token := range tokens {
+			_ = consume(ctx, token, attributes)
+		}
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &otlpjsonfilereceiver{input: input, id: settings.ID, storageID: cfg.StorageID}, nil
+}
+
 func (f *otlpjsonfilereceiver) Start(ctx context.Context, host component.Host) error {
 	storageClient, err := adapter.GetStorageClient(ctx, host, f.storageID, f.id)
 	if err != nil {
 		return err
 	}
+	if f.replayer != nil {
+		return f.replayer.Start(storageClient)
+	}
 	return f.input.Start(storageClient)
 }
 
 func (f *otlpjsonfilereceiver) Shutdown(_ context.Context) error {
+	if f.replayer != nil {
+		return f.replayer.Stop()
+	}
 	return f.input.Stop()
 }
 
@@ -79,43 +129,35 @@ func createLogsReceiver(_ context.Context, settings receiver.Settings, configura
 		return nil, err
 	}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
-	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
-		for _, token := range tokens {
-			ctx = obsrecv.StartLogsOp(ctx)
-			var l plog.Logs
-			l, err = logsUnmarshaler.UnmarshalLogs(token)
-			if err != nil {
-				obsrecv.EndLogsOp(ctx, metadata.Type.String(), 0, err)
-			} else {
-				logRecordCount := l.LogRecordCount()
-				if logRecordCount != 0 {
-					// Appends token.Attributes
-					for i := 0; i < l.ResourceLogs().Len(); i++ {
-						resourceLog := l.ResourceLogs().At(i)
-						for j := 0; j < resourceLog.ScopeLogs().Len(); j++ {
-							scopeLog := resourceLog.ScopeLogs().At(j)
-							for k := 0; k < scopeLog.LogRecords().Len(); k++ {
-								LogRecords := scopeLog.LogRecords().At(k)
-								appendToMap(attributes, LogRecords.Attributes())
-							}
-						}
+	r, err := newReceiver(settings, cfg, func(ctx context.Context, token []byte, attributes map[string]any) error {
+		ctx = obsrecv.StartLogsOp(ctx)
+		l, err := logsUnmarshaler.UnmarshalLogs(token)
+		if err != nil {
+			obsrecv.EndLogsOp(ctx, metadata.Type.String(), 0, err)
+			return consumererror.NewPermanent(err)
+		}
+		logRecordCount := l.LogRecordCount()
+		if logRecordCount != 0 {
+			// Appends token.Attributes
+			for i := 0; i < l.ResourceLogs().Len(); i++ {
+				resourceLog := l.ResourceLogs().At(i)
+				for j := 0; j < resourceLog.ScopeLogs().Len(); j++ {
+					scopeLog := resourceLog.ScopeLogs().At(j)
+					for k := 0; k < scopeLog.LogRecords().Len(); k++ {
+						LogRecords := scopeLog.LogRecords().At(k)
+						appendToMap(attributes, LogRecords.Attributes())
 					}
-					err = logs.ConsumeLogs(ctx, l)
 				}
-				obsrecv.EndLogsOp(ctx, metadata.Type.String(), logRecordCount, err)
 			}
+			err = logs.ConsumeLogs(ctx, l)
 		}
-		return nil
-	}, opts...)
+		obsrecv.EndLogsOp(ctx, metadata.Type.String(), logRecordCount, err)
+		return err
+	}, logsTimestamp)
 	if err != nil {
 		return nil, err
 	}
-
-	return &otlpjsonfilereceiver{input: input, id: settings.ID, storageID: cfg.StorageID}, nil
+	return r, nil
 }
 
 func createMetricsReceiver(_ context.Context, settings receiver.Settings, configuration component.Config, metrics consumer.Metrics) (receiver.Metrics, error) {
@@ -129,42 +171,34 @@ func createMetricsReceiver(_ context.Context, settings receiver.Settings, config
 		return nil, err
 	}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
-	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
-		for _, token := range tokens {
-			ctx = obsrecv.StartMetricsOp(ctx)
-			var m pmetric.Metrics
-			m, err = metricsUnmarshaler.UnmarshalMetrics(token)
-			if err != nil {
-				obsrecv.EndMetricsOp(ctx, metadata.Type.String(), 0, err)
-			} else {
-				if m.ResourceMetrics().Len() != 0 {
-					// Appends token.Attributes
-					for i := 0; i < m.ResourceMetrics().Len(); i++ {
-						resourceMetric := m.ResourceMetrics().At(i)
-						for j := 0; j < resourceMetric.ScopeMetrics().Len(); j++ {
-							ScopeMetric := resourceMetric.ScopeMetrics().At(j)
-							for k := 0; k < ScopeMetric.Metrics().Len(); k++ {
-								metric := ScopeMetric.Metrics().At(k)
-								appendToMap(attributes, metric.Metadata())
-							}
-						}
+	r, err := newReceiver(settings, cfg, func(ctx context.Context, token []byte, attributes map[string]any) error {
+		ctx = obsrecv.StartMetricsOp(ctx)
+		m, err := metricsUnmarshaler.UnmarshalMetrics(token)
+		if err != nil {
+			obsrecv.EndMetricsOp(ctx, metadata.Type.String(), 0, err)
+			return consumererror.NewPermanent(err)
+		}
+		if m.ResourceMetrics().Len() != 0 {
+			// Appends token.Attributes
+			for i := 0; i < m.ResourceMetrics().Len(); i++ {
+				resourceMetric := m.ResourceMetrics().At(i)
+				for j := 0; j < resourceMetric.ScopeMetrics().Len(); j++ {
+					ScopeMetric := resourceMetric.ScopeMetrics().At(j)
+					for k := 0; k < ScopeMetric.Metrics().Len(); k++ {
+						metric := ScopeMetric.Metrics().At(k)
+						appendToMap(attributes, metric.Metadata())
 					}
-					err = metrics.ConsumeMetrics(ctx, m)
 				}
-				obsrecv.EndMetricsOp(ctx, metadata.Type.String(), m.MetricCount(), err)
 			}
+			err = metrics.ConsumeMetrics(ctx, m)
 		}
-		return nil
-	}, opts...)
+		obsrecv.EndMetricsOp(ctx, metadata.Type.String(), m.MetricCount(), err)
+		return err
+	}, metricsTimestamp)
 	if err != nil {
 		return nil, err
 	}
-
-	return &otlpjsonfilereceiver{input: input, id: settings.ID, storageID: cfg.StorageID}, nil
+	return r, nil
 }
 
 func createTracesReceiver(_ context.Context, settings receiver.Settings, configuration component.Config, traces consumer.Traces) (receiver.Traces, error) {
@@ -178,66 +212,54 @@ func createTracesReceiver(_ context.Context, settings receiver.Settings, configu
 		return nil, err
 	}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
-	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
-		for _, token := range tokens {
-			ctx = obsrecv.StartTracesOp(ctx)
-			var t ptrace.Traces
-			t, err = tracesUnmarshaler.UnmarshalTraces(token)
-			if err != nil {
-				obsrecv.EndTracesOp(ctx, metadata.Type.String(), 0, err)
-			} else {
-				if t.ResourceSpans().Len() != 0 {
-					// Appends token.Attributes
-					for i := 0; i < t.ResourceSpans().Len(); i++ {
-						resourceSpan := t.ResourceSpans().At(i)
-						for j := 0; j < resourceSpan.ScopeSpans().Len(); j++ {
-							scopeSpan := resourceSpan.ScopeSpans().At(j)
-							for k := 0; k < scopeSpan.Spans().Len(); k++ {
-								spans := scopeSpan.Spans().At(k)
-								appendToMap(attributes, spans.Attributes())
-							}
-						}
+	r, err := newReceiver(settings, cfg, func(ctx context.Context, token []byte, attributes map[string]any) error {
+		ctx = obsrecv.StartTracesOp(ctx)
+		t, err := tracesUnmarshaler.UnmarshalTraces(token)
+		if err != nil {
+			obsrecv.EndTracesOp(ctx, metadata.Type.String(), 0, err)
+			return consumererror.NewPermanent(err)
+		}
+		if t.ResourceSpans().Len() != 0 {
+			// Appends token.Attributes
+			for i := 0; i < t.ResourceSpans().Len(); i++ {
+				resourceSpan := t.ResourceSpans().At(i)
+				for j := 0; j < resourceSpan.ScopeSpans().Len(); j++ {
+					scopeSpan := resourceSpan.ScopeSpans().At(j)
+					for k := 0; k < scopeSpan.Spans().Len(); k++ {
+						spans := scopeSpan.Spans().At(k)
+						appendToMap(attributes, spans.Attributes())
 					}
-					err = traces.ConsumeTraces(ctx, t)
 				}
-				obsrecv.EndTracesOp(ctx, metadata.Type.String(), t.SpanCount(), err)
 			}
+			err = traces.ConsumeTraces(ctx, t)
 		}
-		return nil
-	}, opts...)
+		obsrecv.EndTracesOp(ctx, metadata.Type.String(), t.SpanCount(), err)
+		return err
+	}, tracesTimestamp)
 	if err != nil {
 		return nil, err
 	}
-
-	return &otlpjsonfilereceiver{input: input, id: settings.ID, storageID: cfg.StorageID}, nil
+	return r, nil
 }
 
 func createProfilesReceiver(_ context.Context, settings receiver.Settings, configuration component.Config, profiles xconsumer.Profiles) (xreceiver.Profiles, error) {
 	profilesUnmarshaler := &pprofile.JSONUnmarshaler{}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
-	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, _ map[string]any, _ int64, _ []int64) error {
-		for _, token := range tokens {
-			p, _ := profilesUnmarshaler.UnmarshalProfiles(token)
-			// TODO Append token.Attributes
-			if p.ResourceProfiles().Len() != 0 {
-				_ = profiles.ConsumeProfiles(ctx, p)
-			}
+	r, err := newReceiver(settings, cfg, func(ctx context.Context, token []byte, _ map[string]any) error {
+		p, err := profilesUnmarshaler.UnmarshalProfiles(token)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		// TODO Append token.Attributes
+		if p.ResourceProfiles().Len() != 0 {
+			return profiles.ConsumeProfiles(ctx, p)
 		}
 		return nil
-	}, opts...)
+	}, profilesTimestamp)
 	if err != nil {
 		return nil, err
 	}
-
-	return &otlpjsonfilereceiver{input: input, id: settings.ID, storageID: cfg.StorageID}, nil
+	return r, nil
 }
 
 func appendToMap(attributes map[string]any, attr pcommon.Map) {
@@ -4,6 +4,7 @@
 package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -15,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pprofile"
@@ -23,6 +25,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/xreceiver"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher"
@@ -192,6 +195,57 @@ func TestFileLogsReceiver(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestFileLogsReceiverWithOrderedReplay(t *testing.T) {
+	tempFolder := t.TempDir()
+	factory := NewFactory()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.OrderedReplay = true
+	cfg.PollInterval = 10 * time.Millisecond
+	storageID := storagetest.NewStorageID("replay")
+	cfg.StorageID = &storageID
+
+	marshaler := &plog.JSONMarshaler{}
+	expected := make([]plog.Logs, 0, 2)
+	// The file with the later name contains the earlier telemetry and is replayed first.
+	for i, name := range []string{"b.json", "a.json"} {
+		ld := testdata.GenerateLogs(1)
+		ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).SetTimestamp(pcommon.Timestamp(i + 1))
+		b, err := marshaler.MarshalLogs(ld)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(tempFolder, name), append(b, '\n'), 0o600))
+		ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("log.file.name", name)
+		expected = append(expected, ld)
+	}
+
+	sink := new(consumertest.LogsSink)
+	receiver, err := factory.CreateLogs(t.Context(), receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start(t.Context(), storagetest.NewStorageHost().WithInMemoryStorageExtension("replay")))
+	t.Cleanup(func() {
+		assert.NoError(t, receiver.Shutdown(context.Background()))
+	})
+
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		assert.Equal(tt, expected, sink.AllLogs())
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, cfg.Validate())
+
+	cfg.OrderedReplay = true
+	assert.EqualError(t, cfg.Validate(), "ordered_replay requires a storage extension")
+
+	storageID := component.MustNewID("file_storage")
+	cfg.StorageID = &storageID
+	require.NoError(t, cfg.Validate())
+
+	cfg.ReplayFile = true
+	assert.EqualError(t, cfg.Validate(), "ordered_replay and replay_file cannot be enabled together")
+}
+
 func testdataConfigYamlAsMap() *Config {
 	return &Config{
 		Config: fileconsumer.Config{
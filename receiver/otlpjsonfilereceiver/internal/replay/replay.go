@@ -0,0 +1,363 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package replay reads OTLP JSON files in the order of the telemetry they contain, one batch after
+// the other, and persists the position of the next batch to read so that the replay resumes without
+// gaps after a restart.
+package replay // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver/internal/replay"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher"
+)
+
+// BookmarkKey is the storage key of the bookmark.
+const BookmarkKey = "ordered_replay_bookmark"
+
+// Bookmark is the position of the replay: the next batch to read is at Offset in File.
+type Bookmark struct {
+	// File is the path of the file being replayed.
+	File string `json:"file"`
+	// Timestamp is the earliest timestamp of the first batch of the file, in nanoseconds since
+	// the epoch. The files are replayed in the order of this timestamp.
+	Timestamp int64 `json:"timestamp"`
+	// Offset is the offset of the next batch to read in the file.
+	Offset int64 `json:"offset"`
+}
+
+// before returns whether the file with the given timestamp and path is replayed before the bookmarked file.
+func (b Bookmark) before(timestamp int64, path string) bool {
+	if timestamp != b.Timestamp {
+		return timestamp < b.Timestamp
+	}
+	return path < b.File
+}
+
+// Settings are the settings of a Replayer.
+type Settings struct {
+	Criteria     matcher.Criteria
+	PollInterval time.Duration
+	Logger       *zap.Logger
+	// MaxBatchSize is the maximum size of a batch. The larger batches are dropped.
+	MaxBatchSize int
+	// Timestamp returns the earliest timestamp of the telemetry of a batch, in nanoseconds since the epoch.
+	Timestamp func(batch []byte) (int64, error)
+	// Consume sends a batch read from the given file. A batch is retried at the next poll until it is
+	// consumed, unless the error is permanent.
+	Consume func(ctx context.Context, path string, batch []byte) error
+}
+
+// Replayer replays the batches of the matched files in order. The files are ordered by the earliest
+// timestamp of their first batch, and a file is only left once it is fully read and a later file exists.
+type Replayer struct {
+	set     Settings
+	matcher *matcher.Matcher
+
+	// timestamps caches the timestamp of the first batch of the matched files.
+	timestamps map[string]int64
+	// skipped are the matched files found after the replay went past them.
+	skipped map[string]struct{}
+
+	client storage.Client
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex
+	bookmark Bookmark
+}
+
+// New creates a Replayer.
+func New(set Settings) (*Replayer, error) {
+	m, err := matcher.New(set.Criteria)
+	if err != nil {
+		return nil, err
+	}
+	return &Replayer{
+		set:        set,
+		matcher:    m,
+		timestamps: map[string]int64{},
+		skipped:    map[string]struct{}{},
+	}, nil
+}
+
+// Bookmark returns the current position of the replay.
+func (r *Replayer) Bookmark() Bookmark {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.bookmark
+}
+
+// Start loads the bookmark persisted in the given storage client and starts replaying the files.
+func (r *Replayer) Start(client storage.Client) error {
+	r.client = client
+	data, err := client.Get(context.Background(), BookmarkKey)
+	if err != nil {
+		return fmt.Errorf("failed to load the replay bookmark: %w", err)
+	}
+	if data != nil {
+		var bookmark Bookmark
+		if err = json.Unmarshal(data, &bookmark); err != nil {
+			return fmt.Errorf("failed to decode the replay bookmark: %w", err)
+		}
+		r.setBookmark(bookmark)
+		r.set.Logger.Info("Resuming the replay", zap.String("file", bookmark.File), zap.Int64("offset", bookmark.Offset))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.set.PollInterval)
+		defer ticker.Stop()
+		for {
+			r.poll(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops replaying the files.
+func (r *Replayer) Stop() error {
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+	r.wg.Wait()
+	return nil
+}
+
+type orderedFile struct {
+	path      string
+	timestamp int64
+}
+
+func (r *Replayer) poll(ctx context.Context) {
+	files := r.orderedFiles()
+	bookmark := r.Bookmark()
+	for _, f := range files {
+		if ctx.Err() != nil {
+			return
+		}
+		if f.path != bookmark.File {
+			if bookmark.File != "" && bookmark.before(f.timestamp, f.path) {
+				if _, ok := r.skipped[f.path]; !ok {
+					r.skipped[f.path] = struct{}{}
+					r.set.Logger.Warn("Skipping a file older than the file being replayed", zap.String("file", f.path), zap.String("replayed_file", bookmark.File))
+				}
+				continue
+			}
+			// The bookmarked file is fully read, or no longer matched, and a later file exists.
+			r.setBookmark(Bookmark{File: f.path, Timestamp: f.timestamp})
+			r.persistBookmark(ctx)
+		}
+
+		if err := r.readFile(ctx, f.path); err != nil {
+			r.set.Logger.Warn("Failed to replay a file, retrying", zap.String("file", f.path), zap.Error(err))
+			return
+		}
+		bookmark = r.Bookmark()
+	}
+}
+
+// orderedFiles returns the matched files with at least a complete batch, in replay order.
+func (r *Replayer) orderedFiles() []orderedFile {
+	paths, err := r.matcher.MatchFiles()
+	if err != nil {
+		r.set.Logger.Debug("Failed to match the files to replay", zap.Error(err))
+	}
+
+	// forget the files which are no longer matched, e.g. because they were deleted after being replayed
+	matched := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		matched[path] = struct{}{}
+	}
+	for path := range r.timestamps {
+		if _, ok := matched[path]; !ok {
+			delete(r.timestamps, path)
+		}
+	}
+	for path := range r.skipped {
+		if _, ok := matched[path]; !ok {
+			delete(r.skipped, path)
+		}
+	}
+
+	files := make([]orderedFile, 0, len(paths))
+	for _, path := range paths {
+		timestamp, ok := r.timestamps[path]
+		if !ok {
+			batch, err := r.readFirstBatch(path)
+			if err != nil {
+				r.set.Logger.Warn("Failed to read the first batch of a file", zap.String("file", path), zap.Error(err))
+				continue
+			}
+			if batch == nil {
+				// The first batch is not written yet.
+				continue
+			}
+			timestamp, err = r.set.Timestamp(batch)
+			if err != nil {
+				r.set.Logger.Warn("Failed to read the timestamp of a file, using its modification time", zap.String("file", path), zap.Error(err))
+				timestamp = modTime(path)
+			}
+			r.timestamps[path] = timestamp
+		}
+		files = append(files, orderedFile{path: path, timestamp: timestamp})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].timestamp != files[j].timestamp {
+			return files[i].timestamp < files[j].timestamp
+		}
+		return files[i].path < files[j].path
+	})
+	return files
+}
+
+// readFile consumes the complete batches of the bookmarked file, starting at the bookmarked offset.
+func (r *Replayer) readFile(ctx context.Context, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	bookmark := r.Bookmark()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() < bookmark.Offset {
+		r.set.Logger.Warn("The file being replayed was truncated, replaying it from the beginning", zap.String("file", path))
+		bookmark.Offset = 0
+	}
+	if _, err = f.Seek(bookmark.Offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(f)
+	for ctx.Err() == nil {
+		line, n, err := readLine(reader, r.set.MaxBatchSize)
+		if errors.Is(err, io.EOF) {
+			// The last batch is not complete yet.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if line == nil {
+			r.set.Logger.Warn("Dropping a batch larger than the maximum size", zap.String("file", path), zap.Int64("offset", bookmark.Offset), zap.Int("size", n))
+		} else if batch := bytes.TrimSpace(line); len(batch) != 0 {
+			if err = r.set.Consume(ctx, path, batch); err != nil {
+				if !consumererror.IsPermanent(err) {
+					return err
+				}
+				r.set.Logger.Warn("Dropping a batch which cannot be consumed", zap.String("file", path), zap.Int64("offset", bookmark.Offset), zap.Error(err))
+			}
+		}
+
+		bookmark.Offset += int64(n)
+		r.setBookmark(bookmark)
+		r.persistBookmark(ctx)
+	}
+	return nil
+}
+
+func (r *Replayer) setBookmark(bookmark Bookmark) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bookmark = bookmark
+}
+
+func (r *Replayer) persistBookmark(ctx context.Context) {
+	data, err := json.Marshal(r.Bookmark())
+	if err == nil {
+		err = r.client.Set(ctx, BookmarkKey, data)
+	}
+	if err != nil {
+		r.set.Logger.Error("Failed to persist the replay bookmark", zap.Error(err))
+	}
+}
+
+// readFirstBatch returns the first complete batch of the file, or nil if there is none yet.
+// The batches larger than the maximum size are skipped.
+func (r *Replayer) readFirstBatch(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	for {
+		line, _, err := readLine(reader, r.set.MaxBatchSize)
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if batch := bytes.TrimSpace(line); len(batch) != 0 {
+			return batch, nil
+		}
+	}
+}
+
+// readLine reads the next line of the reader, including its newline, without buffering more than maxSize
+// bytes. It returns the number of bytes read, and the line unless it is larger than maxSize, in which case
+// it is discarded. io.EOF is returned if the line is not complete yet.
+func readLine(reader *bufio.Reader, maxSize int) (line []byte, n int, err error) {
+	tooLarge := false
+	for {
+		chunk, readErr := reader.ReadSlice('\n')
+		n += len(chunk)
+		if !tooLarge {
+			if maxSize > 0 && len(line)+len(chunk) > maxSize {
+				tooLarge = true
+				line = nil
+			} else {
+				line = append(line, chunk...)
+			}
+		}
+		switch {
+		case errors.Is(readErr, bufio.ErrBufferFull):
+			continue
+		case readErr != nil:
+			return nil, n, readErr
+		case tooLarge:
+			return nil, n, nil
+		default:
+			return line, n, nil
+		}
+	}
+}
+
+func modTime(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.ModTime().UnixNano()
+}
@@ -0,0 +1,238 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher"
+)
+
+type consumed struct {
+	file  string
+	batch string
+}
+
+type sink struct {
+	mu       sync.Mutex
+	consumed []consumed
+	err      func(batch string) error
+}
+
+func (s *sink) consume(_ context.Context, path string, batch []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		if err := s.err(string(batch)); err != nil {
+			return err
+		}
+	}
+	s.consumed = append(s.consumed, consumed{file: filepath.Base(path), batch: string(batch)})
+	return nil
+}
+
+func (s *sink) all() []consumed {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]consumed(nil), s.consumed...)
+}
+
+func timestamp(batch []byte) (int64, error) {
+	var b struct {
+		Timestamp int64 `json:"ts"`
+	}
+	err := json.Unmarshal(batch, &b)
+	return b.Timestamp, err
+}
+
+func newReplayer(t *testing.T, dir string, s *sink) *Replayer {
+	r, err := New(Settings{
+		Criteria:     matcher.Criteria{Include: []string{filepath.Join(dir, "*.json")}},
+		PollInterval: 10 * time.Millisecond,
+		Logger:       zap.NewNop(),
+		MaxBatchSize: 64,
+		Timestamp:    timestamp,
+		Consume:      s.consume,
+	})
+	require.NoError(t, err)
+	return r
+}
+
+func startReplayer(t *testing.T, dir string, client storage.Client, s *sink) *Replayer {
+	r := newReplayer(t, dir, s)
+	require.NoError(t, r.Start(client))
+	return r
+}
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func appendFile(t *testing.T, path, content string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(content)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func newClient() storage.Client {
+	return storagetest.NewInMemoryClient(component.KindReceiver, component.MustNewID("otlpjsonfile"), "")
+}
+
+func TestReplayOrder(t *testing.T) {
+	dir := t.TempDir()
+	// The names of the files don't follow the order of their telemetry.
+	writeFile(t, filepath.Join(dir, "a.json"), "{\"ts\":300}\n{\"ts\":310}\n")
+	writeFile(t, filepath.Join(dir, "b.json"), "{\"ts\":100}\n{\"ts\":110}\n")
+	writeFile(t, filepath.Join(dir, "c.json"), "{\"ts\":200}\n{\"ts\":210}\n")
+
+	s := &sink{}
+	r := startReplayer(t, dir, newClient(), s)
+	defer func() { require.NoError(t, r.Stop()) }()
+
+	expected := []consumed{
+		{file: "b.json", batch: `{"ts":100}`},
+		{file: "b.json", batch: `{"ts":110}`},
+		{file: "c.json", batch: `{"ts":200}`},
+		{file: "c.json", batch: `{"ts":210}`},
+		{file: "a.json", batch: `{"ts":300}`},
+		{file: "a.json", batch: `{"ts":310}`},
+	}
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		assert.Equal(tt, expected, s.all())
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, Bookmark{File: filepath.Join(dir, "a.json"), Timestamp: 300, Offset: 22}, r.Bookmark())
+}
+
+func TestReplayIncompleteBatch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.json")
+	writeFile(t, path, "{\"ts\":100}\n{\"ts\":")
+
+	s := &sink{}
+	r := startReplayer(t, dir, newClient(), s)
+	defer func() { require.NoError(t, r.Stop()) }()
+
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		assert.Equal(tt, []consumed{{file: "a.json", batch: `{"ts":100}`}}, s.all())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	appendFile(t, path, "110}\n")
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		assert.Equal(tt, []consumed{
+			{file: "a.json", batch: `{"ts":100}`},
+			{file: "a.json", batch: `{"ts":110}`},
+		}, s.all())
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestReplayResume(t *testing.T) {
+	dir := t.TempDir()
+	client := newClient()
+	path := filepath.Join(dir, "a.json")
+	writeFile(t, path, "{\"ts\":100}\n{\"ts\":110}\n")
+
+	s := &sink{}
+	r := startReplayer(t, dir, client, s)
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		assert.Len(tt, s.all(), 2)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Stop())
+
+	// Telemetry written while the replay is stopped is consumed after a restart, without duplicates.
+	appendFile(t, path, "{\"ts\":120}\n")
+	writeFile(t, filepath.Join(dir, "b.json"), "{\"ts\":200}\n")
+
+	s = &sink{}
+	r = startReplayer(t, dir, client, s)
+	defer func() { require.NoError(t, r.Stop()) }()
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		assert.Equal(tt, []consumed{
+			{file: "a.json", batch: `{"ts":120}`},
+			{file: "b.json", batch: `{"ts":200}`},
+		}, s.all())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	data, err := client.Get(t.Context(), BookmarkKey)
+	require.NoError(t, err)
+	var bookmark Bookmark
+	require.NoError(t, json.Unmarshal(data, &bookmark))
+	assert.Equal(t, Bookmark{File: filepath.Join(dir, "b.json"), Timestamp: 200, Offset: 11}, bookmark)
+}
+
+func TestReplayRetry(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.json"), "{\"ts\":100}\nnot json\n{\"ts\":110}\n")
+
+	failures := 0
+	s := &sink{err: func(batch string) error {
+		switch {
+		case batch == "not json":
+			return consumererror.NewPermanent(errors.New("invalid batch"))
+		case batch == `{"ts":110}` && failures < 2:
+			failures++
+			return errors.New("temporary failure")
+		}
+		return nil
+	}}
+	r := startReplayer(t, dir, newClient(), s)
+	defer func() { require.NoError(t, r.Stop()) }()
+
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		assert.Equal(tt, []consumed{
+			{file: "a.json", batch: `{"ts":100}`},
+			{file: "a.json", batch: `{"ts":110}`},
+		}, s.all())
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestReplayMaxBatchSize(t *testing.T) {
+	dir := t.TempDir()
+	large := `{"ts":50,"padding":"` + strings.Repeat("x", 100) + `"}`
+	// The large batch is skipped to read the timestamp of the file, and dropped.
+	writeFile(t, filepath.Join(dir, "a.json"), large+"\n{\"ts\":100}\n"+large+"\n{\"ts\":110}\n")
+
+	s := &sink{}
+	r := startReplayer(t, dir, newClient(), s)
+	defer func() { require.NoError(t, r.Stop()) }()
+
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		assert.Equal(tt, []consumed{
+			{file: "a.json", batch: `{"ts":100}`},
+			{file: "a.json", batch: `{"ts":110}`},
+		}, s.all())
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, Bookmark{File: filepath.Join(dir, "a.json"), Timestamp: 100, Offset: int64(2*len(large) + 24)}, r.Bookmark())
+}
+
+func TestReplayForgetsRemovedFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.json"), "{\"ts\":100}\n")
+	writeFile(t, filepath.Join(dir, "b.json"), "{\"ts\":200}\n")
+
+	r := newReplayer(t, dir, &sink{})
+	assert.Len(t, r.orderedFiles(), 2)
+	assert.Len(t, r.timestamps, 2)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "a.json")))
+	assert.Equal(t, []orderedFile{{path: filepath.Join(dir, "b.json"), timestamp: 200}}, r.orderedFiles())
+	assert.Equal(t, map[string]int64{filepath.Join(dir, "b.json"): 200}, r.timestamps)
+}
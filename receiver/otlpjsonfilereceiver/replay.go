@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+
+import (
+	"context"
+	"path/filepath"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver/internal/replay"
+)
+
+// consumeFunc consumes a batch read from a file, with the attributes of the file.
+type consumeFunc func(ctx context.Context, token []byte, attributes map[string]any) error
+
+func newReplayer(settings receiver.Settings, cfg *Config, consume consumeFunc, timestamp func([]byte) (int64, error)) (*replay.Replayer, error) {
+	return replay.New(replay.Settings{
+		Criteria:     cfg.Criteria,
+		PollInterval: cfg.PollInterval,
+		Logger:       settings.Logger,
+		MaxBatchSize: int(cfg.MaxLogSize),
+		Timestamp:    timestamp,
+		Consume: func(ctx context.Context, path string, batch []byte) error {
+			attributes := map[string]any{}
+			if cfg.IncludeFileName {
+				attributes[attrs.LogFileName] = filepath.Base(path)
+			}
+			if cfg.IncludeFilePath {
+				attributes[attrs.LogFilePath] = path
+			}
+			return consume(ctx, batch, attributes)
+		},
+	})
+}
+
+// earliest keeps the earliest non-zero timestamp.
+type earliest int64
+
+func (e *earliest) add(ts pcommon.Timestamp) {
+	if ts != 0 && (*e == 0 || int64(ts) < int64(*e)) {
+		*e = earliest(ts)
+	}
+}
+
+func logsTimestamp(batch []byte) (int64, error) {
+	ld, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(batch)
+	if err != nil {
+		return 0, err
+	}
+	var ts earliest
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		sls := ld.ResourceLogs().At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				if lrs.At(k).Timestamp() != 0 {
+					ts.add(lrs.At(k).Timestamp())
+				} else {
+					ts.add(lrs.At(k).ObservedTimestamp())
+				}
+			}
+		}
+	}
+	return int64(ts), nil
+}
+
+func tracesTimestamp(batch []byte) (int64, error) {
+	td, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(batch)
+	if err != nil {
+		return 0, err
+	}
+	var ts earliest
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		sss := td.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				ts.add(spans.At(k).StartTimestamp())
+			}
+		}
+	}
+	return int64(ts), nil
+}
+
+func metricsTimestamp(batch []byte) (int64, error) {
+	md, err := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(batch)
+	if err != nil {
+		return 0, err
+	}
+	var ts earliest
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				addDataPointsTimestamp(&ts, metrics.At(k))
+			}
+		}
+	}
+	return int64(ts), nil
+}
+
+func addDataPointsTimestamp(ts *earliest, m pmetric.Metric) {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+			ts.add(m.Gauge().DataPoints().At(i).Timestamp())
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < m.Sum().DataPoints().Len(); i++ {
+			ts.add(m.Sum().DataPoints().At(i).Timestamp())
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < m.Histogram().DataPoints().Len(); i++ {
+			ts.add(m.Histogram().DataPoints().At(i).Timestamp())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < m.ExponentialHistogram().DataPoints().Len(); i++ {
+			ts.add(m.ExponentialHistogram().DataPoints().At(i).Timestamp())
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < m.Summary().DataPoints().Len(); i++ {
+			ts.add(m.Summary().DataPoints().At(i).Timestamp())
+		}
+	}
+}
+
+func profilesTimestamp(batch []byte) (int64, error) {
+	pd, err := (&pprofile.JSONUnmarshaler{}).UnmarshalProfiles(batch)
+	if err != nil {
+		return 0, err
+	}
+	var ts earliest
+	for i := 0; i < pd.ResourceProfiles().Len(); i++ {
+		sps := pd.ResourceProfiles().At(i).ScopeProfiles()
+		for j := 0; j < sps.Len(); j++ {
+			profiles := sps.At(j).Profiles()
+			for k := 0; k < profiles.Len(); k++ {
+				ts.add(profiles.At(k).Time())
+			}
+		}
+	}
+	return int64(ts), nil
+}
@@ -3,8 +3,15 @@
 
 package pprofreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pprofreceiver"
 
-import "go.opentelemetry.io/collector/config/confighttp"
+import (
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
 
 type Config struct {
 	confighttp.ClientConfig `mapstructure:",squash"`
+
+	// Extension is the ID of a pprof extension capturing the profiles of the Collector
+	// with self_profiling. The captured profiles are emitted by the receiver.
+	Extension *component.ID `mapstructure:"extension"`
 }
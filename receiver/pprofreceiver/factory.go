@@ -30,11 +30,18 @@ func createDefaultConfig() component.Config {
 }
 
 func createProfilesReceiver(
-	context.Context,
-	receiver.Settings,
-	component.Config,
-	xconsumer.Profiles,
+	_ context.Context,
+	_ receiver.Settings,
+	cfg component.Config,
+	nextConsumer xconsumer.Profiles,
 ) (xreceiver.Profiles, error) {
+	config := cfg.(*Config)
+	if config.Extension != nil {
+		return &selfProfilesReceiver{
+			extension:    *config.Extension,
+			nextConsumer: nextConsumer,
+		}, nil
+	}
 	return &rcvr{}, errors.New("not implemented")
 }
 
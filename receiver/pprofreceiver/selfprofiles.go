@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pprofreceiver"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/xconsumer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
+)
+
+// selfProfilesReceiver emits the profiles of the Collector captured by the pprof extension.
+type selfProfilesReceiver struct {
+	extension    component.ID
+	nextConsumer xconsumer.Profiles
+	unregister   func()
+}
+
+func (r *selfProfilesReceiver) Start(_ context.Context, host component.Host) error {
+	ext, ok := host.GetExtensions()[r.extension]
+	if !ok {
+		return fmt.Errorf("extension %q not found", r.extension)
+	}
+	sp, ok := ext.(pprofextension.SelfProfiles)
+	if !ok {
+		return fmt.Errorf("extension %q does not capture the profiles of the Collector", r.extension)
+	}
+	r.unregister = sp.RegisterProfilesConsumer(r.nextConsumer)
+	return nil
+}
+
+func (r *selfProfilesReceiver) Shutdown(context.Context) error {
+	if r.unregister != nil {
+		r.unregister()
+	}
+	return nil
+}
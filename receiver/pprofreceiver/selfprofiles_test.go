@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/consumer/xconsumer"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+type selfProfilesExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumers []xconsumer.Profiles
+}
+
+func (e *selfProfilesExtension) RegisterProfilesConsumer(consumer xconsumer.Profiles) func() {
+	e.consumers = append(e.consumers, consumer)
+	return func() {
+		e.consumers = nil
+	}
+}
+
+type host struct {
+	extensions map[component.ID]component.Component
+}
+
+func (h *host) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestSelfProfilesReceiver(t *testing.T) {
+	extID := component.MustNewID("pprof")
+	ext := &selfProfilesExtension{}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Extension = &extID
+	sink := new(consumertest.ProfilesSink)
+	rcvr, err := createProfilesReceiver(t.Context(), receivertest.NewNopSettings(typ), cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, rcvr.Start(t.Context(), &host{extensions: map[component.ID]component.Component{extID: ext}}))
+	require.Len(t, ext.consumers, 1)
+
+	pd := pprofile.NewProfiles()
+	pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+	require.NoError(t, ext.consumers[0].ConsumeProfiles(context.Background(), pd))
+	assert.Len(t, sink.AllProfiles(), 1)
+
+	require.NoError(t, rcvr.Shutdown(t.Context()))
+	assert.Empty(t, ext.consumers)
+}
+
+func TestSelfProfilesReceiverInvalidExtension(t *testing.T) {
+	extID := component.MustNewID("pprof")
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Extension = &extID
+	rcvr, err := createProfilesReceiver(t.Context(), receivertest.NewNopSettings(typ), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	assert.EqualError(t, rcvr.Start(t.Context(), &host{}), `extension "pprof" not found`)
+
+	h := &host{extensions: map[component.ID]component.Component{extID: componentNop{}}}
+	assert.EqualError(t, rcvr.Start(t.Context(), h), `extension "pprof" does not capture the profiles of the Collector`)
+	assert.NoError(t, rcvr.Shutdown(t.Context()))
+}
+
+type componentNop struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
@@ -4,6 +4,7 @@ package prometheusremotewritereceiver // import "github.com/open-telemetry/opent
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -289,10 +290,10 @@ func (prw *prometheusRemoteWriteReceiver) translateV2(_ context.Context, req *wr
 	var (
 		badRequestErrors error
 		// otelMetrics represents the final metrics, after all the processing, that will be returned by the receiver.
-		otelMetrics   = pmetric.NewMetrics()
-		labelsBuilder = labels.NewScratchBuilder(0)
+		otelMetrics           = pmetric.NewMetrics()
+		labelsBuilder         = labels.NewScratchBuilder(0)
+		exemplarLabelsBuilder = labels.NewScratchBuilder(0)
 		// More about stats: https://github.com/prometheus/docs/blob/main/docs/specs/prw/remote_write_spec_2_0.md#required-written-response-headers
-		// TODO: add exemplars to the stats.
 		stats = promremote.WriteResponseStats{
 			Confirmed: true,
 		}
@@ -357,7 +358,10 @@ func (prw *prometheusRemoteWriteReceiver) translateV2(_ context.Context, req *wr
 		// Handle histograms separately due to their complex mixed-schema processing
 		if ts.Metadata.Type == writev2.Metadata_METRIC_TYPE_HISTOGRAM ||
 			ts.Metadata.Type == writev2.Metadata_METRIC_TYPE_UNSPECIFIED && len(ts.Histograms) > 0 {
-			prw.processHistogramTimeSeries(otelMetrics, ls, ts, scopeName, scopeVersion, metricName, unit, description, metricCache, &stats, modifiedResourceMetric)
+			targets := prw.processHistogramTimeSeries(otelMetrics, ls, ts, scopeName, scopeVersion, metricName, unit, description, metricCache, &stats, modifiedResourceMetric)
+			if err := addExemplars(targets, ts, req.Symbols, &exemplarLabelsBuilder, &stats); err != nil {
+				badRequestErrors = errors.Join(badRequestErrors, fmt.Errorf("metric %q: %w", metricName, err))
+			}
 			continue
 		}
 
@@ -425,16 +429,28 @@ func (prw *prometheusRemoteWriteReceiver) translateV2(_ context.Context, req *wr
 			metric.SetDescription(description)
 		}
 
+		var datapoints pmetric.NumberDataPointSlice
 		switch ts.Metadata.Type {
 		case writev2.Metadata_METRIC_TYPE_GAUGE, writev2.Metadata_METRIC_TYPE_UNSPECIFIED:
-			addNumberDatapoints(metric.Gauge().DataPoints(), ls, ts, &stats)
+			datapoints = metric.Gauge().DataPoints()
 		case writev2.Metadata_METRIC_TYPE_COUNTER:
-			addNumberDatapoints(metric.Sum().DataPoints(), ls, ts, &stats)
+			datapoints = metric.Sum().DataPoints()
 		case writev2.Metadata_METRIC_TYPE_SUMMARY:
 			// Drop summary series as we will not handle them.
 			continue
 		default:
 			badRequestErrors = errors.Join(badRequestErrors, fmt.Errorf("unsupported metric type %q for metric %q", ts.Metadata.Type, metricName))
+			continue
+		}
+		addNumberDatapoints(datapoints, ls, ts, &stats)
+
+		// The data points of the time series are the last ones of the metric.
+		targets := make([]exemplarTarget, 0, len(ts.Samples))
+		for j := datapoints.Len() - len(ts.Samples); j < datapoints.Len(); j++ {
+			targets = append(targets, exemplarTarget{timestamp: datapoints.At(j).Timestamp(), exemplars: datapoints.At(j).Exemplars()})
+		}
+		if err := addExemplars(targets, ts, req.Symbols, &exemplarLabelsBuilder, &stats); err != nil {
+			badRequestErrors = errors.Join(badRequestErrors, fmt.Errorf("metric %q: %w", metricName, err))
 		}
 	}
 
@@ -442,6 +458,7 @@ func (prw *prometheusRemoteWriteReceiver) translateV2(_ context.Context, req *wr
 }
 
 // processHistogramTimeSeries handles all histogram processing, including validation and mixed schemas.
+// It returns the data points which were created, to which the exemplars of the time series are attached.
 func (prw *prometheusRemoteWriteReceiver) processHistogramTimeSeries(
 	otelMetrics pmetric.Metrics,
 	ls labels.Labels,
@@ -450,12 +467,12 @@ func (prw *prometheusRemoteWriteReceiver) processHistogramTimeSeries(
 	metricCache map[uint64]pmetric.Metric,
 	stats *promremote.WriteResponseStats,
 	modifiedRM map[uint64]pmetric.ResourceMetrics,
-) {
+) []exemplarTarget {
 	// Drop classic histogram series (those with samples)
 	if len(ts.Samples) != 0 {
 		prw.settings.Logger.Info("Dropping classic histogram series. Please configure Prometheus to convert classic histograms into Native Histograms Custom Buckets",
 			zapcore.Field{Key: "timeseries", Type: zapcore.StringType, String: ls.Get("__name__")})
-		return
+		return nil
 	}
 	attrs := extractAttributes(ls)
 
@@ -464,6 +481,7 @@ func (prw *prometheusRemoteWriteReceiver) processHistogramTimeSeries(
 		resourceID   identity.Resource
 		scope        pmetric.ScopeMetrics
 		rm           pmetric.ResourceMetrics
+		targets      []exemplarTarget
 	)
 
 	for i := range ts.Histograms {
@@ -547,11 +565,84 @@ func (prw *prometheusRemoteWriteReceiver) processHistogramTimeSeries(
 
 		// Process the individual histogram
 		if histogramType == "nhcb" {
-			prw.addNHCBDatapoint(histMetric.Histogram().DataPoints(), histogram, attrs, stats)
+			datapoints := histMetric.Histogram().DataPoints()
+			count := datapoints.Len()
+			prw.addNHCBDatapoint(datapoints, histogram, attrs, stats)
+			if datapoints.Len() > count {
+				dp := datapoints.At(datapoints.Len() - 1)
+				targets = append(targets, exemplarTarget{timestamp: dp.Timestamp(), exemplars: dp.Exemplars()})
+			}
 		} else {
-			prw.addExponentialHistogramDatapoint(histMetric.ExponentialHistogram().DataPoints(), histogram, attrs, ls, stats)
+			datapoints := histMetric.ExponentialHistogram().DataPoints()
+			count := datapoints.Len()
+			prw.addExponentialHistogramDatapoint(datapoints, histogram, attrs, ls, stats)
+			if datapoints.Len() > count {
+				dp := datapoints.At(datapoints.Len() - 1)
+				targets = append(targets, exemplarTarget{timestamp: dp.Timestamp(), exemplars: dp.Exemplars()})
+			}
 		}
 	}
+	return targets
+}
+
+// The labels of the exemplars referencing a trace, as recommended by the Remote Write specification.
+const (
+	traceIDLabel = "trace_id"
+	spanIDLabel  = "span_id"
+)
+
+// exemplarTarget is a data point created from a time series, to which the exemplars of the time series can be attached.
+type exemplarTarget struct {
+	timestamp pcommon.Timestamp
+	exemplars pmetric.ExemplarSlice
+}
+
+// addExemplars converts the exemplars of the time series and attaches each of them to the first data point whose timestamp
+// is not before the exemplar's, or to the last data point. The trace_id and span_id labels of the exemplars are set as their
+// trace and span IDs, and the other labels as their filtered attributes.
+// The exemplars are dropped if the time series has no data points.
+func addExemplars(targets []exemplarTarget, ts *writev2.TimeSeries, symbols []string, labelsBuilder *labels.ScratchBuilder, stats *promremote.WriteResponseStats) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	var errs error
+	for i := range ts.Exemplars {
+		e, err := ts.Exemplars[i].ToExemplar(labelsBuilder, symbols)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("error converting exemplar to labels: %w", err))
+			continue
+		}
+
+		timestamp := pcommon.Timestamp(e.Ts * int64(time.Millisecond))
+		target := targets[len(targets)-1]
+		for _, t := range targets {
+			if t.timestamp >= timestamp {
+				target = t
+				break
+			}
+		}
+
+		exemplar := target.exemplars.AppendEmpty()
+		exemplar.SetTimestamp(timestamp)
+		exemplar.SetDoubleValue(e.Value)
+		e.Labels.Range(func(l labels.Label) {
+			switch l.Name {
+			case traceIDLabel:
+				if traceID, err := hex.DecodeString(l.Value); err == nil && len(traceID) == 16 {
+					exemplar.SetTraceID(pcommon.TraceID(traceID))
+					return
+				}
+			case spanIDLabel:
+				if spanID, err := hex.DecodeString(l.Value); err == nil && len(spanID) == 8 {
+					exemplar.SetSpanID(pcommon.SpanID(spanID))
+					return
+				}
+			}
+			exemplar.FilteredAttributes().PutStr(l.Name, l.Value)
+		})
+		stats.Exemplars++
+	}
+	return errs
 }
 
 // setMetric append a new empty metric and assign the name, unit and description to it.
@@ -1563,6 +1563,164 @@ func TestTranslateV2(t *testing.T) {
 				return metrics
 			}(),
 		},
+		{
+			name: "counter with exemplars",
+			request: &writev2.Request{
+				Symbols: []string{
+					"",
+					"__name__", "test_counter_total",
+					"job", "test",
+					"instance", "localhost:8080",
+					"trace_id", "4bf92f3577b34da6a3ce929d0e0e4736",
+					"span_id", "00f067aa0ba902b7",
+					"user", "alice",
+				},
+				Timeseries: []writev2.TimeSeries{
+					{
+						Metadata:   writev2.Metadata{Type: writev2.Metadata_METRIC_TYPE_COUNTER},
+						LabelsRefs: []uint32{1, 2, 3, 4, 5, 6},
+						Samples: []writev2.Sample{
+							{Value: 10, Timestamp: 1000, StartTimestamp: 1},
+							{Value: 20, Timestamp: 2000, StartTimestamp: 1},
+						},
+						Exemplars: []writev2.Exemplar{
+							{LabelsRefs: []uint32{7, 8, 9, 10}, Value: 1.5, Timestamp: 900},
+							{LabelsRefs: []uint32{11, 12, 7, 4}, Value: 2.5, Timestamp: 1500},
+						},
+					},
+				},
+			},
+			expectedStats: remote.WriteResponseStats{
+				Confirmed:  true,
+				Samples:    2,
+				Histograms: 0,
+				Exemplars:  2,
+			},
+			expectedMetrics: func() pmetric.Metrics {
+				metrics := pmetric.NewMetrics()
+				rm := metrics.ResourceMetrics().AppendEmpty()
+				attrs := rm.Resource().Attributes()
+				attrs.PutStr("service.name", "test")
+				attrs.PutStr("service.instance.id", "localhost:8080")
+
+				sm := rm.ScopeMetrics().AppendEmpty()
+				sm.Scope().SetName("OpenTelemetry Collector")
+				sm.Scope().SetVersion("latest")
+				m := sm.Metrics().AppendEmpty()
+				m.SetName("test_counter_total")
+				m.Metadata().PutStr(prometheus.MetricMetadataTypeKey, "counter")
+				sum := m.SetEmptySum()
+				sum.SetIsMonotonic(true)
+				sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+
+				dp1 := sum.DataPoints().AppendEmpty()
+				dp1.SetStartTimestamp(pcommon.Timestamp(1 * int64(time.Millisecond)))
+				dp1.SetTimestamp(pcommon.Timestamp(1000 * int64(time.Millisecond)))
+				dp1.SetDoubleValue(10)
+				e1 := dp1.Exemplars().AppendEmpty()
+				e1.SetTimestamp(pcommon.Timestamp(900 * int64(time.Millisecond)))
+				e1.SetDoubleValue(1.5)
+				e1.SetTraceID(pcommon.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36})
+				e1.SetSpanID(pcommon.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7})
+
+				dp2 := sum.DataPoints().AppendEmpty()
+				dp2.SetStartTimestamp(pcommon.Timestamp(1 * int64(time.Millisecond)))
+				dp2.SetTimestamp(pcommon.Timestamp(2000 * int64(time.Millisecond)))
+				dp2.SetDoubleValue(20)
+				e2 := dp2.Exemplars().AppendEmpty()
+				e2.SetTimestamp(pcommon.Timestamp(1500 * int64(time.Millisecond)))
+				e2.SetDoubleValue(2.5)
+				// A trace_id which is not a valid trace ID is kept as an attribute.
+				e2.FilteredAttributes().PutStr("trace_id", "test")
+				e2.FilteredAttributes().PutStr("user", "alice")
+
+				return metrics
+			}(),
+		},
+		{
+			name: "NHCB with exemplar",
+			request: &writev2.Request{
+				Symbols: []string{
+					"",
+					"__name__", "test_hncb_histogram",
+					"job", "test",
+					"instance", "localhost:8080",
+					"trace_id", "4bf92f3577b34da6a3ce929d0e0e4736",
+				},
+				Timeseries: []writev2.TimeSeries{
+					{
+						LabelsRefs: []uint32{1, 2, 3, 4, 5, 6},
+						Metadata:   writev2.Metadata{Type: writev2.Metadata_METRIC_TYPE_HISTOGRAM},
+						Histograms: []writev2.Histogram{
+							{
+								Timestamp:      123456789,
+								StartTimestamp: 123456000,
+								Schema:         -53,
+								Sum:            100.5,
+								Count:          &writev2.Histogram_CountInt{CountInt: 180},
+								CustomValues:   []float64{1.0, 2.0, 5.0, 10.0},
+								PositiveSpans:  []writev2.BucketSpan{{Offset: 0, Length: 5}},
+								PositiveDeltas: []int64{10, 15, 20, 5, 0},
+							},
+						},
+						Exemplars: []writev2.Exemplar{
+							{LabelsRefs: []uint32{7, 8}, Value: 3, Timestamp: 123456700},
+						},
+					},
+				},
+			},
+			expectedStats: remote.WriteResponseStats{
+				Confirmed:  true,
+				Samples:    0,
+				Histograms: 1,
+				Exemplars:  1,
+			},
+			expectedMetrics: func() pmetric.Metrics {
+				metrics := pmetric.NewMetrics()
+				rm := metrics.ResourceMetrics().AppendEmpty()
+				attrs := rm.Resource().Attributes()
+				attrs.PutStr("service.name", "test")
+				attrs.PutStr("service.instance.id", "localhost:8080")
+
+				sm := rm.ScopeMetrics().AppendEmpty()
+				sm.Scope().SetName("OpenTelemetry Collector")
+				sm.Scope().SetVersion("latest")
+				m1 := sm.Metrics().AppendEmpty()
+				m1.SetName("test_hncb_histogram")
+				m1.Metadata().PutStr(prometheus.MetricMetadataTypeKey, "histogram")
+				hist := m1.SetEmptyHistogram()
+				hist.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+
+				dp := hist.DataPoints().AppendEmpty()
+				dp.SetStartTimestamp(pcommon.Timestamp(123456000 * int64(time.Millisecond)))
+				dp.SetTimestamp(pcommon.Timestamp(123456789 * int64(time.Millisecond)))
+				dp.SetSum(100.5)
+				dp.SetCount(180)
+				dp.ExplicitBounds().FromRaw([]float64{1.0, 2.0, 5.0, 10.0})
+				dp.BucketCounts().FromRaw([]uint64{10, 25, 45, 50, 50})
+				e := dp.Exemplars().AppendEmpty()
+				e.SetTimestamp(pcommon.Timestamp(123456700 * int64(time.Millisecond)))
+				e.SetDoubleValue(3)
+				e.SetTraceID(pcommon.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36})
+
+				return metrics
+			}(),
+		},
+		{
+			name: "exemplar labels out of bounds of symbols table",
+			request: &writev2.Request{
+				Symbols: []string{"", "__name__", "test_gauge"},
+				Timeseries: []writev2.TimeSeries{
+					{
+						Metadata:   writev2.Metadata{Type: writev2.Metadata_METRIC_TYPE_GAUGE},
+						LabelsRefs: []uint32{1, 2},
+						Samples:    []writev2.Sample{{Value: 1, Timestamp: 1}},
+						Exemplars:  []writev2.Exemplar{{LabelsRefs: []uint32{1, 3}, Value: 1, Timestamp: 1}},
+					},
+				},
+			},
+			expectError: `metric "test_gauge": error converting exemplar to labels`,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			// since we are using the rmCache to store values across requests, we need to clear it after each test, otherwise it will affect the next test
@@ -109,6 +109,8 @@ type MetricsConfig struct {
 	RabbitmqNodeSocketsUsed                     MetricConfig `mapstructure:"rabbitmq.node.sockets_used"`
 	RabbitmqNodeSocketsUsedDetailsRate          MetricConfig `mapstructure:"rabbitmq.node.sockets_used_details.rate"`
 	RabbitmqNodeUptime                          MetricConfig `mapstructure:"rabbitmq.node.uptime"`
+	RabbitmqQueueMembers                        MetricConfig `mapstructure:"rabbitmq.queue.members"`
+	RabbitmqQueueMembersOnline                  MetricConfig `mapstructure:"rabbitmq.queue.members.online"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -353,6 +355,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		RabbitmqNodeUptime: MetricConfig{
 			Enabled: false,
 		},
+		RabbitmqQueueMembers: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqQueueMembersOnline: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -106,6 +106,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RabbitmqNodeSocketsUsed:                     MetricConfig{Enabled: true},
 					RabbitmqNodeSocketsUsedDetailsRate:          MetricConfig{Enabled: true},
 					RabbitmqNodeUptime:                          MetricConfig{Enabled: true},
+					RabbitmqQueueMembers:                        MetricConfig{Enabled: true},
+					RabbitmqQueueMembersOnline:                  MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					RabbitmqNodeName:  ResourceAttributeConfig{Enabled: true},
@@ -198,6 +200,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RabbitmqNodeSocketsUsed:                     MetricConfig{Enabled: false},
 					RabbitmqNodeSocketsUsedDetailsRate:          MetricConfig{Enabled: false},
 					RabbitmqNodeUptime:                          MetricConfig{Enabled: false},
+					RabbitmqQueueMembers:                        MetricConfig{Enabled: false},
+					RabbitmqQueueMembersOnline:                  MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					RabbitmqNodeName:  ResourceAttributeConfig{Enabled: false},
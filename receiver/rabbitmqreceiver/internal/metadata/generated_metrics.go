@@ -279,6 +279,12 @@ var MetricsInfo = metricsInfo{
 	RabbitmqNodeUptime: metricInfo{
 		Name: "rabbitmq.node.uptime",
 	},
+	RabbitmqQueueMembers: metricInfo{
+		Name: "rabbitmq.queue.members",
+	},
+	RabbitmqQueueMembersOnline: metricInfo{
+		Name: "rabbitmq.queue.members.online",
+	},
 }
 
 type metricsInfo struct {
@@ -362,6 +368,8 @@ type metricsInfo struct {
 	RabbitmqNodeSocketsUsed                     metricInfo
 	RabbitmqNodeSocketsUsedDetailsRate          metricInfo
 	RabbitmqNodeUptime                          metricInfo
+	RabbitmqQueueMembers                        metricInfo
+	RabbitmqQueueMembersOnline                  metricInfo
 }
 
 type metricInfo struct {
@@ -4530,6 +4538,110 @@ func newMetricRabbitmqNodeUptime(cfg MetricConfig) metricRabbitmqNodeUptime {
 	return m
 }
 
+type metricRabbitmqQueueMembers struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.queue.members metric with initial data.
+func (m *metricRabbitmqQueueMembers) init() {
+	m.data.SetName("rabbitmq.queue.members")
+	m.data.SetDescription("The number of replicas of a quorum queue or stream.")
+	m.data.SetUnit("{members}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRabbitmqQueueMembers) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqQueueMembers) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqQueueMembers) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqQueueMembers(cfg MetricConfig) metricRabbitmqQueueMembers {
+	m := metricRabbitmqQueueMembers{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqQueueMembersOnline struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.queue.members.online metric with initial data.
+func (m *metricRabbitmqQueueMembersOnline) init() {
+	m.data.SetName("rabbitmq.queue.members.online")
+	m.data.SetDescription("The number of online replicas of a quorum queue or stream.")
+	m.data.SetUnit("{members}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRabbitmqQueueMembersOnline) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqQueueMembersOnline) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqQueueMembersOnline) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqQueueMembersOnline(cfg MetricConfig) metricRabbitmqQueueMembersOnline {
+	m := metricRabbitmqQueueMembersOnline{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -4620,6 +4732,8 @@ type MetricsBuilder struct {
 	metricRabbitmqNodeSocketsUsed                     metricRabbitmqNodeSocketsUsed
 	metricRabbitmqNodeSocketsUsedDetailsRate          metricRabbitmqNodeSocketsUsedDetailsRate
 	metricRabbitmqNodeUptime                          metricRabbitmqNodeUptime
+	metricRabbitmqQueueMembers                        metricRabbitmqQueueMembers
+	metricRabbitmqQueueMembersOnline                  metricRabbitmqQueueMembersOnline
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -4725,6 +4839,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricRabbitmqNodeSocketsUsed:                     newMetricRabbitmqNodeSocketsUsed(mbc.Metrics.RabbitmqNodeSocketsUsed),
 		metricRabbitmqNodeSocketsUsedDetailsRate:          newMetricRabbitmqNodeSocketsUsedDetailsRate(mbc.Metrics.RabbitmqNodeSocketsUsedDetailsRate),
 		metricRabbitmqNodeUptime:                          newMetricRabbitmqNodeUptime(mbc.Metrics.RabbitmqNodeUptime),
+		metricRabbitmqQueueMembers:                        newMetricRabbitmqQueueMembers(mbc.Metrics.RabbitmqQueueMembers),
+		metricRabbitmqQueueMembersOnline:                  newMetricRabbitmqQueueMembersOnline(mbc.Metrics.RabbitmqQueueMembersOnline),
 		resourceAttributeIncludeFilter:                    make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:                    make(map[string]filter.Filter),
 	}
@@ -4895,6 +5011,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricRabbitmqNodeSocketsUsed.emit(ils.Metrics())
 	mb.metricRabbitmqNodeSocketsUsedDetailsRate.emit(ils.Metrics())
 	mb.metricRabbitmqNodeUptime.emit(ils.Metrics())
+	mb.metricRabbitmqQueueMembers.emit(ils.Metrics())
+	mb.metricRabbitmqQueueMembersOnline.emit(ils.Metrics())
 
 	for _, op := range options {
 		op.apply(rm)
@@ -5326,6 +5444,16 @@ func (mb *MetricsBuilder) RecordRabbitmqNodeUptimeDataPoint(ts pcommon.Timestamp
 	mb.metricRabbitmqNodeUptime.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqQueueMembersDataPoint adds a data point to rabbitmq.queue.members metric.
+func (mb *MetricsBuilder) RecordRabbitmqQueueMembersDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRabbitmqQueueMembers.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqQueueMembersOnlineDataPoint adds a data point to rabbitmq.queue.members.online metric.
+func (mb *MetricsBuilder) RecordRabbitmqQueueMembersOnlineDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRabbitmqQueueMembersOnline.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...MetricBuilderOption) {
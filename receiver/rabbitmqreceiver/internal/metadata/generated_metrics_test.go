@@ -312,6 +312,10 @@ func TestMetricsBuilder(t *testing.T) {
 
 			allMetricsCount++
 			mb.RecordRabbitmqNodeUptimeDataPoint(ts, 1)
+			allMetricsCount++
+			mb.RecordRabbitmqQueueMembersDataPoint(ts, 1)
+			allMetricsCount++
+			mb.RecordRabbitmqQueueMembersOnlineDataPoint(ts, 1)
 
 			rb := mb.NewResourceBuilder()
 			rb.SetRabbitmqNodeName("rabbitmq.node.name-val")
@@ -1462,6 +1466,34 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.queue.members":
+					assert.False(t, validatedMetrics["rabbitmq.queue.members"], "Found a duplicate in the metrics slice: rabbitmq.queue.members")
+					validatedMetrics["rabbitmq.queue.members"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of replicas of a quorum queue or stream.", ms.At(i).Description())
+					assert.Equal(t, "{members}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.queue.members.online":
+					assert.False(t, validatedMetrics["rabbitmq.queue.members.online"], "Found a duplicate in the metrics slice: rabbitmq.queue.members.online")
+					validatedMetrics["rabbitmq.queue.members.online"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of online replicas of a quorum queue or stream.", ms.At(i).Description())
+					assert.Equal(t, "{members}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				}
 			}
 		})
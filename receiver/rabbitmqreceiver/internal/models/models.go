@@ -9,12 +9,17 @@ type Queue struct {
 	Name  string `json:"name"`
 	Node  string `json:"node"`
 	VHost string `json:"vhost"`
+	Type  string `json:"type"`
 
 	// Metrics
 	Consumers              int64 `json:"consumers"`
 	UnacknowledgedMessages int64 `json:"messages_unacknowledged"`
 	ReadyMessages          int64 `json:"messages_ready"`
 
+	// Replicas of the quorum queues and streams
+	Members []string `json:"members"`
+	Online  []string `json:"online"`
+
 	// Embedded Metrics
 	MessageStats map[string]any `json:"message_stats"`
 }
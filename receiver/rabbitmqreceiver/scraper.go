@@ -128,6 +128,12 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 	r.mb.RecordRabbitmqMessageCurrentDataPoint(now, queue.UnacknowledgedMessages, metadata.AttributeMessageStateUnacknowledged)
 	r.mb.RecordRabbitmqMessageCurrentDataPoint(now, queue.ReadyMessages, metadata.AttributeMessageStateReady)
 
+	// Only the quorum queues and the streams are replicated
+	if queue.Type == "quorum" || queue.Type == "stream" {
+		r.mb.RecordRabbitmqQueueMembersDataPoint(now, int64(len(queue.Members)))
+		r.mb.RecordRabbitmqQueueMembersOnlineDataPoint(now, int64(len(queue.Online)))
+	}
+
 	for _, messageStatMetric := range messageStatMetrics {
 		// Get metric value
 		val, ok := queue.MessageStats[messageStatMetric]
@@ -284,3 +284,41 @@ func TestScraperScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestScraperReplicatedQueueMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.RabbitmqQueueMembers.Enabled = true
+	cfg.Metrics.RabbitmqQueueMembersOnline.Enabled = true
+
+	mockClient := mocks.MockClient{}
+	mockClient.On("GetQueues", mock.Anything).Return([]*models.Queue{
+		{Name: "orders", Type: "quorum", Members: []string{"rabbit@a", "rabbit@b", "rabbit@c"}, Online: []string{"rabbit@a", "rabbit@b"}},
+		{Name: "events", Type: "stream", Members: []string{"rabbit@a"}, Online: []string{"rabbit@a"}},
+		{Name: "tasks", Type: "classic"},
+	}, nil)
+	mockClient.On("GetNodes", mock.Anything).Return(nil, nil)
+
+	scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopSettings(metadata.Type))
+	scraper.client = &mockClient
+	actualMetrics, err := scraper.scrape(t.Context())
+	require.NoError(t, err)
+
+	members := map[string][2]int64{}
+	for _, rm := range actualMetrics.ResourceMetrics().All() {
+		queueName, ok := rm.Resource().Attributes().Get("rabbitmq.queue.name")
+		require.True(t, ok)
+		for _, m := range rm.ScopeMetrics().At(0).Metrics().All() {
+			switch m.Name() {
+			case "rabbitmq.queue.members":
+				v := members[queueName.Str()]
+				v[0] = m.Sum().DataPoints().At(0).IntValue()
+				members[queueName.Str()] = v
+			case "rabbitmq.queue.members.online":
+				v := members[queueName.Str()]
+				v[1] = m.Sum().DataPoints().At(0).IntValue()
+				members[queueName.Str()] = v
+			}
+		}
+	}
+	require.Equal(t, map[string][2]int64{"orders": {3, 2}, "events": {1, 1}}, members)
+}
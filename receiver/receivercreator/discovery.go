@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"net"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/go-viper/mapstructure/v2"
@@ -30,11 +32,31 @@ const (
 	discoveryEnabledHint = "enabled"
 	scraperHint          = "scraper"
 	configHint           = "config"
+	portHint             = "port"
+	pathHint             = "path"
+	parserHint           = "parser"
 
 	logsReceiver          = "filelog"
 	defaultLogPathPattern = "/var/log/pods/%s_%s_%s/%s/*.log"
+
+	// podLogsContainerPattern matches the log files of all the Pod's containers
+	podLogsContainerPattern = "*"
+
+	metricsPathConfigKey = "metrics_path"
 )
 
+// metricsPathConfigKeys lists the scrapers exposing the metrics path as a dedicated setting.
+// For the other scrapers the path hint is appended to the endpoint.
+var metricsPathConfigKeys = map[string]string{
+	"prometheus_simple": metricsPathConfigKey,
+}
+
+// logParsers maps the parser hint values to the filelog operator parsing the log body.
+var logParsers = map[string]map[string]any{
+	"json":      {"id": "json-parser", "type": "json_parser"},
+	"key_value": {"id": "key-value-parser", "type": "key_value_parser"},
+}
+
 // k8sHintsBuilder creates configurations from hints provided as Pod's annotations.
 type k8sHintsBuilder struct {
 	logger             *zap.Logger
@@ -54,11 +76,13 @@ func createK8sHintsBuilder(config DiscoveryConfig, logger *zap.Logger) k8sHintsB
 	}
 }
 
-// createReceiverTemplateFromHints creates a receiver configuration based on the provided hints.
+// createReceiverTemplatesFromHints creates receiver configurations based on the provided hints.
 // Hints are extracted from Pod's annotations.
 // Scraper configurations are only created for Port Endpoints.
 // Log receiver configurations are only created for Pod Container Endpoints.
-func (builder *k8sHintsBuilder) createReceiverTemplateFromHints(env observer.EndpointEnv) (*receiverTemplate, error) {
+// Pod Endpoints create both a scraper and a log receiver configuration when
+// the Pod is annotated with io.opentelemetry.discovery/enabled.
+func (builder *k8sHintsBuilder) createReceiverTemplatesFromHints(env observer.EndpointEnv) ([]*receiverTemplate, error) {
 	var pod observer.Pod
 
 	endpointType := getStringEnv(env, "type")
@@ -66,7 +90,11 @@ func (builder *k8sHintsBuilder) createReceiverTemplateFromHints(env observer.End
 		return nil, fmt.Errorf("could not get endpoint type: %v", zap.Any("env", env))
 	}
 
-	if endpointType != string(observer.PortType) && endpointType != string(observer.PodContainerType) {
+	switch endpointType {
+	case string(observer.PodType):
+		return builder.createPodReceivers(env)
+	case string(observer.PortType), string(observer.PodContainerType):
+	default:
 		return nil, nil
 	}
 
@@ -82,14 +110,22 @@ func (builder *k8sHintsBuilder) createReceiverTemplateFromHints(env observer.End
 	}
 
 	annotations := mergeAnnotations(pod.Annotations, builder.defaultAnnotations)
+	var recTemplate *receiverTemplate
+	var err error
 	switch endpointType {
 	case string(observer.PortType):
-		return builder.createScraper(annotations, env)
+		recTemplate, err = builder.createScraper(annotations, env)
 	case string(observer.PodContainerType):
-		return builder.createLogsReceiver(annotations, env)
-	default:
+		recTemplate, err = builder.createLogsReceiver(annotations, env)
+	}
+	if recTemplate == nil || err != nil {
+		return nil, err
+	}
+	if builder.createdForPod(annotations, pod.UID, endpointType, recTemplate) {
+		builder.logger.Debug("skipping hinted receiver created for the pod", zap.String("receiver", recTemplate.id.String()))
 		return nil, nil
 	}
+	return []*receiverTemplate{recTemplate}, nil
 }
 
 func (builder *k8sHintsBuilder) createScraper(
@@ -112,7 +148,17 @@ func (builder *k8sHintsBuilder) createScraper(
 		return nil, nil
 	}
 
-	subreceiverKey, found := getHintAnnotation(annotations, otelMetricsHints, scraperHint, fmt.Sprint(port))
+	defaultEndpoint := getStringEnv(env, endpointConfigKey)
+	return builder.createScraperTemplate(annotations, pod.UID, fmt.Sprint(port), defaultEndpoint, "`endpoint`")
+}
+
+// createScraperTemplate creates the scraper configuration of a Pod's port. scraperEndpoint is the
+// endpoint the scraper targets when the path hint requires to set the endpoint explicitly.
+func (builder *k8sHintsBuilder) createScraperTemplate(
+	annotations map[string]string,
+	podUID, port, defaultEndpoint, scraperEndpoint string,
+) (*receiverTemplate, error) {
+	subreceiverKey, found := getHintAnnotation(annotations, otelMetricsHints, scraperHint, port)
 	if !found || subreceiverKey == "" {
 		// no scraper hint detected
 		return nil, nil
@@ -123,13 +169,16 @@ func (builder *k8sHintsBuilder) createScraper(
 	}
 	builder.logger.Debug("handling added hinted receiver", zap.Any("subreceiverKey", subreceiverKey))
 
-	defaultEndpoint := getStringEnv(env, endpointConfigKey)
-	userConfMap, err := getScraperConfFromAnnotations(annotations, defaultEndpoint, fmt.Sprint(port), builder.logger)
+	userConfMap, err := getScraperConfFromAnnotations(annotations, defaultEndpoint, port, builder.logger)
 	if err != nil {
 		return nil, fmt.Errorf("could not create receiver configuration: %v", zap.Error(err))
 	}
 
-	recTemplate, err := newReceiverTemplate(fmt.Sprintf("%v/%v_%v", subreceiverKey, pod.UID, port), userConfMap)
+	if path, found := getHintAnnotation(annotations, otelMetricsHints, pathHint, port); found && path != "" {
+		setMetricsPath(userConfMap, subreceiverKey, path, scraperEndpoint)
+	}
+
+	recTemplate, err := newReceiverTemplate(fmt.Sprintf("%v/%v_%v", subreceiverKey, podUID, port), userConfMap)
 	recTemplate.signals = receiverSignals{metrics: true, logs: false, traces: false}
 
 	return &recTemplate, err
@@ -177,6 +226,111 @@ func (builder *k8sHintsBuilder) createLogsReceiver(
 	return &recTemplate, err
 }
 
+// createPodReceivers creates both the scraper and the log receiver configurations of a Pod
+// annotated with io.opentelemetry.discovery/enabled. The scraper targets the port provided with
+// the metrics port hint and the log receiver collects the logs of all the Pod's containers.
+func (builder *k8sHintsBuilder) createPodReceivers(env observer.EndpointEnv) ([]*receiverTemplate, error) {
+	var pod observer.Pod
+	if err := mapstructure.Decode(env, &pod); err != nil {
+		return nil, fmt.Errorf("could not extract pod: %v", zap.Any("env", env))
+	}
+
+	annotations := mergeAnnotations(pod.Annotations, builder.defaultAnnotations)
+	if enabled := annotations[fmt.Sprintf("%s/%s", otelHints, discoveryEnabledHint)]; enabled != "true" {
+		return nil, nil
+	}
+
+	builder.logger.Debug("handling hints for added endpoint", zap.Any("env", env))
+
+	var templates []*receiverTemplate
+	if port, found := getHintAnnotation(annotations, otelMetricsHints, portHint, ""); found && port != "" {
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return nil, fmt.Errorf("invalid port hint %q: %w", port, err)
+		}
+		// the endpoint of the Pod's port, in the same form as the one of a Port endpoint
+		defaultEndpoint := net.JoinHostPort(getStringEnv(env, endpointConfigKey), port)
+		recTemplate, err := builder.createScraperTemplate(annotations, pod.UID, port, defaultEndpoint, defaultEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		if recTemplate != nil {
+			setPodScraperEndpoint(recTemplate.config, defaultEndpoint)
+			templates = append(templates, recTemplate)
+		}
+	}
+
+	if builder.podLogsEnabled(annotations) {
+		userConfMap := createPodLogsConfig(annotations, pod.UID, pod.Name, pod.Namespace, builder.logger)
+		recTemplate, err := newReceiverTemplate(fmt.Sprintf("%v/%v", logsReceiver, pod.UID), userConfMap)
+		if err != nil {
+			return nil, err
+		}
+		recTemplate.signals = receiverSignals{metrics: false, logs: true, traces: false}
+		templates = append(templates, &recTemplate)
+	}
+
+	return templates, nil
+}
+
+// podLogsEnabled tells whether the log receiver of a Pod annotated with io.opentelemetry.discovery/enabled is created.
+func (builder *k8sHintsBuilder) podLogsEnabled(annotations map[string]string) bool {
+	if _, ignored := builder.ignoreReceivers[logsReceiver]; ignored {
+		return false
+	}
+	enabled, found := getHintAnnotation(annotations, otelLogsHints, discoveryEnabledHint, "")
+	return !found || enabled == "true"
+}
+
+// createdForPod tells whether the receiver created from the hints of a Port or a Pod Container endpoint
+// duplicates a receiver created from the hints of its Pod endpoint: a scraper with the same receiver ID,
+// which is made of the Pod's UID and the port and so targets the same endpoint, or a log receiver, as the
+// one of the Pod collects the logs of all its containers.
+func (builder *k8sHintsBuilder) createdForPod(annotations map[string]string, podUID, endpointType string, recTemplate *receiverTemplate) bool {
+	if enabled := annotations[fmt.Sprintf("%s/%s", otelHints, discoveryEnabledHint)]; enabled != "true" {
+		return false
+	}
+	switch endpointType {
+	case string(observer.PortType):
+		port, found := getHintAnnotation(annotations, otelMetricsHints, portHint, "")
+		if !found || port == "" {
+			return false
+		}
+		subreceiverKey, found := getHintAnnotation(annotations, otelMetricsHints, scraperHint, port)
+		if !found || subreceiverKey == "" {
+			return false
+		}
+		return recTemplate.id.String() == fmt.Sprintf("%v/%v_%v", subreceiverKey, podUID, port)
+	case string(observer.PodContainerType):
+		return builder.podLogsEnabled(annotations)
+	}
+	return false
+}
+
+// setPodScraperEndpoint sets the endpoint of a scraper created for a Pod endpoint. The `endpoint`
+// variable of a Pod endpoint resolves to the Pod's IP, so the endpoint of the hinted port is used instead.
+func setPodScraperEndpoint(conf userConfigMap, podPortEndpoint string) {
+	endpoint, ok := conf[endpointConfigKey].(string)
+	if !ok {
+		conf[endpointConfigKey] = podPortEndpoint
+		return
+	}
+	conf[endpointConfigKey] = strings.ReplaceAll(endpoint, "`endpoint`", podPortEndpoint)
+}
+
+// setMetricsPath sets the path provided with the path hint unless it is already part of the
+// configuration provided with the config hint.
+func setMetricsPath(conf userConfigMap, scraper, path, endpoint string) {
+	if key, ok := metricsPathConfigKeys[scraper]; ok {
+		if _, set := conf[key]; !set {
+			conf[key] = path
+		}
+		return
+	}
+	if _, set := conf[endpointConfigKey]; !set {
+		conf[endpointConfigKey] = "http://" + endpoint + "/" + strings.TrimPrefix(path, "/")
+	}
+}
+
 func getScraperConfFromAnnotations(
 	annotations map[string]string,
 	defaultEndpoint, scopeSuffix string,
@@ -224,9 +378,33 @@ func createLogsConfig(
 	containerName, podUID, podName, namespace string,
 	logger *zap.Logger,
 ) userConfigMap {
-	scopeSuffix := containerName
 	logPath := fmt.Sprintf(defaultLogPathPattern, namespace, podName, podUID, containerName)
+	return createLogsConfigForPath(annotations, logPath, containerName, logger)
+}
+
+// createPodLogsConfig creates the filelog configuration collecting the logs of all the Pod's containers.
+func createPodLogsConfig(
+	annotations map[string]string,
+	podUID, podName, namespace string,
+	logger *zap.Logger,
+) userConfigMap {
+	logPath := fmt.Sprintf(defaultLogPathPattern, namespace, podName, podUID, podLogsContainerPattern)
+	return createLogsConfigForPath(annotations, logPath, "", logger)
+}
+
+func createLogsConfigForPath(
+	annotations map[string]string,
+	logPath, scopeSuffix string,
+	logger *zap.Logger,
+) userConfigMap {
 	cont := []any{map[string]any{"id": "container-parser", "type": "container"}}
+	if parserName, found := getHintAnnotation(annotations, otelLogsHints, parserHint, scopeSuffix); found && parserName != "" {
+		if parser, ok := logParsers[parserName]; ok {
+			cont = append(cont, maps.Clone(parser))
+		} else {
+			logger.Warn("unsupported parser hint", zap.String("parser", parserName))
+		}
+	}
 	defaultConfMap := userConfigMap{
 		"include":           []string{logPath},
 		"include_file_path": true,
@@ -259,9 +437,11 @@ func createLogsConfig(
 func getHintAnnotation(annotations map[string]string, hintBase, hintKey, suffix string) (string, bool) {
 	// try to scope the hint more on container level by suffixing
 	// with .<port> in case of Port event or .<container_name> in case of Pod Container event
-	containerLevelHint, ok := annotations[fmt.Sprintf("%s.%s/%s", hintBase, suffix, hintKey)]
-	if ok {
-		return containerLevelHint, ok
+	if suffix != "" {
+		containerLevelHint, ok := annotations[fmt.Sprintf("%s.%s/%s", hintBase, suffix, hintKey)]
+		if ok {
+			return containerLevelHint, ok
+		}
 	}
 
 	// if there is no container level hint defined try to use the Pod level hint
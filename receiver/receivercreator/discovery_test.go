@@ -4,6 +4,7 @@
 package receivercreator
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -218,11 +219,16 @@ endpoint: 1.2.3.4:6379`
 			builder := createK8sHintsBuilder(DiscoveryConfig{Enabled: true, IgnoreReceivers: test.ignoreReceivers}, logger)
 			env, err := test.inputEndpoint.Env()
 			require.NoError(t, err)
-			subreceiverTemplate, err := builder.createReceiverTemplateFromHints(env)
-			if subreceiverTemplate == nil {
+			subreceiverTemplates, err := builder.createReceiverTemplatesFromHints(env)
+			if len(subreceiverTemplates) == 0 {
+				if test.wantError {
+					require.Error(t, err)
+				}
 				require.Equal(t, receiverTemplate{}, test.expectedReceiver)
 				return
 			}
+			require.Len(t, subreceiverTemplates, 1)
+			subreceiverTemplate := subreceiverTemplates[0]
 			if !test.wantError {
 				require.NoError(t, err)
 				require.Equal(t, subreceiverTemplate.config, test.expectedReceiver.config)
@@ -588,11 +594,16 @@ include:
 				logger)
 			env, err := test.inputEndpoint.Env()
 			require.NoError(t, err)
-			subreceiverTemplate, err := builder.createReceiverTemplateFromHints(env)
-			if subreceiverTemplate == nil {
+			subreceiverTemplates, err := builder.createReceiverTemplatesFromHints(env)
+			if len(subreceiverTemplates) == 0 {
+				if test.wantError {
+					require.Error(t, err)
+				}
 				require.Equal(t, receiverTemplate{}, test.expectedReceiver)
 				return
 			}
+			require.Len(t, subreceiverTemplates, 1)
+			subreceiverTemplate := subreceiverTemplates[0]
 			if !test.wantError {
 				require.NoError(t, err)
 				require.Equal(t, subreceiverTemplate.config, test.expectedReceiver.config)
@@ -605,6 +616,162 @@ include:
 	}
 }
 
+func TestK8sHintsBuilderPod(t *testing.T) {
+	logger := zaptest.NewLogger(t, zaptest.Level(zap.InfoLevel))
+
+	podEndpointWithAnnotations := func(annotations map[string]string) observer.Endpoint {
+		return observer.Endpoint{
+			ID:     "namespace/pod-2-UID",
+			Target: "1.2.3.4",
+			Details: &observer.Pod{
+				Name:        "pod-2",
+				Namespace:   "default",
+				UID:         "pod-2-UID",
+				Annotations: annotations,
+			},
+		}
+	}
+	podLogs := receiverTemplate{
+		receiverConfig: receiverConfig{
+			id: component.MustNewIDWithName("filelog", "pod-2-UID"),
+			config: userConfigMap{
+				"include":           []string{"/var/log/pods/default_pod-2_pod-2-UID/*/*.log"},
+				"include_file_name": false,
+				"include_file_path": true,
+				"operators": []any{
+					map[string]any{"id": "container-parser", "type": "container"},
+				},
+			},
+		}, signals: receiverSignals{metrics: false, logs: true, traces: false},
+	}
+
+	tests := map[string]struct {
+		inputEndpoint     observer.Endpoint
+		expectedReceivers []receiverTemplate
+		ignoreReceivers   []string
+		wantError         bool
+	}{
+		`metrics_and_logs`: {
+			inputEndpoint: podEndpointWithAnnotations(map[string]string{
+				otelHints + "/enabled":          "true",
+				otelMetricsHints + "/scraper":   "prometheus_simple",
+				otelMetricsHints + "/port":      "9090",
+				otelMetricsHints + "/path":      "/custom/metrics",
+				otelLogsHints + "/parser":       "json",
+				otelMetricsHints + ".80/port":   "80",
+				otelLogsHints + ".redis/parser": "key_value",
+			}),
+			expectedReceivers: []receiverTemplate{
+				{
+					receiverConfig: receiverConfig{
+						id:     component.MustNewIDWithName("prometheus_simple", "pod-2-UID_9090"),
+						config: userConfigMap{"endpoint": "1.2.3.4:9090", "metrics_path": "/custom/metrics"},
+					}, signals: receiverSignals{metrics: true, logs: false, traces: false},
+				},
+				{
+					receiverConfig: receiverConfig{
+						id: component.MustNewIDWithName("filelog", "pod-2-UID"),
+						config: userConfigMap{
+							"include":           []string{"/var/log/pods/default_pod-2_pod-2-UID/*/*.log"},
+							"include_file_name": false,
+							"include_file_path": true,
+							"operators": []any{
+								map[string]any{"id": "container-parser", "type": "container"},
+								map[string]any{"id": "json-parser", "type": "json_parser"},
+							},
+						},
+					}, signals: receiverSignals{metrics: false, logs: true, traces: false},
+				},
+			},
+		}, `metrics_path_in_endpoint`: {
+			inputEndpoint: podEndpointWithAnnotations(map[string]string{
+				otelHints + "/enabled":        "true",
+				otelMetricsHints + "/scraper": "nginx",
+				otelMetricsHints + "/port":    "80",
+				otelMetricsHints + "/path":    "nginx_status",
+				otelLogsHints + "/enabled":    "false",
+			}),
+			expectedReceivers: []receiverTemplate{
+				{
+					receiverConfig: receiverConfig{
+						id:     component.MustNewIDWithName("nginx", "pod-2-UID_80"),
+						config: userConfigMap{"endpoint": "http://1.2.3.4:80/nginx_status"},
+					}, signals: receiverSignals{metrics: true, logs: false, traces: false},
+				},
+			},
+		}, `metrics_config_endpoint`: {
+			inputEndpoint: podEndpointWithAnnotations(map[string]string{
+				otelHints + "/enabled":        "true",
+				otelMetricsHints + "/scraper": "nginx",
+				otelMetricsHints + "/port":    "80",
+				otelMetricsHints + "/config":  "endpoint: \"http://`endpoint`/status\"",
+				otelLogsHints + "/enabled":    "false",
+			}),
+			expectedReceivers: []receiverTemplate{
+				{
+					receiverConfig: receiverConfig{
+						id:     component.MustNewIDWithName("nginx", "pod-2-UID_80"),
+						config: userConfigMap{"endpoint": "http://1.2.3.4:80/status"},
+					}, signals: receiverSignals{metrics: true, logs: false, traces: false},
+				},
+			},
+		}, `logs_only_without_port`: {
+			inputEndpoint: podEndpointWithAnnotations(map[string]string{
+				otelHints + "/enabled":        "true",
+				otelMetricsHints + "/scraper": "redis",
+			}),
+			expectedReceivers: []receiverTemplate{podLogs},
+		}, `ignored_receivers`: {
+			inputEndpoint: podEndpointWithAnnotations(map[string]string{
+				otelHints + "/enabled":        "true",
+				otelMetricsHints + "/scraper": "redis",
+				otelMetricsHints + "/port":    "6379",
+			}),
+			ignoreReceivers: []string{"redis", "filelog"},
+		}, `not_enabled`: {
+			inputEndpoint: podEndpointWithAnnotations(map[string]string{
+				otelMetricsHints + "/scraper": "redis",
+				otelMetricsHints + "/port":    "6379",
+			}),
+		}, `invalid_port`: {
+			inputEndpoint: podEndpointWithAnnotations(map[string]string{
+				otelHints + "/enabled":        "true",
+				otelMetricsHints + "/scraper": "redis",
+				otelMetricsHints + "/port":    "http",
+			}),
+			wantError: true,
+		}, `invalid_config_endpoint`: {
+			inputEndpoint: podEndpointWithAnnotations(map[string]string{
+				otelHints + "/enabled":        "true",
+				otelMetricsHints + "/scraper": "redis",
+				otelMetricsHints + "/port":    "6379",
+				otelMetricsHints + "/config":  "endpoint: 5.6.7.8:6379",
+			}),
+			wantError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := createK8sHintsBuilder(DiscoveryConfig{Enabled: true, IgnoreReceivers: test.ignoreReceivers}, logger)
+			env, err := test.inputEndpoint.Env()
+			require.NoError(t, err)
+			subreceiverTemplates, err := builder.createReceiverTemplatesFromHints(env)
+			if test.wantError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, subreceiverTemplates, len(test.expectedReceivers))
+			for i, expected := range test.expectedReceivers {
+				require.Equal(t, expected.id, subreceiverTemplates[i].id)
+				require.Equal(t, expected.config, subreceiverTemplates[i].config)
+				require.Equal(t, expected.signals, subreceiverTemplates[i].signals)
+			}
+		})
+	}
+}
+
 func TestGetConfFromAnnotations(t *testing.T) {
 	config := `
 endpoint: "0.0.0.0:8080"
@@ -710,7 +877,31 @@ operators:
 		expectedConf    userConfigMap
 		defaultEndpoint string
 	}{
-		"simple_annotation_case": {
+		"parser_annotation_case": {
+			hintsAnn: map[string]string{
+				"io.opentelemetry.discovery.logs/parser":              "json",
+				"io.opentelemetry.discovery.logs.my-container/parser": "key_value",
+			}, expectedConf: userConfigMap{
+				"include":           []string{"/var/log/pods/my-ns_my-pod_my-uid/my-container/*.log"},
+				"include_file_name": false,
+				"include_file_path": true,
+				"operators": []any{
+					map[string]any{"id": "container-parser", "type": "container"},
+					map[string]any{"id": "key-value-parser", "type": "key_value_parser"},
+				},
+			}, defaultEndpoint: "1.2.3.4:8080",
+		}, "unsupported_parser_annotation_case": {
+			hintsAnn: map[string]string{
+				"io.opentelemetry.discovery.logs/parser": "xml",
+			}, expectedConf: userConfigMap{
+				"include":           []string{"/var/log/pods/my-ns_my-pod_my-uid/my-container/*.log"},
+				"include_file_name": false,
+				"include_file_path": true,
+				"operators": []any{
+					map[string]any{"id": "container-parser", "type": "container"},
+				},
+			}, defaultEndpoint: "1.2.3.4:8080",
+		}, "simple_annotation_case": {
 			hintsAnn: map[string]string{
 				"io.opentelemetry.discovery.logs/config": config,
 			}, expectedConf: userConfigMap{
@@ -853,3 +1044,78 @@ func TestValidateEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestK8sHintsBuilderPodDuplicates(t *testing.T) {
+	logger := zaptest.NewLogger(t, zaptest.Level(zap.InfoLevel))
+
+	pod := func(annotations map[string]string) observer.Pod {
+		return observer.Pod{
+			Name:        "pod-2",
+			Namespace:   "default",
+			UID:         "pod-2-UID",
+			Annotations: annotations,
+		}
+	}
+	portEndpoint := func(annotations map[string]string, port uint16) observer.Endpoint {
+		return observer.Endpoint{
+			ID:      observer.EndpointID(fmt.Sprintf("namespace/pod-2-UID/redis(%d)", port)),
+			Target:  fmt.Sprintf("1.2.3.4:%d", port),
+			Details: &observer.Port{Name: "redis", Pod: pod(annotations), Port: port, Transport: observer.ProtocolTCP},
+		}
+	}
+	containerEndpoint := func(annotations map[string]string) observer.Endpoint {
+		return observer.Endpoint{
+			ID:      "namespace/pod-2-UID/redis",
+			Target:  "1.2.3.4",
+			Details: &observer.PodContainer{Name: "redis", ContainerID: "abc", Pod: pod(annotations)},
+		}
+	}
+	annotations := map[string]string{
+		otelHints + "/enabled":        "true",
+		otelMetricsHints + "/scraper": "redis",
+		otelMetricsHints + "/port":    "6379",
+		otelMetricsHints + "/enabled": "true",
+		otelLogsHints + "/enabled":    "true",
+	}
+	podLogsDisabled := map[string]string{
+		otelHints + "/enabled":           "true",
+		otelLogsHints + "/enabled":       "false",
+		otelLogsHints + ".redis/enabled": "true",
+	}
+
+	tests := map[string]struct {
+		inputEndpoint observer.Endpoint
+		expectedID    string
+	}{
+		`scraper_of_the_pod`: {
+			inputEndpoint: portEndpoint(annotations, 6379),
+		},
+		`scraper_of_another_port`: {
+			inputEndpoint: portEndpoint(annotations, 8080),
+			expectedID:    "redis/pod-2-UID_8080",
+		},
+		`logs_of_the_pod`: {
+			inputEndpoint: containerEndpoint(annotations),
+		},
+		`logs_not_collected_for_the_pod`: {
+			inputEndpoint: containerEndpoint(podLogsDisabled),
+			expectedID:    "filelog/pod-2-UID_redis",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := createK8sHintsBuilder(DiscoveryConfig{Enabled: true}, logger)
+			env, err := test.inputEndpoint.Env()
+			require.NoError(t, err)
+			subreceiverTemplates, err := builder.createReceiverTemplatesFromHints(env)
+			require.NoError(t, err)
+			if test.expectedID == "" {
+				assert.Empty(t, subreceiverTemplates)
+				return
+			}
+			require.Len(t, subreceiverTemplates, 1)
+			assert.Equal(t, test.expectedID, subreceiverTemplates[0].id.String())
+		})
+	}
+}
@@ -42,6 +42,9 @@ func createDefaultConfig() component.Config {
 			observer.K8sServiceType: map[string]string{
 				string(conventions.K8SNamespaceNameKey): "`namespace`",
 			},
+			observer.K8sServicePortType: map[string]string{
+				string(conventions.K8SNamespaceNameKey): "`service.namespace`",
+			},
 			observer.K8sIngressType: map[string]string{
 				string(conventions.K8SNamespaceNameKey): "`namespace`",
 			},
@@ -45,6 +45,18 @@ var serviceEndpoint = observer.Endpoint{
 	Details: &service,
 }
 
+var servicePortEndpoint = observer.Endpoint{
+	ID:     "service-1/http(80)",
+	Target: "service-1.default.svc.cluster.local:80",
+	Details: &observer.K8sServicePort{
+		Name:       "http",
+		Service:    service,
+		Port:       80,
+		TargetPort: "8080",
+		Transport:  observer.ProtocolTCP,
+	},
+}
+
 var portEndpoint = observer.Endpoint{
 	ID:     "port-1",
 	Target: "localhost:1234",
@@ -101,6 +113,23 @@ var podContainerEndpointWithHints = observer.Endpoint{
 	},
 }
 
+var podEndpointWithHints = observer.Endpoint{
+	ID:     "namespace/pod-2-UID",
+	Target: "1.2.3.4",
+	Details: &observer.Pod{
+		Name:      "pod-2",
+		Namespace: "default",
+		UID:       "pod-2-UID",
+		Labels:    map[string]string{"env": "prod"},
+		Annotations: map[string]string{
+			otelHints + "/enabled":        "true",
+			otelMetricsHints + "/scraper": "with_endpoint",
+			otelMetricsHints + "/port":    "6379",
+			otelMetricsHints + "/config":  config,
+		},
+	},
+}
+
 var hostportEndpoint = observer.Endpoint{
 	ID:     "port-1",
 	Target: "localhost:1234",
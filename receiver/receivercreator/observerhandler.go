@@ -83,14 +83,16 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 
 		if obs.config.Discovery.Enabled {
 			builder := createK8sHintsBuilder(obs.config.Discovery, obs.params.Logger)
-			subreceiverTemplate, err := builder.createReceiverTemplateFromHints(env)
+			subreceiverTemplates, err := builder.createReceiverTemplatesFromHints(env)
 			if err != nil {
 				obs.params.Logger.Error("could not extract configurations from K8s hints' annotations", zap.Error(err))
 				break
 			}
-			if subreceiverTemplate != nil {
-				obs.params.Logger.Debug("adding K8s hinted receiver", zap.Any("subreceiver", subreceiverTemplate))
-				obs.startReceiver(*subreceiverTemplate, env, e)
+			if len(subreceiverTemplates) > 0 {
+				for _, subreceiverTemplate := range subreceiverTemplates {
+					obs.params.Logger.Debug("adding K8s hinted receiver", zap.Any("subreceiver", subreceiverTemplate))
+					obs.startReceiver(*subreceiverTemplate, env, e)
+				}
 				continue
 			}
 		}
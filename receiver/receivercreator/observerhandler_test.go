@@ -355,6 +355,42 @@ func TestOnAddForLogsWithHints(t *testing.T) {
 	}
 }
 
+func TestOnAddForPodWithHints(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Discovery.Enabled = true
+
+	handler, mr := newObserverHandler(t, cfg, consumertest.NewNop(), consumertest.NewNop(), nil)
+	handler.OnAdd([]observer.Endpoint{
+		podEndpointWithHints,
+		unsupportedEndpoint,
+	})
+
+	receivers := handler.receiversByEndpointID.Get(podEndpointWithHints.ID)
+	require.Len(t, receivers, 2)
+	require.NoError(t, mr.lastError)
+
+	metricsReceiver, ok := receivers[0].(*wrappedReceiver)
+	require.True(t, ok)
+	require.Nil(t, metricsReceiver.logs)
+	require.Nil(t, metricsReceiver.traces)
+	scraper, ok := metricsReceiver.metrics.(*nopWithEndpointReceiver)
+	require.True(t, ok)
+	require.Equal(t, &nopWithEndpointConfig{IntField: 20, Endpoint: "1.2.3.4:6379"}, scraper.cfg)
+
+	logsReceiver, ok := receivers[1].(*wrappedReceiver)
+	require.True(t, ok)
+	require.Nil(t, logsReceiver.metrics)
+	require.Nil(t, logsReceiver.traces)
+	filelog, ok := logsReceiver.logs.(*nopWithEndpointReceiver)
+	require.True(t, ok)
+	require.Equal(t, &nopWithFilelogConfig{
+		Include:         []string{"/var/log/pods/default_pod-2_pod-2-UID/*/*.log"},
+		IncludeFileName: false,
+		IncludeFilePath: true,
+		Operators:       []any{map[string]any{"id": "container-parser", "type": "container"}},
+	}, filelog.cfg)
+}
+
 func TestOnAddForTraces(t *testing.T) {
 	for _, test := range []struct {
 		name                   string
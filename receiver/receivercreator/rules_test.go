@@ -29,6 +29,8 @@ func Test_ruleEval(t *testing.T) {
 		{"basic hostport", args{`type == "hostport" && port == 1234 && process_name == "splunk"`, hostportEndpoint}, true, false},
 		{"basic pod", args{`type == "pod" && labels["region"] == "west-1"`, podEndpoint}, true, false},
 		{"basic service", args{`type == "k8s.service" && labels["region"] == "west-1"`, serviceEndpoint}, true, false},
+		{"basic service port", args{`type == "k8s.service.port" && port == 80 && service.labels["region"] == "west-1"`, servicePortEndpoint}, true, false},
+		{"service rule on service port", args{`type == "k8s.service" && labels["region"] == "west-1"`, servicePortEndpoint}, false, false},
 		{"annotations", args{`type == "pod" && annotations["scrape"] == "true"`, podEndpoint}, true, false},
 		{"basic container", args{`type == "container" && labels["region"] == "east-1"`, containerEndpoint}, true, false},
 		{"basic k8s.node", args{`type == "k8s.node" && kubelet_endpoint_port == 10250`, k8sNodeEndpoint}, true, false},
@@ -5,6 +5,7 @@ package redisreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"errors"
 
 	"github.com/redis/go-redis/v9"
 )
@@ -15,6 +16,10 @@ type client interface {
 	retrieveInfo() (string, error)
 	// retrieves a string of key/value pairs of redis cluster metadata
 	retrieveClusterInfo() (string, error)
+	// retrieves the latency spikes recorded by the latency monitor for an event
+	retrieveLatencyHistory(event string) ([]latencySample, error)
+	// subscribes to the channels matching the given patterns
+	subscribe(ctx context.Context, patterns []string) <-chan *redis.Message
 	// line delimiter
 	// redis lines are delimited by \r\n, files (for testing) by \n
 	delimiter() string
@@ -25,6 +30,7 @@ type client interface {
 // Wraps a real Redis client, implements `client` interface.
 type redisClient struct {
 	client *redis.Client
+	pubsub *redis.PubSub
 }
 
 var _ client = (*redisClient)(nil)
@@ -51,7 +57,27 @@ func (c *redisClient) retrieveClusterInfo() (string, error) {
 	return c.client.ClusterInfo(context.Background()).Result()
 }
 
+// Retrieve Redis LATENCY HISTORY of an event.
+func (c *redisClient) retrieveLatencyHistory(event string) ([]latencySample, error) {
+	reply, err := c.client.Do(context.Background(), "LATENCY", "HISTORY", event).Slice()
+	if err != nil {
+		return nil, err
+	}
+	return parseLatencyHistory(reply)
+}
+
+// Subscribe to the channels matching the patterns using PSUBSCRIBE.
+// The subscription is closed along with the client.
+func (c *redisClient) subscribe(ctx context.Context, patterns []string) <-chan *redis.Message {
+	c.pubsub = c.client.PSubscribe(ctx, patterns...)
+	return c.pubsub.Channel()
+}
+
 // close client to release connection pool.
 func (c *redisClient) close() error {
-	return c.client.Close()
+	var err error
+	if c.pubsub != nil {
+		err = c.pubsub.Close()
+	}
+	return errors.Join(err, c.client.Close())
 }
@@ -4,21 +4,25 @@
 package redisreceiver
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/require"
 )
 
 var _ client = (*fakeClient)(nil)
 
-type fakeClient struct{}
+type fakeClient struct {
+	messages chan *redis.Message
+}
 
 func newFakeClient() *fakeClient {
-	return &fakeClient{}
+	return &fakeClient{messages: make(chan *redis.Message)}
 }
 
 func (fakeClient) delimiter() string {
@@ -37,6 +41,26 @@ func (fakeClient) retrieveClusterInfo() (string, error) {
 	return readFile("cluster_info")
 }
 
+func (fakeClient) retrieveLatencyHistory(event string) ([]latencySample, error) {
+	switch event {
+	case "command":
+		return []latencySample{
+			{timestamp: 1405067822, latencyMs: 1001},
+			{timestamp: 1405067941, latencyMs: 251},
+		}, nil
+	case "fast-command":
+		return []latencySample{
+			{timestamp: 1405067822, latencyMs: 5},
+			{timestamp: 1405067941, latencyMs: 2},
+		}, nil
+	}
+	return nil, nil
+}
+
+func (c fakeClient) subscribe(context.Context, []string) <-chan *redis.Message {
+	return c.messages
+}
+
 func (fakeClient) close() error {
 	return nil
 }
@@ -35,9 +35,25 @@ type Config struct {
 
 	TLS configtls.ClientConfig `mapstructure:"tls,omitempty"`
 
+	// LatencyEvents are the Redis latency monitor events whose LATENCY HISTORY is
+	// retrieved when one of the latency event metrics is enabled.
+	LatencyEvents []string `mapstructure:"latency_events"`
+
+	// KeyspaceNotifications configures the keyspace notifications subscription
+	// used when the receiver is part of a logs pipeline.
+	KeyspaceNotifications KeyspaceNotificationsConfig `mapstructure:"keyspace_notifications"`
+
 	MetricsBuilderConfig metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
 
+// KeyspaceNotificationsConfig defines which Redis keyspace notifications are emitted as logs.
+// Notifications must be enabled on the server with the notify-keyspace-events setting.
+type KeyspaceNotificationsConfig struct {
+	// Patterns are the Pub/Sub channel patterns to subscribe to, e.g. "__keyevent@0__:expired".
+	// No subscription is made when empty.
+	Patterns []string `mapstructure:"patterns"`
+}
+
 // configInfo holds configuration information to be used as resource/metrics attributes.
 type configInfo struct {
 	Address string
@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
@@ -44,8 +45,22 @@ func TestConfig(t *testing.T) {
 				CollectionInterval: 10 * time.Second,
 				InitialDelay:       time.Second,
 			},
+			LatencyEvents: []string{"command", "fast-command"},
+			KeyspaceNotifications: KeyspaceNotificationsConfig{
+				Patterns: []string{"__keyspace@0__:*", "__keyevent@0__:expired"},
+			},
 			MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 		},
 		cfg,
 	)
 }
+
+func TestConfigEmptyKeyspacePatterns(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config_empty_patterns.yaml"))
+	require.NoError(t, err)
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, cm.Unmarshal(cfg))
+
+	assert.Empty(t, cfg.KeyspaceNotifications.Patterns)
+	require.NoError(t, xconfmap.Validate(cfg))
+}
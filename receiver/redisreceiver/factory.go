@@ -22,7 +22,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -35,7 +36,11 @@ func createDefaultConfig() component.Config {
 		TLS: configtls.ClientConfig{
 			Insecure: true,
 		},
-		ControllerConfig:     scs,
+		ControllerConfig: scs,
+		LatencyEvents:    []string{"command", "fast-command"},
+		KeyspaceNotifications: KeyspaceNotificationsConfig{
+			Patterns: []string{keyeventPrefix + "*"},
+		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 	}
 }
@@ -55,3 +60,18 @@ func createMetricsReceiver(
 
 	return scraperhelper.NewMetricsController(&oCfg.ControllerConfig, set, consumer, scraperhelper.AddMetricsScraper(metadata.Type, scrp))
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	oCfg := cfg.(*Config)
+
+	opts, err := newRedisOptions(oCfg)
+	if err != nil {
+		return nil, err
+	}
+	return newKeyspaceReceiver(oCfg, set, consumer, func() client { return newRedisClient(opts) })
+}
@@ -47,7 +47,9 @@ type MetricsConfig struct {
 	RedisClusterStatsMessagesSent             MetricConfig `mapstructure:"redis.cluster.stats_messages_sent"`
 	RedisClusterUptime                        MetricConfig `mapstructure:"redis.cluster.uptime"`
 	RedisCmdCalls                             MetricConfig `mapstructure:"redis.cmd.calls"`
+	RedisCmdFailedCalls                       MetricConfig `mapstructure:"redis.cmd.failed_calls"`
 	RedisCmdLatency                           MetricConfig `mapstructure:"redis.cmd.latency"`
+	RedisCmdRejectedCalls                     MetricConfig `mapstructure:"redis.cmd.rejected_calls"`
 	RedisCmdUsec                              MetricConfig `mapstructure:"redis.cmd.usec"`
 	RedisCommands                             MetricConfig `mapstructure:"redis.commands"`
 	RedisCommandsProcessed                    MetricConfig `mapstructure:"redis.commands.processed"`
@@ -61,6 +63,8 @@ type MetricsConfig struct {
 	RedisKeysExpired                          MetricConfig `mapstructure:"redis.keys.expired"`
 	RedisKeyspaceHits                         MetricConfig `mapstructure:"redis.keyspace.hits"`
 	RedisKeyspaceMisses                       MetricConfig `mapstructure:"redis.keyspace.misses"`
+	RedisLatencyEventLatest                   MetricConfig `mapstructure:"redis.latency.event.latest"`
+	RedisLatencyEventMax                      MetricConfig `mapstructure:"redis.latency.event.max"`
 	RedisLatestFork                           MetricConfig `mapstructure:"redis.latest_fork"`
 	RedisMaxmemory                            MetricConfig `mapstructure:"redis.maxmemory"`
 	RedisMemoryFragmentationRatio             MetricConfig `mapstructure:"redis.memory.fragmentation_ratio"`
@@ -145,9 +149,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		RedisCmdCalls: MetricConfig{
 			Enabled: false,
 		},
+		RedisCmdFailedCalls: MetricConfig{
+			Enabled: false,
+		},
 		RedisCmdLatency: MetricConfig{
 			Enabled: false,
 		},
+		RedisCmdRejectedCalls: MetricConfig{
+			Enabled: false,
+		},
 		RedisCmdUsec: MetricConfig{
 			Enabled: false,
 		},
@@ -187,6 +197,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		RedisKeyspaceMisses: MetricConfig{
 			Enabled: true,
 		},
+		RedisLatencyEventLatest: MetricConfig{
+			Enabled: false,
+		},
+		RedisLatencyEventMax: MetricConfig{
+			Enabled: false,
+		},
 		RedisLatestFork: MetricConfig{
 			Enabled: true,
 		},
@@ -44,7 +44,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisClusterStatsMessagesSent:             MetricConfig{Enabled: true},
 					RedisClusterUptime:                        MetricConfig{Enabled: true},
 					RedisCmdCalls:                             MetricConfig{Enabled: true},
+					RedisCmdFailedCalls:                       MetricConfig{Enabled: true},
 					RedisCmdLatency:                           MetricConfig{Enabled: true},
+					RedisCmdRejectedCalls:                     MetricConfig{Enabled: true},
 					RedisCmdUsec:                              MetricConfig{Enabled: true},
 					RedisCommands:                             MetricConfig{Enabled: true},
 					RedisCommandsProcessed:                    MetricConfig{Enabled: true},
@@ -58,6 +60,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisKeysExpired:                          MetricConfig{Enabled: true},
 					RedisKeyspaceHits:                         MetricConfig{Enabled: true},
 					RedisKeyspaceMisses:                       MetricConfig{Enabled: true},
+					RedisLatencyEventLatest:                   MetricConfig{Enabled: true},
+					RedisLatencyEventMax:                      MetricConfig{Enabled: true},
 					RedisLatestFork:                           MetricConfig{Enabled: true},
 					RedisMaxmemory:                            MetricConfig{Enabled: true},
 					RedisMemoryFragmentationRatio:             MetricConfig{Enabled: true},
@@ -114,7 +118,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisClusterStatsMessagesSent:             MetricConfig{Enabled: false},
 					RedisClusterUptime:                        MetricConfig{Enabled: false},
 					RedisCmdCalls:                             MetricConfig{Enabled: false},
+					RedisCmdFailedCalls:                       MetricConfig{Enabled: false},
 					RedisCmdLatency:                           MetricConfig{Enabled: false},
+					RedisCmdRejectedCalls:                     MetricConfig{Enabled: false},
 					RedisCmdUsec:                              MetricConfig{Enabled: false},
 					RedisCommands:                             MetricConfig{Enabled: false},
 					RedisCommandsProcessed:                    MetricConfig{Enabled: false},
@@ -128,6 +134,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisKeysExpired:                          MetricConfig{Enabled: false},
 					RedisKeyspaceHits:                         MetricConfig{Enabled: false},
 					RedisKeyspaceMisses:                       MetricConfig{Enabled: false},
+					RedisLatencyEventLatest:                   MetricConfig{Enabled: false},
+					RedisLatencyEventMax:                      MetricConfig{Enabled: false},
 					RedisLatestFork:                           MetricConfig{Enabled: false},
 					RedisMaxmemory:                            MetricConfig{Enabled: false},
 					RedisMemoryFragmentationRatio:             MetricConfig{Enabled: false},
@@ -221,9 +221,15 @@ var MetricsInfo = metricsInfo{
 	RedisCmdCalls: metricInfo{
 		Name: "redis.cmd.calls",
 	},
+	RedisCmdFailedCalls: metricInfo{
+		Name: "redis.cmd.failed_calls",
+	},
 	RedisCmdLatency: metricInfo{
 		Name: "redis.cmd.latency",
 	},
+	RedisCmdRejectedCalls: metricInfo{
+		Name: "redis.cmd.rejected_calls",
+	},
 	RedisCmdUsec: metricInfo{
 		Name: "redis.cmd.usec",
 	},
@@ -263,6 +269,12 @@ var MetricsInfo = metricsInfo{
 	RedisKeyspaceMisses: metricInfo{
 		Name: "redis.keyspace.misses",
 	},
+	RedisLatencyEventLatest: metricInfo{
+		Name: "redis.latency.event.latest",
+	},
+	RedisLatencyEventMax: metricInfo{
+		Name: "redis.latency.event.max",
+	},
 	RedisLatestFork: metricInfo{
 		Name: "redis.latest_fork",
 	},
@@ -362,7 +374,9 @@ type metricsInfo struct {
 	RedisClusterStatsMessagesSent             metricInfo
 	RedisClusterUptime                        metricInfo
 	RedisCmdCalls                             metricInfo
+	RedisCmdFailedCalls                       metricInfo
 	RedisCmdLatency                           metricInfo
+	RedisCmdRejectedCalls                     metricInfo
 	RedisCmdUsec                              metricInfo
 	RedisCommands                             metricInfo
 	RedisCommandsProcessed                    metricInfo
@@ -376,6 +390,8 @@ type metricsInfo struct {
 	RedisKeysExpired                          metricInfo
 	RedisKeyspaceHits                         metricInfo
 	RedisKeyspaceMisses                       metricInfo
+	RedisLatencyEventLatest                   metricInfo
+	RedisLatencyEventMax                      metricInfo
 	RedisLatestFork                           metricInfo
 	RedisMaxmemory                            metricInfo
 	RedisMemoryFragmentationRatio             metricInfo
@@ -1324,6 +1340,60 @@ func newMetricRedisCmdCalls(cfg MetricConfig) metricRedisCmdCalls {
 	return m
 }
 
+type metricRedisCmdFailedCalls struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cmd.failed_calls metric with initial data.
+func (m *metricRedisCmdFailedCalls) init() {
+	m.data.SetName("redis.cmd.failed_calls")
+	m.data.SetDescription("Total number of failed calls for a command (errors within the command execution)")
+	m.data.SetUnit("{call}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCmdFailedCalls) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cmd", cmdAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCmdFailedCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCmdFailedCalls) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCmdFailedCalls(cfg MetricConfig) metricRedisCmdFailedCalls {
+	m := metricRedisCmdFailedCalls{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisCmdLatency struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1377,6 +1447,60 @@ func newMetricRedisCmdLatency(cfg MetricConfig) metricRedisCmdLatency {
 	return m
 }
 
+type metricRedisCmdRejectedCalls struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cmd.rejected_calls metric with initial data.
+func (m *metricRedisCmdRejectedCalls) init() {
+	m.data.SetName("redis.cmd.rejected_calls")
+	m.data.SetDescription("Total number of rejected calls for a command (errors prior to command execution)")
+	m.data.SetUnit("{call}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCmdRejectedCalls) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cmd", cmdAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCmdRejectedCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCmdRejectedCalls) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCmdRejectedCalls(cfg MetricConfig) metricRedisCmdRejectedCalls {
+	m := metricRedisCmdRejectedCalls{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisCmdUsec struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2055,6 +2179,110 @@ func newMetricRedisKeyspaceMisses(cfg MetricConfig) metricRedisKeyspaceMisses {
 	return m
 }
 
+type metricRedisLatencyEventLatest struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latency.event.latest metric with initial data.
+func (m *metricRedisLatencyEventLatest) init() {
+	m.data.SetName("redis.latency.event.latest")
+	m.data.SetDescription("Latency of the latest spike of a latency monitor event, as reported by LATENCY HISTORY")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencyEventLatest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, eventAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("event", eventAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencyEventLatest) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencyEventLatest) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencyEventLatest(cfg MetricConfig) metricRedisLatencyEventLatest {
+	m := metricRedisLatencyEventLatest{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisLatencyEventMax struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latency.event.max metric with initial data.
+func (m *metricRedisLatencyEventMax) init() {
+	m.data.SetName("redis.latency.event.max")
+	m.data.SetDescription("Maximum latency among the spikes of a latency monitor event kept by LATENCY HISTORY")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencyEventMax) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, eventAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("event", eventAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencyEventMax) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencyEventMax) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencyEventMax(cfg MetricConfig) metricRedisLatencyEventMax {
+	m := metricRedisLatencyEventMax{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisLatestFork struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -3407,7 +3635,9 @@ type MetricsBuilder struct {
 	metricRedisClusterStatsMessagesSent             metricRedisClusterStatsMessagesSent
 	metricRedisClusterUptime                        metricRedisClusterUptime
 	metricRedisCmdCalls                             metricRedisCmdCalls
+	metricRedisCmdFailedCalls                       metricRedisCmdFailedCalls
 	metricRedisCmdLatency                           metricRedisCmdLatency
+	metricRedisCmdRejectedCalls                     metricRedisCmdRejectedCalls
 	metricRedisCmdUsec                              metricRedisCmdUsec
 	metricRedisCommands                             metricRedisCommands
 	metricRedisCommandsProcessed                    metricRedisCommandsProcessed
@@ -3421,6 +3651,8 @@ type MetricsBuilder struct {
 	metricRedisKeysExpired                          metricRedisKeysExpired
 	metricRedisKeyspaceHits                         metricRedisKeyspaceHits
 	metricRedisKeyspaceMisses                       metricRedisKeyspaceMisses
+	metricRedisLatencyEventLatest                   metricRedisLatencyEventLatest
+	metricRedisLatencyEventMax                      metricRedisLatencyEventMax
 	metricRedisLatestFork                           metricRedisLatestFork
 	metricRedisMaxmemory                            metricRedisMaxmemory
 	metricRedisMemoryFragmentationRatio             metricRedisMemoryFragmentationRatio
@@ -3490,7 +3722,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricRedisClusterStatsMessagesSent:             newMetricRedisClusterStatsMessagesSent(mbc.Metrics.RedisClusterStatsMessagesSent),
 		metricRedisClusterUptime:                        newMetricRedisClusterUptime(mbc.Metrics.RedisClusterUptime),
 		metricRedisCmdCalls:                             newMetricRedisCmdCalls(mbc.Metrics.RedisCmdCalls),
+		metricRedisCmdFailedCalls:                       newMetricRedisCmdFailedCalls(mbc.Metrics.RedisCmdFailedCalls),
 		metricRedisCmdLatency:                           newMetricRedisCmdLatency(mbc.Metrics.RedisCmdLatency),
+		metricRedisCmdRejectedCalls:                     newMetricRedisCmdRejectedCalls(mbc.Metrics.RedisCmdRejectedCalls),
 		metricRedisCmdUsec:                              newMetricRedisCmdUsec(mbc.Metrics.RedisCmdUsec),
 		metricRedisCommands:                             newMetricRedisCommands(mbc.Metrics.RedisCommands),
 		metricRedisCommandsProcessed:                    newMetricRedisCommandsProcessed(mbc.Metrics.RedisCommandsProcessed),
@@ -3504,6 +3738,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricRedisKeysExpired:                          newMetricRedisKeysExpired(mbc.Metrics.RedisKeysExpired),
 		metricRedisKeyspaceHits:                         newMetricRedisKeyspaceHits(mbc.Metrics.RedisKeyspaceHits),
 		metricRedisKeyspaceMisses:                       newMetricRedisKeyspaceMisses(mbc.Metrics.RedisKeyspaceMisses),
+		metricRedisLatencyEventLatest:                   newMetricRedisLatencyEventLatest(mbc.Metrics.RedisLatencyEventLatest),
+		metricRedisLatencyEventMax:                      newMetricRedisLatencyEventMax(mbc.Metrics.RedisLatencyEventMax),
 		metricRedisLatestFork:                           newMetricRedisLatestFork(mbc.Metrics.RedisLatestFork),
 		metricRedisMaxmemory:                            newMetricRedisMaxmemory(mbc.Metrics.RedisMaxmemory),
 		metricRedisMemoryFragmentationRatio:             newMetricRedisMemoryFragmentationRatio(mbc.Metrics.RedisMemoryFragmentationRatio),
@@ -3638,7 +3874,9 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricRedisClusterStatsMessagesSent.emit(ils.Metrics())
 	mb.metricRedisClusterUptime.emit(ils.Metrics())
 	mb.metricRedisCmdCalls.emit(ils.Metrics())
+	mb.metricRedisCmdFailedCalls.emit(ils.Metrics())
 	mb.metricRedisCmdLatency.emit(ils.Metrics())
+	mb.metricRedisCmdRejectedCalls.emit(ils.Metrics())
 	mb.metricRedisCmdUsec.emit(ils.Metrics())
 	mb.metricRedisCommands.emit(ils.Metrics())
 	mb.metricRedisCommandsProcessed.emit(ils.Metrics())
@@ -3652,6 +3890,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricRedisKeysExpired.emit(ils.Metrics())
 	mb.metricRedisKeyspaceHits.emit(ils.Metrics())
 	mb.metricRedisKeyspaceMisses.emit(ils.Metrics())
+	mb.metricRedisLatencyEventLatest.emit(ils.Metrics())
+	mb.metricRedisLatencyEventMax.emit(ils.Metrics())
 	mb.metricRedisLatestFork.emit(ils.Metrics())
 	mb.metricRedisMaxmemory.emit(ils.Metrics())
 	mb.metricRedisMemoryFragmentationRatio.emit(ils.Metrics())
@@ -3799,11 +4039,21 @@ func (mb *MetricsBuilder) RecordRedisCmdCallsDataPoint(ts pcommon.Timestamp, val
 	mb.metricRedisCmdCalls.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
 }
 
+// RecordRedisCmdFailedCallsDataPoint adds a data point to redis.cmd.failed_calls metric.
+func (mb *MetricsBuilder) RecordRedisCmdFailedCallsDataPoint(ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
+	mb.metricRedisCmdFailedCalls.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
+}
+
 // RecordRedisCmdLatencyDataPoint adds a data point to redis.cmd.latency metric.
 func (mb *MetricsBuilder) RecordRedisCmdLatencyDataPoint(ts pcommon.Timestamp, val float64, cmdAttributeValue string, percentileAttributeValue AttributePercentile) {
 	mb.metricRedisCmdLatency.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue, percentileAttributeValue.String())
 }
 
+// RecordRedisCmdRejectedCallsDataPoint adds a data point to redis.cmd.rejected_calls metric.
+func (mb *MetricsBuilder) RecordRedisCmdRejectedCallsDataPoint(ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
+	mb.metricRedisCmdRejectedCalls.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
+}
+
 // RecordRedisCmdUsecDataPoint adds a data point to redis.cmd.usec metric.
 func (mb *MetricsBuilder) RecordRedisCmdUsecDataPoint(ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
 	mb.metricRedisCmdUsec.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
@@ -3869,6 +4119,16 @@ func (mb *MetricsBuilder) RecordRedisKeyspaceMissesDataPoint(ts pcommon.Timestam
 	mb.metricRedisKeyspaceMisses.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisLatencyEventLatestDataPoint adds a data point to redis.latency.event.latest metric.
+func (mb *MetricsBuilder) RecordRedisLatencyEventLatestDataPoint(ts pcommon.Timestamp, val float64, eventAttributeValue string) {
+	mb.metricRedisLatencyEventLatest.recordDataPoint(mb.startTime, ts, val, eventAttributeValue)
+}
+
+// RecordRedisLatencyEventMaxDataPoint adds a data point to redis.latency.event.max metric.
+func (mb *MetricsBuilder) RecordRedisLatencyEventMaxDataPoint(ts pcommon.Timestamp, val float64, eventAttributeValue string) {
+	mb.metricRedisLatencyEventMax.recordDataPoint(mb.startTime, ts, val, eventAttributeValue)
+}
+
 // RecordRedisLatestForkDataPoint adds a data point to redis.latest_fork metric.
 func (mb *MetricsBuilder) RecordRedisLatestForkDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRedisLatestFork.recordDataPoint(mb.startTime, ts, val)
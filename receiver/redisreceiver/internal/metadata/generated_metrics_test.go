@@ -125,9 +125,15 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisCmdCallsDataPoint(ts, 1, "cmd-val")
 
+			allMetricsCount++
+			mb.RecordRedisCmdFailedCallsDataPoint(ts, 1, "cmd-val")
+
 			allMetricsCount++
 			mb.RecordRedisCmdLatencyDataPoint(ts, 1, "cmd-val", AttributePercentileP50)
 
+			allMetricsCount++
+			mb.RecordRedisCmdRejectedCallsDataPoint(ts, 1, "cmd-val")
+
 			allMetricsCount++
 			mb.RecordRedisCmdUsecDataPoint(ts, 1, "cmd-val")
 
@@ -179,6 +185,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisKeyspaceMissesDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRedisLatencyEventLatestDataPoint(ts, 1, "event-val")
+
+			allMetricsCount++
+			mb.RecordRedisLatencyEventMaxDataPoint(ts, 1, "event-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRedisLatestForkDataPoint(ts, 1)
@@ -530,6 +542,23 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("cmd")
 					assert.True(t, ok)
 					assert.Equal(t, "cmd-val", attrVal.Str())
+				case "redis.cmd.failed_calls":
+					assert.False(t, validatedMetrics["redis.cmd.failed_calls"], "Found a duplicate in the metrics slice: redis.cmd.failed_calls")
+					validatedMetrics["redis.cmd.failed_calls"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total number of failed calls for a command (errors within the command execution)", ms.At(i).Description())
+					assert.Equal(t, "{call}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("cmd")
+					assert.True(t, ok)
+					assert.Equal(t, "cmd-val", attrVal.Str())
 				case "redis.cmd.latency":
 					assert.False(t, validatedMetrics["redis.cmd.latency"], "Found a duplicate in the metrics slice: redis.cmd.latency")
 					validatedMetrics["redis.cmd.latency"] = true
@@ -548,6 +577,23 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("percentile")
 					assert.True(t, ok)
 					assert.Equal(t, "p50", attrVal.Str())
+				case "redis.cmd.rejected_calls":
+					assert.False(t, validatedMetrics["redis.cmd.rejected_calls"], "Found a duplicate in the metrics slice: redis.cmd.rejected_calls")
+					validatedMetrics["redis.cmd.rejected_calls"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total number of rejected calls for a command (errors prior to command execution)", ms.At(i).Description())
+					assert.Equal(t, "{call}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("cmd")
+					assert.True(t, ok)
+					assert.Equal(t, "cmd-val", attrVal.Str())
 				case "redis.cmd.usec":
 					assert.False(t, validatedMetrics["redis.cmd.usec"], "Found a duplicate in the metrics slice: redis.cmd.usec")
 					validatedMetrics["redis.cmd.usec"] = true
@@ -737,6 +783,36 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.latency.event.latest":
+					assert.False(t, validatedMetrics["redis.latency.event.latest"], "Found a duplicate in the metrics slice: redis.latency.event.latest")
+					validatedMetrics["redis.latency.event.latest"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Latency of the latest spike of a latency monitor event, as reported by LATENCY HISTORY", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("event")
+					assert.True(t, ok)
+					assert.Equal(t, "event-val", attrVal.Str())
+				case "redis.latency.event.max":
+					assert.False(t, validatedMetrics["redis.latency.event.max"], "Found a duplicate in the metrics slice: redis.latency.event.max")
+					validatedMetrics["redis.latency.event.max"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Maximum latency among the spikes of a latency monitor event kept by LATENCY HISTORY", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("event")
+					assert.True(t, ok)
+					assert.Equal(t, "event-val", attrVal.Str())
 				case "redis.latest_fork":
 					assert.False(t, validatedMetrics["redis.latest_fork"], "Found a duplicate in the metrics slice: redis.latest_fork")
 					validatedMetrics["redis.latest_fork"] = true
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+const (
+	keyspacePrefix = "__keyspace@"
+	keyeventPrefix = "__keyevent@"
+)
+
+// keyspaceReceiver subscribes to Redis keyspace notifications and emits each of them as a log record.
+// See https://redis.io/docs/latest/develop/use/keyspace-notifications/ for details.
+type keyspaceReceiver struct {
+	cfg        *Config
+	settings   receiver.Settings
+	consumer   consumer.Logs
+	newClient  func() client
+	configInfo configInfo
+
+	client client
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newKeyspaceReceiver(cfg *Config, settings receiver.Settings, consumer consumer.Logs, newClient func() client) (*keyspaceReceiver, error) {
+	configInfo, err := newConfigInfo(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &keyspaceReceiver{
+		cfg:        cfg,
+		settings:   settings,
+		consumer:   consumer,
+		newClient:  newClient,
+		configInfo: configInfo,
+	}, nil
+}
+
+func (r *keyspaceReceiver) Start(ctx context.Context, _ component.Host) error {
+	if len(r.cfg.KeyspaceNotifications.Patterns) == 0 {
+		r.settings.Logger.Info("no keyspace notification patterns configured, not subscribing to keyspace notifications")
+		return nil
+	}
+	r.client = r.newClient()
+	ctx, r.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	messages := r.client.subscribe(ctx, r.cfg.KeyspaceNotifications.Patterns)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.consumeMessages(ctx, messages)
+	}()
+	return nil
+}
+
+func (r *keyspaceReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	var err error
+	if r.client != nil {
+		err = r.client.close()
+	}
+	r.wg.Wait()
+	return err
+}
+
+func (r *keyspaceReceiver) consumeMessages(ctx context.Context, messages <-chan *redis.Message) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			if err := r.consumer.ConsumeLogs(ctx, r.toLogs(msg)); err != nil {
+				r.settings.Logger.Error("failed to consume keyspace notification", zap.Error(err))
+			}
+		}
+	}
+}
+
+// toLogs converts a keyspace notification to logs. Keyspace notifications are published
+// on "__keyspace@<db>__:<key>" with the event as payload, key-event notifications on
+// "__keyevent@<db>__:<event>" with the key as payload.
+func (r *keyspaceReceiver) toLogs(msg *redis.Message) plog.Logs {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rb := metadata.NewResourceBuilder(r.cfg.MetricsBuilderConfig.ResourceAttributes)
+	rb.SetServerAddress(r.configInfo.Address)
+	rb.SetServerPort(r.configInfo.Port)
+	rb.Emit().MoveTo(rl.Resource())
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(metadata.ScopeName)
+	sl.Scope().SetVersion(r.settings.BuildInfo.Version)
+
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	lr.SetEventName("redis.keyspace.notification")
+	attrs := lr.Attributes()
+	attrs.PutStr("redis.channel", msg.Channel)
+
+	db, key, event := parseKeyspaceNotification(msg.Channel, msg.Payload)
+	if db != "" {
+		attrs.PutStr("redis.db", db)
+	}
+	if key != "" {
+		attrs.PutStr("redis.key", key)
+	}
+	if event != "" {
+		attrs.PutStr("redis.event", event)
+	}
+	lr.Body().SetStr(msg.Payload)
+	return logs
+}
+
+// parseKeyspaceNotification extracts the database, key and event of a notification.
+// Unknown channels only yield empty values.
+func parseKeyspaceNotification(channel, payload string) (db, key, event string) {
+	var rest string
+	var isKeyspace bool
+	switch {
+	case strings.HasPrefix(channel, keyspacePrefix):
+		rest, isKeyspace = channel[len(keyspacePrefix):], true
+	case strings.HasPrefix(channel, keyeventPrefix):
+		rest = channel[len(keyeventPrefix):]
+	default:
+		return "", "", ""
+	}
+
+	db, name, found := strings.Cut(rest, "__:")
+	if !found {
+		return "", "", ""
+	}
+	if isKeyspace {
+		return db, name, payload
+	}
+	return db, payload, name
+}
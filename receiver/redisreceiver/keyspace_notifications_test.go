@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+func TestParseKeyspaceNotification(t *testing.T) {
+	tests := []struct {
+		name             string
+		channel, payload string
+		wantDB, wantKey  string
+		wantEvent        string
+	}{
+		{
+			name:      "keyspace",
+			channel:   "__keyspace@0__:mykey",
+			payload:   "del",
+			wantDB:    "0",
+			wantKey:   "mykey",
+			wantEvent: "del",
+		},
+		{
+			name:      "keyevent",
+			channel:   "__keyevent@3__:expired",
+			payload:   "session:42",
+			wantDB:    "3",
+			wantKey:   "session:42",
+			wantEvent: "expired",
+		},
+		{
+			name:    "key containing separator",
+			channel: "__keyspace@1__:a__:b",
+			payload: "set",
+			wantDB:  "1", wantKey: "a__:b", wantEvent: "set",
+		},
+		{
+			name:    "unknown channel",
+			channel: "news",
+			payload: "hello",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, key, event := parseKeyspaceNotification(tt.channel, tt.payload)
+			assert.Equal(t, tt.wantDB, db)
+			assert.Equal(t, tt.wantKey, key)
+			assert.Equal(t, tt.wantEvent, event)
+		})
+	}
+}
+
+func TestKeyspaceReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:6379"
+	cfg.MetricsBuilderConfig.ResourceAttributes.ServerAddress.Enabled = true
+
+	sink := new(consumertest.LogsSink)
+	fc := newFakeClient()
+	r, err := newKeyspaceReceiver(cfg, receivertest.NewNopSettings(metadata.Type), sink, func() client { return fc })
+	require.NoError(t, err)
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	fc.messages <- &redis.Message{Channel: "__keyevent@0__:expired", Pattern: "__keyevent@*", Payload: "mykey"}
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(t.Context()))
+
+	rl := sink.AllLogs()[0].ResourceLogs().At(0)
+	address, ok := rl.Resource().Attributes().Get("server.address")
+	require.True(t, ok)
+	assert.Equal(t, "localhost", address.Str())
+
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "mykey", lr.Body().Str())
+	assert.Equal(t, map[string]any{
+		"redis.channel": "__keyevent@0__:expired",
+		"redis.db":      "0",
+		"redis.key":     "mykey",
+		"redis.event":   "expired",
+	}, lr.Attributes().AsRaw())
+}
+
+func TestKeyspaceReceiverNoPatterns(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:6379"
+	cfg.KeyspaceNotifications.Patterns = []string{}
+
+	r, err := newKeyspaceReceiver(cfg, receivertest.NewNopSettings(metadata.Type), consumertest.NewNop(), func() client {
+		require.FailNow(t, "no client is created without patterns")
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	require.NoError(t, r.Shutdown(t.Context()))
+}
+
+func TestNewLogsReceiver_invalid_endpoint(t *testing.T) {
+	c := createDefaultConfig().(*Config)
+	_, err := createLogsReceiver(t.Context(), receivertest.NewNopSettings(metadata.Type), c, nil)
+	assert.ErrorContains(t, err, "invalid endpoint")
+}
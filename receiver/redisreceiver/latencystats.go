@@ -36,3 +36,29 @@ func parseLatencyStats(str string) (latencies, error) {
 
 	return res, nil
 }
+
+// latencySample is one entry of the LATENCY HISTORY reply.
+type latencySample struct {
+	timestamp int64
+	latencyMs int64
+}
+
+// parseLatencyHistory parses the reply of the LATENCY HISTORY command. Each entry is an array
+// of the unix timestamp of a latency spike and its latency in milliseconds, from the oldest
+// to the latest spike, e.g. [[1405067822, 251], [1405067941, 1001]].
+func parseLatencyHistory(reply []any) ([]latencySample, error) {
+	res := make([]latencySample, 0, len(reply))
+	for _, entry := range reply {
+		fields, ok := entry.([]any)
+		if !ok || len(fields) < 2 {
+			return nil, fmt.Errorf("unexpected latency history entry '%v'", entry)
+		}
+		timestamp, timestampOk := fields[0].(int64)
+		latency, latencyOk := fields[1].(int64)
+		if !timestampOk || !latencyOk {
+			return nil, fmt.Errorf("unexpected latency history values '%v'", entry)
+		}
+		res = append(res, latencySample{timestamp: timestamp, latencyMs: latency})
+	}
+	return res, nil
+}
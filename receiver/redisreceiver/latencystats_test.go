@@ -32,3 +32,33 @@ func TestParseMalformedLatencyStats(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLatencyHistory(t *testing.T) {
+	samples, err := parseLatencyHistory([]any{
+		[]any{int64(1405067822), int64(251)},
+		[]any{int64(1405067941), int64(1001)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []latencySample{
+		{timestamp: 1405067822, latencyMs: 251},
+		{timestamp: 1405067941, latencyMs: 1001},
+	}, samples)
+}
+
+func TestParseMalformedLatencyHistory(t *testing.T) {
+	tests := []struct {
+		name  string
+		reply []any
+	}{
+		{"not an array", []any{int64(1405067822)}},
+		{"too few fields", []any{[]any{int64(1405067822)}}},
+		{"wrong timestamp type", []any{[]any{"1405067822", int64(251)}}},
+		{"wrong latency type", []any{[]any{int64(1405067822), "251"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseLatencyHistory(test.reply)
+			require.Error(t, err)
+		})
+	}
+}
@@ -30,11 +30,23 @@ type redisScraper struct {
 	mb         *metadata.MetricsBuilder
 	uptime     time.Duration
 	configInfo configInfo
+
+	latencyEventsEnabled bool
+	latencyEvents        []string
 }
 
 const redisMaxDbs = 16 // Maximum possible number of redis databases
 
 func newRedisScraper(cfg *Config, settings receiver.Settings) (scraper.Metrics, error) {
+	opts, err := newRedisOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
+}
+
+// newRedisOptions builds the go-redis client options from the receiver configuration.
+func newRedisOptions(cfg *Config) (*redis.Options, error) {
 	opts := &redis.Options{
 		Addr:     cfg.Endpoint,
 		Username: cfg.Username,
@@ -50,7 +62,7 @@ func newRedisScraper(cfg *Config, settings receiver.Settings) (scraper.Metrics,
 	if opts.TLSConfig, err = cfg.TLS.LoadTLSConfig(context.Background()); err != nil {
 		return nil, err
 	}
-	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
+	return opts, nil
 }
 
 func newRedisScraperWithClient(client client, settings receiver.Settings, cfg *Config) (scraper.Metrics, error) {
@@ -64,6 +76,9 @@ func newRedisScraperWithClient(client client, settings receiver.Settings, cfg *C
 		settings:   settings.TelemetrySettings,
 		mb:         metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
 		configInfo: configInfo,
+		latencyEventsEnabled: cfg.MetricsBuilderConfig.Metrics.RedisLatencyEventLatest.Enabled ||
+			cfg.MetricsBuilderConfig.Metrics.RedisLatencyEventMax.Enabled,
+		latencyEvents: cfg.LatencyEvents,
 	}
 	return scraper.NewMetrics(
 		rs.Scrape,
@@ -107,6 +122,7 @@ func (rs *redisScraper) Scrape(context.Context) (pmetric.Metrics, error) {
 	rs.recordKeyspaceMetrics(now, inf)
 	rs.recordRoleMetrics(now, inf)
 	rs.recordCmdMetrics(now, inf)
+	rs.recordLatencyEventMetrics(now)
 	rs.recordModeMetrics(now, mode)
 	rb := rs.mb.NewResourceBuilder()
 	rb.SetRedisVersion(rs.getRedisVersion(inf))
@@ -246,7 +262,7 @@ func (rs *redisScraper) recordCmdMetrics(ts pcommon.Timestamp, inf info) {
 }
 
 // recordCmdStatsMetrics records metrics for a particular Redis command.
-// Only 'calls' and 'usec' are recorded at the moment.
+// 'calls', 'usec', 'rejected_calls' and 'failed_calls' are recorded, 'usec_per_call' is derivable and skipped.
 // 'cmd' is the Redis command, 'val' is the values string (e.g. "calls=1685,usec=6032,usec_per_call=3.58,rejected_calls=0,failed_calls=0").
 func (rs *redisScraper) recordCmdStatsMetrics(ts pcommon.Timestamp, cmd, val string) {
 	for element := range strings.SplitSeq(strings.TrimSpace(val), ",") {
@@ -263,6 +279,10 @@ func (rs *redisScraper) recordCmdStatsMetrics(ts pcommon.Timestamp, cmd, val str
 			rs.mb.RecordRedisCmdCallsDataPoint(ts, parsed, cmd)
 		case "usec":
 			rs.mb.RecordRedisCmdUsecDataPoint(ts, parsed, cmd)
+		case "rejected_calls":
+			rs.mb.RecordRedisCmdRejectedCallsDataPoint(ts, parsed, cmd)
+		case "failed_calls":
+			rs.mb.RecordRedisCmdFailedCallsDataPoint(ts, parsed, cmd)
 		}
 	}
 }
@@ -284,6 +304,33 @@ func (rs *redisScraper) recordCmdLatencyMetrics(ts pcommon.Timestamp, cmd, val s
 	}
 }
 
+// recordLatencyEventMetrics records metrics from the Redis latency monitor (LATENCY HISTORY).
+// The command is only issued, once per configured event, when one of the latency event metrics
+// is enabled. The latency monitor must be enabled on the server (latency-monitor-threshold > 0)
+// for spikes to be recorded, events without any spike are skipped.
+func (rs *redisScraper) recordLatencyEventMetrics(ts pcommon.Timestamp) {
+	if !rs.latencyEventsEnabled {
+		return
+	}
+	for _, event := range rs.latencyEvents {
+		samples, err := rs.client.retrieveLatencyHistory(event)
+		if err != nil {
+			rs.settings.Logger.Warn("failed to retrieve latency history", zap.String("event", event), zap.Error(err))
+			continue
+		}
+		if len(samples) == 0 {
+			continue
+		}
+		maxMs := samples[0].latencyMs
+		for _, sample := range samples[1:] {
+			maxMs = max(maxMs, sample.latencyMs)
+		}
+		// LATENCY HISTORY reports latencies in milliseconds, metrics are in seconds.
+		rs.mb.RecordRedisLatencyEventLatestDataPoint(ts, float64(samples[len(samples)-1].latencyMs)/1e3, event)
+		rs.mb.RecordRedisLatencyEventMaxDataPoint(ts, float64(maxMs)/1e3, event)
+	}
+}
+
 // sentinelDataPointRecorders returns the map of supported Sentinel metrics.
 func (rs *redisScraper) sentinelDataPointRecorders() map[string]any {
 	return map[string]any{
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 
@@ -39,6 +40,48 @@ func TestRedisRunnable(t *testing.T) {
 	assert.Equal(t, "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver", il.Name())
 }
 
+func TestRedisLatencyEventMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:6379"
+	cfg.MetricsBuilderConfig.Metrics.RedisLatencyEventLatest.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.RedisLatencyEventMax.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.RedisCmdRejectedCalls.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.RedisCmdFailedCalls.Enabled = true
+	runner, err := newRedisScraperWithClient(newFakeClient(), receivertest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, err)
+	md, err := runner.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+
+	found := map[string]pmetric.Metric{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		found[metrics.At(i).Name()] = metrics.At(i)
+	}
+
+	latest, ok := found["redis.latency.event.latest"]
+	require.True(t, ok)
+	require.Equal(t, 2, latest.Gauge().DataPoints().Len())
+	for i := 0; i < latest.Gauge().DataPoints().Len(); i++ {
+		dp := latest.Gauge().DataPoints().At(i)
+		event, _ := dp.Attributes().Get("event")
+		if event.Str() == "command" {
+			assert.InDelta(t, 0.251, dp.DoubleValue(), 1e-9)
+		}
+	}
+	maxLatency, ok := found["redis.latency.event.max"]
+	require.True(t, ok)
+	require.Equal(t, 2, maxLatency.Gauge().DataPoints().Len())
+	for i := 0; i < maxLatency.Gauge().DataPoints().Len(); i++ {
+		dp := maxLatency.Gauge().DataPoints().At(i)
+		event, _ := dp.Attributes().Get("event")
+		if event.Str() == "command" {
+			assert.InDelta(t, 1.001, dp.DoubleValue(), 1e-9)
+		}
+	}
+	assert.Contains(t, found, "redis.cmd.rejected_calls")
+	assert.Contains(t, found, "redis.cmd.failed_calls")
+}
+
 func TestNewReceiver_invalid_endpoint(t *testing.T) {
 	c := createDefaultConfig().(*Config)
 	_, err := createMetricsReceiver(t.Context(), receivertest.NewNopSettings(metadata.Type), c, nil)
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 )
 
@@ -24,6 +25,7 @@ const (
 	defaultSecurityLevel      = "no_auth_no_priv"
 	defaultAuthType           = "MD5"
 	defaultPrivacyType        = "DES"
+	defaultTrapsEndpoint      = "udp://0.0.0.0:162"
 )
 
 var (
@@ -69,6 +71,7 @@ var (
 	errBadPrivacyType       = errors.New("privacy_type must be either DES, AES, AES192, AES192C, AES256, AES256C")
 	errEmptyPrivacyPassword = errors.New("privacy_password must be specified when security_level is auth_priv")
 	errMetricRequired       = errors.New("must have at least one config under metrics")
+	errTrapsEndpointScheme  = errors.New("traps endpoint scheme must be either tcp or udp")
 )
 
 // Config defines the configuration for the various elements of the receiver.
@@ -132,6 +135,26 @@ type Config struct {
 	// Metrics defines what SNMP metrics will be collected for this receiver and is composed of metric
 	// names along with their metric configurations
 	Metrics map[string]*MetricConfig `mapstructure:"metrics"`
+
+	// Traps configures the listener for SNMP traps and informs, used when the receiver is part of a logs pipeline.
+	// Received traps are authenticated with the Version, Community and v3 security settings above.
+	Traps configoptional.Optional[TrapsConfig] `mapstructure:"traps"`
+}
+
+// TrapsConfig contains config info about the SNMP trap and inform listener.
+type TrapsConfig struct {
+	// Endpoint is the address to listen on for traps. Must be formatted as [udp|tcp]://{host}:{port}.
+	// Default: udp://0.0.0.0:162
+	Endpoint string `mapstructure:"endpoint"`
+
+	// OIDNames maps OIDs to names, e.g. the object names of the MIBs used by the trap senders.
+	// Variables with an OID under one of these OIDs are named after the longest matching OID, followed by
+	// the remaining index (Ex: ifIndex.2). The OIDs of the configured metrics and attributes, as well as
+	// common SNMPv2-MIB and IF-MIB objects, are resolved as well.
+	OIDNames map[string]string `mapstructure:"oid_names"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
 }
 
 // ResourceAttributeConfig contains config info about all of the resource attributes that will be used by this receiver.
@@ -263,10 +286,32 @@ func (cfg *Config) Validate() error {
 		combinedErr = errors.Join(combinedErr, validateSecurity(cfg))
 	}
 	combinedErr = errors.Join(combinedErr, validateMetricConfigs(cfg))
+	if cfg.Traps.HasValue() {
+		combinedErr = errors.Join(combinedErr, validateTrapsEndpoint(cfg.Traps.Get()))
+	}
 
 	return combinedErr
 }
 
+// validateTrapsEndpoint validates the traps Endpoint
+func validateTrapsEndpoint(traps *TrapsConfig) error {
+	u, err := url.Parse(traps.Endpoint)
+	if err != nil {
+		return fmt.Errorf(errMsgInvalidEndpointWError, traps.Endpoint, err)
+	}
+	if u.Port() == "" {
+		return fmt.Errorf(errMsgInvalidEndpoint, traps.Endpoint)
+	}
+
+	switch strings.ToUpper(u.Scheme) {
+	case "TCP", "UDP": // ok
+	default:
+		return errTrapsEndpointScheme
+	}
+
+	return nil
+}
+
 // validateEndpoint validates the Endpoint
 func validateEndpoint(cfg *Config) error {
 	if cfg.Endpoint == "" {
@@ -391,9 +436,12 @@ func validateMetricConfigs(cfg *Config) error {
 	combinedErr = errors.Join(combinedErr, validateAttributeConfigs(cfg))
 	combinedErr = errors.Join(combinedErr, validateResourceAttributeConfigs(cfg))
 
-	// Ensure there is at least one MetricConfig
+	// Ensure there is at least one MetricConfig, unless the receiver only listens for traps
 	metrics := cfg.Metrics
 	if len(metrics) == 0 {
+		if cfg.Traps.HasValue() {
+			return combinedErr
+		}
 		return errors.Join(combinedErr, errMetricRequired)
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 
@@ -66,6 +67,19 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 	expectedConfigNoEndpointScheme.Endpoint = "localhost:161"
 	expectedConfigNoEndpointScheme.Metrics = metrics
 
+	expectedConfigTraps := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTraps.Traps = configoptional.Some(TrapsConfig{
+		Endpoint: "udp://0.0.0.0:1162",
+		OIDNames: map[string]string{
+			".1.3.6.1.4.1.9.9.41.2.0.1": "clogMessageGenerated",
+		},
+	})
+
+	expectedConfigTrapsBadEndpointScheme := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTrapsBadEndpointScheme.Traps = configoptional.Some(TrapsConfig{
+		Endpoint: "udp6://[::1]:1162",
+	})
+
 	expectedConfigBadVersion := factory.CreateDefaultConfig().(*Config)
 	expectedConfigBadVersion.Version = "9999"
 	expectedConfigBadVersion.Metrics = metrics
@@ -168,6 +182,18 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 			expectedCfg: expectedConfigNoEndpointScheme,
 			expectedErr: fmt.Sprintf(errMsgInvalidEndpoint[:len(errMsgInvalidEndpoint)-2], "localhost:161"),
 		},
+		{
+			name:        "TrapsWithoutMetrics",
+			nameVal:     "traps",
+			expectedCfg: expectedConfigTraps,
+			expectedErr: "",
+		},
+		{
+			name:        "TrapsBadEndpointSchemeErrors",
+			nameVal:     "traps_bad_endpoint_scheme",
+			expectedCfg: expectedConfigTrapsBadEndpointScheme,
+			expectedErr: errTrapsEndpointScheme.Error(),
+		},
 		{
 			name:        "NoVersionUsesDefault",
 			nameVal:     "no_version",
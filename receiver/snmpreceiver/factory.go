@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
@@ -27,7 +28,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 // createDefaultConfig creates a config for SNMP with as many default values as possible
@@ -43,6 +45,9 @@ func createDefaultConfig() component.Config {
 		SecurityLevel: defaultSecurityLevel,
 		AuthType:      defaultAuthType,
 		PrivacyType:   defaultPrivacyType,
+		Traps: configoptional.Default(TrapsConfig{
+			Endpoint: defaultTrapsEndpoint,
+		}),
 	}
 }
 
@@ -62,6 +67,11 @@ func createMetricsReceiver(
 		return nil, fmt.Errorf("failed to validate added config defaults: %w", err)
 	}
 
+	// Metrics are optional when the receiver listens for traps, but required to scrape
+	if len(snmpConfig.Metrics) == 0 {
+		return nil, errMetricRequired
+	}
+
 	snmpScraper := newScraper(params.Logger, snmpConfig, params)
 	s, err := scraper.NewMetrics(snmpScraper.scrape, scraper.WithStart(snmpScraper.start))
 	if err != nil {
@@ -71,6 +81,25 @@ func createMetricsReceiver(
 	return scraperhelper.NewMetricsController(&snmpConfig.ControllerConfig, params, consumer, scraperhelper.AddMetricsScraper(metadata.Type, s))
 }
 
+// createLogsReceiver creates the trap listener for SNMP
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.Settings,
+	config component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	snmpConfig, ok := config.(*Config)
+	if !ok {
+		return nil, errConfigNotSNMP
+	}
+
+	if err := addMissingConfigDefaults(snmpConfig); err != nil {
+		return nil, fmt.Errorf("failed to validate added config defaults: %w", err)
+	}
+
+	return newTrapReceiver(params, snmpConfig, snmpConfig.Traps.GetOrInsertDefault(), consumer), nil
+}
+
 // addMissingConfigDefaults adds any missing config parameters that have defaults
 func addMissingConfigDefaults(cfg *Config) error {
 	// Add the schema prefix to the endpoint if it doesn't contain one
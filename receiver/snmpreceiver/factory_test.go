@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
@@ -43,6 +44,9 @@ func TestNewFactory(t *testing.T) {
 					SecurityLevel: "no_auth_no_priv",
 					AuthType:      "MD5",
 					PrivacyType:   "DES",
+					Traps: configoptional.Default(TrapsConfig{
+						Endpoint: "udp://0.0.0.0:162",
+					}),
 				}
 
 				require.Equal(t, expectedCfg, factory.CreateDefaultConfig())
@@ -259,6 +263,39 @@ func TestNewFactory(t *testing.T) {
 				require.Equal(t, "1", snmpCfg.Metrics["m1"].Unit)
 			},
 		},
+		{
+			desc: "creates a new factory and CreateLogs returns no error without metrics",
+			testFunc: func(t *testing.T) {
+				factory := NewFactory()
+				cfg := factory.CreateDefaultConfig()
+				snmpCfg := cfg.(*Config)
+				snmpCfg.Traps = configoptional.Some(TrapsConfig{Endpoint: "udp://localhost:1162"})
+				require.NoError(t, snmpCfg.Validate())
+				r, err := factory.CreateLogs(
+					t.Context(),
+					receivertest.NewNopSettings(metadata.Type),
+					cfg,
+					consumertest.NewNop(),
+				)
+				require.NoError(t, err)
+				require.IsType(t, &trapReceiver{}, r)
+			},
+		},
+		{
+			desc: "CreateMetrics returns error without metrics",
+			testFunc: func(t *testing.T) {
+				factory := NewFactory()
+				cfg := factory.CreateDefaultConfig()
+				cfg.(*Config).Traps = configoptional.Some(TrapsConfig{Endpoint: "udp://localhost:1162"})
+				_, err := factory.CreateMetrics(
+					t.Context(),
+					receivertest.NewNopSettings(metadata.Type),
+					cfg,
+					consumertest.NewNop(),
+				)
+				require.ErrorIs(t, err, errMetricRequired)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
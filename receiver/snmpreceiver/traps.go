@@ -0,0 +1,341 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gosnmp/gosnmp"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver/internal/metadata"
+)
+
+const (
+	// snmpTrapOID.0 holds the OID of a v2c/v3 notification
+	snmpTrapOID = "1.3.6.1.6.3.1.1.4.1.0"
+	// sysUpTime.0 holds the time since the sender's network management portion was last re-initialized
+	sysUpTimeOID = "1.3.6.1.2.1.1.3.0"
+	// the generic v1 traps are mapped to the SNMPv2 notifications under snmpTraps, see RFC 3584
+	snmpTrapsOID = "1.3.6.1.6.3.1.1.5"
+	// enterpriseSpecific v1 generic trap
+	enterpriseSpecificTrap = 6
+)
+
+// standardOIDNames are the names of the SNMPv2-MIB and IF-MIB objects commonly found in traps
+var standardOIDNames = map[string]string{
+	"1.3.6.1.2.1.1.3":        "sysUpTime",
+	"1.3.6.1.6.3.1.1.4.1":    "snmpTrapOID",
+	"1.3.6.1.6.3.1.1.4.3":    "snmpTrapEnterprise",
+	"1.3.6.1.6.3.1.1.5.1":    "coldStart",
+	"1.3.6.1.6.3.1.1.5.2":    "warmStart",
+	"1.3.6.1.6.3.1.1.5.3":    "linkDown",
+	"1.3.6.1.6.3.1.1.5.4":    "linkUp",
+	"1.3.6.1.6.3.1.1.5.5":    "authenticationFailure",
+	"1.3.6.1.2.1.2.2.1.1":    "ifIndex",
+	"1.3.6.1.2.1.2.2.1.2":    "ifDescr",
+	"1.3.6.1.2.1.2.2.1.7":    "ifAdminStatus",
+	"1.3.6.1.2.1.2.2.1.8":    "ifOperStatus",
+	"1.3.6.1.2.1.31.1.1.1.1": "ifName",
+}
+
+// oidResolver resolves OIDs to names
+type oidResolver struct {
+	names map[string]string
+}
+
+// newOIDResolver creates an oidResolver using the standard names, the OIDs of the configured
+// metrics, attributes and resource attributes, and finally the configured trap OID names
+func newOIDResolver(cfg *Config, traps *TrapsConfig) *oidResolver {
+	names := map[string]string{}
+	add := func(oid, name string) {
+		if oid != "" && name != "" {
+			names[normalizeOID(oid)] = name
+		}
+	}
+
+	for oid, name := range standardOIDNames {
+		add(oid, name)
+	}
+	for name, metricCfg := range cfg.Metrics {
+		for _, scalarOID := range metricCfg.ScalarOIDs {
+			add(scalarOID.OID, name)
+		}
+		for _, columnOID := range metricCfg.ColumnOIDs {
+			add(columnOID.OID, name)
+		}
+	}
+	for name, attributeCfg := range cfg.Attributes {
+		if attributeCfg.Value != "" {
+			name = attributeCfg.Value
+		}
+		add(attributeCfg.OID, name)
+	}
+	for name, resourceAttributeCfg := range cfg.ResourceAttributes {
+		add(resourceAttributeCfg.OID, name)
+		add(resourceAttributeCfg.ScalarOID, name)
+	}
+	for oid, name := range traps.OIDNames {
+		add(oid, name)
+	}
+
+	return &oidResolver{names: names}
+}
+
+// resolve returns the name of the longest known OID prefix followed by the remaining index,
+// or false if no prefix is known
+func (r *oidResolver) resolve(oid string) (string, bool) {
+	oid = normalizeOID(oid)
+	prefix := oid
+	for {
+		if name, ok := r.names[prefix]; ok {
+			return name + oid[len(prefix):], true
+		}
+		i := strings.LastIndexByte(prefix, '.')
+		if i < 0 {
+			return "", false
+		}
+		prefix = prefix[:i]
+	}
+}
+
+// name returns the resolved name of an OID, or the OID itself when it cannot be resolved
+func (r *oidResolver) name(oid string) string {
+	if name, ok := r.resolve(oid); ok {
+		return name
+	}
+	return normalizeOID(oid)
+}
+
+func normalizeOID(oid string) string {
+	return strings.TrimPrefix(oid, ".")
+}
+
+// trapReceiver listens for SNMP traps and informs, and converts them into logs
+type trapReceiver struct {
+	settings receiver.Settings
+	cfg      *Config
+	traps    *TrapsConfig
+	consumer consumer.Logs
+	resolver *oidResolver
+
+	listener *gosnmp.TrapListener
+	wg       sync.WaitGroup
+}
+
+// Verify trapReceiver implements the receiver.Logs interface
+var _ receiver.Logs = (*trapReceiver)(nil)
+
+func newTrapReceiver(settings receiver.Settings, cfg *Config, traps *TrapsConfig, consumer consumer.Logs) *trapReceiver {
+	return &trapReceiver{
+		settings: settings,
+		cfg:      cfg,
+		traps:    traps,
+		consumer: consumer,
+		resolver: newOIDResolver(cfg, traps),
+	}
+}
+
+// Start starts listening for traps, and returns once the listener is ready
+func (r *trapReceiver) Start(_ context.Context, _ component.Host) error {
+	r.listener = gosnmp.NewTrapListener()
+	r.listener.Params = r.listenerParams()
+	r.listener.OnNewTrap = r.handleTrap
+
+	listenErr := make(chan error, 1)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		listenErr <- r.listener.Listen(r.traps.Endpoint)
+	}()
+
+	select {
+	case <-r.listener.Listening():
+		return nil
+	case err := <-listenErr:
+		return fmt.Errorf("failed to listen for traps on %s: %w", r.traps.Endpoint, err)
+	}
+}
+
+// Shutdown stops listening for traps
+func (r *trapReceiver) Shutdown(context.Context) error {
+	if r.listener == nil {
+		return nil
+	}
+	r.listener.Close()
+	r.wg.Wait()
+	return nil
+}
+
+// listenerParams creates the gosnmp parameters used to decode and authenticate received traps
+func (r *trapReceiver) listenerParams() *gosnmp.GoSNMP {
+	params := &otelGoSNMPWrapper{
+		gosnmp.GoSNMP{
+			Timeout: r.cfg.Timeout,
+		},
+	}
+	// gosnmp logs every malformed or unauthenticated packet, keep these at debug level
+	if logger, err := zap.NewStdLogAt(r.settings.Logger, zap.DebugLevel); err == nil {
+		params.Logger = gosnmp.NewLogger(logger)
+	}
+	switch r.cfg.Version {
+	case "v3":
+		params.SetVersion(gosnmp.Version3)
+		setV3ClientConfigs(params, r.cfg)
+	case "v1":
+		params.SetVersion(gosnmp.Version1)
+		params.SetCommunity(r.cfg.Community)
+	default:
+		params.SetVersion(gosnmp.Version2c)
+		params.SetCommunity(r.cfg.Community)
+	}
+	return &params.GoSNMP
+}
+
+// handleTrap converts a trap or inform into a log and passes it to the next consumer
+func (r *trapReceiver) handleTrap(packet *gosnmp.SnmpPacket, addr *net.UDPAddr) {
+	if err := r.authorize(packet); err != nil {
+		r.settings.Logger.Debug("Dropping SNMP trap", zap.Stringer("sender", addr), zap.Error(err))
+		return
+	}
+
+	logs := r.trapToLogs(packet, addr, time.Now())
+	if err := r.consumer.ConsumeLogs(context.Background(), logs); err != nil {
+		r.settings.Logger.Error("Failed to consume SNMP trap", zap.Error(err))
+	}
+}
+
+// authorize only accepts traps of the configured version family, with the configured community for v1 and v2c.
+// v3 traps are authenticated by gosnmp with the configured security settings.
+func (r *trapReceiver) authorize(packet *gosnmp.SnmpPacket) error {
+	if r.cfg.Version == "v3" {
+		if packet.Version != gosnmp.Version3 {
+			return fmt.Errorf("unexpected SNMP version %s", packet.Version)
+		}
+		return nil
+	}
+
+	if packet.Version == gosnmp.Version3 {
+		return errors.New("unexpected SNMP version 3")
+	}
+	if packet.Community != r.cfg.Community {
+		return errors.New("unknown community")
+	}
+	return nil
+}
+
+func (r *trapReceiver) trapToLogs(packet *gosnmp.SnmpPacket, addr *net.UDPAddr, now time.Time) plog.Logs {
+	logs := plog.NewLogs()
+	sl := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(metadata.ScopeName)
+	sl.Scope().SetVersion(r.settings.BuildInfo.Version)
+
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(now))
+
+	attrs := lr.Attributes()
+	if addr != nil {
+		attrs.PutStr("network.peer.address", addr.IP.String())
+		attrs.PutInt("network.peer.port", int64(addr.Port))
+	}
+	attrs.PutStr("snmp.version", packet.Version.String())
+
+	pduType := "trap"
+	if packet.PDUType == gosnmp.InformRequest {
+		pduType = "inform"
+	}
+	attrs.PutStr("snmp.pdu.type", pduType)
+	lr.SetEventName("snmp." + pduType)
+
+	body := lr.Body().SetEmptyMap()
+	var trapOID string
+	if packet.Version == gosnmp.Version1 {
+		trapOID = v1TrapOID(packet)
+		attrs.PutInt("snmp.uptime", int64(packet.Timestamp))
+		if packet.AgentAddress != "" {
+			attrs.PutStr("snmp.agent.address", packet.AgentAddress)
+		}
+		if packet.Enterprise != "" {
+			attrs.PutStr("snmp.enterprise.oid", normalizeOID(packet.Enterprise))
+		}
+	}
+
+	for _, variable := range packet.Variables {
+		oid := normalizeOID(variable.Name)
+		switch oid {
+		case snmpTrapOID:
+			if value, ok := variable.Value.(string); ok {
+				trapOID = normalizeOID(value)
+			}
+			continue
+		case sysUpTimeOID:
+			if value, ok := variable.Value.(uint32); ok {
+				attrs.PutInt("snmp.uptime", int64(value))
+			}
+			continue
+		}
+		r.putVariable(body.PutEmpty(r.resolver.name(oid)), variable)
+	}
+
+	if trapOID != "" {
+		attrs.PutStr("snmp.trap.oid", trapOID)
+		if name, ok := r.resolver.resolve(trapOID); ok {
+			attrs.PutStr("snmp.trap.name", name)
+		}
+	}
+
+	return logs
+}
+
+// putVariable sets the value of a trap variable, resolving OID values to names
+func (r *trapReceiver) putVariable(dest pcommon.Value, variable gosnmp.SnmpPDU) {
+	switch variable.Type {
+	case gosnmp.Counter64, gosnmp.Counter32, gosnmp.Gauge32, gosnmp.Uinteger32, gosnmp.TimeTicks, gosnmp.Integer:
+		dest.SetInt(gosnmp.ToBigInt(variable.Value).Int64())
+	case gosnmp.OpaqueFloat:
+		if value, ok := variable.Value.(float32); ok {
+			dest.SetDouble(float64(value))
+		}
+	case gosnmp.OpaqueDouble:
+		if value, ok := variable.Value.(float64); ok {
+			dest.SetDouble(value)
+		}
+	case gosnmp.ObjectIdentifier:
+		dest.SetStr(r.resolver.name(toString(variable.Value)))
+	case gosnmp.OctetString:
+		value, _ := variable.Value.([]byte)
+		if utf8.Valid(value) {
+			dest.SetStr(string(value))
+		} else {
+			dest.SetStr(hex.EncodeToString(value))
+		}
+	case gosnmp.Null, gosnmp.NoSuchObject, gosnmp.NoSuchInstance, gosnmp.EndOfMibView:
+		// leave the value empty
+	default:
+		dest.SetStr(toString(variable.Value))
+	}
+}
+
+// v1TrapOID maps the generic and specific trap numbers of a v1 trap to a notification OID, see RFC 3584 section 3.1
+func v1TrapOID(packet *gosnmp.SnmpPacket) string {
+	if packet.GenericTrap == enterpriseSpecificTrap {
+		return normalizeOID(packet.Enterprise) + ".0." + strconv.Itoa(packet.SpecificTrap)
+	}
+	return snmpTrapsOID + "." + strconv.Itoa(packet.GenericTrap+1)
+}
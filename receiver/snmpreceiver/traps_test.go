@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
+
+import (
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver/internal/metadata"
+)
+
+func TestOIDResolver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics = map[string]*MetricConfig{
+		"cpu.usage": {ScalarOIDs: []ScalarOID{{OID: ".1.3.6.1.4.1.2021.11.9.0"}}},
+	}
+	cfg.Attributes = map[string]*AttributeConfig{
+		"disk": {OID: "1.3.6.1.4.1.2021.9.1.2", Value: "disk.path"},
+	}
+	traps := &TrapsConfig{OIDNames: map[string]string{
+		".1.3.6.1.4.1.9.9.41.2.0.1": "clogMessageGenerated",
+		"1.3.6.1.2.1.2.2.1.1":       "interfaceIndex",
+	}}
+	resolver := newOIDResolver(cfg, traps)
+
+	testCases := []struct {
+		oid      string
+		expected string
+		resolved bool
+	}{
+		{oid: ".1.3.6.1.6.3.1.1.5.3", expected: "linkDown", resolved: true},
+		{oid: "1.3.6.1.2.1.2.2.1.1.2", expected: "interfaceIndex.2", resolved: true},
+		{oid: "1.3.6.1.2.1.2.2.1.8.2", expected: "ifOperStatus.2", resolved: true},
+		{oid: "1.3.6.1.4.1.2021.11.9.0", expected: "cpu.usage", resolved: true},
+		{oid: "1.3.6.1.4.1.2021.9.1.2.1", expected: "disk.path.1", resolved: true},
+		{oid: "1.3.6.1.4.1.9.9.41.2.0.1", expected: "clogMessageGenerated", resolved: true},
+		{oid: ".1.3.6.1.4.1.99999.1", expected: "1.3.6.1.4.1.99999.1", resolved: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.oid, func(t *testing.T) {
+			_, resolved := resolver.resolve(tc.oid)
+			assert.Equal(t, tc.resolved, resolved)
+			assert.Equal(t, tc.expected, resolver.name(tc.oid))
+		})
+	}
+}
+
+func TestTrapToLogsV1(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	r := newTrapReceiver(receivertest.NewNopSettings(metadata.Type), cfg, cfg.Traps.GetOrInsertDefault(), consumertest.NewNop())
+
+	packet := &gosnmp.SnmpPacket{
+		Version:   gosnmp.Version1,
+		Community: "public",
+		PDUType:   gosnmp.Trap,
+		SnmpTrap: gosnmp.SnmpTrap{
+			Enterprise:   ".1.3.6.1.4.1.8072.3.2.10",
+			AgentAddress: "10.0.0.1",
+			GenericTrap:  2,
+			Timestamp:    4200,
+		},
+		Variables: []gosnmp.SnmpPDU{
+			{Name: ".1.3.6.1.2.1.2.2.1.1.3", Type: gosnmp.Integer, Value: 3},
+			{Name: ".1.3.6.1.2.1.2.2.1.2.3", Type: gosnmp.OctetString, Value: []byte("eth0")},
+		},
+	}
+	logs := r.trapToLogs(packet, &net.UDPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000}, time.Now())
+
+	lr := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "snmp.trap", lr.EventName())
+	assert.Equal(t, map[string]any{
+		"network.peer.address": "10.0.0.1",
+		"network.peer.port":    int64(50000),
+		"snmp.version":         "1",
+		"snmp.pdu.type":        "trap",
+		"snmp.uptime":          int64(4200),
+		"snmp.agent.address":   "10.0.0.1",
+		"snmp.enterprise.oid":  "1.3.6.1.4.1.8072.3.2.10",
+		"snmp.trap.oid":        "1.3.6.1.6.3.1.1.5.3",
+		"snmp.trap.name":       "linkDown",
+	}, lr.Attributes().AsRaw())
+	assert.Equal(t, map[string]any{
+		"ifIndex.3": int64(3),
+		"ifDescr.3": "eth0",
+	}, lr.Body().Map().AsRaw())
+
+	packet.GenericTrap = enterpriseSpecificTrap
+	packet.SpecificTrap = 17
+	assert.Equal(t, "1.3.6.1.4.1.8072.3.2.10.0.17", v1TrapOID(packet))
+}
+
+func TestTrapAuthorize(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Community = "secret"
+	r := newTrapReceiver(receivertest.NewNopSettings(metadata.Type), cfg, cfg.Traps.GetOrInsertDefault(), consumertest.NewNop())
+
+	require.NoError(t, r.authorize(&gosnmp.SnmpPacket{Version: gosnmp.Version2c, Community: "secret"}))
+	require.NoError(t, r.authorize(&gosnmp.SnmpPacket{Version: gosnmp.Version1, Community: "secret"}))
+	require.Error(t, r.authorize(&gosnmp.SnmpPacket{Version: gosnmp.Version2c, Community: "public"}))
+	require.Error(t, r.authorize(&gosnmp.SnmpPacket{Version: gosnmp.Version3}))
+
+	cfg.Version = "v3"
+	require.NoError(t, r.authorize(&gosnmp.SnmpPacket{Version: gosnmp.Version3}))
+	require.Error(t, r.authorize(&gosnmp.SnmpPacket{Version: gosnmp.Version2c, Community: "secret"}))
+}
+
+func TestTrapReceiver(t *testing.T) {
+	port := freeUDPPort(t)
+	cfg := createDefaultConfig().(*Config)
+	traps := cfg.Traps.GetOrInsertDefault()
+	traps.Endpoint = "udp://127.0.0.1:" + strconv.Itoa(port)
+	traps.OIDNames = map[string]string{
+		"1.3.6.1.4.1.8072.2.3.0.1": "netSnmpExampleHeartbeatNotification",
+		"1.3.6.1.4.1.8072.2.3.2.1": "netSnmpExampleHeartbeatRate",
+	}
+
+	sink := new(consumertest.LogsSink)
+	r := newTrapReceiver(receivertest.NewNopSettings(metadata.Type), cfg, traps, sink)
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, r.Shutdown(t.Context())) }()
+
+	sender := &gosnmp.GoSNMP{
+		Target:    "127.0.0.1",
+		Port:      uint16(port),
+		Version:   gosnmp.Version2c,
+		Community: "public",
+		Timeout:   time.Second,
+		Retries:   1,
+	}
+	require.NoError(t, sender.Connect())
+	defer sender.Conn.Close()
+
+	_, err := sender.SendTrap(gosnmp.SnmpTrap{
+		Variables: []gosnmp.SnmpPDU{
+			{Name: ".1.3.6.1.2.1.1.3.0", Type: gosnmp.TimeTicks, Value: uint32(1234)},
+			{Name: ".1.3.6.1.6.3.1.1.4.1.0", Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.4.1.8072.2.3.0.1"},
+			{Name: ".1.3.6.1.4.1.8072.2.3.2.1", Type: gosnmp.Integer, Value: 42},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	lr := firstLogRecord(sink.AllLogs()[0])
+	assert.Equal(t, "snmp.trap", lr.EventName())
+	trapName, ok := lr.Attributes().Get("snmp.trap.name")
+	require.True(t, ok)
+	assert.Equal(t, "netSnmpExampleHeartbeatNotification", trapName.Str())
+	uptime, ok := lr.Attributes().Get("snmp.uptime")
+	require.True(t, ok)
+	assert.Equal(t, int64(1234), uptime.Int())
+	assert.Equal(t, map[string]any{"netSnmpExampleHeartbeatRate": int64(42)}, lr.Body().Map().AsRaw())
+
+	// traps with an unknown community are dropped
+	sender.Community = "private"
+	_, err = sender.SendTrap(gosnmp.SnmpTrap{
+		Variables: []gosnmp.SnmpPDU{
+			{Name: ".1.3.6.1.6.3.1.1.4.1.0", Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.6.3.1.1.5.1"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Never(t, func() bool { return sink.LogRecordCount() > 1 }, 200*time.Millisecond, 10*time.Millisecond)
+}
+
+func freeUDPPort(t *testing.T) int {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).Port
+}
+
+func firstLogRecord(logs plog.Logs) plog.LogRecord {
+	return logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+}
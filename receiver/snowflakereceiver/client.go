@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	sf "github.com/snowflakedb/gosnowflake"
 	"go.opentelemetry.io/collector/component"
@@ -16,6 +17,7 @@ import (
 // queries
 var (
 	billingMetricsQuery          = "select SERVICE_TYPE, NAME, sum(CREDITS_USED_COMPUTE), sum(CREDITS_USED_CLOUD_SERVICES), sum(CREDITS_USED) from METERING_HISTORY where start_time >= DATEADD(hour, -24, current_timestamp()) group by 1, 2;"
+	warehouseHourlyBillingQuery  = "select WAREHOUSE_NAME, END_TIME, CREDITS_USED from WAREHOUSE_METERING_HISTORY where start_time >= DATEADD(hour, -24, current_timestamp()) order by 1, 2;"
 	warehouseBillingMetricsQuery = "select WAREHOUSE_NAME, sum(CREDITS_USED_COMPUTE), sum(CREDITS_USED_CLOUD_SERVICES), sum(CREDITS_USED) from WAREHOUSE_METERING_HISTORY where start_time >= DATEADD(hour, -24, current_timestamp()) group by 1;"
 	loginMetricsQuery            = "select USER_NAME, ERROR_MESSAGE, REPORTED_CLIENT_TYPE, IS_SUCCESS, count(*) from LOGIN_HISTORY where event_timestamp >= DATEADD(hour, -24, current_timestamp()) group by 1, 2, 3, 4;"
 	highLevelQueryMetricsQuery   = "select WAREHOUSE_NAME, AVG(AVG_RUNNING), AVG(AVG_QUEUED_LOAD), AVG(AVG_QUEUED_PROVISIONING), AVG(AVG_BLOCKED) from WAREHOUSE_LOAD_HISTORY where start_time >= DATEADD(hour, -24, current_timestamp()) group by 1;"
@@ -23,6 +25,7 @@ var (
 	sessionMetricsQuery          = "select USER_NAME, count(distinct(SESSION_ID)) from Sessions where created_on >= DATEADD(hour, -24, current_timestamp()) group by 1;"
 	snowpipeMetricsQuery         = "select pipe_name, sum(credits_used), sum(bytes_inserted), sum(files_inserted) from pipe_usage_history where start_time >= DATEADD(hour, -24, current_timestamp()) group by 1;"
 	storageMetricsQuery          = "select STORAGE_BYTES, STAGE_BYTES, FAILSAFE_BYTES from STORAGE_USAGE ORDER BY USAGE_DATE DESC LIMIT 1;"
+	queryHistoryQuery            = "select QUERY_ID, QUERY_TEXT, DATABASE_NAME, SCHEMA_NAME, QUERY_TYPE, USER_NAME, ROLE_NAME, WAREHOUSE_NAME, WAREHOUSE_SIZE, EXECUTION_STATUS, ERROR_CODE, ERROR_MESSAGE, START_TIME, END_TIME, TOTAL_ELAPSED_TIME, BYTES_SCANNED, ROWS_PRODUCED, CREDITS_USED_CLOUD_SERVICES from QUERY_HISTORY where end_time >= ? order by END_TIME limit ?;"
 )
 
 // snowflake client is comprised of a sql.DB (the proper 'client' in question),
@@ -67,8 +70,8 @@ func newDefaultClient(settings component.TelemetrySettings, c Config) (*snowflak
 }
 
 // queries database and returns resulting rows
-func (c snowflakeClient) readDB(ctx context.Context, q string) (*sql.Rows, error) {
-	rows, err := c.client.QueryContext(ctx, q)
+func (c snowflakeClient) readDB(ctx context.Context, q string, args ...any) (*sql.Rows, error) {
+	rows, err := c.client.QueryContext(ctx, q, args...)
 	if err != nil {
 		c.logger.Error(fmt.Sprintf("Query failed with %v", err))
 		return nil, err
@@ -144,6 +147,37 @@ func (c snowflakeClient) FetchWarehouseBillingMetrics(ctx context.Context) (*[]w
 	return &res, nil
 }
 
+func (c snowflakeClient) FetchWarehouseHourlyBillingMetrics(ctx context.Context) (*[]whHourlyBillingMetric, error) {
+	rows, err := c.readDB(ctx, warehouseHourlyBillingQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == nil {
+		err = fmt.Errorf("no rows returned by query: %v", warehouseHourlyBillingQuery)
+		return nil, err
+	}
+
+	var res []whHourlyBillingMetric
+
+	for rows.Next() {
+		var warehouseName sql.NullString
+		var endTime time.Time
+		var credits float64
+
+		err := rows.Scan(&warehouseName, &endTime, &credits)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, whHourlyBillingMetric{
+			warehouseName: warehouseName,
+			endTime:       endTime,
+			credits:       credits,
+		})
+	}
+	return &res, nil
+}
+
 func (c snowflakeClient) FetchLoginMetrics(ctx context.Context) (*[]loginMetric, error) {
 	rows, err := c.readDB(ctx, loginMetricsQuery)
 	if err != nil {
@@ -409,3 +443,31 @@ func (c snowflakeClient) FetchStorageMetrics(ctx context.Context) (*[]storageMet
 	}
 	return &res, nil
 }
+
+// FetchQueryHistory returns the queries which ended at or after the given time, ordered by end time
+func (c snowflakeClient) FetchQueryHistory(ctx context.Context, since time.Time, limit int) (*[]queryHistoryRow, error) {
+	rows, err := c.readDB(ctx, queryHistoryQuery, since, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == nil {
+		err = fmt.Errorf("no rows returned by query: %v", queryHistoryQuery)
+		return nil, err
+	}
+
+	var res []queryHistoryRow
+
+	for rows.Next() {
+		var row queryHistoryRow
+		err := rows.Scan(&row.queryID, &row.queryText, &row.databaseName, &row.schemaName, &row.queryType,
+			&row.userName, &row.roleName, &row.warehouseName, &row.warehouseSize, &row.executionStatus,
+			&row.errorCode, &row.errorMessage, &row.startTime, &row.endTime, &row.totalElapsedTime,
+			&row.bytesScanned, &row.rowsProduced, &row.creditsUsedCloudServices)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, row)
+	}
+	return &res, nil
+}
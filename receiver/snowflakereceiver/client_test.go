@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -103,6 +104,20 @@ func TestMetricQueries(t *testing.T) {
 				totalVirtualWarehouse: 1.0,
 			},
 		},
+		{
+			desc:    "FetchWarehouseHourlyBillingMetrics",
+			query:   warehouseHourlyBillingQuery,
+			columns: []string{"wh_name", "end_time", "credits"},
+			params:  []driver.Value{"n", time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC), 1.5},
+			expect: whHourlyBillingMetric{
+				warehouseName: sql.NullString{
+					String: "n",
+					Valid:  true,
+				},
+				endTime: time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC),
+				credits: 1.5,
+			},
+		},
 		{
 			desc:    "FetchLoginMetrics",
 			query:   loginMetricsQuery,
@@ -317,3 +332,47 @@ func TestMetricQueries(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchQueryHistory(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal("an error was not expected when opening mock db", err)
+	}
+	defer db.Close()
+
+	since := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	start := since.Add(time.Minute)
+	end := since.Add(2 * time.Minute)
+	rows := mock.NewRows([]string{
+		"query_id", "query_text", "database_name", "schema_name", "query_type", "user_name", "role_name",
+		"warehouse_name", "warehouse_size", "execution_status", "error_code", "error_message", "start_time",
+		"end_time", "total_elapsed_time", "bytes_scanned", "rows_produced", "credits_used_cloud_services",
+	}).AddRow("id", "select 1", "db", "schema", "SELECT", "user", "role", "wh", "X-Small", "SUCCESS", nil, nil,
+		start, end, 60000, 1024, nil, 0.5)
+	mock.ExpectQuery(queryHistoryQuery).WithArgs(since, 10).WillReturnRows(rows)
+
+	client := snowflakeClient{
+		client: db,
+		logger: receivertest.NewNopSettings(metadata.Type).Logger,
+	}
+	queries, err := client.FetchQueryHistory(t.Context(), since, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []queryHistoryRow{{
+		queryID:                  "id",
+		queryText:                sql.NullString{String: "select 1", Valid: true},
+		databaseName:             sql.NullString{String: "db", Valid: true},
+		schemaName:               sql.NullString{String: "schema", Valid: true},
+		queryType:                sql.NullString{String: "SELECT", Valid: true},
+		userName:                 sql.NullString{String: "user", Valid: true},
+		roleName:                 sql.NullString{String: "role", Valid: true},
+		warehouseName:            sql.NullString{String: "wh", Valid: true},
+		warehouseSize:            sql.NullString{String: "X-Small", Valid: true},
+		executionStatus:          sql.NullString{String: "SUCCESS", Valid: true},
+		startTime:                start,
+		endTime:                  end,
+		totalElapsedTime:         60000,
+		bytesScanned:             1024,
+		creditsUsedCloudServices: sql.NullFloat64{Float64: 0.5, Valid: true},
+	}}, *queries)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
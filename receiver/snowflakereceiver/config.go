@@ -5,6 +5,7 @@ package snowflakereceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
@@ -18,6 +19,8 @@ var (
 	errMissingPassword  = errors.New("You must provide a password for the snowflake username")
 	errMissingAccount   = errors.New("You must provide a valid account name")
 	errMissingWarehouse = errors.New("You must provide a valid warehouse name")
+	errInvalidMaxRows   = errors.New("query_history::max_rows must be greater than 0")
+	errInvalidLag       = errors.New("query_history::lag must not be negative")
 )
 
 type Config struct {
@@ -30,6 +33,16 @@ type Config struct {
 	Warehouse                      string              `mapstructure:"warehouse"`
 	Database                       string              `mapstructure:"database"`
 	Role                           string              `mapstructure:"role"`
+	// QueryHistory configures the query history reported as logs, when the receiver is used in a logs pipeline.
+	QueryHistory QueryHistoryConfig `mapstructure:"query_history"`
+}
+
+type QueryHistoryConfig struct {
+	// MaxRows is the maximum number of queries reported on each collection.
+	MaxRows int `mapstructure:"max_rows"`
+	// Lag is how long before the end of the most recent query already reported the next collection starts,
+	// so that the queries added late to the query history, or cut by the limit of rows, are still reported.
+	Lag time.Duration `mapstructure:"lag"`
 }
 
 func (cfg *Config) Validate() error {
@@ -50,5 +63,13 @@ func (cfg *Config) Validate() error {
 		errs = multierr.Append(errs, errMissingWarehouse)
 	}
 
+	if cfg.QueryHistory.MaxRows <= 0 {
+		errs = multierr.Append(errs, errInvalidMaxRows)
+	}
+
+	if cfg.QueryHistory.Lag < 0 {
+		errs = multierr.Append(errs, errInvalidLag)
+	}
+
 	return errs
 }
@@ -78,6 +78,32 @@ func TestValidateConfig(t *testing.T) {
 				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
 			},
 		},
+		{
+			desc:   "Invalid query history max rows",
+			expect: errInvalidMaxRows,
+			conf: Config{
+				Username:         "username",
+				Password:         "password",
+				Account:          "account",
+				Warehouse:        "warehouse",
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+		},
+		{
+			desc:   "Invalid query history lag",
+			expect: errInvalidLag,
+			conf: Config{
+				Username:         "username",
+				Password:         "password",
+				Account:          "account",
+				Warehouse:        "warehouse",
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				QueryHistory: QueryHistoryConfig{
+					MaxRows: 100,
+					Lag:     -time.Minute,
+				},
+			},
+		},
 		{
 			desc:   "Missing multiple check multierror",
 			expect: multierror,
@@ -128,6 +154,10 @@ func TestLoadConfig(t *testing.T) {
 		Database:             "SNOWFLAKE",
 		Schema:               "ACCOUNT_USAGE",
 		MetricsBuilderConfig: testMetrics,
+		QueryHistory: QueryHistoryConfig{
+			MaxRows: 100,
+			Lag:     10 * time.Minute,
+		},
 	}
 
 	factory := NewFactory()
@@ -21,6 +21,9 @@ const (
 	defaultRole     = "ACCOUNTADMIN"
 	defaultDB       = "SNOWFLAKE"
 	defaultSchema   = "ACCOUNT_USAGE"
+	defaultMaxRows  = 1000
+	// the views of ACCOUNT_USAGE, including QUERY_HISTORY, can lag up to 45 minutes behind
+	defaultLag = 45 * time.Minute
 )
 
 func createDefaultConfig() component.Config {
@@ -33,6 +36,10 @@ func createDefaultConfig() component.Config {
 		Schema:               defaultSchema,
 		Database:             defaultDB,
 		Role:                 defaultRole,
+		QueryHistory: QueryHistoryConfig{
+			MaxRows: defaultMaxRows,
+			Lag:     defaultLag,
+		},
 	}
 }
 
@@ -41,6 +48,7 @@ func NewFactory() receiver.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
 	)
 }
 
@@ -65,3 +73,29 @@ func createMetricsReceiver(
 		scraperhelper.AddMetricsScraper(metadata.Type, s),
 	)
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.Settings,
+	baseCfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg := baseCfg.(*Config)
+	queryHistoryScraper := newSnowflakeQueryHistoryScraper(params, cfg)
+
+	s, err := scraper.NewLogs(queryHistoryScraper.scrape, scraper.WithStart(queryHistoryScraper.start), scraper.WithShutdown(queryHistoryScraper.shutdown))
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewLogsController(
+		&cfg.ControllerConfig,
+		params,
+		consumer,
+		scraperhelper.AddFactoryWithConfig(
+			scraper.NewFactory(metadata.Type, nil,
+				scraper.WithLogs(func(context.Context, scraper.Settings, component.Config) (scraper.Logs, error) {
+					return s, nil
+				}, metadata.LogsStability)), nil),
+	)
+}
@@ -33,6 +33,8 @@ type MetricsConfig struct {
 	SnowflakeBillingTotalCreditTotal               MetricConfig `mapstructure:"snowflake.billing.total_credit.total"`
 	SnowflakeBillingVirtualWarehouseTotal          MetricConfig `mapstructure:"snowflake.billing.virtual_warehouse.total"`
 	SnowflakeBillingWarehouseCloudServiceTotal     MetricConfig `mapstructure:"snowflake.billing.warehouse.cloud_service.total"`
+	SnowflakeBillingWarehouseCreditForecast        MetricConfig `mapstructure:"snowflake.billing.warehouse.credit.forecast"`
+	SnowflakeBillingWarehouseCreditHourly          MetricConfig `mapstructure:"snowflake.billing.warehouse.credit.hourly"`
 	SnowflakeBillingWarehouseTotalCreditTotal      MetricConfig `mapstructure:"snowflake.billing.warehouse.total_credit.total"`
 	SnowflakeBillingWarehouseVirtualWarehouseTotal MetricConfig `mapstructure:"snowflake.billing.warehouse.virtual_warehouse.total"`
 	SnowflakeDatabaseBytesScannedAvg               MetricConfig `mapstructure:"snowflake.database.bytes_scanned.avg"`
@@ -80,6 +82,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		SnowflakeBillingWarehouseCloudServiceTotal: MetricConfig{
 			Enabled: false,
 		},
+		SnowflakeBillingWarehouseCreditForecast: MetricConfig{
+			Enabled: false,
+		},
+		SnowflakeBillingWarehouseCreditHourly: MetricConfig{
+			Enabled: false,
+		},
 		SnowflakeBillingWarehouseTotalCreditTotal: MetricConfig{
 			Enabled: false,
 		},
@@ -30,6 +30,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SnowflakeBillingTotalCreditTotal:               MetricConfig{Enabled: true},
 					SnowflakeBillingVirtualWarehouseTotal:          MetricConfig{Enabled: true},
 					SnowflakeBillingWarehouseCloudServiceTotal:     MetricConfig{Enabled: true},
+					SnowflakeBillingWarehouseCreditForecast:        MetricConfig{Enabled: true},
+					SnowflakeBillingWarehouseCreditHourly:          MetricConfig{Enabled: true},
 					SnowflakeBillingWarehouseTotalCreditTotal:      MetricConfig{Enabled: true},
 					SnowflakeBillingWarehouseVirtualWarehouseTotal: MetricConfig{Enabled: true},
 					SnowflakeDatabaseBytesScannedAvg:               MetricConfig{Enabled: true},
@@ -75,6 +77,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SnowflakeBillingTotalCreditTotal:               MetricConfig{Enabled: false},
 					SnowflakeBillingVirtualWarehouseTotal:          MetricConfig{Enabled: false},
 					SnowflakeBillingWarehouseCloudServiceTotal:     MetricConfig{Enabled: false},
+					SnowflakeBillingWarehouseCreditForecast:        MetricConfig{Enabled: false},
+					SnowflakeBillingWarehouseCreditHourly:          MetricConfig{Enabled: false},
 					SnowflakeBillingWarehouseTotalCreditTotal:      MetricConfig{Enabled: false},
 					SnowflakeBillingWarehouseVirtualWarehouseTotal: MetricConfig{Enabled: false},
 					SnowflakeDatabaseBytesScannedAvg:               MetricConfig{Enabled: false},
@@ -25,6 +25,12 @@ var MetricsInfo = metricsInfo{
 	SnowflakeBillingWarehouseCloudServiceTotal: metricInfo{
 		Name: "snowflake.billing.warehouse.cloud_service.total",
 	},
+	SnowflakeBillingWarehouseCreditForecast: metricInfo{
+		Name: "snowflake.billing.warehouse.credit.forecast",
+	},
+	SnowflakeBillingWarehouseCreditHourly: metricInfo{
+		Name: "snowflake.billing.warehouse.credit.hourly",
+	},
 	SnowflakeBillingWarehouseTotalCreditTotal: metricInfo{
 		Name: "snowflake.billing.warehouse.total_credit.total",
 	},
@@ -125,6 +131,8 @@ type metricsInfo struct {
 	SnowflakeBillingTotalCreditTotal               metricInfo
 	SnowflakeBillingVirtualWarehouseTotal          metricInfo
 	SnowflakeBillingWarehouseCloudServiceTotal     metricInfo
+	SnowflakeBillingWarehouseCreditForecast        metricInfo
+	SnowflakeBillingWarehouseCreditHourly          metricInfo
 	SnowflakeBillingWarehouseTotalCreditTotal      metricInfo
 	SnowflakeBillingWarehouseVirtualWarehouseTotal metricInfo
 	SnowflakeDatabaseBytesScannedAvg               metricInfo
@@ -370,6 +378,110 @@ func newMetricSnowflakeBillingWarehouseCloudServiceTotal(cfg MetricConfig) metri
 	return m
 }
 
+type metricSnowflakeBillingWarehouseCreditForecast struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills snowflake.billing.warehouse.credit.forecast metric with initial data.
+func (m *metricSnowflakeBillingWarehouseCreditForecast) init() {
+	m.data.SetName("snowflake.billing.warehouse.credit.forecast")
+	m.data.SetDescription("Credits the warehouse is projected to use over the next 24 hours, extrapolated from the trend of its hourly credit usage over the last 24 hours.")
+	m.data.SetUnit("{credits}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSnowflakeBillingWarehouseCreditForecast) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, warehouseNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("warehouse_name", warehouseNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSnowflakeBillingWarehouseCreditForecast) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSnowflakeBillingWarehouseCreditForecast) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSnowflakeBillingWarehouseCreditForecast(cfg MetricConfig) metricSnowflakeBillingWarehouseCreditForecast {
+	m := metricSnowflakeBillingWarehouseCreditForecast{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSnowflakeBillingWarehouseCreditHourly struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills snowflake.billing.warehouse.credit.hourly metric with initial data.
+func (m *metricSnowflakeBillingWarehouseCreditHourly) init() {
+	m.data.SetName("snowflake.billing.warehouse.credit.hourly")
+	m.data.SetDescription("Credits used by the warehouse during the most recent hour reported by Snowflake.")
+	m.data.SetUnit("{credits}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSnowflakeBillingWarehouseCreditHourly) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, warehouseNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("warehouse_name", warehouseNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSnowflakeBillingWarehouseCreditHourly) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSnowflakeBillingWarehouseCreditHourly) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSnowflakeBillingWarehouseCreditHourly(cfg MetricConfig) metricSnowflakeBillingWarehouseCreditHourly {
+	m := metricSnowflakeBillingWarehouseCreditHourly{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSnowflakeBillingWarehouseTotalCreditTotal struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2106,6 +2218,8 @@ type MetricsBuilder struct {
 	metricSnowflakeBillingTotalCreditTotal               metricSnowflakeBillingTotalCreditTotal
 	metricSnowflakeBillingVirtualWarehouseTotal          metricSnowflakeBillingVirtualWarehouseTotal
 	metricSnowflakeBillingWarehouseCloudServiceTotal     metricSnowflakeBillingWarehouseCloudServiceTotal
+	metricSnowflakeBillingWarehouseCreditForecast        metricSnowflakeBillingWarehouseCreditForecast
+	metricSnowflakeBillingWarehouseCreditHourly          metricSnowflakeBillingWarehouseCreditHourly
 	metricSnowflakeBillingWarehouseTotalCreditTotal      metricSnowflakeBillingWarehouseTotalCreditTotal
 	metricSnowflakeBillingWarehouseVirtualWarehouseTotal metricSnowflakeBillingWarehouseVirtualWarehouseTotal
 	metricSnowflakeDatabaseBytesScannedAvg               metricSnowflakeDatabaseBytesScannedAvg
@@ -2166,6 +2280,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricSnowflakeBillingTotalCreditTotal:  newMetricSnowflakeBillingTotalCreditTotal(mbc.Metrics.SnowflakeBillingTotalCreditTotal),
 		metricSnowflakeBillingVirtualWarehouseTotal:          newMetricSnowflakeBillingVirtualWarehouseTotal(mbc.Metrics.SnowflakeBillingVirtualWarehouseTotal),
 		metricSnowflakeBillingWarehouseCloudServiceTotal:     newMetricSnowflakeBillingWarehouseCloudServiceTotal(mbc.Metrics.SnowflakeBillingWarehouseCloudServiceTotal),
+		metricSnowflakeBillingWarehouseCreditForecast:        newMetricSnowflakeBillingWarehouseCreditForecast(mbc.Metrics.SnowflakeBillingWarehouseCreditForecast),
+		metricSnowflakeBillingWarehouseCreditHourly:          newMetricSnowflakeBillingWarehouseCreditHourly(mbc.Metrics.SnowflakeBillingWarehouseCreditHourly),
 		metricSnowflakeBillingWarehouseTotalCreditTotal:      newMetricSnowflakeBillingWarehouseTotalCreditTotal(mbc.Metrics.SnowflakeBillingWarehouseTotalCreditTotal),
 		metricSnowflakeBillingWarehouseVirtualWarehouseTotal: newMetricSnowflakeBillingWarehouseVirtualWarehouseTotal(mbc.Metrics.SnowflakeBillingWarehouseVirtualWarehouseTotal),
 		metricSnowflakeDatabaseBytesScannedAvg:               newMetricSnowflakeDatabaseBytesScannedAvg(mbc.Metrics.SnowflakeDatabaseBytesScannedAvg),
@@ -2279,6 +2395,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricSnowflakeBillingTotalCreditTotal.emit(ils.Metrics())
 	mb.metricSnowflakeBillingVirtualWarehouseTotal.emit(ils.Metrics())
 	mb.metricSnowflakeBillingWarehouseCloudServiceTotal.emit(ils.Metrics())
+	mb.metricSnowflakeBillingWarehouseCreditForecast.emit(ils.Metrics())
+	mb.metricSnowflakeBillingWarehouseCreditHourly.emit(ils.Metrics())
 	mb.metricSnowflakeBillingWarehouseTotalCreditTotal.emit(ils.Metrics())
 	mb.metricSnowflakeBillingWarehouseVirtualWarehouseTotal.emit(ils.Metrics())
 	mb.metricSnowflakeDatabaseBytesScannedAvg.emit(ils.Metrics())
@@ -2361,6 +2479,16 @@ func (mb *MetricsBuilder) RecordSnowflakeBillingWarehouseCloudServiceTotalDataPo
 	mb.metricSnowflakeBillingWarehouseCloudServiceTotal.recordDataPoint(mb.startTime, ts, val, warehouseNameAttributeValue)
 }
 
+// RecordSnowflakeBillingWarehouseCreditForecastDataPoint adds a data point to snowflake.billing.warehouse.credit.forecast metric.
+func (mb *MetricsBuilder) RecordSnowflakeBillingWarehouseCreditForecastDataPoint(ts pcommon.Timestamp, val float64, warehouseNameAttributeValue string) {
+	mb.metricSnowflakeBillingWarehouseCreditForecast.recordDataPoint(mb.startTime, ts, val, warehouseNameAttributeValue)
+}
+
+// RecordSnowflakeBillingWarehouseCreditHourlyDataPoint adds a data point to snowflake.billing.warehouse.credit.hourly metric.
+func (mb *MetricsBuilder) RecordSnowflakeBillingWarehouseCreditHourlyDataPoint(ts pcommon.Timestamp, val float64, warehouseNameAttributeValue string) {
+	mb.metricSnowflakeBillingWarehouseCreditHourly.recordDataPoint(mb.startTime, ts, val, warehouseNameAttributeValue)
+}
+
 // RecordSnowflakeBillingWarehouseTotalCreditTotalDataPoint adds a data point to snowflake.billing.warehouse.total_credit.total metric.
 func (mb *MetricsBuilder) RecordSnowflakeBillingWarehouseTotalCreditTotalDataPoint(ts pcommon.Timestamp, val float64, warehouseNameAttributeValue string) {
 	mb.metricSnowflakeBillingWarehouseTotalCreditTotal.recordDataPoint(mb.startTime, ts, val, warehouseNameAttributeValue)
@@ -79,6 +79,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSnowflakeBillingWarehouseCloudServiceTotalDataPoint(ts, 1, "warehouse_name-val")
 
+			allMetricsCount++
+			mb.RecordSnowflakeBillingWarehouseCreditForecastDataPoint(ts, 1, "warehouse_name-val")
+
+			allMetricsCount++
+			mb.RecordSnowflakeBillingWarehouseCreditHourlyDataPoint(ts, 1, "warehouse_name-val")
+
 			allMetricsCount++
 			mb.RecordSnowflakeBillingWarehouseTotalCreditTotalDataPoint(ts, 1, "warehouse_name-val")
 
@@ -272,6 +278,36 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("warehouse_name")
 					assert.True(t, ok)
 					assert.Equal(t, "warehouse_name-val", attrVal.Str())
+				case "snowflake.billing.warehouse.credit.forecast":
+					assert.False(t, validatedMetrics["snowflake.billing.warehouse.credit.forecast"], "Found a duplicate in the metrics slice: snowflake.billing.warehouse.credit.forecast")
+					validatedMetrics["snowflake.billing.warehouse.credit.forecast"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Credits the warehouse is projected to use over the next 24 hours, extrapolated from the trend of its hourly credit usage over the last 24 hours.", ms.At(i).Description())
+					assert.Equal(t, "{credits}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("warehouse_name")
+					assert.True(t, ok)
+					assert.Equal(t, "warehouse_name-val", attrVal.Str())
+				case "snowflake.billing.warehouse.credit.hourly":
+					assert.False(t, validatedMetrics["snowflake.billing.warehouse.credit.hourly"], "Found a duplicate in the metrics slice: snowflake.billing.warehouse.credit.hourly")
+					validatedMetrics["snowflake.billing.warehouse.credit.hourly"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Credits used by the warehouse during the most recent hour reported by Snowflake.", ms.At(i).Description())
+					assert.Equal(t, "{credits}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("warehouse_name")
+					assert.True(t, ok)
+					assert.Equal(t, "warehouse_name-val", attrVal.Str())
 				case "snowflake.billing.warehouse.total_credit.total":
 					assert.False(t, validatedMetrics["snowflake.billing.warehouse.total_credit.total"], "Found a duplicate in the metrics slice: snowflake.billing.warehouse.total_credit.total")
 					validatedMetrics["snowflake.billing.warehouse.total_credit.total"] = true
@@ -3,7 +3,10 @@
 
 package snowflakereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snowflakereceiver"
 
-import "database/sql"
+import (
+	"database/sql"
+	"time"
+)
 
 // each query returns columns which serialize into these data structures
 // these are consumed by the scraper to create and emit metrics
@@ -25,6 +28,13 @@ type whBillingMetric struct {
 	totalVirtualWarehouse float64
 }
 
+// warehouse hourly billing query
+type whHourlyBillingMetric struct {
+	warehouseName sql.NullString
+	endTime       time.Time
+	credits       float64
+}
+
 // login metrics query
 type loginMetric struct {
 	userName           sql.NullString
@@ -95,3 +105,25 @@ type storageMetric struct {
 	stageBytes    float64
 	failsafeBytes float64
 }
+
+// query history query
+type queryHistoryRow struct {
+	queryID                  string
+	queryText                sql.NullString
+	databaseName             sql.NullString
+	schemaName               sql.NullString
+	queryType                sql.NullString
+	userName                 sql.NullString
+	roleName                 sql.NullString
+	warehouseName            sql.NullString
+	warehouseSize            sql.NullString
+	executionStatus          sql.NullString
+	errorCode                sql.NullString
+	errorMessage             sql.NullString
+	startTime                time.Time
+	endTime                  time.Time
+	totalElapsedTime         int64
+	bytesScanned             int64
+	rowsProduced             sql.NullInt64
+	creditsUsedCloudServices sql.NullFloat64
+}
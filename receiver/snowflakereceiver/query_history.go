@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snowflakereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snowflakereceiver"
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snowflakereceiver/internal/metadata"
+)
+
+// queryHistoryEventName is the event name of the log records reporting the queries
+const queryHistoryEventName = "snowflake.query"
+
+var (
+	// literalsAndComments matches the string literals, numeric literals and comments of a query
+	literalsAndComments = regexp.MustCompile(`(?s)'(?:[^'\\]|\\.|'')*'|\$\$.*?\$\$|/\*.*?\*/|--[^\n]*|\b\d+(?:\.\d+)?(?:[eE][+-]?\d+)?\b`)
+	// valueLists matches the lists of placeholders of the IN clauses, whose length varies between executions
+	valueLists = regexp.MustCompile(`(?i)(\bin\s*)\(\s*\?(?:\s*,\s*\?)*\s*\)`)
+	whitespace = regexp.MustCompile(`\s+`)
+)
+
+// normalizeQuery replaces the literals of the query with placeholders and removes its comments, so that the
+// executions of a query with different values have the same normalized text.
+func normalizeQuery(query string) string {
+	normalized := literalsAndComments.ReplaceAllStringFunc(query, func(match string) string {
+		if strings.HasPrefix(match, "--") || strings.HasPrefix(match, "/*") {
+			return " "
+		}
+		return "?"
+	})
+	normalized = valueLists.ReplaceAllString(normalized, "${1}(?)")
+	return strings.TrimSpace(whitespace.ReplaceAllString(normalized, " "))
+}
+
+// queryHash returns the hash identifying a normalized query
+func queryHash(normalizedQuery string) string {
+	sum := sha256.Sum256([]byte(normalizedQuery))
+	return hex.EncodeToString(sum[:])
+}
+
+// snowflakeQueryHistoryScraper reports the queries of the query history as logs. Each scrape reports the queries
+// which ended since the most recent query already reported, minus the configured lag. The queries of the lag
+// window which were already reported are tracked by ID, so that they are reported only once.
+type snowflakeQueryHistoryScraper struct {
+	client   *snowflakeClient
+	settings component.TelemetrySettings
+	conf     *Config
+	started  time.Time
+	lastEnd  time.Time
+	// reported holds the end time of the queries already reported which ended in the lag window, by query ID
+	reported map[string]time.Time
+}
+
+func newSnowflakeQueryHistoryScraper(settings receiver.Settings, conf *Config) *snowflakeQueryHistoryScraper {
+	return &snowflakeQueryHistoryScraper{
+		settings: settings.TelemetrySettings,
+		conf:     conf,
+		reported: map[string]time.Time{},
+	}
+}
+
+func (s *snowflakeQueryHistoryScraper) start(_ context.Context, _ component.Host) (err error) {
+	// only the queries ending after the start of the receiver are reported
+	s.started = time.Now()
+	s.lastEnd = s.started
+	s.client, err = newDefaultClient(s.settings, *s.conf)
+	return err
+}
+
+func (s *snowflakeQueryHistoryScraper) shutdown(_ context.Context) error {
+	if s.client == nil {
+		return nil
+	}
+	return s.client.client.Close()
+}
+
+// since returns the end time of the first query to fetch
+func (s *snowflakeQueryHistoryScraper) since() time.Time {
+	since := s.lastEnd.Add(-s.conf.QueryHistory.Lag)
+	if since.Before(s.started) {
+		return s.started
+	}
+	return since
+}
+
+func (s *snowflakeQueryHistoryScraper) scrape(ctx context.Context) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	maxRows := s.conf.QueryHistory.MaxRows
+	// the queries already reported are fetched again, so that they don't take the place of the new ones
+	queries, err := s.client.FetchQueryHistory(ctx, s.since(), maxRows+len(s.reported))
+	if err != nil {
+		return logs, err
+	}
+
+	var records plog.LogRecordSlice
+	count := 0
+	observed := pcommon.NewTimestampFromTime(time.Now())
+	for i := range *queries {
+		row := &(*queries)[i]
+		if _, ok := s.reported[row.queryID]; ok {
+			continue
+		}
+		if count == maxRows {
+			break
+		}
+		if count == 0 {
+			rl := logs.ResourceLogs().AppendEmpty()
+			rb := metadata.NewResourceBuilder(s.conf.ResourceAttributes)
+			rb.SetSnowflakeAccountName(s.conf.Account)
+			rb.Emit().MoveTo(rl.Resource())
+			sl := rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName(metadata.ScopeName)
+			records = sl.LogRecords()
+		}
+		count++
+		lr := records.AppendEmpty()
+		lr.SetObservedTimestamp(observed)
+		queryToLogRecord(row, lr)
+		s.reported[row.queryID] = row.endTime
+		if row.endTime.After(s.lastEnd) {
+			s.lastEnd = row.endTime
+		}
+	}
+
+	// forget the queries which ended before the lag window, they aren't fetched anymore
+	since := s.since()
+	for id, end := range s.reported {
+		if end.Before(since) {
+			delete(s.reported, id)
+		}
+	}
+	return logs, nil
+}
+
+// queryToLogRecord fills the log record reporting a query of the query history
+func queryToLogRecord(row *queryHistoryRow, lr plog.LogRecord) {
+	normalized := normalizeQuery(row.queryText.String)
+	lr.SetEventName(queryHistoryEventName)
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(row.startTime))
+	lr.Body().SetStr(normalized)
+	if row.executionStatus.String == "FAIL" {
+		lr.SetSeverityNumber(plog.SeverityNumberError)
+	} else {
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	}
+
+	attrs := lr.Attributes()
+	attrs.PutStr("query_id", row.queryID)
+	attrs.PutStr("query_hash", queryHash(normalized))
+	putNullString(attrs, "query_type", row.queryType)
+	putNullString(attrs, "database_name", row.databaseName)
+	putNullString(attrs, "schema_name", row.schemaName)
+	putNullString(attrs, "user_name", row.userName)
+	putNullString(attrs, "role_name", row.roleName)
+	putNullString(attrs, "warehouse_name", row.warehouseName)
+	putNullString(attrs, "warehouse_size", row.warehouseSize)
+	putNullString(attrs, "execution_status", row.executionStatus)
+	putNullString(attrs, "error_code", row.errorCode)
+	putNullString(attrs, "error_message", row.errorMessage)
+	attrs.PutInt("total_elapsed_time", row.totalElapsedTime)
+	attrs.PutInt("bytes_scanned", row.bytesScanned)
+	if row.rowsProduced.Valid {
+		attrs.PutInt("rows_produced", row.rowsProduced.Int64)
+	}
+	if row.creditsUsedCloudServices.Valid {
+		attrs.PutDouble("credits_used_cloud_services", row.creditsUsedCloudServices.Float64)
+	}
+}
+
+func putNullString(attrs pcommon.Map, key string, value sql.NullString) {
+	if value.Valid && value.String != "" {
+		attrs.PutStr(key, value.String)
+	}
+}
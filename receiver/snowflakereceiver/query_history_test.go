@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snowflakereceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snowflakereceiver/internal/metadata"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "select *   from users\n where id = 42 and name = 'it''s'",
+			expected: "select * from users where id = ? and name = ?",
+		},
+		{
+			query:    "SELECT a FROM t1 WHERE b IN (1, 2.5, 'c') -- comment",
+			expected: "SELECT a FROM t1 WHERE b IN (?)",
+		},
+		{
+			query:    "/* tag */ call proc($$ body $$, 1e10)",
+			expected: "call proc(?, ?)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			assert.Equal(t, test.expected, normalizeQuery(test.query))
+		})
+	}
+
+	assert.Equal(t, queryHash(normalizeQuery("select 1")), queryHash(normalizeQuery("select  2")))
+	assert.NotEqual(t, queryHash(normalizeQuery("select 1")), queryHash(normalizeQuery("select a")))
+}
+
+func TestQueryHistoryScraper(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	columns := []string{
+		"query_id", "query_text", "database_name", "schema_name", "query_type", "user_name", "role_name",
+		"warehouse_name", "warehouse_size", "execution_status", "error_code", "error_message", "start_time",
+		"end_time", "total_elapsed_time", "bytes_scanned", "rows_produced", "credits_used_cloud_services",
+	}
+	since := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	start := since.Add(time.Minute)
+	end := since.Add(2 * time.Minute)
+	mock.ExpectQuery(queryHistoryQuery).WithArgs(since, 100).WillReturnRows(mock.NewRows(columns).
+		AddRow("id-1", "select * from t where id = 1", "db", "public", "SELECT", "user", "role", "wh", "X-Small",
+			"SUCCESS", nil, nil, start, end, 60000, 1024, 10, 0.5).
+		AddRow("id-2", "select * from missing", "db", "public", "SELECT", "user", "role", "wh", "X-Small",
+			"FAIL", "002003", "Object does not exist", start, end, 10, 0, nil, nil))
+	// the queries of the lag window are fetched again, only the ones not reported yet are reported
+	mock.ExpectQuery(queryHistoryQuery).WithArgs(since, 102).WillReturnRows(mock.NewRows(columns).
+		AddRow("id-1", "select * from t where id = 1", "db", "public", "SELECT", "user", "role", "wh", "X-Small",
+			"SUCCESS", nil, nil, start, end, 60000, 1024, 10, 0.5).
+		AddRow("id-3", "select 2", "db", "public", "SELECT", "user", "role", "wh", "X-Small",
+			"SUCCESS", nil, nil, start, end, 10, 0, 1, nil).
+		AddRow("id-2", "select * from missing", "db", "public", "SELECT", "user", "role", "wh", "X-Small",
+			"FAIL", "002003", "Object does not exist", start, end, 10, 0, nil, nil))
+	// the queries which ended before the lag window are forgotten
+	later := end.Add(time.Hour)
+	mock.ExpectQuery(queryHistoryQuery).WithArgs(since, 103).WillReturnRows(mock.NewRows(columns).
+		AddRow("id-4", "select 3", "db", "public", "SELECT", "user", "role", "wh", "X-Small",
+			"SUCCESS", nil, nil, later, later, 10, 0, 1, nil))
+	mock.ExpectQuery(queryHistoryQuery).WithArgs(later.Add(-defaultLag), 101).WillReturnRows(mock.NewRows(columns))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Account = "account"
+	cfg.QueryHistory.MaxRows = 100
+	scraper := newSnowflakeQueryHistoryScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.client = &snowflakeClient{
+		client: db,
+		logger: receivertest.NewNopSettings(metadata.Type).Logger,
+	}
+	scraper.started = since
+	scraper.lastEnd = since
+
+	logs, err := scraper.scrape(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 2, logs.LogRecordCount())
+	account, _ := logs.ResourceLogs().At(0).Resource().Attributes().Get("snowflake.account.name")
+	assert.Equal(t, "account", account.Str())
+
+	records := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	success := records.At(0)
+	assert.Equal(t, queryHistoryEventName, success.EventName())
+	assert.Equal(t, start, success.Timestamp().AsTime())
+	assert.Equal(t, "select * from t where id = ?", success.Body().Str())
+	assert.Equal(t, plog.SeverityNumberInfo, success.SeverityNumber())
+	assert.Equal(t, map[string]any{
+		"query_id":                    "id-1",
+		"query_hash":                  queryHash("select * from t where id = ?"),
+		"query_type":                  "SELECT",
+		"database_name":               "db",
+		"schema_name":                 "public",
+		"user_name":                   "user",
+		"role_name":                   "role",
+		"warehouse_name":              "wh",
+		"warehouse_size":              "X-Small",
+		"execution_status":            "SUCCESS",
+		"total_elapsed_time":          int64(60000),
+		"bytes_scanned":               int64(1024),
+		"rows_produced":               int64(10),
+		"credits_used_cloud_services": 0.5,
+	}, success.Attributes().AsRaw())
+
+	failure := records.At(1)
+	assert.Equal(t, plog.SeverityNumberError, failure.SeverityNumber())
+	errorCode, _ := failure.Attributes().Get("error_code")
+	assert.Equal(t, "002003", errorCode.Str())
+	_, ok := failure.Attributes().Get("rows_produced")
+	assert.False(t, ok)
+
+	logs, err = scraper.scrape(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+	queryID, _ := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("query_id")
+	assert.Equal(t, "id-3", queryID.Str())
+
+	logs, err = scraper.scrape(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, logs.LogRecordCount())
+	assert.Len(t, scraper.reported, 1)
+
+	logs, err = scraper.scrape(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 0, logs.LogRecordCount())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQueryHistoryScraperLateQuery(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	columns := []string{
+		"query_id", "query_text", "database_name", "schema_name", "query_type", "user_name", "role_name",
+		"warehouse_name", "warehouse_size", "execution_status", "error_code", "error_message", "start_time",
+		"end_time", "total_elapsed_time", "bytes_scanned", "rows_produced", "credits_used_cloud_services",
+	}
+	started := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	end := started.Add(time.Hour)
+	// the query ending 30 minutes before the most recent one is only added to the view on the next collection
+	lateEnd := end.Add(-30 * time.Minute)
+	mock.ExpectQuery(queryHistoryQuery).WithArgs(started, 100).WillReturnRows(mock.NewRows(columns).
+		AddRow("id-1", "select 1", "db", "public", "SELECT", "user", "role", "wh", "X-Small",
+			"SUCCESS", nil, nil, end, end, 10, 0, 1, nil))
+	mock.ExpectQuery(queryHistoryQuery).WithArgs(end.Add(-defaultLag), 101).WillReturnRows(mock.NewRows(columns).
+		AddRow("id-2", "select 2", "db", "public", "SELECT", "user", "role", "wh", "X-Small",
+			"SUCCESS", nil, nil, lateEnd, lateEnd, 10, 0, 1, nil).
+		AddRow("id-1", "select 1", "db", "public", "SELECT", "user", "role", "wh", "X-Small",
+			"SUCCESS", nil, nil, end, end, 10, 0, 1, nil))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Account = "account"
+	cfg.QueryHistory.MaxRows = 100
+	scraper := newSnowflakeQueryHistoryScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.client = &snowflakeClient{
+		client: db,
+		logger: receivertest.NewNopSettings(metadata.Type).Logger,
+	}
+	scraper.started = started
+	scraper.lastEnd = started
+
+	logs, err := scraper.scrape(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+
+	logs, err = scraper.scrape(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+	queryID, _ := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("query_id")
+	assert.Equal(t, "id-2", queryID.Str())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -68,6 +68,7 @@ func (s *snowflakeMetricsScraper) scrape(ctx context.Context) (pmetric.Metrics,
 	metricScrapes := []func(context.Context, pcommon.Timestamp, chan<- error){
 		s.scrapeBillingMetrics,
 		s.scrapeWarehouseBillingMetrics,
+		s.scrapeWarehouseHourlyBillingMetrics,
 		s.scrapeLoginMetrics,
 		s.scrapeHighLevelQueryMetrics,
 		s.scrapeDBMetrics,
@@ -142,6 +143,72 @@ func (s *snowflakeMetricsScraper) scrapeWarehouseBillingMetrics(ctx context.Cont
 	}
 }
 
+func (s *snowflakeMetricsScraper) scrapeWarehouseHourlyBillingMetrics(ctx context.Context, t pcommon.Timestamp, errs chan<- error) {
+	if !s.conf.Metrics.SnowflakeBillingWarehouseCreditHourly.Enabled && !s.conf.Metrics.SnowflakeBillingWarehouseCreditForecast.Enabled {
+		return
+	}
+
+	warehouseHourlyBillingMetrics, err := s.client.FetchWarehouseHourlyBillingMetrics(ctx)
+	if err != nil {
+		errs <- err
+		return
+	}
+
+	for warehouseName, hourlyCredits := range hourlyCreditsByWarehouse(*warehouseHourlyBillingMetrics) {
+		s.mb.RecordSnowflakeBillingWarehouseCreditHourlyDataPoint(t, hourlyCredits[len(hourlyCredits)-1], warehouseName)
+		s.mb.RecordSnowflakeBillingWarehouseCreditForecastDataPoint(t, forecastCredits(hourlyCredits), warehouseName)
+	}
+}
+
+// hourlyCreditsByWarehouse returns the credits used by each warehouse during each of the 24 hours ending with the
+// most recent hour reported by Snowflake, oldest first. The hours without metering rows didn't use any credits.
+func hourlyCreditsByWarehouse(rows []whHourlyBillingMetric) map[string][]float64 {
+	var latest time.Time
+	for _, row := range rows {
+		if row.endTime.After(latest) {
+			latest = row.endTime
+		}
+	}
+
+	res := make(map[string][]float64)
+	for _, row := range rows {
+		hoursAgo := int(latest.Sub(row.endTime) / time.Hour)
+		if hoursAgo >= 24 {
+			continue
+		}
+		hourlyCredits, ok := res[row.warehouseName.String]
+		if !ok {
+			hourlyCredits = make([]float64, 24)
+			res[row.warehouseName.String] = hourlyCredits
+		}
+		hourlyCredits[23-hoursAgo] += row.credits
+	}
+	return res
+}
+
+// forecastCredits extrapolates the linear trend of the hourly credits to the same number of following hours, and
+// returns the sum of the projected credits.
+func forecastCredits(hourlyCredits []float64) float64 {
+	n := float64(len(hourlyCredits))
+	var sumX, sumY, sumXY, sumXX float64
+	for i, credits := range hourlyCredits {
+		x := float64(i)
+		sumX += x
+		sumY += credits
+		sumXY += x * credits
+		sumXX += x * x
+	}
+	slope := (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+	intercept := (sumY - slope*sumX) / n
+
+	var forecast float64
+	for i := len(hourlyCredits); i < 2*len(hourlyCredits); i++ {
+		// a warehouse can't use negative credits
+		forecast += max(0, intercept+slope*float64(i))
+	}
+	return forecast
+}
+
 func (s *snowflakeMetricsScraper) scrapeLoginMetrics(ctx context.Context, t pcommon.Timestamp, errs chan<- error) {
 	if !s.conf.Metrics.SnowflakeLoginsTotal.Enabled {
 		return
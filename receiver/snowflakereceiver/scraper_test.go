@@ -4,11 +4,14 @@
 package snowflakereceiver
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
@@ -61,6 +64,39 @@ func TestScraper(t *testing.T) {
 		pmetrictest.IgnoreTimestamp()))
 }
 
+func TestWarehouseHourlyCredits(t *testing.T) {
+	latest := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	rows := []whHourlyBillingMetric{
+		{warehouseName: sql.NullString{String: "a", Valid: true}, endTime: latest.Add(-24 * time.Hour), credits: 100},
+		{warehouseName: sql.NullString{String: "b", Valid: true}, endTime: latest.Add(-time.Hour), credits: 2},
+	}
+	// the usage of warehouse a increases by 1 credit per hour
+	for i := range 24 {
+		rows = append(rows, whHourlyBillingMetric{
+			warehouseName: sql.NullString{String: "a", Valid: true},
+			endTime:       latest.Add(-time.Duration(23-i) * time.Hour),
+			credits:       float64(i),
+		})
+	}
+
+	hourlyCredits := hourlyCreditsByWarehouse(rows)
+	require.Len(t, hourlyCredits, 2)
+	require.Len(t, hourlyCredits["a"], 24)
+	assert.Equal(t, 0.0, hourlyCredits["a"][0])
+	assert.Equal(t, 23.0, hourlyCredits["a"][23])
+	assert.Equal(t, 2.0, hourlyCredits["b"][22])
+	assert.Equal(t, 0.0, hourlyCredits["b"][23])
+
+	// the next 24 hours use 24 to 47 credits
+	assert.InDelta(t, 852, forecastCredits(hourlyCredits["a"]), 1e-9)
+	// a decreasing usage is never projected below 0
+	decreasing := make([]float64, 24)
+	for i := range decreasing {
+		decreasing[i] = float64(23 - i)
+	}
+	assert.InDelta(t, 0, forecastCredits(decreasing), 1e-9)
+}
+
 func TestStart(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	cfg.Account = "account"
@@ -4,16 +4,19 @@
 package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver"
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"maps"
 	"net/url"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/vmware/govmomi/event"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/performance"
@@ -24,6 +27,7 @@ import (
 	"github.com/vmware/govmomi/vim25/soap"
 	vt "github.com/vmware/govmomi/vim25/types"
 	"github.com/vmware/govmomi/vsan"
+	vsanmethods "github.com/vmware/govmomi/vsan/methods"
 	"github.com/vmware/govmomi/vsan/types"
 	"go.uber.org/zap"
 )
@@ -37,6 +41,7 @@ type vcenterClient struct {
 	finder         *find.Finder
 	pm             *performance.Manager
 	vm             *view.Manager
+	em             *event.Manager
 	cfg            *Config
 }
 
@@ -89,6 +94,7 @@ func (vc *vcenterClient) EnsureConnection(ctx context.Context) error {
 	vc.finder = find.NewFinder(vc.vimDriver)
 	vc.pm = performance.NewManager(vc.vimDriver)
 	vc.vm = view.NewManager(vc.vimDriver)
+	vc.em = event.NewManager(vc.vimDriver)
 	vsanDriver, err := vsan.NewClient(ctx, vc.vimDriver)
 	if err != nil {
 		vc.logger.Info(fmt.Errorf("could not create VSAN client: %w", err).Error())
@@ -138,6 +144,7 @@ func (vc *vcenterClient) Datastores(ctx context.Context, containerMoRef vt.Manag
 		"name",
 		"summary.capacity",
 		"summary.freeSpace",
+		"summary.url",
 	}, &datastores)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve Datastores: %w", err)
@@ -344,6 +351,56 @@ func (vc *vcenterClient) PerfMetricsQuery(
 	}, nil
 }
 
+// vsanClusterHealthSystem is the vSAN health system of vCenter, which reports the health of the vSAN clusters
+var vsanClusterHealthSystem = vt.ManagedObjectReference{
+	Type:  "VsanVcClusterHealthSystem",
+	Value: "vsan-cluster-health-system",
+}
+
+// VSANClusterHealth returns the vSAN health summary of a cluster, as last computed by vCenter
+func (vc *vcenterClient) VSANClusterHealth(
+	ctx context.Context,
+	clusterRef *vt.ManagedObjectReference,
+) (*types.VsanClusterHealthSummary, error) {
+	// Not all vCenters support vSAN so just return an empty result
+	if vc.vsanDriver == nil {
+		return nil, nil
+	}
+
+	// Don't run the health checks, they're run periodically by vCenter
+	fetchFromCache := true
+	res, err := vsanmethods.VsanQueryVcClusterHealthSummary(ctx, vc.vsanDriver, &types.VsanQueryVcClusterHealthSummary{
+		This:           vsanClusterHealthSystem,
+		Cluster:        clusterRef,
+		FetchFromCache: &fetchFromCache,
+		Fields:         []string{"overallHealth", "groups"},
+	})
+	if err != nil {
+		err = fmt.Errorf("problem retrieving vSAN health for cluster %s: %w", clusterRef.Value, err)
+		return nil, vc.handleVSANError(err, "cluster health")
+	}
+	return &res.Returnval, nil
+}
+
+// Events returns the events created after the given time, sorted by key
+func (vc *vcenterClient) Events(ctx context.Context, since time.Time) ([]vt.BaseEvent, error) {
+	events, err := vc.em.QueryEvents(ctx, vt.EventFilterSpec{
+		Time: &vt.EventFilterSpecByTime{BeginTime: &since},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve Events: %w", err)
+	}
+	slices.SortFunc(events, func(a, b vt.BaseEvent) int {
+		return cmp.Compare(a.GetEvent().Key, b.GetEvent().Key)
+	})
+	return events, nil
+}
+
+// EventCategory returns the category of the event: info, warning, error or user
+func (vc *vcenterClient) EventCategory(ctx context.Context, e vt.BaseEvent) (string, error) {
+	return vc.em.EventCategory(ctx, e)
+}
+
 // vSANQueryResults contains all returned vSAN metric related data
 type vSANQueryResults struct {
 	// Contains vSAN metric data keyed by UUID string
@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/vmware/govmomi/event"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/performance"
 	"github.com/vmware/govmomi/session"
@@ -288,6 +290,42 @@ func TestEmptyVAppInventoryListObjects(t *testing.T) {
 	}, vpx)
 }
 
+func TestEvents(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		client := vcenterClient{
+			vimDriver: c,
+			em:        event.NewManager(c),
+		}
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		require.NoError(t, err)
+
+		since := time.Now()
+		task, err := vm.PowerOff(ctx)
+		require.NoError(t, err)
+		require.NoError(t, task.Wait(ctx))
+
+		events, err := client.Events(ctx, since)
+		require.NoError(t, err)
+		require.NotEmpty(t, events)
+		var poweredOff *types.VmPoweredOffEvent
+		for i, e := range events {
+			if i > 0 {
+				require.Greater(t, e.GetEvent().Key, events[i-1].GetEvent().Key)
+			}
+			if pe, ok := e.(*types.VmPoweredOffEvent); ok {
+				poweredOff = pe
+			}
+		}
+		require.NotNil(t, poweredOff)
+		require.Equal(t, "DC0_H0_VM0", poweredOff.Vm.Name)
+
+		category, err := client.EventCategory(ctx, poweredOff)
+		require.NoError(t, err)
+		require.Equal(t, "info", category)
+	})
+}
+
 func TestSessionReestablish(t *testing.T) {
 	simulator.Test(func(ctx context.Context, c *vim25.Client) {
 		sm := session.NewManager(c)
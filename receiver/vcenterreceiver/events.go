@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver"
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/types"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver/internal/metadata"
+)
+
+// eventsReceiver periodically queries the vCenter events, and reports the events created since the previous
+// query as logs.
+type eventsReceiver struct {
+	client   *vcenterClient
+	config   *Config
+	consumer consumer.Logs
+	logger   *zap.Logger
+
+	// lastKey and lastTime identify the most recent event already reported
+	lastKey  int32
+	lastTime time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newEventsReceiver(logger *zap.Logger, config *Config, consumer consumer.Logs) *eventsReceiver {
+	return &eventsReceiver{
+		client:   newVcenterClient(logger, config),
+		config:   config,
+		consumer: consumer,
+		logger:   logger,
+	}
+}
+
+func (r *eventsReceiver) Start(ctx context.Context, _ component.Host) error {
+	connectErr := r.client.EnsureConnection(ctx)
+	// don't fail to start if we cannot establish connection, just log an error
+	if connectErr != nil {
+		r.logger.Error("unable to establish a connection to the vSphere SDK " + connectErr.Error())
+	}
+	// Only the events created after the start of the receiver are reported
+	r.lastTime = time.Now()
+
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.config.CollectionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.collect(ctx); err != nil {
+					r.logger.Error("failed to collect vCenter events", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *eventsReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return r.client.Disconnect(ctx)
+}
+
+// collect reports the events created since the previous collection.
+func (r *eventsReceiver) collect(ctx context.Context) error {
+	if err := r.client.EnsureConnection(ctx); err != nil {
+		return err
+	}
+	events, err := r.client.Events(ctx, r.lastTime)
+	if err != nil {
+		return err
+	}
+
+	logs := plog.NewLogs()
+	records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	records.Scope().SetName(metadata.ScopeName)
+	lastKey, lastTime := r.lastKey, r.lastTime
+	for _, e := range events {
+		event := e.GetEvent()
+		// The events created at the time of the previous query are returned again
+		if event.Key <= r.lastKey {
+			continue
+		}
+		category, err := r.client.EventCategory(ctx, e)
+		if err != nil {
+			r.logger.Debug("failed to retrieve the category of the vCenter event", zap.Int32("key", event.Key), zap.Error(err))
+		}
+		eventToLogRecord(e, category, records.LogRecords().AppendEmpty())
+		lastKey = max(lastKey, event.Key)
+		if event.CreatedTime.After(lastTime) {
+			lastTime = event.CreatedTime
+		}
+	}
+	if logs.LogRecordCount() == 0 {
+		return nil
+	}
+	if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+		return err
+	}
+	r.lastKey, r.lastTime = lastKey, lastTime
+	return nil
+}
+
+// eventToLogRecord converts a vCenter event to a log record.
+func eventToLogRecord(e types.BaseEvent, category string, lr plog.LogRecord) {
+	event := e.GetEvent()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(event.CreatedTime))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	lr.Body().SetStr(event.FullFormattedMessage)
+	switch category {
+	case "error":
+		lr.SetSeverityNumber(plog.SeverityNumberError)
+	case "warning":
+		lr.SetSeverityNumber(plog.SeverityNumberWarn)
+	case "info", "user":
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	}
+	lr.SetSeverityText(category)
+
+	attrs := lr.Attributes()
+	attrs.PutStr("vcenter.event.type", reflect.Indirect(reflect.ValueOf(e)).Type().Name())
+	attrs.PutInt("vcenter.event.key", int64(event.Key))
+	attrs.PutInt("vcenter.event.chain_id", int64(event.ChainId))
+	if event.UserName != "" {
+		attrs.PutStr("vcenter.event.user", event.UserName)
+	}
+	if event.Datacenter != nil {
+		attrs.PutStr("vcenter.datacenter.name", event.Datacenter.Name)
+	}
+	if event.ComputeResource != nil {
+		attrs.PutStr("vcenter.cluster.name", event.ComputeResource.Name)
+	}
+	if event.Host != nil {
+		attrs.PutStr("vcenter.host.name", event.Host.Name)
+	}
+	if event.Vm != nil {
+		attrs.PutStr("vcenter.vm.name", event.Vm.Name)
+	}
+	if event.Ds != nil {
+		attrs.PutStr("vcenter.datastore.name", event.Ds.Name)
+	}
+}
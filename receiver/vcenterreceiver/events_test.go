@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vcenterreceiver // import github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+func TestEventToLogRecord(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	e := &types.VmPoweredOffEvent{
+		VmEvent: types.VmEvent{
+			Event: types.Event{
+				Key:                  42,
+				ChainId:              40,
+				CreatedTime:          created,
+				UserName:             "admin",
+				FullFormattedMessage: "vm-1 on host-1 in dc-1 is powered off",
+				Datacenter:           &types.DatacenterEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "dc-1"}},
+				ComputeResource:      &types.ComputeResourceEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "cluster-1"}},
+				Host:                 &types.HostEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "host-1"}},
+				Vm:                   &types.VmEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "vm-1"}},
+			},
+		},
+	}
+
+	lr := plog.NewLogRecord()
+	eventToLogRecord(e, "warning", lr)
+	assert.Equal(t, created, lr.Timestamp().AsTime())
+	assert.Equal(t, "vm-1 on host-1 in dc-1 is powered off", lr.Body().Str())
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, "warning", lr.SeverityText())
+	assert.Equal(t, map[string]any{
+		"vcenter.event.type":      "VmPoweredOffEvent",
+		"vcenter.event.key":       int64(42),
+		"vcenter.event.chain_id":  int64(40),
+		"vcenter.event.user":      "admin",
+		"vcenter.datacenter.name": "dc-1",
+		"vcenter.cluster.name":    "cluster-1",
+		"vcenter.host.name":       "host-1",
+		"vcenter.vm.name":         "vm-1",
+	}, lr.Attributes().AsRaw())
+}
+
+func TestEventsReceiverCollect(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		pw, _ := simulator.DefaultLogin.Password()
+		cfg := createDefaultConfig().(*Config)
+		cfg.Username = simulator.DefaultLogin.Username()
+		cfg.Password = configopaque.String(pw)
+		cfg.Endpoint = fmt.Sprintf("%s://%s", c.URL().Scheme, c.URL().Host)
+		cfg.Insecure = true
+		sink := &consumertest.LogsSink{}
+		r := newEventsReceiver(zap.NewNop(), cfg, sink)
+		defer func() { require.NoError(t, r.client.Disconnect(ctx)) }()
+		r.lastTime = time.Now()
+
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		require.NoError(t, err)
+		task, err := vm.PowerOff(ctx)
+		require.NoError(t, err)
+		require.NoError(t, task.Wait(ctx))
+
+		require.NoError(t, r.collect(ctx))
+		require.Len(t, sink.AllLogs(), 1)
+		records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+		var found bool
+		for i := 0; i < records.Len(); i++ {
+			eventType, _ := records.At(i).Attributes().Get("vcenter.event.type")
+			if eventType.Str() == "VmPoweredOffEvent" {
+				found = true
+				assert.Equal(t, plog.SeverityNumberInfo, records.At(i).SeverityNumber())
+			}
+		}
+		assert.True(t, found)
+
+		// The events already reported are skipped
+		require.NoError(t, r.collect(ctx))
+		assert.Len(t, sink.AllLogs(), 1)
+	})
+}
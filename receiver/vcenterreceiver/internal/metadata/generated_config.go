@@ -37,6 +37,7 @@ type MetricsConfig struct {
 	VcenterClusterVMCount               MetricConfig `mapstructure:"vcenter.cluster.vm.count"`
 	VcenterClusterVMTemplateCount       MetricConfig `mapstructure:"vcenter.cluster.vm_template.count"`
 	VcenterClusterVsanCongestions       MetricConfig `mapstructure:"vcenter.cluster.vsan.congestions"`
+	VcenterClusterVsanHealth            MetricConfig `mapstructure:"vcenter.cluster.vsan.health"`
 	VcenterClusterVsanLatencyAvg        MetricConfig `mapstructure:"vcenter.cluster.vsan.latency.avg"`
 	VcenterClusterVsanOperations        MetricConfig `mapstructure:"vcenter.cluster.vsan.operations"`
 	VcenterClusterVsanThroughput        MetricConfig `mapstructure:"vcenter.cluster.vsan.throughput"`
@@ -49,6 +50,7 @@ type MetricsConfig struct {
 	VcenterDatacenterVMCount            MetricConfig `mapstructure:"vcenter.datacenter.vm.count"`
 	VcenterDatastoreDiskUsage           MetricConfig `mapstructure:"vcenter.datastore.disk.usage"`
 	VcenterDatastoreDiskUtilization     MetricConfig `mapstructure:"vcenter.datastore.disk.utilization"`
+	VcenterDatastoreLatencyAvg          MetricConfig `mapstructure:"vcenter.datastore.latency.avg"`
 	VcenterHostCPUCapacity              MetricConfig `mapstructure:"vcenter.host.cpu.capacity"`
 	VcenterHostCPUReserved              MetricConfig `mapstructure:"vcenter.host.cpu.reserved"`
 	VcenterHostCPUUsage                 MetricConfig `mapstructure:"vcenter.host.cpu.usage"`
@@ -128,6 +130,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		VcenterClusterVsanCongestions: MetricConfig{
 			Enabled: true,
 		},
+		VcenterClusterVsanHealth: MetricConfig{
+			Enabled: false,
+		},
 		VcenterClusterVsanLatencyAvg: MetricConfig{
 			Enabled: true,
 		},
@@ -164,6 +169,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		VcenterDatastoreDiskUtilization: MetricConfig{
 			Enabled: true,
 		},
+		VcenterDatastoreLatencyAvg: MetricConfig{
+			Enabled: false,
+		},
 		VcenterHostCPUCapacity: MetricConfig{
 			Enabled: true,
 		},
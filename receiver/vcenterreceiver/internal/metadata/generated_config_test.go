@@ -34,6 +34,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterClusterVMCount:               MetricConfig{Enabled: true},
 					VcenterClusterVMTemplateCount:       MetricConfig{Enabled: true},
 					VcenterClusterVsanCongestions:       MetricConfig{Enabled: true},
+					VcenterClusterVsanHealth:            MetricConfig{Enabled: true},
 					VcenterClusterVsanLatencyAvg:        MetricConfig{Enabled: true},
 					VcenterClusterVsanOperations:        MetricConfig{Enabled: true},
 					VcenterClusterVsanThroughput:        MetricConfig{Enabled: true},
@@ -46,6 +47,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterDatacenterVMCount:            MetricConfig{Enabled: true},
 					VcenterDatastoreDiskUsage:           MetricConfig{Enabled: true},
 					VcenterDatastoreDiskUtilization:     MetricConfig{Enabled: true},
+					VcenterDatastoreLatencyAvg:          MetricConfig{Enabled: true},
 					VcenterHostCPUCapacity:              MetricConfig{Enabled: true},
 					VcenterHostCPUReserved:              MetricConfig{Enabled: true},
 					VcenterHostCPUUsage:                 MetricConfig{Enabled: true},
@@ -126,6 +128,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterClusterVMCount:               MetricConfig{Enabled: false},
 					VcenterClusterVMTemplateCount:       MetricConfig{Enabled: false},
 					VcenterClusterVsanCongestions:       MetricConfig{Enabled: false},
+					VcenterClusterVsanHealth:            MetricConfig{Enabled: false},
 					VcenterClusterVsanLatencyAvg:        MetricConfig{Enabled: false},
 					VcenterClusterVsanOperations:        MetricConfig{Enabled: false},
 					VcenterClusterVsanThroughput:        MetricConfig{Enabled: false},
@@ -138,6 +141,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterDatacenterVMCount:            MetricConfig{Enabled: false},
 					VcenterDatastoreDiskUsage:           MetricConfig{Enabled: false},
 					VcenterDatastoreDiskUtilization:     MetricConfig{Enabled: false},
+					VcenterDatastoreLatencyAvg:          MetricConfig{Enabled: false},
 					VcenterHostCPUCapacity:              MetricConfig{Enabled: false},
 					VcenterHostCPUReserved:              MetricConfig{Enabled: false},
 					VcenterHostCPUUsage:                 MetricConfig{Enabled: false},
@@ -330,6 +330,40 @@ var MapAttributeVMCountPowerState = map[string]AttributeVMCountPowerState{
 	"unknown":   AttributeVMCountPowerStateUnknown,
 }
 
+// AttributeVsanHealthStatus specifies the value vsan_health_status attribute.
+type AttributeVsanHealthStatus int
+
+const (
+	_ AttributeVsanHealthStatus = iota
+	AttributeVsanHealthStatusGreen
+	AttributeVsanHealthStatusYellow
+	AttributeVsanHealthStatusRed
+	AttributeVsanHealthStatusUnknown
+)
+
+// String returns the string representation of the AttributeVsanHealthStatus.
+func (av AttributeVsanHealthStatus) String() string {
+	switch av {
+	case AttributeVsanHealthStatusGreen:
+		return "green"
+	case AttributeVsanHealthStatusYellow:
+		return "yellow"
+	case AttributeVsanHealthStatusRed:
+		return "red"
+	case AttributeVsanHealthStatusUnknown:
+		return "unknown"
+	}
+	return ""
+}
+
+// MapAttributeVsanHealthStatus is a helper map of string to AttributeVsanHealthStatus attribute value.
+var MapAttributeVsanHealthStatus = map[string]AttributeVsanHealthStatus{
+	"green":   AttributeVsanHealthStatusGreen,
+	"yellow":  AttributeVsanHealthStatusYellow,
+	"red":     AttributeVsanHealthStatusRed,
+	"unknown": AttributeVsanHealthStatusUnknown,
+}
+
 // AttributeVsanLatencyType specifies the value vsan_latency_type attribute.
 type AttributeVsanLatencyType int
 
@@ -437,6 +471,9 @@ var MetricsInfo = metricsInfo{
 	VcenterClusterVsanCongestions: metricInfo{
 		Name: "vcenter.cluster.vsan.congestions",
 	},
+	VcenterClusterVsanHealth: metricInfo{
+		Name: "vcenter.cluster.vsan.health",
+	},
 	VcenterClusterVsanLatencyAvg: metricInfo{
 		Name: "vcenter.cluster.vsan.latency.avg",
 	},
@@ -473,6 +510,9 @@ var MetricsInfo = metricsInfo{
 	VcenterDatastoreDiskUtilization: metricInfo{
 		Name: "vcenter.datastore.disk.utilization",
 	},
+	VcenterDatastoreLatencyAvg: metricInfo{
+		Name: "vcenter.datastore.latency.avg",
+	},
 	VcenterHostCPUCapacity: metricInfo{
 		Name: "vcenter.host.cpu.capacity",
 	},
@@ -637,6 +677,7 @@ type metricsInfo struct {
 	VcenterClusterVMCount               metricInfo
 	VcenterClusterVMTemplateCount       metricInfo
 	VcenterClusterVsanCongestions       metricInfo
+	VcenterClusterVsanHealth            metricInfo
 	VcenterClusterVsanLatencyAvg        metricInfo
 	VcenterClusterVsanOperations        metricInfo
 	VcenterClusterVsanThroughput        metricInfo
@@ -649,6 +690,7 @@ type metricsInfo struct {
 	VcenterDatacenterVMCount            metricInfo
 	VcenterDatastoreDiskUsage           metricInfo
 	VcenterDatastoreDiskUtilization     metricInfo
+	VcenterDatastoreLatencyAvg          metricInfo
 	VcenterHostCPUCapacity              metricInfo
 	VcenterHostCPUReserved              metricInfo
 	VcenterHostCPUUsage                 metricInfo
@@ -1124,6 +1166,59 @@ func newMetricVcenterClusterVsanCongestions(cfg MetricConfig) metricVcenterClust
 	return m
 }
 
+type metricVcenterClusterVsanHealth struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.cluster.vsan.health metric with initial data.
+func (m *metricVcenterClusterVsanHealth) init() {
+	m.data.SetName("vcenter.cluster.vsan.health")
+	m.data.SetDescription("The vSAN health of the cluster, reported as 1 for the current status of the overall health and of each health check group.")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricVcenterClusterVsanHealth) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vsanHealthGroupAttributeValue string, vsanHealthStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("group", vsanHealthGroupAttributeValue)
+	dp.Attributes().PutStr("status", vsanHealthStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterClusterVsanHealth) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterClusterVsanHealth) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterClusterVsanHealth(cfg MetricConfig) metricVcenterClusterVsanHealth {
+	m := metricVcenterClusterVsanHealth{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricVcenterClusterVsanLatencyAvg struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1758,6 +1853,58 @@ func newMetricVcenterDatastoreDiskUtilization(cfg MetricConfig) metricVcenterDat
 	return m
 }
 
+type metricVcenterDatastoreLatencyAvg struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.datastore.latency.avg metric with initial data.
+func (m *metricVcenterDatastoreLatencyAvg) init() {
+	m.data.SetName("vcenter.datastore.latency.avg")
+	m.data.SetDescription("The average latency of the datastore commands, averaged over the hosts using the datastore.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricVcenterDatastoreLatencyAvg) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, diskDirectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("direction", diskDirectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterDatastoreLatencyAvg) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterDatastoreLatencyAvg) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterDatastoreLatencyAvg(cfg MetricConfig) metricVcenterDatastoreLatencyAvg {
+	m := metricVcenterDatastoreLatencyAvg{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricVcenterHostCPUCapacity struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -4445,6 +4592,7 @@ type MetricsBuilder struct {
 	metricVcenterClusterVMCount               metricVcenterClusterVMCount
 	metricVcenterClusterVMTemplateCount       metricVcenterClusterVMTemplateCount
 	metricVcenterClusterVsanCongestions       metricVcenterClusterVsanCongestions
+	metricVcenterClusterVsanHealth            metricVcenterClusterVsanHealth
 	metricVcenterClusterVsanLatencyAvg        metricVcenterClusterVsanLatencyAvg
 	metricVcenterClusterVsanOperations        metricVcenterClusterVsanOperations
 	metricVcenterClusterVsanThroughput        metricVcenterClusterVsanThroughput
@@ -4457,6 +4605,7 @@ type MetricsBuilder struct {
 	metricVcenterDatacenterVMCount            metricVcenterDatacenterVMCount
 	metricVcenterDatastoreDiskUsage           metricVcenterDatastoreDiskUsage
 	metricVcenterDatastoreDiskUtilization     metricVcenterDatastoreDiskUtilization
+	metricVcenterDatastoreLatencyAvg          metricVcenterDatastoreLatencyAvg
 	metricVcenterHostCPUCapacity              metricVcenterHostCPUCapacity
 	metricVcenterHostCPUReserved              metricVcenterHostCPUReserved
 	metricVcenterHostCPUUsage                 metricVcenterHostCPUUsage
@@ -4541,6 +4690,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricVcenterClusterVMCount:               newMetricVcenterClusterVMCount(mbc.Metrics.VcenterClusterVMCount),
 		metricVcenterClusterVMTemplateCount:       newMetricVcenterClusterVMTemplateCount(mbc.Metrics.VcenterClusterVMTemplateCount),
 		metricVcenterClusterVsanCongestions:       newMetricVcenterClusterVsanCongestions(mbc.Metrics.VcenterClusterVsanCongestions),
+		metricVcenterClusterVsanHealth:            newMetricVcenterClusterVsanHealth(mbc.Metrics.VcenterClusterVsanHealth),
 		metricVcenterClusterVsanLatencyAvg:        newMetricVcenterClusterVsanLatencyAvg(mbc.Metrics.VcenterClusterVsanLatencyAvg),
 		metricVcenterClusterVsanOperations:        newMetricVcenterClusterVsanOperations(mbc.Metrics.VcenterClusterVsanOperations),
 		metricVcenterClusterVsanThroughput:        newMetricVcenterClusterVsanThroughput(mbc.Metrics.VcenterClusterVsanThroughput),
@@ -4553,6 +4703,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricVcenterDatacenterVMCount:            newMetricVcenterDatacenterVMCount(mbc.Metrics.VcenterDatacenterVMCount),
 		metricVcenterDatastoreDiskUsage:           newMetricVcenterDatastoreDiskUsage(mbc.Metrics.VcenterDatastoreDiskUsage),
 		metricVcenterDatastoreDiskUtilization:     newMetricVcenterDatastoreDiskUtilization(mbc.Metrics.VcenterDatastoreDiskUtilization),
+		metricVcenterDatastoreLatencyAvg:          newMetricVcenterDatastoreLatencyAvg(mbc.Metrics.VcenterDatastoreLatencyAvg),
 		metricVcenterHostCPUCapacity:              newMetricVcenterHostCPUCapacity(mbc.Metrics.VcenterHostCPUCapacity),
 		metricVcenterHostCPUReserved:              newMetricVcenterHostCPUReserved(mbc.Metrics.VcenterHostCPUReserved),
 		metricVcenterHostCPUUsage:                 newMetricVcenterHostCPUUsage(mbc.Metrics.VcenterHostCPUUsage),
@@ -4756,6 +4907,7 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricVcenterClusterVMCount.emit(ils.Metrics())
 	mb.metricVcenterClusterVMTemplateCount.emit(ils.Metrics())
 	mb.metricVcenterClusterVsanCongestions.emit(ils.Metrics())
+	mb.metricVcenterClusterVsanHealth.emit(ils.Metrics())
 	mb.metricVcenterClusterVsanLatencyAvg.emit(ils.Metrics())
 	mb.metricVcenterClusterVsanOperations.emit(ils.Metrics())
 	mb.metricVcenterClusterVsanThroughput.emit(ils.Metrics())
@@ -4768,6 +4920,7 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricVcenterDatacenterVMCount.emit(ils.Metrics())
 	mb.metricVcenterDatastoreDiskUsage.emit(ils.Metrics())
 	mb.metricVcenterDatastoreDiskUtilization.emit(ils.Metrics())
+	mb.metricVcenterDatastoreLatencyAvg.emit(ils.Metrics())
 	mb.metricVcenterHostCPUCapacity.emit(ils.Metrics())
 	mb.metricVcenterHostCPUReserved.emit(ils.Metrics())
 	mb.metricVcenterHostCPUUsage.emit(ils.Metrics())
@@ -4890,6 +5043,11 @@ func (mb *MetricsBuilder) RecordVcenterClusterVsanCongestionsDataPoint(ts pcommo
 	mb.metricVcenterClusterVsanCongestions.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordVcenterClusterVsanHealthDataPoint adds a data point to vcenter.cluster.vsan.health metric.
+func (mb *MetricsBuilder) RecordVcenterClusterVsanHealthDataPoint(ts pcommon.Timestamp, val int64, vsanHealthGroupAttributeValue string, vsanHealthStatusAttributeValue AttributeVsanHealthStatus) {
+	mb.metricVcenterClusterVsanHealth.recordDataPoint(mb.startTime, ts, val, vsanHealthGroupAttributeValue, vsanHealthStatusAttributeValue.String())
+}
+
 // RecordVcenterClusterVsanLatencyAvgDataPoint adds a data point to vcenter.cluster.vsan.latency.avg metric.
 func (mb *MetricsBuilder) RecordVcenterClusterVsanLatencyAvgDataPoint(ts pcommon.Timestamp, val int64, vsanLatencyTypeAttributeValue AttributeVsanLatencyType) {
 	mb.metricVcenterClusterVsanLatencyAvg.recordDataPoint(mb.startTime, ts, val, vsanLatencyTypeAttributeValue.String())
@@ -4950,6 +5108,11 @@ func (mb *MetricsBuilder) RecordVcenterDatastoreDiskUtilizationDataPoint(ts pcom
 	mb.metricVcenterDatastoreDiskUtilization.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordVcenterDatastoreLatencyAvgDataPoint adds a data point to vcenter.datastore.latency.avg metric.
+func (mb *MetricsBuilder) RecordVcenterDatastoreLatencyAvgDataPoint(ts pcommon.Timestamp, val float64, diskDirectionAttributeValue AttributeDiskDirection) {
+	mb.metricVcenterDatastoreLatencyAvg.recordDataPoint(mb.startTime, ts, val, diskDirectionAttributeValue.String())
+}
+
 // RecordVcenterHostCPUCapacityDataPoint adds a data point to vcenter.host.cpu.capacity metric.
 func (mb *MetricsBuilder) RecordVcenterHostCPUCapacityDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricVcenterHostCPUCapacity.recordDataPoint(mb.startTime, ts, val)
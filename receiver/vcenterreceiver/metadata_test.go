@@ -82,10 +82,10 @@ func getVSANMetricsFromMetadataYAML(t *testing.T) []string {
 		t.Fatalf("Failed to parse metadata.yaml: %v", err)
 	}
 
-	// Extract vSAN metrics
+	// Extract vSAN metrics, except the ones not reported by the vSAN performance service
 	var vSANMetrics []string
 	for metricName := range metadata.Metrics {
-		if strings.Contains(metricName, "vsan") {
+		if strings.Contains(metricName, "vsan") && metricName != "vcenter.cluster.vsan.health" {
 			vSANMetrics = append(vSANMetrics, metricName)
 		}
 	}
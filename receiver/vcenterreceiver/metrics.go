@@ -4,9 +4,14 @@
 package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver"
 
 import (
+	"path"
+	"strings"
+	"time"
+
 	"github.com/vmware/govmomi/performance"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
+	vsantypes "github.com/vmware/govmomi/vsan/types"
 	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
@@ -120,6 +125,59 @@ func (v *vcenterMetricScraper) recordDatastoreStats(
 	v.mb.RecordVcenterDatastoreDiskUtilizationDataPoint(ts, diskUtilization)
 }
 
+// datastoreUUID returns the UUID identifying a Datastore in the performance counters of the hosts, from its URL
+// (e.g. ds:///vmfs/volumes/<uuid>/)
+func datastoreUUID(ds *mo.Datastore) string {
+	return path.Base(strings.TrimSuffix(ds.Summary.Url, "/"))
+}
+
+// recordDatastoreLatency records the latency of a vSphere Datastore, averaged over the hosts using it
+func (v *vcenterMetricScraper) recordDatastoreLatency(ds *mo.Datastore) {
+	uuid := datastoreUUID(ds)
+	if uuid == "" || uuid == "." {
+		return
+	}
+
+	type latency struct {
+		sum   int64
+		count int64
+		ts    time.Time
+	}
+	latencies := map[metadata.AttributeDiskDirection]*latency{}
+	for _, entityMetric := range v.scrapeData.hostPerfMetricsByRef {
+		for _, val := range entityMetric.Value {
+			if val.Instance != uuid || len(val.Value) == 0 {
+				continue
+			}
+			var direction metadata.AttributeDiskDirection
+			switch val.Name {
+			case "datastore.totalReadLatency.average":
+				direction = metadata.AttributeDiskDirectionRead
+			case "datastore.totalWriteLatency.average":
+				direction = metadata.AttributeDiskDirectionWrite
+			default:
+				continue
+			}
+			// Only the most recent sample of each host is used
+			last := len(val.Value) - 1
+			l, ok := latencies[direction]
+			if !ok {
+				l = &latency{}
+				latencies[direction] = l
+			}
+			l.sum += val.Value[last]
+			l.count++
+			if ts := entityMetric.SampleInfo[last].Timestamp; ts.After(l.ts) {
+				l.ts = ts
+			}
+		}
+	}
+
+	for direction, l := range latencies {
+		v.mb.RecordVcenterDatastoreLatencyAvgDataPoint(pcommon.NewTimestampFromTime(l.ts), float64(l.sum)/float64(l.count), direction)
+	}
+}
+
 // recordClusterStats records stat metrics for a vSphere Cluster
 func (v *vcenterMetricScraper) recordClusterStats(
 	ts pcommon.Timestamp,
@@ -174,6 +232,21 @@ func (v *vcenterMetricScraper) recordClusterVSANMetrics(vSANMetrics *vSANMetricR
 	}
 }
 
+func getVSANHealthStatusAttribute(status string) metadata.AttributeVsanHealthStatus {
+	if attr, ok := metadata.MapAttributeVsanHealthStatus[status]; ok {
+		return attr
+	}
+	return metadata.AttributeVsanHealthStatusUnknown
+}
+
+// recordClusterVSANHealth records the vSAN health of a vSphere Cluster
+func (v *vcenterMetricScraper) recordClusterVSANHealth(ts pcommon.Timestamp, health *vsantypes.VsanClusterHealthSummary) {
+	v.mb.RecordVcenterClusterVsanHealthDataPoint(ts, 1, "overall", getVSANHealthStatusAttribute(health.OverallHealth))
+	for _, group := range health.Groups {
+		v.mb.RecordVcenterClusterVsanHealthDataPoint(ts, 1, group.GroupName, getVSANHealthStatusAttribute(group.GroupHealth))
+	}
+}
+
 // recordResourcePoolStats records stat metrics for a vSphere Resource Pool
 func (v *vcenterMetricScraper) recordResourcePoolStats(
 	ts pcommon.Timestamp,
@@ -337,6 +410,12 @@ var hostPerfMetricList = []string{
 	"cpu.totalCapacity.average",
 }
 
+// datastorePerfMetricList are the Performance Counters reported by the hosts for each of the Datastores they use
+var datastorePerfMetricList = []string{
+	"datastore.totalReadLatency.average",
+	"datastore.totalWriteLatency.average",
+}
+
 // recordHostPerformanceMetrics records performance metrics for a vSphere Host
 func (v *vcenterMetricScraper) recordHostPerformanceMetrics(entityMetric *performance.EntityMetric) {
 	for _, val := range entityMetric.Value {
@@ -84,6 +84,9 @@ func (v *vcenterMetricScraper) buildDatastoreMetrics(
 
 	// Record & emit Datastore metric data points
 	v.recordDatastoreStats(ts, ds)
+	if v.config.Metrics.VcenterDatastoreLatencyAvg.Enabled {
+		v.recordDatastoreLatency(ds)
+	}
 	v.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
@@ -384,6 +387,9 @@ func (v *vcenterMetricScraper) buildClusterMetrics(
 	}
 	// Record and emit Cluster metric data points
 	v.recordClusterStats(ts, cr, vmGroupInfo)
+	if health := v.scrapeData.clusterVSANHealthByRef[cr.Reference().Value]; health != nil {
+		v.recordClusterVSANHealth(ts, health)
+	}
 
 	if v.hasEnabledVSANMetrics() {
 		vSANConfig := cr.ConfigurationEx.(*types.ClusterConfigInfoEx).VsanConfigInfo
@@ -4,11 +4,13 @@ package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/performance"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
+	vsantypes "github.com/vmware/govmomi/vsan/types"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
@@ -42,6 +44,7 @@ type vcenterScrapeData struct {
 	vmVSANMetricsByUUID      map[string]*vSANMetricResults
 	hostVSANMetricsByUUID    map[string]*vSANMetricResults
 	clusterVSANMetricsByUUID map[string]*vSANMetricResults
+	clusterVSANHealthByRef   map[string]*vsantypes.VsanClusterHealthSummary
 }
 
 type vcenterMetricScraper struct {
@@ -85,6 +88,7 @@ func newVcenterScrapeData() *vcenterScrapeData {
 		vmVSANMetricsByUUID:      make(map[string]*vSANMetricResults),
 		hostVSANMetricsByUUID:    make(map[string]*vSANMetricResults),
 		clusterVSANMetricsByUUID: make(map[string]*vSANMetricResults),
+		clusterVSANHealthByRef:   make(map[string]*vsantypes.VsanClusterHealthSummary),
 	}
 }
 
@@ -276,6 +280,21 @@ func (v *vcenterMetricScraper) scrapeComputes(ctx context.Context, dc *mo.Datace
 		}
 	}
 
+	if v.config.Metrics.VcenterClusterVsanHealth.Enabled {
+		// Get the vSAN health of the Clusters and store for later retrieval
+		v.scrapeData.clusterVSANHealthByRef = make(map[string]*vsantypes.VsanClusterHealthSummary)
+		for _, clusterRef := range v.scrapeData.clusterRefs {
+			health, err := v.client.VSANClusterHealth(ctx, clusterRef)
+			if err != nil {
+				errs.AddPartial(1, fmt.Errorf("failed to retrieve vSAN health for Clusters: %w", err))
+				continue
+			}
+			if health != nil {
+				v.scrapeData.clusterVSANHealthByRef[clusterRef.Value] = health
+			}
+		}
+	}
+
 	if v.hasEnabledVSANMetrics() {
 		// Get all Cluster vSAN metrics and store for later retrieval (only if vSAN metrics are enabled)
 		vSANMetrics, err := v.client.VSANClusters(ctx, v.scrapeData.clusterRefs)
@@ -312,7 +331,12 @@ func (v *vcenterMetricScraper) scrapeHosts(ctx context.Context, dc *mo.Datacente
 		IntervalId: int32(20),
 	}
 	// Get all HostSystem performance metrics and store for later retrieval
-	results, err := v.client.PerfMetricsQuery(ctx, spec, hostPerfMetricList, hsRefs)
+	perfMetrics := hostPerfMetricList
+	if v.config.Metrics.VcenterDatastoreLatencyAvg.Enabled {
+		// The hosts report the latency of each datastore they use
+		perfMetrics = append(slices.Clone(hostPerfMetricList), datastorePerfMetricList...)
+	}
+	results, err := v.client.PerfMetricsQuery(ctx, spec, perfMetrics, hsRefs)
 	if err != nil {
 		errs.AddPartial(1, fmt.Errorf("failed to retrieve perf metrics for HostSystems: %w", err))
 	} else {
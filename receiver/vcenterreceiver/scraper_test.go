@@ -7,10 +7,17 @@ import (
 	"context"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vmware/govmomi/performance"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+	vsantypes "github.com/vmware/govmomi/vsan/types"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 
@@ -113,6 +120,68 @@ func setResourcePoolMemoryUsageAttrFeatureGate(t *testing.T, val bool) {
 	})
 }
 
+func TestRecordDatastoreLatencyAndVSANHealth(t *testing.T) {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.VcenterClusterVsanHealth.Enabled = true
+	mbc.Metrics.VcenterDatastoreLatencyAvg.Enabled = true
+	scraper := &vcenterMetricScraper{
+		config:     &Config{MetricsBuilderConfig: mbc},
+		mb:         metadata.NewMetricsBuilder(mbc, receivertest.NewNopSettings(metadata.Type)),
+		logger:     zap.NewNop(),
+		scrapeData: newVcenterScrapeData(),
+	}
+	ts := time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC)
+	hostLatency := func(read, write int64) *performance.EntityMetric {
+		return &performance.EntityMetric{
+			SampleInfo: []types.PerfSampleInfo{{Timestamp: ts.Add(-20 * time.Second)}, {Timestamp: ts}},
+			Value: []performance.MetricSeries{
+				{Name: "datastore.totalReadLatency.average", Instance: "ds-uuid", Value: []int64{100, read}},
+				{Name: "datastore.totalWriteLatency.average", Instance: "ds-uuid", Value: []int64{100, write}},
+				{Name: "datastore.totalReadLatency.average", Instance: "other-uuid", Value: []int64{100, 100}},
+			},
+		}
+	}
+	scraper.scrapeData.hostPerfMetricsByRef["host-1"] = hostLatency(2, 5)
+	scraper.scrapeData.hostPerfMetricsByRef["host-2"] = hostLatency(3, 6)
+
+	scraper.recordDatastoreLatency(&mo.Datastore{Summary: types.DatastoreSummary{Url: "ds:///vmfs/volumes/ds-uuid/"}})
+	scraper.recordClusterVSANHealth(pcommon.NewTimestampFromTime(ts), &vsantypes.VsanClusterHealthSummary{
+		OverallHealth: "yellow",
+		Groups: []vsantypes.VsanClusterHealthGroup{
+			{GroupName: "Network", GroupHealth: "green"},
+			{GroupName: "Data", GroupHealth: "info"},
+		},
+	})
+
+	metrics := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		values := map[string]any{}
+		for j := 0; j < m.Gauge().DataPoints().Len(); j++ {
+			dp := m.Gauge().DataPoints().At(j)
+			assert.Equal(t, ts, dp.Timestamp().AsTime())
+			switch m.Name() {
+			case "vcenter.datastore.latency.avg":
+				direction, _ := dp.Attributes().Get("direction")
+				values[direction.Str()] = dp.DoubleValue()
+			case "vcenter.cluster.vsan.health":
+				group, _ := dp.Attributes().Get("group")
+				status, _ := dp.Attributes().Get("status")
+				values[group.Str()] = status.Str()
+			}
+		}
+		switch m.Name() {
+		case "vcenter.datastore.latency.avg":
+			assert.Equal(t, map[string]any{"read": 2.5, "write": 5.5}, values)
+		case "vcenter.cluster.vsan.health":
+			assert.Equal(t, map[string]any{"overall": "yellow", "Network": "green", "Data": "unknown"}, values)
+		default:
+			t.Errorf("unexpected metric %s", m.Name())
+		}
+	}
+}
+
 func TestScrape_NoClient(t *testing.T) {
 	ctx := t.Context()
 	scraper := &vcenterMetricScraper{
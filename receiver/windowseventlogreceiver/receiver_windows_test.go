@@ -51,6 +51,24 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, createTestConfig(), cfg)
 }
 
+func TestLoadConfigRemotes(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := newFactoryAdapter()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "remotes").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	expected := createTestConfig()
+	expected.InputConfig.Remotes = []windows.RemoteConfig{
+		{Server: "server-1", Username: "user", Password: "password"},
+		{Server: "server-2", Username: "user", Password: "password", Domain: "domain"},
+	}
+	assert.Equal(t, expected, cfg)
+}
+
 func TestCreateWithInvalidInputConfig(t *testing.T) {
 	cfg := &WindowsLogConfig{
 		BaseConfig: adapter.BaseConfig{},
@@ -5,7 +5,9 @@ package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configoptional"
 )
 
 // Config defines configuration for Zipkin receiver.
@@ -15,6 +17,9 @@ type Config struct {
 	// If enabled the zipkin receiver will attempt to parse string tags/binary annotations into int/bool/float.
 	// Disabled by default
 	ParseStringTags bool `mapstructure:"parse_string_tags"`
+	// GRPC configures the server receiving Zipkin proto3 spans with the SpanService/Report gRPC method.
+	// Disabled by default
+	GRPC configoptional.Optional[configgrpc.ServerConfig] `mapstructure:"grpc"`
 
 	// prevent unkeyed literal initialization
 	_ struct{}
@@ -10,8 +10,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 
@@ -42,6 +44,7 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				ParseStringTags: false,
+				GRPC:            defaultGRPCConfig(),
 			},
 		},
 		{
@@ -54,6 +57,24 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				ParseStringTags: true,
+				GRPC:            defaultGRPCConfig(),
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "grpc"),
+			expected: &Config{
+				ServerConfig: confighttp.ServerConfig{
+					NetAddr: confignet.AddrConfig{
+						Transport: "tcp",
+						Endpoint:  defaultHTTPEndpoint,
+					},
+				},
+				GRPC: configoptional.Some(configgrpc.ServerConfig{
+					NetAddr: confignet.AddrConfig{
+						Transport: "tcp",
+						Endpoint:  "localhost:9413",
+					},
+				}),
 			},
 		},
 	}
@@ -72,3 +93,12 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func defaultGRPCConfig() configoptional.Optional[configgrpc.ServerConfig] {
+	return configoptional.Default(configgrpc.ServerConfig{
+		NetAddr: confignet.AddrConfig{
+			Transport: "tcp",
+			Endpoint:  defaultGRPCEndpoint,
+		},
+	})
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 
@@ -19,6 +21,7 @@ import (
 
 const (
 	defaultHTTPEndpoint = "localhost:9411"
+	defaultGRPCEndpoint = "localhost:9412"
 )
 
 // NewFactory creates a new Zipkin receiver factory
@@ -38,6 +41,12 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		ServerConfig:    confighttp.ServerConfig{NetAddr: netAddr},
 		ParseStringTags: false,
+		GRPC: configoptional.Default(configgrpc.ServerConfig{
+			NetAddr: confignet.AddrConfig{
+				Endpoint:  defaultGRPCEndpoint,
+				Transport: confignet.TransportTypeTCP,
+			},
+		}),
 	}
 }
 
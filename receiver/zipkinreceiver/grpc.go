@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver"
+
+import (
+	"context"
+
+	zipkin_proto3 "github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const spanServiceReportMethod = "/zipkin.proto3.SpanService/Report"
+
+// spanServiceServer is the SpanService of the Zipkin proto3 API, see
+// https://github.com/openzipkin/zipkin-api/blob/master/zipkin.proto.
+// The ReportResponse message is empty, so emptypb.Empty is used in its place.
+type spanServiceServer interface {
+	Report(context.Context, *zipkin_proto3.ListOfSpans) (*emptypb.Empty, error)
+}
+
+var spanServiceDesc = grpc.ServiceDesc{
+	ServiceName: "zipkin.proto3.SpanService",
+	HandlerType: (*spanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Report",
+			Handler:    spanServiceReportHandler,
+		},
+	},
+	Metadata: "zipkin.proto",
+}
+
+func spanServiceReportHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(zipkin_proto3.ListOfSpans)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(spanServiceServer).Report(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: spanServiceReportMethod,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(spanServiceServer).Report(ctx, req.(*zipkin_proto3.ListOfSpans))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Report receives the spans sent with the SpanService/Report gRPC method.
+func (zr *zipkinReceiver) Report(ctx context.Context, req *zipkin_proto3.ListOfSpans) (*emptypb.Empty, error) {
+	obsrecv := zr.obsrecvrs[receiverTransportGRPCV2PROTO]
+	ctx = obsrecv.StartTracesOp(ctx)
+
+	// The translator decodes the encoded spans, so that the gRPC spans are handled
+	// exactly like the protobuf spans received over HTTP.
+	blob, err := proto.Marshal(req)
+	if err != nil {
+		obsrecv.EndTracesOp(ctx, zipkinV2TagValue, len(req.GetSpans()), err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	td, err := zr.protobufUnmarshaler.UnmarshalTraces(blob)
+	if err != nil {
+		obsrecv.EndTracesOp(ctx, zipkinV2TagValue, len(req.GetSpans()), err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	numReceivedSpans := td.SpanCount()
+	consumerErr := zr.nextConsumer.ConsumeTraces(ctx, td)
+	obsrecv.EndTracesOp(ctx, zipkinV2TagValue, numReceivedSpans, consumerErr)
+	if consumerErr == nil {
+		return &emptypb.Empty{}, nil
+	}
+	if consumererror.IsPermanent(consumerErr) {
+		return nil, status.Error(codes.InvalidArgument, consumerErr.Error())
+	}
+	return nil, status.Error(codes.Unavailable, consumerErr.Error())
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	zipkin_proto3 "github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configgrpc"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver/internal/metadata"
+)
+
+func TestStartTraceReceptionWithGRPC(t *testing.T) {
+	cfg := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			NetAddr: confignet.AddrConfig{
+				Transport: "tcp",
+				Endpoint:  "localhost:0",
+			},
+		},
+		GRPC: configoptional.Some(configgrpc.ServerConfig{
+			NetAddr: confignet.AddrConfig{
+				Transport: "tcp",
+				Endpoint:  "localhost:0",
+			},
+		}),
+	}
+	zr, err := newReceiver(cfg, consumertest.NewNop(), receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+
+	require.NoError(t, zr.Start(t.Context(), componenttest.NewNopHost()))
+	require.NotNil(t, zr.grpc)
+	require.NoError(t, zr.Shutdown(t.Context()))
+}
+
+func TestReceiverGRPCReport(t *testing.T) {
+	tests := []struct {
+		name     string
+		consumer consumer.Traces
+		wantCode codes.Code
+	}{
+		{
+			name:     "success",
+			consumer: new(consumertest.TracesSink),
+			wantCode: codes.OK,
+		},
+		{
+			name:     "consumer_error",
+			consumer: consumertest.NewErr(errors.New("consumer error")),
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "consumer_permanent_error",
+			consumer: consumertest.NewErr(consumererror.NewPermanent(errors.New("consumer error"))),
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			zr, err := newReceiver(cfg, tt.consumer, receivertest.NewNopSettings(metadata.Type))
+			require.NoError(t, err)
+
+			srv := grpc.NewServer()
+			srv.RegisterService(&spanServiceDesc, zr)
+			ln, err := net.Listen("tcp", "localhost:0")
+			require.NoError(t, err)
+			go func() {
+				_ = srv.Serve(ln)
+			}()
+			defer srv.Stop()
+
+			conn, err := grpc.NewClient(ln.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			require.NoError(t, err)
+			defer conn.Close()
+
+			req := &zipkin_proto3.ListOfSpans{
+				Spans: []*zipkin_proto3.Span{
+					{
+						TraceId:       []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+						Id:            []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+						Name:          "get /api",
+						Kind:          zipkin_proto3.Span_SERVER,
+						Timestamp:     1_700_000_000_000_000,
+						Duration:      1_000,
+						LocalEndpoint: &zipkin_proto3.Endpoint{ServiceName: "frontend"},
+					},
+				},
+			}
+			err = conn.Invoke(t.Context(), spanServiceReportMethod, req, &emptypb.Empty{})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+
+			if sink, ok := tt.consumer.(*consumertest.TracesSink); ok {
+				require.Len(t, sink.AllTraces(), 1)
+				td := sink.AllTraces()[0]
+				require.Equal(t, 1, td.SpanCount())
+				serviceName, ok := td.ResourceSpans().At(0).Resource().Attributes().Get("service.name")
+				require.True(t, ok)
+				assert.Equal(t, "frontend", serviceName.Str())
+				assert.Equal(t, "get /api", td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"compress/zlib"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -21,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"google.golang.org/grpc"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv1"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv2"
@@ -31,6 +33,8 @@ const (
 	receiverTransportV1JSON   = "http_v1_json"
 	receiverTransportV2JSON   = "http_v2_json"
 	receiverTransportV2PROTO  = "http_v2_proto"
+
+	receiverTransportGRPCV2PROTO = "grpc_v2_proto"
 )
 
 var (
@@ -44,6 +48,7 @@ type zipkinReceiver struct {
 
 	shutdownWG sync.WaitGroup
 	server     *http.Server
+	grpc       *grpc.Server
 	config     *Config
 
 	v1ThriftUnmarshaler      ptrace.Unmarshaler
@@ -60,7 +65,7 @@ var _ http.Handler = (*zipkinReceiver)(nil)
 
 // newReceiver creates a new zipkinReceiver reference.
 func newReceiver(config *Config, nextConsumer consumer.Traces, settings receiver.Settings) (*zipkinReceiver, error) {
-	transports := []string{receiverTransportV1Thrift, receiverTransportV1JSON, receiverTransportV2JSON, receiverTransportV2PROTO}
+	transports := []string{receiverTransportV1Thrift, receiverTransportV1JSON, receiverTransportV2JSON, receiverTransportV2PROTO, receiverTransportGRPCV2PROTO}
 	obsrecvrs := make(map[string]*receiverhelper.ObsReport)
 	for _, transport := range transports {
 		obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
@@ -114,6 +119,31 @@ func (zr *zipkinReceiver) Start(ctx context.Context, host component.Host) error
 		}
 	}()
 
+	if zr.config.GRPC.HasValue() {
+		grpcConfig := zr.config.GRPC.Get()
+		zr.grpc, err = grpcConfig.ToServer(ctx, host.GetExtensions(), zr.settings.TelemetrySettings)
+		if err != nil {
+			return fmt.Errorf("failed to build the options for the Zipkin gRPC server: %w", err)
+		}
+
+		var grpcListener net.Listener
+		grpcListener, err = grpcConfig.NetAddr.Listen(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to bind to gRPC address %q: %w", grpcConfig.NetAddr.Endpoint, err)
+		}
+
+		zr.grpc.RegisterService(&spanServiceDesc, zr)
+
+		zr.shutdownWG.Add(1)
+		go func() {
+			defer zr.shutdownWG.Done()
+
+			if errGrpc := zr.grpc.Serve(grpcListener); !errors.Is(errGrpc, grpc.ErrServerStopped) && errGrpc != nil {
+				componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(errGrpc))
+			}
+		}()
+	}
+
 	return nil
 }
 
@@ -149,12 +179,15 @@ func (zr *zipkinReceiver) v2ToTraceSpans(blob []byte, hdr http.Header) (reqs ptr
 
 // Shutdown tells the receiver that should stop reception,
 // giving it a chance to perform any necessary clean-up and shutting down
-// its HTTP server.
+// its HTTP and gRPC servers.
 func (zr *zipkinReceiver) Shutdown(context.Context) error {
 	var err error
 	if zr.server != nil {
 		err = zr.server.Close()
 	}
+	if zr.grpc != nil {
+		zr.grpc.GracefulStop()
+	}
 	zr.shutdownWG.Wait()
 	return err
 }